@@ -45,6 +45,7 @@ func NewRootCmd() *cobra.Command {
 		NewVersionCmd(),
 		NewLocalCmd(),
 		NewPreviewCmd(),
+		NewLogsCmd(),
 	)
 
 	cmd.SetHelpTemplate(helpTemplate)
@@ -108,6 +109,11 @@ func NewPreviewCmd() *cobra.Command {
 	return cmd
 }
 
+// NewLogsCmd generate resource run logs command.
+func NewLogsCmd() *cobra.Command {
+	return pkgcmd.Logs(serverConfig)
+}
+
 // NewVersionCmd return cli version.
 func NewVersionCmd() *cobra.Command {
 	return pkgcmd.Version(serverConfig)
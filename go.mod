@@ -26,6 +26,8 @@ require (
 	github.com/alexeyco/simpletable v1.0.0
 	github.com/aliyun/alibaba-cloud-sdk-go v1.62.3
 	github.com/aliyun/aliyun_assist_client v0.0.0-20231123090709-62b1701cc31a
+	github.com/antonmedv/expr v1.15.5
+	github.com/apache/arrow/go/v12 v12.0.1
 	github.com/argoproj/argo-workflows/v3 v3.5.4
 	github.com/aws/aws-sdk-go v1.49.21
 	github.com/aws/aws-sdk-go-v2 v1.24.1
@@ -126,6 +128,7 @@ require (
 	github.com/Azure/go-autorest/tracing v0.6.0 // indirect
 	github.com/AzureAD/microsoft-authentication-library-for-go v1.2.1 // indirect
 	github.com/BurntSushi/toml v1.3.2 // indirect
+	github.com/JohnCGriffin/overflow v0.0.0-20211019200055-46fa312c352c // indirect
 	github.com/MakeNowJust/heredoc v1.0.0 // indirect
 	github.com/Masterminds/goutils v1.1.1 // indirect
 	github.com/Masterminds/squirrel v1.5.4 // indirect
@@ -138,8 +141,6 @@ require (
 	github.com/alitto/pond v1.8.3 // indirect
 	github.com/allegro/bigcache/v3 v3.1.0 // indirect
 	github.com/andybalholm/brotli v1.1.0 // indirect
-	github.com/antonmedv/expr v1.15.5 // indirect
-	github.com/apache/arrow/go/v12 v12.0.1 // indirect
 	github.com/apache/thrift v0.19.0 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/argoproj/argo-events v1.9.0 // indirect
@@ -0,0 +1,128 @@
+package auditlog
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/auths/session"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/log"
+	"github.com/seal-io/walrus/utils/strs"
+)
+
+// Auditor returns a runtime.RouteAuditor to record the mutating API calls as audit logs.
+func Auditor(mc model.ClientSet) runtime.RouteAuditor {
+	logger := log.WithName("api").WithName("audit")
+
+	return runtime.RouteAuditFunc(func(c *gin.Context, r runtime.AuditRecord) {
+		// Record even if the client has gone.
+		ctx := context.WithoutCancel(c.Request.Context())
+
+		entity := &model.AuditLog{
+			Action:  getAction(r),
+			Method:  r.Method,
+			Path:    c.Request.URL.Path,
+			Request: r.Request,
+			Status:  r.Status,
+			Result:  types.AuditLogResultSucceeded,
+			Message: r.Message,
+		}
+
+		if r.Status >= http.StatusBadRequest {
+			entity.Result = types.AuditLogResultFailed
+		}
+
+		if sj, err := session.GetSubject(c); err == nil && !sj.IsAnonymous() {
+			entity.SubjectID = sj.ID.String()
+			entity.SubjectName = sj.Name
+		}
+
+		if n := len(r.Kinds); n != 0 {
+			entity.ResourceKind = r.Kinds[n-1]
+			entity.ResourceID = r.ResourceRefers[n-1]
+		}
+
+		for i := range r.Kinds {
+			switch r.Kinds[i] {
+			case "Project":
+				entity.ProjectID = getProjectID(ctx, mc, r.ResourceRefers[i])
+			case "Environment":
+				entity.EnvironmentID = getEnvironmentID(ctx, mc, entity.ProjectID, r.ResourceRefers[i])
+			}
+		}
+
+		err := mc.AuditLogs().Create().
+			Set(entity).
+			Exec(ctx)
+		if err != nil {
+			logger.Errorf("error recording %s %s: %v", entity.Method, entity.Path, err)
+		}
+	})
+}
+
+// getAction returns the action of the given record,
+// i.e. create, update, patch, delete or the name of the custom route.
+func getAction(r runtime.AuditRecord) string {
+	if r.Custom {
+		return strs.CamelizeDownFirst(r.CustomName)
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	}
+
+	return strings.ToLower(r.Method)
+}
+
+// getProjectID returns the ID of the project referred by the given ID or name.
+func getProjectID(ctx context.Context, mc model.ClientSet, refer string) object.ID {
+	if refer == "" || object.ID(refer).Valid() {
+		return object.ID(refer)
+	}
+
+	id, err := mc.Projects().Query().
+		Where(project.Name(refer)).
+		OnlyID(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return id
+}
+
+// getEnvironmentID returns the ID of the environment referred by the given ID or name.
+func getEnvironmentID(ctx context.Context, mc model.ClientSet, projectID object.ID, refer string) object.ID {
+	if refer == "" || object.ID(refer).Valid() {
+		return object.ID(refer)
+	}
+
+	if projectID == "" {
+		return ""
+	}
+
+	id, err := mc.Environments().Query().
+		Where(
+			environment.ProjectID(projectID),
+			environment.Name(refer)).
+		OnlyID(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return id
+}
@@ -0,0 +1,67 @@
+package auditlog
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+)
+
+var (
+	queryFields = []string{
+		auditlog.FieldSubjectName,
+		auditlog.FieldPath,
+		auditlog.FieldResourceID,
+	}
+	getFields  = auditlog.WithoutFields()
+	sortFields = []string{
+		auditlog.FieldCreateTime,
+		auditlog.FieldSubjectName,
+		auditlog.FieldResourceKind,
+		auditlog.FieldStatus,
+	}
+)
+
+func (h Handler) Get(req GetRequest) (GetResponse, error) {
+	entity, err := h.modelClient.AuditLogs().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeAuditLog(entity), nil
+}
+
+func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse, int, error) {
+	query := h.modelClient.AuditLogs().Query().
+		Where(req.Filter.Predicates()...)
+
+	if queries, ok := req.Querying(queryFields); ok {
+		query.Where(queries)
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getFields, getFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortFields, model.Desc(auditlog.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeAuditLogs(entities), cnt, nil
+}
@@ -0,0 +1,118 @@
+package auditlog
+
+import (
+	"errors"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+type (
+	GetRequest struct {
+		model.AuditLogQueryInput `path:",inline"`
+	}
+
+	GetResponse = *model.AuditLogOutput
+)
+
+type (
+	CollectionGetRequest struct {
+		model.AuditLogQueryInputs `path:",inline" query:",inline"`
+
+		runtime.RequestCollection[
+			predicate.AuditLog, auditlog.OrderOption,
+		] `query:",inline"`
+
+		Filter `query:",inline"`
+	}
+
+	CollectionGetResponse = []*model.AuditLogOutput
+)
+
+func (r *CollectionGetRequest) Validate() error {
+	if err := r.AuditLogQueryInputs.Validate(); err != nil {
+		return err
+	}
+
+	return r.Filter.Validate()
+}
+
+// Filter holds the filters of querying audit logs.
+type Filter struct {
+	SubjectID     string     `query:"subjectID,omitempty"`
+	SubjectName   string     `query:"subjectName,omitempty"`
+	Action        string     `query:"action,omitempty"`
+	ResourceKind  string     `query:"resourceKind,omitempty"`
+	ResourceID    string     `query:"resourceID,omitempty"`
+	ProjectID     object.ID  `query:"projectID,omitempty"`
+	EnvironmentID object.ID  `query:"environmentID,omitempty"`
+	Result        string     `query:"result,omitempty"`
+	StartTime     *time.Time `query:"startTime,omitempty"`
+	EndTime       *time.Time `query:"endTime,omitempty"`
+}
+
+func (f *Filter) Validate() error {
+	switch f.Result {
+	case "", types.AuditLogResultSucceeded, types.AuditLogResultFailed:
+	default:
+		return errors.New("invalid result: unknown")
+	}
+
+	if f.StartTime != nil && f.EndTime != nil && f.EndTime.Before(*f.StartTime) {
+		return errors.New("invalid time range: end time is early than start time")
+	}
+
+	return nil
+}
+
+// Predicates returns the predicates of the filter.
+func (f *Filter) Predicates() []predicate.AuditLog {
+	var ps []predicate.AuditLog
+
+	if f.SubjectID != "" {
+		ps = append(ps, auditlog.SubjectID(f.SubjectID))
+	}
+
+	if f.SubjectName != "" {
+		ps = append(ps, auditlog.SubjectName(f.SubjectName))
+	}
+
+	if f.Action != "" {
+		ps = append(ps, auditlog.Action(f.Action))
+	}
+
+	if f.ResourceKind != "" {
+		ps = append(ps, auditlog.ResourceKind(f.ResourceKind))
+	}
+
+	if f.ResourceID != "" {
+		ps = append(ps, auditlog.ResourceID(f.ResourceID))
+	}
+
+	if f.ProjectID != "" {
+		ps = append(ps, auditlog.ProjectID(f.ProjectID))
+	}
+
+	if f.EnvironmentID != "" {
+		ps = append(ps, auditlog.EnvironmentID(f.EnvironmentID))
+	}
+
+	if f.Result != "" {
+		ps = append(ps, auditlog.Result(f.Result))
+	}
+
+	if f.StartTime != nil {
+		ps = append(ps, auditlog.CreateTimeGTE(*f.StartTime))
+	}
+
+	if f.EndTime != nil {
+		ps = append(ps, auditlog.CreateTimeLTE(*f.EndTime))
+	}
+
+	return ps
+}
@@ -0,0 +1,57 @@
+package auditlog
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// exportBatchSize is the size of the audit logs to fetch per batch when exporting.
+const exportBatchSize = 500
+
+// CollectionRouteExport exports the audit logs in JSON Lines format.
+func (h Handler) CollectionRouteExport(req CollectionRouteExportRequest) error {
+	query := h.modelClient.AuditLogs().Query().
+		Where(req.Filter.Predicates()...).
+		Order(model.Asc(auditlog.FieldCreateTime), model.Asc(auditlog.FieldID))
+
+	name := fmt.Sprintf("audit-logs-%s.jsonl", time.Now().UTC().Format("20060102150405"))
+
+	w := req.Context.Writer
+	w.Header().Set("Content-Type", "application/jsonl")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+	w.WriteHeader(http.StatusOK)
+
+	for offset := 0; ; offset += exportBatchSize {
+		entities, err := query.Clone().
+			Limit(exportBatchSize).
+			Offset(offset).
+			All(req.Context)
+		if err != nil {
+			return err
+		}
+
+		for i := range entities {
+			bs, err := json.Marshal(model.ExposeAuditLog(entities[i]))
+			if err != nil {
+				return err
+			}
+
+			if _, err = w.Write(append(bs, '\n')); err != nil {
+				return err
+			}
+		}
+
+		if len(entities) < exportBatchSize {
+			break
+		}
+
+		w.Flush()
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package auditlog
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+)
+
+type CollectionRouteExportRequest struct {
+	_ struct{} `route:"GET=/export"`
+
+	model.AuditLogQueryInputs `path:",inline" query:",inline"`
+
+	Filter `query:",inline"`
+}
+
+func (r *CollectionRouteExportRequest) Validate() error {
+	if err := r.AuditLogQueryInputs.Validate(); err != nil {
+		return err
+	}
+
+	return r.Filter.Validate()
+}
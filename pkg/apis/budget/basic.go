@@ -0,0 +1,106 @@
+package budget
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+)
+
+func (h Handler) Create(req CreateRequest) (CreateResponse, error) {
+	entity := req.Model()
+
+	entity, err := h.modelClient.Budgets().Create().
+		Set(entity).
+		Save(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeBudget(entity), nil
+}
+
+func (h Handler) Get(req GetRequest) (GetResponse, error) {
+	entity, err := h.modelClient.Budgets().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeBudget(entity), nil
+}
+
+func (h Handler) Update(req UpdateRequest) error {
+	entity := req.Model()
+
+	return h.modelClient.Budgets().UpdateOne(entity).
+		Set(entity).
+		Exec(req.Context)
+}
+
+func (h Handler) Delete(req DeleteRequest) error {
+	return h.modelClient.Budgets().DeleteOneID(req.ID).
+		Exec(req.Context)
+}
+
+var (
+	queryFields = []string{
+		budget.FieldName,
+	}
+	getFields  = budget.WithoutFields()
+	sortFields = []string{
+		budget.FieldName,
+		budget.FieldAmount,
+		budget.FieldCreateTime,
+	}
+)
+
+func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse, int, error) {
+	query := h.modelClient.Budgets().Query().
+		Where(budget.ProjectID(req.Project.ID))
+
+	if req.Environment != nil {
+		query.Where(budget.EnvironmentID(req.Environment.ID))
+	}
+
+	if queries, ok := req.Querying(queryFields); ok {
+		query.Where(queries)
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getFields, getFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortFields, model.Desc(budget.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeBudgets(entities), cnt, nil
+}
+
+func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
+	ids := req.IDs()
+
+	return h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
+		_, err := tx.Budgets().Delete().
+			Where(budget.IDIn(ids...)).
+			Exec(req.Context)
+
+		return err
+	})
+}
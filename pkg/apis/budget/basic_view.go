@@ -0,0 +1,120 @@
+package budget
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/validation"
+)
+
+type (
+	CreateRequest struct {
+		model.BudgetCreateInput `path:",inline" json:",inline"`
+	}
+
+	CreateResponse = *model.BudgetOutput
+)
+
+func (r *CreateRequest) Validate() error {
+	if err := r.BudgetCreateInput.Validate(); err != nil {
+		return err
+	}
+
+	if err := validation.IsValidName(r.Name); err != nil {
+		return fmt.Errorf("invalid name: %w", err)
+	}
+
+	if r.Period == "" {
+		r.Period = types.BudgetPeriodMonthly
+	}
+
+	return validateBudget(r.Amount, r.Period, r.StartTime, r.EndTime, r.Thresholds)
+}
+
+type (
+	GetRequest = model.BudgetQueryInput
+
+	GetResponse = *model.BudgetOutput
+)
+
+type UpdateRequest struct {
+	model.BudgetUpdateInput `path:",inline" json:",inline"`
+}
+
+func (r *UpdateRequest) Validate() error {
+	if err := r.BudgetUpdateInput.Validate(); err != nil {
+		return err
+	}
+
+	if r.Period == "" {
+		r.Period = types.BudgetPeriodMonthly
+	}
+
+	if len(r.Thresholds) == 0 {
+		r.Thresholds = types.DefaultBudgetThresholds()
+	}
+
+	return validateBudget(r.Amount, r.Period, r.StartTime, r.EndTime, r.Thresholds)
+}
+
+type DeleteRequest = model.BudgetDeleteInput
+
+type (
+	CollectionGetRequest struct {
+		model.BudgetQueryInputs `path:",inline" query:",inline"`
+
+		runtime.RequestCollection[
+			predicate.Budget, budget.OrderOption,
+		] `query:",inline"`
+	}
+
+	CollectionGetResponse = []*model.BudgetOutput
+)
+
+type CollectionDeleteRequest = model.BudgetDeleteInputs
+
+func validateBudget(
+	amount float64,
+	period string,
+	startTime, endTime *time.Time,
+	thresholds types.BudgetThresholds,
+) error {
+	if amount <= 0 {
+		return errors.New("invalid amount: must be positive")
+	}
+
+	switch period {
+	case types.BudgetPeriodMonthly:
+		if startTime != nil || endTime != nil {
+			return errors.New("invalid period: time range is only allowed for custom period")
+		}
+	case types.BudgetPeriodCustom:
+		if startTime == nil || endTime == nil {
+			return errors.New("invalid period: start time and end time are required for custom period")
+		}
+
+		if !endTime.After(*startTime) {
+			return errors.New("invalid period: end time must be after start time")
+		}
+	default:
+		return fmt.Errorf("invalid period: %s", period)
+	}
+
+	for _, t := range thresholds {
+		if !types.IsBudgetThresholdType(t.Type) {
+			return fmt.Errorf("invalid threshold type: %s", t.Type)
+		}
+
+		if t.Percent <= 0 {
+			return fmt.Errorf("invalid threshold percent: %g", t.Percent)
+		}
+	}
+
+	return nil
+}
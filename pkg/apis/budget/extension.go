@@ -0,0 +1,47 @@
+package budget
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+)
+
+var (
+	getEventsFields  = budgetevent.WithoutFields()
+	sortEventsFields = []string{
+		budgetevent.FieldPeriodStart,
+		budgetevent.FieldCreateTime,
+	}
+)
+
+func (h Handler) RouteGetEvents(req RouteGetEventsRequest) (RouteGetEventsResponse, int, error) {
+	query := h.modelClient.BudgetEvents().Query().
+		Where(budgetevent.BudgetID(req.ID))
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getEventsFields, getEventsFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortEventsFields, model.Desc(budgetevent.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeBudgetEvents(entities), cnt, nil
+}
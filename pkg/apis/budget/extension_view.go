@@ -0,0 +1,22 @@
+package budget
+
+import (
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+type (
+	RouteGetEventsRequest struct {
+		_ struct{} `route:"GET=/events"`
+
+		model.BudgetQueryInput `path:",inline"`
+
+		runtime.RequestCollection[
+			predicate.BudgetEvent, budgetevent.OrderOption,
+		] `query:",inline"`
+	}
+
+	RouteGetEventsResponse = []*model.BudgetEventOutput
+)
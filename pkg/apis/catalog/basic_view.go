@@ -5,6 +5,9 @@ import (
 	"net/url"
 	"regexp"
 
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"golang.org/x/crypto/ssh"
+
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
@@ -37,6 +40,14 @@ func (r *CreateRequest) Validate() error {
 
 	switch r.Type {
 	case types.GitDriverGithub, types.GitDriverGitlab, types.GitDriverGitee:
+		if len(r.Repositories) != 0 || len(r.Directories) != 0 || r.Monorepo {
+			return fmt.Errorf("repositories, directories and monorepo are only supported by %q catalog",
+				types.GitDriverGit)
+		}
+	case types.GitDriverGit:
+		if _, err = transport.NewEndpoint(r.Source); err != nil {
+			return fmt.Errorf("invalid source: %w", err)
+		}
 	default:
 		return fmt.Errorf("unsupported catalog type %q", r.Type)
 	}
@@ -45,7 +56,7 @@ func (r *CreateRequest) Validate() error {
 		return fmt.Errorf("invalid filter pattern: %w", err)
 	}
 
-	return nil
+	return validateGitAccess(r.Repositories, r.Credential)
 }
 
 type (
@@ -69,7 +80,7 @@ func (r *UpdateRequest) Validate() error {
 		return fmt.Errorf("invalid filter pattern: %w", err)
 	}
 
-	return nil
+	return validateGitAccess(r.Repositories, r.Credential)
 }
 
 type DeleteRequest = model.CatalogDeleteInput
@@ -93,3 +104,26 @@ func (r *CollectionGetRequest) SetStream(stream runtime.RequestUnidiStream) {
 }
 
 type CollectionDeleteRequest = model.CatalogDeleteInputs
+
+// validateGitAccess validates the repositories and the credential of the catalog.
+func validateGitAccess(repositories []string, credential map[string]string) error {
+	for _, repo := range repositories {
+		if _, err := transport.NewEndpoint(repo); err != nil {
+			return fmt.Errorf("invalid repository %q: %w", repo, err)
+		}
+	}
+
+	for k, v := range credential {
+		switch k {
+		case types.CatalogCredentialUsername, types.CatalogCredentialToken:
+		case types.CatalogCredentialSSHKey:
+			if _, err := ssh.ParseRawPrivateKey([]byte(v)); err != nil {
+				return fmt.Errorf("invalid credential: invalid SSH key: %w", err)
+			}
+		default:
+			return fmt.Errorf("invalid credential: unknown key %q", k)
+		}
+	}
+
+	return nil
+}
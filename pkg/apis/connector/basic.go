@@ -40,6 +40,10 @@ func (h Handler) Create(req CreateRequest) (CreateResponse, error) {
 			"It takes about an hour to generate hour-level cost data")
 	}
 
+	if entity.Category == types.ConnectorCategoryCloudProvider && entity.EnableFinOps {
+		status.ConnectorStatusCostSynced.Unknown(entity, "Importing cost data from billing export")
+	}
+
 	entity.Status.SetSummary(status.WalkConnector(&entity.Status))
 
 	entity, err := h.modelClient.Connectors().Create().
@@ -273,15 +277,35 @@ func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
 }
 
 // applyFinOps updates custom pricing and (re)installs cost tools if needed,
+// or imports the cost data of the cloud provider connector,
 // within 3 minutes in the background.
 func applyFinOps(mc model.ClientSet, conn *model.Connector, reinstall bool) error {
-	// Skip non-k8s connectors.
-	if conn.Category != types.ConnectorCategoryKubernetes {
+	// Skip finops disabling connectors.
+	if !conn.EnableFinOps {
 		return nil
 	}
 
-	// Skip finops disabling connectors.
-	if !conn.EnableFinOps {
+	// Import cost data for cloud provider connectors.
+	if conn.Category == types.ConnectorCategoryCloudProvider {
+		gopool.Go(func() {
+			logger := log.WithName("api").WithName("connector")
+
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+			defer cancel()
+
+			syncer := pkgconn.NewStatusSyncer(mc)
+
+			err := syncer.SyncStatus(ctx, conn, true)
+			if err != nil {
+				logger.Errorf("error syncing status of connector %q: %v", conn.ID, err)
+			}
+		})
+
+		return nil
+	}
+
+	// Skip non-k8s connectors.
+	if conn.Category != types.ConnectorCategoryKubernetes {
 		return nil
 	}
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/costs/billing"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
@@ -142,8 +143,17 @@ func validateConnector(ctx context.Context, mc model.ClientSet, entity *model.Co
 		return errors.New("invalid connector category")
 	}
 
-	if entity.Category != types.ConnectorCategoryKubernetes && entity.EnableFinOps {
-		return errors.New("invalid connector: finOps not supported")
+	if entity.EnableFinOps {
+		switch entity.Category {
+		case types.ConnectorCategoryKubernetes:
+		case types.ConnectorCategoryCloudProvider:
+			// Import the cost from the billing export.
+			if _, err := billing.NewSource(entity); err != nil {
+				return fmt.Errorf("invalid connector: finOps: %w", err)
+			}
+		default:
+			return errors.New("invalid connector: finOps not supported")
+		}
 	}
 
 	return nil
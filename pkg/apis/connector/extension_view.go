@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/drone/go-scm/scm"
+	"golang.org/x/exp/slices"
 
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
@@ -24,7 +25,7 @@ func (r *RouteApplyCostToolsRequest) Validate() error {
 		return err
 	}
 
-	return validateConnectorType(r.Context, r.Client, r.ID)
+	return validateConnectorType(r.Context, r.Client, r.ID, types.ConnectorCategoryKubernetes)
 }
 
 type RouteSyncCostDataRequest struct {
@@ -38,7 +39,8 @@ func (r *RouteSyncCostDataRequest) Validate() error {
 		return err
 	}
 
-	return validateConnectorType(r.Context, r.Client, r.ID)
+	return validateConnectorType(r.Context, r.Client, r.ID,
+		types.ConnectorCategoryKubernetes, types.ConnectorCategoryCloudProvider)
 }
 
 type (
@@ -70,16 +72,21 @@ type (
 	RouteGetRepositoryBranchesResponse = []*scm.Reference
 )
 
-func validateConnectorType(ctx context.Context, modelClient model.ClientSet, id object.ID) error {
+func validateConnectorType(
+	ctx context.Context,
+	modelClient model.ClientSet,
+	id object.ID,
+	categories ...string,
+) error {
 	conn, err := modelClient.Connectors().Query().
-		Select(connector.FieldType).
+		Select(connector.FieldCategory).
 		Where(connector.ID(id)).
 		Only(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get connector: %w", err)
 	}
 
-	if conn.Type != types.ConnectorTypeKubernetes {
+	if !slices.Contains(categories, conn.Category) {
 		return errors.New("invalid type: not support")
 	}
 
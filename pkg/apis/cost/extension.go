@@ -11,6 +11,7 @@ import (
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/costs/distributor"
 	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
 	"github.com/seal-io/walrus/pkg/dao/model/costreport"
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/types"
@@ -28,6 +29,82 @@ func (h Handler) CollectionRouteGetCostReports(
 	return runtime.FullPageResponse(items, count), nil
 }
 
+func (h Handler) CollectionRouteGetForecastCosts(
+	req CollectionRouteGetForecastCostsRequest,
+) (CollectionRouteGetForecastCostsResponse, error) {
+	items, err := h.forecaster.Forecast(
+		req.Context, req.StartTime, req.EndTime, time.Now(), req.HistoryDays, req.QueryCondition)
+	if err != nil {
+		return nil, fmt.Errorf("error forecast cost: %w", err)
+	}
+
+	return runtime.FullPageResponse(items, len(items)), nil
+}
+
+var (
+	getCostAnomaliesFields  = costanomaly.WithoutFields()
+	sortCostAnomaliesFields = []string{
+		costanomaly.FieldDay,
+		costanomaly.FieldCost,
+		costanomaly.FieldDeviationPercent,
+		costanomaly.FieldScore,
+	}
+)
+
+func (h Handler) CollectionRouteGetCostAnomalies(
+	req CollectionRouteGetCostAnomaliesRequest,
+) (CollectionRouteGetCostAnomaliesResponse, int, error) {
+	query := h.modelClient.CostAnomalies().Query()
+
+	if req.Scope != "" {
+		query.Where(costanomaly.Scope(req.Scope))
+	}
+
+	if req.Name != "" {
+		query.Where(costanomaly.Name(req.Name))
+	}
+
+	if req.StartTime != nil {
+		query.Where(costanomaly.DayGTE(*req.StartTime))
+	}
+
+	if req.EndTime != nil {
+		query.Where(costanomaly.DayLT(*req.EndTime))
+	}
+
+	if queries, ok := req.Querying([]string{costanomaly.FieldName}); ok {
+		query.Where(queries)
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getCostAnomaliesFields, getCostAnomaliesFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortCostAnomaliesFields, model.Desc(costanomaly.FieldDay)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeCostAnomalies(entities), cnt, nil
+}
+
 func (h Handler) CollectionRouteGetSummaryCosts(
 	req CollectionRouteGetSummaryCostsRequest,
 ) (*CollectionRouteGetSummaryCostsResponse, error) {
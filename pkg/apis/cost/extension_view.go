@@ -10,6 +10,10 @@ import (
 	costvalidation "github.com/seal-io/walrus/pkg/apis/cost/validation"
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/costs/distributor"
+	"github.com/seal-io/walrus/pkg/costs/forecaster"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/utils/validation"
@@ -203,3 +207,87 @@ func (r *CollectionRouteGetSummaryQueriedCostsRequest) Validate() error {
 func (r *CollectionRouteGetSummaryQueriedCostsRequest) SetGinContext(ctx *gin.Context) {
 	r.Context = ctx
 }
+
+type (
+	CollectionRouteGetForecastCostsRequest struct {
+		_ struct{} `route:"POST=/forecast-costs"`
+
+		types.QueryCondition `json:",inline"`
+
+		StartTime time.Time `json:"startTime,omitempty"`
+		EndTime   time.Time `json:"endTime,omitempty"`
+		// HistoryDays is the days of the cost history to fit the forecast,
+		// default is 28.
+		HistoryDays int `json:"historyDays,omitempty"`
+
+		Context *gin.Context
+	}
+
+	CollectionRouteGetForecastCostsResponse = *runtime.ResponseCollection
+)
+
+func (r *CollectionRouteGetForecastCostsRequest) Validate() error {
+	if err := validation.TimeRangeWithinYear(r.StartTime, r.EndTime); err != nil {
+		return err
+	}
+
+	if slices.Contains([]types.GroupByField{
+		types.GroupByFieldDay, types.GroupByFieldWeek, types.GroupByFieldMonth,
+	}, r.GroupBy) {
+		return errors.New("invalid group by: time bucket is not supported")
+	}
+
+	if r.Step != "" && r.Step != types.StepDay {
+		return errors.New("invalid step: only day is supported")
+	}
+
+	switch {
+	case r.HistoryDays < 0 || r.HistoryDays > 365:
+		return errors.New("invalid history days: out of range")
+	case r.HistoryDays == 0:
+		r.HistoryDays = forecaster.DefaultHistoryDays
+	}
+
+	return costvalidation.ValidateCostQuery(r.QueryCondition)
+}
+
+func (r *CollectionRouteGetForecastCostsRequest) SetGinContext(ctx *gin.Context) {
+	r.Context = ctx
+}
+
+type (
+	CollectionRouteGetCostAnomaliesRequest struct {
+		_ struct{} `route:"GET=/cost-anomalies"`
+
+		runtime.RequestCollection[
+			predicate.CostAnomaly, costanomaly.OrderOption,
+		] `query:",inline"`
+
+		Scope     string     `query:"scope,omitempty"`
+		Name      string     `query:"name,omitempty"`
+		StartTime *time.Time `query:"startTime,omitempty"`
+		EndTime   *time.Time `query:"endTime,omitempty"`
+
+		Context *gin.Context
+	}
+
+	CollectionRouteGetCostAnomaliesResponse = []*model.CostAnomalyOutput
+)
+
+func (r *CollectionRouteGetCostAnomaliesRequest) Validate() error {
+	switch r.Scope {
+	case "", types.CostAnomalyScopeProject, types.CostAnomalyScopeNamespace:
+	default:
+		return errors.New("invalid scope: unknown")
+	}
+
+	if r.StartTime != nil && r.EndTime != nil && r.EndTime.Before(*r.StartTime) {
+		return errors.New("invalid time range: end time is early than start time")
+	}
+
+	return nil
+}
+
+func (r *CollectionRouteGetCostAnomaliesRequest) SetGinContext(ctx *gin.Context) {
+	r.Context = ctx
+}
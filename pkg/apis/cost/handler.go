@@ -2,6 +2,7 @@ package cost
 
 import (
 	"github.com/seal-io/walrus/pkg/costs/distributor"
+	"github.com/seal-io/walrus/pkg/costs/forecaster"
 	"github.com/seal-io/walrus/pkg/dao/model"
 )
 
@@ -9,12 +10,14 @@ func Handle(mc model.ClientSet) Handler {
 	return Handler{
 		modelClient: mc,
 		distributor: distributor.New(mc),
+		forecaster:  forecaster.New(mc),
 	}
 }
 
 type Handler struct {
 	modelClient model.ClientSet
 	distributor *distributor.Distributor
+	forecaster  *forecaster.Forecaster
 }
 
 func (Handler) Kind() string {
@@ -8,7 +8,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 
 	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcecomponent"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
@@ -68,6 +71,50 @@ func (h Handler) CollectionRouteGetLatestResourceRuns(
 	return model.ExposeResourceRuns(entities), len(entities), nil
 }
 
+// CollectionRouteGetLatestBudgetEvents returns the latest 10 threshold breaches of budgets.
+func (h Handler) CollectionRouteGetLatestBudgetEvents(
+	req CollectionRouteGetLatestBudgetEventsRequest,
+) (CollectionRouteGetLatestBudgetEventsResponse, int, error) {
+	ctx := intercept.WithProjectInterceptor(req.Context)
+
+	entities, err := h.modelClient.BudgetEvents().Query().
+		Order(model.Desc(budgetevent.FieldCreateTime)).
+		Limit(10).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeBudgetEvents(entities), len(entities), nil
+}
+
+// CollectionRouteGetBudgets returns the budgets with the spend of the last evaluation.
+func (h Handler) CollectionRouteGetBudgets(
+	req CollectionRouteGetBudgetsRequest,
+) (CollectionRouteGetBudgetsResponse, int, error) {
+	ctx := intercept.WithProjectInterceptor(req.Context)
+
+	entities, err := h.modelClient.Budgets().Query().
+		Order(model.Asc(budget.FieldName)).
+		WithProject(func(pq *model.ProjectQuery) {
+			pq.Select(
+				project.FieldID,
+				project.FieldName,
+			)
+		}).
+		WithEnvironment(func(eq *model.EnvironmentQuery) {
+			eq.Select(
+				environment.FieldID,
+				environment.FieldName)
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeBudgets(entities), len(entities), nil
+}
+
 func (h Handler) CollectionRouteGetBasicInformation(
 	req CollectionRouteGetBasicInformationRequest,
 ) (*CollectionRouteGetBasicInformationResponse, error) {
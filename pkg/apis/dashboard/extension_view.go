@@ -104,3 +104,31 @@ func (r *CollectionRouteGetResourceRunStatisticsRequest) Validate() error {
 func (r *CollectionRouteGetResourceRunStatisticsRequest) SetGinContext(ctx *gin.Context) {
 	r.Context = ctx
 }
+
+type (
+	CollectionRouteGetLatestBudgetEventsRequest struct {
+		_ struct{} `route:"GET=/latest-budget-events"`
+
+		Context *gin.Context
+	}
+
+	CollectionRouteGetLatestBudgetEventsResponse = []*model.BudgetEventOutput
+)
+
+func (r *CollectionRouteGetLatestBudgetEventsRequest) SetGinContext(ctx *gin.Context) {
+	r.Context = ctx
+}
+
+type (
+	CollectionRouteGetBudgetsRequest struct {
+		_ struct{} `route:"GET=/budgets"`
+
+		Context *gin.Context
+	}
+
+	CollectionRouteGetBudgetsResponse = []*model.BudgetOutput
+)
+
+func (r *CollectionRouteGetBudgetsRequest) SetGinContext(ctx *gin.Context) {
+	r.Context = ctx
+}
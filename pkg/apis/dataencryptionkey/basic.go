@@ -0,0 +1,71 @@
+package dataencryptionkey
+
+import (
+	"errors"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+)
+
+// Create rotates the data encryption key,
+// the existing data is re-encrypted with the new key in background.
+func (h Handler) Create(req CreateRequest) (CreateResponse, error) {
+	if h.manager == nil {
+		return nil, errors.New("data encryption key rotation is not configured")
+	}
+
+	entity, err := h.manager.Rotate(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeDataEncryptionKey(entity), nil
+}
+
+func (h Handler) Get(req GetRequest) (GetResponse, error) {
+	entity, err := h.modelClient.DataEncryptionKeys().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeDataEncryptionKey(entity), nil
+}
+
+var (
+	getFields  = dataencryptionkey.WithoutFields()
+	sortFields = []string{
+		dataencryptionkey.FieldCreateTime,
+	}
+)
+
+func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse, int, error) {
+	query := h.modelClient.DataEncryptionKeys().Query()
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getFields, getFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortFields, model.Desc(dataencryptionkey.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeDataEncryptionKeys(entities), cnt, nil
+}
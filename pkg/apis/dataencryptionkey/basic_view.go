@@ -0,0 +1,34 @@
+package dataencryptionkey
+
+import (
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+type (
+	CreateRequest struct {
+		model.DataEncryptionKeyCreateInput `path:",inline" json:",inline"`
+	}
+
+	CreateResponse = *model.DataEncryptionKeyOutput
+)
+
+type (
+	GetRequest = model.DataEncryptionKeyQueryInput
+
+	GetResponse = *model.DataEncryptionKeyOutput
+)
+
+type (
+	CollectionGetRequest struct {
+		model.DataEncryptionKeyQueryInputs `path:",inline" query:",inline"`
+
+		runtime.RequestCollection[
+			predicate.DataEncryptionKey, dataencryptionkey.OrderOption,
+		] `query:",inline"`
+	}
+
+	CollectionGetResponse = []*model.DataEncryptionKeyOutput
+)
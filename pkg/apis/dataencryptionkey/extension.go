@@ -0,0 +1,26 @@
+package dataencryptionkey
+
+import (
+	"errors"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+)
+
+// RouteReencrypt resumes re-encrypting the existing data with the active key.
+func (h Handler) RouteReencrypt(req RouteReencryptRequest) (RouteReencryptResponse, error) {
+	if h.manager == nil {
+		return nil, errors.New("data encryption key rotation is not configured")
+	}
+
+	entity, err := h.modelClient.DataEncryptionKeys().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err = h.manager.Reencrypt(req.Context, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeDataEncryptionKey(entity), nil
+}
@@ -0,0 +1,13 @@
+package dataencryptionkey
+
+import "github.com/seal-io/walrus/pkg/dao/model"
+
+type (
+	RouteReencryptRequest struct {
+		_ struct{} `route:"POST=/reencrypt"`
+
+		model.DataEncryptionKeyQueryInput `path:",inline"`
+	}
+
+	RouteReencryptResponse = *model.DataEncryptionKeyOutput
+)
@@ -0,0 +1,22 @@
+package dataencryptionkey
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dataencryption"
+)
+
+func Handle(mc model.ClientSet, mgr *dataencryption.Manager) Handler {
+	return Handler{
+		modelClient: mc,
+		manager:     mgr,
+	}
+}
+
+type Handler struct {
+	modelClient model.ClientSet
+	manager     *dataencryption.Manager
+}
+
+func (Handler) Kind() string {
+	return "DataEncryptionKey"
+}
@@ -92,7 +92,7 @@ func (h Handler) Update(req UpdateRequest) error {
 
 func (h Handler) Delete(req DeleteRequest) error {
 	return h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
-		err := h.cleanEnvironmentPlanFiles(req.Context, tx, req.ID)
+		err := h.cleanEnvironmentRunArtifacts(req.Context, tx, req.ID)
 		if err != nil {
 			return err
 		}
@@ -282,7 +282,7 @@ func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
 	}
 
 	return h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
-		err := h.cleanEnvironmentPlanFiles(req.Context, tx, ids...)
+		err := h.cleanEnvironmentRunArtifacts(req.Context, tx, ids...)
 		if err != nil {
 			return err
 		}
@@ -320,7 +320,7 @@ func (h Handler) getDeployer(ctx context.Context) (deptypes.Deployer, error) {
 	return dep, nil
 }
 
-func (h Handler) cleanEnvironmentPlanFiles(ctx context.Context, mc model.ClientSet, environmentIDs ...object.ID) error {
+func (h Handler) cleanEnvironmentRunArtifacts(ctx context.Context, mc model.ClientSet, environmentIDs ...object.ID) error {
 	runsIDs, err := mc.ResourceRuns().Query().
 		Where(resourcerun.EnvironmentIDIn(environmentIDs...)).
 		IDs(ctx)
@@ -328,5 +328,5 @@ func (h Handler) cleanEnvironmentPlanFiles(ctx context.Context, mc model.ClientS
 		return err
 	}
 
-	return pkgrun.CleanPlanFiles(ctx, mc, h.storageManager, runsIDs...)
+	return pkgrun.CleanRunArtifacts(ctx, mc, h.storageManager, runsIDs...)
 }
@@ -3,7 +3,10 @@ package environment
 import (
 	"k8s.io/client-go/rest"
 
+	"github.com/seal-io/walrus/pkg/apis/budget"
 	"github.com/seal-io/walrus/pkg/apis/resource"
+	"github.com/seal-io/walrus/pkg/apis/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/apis/resourcerunpolicy"
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/apis/variable"
 	"github.com/seal-io/walrus/pkg/dao/model"
@@ -31,6 +34,9 @@ func (Handler) Kind() string {
 func (h Handler) SubResourceHandlers() []runtime.IResourceHandler {
 	return []runtime.IResourceHandler{
 		variable.Handle(h.modelClient),
+		resourcerunapprovalrule.Handle(h.modelClient),
+		resourcerunpolicy.Handle(h.modelClient),
+		budget.Handle(h.modelClient),
 		resource.Handle(h.modelClient, h.kubeConfig, h.storageManager),
 	}
 }
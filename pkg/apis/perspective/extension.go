@@ -142,7 +142,8 @@ func (h Handler) CollectionRouteGetFieldValues(
 			Modify(func(s *sql.Selector) {
 				s.
 					Where(
-						sql.EQ(connector.FieldCategory, types.ConnectorCategoryKubernetes)).
+						sql.In(connector.FieldCategory,
+							types.ConnectorCategoryKubernetes, types.ConnectorCategoryCloudProvider)).
 					SelectExpr(
 						sql.Expr(fmt.Sprintf(`%s AS label`, connector.FieldName)),
 						sql.Expr(fmt.Sprintf(`%s AS value`, connector.FieldID)),
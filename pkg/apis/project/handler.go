@@ -3,14 +3,18 @@ package project
 import (
 	"k8s.io/client-go/rest"
 
+	"github.com/seal-io/walrus/pkg/apis/budget"
 	"github.com/seal-io/walrus/pkg/apis/catalog"
 	"github.com/seal-io/walrus/pkg/apis/connector"
 	"github.com/seal-io/walrus/pkg/apis/environment"
 	"github.com/seal-io/walrus/pkg/apis/projectsubject"
+	"github.com/seal-io/walrus/pkg/apis/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/apis/resourcerunpolicy"
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/apis/template"
 	"github.com/seal-io/walrus/pkg/apis/templateversion"
 	"github.com/seal-io/walrus/pkg/apis/variable"
+	"github.com/seal-io/walrus/pkg/apis/webhook"
 	"github.com/seal-io/walrus/pkg/apis/workflow"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/storage"
@@ -42,10 +46,14 @@ func (h Handler) SubResourceHandlers() []runtime.IResourceHandler {
 		connector.Handle(h.modelClient),
 		environment.Handle(h.modelClient, h.kubeConfig, h.storageManager),
 		variable.Handle(h.modelClient),
+		resourcerunapprovalrule.Handle(h.modelClient),
+		resourcerunpolicy.Handle(h.modelClient),
+		budget.Handle(h.modelClient),
 		workflow.Handle(h.modelClient, h.kubeConfig, h.workflowClient),
 		catalog.Handle(h.modelClient),
 		template.Handle(h.modelClient),
 		templateversion.Handle(h.modelClient),
+		webhook.Handle(h.modelClient),
 		runtime.Alias(
 			projectsubject.Handle(h.modelClient),
 			"Subject"),
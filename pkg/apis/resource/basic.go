@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/dao/model"
@@ -75,7 +76,7 @@ func (h Handler) Get(req GetRequest) (GetResponse, error) {
 }
 
 func (h Handler) Delete(req DeleteRequest) (err error) {
-	err = h.cleanResourcePlanFiles(req.Context, h.modelClient, req.ID)
+	err = h.cleanResourceRunArtifacts(req.Context, h.modelClient, req.ID)
 	if err != nil {
 		return err
 	}
@@ -184,6 +185,7 @@ var (
 			resourcerun.FieldResourceID,
 			resourcerun.FieldStatus,
 			resourcerun.FieldComponentChangeSummary,
+			resourcerun.FieldCostEstimate,
 		).
 			Where(func(s *sql.Selector) {
 				sq := s.Clone().
@@ -211,6 +213,16 @@ func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse,
 		query.Where(queries)
 	}
 
+	if req.Drift != "" {
+		query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(
+				resource.FieldDrift,
+				req.Drift,
+				sqljson.Path("status"),
+			))
+		})
+	}
+
 	if stream := req.Stream; stream != nil {
 		// Handle stream request.
 		if fields, ok := req.Extracting(getFields, getFields...); ok {
@@ -357,7 +369,7 @@ func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
 		return err
 	}
 
-	err = h.cleanResourcePlanFiles(req.Context, h.modelClient, req.IDs()...)
+	err = h.cleanResourceRunArtifacts(req.Context, h.modelClient, req.IDs()...)
 	if err != nil {
 		return err
 	}
@@ -372,7 +384,7 @@ func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
 	})
 }
 
-func (h Handler) cleanResourcePlanFiles(ctx context.Context, mc model.ClientSet, resourceIDs ...object.ID) error {
+func (h Handler) cleanResourceRunArtifacts(ctx context.Context, mc model.ClientSet, resourceIDs ...object.ID) error {
 	runIDs, err := h.modelClient.ResourceRuns().Query().
 		Where(resourcerun.ResourceIDIn(resourceIDs...)).
 		IDs(ctx)
@@ -380,5 +392,5 @@ func (h Handler) cleanResourcePlanFiles(ctx context.Context, mc model.ClientSet,
 		return err
 	}
 
-	return pkgrun.CleanPlanFiles(ctx, mc, h.storageManager, runIDs...)
+	return pkgrun.CleanRunArtifacts(ctx, mc, h.storageManager, runIDs...)
 }
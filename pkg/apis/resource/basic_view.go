@@ -154,6 +154,13 @@ func (r *PatchRequest) Validate() error {
 			return errors.New("invalid template name: immutable")
 		}
 
+		if *patched.TemplateID != patched.Edges.Template.ID {
+			err = validateTemplateVersionLifecycle(r.Context, r.Client, *patched.TemplateID)
+			if err != nil {
+				return err
+			}
+		}
+
 		if err = validateAttributesWithTemplate(
 			r.Context, r.Client, r.Project.ID, r.Environment.ID, r.Attributes, patched.Edges.Template); err != nil {
 			return err
@@ -359,6 +366,8 @@ func (r *CollectionCreateRequest) Validate() error {
 			templateversion.FieldVersion,
 			templateversion.FieldSchema,
 			templateversion.FieldUISchema,
+			templateversion.FieldLifecycle,
+			templateversion.FieldLifecycleReason,
 		).
 		All(r.Context)
 	if err != nil {
@@ -369,6 +378,10 @@ func (r *CollectionCreateRequest) Validate() error {
 
 	// Validate template version whether match the target environment.
 	for i := range tvs {
+		if err = checkTemplateVersionLifecycle(tvs[i]); err != nil {
+			return err
+		}
+
 		if err = validateEnvironment(tvs[i], env); err != nil {
 			return errorx.HttpErrorf(
 				http.StatusBadRequest, "environment %s missing required connectors", env.Name)
@@ -415,12 +428,29 @@ type (
 
 		WithSchema bool `query:"withSchema,omitempty"`
 
+		// Drift filters the resources by the drift status, e.g. Drifted.
+		Drift string `query:"drift,omitempty"`
+
 		Stream *runtime.RequestUnidiStream
 	}
 
 	CollectionGetResponse = []*model.ResourceOutput
 )
 
+func (r *CollectionGetRequest) Validate() error {
+	if err := r.ResourceQueryInputs.Validate(); err != nil {
+		return err
+	}
+
+	switch r.Drift {
+	case "", types.ResourceDriftStatusInSync, types.ResourceDriftStatusDrifted:
+	default:
+		return fmt.Errorf("invalid drift: unknown status %q", r.Drift)
+	}
+
+	return nil
+}
+
 func (r *CollectionGetRequest) SetStream(stream runtime.RequestUnidiStream) {
 	r.Stream = &stream
 }
@@ -469,6 +499,38 @@ func (r *CollectionDeleteRequest) Validate() error {
 	return nil
 }
 
+// validateTemplateVersionLifecycle validates the lifecycle of the template version with the given ID.
+func validateTemplateVersionLifecycle(ctx context.Context, client model.ClientSet, id object.ID) error {
+	tv, err := client.TemplateVersions().Query().
+		Where(templateversion.ID(id)).
+		Select(
+			templateversion.FieldID,
+			templateversion.FieldName,
+			templateversion.FieldVersion,
+			templateversion.FieldLifecycle,
+			templateversion.FieldLifecycleReason).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get template version: %w", err)
+	}
+
+	return checkTemplateVersionLifecycle(tv)
+}
+
+// checkTemplateVersionLifecycle refuses the blocked template version.
+func checkTemplateVersionLifecycle(tv *model.TemplateVersion) error {
+	if tv.Lifecycle != types.TemplateVersionLifecycleBlocked {
+		return nil
+	}
+
+	msg := fmt.Sprintf("template version %s:%s is blocked", tv.Name, tv.Version)
+	if tv.LifecycleReason != "" {
+		msg += ": " + tv.LifecycleReason
+	}
+
+	return errorx.NewHttpError(http.StatusBadRequest, msg)
+}
+
 func validateEnvironment(tv *model.TemplateVersion, env *model.Environment) error {
 	if len(env.Edges.Connectors) == 0 {
 		return errorx.NewHttpError(http.StatusBadRequest, "no connectors")
@@ -570,13 +632,20 @@ func ValidateCreateInput(rci *model.ResourceCreateInput) error {
 			Select(
 				templateversion.FieldID,
 				templateversion.FieldName,
+				templateversion.FieldVersion,
 				templateversion.FieldSchema,
-				templateversion.FieldUISchema).
+				templateversion.FieldUISchema,
+				templateversion.FieldLifecycle,
+				templateversion.FieldLifecycleReason).
 			Only(rci.Context)
 		if err != nil {
 			return fmt.Errorf("failed to get template version: %w", err)
 		}
 
+		if err = checkTemplateVersionLifecycle(tv); err != nil {
+			return err
+		}
+
 		// Validate template version whether match the target environment.
 		if err = validateEnvironment(tv, env); err != nil {
 			return err
@@ -603,6 +672,7 @@ func ValidateCreateInput(rci *model.ResourceCreateInput) error {
 						resourcedefinitionmatchingrule.FieldID,
 						resourcedefinitionmatchingrule.FieldName,
 						resourcedefinitionmatchingrule.FieldSelector,
+						resourcedefinitionmatchingrule.FieldTemplateID,
 					)
 			}).
 			All(rci.Context)
@@ -622,6 +692,10 @@ func ValidateCreateInput(rci *model.ResourceCreateInput) error {
 			return errors.New("no matching resource definition found")
 		}
 
+		if err = validateTemplateVersionLifecycle(rci.Context, rci.Client, rule.TemplateID); err != nil {
+			return err
+		}
+
 		if err = validateAttributesWithResourceDefinition(
 			rci.Context, rci.Client, rci.Project.ID, rci.Environment.ID, rci.Attributes, def); err != nil {
 			return err
@@ -0,0 +1,54 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+func TestCheckTemplateVersionLifecycle(t *testing.T) {
+	cases := []struct {
+		name     string
+		given    *model.TemplateVersion
+		expected string
+	}{
+		{
+			name: "active",
+			given: &model.TemplateVersion{
+				Lifecycle: types.TemplateVersionLifecycleActive,
+			},
+		},
+		{
+			name: "deprecated",
+			given: &model.TemplateVersion{
+				Lifecycle:       types.TemplateVersionLifecycleDeprecated,
+				LifecycleReason: "use 2.0.0 instead",
+			},
+		},
+		{
+			name: "blocked",
+			given: &model.TemplateVersion{
+				Name:            "mysql",
+				Version:         "1.0.0",
+				Lifecycle:       types.TemplateVersionLifecycleBlocked,
+				LifecycleReason: "CVE-2023-0001",
+			},
+			expected: "template version mysql:1.0.0 is blocked: CVE-2023-0001",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkTemplateVersionLifecycle(c.given)
+			if c.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.EqualError(t, err, c.expected)
+		})
+	}
+}
@@ -95,14 +95,24 @@ func (r *RouteUpgradeRequest) Validate() error {
 		tv, err := r.Client.TemplateVersions().Query().
 			Where(templateversion.ID(r.Template.ID)).
 			Select(
+				templateversion.FieldName,
+				templateversion.FieldVersion,
 				templateversion.FieldSchema,
 				templateversion.FieldUISchema,
+				templateversion.FieldLifecycle,
+				templateversion.FieldLifecycleReason,
 			).
 			Only(r.Context)
 		if err != nil {
 			return fmt.Errorf("failed to get template version: %w", err)
 		}
 
+		if tv.ID != *entity.TemplateID {
+			if err = checkTemplateVersionLifecycle(tv); err != nil {
+				return err
+			}
+		}
+
 		if err = validateAttributesWithTemplate(
 			r.Context, r.Client, r.Project.ID, r.Environment.ID, r.Attributes, tv); err != nil {
 			return err
@@ -613,6 +623,13 @@ func (r *CollectionRouteUpgradeRequest) Validate() error {
 				return errors.New("invalid template name: immutable")
 			}
 
+			if entity.TemplateID == nil || input.Template.ID != *entity.TemplateID {
+				err = validateTemplateVersionLifecycle(r.Context, r.Client, input.Template.ID)
+				if err != nil {
+					return err
+				}
+			}
+
 			tv, err := r.Client.TemplateVersions().Query().
 				Where(templateversion.ID(input.Template.ID)).
 				Select(
@@ -5,6 +5,7 @@ import (
 
 	"github.com/seal-io/walrus/pkg/apis/resourcecomponent"
 	"github.com/seal-io/walrus/pkg/apis/resourcerun"
+	"github.com/seal-io/walrus/pkg/apis/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/storage"
@@ -36,5 +37,8 @@ func (h Handler) SubResourceHandlers() []runtime.IResourceHandler {
 		runtime.Alias(
 			resourcerun.Handle(h.modelClient, h.kubeConfig, h.storageManager),
 			"Run"),
+		runtime.Alias(
+			resourcestateversion.Handle(h.modelClient, h.storageManager),
+			"StateVersion"),
 	}
 }
@@ -170,7 +170,7 @@ func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
 	ids := req.IDs()
 
 	return h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
-		err := pkgrun.CleanPlanFiles(req.Context, tx, h.storageManager, ids...)
+		err := pkgrun.CleanRunArtifacts(req.Context, tx, h.storageManager, ids...)
 		if err != nil {
 			return err
 		}
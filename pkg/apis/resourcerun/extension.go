@@ -7,12 +7,19 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
 	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	"github.com/seal-io/walrus/pkg/auths"
+	"github.com/seal-io/walrus/pkg/auths/session"
+	"github.com/seal-io/walrus/pkg/costs/pricing"
 	"github.com/seal-io/walrus/pkg/dao"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
@@ -26,15 +33,23 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/pkg/deployer"
+	"github.com/seal-io/walrus/pkg/deployer/pulumi"
 	"github.com/seal-io/walrus/pkg/deployer/terraform"
 	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
 	"github.com/seal-io/walrus/pkg/operator"
 	optypes "github.com/seal-io/walrus/pkg/operator/types"
 	"github.com/seal-io/walrus/pkg/resourcecomponents"
 	pkgrun "github.com/seal-io/walrus/pkg/resourceruns"
+	"github.com/seal-io/walrus/pkg/resourceruns/approval"
+	"github.com/seal-io/walrus/pkg/resourceruns/estimate"
+	"github.com/seal-io/walrus/pkg/resourceruns/logs"
+	"github.com/seal-io/walrus/pkg/resourceruns/policy"
+	runqueue "github.com/seal-io/walrus/pkg/resourceruns/queue"
 	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	pkgstate "github.com/seal-io/walrus/pkg/resourcestate"
 	"github.com/seal-io/walrus/pkg/servervars"
 	"github.com/seal-io/walrus/pkg/settings"
+	"github.com/seal-io/walrus/pkg/storage"
 	tfparser "github.com/seal-io/walrus/pkg/terraform/parser"
 	"github.com/seal-io/walrus/utils/errorx"
 	"github.com/seal-io/walrus/utils/gopool"
@@ -100,6 +115,17 @@ func (h Handler) RouteUpdateTerraformStates(
 		return err
 	}
 
+	err = pkgstate.CheckLock(req.Context, h.modelClient, entity.ResourceID, req.LockID)
+	if err != nil {
+		var le pkgstate.LockedError
+		if errors.As(err, &le) {
+			req.Context.JSON(http.StatusConflict, le.Lock.Info)
+			return nil
+		}
+
+		return err
+	}
+
 	state, err := h.modelClient.ResourceStates().Query().
 		Where(resourcestate.ResourceID(entity.ResourceID)).
 		Only(req.Context)
@@ -109,10 +135,32 @@ func (h Handler) RouteUpdateTerraformStates(
 
 	state.Data = string(req.RawMessage)
 
-	err = h.modelClient.ResourceStates().UpdateOne(state).
-		SetData(state.Data).
-		Exec(req.Context)
+	// The states of the non HCL deployer are stored as they are,
+	// as the versions and components are recognized from the terraform states.
+	if !types.IsHCLDeployerType(entity.DeployerType) {
+		return h.modelClient.ResourceStates().UpdateOne(state).
+			SetData(state.Data).
+			Exec(req.Context)
+	}
+
+	// Record the state version along with the state,
+	// reject the state which goes backwards or forks from the latest version.
+	err = h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
+		_, err := pkgstate.CreateVersion(req.Context, tx, entity, state.Data)
+		if err != nil {
+			return err
+		}
+
+		return tx.ResourceStates().UpdateOne(state).
+			SetData(state.Data).
+			Exec(req.Context)
+	})
 	if err != nil {
+		var ve pkgstate.VersionConflictError
+		if errors.As(err, &ve) {
+			return errorx.HttpErrorf(http.StatusConflict, "%v", ve)
+		}
+
 		return err
 	}
 
@@ -132,12 +180,123 @@ func (h Handler) RouteUpdateTerraformStates(
 		}
 	}()
 
-	return manageResourceComponentsAndEndpoints(req.Context, h.modelClient, entity, state.Data)
+	if err = ManageResourceComponentsAndEndpoints(req.Context, h.modelClient, entity, state.Data); err != nil {
+		return err
+	}
+
+	// Snapshot the state, which is not a blocker of the deployment.
+	if h.storageManager != nil {
+		serr := h.storageManager.SetRunArtifact(req.Context, entity, storage.ArtifactState, req.RawMessage)
+		if serr != nil {
+			logger.Errorf("error snapshotting state of run %s: %v", entity.ID, serr)
+		}
+	}
+
+	return nil
 }
 
-// manageResourceComponentsAndEndpoints parses and updates the resource components/endpoints,
+// RouteLockTerraformStates locks the terraform states of the service run deployment,
+// responds the holding lock information with 423 if the states have been locked by others.
+func (h Handler) RouteLockTerraformStates(req RouteLockTerraformStatesRequest) error {
+	entity, err := h.modelClient.ResourceRuns().Query().
+		Where(resourcerun.ID(req.ID)).
+		Select(
+			resourcerun.FieldID,
+			resourcerun.FieldResourceID).
+		Only(req.Context)
+	if err != nil {
+		return err
+	}
+
+	opts := pkgstate.LockOptions{
+		ResourceID: entity.ResourceID,
+		Holder:     req.LockInfo.Who,
+		Info:       req.LockInfo,
+	}
+
+	if sj, _ := session.GetSubject(req.Context); sj.Name != "" {
+		opts.Holder = sj.Name
+	}
+
+	// Only the lock requested by the run's deployment is owned by the run,
+	// which is released automatically once the run exits.
+	if auths.IsDeploymentAccessToken(req.Context.Request, h.modelClient, entity.ID) {
+		opts.RunID = entity.ID
+	}
+
+	lock, err := pkgstate.Lock(req.Context, h.modelClient, opts)
+	if err != nil {
+		var le pkgstate.LockedError
+		if errors.As(err, &le) {
+			req.Context.JSON(http.StatusLocked, le.Lock.Info)
+			return nil
+		}
+
+		return err
+	}
+
+	req.Context.JSON(http.StatusOK, lock.Info)
+
+	return nil
+}
+
+// RouteUnlockTerraformStates unlocks the terraform states of the service run deployment,
+// responds the holding lock information with 409 if the states have been locked by others.
+//
+// Unlocking without lock information is treated as force unlocking, which is only allowed for administrators.
+func (h Handler) RouteUnlockTerraformStates(req RouteUnlockTerraformStatesRequest) error {
+	if req.LockInfo == nil {
+		return h.RouteForceUnlockTerraformStates(RouteForceUnlockTerraformStatesRequest{
+			ResourceRunQueryInput: req.ResourceRunQueryInput,
+		})
+	}
+
+	entity, err := h.modelClient.ResourceRuns().Query().
+		Where(resourcerun.ID(req.ID)).
+		Select(
+			resourcerun.FieldID,
+			resourcerun.FieldResourceID).
+		Only(req.Context)
+	if err != nil {
+		return err
+	}
+
+	err = pkgstate.Unlock(req.Context, h.modelClient, entity.ResourceID, req.LockInfo.ID)
+	if err != nil {
+		var le pkgstate.LockedError
+		if errors.As(err, &le) {
+			req.Context.JSON(http.StatusConflict, le.Lock.Info)
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// RouteForceUnlockTerraformStates releases the terraform states lock regardless of the holder.
+func (h Handler) RouteForceUnlockTerraformStates(req RouteForceUnlockTerraformStatesRequest) error {
+	if sj, _ := session.GetSubject(req.Context); !sj.IsAdmin() {
+		return errorx.NewHttpError(http.StatusForbidden, "only administrator can force unlock the states")
+	}
+
+	entity, err := h.modelClient.ResourceRuns().Query().
+		Where(resourcerun.ID(req.ID)).
+		Select(
+			resourcerun.FieldID,
+			resourcerun.FieldResourceID).
+		Only(req.Context)
+	if err != nil {
+		return err
+	}
+
+	return pkgstate.ForceUnlock(req.Context, h.modelClient, entity.ResourceID)
+}
+
+// ManageResourceComponentsAndEndpoints parses and updates the resource components/endpoints,
 // and execute reconcileResourceComponents for the new created resource components.
-func manageResourceComponentsAndEndpoints(
+func ManageResourceComponentsAndEndpoints(
 	ctx context.Context,
 	mc model.ClientSet,
 	entity *model.ResourceRun,
@@ -482,6 +641,22 @@ func (h Handler) RouteLog(req RouteLogRequest) error {
 		out = req.Context.Writer
 	}
 
+	run, err := h.modelClient.ResourceRuns().Get(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	// Read the stored logs if the job has exited,
+	// as the job may be cleaned up.
+	if isJobExited(run, req.JobType) {
+		r, err := logs.Open(ctx, h.storageManager, run, req.JobType)
+		if err != nil {
+			return err
+		}
+
+		return r.Copy(ctx, out, false)
+	}
+
 	return terraform.StreamJobLogs(ctx, terraform.StreamJobLogsOptions{
 		Cli:     cli,
 		RunID:   req.ID,
@@ -490,6 +665,94 @@ func (h Handler) RouteLog(req RouteLogRequest) error {
 	})
 }
 
+// isJobExited returns true if the given type job of the run has exited.
+func isJobExited(run *model.ResourceRun, jobType string) bool {
+	ct := status.ResourceRunStatusApplied
+	if jobType == types.RunTaskTypePlan.String() {
+		ct = status.ResourceRunStatusPlanned
+	}
+
+	return ct.IsTrue(run) || ct.IsFalse(run)
+}
+
+func (h Handler) RouteGetLogs(req RouteGetLogsRequest) (*RouteGetLogsResponse, error) {
+	r, err := h.openLogs(req.Context, req.ID, req.JobType)
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []logs.Line
+
+	if req.Tail > 0 {
+		lines, err = r.Tail(req.Context, req.Tail)
+	} else {
+		lines, err = r.ReadLines(req.Context, req.Line, req.Lines)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	idx := r.Index()
+
+	return &RouteGetLogsResponse{
+		TotalLines: idx.Lines,
+		TotalSize:  idx.Size,
+		Lines:      lines,
+	}, nil
+}
+
+func (h Handler) RouteGetLogBytes(req RouteGetLogBytesRequest) error {
+	r, err := h.openLogs(req.Context, req.ID, req.JobType)
+	if err != nil {
+		return err
+	}
+
+	data, err := r.ReadBytes(req.Context, req.Offset, req.Length)
+	if err != nil {
+		return err
+	}
+
+	req.Context.Writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	req.Context.Writer.Header().Set("X-Log-Size", strconv.FormatInt(r.Index().Size, 10))
+
+	_, err = req.Context.Writer.Write(data)
+
+	return err
+}
+
+func (h Handler) RouteSearchLogs(req RouteSearchLogsRequest) (RouteSearchLogsResponse, error) {
+	r, err := h.openLogs(req.Context, req.ID, req.JobType)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Search(req.Context, req.SearchOptions)
+}
+
+func (h Handler) RouteDownloadLogs(req RouteDownloadLogsRequest) error {
+	r, err := h.openLogs(req.Context, req.ID, req.JobType)
+	if err != nil {
+		return err
+	}
+
+	req.Context.Writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	req.Context.Writer.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%s-%s.log", req.ID, req.JobType))
+
+	return r.Copy(req.Context, req.Context.Writer, req.Timestamps)
+}
+
+// openLogs opens the stored logs of the given type job of the run.
+func (h Handler) openLogs(ctx context.Context, id object.ID, jobType string) (*logs.Reader, error) {
+	run, err := h.modelClient.ResourceRuns().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs.Open(ctx, h.storageManager, run, jobType)
+}
+
 // RouteGetDiffLatest get the run with the service latest run diff.
 func (h Handler) RouteGetDiffLatest(req RouteGetDiffLatestRequest) (*RouteGetDiffLatestResponse, error) {
 	compareRun, err := h.modelClient.ResourceRuns().Query().
@@ -610,6 +873,7 @@ func (h Handler) RouteApply(req RouteApplyRequest) error {
 	}
 
 	if !req.Approve {
+		status.ResourceRunStatusQueued.Remove(run)
 		status.ResourceRunStatusCanceled.True(run, "")
 		run.Status.SetSummary(status.WalkResourceRun(&run.Status))
 
@@ -618,6 +882,15 @@ func (h Handler) RouteApply(req RouteApplyRequest) error {
 		return err
 	}
 
+	if runstatus.IsStatusPendingApproval(run) {
+		return errorx.Errorf("can not apply a pending approval run: %s",
+			status.ResourceRunStatusApproved.GetMessage(run))
+	}
+
+	if denied := run.PolicyResults.Denied(); len(denied) != 0 {
+		return errorx.HttpErrorf(http.StatusForbidden, "%s", policy.DeniedMessage(denied))
+	}
+
 	dp, err := deployer.Get(req.Context, deptypes.CreateOptions{
 		Type:       types.DeployerTypeTF,
 		KubeConfig: h.kubeConfig,
@@ -629,6 +902,100 @@ func (h Handler) RouteApply(req RouteApplyRequest) error {
 	return pkgrun.Apply(req.Context, h.modelClient, dp, run)
 }
 
+// RouteGetQueuePosition gets the position of the queued run.
+func (h Handler) RouteGetQueuePosition(req RouteGetQueuePositionRequest) (RouteGetQueuePositionResponse, error) {
+	run, err := h.modelClient.ResourceRuns().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !runstatus.IsStatusQueued(run) {
+		return nil, errorx.Errorf("run is not queued: %s", run.Status.SummaryStatus)
+	}
+
+	pos, err := runqueue.GetPosition(req.Context, h.modelClient, run)
+	if err != nil {
+		return nil, err
+	}
+
+	if pos == nil {
+		// The run is going to be admitted by the next check.
+		pos = &runqueue.Position{}
+	}
+
+	return pos, nil
+}
+
+// RouteCancel cancels the running run.
+func (h Handler) RouteCancel(req RouteCancelRequest) error {
+	run, err := h.modelClient.ResourceRuns().Get(req.Context, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if !runstatus.IsStatusRunning(run) {
+		return errorx.Errorf("can not cancel a non-running run: %s", run.Status.SummaryStatus)
+	}
+
+	cli, err := kubernetes.NewForConfig(h.kubeConfig)
+	if err != nil {
+		return fmt.Errorf("error creating kubernetes client: %w", err)
+	}
+
+	err = terraform.GetJobExecutor(cli).Cancel(req.Context, run)
+	if err != nil {
+		return fmt.Errorf("error canceling run jobs: %w", err)
+	}
+
+	runstatus.SetStatusFalse(run, "canceled by user")
+
+	_, err = runstatus.UpdateStatus(req.Context, h.modelClient, run)
+	if err != nil {
+		return err
+	}
+
+	// Release the state locks held by the canceled jobs.
+	return pkgstate.ReleaseRunLocks(req.Context, h.modelClient, run.ID)
+}
+
+// RouteApprove approves the pending approval run.
+func (h Handler) RouteApprove(req RouteApproveRequest) error {
+	return h.recordApproval(req.Context, req.ID, true, req.Comment)
+}
+
+// RouteReject rejects the pending approval run.
+func (h Handler) RouteReject(req RouteRejectRequest) error {
+	return h.recordApproval(req.Context, req.ID, false, req.Comment)
+}
+
+func (h Handler) recordApproval(ctx *gin.Context, id object.ID, approved bool, comment string) error {
+	sj, err := session.GetSubject(ctx)
+	if err != nil {
+		return err
+	}
+
+	run, err := h.modelClient.ResourceRuns().Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// The creator can not approve the own run, but can reject it.
+	if approved && run.CreatedBy == sj.Name {
+		return errorx.HttpErrorf(http.StatusForbidden, "can not approve the run created by yourself")
+	}
+
+	record := types.ResourceRunApprovalRecord{
+		SubjectID:   sj.ID.String(),
+		SubjectName: sj.Name,
+		Roles:       getSubjectRoles(sj, run.ProjectID),
+		Approved:    approved,
+		Comment:     comment,
+		Time:        time.Now(),
+	}
+
+	return approval.Record(ctx, h.modelClient, run, record)
+}
+
 func (h Handler) RouteSetPlan(req RouteSetPlanRequest) error {
 	run, err := h.modelClient.ResourceRuns().Get(req.Context, req.ID)
 	if err != nil {
@@ -653,27 +1020,60 @@ func (h Handler) RouteSetPlan(req RouteSetPlanRequest) error {
 	}
 
 	var runPlanChanges *types.Plan
-	if err = json.Unmarshal(jsonPlanBytes, &runPlanChanges); err != nil {
-		return err
-	}
 
-	runPlanChanges.ResourceComponentChanges, err = resourcecomponents.FilterResourceComponentChange(
-		req.Context,
-		h.modelClient,
-		run.ResourceID,
-		runPlanChanges.ResourceComponentChanges,
-	)
-	if err != nil {
-		return err
+	switch run.DeployerType {
+	case types.DeployerTypePulumi:
+		runPlanChanges, err = pulumi.ParsePreview(jsonPlanBytes)
+		if err != nil {
+			return err
+		}
+	default:
+		if err = json.Unmarshal(jsonPlanBytes, &runPlanChanges); err != nil {
+			return err
+		}
+
+		runPlanChanges.ResourceComponentChanges, err = resourcecomponents.FilterResourceComponentChange(
+			req.Context,
+			h.modelClient,
+			run.ResourceID,
+			runPlanChanges.ResourceComponentChanges,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	run.ComponentChangeSummary = runPlanChanges.GetResourceChangeSummary()
 	run.ComponentChanges = runPlanChanges.ResourceComponentChanges
 
-	err = h.modelClient.ResourceRuns().UpdateOne(run).
+	// Evaluate the policies against the plan,
+	// the denied run is blocked from applying, the warned run is annotated only.
+	run.PolicyResults, err = policy.Evaluate(req.Context, h.modelClient, run, jsonPlanBytes)
+	if err != nil {
+		return fmt.Errorf("error evaluating policies: %w", err)
+	}
+
+	// Estimate the monthly cost of the plan,
+	// the Pulumi preview is not supported.
+	if run.DeployerType != types.DeployerTypePulumi {
+		run.CostEstimate, err = estimate.Estimate(req.Context, h.modelClient, pricing.Get(), run, jsonPlanBytes)
+		if err != nil {
+			return fmt.Errorf("error estimating cost: %w", err)
+		}
+	}
+
+	update := h.modelClient.ResourceRuns().UpdateOne(run).
 		SetComponentChangeSummary(run.ComponentChangeSummary).
 		SetComponentChanges(run.ComponentChanges).
-		Exec(req.Context)
+		SetPolicyResults(run.PolicyResults)
+
+	if run.CostEstimate != nil {
+		update.SetCostEstimate(run.CostEstimate)
+	} else {
+		update.ClearCostEstimate()
+	}
+
+	err = update.Exec(req.Context)
 	if err != nil {
 		return err
 	}
@@ -696,7 +1096,35 @@ func (h Handler) RouteSetPlan(req RouteSetPlanRequest) error {
 		return err
 	}
 
-	return h.storageManager.SetRunPlan(req.Context, run, planBytes)
+	if err = h.storageManager.SetRunPlan(req.Context, run, planBytes); err != nil {
+		return err
+	}
+
+	return h.storageManager.SetRunArtifact(req.Context, run, storage.ArtifactJSONPlan, jsonPlanBytes)
+}
+
+// RouteGetCostEstimate estimates the monthly cost of the run by the stored JSON plan,
+// with the current pricing.
+func (h Handler) RouteGetCostEstimate(req RouteGetCostEstimateRequest) (RouteGetCostEstimateResponse, error) {
+	run, err := h.modelClient.ResourceRuns().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if run.DeployerType == types.DeployerTypePulumi {
+		return nil, errorx.HttpErrorf(http.StatusBadRequest, "cost estimation is not supported by %s", run.DeployerType)
+	}
+
+	jsonPlan, err := h.storageManager.GetRunArtifact(req.Context, run, storage.ArtifactJSONPlan)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, errorx.HttpErrorf(http.StatusNotFound, "plan of run %s is not found", run.ID)
+		}
+
+		return nil, err
+	}
+
+	return estimate.Estimate(req.Context, h.modelClient, pricing.Get(), run, jsonPlan)
 }
 
 func (h Handler) RouteGetPlan(req RouteGetPlanRequest) error {
@@ -718,3 +1146,24 @@ func (h Handler) RouteGetPlan(req RouteGetPlanRequest) error {
 
 	return err
 }
+
+// getSubjectRoles returns the system roles and the roles of the given project held by the subject.
+func getSubjectRoles(sj session.Subject, projectID object.ID) []string {
+	roles := make([]string, 0, len(sj.Roles))
+
+	for i := range sj.Roles {
+		roles = append(roles, sj.Roles[i].ID)
+	}
+
+	for i := range sj.ProjectRoles {
+		if sj.ProjectRoles[i].Project.ID != projectID {
+			continue
+		}
+
+		for j := range sj.ProjectRoles[i].Roles {
+			roles = append(roles, sj.ProjectRoles[i].Roles[j].ID)
+		}
+	}
+
+	return roles
+}
@@ -2,12 +2,16 @@ package resourcerun
 
 import (
 	"errors"
+	"fmt"
 	"mime/multipart"
+	"time"
 
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/property"
+	"github.com/seal-io/walrus/pkg/resourceruns/logs"
+	runqueue "github.com/seal-io/walrus/pkg/resourceruns/queue"
 	"github.com/seal-io/walrus/utils/json"
 )
 
@@ -33,7 +37,70 @@ type RouteUpdateTerraformStatesRequest struct {
 
 	model.ResourceRunQueryInput `path:",inline"`
 
+	// LockID is the ID of the lock held by the Terraform HTTP backend client.
+	LockID string `query:"ID,omitempty"`
+
+	json.RawMessage `path:"-" json:",inline"`
+}
+
+type RouteLockTerraformStatesRequest struct {
+	_ struct{} `route:"POST=/terraform-states/lock"`
+
+	model.ResourceRunQueryInput `path:",inline"`
+
 	json.RawMessage `path:"-" json:",inline"`
+
+	LockInfo types.ResourceStateLockInfo `path:"-" query:"-" json:"-"`
+}
+
+func (r *RouteLockTerraformStatesRequest) Validate() error {
+	if err := r.ResourceRunQueryInput.Validate(); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(r.RawMessage, &r.LockInfo); err != nil {
+		return fmt.Errorf("invalid lock: %w", err)
+	}
+
+	if r.LockInfo.ID == "" {
+		return errors.New("invalid lock: blank ID")
+	}
+
+	return nil
+}
+
+type RouteUnlockTerraformStatesRequest struct {
+	_ struct{} `route:"POST=/terraform-states/unlock"`
+
+	model.ResourceRunQueryInput `path:",inline"`
+
+	json.RawMessage `path:"-" json:",inline"`
+
+	// LockInfo is nil if unlocking without lock information,
+	// which is sent by `terraform force-unlock`.
+	LockInfo *types.ResourceStateLockInfo `path:"-" query:"-" json:"-"`
+}
+
+func (r *RouteUnlockTerraformStatesRequest) Validate() error {
+	if err := r.ResourceRunQueryInput.Validate(); err != nil {
+		return err
+	}
+
+	if len(r.RawMessage) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(r.RawMessage, &r.LockInfo); err != nil {
+		return fmt.Errorf("invalid lock: %w", err)
+	}
+
+	return nil
+}
+
+type RouteForceUnlockTerraformStatesRequest struct {
+	_ struct{} `route:"DELETE=/terraform-states/lock"`
+
+	model.ResourceRunQueryInput `path:",inline"`
 }
 
 type RouteLogRequest struct {
@@ -51,7 +118,15 @@ func (r *RouteLogRequest) Validate() error {
 		return err
 	}
 
-	switch r.JobType {
+	return validateJobType(r.JobType)
+}
+
+func (r *RouteLogRequest) SetStream(stream runtime.RequestUnidiStream) {
+	r.Stream = &stream
+}
+
+func validateJobType(jobType string) error {
+	switch jobType {
 	case types.RunTaskTypeApply.String(), types.RunTaskTypeDestroy.String(), types.RunTaskTypePlan.String():
 	default:
 		return errors.New("invalid job type")
@@ -60,8 +135,190 @@ func (r *RouteLogRequest) Validate() error {
 	return nil
 }
 
-func (r *RouteLogRequest) SetStream(stream runtime.RequestUnidiStream) {
-	r.Stream = &stream
+const (
+	defaultLogLines  = 500
+	maxLogLines      = 5000
+	defaultLogLength = 64 * 1024
+	maxLogLength     = 1024 * 1024
+	defaultLogMatch  = 100
+	maxLogMatch      = 1000
+)
+
+type (
+	RouteGetLogsRequest struct {
+		_ struct{} `route:"GET=/logs"`
+
+		model.ResourceRunQueryInput `path:",inline"`
+
+		JobType string `query:"jobType"`
+
+		// Line is the 1-based line number to start reading from.
+		Line int `query:"line,omitempty"`
+		// Lines is the count of lines to read.
+		Lines int `query:"lines,omitempty"`
+		// Tail reads the last given count of lines if positive, the Line is ignored.
+		Tail int `query:"tail,omitempty"`
+	}
+
+	RouteGetLogsResponse struct {
+		TotalLines int         `json:"totalLines"`
+		TotalSize  int64       `json:"totalSize"`
+		Lines      []logs.Line `json:"lines"`
+	}
+)
+
+func (r *RouteGetLogsRequest) Validate() error {
+	if err := r.ResourceRunQueryInput.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateJobType(r.JobType); err != nil {
+		return err
+	}
+
+	if r.Line < 0 || r.Lines < 0 || r.Tail < 0 {
+		return errors.New("invalid line range: negative value")
+	}
+
+	if r.Tail > 0 {
+		r.Lines = r.Tail
+	}
+
+	switch {
+	case r.Lines == 0:
+		r.Lines = defaultLogLines
+	case r.Lines > maxLogLines:
+		return fmt.Errorf("invalid line range: lines exceeds %d", maxLogLines)
+	}
+
+	return nil
+}
+
+type RouteGetLogBytesRequest struct {
+	_ struct{} `route:"GET=/logs/bytes"`
+
+	model.ResourceRunQueryInput `path:",inline"`
+
+	JobType string `query:"jobType"`
+
+	// Offset is the byte offset to start reading from.
+	Offset int64 `query:"offset,omitempty"`
+	// Length is the count of bytes to read.
+	Length int64 `query:"length,omitempty"`
+}
+
+func (r *RouteGetLogBytesRequest) Validate() error {
+	if err := r.ResourceRunQueryInput.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateJobType(r.JobType); err != nil {
+		return err
+	}
+
+	if r.Offset < 0 || r.Length < 0 {
+		return errors.New("invalid byte range: negative value")
+	}
+
+	switch {
+	case r.Length == 0:
+		r.Length = defaultLogLength
+	case r.Length > maxLogLength:
+		return fmt.Errorf("invalid byte range: length exceeds %d", maxLogLength)
+	}
+
+	return nil
+}
+
+type (
+	RouteSearchLogsRequest struct {
+		_ struct{} `route:"GET=/logs/search"`
+
+		model.ResourceRunQueryInput `path:",inline"`
+
+		JobType string `query:"jobType"`
+
+		// Query is the text to search, it is matched case-insensitively.
+		Query string `query:"query"`
+		// Since is the RFC3339 time to search the lines produced at or after.
+		Since string `query:"since,omitempty"`
+		// Until is the RFC3339 time to search the lines produced at or before.
+		Until string `query:"until,omitempty"`
+		// Limit is the maximum count of the matched lines.
+		Limit int `query:"limit,omitempty"`
+
+		SearchOptions logs.SearchOptions `path:"-" query:"-" json:"-"`
+	}
+
+	RouteSearchLogsResponse = []logs.Line
+)
+
+func (r *RouteSearchLogsRequest) Validate() error {
+	if err := r.ResourceRunQueryInput.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateJobType(r.JobType); err != nil {
+		return err
+	}
+
+	if r.Query == "" {
+		return errors.New("invalid query: blank")
+	}
+
+	r.SearchOptions.Query = r.Query
+
+	for _, t := range []struct {
+		name string
+		in   string
+		out  *time.Time
+	}{
+		{name: "since", in: r.Since, out: &r.SearchOptions.Since},
+		{name: "until", in: r.Until, out: &r.SearchOptions.Until},
+	} {
+		if t.in == "" {
+			continue
+		}
+
+		v, err := time.Parse(time.RFC3339, t.in)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", t.name, err)
+		}
+
+		*t.out = v
+	}
+
+	switch {
+	case r.Limit < 0:
+		return errors.New("invalid limit: negative value")
+	case r.Limit == 0:
+		r.SearchOptions.Limit = defaultLogMatch
+	case r.Limit > maxLogMatch:
+		return fmt.Errorf("invalid limit: exceeds %d", maxLogMatch)
+	default:
+		r.SearchOptions.Limit = r.Limit
+	}
+
+	return nil
+}
+
+type RouteDownloadLogsRequest struct {
+	_ struct{} `route:"GET=/logs/download"`
+
+	model.ResourceRunQueryInput `path:",inline"`
+
+	JobType string `query:"jobType"`
+
+	// Timestamps prefixes each line with its RFC3339 timestamp.
+	Timestamps bool `query:"timestamps,omitempty"`
+}
+
+func (r *RouteDownloadLogsRequest) Validate() error {
+	if err := r.ResourceRunQueryInput.Validate(); err != nil {
+		return err
+	}
+
+	return validateJobType(r.JobType)
 }
 
 type (
@@ -104,6 +361,56 @@ func (r *RouteApplyRequest) Validate() error {
 	return r.ResourceRunQueryInput.Validate()
 }
 
+type (
+	RouteGetQueuePositionRequest struct {
+		_ struct{} `route:"GET=/queue-position"`
+
+		model.ResourceRunQueryInput `path:",inline"`
+	}
+
+	RouteGetQueuePositionResponse = *runqueue.Position
+)
+
+type (
+	RouteCancelRequest struct {
+		_ struct{} `route:"POST=/cancel"`
+
+		model.ResourceRunQueryInput `path:",inline"`
+	}
+)
+
+func (r *RouteCancelRequest) Validate() error {
+	return r.ResourceRunQueryInput.Validate()
+}
+
+type (
+	RouteApproveRequest struct {
+		_ struct{} `route:"POST=/approve"`
+
+		model.ResourceRunQueryInput `path:",inline"`
+
+		Comment string `json:"comment,omitempty"`
+	}
+)
+
+func (r *RouteApproveRequest) Validate() error {
+	return r.ResourceRunQueryInput.Validate()
+}
+
+type (
+	RouteRejectRequest struct {
+		_ struct{} `route:"POST=/reject"`
+
+		model.ResourceRunQueryInput `path:",inline"`
+
+		Comment string `json:"comment,omitempty"`
+	}
+)
+
+func (r *RouteRejectRequest) Validate() error {
+	return r.ResourceRunQueryInput.Validate()
+}
+
 type (
 	RouteSetPlanRequest struct {
 		_ struct{} `route:"POST=/plan"`
@@ -119,6 +426,16 @@ func (r *RouteSetPlanRequest) Validate() error {
 	return r.ResourceRunQueryInput.Validate()
 }
 
+type (
+	RouteGetCostEstimateRequest struct {
+		_ struct{} `route:"GET=/cost-estimate"`
+
+		model.ResourceRunQueryInput `path:",inline"`
+	}
+
+	RouteGetCostEstimateResponse = *types.ResourceRunCostEstimate
+)
+
 type (
 	RouteGetPlanRequest struct {
 		_ struct{} `route:"GET=/plan"`
@@ -0,0 +1,149 @@
+package resourcerunapprovalrule
+
+import (
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+)
+
+func (h Handler) Create(req CreateRequest) (CreateResponse, error) {
+	entity := req.Model()
+
+	entity, err := h.modelClient.ResourceRunApprovalRules().Create().
+		Set(entity).
+		Save(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeResourceRunApprovalRule(entity), nil
+}
+
+func (h Handler) Get(req GetRequest) (GetResponse, error) {
+	entity, err := h.modelClient.ResourceRunApprovalRules().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeResourceRunApprovalRule(entity), nil
+}
+
+func (h Handler) Update(req UpdateRequest) error {
+	entity := req.Model()
+
+	return h.modelClient.ResourceRunApprovalRules().UpdateOne(entity).
+		Set(entity).
+		Exec(req.Context)
+}
+
+func (h Handler) Delete(req DeleteRequest) error {
+	return h.modelClient.ResourceRunApprovalRules().DeleteOneID(req.ID).
+		Exec(req.Context)
+}
+
+var (
+	queryFields = []string{
+		resourcerunapprovalrule.FieldName,
+	}
+	getFields  = resourcerunapprovalrule.WithoutFields()
+	sortFields = []string{
+		resourcerunapprovalrule.FieldName,
+		resourcerunapprovalrule.FieldCreateTime,
+	}
+)
+
+func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse, int, error) {
+	// Ps is the generated query condition base on input.
+	var ps *sql.Predicate
+
+	switch {
+	case req.Environment != nil:
+		// Environment scope only.
+		ps = sql.And(
+			sql.EQ(resourcerunapprovalrule.FieldProjectID, req.Environment.Project.ID),
+			sql.EQ(resourcerunapprovalrule.FieldEnvironmentID, req.Environment.ID),
+		)
+
+		if req.IncludeInherited {
+			ps = sql.Or(
+				// Global scope.
+				sql.IsNull(resourcerunapprovalrule.FieldProjectID),
+				// Project scope.
+				sql.And(
+					sql.EQ(resourcerunapprovalrule.FieldProjectID, req.Environment.Project.ID),
+					sql.IsNull(resourcerunapprovalrule.FieldEnvironmentID),
+				),
+				// Environment scope.
+				ps,
+			)
+		}
+	case req.Project != nil:
+		// Project scope only.
+		ps = sql.And(
+			sql.EQ(resourcerunapprovalrule.FieldProjectID, req.Project.ID),
+			sql.IsNull(resourcerunapprovalrule.FieldEnvironmentID),
+		)
+
+		if req.IncludeInherited {
+			ps = sql.Or(
+				// Global scope.
+				sql.IsNull(resourcerunapprovalrule.FieldProjectID),
+				// Project scope.
+				ps,
+			)
+		}
+	default:
+		// Global scope.
+		ps = sql.IsNull(resourcerunapprovalrule.FieldProjectID)
+	}
+
+	query := h.modelClient.ResourceRunApprovalRules().Query().
+		Where(func(s *sql.Selector) {
+			s.Where(ps)
+		})
+
+	if queries, ok := req.Querying(queryFields); ok {
+		query.Where(queries)
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getFields, getFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortFields, model.Desc(resourcerunapprovalrule.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeResourceRunApprovalRules(entities), cnt, nil
+}
+
+func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
+	ids := req.IDs()
+
+	return h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
+		_, err := tx.ResourceRunApprovalRules().Delete().
+			Where(resourcerunapprovalrule.IDIn(ids...)).
+			Exec(req.Context)
+
+		return err
+	})
+}
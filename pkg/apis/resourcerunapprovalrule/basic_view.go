@@ -0,0 +1,118 @@
+package resourcerunapprovalrule
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/validation"
+)
+
+type (
+	CreateRequest struct {
+		model.ResourceRunApprovalRuleCreateInput `path:",inline" json:",inline"`
+	}
+
+	CreateResponse = *model.ResourceRunApprovalRuleOutput
+)
+
+func (r *CreateRequest) Validate() error {
+	if err := r.ResourceRunApprovalRuleCreateInput.Validate(); err != nil {
+		return err
+	}
+
+	if err := validation.IsValidName(r.Name); err != nil {
+		return fmt.Errorf("invalid name: %w", err)
+	}
+
+	if r.Approvals == 0 {
+		r.Approvals = 1
+	}
+
+	return validateRule(r.EnvironmentType, r.RunTypes, r.Approvals)
+}
+
+type (
+	GetRequest = model.ResourceRunApprovalRuleQueryInput
+
+	GetResponse = *model.ResourceRunApprovalRuleOutput
+)
+
+type UpdateRequest struct {
+	model.ResourceRunApprovalRuleUpdateInput `path:",inline" json:",inline"`
+}
+
+func (r *UpdateRequest) Validate() error {
+	if err := r.ResourceRunApprovalRuleUpdateInput.Validate(); err != nil {
+		return err
+	}
+
+	if r.Approvals == 0 {
+		r.Approvals = 1
+	}
+
+	return validateRule(r.EnvironmentType, r.RunTypes, r.Approvals)
+}
+
+type DeleteRequest = model.ResourceRunApprovalRuleDeleteInput
+
+type (
+	CollectionGetRequest struct {
+		model.ResourceRunApprovalRuleQueryInputs `path:",inline" query:",inline"`
+
+		runtime.RequestCollection[
+			predicate.ResourceRunApprovalRule, resourcerunapprovalrule.OrderOption,
+		] `query:",inline"`
+
+		IncludeInherited bool `query:"includeInherited,omitempty"`
+	}
+
+	CollectionGetResponse = []*model.ResourceRunApprovalRuleOutput
+)
+
+type CollectionDeleteRequest = model.ResourceRunApprovalRuleDeleteInputs
+
+// runTypes holds the run types which can require approvals.
+var runTypes = []types.RunType{
+	types.RunTypeCreate,
+	types.RunTypeUpdate,
+	types.RunTypeDelete,
+	types.RunTypeStart,
+	types.RunTypeStop,
+	types.RunTypeRollback,
+}
+
+func validateRule(environmentType string, rts []string, approvals int) error {
+	if environmentType != "" && !types.IsEnvironmentType(environmentType) {
+		return fmt.Errorf("invalid environment type: %s", environmentType)
+	}
+
+	if len(rts) == 0 {
+		return errors.New("invalid run types: blank")
+	}
+
+	for _, rt := range rts {
+		found := false
+
+		for i := range runTypes {
+			if runTypes[i].String() == rt {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("invalid run type: %s", rt)
+		}
+	}
+
+	if approvals < 0 {
+		return errors.New("invalid approvals: negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package resourcerunpolicy
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+)
+
+func (h Handler) Create(req CreateRequest) (CreateResponse, error) {
+	entity := req.Model()
+
+	entity, err := h.modelClient.ResourceRunPolicies().Create().
+		Set(entity).
+		Save(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeResourceRunPolicy(entity), nil
+}
+
+func (h Handler) Get(req GetRequest) (GetResponse, error) {
+	entity, err := h.modelClient.ResourceRunPolicies().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeResourceRunPolicy(entity), nil
+}
+
+func (h Handler) Update(req UpdateRequest) error {
+	entity := req.Model()
+
+	return h.modelClient.ResourceRunPolicies().UpdateOne(entity).
+		Set(entity).
+		Exec(req.Context)
+}
+
+func (h Handler) Delete(req DeleteRequest) error {
+	return h.modelClient.ResourceRunPolicies().DeleteOneID(req.ID).
+		Exec(req.Context)
+}
+
+var (
+	queryFields = []string{
+		resourcerunpolicy.FieldName,
+	}
+	getFields  = resourcerunpolicy.WithoutFields()
+	sortFields = []string{
+		resourcerunpolicy.FieldName,
+		resourcerunpolicy.FieldCreateTime,
+	}
+)
+
+func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse, int, error) {
+	query := h.modelClient.ResourceRunPolicies().Query().
+		Where(resourcerunpolicy.ProjectID(req.Project.ID))
+
+	switch {
+	case req.Environment == nil:
+		// Project scope only.
+		query.Where(resourcerunpolicy.EnvironmentIDIsNil())
+	case req.IncludeInherited:
+		// Project scope and environment scope.
+		query.Where(resourcerunpolicy.Or(
+			resourcerunpolicy.EnvironmentIDIsNil(),
+			resourcerunpolicy.EnvironmentID(req.Environment.ID)))
+	default:
+		// Environment scope only.
+		query.Where(resourcerunpolicy.EnvironmentID(req.Environment.ID))
+	}
+
+	if queries, ok := req.Querying(queryFields); ok {
+		query.Where(queries)
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getFields, getFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortFields, model.Desc(resourcerunpolicy.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeResourceRunPolicies(entities), cnt, nil
+}
+
+func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
+	ids := req.IDs()
+
+	return h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
+		_, err := tx.ResourceRunPolicies().Delete().
+			Where(resourcerunpolicy.IDIn(ids...)).
+			Exec(req.Context)
+
+		return err
+	})
+}
@@ -0,0 +1,94 @@
+package resourcerunpolicy
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/resourceruns/policy"
+	"github.com/seal-io/walrus/utils/validation"
+)
+
+type (
+	CreateRequest struct {
+		model.ResourceRunPolicyCreateInput `path:",inline" json:",inline"`
+	}
+
+	CreateResponse = *model.ResourceRunPolicyOutput
+)
+
+func (r *CreateRequest) Validate() error {
+	if err := r.ResourceRunPolicyCreateInput.Validate(); err != nil {
+		return err
+	}
+
+	if err := validation.IsValidName(r.Name); err != nil {
+		return fmt.Errorf("invalid name: %w", err)
+	}
+
+	if r.Enforcement == "" {
+		r.Enforcement = types.PolicyEnforcementDeny
+	}
+
+	return validatePolicy(r.Enforcement, r.Expression)
+}
+
+type (
+	GetRequest = model.ResourceRunPolicyQueryInput
+
+	GetResponse = *model.ResourceRunPolicyOutput
+)
+
+type UpdateRequest struct {
+	model.ResourceRunPolicyUpdateInput `path:",inline" json:",inline"`
+}
+
+func (r *UpdateRequest) Validate() error {
+	if err := r.ResourceRunPolicyUpdateInput.Validate(); err != nil {
+		return err
+	}
+
+	if r.Enforcement == "" {
+		r.Enforcement = types.PolicyEnforcementDeny
+	}
+
+	return validatePolicy(r.Enforcement, r.Expression)
+}
+
+type DeleteRequest = model.ResourceRunPolicyDeleteInput
+
+type (
+	CollectionGetRequest struct {
+		model.ResourceRunPolicyQueryInputs `path:",inline" query:",inline"`
+
+		runtime.RequestCollection[
+			predicate.ResourceRunPolicy, resourcerunpolicy.OrderOption,
+		] `query:",inline"`
+
+		IncludeInherited bool `query:"includeInherited,omitempty"`
+	}
+
+	CollectionGetResponse = []*model.ResourceRunPolicyOutput
+)
+
+type CollectionDeleteRequest = model.ResourceRunPolicyDeleteInputs
+
+func validatePolicy(enforcement, expression string) error {
+	if !types.IsPolicyEnforcement(enforcement) {
+		return fmt.Errorf("invalid enforcement: %s", enforcement)
+	}
+
+	if expression == "" {
+		return errors.New("invalid expression: blank")
+	}
+
+	if err := policy.Validate(expression); err != nil {
+		return fmt.Errorf("invalid expression: %w", err)
+	}
+
+	return nil
+}
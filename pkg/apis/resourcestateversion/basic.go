@@ -0,0 +1,76 @@
+package resourcestateversion
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+)
+
+var (
+	getFields = resourcestateversion.WithoutFields(
+		resourcestateversion.FieldData,
+	)
+	sortFields = []string{
+		resourcestateversion.FieldSerial,
+		resourcestateversion.FieldCreateTime,
+	}
+)
+
+func (h Handler) Get(req GetRequest) (GetResponse, error) {
+	entity, err := h.modelClient.ResourceStateVersions().Query().
+		Where(resourcestateversion.ID(req.ID)).
+		Select(getFields...).
+		WithRun(func(rq *model.ResourceRunQuery) {
+			rq.Select(
+				resourcerun.FieldID,
+				resourcerun.FieldType,
+				resourcerun.FieldCreatedBy)
+		}).
+		Only(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeResourceStateVersion(entity), nil
+}
+
+func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse, int, error) {
+	query := h.modelClient.ResourceStateVersions().Query().
+		Where(resourcestateversion.ResourceID(req.Resource.ID))
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getFields, getFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortFields, model.Desc(resourcestateversion.FieldSerial)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		// Must append run ID.
+		Select(resourcestateversion.FieldRunID).
+		Unique(false).
+		WithRun(func(rq *model.ResourceRunQuery) {
+			rq.Select(
+				resourcerun.FieldID,
+				resourcerun.FieldType,
+				resourcerun.FieldCreatedBy)
+		}).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeResourceStateVersions(entities), cnt, nil
+}
@@ -0,0 +1,26 @@
+package resourcestateversion
+
+import (
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+)
+
+type (
+	GetRequest = model.ResourceStateVersionQueryInput
+
+	GetResponse = *model.ResourceStateVersionOutput
+)
+
+type (
+	CollectionGetRequest struct {
+		model.ResourceStateVersionQueryInputs `path:",inline" query:",inline"`
+
+		runtime.RequestCollection[
+			predicate.ResourceStateVersion, resourcestateversion.OrderOption,
+		] `query:",inline"`
+	}
+
+	CollectionGetResponse = []*model.ResourceStateVersionOutput
+)
@@ -0,0 +1,183 @@
+package resourcestateversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/apis/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	pkgresourcerun "github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	pkgrun "github.com/seal-io/walrus/pkg/resourceruns"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	pkgstate "github.com/seal-io/walrus/pkg/resourcestate"
+	"github.com/seal-io/walrus/utils/errorx"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+// RouteDiff compares the state version with the base version,
+// the base version defaults to the previous version of the state version.
+func (h Handler) RouteDiff(req RouteDiffRequest) (RouteDiffResponse, error) {
+	entity, err := h.modelClient.ResourceStateVersions().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := h.modelClient.ResourceStateVersions().Query().
+		Where(resourcestateversion.ResourceID(entity.ResourceID))
+
+	if req.Base != "" {
+		query.Where(resourcestateversion.ID(req.Base))
+	} else {
+		query.Where(resourcestateversion.SerialLT(entity.Serial)).
+			Order(model.Desc(resourcestateversion.FieldSerial))
+	}
+
+	var baseData string
+
+	base, err := query.First(req.Context)
+	if err != nil {
+		if !model.IsNotFound(err) {
+			return nil, err
+		}
+
+		if req.Base != "" {
+			return nil, errorx.HttpErrorf(http.StatusBadRequest, "invalid base: not found")
+		}
+	} else {
+		baseData = base.Data
+	}
+
+	return pkgstate.Diff(baseData, entity.Data)
+}
+
+// RouteRestore restores the resource state to the state version,
+// it records the restoration as a new run and a new state version on top of the latest version.
+func (h Handler) RouteRestore(req RouteRestoreRequest) (RouteRestoreResponse, error) {
+	entity, err := h.modelClient.ResourceStateVersions().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pkgstate.CheckLock(req.Context, h.modelClient, entity.ResourceID, "")
+	if err != nil {
+		var le pkgstate.LockedError
+		if errors.As(err, &le) {
+			return nil, errorx.HttpErrorf(http.StatusLocked, "%v", le)
+		}
+
+		return nil, err
+	}
+
+	latest, err := pkgstate.GetLatestVersion(req.Context, h.modelClient, entity.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest.ID == entity.ID {
+		return nil, errorx.HttpErrorf(http.StatusBadRequest, "invalid version: already the latest")
+	}
+
+	// Put the restoring state on top of the latest version,
+	// so that the following deployments can continue to write the state.
+	data, err := pkgstate.RewriteVersion(entity.Data, latest.Serial+1, latest.Lineage)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := pkgrun.Create(req.Context, h.modelClient, pkgrun.CreateOptions{
+		StorageManager: h.storageManager,
+		ResourceID:     entity.ResourceID,
+		DeployerType:   types.DeployerTypeTF,
+		Type:           types.RunTypeRestore,
+		ChangeComment:  req.ChangeComment,
+	})
+	if err != nil {
+		return nil, errorx.Wrap(err, "error creating restore run")
+	}
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		// Timeout context.
+		updateCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		runstatus.SetStatusFalse(run, err.Error())
+
+		if _, uerr := runstatus.UpdateStatus(updateCtx, h.modelClient, run); uerr != nil {
+			log.WithName("api").WithName("resource-state-version").
+				Errorf("update status failed: %v", uerr)
+		}
+	}()
+
+	err = h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
+		_, err := pkgstate.CreateVersion(req.Context, tx, run, data)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ResourceStates().Update().
+			Where(resourcestate.ResourceID(entity.ResourceID)).
+			SetData(data).
+			Save(req.Context)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Refresh the components and endpoints with the restored state.
+	refer, err := h.modelClient.ResourceRuns().Query().
+		Where(pkgresourcerun.ID(run.ID)).
+		WithProject(func(pq *model.ProjectQuery) {
+			pq.Select(
+				project.FieldID,
+				project.FieldName)
+		}).
+		WithEnvironment(func(eq *model.EnvironmentQuery) {
+			eq.Select(
+				environment.FieldID,
+				environment.FieldName)
+		}).
+		WithResource(func(rq *model.ResourceQuery) {
+			rq.Select(
+				resource.FieldID,
+				resource.FieldName)
+		}).
+		Only(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	err = resourcerun.ManageResourceComponentsAndEndpoints(req.Context, h.modelClient, refer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Mark the run as applied without deploying,
+	// the resource status is reported by the run syncer.
+	msg := fmt.Sprintf("restored from state version %d", entity.Serial)
+	status.ResourceRunStatusPending.True(run, "")
+	status.ResourceRunStatusPlanned.True(run, "")
+	status.ResourceRunStatusApplied.True(run, msg)
+
+	refer, err = runstatus.UpdateStatus(req.Context, h.modelClient, run)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeResourceRun(refer), nil
+}
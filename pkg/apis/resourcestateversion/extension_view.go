@@ -0,0 +1,41 @@
+package resourcestateversion
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	pkgstate "github.com/seal-io/walrus/pkg/resourcestate"
+)
+
+type (
+	RouteDiffRequest struct {
+		_ struct{} `route:"GET=/diff"`
+
+		model.ResourceStateVersionQueryInput `path:",inline"`
+
+		// Base is the ID of the version to compare with,
+		// default to the previous version.
+		Base object.ID `query:"base,omitempty"`
+	}
+
+	RouteDiffResponse = *pkgstate.VersionDiff
+)
+
+func (r *RouteDiffRequest) Validate() error {
+	return r.ResourceStateVersionQueryInput.Validate()
+}
+
+type (
+	RouteRestoreRequest struct {
+		_ struct{} `route:"POST=/restore"`
+
+		model.ResourceStateVersionQueryInput `path:",inline"`
+
+		ChangeComment string `json:"changeComment,omitempty"`
+	}
+
+	RouteRestoreResponse = *model.ResourceRunOutput
+)
+
+func (r *RouteRestoreRequest) Validate() error {
+	return r.ResourceStateVersionQueryInput.Validate()
+}
@@ -0,0 +1,22 @@
+package resourcestateversion
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/storage"
+)
+
+func Handle(mc model.ClientSet, sm *storage.Manager) Handler {
+	return Handler{
+		modelClient:    mc,
+		storageManager: sm,
+	}
+}
+
+type Handler struct {
+	modelClient    model.ClientSet
+	storageManager *storage.Manager
+}
+
+func (Handler) Kind() string {
+	return "ResourceStateVersion"
+}
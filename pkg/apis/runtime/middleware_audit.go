@@ -0,0 +1,278 @@
+package runtime
+
+import (
+	"encoding"
+	stdjson "encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+type (
+	// RouteAuditor holds the operation of auditing.
+	RouteAuditor interface {
+		// Audit records the given record of a mutating request.
+		Audit(*gin.Context, AuditRecord)
+	}
+
+	// RouteAuditFunc is the function type of RouteAuditor.
+	RouteAuditFunc func(*gin.Context, AuditRecord)
+)
+
+// Audit implements the RouteAuditor interface.
+func (fn RouteAuditFunc) Audit(c *gin.Context, r AuditRecord) {
+	if fn == nil {
+		return
+	}
+
+	fn(c, r)
+}
+
+// WithResourceAuditor is a RouterOption to configure the auditor for the mutating routes of IResourceHandler.
+func WithResourceAuditor(auditor RouteAuditor) RouterOption {
+	return routerOption(func(r *Router) {
+		r.auditor = auditor
+	})
+}
+
+// AuditRecord holds the information of a mutating request.
+type AuditRecord struct {
+	// RouteProfile holds the profile of the requesting route.
+	RouteProfile
+
+	// ResourceRefers holds the hierarchical resource refers of the request,
+	// which aligns with the ResourceProfile.Resources,
+	// the last one is filled with the ID of the created resource if the path doesn't have it.
+	ResourceRefers []string
+	// Request holds the summary of the request, sensitive fields are redacted.
+	Request string
+	// Status holds the status code of the response.
+	Status int
+	// Message holds the error message of the response.
+	Message string
+}
+
+const auditRecordContextKey = "_audit_record_"
+
+// auditRequestMaxSize is the maximum size of the request summary.
+const auditRequestMaxSize = 8 * 1024
+
+// isAuditingRoute returns true if the given route should be audited.
+func isAuditingRoute(r *Route) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+
+	return true
+}
+
+// newAuditRecord creates an AuditRecord with the given route,
+// and stores it into the gin context.
+func newAuditRecord(c *gin.Context, r *Route) *AuditRecord {
+	ar := &AuditRecord{
+		RouteProfile:   r.RouteProfile.DeepCopy(),
+		ResourceRefers: make([]string, len(r.ResourcePathRefers)),
+	}
+
+	for i := range r.ResourcePathRefers {
+		ar.ResourceRefers[i] = c.Param(r.ResourcePathRefers[i])
+	}
+
+	c.Set(auditRecordContextKey, ar)
+
+	return ar
+}
+
+// SetRequest summarizes the given request object with redacting the sensitive fields.
+func (r *AuditRecord) SetRequest(obj any) {
+	v := redact(reflect.ValueOf(obj))
+	if v == nil {
+		return
+	}
+
+	bs, err := json.Marshal(v)
+	if err != nil || string(bs) == "{}" {
+		return
+	}
+
+	if len(bs) > auditRequestMaxSize {
+		bs = append(bs[:auditRequestMaxSize], "..."...)
+	}
+
+	r.Request = string(bs)
+}
+
+// SetResponse fills the ID of the created resource from the given response object.
+func (r *AuditRecord) SetResponse(obj any) {
+	if len(r.ResourceRefers) == 0 || r.ResourceRefers[len(r.ResourceRefers)-1] != "" {
+		return
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(obj))
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	id := v.FieldByName("ID")
+	if id.Kind() != reflect.String {
+		return
+	}
+
+	r.ResourceRefers[len(r.ResourceRefers)-1] = id.String()
+}
+
+// auditing is a gin middleware,
+// which passes the record of the mutating request to the given auditor after responding.
+func auditing(auditor RouteAuditor) Handle {
+	return func(c *gin.Context) {
+		c.Next()
+
+		v, ok := c.Get(auditRecordContextKey)
+		if !ok {
+			return
+		}
+
+		ar := v.(*AuditRecord)
+		ar.Status = c.Writer.Status()
+
+		if ar.Status >= http.StatusBadRequest && len(c.Errors) != 0 {
+			ar.Message = getHttpError(c).Message
+		}
+
+		auditor.Audit(c, *ar)
+	}
+}
+
+const (
+	redactedValue   = "******"
+	sensitiveTagKey = "sensitive"
+)
+
+var (
+	cryptoPkgPath  = reflect.TypeOf(crypto.String("")).PkgPath()
+	ginContextType = reflect.TypeOf(&gin.Context{})
+	jsonMarshaler  = reflect.TypeOf((*stdjson.Marshaler)(nil)).Elem()
+	textMarshaler  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// redact returns a JSON marshallable value of the given value,
+// which replaces the crypto typed fields or the fields tagged with `sensitive:"true"`.
+func redact(v reflect.Value) any {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+
+		v = v.Elem()
+	}
+
+	if !v.IsValid() {
+		return nil
+	}
+
+	t := v.Type()
+	if t.PkgPath() == cryptoPkgPath {
+		return redactedValue
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if isMarshaler(t) {
+			return v.Interface()
+		}
+
+		m := map[string]any{}
+		redactStruct(v, m)
+
+		return m
+	case reflect.Slice, reflect.Array:
+		et := t.Elem()
+		for et.Kind() == reflect.Pointer {
+			et = et.Elem()
+		}
+
+		if et.Kind() != reflect.Struct || isMarshaler(et) {
+			return v.Interface()
+		}
+
+		s := make([]any, v.Len())
+		for i := range s {
+			s[i] = redact(v.Index(i))
+		}
+
+		return s
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	}
+
+	return v.Interface()
+}
+
+// redactStruct redacts the fields of the given struct value into the given map,
+// the embedded structs are flatten as the JSON marshaling.
+func redactStruct(v reflect.Value, m map[string]any) {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		ft := t.Field(i)
+		if ft.Type == ginContextType {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(ft.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		fv := v.Field(i)
+
+		if ft.Anonymous && name == "" {
+			for fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					break
+				}
+
+				fv = fv.Elem()
+			}
+
+			if fv.Kind() == reflect.Struct && !isMarshaler(fv.Type()) {
+				redactStruct(fv, m)
+				continue
+			}
+		}
+
+		if !ft.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = ft.Name
+		}
+
+		if strings.Contains(opts, "omitempty") && fv.IsZero() {
+			continue
+		}
+
+		if ft.Tag.Get(sensitiveTagKey) == "true" {
+			m[name] = redactedValue
+			continue
+		}
+
+		if rv := redact(fv); rv != nil {
+			m[name] = rv
+		}
+	}
+}
+
+func isMarshaler(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+
+	return t.Implements(jsonMarshaler) || pt.Implements(jsonMarshaler) ||
+		t.Implements(textMarshaler) || pt.Implements(textMarshaler)
+}
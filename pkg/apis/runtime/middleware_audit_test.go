@@ -0,0 +1,77 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+)
+
+func TestAuditRecord_SetRequest(t *testing.T) {
+	type (
+		embedded struct {
+			Name string `json:"name"`
+		}
+
+		item struct {
+			Key   string        `json:"key"`
+			Value crypto.String `json:"value"`
+		}
+
+		request struct {
+			embedded `json:",inline"`
+
+			Client   *model.Client `json:"-"`
+			Password string        `json:"password" sensitive:"true"`
+			Labels   map[string]string
+			Items    []*item `json:"items,omitempty"`
+			Ignored  string  `json:"ignored,omitempty"`
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		given    any
+		expected string
+	}{
+		{
+			name:     "nil",
+			given:    (*request)(nil),
+			expected: "",
+		},
+		{
+			name: "redacted",
+			given: &request{
+				embedded: embedded{Name: "test"},
+				Password: "secret",
+				Labels:   map[string]string{"a": "b"},
+				Items: []*item{
+					{Key: "k", Value: "v"},
+				},
+			},
+			expected: `{"Labels":{"a":"b"},"items":[{"key":"k","value":"******"}],` +
+				`"name":"test","password":"******"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var r AuditRecord
+			r.SetRequest(tc.given)
+			assert.Equal(t, tc.expected, r.Request)
+		})
+	}
+}
+
+func TestAuditRecord_SetResponse(t *testing.T) {
+	r := AuditRecord{
+		ResourceRefers: []string{"1", ""},
+	}
+	r.SetResponse(&model.EnvironmentOutput{ID: "2"})
+	assert.Equal(t, []string{"1", "2"}, r.ResourceRefers)
+
+	r.SetResponse(&model.EnvironmentOutput{ID: "3"})
+	assert.Equal(t, []string{"1", "2"}, r.ResourceRefers, "should not override")
+}
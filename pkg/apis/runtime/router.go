@@ -112,6 +112,7 @@ type (
 
 		adviceProviders []RouteAdviceProvider
 		authorizer      RouteAuthorizer
+		auditor         RouteAuditor
 	}
 )
 
@@ -175,7 +176,13 @@ func NewRouter(options ...RouterOption) IRouter {
 		return ok
 	})
 
-	e.Use(observing, recovering, erroring)
+	e.Use(observing, recovering)
+
+	if rt.auditor != nil {
+		e.Use(auditing(rt.auditor))
+	}
+
+	e.Use(erroring)
 
 	// Apply route options.
 	rt.options = rt.options.Apply(func(o RouterOption) bool {
@@ -52,6 +52,12 @@ func (rt *Router) Routes(handler IHandler) IRouter {
 				return
 			}
 
+			// Audit.
+			var ar *AuditRecord
+			if rt.auditor != nil && isAuditingRoute(&route) {
+				ar = newAuditRecord(c, &route)
+			}
+
 			// Authorize.
 			if rt.authorizer != nil {
 				authedStatus := rt.authorizer.Authorize(c, route.RouteProfile.DeepCopy())
@@ -119,6 +125,10 @@ func (rt *Router) Routes(handler IHandler) IRouter {
 				rt.adviceProviders[j].Set(inputObj)
 			}
 
+			if ar != nil {
+				ar.SetRequest(inputObj)
+			}
+
 			// Validate request.
 			if route.RequestAttributes.HasAll(RequestWithValidate) {
 				if err := inputObj.(Validator).Validate(); err != nil {
@@ -149,6 +159,10 @@ func (rt *Router) Routes(handler IHandler) IRouter {
 			}
 			routeOutputs := route.GoCaller.Call([]reflect.Value{routeInput})
 
+			if ar != nil && len(routeOutputs) == 2 {
+				ar.SetResponse(routeOutputs[0].Interface())
+			}
+
 			// Render response.
 			if c.Request.Context().Err() != nil ||
 				c.Writer.Written() ||
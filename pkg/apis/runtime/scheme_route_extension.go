@@ -34,7 +34,11 @@ var (
 		"/projects/:project/environments/:environment/apply",
 		"/projects/:project/environments/:environment/export",
 		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/log",
+		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/logs/bytes",
+		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/logs/download",
 		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/terraform-states",
+		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/terraform-states/lock",
+		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/terraform-states/unlock",
 		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/plan",
 		"/projects/:project/environments/:environment/resources/_/graph",
 		"/projects/:project/environments/:environment/resources/:resource/graph",
@@ -57,6 +61,9 @@ var (
 	cliJsonYamlOutputFormatPaths = []string{
 		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/diff-latest",
 		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/diff-previous",
+		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/queue-position",
+		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/logs",
+		"/projects/:project/environments/:environment/resources/:resource/runs/:resourcerun/logs/search",
 	}
 )
 
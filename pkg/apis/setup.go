@@ -7,17 +7,20 @@ import (
 
 	"k8s.io/client-go/rest"
 
+	"github.com/seal-io/walrus/pkg/apis/auditlog"
 	"github.com/seal-io/walrus/pkg/apis/catalog"
 	"github.com/seal-io/walrus/pkg/apis/cli"
 	"github.com/seal-io/walrus/pkg/apis/connector"
 	"github.com/seal-io/walrus/pkg/apis/cost"
 	"github.com/seal-io/walrus/pkg/apis/dashboard"
+	"github.com/seal-io/walrus/pkg/apis/dataencryptionkey"
 	"github.com/seal-io/walrus/pkg/apis/debug"
 	"github.com/seal-io/walrus/pkg/apis/measure"
 	"github.com/seal-io/walrus/pkg/apis/perspective"
 	"github.com/seal-io/walrus/pkg/apis/project"
 	"github.com/seal-io/walrus/pkg/apis/proxy"
 	"github.com/seal-io/walrus/pkg/apis/resourcedefinition"
+	"github.com/seal-io/walrus/pkg/apis/resourcerunapprovalrule"
 	"github.com/seal-io/walrus/pkg/apis/role"
 	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/apis/setting"
@@ -30,6 +33,7 @@ import (
 	"github.com/seal-io/walrus/pkg/apis/walrusfilehub"
 	"github.com/seal-io/walrus/pkg/auths"
 	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dataencryption"
 	"github.com/seal-io/walrus/pkg/storage"
 	pkgworkflow "github.com/seal-io/walrus/pkg/workflow"
 )
@@ -82,6 +86,7 @@ func (s *Server) Setup(ctx context.Context, opts SetupOptions) (http.Handler, er
 		runtime.ExposeOpenAPI(),
 		runtime.WithRouteAdviceProviders(provideModelClient(opts.ModelClient)),
 		runtime.WithResourceAuthorizer(account),
+		runtime.WithResourceAuditor(auditlog.Auditor(opts.ModelClient)),
 	}
 
 	apis := runtime.NewRouter(apisOpts...).
@@ -98,13 +103,16 @@ func (s *Server) Setup(ctx context.Context, opts SetupOptions) (http.Handler, er
 		Use(throttler, wsCounter, account.Filter)
 	{
 		r := resourceApis
+		r.Routes(auditlog.Handle(opts.ModelClient))
 		r.Routes(catalog.Handle(opts.ModelClient))
 		r.Routes(connector.Handle(opts.ModelClient))
 		r.Routes(cost.Handle(opts.ModelClient))
 		r.Routes(dashboard.Handle(opts.ModelClient))
+		r.Routes(dataencryptionkey.Handle(opts.ModelClient, dataencryption.ManagerConfig.Get()))
 		r.Routes(perspective.Handle(opts.ModelClient))
 		r.Routes(project.Handle(opts.ModelClient, opts.K8sConfig, wc, opts.StorageManager))
 		r.Routes(resourcedefinition.Handle(opts.ModelClient, opts.K8sConfig, opts.StorageManager))
+		r.Routes(resourcerunapprovalrule.Handle(opts.ModelClient))
 		r.Routes(role.Handle(opts.ModelClient))
 		r.Routes(setting.Handle(opts.ModelClient))
 		r.Routes(subject.Handle(opts.ModelClient))
@@ -10,7 +10,9 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/templates/registry"
 	"github.com/seal-io/walrus/pkg/vcs"
 	"github.com/seal-io/walrus/utils/validation"
 )
@@ -32,6 +34,19 @@ func (r *CreateRequest) Validate() error {
 		return err
 	}
 
+	if err := validateCredential(r.Credential); err != nil {
+		return err
+	}
+
+	// Sources stored in the OCI registry or the Terraform module registry.
+	if registry.IsSource(r.Source) {
+		return nil
+	}
+
+	if len(r.Credential) != 0 {
+		return errors.New("invalid credential: only allowed for the registry source")
+	}
+
 	if _, err := getter.Detect(r.Source, "", getter.Detectors); err != nil {
 		return fmt.Errorf("invalid source: %w", err)
 	}
@@ -43,6 +58,22 @@ func (r *CreateRequest) Validate() error {
 	return nil
 }
 
+func validateCredential(credential map[string]string) error {
+	for k := range credential {
+		switch k {
+		case types.TemplateCredentialUsername, types.TemplateCredentialToken:
+		default:
+			return fmt.Errorf("invalid credential: unknown key %q", k)
+		}
+	}
+
+	if len(credential) != 0 && credential[types.TemplateCredentialToken] == "" {
+		return errors.New("invalid credential: token is required")
+	}
+
+	return nil
+}
+
 type (
 	GetRequest = model.TemplateQueryInput
 
@@ -58,7 +89,7 @@ func (r *UpdateRequest) Validate() error {
 		return err
 	}
 
-	return nil
+	return validateCredential(r.Credential)
 }
 
 type DeleteRequest = model.TemplateDeleteInput
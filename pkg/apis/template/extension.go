@@ -7,6 +7,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/template"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
+	"github.com/seal-io/walrus/pkg/templates"
 )
 
 func (h Handler) RouteRefresh(req RouteRefreshRequest) error {
@@ -46,6 +47,11 @@ func (h Handler) RouteGetVersions(
 		query.Where(queries)
 	}
 
+	// Hide the versions failed in testing from selection.
+	if ps := templates.WithoutTestFailedVersions(req.Context, h.modelClient); ps != nil {
+		query.Where(ps)
+	}
+
 	// Get count.
 	cnt, err := query.Clone().Count(req.Context)
 	if err != nil {
@@ -0,0 +1,94 @@
+package templateupgradecampaign
+
+import (
+	"github.com/seal-io/walrus/pkg/auths/session"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+)
+
+func (h Handler) Create(req CreateRequest) (CreateResponse, error) {
+	entity := req.Model()
+
+	// The upgrade runs are acted as the subject who creates the campaign.
+	s, err := session.GetSubject(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	entity.SubjectID = s.ID
+
+	entity, err = h.modelClient.TemplateUpgradeCampaigns().Create().
+		Set(entity).
+		Save(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeTemplateUpgradeCampaign(entity), nil
+}
+
+func (h Handler) Get(req GetRequest) (GetResponse, error) {
+	entity, err := h.modelClient.TemplateUpgradeCampaigns().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeTemplateUpgradeCampaign(entity), nil
+}
+
+func (h Handler) Delete(req DeleteRequest) error {
+	return h.modelClient.TemplateUpgradeCampaigns().DeleteOneID(req.ID).
+		Exec(req.Context)
+}
+
+var (
+	queryFields = []string{
+		templateupgradecampaign.FieldStatus,
+	}
+	getFields  = templateupgradecampaign.WithoutFields()
+	sortFields = []string{
+		templateupgradecampaign.FieldStatus,
+		templateupgradecampaign.FieldCreateTime,
+	}
+)
+
+func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse, int, error) {
+	query := h.modelClient.TemplateUpgradeCampaigns().Query().
+		Where(templateupgradecampaign.TemplateVersionID(req.TemplateVersion.ID))
+
+	if req.Project != nil {
+		query.Where(templateupgradecampaign.ProjectID(req.Project.ID))
+	}
+
+	if queries, ok := req.Querying(queryFields); ok {
+		query.Where(queries)
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getFields, getFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortFields, model.Desc(templateupgradecampaign.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeTemplateUpgradeCampaigns(entities), cnt, nil
+}
@@ -0,0 +1,114 @@
+package templateupgradecampaign
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/templates/campaign"
+)
+
+// defaultBatchSize is the count of the resources to upgrade in each batch if not specified.
+const defaultBatchSize = 10
+
+type (
+	CreateRequest struct {
+		model.TemplateUpgradeCampaignCreateInput `path:",inline" json:",inline"`
+	}
+
+	CreateResponse = *model.TemplateUpgradeCampaignOutput
+)
+
+func (r *CreateRequest) Validate() error {
+	if err := r.TemplateUpgradeCampaignCreateInput.Validate(); err != nil {
+		return err
+	}
+
+	if r.BatchSize == 0 {
+		r.BatchSize = defaultBatchSize
+	}
+
+	if r.BatchSize < 0 {
+		return errors.New("invalid batch size: negative")
+	}
+
+	if !r.TargetVersionID.Valid() {
+		return errors.New("invalid target version id: blank")
+	}
+
+	if r.TargetVersionID == r.TemplateVersion.ID {
+		return errors.New("invalid target version: same as the template version")
+	}
+
+	tvs, err := r.Client.TemplateVersions().Query().
+		Where(templateversion.IDIn(r.TemplateVersion.ID, r.TargetVersionID)).
+		Select(
+			templateversion.FieldID,
+			templateversion.FieldTemplateID,
+			templateversion.FieldName,
+			templateversion.FieldVersion,
+			templateversion.FieldLifecycle).
+		All(r.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get template versions: %w", err)
+	}
+
+	var from, to *model.TemplateVersion
+
+	for i := range tvs {
+		switch tvs[i].ID {
+		case r.TemplateVersion.ID:
+			from = tvs[i]
+		case r.TargetVersionID:
+			to = tvs[i]
+		}
+	}
+
+	if from == nil || to == nil {
+		return errors.New("invalid target version: not found")
+	}
+
+	if from.TemplateID != to.TemplateID {
+		return errors.New("invalid target version: not a version of the same template")
+	}
+
+	if to.Lifecycle == types.TemplateVersionLifecycleBlocked {
+		return fmt.Errorf("invalid target version: %s:%s is blocked", to.Name, to.Version)
+	}
+
+	resources, err := campaign.GetAffectedResources(r.Context, r.Client, from.ID, r.Model().ProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get affected resources: %w", err)
+	}
+
+	if len(resources) == 0 {
+		return fmt.Errorf("no resource uses the template version %s:%s", from.Name, from.Version)
+	}
+
+	return nil
+}
+
+type (
+	GetRequest = model.TemplateUpgradeCampaignQueryInput
+
+	GetResponse = *model.TemplateUpgradeCampaignOutput
+)
+
+type DeleteRequest = model.TemplateUpgradeCampaignDeleteInput
+
+type (
+	CollectionGetRequest struct {
+		model.TemplateUpgradeCampaignQueryInputs `path:",inline" query:",inline"`
+
+		runtime.RequestCollection[
+			predicate.TemplateUpgradeCampaign, templateupgradecampaign.OrderOption,
+		] `query:",inline"`
+	}
+
+	CollectionGetResponse = []*model.TemplateUpgradeCampaignOutput
+)
@@ -0,0 +1,14 @@
+package templateupgradecampaign
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+// RouteCancel cancels the campaign,
+// the started upgrade runs are not interrupted.
+func (h Handler) RouteCancel(req RouteCancelRequest) error {
+	return h.modelClient.TemplateUpgradeCampaigns().UpdateOne(req.entity).
+		SetStatus(types.TemplateUpgradeCampaignStatusCanceled).
+		SetMessage("canceled by user").
+		Exec(req.Context)
+}
@@ -0,0 +1,35 @@
+package templateupgradecampaign
+
+import (
+	"errors"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/templates/campaign"
+)
+
+type RouteCancelRequest struct {
+	_ struct{} `route:"POST=/cancel"`
+
+	model.TemplateUpgradeCampaignQueryInput `path:",inline"`
+
+	entity *model.TemplateUpgradeCampaign `json:"-"`
+}
+
+func (r *RouteCancelRequest) Validate() error {
+	if err := r.TemplateUpgradeCampaignQueryInput.Validate(); err != nil {
+		return err
+	}
+
+	entity, err := r.Client.TemplateUpgradeCampaigns().Get(r.Context, r.ID)
+	if err != nil {
+		return err
+	}
+
+	if campaign.IsFinished(entity) {
+		return errors.New("cannot cancel the finished campaign")
+	}
+
+	r.entity = entity
+
+	return nil
+}
@@ -3,6 +3,10 @@ package templateversion
 import (
 	"fmt"
 
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 )
 
@@ -27,3 +31,84 @@ func (h Handler) RouteReset(req RouteResetRequest) error {
 
 	return nil
 }
+
+func (h Handler) RouteSetLifecycle(req RouteSetLifecycleRequest) error {
+	return h.modelClient.TemplateVersions().UpdateOneID(req.ID).
+		SetLifecycle(req.Lifecycle).
+		SetLifecycleReason(req.Reason).
+		Exec(req.Context)
+}
+
+var (
+	queryResourceFields = []string{
+		resource.FieldName,
+	}
+	getResourceFields = resource.WithoutFields(
+		resource.FieldUpdateTime)
+	sortResourceFields = []string{
+		resource.FieldName,
+		resource.FieldCreateTime,
+	}
+)
+
+// RouteGetResources lists the resources using the template version across the projects.
+func (h Handler) RouteGetResources(req RouteGetResourcesRequest) (RouteGetResourcesResponse, int, error) {
+	query := h.modelClient.Resources().Query().
+		Where(resource.TemplateID(req.ID))
+
+	if req.Project != nil {
+		query.Where(resource.ProjectID(req.Project.ID))
+	}
+
+	if queries, ok := req.Querying(queryResourceFields); ok {
+		query.Where(queries)
+	}
+
+	if req.ProjectName != "" {
+		query.Where(resource.HasProjectWith(project.Name(req.ProjectName)))
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getResourceFields, getResourceFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortResourceFields, model.Desc(resource.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		// Must append environment ID.
+		Select(resource.FieldEnvironmentID).
+		// Must extract template.
+		Select(resource.FieldTemplateID).
+		WithTemplate(func(tvq *model.TemplateVersionQuery) {
+			tvq.Select(
+				templateversion.FieldID,
+				templateversion.FieldName,
+				templateversion.FieldVersion)
+		}).
+		WithProject(func(pq *model.ProjectQuery) {
+			pq.Select(project.FieldName)
+		}).
+		WithEnvironment(func(eq *model.EnvironmentQuery) {
+			eq.Select(environment.FieldName)
+		}).
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeResources(entities), cnt, nil
+}
@@ -1,7 +1,16 @@
 package templateversion
 
 import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/seal-io/walrus/pkg/apis/runtime"
 	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/types"
 )
 
 type RouteResetRequest struct {
@@ -9,3 +18,49 @@ type RouteResetRequest struct {
 
 	model.TemplateVersionQueryInput `path:",inline"`
 }
+
+type RouteSetLifecycleRequest struct {
+	_ struct{} `route:"PUT=/lifecycle"`
+
+	model.TemplateVersionQueryInput `path:",inline"`
+
+	// Lifecycle is the lifecycle state to set, active, deprecated or blocked.
+	Lifecycle string `json:"lifecycle"`
+	// Reason is the reason of the lifecycle state, required if not active.
+	Reason string `json:"reason,omitempty"`
+}
+
+func (r *RouteSetLifecycleRequest) Validate() error {
+	if err := r.TemplateVersionQueryInput.Validate(); err != nil {
+		return err
+	}
+
+	if !slices.Contains(types.TemplateVersionLifecycles(), r.Lifecycle) {
+		return fmt.Errorf("invalid lifecycle: %q", r.Lifecycle)
+	}
+
+	switch {
+	case r.Lifecycle == types.TemplateVersionLifecycleActive:
+		r.Reason = ""
+	case r.Reason == "":
+		return errors.New("invalid reason: blank")
+	}
+
+	return nil
+}
+
+type (
+	RouteGetResourcesRequest struct {
+		_ struct{} `route:"GET=/resources"`
+
+		model.TemplateVersionQueryInput `path:",inline"`
+
+		runtime.RequestCollection[
+			predicate.Resource, resource.OrderOption,
+		] `query:",inline"`
+
+		ProjectName string `query:"projectName,omitempty"`
+	}
+
+	RouteGetResourcesResponse = []*model.ResourceOutput
+)
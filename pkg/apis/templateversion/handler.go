@@ -1,6 +1,8 @@
 package templateversion
 
 import (
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/apis/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model"
 )
 
@@ -17,3 +19,9 @@ type Handler struct {
 func (Handler) Kind() string {
 	return "TemplateVersion"
 }
+
+func (h Handler) SubResourceHandlers() []runtime.IResourceHandler {
+	return []runtime.IResourceHandler{
+		templateupgradecampaign.Handle(h.modelClient),
+	}
+}
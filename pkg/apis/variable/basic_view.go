@@ -8,6 +8,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/kms"
 	"github.com/seal-io/walrus/utils/validation"
 )
 
@@ -32,10 +33,20 @@ func (r *CreateRequest) Validate() error {
 		return errors.New("invalid value: blank")
 	}
 
-	return nil
+	return validateSecretRef(string(r.Value), r.Sensitive)
+}
+
+type UpdateRequest struct {
+	model.VariableUpdateInput `path:",inline" json:",inline"`
 }
 
-type UpdateRequest = model.VariableUpdateInput
+func (r *UpdateRequest) Validate() error {
+	if err := r.VariableUpdateInput.Validate(); err != nil {
+		return err
+	}
+
+	return validateSecretRef(string(r.Value), r.Sensitive)
+}
 
 type DeleteRequest = model.VariableDeleteInput
 
@@ -55,6 +66,16 @@ type (
 
 type CollectionDeleteRequest = model.VariableDeleteInputs
 
+// validateSecretRef refuses referencing to the external secret store by a non-sensitive variable,
+// which prevents the secret from being exposed.
+func validateSecretRef(value string, sensitive bool) error {
+	if !sensitive && kms.IsSecretRef(value) {
+		return errors.New("invalid value: secret reference is only allowed for sensitive variable")
+	}
+
+	return nil
+}
+
 func exposeVariable(in *model.Variable) *model.VariableOutput {
 	if in.Sensitive {
 		in.Value = ""
@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+)
+
+func (h Handler) Create(req CreateRequest) (CreateResponse, error) {
+	entity := req.Model()
+
+	entity, err := h.modelClient.Webhooks().Create().
+		Set(entity).
+		Save(req.Context)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeWebhook(entity), nil
+}
+
+func (h Handler) Get(req GetRequest) (GetResponse, error) {
+	entity, err := h.modelClient.Webhooks().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeWebhook(entity), nil
+}
+
+func (h Handler) Update(req UpdateRequest) error {
+	entity := req.Model()
+
+	return h.modelClient.Webhooks().UpdateOne(entity).
+		Set(entity).
+		Exec(req.Context)
+}
+
+func (h Handler) Delete(req DeleteRequest) error {
+	return h.modelClient.Webhooks().DeleteOneID(req.ID).
+		Exec(req.Context)
+}
+
+var (
+	queryFields = []string{
+		webhook.FieldName,
+	}
+	getFields  = webhook.WithoutFields()
+	sortFields = []string{
+		webhook.FieldName,
+		webhook.FieldCreateTime,
+	}
+)
+
+func (h Handler) CollectionGet(req CollectionGetRequest) (CollectionGetResponse, int, error) {
+	query := h.modelClient.Webhooks().Query().
+		Where(webhook.ProjectID(req.Project.ID))
+
+	if queries, ok := req.Querying(queryFields); ok {
+		query.Where(queries)
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getFields, getFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortFields, model.Desc(webhook.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeWebhooks(entities), cnt, nil
+}
+
+func (h Handler) CollectionDelete(req CollectionDeleteRequest) error {
+	ids := req.IDs()
+
+	return h.modelClient.WithTx(req.Context, func(tx *model.Tx) error {
+		_, err := tx.Webhooks().Delete().
+			Where(webhook.IDIn(ids...)).
+			Exec(req.Context)
+
+		return err
+	})
+}
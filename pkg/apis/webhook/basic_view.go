@@ -0,0 +1,107 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/datalisten/modelchange"
+	"github.com/seal-io/walrus/utils/validation"
+)
+
+type (
+	CreateRequest struct {
+		model.WebhookCreateInput `path:",inline" json:",inline"`
+	}
+
+	CreateResponse = *model.WebhookOutput
+)
+
+func (r *CreateRequest) Validate() error {
+	if err := r.WebhookCreateInput.Validate(); err != nil {
+		return err
+	}
+
+	if err := validation.IsValidName(r.Name); err != nil {
+		return fmt.Errorf("invalid name: %w", err)
+	}
+
+	if r.Secret == "" {
+		return errors.New("invalid secret: blank")
+	}
+
+	return validateSubscription(r.URL, r.Topics, r.EventTypes)
+}
+
+type (
+	GetRequest = model.WebhookQueryInput
+
+	GetResponse = *model.WebhookOutput
+)
+
+type UpdateRequest struct {
+	model.WebhookUpdateInput `path:",inline" json:",inline"`
+}
+
+func (r *UpdateRequest) Validate() error {
+	if err := r.WebhookUpdateInput.Validate(); err != nil {
+		return err
+	}
+
+	return validateSubscription(r.URL, r.Topics, r.EventTypes)
+}
+
+type DeleteRequest = model.WebhookDeleteInput
+
+type (
+	CollectionGetRequest struct {
+		model.WebhookQueryInputs `path:",inline" query:",inline"`
+
+		runtime.RequestCollection[
+			predicate.Webhook, webhook.OrderOption,
+		] `query:",inline"`
+	}
+
+	CollectionGetResponse = []*model.WebhookOutput
+)
+
+type CollectionDeleteRequest = model.WebhookDeleteInputs
+
+func validateSubscription(rawURL string, topics, eventTypes []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url: %s", rawURL)
+	}
+
+	if len(topics) == 0 {
+		return errors.New("invalid topics: blank")
+	}
+
+	supportedTopics := modelchange.Topics()
+
+	for i := range topics {
+		if !slices.Contains(supportedTopics, topics[i]) {
+			return fmt.Errorf("invalid topic: %s", topics[i])
+		}
+	}
+
+	supportedEventTypes := modelchange.EventTypes()
+
+	for i := range eventTypes {
+		if !slices.Contains(supportedEventTypes, eventTypes[i]) {
+			return fmt.Errorf("invalid event type: %s", eventTypes[i])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
+	"github.com/seal-io/walrus/pkg/webhooks"
+)
+
+func (h Handler) RouteTest(req RouteTestRequest) (RouteTestResponse, error) {
+	entity, err := h.modelClient.Webhooks().Get(req.Context, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := webhooks.Test(req.Context, h.modelClient, entity)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ExposeWebhookDelivery(d), nil
+}
+
+var (
+	queryDeliveriesFields = []string{
+		webhookdelivery.FieldEventID,
+		webhookdelivery.FieldTopic,
+	}
+	getDeliveriesFields  = webhookdelivery.WithoutFields()
+	sortDeliveriesFields = []string{
+		webhookdelivery.FieldStatus,
+		webhookdelivery.FieldCreateTime,
+	}
+)
+
+func (h Handler) RouteGetDeliveries(req RouteGetDeliveriesRequest) (RouteGetDeliveriesResponse, int, error) {
+	query := h.modelClient.WebhookDeliveries().Query().
+		Where(webhookdelivery.WebhookID(req.ID))
+
+	if queries, ok := req.Querying(queryDeliveriesFields); ok {
+		query.Where(queries)
+	}
+
+	// Get count.
+	cnt, err := query.Clone().Count(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Get entities.
+	if limit, offset, ok := req.Paging(); ok {
+		query.Limit(limit).Offset(offset)
+	}
+
+	if fields, ok := req.Extracting(getDeliveriesFields, getDeliveriesFields...); ok {
+		query.Select(fields...)
+	}
+
+	if orders, ok := req.Sorting(sortDeliveriesFields, model.Desc(webhookdelivery.FieldCreateTime)); ok {
+		query.Order(orders...)
+	}
+
+	entities, err := query.
+		Unique(false).
+		All(req.Context)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return model.ExposeWebhookDeliveries(entities), cnt, nil
+}
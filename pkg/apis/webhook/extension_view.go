@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"github.com/seal-io/walrus/pkg/apis/runtime"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
+)
+
+type (
+	RouteTestRequest struct {
+		_ struct{} `route:"POST=/test"`
+
+		model.WebhookQueryInput `path:",inline"`
+	}
+
+	RouteTestResponse = *model.WebhookDeliveryOutput
+)
+
+type (
+	RouteGetDeliveriesRequest struct {
+		_ struct{} `route:"GET=/deliveries"`
+
+		model.WebhookQueryInput `path:",inline"`
+
+		runtime.RequestCollection[
+			predicate.WebhookDelivery, webhookdelivery.OrderOption,
+		] `query:",inline"`
+	}
+
+	RouteGetDeliveriesResponse = []*model.WebhookDeliveryOutput
+)
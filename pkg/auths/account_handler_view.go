@@ -19,7 +19,7 @@ type (
 		_ struct{} `route:"POST=/login"`
 
 		Username string `json:"username"`
-		Password string `json:"password"`
+		Password string `json:"password" sensitive:"true"`
 
 		Context *gin.Context
 	}
@@ -70,8 +70,8 @@ func (r *GetInfoRequest) SetGinContext(ctx *gin.Context) {
 type UpdateInfoRequest struct {
 	_ struct{} `route:"PUT=/info"`
 
-	Password    string `json:"password,omitempty"`
-	OldPassword string `json:"oldPassword,omitempty"`
+	Password    string `json:"password,omitempty" sensitive:"true"`
+	OldPassword string `json:"oldPassword,omitempty" sensitive:"true"`
 
 	Context *gin.Context
 }
@@ -186,6 +186,15 @@ func SetSubject(ctx context.Context, subject Subject) {
 	c.Set(subjectContextKey, subject)
 }
 
+// WithSubject returns a copy of the given context.Context with the Subject,
+// it is used to act as the subject out of the request, e.g. background tasks.
+func WithSubject(ctx context.Context, subject Subject) context.Context {
+	ctx = context.WithValue(ctx, gin.ContextKey, &gin.Context{})
+	SetSubject(ctx, subject)
+
+	return ctx
+}
+
 // GetSubject gets the Subject from the given context.Context.
 func GetSubject(ctx context.Context) (Subject, error) {
 	c, err := getContext(ctx)
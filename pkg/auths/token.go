@@ -8,6 +8,7 @@ import (
 
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/token"
+	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/utils/strs"
@@ -151,3 +152,25 @@ func GetAccessToken(
 
 	return entity, nil
 }
+
+// IsDeploymentAccessToken returns true if the given request is authenticated by
+// the deployment token created for the given run.
+func IsDeploymentAccessToken(r *http.Request, mc model.ClientSet, runID object.ID) bool {
+	sid, tid, tv := decodeToken(r)
+	if tv == "" {
+		return false
+	}
+
+	entity, err := mc.Tokens().Query().
+		Where(
+			token.ID(tid),
+			token.SubjectID(sid),
+			token.Kind(types.TokenKindDeployment),
+			token.Name(runID.String())).
+		Only(r.Context())
+	if err != nil {
+		return false
+	}
+
+	return string(entity.Value) == tv
+}
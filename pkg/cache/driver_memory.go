@@ -0,0 +1,314 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	cacheutil "github.com/seal-io/walrus/utils/cache"
+	"github.com/seal-io/walrus/utils/strs"
+)
+
+const (
+	// _defaultMemoryMaxEntries is the default maximum number of entries of the in-process cache.
+	_defaultMemoryMaxEntries = 10000
+	// _defaultMemoryEntryMaxAge is the default maximum lifetime of the in-process cache entries.
+	_defaultMemoryEntryMaxAge = 5 * time.Minute
+)
+
+// parseMemoryAddress parses the in-process cache options from the given address,
+// which is in form of memory://[?max_entries=<number>&entry_max_age=<duration>].
+func parseMemoryAddress(s string) (maxEntries int, entryMaxAge time.Duration, err error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	maxEntries, entryMaxAge = _defaultMemoryMaxEntries, _defaultMemoryEntryMaxAge
+	q := u.Query()
+
+	if v := q.Get("max_entries"); v != "" {
+		maxEntries, err = strconv.Atoi(v)
+		if err != nil || maxEntries <= 0 {
+			return 0, 0, fmt.Errorf("invalid max_entries %q: must be a positive integer", v)
+		}
+	}
+
+	if v := q.Get("entry_max_age"); v != "" {
+		entryMaxAge, err = time.ParseDuration(v)
+		if err != nil || entryMaxAge <= 0 {
+			return 0, 0, fmt.Errorf("invalid entry_max_age %q: must be a positive duration", v)
+		}
+	}
+
+	return maxEntries, entryMaxAge, nil
+}
+
+func newMemoryDriver(maxEntries int, entryMaxAge time.Duration) Driver {
+	return &memoryDriver{
+		cli: newMemoryCache(maxEntries, entryMaxAge),
+	}
+}
+
+// memoryDriver implements Driver interface with a size-bounded LRU cache living in the process,
+// which does not need any external service.
+type memoryDriver struct {
+	cli *memoryCache
+}
+
+// SetMaxIdleConns is a no-op as there is no connection.
+func (m *memoryDriver) SetMaxIdleConns(n int) {}
+
+// SetMaxOpenConns is a no-op as there is no connection.
+func (m *memoryDriver) SetMaxOpenConns(n int) {}
+
+// SetConnMaxLifetime is a no-op as there is no connection.
+func (m *memoryDriver) SetConnMaxLifetime(d time.Duration) {}
+
+// SetConnMaxIdleTime is a no-op as there is no connection.
+func (m *memoryDriver) SetConnMaxIdleTime(d time.Duration) {}
+
+func (m *memoryDriver) PingContext(ctx context.Context) error {
+	return nil
+}
+
+func (m *memoryDriver) Underlay(ctx context.Context) (string, any, error) {
+	return DialectMemory, m.cli, nil
+}
+
+// Stats returns the statistics of the in-process cache in the form of the connection pool,
+// to report the same metrics as the remote cache,
+// the maximum entries as the max open connections, the present entries as the idle connections,
+// the missing times as the new open count, the expired entries as the timeout count,
+// and the evicted entries as the closed count.
+func (m *memoryDriver) Stats() DriverStats {
+	return m.cli.stats()
+}
+
+// memoryCache implements the cacheutil.Cache with a size-bounded LRU list,
+// the least recently used entry is evicted when the cache is full,
+// and the entry is expired after the maximum lifetime since set.
+type memoryCache struct {
+	mu sync.Mutex
+
+	maxEntries  int
+	entryMaxAge time.Duration
+	now         func() time.Time
+	ll          *list.List
+	items       map[string]*list.Element
+
+	missCount    int64
+	expiredCount int64
+	evictedCount int64
+
+	// Origin identifies the process to skip the invalidation broadcast by itself.
+	origin string
+	// Broadcast broadcasts the deleted keys to the other processes if not nil.
+	broadcast func(ctx context.Context, keys []string) error
+}
+
+type memoryEntry struct {
+	key      string
+	value    []byte
+	expireAt time.Time
+}
+
+func (e *memoryEntry) Key() string {
+	return e.key
+}
+
+func (e *memoryEntry) Value() ([]byte, error) {
+	return e.value, nil
+}
+
+func newMemoryCache(maxEntries int, entryMaxAge time.Duration) *memoryCache {
+	return &memoryCache{
+		maxEntries:  maxEntries,
+		entryMaxAge: entryMaxAge,
+		now:         time.Now,
+		ll:          list.New(),
+		items:       make(map[string]*list.Element),
+		origin:      strs.Hex(16),
+	}
+}
+
+func (c *memoryCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+
+	return nil
+}
+
+func (c *memoryCache) Name() string {
+	return "memory-lru"
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, entry []byte) error {
+	value := make([]byte, len(entry))
+	copy(value, entry)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expireAt := c.now().Add(c.entryMaxAge)
+
+	e := &memoryEntry{
+		key:      key,
+		value:    value,
+		expireAt: expireAt,
+	}
+
+	// Replace instead of modifying the entry, which may be accessed by the iteration.
+	if el, ok := c.items[key]; ok {
+		el.Value = e
+		c.ll.MoveToFront(el)
+
+		return nil
+	}
+
+	c.items[key] = c.ll.PushFront(e)
+
+	for c.ll.Len() > c.maxEntries {
+		c.removeElement(c.ll.Back())
+		c.evictedCount++
+	}
+
+	return nil
+}
+
+// Delete removes the keys and broadcasts the invalidation to the other processes if enabled.
+func (c *memoryCache) Delete(ctx context.Context, keys ...string) error {
+	c.invalidate(keys)
+
+	c.mu.Lock()
+	broadcast := c.broadcast
+	c.mu.Unlock()
+
+	if broadcast == nil || len(keys) == 0 {
+		return nil
+	}
+
+	return broadcast(ctx, keys)
+}
+
+// invalidate removes the keys without broadcasting.
+func (c *memoryCache) invalidate(keys []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := range keys {
+		if el, ok := c.items[keys[i]]; ok {
+			c.removeElement(el)
+		}
+	}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.missCount++
+		return nil, cacheutil.ErrEntryNotFound
+	}
+
+	e := el.Value.(*memoryEntry)
+	if !c.now().Before(e.expireAt) {
+		c.removeElement(el)
+		c.expiredCount++
+		c.missCount++
+
+		return nil, cacheutil.ErrEntryNotFound
+	}
+
+	c.ll.MoveToFront(el)
+
+	return e.value, nil
+}
+
+// List returns the entries of the given keys, the entry is nil if not found.
+func (c *memoryCache) List(ctx context.Context, keys ...string) ([][]byte, error) {
+	entries := make([][]byte, len(keys))
+
+	for i := range keys {
+		e, err := c.Get(ctx, keys[i])
+		if err != nil {
+			if errors.Is(err, cacheutil.ErrEntryNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		entries[i] = e
+	}
+
+	return entries, nil
+}
+
+func (c *memoryCache) Iterate(ctx context.Context, m cacheutil.EntryKeyMatcher, a cacheutil.EntryAccessor) error {
+	if a == nil {
+		return nil
+	}
+
+	// Snapshot the live entries to allow accessing the cache during iteration.
+	c.mu.Lock()
+
+	now := c.now()
+	entries := make([]*memoryEntry, 0, c.ll.Len())
+
+	for el := c.ll.Front(); el != nil; el = el.Next() {
+		e := el.Value.(*memoryEntry)
+		if !now.Before(e.expireAt) {
+			continue
+		}
+
+		if m != nil && !m.Match(e.key) {
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	c.mu.Unlock()
+
+	for _, e := range entries {
+		n, err := a(ctx, e)
+		if err != nil {
+			return err
+		}
+
+		if !n {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (c *memoryCache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*memoryEntry).key)
+}
+
+func (c *memoryCache) stats() DriverStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return DriverStats{
+		MaxOpenConnections: int64(c.maxEntries),
+		IdleConnections:    int64(c.ll.Len()),
+		NewOpenCount:       c.missCount,
+		TimeoutCount:       c.expiredCount,
+		ClosedCount:        c.evictedCount,
+	}
+}
@@ -0,0 +1,178 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cacheutil "github.com/seal-io/walrus/utils/cache"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+func TestLoadMemoryDriver(t *testing.T) {
+	testCases := []struct {
+		name               string
+		given              string
+		expectedMaxEntries int64
+		expectedError      bool
+	}{
+		{
+			name:               "default",
+			given:              "memory://",
+			expectedMaxEntries: _defaultMemoryMaxEntries,
+		},
+		{
+			name:               "customized",
+			given:              "memory://?max_entries=100&entry_max_age=1m",
+			expectedMaxEntries: 100,
+		},
+		{
+			name:          "invalid max entries",
+			given:         "memory://?max_entries=0",
+			expectedError: true,
+		},
+		{
+			name:          "invalid entry max age",
+			given:         "memory://?entry_max_age=forever",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dialect, drv, err := LoadDriver(tc.given)
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, DialectMemory, dialect)
+			assert.Equal(t, tc.expectedMaxEntries, drv.Stats().MaxOpenConnections)
+
+			underlayDialect, cli, err := drv.Underlay(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, DialectMemory, underlayDialect)
+			assert.Implements(t, (*cacheutil.Cache)(nil), cli)
+		})
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now()
+	c := newMemoryCache(2, time.Minute)
+	c.now = func() time.Time { return now }
+
+	require.NoError(t, c.Set(ctx, "a", []byte("1")))
+	require.NoError(t, c.Set(ctx, "b", []byte("2")))
+
+	// Access "a" to make "b" the least recently used.
+	v, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("1"), v)
+
+	require.NoError(t, c.Set(ctx, "c", []byte("3")))
+
+	_, err = c.Get(ctx, "b")
+	assert.ErrorIs(t, err, cacheutil.ErrEntryNotFound)
+
+	entries, err := c.List(ctx, "a", "b", "c")
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("1"), nil, []byte("3")}, entries)
+
+	// Expire all entries.
+	now = now.Add(time.Minute)
+
+	var keys []string
+
+	err = c.Iterate(ctx, nil, func(ctx context.Context, entry cacheutil.Entry) (bool, error) {
+		keys = append(keys, entry.Key())
+		return true, nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = c.Get(ctx, "a")
+	assert.ErrorIs(t, err, cacheutil.ErrEntryNotFound)
+
+	assert.Equal(t, DriverStats{
+		MaxOpenConnections: 2,
+		IdleConnections:    1,
+		NewOpenCount:       3,
+		TimeoutCount:       1,
+		ClosedCount:        1,
+	}, c.stats())
+}
+
+func TestMemoryCacheInvalidation(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		local  = newMemoryCache(10, time.Minute)
+		remote = newMemoryCache(10, time.Minute)
+		h      = invalidationHandler{cli: remote}
+	)
+
+	// Simulate the database notification.
+	local.broadcast = func(ctx context.Context, keys []string) error {
+		for _, p := range chunkInvalidationPayloads(local.origin, keys) {
+			h.Handle(ctx, InvalidationChannel, p)
+		}
+
+		return nil
+	}
+
+	for _, c := range []*memoryCache{local, remote} {
+		require.NoError(t, c.Set(ctx, "a", []byte("1")))
+		require.NoError(t, c.Set(ctx, "b", []byte("2")))
+	}
+
+	require.NoError(t, local.Delete(ctx, "a"))
+
+	_, err := remote.Get(ctx, "a")
+	assert.ErrorIs(t, err, cacheutil.ErrEntryNotFound)
+
+	_, err = remote.Get(ctx, "b")
+	assert.NoError(t, err)
+
+	// Skip the invalidation broadcast by itself.
+	require.NoError(t, local.Set(ctx, "a", []byte("1")))
+	invalidationHandler{cli: local}.Handle(ctx, InvalidationChannel,
+		chunkInvalidationPayloads(local.origin, []string{"a"})[0])
+
+	_, err = local.Get(ctx, "a")
+	assert.NoError(t, err)
+}
+
+func TestChunkInvalidationPayloads(t *testing.T) {
+	keys := make([]string, 300)
+	for i := range keys {
+		keys[i] = strings.Repeat("k", 40) + string(rune('a'+i%26))
+	}
+
+	keys = append(keys, strings.Repeat("x", _maxInvalidationPayloadSize))
+
+	payloads := chunkInvalidationPayloads("origin", keys)
+	require.Greater(t, len(payloads), 1)
+
+	var count int
+
+	for _, p := range payloads {
+		assert.LessOrEqual(t, len(p), _maxInvalidationPayloadSize)
+
+		var ip invalidationPayload
+		require.NoError(t, json.Unmarshal([]byte(p), &ip))
+		assert.Equal(t, "origin", ip.Origin)
+
+		count += len(ip.Keys)
+	}
+
+	// The oversize key is dropped.
+	assert.Equal(t, 300, count)
+}
@@ -15,6 +15,7 @@ import (
 const (
 	DialectRedis        = "redis"
 	DialectRedisCluster = "rediss"
+	DialectMemory       = "memory"
 )
 
 func GetDriverAndName(cacheSourceAddress string) (csd, csn string, err error) {
@@ -30,6 +31,9 @@ func GetDriverAndName(cacheSourceAddress string) (csd, csn string, err error) {
 	case strings.HasPrefix(cacheSourceAddress, "rediss://"):
 		csd = DialectRedisCluster
 		csn, err = clipRedisAddress(cacheSourceAddress)
+	case strings.HasPrefix(cacheSourceAddress, "memory://"):
+		csd = DialectMemory
+		csn = cacheSourceAddress
 	}
 
 	if csd == "" {
@@ -64,6 +68,18 @@ func LoadDriver(cacheSourceAddress string) (drvDialect string, drv Driver, err e
 		if err != nil {
 			return
 		}
+	case DialectMemory:
+		var (
+			maxEntries  int
+			entryMaxAge time.Duration
+		)
+
+		maxEntries, entryMaxAge, err = parseMemoryAddress(drvSource)
+		if err != nil {
+			return
+		}
+
+		drv = newMemoryDriver(maxEntries, entryMaxAge)
 	}
 
 	return
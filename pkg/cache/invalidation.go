@@ -0,0 +1,138 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/seal-io/walrus/pkg/database"
+	"github.com/seal-io/walrus/utils/json"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+// InvalidationChannel is the database channel to broadcast the deleted keys of the in-process cache,
+// so that the replicas can invalidate their own in-process cache without a shared remote cache.
+const InvalidationChannel = "cache_invalidation_channel"
+
+// _maxInvalidationPayloadSize keeps the payload under the 8000 bytes limit of the Postgres NOTIFY.
+const _maxInvalidationPayloadSize = 7000
+
+// invalidationPayload holds the deleted keys broadcast by the origin process.
+type invalidationPayload struct {
+	Origin string   `json:"o"`
+	Keys   []string `json:"k"`
+}
+
+// BroadcastInvalidation enables the given in-process cache driver to broadcast the deleted keys
+// to the other replicas with the Postgres NOTIFY of the given database,
+// it's a no-op for the other drivers.
+func BroadcastInvalidation(drv Driver, db *sql.DB) {
+	md, ok := drv.(*memoryDriver)
+	if !ok || db == nil {
+		return
+	}
+
+	c := md.cli
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.broadcast = func(ctx context.Context, keys []string) error {
+		for _, p := range chunkInvalidationPayloads(c.origin, keys) {
+			if _, err := db.ExecContext(ctx, "SELECT pg_notify($1, $2)", InvalidationChannel, p); err != nil {
+				return fmt.Errorf("error broadcasting cache invalidation: %w", err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// chunkInvalidationPayloads splits the keys into multiple payloads within the size limit,
+// the key exceeding the limit is dropped, which is expired at last.
+func chunkInvalidationPayloads(origin string, keys []string) []string {
+	var (
+		payloads []string
+		chunk    []string
+		size     int
+	)
+
+	// Size of the payload without keys, i.e. {"o":"<origin>","k":[]}.
+	base := len(origin) + 16
+
+	flush := func() {
+		if len(chunk) == 0 {
+			return
+		}
+
+		bs, err := json.Marshal(invalidationPayload{Origin: origin, Keys: chunk})
+		if err == nil {
+			payloads = append(payloads, string(bs))
+		}
+
+		chunk, size = nil, 0
+	}
+
+	for _, k := range keys {
+		// Plus the quotes and the comma, escaping is ignored as the keys are mostly plain.
+		ks := len(k) + 3
+		if base+ks > _maxInvalidationPayloadSize {
+			continue
+		}
+
+		if base+size+ks > _maxInvalidationPayloadSize {
+			flush()
+		}
+
+		chunk = append(chunk, k)
+		size += ks
+	}
+
+	flush()
+
+	return payloads
+}
+
+// InvalidationHandler returns a database.ListenHandler to delete the keys broadcast by the other replicas
+// from the given in-process cache driver, returns nil for the other drivers.
+func InvalidationHandler(drv Driver) database.ListenHandler {
+	md, ok := drv.(*memoryDriver)
+	if !ok {
+		return nil
+	}
+
+	return invalidationHandler{
+		logger: log.WithName("cache").WithName("invalidation"),
+		cli:    md.cli,
+	}
+}
+
+type invalidationHandler struct {
+	logger log.Logger
+	cli    *memoryCache
+}
+
+func (invalidationHandler) Channels() []string {
+	return []string{
+		InvalidationChannel,
+	}
+}
+
+func (h invalidationHandler) Handle(ctx context.Context, _, payload string) {
+	if payload == "" {
+		return
+	}
+
+	var p invalidationPayload
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		h.logger.Warnf("error unmarshalling cache invalidation payload: %v", err)
+		return
+	}
+
+	// The origin has deleted the keys before broadcasting.
+	if p.Origin == h.cli.origin {
+		return
+	}
+
+	h.cli.invalidate(p.Keys)
+}
@@ -3,13 +3,18 @@ package catalog
 import (
 	"context"
 	"fmt"
+	"os"
+	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/drone/go-scm/scm"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"go.uber.org/multierr"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/seal-io/walrus/pkg/bus/builtin"
 	"github.com/seal-io/walrus/pkg/bus/catalog"
@@ -34,6 +39,10 @@ const (
 
 // getRepos returns org and a list of repositories from the given catalog.
 func getRepos(ctx context.Context, c *model.Catalog, ua string, skipTLSVerify bool) ([]*vcs.Repository, error) {
+	if c.Type == types.GitDriverGit {
+		return getGitRepos(c)
+	}
+
 	var (
 		client *scm.Client
 		err    error
@@ -85,12 +94,121 @@ func getRepos(ctx context.Context, c *model.Catalog, ua string, skipTLSVerify bo
 			Description: repos[i].Description,
 			Link:        repos[i].Link,
 			Topics:      repos[i].Topics,
+			Auth:        templates.GetCatalogGitAuth(c),
 		})
 	}
 
 	return list, nil
 }
 
+// getGitRepos returns the repositories of the generic Git catalog,
+// which are the subdirectories of the source if the directories are specified,
+// otherwise the source itself, along with the specified repositories.
+// The templates of the monorepo source are discovered after cloning, see syncMonorepoTemplates.
+func getGitRepos(c *model.Catalog) ([]*vcs.Repository, error) {
+	switch {
+	case c.Monorepo:
+		return listGitRepos(c, nil, c.Repositories)
+	case len(c.Directories) == 0:
+		return listGitRepos(c, nil, append([]string{c.Source}, c.Repositories...))
+	default:
+		return listGitRepos(c, c.Directories, c.Repositories)
+	}
+}
+
+// listGitRepos returns the repositories of the given subdirectories of the source and the given links,
+// which are filtered and deduplicated by names.
+func listGitRepos(c *model.Catalog, dirs, links []string) ([]*vcs.Repository, error) {
+	var nameReg *regexp.Regexp
+
+	if c.FilterPattern != "" {
+		var err error
+
+		nameReg, err = regexp.Compile(c.FilterPattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		auth  = templates.GetCatalogGitAuth(c)
+		list  = make([]*vcs.Repository, 0, len(dirs)+len(links))
+		names = sets.New[string]()
+	)
+
+	add := func(r *vcs.Repository) {
+		if nameReg != nil && !nameReg.MatchString(r.Name) {
+			return
+		}
+
+		if names.Has(r.Name) {
+			log.WithName("catalog").
+				Warnf("skip duplicated repository %q of catalog %s", r.Name, c.Name)
+
+			return
+		}
+
+		names.Insert(r.Name)
+
+		r.Auth = auth
+		list = append(list, r)
+	}
+
+	if len(dirs) != 0 {
+		namespace, _, err := getGitRepoName(c.Source)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dir := range dirs {
+			dir = strings.Trim(path.Clean("/"+dir), "/")
+			if dir == "" {
+				continue
+			}
+
+			add(&vcs.Repository{
+				Namespace: namespace,
+				Name:      path.Base(dir),
+				Link:      c.Source,
+				SubPath:   dir,
+			})
+		}
+	}
+
+	for _, link := range links {
+		namespace, name, err := getGitRepoName(link)
+		if err != nil {
+			return nil, err
+		}
+
+		add(&vcs.Repository{
+			Namespace: namespace,
+			Name:      name,
+			Link:      link,
+		})
+	}
+
+	return list, nil
+}
+
+// getGitRepoName returns the namespace and name of the given repository URL,
+// the namespace can be nested, e.g. "group/subgroup".
+func getGitRepoName(link string) (namespace, name string, err error) {
+	endpoint, err := transport.NewEndpoint(link)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid repository %q: %w", link, err)
+	}
+
+	p := strings.Trim(strings.TrimSuffix(endpoint.Path, ".git"), "/")
+	if p == "" {
+		return "", "", fmt.Errorf("invalid repository %q: blank path", link)
+	}
+
+	namespace, name = path.Split(p)
+
+	return strings.TrimSuffix(namespace, "/"), name, nil
+}
+
 func getSyncResult(ctx context.Context, mc model.ClientSet, c *model.Catalog) (*types.CatalogSync, error) {
 	var (
 		catalogSync = &types.CatalogSync{
@@ -135,9 +253,15 @@ func SyncTemplates(ctx context.Context, mc model.ClientSet, c *model.Catalog) er
 
 	ua := version.GetUserAgent() + "; uuid=" + settings.InstallationUUID.ShouldValue(ctx, mc)
 
+	var merr error
+
+	if c.Type == types.GitDriverGit && c.Monorepo {
+		merr = syncMonorepoTemplates(ctx, mc, c)
+	}
+
 	repos, err := getRepos(ctx, c, ua, settings.SkipRemoteTLSVerify.ShouldValueBool(ctx, mc))
 	if err != nil {
-		return err
+		return multierr.Append(merr, err)
 	}
 
 	logger.Infof("found %d repositories in %s", len(repos), c.Source)
@@ -163,15 +287,7 @@ func SyncTemplates(ctx context.Context, mc model.ClientSet, c *model.Catalog) er
 				repo := repos[j]
 				repo.Driver = c.Type
 
-				t := &model.Template{
-					Name:        normalizeTemplateName(c, repo.Name),
-					Description: repo.Description,
-					Source:      repo.Link,
-					CatalogID:   c.ID,
-					ProjectID:   c.ProjectID,
-				}
-
-				t.Labels = createWalrusBuiltinLabels(repo.Topics)
+				t := newTemplate(c, repo)
 
 				logger.Debugf("syncing  \"%s:%s\" of catalog %q", c.Name, repo.Name, c.ID)
 
@@ -195,7 +311,79 @@ func SyncTemplates(ctx context.Context, mc model.ClientSet, c *model.Catalog) er
 		})
 	}
 
-	return wg.Wait()
+	return multierr.Append(merr, wg.Wait())
+}
+
+// syncMonorepoTemplates clones the source of the monorepo catalog once,
+// discovers the templates from the directories and syncs them one by one,
+// as the templates share the worktree of the clone.
+func syncMonorepoTemplates(ctx context.Context, mc model.ClientSet, c *model.Catalog) error {
+	logger := log.WithName("catalog")
+
+	tempDir := filepath.Join(os.TempDir(), "seal-catalog-"+strs.String(10))
+	defer os.RemoveAll(tempDir)
+
+	u, err := transport.NewEndpoint(c.Source)
+	if err != nil {
+		return err
+	}
+
+	r, err := vcs.CloneGitRepoWithAuth(ctx, u.String(), tempDir,
+		settings.SkipRemoteTLSVerify.ShouldValueBool(ctx, mc), templates.GetCatalogGitAuth(c))
+	if err != nil {
+		return err
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		return err
+	}
+
+	dirs, err := templates.DiscoverTemplateDirs(w.Filesystem.Root(), c.Directories)
+	if err != nil {
+		return err
+	}
+
+	repos, err := listGitRepos(c, dirs, nil)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("found %d templates in monorepo %s", len(repos), c.Source)
+
+	var berr error
+
+	for _, repo := range repos {
+		repo.Driver = c.Type
+
+		t := newTemplate(c, repo)
+
+		serr := templates.SyncTemplateFromClonedGitRepo(ctx, mc, t, repo, r)
+		if serr != nil {
+			berr = multierr.Append(berr,
+				fmt.Errorf("error syncing \"%s:%s\" of catalog %q: %w",
+					c.Name, repo.Name, c.ID, serr))
+		}
+	}
+
+	return berr
+}
+
+// newTemplate returns the template of the given repository of the catalog.
+func newTemplate(c *model.Catalog, repo *vcs.Repository) *model.Template {
+	source := repo.Link
+	if repo.SubPath != "" {
+		source += "//" + repo.SubPath
+	}
+
+	return &model.Template{
+		Name:        normalizeTemplateName(c, repo.Name),
+		Description: repo.Description,
+		Source:      source,
+		CatalogID:   c.ID,
+		ProjectID:   c.ProjectID,
+		Labels:      createWalrusBuiltinLabels(repo.Topics),
+	}
 }
 
 func normalizeTemplateName(c *model.Catalog, name string) string {
@@ -2,9 +2,13 @@ package catalog
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/pkg/vcs"
 	"github.com/seal-io/walrus/utils/version"
 )
 
@@ -25,3 +29,99 @@ func TestGetRepos(t *testing.T) {
 		}
 	}
 }
+
+func TestGetGitRepos(t *testing.T) {
+	testCases := []struct {
+		name     string
+		given    *model.Catalog
+		expected []vcs.Repository
+	}{
+		{
+			name: "repositories",
+			given: &model.Catalog{
+				Type:   types.GitDriverGit,
+				Source: "https://gitea.example.com/group/sub/terraform-mysql.git",
+				Repositories: []string{
+					"git@git.example.com:infra/terraform-redis.git",
+					"ssh://git@git.example.com:2222/infra/terraform-mysql.git",
+				},
+			},
+			expected: []vcs.Repository{
+				{
+					Namespace: "group/sub",
+					Name:      "terraform-mysql",
+					Link:      "https://gitea.example.com/group/sub/terraform-mysql.git",
+				},
+				{
+					Namespace: "infra",
+					Name:      "terraform-redis",
+					Link:      "git@git.example.com:infra/terraform-redis.git",
+				},
+			},
+		},
+		{
+			name: "directories",
+			given: &model.Catalog{
+				Type:          types.GitDriverGit,
+				Source:        "https://gitea.example.com/infra/modules.git",
+				Directories:   []string{"modules/mysql/", "/modules/redis", "modules/skip"},
+				FilterPattern: "^(mysql|redis)$",
+				Credential: crypto.Map[string, string]{
+					types.CatalogCredentialToken: "token",
+				},
+			},
+			expected: []vcs.Repository{
+				{
+					Namespace: "infra",
+					Name:      "mysql",
+					Link:      "https://gitea.example.com/infra/modules.git",
+					SubPath:   "modules/mysql",
+					Auth:      &vcs.GitAuth{Token: "token"},
+				},
+				{
+					Namespace: "infra",
+					Name:      "redis",
+					Link:      "https://gitea.example.com/infra/modules.git",
+					SubPath:   "modules/redis",
+					Auth:      &vcs.GitAuth{Token: "token"},
+				},
+			},
+		},
+		{
+			name: "monorepo",
+			given: &model.Catalog{
+				Type:         types.GitDriverGit,
+				Source:       "https://gitea.example.com/infra/modules.git",
+				Directories:  []string{"modules"},
+				Monorepo:     true,
+				Repositories: []string{"https://gitea.example.com/infra/terraform-redis.git"},
+			},
+			expected: []vcs.Repository{
+				{
+					Namespace: "infra",
+					Name:      "terraform-redis",
+					Link:      "https://gitea.example.com/infra/terraform-redis.git",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := getRepos(context.Background(), tc.given, version.GetUserAgent(), true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(actual) != len(tc.expected) {
+				t.Fatalf("expected %d repositories, got %d", len(tc.expected), len(actual))
+			}
+
+			for i := range actual {
+				if !reflect.DeepEqual(*actual[i], tc.expected[i]) {
+					t.Errorf("expected %+v, got %+v", tc.expected[i], *actual[i])
+				}
+			}
+		})
+	}
+}
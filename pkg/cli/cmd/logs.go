@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/seal-io/walrus/pkg/cli/common"
+	"github.com/seal-io/walrus/pkg/cli/config"
+	"github.com/seal-io/walrus/pkg/cli/manifest"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// LogsOption holds the options of the logs command.
+type LogsOption struct {
+	manifest.CommonOption
+
+	// Run is the ID of the resource run, defaults to the latest run.
+	Run string
+	// JobType is the job type of the run, plan, apply or destroy.
+	JobType string
+	// Follow streams the logs of the running job.
+	Follow bool
+	// Tail shows the last given count of lines.
+	Tail int
+	// Line is the 1-based line number to start fetching from.
+	Line int
+	// Lines is the count of lines to fetch.
+	Lines int
+	// Offset is the byte offset to start fetching from.
+	Offset int64
+	// Length is the count of bytes to fetch.
+	Length int64
+	// Search is the text to search.
+	Search string
+	// Timestamps shows the timestamp of each line.
+	Timestamps bool
+	// Output is the file to download the logs into.
+	Output string
+}
+
+func (o *LogsOption) AddFlags(cmd *cobra.Command) {
+	o.ScopeContext.AddFlags(cmd)
+
+	cmd.Flags().StringVar(&o.Run, "run", "", "ID of the resource run, defaults to the latest run")
+	cmd.Flags().StringVar(&o.JobType, "job-type", "apply", "Job type of the run, select from plan, apply or destroy")
+	cmd.Flags().BoolVarP(&o.Follow, "follow", "f", false, "Stream the logs of the running job")
+	cmd.Flags().IntVar(&o.Tail, "tail", 0, "Show the last given count of lines")
+	cmd.Flags().IntVar(&o.Line, "line", 0, "1-based line number to start fetching from")
+	cmd.Flags().IntVar(&o.Lines, "lines", 0, "Count of lines to fetch")
+	cmd.Flags().Int64Var(&o.Offset, "offset", 0, "Byte offset to start fetching from")
+	cmd.Flags().Int64Var(&o.Length, "length", 0, "Count of bytes to fetch")
+	cmd.Flags().StringVar(&o.Search, "search", "", "Search the lines contain the given text")
+	cmd.Flags().BoolVar(&o.Timestamps, "timestamps", false, "Show the timestamp of each line")
+	cmd.Flags().StringVarP(&o.Output, "output", "o", "", "Download the logs into the given file")
+}
+
+// Logs returns the command to tail and fetch the logs of the resource run.
+func Logs(sc *config.Config) *cobra.Command {
+	opts := &LogsOption{}
+
+	cmd := &cobra.Command{
+		Use:     "logs RESOURCE_NAME",
+		GroupID: common.GroupAdvanced.ID,
+		Example: logsExample,
+		Short:   "Tail, fetch, search or download the logs of the resource run.",
+		Args:    cobra.ExactArgs(1),
+		PreRun:  setupServerContextFunc(sc, &opts.CommonOption),
+		Run: func(cmd *cobra.Command, args []string) {
+			err := logs(sc, args[0], cmd, opts)
+			if err != nil {
+				panic(err)
+			}
+		},
+	}
+
+	opts.AddFlags(cmd)
+
+	return cmd
+}
+
+type logLine struct {
+	Number int       `json:"number"`
+	Time   time.Time `json:"time"`
+	Text   string    `json:"text"`
+}
+
+func logs(sc *config.Config, resource string, cmd *cobra.Command, opts *LogsOption) error {
+	runsPath := path.Join("v1", "projects", sc.Project, "environments", sc.Environment,
+		"resources", resource, "runs")
+
+	runID := opts.Run
+	if runID == "" {
+		id, err := getLatestRunID(sc, runsPath)
+		if err != nil {
+			return err
+		}
+
+		runID = id
+	}
+
+	var (
+		runPath = path.Join(runsPath, runID)
+		query   = url.Values{"jobType": []string{opts.JobType}}
+		flags   = cmd.Flags()
+	)
+
+	switch {
+	case opts.Follow:
+		return copyLogs(sc, runPath+"/log", query, os.Stdout)
+	case opts.Output != "":
+		query.Set("timestamps", strconv.FormatBool(opts.Timestamps))
+
+		f, err := os.Create(opts.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		return copyLogs(sc, runPath+"/logs/download", query, f)
+	case opts.Search != "":
+		query.Set("query", opts.Search)
+
+		var lines []logLine
+		if err := getLogsJSON(sc, runPath+"/logs/search", query, &lines); err != nil {
+			return err
+		}
+
+		printLogLines(lines, true, opts.Timestamps)
+
+		return nil
+	case flags.Changed("offset") || flags.Changed("length"):
+		query.Set("offset", strconv.FormatInt(opts.Offset, 10))
+		query.Set("length", strconv.FormatInt(opts.Length, 10))
+
+		return copyLogs(sc, runPath+"/logs/bytes", query, os.Stdout)
+	case opts.Tail > 0 || flags.Changed("line") || flags.Changed("lines"):
+		query.Set("tail", strconv.Itoa(opts.Tail))
+		query.Set("line", strconv.Itoa(opts.Line))
+		query.Set("lines", strconv.Itoa(opts.Lines))
+
+		var resp struct {
+			Lines []logLine `json:"lines"`
+		}
+		if err := getLogsJSON(sc, runPath+"/logs", query, &resp); err != nil {
+			return err
+		}
+
+		printLogLines(resp.Lines, false, opts.Timestamps)
+
+		return nil
+	}
+
+	query.Set("timestamps", strconv.FormatBool(opts.Timestamps))
+
+	return copyLogs(sc, runPath+"/logs/download", query, os.Stdout)
+}
+
+// getLatestRunID returns the ID of the latest run of the resource.
+func getLatestRunID(sc *config.Config, runsPath string) (string, error) {
+	var resp struct {
+		Items []struct {
+			ID string `json:"id"`
+		} `json:"items"`
+	}
+
+	query := url.Values{
+		"perPage": []string{"1"},
+		"sort":    []string{"-createTime"},
+	}
+
+	if err := getLogsJSON(sc, runsPath, query, &resp); err != nil {
+		return "", err
+	}
+
+	if len(resp.Items) == 0 {
+		return "", errors.New("no run found for the resource")
+	}
+
+	return resp.Items[0].ID, nil
+}
+
+// getLogsJSON sends the request and decodes the JSON response into the given value.
+func getLogsJSON(sc *config.Config, p string, query url.Values, v any) error {
+	resp, err := doLogsRequest(sc, p, query, 30*time.Second)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, v)
+}
+
+// copyLogs sends the request and copies the response into the given writer without timeout,
+// as the logs may be large or streamed.
+func copyLogs(sc *config.Config, p string, query url.Values, w io.Writer) error {
+	resp, err := doLogsRequest(sc, p, query, 0)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = io.Copy(w, resp.Body)
+
+	return err
+}
+
+func doLogsRequest(sc *config.Config, p string, query url.Values, timeout time.Duration) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, p+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := sc.DoRequestWithTimeout(req, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = common.CheckResponseStatus(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func printLogLines(lines []logLine, numbers, timestamps bool) {
+	for _, l := range lines {
+		if numbers {
+			fmt.Printf("%d:", l.Number)
+		}
+
+		if timestamps {
+			fmt.Printf("%s ", l.Time.Format(time.RFC3339Nano))
+		}
+
+		fmt.Println(l.Text)
+	}
+}
+
+var logsExample = `
+  # Show the apply logs of the latest run of the resource
+  $ walrus logs my-resource -p my-project -e my-environment
+
+  # Follow the plan logs of the given run
+  $ walrus logs my-resource --run 1234567890 --job-type plan -f
+
+  # Show the last 100 lines with timestamps
+  $ walrus logs my-resource --tail 100 --timestamps
+
+  # Fetch 200 lines starting from line 1000
+  $ walrus logs my-resource --line 1000 --lines 200
+
+  # Fetch 4096 bytes starting from byte offset 8192
+  $ walrus logs my-resource --offset 8192 --length 4096
+
+  # Search the lines contain "error"
+  $ walrus logs my-resource --search error
+
+  # Download the logs into a file
+  $ walrus logs my-resource -o apply.log
+`
@@ -332,6 +332,10 @@ func printResourceRunChanges(resName, action string, run *model.ResourceRunOutpu
 	summary := fmt.Sprintf("resource %s %s, changes: %d created, %d updated, %d deleted.",
 		resName, actionMsg, run.ComponentChangeSummary.Created, run.ComponentChangeSummary.Updated, run.ComponentChangeSummary.Deleted)
 
+	if run.CostEstimate != nil {
+		summary = fmt.Sprintf("%s, cost: %s.", strings.TrimSuffix(summary, "."), run.CostEstimate.DeltaString())
+	}
+
 	if len(run.ComponentChanges) == 0 {
 		return nil
 	}
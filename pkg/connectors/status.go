@@ -124,6 +124,10 @@ func (in *StatusSyncer) SyncFinOpsStatus(ctx context.Context, conn *model.Connec
 }
 
 func (in *StatusSyncer) syncFinOpsData(ctx context.Context, conn model.Connector) (bool, string, error) {
+	if conn.Category == types.ConnectorCategoryCloudProvider {
+		return in.importFinOpsData(ctx, conn)
+	}
+
 	if conn.Type != types.ConnectorTypeKubernetes {
 		return false, "", nil
 	}
@@ -159,6 +163,29 @@ func (in *StatusSyncer) syncFinOpsData(ctx context.Context, conn model.Connector
 	return true, fmt.Sprintf("Last sync time %s", now.Format(time.RFC3339)), nil
 }
 
+// importFinOpsData imports the cost data of the cloud provider connector from the billing export.
+func (in *StatusSyncer) importFinOpsData(ctx context.Context, conn model.Connector) (bool, string, error) {
+	if !conn.EnableFinOps {
+		return true, "", nil
+	}
+
+	if !status.ConnectorStatusReady.IsTrue(&conn) {
+		// Skip connector isn't ready.
+		return true, "", nil
+	}
+
+	cloudSyncer := syncer.NewCloudCostSyncer(in.client, nil)
+
+	err := cloudSyncer.Sync(ctx, &conn, nil, nil)
+	if err != nil {
+		return true, "", err
+	}
+
+	now := time.Now().UTC()
+
+	return true, fmt.Sprintf("Last import time %s", now.Format(time.RFC3339)), nil
+}
+
 func (in *StatusSyncer) checkReachable(ctx context.Context, conn model.Connector) (bool, error) {
 	if !slices.Contains(
 		[]string{
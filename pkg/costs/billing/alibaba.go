@@ -0,0 +1,68 @@
+package billing
+
+import (
+	"context"
+	"strings"
+)
+
+// parseAlibabaCSV parses the Alibaba Cloud instance bill in CSV,
+// the usage window is the billing date if no usage start time and end time.
+func parseAlibabaCSV(_ context.Context, data []byte) ([]LineItem, error) {
+	var items []LineItem
+
+	err := readCSV(data, func(r row) error {
+		cost, err := parseCost(r.get("Pretax Amount", "Payable Amount", "Payment Amount"))
+		if err != nil {
+			return err
+		}
+
+		if cost == 0 {
+			return nil
+		}
+
+		st, et, err := parseWindow(
+			r.get("Usage Start Time", "Billing Date"),
+			r.get("Usage End Time"))
+		if err != nil {
+			return err
+		}
+
+		items = append(items, LineItem{
+			StartTime:  st,
+			EndTime:    et,
+			ResourceID: r.get("Instance ID", "Resource ID"),
+			Service:    r.get("Product Code", "Product", "Product Name"),
+			Cost:       cost,
+			Currency:   r.get("Currency"),
+			Tags:       alibabaTags(r.get("Instance Tag", "Tag", "Tags")),
+		})
+
+		return nil
+	})
+
+	return items, err
+}
+
+// alibabaTags parses the tag column in form of "key:<k> value:<v>; key:<k2> value:<v2>" or "<k>:<v>;<k2>:<v2>".
+func alibabaTags(s string) map[string]string {
+	tags := map[string]string{}
+
+	for _, p := range strings.Split(s, ";") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+
+		if strings.HasPrefix(p, "key:") {
+			k, v, _ := strings.Cut(p[len("key:"):], " value:")
+			tags[strings.TrimSpace(k)] = strings.TrimSpace(v)
+
+			continue
+		}
+
+		k, v, _ := strings.Cut(p, ":")
+		tags[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+
+	return tags
+}
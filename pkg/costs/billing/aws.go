@@ -0,0 +1,105 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// parseAWSCUR parses the AWS Cost and Usage Report in CSV or parquet,
+// both the legacy CUR columns (e.g. lineItem/UnblendedCost) and the CUR 2.0 columns (e.g. line_item_unblended_cost)
+// are supported.
+func parseAWSCUR(ctx context.Context, data []byte) ([]LineItem, error) {
+	var items []LineItem
+
+	fn := func(r row) error {
+		cost, err := parseCost(r.get("lineItem/UnblendedCost", "line_item_unblended_cost"))
+		if err != nil {
+			return err
+		}
+
+		if cost == 0 {
+			return nil
+		}
+
+		st, et, err := parseWindow(
+			r.get("lineItem/UsageStartDate", "line_item_usage_start_date"),
+			r.get("lineItem/UsageEndDate", "line_item_usage_end_date"))
+		if err != nil {
+			return err
+		}
+
+		tags, err := awsTags(r)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, LineItem{
+			StartTime:  st,
+			EndTime:    et,
+			ResourceID: r.get("lineItem/ResourceId", "line_item_resource_id"),
+			Service:    r.get("lineItem/ProductCode", "line_item_product_code"),
+			Cost:       cost,
+			Currency:   r.get("lineItem/CurrencyCode", "line_item_currency_code"),
+			Tags:       tags,
+		})
+
+		return nil
+	}
+
+	var err error
+	if isParquet(data) {
+		err = readParquet(ctx, data, fn)
+	} else {
+		err = readCSV(data, fn)
+	}
+
+	return items, err
+}
+
+// awsTags returns the resource tags from the resourceTags/user:<key> or resource_tags_user_<key> columns,
+// or the resource_tags column of CUR 2.0.
+func awsTags(r row) (map[string]string, error) {
+	tags := map[string]string{}
+
+	trimUser := func(k string) string {
+		for _, p := range []string{"user:", "user_"} {
+			if strings.HasPrefix(k, p) {
+				return k[len(p):]
+			}
+		}
+
+		return k
+	}
+
+	var err error
+
+	r.each(func(column, value string) {
+		if value == "" || err != nil {
+			return
+		}
+
+		lc := strings.ToLower(column)
+
+		switch {
+		case strings.HasPrefix(lc, "resourcetags/"):
+			tags[trimUser(column[len("resourceTags/"):])] = value
+		case strings.HasPrefix(lc, "resource_tags_"):
+			tags[trimUser(column[len("resource_tags_"):])] = value
+		case lc == "resource_tags":
+			var m map[string]string
+			if err = json.Unmarshal([]byte(value), &m); err != nil {
+				err = fmt.Errorf("invalid resource tags: %w", err)
+				return
+			}
+
+			for k, v := range m {
+				tags[trimUser(k)] = v
+			}
+		}
+	})
+
+	return tags, err
+}
@@ -0,0 +1,71 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// parseAzureCSV parses the Azure Cost Management export in CSV,
+// both the actual cost export (e.g. CostInBillingCurrency) and the legacy usage export (e.g. PreTaxCost)
+// are supported.
+func parseAzureCSV(_ context.Context, data []byte) ([]LineItem, error) {
+	var items []LineItem
+
+	err := readCSV(data, func(r row) error {
+		cost, err := parseCost(r.get("CostInBillingCurrency", "PreTaxCost", "Cost"))
+		if err != nil {
+			return err
+		}
+
+		if cost == 0 {
+			return nil
+		}
+
+		st, et, err := parseWindow(r.get("Date", "UsageDateTime"), "")
+		if err != nil {
+			return err
+		}
+
+		tags, err := azureTags(r.get("Tags"))
+		if err != nil {
+			return err
+		}
+
+		items = append(items, LineItem{
+			StartTime:  st,
+			EndTime:    et,
+			ResourceID: r.get("ResourceId", "InstanceId"),
+			Service:    r.get("MeterCategory", "ConsumedService"),
+			Cost:       cost,
+			Currency:   r.get("BillingCurrencyCode", "BillingCurrency", "Currency"),
+			Tags:       tags,
+		})
+
+		return nil
+	})
+
+	return items, err
+}
+
+// azureTags parses the tags column,
+// which is a JSON object or a JSON object without the braces in the legacy export.
+func azureTags(s string) (map[string]string, error) {
+	tags := map[string]string{}
+
+	if s == "" {
+		return tags, nil
+	}
+
+	if !strings.HasPrefix(s, "{") {
+		s = "{" + s + "}"
+	}
+
+	if err := json.Unmarshal([]byte(s), &tags); err != nil {
+		return nil, fmt.Errorf("invalid tags: %w", err)
+	}
+
+	return tags, nil
+}
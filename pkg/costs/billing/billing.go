@@ -0,0 +1,96 @@
+package billing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+// LineItem holds the cost of a cloud resource within a usage window, parsed from the billing export.
+type LineItem struct {
+	// StartTime is the usage start time.
+	StartTime time.Time
+	// EndTime is the usage end time.
+	EndTime time.Time
+	// ResourceID is the ID of the cloud resource, could be empty for the resource-less cost, e.g. tax or support.
+	ResourceID string
+	// Service is the cloud service of the cost, e.g. AmazonEC2.
+	Service string
+	// Cost is the cost amount.
+	Cost float64
+	// Currency is the currency code of the cost.
+	Currency string
+	// Tags is the tags of the cloud resource, with the provider prefix trimmed.
+	Tags map[string]string
+}
+
+type parseFunc func(ctx context.Context, data []byte) ([]LineItem, error)
+
+var parsers = map[string]parseFunc{
+	types.FinOpsBillingFormatAWSCUR:      parseAWSCUR,
+	types.FinOpsBillingFormatAzureCSV:    parseAzureCSV,
+	types.FinOpsBillingFormatGCPBigQuery: parseGCPBigQuery,
+	types.FinOpsBillingFormatAlibabaCSV:  parseAlibabaCSV,
+}
+
+// exportFileExts holds the file extensions of the billing export in different formats,
+// the compressed file is recognized by the extension before .gz.
+var exportFileExts = map[string][]string{
+	types.FinOpsBillingFormatAWSCUR:      {".csv", ".parquet"},
+	types.FinOpsBillingFormatAzureCSV:    {".csv"},
+	types.FinOpsBillingFormatGCPBigQuery: {".json", ".jsonl", ".ndjson"},
+	types.FinOpsBillingFormatAlibabaCSV:  {".csv"},
+}
+
+// IsSupportedFormat returns true if the given billing export format is supported.
+func IsSupportedFormat(format string) bool {
+	_, ok := parsers[format]
+	return ok
+}
+
+// IsExportFile returns true if the given file name is a billing export file of the given format.
+func IsExportFile(format, name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	if ext == ".gz" {
+		ext = strings.ToLower(path.Ext(strings.TrimSuffix(name, path.Ext(name))))
+	}
+
+	for _, e := range exportFileExts[format] {
+		if ext == e {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Parse parses the line items from the billing export file in the given format,
+// the gzip compressed data is decompressed automatically.
+func Parse(ctx context.Context, format string, data []byte) ([]LineItem, error) {
+	parse, ok := parsers[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported billing export format %q", format)
+	}
+
+	// Decompress gzip data by the magic number.
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing billing export: %w", err)
+		}
+
+		data, err = io.ReadAll(gr)
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing billing export: %w", err)
+		}
+	}
+
+	return parse(ctx, data)
+}
@@ -0,0 +1,271 @@
+package billing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+func TestParse(t *testing.T) {
+	var (
+		t0 = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		t1 = t0.Add(time.Hour)
+		d0 = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	)
+
+	testCases := []struct {
+		format   string
+		file     string
+		expected []LineItem
+	}{
+		{
+			format: types.FinOpsBillingFormatAWSCUR,
+			file:   "testdata/aws-cur.csv",
+			expected: []LineItem{
+				{
+					StartTime:  t0,
+					EndTime:    t1,
+					ResourceID: "i-0123456789",
+					Service:    "AmazonEC2",
+					Cost:       0.0416,
+					Currency:   "USD",
+					Tags: map[string]string{
+						"walrus.seal.io/project-name":     "default",
+						"walrus.seal.io/environment-name": "dev",
+						"walrus.seal.io/resource-name":    "web",
+						"Name":                            "web-server",
+					},
+				},
+				{
+					StartTime: t0,
+					EndTime:   t1,
+					Service:   "AWSSupportBusiness",
+					Cost:      1.5,
+					Currency:  "USD",
+					Tags:      map[string]string{},
+				},
+			},
+		},
+		{
+			format: types.FinOpsBillingFormatAWSCUR,
+			file:   "testdata/aws-cur2.csv",
+			expected: []LineItem{
+				{
+					StartTime:  t0,
+					EndTime:    t1,
+					ResourceID: "arn:aws:rds:us-east-1:123456789012:db:mysql",
+					Service:    "AmazonRDS",
+					Cost:       0.017,
+					Currency:   "USD",
+					Tags: map[string]string{
+						"walrus_seal_io_project_name":     "default",
+						"walrus_seal_io_environment_name": "dev",
+						"walrus_seal_io_resource_name":    "mysql",
+					},
+				},
+			},
+		},
+		{
+			format: types.FinOpsBillingFormatAzureCSV,
+			file:   "testdata/azure.csv",
+			expected: []LineItem{
+				{
+					StartTime:  d0,
+					EndTime:    d0.Add(24 * time.Hour),
+					ResourceID: "/subscriptions/xxx/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/web",
+					Service:    "Virtual Machines",
+					Cost:       2.4,
+					Currency:   "USD",
+					Tags: map[string]string{
+						"walrus.seal.io-project-name":     "default",
+						"walrus.seal.io-environment-name": "dev",
+						"walrus.seal.io-resource-name":    "web",
+					},
+				},
+				{
+					StartTime: d0,
+					EndTime:   d0.Add(24 * time.Hour),
+					Service:   "Bandwidth",
+					Cost:      0.1,
+					Currency:  "USD",
+					Tags:      map[string]string{},
+				},
+			},
+		},
+		{
+			format: types.FinOpsBillingFormatGCPBigQuery,
+			file:   "testdata/gcp.json",
+			expected: []LineItem{
+				{
+					StartTime:  t0,
+					EndTime:    t1,
+					ResourceID: "//compute.googleapis.com/projects/demo/zones/us-central1-a/instances/123",
+					Service:    "Compute Engine",
+					Cost:       1.0,
+					Currency:   "USD",
+					Tags: map[string]string{
+						"walrus_seal_io-project-name":     "default",
+						"walrus_seal_io-environment-name": "dev",
+						"walrus_seal_io-resource-name":    "web",
+					},
+				},
+			},
+		},
+		{
+			format: types.FinOpsBillingFormatAlibabaCSV,
+			file:   "testdata/alibaba.csv",
+			expected: []LineItem{
+				{
+					StartTime:  t0,
+					EndTime:    t0.Add(24 * time.Hour),
+					ResourceID: "i-bp1234567890",
+					Service:    "ecs",
+					Cost:       3.6,
+					Currency:   "CNY",
+					Tags: map[string]string{
+						"walrus.seal.io/project-name":     "default",
+						"walrus.seal.io/environment-name": "dev",
+						"walrus.seal.io/resource-name":    "web",
+					},
+				},
+				{
+					StartTime:  t0,
+					EndTime:    t0.Add(24 * time.Hour),
+					ResourceID: "walrus-bucket",
+					Service:    "oss",
+					Cost:       1024.5,
+					Currency:   "CNY",
+					Tags: map[string]string{
+						"env": "dev",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.file, func(t *testing.T) {
+			data, err := os.ReadFile(tc.file)
+			require.NoError(t, err)
+
+			actual, err := Parse(context.Background(), tc.format, data)
+			require.NoError(t, err)
+			assert.InDeltaSlice(t, costsOf(tc.expected), costsOf(actual), 1e-9)
+
+			for i := range actual {
+				actual[i].Cost = 0
+			}
+
+			for i := range tc.expected {
+				tc.expected[i].Cost = 0
+			}
+
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func costsOf(items []LineItem) []float64 {
+	r := make([]float64, len(items))
+	for i := range items {
+		r[i] = items[i].Cost
+	}
+
+	return r
+}
+
+func TestParseCompressedParquet(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "line_item_usage_start_date", Type: &arrow.TimestampType{Unit: arrow.Millisecond}},
+		{Name: "line_item_usage_end_date", Type: &arrow.TimestampType{Unit: arrow.Millisecond}},
+		{Name: "line_item_resource_id", Type: arrow.BinaryTypes.String},
+		{Name: "line_item_unblended_cost", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "resource_tags", Type: arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String)},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b.Field(0).(*array.TimestampBuilder).Append(arrow.Timestamp(t0.UnixMilli()))
+	b.Field(1).(*array.TimestampBuilder).Append(arrow.Timestamp(t0.Add(time.Hour).UnixMilli()))
+	b.Field(2).(*array.StringBuilder).Append("i-0123456789")
+	b.Field(3).(*array.Float64Builder).Append(0.5)
+
+	mb := b.Field(4).(*array.MapBuilder)
+	mb.Append(true)
+	mb.KeyBuilder().(*array.StringBuilder).Append("user_walrus_seal_io_project_name")
+	mb.ItemBuilder().(*array.StringBuilder).Append("default")
+
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	tbl := array.NewTableFromRecords(schema, []arrow.Record{rec})
+	defer tbl.Release()
+
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+	require.NoError(t, pqarrow.WriteTable(tbl, gw, 1024, nil, pqarrow.DefaultWriterProps()))
+	require.NoError(t, gw.Close())
+
+	actual, err := Parse(context.Background(), types.FinOpsBillingFormatAWSCUR, buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, []LineItem{
+		{
+			StartTime:  t0,
+			EndTime:    t0.Add(time.Hour),
+			ResourceID: "i-0123456789",
+			Cost:       0.5,
+			Tags: map[string]string{
+				"walrus_seal_io_project_name": "default",
+			},
+		},
+	}, actual)
+}
+
+func TestIsExportFile(t *testing.T) {
+	assert.True(t, IsExportFile(types.FinOpsBillingFormatAWSCUR, "cur/20240101-20240201/cur-00001.csv.gz"))
+	assert.True(t, IsExportFile(types.FinOpsBillingFormatAWSCUR, "cur/data/cur-00001.snappy.parquet"))
+	assert.False(t, IsExportFile(types.FinOpsBillingFormatAWSCUR, "cur/20240101-20240201/cur-Manifest.json"))
+	assert.True(t, IsExportFile(types.FinOpsBillingFormatGCPBigQuery, "billing/000000000000.json.gz"))
+	assert.False(t, IsExportFile(types.FinOpsBillingFormatAzureCSV, "export/part.parquet"))
+	assert.False(t, IsExportFile("unknown", "export.csv"))
+}
+
+func TestLineItemLabels(t *testing.T) {
+	item := LineItem{
+		Tags: map[string]string{
+			"walrus_seal_io_project_name":     "default",
+			"walrus.seal.io-environment-name": "dev",
+			"Walrus.Seal.IO/Resource-Name":    "web",
+			"owner":                           "ops",
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"walrus_seal_io_project_name":     "default",
+		"walrus.seal.io-environment-name": "dev",
+		"Walrus.Seal.IO/Resource-Name":    "web",
+		"owner":                           "ops",
+		types.LabelWalrusProjectName:      "default",
+		types.LabelWalrusEnvironmentName:  "dev",
+		types.LabelWalrusResourceName:     "web",
+		types.LabelWalrusEnvironmentPath:  "default/dev",
+		types.LabelWalrusResourcePath:     "default/dev/web",
+	}, item.Labels())
+}
@@ -0,0 +1,94 @@
+package billing
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// gcpRow is a row of the GCP billing export table of BigQuery.
+type gcpRow struct {
+	Service struct {
+		Description string `json:"description"`
+	} `json:"service"`
+	Resource struct {
+		Name       string `json:"name"`
+		GlobalName string `json:"global_name"`
+	} `json:"resource"`
+	Labels []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"labels"`
+	Cost     float64 `json:"cost"`
+	Currency string  `json:"currency"`
+	Credits  []struct {
+		Amount float64 `json:"amount"`
+	} `json:"credits"`
+	UsageStartTime string `json:"usage_start_time"`
+	UsageEndTime   string `json:"usage_end_time"`
+}
+
+// parseGCPBigQuery parses the GCP billing export dumped from BigQuery in newline-delimited JSON,
+// e.g. bq extract --destination_format NEWLINE_DELIMITED_JSON,
+// the cost is net of the credits.
+func parseGCPBigQuery(_ context.Context, data []byte) ([]LineItem, error) {
+	var items []LineItem
+
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for n := 1; s.Scan(); n++ {
+		line := bytes.TrimSpace(s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var r gcpRow
+		if err := json.Unmarshal(line, &r); err != nil {
+			return nil, fmt.Errorf("invalid json line %d: %w", n, err)
+		}
+
+		cost := r.Cost
+		for i := range r.Credits {
+			cost += r.Credits[i].Amount
+		}
+
+		if cost == 0 {
+			continue
+		}
+
+		st, et, err := parseWindow(r.UsageStartTime, r.UsageEndTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid json line %d: %w", n, err)
+		}
+
+		tags := make(map[string]string, len(r.Labels))
+		for i := range r.Labels {
+			tags[r.Labels[i].Key] = r.Labels[i].Value
+		}
+
+		id := r.Resource.GlobalName
+		if id == "" {
+			id = r.Resource.Name
+		}
+
+		items = append(items, LineItem{
+			StartTime:  st,
+			EndTime:    et,
+			ResourceID: id,
+			Service:    r.Service.Description,
+			Cost:       cost,
+			Currency:   r.Currency,
+			Tags:       tags,
+		})
+	}
+
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("error reading json lines: %w", err)
+	}
+
+	return items, nil
+}
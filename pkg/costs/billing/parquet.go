@@ -0,0 +1,113 @@
+package billing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// isParquet returns true if the given data starts with the parquet magic number.
+func isParquet(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("PAR1"))
+}
+
+// readParquet reads the parquet data and calls the given function with each row,
+// the value of the map column is encoded as JSON object.
+func readParquet(ctx context.Context, data []byte, fn func(r row) error) error {
+	pr, err := file.NewParquetReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error opening parquet: %w", err)
+	}
+	defer func() { _ = pr.Close() }()
+
+	fr, err := pqarrow.NewFileReader(pr, pqarrow.ArrowReadProperties{BatchSize: 1024}, memory.DefaultAllocator)
+	if err != nil {
+		return fmt.Errorf("error opening parquet: %w", err)
+	}
+
+	rr, err := fr.GetRecordReader(ctx, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error reading parquet: %w", err)
+	}
+	defer rr.Release()
+
+	var (
+		header []string
+		index  map[string]int
+		n      int
+	)
+
+	for rr.Next() {
+		rec := rr.Record()
+
+		if header == nil {
+			header = make([]string, rec.NumCols())
+			for i := range header {
+				header[i] = rec.ColumnName(i)
+			}
+
+			index = newIndex(header)
+		}
+
+		for i := 0; i < int(rec.NumRows()); i++ {
+			n++
+
+			values := make([]string, rec.NumCols())
+			for j := range values {
+				values[j] = valueString(rec.Column(j), i)
+			}
+
+			err = fn(row{header: header, index: index, values: values})
+			if err != nil {
+				return fmt.Errorf("invalid parquet row %d: %w", n, err)
+			}
+		}
+	}
+
+	if err = rr.Err(); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("error reading parquet: %w", err)
+	}
+
+	return nil
+}
+
+// valueString returns the string value at the given index of the array, blank if null.
+func valueString(arr arrow.Array, i int) string {
+	if arr.IsNull(i) {
+		return ""
+	}
+
+	switch a := arr.(type) {
+	case *array.String:
+		return a.Value(i)
+	case *array.Timestamp:
+		unit := a.DataType().(*arrow.TimestampType).Unit
+		return a.Value(i).ToTime(unit).UTC().Format(time.RFC3339)
+	case *array.Map:
+		var (
+			keys  = a.Keys()
+			items = a.Items()
+			m     = map[string]string{}
+		)
+
+		start, end := a.ValueOffsets(i)
+		for j := int(start); j < int(end); j++ {
+			m[valueString(keys, j)] = valueString(items, j)
+		}
+
+		return string(json.MustMarshal(m))
+	}
+
+	return arr.ValueStr(i)
+}
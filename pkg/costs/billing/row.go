@@ -0,0 +1,178 @@
+package billing
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// row is a record of the tabular billing export.
+type row struct {
+	// Header holds the original column names.
+	header []string
+	// Index maps the normalized column name to the position.
+	index  map[string]int
+	values []string
+}
+
+// normalizeColumn lowercases the column name and removes the spaces, underscores and hyphens,
+// so that the different naming styles of the same column can be matched,
+// e.g. "Instance ID", "InstanceId" and "instance_id".
+func normalizeColumn(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '_', '-':
+			return -1
+		}
+
+		return r
+	}, strings.ToLower(strings.TrimSpace(s)))
+}
+
+func newIndex(header []string) map[string]int {
+	index := make(map[string]int, len(header))
+
+	for i := range header {
+		k := normalizeColumn(header[i])
+		if _, ok := index[k]; !ok {
+			index[k] = i
+		}
+	}
+
+	return index
+}
+
+// get returns the first non-blank value of the given column names.
+func (r row) get(columns ...string) string {
+	for _, c := range columns {
+		i, ok := r.index[normalizeColumn(c)]
+		if !ok || i >= len(r.values) {
+			continue
+		}
+
+		if v := strings.TrimSpace(r.values[i]); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// each calls the given function with the original column name and the value of each column.
+func (r row) each(fn func(column, value string)) {
+	for i := range r.header {
+		if i < len(r.values) {
+			fn(r.header[i], r.values[i])
+		}
+	}
+}
+
+// readCSV reads the CSV data with header and calls the given function with each row.
+func readCSV(data []byte, fn func(r row) error) error {
+	// Trim the UTF-8 BOM, which is written by some exports.
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	cr := csv.NewReader(bytes.NewReader(data))
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return fmt.Errorf("error reading csv header: %w", err)
+	}
+
+	index := newIndex(header)
+
+	for n := 2; ; n++ {
+		values, err := cr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("error reading csv line %d: %w", n, err)
+		}
+
+		err = fn(row{header: header, index: index, values: values})
+		if err != nil {
+			return fmt.Errorf("invalid csv line %d: %w", n, err)
+		}
+	}
+}
+
+// timeLayouts holds the time layouts used by the billing exports.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"01/02/2006",
+	"20060102",
+}
+
+// parseTime parses the time in UTC if no zone specified.
+func parseTime(s string) (t time.Time, dateOnly bool, err error) {
+	for _, l := range timeLayouts {
+		t, err = time.Parse(l, s)
+		if err == nil {
+			return t.UTC(), !strings.Contains(l, "15"), nil
+		}
+	}
+
+	return time.Time{}, false, fmt.Errorf("invalid time %q", s)
+}
+
+// parseWindow parses the usage window from the given start and end time,
+// the window is a day if the end time is blank and the start time is a date,
+// or an hour if the end time is blank and the start time is a timestamp.
+func parseWindow(start, end string) (time.Time, time.Time, error) {
+	st, dateOnly, err := parseTime(start)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start time: %w", err)
+	}
+
+	if end == "" {
+		if dateOnly {
+			return st, st.Add(24 * time.Hour), nil
+		}
+
+		return st, st.Add(time.Hour), nil
+	}
+
+	et, _, err := parseTime(end)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end time: %w", err)
+	}
+
+	if !et.After(st) {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end time %q: not after start time", end)
+	}
+
+	return st, et, nil
+}
+
+// parseCost parses the cost amount, blank as 0.
+func parseCost(s string) (float64, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	if s == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cost %q", s)
+	}
+
+	return v, nil
+}
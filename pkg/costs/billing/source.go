@@ -0,0 +1,152 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types/property"
+	optypes "github.com/seal-io/walrus/pkg/operator/types"
+	"github.com/seal-io/walrus/pkg/storage"
+)
+
+const (
+	// BillingAccessKey is the config data key of the connector to specify the access key of the billing source.
+	BillingAccessKey = "billing_access_key"
+	// BillingSecretKey is the config data key of the connector to specify the secret key of the billing source.
+	BillingSecretKey = "billing_secret_key"
+)
+
+// Source reads the billing export files of a cloud provider connector.
+type Source struct {
+	format string
+	prefix string
+	driver storage.Driver
+}
+
+// NewSource returns the Source of the given connector's billing source.
+func NewSource(conn *model.Connector) (*Source, error) {
+	bs := conn.FinOpsBillingSource
+	if bs.IsZero() {
+		return nil, errors.New("billing source is not configured")
+	}
+
+	if !IsSupportedFormat(bs.Format) {
+		return nil, fmt.Errorf("unsupported billing export format %q", bs.Format)
+	}
+
+	u, err := url.Parse(bs.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid billing source url: %w", err)
+	}
+
+	s := &Source{
+		format: bs.Format,
+	}
+
+	switch u.Scheme {
+	case "file":
+		var fi os.FileInfo
+
+		fi, err = os.Stat(u.Path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid billing source url: %w", err)
+		}
+
+		if !fi.IsDir() {
+			return nil, errors.New("invalid billing source url: not a directory")
+		}
+
+		s.driver, err = storage.NewFilesystemDriver(u.Path)
+	case "s3":
+		if u.User != nil {
+			return nil, errors.New("invalid billing source url: credential must be configured in the config data")
+		}
+
+		bucket, prefix, _ := strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+		if bucket == "" {
+			return nil, errors.New("invalid billing source url: blank bucket")
+		}
+
+		q := u.Query()
+		ak, sk := s3Credential(conn)
+		s.prefix = prefix
+		s.driver, err = storage.NewS3Driver(&storage.Config{
+			Endpoint:        u.Host,
+			Region:          q.Get("region"),
+			Bucket:          bucket,
+			Secure:          q.Get("sslmode") != "disable",
+			AccessKeyID:     ak,
+			SecretAccessKey: sk,
+		})
+	default:
+		return nil, fmt.Errorf("invalid billing source url: unsupported scheme %q", u.Scheme)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating billing source: %w", err)
+	}
+
+	return s, nil
+}
+
+// s3Credential returns the billing source credential from the connector config data,
+// falls back to the credential of the connector.
+func s3Credential(conn *model.Connector) (ak, sk string) {
+	get := func(keys ...string) string {
+		for _, k := range keys {
+			if v, ok, _ := property.GetString(conn.ConfigData[k].Value); ok && v != "" {
+				return v
+			}
+		}
+
+		return ""
+	}
+
+	return get(BillingAccessKey, optypes.AccessKey), get(BillingSecretKey, optypes.AccessSecret)
+}
+
+// List returns the export files modified after the given time, sorted by key.
+func (s *Source) List(ctx context.Context, since time.Time) ([]storage.Object, error) {
+	objs, err := s.driver.List(ctx, s.prefix)
+	if err != nil {
+		return nil, fmt.Errorf("error listing billing export files: %w", err)
+	}
+
+	r := make([]storage.Object, 0, len(objs))
+
+	for i := range objs {
+		if !IsExportFile(s.format, objs[i].Key) || !objs[i].ModifiedAt.After(since) {
+			continue
+		}
+
+		r = append(r, objs[i])
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		return r[i].Key < r[j].Key
+	})
+
+	return r, nil
+}
+
+// Read parses the line items of the given export file.
+func (s *Source) Read(ctx context.Context, key string) ([]LineItem, error) {
+	data, _, err := s.driver.Get(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("error reading billing export file %s: %w", key, err)
+	}
+
+	items, err := Parse(ctx, s.format, data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing billing export file %s: %w", key, err)
+	}
+
+	return items, nil
+}
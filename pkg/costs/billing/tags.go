@@ -0,0 +1,57 @@
+package billing
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+// normalizeTagKey lowercases the tag key and replaces the non-alphanumeric characters with underscores,
+// which is the common subset of the tag key sanitization of the cloud providers,
+// e.g. GCP labels and AWS CUR parquet columns only allow lowercase letters, digits and underscores.
+func normalizeTagKey(k string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+
+		return '_'
+	}, k)
+}
+
+// walrusLabels maps the normalized tag key to the built-in label,
+// which is applied to the cloud resources by the Label operation.
+var walrusLabels = map[string]string{
+	normalizeTagKey(types.LabelWalrusProjectName):     types.LabelWalrusProjectName,
+	normalizeTagKey(types.LabelWalrusEnvironmentName): types.LabelWalrusEnvironmentName,
+	normalizeTagKey(types.LabelWalrusResourceName):    types.LabelWalrusResourceName,
+}
+
+// Labels returns the labels of the line item,
+// includes the tags and the built-in labels recognized from the tags.
+func (i LineItem) Labels() map[string]string {
+	labels := make(map[string]string, len(i.Tags)+5)
+
+	for k, v := range i.Tags {
+		labels[k] = v
+
+		if l, ok := walrusLabels[normalizeTagKey(k)]; ok && v != "" {
+			labels[l] = v
+		}
+	}
+
+	proj, ok1 := labels[types.LabelWalrusProjectName]
+	env, ok2 := labels[types.LabelWalrusEnvironmentName]
+	svc, ok3 := labels[types.LabelWalrusResourceName]
+
+	if ok1 && ok2 && ok3 {
+		labels[types.LabelWalrusEnvironmentPath] = fmt.Sprintf("%s/%s", proj, env)
+		labels[types.LabelWalrusResourcePath] = fmt.Sprintf("%s/%s/%s", proj, env, svc)
+	}
+
+	return labels
+}
@@ -0,0 +1,155 @@
+package chargeback
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/seal-io/walrus/pkg/costs/distributor"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+// Generator generates the chargeback statements,
+// which list the costs per project and environment within a period.
+type Generator struct {
+	client      model.ClientSet
+	distributor *distributor.Distributor
+}
+
+func New(client model.ClientSet) *Generator {
+	return &Generator{
+		client:      client,
+		distributor: distributor.New(client),
+	}
+}
+
+// Generate generates and stores the statement of the given input,
+// the statement is never changed after generated,
+// generates a new one to reflect the re-synced costs.
+func (g *Generator) Generate(
+	ctx context.Context,
+	input *model.ChargebackStatementCreateInput,
+) (*model.ChargebackStatement, error) {
+	entity := input.Model()
+
+	items, _, err := g.distributor.Distribute(ctx, entity.StartTime, entity.EndTime,
+		Condition(entity.Filters, entity.SharedOptions))
+	if err != nil {
+		return nil, fmt.Errorf("error query allocation cost: %w", err)
+	}
+
+	entity.Items = Items(items)
+	entity.Name = Name(entity.StartTime, entity.EndTime)
+
+	for i := range entity.Items {
+		entity.TotalCost += entity.Items[i].TotalCost
+	}
+
+	return g.client.ChargebackStatements().Create().
+		Set(entity).
+		SetName(entity.Name).
+		SetTotalCost(entity.TotalCost).
+		SetItems(entity.Items).
+		Save(ctx)
+}
+
+// Condition returns the query condition grouping the costs by the environment path,
+// the idle and management costs are excluded if they are distributed to the items.
+func Condition(filters types.CostFilters, shared *types.SharedCostOptions) types.QueryCondition {
+	var excludes []string
+
+	if shared != nil {
+		if shared.Idle != nil {
+			excludes = append(excludes, types.IdleCostItemName)
+		}
+
+		if shared.Management != nil {
+			excludes = append(excludes, types.ManagementCostItemName)
+		}
+	}
+
+	if len(excludes) != 0 {
+		rule := types.FilterRule{
+			FieldName: types.FilterFieldName,
+			Operator:  types.OperatorNotIn,
+			Values:    excludes,
+		}
+
+		if len(filters) == 0 {
+			filters = types.CostFilters{{rule}}
+		} else {
+			fs := make(types.CostFilters, len(filters))
+			for i := range filters {
+				fs[i] = append(slices.Clone(filters[i]), rule)
+			}
+
+			filters = fs
+		}
+	}
+
+	return types.QueryCondition{
+		Filters:       filters,
+		GroupBy:       types.GroupByFieldEnvironmentPath,
+		SharedOptions: shared,
+	}
+}
+
+// Items converts the costs grouped by the environment path into the statement items,
+// the items are sorted by the project and environment.
+func Items(costs []distributor.Resource) types.ChargebackStatementItems {
+	items := make(types.ChargebackStatementItems, 0, len(costs))
+
+	for i := range costs {
+		item := types.ChargebackStatementItem{
+			Project:          costs[i].ItemName,
+			TotalCost:        costs[i].TotalCost,
+			SharedCost:       costs[i].SharedCost,
+			CPUCost:          costs[i].CPUCost,
+			GPUCost:          costs[i].GPUCost,
+			RAMCost:          costs[i].RAMCost,
+			PVCost:           costs[i].PVCost,
+			LoadBalancerCost: costs[i].LoadBalancerCost,
+		}
+
+		// The environment path is in form of project/environment.
+		if p, e, ok := strings.Cut(costs[i].ItemName, "/"); ok {
+			item.Project, item.Environment = p, e
+		}
+
+		if item.Project == "" {
+			item.Project = types.UnallocatedItemName
+		}
+
+		items = append(items, item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Project != items[j].Project {
+			return items[i].Project < items[j].Project
+		}
+
+		return items[i].Environment < items[j].Environment
+	})
+
+	return items
+}
+
+// Name returns the name of the statement period,
+// i.e. 2024-03 for a calendar month, 2024-03-01~2024-03-15 for others.
+func Name(start, end time.Time) string {
+	if start.Day() == 1 && start.Equal(truncateDay(start)) && end.Equal(start.AddDate(0, 1, 0)) {
+		return start.Format("2006-01")
+	}
+
+	return start.Format(time.DateOnly) + "~" + end.Format(time.DateOnly)
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,329 @@
+package detector
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/costs/distributor"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/log"
+	"github.com/seal-io/walrus/utils/strs"
+)
+
+const (
+	// baselineDays is the days before the detected day to calculate the baseline.
+	baselineDays = 14
+	// minBaselineDays is the minimum days with cost within the baseline days to detect.
+	minBaselineDays = 7
+	// recheckDays is the complete days to detect in each round,
+	// as the cost of the recent days may be restated.
+	recheckDays = 3
+	// minScore is the minimum standard deviations from the baseline to flag.
+	minScore = 3
+	// minDeviationPercent is the minimum percentage deviated from the baseline to flag.
+	minDeviationPercent = 30
+	// minDeviationCost is the minimum cost deviated from the baseline to flag.
+	minDeviationCost = 1
+)
+
+// Detector detects the days where the cost of a project or namespace
+// deviates significantly from its baseline, and records them as anomalies.
+type Detector struct {
+	client      model.ClientSet
+	logger      log.Logger
+	distributor *distributor.Distributor
+}
+
+func New(client model.ClientSet, logger log.Logger) *Detector {
+	if logger == nil {
+		logger = log.WithName("cost")
+	}
+
+	return &Detector{
+		client:      client,
+		logger:      logger,
+		distributor: distributor.New(client),
+	}
+}
+
+// Detect detects the anomalies of the recent complete days before the given time.
+func (in *Detector) Detect(ctx context.Context, now time.Time) error {
+	now = now.UTC()
+
+	var (
+		end   = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		first = end.AddDate(0, 0, -recheckDays)
+		start = first.AddDate(0, 0, -baselineDays)
+	)
+
+	ss, err := in.projectSeries(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	nss, err := in.namespaceSeries(ctx, start, end)
+	if err != nil {
+		return err
+	}
+
+	ss = append(ss, nss...)
+
+	for i := range ss {
+		if err = in.apply(ctx, ss[i], first, end); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// series is the daily cost of a project or namespace.
+type series struct {
+	scope       string
+	name        string
+	connectorID object.ID
+	costs       map[time.Time]float64
+}
+
+func (s series) fingerprint() string {
+	if s.connectorID == "" {
+		return strs.Join("/", s.scope, s.name)
+	}
+
+	return strs.Join("/", s.scope, s.connectorID.String(), s.name)
+}
+
+func (in *Detector) projectSeries(ctx context.Context, start, end time.Time) ([]series, error) {
+	return in.dailySeries(ctx, start, end, types.QueryCondition{
+		GroupBy: types.GroupByFieldProject,
+	}, func(name string) series {
+		return series{
+			scope: types.CostAnomalyScopeProject,
+			name:  name,
+		}
+	})
+}
+
+func (in *Detector) namespaceSeries(ctx context.Context, start, end time.Time) ([]series, error) {
+	conns, err := in.client.Connectors().Query().
+		Where(
+			connector.Category(types.ConnectorCategoryKubernetes),
+			connector.EnableFinOps(true)).
+		Select(connector.FieldID).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing connectors: %w", err)
+	}
+
+	var r []series
+
+	for i := range conns {
+		connID := conns[i].ID
+
+		ss, err := in.dailySeries(ctx, start, end, types.QueryCondition{
+			Filters: types.CostFilters{
+				{
+					{
+						FieldName: types.FilterFieldConnectorID,
+						Operator:  types.OperatorIn,
+						Values:    []string{connID.String()},
+					},
+				},
+			},
+			GroupBy: types.GroupByFieldNamespace,
+		}, func(name string) series {
+			return series{
+				scope:       types.CostAnomalyScopeNamespace,
+				name:        name,
+				connectorID: connID,
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		r = append(r, ss...)
+	}
+
+	return r, nil
+}
+
+func (in *Detector) dailySeries(
+	ctx context.Context,
+	start, end time.Time,
+	cond types.QueryCondition,
+	newSeries func(name string) series,
+) ([]series, error) {
+	cond.Step = types.StepDay
+
+	items, _, err := in.distributor.Distribute(ctx, start, end, cond)
+	if err != nil {
+		return nil, fmt.Errorf("error query daily cost: %w", err)
+	}
+
+	var (
+		r     []series
+		index = map[string]int{}
+	)
+
+	for i := range items {
+		name := items[i].ItemName
+		if items[i].StartTime == nil ||
+			name == types.UnallocatedItemName || types.IsIdleOrManagementCost(name) {
+			continue
+		}
+
+		j, ok := index[name]
+		if !ok {
+			s := newSeries(name)
+			s.costs = map[time.Time]float64{}
+			r = append(r, s)
+			j = len(r) - 1
+			index[name] = j
+		}
+
+		st := items[i].StartTime
+		d := time.Date(st.Year(), st.Month(), st.Day(), 0, 0, 0, 0, time.UTC)
+		r[j].costs[d] += items[i].TotalCost
+	}
+
+	return r, nil
+}
+
+// apply detects the days from first to end of the given series,
+// records the anomalies and removes the ones no longer anomalous.
+func (in *Detector) apply(ctx context.Context, s series, first, end time.Time) error {
+	fp := s.fingerprint()
+
+	for d := first; d.Before(end); d = d.AddDate(0, 0, 1) {
+		cost, ok := s.costs[d]
+		if !ok {
+			// Not collected yet.
+			continue
+		}
+
+		var baseline []float64
+
+		for b := d.AddDate(0, 0, -baselineDays); b.Before(d); b = b.AddDate(0, 0, 1) {
+			if c, ok := s.costs[b]; ok {
+				baseline = append(baseline, c)
+			}
+		}
+
+		a, ok := detect(baseline, cost)
+		if !ok {
+			_, err := in.client.CostAnomalies().Delete().
+				Where(
+					costanomaly.Fingerprint(fp),
+					costanomaly.Day(d)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("error deleting cost anomaly: %w", err)
+			}
+
+			continue
+		}
+
+		create := in.client.CostAnomalies().Create().
+			SetScope(s.scope).
+			SetName(s.name).
+			SetFingerprint(fp).
+			SetDay(d).
+			SetType(a.typ).
+			SetCost(cost).
+			SetBaseline(a.baseline).
+			SetDeviationPercent(a.deviationPercent).
+			SetScore(a.score)
+
+		if s.connectorID != "" {
+			create.SetConnectorID(s.connectorID)
+		}
+
+		err := create.
+			OnConflictColumns(
+				costanomaly.FieldFingerprint,
+				costanomaly.FieldDay,
+			).
+			Update(func(up *model.CostAnomalyUpsert) {
+				// Update the restated cost.
+				up.UpdateType()
+				up.UpdateCost()
+				up.UpdateBaseline()
+				up.UpdateDeviationPercent()
+				up.UpdateScore()
+				up.UpdateUpdateTime()
+			}).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error creating cost anomaly: %w", err)
+		}
+
+		in.logger.Debugf("detected %s cost %s of %s on %s", s.scope, a.typ, s.name, d.Format(time.DateOnly))
+	}
+
+	return nil
+}
+
+// anomaly is the deviation of a day cost from the baseline.
+type anomaly struct {
+	typ              string
+	baseline         float64
+	deviationPercent float64
+	score            float64
+}
+
+// detect returns the anomaly if the given cost deviates significantly from the given baseline costs.
+func detect(baseline []float64, cost float64) (anomaly, bool) {
+	if len(baseline) < minBaselineDays {
+		return anomaly{}, false
+	}
+
+	var mean, variance float64
+
+	for _, c := range baseline {
+		mean += c
+	}
+
+	mean /= float64(len(baseline))
+
+	for _, c := range baseline {
+		variance += (c - mean) * (c - mean)
+	}
+
+	variance /= float64(len(baseline))
+
+	// Avoid flagging tiny fluctuations of a flat baseline.
+	sd := math.Max(math.Sqrt(variance), math.Max(0.05*mean, 0.01))
+
+	deviation := cost - mean
+	if math.Abs(deviation) < minDeviationCost {
+		return anomaly{}, false
+	}
+
+	a := anomaly{
+		typ:              types.CostAnomalyTypeSpike,
+		baseline:         mean,
+		deviationPercent: 100,
+		score:            deviation / sd,
+	}
+
+	if mean != 0 {
+		a.deviationPercent = deviation / mean * 100
+	}
+
+	if deviation < 0 {
+		a.typ = types.CostAnomalyTypeDrop
+	}
+
+	if math.Abs(a.deviationPercent) < minDeviationPercent || math.Abs(a.score) < minScore {
+		return anomaly{}, false
+	}
+
+	return a, true
+}
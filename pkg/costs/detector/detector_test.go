@@ -0,0 +1,97 @@
+package detector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+func TestDetect(t *testing.T) {
+	baseline := []float64{10, 11, 9, 10, 12, 10, 9, 11, 10, 10}
+
+	a, ok := detect(baseline, 30)
+	require.True(t, ok)
+	assert.Equal(t, types.CostAnomalyTypeSpike, a.typ)
+	assert.InDelta(t, 10.2, a.baseline, 1e-9)
+	assert.InDelta(t, 194.12, a.deviationPercent, 0.01)
+
+	a, ok = detect(baseline, 2)
+	require.True(t, ok)
+	assert.Equal(t, types.CostAnomalyTypeDrop, a.typ)
+
+	_, ok = detect(baseline, 12)
+	assert.False(t, ok, "within the fluctuation")
+
+	_, ok = detect(baseline[:6], 30)
+	assert.False(t, ok, "insufficient baseline")
+
+	_, ok = detect([]float64{0, 0, 0, 0, 0, 0, 0}, 0.5)
+	assert.False(t, ok, "negligible cost")
+
+	a, ok = detect([]float64{0, 0, 0, 0, 0, 0, 0}, 5)
+	require.True(t, ok)
+	assert.Equal(t, 100.0, a.deviationPercent)
+}
+
+func TestApply(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, "sqlite3", "file:costdetector?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	var (
+		end   = time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC)
+		first = end.AddDate(0, 0, -recheckDays)
+		s     = series{
+			scope: types.CostAnomalyScopeProject,
+			name:  "default",
+			costs: map[time.Time]float64{},
+		}
+	)
+
+	for d := first.AddDate(0, 0, -baselineDays); d.Before(end); d = d.AddDate(0, 0, 1) {
+		s.costs[d] = 10
+	}
+
+	s.costs[end.AddDate(0, 0, -1)] = 50
+
+	d := New(client, nil)
+
+	// Apply twice to record once.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, d.apply(ctx, s, first, end))
+	}
+
+	as, err := client.CostAnomalies().Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, as, 1)
+	assert.Equal(t, "project/default", as[0].Fingerprint)
+	assert.Equal(t, types.CostAnomalyTypeSpike, as[0].Type)
+	assert.Equal(t, 50.0, as[0].Cost)
+	assert.Equal(t, 10.0, as[0].Baseline)
+
+	// Remove the anomaly as the cost is restated.
+	s.costs[end.AddDate(0, 0, -1)] = 10
+	require.NoError(t, d.apply(ctx, s, first, end))
+
+	cnt, err := client.CostAnomalies().Query().
+		Where(costanomaly.Fingerprint("project/default")).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, cnt)
+}
@@ -249,7 +249,7 @@ func havingSQL(
 		connIDs, err := client.Connectors().Query().
 			Where(
 				connector.NameContainsFold(query),
-				connector.TypeEQ(types.ConnectorTypeKubernetes),
+				connector.CategoryIn(types.ConnectorCategoryKubernetes, types.ConnectorCategoryCloudProvider),
 			).IDs(ctx)
 		if err != nil {
 			return nil, err
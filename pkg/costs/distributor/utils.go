@@ -11,7 +11,7 @@ import (
 
 func connectorIDs(ctx context.Context, client model.ClientSet) ([]object.ID, error) {
 	return client.Connectors().Query().
-		Where(connector.Type(types.ConnectorTypeKubernetes)).
+		Where(connector.CategoryIn(types.ConnectorCategoryKubernetes, types.ConnectorCategoryCloudProvider)).
 		IDs(ctx)
 }
 
@@ -28,7 +28,7 @@ func applyItemDisplayName(
 	// Group by connector id.
 	conns, err := client.Connectors().Query().
 		Where(
-			connector.TypeEQ(types.ConnectorTypeKubernetes),
+			connector.CategoryIn(types.ConnectorCategoryKubernetes, types.ConnectorCategoryCloudProvider),
 		).
 		Select(
 			connector.FieldID,
@@ -0,0 +1,108 @@
+package evaluator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+// forecastMinElapsed is the minimum elapsed duration of the period to forecast,
+// the run rate of the first hours is too volatile to project.
+const forecastMinElapsed = 24 * time.Hour
+
+// Period returns the period of the given budget at the given time,
+// returns false if the budget is not started yet.
+func Period(b *model.Budget, now time.Time) (start, end time.Time, ok bool) {
+	switch b.Period {
+	case types.BudgetPeriodMonthly:
+		now = now.UTC()
+		start = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		end = start.AddDate(0, 1, 0)
+
+		return start, end, true
+	case types.BudgetPeriodCustom:
+		if b.StartTime == nil || b.EndTime == nil || now.Before(*b.StartTime) {
+			return
+		}
+
+		return *b.StartTime, *b.EndTime, true
+	}
+
+	return
+}
+
+// Forecast projects the cost at the end of the period by the run rate of the elapsed duration.
+func Forecast(actual float64, start, end, now time.Time) float64 {
+	if !now.Before(end) {
+		return actual
+	}
+
+	elapsed := now.Sub(start)
+	if elapsed < forecastMinElapsed {
+		return actual
+	}
+
+	return actual * float64(end.Sub(start)) / float64(elapsed)
+}
+
+// Breaches returns the thresholds breached by the given spend.
+func Breaches(thresholds types.BudgetThresholds, amount float64, spend *types.BudgetSpend) types.BudgetThresholds {
+	var r types.BudgetThresholds
+
+	for _, t := range thresholds {
+		cost := spend.ActualCost
+		if t.Type == types.BudgetThresholdTypeForecast {
+			cost = spend.ForecastCost
+		}
+
+		if cost > 0 && cost >= amount*t.Percent/100 {
+			r = append(r, t)
+		}
+	}
+
+	return r
+}
+
+// ScopeFilters returns the cost filters of the given budget,
+// which narrows down the filters of the budget to the project or environment,
+// the budget must be loaded with the project and environment edges.
+func ScopeFilters(b *model.Budget) (types.CostFilters, error) {
+	proj := b.Edges.Project
+	if proj == nil {
+		return nil, errors.New("project of the budget is not loaded")
+	}
+
+	scope := []types.FilterRule{
+		{
+			FieldName: types.FilterFieldProject,
+			Operator:  types.OperatorIn,
+			Values:    []string{proj.Name},
+		},
+	}
+
+	if b.EnvironmentID != "" {
+		env := b.Edges.Environment
+		if env == nil {
+			return nil, errors.New("environment of the budget is not loaded")
+		}
+
+		scope = append(scope, types.FilterRule{
+			FieldName: types.FilterFieldEnvironmentPath,
+			Operator:  types.OperatorIn,
+			Values:    []string{proj.Name + "/" + env.Name},
+		})
+	}
+
+	if len(b.Filters) == 0 {
+		return types.CostFilters{scope}, nil
+	}
+
+	r := make(types.CostFilters, len(b.Filters))
+	for i := range b.Filters {
+		r[i] = append(append([]types.FilterRule{}, scope...), b.Filters[i]...)
+	}
+
+	return r, nil
+}
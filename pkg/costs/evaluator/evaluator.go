@@ -0,0 +1,168 @@
+package evaluator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/costs/distributor"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/costreport"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+// Evaluator evaluates the spend of the budgets,
+// and records the events of the breached thresholds.
+type Evaluator struct {
+	client model.ClientSet
+	logger log.Logger
+}
+
+func New(client model.ClientSet, logger log.Logger) *Evaluator {
+	if logger == nil {
+		logger = log.WithName("cost")
+	}
+
+	return &Evaluator{
+		client: client,
+		logger: logger,
+	}
+}
+
+// EvaluateAll evaluates all budgets at the given time.
+func (in *Evaluator) EvaluateAll(ctx context.Context, now time.Time) error {
+	bs, err := in.client.Budgets().Query().
+		WithProject(func(pq *model.ProjectQuery) {
+			pq.Select(project.FieldName)
+		}).
+		WithEnvironment(func(eq *model.EnvironmentQuery) {
+			eq.Select(environment.FieldName)
+		}).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing budgets: %w", err)
+	}
+
+	for i := range bs {
+		if err = in.Evaluate(ctx, bs[i], now); err != nil {
+			// Continue with the other budgets.
+			in.logger.Errorf("error evaluating budget %s: %v", bs[i].ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Evaluate evaluates the given budget at the given time,
+// the budget must be loaded with the project and environment edges.
+func (in *Evaluator) Evaluate(ctx context.Context, b *model.Budget, now time.Time) error {
+	start, end, ok := Period(b, now)
+	if !ok {
+		return nil
+	}
+
+	actual, err := in.actualCost(ctx, b, start, end)
+	if err != nil {
+		return err
+	}
+
+	spend := &types.BudgetSpend{
+		PeriodStart:  start,
+		PeriodEnd:    end,
+		ActualCost:   actual,
+		ForecastCost: Forecast(actual, start, end, now),
+		EvaluateTime: now,
+	}
+
+	for _, t := range Breaches(b.Thresholds, b.Amount, spend) {
+		if err = in.createEvent(ctx, b, spend, t); err != nil {
+			return err
+		}
+	}
+
+	return in.client.Budgets().UpdateOne(b).
+		SetSpend(spend).
+		Exec(ctx)
+}
+
+// actualCost returns the cost within the given period of the budget scope.
+func (in *Evaluator) actualCost(ctx context.Context, b *model.Budget, start, end time.Time) (float64, error) {
+	filters, err := ScopeFilters(b)
+	if err != nil {
+		return 0, err
+	}
+
+	ps := []*sql.Predicate{
+		sql.GTE(costreport.FieldStartTime, start),
+		sql.LTE(costreport.FieldEndTime, end),
+	}
+
+	if p := distributor.FilterToSQLPredicates(filters); p != nil {
+		ps = append(ps, p)
+	}
+
+	cost, err := in.client.CostReports().Query().
+		Modify(func(s *sql.Selector) {
+			s.Where(
+				sql.And(ps...),
+			).SelectExpr(
+				sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString(fmt.Sprintf(`COALESCE(SUM(%s),0)`, costreport.FieldTotalCost))
+				}),
+			)
+		}).
+		Float64(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error summing cost: %w", err)
+	}
+
+	return cost, nil
+}
+
+func (in *Evaluator) createEvent(
+	ctx context.Context,
+	b *model.Budget,
+	spend *types.BudgetSpend,
+	t types.BudgetThreshold,
+) error {
+	cost := spend.ActualCost
+	if t.Type == types.BudgetThresholdTypeForecast {
+		cost = spend.ForecastCost
+	}
+
+	create := in.client.BudgetEvents().Create().
+		SetProjectID(b.ProjectID).
+		SetBudgetID(b.ID).
+		SetName(b.Name).
+		SetPeriodStart(spend.PeriodStart).
+		SetPeriodEnd(spend.PeriodEnd).
+		SetThresholdType(t.Type).
+		SetThresholdPercent(t.Percent).
+		SetAmount(b.Amount).
+		SetCost(cost).
+		SetMessage(fmt.Sprintf("%s cost %.2f exceeds %g%% of budget %s amount %.2f",
+			t.Type, cost, t.Percent, b.Name, b.Amount))
+
+	if b.EnvironmentID != "" {
+		create.SetEnvironmentID(b.EnvironmentID)
+	}
+
+	err := create.Exec(ctx)
+	if err != nil {
+		// Alerted in the period already.
+		if model.IsConstraintError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("error creating budget event: %w", err)
+	}
+
+	in.logger.Infof("budget %s breaches %s threshold %g%%", b.ID, t.Type, t.Percent)
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package evaluator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+func TestPeriod(t *testing.T) {
+	var (
+		now   = time.Date(2024, 2, 10, 8, 0, 0, 0, time.UTC)
+		start = time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC)
+		end   = time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	)
+
+	s, e, ok := Period(&model.Budget{Period: types.BudgetPeriodMonthly}, now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), s)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), e)
+
+	s, e, ok = Period(&model.Budget{Period: types.BudgetPeriodCustom, StartTime: &start, EndTime: &end}, now)
+	assert.True(t, ok)
+	assert.Equal(t, start, s)
+	assert.Equal(t, end, e)
+
+	_, _, ok = Period(&model.Budget{Period: types.BudgetPeriodCustom, StartTime: &end, EndTime: &end}, now)
+	assert.False(t, ok, "not started")
+}
+
+func TestForecast(t *testing.T) {
+	var (
+		start = time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+		end   = start.AddDate(0, 1, 0)
+	)
+
+	assert.Equal(t, 30.0, Forecast(10, start, end, start.AddDate(0, 0, 10)))
+	assert.Equal(t, 10.0, Forecast(10, start, end, start.Add(time.Hour)), "too early to forecast")
+	assert.Equal(t, 10.0, Forecast(10, start, end, end), "period ended")
+}
+
+func TestBreaches(t *testing.T) {
+	spend := &types.BudgetSpend{
+		ActualCost:   85,
+		ForecastCost: 120,
+	}
+
+	assert.Equal(t, types.BudgetThresholds{
+		{Type: types.BudgetThresholdTypeActual, Percent: 50},
+		{Type: types.BudgetThresholdTypeActual, Percent: 80},
+		{Type: types.BudgetThresholdTypeForecast, Percent: 100},
+	}, Breaches(types.DefaultBudgetThresholds(), 100, spend))
+}
+
+func TestEvaluate(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, "sqlite3", "file:budgetevaluator?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	proj, err := client.Projects().Create().
+		SetName("default").
+		Save(ctx)
+	require.NoError(t, err)
+
+	env, err := client.Environments().Create().
+		SetProjectID(proj.ID).
+		SetName("dev").
+		SetType(types.EnvironmentDevelopment).
+		Save(ctx)
+	require.NoError(t, err)
+
+	conn, err := client.Connectors().Create().
+		SetName("aws").
+		SetApplicableEnvironmentType(types.EnvironmentDevelopment).
+		SetType(types.ConnectorTypeAWS).
+		SetCategory(types.ConnectorCategoryCloudProvider).
+		SetConfigVersion("v1").
+		SetEnableFinOps(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	t0 := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, c := range []struct {
+		env  string
+		cost float64
+	}{
+		{env: "dev", cost: 60},
+		{env: "dev", cost: 25},
+		{env: "prod", cost: 1000},
+	} {
+		err = client.CostReports().Create().
+			SetConnectorID(conn.ID).
+			SetStartTime(t0.AddDate(0, 0, i)).
+			SetEndTime(t0.AddDate(0, 0, i+1)).
+			SetMinutes(24 * 60).
+			SetName(c.env + "-web").
+			SetFingerprint(c.env + "-web").
+			SetLabels(map[string]string{
+				types.LabelWalrusProjectName:     "default",
+				types.LabelWalrusEnvironmentPath: "default/" + c.env,
+			}).
+			SetTotalCost(c.cost).
+			Exec(ctx)
+		require.NoError(t, err)
+	}
+
+	b, err := client.Budgets().Create().
+		SetProjectID(proj.ID).
+		SetEnvironmentID(env.ID).
+		SetName("dev").
+		SetAmount(100).
+		Save(ctx)
+	require.NoError(t, err)
+
+	e := New(client, nil)
+	now := t0.AddDate(0, 0, 10)
+
+	// Evaluate twice to alert once.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, e.EvaluateAll(ctx, now))
+	}
+
+	b, err = client.Budgets().Get(ctx, b.ID)
+	require.NoError(t, err)
+	require.NotNil(t, b.Spend)
+	assert.Equal(t, 85.0, b.Spend.ActualCost)
+	assert.Equal(t, 255.0, b.Spend.ForecastCost)
+
+	evs, err := client.BudgetEvents().Query().
+		Where(budgetevent.BudgetID(b.ID)).
+		Order(model.Asc(budgetevent.FieldThresholdType), model.Asc(budgetevent.FieldThresholdPercent)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, evs, 3)
+	assert.Equal(t, types.BudgetThresholdTypeActual, evs[0].ThresholdType)
+	assert.Equal(t, 50.0, evs[0].ThresholdPercent)
+	assert.Equal(t, 80.0, evs[1].ThresholdPercent)
+	assert.Equal(t, types.BudgetThresholdTypeForecast, evs[2].ThresholdType)
+	assert.Equal(t, env.ID, evs[2].EnvironmentID)
+}
@@ -0,0 +1,169 @@
+package forecaster
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/costs/distributor"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+// DefaultHistoryDays is the default days of the cost history to fit the forecast.
+const DefaultHistoryDays = 28
+
+// Forecast is the projected spend of a group-by item.
+type Forecast struct {
+	// ItemName is the value of the group-by key.
+	ItemName string `json:"itemName"`
+	// ActualCost is the cost spent from the start of the period to today.
+	ActualCost float64 `json:"actualCost"`
+	// ForecastCost is the projected cost at the end of the period, including the actual cost.
+	ForecastCost float64 `json:"forecastCost"`
+	// Daily holds the projected daily cost of the remaining days.
+	Daily []DailyCost `json:"daily,omitempty"`
+}
+
+// DailyCost is the cost of a day.
+type DailyCost struct {
+	Date string  `json:"date"`
+	Cost float64 `json:"cost"`
+}
+
+// Forecaster projects the end-of-period spend per group-by key,
+// by the linear regression with weekly seasonality over the daily cost history.
+type Forecaster struct {
+	distributor *distributor.Distributor
+}
+
+func New(client model.ClientSet) *Forecaster {
+	return &Forecaster{
+		distributor: distributor.New(client),
+	}
+}
+
+// Forecast projects the spend of the period from startTime to endTime at the given time,
+// by the daily cost of the given history days before the given time,
+// the days are bucketed in the location of startTime.
+func (f *Forecaster) Forecast(
+	ctx context.Context,
+	startTime, endTime, now time.Time,
+	historyDays int,
+	cond types.QueryCondition,
+) ([]Forecast, error) {
+	if historyDays <= 0 {
+		historyDays = DefaultHistoryDays
+	}
+
+	loc := startTime.Location()
+
+	// Fit with the complete days only.
+	today := truncateDay(now.In(loc))
+	if today.After(endTime) {
+		today = endTime
+	}
+
+	histStart := today.AddDate(0, 0, -historyDays)
+	if startTime.Before(histStart) {
+		histStart = truncateDay(startTime)
+	}
+
+	cond.Step = types.StepDay
+	cond.Paging = types.QueryPagination{}
+
+	items, _, err := f.distributor.Distribute(ctx, histStart, today, cond)
+	if err != nil {
+		return nil, fmt.Errorf("error query daily cost: %w", err)
+	}
+
+	var (
+		histDays  = dayIndex(histStart, today)
+		startDay  = dayIndex(histStart, truncateDay(startTime.In(loc)))
+		remaining = int(math.Ceil(endTime.Sub(today).Hours() / 24))
+		series    = map[string][]float64{}
+	)
+
+	for i := range items {
+		if items[i].StartTime == nil {
+			continue
+		}
+
+		// The day bucket is truncated in the location,
+		// but scanned without the location.
+		st := items[i].StartTime
+		d := dayIndex(histStart, time.Date(st.Year(), st.Month(), st.Day(), 0, 0, 0, 0, loc))
+
+		if d < 0 || d >= histDays {
+			continue
+		}
+
+		s, ok := series[items[i].ItemName]
+		if !ok {
+			s = make([]float64, histDays)
+			series[items[i].ItemName] = s
+		}
+
+		s[d] += items[i].TotalCost
+	}
+
+	r := make([]Forecast, 0, len(series))
+
+	for name, s := range series {
+		fc := Forecast{
+			ItemName: name,
+		}
+
+		for d := max(startDay, 0); d < histDays; d++ {
+			fc.ActualCost += s[d]
+		}
+
+		fc.ForecastCost = fc.ActualCost
+
+		// Fit from the first day with cost,
+		// as the item may be created within the history.
+		first := 0
+		for first < histDays && s[first] == 0 {
+			first++
+		}
+
+		reg := fit(s[first:])
+
+		for j := 0; j < remaining; j++ {
+			// Skip the days before the period.
+			if histDays+j < startDay {
+				continue
+			}
+
+			c := reg.predict(histDays - first + j)
+			fc.ForecastCost += c
+			fc.Daily = append(fc.Daily, DailyCost{
+				Date: today.AddDate(0, 0, j).Format(time.DateOnly),
+				Cost: c,
+			})
+		}
+
+		r = append(r, fc)
+	}
+
+	sort.Slice(r, func(i, j int) bool {
+		if r[i].ForecastCost != r[j].ForecastCost {
+			return r[i].ForecastCost > r[j].ForecastCost
+		}
+
+		return r[i].ItemName < r[j].ItemName
+	})
+
+	return r, nil
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// dayIndex returns the days from the given base day to the given day.
+func dayIndex(base, t time.Time) int {
+	return int(math.Round(t.Sub(base).Hours() / 24))
+}
@@ -0,0 +1,94 @@
+package forecaster
+
+import "math"
+
+// seasonLength is the length of the weekly seasonality in days.
+const seasonLength = 7
+
+// regression is the linear trend with weekly seasonality fitted from a daily cost series,
+// y(i) = intercept + slope * i + seasonal[i % 7].
+type regression struct {
+	intercept float64
+	slope     float64
+	seasonal  [seasonLength]float64
+}
+
+// fit fits the regression from the given daily cost series,
+// the seasonality is fitted only if the series covers two weeks at least.
+func fit(ys []float64) regression {
+	var r regression
+
+	n := len(ys)
+	switch n {
+	case 0:
+		return r
+	case 1:
+		r.intercept = ys[0]
+		return r
+	}
+
+	if n < 2*seasonLength {
+		// Fit the trend by ordinary least squares.
+		var sx, sy, sxy, sxx float64
+
+		for i, y := range ys {
+			x := float64(i)
+			sx += x
+			sy += y
+			sxy += x * y
+			sxx += x * x
+		}
+
+		fn := float64(n)
+		r.slope = (fn*sxy - sx*sy) / (fn*sxx - sx*sx)
+		r.intercept = (sy - r.slope*sx) / fn
+
+		return r
+	}
+
+	// Fit the trend and the seasonality jointly by ordinary least squares with weekday dummies,
+	// the slope is estimated within each weekday, and the intercept of each weekday is the level.
+	var (
+		sx, sy [seasonLength]float64
+		cnt    [seasonLength]float64
+	)
+
+	for i, y := range ys {
+		k := i % seasonLength
+		sx[k] += float64(i)
+		sy[k] += y
+		cnt[k]++
+	}
+
+	var sxy, sxx float64
+
+	for i, y := range ys {
+		k := i % seasonLength
+		dx := float64(i) - sx[k]/cnt[k]
+		sxy += dx * (y - sy[k]/cnt[k])
+		sxx += dx * dx
+	}
+
+	r.slope = sxy / sxx
+
+	for k := range r.seasonal {
+		r.seasonal[k] = (sy[k] - r.slope*sx[k]) / cnt[k]
+		r.intercept += r.seasonal[k]
+	}
+
+	// Center the seasonality.
+	r.intercept /= seasonLength
+
+	for k := range r.seasonal {
+		r.seasonal[k] -= r.intercept
+	}
+
+	return r
+}
+
+// predict returns the cost of the i-th day of the series, never negative.
+func (r regression) predict(i int) float64 {
+	y := r.intercept + r.slope*float64(i) + r.seasonal[i%seasonLength]
+
+	return math.Max(y, 0)
+}
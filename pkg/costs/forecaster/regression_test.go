@@ -0,0 +1,64 @@
+package forecaster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFit(t *testing.T) {
+	// Weekday costs are higher than weekend costs.
+	weekly := []float64{2, 2, 2, 2, 2, -5, -5}
+
+	testCases := []struct {
+		name     string
+		series   func(i int) float64
+		days     int
+		expected func(i int) float64
+	}{
+		{
+			name:     "constant",
+			series:   func(int) float64 { return 10 },
+			days:     5,
+			expected: func(int) float64 { return 10 },
+		},
+		{
+			name:     "linear trend without enough days for seasonality",
+			series:   func(i int) float64 { return 10 + float64(i) },
+			days:     10,
+			expected: func(i int) float64 { return 10 + float64(i) },
+		},
+		{
+			name:     "linear trend with weekly seasonality",
+			series:   func(i int) float64 { return 100 + 0.5*float64(i) + weekly[i%7] },
+			days:     28,
+			expected: func(i int) float64 { return 100 + 0.5*float64(i) + weekly[i%7] },
+		},
+		{
+			name:     "declining trend never negative",
+			series:   func(i int) float64 { return 10 - float64(i) },
+			days:     8,
+			expected: func(i int) float64 { return max(10-float64(i), 0) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ys := make([]float64, tc.days)
+			for i := range ys {
+				ys[i] = tc.series(i)
+			}
+
+			r := fit(ys)
+
+			for i := tc.days; i < tc.days+14; i++ {
+				assert.InDelta(t, tc.expected(i), r.predict(i), 1e-6, "day %d", i)
+			}
+		})
+	}
+}
+
+func TestFitEmpty(t *testing.T) {
+	assert.Equal(t, 0.0, fit(nil).predict(3))
+	assert.Equal(t, 5.0, fit([]float64{5}).predict(3))
+}
@@ -0,0 +1,262 @@
+package pricing
+
+import (
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/seal-io/walrus/utils/json"
+	"github.com/seal-io/walrus/utils/vars"
+)
+
+// CatalogConfig holds the config of the pricing catalog,
+// the builtin catalog is used if not configured.
+var CatalogConfig = vars.SetOnce[*Catalog]{}
+
+// Get returns the configured pricing catalog.
+func Get() *Catalog {
+	if c := CatalogConfig.Get(); c != nil {
+		return c
+	}
+
+	return Builtin()
+}
+
+//go:embed prices.csv
+var builtinPrices []byte
+
+var builtin = sync.OnceValue(func() *Catalog {
+	c, err := Load(bytes.NewReader(builtinPrices))
+	if err != nil {
+		panic(fmt.Errorf("error loading builtin prices: %w", err))
+	}
+
+	return c
+})
+
+// Builtin returns the catalog holding the on-demand prices of the common products.
+func Builtin() *Catalog {
+	return builtin()
+}
+
+// Product is a priced product of the catalog.
+type Product struct {
+	VendorName    string
+	Region        string
+	Service       string
+	ProductFamily string
+	Attributes    map[string]string
+	Prices        []Price
+}
+
+// Price is the unit price of a product.
+type Price struct {
+	USD              float64
+	Unit             string
+	PurchaseOption   string
+	StartUsageAmount float64
+}
+
+// Filter filters the product and the price to look up.
+type Filter struct {
+	VendorName    string
+	Region        string
+	Service       string
+	ProductFamily string
+	// Attributes are the attributes the product must equal to.
+	Attributes map[string]string
+	// PurchaseOption is the purchase option of the price, i.e. on_demand.
+	PurchaseOption string
+}
+
+func (f Filter) key() string {
+	return strings.Join([]string{f.VendorName, f.Service, f.ProductFamily, f.Region}, "/")
+}
+
+// Catalog holds the products indexed by the vendor, service, product family and region.
+type Catalog struct {
+	products map[string][]*Product
+}
+
+// Lookup returns the first tier price of the product matched the given filter.
+func (c *Catalog) Lookup(f Filter) (Price, bool) {
+	if c == nil {
+		return Price{}, false
+	}
+
+	for _, p := range c.products[f.key()] {
+		if !matchAttributes(p.Attributes, f.Attributes) {
+			continue
+		}
+
+		for _, pr := range p.Prices {
+			if f.PurchaseOption != "" && pr.PurchaseOption != f.PurchaseOption {
+				continue
+			}
+
+			if pr.StartUsageAmount != 0 {
+				continue
+			}
+
+			return pr, true
+		}
+	}
+
+	return Price{}, false
+}
+
+func matchAttributes(attrs, expected map[string]string) bool {
+	for k, v := range expected {
+		if !strings.EqualFold(attrs[k], v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LoadFile loads the catalog from the given file,
+// the file is decompressed if it has the .gz suffix.
+func LoadFile(path string) (*Catalog, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+
+	if strings.HasSuffix(path, ".gz") {
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gr.Close()
+
+		r = gr
+	}
+
+	return Load(r)
+}
+
+// Load loads the catalog from the given reader of the Infracost price data dump,
+// which is a CSV with the columns of productHash, sku, vendorName, region, service, productFamily,
+// attributes in JSON object and prices in JSON object of price hash to price list.
+func Load(r io.Reader) (*Catalog, error) {
+	cr := csv.NewReader(r)
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading header: %w", err)
+	}
+
+	idx := map[string]int{}
+	for i := range header {
+		idx[header[i]] = i
+	}
+
+	for _, n := range []string{"vendorName", "region", "service", "productFamily", "attributes", "prices"} {
+		if _, ok := idx[n]; !ok {
+			return nil, fmt.Errorf("invalid header: missing column %s", n)
+		}
+	}
+
+	c := &Catalog{
+		products: map[string][]*Product{},
+	}
+
+	for {
+		rec, err := cr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("error reading record: %w", err)
+		}
+
+		p := &Product{
+			VendorName:    rec[idx["vendorName"]],
+			Region:        rec[idx["region"]],
+			Service:       rec[idx["service"]],
+			ProductFamily: rec[idx["productFamily"]],
+		}
+
+		if err = json.Unmarshal([]byte(rec[idx["attributes"]]), &p.Attributes); err != nil {
+			return nil, fmt.Errorf("error decoding attributes: %w", err)
+		}
+
+		p.Prices, err = decodePrices(rec[idx["prices"]])
+		if err != nil {
+			return nil, err
+		}
+
+		k := Filter{
+			VendorName:    p.VendorName,
+			Region:        p.Region,
+			Service:       p.Service,
+			ProductFamily: p.ProductFamily,
+		}.key()
+		c.products[k] = append(c.products[k], p)
+	}
+
+	return c, nil
+}
+
+func decodePrices(s string) ([]Price, error) {
+	var raw map[string][]struct {
+		USD              string `json:"USD"`
+		Unit             string `json:"unit"`
+		PurchaseOption   string `json:"purchaseOption"`
+		StartUsageAmount string `json:"startUsageAmount"`
+	}
+
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("error decoding prices: %w", err)
+	}
+
+	// Keep the order stable.
+	hs := maps.Keys(raw)
+	sort.Strings(hs)
+
+	var r []Price
+
+	for _, h := range hs {
+		for _, p := range raw[h] {
+			usd, err := strconv.ParseFloat(p.USD, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing price %q: %w", p.USD, err)
+			}
+
+			var start float64
+
+			if p.StartUsageAmount != "" {
+				start, err = strconv.ParseFloat(p.StartUsageAmount, 64)
+				if err != nil {
+					return nil, fmt.Errorf("error parsing start usage amount %q: %w", p.StartUsageAmount, err)
+				}
+			}
+
+			r = append(r, Price{
+				USD:              usd,
+				Unit:             p.Unit,
+				PurchaseOption:   p.PurchaseOption,
+				StartUsageAmount: start,
+			})
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,53 @@
+package pricing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	data := `productHash,sku,vendorName,region,service,productFamily,attributes,prices
+h1,s1,aws,us-east-1,AmazonEC2,Storage,"{""volumeApiName"":""gp3""}","{""p2"":[{""USD"":""0.06"",""unit"":""GB-Mo"",""purchaseOption"":""reserved""}],""p1"":[{""USD"":""0.08"",""unit"":""GB-Mo"",""purchaseOption"":""on_demand"",""startUsageAmount"":""0""}]}"
+`
+
+	c, err := Load(strings.NewReader(data))
+	require.NoError(t, err)
+
+	f := Filter{
+		VendorName:    "aws",
+		Region:        "us-east-1",
+		Service:       "AmazonEC2",
+		ProductFamily: "Storage",
+		Attributes: map[string]string{
+			"volumeApiName": "GP3",
+		},
+		PurchaseOption: "on_demand",
+	}
+
+	p, ok := c.Lookup(f)
+	require.True(t, ok)
+	assert.Equal(t, Price{USD: 0.08, Unit: "GB-Mo", PurchaseOption: "on_demand"}, p)
+
+	f.Region = "us-west-2"
+	_, ok = c.Lookup(f)
+	assert.False(t, ok)
+
+	_, err = Load(strings.NewReader("vendorName,region\n"))
+	assert.Error(t, err)
+}
+
+func TestBuiltin(t *testing.T) {
+	_, ok := Builtin().Lookup(Filter{
+		VendorName:    "aws",
+		Region:        "us-east-1",
+		Service:       "AmazonEC2",
+		ProductFamily: "Compute Instance",
+		Attributes: map[string]string{
+			"instanceType": "t3.micro",
+		},
+	})
+	assert.True(t, ok)
+}
@@ -0,0 +1,241 @@
+package syncer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/costs/billing"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/costreport"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+// restatementWindow is the duration to re-import before the latest imported cost,
+// as the cloud providers keep updating the billing export until the cost is finalized.
+const restatementWindow = 72 * time.Hour
+
+// CloudCostSyncer imports the cost of the cloud provider connector from the billing export files.
+type CloudCostSyncer struct {
+	client model.ClientSet
+	logger log.Logger
+}
+
+func NewCloudCostSyncer(client model.ClientSet, logger log.Logger) *CloudCostSyncer {
+	if logger == nil {
+		logger = log.WithName("cost")
+	}
+
+	return &CloudCostSyncer{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (in *CloudCostSyncer) SetLogger(logger log.Logger) {
+	in.logger = logger
+}
+
+// Sync imports the cost within the given time range,
+// or since the restatement window before the latest imported cost if the time range is not specified.
+func (in *CloudCostSyncer) Sync(ctx context.Context, conn *model.Connector, startTime, endTime *time.Time) error {
+	in.logger.Debugf("import cost for connector: %s", conn.Name)
+
+	src, err := billing.NewSource(conn)
+	if err != nil {
+		return err
+	}
+
+	startTime, endTime, err = in.timeRange(ctx, conn, startTime, endTime)
+	if err != nil {
+		return err
+	}
+
+	// The export file not modified since the start time doesn't contain the cost after that.
+	var since time.Time
+	if startTime != nil {
+		since = *startTime
+	}
+
+	objs, err := src.List(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	// Aggregate the line items of all files,
+	// as the cost of a resource may be split into multiple files of the same period.
+	agg := newCostAggregator(conn)
+
+	for i := range objs {
+		items, err := src.Read(ctx, objs[i].Key)
+		if err != nil {
+			return err
+		}
+
+		for j := range items {
+			if startTime != nil && !items[j].EndTime.After(*startTime) ||
+				endTime != nil && items[j].StartTime.After(*endTime) {
+				continue
+			}
+
+			agg.add(items[j])
+		}
+
+		in.logger.Debugf("connector: %s, read %d line items from %s", conn.Name, len(items), objs[i].Key)
+	}
+
+	costs := agg.costs()
+	if len(costs) == 0 {
+		return nil
+	}
+
+	if err = in.batchUpsertCostReports(ctx, costs); err != nil {
+		return fmt.Errorf("error creating item costs: %w", err)
+	}
+
+	in.logger.Debugf("connector: %s, imported %d records from %d files", conn.Name, len(costs), len(objs))
+
+	return nil
+}
+
+func (in *CloudCostSyncer) batchUpsertCostReports(ctx context.Context, costs []*model.CostReport) error {
+	batchUpsert := func(ctx context.Context, cs []*model.CostReport) error {
+		return in.client.CostReports().CreateBulk().
+			Set(cs...).
+			OnConflictColumns(
+				costreport.FieldStartTime,
+				costreport.FieldEndTime,
+				costreport.FieldConnectorID,
+				costreport.FieldFingerprint,
+			).
+			Update(func(up *model.CostReportUpsert) {
+				// Update the restated cost.
+				up.UpdateTotalCost()
+			}).
+			Exec(ctx)
+	}
+
+	batchSize := 1000
+	totalCosts := len(costs)
+
+	for i := 0; i < totalCosts; i += batchSize {
+		end := i + batchSize
+		if end > totalCosts {
+			end = totalCosts
+		}
+
+		err := batchUpsert(ctx, costs[i:end])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (in *CloudCostSyncer) timeRange(
+	ctx context.Context,
+	conn *model.Connector,
+	startTime,
+	endTime *time.Time,
+) (*time.Time, *time.Time, error) {
+	// Time range existed.
+	if startTime != nil && endTime != nil {
+		return startTime, endTime, nil
+	}
+
+	existed, err := in.client.CostReports().Query().
+		Where(costreport.ConnectorID(conn.ID)).
+		Order(model.Desc(costreport.FieldEndTime)).
+		First(ctx)
+	if err != nil {
+		if model.IsNotFound(err) {
+			// Import all.
+			return nil, nil, nil
+		}
+
+		return nil, nil, err
+	}
+
+	s := existed.EndTime.Add(-restatementWindow)
+
+	return &s, nil, nil
+}
+
+// costAggregator sums up the line items of the same resource within the same usage window.
+type costAggregator struct {
+	conn  *model.Connector
+	index map[costKey]*model.CostReport
+}
+
+type costKey struct {
+	startTime time.Time
+	endTime   time.Time
+	name      string
+}
+
+func newCostAggregator(conn *model.Connector) *costAggregator {
+	return &costAggregator{
+		conn:  conn,
+		index: map[costKey]*model.CostReport{},
+	}
+}
+
+func (a *costAggregator) add(item billing.LineItem) {
+	labels := item.Labels()
+
+	// Name the resource-less cost by the service,
+	// and distinguish by the resource path to keep the walrus labels.
+	name := item.ResourceID
+	if name == "" {
+		name = item.Service
+
+		if p := labels[types.LabelWalrusResourcePath]; p != "" {
+			name += "/" + p
+		}
+	}
+
+	k := costKey{
+		startTime: item.StartTime,
+		endTime:   item.EndTime,
+		name:      name,
+	}
+
+	if c, ok := a.index[k]; ok {
+		c.TotalCost += item.Cost
+		return
+	}
+
+	a.index[k] = &model.CostReport{
+		ConnectorID:    a.conn.ID,
+		StartTime:      item.StartTime,
+		EndTime:        item.EndTime,
+		Minutes:        item.EndTime.Sub(item.StartTime).Minutes(),
+		Name:           name,
+		ClusterName:    a.conn.Name,
+		Controller:     item.ResourceID,
+		ControllerKind: item.Service,
+		Labels:         labels,
+		TotalCost:      item.Cost,
+	}
+}
+
+// costs returns the aggregated cost reports, sorted by the start time and name.
+func (a *costAggregator) costs() []*model.CostReport {
+	costs := make([]*model.CostReport, 0, len(a.index))
+	for _, c := range a.index {
+		costs = append(costs, c)
+	}
+
+	sort.Slice(costs, func(i, j int) bool {
+		if !costs[i].StartTime.Equal(costs[j].StartTime) {
+			return costs[i].StartTime.Before(costs[j].StartTime)
+		}
+
+		return costs[i].Name < costs[j].Name
+	})
+
+	return costs
+}
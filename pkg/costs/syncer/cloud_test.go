@@ -0,0 +1,128 @@
+package syncer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/costs/billing"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/costreport"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+func TestCostAggregator(t *testing.T) {
+	conn := &model.Connector{
+		ID:   "1",
+		Name: "aws",
+	}
+
+	var (
+		t0   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		t1   = t0.Add(time.Hour)
+		tags = map[string]string{
+			"walrus.seal.io/project-name":     "default",
+			"walrus.seal.io/environment-name": "dev",
+			"walrus.seal.io/resource-name":    "web",
+		}
+	)
+
+	a := newCostAggregator(conn)
+	a.add(billing.LineItem{StartTime: t1, EndTime: t1.Add(time.Hour), ResourceID: "i-1", Service: "AmazonEC2", Cost: 1})
+	a.add(billing.LineItem{StartTime: t0, EndTime: t1, ResourceID: "i-1", Service: "AmazonEC2", Cost: 1, Tags: tags})
+	a.add(billing.LineItem{StartTime: t0, EndTime: t1, ResourceID: "i-1", Service: "AWSDataTransfer", Cost: 0.5})
+	a.add(billing.LineItem{StartTime: t0, EndTime: t1, Service: "AmazonCloudWatch", Cost: 0.2, Tags: tags})
+	a.add(billing.LineItem{StartTime: t0, EndTime: t1, Service: "AmazonCloudWatch", Cost: 0.3})
+
+	costs := a.costs()
+
+	type cost struct {
+		start time.Time
+		name  string
+		total float64
+	}
+
+	actual := make([]cost, len(costs))
+	for i := range costs {
+		actual[i] = cost{start: costs[i].StartTime, name: costs[i].Name, total: costs[i].TotalCost}
+	}
+
+	assert.Equal(t, []cost{
+		{start: t0, name: "AmazonCloudWatch", total: 0.3},
+		{start: t0, name: "AmazonCloudWatch/default/dev/web", total: 0.2},
+		{start: t0, name: "i-1", total: 1.5},
+		{start: t1, name: "i-1", total: 1},
+	}, actual)
+
+	assert.Equal(t, "aws", costs[2].ClusterName)
+	assert.Equal(t, "i-1", costs[2].Controller)
+	assert.Equal(t, "AmazonEC2", costs[2].ControllerKind)
+	assert.Equal(t, float64(60), costs[2].Minutes)
+	assert.Equal(t, "default/dev/web", costs[2].Labels[types.LabelWalrusResourcePath])
+}
+
+func TestCloudCostSyncerSync(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, "sqlite3", "file:cloudcostsyncer?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	dir := t.TempDir()
+
+	conn, err := client.Connectors().Create().
+		SetName("aws").
+		SetApplicableEnvironmentType(types.EnvironmentDevelopment).
+		SetType(types.ConnectorTypeAWS).
+		SetCategory(types.ConnectorCategoryCloudProvider).
+		SetConfigVersion("v1").
+		SetEnableFinOps(true).
+		SetFinOpsBillingSource(&types.FinOpsBillingSource{
+			Format: types.FinOpsBillingFormatAWSCUR,
+			URL:    "file://" + dir,
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	write := func(cost string) {
+		data := "lineItem/UsageStartDate,lineItem/UsageEndDate,lineItem/ProductCode,lineItem/ResourceId," +
+			"lineItem/UnblendedCost,resourceTags/user:walrus.seal.io/project-name\n" +
+			"2024-01-01T00:00:00Z,2024-01-01T01:00:00Z,AmazonEC2,i-1," + cost + ",default\n" +
+			"2024-01-01T00:00:00Z,2024-01-01T01:00:00Z,AmazonEC2,i-2,1,default\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "cur-00001.csv"), []byte(data), 0o600))
+	}
+
+	s := NewCloudCostSyncer(client, nil)
+
+	write("1")
+	require.NoError(t, s.Sync(ctx, conn, nil, nil))
+
+	// Import the restated cost.
+	write("2.5")
+	require.NoError(t, s.Sync(ctx, conn, nil, nil))
+
+	crs, err := client.CostReports().Query().
+		Where(costreport.ConnectorID(conn.ID)).
+		Order(model.Asc(costreport.FieldName)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, crs, 2)
+	assert.Equal(t, "i-1", crs[0].Name)
+	assert.Equal(t, "aws/i-1", crs[0].Fingerprint)
+	assert.Equal(t, 2.5, crs[0].TotalCost)
+	assert.Equal(t, "default", crs[0].Labels[types.LabelWalrusProjectName])
+	assert.Equal(t, 1.0, crs[1].TotalCost)
+}
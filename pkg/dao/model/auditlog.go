@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// ID of the subject who performs the call, empty means anonymous.
+	SubjectID string `json:"subject_id,omitempty"`
+	// Name of the subject who performs the call.
+	SubjectName string `json:"subject_name,omitempty"`
+	// Action of the call, i.e. create, update, delete or the name of the custom route.
+	Action string `json:"action,omitempty"`
+	// HTTP method of the call.
+	Method string `json:"method,omitempty"`
+	// Path of the call.
+	Path string `json:"path,omitempty"`
+	// Kind of the resource to operate.
+	ResourceKind string `json:"resource_kind,omitempty"`
+	// ID or name of the resource to operate, empty means operating a collection.
+	ResourceID string `json:"resource_id,omitempty"`
+	// ID of the project to which the resource belongs.
+	ProjectID object.ID `json:"project_id,omitempty"`
+	// ID of the environment to which the resource belongs.
+	EnvironmentID object.ID `json:"environment_id,omitempty"`
+	// Summary of the request, sensitive fields are redacted.
+	Request string `json:"request,omitempty"`
+	// HTTP status code of the response.
+	Status int `json:"status,omitempty"`
+	// Result of the call, succeeded or failed.
+	Result string `json:"result,omitempty"`
+	// Message of the result, i.e. the error message if failed.
+	Message      string `json:"message,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID, auditlog.FieldProjectID, auditlog.FieldEnvironmentID:
+			values[i] = new(object.ID)
+		case auditlog.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case auditlog.FieldSubjectID, auditlog.FieldSubjectName, auditlog.FieldAction, auditlog.FieldMethod, auditlog.FieldPath, auditlog.FieldResourceKind, auditlog.FieldResourceID, auditlog.FieldRequest, auditlog.FieldResult, auditlog.FieldMessage:
+			values[i] = new(sql.NullString)
+		case auditlog.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditlog.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				al.ID = *value
+			}
+		case auditlog.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				al.CreateTime = new(time.Time)
+				*al.CreateTime = value.Time
+			}
+		case auditlog.FieldSubjectID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value.Valid {
+				al.SubjectID = value.String
+			}
+		case auditlog.FieldSubjectName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_name", values[i])
+			} else if value.Valid {
+				al.SubjectName = value.String
+			}
+		case auditlog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				al.Action = value.String
+			}
+		case auditlog.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				al.Method = value.String
+			}
+		case auditlog.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				al.Path = value.String
+			}
+		case auditlog.FieldResourceKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_kind", values[i])
+			} else if value.Valid {
+				al.ResourceKind = value.String
+			}
+		case auditlog.FieldResourceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value.Valid {
+				al.ResourceID = value.String
+			}
+		case auditlog.FieldProjectID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				al.ProjectID = *value
+			}
+		case auditlog.FieldEnvironmentID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value != nil {
+				al.EnvironmentID = *value
+			}
+		case auditlog.FieldRequest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request", values[i])
+			} else if value.Valid {
+				al.Request = value.String
+			}
+		case auditlog.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				al.Status = int(value.Int64)
+			}
+		case auditlog.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				al.Result = value.String
+			}
+		case auditlog.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				al.Message = value.String
+			}
+		default:
+			al.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AuditLog.
+// This includes values selected through modifiers, order, etc.
+func (al *AuditLog) Value(name string) (ent.Value, error) {
+	return al.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that you need to call AuditLog.Unwrap() before calling this method if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return NewAuditLogClient(al.config).UpdateOne(al)
+}
+
+// Unwrap unwraps the AuditLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	_tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("model: AuditLog is not a transactional entity")
+	}
+	al.config.driver = _tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", al.ID))
+	if v := al.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(al.SubjectID)
+	builder.WriteString(", ")
+	builder.WriteString("subject_name=")
+	builder.WriteString(al.SubjectName)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(al.Action)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(al.Method)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(al.Path)
+	builder.WriteString(", ")
+	builder.WriteString("resource_kind=")
+	builder.WriteString(al.ResourceKind)
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(al.ResourceID)
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("request=")
+	builder.WriteString(al.Request)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", al.Status))
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(al.Result)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(al.Message)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
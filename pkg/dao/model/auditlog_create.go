@@ -0,0 +1,1139 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation   *AuditLogMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *AuditLog
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (alc *AuditLogCreate) SetCreateTime(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreateTime(t)
+	return alc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreateTime(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreateTime(*t)
+	}
+	return alc
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (alc *AuditLogCreate) SetSubjectID(s string) *AuditLogCreate {
+	alc.mutation.SetSubjectID(s)
+	return alc
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableSubjectID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetSubjectID(*s)
+	}
+	return alc
+}
+
+// SetSubjectName sets the "subject_name" field.
+func (alc *AuditLogCreate) SetSubjectName(s string) *AuditLogCreate {
+	alc.mutation.SetSubjectName(s)
+	return alc
+}
+
+// SetNillableSubjectName sets the "subject_name" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableSubjectName(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetSubjectName(*s)
+	}
+	return alc
+}
+
+// SetAction sets the "action" field.
+func (alc *AuditLogCreate) SetAction(s string) *AuditLogCreate {
+	alc.mutation.SetAction(s)
+	return alc
+}
+
+// SetMethod sets the "method" field.
+func (alc *AuditLogCreate) SetMethod(s string) *AuditLogCreate {
+	alc.mutation.SetMethod(s)
+	return alc
+}
+
+// SetPath sets the "path" field.
+func (alc *AuditLogCreate) SetPath(s string) *AuditLogCreate {
+	alc.mutation.SetPath(s)
+	return alc
+}
+
+// SetResourceKind sets the "resource_kind" field.
+func (alc *AuditLogCreate) SetResourceKind(s string) *AuditLogCreate {
+	alc.mutation.SetResourceKind(s)
+	return alc
+}
+
+// SetNillableResourceKind sets the "resource_kind" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableResourceKind(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetResourceKind(*s)
+	}
+	return alc
+}
+
+// SetResourceID sets the "resource_id" field.
+func (alc *AuditLogCreate) SetResourceID(s string) *AuditLogCreate {
+	alc.mutation.SetResourceID(s)
+	return alc
+}
+
+// SetNillableResourceID sets the "resource_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableResourceID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetResourceID(*s)
+	}
+	return alc
+}
+
+// SetProjectID sets the "project_id" field.
+func (alc *AuditLogCreate) SetProjectID(o object.ID) *AuditLogCreate {
+	alc.mutation.SetProjectID(o)
+	return alc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableProjectID(o *object.ID) *AuditLogCreate {
+	if o != nil {
+		alc.SetProjectID(*o)
+	}
+	return alc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (alc *AuditLogCreate) SetEnvironmentID(o object.ID) *AuditLogCreate {
+	alc.mutation.SetEnvironmentID(o)
+	return alc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableEnvironmentID(o *object.ID) *AuditLogCreate {
+	if o != nil {
+		alc.SetEnvironmentID(*o)
+	}
+	return alc
+}
+
+// SetRequest sets the "request" field.
+func (alc *AuditLogCreate) SetRequest(s string) *AuditLogCreate {
+	alc.mutation.SetRequest(s)
+	return alc
+}
+
+// SetNillableRequest sets the "request" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableRequest(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetRequest(*s)
+	}
+	return alc
+}
+
+// SetStatus sets the "status" field.
+func (alc *AuditLogCreate) SetStatus(i int) *AuditLogCreate {
+	alc.mutation.SetStatus(i)
+	return alc
+}
+
+// SetResult sets the "result" field.
+func (alc *AuditLogCreate) SetResult(s string) *AuditLogCreate {
+	alc.mutation.SetResult(s)
+	return alc
+}
+
+// SetMessage sets the "message" field.
+func (alc *AuditLogCreate) SetMessage(s string) *AuditLogCreate {
+	alc.mutation.SetMessage(s)
+	return alc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableMessage(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetMessage(*s)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(o object.ID) *AuditLogCreate {
+	alc.mutation.SetID(o)
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	if err := alc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() error {
+	if _, ok := alc.mutation.CreateTime(); !ok {
+		if auditlog.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized auditlog.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := auditlog.DefaultCreateTime()
+		alc.mutation.SetCreateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "AuditLog.create_time"`)}
+	}
+	if _, ok := alc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`model: missing required field "AuditLog.action"`)}
+	}
+	if v, ok := alc.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`model: validator failed for field "AuditLog.action": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`model: missing required field "AuditLog.method"`)}
+	}
+	if v, ok := alc.mutation.Method(); ok {
+		if err := auditlog.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`model: validator failed for field "AuditLog.method": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`model: missing required field "AuditLog.path"`)}
+	}
+	if v, ok := alc.mutation.Path(); ok {
+		if err := auditlog.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`model: validator failed for field "AuditLog.path": %w`, err)}
+		}
+	}
+	if _, ok := alc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`model: missing required field "AuditLog.status"`)}
+	}
+	if _, ok := alc.mutation.Result(); !ok {
+		return &ValidationError{Name: "result", err: errors.New(`model: missing required field "AuditLog.result"`)}
+	}
+	if v, ok := alc.mutation.Result(); ok {
+		if err := auditlog.ResultValidator(v); err != nil {
+			return &ValidationError{Name: "result", err: fmt.Errorf(`model: validator failed for field "AuditLog.result": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeString))
+	)
+	_spec.Schema = alc.schemaConfig.AuditLog
+	_spec.OnConflict = alc.conflict
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := alc.mutation.CreateTime(); ok {
+		_spec.SetField(auditlog.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := alc.mutation.SubjectID(); ok {
+		_spec.SetField(auditlog.FieldSubjectID, field.TypeString, value)
+		_node.SubjectID = value
+	}
+	if value, ok := alc.mutation.SubjectName(); ok {
+		_spec.SetField(auditlog.FieldSubjectName, field.TypeString, value)
+		_node.SubjectName = value
+	}
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.SetField(auditlog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := alc.mutation.Method(); ok {
+		_spec.SetField(auditlog.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := alc.mutation.Path(); ok {
+		_spec.SetField(auditlog.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := alc.mutation.ResourceKind(); ok {
+		_spec.SetField(auditlog.FieldResourceKind, field.TypeString, value)
+		_node.ResourceKind = value
+	}
+	if value, ok := alc.mutation.ResourceID(); ok {
+		_spec.SetField(auditlog.FieldResourceID, field.TypeString, value)
+		_node.ResourceID = value
+	}
+	if value, ok := alc.mutation.ProjectID(); ok {
+		_spec.SetField(auditlog.FieldProjectID, field.TypeString, value)
+		_node.ProjectID = value
+	}
+	if value, ok := alc.mutation.EnvironmentID(); ok {
+		_spec.SetField(auditlog.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := alc.mutation.Request(); ok {
+		_spec.SetField(auditlog.FieldRequest, field.TypeString, value)
+		_node.Request = value
+	}
+	if value, ok := alc.mutation.Status(); ok {
+		_spec.SetField(auditlog.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := alc.mutation.Result(); ok {
+		_spec.SetField(auditlog.FieldResult, field.TypeString, value)
+		_node.Result = value
+	}
+	if value, ok := alc.mutation.Message(); ok {
+		_spec.SetField(auditlog.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (alc *AuditLogCreate) Set(obj *AuditLog) *AuditLogCreate {
+	// Required.
+	alc.SetAction(obj.Action)
+	alc.SetMethod(obj.Method)
+	alc.SetPath(obj.Path)
+	alc.SetStatus(obj.Status)
+	alc.SetResult(obj.Result)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		alc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.SubjectID != "" {
+		alc.SetSubjectID(obj.SubjectID)
+	}
+	if obj.SubjectName != "" {
+		alc.SetSubjectName(obj.SubjectName)
+	}
+	if obj.ResourceKind != "" {
+		alc.SetResourceKind(obj.ResourceKind)
+	}
+	if obj.ResourceID != "" {
+		alc.SetResourceID(obj.ResourceID)
+	}
+	if obj.ProjectID != "" {
+		alc.SetProjectID(obj.ProjectID)
+	}
+	if obj.EnvironmentID != "" {
+		alc.SetEnvironmentID(obj.EnvironmentID)
+	}
+	if obj.Request != "" {
+		alc.SetRequest(obj.Request)
+	}
+	if obj.Message != "" {
+		alc.SetMessage(obj.Message)
+	}
+
+	// Record the given object.
+	alc.object = obj
+
+	return alc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (alc *AuditLogCreate) getClientSet() (mc ClientSet) {
+	if _, ok := alc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: alc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: alc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the AuditLog entity,
+// which is always good for cascading create operations.
+func (alc *AuditLogCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *AuditLog) error) (*AuditLog, error) {
+	obj, err := alc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := alc.getClientSet()
+
+	if x := alc.object; x != nil {
+		if _, set := alc.mutation.Field(auditlog.FieldSubjectID); set {
+			obj.SubjectID = x.SubjectID
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldSubjectName); set {
+			obj.SubjectName = x.SubjectName
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldAction); set {
+			obj.Action = x.Action
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldMethod); set {
+			obj.Method = x.Method
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldPath); set {
+			obj.Path = x.Path
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldResourceKind); set {
+			obj.ResourceKind = x.ResourceKind
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldResourceID); set {
+			obj.ResourceID = x.ResourceID
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldProjectID); set {
+			obj.ProjectID = x.ProjectID
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldEnvironmentID); set {
+			obj.EnvironmentID = x.EnvironmentID
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldRequest); set {
+			obj.Request = x.Request
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldStatus); set {
+			obj.Status = x.Status
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldResult); set {
+			obj.Result = x.Result
+		}
+		if _, set := alc.mutation.Field(auditlog.FieldMessage); set {
+			obj.Message = x.Message
+		}
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (alc *AuditLogCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *AuditLog) error) *AuditLog {
+	obj, err := alc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (alc *AuditLogCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *AuditLog) error) error {
+	_, err := alc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *AuditLog) error) {
+	if err := alc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the AuditLogCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (alcb *AuditLogCreateBulk) Set(objs ...*AuditLog) *AuditLogCreateBulk {
+	if len(objs) != 0 {
+		client := NewAuditLogClient(alcb.config)
+
+		alcb.builders = make([]*AuditLogCreate, len(objs))
+		for i := range objs {
+			alcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		alcb.objects = objs
+	}
+
+	return alcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (alcb *AuditLogCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := alcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: alcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: alcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the AuditLog entities,
+// which is always good for cascading create operations.
+func (alcb *AuditLogCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *AuditLog) error) ([]*AuditLog, error) {
+	objs, err := alcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := alcb.getClientSet()
+
+	if x := alcb.objects; x != nil {
+		for i := range x {
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldSubjectID); set {
+				objs[i].SubjectID = x[i].SubjectID
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldSubjectName); set {
+				objs[i].SubjectName = x[i].SubjectName
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldAction); set {
+				objs[i].Action = x[i].Action
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldMethod); set {
+				objs[i].Method = x[i].Method
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldPath); set {
+				objs[i].Path = x[i].Path
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldResourceKind); set {
+				objs[i].ResourceKind = x[i].ResourceKind
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldResourceID); set {
+				objs[i].ResourceID = x[i].ResourceID
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldProjectID); set {
+				objs[i].ProjectID = x[i].ProjectID
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldEnvironmentID); set {
+				objs[i].EnvironmentID = x[i].EnvironmentID
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldRequest); set {
+				objs[i].Request = x[i].Request
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldStatus); set {
+				objs[i].Status = x[i].Status
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldResult); set {
+				objs[i].Result = x[i].Result
+			}
+			if _, set := alcb.builders[i].mutation.Field(auditlog.FieldMessage); set {
+				objs[i].Message = x[i].Message
+			}
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *AuditLog) error) []*AuditLog {
+	objs, err := alcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (alcb *AuditLogCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *AuditLog) error) error {
+	_, err := alcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *AuditLog) error) {
+	if err := alcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *AuditLogUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *AuditLog) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for AuditLogUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *AuditLog) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *AuditLogUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *AuditLog) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the AuditLogUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for AuditLogUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *AuditLog) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertOne {
+	alc.conflict = opts
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflictColumns(columns ...string) *AuditLogUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// AuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AuditLog node.
+	AuditLogUpsertOne struct {
+		create *AuditLogCreate
+	}
+
+	// AuditLogUpsert is the "OnConflict" setter.
+	AuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertOne) UpdateNewValues() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(auditlog.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.SubjectID(); exists {
+			s.SetIgnore(auditlog.FieldSubjectID)
+		}
+		if _, exists := u.create.mutation.SubjectName(); exists {
+			s.SetIgnore(auditlog.FieldSubjectName)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(auditlog.FieldAction)
+		}
+		if _, exists := u.create.mutation.Method(); exists {
+			s.SetIgnore(auditlog.FieldMethod)
+		}
+		if _, exists := u.create.mutation.Path(); exists {
+			s.SetIgnore(auditlog.FieldPath)
+		}
+		if _, exists := u.create.mutation.ResourceKind(); exists {
+			s.SetIgnore(auditlog.FieldResourceKind)
+		}
+		if _, exists := u.create.mutation.ResourceID(); exists {
+			s.SetIgnore(auditlog.FieldResourceID)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(auditlog.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.EnvironmentID(); exists {
+			s.SetIgnore(auditlog.FieldEnvironmentID)
+		}
+		if _, exists := u.create.mutation.Request(); exists {
+			s.SetIgnore(auditlog.FieldRequest)
+		}
+		if _, exists := u.create.mutation.Status(); exists {
+			s.SetIgnore(auditlog.FieldStatus)
+		}
+		if _, exists := u.create.mutation.Result(); exists {
+			s.SetIgnore(auditlog.FieldResult)
+		}
+		if _, exists := u.create.mutation.Message(); exists {
+			s.SetIgnore(auditlog.FieldMessage)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogUpsertOne) Ignore() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertOne) DoNothing() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertOne) Update(set func(*AuditLogUpsert)) *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for AuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: AuditLogUpsertOne.ID is not supported by MySQL driver. Use AuditLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err        error
+	builders   []*AuditLogCreate
+	conflict   []sql.ConflictOption
+	objects    []*AuditLog
+	fromUpsert bool
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertBulk {
+	alcb.conflict = opts
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflictColumns(columns ...string) *AuditLogUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// AuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLog nodes.
+type AuditLogUpsertBulk struct {
+	create *AuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) UpdateNewValues() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlog.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(auditlog.FieldCreateTime)
+			}
+			if _, exists := b.mutation.SubjectID(); exists {
+				s.SetIgnore(auditlog.FieldSubjectID)
+			}
+			if _, exists := b.mutation.SubjectName(); exists {
+				s.SetIgnore(auditlog.FieldSubjectName)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(auditlog.FieldAction)
+			}
+			if _, exists := b.mutation.Method(); exists {
+				s.SetIgnore(auditlog.FieldMethod)
+			}
+			if _, exists := b.mutation.Path(); exists {
+				s.SetIgnore(auditlog.FieldPath)
+			}
+			if _, exists := b.mutation.ResourceKind(); exists {
+				s.SetIgnore(auditlog.FieldResourceKind)
+			}
+			if _, exists := b.mutation.ResourceID(); exists {
+				s.SetIgnore(auditlog.FieldResourceID)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(auditlog.FieldProjectID)
+			}
+			if _, exists := b.mutation.EnvironmentID(); exists {
+				s.SetIgnore(auditlog.FieldEnvironmentID)
+			}
+			if _, exists := b.mutation.Request(); exists {
+				s.SetIgnore(auditlog.FieldRequest)
+			}
+			if _, exists := b.mutation.Status(); exists {
+				s.SetIgnore(auditlog.FieldStatus)
+			}
+			if _, exists := b.mutation.Result(); exists {
+				s.SetIgnore(auditlog.FieldResult)
+			}
+			if _, exists := b.mutation.Message(); exists {
+				s.SetIgnore(auditlog.FieldMessage)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) Ignore() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertBulk) DoNothing() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertBulk) Update(set func(*AuditLogUpsert)) *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the AuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for AuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
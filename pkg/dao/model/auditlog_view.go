@@ -0,0 +1,867 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// AuditLogCreateInput holds the creation input of the AuditLog entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type AuditLogCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Result of the call, succeeded or failed.
+	Result string `path:"-" query:"-" json:"result"`
+	// HTTP status code of the response.
+	Status int `path:"-" query:"-" json:"status"`
+	// Path of the call.
+	Path string `path:"-" query:"-" json:"path"`
+	// HTTP method of the call.
+	Method string `path:"-" query:"-" json:"method"`
+	// Action of the call, i.e. create, update, delete or the name of the custom route.
+	Action string `path:"-" query:"-" json:"action"`
+	// ID of the subject who performs the call, empty means anonymous.
+	SubjectID string `path:"-" query:"-" json:"subjectID,omitempty"`
+	// Name of the subject who performs the call.
+	SubjectName string `path:"-" query:"-" json:"subjectName,omitempty"`
+	// Kind of the resource to operate.
+	ResourceKind string `path:"-" query:"-" json:"resourceKind,omitempty"`
+	// ID or name of the resource to operate, empty means operating a collection.
+	ResourceID string `path:"-" query:"-" json:"resourceID,omitempty"`
+	// ID of the project to which the resource belongs.
+	ProjectID object.ID `path:"-" query:"-" json:"projectID,omitempty"`
+	// ID of the environment to which the resource belongs.
+	EnvironmentID object.ID `path:"-" query:"-" json:"environmentID,omitempty"`
+	// Summary of the request, sensitive fields are redacted.
+	Request string `path:"-" query:"-" json:"request,omitempty"`
+	// Message of the result, i.e. the error message if failed.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+}
+
+// Model returns the AuditLog entity for creating,
+// after validating.
+func (alci *AuditLogCreateInput) Model() *AuditLog {
+	if alci == nil {
+		return nil
+	}
+
+	_al := &AuditLog{
+		Result:        alci.Result,
+		Status:        alci.Status,
+		Path:          alci.Path,
+		Method:        alci.Method,
+		Action:        alci.Action,
+		SubjectID:     alci.SubjectID,
+		SubjectName:   alci.SubjectName,
+		ResourceKind:  alci.ResourceKind,
+		ResourceID:    alci.ResourceID,
+		ProjectID:     alci.ProjectID,
+		EnvironmentID: alci.EnvironmentID,
+		Request:       alci.Request,
+		Message:       alci.Message,
+	}
+
+	return _al
+}
+
+// Validate checks the AuditLogCreateInput entity.
+func (alci *AuditLogCreateInput) Validate() error {
+	if alci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return alci.ValidateWith(alci.inputConfig.Context, alci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the AuditLogCreateInput entity with the given context and client set.
+func (alci *AuditLogCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if alci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// AuditLogCreateInputs holds the creation input item of the AuditLog entities.
+type AuditLogCreateInputsItem struct {
+	// Result of the call, succeeded or failed.
+	Result string `path:"-" query:"-" json:"result"`
+	// HTTP status code of the response.
+	Status int `path:"-" query:"-" json:"status"`
+	// Path of the call.
+	Path string `path:"-" query:"-" json:"path"`
+	// HTTP method of the call.
+	Method string `path:"-" query:"-" json:"method"`
+	// Action of the call, i.e. create, update, delete or the name of the custom route.
+	Action string `path:"-" query:"-" json:"action"`
+	// ID of the subject who performs the call, empty means anonymous.
+	SubjectID string `path:"-" query:"-" json:"subjectID,omitempty"`
+	// Name of the subject who performs the call.
+	SubjectName string `path:"-" query:"-" json:"subjectName,omitempty"`
+	// Kind of the resource to operate.
+	ResourceKind string `path:"-" query:"-" json:"resourceKind,omitempty"`
+	// ID or name of the resource to operate, empty means operating a collection.
+	ResourceID string `path:"-" query:"-" json:"resourceID,omitempty"`
+	// ID of the project to which the resource belongs.
+	ProjectID object.ID `path:"-" query:"-" json:"projectID,omitempty"`
+	// ID of the environment to which the resource belongs.
+	EnvironmentID object.ID `path:"-" query:"-" json:"environmentID,omitempty"`
+	// Summary of the request, sensitive fields are redacted.
+	Request string `path:"-" query:"-" json:"request,omitempty"`
+	// Message of the result, i.e. the error message if failed.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+}
+
+// ValidateWith checks the AuditLogCreateInputsItem entity with the given context and client set.
+func (alci *AuditLogCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if alci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// AuditLogCreateInputs holds the creation input of the AuditLog entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type AuditLogCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*AuditLogCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the AuditLog entities for creating,
+// after validating.
+func (alci *AuditLogCreateInputs) Model() []*AuditLog {
+	if alci == nil || len(alci.Items) == 0 {
+		return nil
+	}
+
+	_als := make([]*AuditLog, len(alci.Items))
+
+	for i := range alci.Items {
+		_al := &AuditLog{
+			Result:        alci.Items[i].Result,
+			Status:        alci.Items[i].Status,
+			Path:          alci.Items[i].Path,
+			Method:        alci.Items[i].Method,
+			Action:        alci.Items[i].Action,
+			SubjectID:     alci.Items[i].SubjectID,
+			SubjectName:   alci.Items[i].SubjectName,
+			ResourceKind:  alci.Items[i].ResourceKind,
+			ResourceID:    alci.Items[i].ResourceID,
+			ProjectID:     alci.Items[i].ProjectID,
+			EnvironmentID: alci.Items[i].EnvironmentID,
+			Request:       alci.Items[i].Request,
+			Message:       alci.Items[i].Message,
+		}
+
+		_als[i] = _al
+	}
+
+	return _als
+}
+
+// Validate checks the AuditLogCreateInputs entity .
+func (alci *AuditLogCreateInputs) Validate() error {
+	if alci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return alci.ValidateWith(alci.inputConfig.Context, alci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the AuditLogCreateInputs entity with the given context and client set.
+func (alci *AuditLogCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if alci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(alci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	for i := range alci.Items {
+		if alci.Items[i] == nil {
+			continue
+		}
+
+		if err := alci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AuditLogDeleteInput holds the deletion input of the AuditLog entity,
+// please tags with `path:",inline"` if embedding.
+type AuditLogDeleteInput struct {
+	AuditLogQueryInput `path:",inline"`
+}
+
+// AuditLogDeleteInputs holds the deletion input item of the AuditLog entities.
+type AuditLogDeleteInputsItem struct {
+	// ID of the AuditLog entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// AuditLogDeleteInputs holds the deletion input of the AuditLog entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type AuditLogDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*AuditLogDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the AuditLog entities for deleting,
+// after validating.
+func (aldi *AuditLogDeleteInputs) Model() []*AuditLog {
+	if aldi == nil || len(aldi.Items) == 0 {
+		return nil
+	}
+
+	_als := make([]*AuditLog, len(aldi.Items))
+	for i := range aldi.Items {
+		_als[i] = &AuditLog{
+			ID: aldi.Items[i].ID,
+		}
+	}
+	return _als
+}
+
+// IDs returns the ID list of the AuditLog entities for deleting,
+// after validating.
+func (aldi *AuditLogDeleteInputs) IDs() []object.ID {
+	if aldi == nil || len(aldi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(aldi.Items))
+	for i := range aldi.Items {
+		ids[i] = aldi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the AuditLogDeleteInputs entity.
+func (aldi *AuditLogDeleteInputs) Validate() error {
+	if aldi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return aldi.ValidateWith(aldi.inputConfig.Context, aldi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the AuditLogDeleteInputs entity with the given context and client set.
+func (aldi *AuditLogDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if aldi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(aldi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.AuditLogs().Query()
+
+	ids := make([]object.ID, 0, len(aldi.Items))
+
+	for i := range aldi.Items {
+		if aldi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if aldi.Items[i].ID != "" {
+			ids = append(ids, aldi.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(auditlog.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	return nil
+}
+
+// AuditLogPatchInput holds the patch input of the AuditLog entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type AuditLogPatchInput struct {
+	AuditLogQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// ID of the subject who performs the call, empty means anonymous.
+	SubjectID string `path:"-" query:"-" json:"subjectID,omitempty"`
+	// Name of the subject who performs the call.
+	SubjectName string `path:"-" query:"-" json:"subjectName,omitempty"`
+	// Action of the call, i.e. create, update, delete or the name of the custom route.
+	Action string `path:"-" query:"-" json:"action,omitempty"`
+	// HTTP method of the call.
+	Method string `path:"-" query:"-" json:"method,omitempty"`
+	// Path of the call.
+	Path string `path:"-" query:"-" json:"path,omitempty"`
+	// Kind of the resource to operate.
+	ResourceKind string `path:"-" query:"-" json:"resourceKind,omitempty"`
+	// ID or name of the resource to operate, empty means operating a collection.
+	ResourceID string `path:"-" query:"-" json:"resourceID,omitempty"`
+	// ID of the project to which the resource belongs.
+	ProjectID object.ID `path:"-" query:"-" json:"projectID,omitempty"`
+	// ID of the environment to which the resource belongs.
+	EnvironmentID object.ID `path:"-" query:"-" json:"environmentID,omitempty"`
+	// Summary of the request, sensitive fields are redacted.
+	Request string `path:"-" query:"-" json:"request,omitempty"`
+	// HTTP status code of the response.
+	Status int `path:"-" query:"-" json:"status,omitempty"`
+	// Result of the call, succeeded or failed.
+	Result string `path:"-" query:"-" json:"result,omitempty"`
+	// Message of the result, i.e. the error message if failed.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+
+	patchedEntity *AuditLog `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the AuditLog partition entity for patching.
+func (alpi *AuditLogPatchInput) PatchModel() *AuditLog {
+	if alpi == nil {
+		return nil
+	}
+
+	_al := &AuditLog{
+		CreateTime:    alpi.CreateTime,
+		SubjectID:     alpi.SubjectID,
+		SubjectName:   alpi.SubjectName,
+		Action:        alpi.Action,
+		Method:        alpi.Method,
+		Path:          alpi.Path,
+		ResourceKind:  alpi.ResourceKind,
+		ResourceID:    alpi.ResourceID,
+		ProjectID:     alpi.ProjectID,
+		EnvironmentID: alpi.EnvironmentID,
+		Request:       alpi.Request,
+		Status:        alpi.Status,
+		Result:        alpi.Result,
+		Message:       alpi.Message,
+	}
+
+	return _al
+}
+
+// Model returns the AuditLog patched entity,
+// after validating.
+func (alpi *AuditLogPatchInput) Model() *AuditLog {
+	if alpi == nil {
+		return nil
+	}
+
+	return alpi.patchedEntity
+}
+
+// Validate checks the AuditLogPatchInput entity.
+func (alpi *AuditLogPatchInput) Validate() error {
+	if alpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return alpi.ValidateWith(alpi.inputConfig.Context, alpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the AuditLogPatchInput entity with the given context and client set.
+func (alpi *AuditLogPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := alpi.AuditLogQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.AuditLogs().Query()
+
+	if alpi.Refer != nil {
+		if alpi.Refer.IsID() {
+			q.Where(
+				auditlog.ID(alpi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of auditlog")
+		}
+	} else if alpi.ID != "" {
+		q.Where(
+			auditlog.ID(alpi.ID))
+	} else {
+		return errors.New("invalid identify of auditlog")
+	}
+
+	q.Select(
+		auditlog.WithoutFields(
+			auditlog.FieldCreateTime,
+		)...,
+	)
+
+	var e *AuditLog
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*AuditLog)
+		}
+	}
+
+	_pm := alpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*AuditLog)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.SubjectID != _obj.SubjectID {
+		return errors.New("field subjectID is immutable")
+	}
+	if e.SubjectName != _obj.SubjectName {
+		return errors.New("field subjectName is immutable")
+	}
+	if e.Action != _obj.Action {
+		return errors.New("field action is immutable")
+	}
+	if e.Method != _obj.Method {
+		return errors.New("field method is immutable")
+	}
+	if e.Path != _obj.Path {
+		return errors.New("field path is immutable")
+	}
+	if e.ResourceKind != _obj.ResourceKind {
+		return errors.New("field resourceKind is immutable")
+	}
+	if e.ResourceID != _obj.ResourceID {
+		return errors.New("field resourceID is immutable")
+	}
+	if e.ProjectID != _obj.ProjectID {
+		return errors.New("field projectID is immutable")
+	}
+	if e.EnvironmentID != _obj.EnvironmentID {
+		return errors.New("field environmentID is immutable")
+	}
+	if e.Request != _obj.Request {
+		return errors.New("field request is immutable")
+	}
+	if e.Status != _obj.Status {
+		return errors.New("field status is immutable")
+	}
+	if e.Result != _obj.Result {
+		return errors.New("field result is immutable")
+	}
+	if e.Message != _obj.Message {
+		return errors.New("field message is immutable")
+	}
+
+	alpi.patchedEntity = _obj
+	return nil
+}
+
+// AuditLogQueryInput holds the query input of the AuditLog entity,
+// please tags with `path:",inline"` if embedding.
+type AuditLogQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Refer holds the route path reference of the AuditLog entity.
+	Refer *object.Refer `path:"auditlog,default=" query:"-" json:"-"`
+	// ID of the AuditLog entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// Model returns the AuditLog entity for querying,
+// after validating.
+func (alqi *AuditLogQueryInput) Model() *AuditLog {
+	if alqi == nil {
+		return nil
+	}
+
+	return &AuditLog{
+		ID: alqi.ID,
+	}
+}
+
+// Validate checks the AuditLogQueryInput entity.
+func (alqi *AuditLogQueryInput) Validate() error {
+	if alqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return alqi.ValidateWith(alqi.inputConfig.Context, alqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the AuditLogQueryInput entity with the given context and client set.
+func (alqi *AuditLogQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if alqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if alqi.Refer != nil && *alqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", auditlog.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.AuditLogs().Query()
+
+	if alqi.Refer != nil {
+		if alqi.Refer.IsID() {
+			q.Where(
+				auditlog.ID(alqi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of auditlog")
+		}
+	} else if alqi.ID != "" {
+		q.Where(
+			auditlog.ID(alqi.ID))
+	} else {
+		return errors.New("invalid identify of auditlog")
+	}
+
+	q.Select(
+		auditlog.FieldID,
+	)
+
+	var e *AuditLog
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*AuditLog)
+		}
+	}
+
+	alqi.ID = e.ID
+	return nil
+}
+
+// AuditLogQueryInputs holds the query input of the AuditLog entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type AuditLogQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Validate checks the AuditLogQueryInputs entity.
+func (alqi *AuditLogQueryInputs) Validate() error {
+	if alqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return alqi.ValidateWith(alqi.inputConfig.Context, alqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the AuditLogQueryInputs entity with the given context and client set.
+func (alqi *AuditLogQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if alqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// AuditLogUpdateInput holds the modification input of the AuditLog entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type AuditLogUpdateInput struct {
+	AuditLogQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Model returns the AuditLog entity for modifying,
+// after validating.
+func (alui *AuditLogUpdateInput) Model() *AuditLog {
+	if alui == nil {
+		return nil
+	}
+
+	_al := &AuditLog{
+		ID: alui.ID,
+	}
+
+	return _al
+}
+
+// Validate checks the AuditLogUpdateInput entity.
+func (alui *AuditLogUpdateInput) Validate() error {
+	if alui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return alui.ValidateWith(alui.inputConfig.Context, alui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the AuditLogUpdateInput entity with the given context and client set.
+func (alui *AuditLogUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := alui.AuditLogQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AuditLogUpdateInputs holds the modification input item of the AuditLog entities.
+type AuditLogUpdateInputsItem struct {
+	// ID of the AuditLog entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ValidateWith checks the AuditLogUpdateInputsItem entity with the given context and client set.
+func (alui *AuditLogUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if alui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// AuditLogUpdateInputs holds the modification input of the AuditLog entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type AuditLogUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*AuditLogUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the AuditLog entities for modifying,
+// after validating.
+func (alui *AuditLogUpdateInputs) Model() []*AuditLog {
+	if alui == nil || len(alui.Items) == 0 {
+		return nil
+	}
+
+	_als := make([]*AuditLog, len(alui.Items))
+
+	for i := range alui.Items {
+		_al := &AuditLog{
+			ID: alui.Items[i].ID,
+		}
+
+		_als[i] = _al
+	}
+
+	return _als
+}
+
+// IDs returns the ID list of the AuditLog entities for modifying,
+// after validating.
+func (alui *AuditLogUpdateInputs) IDs() []object.ID {
+	if alui == nil || len(alui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(alui.Items))
+	for i := range alui.Items {
+		ids[i] = alui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the AuditLogUpdateInputs entity.
+func (alui *AuditLogUpdateInputs) Validate() error {
+	if alui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return alui.ValidateWith(alui.inputConfig.Context, alui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the AuditLogUpdateInputs entity with the given context and client set.
+func (alui *AuditLogUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if alui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(alui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.AuditLogs().Query()
+
+	ids := make([]object.ID, 0, len(alui.Items))
+
+	for i := range alui.Items {
+		if alui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if alui.Items[i].ID != "" {
+			ids = append(ids, alui.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(auditlog.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range alui.Items {
+		if err := alui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AuditLogOutput holds the output of the AuditLog entity.
+type AuditLogOutput struct {
+	ID            object.ID  `json:"id,omitempty"`
+	CreateTime    *time.Time `json:"createTime,omitempty"`
+	SubjectID     string     `json:"subjectID,omitempty"`
+	SubjectName   string     `json:"subjectName,omitempty"`
+	Action        string     `json:"action,omitempty"`
+	Method        string     `json:"method,omitempty"`
+	Path          string     `json:"path,omitempty"`
+	ResourceKind  string     `json:"resourceKind,omitempty"`
+	ResourceID    string     `json:"resourceID,omitempty"`
+	ProjectID     object.ID  `json:"projectID,omitempty"`
+	EnvironmentID object.ID  `json:"environmentID,omitempty"`
+	Request       string     `json:"request,omitempty"`
+	Status        int        `json:"status,omitempty"`
+	Result        string     `json:"result,omitempty"`
+	Message       string     `json:"message,omitempty"`
+}
+
+// View returns the output of AuditLog entity.
+func (_al *AuditLog) View() *AuditLogOutput {
+	return ExposeAuditLog(_al)
+}
+
+// View returns the output of AuditLog entities.
+func (_als AuditLogs) View() []*AuditLogOutput {
+	return ExposeAuditLogs(_als)
+}
+
+// ExposeAuditLog converts the AuditLog to AuditLogOutput.
+func ExposeAuditLog(_al *AuditLog) *AuditLogOutput {
+	if _al == nil {
+		return nil
+	}
+
+	alo := &AuditLogOutput{
+		ID:            _al.ID,
+		CreateTime:    _al.CreateTime,
+		SubjectID:     _al.SubjectID,
+		SubjectName:   _al.SubjectName,
+		Action:        _al.Action,
+		Method:        _al.Method,
+		Path:          _al.Path,
+		ResourceKind:  _al.ResourceKind,
+		ResourceID:    _al.ResourceID,
+		ProjectID:     _al.ProjectID,
+		EnvironmentID: _al.EnvironmentID,
+		Request:       _al.Request,
+		Status:        _al.Status,
+		Result:        _al.Result,
+		Message:       _al.Message,
+	}
+
+	return alo
+}
+
+// ExposeAuditLogs converts the AuditLog slice to AuditLogOutput pointer slice.
+func ExposeAuditLogs(_als []*AuditLog) []*AuditLogOutput {
+	if len(_als) == 0 {
+		return nil
+	}
+
+	alos := make([]*AuditLogOutput, len(_als))
+	for i := range _als {
+		alos[i] = ExposeAuditLog(_als[i])
+	}
+	return alos
+}
@@ -0,0 +1,348 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// Budget is the model entity for the Budget schema.
+type Budget struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `json:"labels,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// ID of the project to belong.
+	ProjectID object.ID `json:"project_id,omitempty"`
+	// ID of the environment to watch, empty means the whole project.
+	EnvironmentID object.ID `json:"environment_id,omitempty"`
+	// Filters to narrow down the watched cost, within the project or environment.
+	Filters types.CostFilters `json:"filters,omitempty"`
+	// Amount of the budget within a period.
+	Amount float64 `json:"amount,omitempty,cli-table-column"`
+	// Period of the budget, monthly or custom.
+	Period string `json:"period,omitempty,cli-table-column"`
+	// Start time of the custom period.
+	StartTime *time.Time `json:"start_time,omitempty"`
+	// End time of the custom period.
+	EndTime *time.Time `json:"end_time,omitempty"`
+	// Thresholds to alert, in percentage of the amount.
+	Thresholds types.BudgetThresholds `json:"thresholds,omitempty"`
+	// Spend of the budget at the last evaluation.
+	Spend *types.BudgetSpend `json:"spend,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BudgetQuery when eager-loading is set.
+	Edges        BudgetEdges `json:"edges,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// BudgetEdges holds the relations/edges for other nodes in the graph.
+type BudgetEdges struct {
+	// Project to which the budget belongs.
+	Project *Project `json:"project,omitempty"`
+	// Environment which the budget watches.
+	Environment *Environment `json:"environment,omitempty"`
+	// Events of the budget.
+	Events []*BudgetEvent `json:"events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BudgetEdges) ProjectOrErr() (*Project, error) {
+	if e.loadedTypes[0] {
+		if e.Project == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: project.Label}
+		}
+		return e.Project, nil
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// EnvironmentOrErr returns the Environment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BudgetEdges) EnvironmentOrErr() (*Environment, error) {
+	if e.loadedTypes[1] {
+		if e.Environment == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: environment.Label}
+		}
+		return e.Environment, nil
+	}
+	return nil, &NotLoadedError{edge: "environment"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e BudgetEdges) EventsOrErr() ([]*BudgetEvent, error) {
+	if e.loadedTypes[2] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Budget) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case budget.FieldLabels, budget.FieldFilters, budget.FieldThresholds, budget.FieldSpend:
+			values[i] = new([]byte)
+		case budget.FieldID, budget.FieldProjectID, budget.FieldEnvironmentID:
+			values[i] = new(object.ID)
+		case budget.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case budget.FieldName, budget.FieldDescription, budget.FieldPeriod:
+			values[i] = new(sql.NullString)
+		case budget.FieldCreateTime, budget.FieldUpdateTime, budget.FieldStartTime, budget.FieldEndTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Budget fields.
+func (b *Budget) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case budget.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				b.ID = *value
+			}
+		case budget.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				b.Name = value.String
+			}
+		case budget.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				b.Description = value.String
+			}
+		case budget.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case budget.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				b.CreateTime = new(time.Time)
+				*b.CreateTime = value.Time
+			}
+		case budget.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				b.UpdateTime = new(time.Time)
+				*b.UpdateTime = value.Time
+			}
+		case budget.FieldProjectID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				b.ProjectID = *value
+			}
+		case budget.FieldEnvironmentID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value != nil {
+				b.EnvironmentID = *value
+			}
+		case budget.FieldFilters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.Filters); err != nil {
+					return fmt.Errorf("unmarshal field filters: %w", err)
+				}
+			}
+		case budget.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				b.Amount = value.Float64
+			}
+		case budget.FieldPeriod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field period", values[i])
+			} else if value.Valid {
+				b.Period = value.String
+			}
+		case budget.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				b.StartTime = new(time.Time)
+				*b.StartTime = value.Time
+			}
+		case budget.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				b.EndTime = new(time.Time)
+				*b.EndTime = value.Time
+			}
+		case budget.FieldThresholds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field thresholds", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.Thresholds); err != nil {
+					return fmt.Errorf("unmarshal field thresholds: %w", err)
+				}
+			}
+		case budget.FieldSpend:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field spend", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &b.Spend); err != nil {
+					return fmt.Errorf("unmarshal field spend: %w", err)
+				}
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Budget.
+// This includes values selected through modifiers, order, etc.
+func (b *Budget) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the Budget entity.
+func (b *Budget) QueryProject() *ProjectQuery {
+	return NewBudgetClient(b.config).QueryProject(b)
+}
+
+// QueryEnvironment queries the "environment" edge of the Budget entity.
+func (b *Budget) QueryEnvironment() *EnvironmentQuery {
+	return NewBudgetClient(b.config).QueryEnvironment(b)
+}
+
+// QueryEvents queries the "events" edge of the Budget entity.
+func (b *Budget) QueryEvents() *BudgetEventQuery {
+	return NewBudgetClient(b.config).QueryEvents(b)
+}
+
+// Update returns a builder for updating this Budget.
+// Note that you need to call Budget.Unwrap() before calling this method if this Budget
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Budget) Update() *BudgetUpdateOne {
+	return NewBudgetClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Budget entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Budget) Unwrap() *Budget {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("model: Budget is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Budget) String() string {
+	var builder strings.Builder
+	builder.WriteString("Budget(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("name=")
+	builder.WriteString(b.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(b.Description)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", b.Labels))
+	builder.WriteString(", ")
+	if v := b.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := b.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", b.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", b.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("filters=")
+	builder.WriteString(fmt.Sprintf("%v", b.Filters))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", b.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("period=")
+	builder.WriteString(b.Period)
+	builder.WriteString(", ")
+	if v := b.StartTime; v != nil {
+		builder.WriteString("start_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := b.EndTime; v != nil {
+		builder.WriteString("end_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("thresholds=")
+	builder.WriteString(fmt.Sprintf("%v", b.Thresholds))
+	builder.WriteString(", ")
+	builder.WriteString("spend=")
+	builder.WriteString(fmt.Sprintf("%v", b.Spend))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Budgets is a parsable slice of Budget.
+type Budgets []*Budget
@@ -0,0 +1,1706 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// BudgetCreate is the builder for creating a Budget entity.
+type BudgetCreate struct {
+	config
+	mutation   *BudgetMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *Budget
+	fromUpsert bool
+}
+
+// SetName sets the "name" field.
+func (bc *BudgetCreate) SetName(s string) *BudgetCreate {
+	bc.mutation.SetName(s)
+	return bc
+}
+
+// SetDescription sets the "description" field.
+func (bc *BudgetCreate) SetDescription(s string) *BudgetCreate {
+	bc.mutation.SetDescription(s)
+	return bc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (bc *BudgetCreate) SetNillableDescription(s *string) *BudgetCreate {
+	if s != nil {
+		bc.SetDescription(*s)
+	}
+	return bc
+}
+
+// SetLabels sets the "labels" field.
+func (bc *BudgetCreate) SetLabels(m map[string]string) *BudgetCreate {
+	bc.mutation.SetLabels(m)
+	return bc
+}
+
+// SetCreateTime sets the "create_time" field.
+func (bc *BudgetCreate) SetCreateTime(t time.Time) *BudgetCreate {
+	bc.mutation.SetCreateTime(t)
+	return bc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (bc *BudgetCreate) SetNillableCreateTime(t *time.Time) *BudgetCreate {
+	if t != nil {
+		bc.SetCreateTime(*t)
+	}
+	return bc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (bc *BudgetCreate) SetUpdateTime(t time.Time) *BudgetCreate {
+	bc.mutation.SetUpdateTime(t)
+	return bc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (bc *BudgetCreate) SetNillableUpdateTime(t *time.Time) *BudgetCreate {
+	if t != nil {
+		bc.SetUpdateTime(*t)
+	}
+	return bc
+}
+
+// SetProjectID sets the "project_id" field.
+func (bc *BudgetCreate) SetProjectID(o object.ID) *BudgetCreate {
+	bc.mutation.SetProjectID(o)
+	return bc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (bc *BudgetCreate) SetEnvironmentID(o object.ID) *BudgetCreate {
+	bc.mutation.SetEnvironmentID(o)
+	return bc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (bc *BudgetCreate) SetNillableEnvironmentID(o *object.ID) *BudgetCreate {
+	if o != nil {
+		bc.SetEnvironmentID(*o)
+	}
+	return bc
+}
+
+// SetFilters sets the "filters" field.
+func (bc *BudgetCreate) SetFilters(tf types.CostFilters) *BudgetCreate {
+	bc.mutation.SetFilters(tf)
+	return bc
+}
+
+// SetAmount sets the "amount" field.
+func (bc *BudgetCreate) SetAmount(f float64) *BudgetCreate {
+	bc.mutation.SetAmount(f)
+	return bc
+}
+
+// SetPeriod sets the "period" field.
+func (bc *BudgetCreate) SetPeriod(s string) *BudgetCreate {
+	bc.mutation.SetPeriod(s)
+	return bc
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (bc *BudgetCreate) SetNillablePeriod(s *string) *BudgetCreate {
+	if s != nil {
+		bc.SetPeriod(*s)
+	}
+	return bc
+}
+
+// SetStartTime sets the "start_time" field.
+func (bc *BudgetCreate) SetStartTime(t time.Time) *BudgetCreate {
+	bc.mutation.SetStartTime(t)
+	return bc
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (bc *BudgetCreate) SetNillableStartTime(t *time.Time) *BudgetCreate {
+	if t != nil {
+		bc.SetStartTime(*t)
+	}
+	return bc
+}
+
+// SetEndTime sets the "end_time" field.
+func (bc *BudgetCreate) SetEndTime(t time.Time) *BudgetCreate {
+	bc.mutation.SetEndTime(t)
+	return bc
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (bc *BudgetCreate) SetNillableEndTime(t *time.Time) *BudgetCreate {
+	if t != nil {
+		bc.SetEndTime(*t)
+	}
+	return bc
+}
+
+// SetThresholds sets the "thresholds" field.
+func (bc *BudgetCreate) SetThresholds(tt types.BudgetThresholds) *BudgetCreate {
+	bc.mutation.SetThresholds(tt)
+	return bc
+}
+
+// SetSpend sets the "spend" field.
+func (bc *BudgetCreate) SetSpend(ts *types.BudgetSpend) *BudgetCreate {
+	bc.mutation.SetSpend(ts)
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BudgetCreate) SetID(o object.ID) *BudgetCreate {
+	bc.mutation.SetID(o)
+	return bc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (bc *BudgetCreate) SetProject(p *Project) *BudgetCreate {
+	return bc.SetProjectID(p.ID)
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (bc *BudgetCreate) SetEnvironment(e *Environment) *BudgetCreate {
+	return bc.SetEnvironmentID(e.ID)
+}
+
+// AddEventIDs adds the "events" edge to the BudgetEvent entity by IDs.
+func (bc *BudgetCreate) AddEventIDs(ids ...object.ID) *BudgetCreate {
+	bc.mutation.AddEventIDs(ids...)
+	return bc
+}
+
+// AddEvents adds the "events" edges to the BudgetEvent entity.
+func (bc *BudgetCreate) AddEvents(b ...*BudgetEvent) *BudgetCreate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bc.AddEventIDs(ids...)
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (bc *BudgetCreate) Mutation() *BudgetMutation {
+	return bc.mutation
+}
+
+// Save creates the Budget in the database.
+func (bc *BudgetCreate) Save(ctx context.Context) (*Budget, error) {
+	if err := bc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BudgetCreate) SaveX(ctx context.Context) *Budget {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BudgetCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BudgetCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BudgetCreate) defaults() error {
+	if _, ok := bc.mutation.Labels(); !ok {
+		v := budget.DefaultLabels
+		bc.mutation.SetLabels(v)
+	}
+	if _, ok := bc.mutation.CreateTime(); !ok {
+		if budget.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized budget.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := budget.DefaultCreateTime()
+		bc.mutation.SetCreateTime(v)
+	}
+	if _, ok := bc.mutation.UpdateTime(); !ok {
+		if budget.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized budget.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := budget.DefaultUpdateTime()
+		bc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := bc.mutation.Period(); !ok {
+		v := budget.DefaultPeriod
+		bc.mutation.SetPeriod(v)
+	}
+	if _, ok := bc.mutation.Thresholds(); !ok {
+		v := budget.DefaultThresholds
+		bc.mutation.SetThresholds(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BudgetCreate) check() error {
+	if _, ok := bc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`model: missing required field "Budget.name"`)}
+	}
+	if v, ok := bc.mutation.Name(); ok {
+		if err := budget.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`model: validator failed for field "Budget.name": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "Budget.create_time"`)}
+	}
+	if _, ok := bc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "Budget.update_time"`)}
+	}
+	if _, ok := bc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`model: missing required field "Budget.project_id"`)}
+	}
+	if v, ok := bc.mutation.ProjectID(); ok {
+		if err := budget.ProjectIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "project_id", err: fmt.Errorf(`model: validator failed for field "Budget.project_id": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`model: missing required field "Budget.amount"`)}
+	}
+	if v, ok := bc.mutation.Amount(); ok {
+		if err := budget.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`model: validator failed for field "Budget.amount": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`model: missing required field "Budget.period"`)}
+	}
+	if v, ok := bc.mutation.Period(); ok {
+		if err := budget.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`model: validator failed for field "Budget.period": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Thresholds(); !ok {
+		return &ValidationError{Name: "thresholds", err: errors.New(`model: missing required field "Budget.thresholds"`)}
+	}
+	if _, ok := bc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project", err: errors.New(`model: missing required edge "Budget.project"`)}
+	}
+	return nil
+}
+
+func (bc *BudgetCreate) sqlSave(ctx context.Context) (*Budget, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BudgetCreate) createSpec() (*Budget, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Budget{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(budget.Table, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString))
+	)
+	_spec.Schema = bc.schemaConfig.Budget
+	_spec.OnConflict = bc.conflict
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bc.mutation.Name(); ok {
+		_spec.SetField(budget.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := bc.mutation.Description(); ok {
+		_spec.SetField(budget.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := bc.mutation.Labels(); ok {
+		_spec.SetField(budget.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := bc.mutation.CreateTime(); ok {
+		_spec.SetField(budget.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := bc.mutation.UpdateTime(); ok {
+		_spec.SetField(budget.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := bc.mutation.Filters(); ok {
+		_spec.SetField(budget.FieldFilters, field.TypeJSON, value)
+		_node.Filters = value
+	}
+	if value, ok := bc.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := bc.mutation.Period(); ok {
+		_spec.SetField(budget.FieldPeriod, field.TypeString, value)
+		_node.Period = value
+	}
+	if value, ok := bc.mutation.StartTime(); ok {
+		_spec.SetField(budget.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = &value
+	}
+	if value, ok := bc.mutation.EndTime(); ok {
+		_spec.SetField(budget.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = &value
+	}
+	if value, ok := bc.mutation.Thresholds(); ok {
+		_spec.SetField(budget.FieldThresholds, field.TypeJSON, value)
+		_node.Thresholds = value
+	}
+	if value, ok := bc.mutation.Spend(); ok {
+		_spec.SetField(budget.FieldSpend, field.TypeJSON, value)
+		_node.Spend = value
+	}
+	if nodes := bc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budget.ProjectTable,
+			Columns: []string{budget.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = bc.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budget.EnvironmentTable,
+			Columns: []string{budget.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = bc.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   budget.EventsTable,
+			Columns: []string{budget.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = bc.schemaConfig.BudgetEvent
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (bc *BudgetCreate) Set(obj *Budget) *BudgetCreate {
+	// Required.
+	bc.SetName(obj.Name)
+	bc.SetProjectID(obj.ProjectID)
+	bc.SetAmount(obj.Amount)
+	bc.SetPeriod(obj.Period)
+	bc.SetThresholds(obj.Thresholds)
+
+	// Optional.
+	if obj.Description != "" {
+		bc.SetDescription(obj.Description)
+	}
+	if !reflect.ValueOf(obj.Labels).IsZero() {
+		bc.SetLabels(obj.Labels)
+	}
+	if obj.CreateTime != nil {
+		bc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		bc.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.EnvironmentID != "" {
+		bc.SetEnvironmentID(obj.EnvironmentID)
+	}
+	if !reflect.ValueOf(obj.Filters).IsZero() {
+		bc.SetFilters(obj.Filters)
+	}
+	if obj.StartTime != nil {
+		bc.SetStartTime(*obj.StartTime)
+	}
+	if obj.EndTime != nil {
+		bc.SetEndTime(*obj.EndTime)
+	}
+	if !reflect.ValueOf(obj.Spend).IsZero() {
+		bc.SetSpend(obj.Spend)
+	}
+
+	// Record the given object.
+	bc.object = obj
+
+	return bc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (bc *BudgetCreate) getClientSet() (mc ClientSet) {
+	if _, ok := bc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: bc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: bc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the Budget entity,
+// which is always good for cascading create operations.
+func (bc *BudgetCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Budget) error) (*Budget, error) {
+	obj, err := bc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := bc.getClientSet()
+	if bc.fromUpsert {
+		q := mc.Budgets().Query().
+			Where(
+				budget.ProjectID(obj.ProjectID),
+				budget.Name(obj.Name),
+			)
+		obj.ID, err = q.OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("model: failed to query id of Budget entity: %w", err)
+		}
+	}
+
+	if x := bc.object; x != nil {
+		if _, set := bc.mutation.Field(budget.FieldName); set {
+			obj.Name = x.Name
+		}
+		if _, set := bc.mutation.Field(budget.FieldDescription); set {
+			obj.Description = x.Description
+		}
+		if _, set := bc.mutation.Field(budget.FieldProjectID); set {
+			obj.ProjectID = x.ProjectID
+		}
+		if _, set := bc.mutation.Field(budget.FieldEnvironmentID); set {
+			obj.EnvironmentID = x.EnvironmentID
+		}
+		if _, set := bc.mutation.Field(budget.FieldFilters); set {
+			obj.Filters = x.Filters
+		}
+		if _, set := bc.mutation.Field(budget.FieldAmount); set {
+			obj.Amount = x.Amount
+		}
+		if _, set := bc.mutation.Field(budget.FieldStartTime); set {
+			obj.StartTime = x.StartTime
+		}
+		if _, set := bc.mutation.Field(budget.FieldEndTime); set {
+			obj.EndTime = x.EndTime
+		}
+		if _, set := bc.mutation.Field(budget.FieldSpend); set {
+			obj.Spend = x.Spend
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (bc *BudgetCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Budget) error) *Budget {
+	obj, err := bc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (bc *BudgetCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Budget) error) error {
+	_, err := bc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (bc *BudgetCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Budget) error) {
+	if err := bc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the BudgetCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (bcb *BudgetCreateBulk) Set(objs ...*Budget) *BudgetCreateBulk {
+	if len(objs) != 0 {
+		client := NewBudgetClient(bcb.config)
+
+		bcb.builders = make([]*BudgetCreate, len(objs))
+		for i := range objs {
+			bcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		bcb.objects = objs
+	}
+
+	return bcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (bcb *BudgetCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := bcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: bcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: bcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the Budget entities,
+// which is always good for cascading create operations.
+func (bcb *BudgetCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Budget) error) ([]*Budget, error) {
+	objs, err := bcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := bcb.getClientSet()
+	if bcb.fromUpsert {
+		for i := range objs {
+			obj := objs[i]
+			q := mc.Budgets().Query().
+				Where(
+					budget.ProjectID(obj.ProjectID),
+					budget.Name(obj.Name),
+				)
+			objs[i].ID, err = q.OnlyID(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("model: failed to query id of Budget entity: %w", err)
+			}
+		}
+	}
+
+	if x := bcb.objects; x != nil {
+		for i := range x {
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldName); set {
+				objs[i].Name = x[i].Name
+			}
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldDescription); set {
+				objs[i].Description = x[i].Description
+			}
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldProjectID); set {
+				objs[i].ProjectID = x[i].ProjectID
+			}
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldEnvironmentID); set {
+				objs[i].EnvironmentID = x[i].EnvironmentID
+			}
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldFilters); set {
+				objs[i].Filters = x[i].Filters
+			}
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldAmount); set {
+				objs[i].Amount = x[i].Amount
+			}
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldStartTime); set {
+				objs[i].StartTime = x[i].StartTime
+			}
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldEndTime); set {
+				objs[i].EndTime = x[i].EndTime
+			}
+			if _, set := bcb.builders[i].mutation.Field(budget.FieldSpend); set {
+				objs[i].Spend = x[i].Spend
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (bcb *BudgetCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Budget) error) []*Budget {
+	objs, err := bcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (bcb *BudgetCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Budget) error) error {
+	_, err := bcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (bcb *BudgetCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Budget) error) {
+	if err := bcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *BudgetUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Budget) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for BudgetUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *BudgetUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Budget) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *BudgetUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Budget) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the BudgetUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for BudgetUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *BudgetUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Budget) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Budget.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (bc *BudgetCreate) OnConflict(opts ...sql.ConflictOption) *BudgetUpsertOne {
+	bc.conflict = opts
+	return &BudgetUpsertOne{
+		create: bc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bc *BudgetCreate) OnConflictColumns(columns ...string) *BudgetUpsertOne {
+	bc.conflict = append(bc.conflict, sql.ConflictColumns(columns...))
+	return &BudgetUpsertOne{
+		create: bc,
+	}
+}
+
+type (
+	// BudgetUpsertOne is the builder for "upsert"-ing
+	//  one Budget node.
+	BudgetUpsertOne struct {
+		create *BudgetCreate
+	}
+
+	// BudgetUpsert is the "OnConflict" setter.
+	BudgetUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *BudgetUpsert) SetDescription(v string) *BudgetUpsert {
+	u.Set(budget.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateDescription() *BudgetUpsert {
+	u.SetExcluded(budget.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *BudgetUpsert) ClearDescription() *BudgetUpsert {
+	u.SetNull(budget.FieldDescription)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *BudgetUpsert) SetLabels(v map[string]string) *BudgetUpsert {
+	u.Set(budget.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateLabels() *BudgetUpsert {
+	u.SetExcluded(budget.FieldLabels)
+	return u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *BudgetUpsert) ClearLabels() *BudgetUpsert {
+	u.SetNull(budget.FieldLabels)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BudgetUpsert) SetUpdateTime(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateUpdateTime() *BudgetUpsert {
+	u.SetExcluded(budget.FieldUpdateTime)
+	return u
+}
+
+// SetFilters sets the "filters" field.
+func (u *BudgetUpsert) SetFilters(v types.CostFilters) *BudgetUpsert {
+	u.Set(budget.FieldFilters, v)
+	return u
+}
+
+// UpdateFilters sets the "filters" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateFilters() *BudgetUpsert {
+	u.SetExcluded(budget.FieldFilters)
+	return u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (u *BudgetUpsert) ClearFilters() *BudgetUpsert {
+	u.SetNull(budget.FieldFilters)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsert) SetAmount(v float64) *BudgetUpsert {
+	u.Set(budget.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateAmount() *BudgetUpsert {
+	u.SetExcluded(budget.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsert) AddAmount(v float64) *BudgetUpsert {
+	u.Add(budget.FieldAmount, v)
+	return u
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsert) SetPeriod(v string) *BudgetUpsert {
+	u.Set(budget.FieldPeriod, v)
+	return u
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdatePeriod() *BudgetUpsert {
+	u.SetExcluded(budget.FieldPeriod)
+	return u
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *BudgetUpsert) SetStartTime(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldStartTime, v)
+	return u
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateStartTime() *BudgetUpsert {
+	u.SetExcluded(budget.FieldStartTime)
+	return u
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (u *BudgetUpsert) ClearStartTime() *BudgetUpsert {
+	u.SetNull(budget.FieldStartTime)
+	return u
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *BudgetUpsert) SetEndTime(v time.Time) *BudgetUpsert {
+	u.Set(budget.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateEndTime() *BudgetUpsert {
+	u.SetExcluded(budget.FieldEndTime)
+	return u
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (u *BudgetUpsert) ClearEndTime() *BudgetUpsert {
+	u.SetNull(budget.FieldEndTime)
+	return u
+}
+
+// SetThresholds sets the "thresholds" field.
+func (u *BudgetUpsert) SetThresholds(v types.BudgetThresholds) *BudgetUpsert {
+	u.Set(budget.FieldThresholds, v)
+	return u
+}
+
+// UpdateThresholds sets the "thresholds" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateThresholds() *BudgetUpsert {
+	u.SetExcluded(budget.FieldThresholds)
+	return u
+}
+
+// SetSpend sets the "spend" field.
+func (u *BudgetUpsert) SetSpend(v *types.BudgetSpend) *BudgetUpsert {
+	u.Set(budget.FieldSpend, v)
+	return u
+}
+
+// UpdateSpend sets the "spend" field to the value that was provided on create.
+func (u *BudgetUpsert) UpdateSpend() *BudgetUpsert {
+	u.SetExcluded(budget.FieldSpend)
+	return u
+}
+
+// ClearSpend clears the value of the "spend" field.
+func (u *BudgetUpsert) ClearSpend() *BudgetUpsert {
+	u.SetNull(budget.FieldSpend)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetUpsertOne) UpdateNewValues() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(budget.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(budget.FieldName)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(budget.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(budget.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.EnvironmentID(); exists {
+			s.SetIgnore(budget.FieldEnvironmentID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetUpsertOne) Ignore() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetUpsertOne) DoNothing() *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetCreate.OnConflict
+// documentation for more info.
+func (u *BudgetUpsertOne) Update(set func(*BudgetUpsert)) *BudgetUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *BudgetUpsertOne) SetDescription(v string) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateDescription() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *BudgetUpsertOne) ClearDescription() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *BudgetUpsertOne) SetLabels(v map[string]string) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateLabels() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *BudgetUpsertOne) ClearLabels() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BudgetUpsertOne) SetUpdateTime(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateUpdateTime() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetFilters sets the "filters" field.
+func (u *BudgetUpsertOne) SetFilters(v types.CostFilters) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetFilters(v)
+	})
+}
+
+// UpdateFilters sets the "filters" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateFilters() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateFilters()
+	})
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (u *BudgetUpsertOne) ClearFilters() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearFilters()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsertOne) SetAmount(v float64) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsertOne) AddAmount(v float64) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateAmount() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsertOne) SetPeriod(v string) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdatePeriod() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *BudgetUpsertOne) SetStartTime(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateStartTime() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (u *BudgetUpsertOne) ClearStartTime() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearStartTime()
+	})
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *BudgetUpsertOne) SetEndTime(v time.Time) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateEndTime() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (u *BudgetUpsertOne) ClearEndTime() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearEndTime()
+	})
+}
+
+// SetThresholds sets the "thresholds" field.
+func (u *BudgetUpsertOne) SetThresholds(v types.BudgetThresholds) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetThresholds(v)
+	})
+}
+
+// UpdateThresholds sets the "thresholds" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateThresholds() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateThresholds()
+	})
+}
+
+// SetSpend sets the "spend" field.
+func (u *BudgetUpsertOne) SetSpend(v *types.BudgetSpend) *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetSpend(v)
+	})
+}
+
+// UpdateSpend sets the "spend" field to the value that was provided on create.
+func (u *BudgetUpsertOne) UpdateSpend() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateSpend()
+	})
+}
+
+// ClearSpend clears the value of the "spend" field.
+func (u *BudgetUpsertOne) ClearSpend() *BudgetUpsertOne {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearSpend()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for BudgetCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: BudgetUpsertOne.ID is not supported by MySQL driver. Use BudgetUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetCreateBulk is the builder for creating many Budget entities in bulk.
+type BudgetCreateBulk struct {
+	config
+	err        error
+	builders   []*BudgetCreate
+	conflict   []sql.ConflictOption
+	objects    []*Budget
+	fromUpsert bool
+}
+
+// Save creates the Budget entities in the database.
+func (bcb *BudgetCreateBulk) Save(ctx context.Context) ([]*Budget, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Budget, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BudgetCreateBulk) SaveX(ctx context.Context) []*Budget {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BudgetCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BudgetCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Budget.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (bcb *BudgetCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetUpsertBulk {
+	bcb.conflict = opts
+	return &BudgetUpsertBulk{
+		create: bcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bcb *BudgetCreateBulk) OnConflictColumns(columns ...string) *BudgetUpsertBulk {
+	bcb.conflict = append(bcb.conflict, sql.ConflictColumns(columns...))
+	return &BudgetUpsertBulk{
+		create: bcb,
+	}
+}
+
+// BudgetUpsertBulk is the builder for "upsert"-ing
+// a bulk of Budget nodes.
+type BudgetUpsertBulk struct {
+	create *BudgetCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budget.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetUpsertBulk) UpdateNewValues() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(budget.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(budget.FieldName)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(budget.FieldCreateTime)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(budget.FieldProjectID)
+			}
+			if _, exists := b.mutation.EnvironmentID(); exists {
+				s.SetIgnore(budget.FieldEnvironmentID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Budget.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetUpsertBulk) Ignore() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetUpsertBulk) DoNothing() *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetUpsertBulk) Update(set func(*BudgetUpsert)) *BudgetUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *BudgetUpsertBulk) SetDescription(v string) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateDescription() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *BudgetUpsertBulk) ClearDescription() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *BudgetUpsertBulk) SetLabels(v map[string]string) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateLabels() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *BudgetUpsertBulk) ClearLabels() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BudgetUpsertBulk) SetUpdateTime(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateUpdateTime() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetFilters sets the "filters" field.
+func (u *BudgetUpsertBulk) SetFilters(v types.CostFilters) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetFilters(v)
+	})
+}
+
+// UpdateFilters sets the "filters" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateFilters() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateFilters()
+	})
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (u *BudgetUpsertBulk) ClearFilters() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearFilters()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BudgetUpsertBulk) SetAmount(v float64) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BudgetUpsertBulk) AddAmount(v float64) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateAmount() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPeriod sets the "period" field.
+func (u *BudgetUpsertBulk) SetPeriod(v string) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetPeriod(v)
+	})
+}
+
+// UpdatePeriod sets the "period" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdatePeriod() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdatePeriod()
+	})
+}
+
+// SetStartTime sets the "start_time" field.
+func (u *BudgetUpsertBulk) SetStartTime(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetStartTime(v)
+	})
+}
+
+// UpdateStartTime sets the "start_time" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateStartTime() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateStartTime()
+	})
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (u *BudgetUpsertBulk) ClearStartTime() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearStartTime()
+	})
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *BudgetUpsertBulk) SetEndTime(v time.Time) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateEndTime() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (u *BudgetUpsertBulk) ClearEndTime() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearEndTime()
+	})
+}
+
+// SetThresholds sets the "thresholds" field.
+func (u *BudgetUpsertBulk) SetThresholds(v types.BudgetThresholds) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetThresholds(v)
+	})
+}
+
+// UpdateThresholds sets the "thresholds" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateThresholds() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateThresholds()
+	})
+}
+
+// SetSpend sets the "spend" field.
+func (u *BudgetUpsertBulk) SetSpend(v *types.BudgetSpend) *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.SetSpend(v)
+	})
+}
+
+// UpdateSpend sets the "spend" field to the value that was provided on create.
+func (u *BudgetUpsertBulk) UpdateSpend() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.UpdateSpend()
+	})
+}
+
+// ClearSpend clears the value of the "spend" field.
+func (u *BudgetUpsertBulk) ClearSpend() *BudgetUpsertBulk {
+	return u.Update(func(s *BudgetUpsert) {
+		s.ClearSpend()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the BudgetCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for BudgetCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,836 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// BudgetQuery is the builder for querying Budget entities.
+type BudgetQuery struct {
+	config
+	ctx             *QueryContext
+	order           []budget.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Budget
+	withProject     *ProjectQuery
+	withEnvironment *EnvironmentQuery
+	withEvents      *BudgetEventQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BudgetQuery builder.
+func (bq *BudgetQuery) Where(ps ...predicate.Budget) *BudgetQuery {
+	bq.predicates = append(bq.predicates, ps...)
+	return bq
+}
+
+// Limit the number of records to be returned by this query.
+func (bq *BudgetQuery) Limit(limit int) *BudgetQuery {
+	bq.ctx.Limit = &limit
+	return bq
+}
+
+// Offset to start from.
+func (bq *BudgetQuery) Offset(offset int) *BudgetQuery {
+	bq.ctx.Offset = &offset
+	return bq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (bq *BudgetQuery) Unique(unique bool) *BudgetQuery {
+	bq.ctx.Unique = &unique
+	return bq
+}
+
+// Order specifies how the records should be ordered.
+func (bq *BudgetQuery) Order(o ...budget.OrderOption) *BudgetQuery {
+	bq.order = append(bq.order, o...)
+	return bq
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (bq *BudgetQuery) QueryProject() *ProjectQuery {
+	query := (&ProjectClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budget.Table, budget.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budget.ProjectTable, budget.ProjectColumn),
+		)
+		schemaConfig := bq.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.Budget
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnvironment chains the current query on the "environment" edge.
+func (bq *BudgetQuery) QueryEnvironment() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budget.Table, budget.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budget.EnvironmentTable, budget.EnvironmentColumn),
+		)
+		schemaConfig := bq.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.Budget
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEvents chains the current query on the "events" edge.
+func (bq *BudgetQuery) QueryEvents() *BudgetEventQuery {
+	query := (&BudgetEventClient{config: bq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := bq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budget.Table, budget.FieldID, selector),
+			sqlgraph.To(budgetevent.Table, budgetevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, budget.EventsTable, budget.EventsColumn),
+		)
+		schemaConfig := bq.schemaConfig
+		step.To.Schema = schemaConfig.BudgetEvent
+		step.Edge.Schema = schemaConfig.BudgetEvent
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Budget entity from the query.
+// Returns a *NotFoundError when no Budget was found.
+func (bq *BudgetQuery) First(ctx context.Context) (*Budget, error) {
+	nodes, err := bq.Limit(1).All(setContextOp(ctx, bq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{budget.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bq *BudgetQuery) FirstX(ctx context.Context) *Budget {
+	node, err := bq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Budget ID from the query.
+// Returns a *NotFoundError when no Budget ID was found.
+func (bq *BudgetQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = bq.Limit(1).IDs(setContextOp(ctx, bq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{budget.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (bq *BudgetQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := bq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Budget entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Budget entity is found.
+// Returns a *NotFoundError when no Budget entities are found.
+func (bq *BudgetQuery) Only(ctx context.Context) (*Budget, error) {
+	nodes, err := bq.Limit(2).All(setContextOp(ctx, bq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{budget.Label}
+	default:
+		return nil, &NotSingularError{budget.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bq *BudgetQuery) OnlyX(ctx context.Context) *Budget {
+	node, err := bq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Budget ID in the query.
+// Returns a *NotSingularError when more than one Budget ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (bq *BudgetQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = bq.Limit(2).IDs(setContextOp(ctx, bq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{budget.Label}
+	default:
+		err = &NotSingularError{budget.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (bq *BudgetQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := bq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Budgets.
+func (bq *BudgetQuery) All(ctx context.Context) ([]*Budget, error) {
+	ctx = setContextOp(ctx, bq.ctx, "All")
+	if err := bq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Budget, *BudgetQuery]()
+	return withInterceptors[[]*Budget](ctx, bq, qr, bq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bq *BudgetQuery) AllX(ctx context.Context) []*Budget {
+	nodes, err := bq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Budget IDs.
+func (bq *BudgetQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if bq.ctx.Unique == nil && bq.path != nil {
+		bq.Unique(true)
+	}
+	ctx = setContextOp(ctx, bq.ctx, "IDs")
+	if err = bq.Select(budget.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bq *BudgetQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := bq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bq *BudgetQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, bq.ctx, "Count")
+	if err := bq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, bq, querierCount[*BudgetQuery](), bq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bq *BudgetQuery) CountX(ctx context.Context) int {
+	count, err := bq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bq *BudgetQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, bq.ctx, "Exist")
+	switch _, err := bq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bq *BudgetQuery) ExistX(ctx context.Context) bool {
+	exist, err := bq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BudgetQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bq *BudgetQuery) Clone() *BudgetQuery {
+	if bq == nil {
+		return nil
+	}
+	return &BudgetQuery{
+		config:          bq.config,
+		ctx:             bq.ctx.Clone(),
+		order:           append([]budget.OrderOption{}, bq.order...),
+		inters:          append([]Interceptor{}, bq.inters...),
+		predicates:      append([]predicate.Budget{}, bq.predicates...),
+		withProject:     bq.withProject.Clone(),
+		withEnvironment: bq.withEnvironment.Clone(),
+		withEvents:      bq.withEvents.Clone(),
+		// clone intermediate query.
+		sql:  bq.sql.Clone(),
+		path: bq.path,
+	}
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BudgetQuery) WithProject(opts ...func(*ProjectQuery)) *BudgetQuery {
+	query := (&ProjectClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withProject = query
+	return bq
+}
+
+// WithEnvironment tells the query-builder to eager-load the nodes that are connected to
+// the "environment" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BudgetQuery) WithEnvironment(opts ...func(*EnvironmentQuery)) *BudgetQuery {
+	query := (&EnvironmentClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withEnvironment = query
+	return bq
+}
+
+// WithEvents tells the query-builder to eager-load the nodes that are connected to
+// the "events" edge. The optional arguments are used to configure the query builder of the edge.
+func (bq *BudgetQuery) WithEvents(opts ...func(*BudgetEventQuery)) *BudgetQuery {
+	query := (&BudgetEventClient{config: bq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withEvents = query
+	return bq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Budget.Query().
+//		GroupBy(budget.FieldName).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (bq *BudgetQuery) GroupBy(field string, fields ...string) *BudgetGroupBy {
+	bq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BudgetGroupBy{build: bq}
+	grbuild.flds = &bq.ctx.Fields
+	grbuild.label = budget.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Budget.Query().
+//		Select(budget.FieldName).
+//		Scan(ctx, &v)
+func (bq *BudgetQuery) Select(fields ...string) *BudgetSelect {
+	bq.ctx.Fields = append(bq.ctx.Fields, fields...)
+	sbuild := &BudgetSelect{BudgetQuery: bq}
+	sbuild.label = budget.Label
+	sbuild.flds, sbuild.scan = &bq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BudgetSelect configured with the given aggregations.
+func (bq *BudgetQuery) Aggregate(fns ...AggregateFunc) *BudgetSelect {
+	return bq.Select().Aggregate(fns...)
+}
+
+func (bq *BudgetQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range bq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, bq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range bq.ctx.Fields {
+		if !budget.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if bq.path != nil {
+		prev, err := bq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bq.sql = prev
+	}
+	return nil
+}
+
+func (bq *BudgetQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Budget, error) {
+	var (
+		nodes       = []*Budget{}
+		_spec       = bq.querySpec()
+		loadedTypes = [3]bool{
+			bq.withProject != nil,
+			bq.withEnvironment != nil,
+			bq.withEvents != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Budget).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Budget{config: bq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = bq.schemaConfig.Budget
+	ctx = internal.NewSchemaConfigContext(ctx, bq.schemaConfig)
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := bq.withProject; query != nil {
+		if err := bq.loadProject(ctx, query, nodes, nil,
+			func(n *Budget, e *Project) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withEnvironment; query != nil {
+		if err := bq.loadEnvironment(ctx, query, nodes, nil,
+			func(n *Budget, e *Environment) { n.Edges.Environment = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := bq.withEvents; query != nil {
+		if err := bq.loadEvents(ctx, query, nodes,
+			func(n *Budget) { n.Edges.Events = []*BudgetEvent{} },
+			func(n *Budget, e *BudgetEvent) { n.Edges.Events = append(n.Edges.Events, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (bq *BudgetQuery) loadProject(ctx context.Context, query *ProjectQuery, nodes []*Budget, init func(*Budget), assign func(*Budget, *Project)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*Budget)
+	for i := range nodes {
+		fk := nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(project.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bq *BudgetQuery) loadEnvironment(ctx context.Context, query *EnvironmentQuery, nodes []*Budget, init func(*Budget), assign func(*Budget, *Environment)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*Budget)
+	for i := range nodes {
+		fk := nodes[i].EnvironmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "environment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (bq *BudgetQuery) loadEvents(ctx context.Context, query *BudgetEventQuery, nodes []*Budget, init func(*Budget), assign func(*Budget, *BudgetEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Budget)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(budgetevent.FieldBudgetID)
+	}
+	query.Where(predicate.BudgetEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(budget.EventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BudgetID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "budget_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (bq *BudgetQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bq.querySpec()
+	_spec.Node.Schema = bq.schemaConfig.Budget
+	ctx = internal.NewSchemaConfigContext(ctx, bq.schemaConfig)
+	if len(bq.modifiers) > 0 {
+		_spec.Modifiers = bq.modifiers
+	}
+	_spec.Node.Columns = bq.ctx.Fields
+	if len(bq.ctx.Fields) > 0 {
+		_spec.Unique = bq.ctx.Unique != nil && *bq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
+}
+
+func (bq *BudgetQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(budget.Table, budget.Columns, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString))
+	_spec.From = bq.sql
+	if unique := bq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if bq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := bq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budget.FieldID)
+		for i := range fields {
+			if fields[i] != budget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if bq.withProject != nil {
+			_spec.Node.AddColumnOnce(budget.FieldProjectID)
+		}
+		if bq.withEnvironment != nil {
+			_spec.Node.AddColumnOnce(budget.FieldEnvironmentID)
+		}
+	}
+	if ps := bq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bq *BudgetQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(bq.driver.Dialect())
+	t1 := builder.Table(budget.Table)
+	columns := bq.ctx.Fields
+	if len(columns) == 0 {
+		columns = budget.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if bq.sql != nil {
+		selector = bq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if bq.ctx.Unique != nil && *bq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(bq.schemaConfig.Budget)
+	ctx = internal.NewSchemaConfigContext(ctx, bq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range bq.modifiers {
+		m(selector)
+	}
+	for _, p := range bq.predicates {
+		p(selector)
+	}
+	for _, p := range bq.order {
+		p(selector)
+	}
+	if offset := bq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (bq *BudgetQuery) ForUpdate(opts ...sql.LockOption) *BudgetQuery {
+	if bq.driver.Dialect() == dialect.Postgres {
+		bq.Unique(false)
+	}
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return bq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (bq *BudgetQuery) ForShare(opts ...sql.LockOption) *BudgetQuery {
+	if bq.driver.Dialect() == dialect.Postgres {
+		bq.Unique(false)
+	}
+	bq.modifiers = append(bq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return bq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bq *BudgetQuery) Modify(modifiers ...func(s *sql.Selector)) *BudgetSelect {
+	bq.modifiers = append(bq.modifiers, modifiers...)
+	return bq.Select()
+}
+
+// WhereP appends storage-level predicates to the BudgetQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (bq *BudgetQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.Budget, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.Budget(ps[i]))
+	}
+	bq.predicates = append(bq.predicates, wps...)
+}
+
+// BudgetGroupBy is the group-by builder for Budget entities.
+type BudgetGroupBy struct {
+	selector
+	build *BudgetQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bgb *BudgetGroupBy) Aggregate(fns ...AggregateFunc) *BudgetGroupBy {
+	bgb.fns = append(bgb.fns, fns...)
+	return bgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bgb *BudgetGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bgb.build.ctx, "GroupBy")
+	if err := bgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BudgetQuery, *BudgetGroupBy](ctx, bgb.build, bgb, bgb.build.inters, v)
+}
+
+func (bgb *BudgetGroupBy) sqlScan(ctx context.Context, root *BudgetQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bgb.fns))
+	for _, fn := range bgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bgb.flds)+len(bgb.fns))
+		for _, f := range *bgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BudgetSelect is the builder for selecting fields of Budget entities.
+type BudgetSelect struct {
+	*BudgetQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bs *BudgetSelect) Aggregate(fns ...AggregateFunc) *BudgetSelect {
+	bs.fns = append(bs.fns, fns...)
+	return bs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bs *BudgetSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bs.ctx, "Select")
+	if err := bs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BudgetQuery, *BudgetSelect](ctx, bs.BudgetQuery, bs, bs.inters, v)
+}
+
+func (bs *BudgetSelect) sqlScan(ctx context.Context, root *BudgetQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bs.fns))
+	for _, fn := range bs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bs *BudgetSelect) Modify(modifiers ...func(s *sql.Selector)) *BudgetSelect {
+	bs.modifiers = append(bs.modifiers, modifiers...)
+	return bs
+}
@@ -0,0 +1,1158 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// BudgetUpdate is the builder for updating Budget entities.
+type BudgetUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BudgetMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *Budget
+}
+
+// Where appends a list predicates to the BudgetUpdate builder.
+func (bu *BudgetUpdate) Where(ps ...predicate.Budget) *BudgetUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetDescription sets the "description" field.
+func (bu *BudgetUpdate) SetDescription(s string) *BudgetUpdate {
+	bu.mutation.SetDescription(s)
+	return bu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (bu *BudgetUpdate) SetNillableDescription(s *string) *BudgetUpdate {
+	if s != nil {
+		bu.SetDescription(*s)
+	}
+	return bu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (bu *BudgetUpdate) ClearDescription() *BudgetUpdate {
+	bu.mutation.ClearDescription()
+	return bu
+}
+
+// SetLabels sets the "labels" field.
+func (bu *BudgetUpdate) SetLabels(m map[string]string) *BudgetUpdate {
+	bu.mutation.SetLabels(m)
+	return bu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (bu *BudgetUpdate) ClearLabels() *BudgetUpdate {
+	bu.mutation.ClearLabels()
+	return bu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (bu *BudgetUpdate) SetUpdateTime(t time.Time) *BudgetUpdate {
+	bu.mutation.SetUpdateTime(t)
+	return bu
+}
+
+// SetFilters sets the "filters" field.
+func (bu *BudgetUpdate) SetFilters(tf types.CostFilters) *BudgetUpdate {
+	bu.mutation.SetFilters(tf)
+	return bu
+}
+
+// AppendFilters appends tf to the "filters" field.
+func (bu *BudgetUpdate) AppendFilters(tf types.CostFilters) *BudgetUpdate {
+	bu.mutation.AppendFilters(tf)
+	return bu
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (bu *BudgetUpdate) ClearFilters() *BudgetUpdate {
+	bu.mutation.ClearFilters()
+	return bu
+}
+
+// SetAmount sets the "amount" field.
+func (bu *BudgetUpdate) SetAmount(f float64) *BudgetUpdate {
+	bu.mutation.ResetAmount()
+	bu.mutation.SetAmount(f)
+	return bu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (bu *BudgetUpdate) SetNillableAmount(f *float64) *BudgetUpdate {
+	if f != nil {
+		bu.SetAmount(*f)
+	}
+	return bu
+}
+
+// AddAmount adds f to the "amount" field.
+func (bu *BudgetUpdate) AddAmount(f float64) *BudgetUpdate {
+	bu.mutation.AddAmount(f)
+	return bu
+}
+
+// SetPeriod sets the "period" field.
+func (bu *BudgetUpdate) SetPeriod(s string) *BudgetUpdate {
+	bu.mutation.SetPeriod(s)
+	return bu
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (bu *BudgetUpdate) SetNillablePeriod(s *string) *BudgetUpdate {
+	if s != nil {
+		bu.SetPeriod(*s)
+	}
+	return bu
+}
+
+// SetStartTime sets the "start_time" field.
+func (bu *BudgetUpdate) SetStartTime(t time.Time) *BudgetUpdate {
+	bu.mutation.SetStartTime(t)
+	return bu
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (bu *BudgetUpdate) SetNillableStartTime(t *time.Time) *BudgetUpdate {
+	if t != nil {
+		bu.SetStartTime(*t)
+	}
+	return bu
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (bu *BudgetUpdate) ClearStartTime() *BudgetUpdate {
+	bu.mutation.ClearStartTime()
+	return bu
+}
+
+// SetEndTime sets the "end_time" field.
+func (bu *BudgetUpdate) SetEndTime(t time.Time) *BudgetUpdate {
+	bu.mutation.SetEndTime(t)
+	return bu
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (bu *BudgetUpdate) SetNillableEndTime(t *time.Time) *BudgetUpdate {
+	if t != nil {
+		bu.SetEndTime(*t)
+	}
+	return bu
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (bu *BudgetUpdate) ClearEndTime() *BudgetUpdate {
+	bu.mutation.ClearEndTime()
+	return bu
+}
+
+// SetThresholds sets the "thresholds" field.
+func (bu *BudgetUpdate) SetThresholds(tt types.BudgetThresholds) *BudgetUpdate {
+	bu.mutation.SetThresholds(tt)
+	return bu
+}
+
+// AppendThresholds appends tt to the "thresholds" field.
+func (bu *BudgetUpdate) AppendThresholds(tt types.BudgetThresholds) *BudgetUpdate {
+	bu.mutation.AppendThresholds(tt)
+	return bu
+}
+
+// SetSpend sets the "spend" field.
+func (bu *BudgetUpdate) SetSpend(ts *types.BudgetSpend) *BudgetUpdate {
+	bu.mutation.SetSpend(ts)
+	return bu
+}
+
+// ClearSpend clears the value of the "spend" field.
+func (bu *BudgetUpdate) ClearSpend() *BudgetUpdate {
+	bu.mutation.ClearSpend()
+	return bu
+}
+
+// AddEventIDs adds the "events" edge to the BudgetEvent entity by IDs.
+func (bu *BudgetUpdate) AddEventIDs(ids ...object.ID) *BudgetUpdate {
+	bu.mutation.AddEventIDs(ids...)
+	return bu
+}
+
+// AddEvents adds the "events" edges to the BudgetEvent entity.
+func (bu *BudgetUpdate) AddEvents(b ...*BudgetEvent) *BudgetUpdate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bu.AddEventIDs(ids...)
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (bu *BudgetUpdate) Mutation() *BudgetMutation {
+	return bu.mutation
+}
+
+// ClearEvents clears all "events" edges to the BudgetEvent entity.
+func (bu *BudgetUpdate) ClearEvents() *BudgetUpdate {
+	bu.mutation.ClearEvents()
+	return bu
+}
+
+// RemoveEventIDs removes the "events" edge to BudgetEvent entities by IDs.
+func (bu *BudgetUpdate) RemoveEventIDs(ids ...object.ID) *BudgetUpdate {
+	bu.mutation.RemoveEventIDs(ids...)
+	return bu
+}
+
+// RemoveEvents removes "events" edges to BudgetEvent entities.
+func (bu *BudgetUpdate) RemoveEvents(b ...*BudgetEvent) *BudgetUpdate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return bu.RemoveEventIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BudgetUpdate) Save(ctx context.Context) (int, error) {
+	if err := bu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BudgetUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BudgetUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BudgetUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bu *BudgetUpdate) defaults() error {
+	if _, ok := bu.mutation.UpdateTime(); !ok {
+		if budget.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized budget.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := budget.UpdateDefaultUpdateTime()
+		bu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BudgetUpdate) check() error {
+	if v, ok := bu.mutation.Amount(); ok {
+		if err := budget.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`model: validator failed for field "Budget.amount": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Period(); ok {
+		if err := budget.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`model: validator failed for field "Budget.period": %w`, err)}
+		}
+	}
+	if _, ok := bu.mutation.ProjectID(); bu.mutation.ProjectCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "Budget.project"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (bu *BudgetUpdate) Set(obj *Budget) *BudgetUpdate {
+	// Without Default.
+	if obj.Description != "" {
+		bu.SetDescription(obj.Description)
+	} else {
+		bu.ClearDescription()
+	}
+	if !reflect.ValueOf(obj.Labels).IsZero() {
+		bu.SetLabels(obj.Labels)
+	}
+	if !reflect.ValueOf(obj.Filters).IsZero() {
+		bu.SetFilters(obj.Filters)
+	} else {
+		bu.ClearFilters()
+	}
+	bu.SetAmount(obj.Amount)
+	bu.SetPeriod(obj.Period)
+	if obj.StartTime != nil {
+		bu.SetStartTime(*obj.StartTime)
+	} else {
+		bu.ClearStartTime()
+	}
+	if obj.EndTime != nil {
+		bu.SetEndTime(*obj.EndTime)
+	} else {
+		bu.ClearEndTime()
+	}
+	bu.SetThresholds(obj.Thresholds)
+	if !reflect.ValueOf(obj.Spend).IsZero() {
+		bu.SetSpend(obj.Spend)
+	}
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		bu.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	bu.object = obj
+
+	return bu
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bu *BudgetUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetUpdate {
+	bu.modifiers = append(bu.modifiers, modifiers...)
+	return bu
+}
+
+func (bu *BudgetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budget.Table, budget.Columns, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Description(); ok {
+		_spec.SetField(budget.FieldDescription, field.TypeString, value)
+	}
+	if bu.mutation.DescriptionCleared() {
+		_spec.ClearField(budget.FieldDescription, field.TypeString)
+	}
+	if value, ok := bu.mutation.Labels(); ok {
+		_spec.SetField(budget.FieldLabels, field.TypeJSON, value)
+	}
+	if bu.mutation.LabelsCleared() {
+		_spec.ClearField(budget.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.UpdateTime(); ok {
+		_spec.SetField(budget.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := bu.mutation.Filters(); ok {
+		_spec.SetField(budget.FieldFilters, field.TypeJSON, value)
+	}
+	if value, ok := bu.mutation.AppendedFilters(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, budget.FieldFilters, value)
+		})
+	}
+	if bu.mutation.FiltersCleared() {
+		_spec.ClearField(budget.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := bu.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bu.mutation.AddedAmount(); ok {
+		_spec.AddField(budget.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := bu.mutation.Period(); ok {
+		_spec.SetField(budget.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.StartTime(); ok {
+		_spec.SetField(budget.FieldStartTime, field.TypeTime, value)
+	}
+	if bu.mutation.StartTimeCleared() {
+		_spec.ClearField(budget.FieldStartTime, field.TypeTime)
+	}
+	if value, ok := bu.mutation.EndTime(); ok {
+		_spec.SetField(budget.FieldEndTime, field.TypeTime, value)
+	}
+	if bu.mutation.EndTimeCleared() {
+		_spec.ClearField(budget.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := bu.mutation.Thresholds(); ok {
+		_spec.SetField(budget.FieldThresholds, field.TypeJSON, value)
+	}
+	if value, ok := bu.mutation.AppendedThresholds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, budget.FieldThresholds, value)
+		})
+	}
+	if value, ok := bu.mutation.Spend(); ok {
+		_spec.SetField(budget.FieldSpend, field.TypeJSON, value)
+	}
+	if bu.mutation.SpendCleared() {
+		_spec.ClearField(budget.FieldSpend, field.TypeJSON)
+	}
+	if bu.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   budget.EventsTable,
+			Columns: []string{budget.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = bu.schemaConfig.BudgetEvent
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.RemovedEventsIDs(); len(nodes) > 0 && !bu.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   budget.EventsTable,
+			Columns: []string{budget.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = bu.schemaConfig.BudgetEvent
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   budget.EventsTable,
+			Columns: []string{budget.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = bu.schemaConfig.BudgetEvent
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = bu.schemaConfig.Budget
+	ctx = internal.NewSchemaConfigContext(ctx, bu.schemaConfig)
+	_spec.AddModifiers(bu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BudgetUpdateOne is the builder for updating a single Budget entity.
+type BudgetUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BudgetMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *Budget
+}
+
+// SetDescription sets the "description" field.
+func (buo *BudgetUpdateOne) SetDescription(s string) *BudgetUpdateOne {
+	buo.mutation.SetDescription(s)
+	return buo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (buo *BudgetUpdateOne) SetNillableDescription(s *string) *BudgetUpdateOne {
+	if s != nil {
+		buo.SetDescription(*s)
+	}
+	return buo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (buo *BudgetUpdateOne) ClearDescription() *BudgetUpdateOne {
+	buo.mutation.ClearDescription()
+	return buo
+}
+
+// SetLabels sets the "labels" field.
+func (buo *BudgetUpdateOne) SetLabels(m map[string]string) *BudgetUpdateOne {
+	buo.mutation.SetLabels(m)
+	return buo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (buo *BudgetUpdateOne) ClearLabels() *BudgetUpdateOne {
+	buo.mutation.ClearLabels()
+	return buo
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (buo *BudgetUpdateOne) SetUpdateTime(t time.Time) *BudgetUpdateOne {
+	buo.mutation.SetUpdateTime(t)
+	return buo
+}
+
+// SetFilters sets the "filters" field.
+func (buo *BudgetUpdateOne) SetFilters(tf types.CostFilters) *BudgetUpdateOne {
+	buo.mutation.SetFilters(tf)
+	return buo
+}
+
+// AppendFilters appends tf to the "filters" field.
+func (buo *BudgetUpdateOne) AppendFilters(tf types.CostFilters) *BudgetUpdateOne {
+	buo.mutation.AppendFilters(tf)
+	return buo
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (buo *BudgetUpdateOne) ClearFilters() *BudgetUpdateOne {
+	buo.mutation.ClearFilters()
+	return buo
+}
+
+// SetAmount sets the "amount" field.
+func (buo *BudgetUpdateOne) SetAmount(f float64) *BudgetUpdateOne {
+	buo.mutation.ResetAmount()
+	buo.mutation.SetAmount(f)
+	return buo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (buo *BudgetUpdateOne) SetNillableAmount(f *float64) *BudgetUpdateOne {
+	if f != nil {
+		buo.SetAmount(*f)
+	}
+	return buo
+}
+
+// AddAmount adds f to the "amount" field.
+func (buo *BudgetUpdateOne) AddAmount(f float64) *BudgetUpdateOne {
+	buo.mutation.AddAmount(f)
+	return buo
+}
+
+// SetPeriod sets the "period" field.
+func (buo *BudgetUpdateOne) SetPeriod(s string) *BudgetUpdateOne {
+	buo.mutation.SetPeriod(s)
+	return buo
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (buo *BudgetUpdateOne) SetNillablePeriod(s *string) *BudgetUpdateOne {
+	if s != nil {
+		buo.SetPeriod(*s)
+	}
+	return buo
+}
+
+// SetStartTime sets the "start_time" field.
+func (buo *BudgetUpdateOne) SetStartTime(t time.Time) *BudgetUpdateOne {
+	buo.mutation.SetStartTime(t)
+	return buo
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (buo *BudgetUpdateOne) SetNillableStartTime(t *time.Time) *BudgetUpdateOne {
+	if t != nil {
+		buo.SetStartTime(*t)
+	}
+	return buo
+}
+
+// ClearStartTime clears the value of the "start_time" field.
+func (buo *BudgetUpdateOne) ClearStartTime() *BudgetUpdateOne {
+	buo.mutation.ClearStartTime()
+	return buo
+}
+
+// SetEndTime sets the "end_time" field.
+func (buo *BudgetUpdateOne) SetEndTime(t time.Time) *BudgetUpdateOne {
+	buo.mutation.SetEndTime(t)
+	return buo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (buo *BudgetUpdateOne) SetNillableEndTime(t *time.Time) *BudgetUpdateOne {
+	if t != nil {
+		buo.SetEndTime(*t)
+	}
+	return buo
+}
+
+// ClearEndTime clears the value of the "end_time" field.
+func (buo *BudgetUpdateOne) ClearEndTime() *BudgetUpdateOne {
+	buo.mutation.ClearEndTime()
+	return buo
+}
+
+// SetThresholds sets the "thresholds" field.
+func (buo *BudgetUpdateOne) SetThresholds(tt types.BudgetThresholds) *BudgetUpdateOne {
+	buo.mutation.SetThresholds(tt)
+	return buo
+}
+
+// AppendThresholds appends tt to the "thresholds" field.
+func (buo *BudgetUpdateOne) AppendThresholds(tt types.BudgetThresholds) *BudgetUpdateOne {
+	buo.mutation.AppendThresholds(tt)
+	return buo
+}
+
+// SetSpend sets the "spend" field.
+func (buo *BudgetUpdateOne) SetSpend(ts *types.BudgetSpend) *BudgetUpdateOne {
+	buo.mutation.SetSpend(ts)
+	return buo
+}
+
+// ClearSpend clears the value of the "spend" field.
+func (buo *BudgetUpdateOne) ClearSpend() *BudgetUpdateOne {
+	buo.mutation.ClearSpend()
+	return buo
+}
+
+// AddEventIDs adds the "events" edge to the BudgetEvent entity by IDs.
+func (buo *BudgetUpdateOne) AddEventIDs(ids ...object.ID) *BudgetUpdateOne {
+	buo.mutation.AddEventIDs(ids...)
+	return buo
+}
+
+// AddEvents adds the "events" edges to the BudgetEvent entity.
+func (buo *BudgetUpdateOne) AddEvents(b ...*BudgetEvent) *BudgetUpdateOne {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return buo.AddEventIDs(ids...)
+}
+
+// Mutation returns the BudgetMutation object of the builder.
+func (buo *BudgetUpdateOne) Mutation() *BudgetMutation {
+	return buo.mutation
+}
+
+// ClearEvents clears all "events" edges to the BudgetEvent entity.
+func (buo *BudgetUpdateOne) ClearEvents() *BudgetUpdateOne {
+	buo.mutation.ClearEvents()
+	return buo
+}
+
+// RemoveEventIDs removes the "events" edge to BudgetEvent entities by IDs.
+func (buo *BudgetUpdateOne) RemoveEventIDs(ids ...object.ID) *BudgetUpdateOne {
+	buo.mutation.RemoveEventIDs(ids...)
+	return buo
+}
+
+// RemoveEvents removes "events" edges to BudgetEvent entities.
+func (buo *BudgetUpdateOne) RemoveEvents(b ...*BudgetEvent) *BudgetUpdateOne {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return buo.RemoveEventIDs(ids...)
+}
+
+// Where appends a list predicates to the BudgetUpdate builder.
+func (buo *BudgetUpdateOne) Where(ps ...predicate.Budget) *BudgetUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BudgetUpdateOne) Select(field string, fields ...string) *BudgetUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Budget entity.
+func (buo *BudgetUpdateOne) Save(ctx context.Context) (*Budget, error) {
+	if err := buo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BudgetUpdateOne) SaveX(ctx context.Context) *Budget {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BudgetUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BudgetUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (buo *BudgetUpdateOne) defaults() error {
+	if _, ok := buo.mutation.UpdateTime(); !ok {
+		if budget.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized budget.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := budget.UpdateDefaultUpdateTime()
+		buo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BudgetUpdateOne) check() error {
+	if v, ok := buo.mutation.Amount(); ok {
+		if err := budget.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`model: validator failed for field "Budget.amount": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Period(); ok {
+		if err := budget.PeriodValidator(v); err != nil {
+			return &ValidationError{Name: "period", err: fmt.Errorf(`model: validator failed for field "Budget.period": %w`, err)}
+		}
+	}
+	if _, ok := buo.mutation.ProjectID(); buo.mutation.ProjectCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "Budget.project"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (buo *BudgetUpdateOne) Set(obj *Budget) *BudgetUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*BudgetMutation)
+			db, err := mt.Client().Budget.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting Budget with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if obj.Description != "" {
+				if db.Description != obj.Description {
+					buo.SetDescription(obj.Description)
+				}
+			} else {
+				buo.ClearDescription()
+			}
+			if !reflect.ValueOf(obj.Labels).IsZero() {
+				if !reflect.DeepEqual(db.Labels, obj.Labels) {
+					buo.SetLabels(obj.Labels)
+				}
+			}
+			if !reflect.ValueOf(obj.Filters).IsZero() {
+				if !reflect.DeepEqual(db.Filters, obj.Filters) {
+					buo.SetFilters(obj.Filters)
+				}
+			} else {
+				buo.ClearFilters()
+			}
+			if db.Amount != obj.Amount {
+				buo.SetAmount(obj.Amount)
+			}
+			if db.Period != obj.Period {
+				buo.SetPeriod(obj.Period)
+			}
+			if obj.StartTime != nil {
+				if !reflect.DeepEqual(db.StartTime, obj.StartTime) {
+					buo.SetStartTime(*obj.StartTime)
+				}
+			} else {
+				buo.ClearStartTime()
+			}
+			if obj.EndTime != nil {
+				if !reflect.DeepEqual(db.EndTime, obj.EndTime) {
+					buo.SetEndTime(*obj.EndTime)
+				}
+			} else {
+				buo.ClearEndTime()
+			}
+			if !reflect.DeepEqual(db.Thresholds, obj.Thresholds) {
+				buo.SetThresholds(obj.Thresholds)
+			}
+			if !reflect.ValueOf(obj.Spend).IsZero() {
+				if !reflect.DeepEqual(db.Spend, obj.Spend) {
+					buo.SetSpend(obj.Spend)
+				}
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				buo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			buo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	buo.hooks = append(buo.hooks, h)
+
+	return buo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (buo *BudgetUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := buo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: buo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: buo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the Budget entity,
+// which is always good for cascading update operations.
+func (buo *BudgetUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Budget) error) (*Budget, error) {
+	obj, err := buo.Save(ctx)
+	if err != nil &&
+		(buo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := buo.getClientSet()
+
+	if obj == nil {
+		obj = buo.object
+	} else if x := buo.object; x != nil {
+		if _, set := buo.mutation.Field(budget.FieldDescription); set {
+			obj.Description = x.Description
+		}
+		if _, set := buo.mutation.Field(budget.FieldLabels); set {
+			obj.Labels = x.Labels
+		}
+		if _, set := buo.mutation.Field(budget.FieldFilters); set {
+			obj.Filters = x.Filters
+		}
+		if _, set := buo.mutation.Field(budget.FieldAmount); set {
+			obj.Amount = x.Amount
+		}
+		if _, set := buo.mutation.Field(budget.FieldPeriod); set {
+			obj.Period = x.Period
+		}
+		if _, set := buo.mutation.Field(budget.FieldStartTime); set {
+			obj.StartTime = x.StartTime
+		}
+		if _, set := buo.mutation.Field(budget.FieldEndTime); set {
+			obj.EndTime = x.EndTime
+		}
+		if _, set := buo.mutation.Field(budget.FieldThresholds); set {
+			obj.Thresholds = x.Thresholds
+		}
+		if _, set := buo.mutation.Field(budget.FieldSpend); set {
+			obj.Spend = x.Spend
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (buo *BudgetUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Budget) error) *Budget {
+	obj, err := buo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (buo *BudgetUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Budget) error) error {
+	_, err := buo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BudgetUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Budget) error) {
+	if err := buo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (buo *BudgetUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetUpdateOne {
+	buo.modifiers = append(buo.modifiers, modifiers...)
+	return buo
+}
+
+func (buo *BudgetUpdateOne) sqlSave(ctx context.Context) (_node *Budget, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budget.Table, budget.Columns, sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "Budget.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budget.FieldID)
+		for _, f := range fields {
+			if !budget.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != budget.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Description(); ok {
+		_spec.SetField(budget.FieldDescription, field.TypeString, value)
+	}
+	if buo.mutation.DescriptionCleared() {
+		_spec.ClearField(budget.FieldDescription, field.TypeString)
+	}
+	if value, ok := buo.mutation.Labels(); ok {
+		_spec.SetField(budget.FieldLabels, field.TypeJSON, value)
+	}
+	if buo.mutation.LabelsCleared() {
+		_spec.ClearField(budget.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.UpdateTime(); ok {
+		_spec.SetField(budget.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := buo.mutation.Filters(); ok {
+		_spec.SetField(budget.FieldFilters, field.TypeJSON, value)
+	}
+	if value, ok := buo.mutation.AppendedFilters(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, budget.FieldFilters, value)
+		})
+	}
+	if buo.mutation.FiltersCleared() {
+		_spec.ClearField(budget.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := buo.mutation.Amount(); ok {
+		_spec.SetField(budget.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := buo.mutation.AddedAmount(); ok {
+		_spec.AddField(budget.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := buo.mutation.Period(); ok {
+		_spec.SetField(budget.FieldPeriod, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.StartTime(); ok {
+		_spec.SetField(budget.FieldStartTime, field.TypeTime, value)
+	}
+	if buo.mutation.StartTimeCleared() {
+		_spec.ClearField(budget.FieldStartTime, field.TypeTime)
+	}
+	if value, ok := buo.mutation.EndTime(); ok {
+		_spec.SetField(budget.FieldEndTime, field.TypeTime, value)
+	}
+	if buo.mutation.EndTimeCleared() {
+		_spec.ClearField(budget.FieldEndTime, field.TypeTime)
+	}
+	if value, ok := buo.mutation.Thresholds(); ok {
+		_spec.SetField(budget.FieldThresholds, field.TypeJSON, value)
+	}
+	if value, ok := buo.mutation.AppendedThresholds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, budget.FieldThresholds, value)
+		})
+	}
+	if value, ok := buo.mutation.Spend(); ok {
+		_spec.SetField(budget.FieldSpend, field.TypeJSON, value)
+	}
+	if buo.mutation.SpendCleared() {
+		_spec.ClearField(budget.FieldSpend, field.TypeJSON)
+	}
+	if buo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   budget.EventsTable,
+			Columns: []string{budget.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = buo.schemaConfig.BudgetEvent
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !buo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   budget.EventsTable,
+			Columns: []string{budget.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = buo.schemaConfig.BudgetEvent
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   budget.EventsTable,
+			Columns: []string{budget.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = buo.schemaConfig.BudgetEvent
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = buo.schemaConfig.Budget
+	ctx = internal.NewSchemaConfigContext(ctx, buo.schemaConfig)
+	_spec.AddModifiers(buo.modifiers...)
+	_node = &Budget{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budget.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1173 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// BudgetCreateInput holds the creation input of the Budget entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create Budget entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to create Budget entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Amount of the budget within a period.
+	Amount float64 `path:"-" query:"-" json:"amount,cli-table-column"`
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Filters to narrow down the watched cost, within the project or environment.
+	Filters types.CostFilters `path:"-" query:"-" json:"filters,omitempty"`
+	// Period of the budget, monthly or custom.
+	Period string `path:"-" query:"-" json:"period,cli-table-column,omitempty"`
+	// Start time of the custom period.
+	StartTime *time.Time `path:"-" query:"-" json:"startTime,omitempty"`
+	// End time of the custom period.
+	EndTime *time.Time `path:"-" query:"-" json:"endTime,omitempty"`
+	// Thresholds to alert, in percentage of the amount.
+	Thresholds types.BudgetThresholds `path:"-" query:"-" json:"thresholds,omitempty"`
+}
+
+// Model returns the Budget entity for creating,
+// after validating.
+func (bci *BudgetCreateInput) Model() *Budget {
+	if bci == nil {
+		return nil
+	}
+
+	_b := &Budget{
+		Amount:      bci.Amount,
+		Name:        bci.Name,
+		Description: bci.Description,
+		Labels:      bci.Labels,
+		Filters:     bci.Filters,
+		Period:      bci.Period,
+		StartTime:   bci.StartTime,
+		EndTime:     bci.EndTime,
+		Thresholds:  bci.Thresholds,
+	}
+
+	if bci.Project != nil {
+		_b.ProjectID = bci.Project.ID
+	}
+	if bci.Environment != nil {
+		_b.EnvironmentID = bci.Environment.ID
+	}
+
+	return _b
+}
+
+// Validate checks the BudgetCreateInput entity.
+func (bci *BudgetCreateInput) Validate() error {
+	if bci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bci.ValidateWith(bci.inputConfig.Context, bci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetCreateInput entity with the given context and client set.
+func (bci *BudgetCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if bci.Project != nil {
+		if err := bci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+	// Validate when creating under the Environment route.
+	if bci.Environment != nil {
+		if err := bci.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				bci.Environment = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// BudgetCreateInputs holds the creation input item of the Budget entities.
+type BudgetCreateInputsItem struct {
+	// Amount of the budget within a period.
+	Amount float64 `path:"-" query:"-" json:"amount,cli-table-column"`
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Filters to narrow down the watched cost, within the project or environment.
+	Filters types.CostFilters `path:"-" query:"-" json:"filters,omitempty"`
+	// Period of the budget, monthly or custom.
+	Period string `path:"-" query:"-" json:"period,cli-table-column,omitempty"`
+	// Start time of the custom period.
+	StartTime *time.Time `path:"-" query:"-" json:"startTime,omitempty"`
+	// End time of the custom period.
+	EndTime *time.Time `path:"-" query:"-" json:"endTime,omitempty"`
+	// Thresholds to alert, in percentage of the amount.
+	Thresholds types.BudgetThresholds `path:"-" query:"-" json:"thresholds,omitempty"`
+}
+
+// ValidateWith checks the BudgetCreateInputsItem entity with the given context and client set.
+func (bci *BudgetCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// BudgetCreateInputs holds the creation input of the Budget entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create Budget entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to create Budget entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*BudgetCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the Budget entities for creating,
+// after validating.
+func (bci *BudgetCreateInputs) Model() []*Budget {
+	if bci == nil || len(bci.Items) == 0 {
+		return nil
+	}
+
+	_bs := make([]*Budget, len(bci.Items))
+
+	for i := range bci.Items {
+		_b := &Budget{
+			Amount:      bci.Items[i].Amount,
+			Name:        bci.Items[i].Name,
+			Description: bci.Items[i].Description,
+			Labels:      bci.Items[i].Labels,
+			Filters:     bci.Items[i].Filters,
+			Period:      bci.Items[i].Period,
+			StartTime:   bci.Items[i].StartTime,
+			EndTime:     bci.Items[i].EndTime,
+			Thresholds:  bci.Items[i].Thresholds,
+		}
+
+		if bci.Project != nil {
+			_b.ProjectID = bci.Project.ID
+		}
+		if bci.Environment != nil {
+			_b.EnvironmentID = bci.Environment.ID
+		}
+
+		_bs[i] = _b
+	}
+
+	return _bs
+}
+
+// Validate checks the BudgetCreateInputs entity .
+func (bci *BudgetCreateInputs) Validate() error {
+	if bci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bci.ValidateWith(bci.inputConfig.Context, bci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetCreateInputs entity with the given context and client set.
+func (bci *BudgetCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(bci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if bci.Project != nil {
+		if err := bci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				bci.Project = nil
+			}
+		}
+	}
+	// Validate when creating under the Environment route.
+	if bci.Environment != nil {
+		if err := bci.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				bci.Environment = nil
+			}
+		}
+	}
+
+	for i := range bci.Items {
+		if bci.Items[i] == nil {
+			continue
+		}
+
+		if err := bci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BudgetDeleteInput holds the deletion input of the Budget entity,
+// please tags with `path:",inline"` if embedding.
+type BudgetDeleteInput struct {
+	BudgetQueryInput `path:",inline"`
+}
+
+// BudgetDeleteInputs holds the deletion input item of the Budget entities.
+type BudgetDeleteInputsItem struct {
+	// ID of the Budget entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the Budget entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+}
+
+// BudgetDeleteInputs holds the deletion input of the Budget entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to delete Budget entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to delete Budget entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*BudgetDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the Budget entities for deleting,
+// after validating.
+func (bdi *BudgetDeleteInputs) Model() []*Budget {
+	if bdi == nil || len(bdi.Items) == 0 {
+		return nil
+	}
+
+	_bs := make([]*Budget, len(bdi.Items))
+	for i := range bdi.Items {
+		_bs[i] = &Budget{
+			ID: bdi.Items[i].ID,
+		}
+	}
+	return _bs
+}
+
+// IDs returns the ID list of the Budget entities for deleting,
+// after validating.
+func (bdi *BudgetDeleteInputs) IDs() []object.ID {
+	if bdi == nil || len(bdi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(bdi.Items))
+	for i := range bdi.Items {
+		ids[i] = bdi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the BudgetDeleteInputs entity.
+func (bdi *BudgetDeleteInputs) Validate() error {
+	if bdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bdi.ValidateWith(bdi.inputConfig.Context, bdi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetDeleteInputs entity with the given context and client set.
+func (bdi *BudgetDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(bdi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.Budgets().Query()
+
+	// Validate when deleting under the Project route.
+	if bdi.Project != nil {
+		if err := bdi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				budget.ProjectID(bdi.Project.ID))
+		}
+	}
+
+	// Validate when deleting under the Environment route.
+	if bdi.Environment != nil {
+		if err := bdi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				bdi.Environment = nil
+				q.Where(
+					budget.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				budget.EnvironmentID(bdi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			budget.EnvironmentIDIsNil())
+	}
+
+	ids := make([]object.ID, 0, len(bdi.Items))
+	ors := make([]predicate.Budget, 0, len(bdi.Items))
+	indexers := make(map[any][]int)
+
+	for i := range bdi.Items {
+		if bdi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if bdi.Items[i].ID != "" {
+			ids = append(ids, bdi.Items[i].ID)
+			ors = append(ors, budget.ID(bdi.Items[i].ID))
+			indexers[bdi.Items[i].ID] = append(indexers[bdi.Items[i].ID], i)
+		} else if bdi.Items[i].Name != "" {
+			ors = append(ors, budget.And(
+				budget.Name(bdi.Items[i].Name)))
+			indexerKey := fmt.Sprint("/", bdi.Items[i].Name)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := budget.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = budget.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			budget.FieldID,
+			budget.FieldName,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Name)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			bdi.Items[j].ID = es[i].ID
+			bdi.Items[j].Name = es[i].Name
+		}
+	}
+
+	return nil
+}
+
+// BudgetPatchInput holds the patch input of the Budget entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetPatchInput struct {
+	BudgetQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// Filters to narrow down the watched cost, within the project or environment.
+	Filters types.CostFilters `path:"-" query:"-" json:"filters,omitempty"`
+	// Amount of the budget within a period.
+	Amount float64 `path:"-" query:"-" json:"amount,omitempty"`
+	// Period of the budget, monthly or custom.
+	Period string `path:"-" query:"-" json:"period,omitempty"`
+	// Start time of the custom period.
+	StartTime *time.Time `path:"-" query:"-" json:"startTime,omitempty"`
+	// End time of the custom period.
+	EndTime *time.Time `path:"-" query:"-" json:"endTime,omitempty"`
+	// Thresholds to alert, in percentage of the amount.
+	Thresholds types.BudgetThresholds `path:"-" query:"-" json:"thresholds,omitempty"`
+	// Spend of the budget at the last evaluation.
+	Spend *types.BudgetSpend `path:"-" query:"-" json:"spend,omitempty"`
+
+	patchedEntity *Budget `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the Budget partition entity for patching.
+func (bpi *BudgetPatchInput) PatchModel() *Budget {
+	if bpi == nil {
+		return nil
+	}
+
+	_b := &Budget{
+		Name:        bpi.Name,
+		Description: bpi.Description,
+		Labels:      bpi.Labels,
+		CreateTime:  bpi.CreateTime,
+		UpdateTime:  bpi.UpdateTime,
+		Filters:     bpi.Filters,
+		Amount:      bpi.Amount,
+		Period:      bpi.Period,
+		StartTime:   bpi.StartTime,
+		EndTime:     bpi.EndTime,
+		Thresholds:  bpi.Thresholds,
+		Spend:       bpi.Spend,
+	}
+
+	if bpi.Project != nil {
+		_b.ProjectID = bpi.Project.ID
+	}
+	if bpi.Environment != nil {
+		_b.EnvironmentID = bpi.Environment.ID
+	}
+
+	return _b
+}
+
+// Model returns the Budget patched entity,
+// after validating.
+func (bpi *BudgetPatchInput) Model() *Budget {
+	if bpi == nil {
+		return nil
+	}
+
+	return bpi.patchedEntity
+}
+
+// Validate checks the BudgetPatchInput entity.
+func (bpi *BudgetPatchInput) Validate() error {
+	if bpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bpi.ValidateWith(bpi.inputConfig.Context, bpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetPatchInput entity with the given context and client set.
+func (bpi *BudgetPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := bpi.BudgetQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.Budgets().Query()
+
+	// Validate when querying under the Project route.
+	if bpi.Project != nil {
+		if err := bpi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				budget.ProjectID(bpi.Project.ID))
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if bpi.Environment != nil {
+		if err := bpi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				bpi.Environment = nil
+				q.Where(
+					budget.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				budget.EnvironmentID(bpi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			budget.EnvironmentIDIsNil())
+	}
+
+	if bpi.Refer != nil {
+		if bpi.Refer.IsID() {
+			q.Where(
+				budget.ID(bpi.Refer.ID()))
+		} else if refers := bpi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				budget.Name(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of budget")
+		}
+	} else if bpi.ID != "" {
+		q.Where(
+			budget.ID(bpi.ID))
+	} else if bpi.Name != "" {
+		q.Where(
+			budget.Name(bpi.Name))
+	} else {
+		return errors.New("invalid identify of budget")
+	}
+
+	q.Select(
+		budget.WithoutFields(
+			budget.FieldCreateTime,
+			budget.FieldUpdateTime,
+			budget.FieldSpend,
+		)...,
+	)
+
+	var e *Budget
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*Budget)
+		}
+	}
+
+	_pm := bpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*Budget)
+
+	if e.Name != _obj.Name {
+		return errors.New("field name is immutable")
+	}
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+
+	bpi.patchedEntity = _obj
+	return nil
+}
+
+// BudgetQueryInput holds the query input of the Budget entity,
+// please tags with `path:",inline"` if embedding.
+type BudgetQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query Budget entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"project"`
+	// Environment indicates to query Budget entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"environment,omitempty"`
+
+	// Refer holds the route path reference of the Budget entity.
+	Refer *object.Refer `path:"budget,default=" query:"-" json:"-"`
+	// ID of the Budget entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the Budget entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+}
+
+// Model returns the Budget entity for querying,
+// after validating.
+func (bqi *BudgetQueryInput) Model() *Budget {
+	if bqi == nil {
+		return nil
+	}
+
+	return &Budget{
+		ID:   bqi.ID,
+		Name: bqi.Name,
+	}
+}
+
+// Validate checks the BudgetQueryInput entity.
+func (bqi *BudgetQueryInput) Validate() error {
+	if bqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bqi.ValidateWith(bqi.inputConfig.Context, bqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetQueryInput entity with the given context and client set.
+func (bqi *BudgetQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if bqi.Refer != nil && *bqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", budget.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.Budgets().Query()
+
+	// Validate when querying under the Project route.
+	if bqi.Project != nil {
+		if err := bqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				budget.ProjectID(bqi.Project.ID))
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if bqi.Environment != nil {
+		if err := bqi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				bqi.Environment = nil
+				q.Where(
+					budget.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				budget.EnvironmentID(bqi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			budget.EnvironmentIDIsNil())
+	}
+
+	if bqi.Refer != nil {
+		if bqi.Refer.IsID() {
+			q.Where(
+				budget.ID(bqi.Refer.ID()))
+		} else if refers := bqi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				budget.Name(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of budget")
+		}
+	} else if bqi.ID != "" {
+		q.Where(
+			budget.ID(bqi.ID))
+	} else if bqi.Name != "" {
+		q.Where(
+			budget.Name(bqi.Name))
+	} else {
+		return errors.New("invalid identify of budget")
+	}
+
+	q.Select(
+		budget.FieldID,
+		budget.FieldName,
+	)
+
+	var e *Budget
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*Budget)
+		}
+	}
+
+	bqi.ID = e.ID
+	bqi.Name = e.Name
+	return nil
+}
+
+// BudgetQueryInputs holds the query input of the Budget entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type BudgetQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query Budget entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to query Budget entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Validate checks the BudgetQueryInputs entity.
+func (bqi *BudgetQueryInputs) Validate() error {
+	if bqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bqi.ValidateWith(bqi.inputConfig.Context, bqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetQueryInputs entity with the given context and client set.
+func (bqi *BudgetQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when querying under the Project route.
+	if bqi.Project != nil {
+		if err := bqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if bqi.Environment != nil {
+		if err := bqi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				bqi.Environment = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// BudgetUpdateInput holds the modification input of the Budget entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetUpdateInput struct {
+	BudgetQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Filters to narrow down the watched cost, within the project or environment.
+	Filters types.CostFilters `path:"-" query:"-" json:"filters,omitempty"`
+	// Amount of the budget within a period.
+	Amount float64 `path:"-" query:"-" json:"amount,omitempty"`
+	// Period of the budget, monthly or custom.
+	Period string `path:"-" query:"-" json:"period,omitempty"`
+	// Start time of the custom period.
+	StartTime *time.Time `path:"-" query:"-" json:"startTime,omitempty"`
+	// End time of the custom period.
+	EndTime *time.Time `path:"-" query:"-" json:"endTime,omitempty"`
+	// Thresholds to alert, in percentage of the amount.
+	Thresholds types.BudgetThresholds `path:"-" query:"-" json:"thresholds,omitempty"`
+}
+
+// Model returns the Budget entity for modifying,
+// after validating.
+func (bui *BudgetUpdateInput) Model() *Budget {
+	if bui == nil {
+		return nil
+	}
+
+	_b := &Budget{
+		ID:          bui.ID,
+		Name:        bui.Name,
+		Description: bui.Description,
+		Labels:      bui.Labels,
+		Filters:     bui.Filters,
+		Amount:      bui.Amount,
+		Period:      bui.Period,
+		StartTime:   bui.StartTime,
+		EndTime:     bui.EndTime,
+		Thresholds:  bui.Thresholds,
+	}
+
+	return _b
+}
+
+// Validate checks the BudgetUpdateInput entity.
+func (bui *BudgetUpdateInput) Validate() error {
+	if bui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bui.ValidateWith(bui.inputConfig.Context, bui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetUpdateInput entity with the given context and client set.
+func (bui *BudgetUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := bui.BudgetQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BudgetUpdateInputs holds the modification input item of the Budget entities.
+type BudgetUpdateInputsItem struct {
+	// ID of the Budget entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the Budget entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Filters to narrow down the watched cost, within the project or environment.
+	Filters types.CostFilters `path:"-" query:"-" json:"filters,omitempty"`
+	// Amount of the budget within a period.
+	Amount float64 `path:"-" query:"-" json:"amount,cli-table-column"`
+	// Period of the budget, monthly or custom.
+	Period string `path:"-" query:"-" json:"period,cli-table-column"`
+	// Start time of the custom period.
+	StartTime *time.Time `path:"-" query:"-" json:"startTime,omitempty"`
+	// End time of the custom period.
+	EndTime *time.Time `path:"-" query:"-" json:"endTime,omitempty"`
+	// Thresholds to alert, in percentage of the amount.
+	Thresholds types.BudgetThresholds `path:"-" query:"-" json:"thresholds"`
+}
+
+// ValidateWith checks the BudgetUpdateInputsItem entity with the given context and client set.
+func (bui *BudgetUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// BudgetUpdateInputs holds the modification input of the Budget entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to update Budget entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to update Budget entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*BudgetUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the Budget entities for modifying,
+// after validating.
+func (bui *BudgetUpdateInputs) Model() []*Budget {
+	if bui == nil || len(bui.Items) == 0 {
+		return nil
+	}
+
+	_bs := make([]*Budget, len(bui.Items))
+
+	for i := range bui.Items {
+		_b := &Budget{
+			ID:          bui.Items[i].ID,
+			Name:        bui.Items[i].Name,
+			Description: bui.Items[i].Description,
+			Labels:      bui.Items[i].Labels,
+			Filters:     bui.Items[i].Filters,
+			Amount:      bui.Items[i].Amount,
+			Period:      bui.Items[i].Period,
+			StartTime:   bui.Items[i].StartTime,
+			EndTime:     bui.Items[i].EndTime,
+			Thresholds:  bui.Items[i].Thresholds,
+		}
+
+		_bs[i] = _b
+	}
+
+	return _bs
+}
+
+// IDs returns the ID list of the Budget entities for modifying,
+// after validating.
+func (bui *BudgetUpdateInputs) IDs() []object.ID {
+	if bui == nil || len(bui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(bui.Items))
+	for i := range bui.Items {
+		ids[i] = bui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the BudgetUpdateInputs entity.
+func (bui *BudgetUpdateInputs) Validate() error {
+	if bui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bui.ValidateWith(bui.inputConfig.Context, bui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetUpdateInputs entity with the given context and client set.
+func (bui *BudgetUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(bui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.Budgets().Query()
+
+	// Validate when updating under the Project route.
+	if bui.Project != nil {
+		if err := bui.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				budget.ProjectID(bui.Project.ID))
+		}
+	}
+
+	// Validate when updating under the Environment route.
+	if bui.Environment != nil {
+		if err := bui.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				bui.Environment = nil
+				q.Where(
+					budget.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				budget.EnvironmentID(bui.Environment.ID))
+		}
+	} else {
+		q.Where(
+			budget.EnvironmentIDIsNil())
+	}
+
+	ids := make([]object.ID, 0, len(bui.Items))
+	ors := make([]predicate.Budget, 0, len(bui.Items))
+	indexers := make(map[any][]int)
+
+	for i := range bui.Items {
+		if bui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if bui.Items[i].ID != "" {
+			ids = append(ids, bui.Items[i].ID)
+			ors = append(ors, budget.ID(bui.Items[i].ID))
+			indexers[bui.Items[i].ID] = append(indexers[bui.Items[i].ID], i)
+		} else if bui.Items[i].Name != "" {
+			ors = append(ors, budget.And(
+				budget.Name(bui.Items[i].Name)))
+			indexerKey := fmt.Sprint("/", bui.Items[i].Name)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := budget.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = budget.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			budget.FieldID,
+			budget.FieldName,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Name)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			bui.Items[j].ID = es[i].ID
+			bui.Items[j].Name = es[i].Name
+		}
+	}
+
+	for i := range bui.Items {
+		if err := bui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BudgetOutput holds the output of the Budget entity.
+type BudgetOutput struct {
+	ID          object.ID              `json:"id,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Labels      map[string]string      `json:"labels,omitempty"`
+	CreateTime  *time.Time             `json:"createTime,omitempty"`
+	UpdateTime  *time.Time             `json:"updateTime,omitempty"`
+	Filters     types.CostFilters      `json:"filters,omitempty"`
+	Amount      float64                `json:"amount,cli-table-column,omitempty"`
+	Period      string                 `json:"period,cli-table-column,omitempty"`
+	StartTime   *time.Time             `json:"startTime,omitempty"`
+	EndTime     *time.Time             `json:"endTime,omitempty"`
+	Thresholds  types.BudgetThresholds `json:"thresholds,omitempty"`
+	Spend       *types.BudgetSpend     `json:"spend,omitempty"`
+
+	Project     *ProjectOutput     `json:"project,omitempty"`
+	Environment *EnvironmentOutput `json:"environment,omitempty"`
+}
+
+// View returns the output of Budget entity.
+func (_b *Budget) View() *BudgetOutput {
+	return ExposeBudget(_b)
+}
+
+// View returns the output of Budget entities.
+func (_bs Budgets) View() []*BudgetOutput {
+	return ExposeBudgets(_bs)
+}
+
+// ExposeBudget converts the Budget to BudgetOutput.
+func ExposeBudget(_b *Budget) *BudgetOutput {
+	if _b == nil {
+		return nil
+	}
+
+	bo := &BudgetOutput{
+		ID:          _b.ID,
+		Name:        _b.Name,
+		Description: _b.Description,
+		Labels:      _b.Labels,
+		CreateTime:  _b.CreateTime,
+		UpdateTime:  _b.UpdateTime,
+		Filters:     _b.Filters,
+		Amount:      _b.Amount,
+		Period:      _b.Period,
+		StartTime:   _b.StartTime,
+		EndTime:     _b.EndTime,
+		Thresholds:  _b.Thresholds,
+		Spend:       _b.Spend,
+	}
+
+	if _b.Edges.Project != nil {
+		bo.Project = ExposeProject(_b.Edges.Project)
+	} else if _b.ProjectID != "" {
+		bo.Project = &ProjectOutput{
+			ID: _b.ProjectID,
+		}
+	}
+	if _b.Edges.Environment != nil {
+		bo.Environment = ExposeEnvironment(_b.Edges.Environment)
+	} else if _b.EnvironmentID != "" {
+		bo.Environment = &EnvironmentOutput{
+			ID: _b.EnvironmentID,
+		}
+	}
+	return bo
+}
+
+// ExposeBudgets converts the Budget slice to BudgetOutput pointer slice.
+func ExposeBudgets(_bs []*Budget) []*BudgetOutput {
+	if len(_bs) == 0 {
+		return nil
+	}
+
+	bos := make([]*BudgetOutput, len(_bs))
+	for i := range _bs {
+		bos[i] = ExposeBudget(_bs[i])
+	}
+	return bos
+}
@@ -0,0 +1,282 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// BudgetEvent is the model entity for the BudgetEvent schema.
+type BudgetEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// ID of the project to belong.
+	ProjectID object.ID `json:"project_id,omitempty"`
+	// ID of the environment which the budget watches.
+	EnvironmentID object.ID `json:"environment_id,omitempty"`
+	// ID of the budget to belong.
+	BudgetID object.ID `json:"budget_id,omitempty"`
+	// Name of the budget.
+	Name string `json:"name,omitempty"`
+	// Start time of the period which the event occurs in.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// End time of the period which the event occurs in.
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// Type of the breached threshold, actual or forecast.
+	ThresholdType string `json:"threshold_type,omitempty"`
+	// Percentage of the breached threshold.
+	ThresholdPercent float64 `json:"threshold_percent,omitempty"`
+	// Amount of the budget.
+	Amount float64 `json:"amount,omitempty"`
+	// Actual or forecast cost breaching the threshold.
+	Cost float64 `json:"cost,omitempty"`
+	// Message of the event.
+	Message string `json:"message,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BudgetEventQuery when eager-loading is set.
+	Edges        BudgetEventEdges `json:"edges,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// BudgetEventEdges holds the relations/edges for other nodes in the graph.
+type BudgetEventEdges struct {
+	// Budget to which the event belongs.
+	Budget *Budget `json:"budget,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BudgetOrErr returns the Budget value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BudgetEventEdges) BudgetOrErr() (*Budget, error) {
+	if e.loadedTypes[0] {
+		if e.Budget == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: budget.Label}
+		}
+		return e.Budget, nil
+	}
+	return nil, &NotLoadedError{edge: "budget"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BudgetEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case budgetevent.FieldID, budgetevent.FieldProjectID, budgetevent.FieldEnvironmentID, budgetevent.FieldBudgetID:
+			values[i] = new(object.ID)
+		case budgetevent.FieldThresholdPercent, budgetevent.FieldAmount, budgetevent.FieldCost:
+			values[i] = new(sql.NullFloat64)
+		case budgetevent.FieldName, budgetevent.FieldThresholdType, budgetevent.FieldMessage:
+			values[i] = new(sql.NullString)
+		case budgetevent.FieldCreateTime, budgetevent.FieldUpdateTime, budgetevent.FieldPeriodStart, budgetevent.FieldPeriodEnd:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BudgetEvent fields.
+func (be *BudgetEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case budgetevent.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				be.ID = *value
+			}
+		case budgetevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				be.CreateTime = new(time.Time)
+				*be.CreateTime = value.Time
+			}
+		case budgetevent.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				be.UpdateTime = new(time.Time)
+				*be.UpdateTime = value.Time
+			}
+		case budgetevent.FieldProjectID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				be.ProjectID = *value
+			}
+		case budgetevent.FieldEnvironmentID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value != nil {
+				be.EnvironmentID = *value
+			}
+		case budgetevent.FieldBudgetID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field budget_id", values[i])
+			} else if value != nil {
+				be.BudgetID = *value
+			}
+		case budgetevent.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				be.Name = value.String
+			}
+		case budgetevent.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				be.PeriodStart = value.Time
+			}
+		case budgetevent.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				be.PeriodEnd = value.Time
+			}
+		case budgetevent.FieldThresholdType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold_type", values[i])
+			} else if value.Valid {
+				be.ThresholdType = value.String
+			}
+		case budgetevent.FieldThresholdPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold_percent", values[i])
+			} else if value.Valid {
+				be.ThresholdPercent = value.Float64
+			}
+		case budgetevent.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				be.Amount = value.Float64
+			}
+		case budgetevent.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				be.Cost = value.Float64
+			}
+		case budgetevent.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				be.Message = value.String
+			}
+		default:
+			be.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BudgetEvent.
+// This includes values selected through modifiers, order, etc.
+func (be *BudgetEvent) Value(name string) (ent.Value, error) {
+	return be.selectValues.Get(name)
+}
+
+// QueryBudget queries the "budget" edge of the BudgetEvent entity.
+func (be *BudgetEvent) QueryBudget() *BudgetQuery {
+	return NewBudgetEventClient(be.config).QueryBudget(be)
+}
+
+// Update returns a builder for updating this BudgetEvent.
+// Note that you need to call BudgetEvent.Unwrap() before calling this method if this BudgetEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (be *BudgetEvent) Update() *BudgetEventUpdateOne {
+	return NewBudgetEventClient(be.config).UpdateOne(be)
+}
+
+// Unwrap unwraps the BudgetEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (be *BudgetEvent) Unwrap() *BudgetEvent {
+	_tx, ok := be.config.driver.(*txDriver)
+	if !ok {
+		panic("model: BudgetEvent is not a transactional entity")
+	}
+	be.config.driver = _tx.drv
+	return be
+}
+
+// String implements the fmt.Stringer.
+func (be *BudgetEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("BudgetEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", be.ID))
+	if v := be.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := be.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", be.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", be.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("budget_id=")
+	builder.WriteString(fmt.Sprintf("%v", be.BudgetID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(be.Name)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(be.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(be.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("threshold_type=")
+	builder.WriteString(be.ThresholdType)
+	builder.WriteString(", ")
+	builder.WriteString("threshold_percent=")
+	builder.WriteString(fmt.Sprintf("%v", be.ThresholdPercent))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", be.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", be.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(be.Message)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BudgetEvents is a parsable slice of BudgetEvent.
+type BudgetEvents []*BudgetEvent
@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package budgetevent
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// Label holds the string label denoting the budgetevent type in the database.
+	Label = "budget_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldEnvironmentID holds the string denoting the environment_id field in the database.
+	FieldEnvironmentID = "environment_id"
+	// FieldBudgetID holds the string denoting the budget_id field in the database.
+	FieldBudgetID = "budget_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldThresholdType holds the string denoting the threshold_type field in the database.
+	FieldThresholdType = "threshold_type"
+	// FieldThresholdPercent holds the string denoting the threshold_percent field in the database.
+	FieldThresholdPercent = "threshold_percent"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// EdgeBudget holds the string denoting the budget edge name in mutations.
+	EdgeBudget = "budget"
+	// Table holds the table name of the budgetevent in the database.
+	Table = "budget_events"
+	// BudgetTable is the table that holds the budget relation/edge.
+	BudgetTable = "budget_events"
+	// BudgetInverseTable is the table name for the Budget entity.
+	// It exists in this package in order to avoid circular dependency with the "budget" package.
+	BudgetInverseTable = "budgets"
+	// BudgetColumn is the table column denoting the budget relation/edge.
+	BudgetColumn = "budget_id"
+)
+
+// Columns holds all SQL columns for budgetevent fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldProjectID,
+	FieldEnvironmentID,
+	FieldBudgetID,
+	FieldName,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldThresholdType,
+	FieldThresholdPercent,
+	FieldAmount,
+	FieldCost,
+	FieldMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	ProjectIDValidator func(string) error
+	// BudgetIDValidator is a validator for the "budget_id" field. It is called by the builders before save.
+	BudgetIDValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// ThresholdTypeValidator is a validator for the "threshold_type" field. It is called by the builders before save.
+	ThresholdTypeValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the BudgetEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByEnvironmentID orders the results by the environment_id field.
+func ByEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnvironmentID, opts...).ToFunc()
+}
+
+// ByBudgetID orders the results by the budget_id field.
+func ByBudgetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBudgetID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByThresholdType orders the results by the threshold_type field.
+func ByThresholdType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThresholdType, opts...).ToFunc()
+}
+
+// ByThresholdPercent orders the results by the threshold_percent field.
+func ByThresholdPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThresholdPercent, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByCost orders the results by the cost field.
+func ByCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCost, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByBudgetField orders the results by budget field.
+func ByBudgetField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBudgetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BudgetTable, BudgetColumn),
+	)
+}
+
+// WithoutFields returns the fields ignored the given list.
+func WithoutFields(ignores ...string) []string {
+	if len(ignores) == 0 {
+		return slices.Clone(Columns)
+	}
+
+	var s = make(map[string]bool, len(ignores))
+	for i := range ignores {
+		s[ignores[i]] = true
+	}
+
+	var r = make([]string, 0, len(Columns)-len(s))
+	for i := range Columns {
+		if s[Columns[i]] {
+			continue
+		}
+		r = append(r, Columns[i])
+	}
+	return r
+}
@@ -0,0 +1,876 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package budgetevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldProjectID, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// BudgetID applies equality check predicate on the "budget_id" field. It's identical to BudgetIDEQ.
+func BudgetID(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldBudgetID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldName, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// ThresholdType applies equality check predicate on the "threshold_type" field. It's identical to ThresholdTypeEQ.
+func ThresholdType(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldThresholdType, v))
+}
+
+// ThresholdPercent applies equality check predicate on the "threshold_percent" field. It's identical to ThresholdPercentEQ.
+func ThresholdPercent(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldThresholdPercent, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldAmount, v))
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldCost, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDContains applies the Contains predicate on the "project_id" field.
+func ProjectIDContains(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldContains(FieldProjectID, vc))
+}
+
+// ProjectIDHasPrefix applies the HasPrefix predicate on the "project_id" field.
+func ProjectIDHasPrefix(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldHasPrefix(FieldProjectID, vc))
+}
+
+// ProjectIDHasSuffix applies the HasSuffix predicate on the "project_id" field.
+func ProjectIDHasSuffix(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldHasSuffix(FieldProjectID, vc))
+}
+
+// ProjectIDEqualFold applies the EqualFold predicate on the "project_id" field.
+func ProjectIDEqualFold(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldEqualFold(FieldProjectID, vc))
+}
+
+// ProjectIDContainsFold applies the ContainsFold predicate on the "project_id" field.
+func ProjectIDContainsFold(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldContainsFold(FieldProjectID, vc))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldContains(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldHasPrefix(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldHasSuffix(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldEqualFold(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldContainsFold(FieldEnvironmentID, vc))
+}
+
+// BudgetIDEQ applies the EQ predicate on the "budget_id" field.
+func BudgetIDEQ(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldBudgetID, v))
+}
+
+// BudgetIDNEQ applies the NEQ predicate on the "budget_id" field.
+func BudgetIDNEQ(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldBudgetID, v))
+}
+
+// BudgetIDIn applies the In predicate on the "budget_id" field.
+func BudgetIDIn(vs ...object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldBudgetID, vs...))
+}
+
+// BudgetIDNotIn applies the NotIn predicate on the "budget_id" field.
+func BudgetIDNotIn(vs ...object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldBudgetID, vs...))
+}
+
+// BudgetIDGT applies the GT predicate on the "budget_id" field.
+func BudgetIDGT(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldBudgetID, v))
+}
+
+// BudgetIDGTE applies the GTE predicate on the "budget_id" field.
+func BudgetIDGTE(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldBudgetID, v))
+}
+
+// BudgetIDLT applies the LT predicate on the "budget_id" field.
+func BudgetIDLT(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldBudgetID, v))
+}
+
+// BudgetIDLTE applies the LTE predicate on the "budget_id" field.
+func BudgetIDLTE(v object.ID) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldBudgetID, v))
+}
+
+// BudgetIDContains applies the Contains predicate on the "budget_id" field.
+func BudgetIDContains(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldContains(FieldBudgetID, vc))
+}
+
+// BudgetIDHasPrefix applies the HasPrefix predicate on the "budget_id" field.
+func BudgetIDHasPrefix(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldHasPrefix(FieldBudgetID, vc))
+}
+
+// BudgetIDHasSuffix applies the HasSuffix predicate on the "budget_id" field.
+func BudgetIDHasSuffix(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldHasSuffix(FieldBudgetID, vc))
+}
+
+// BudgetIDEqualFold applies the EqualFold predicate on the "budget_id" field.
+func BudgetIDEqualFold(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldEqualFold(FieldBudgetID, vc))
+}
+
+// BudgetIDContainsFold applies the ContainsFold predicate on the "budget_id" field.
+func BudgetIDContainsFold(v object.ID) predicate.BudgetEvent {
+	vc := string(v)
+	return predicate.BudgetEvent(sql.FieldContainsFold(FieldBudgetID, vc))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldContainsFold(FieldName, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// ThresholdTypeEQ applies the EQ predicate on the "threshold_type" field.
+func ThresholdTypeEQ(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldThresholdType, v))
+}
+
+// ThresholdTypeNEQ applies the NEQ predicate on the "threshold_type" field.
+func ThresholdTypeNEQ(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldThresholdType, v))
+}
+
+// ThresholdTypeIn applies the In predicate on the "threshold_type" field.
+func ThresholdTypeIn(vs ...string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldThresholdType, vs...))
+}
+
+// ThresholdTypeNotIn applies the NotIn predicate on the "threshold_type" field.
+func ThresholdTypeNotIn(vs ...string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldThresholdType, vs...))
+}
+
+// ThresholdTypeGT applies the GT predicate on the "threshold_type" field.
+func ThresholdTypeGT(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldThresholdType, v))
+}
+
+// ThresholdTypeGTE applies the GTE predicate on the "threshold_type" field.
+func ThresholdTypeGTE(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldThresholdType, v))
+}
+
+// ThresholdTypeLT applies the LT predicate on the "threshold_type" field.
+func ThresholdTypeLT(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldThresholdType, v))
+}
+
+// ThresholdTypeLTE applies the LTE predicate on the "threshold_type" field.
+func ThresholdTypeLTE(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldThresholdType, v))
+}
+
+// ThresholdTypeContains applies the Contains predicate on the "threshold_type" field.
+func ThresholdTypeContains(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldContains(FieldThresholdType, v))
+}
+
+// ThresholdTypeHasPrefix applies the HasPrefix predicate on the "threshold_type" field.
+func ThresholdTypeHasPrefix(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldHasPrefix(FieldThresholdType, v))
+}
+
+// ThresholdTypeHasSuffix applies the HasSuffix predicate on the "threshold_type" field.
+func ThresholdTypeHasSuffix(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldHasSuffix(FieldThresholdType, v))
+}
+
+// ThresholdTypeEqualFold applies the EqualFold predicate on the "threshold_type" field.
+func ThresholdTypeEqualFold(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEqualFold(FieldThresholdType, v))
+}
+
+// ThresholdTypeContainsFold applies the ContainsFold predicate on the "threshold_type" field.
+func ThresholdTypeContainsFold(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldContainsFold(FieldThresholdType, v))
+}
+
+// ThresholdPercentEQ applies the EQ predicate on the "threshold_percent" field.
+func ThresholdPercentEQ(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldThresholdPercent, v))
+}
+
+// ThresholdPercentNEQ applies the NEQ predicate on the "threshold_percent" field.
+func ThresholdPercentNEQ(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldThresholdPercent, v))
+}
+
+// ThresholdPercentIn applies the In predicate on the "threshold_percent" field.
+func ThresholdPercentIn(vs ...float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldThresholdPercent, vs...))
+}
+
+// ThresholdPercentNotIn applies the NotIn predicate on the "threshold_percent" field.
+func ThresholdPercentNotIn(vs ...float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldThresholdPercent, vs...))
+}
+
+// ThresholdPercentGT applies the GT predicate on the "threshold_percent" field.
+func ThresholdPercentGT(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldThresholdPercent, v))
+}
+
+// ThresholdPercentGTE applies the GTE predicate on the "threshold_percent" field.
+func ThresholdPercentGTE(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldThresholdPercent, v))
+}
+
+// ThresholdPercentLT applies the LT predicate on the "threshold_percent" field.
+func ThresholdPercentLT(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldThresholdPercent, v))
+}
+
+// ThresholdPercentLTE applies the LTE predicate on the "threshold_percent" field.
+func ThresholdPercentLTE(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldThresholdPercent, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldAmount, v))
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldCost, v))
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldCost, v))
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldCost, vs...))
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldCost, vs...))
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldCost, v))
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldCost, v))
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldCost, v))
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldCost, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// HasBudget applies the HasEdge predicate on the "budget" edge.
+func HasBudget() predicate.BudgetEvent {
+	return predicate.BudgetEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BudgetTable, BudgetColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.BudgetEvent
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetWith applies the HasEdge predicate on the "budget" edge with a given conditions (other predicates).
+func HasBudgetWith(preds ...predicate.Budget) predicate.BudgetEvent {
+	return predicate.BudgetEvent(func(s *sql.Selector) {
+		step := newBudgetStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.BudgetEvent
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BudgetEvent) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BudgetEvent) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BudgetEvent) predicate.BudgetEvent {
+	return predicate.BudgetEvent(sql.NotPredicates(p))
+}
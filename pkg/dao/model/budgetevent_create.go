@@ -0,0 +1,1141 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// BudgetEventCreate is the builder for creating a BudgetEvent entity.
+type BudgetEventCreate struct {
+	config
+	mutation   *BudgetEventMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *BudgetEvent
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (bec *BudgetEventCreate) SetCreateTime(t time.Time) *BudgetEventCreate {
+	bec.mutation.SetCreateTime(t)
+	return bec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (bec *BudgetEventCreate) SetNillableCreateTime(t *time.Time) *BudgetEventCreate {
+	if t != nil {
+		bec.SetCreateTime(*t)
+	}
+	return bec
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (bec *BudgetEventCreate) SetUpdateTime(t time.Time) *BudgetEventCreate {
+	bec.mutation.SetUpdateTime(t)
+	return bec
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (bec *BudgetEventCreate) SetNillableUpdateTime(t *time.Time) *BudgetEventCreate {
+	if t != nil {
+		bec.SetUpdateTime(*t)
+	}
+	return bec
+}
+
+// SetProjectID sets the "project_id" field.
+func (bec *BudgetEventCreate) SetProjectID(o object.ID) *BudgetEventCreate {
+	bec.mutation.SetProjectID(o)
+	return bec
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (bec *BudgetEventCreate) SetEnvironmentID(o object.ID) *BudgetEventCreate {
+	bec.mutation.SetEnvironmentID(o)
+	return bec
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (bec *BudgetEventCreate) SetNillableEnvironmentID(o *object.ID) *BudgetEventCreate {
+	if o != nil {
+		bec.SetEnvironmentID(*o)
+	}
+	return bec
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (bec *BudgetEventCreate) SetBudgetID(o object.ID) *BudgetEventCreate {
+	bec.mutation.SetBudgetID(o)
+	return bec
+}
+
+// SetName sets the "name" field.
+func (bec *BudgetEventCreate) SetName(s string) *BudgetEventCreate {
+	bec.mutation.SetName(s)
+	return bec
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (bec *BudgetEventCreate) SetPeriodStart(t time.Time) *BudgetEventCreate {
+	bec.mutation.SetPeriodStart(t)
+	return bec
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (bec *BudgetEventCreate) SetPeriodEnd(t time.Time) *BudgetEventCreate {
+	bec.mutation.SetPeriodEnd(t)
+	return bec
+}
+
+// SetThresholdType sets the "threshold_type" field.
+func (bec *BudgetEventCreate) SetThresholdType(s string) *BudgetEventCreate {
+	bec.mutation.SetThresholdType(s)
+	return bec
+}
+
+// SetThresholdPercent sets the "threshold_percent" field.
+func (bec *BudgetEventCreate) SetThresholdPercent(f float64) *BudgetEventCreate {
+	bec.mutation.SetThresholdPercent(f)
+	return bec
+}
+
+// SetAmount sets the "amount" field.
+func (bec *BudgetEventCreate) SetAmount(f float64) *BudgetEventCreate {
+	bec.mutation.SetAmount(f)
+	return bec
+}
+
+// SetCost sets the "cost" field.
+func (bec *BudgetEventCreate) SetCost(f float64) *BudgetEventCreate {
+	bec.mutation.SetCost(f)
+	return bec
+}
+
+// SetMessage sets the "message" field.
+func (bec *BudgetEventCreate) SetMessage(s string) *BudgetEventCreate {
+	bec.mutation.SetMessage(s)
+	return bec
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (bec *BudgetEventCreate) SetNillableMessage(s *string) *BudgetEventCreate {
+	if s != nil {
+		bec.SetMessage(*s)
+	}
+	return bec
+}
+
+// SetID sets the "id" field.
+func (bec *BudgetEventCreate) SetID(o object.ID) *BudgetEventCreate {
+	bec.mutation.SetID(o)
+	return bec
+}
+
+// SetBudget sets the "budget" edge to the Budget entity.
+func (bec *BudgetEventCreate) SetBudget(b *Budget) *BudgetEventCreate {
+	return bec.SetBudgetID(b.ID)
+}
+
+// Mutation returns the BudgetEventMutation object of the builder.
+func (bec *BudgetEventCreate) Mutation() *BudgetEventMutation {
+	return bec.mutation
+}
+
+// Save creates the BudgetEvent in the database.
+func (bec *BudgetEventCreate) Save(ctx context.Context) (*BudgetEvent, error) {
+	if err := bec.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, bec.sqlSave, bec.mutation, bec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bec *BudgetEventCreate) SaveX(ctx context.Context) *BudgetEvent {
+	v, err := bec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bec *BudgetEventCreate) Exec(ctx context.Context) error {
+	_, err := bec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bec *BudgetEventCreate) ExecX(ctx context.Context) {
+	if err := bec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bec *BudgetEventCreate) defaults() error {
+	if _, ok := bec.mutation.CreateTime(); !ok {
+		if budgetevent.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized budgetevent.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := budgetevent.DefaultCreateTime()
+		bec.mutation.SetCreateTime(v)
+	}
+	if _, ok := bec.mutation.UpdateTime(); !ok {
+		if budgetevent.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized budgetevent.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := budgetevent.DefaultUpdateTime()
+		bec.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bec *BudgetEventCreate) check() error {
+	if _, ok := bec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "BudgetEvent.create_time"`)}
+	}
+	if _, ok := bec.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "BudgetEvent.update_time"`)}
+	}
+	if _, ok := bec.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`model: missing required field "BudgetEvent.project_id"`)}
+	}
+	if v, ok := bec.mutation.ProjectID(); ok {
+		if err := budgetevent.ProjectIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "project_id", err: fmt.Errorf(`model: validator failed for field "BudgetEvent.project_id": %w`, err)}
+		}
+	}
+	if _, ok := bec.mutation.BudgetID(); !ok {
+		return &ValidationError{Name: "budget_id", err: errors.New(`model: missing required field "BudgetEvent.budget_id"`)}
+	}
+	if v, ok := bec.mutation.BudgetID(); ok {
+		if err := budgetevent.BudgetIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "budget_id", err: fmt.Errorf(`model: validator failed for field "BudgetEvent.budget_id": %w`, err)}
+		}
+	}
+	if _, ok := bec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`model: missing required field "BudgetEvent.name"`)}
+	}
+	if v, ok := bec.mutation.Name(); ok {
+		if err := budgetevent.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`model: validator failed for field "BudgetEvent.name": %w`, err)}
+		}
+	}
+	if _, ok := bec.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`model: missing required field "BudgetEvent.period_start"`)}
+	}
+	if _, ok := bec.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`model: missing required field "BudgetEvent.period_end"`)}
+	}
+	if _, ok := bec.mutation.ThresholdType(); !ok {
+		return &ValidationError{Name: "threshold_type", err: errors.New(`model: missing required field "BudgetEvent.threshold_type"`)}
+	}
+	if v, ok := bec.mutation.ThresholdType(); ok {
+		if err := budgetevent.ThresholdTypeValidator(v); err != nil {
+			return &ValidationError{Name: "threshold_type", err: fmt.Errorf(`model: validator failed for field "BudgetEvent.threshold_type": %w`, err)}
+		}
+	}
+	if _, ok := bec.mutation.ThresholdPercent(); !ok {
+		return &ValidationError{Name: "threshold_percent", err: errors.New(`model: missing required field "BudgetEvent.threshold_percent"`)}
+	}
+	if _, ok := bec.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`model: missing required field "BudgetEvent.amount"`)}
+	}
+	if _, ok := bec.mutation.Cost(); !ok {
+		return &ValidationError{Name: "cost", err: errors.New(`model: missing required field "BudgetEvent.cost"`)}
+	}
+	if _, ok := bec.mutation.BudgetID(); !ok {
+		return &ValidationError{Name: "budget", err: errors.New(`model: missing required edge "BudgetEvent.budget"`)}
+	}
+	return nil
+}
+
+func (bec *BudgetEventCreate) sqlSave(ctx context.Context) (*BudgetEvent, error) {
+	if err := bec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	bec.mutation.id = &_node.ID
+	bec.mutation.done = true
+	return _node, nil
+}
+
+func (bec *BudgetEventCreate) createSpec() (*BudgetEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BudgetEvent{config: bec.config}
+		_spec = sqlgraph.NewCreateSpec(budgetevent.Table, sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString))
+	)
+	_spec.Schema = bec.schemaConfig.BudgetEvent
+	_spec.OnConflict = bec.conflict
+	if id, ok := bec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bec.mutation.CreateTime(); ok {
+		_spec.SetField(budgetevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := bec.mutation.UpdateTime(); ok {
+		_spec.SetField(budgetevent.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := bec.mutation.ProjectID(); ok {
+		_spec.SetField(budgetevent.FieldProjectID, field.TypeString, value)
+		_node.ProjectID = value
+	}
+	if value, ok := bec.mutation.EnvironmentID(); ok {
+		_spec.SetField(budgetevent.FieldEnvironmentID, field.TypeString, value)
+		_node.EnvironmentID = value
+	}
+	if value, ok := bec.mutation.Name(); ok {
+		_spec.SetField(budgetevent.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := bec.mutation.PeriodStart(); ok {
+		_spec.SetField(budgetevent.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := bec.mutation.PeriodEnd(); ok {
+		_spec.SetField(budgetevent.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := bec.mutation.ThresholdType(); ok {
+		_spec.SetField(budgetevent.FieldThresholdType, field.TypeString, value)
+		_node.ThresholdType = value
+	}
+	if value, ok := bec.mutation.ThresholdPercent(); ok {
+		_spec.SetField(budgetevent.FieldThresholdPercent, field.TypeFloat64, value)
+		_node.ThresholdPercent = value
+	}
+	if value, ok := bec.mutation.Amount(); ok {
+		_spec.SetField(budgetevent.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := bec.mutation.Cost(); ok {
+		_spec.SetField(budgetevent.FieldCost, field.TypeFloat64, value)
+		_node.Cost = value
+	}
+	if value, ok := bec.mutation.Message(); ok {
+		_spec.SetField(budgetevent.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if nodes := bec.mutation.BudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   budgetevent.BudgetTable,
+			Columns: []string{budgetevent.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = bec.schemaConfig.BudgetEvent
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BudgetID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (bec *BudgetEventCreate) Set(obj *BudgetEvent) *BudgetEventCreate {
+	// Required.
+	bec.SetProjectID(obj.ProjectID)
+	bec.SetBudgetID(obj.BudgetID)
+	bec.SetName(obj.Name)
+	bec.SetPeriodStart(obj.PeriodStart)
+	bec.SetPeriodEnd(obj.PeriodEnd)
+	bec.SetThresholdType(obj.ThresholdType)
+	bec.SetThresholdPercent(obj.ThresholdPercent)
+	bec.SetAmount(obj.Amount)
+	bec.SetCost(obj.Cost)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		bec.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		bec.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.EnvironmentID != "" {
+		bec.SetEnvironmentID(obj.EnvironmentID)
+	}
+	if obj.Message != "" {
+		bec.SetMessage(obj.Message)
+	}
+
+	// Record the given object.
+	bec.object = obj
+
+	return bec
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (bec *BudgetEventCreate) getClientSet() (mc ClientSet) {
+	if _, ok := bec.config.driver.(*txDriver); ok {
+		tx := &Tx{config: bec.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: bec.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the BudgetEvent entity,
+// which is always good for cascading create operations.
+func (bec *BudgetEventCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *BudgetEvent) error) (*BudgetEvent, error) {
+	obj, err := bec.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := bec.getClientSet()
+
+	if x := bec.object; x != nil {
+		if _, set := bec.mutation.Field(budgetevent.FieldProjectID); set {
+			obj.ProjectID = x.ProjectID
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldEnvironmentID); set {
+			obj.EnvironmentID = x.EnvironmentID
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldBudgetID); set {
+			obj.BudgetID = x.BudgetID
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldName); set {
+			obj.Name = x.Name
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldPeriodStart); set {
+			obj.PeriodStart = x.PeriodStart
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldPeriodEnd); set {
+			obj.PeriodEnd = x.PeriodEnd
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldThresholdType); set {
+			obj.ThresholdType = x.ThresholdType
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldThresholdPercent); set {
+			obj.ThresholdPercent = x.ThresholdPercent
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldAmount); set {
+			obj.Amount = x.Amount
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldCost); set {
+			obj.Cost = x.Cost
+		}
+		if _, set := bec.mutation.Field(budgetevent.FieldMessage); set {
+			obj.Message = x.Message
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (bec *BudgetEventCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *BudgetEvent) error) *BudgetEvent {
+	obj, err := bec.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (bec *BudgetEventCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *BudgetEvent) error) error {
+	_, err := bec.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (bec *BudgetEventCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *BudgetEvent) error) {
+	if err := bec.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the BudgetEventCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (becb *BudgetEventCreateBulk) Set(objs ...*BudgetEvent) *BudgetEventCreateBulk {
+	if len(objs) != 0 {
+		client := NewBudgetEventClient(becb.config)
+
+		becb.builders = make([]*BudgetEventCreate, len(objs))
+		for i := range objs {
+			becb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		becb.objects = objs
+	}
+
+	return becb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (becb *BudgetEventCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := becb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: becb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: becb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the BudgetEvent entities,
+// which is always good for cascading create operations.
+func (becb *BudgetEventCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *BudgetEvent) error) ([]*BudgetEvent, error) {
+	objs, err := becb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := becb.getClientSet()
+
+	if x := becb.objects; x != nil {
+		for i := range x {
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldProjectID); set {
+				objs[i].ProjectID = x[i].ProjectID
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldEnvironmentID); set {
+				objs[i].EnvironmentID = x[i].EnvironmentID
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldBudgetID); set {
+				objs[i].BudgetID = x[i].BudgetID
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldName); set {
+				objs[i].Name = x[i].Name
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldPeriodStart); set {
+				objs[i].PeriodStart = x[i].PeriodStart
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldPeriodEnd); set {
+				objs[i].PeriodEnd = x[i].PeriodEnd
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldThresholdType); set {
+				objs[i].ThresholdType = x[i].ThresholdType
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldThresholdPercent); set {
+				objs[i].ThresholdPercent = x[i].ThresholdPercent
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldAmount); set {
+				objs[i].Amount = x[i].Amount
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldCost); set {
+				objs[i].Cost = x[i].Cost
+			}
+			if _, set := becb.builders[i].mutation.Field(budgetevent.FieldMessage); set {
+				objs[i].Message = x[i].Message
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (becb *BudgetEventCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *BudgetEvent) error) []*BudgetEvent {
+	objs, err := becb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (becb *BudgetEventCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *BudgetEvent) error) error {
+	_, err := becb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (becb *BudgetEventCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *BudgetEvent) error) {
+	if err := becb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *BudgetEventUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *BudgetEvent) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for BudgetEventUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *BudgetEventUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *BudgetEvent) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *BudgetEventUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *BudgetEvent) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the BudgetEventUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for BudgetEventUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *BudgetEventUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *BudgetEvent) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetEvent.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetEventUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (bec *BudgetEventCreate) OnConflict(opts ...sql.ConflictOption) *BudgetEventUpsertOne {
+	bec.conflict = opts
+	return &BudgetEventUpsertOne{
+		create: bec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bec *BudgetEventCreate) OnConflictColumns(columns ...string) *BudgetEventUpsertOne {
+	bec.conflict = append(bec.conflict, sql.ConflictColumns(columns...))
+	return &BudgetEventUpsertOne{
+		create: bec,
+	}
+}
+
+type (
+	// BudgetEventUpsertOne is the builder for "upsert"-ing
+	//  one BudgetEvent node.
+	BudgetEventUpsertOne struct {
+		create *BudgetEventCreate
+	}
+
+	// BudgetEventUpsert is the "OnConflict" setter.
+	BudgetEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BudgetEventUpsert) SetUpdateTime(v time.Time) *BudgetEventUpsert {
+	u.Set(budgetevent.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BudgetEventUpsert) UpdateUpdateTime() *BudgetEventUpsert {
+	u.SetExcluded(budgetevent.FieldUpdateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BudgetEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budgetevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetEventUpsertOne) UpdateNewValues() *BudgetEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(budgetevent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(budgetevent.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(budgetevent.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.EnvironmentID(); exists {
+			s.SetIgnore(budgetevent.FieldEnvironmentID)
+		}
+		if _, exists := u.create.mutation.BudgetID(); exists {
+			s.SetIgnore(budgetevent.FieldBudgetID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(budgetevent.FieldName)
+		}
+		if _, exists := u.create.mutation.PeriodStart(); exists {
+			s.SetIgnore(budgetevent.FieldPeriodStart)
+		}
+		if _, exists := u.create.mutation.PeriodEnd(); exists {
+			s.SetIgnore(budgetevent.FieldPeriodEnd)
+		}
+		if _, exists := u.create.mutation.ThresholdType(); exists {
+			s.SetIgnore(budgetevent.FieldThresholdType)
+		}
+		if _, exists := u.create.mutation.ThresholdPercent(); exists {
+			s.SetIgnore(budgetevent.FieldThresholdPercent)
+		}
+		if _, exists := u.create.mutation.Amount(); exists {
+			s.SetIgnore(budgetevent.FieldAmount)
+		}
+		if _, exists := u.create.mutation.Cost(); exists {
+			s.SetIgnore(budgetevent.FieldCost)
+		}
+		if _, exists := u.create.mutation.Message(); exists {
+			s.SetIgnore(budgetevent.FieldMessage)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetEventUpsertOne) Ignore() *BudgetEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetEventUpsertOne) DoNothing() *BudgetEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetEventCreate.OnConflict
+// documentation for more info.
+func (u *BudgetEventUpsertOne) Update(set func(*BudgetEventUpsert)) *BudgetEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BudgetEventUpsertOne) SetUpdateTime(v time.Time) *BudgetEventUpsertOne {
+	return u.Update(func(s *BudgetEventUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BudgetEventUpsertOne) UpdateUpdateTime() *BudgetEventUpsertOne {
+	return u.Update(func(s *BudgetEventUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for BudgetEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetEventUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: BudgetEventUpsertOne.ID is not supported by MySQL driver. Use BudgetEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetEventUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetEventCreateBulk is the builder for creating many BudgetEvent entities in bulk.
+type BudgetEventCreateBulk struct {
+	config
+	err        error
+	builders   []*BudgetEventCreate
+	conflict   []sql.ConflictOption
+	objects    []*BudgetEvent
+	fromUpsert bool
+}
+
+// Save creates the BudgetEvent entities in the database.
+func (becb *BudgetEventCreateBulk) Save(ctx context.Context) ([]*BudgetEvent, error) {
+	if becb.err != nil {
+		return nil, becb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(becb.builders))
+	nodes := make([]*BudgetEvent, len(becb.builders))
+	mutators := make([]Mutator, len(becb.builders))
+	for i := range becb.builders {
+		func(i int, root context.Context) {
+			builder := becb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, becb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = becb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, becb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, becb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (becb *BudgetEventCreateBulk) SaveX(ctx context.Context) []*BudgetEvent {
+	v, err := becb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (becb *BudgetEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := becb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (becb *BudgetEventCreateBulk) ExecX(ctx context.Context) {
+	if err := becb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetEventUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (becb *BudgetEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetEventUpsertBulk {
+	becb.conflict = opts
+	return &BudgetEventUpsertBulk{
+		create: becb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (becb *BudgetEventCreateBulk) OnConflictColumns(columns ...string) *BudgetEventUpsertBulk {
+	becb.conflict = append(becb.conflict, sql.ConflictColumns(columns...))
+	return &BudgetEventUpsertBulk{
+		create: becb,
+	}
+}
+
+// BudgetEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of BudgetEvent nodes.
+type BudgetEventUpsertBulk struct {
+	create *BudgetEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BudgetEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budgetevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetEventUpsertBulk) UpdateNewValues() *BudgetEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(budgetevent.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(budgetevent.FieldCreateTime)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(budgetevent.FieldProjectID)
+			}
+			if _, exists := b.mutation.EnvironmentID(); exists {
+				s.SetIgnore(budgetevent.FieldEnvironmentID)
+			}
+			if _, exists := b.mutation.BudgetID(); exists {
+				s.SetIgnore(budgetevent.FieldBudgetID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(budgetevent.FieldName)
+			}
+			if _, exists := b.mutation.PeriodStart(); exists {
+				s.SetIgnore(budgetevent.FieldPeriodStart)
+			}
+			if _, exists := b.mutation.PeriodEnd(); exists {
+				s.SetIgnore(budgetevent.FieldPeriodEnd)
+			}
+			if _, exists := b.mutation.ThresholdType(); exists {
+				s.SetIgnore(budgetevent.FieldThresholdType)
+			}
+			if _, exists := b.mutation.ThresholdPercent(); exists {
+				s.SetIgnore(budgetevent.FieldThresholdPercent)
+			}
+			if _, exists := b.mutation.Amount(); exists {
+				s.SetIgnore(budgetevent.FieldAmount)
+			}
+			if _, exists := b.mutation.Cost(); exists {
+				s.SetIgnore(budgetevent.FieldCost)
+			}
+			if _, exists := b.mutation.Message(); exists {
+				s.SetIgnore(budgetevent.FieldMessage)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetEventUpsertBulk) Ignore() *BudgetEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetEventUpsertBulk) DoNothing() *BudgetEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetEventUpsertBulk) Update(set func(*BudgetEventUpsert)) *BudgetEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *BudgetEventUpsertBulk) SetUpdateTime(v time.Time) *BudgetEventUpsertBulk {
+	return u.Update(func(s *BudgetEventUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *BudgetEventUpsertBulk) UpdateUpdateTime() *BudgetEventUpsertBulk {
+	return u.Update(func(s *BudgetEventUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the BudgetEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for BudgetEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+// BudgetEventDelete is the builder for deleting a BudgetEvent entity.
+type BudgetEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *BudgetEventMutation
+}
+
+// Where appends a list predicates to the BudgetEventDelete builder.
+func (bed *BudgetEventDelete) Where(ps ...predicate.BudgetEvent) *BudgetEventDelete {
+	bed.mutation.Where(ps...)
+	return bed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bed *BudgetEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bed.sqlExec, bed.mutation, bed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bed *BudgetEventDelete) ExecX(ctx context.Context) int {
+	n, err := bed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bed *BudgetEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(budgetevent.Table, sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString))
+	_spec.Node.Schema = bed.schemaConfig.BudgetEvent
+	ctx = internal.NewSchemaConfigContext(ctx, bed.schemaConfig)
+	if ps := bed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bed.mutation.done = true
+	return affected, err
+}
+
+// BudgetEventDeleteOne is the builder for deleting a single BudgetEvent entity.
+type BudgetEventDeleteOne struct {
+	bed *BudgetEventDelete
+}
+
+// Where appends a list predicates to the BudgetEventDelete builder.
+func (bedo *BudgetEventDeleteOne) Where(ps ...predicate.BudgetEvent) *BudgetEventDeleteOne {
+	bedo.bed.mutation.Where(ps...)
+	return bedo
+}
+
+// Exec executes the deletion query.
+func (bedo *BudgetEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := bedo.bed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{budgetevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bedo *BudgetEventDeleteOne) ExecX(ctx context.Context) {
+	if err := bedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,680 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// BudgetEventQuery is the builder for querying BudgetEvent entities.
+type BudgetEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []budgetevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BudgetEvent
+	withBudget *BudgetQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BudgetEventQuery builder.
+func (beq *BudgetEventQuery) Where(ps ...predicate.BudgetEvent) *BudgetEventQuery {
+	beq.predicates = append(beq.predicates, ps...)
+	return beq
+}
+
+// Limit the number of records to be returned by this query.
+func (beq *BudgetEventQuery) Limit(limit int) *BudgetEventQuery {
+	beq.ctx.Limit = &limit
+	return beq
+}
+
+// Offset to start from.
+func (beq *BudgetEventQuery) Offset(offset int) *BudgetEventQuery {
+	beq.ctx.Offset = &offset
+	return beq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (beq *BudgetEventQuery) Unique(unique bool) *BudgetEventQuery {
+	beq.ctx.Unique = &unique
+	return beq
+}
+
+// Order specifies how the records should be ordered.
+func (beq *BudgetEventQuery) Order(o ...budgetevent.OrderOption) *BudgetEventQuery {
+	beq.order = append(beq.order, o...)
+	return beq
+}
+
+// QueryBudget chains the current query on the "budget" edge.
+func (beq *BudgetEventQuery) QueryBudget() *BudgetQuery {
+	query := (&BudgetClient{config: beq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := beq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := beq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budgetevent.Table, budgetevent.FieldID, selector),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budgetevent.BudgetTable, budgetevent.BudgetColumn),
+		)
+		schemaConfig := beq.schemaConfig
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.BudgetEvent
+		fromU = sqlgraph.SetNeighbors(beq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BudgetEvent entity from the query.
+// Returns a *NotFoundError when no BudgetEvent was found.
+func (beq *BudgetEventQuery) First(ctx context.Context) (*BudgetEvent, error) {
+	nodes, err := beq.Limit(1).All(setContextOp(ctx, beq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{budgetevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (beq *BudgetEventQuery) FirstX(ctx context.Context) *BudgetEvent {
+	node, err := beq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BudgetEvent ID from the query.
+// Returns a *NotFoundError when no BudgetEvent ID was found.
+func (beq *BudgetEventQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = beq.Limit(1).IDs(setContextOp(ctx, beq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{budgetevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (beq *BudgetEventQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := beq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BudgetEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BudgetEvent entity is found.
+// Returns a *NotFoundError when no BudgetEvent entities are found.
+func (beq *BudgetEventQuery) Only(ctx context.Context) (*BudgetEvent, error) {
+	nodes, err := beq.Limit(2).All(setContextOp(ctx, beq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{budgetevent.Label}
+	default:
+		return nil, &NotSingularError{budgetevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (beq *BudgetEventQuery) OnlyX(ctx context.Context) *BudgetEvent {
+	node, err := beq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BudgetEvent ID in the query.
+// Returns a *NotSingularError when more than one BudgetEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (beq *BudgetEventQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = beq.Limit(2).IDs(setContextOp(ctx, beq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{budgetevent.Label}
+	default:
+		err = &NotSingularError{budgetevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (beq *BudgetEventQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := beq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BudgetEvents.
+func (beq *BudgetEventQuery) All(ctx context.Context) ([]*BudgetEvent, error) {
+	ctx = setContextOp(ctx, beq.ctx, "All")
+	if err := beq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BudgetEvent, *BudgetEventQuery]()
+	return withInterceptors[[]*BudgetEvent](ctx, beq, qr, beq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (beq *BudgetEventQuery) AllX(ctx context.Context) []*BudgetEvent {
+	nodes, err := beq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BudgetEvent IDs.
+func (beq *BudgetEventQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if beq.ctx.Unique == nil && beq.path != nil {
+		beq.Unique(true)
+	}
+	ctx = setContextOp(ctx, beq.ctx, "IDs")
+	if err = beq.Select(budgetevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (beq *BudgetEventQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := beq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (beq *BudgetEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, beq.ctx, "Count")
+	if err := beq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, beq, querierCount[*BudgetEventQuery](), beq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (beq *BudgetEventQuery) CountX(ctx context.Context) int {
+	count, err := beq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (beq *BudgetEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, beq.ctx, "Exist")
+	switch _, err := beq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (beq *BudgetEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := beq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BudgetEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (beq *BudgetEventQuery) Clone() *BudgetEventQuery {
+	if beq == nil {
+		return nil
+	}
+	return &BudgetEventQuery{
+		config:     beq.config,
+		ctx:        beq.ctx.Clone(),
+		order:      append([]budgetevent.OrderOption{}, beq.order...),
+		inters:     append([]Interceptor{}, beq.inters...),
+		predicates: append([]predicate.BudgetEvent{}, beq.predicates...),
+		withBudget: beq.withBudget.Clone(),
+		// clone intermediate query.
+		sql:  beq.sql.Clone(),
+		path: beq.path,
+	}
+}
+
+// WithBudget tells the query-builder to eager-load the nodes that are connected to
+// the "budget" edge. The optional arguments are used to configure the query builder of the edge.
+func (beq *BudgetEventQuery) WithBudget(opts ...func(*BudgetQuery)) *BudgetEventQuery {
+	query := (&BudgetClient{config: beq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	beq.withBudget = query
+	return beq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BudgetEvent.Query().
+//		GroupBy(budgetevent.FieldCreateTime).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (beq *BudgetEventQuery) GroupBy(field string, fields ...string) *BudgetEventGroupBy {
+	beq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BudgetEventGroupBy{build: beq}
+	grbuild.flds = &beq.ctx.Fields
+	grbuild.label = budgetevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.BudgetEvent.Query().
+//		Select(budgetevent.FieldCreateTime).
+//		Scan(ctx, &v)
+func (beq *BudgetEventQuery) Select(fields ...string) *BudgetEventSelect {
+	beq.ctx.Fields = append(beq.ctx.Fields, fields...)
+	sbuild := &BudgetEventSelect{BudgetEventQuery: beq}
+	sbuild.label = budgetevent.Label
+	sbuild.flds, sbuild.scan = &beq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BudgetEventSelect configured with the given aggregations.
+func (beq *BudgetEventQuery) Aggregate(fns ...AggregateFunc) *BudgetEventSelect {
+	return beq.Select().Aggregate(fns...)
+}
+
+func (beq *BudgetEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range beq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, beq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range beq.ctx.Fields {
+		if !budgetevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if beq.path != nil {
+		prev, err := beq.path(ctx)
+		if err != nil {
+			return err
+		}
+		beq.sql = prev
+	}
+	return nil
+}
+
+func (beq *BudgetEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BudgetEvent, error) {
+	var (
+		nodes       = []*BudgetEvent{}
+		_spec       = beq.querySpec()
+		loadedTypes = [1]bool{
+			beq.withBudget != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BudgetEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BudgetEvent{config: beq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = beq.schemaConfig.BudgetEvent
+	ctx = internal.NewSchemaConfigContext(ctx, beq.schemaConfig)
+	if len(beq.modifiers) > 0 {
+		_spec.Modifiers = beq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, beq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := beq.withBudget; query != nil {
+		if err := beq.loadBudget(ctx, query, nodes, nil,
+			func(n *BudgetEvent, e *Budget) { n.Edges.Budget = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (beq *BudgetEventQuery) loadBudget(ctx context.Context, query *BudgetQuery, nodes []*BudgetEvent, init func(*BudgetEvent), assign func(*BudgetEvent, *Budget)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*BudgetEvent)
+	for i := range nodes {
+		fk := nodes[i].BudgetID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(budget.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "budget_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (beq *BudgetEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := beq.querySpec()
+	_spec.Node.Schema = beq.schemaConfig.BudgetEvent
+	ctx = internal.NewSchemaConfigContext(ctx, beq.schemaConfig)
+	if len(beq.modifiers) > 0 {
+		_spec.Modifiers = beq.modifiers
+	}
+	_spec.Node.Columns = beq.ctx.Fields
+	if len(beq.ctx.Fields) > 0 {
+		_spec.Unique = beq.ctx.Unique != nil && *beq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, beq.driver, _spec)
+}
+
+func (beq *BudgetEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(budgetevent.Table, budgetevent.Columns, sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString))
+	_spec.From = beq.sql
+	if unique := beq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if beq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := beq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budgetevent.FieldID)
+		for i := range fields {
+			if fields[i] != budgetevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if beq.withBudget != nil {
+			_spec.Node.AddColumnOnce(budgetevent.FieldBudgetID)
+		}
+	}
+	if ps := beq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := beq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := beq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := beq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (beq *BudgetEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(beq.driver.Dialect())
+	t1 := builder.Table(budgetevent.Table)
+	columns := beq.ctx.Fields
+	if len(columns) == 0 {
+		columns = budgetevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if beq.sql != nil {
+		selector = beq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if beq.ctx.Unique != nil && *beq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(beq.schemaConfig.BudgetEvent)
+	ctx = internal.NewSchemaConfigContext(ctx, beq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range beq.modifiers {
+		m(selector)
+	}
+	for _, p := range beq.predicates {
+		p(selector)
+	}
+	for _, p := range beq.order {
+		p(selector)
+	}
+	if offset := beq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := beq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (beq *BudgetEventQuery) ForUpdate(opts ...sql.LockOption) *BudgetEventQuery {
+	if beq.driver.Dialect() == dialect.Postgres {
+		beq.Unique(false)
+	}
+	beq.modifiers = append(beq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return beq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (beq *BudgetEventQuery) ForShare(opts ...sql.LockOption) *BudgetEventQuery {
+	if beq.driver.Dialect() == dialect.Postgres {
+		beq.Unique(false)
+	}
+	beq.modifiers = append(beq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return beq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (beq *BudgetEventQuery) Modify(modifiers ...func(s *sql.Selector)) *BudgetEventSelect {
+	beq.modifiers = append(beq.modifiers, modifiers...)
+	return beq.Select()
+}
+
+// WhereP appends storage-level predicates to the BudgetEventQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (beq *BudgetEventQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.BudgetEvent, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.BudgetEvent(ps[i]))
+	}
+	beq.predicates = append(beq.predicates, wps...)
+}
+
+// BudgetEventGroupBy is the group-by builder for BudgetEvent entities.
+type BudgetEventGroupBy struct {
+	selector
+	build *BudgetEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (begb *BudgetEventGroupBy) Aggregate(fns ...AggregateFunc) *BudgetEventGroupBy {
+	begb.fns = append(begb.fns, fns...)
+	return begb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (begb *BudgetEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, begb.build.ctx, "GroupBy")
+	if err := begb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BudgetEventQuery, *BudgetEventGroupBy](ctx, begb.build, begb, begb.build.inters, v)
+}
+
+func (begb *BudgetEventGroupBy) sqlScan(ctx context.Context, root *BudgetEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(begb.fns))
+	for _, fn := range begb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*begb.flds)+len(begb.fns))
+		for _, f := range *begb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*begb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := begb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BudgetEventSelect is the builder for selecting fields of BudgetEvent entities.
+type BudgetEventSelect struct {
+	*BudgetEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bes *BudgetEventSelect) Aggregate(fns ...AggregateFunc) *BudgetEventSelect {
+	bes.fns = append(bes.fns, fns...)
+	return bes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bes *BudgetEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bes.ctx, "Select")
+	if err := bes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BudgetEventQuery, *BudgetEventSelect](ctx, bes.BudgetEventQuery, bes, bes.inters, v)
+}
+
+func (bes *BudgetEventSelect) sqlScan(ctx context.Context, root *BudgetEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bes.fns))
+	for _, fn := range bes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bes *BudgetEventSelect) Modify(modifiers ...func(s *sql.Selector)) *BudgetEventSelect {
+	bes.modifiers = append(bes.modifiers, modifiers...)
+	return bes
+}
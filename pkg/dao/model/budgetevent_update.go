@@ -0,0 +1,461 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+// BudgetEventUpdate is the builder for updating BudgetEvent entities.
+type BudgetEventUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BudgetEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *BudgetEvent
+}
+
+// Where appends a list predicates to the BudgetEventUpdate builder.
+func (beu *BudgetEventUpdate) Where(ps ...predicate.BudgetEvent) *BudgetEventUpdate {
+	beu.mutation.Where(ps...)
+	return beu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (beu *BudgetEventUpdate) SetUpdateTime(t time.Time) *BudgetEventUpdate {
+	beu.mutation.SetUpdateTime(t)
+	return beu
+}
+
+// Mutation returns the BudgetEventMutation object of the builder.
+func (beu *BudgetEventUpdate) Mutation() *BudgetEventMutation {
+	return beu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (beu *BudgetEventUpdate) Save(ctx context.Context) (int, error) {
+	if err := beu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, beu.sqlSave, beu.mutation, beu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (beu *BudgetEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := beu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (beu *BudgetEventUpdate) Exec(ctx context.Context) error {
+	_, err := beu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (beu *BudgetEventUpdate) ExecX(ctx context.Context) {
+	if err := beu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (beu *BudgetEventUpdate) defaults() error {
+	if _, ok := beu.mutation.UpdateTime(); !ok {
+		if budgetevent.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized budgetevent.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := budgetevent.UpdateDefaultUpdateTime()
+		beu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (beu *BudgetEventUpdate) check() error {
+	if _, ok := beu.mutation.BudgetID(); beu.mutation.BudgetCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "BudgetEvent.budget"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (beu *BudgetEventUpdate) Set(obj *BudgetEvent) *BudgetEventUpdate {
+	// Without Default.
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		beu.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	beu.object = obj
+
+	return beu
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (beu *BudgetEventUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetEventUpdate {
+	beu.modifiers = append(beu.modifiers, modifiers...)
+	return beu
+}
+
+func (beu *BudgetEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := beu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budgetevent.Table, budgetevent.Columns, sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString))
+	if ps := beu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := beu.mutation.UpdateTime(); ok {
+		_spec.SetField(budgetevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if beu.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(budgetevent.FieldEnvironmentID, field.TypeString)
+	}
+	if beu.mutation.MessageCleared() {
+		_spec.ClearField(budgetevent.FieldMessage, field.TypeString)
+	}
+	_spec.Node.Schema = beu.schemaConfig.BudgetEvent
+	ctx = internal.NewSchemaConfigContext(ctx, beu.schemaConfig)
+	_spec.AddModifiers(beu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, beu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	beu.mutation.done = true
+	return n, nil
+}
+
+// BudgetEventUpdateOne is the builder for updating a single BudgetEvent entity.
+type BudgetEventUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BudgetEventMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *BudgetEvent
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (beuo *BudgetEventUpdateOne) SetUpdateTime(t time.Time) *BudgetEventUpdateOne {
+	beuo.mutation.SetUpdateTime(t)
+	return beuo
+}
+
+// Mutation returns the BudgetEventMutation object of the builder.
+func (beuo *BudgetEventUpdateOne) Mutation() *BudgetEventMutation {
+	return beuo.mutation
+}
+
+// Where appends a list predicates to the BudgetEventUpdate builder.
+func (beuo *BudgetEventUpdateOne) Where(ps ...predicate.BudgetEvent) *BudgetEventUpdateOne {
+	beuo.mutation.Where(ps...)
+	return beuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (beuo *BudgetEventUpdateOne) Select(field string, fields ...string) *BudgetEventUpdateOne {
+	beuo.fields = append([]string{field}, fields...)
+	return beuo
+}
+
+// Save executes the query and returns the updated BudgetEvent entity.
+func (beuo *BudgetEventUpdateOne) Save(ctx context.Context) (*BudgetEvent, error) {
+	if err := beuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, beuo.sqlSave, beuo.mutation, beuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (beuo *BudgetEventUpdateOne) SaveX(ctx context.Context) *BudgetEvent {
+	node, err := beuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (beuo *BudgetEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := beuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (beuo *BudgetEventUpdateOne) ExecX(ctx context.Context) {
+	if err := beuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (beuo *BudgetEventUpdateOne) defaults() error {
+	if _, ok := beuo.mutation.UpdateTime(); !ok {
+		if budgetevent.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized budgetevent.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := budgetevent.UpdateDefaultUpdateTime()
+		beuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (beuo *BudgetEventUpdateOne) check() error {
+	if _, ok := beuo.mutation.BudgetID(); beuo.mutation.BudgetCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "BudgetEvent.budget"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (beuo *BudgetEventUpdateOne) Set(obj *BudgetEvent) *BudgetEventUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*BudgetEventMutation)
+			db, err := mt.Client().BudgetEvent.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting BudgetEvent with id: %v", *mt.id)
+			}
+
+			// Without Default.
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				beuo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			beuo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	beuo.hooks = append(beuo.hooks, h)
+
+	return beuo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (beuo *BudgetEventUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := beuo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: beuo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: beuo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the BudgetEvent entity,
+// which is always good for cascading update operations.
+func (beuo *BudgetEventUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *BudgetEvent) error) (*BudgetEvent, error) {
+	obj, err := beuo.Save(ctx)
+	if err != nil &&
+		(beuo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := beuo.getClientSet()
+
+	if obj == nil {
+		obj = beuo.object
+	} else if x := beuo.object; x != nil {
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (beuo *BudgetEventUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *BudgetEvent) error) *BudgetEvent {
+	obj, err := beuo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (beuo *BudgetEventUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *BudgetEvent) error) error {
+	_, err := beuo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (beuo *BudgetEventUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *BudgetEvent) error) {
+	if err := beuo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (beuo *BudgetEventUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetEventUpdateOne {
+	beuo.modifiers = append(beuo.modifiers, modifiers...)
+	return beuo
+}
+
+func (beuo *BudgetEventUpdateOne) sqlSave(ctx context.Context) (_node *BudgetEvent, err error) {
+	if err := beuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(budgetevent.Table, budgetevent.Columns, sqlgraph.NewFieldSpec(budgetevent.FieldID, field.TypeString))
+	id, ok := beuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "BudgetEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := beuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budgetevent.FieldID)
+		for _, f := range fields {
+			if !budgetevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != budgetevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := beuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := beuo.mutation.UpdateTime(); ok {
+		_spec.SetField(budgetevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if beuo.mutation.EnvironmentIDCleared() {
+		_spec.ClearField(budgetevent.FieldEnvironmentID, field.TypeString)
+	}
+	if beuo.mutation.MessageCleared() {
+		_spec.ClearField(budgetevent.FieldMessage, field.TypeString)
+	}
+	_spec.Node.Schema = beuo.schemaConfig.BudgetEvent
+	ctx = internal.NewSchemaConfigContext(ctx, beuo.schemaConfig)
+	_spec.AddModifiers(beuo.modifiers...)
+	_node = &BudgetEvent{config: beuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, beuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	beuo.mutation.done = true
+	return _node, nil
+}
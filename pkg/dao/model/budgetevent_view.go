@@ -0,0 +1,840 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// BudgetEventCreateInput holds the creation input of the BudgetEvent entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetEventCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Actual or forecast cost breaching the threshold.
+	Cost float64 `path:"-" query:"-" json:"cost"`
+	// Amount of the budget.
+	Amount float64 `path:"-" query:"-" json:"amount"`
+	// Percentage of the breached threshold.
+	ThresholdPercent float64 `path:"-" query:"-" json:"thresholdPercent"`
+	// Type of the breached threshold, actual or forecast.
+	ThresholdType string `path:"-" query:"-" json:"thresholdType"`
+	// End time of the period which the event occurs in.
+	PeriodEnd time.Time `path:"-" query:"-" json:"periodEnd"`
+	// Start time of the period which the event occurs in.
+	PeriodStart time.Time `path:"-" query:"-" json:"periodStart"`
+	// Name of the budget.
+	Name string `path:"-" query:"-" json:"name"`
+	// ID of the project to belong.
+	ProjectID object.ID `path:"-" query:"-" json:"projectID"`
+	// ID of the environment which the budget watches.
+	EnvironmentID object.ID `path:"-" query:"-" json:"environmentID,omitempty"`
+	// Message of the event.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+}
+
+// Model returns the BudgetEvent entity for creating,
+// after validating.
+func (beci *BudgetEventCreateInput) Model() *BudgetEvent {
+	if beci == nil {
+		return nil
+	}
+
+	_be := &BudgetEvent{
+		Cost:             beci.Cost,
+		Amount:           beci.Amount,
+		ThresholdPercent: beci.ThresholdPercent,
+		ThresholdType:    beci.ThresholdType,
+		PeriodEnd:        beci.PeriodEnd,
+		PeriodStart:      beci.PeriodStart,
+		Name:             beci.Name,
+		ProjectID:        beci.ProjectID,
+		EnvironmentID:    beci.EnvironmentID,
+		Message:          beci.Message,
+	}
+
+	return _be
+}
+
+// Validate checks the BudgetEventCreateInput entity.
+func (beci *BudgetEventCreateInput) Validate() error {
+	if beci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return beci.ValidateWith(beci.inputConfig.Context, beci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetEventCreateInput entity with the given context and client set.
+func (beci *BudgetEventCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if beci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// BudgetEventCreateInputs holds the creation input item of the BudgetEvent entities.
+type BudgetEventCreateInputsItem struct {
+	// Actual or forecast cost breaching the threshold.
+	Cost float64 `path:"-" query:"-" json:"cost"`
+	// Amount of the budget.
+	Amount float64 `path:"-" query:"-" json:"amount"`
+	// Percentage of the breached threshold.
+	ThresholdPercent float64 `path:"-" query:"-" json:"thresholdPercent"`
+	// Type of the breached threshold, actual or forecast.
+	ThresholdType string `path:"-" query:"-" json:"thresholdType"`
+	// End time of the period which the event occurs in.
+	PeriodEnd time.Time `path:"-" query:"-" json:"periodEnd"`
+	// Start time of the period which the event occurs in.
+	PeriodStart time.Time `path:"-" query:"-" json:"periodStart"`
+	// Name of the budget.
+	Name string `path:"-" query:"-" json:"name"`
+	// ID of the project to belong.
+	ProjectID object.ID `path:"-" query:"-" json:"projectID"`
+	// ID of the environment which the budget watches.
+	EnvironmentID object.ID `path:"-" query:"-" json:"environmentID,omitempty"`
+	// Message of the event.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+}
+
+// ValidateWith checks the BudgetEventCreateInputsItem entity with the given context and client set.
+func (beci *BudgetEventCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if beci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// BudgetEventCreateInputs holds the creation input of the BudgetEvent entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetEventCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*BudgetEventCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the BudgetEvent entities for creating,
+// after validating.
+func (beci *BudgetEventCreateInputs) Model() []*BudgetEvent {
+	if beci == nil || len(beci.Items) == 0 {
+		return nil
+	}
+
+	_bes := make([]*BudgetEvent, len(beci.Items))
+
+	for i := range beci.Items {
+		_be := &BudgetEvent{
+			Cost:             beci.Items[i].Cost,
+			Amount:           beci.Items[i].Amount,
+			ThresholdPercent: beci.Items[i].ThresholdPercent,
+			ThresholdType:    beci.Items[i].ThresholdType,
+			PeriodEnd:        beci.Items[i].PeriodEnd,
+			PeriodStart:      beci.Items[i].PeriodStart,
+			Name:             beci.Items[i].Name,
+			ProjectID:        beci.Items[i].ProjectID,
+			EnvironmentID:    beci.Items[i].EnvironmentID,
+			Message:          beci.Items[i].Message,
+		}
+
+		_bes[i] = _be
+	}
+
+	return _bes
+}
+
+// Validate checks the BudgetEventCreateInputs entity .
+func (beci *BudgetEventCreateInputs) Validate() error {
+	if beci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return beci.ValidateWith(beci.inputConfig.Context, beci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetEventCreateInputs entity with the given context and client set.
+func (beci *BudgetEventCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if beci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(beci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	for i := range beci.Items {
+		if beci.Items[i] == nil {
+			continue
+		}
+
+		if err := beci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BudgetEventDeleteInput holds the deletion input of the BudgetEvent entity,
+// please tags with `path:",inline"` if embedding.
+type BudgetEventDeleteInput struct {
+	BudgetEventQueryInput `path:",inline"`
+}
+
+// BudgetEventDeleteInputs holds the deletion input item of the BudgetEvent entities.
+type BudgetEventDeleteInputsItem struct {
+	// ID of the BudgetEvent entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// BudgetEventDeleteInputs holds the deletion input of the BudgetEvent entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetEventDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*BudgetEventDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the BudgetEvent entities for deleting,
+// after validating.
+func (bedi *BudgetEventDeleteInputs) Model() []*BudgetEvent {
+	if bedi == nil || len(bedi.Items) == 0 {
+		return nil
+	}
+
+	_bes := make([]*BudgetEvent, len(bedi.Items))
+	for i := range bedi.Items {
+		_bes[i] = &BudgetEvent{
+			ID: bedi.Items[i].ID,
+		}
+	}
+	return _bes
+}
+
+// IDs returns the ID list of the BudgetEvent entities for deleting,
+// after validating.
+func (bedi *BudgetEventDeleteInputs) IDs() []object.ID {
+	if bedi == nil || len(bedi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(bedi.Items))
+	for i := range bedi.Items {
+		ids[i] = bedi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the BudgetEventDeleteInputs entity.
+func (bedi *BudgetEventDeleteInputs) Validate() error {
+	if bedi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bedi.ValidateWith(bedi.inputConfig.Context, bedi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetEventDeleteInputs entity with the given context and client set.
+func (bedi *BudgetEventDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if bedi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(bedi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.BudgetEvents().Query()
+
+	ids := make([]object.ID, 0, len(bedi.Items))
+
+	for i := range bedi.Items {
+		if bedi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if bedi.Items[i].ID != "" {
+			ids = append(ids, bedi.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(budgetevent.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	return nil
+}
+
+// BudgetEventPatchInput holds the patch input of the BudgetEvent entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetEventPatchInput struct {
+	BudgetEventQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// ID of the project to belong.
+	ProjectID object.ID `path:"-" query:"-" json:"projectID,omitempty"`
+	// ID of the environment which the budget watches.
+	EnvironmentID object.ID `path:"-" query:"-" json:"environmentID,omitempty"`
+	// Name of the budget.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+	// Start time of the period which the event occurs in.
+	PeriodStart time.Time `path:"-" query:"-" json:"periodStart,omitempty"`
+	// End time of the period which the event occurs in.
+	PeriodEnd time.Time `path:"-" query:"-" json:"periodEnd,omitempty"`
+	// Type of the breached threshold, actual or forecast.
+	ThresholdType string `path:"-" query:"-" json:"thresholdType,omitempty"`
+	// Percentage of the breached threshold.
+	ThresholdPercent float64 `path:"-" query:"-" json:"thresholdPercent,omitempty"`
+	// Amount of the budget.
+	Amount float64 `path:"-" query:"-" json:"amount,omitempty"`
+	// Actual or forecast cost breaching the threshold.
+	Cost float64 `path:"-" query:"-" json:"cost,omitempty"`
+	// Message of the event.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+
+	patchedEntity *BudgetEvent `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the BudgetEvent partition entity for patching.
+func (bepi *BudgetEventPatchInput) PatchModel() *BudgetEvent {
+	if bepi == nil {
+		return nil
+	}
+
+	_be := &BudgetEvent{
+		CreateTime:       bepi.CreateTime,
+		UpdateTime:       bepi.UpdateTime,
+		ProjectID:        bepi.ProjectID,
+		EnvironmentID:    bepi.EnvironmentID,
+		Name:             bepi.Name,
+		PeriodStart:      bepi.PeriodStart,
+		PeriodEnd:        bepi.PeriodEnd,
+		ThresholdType:    bepi.ThresholdType,
+		ThresholdPercent: bepi.ThresholdPercent,
+		Amount:           bepi.Amount,
+		Cost:             bepi.Cost,
+		Message:          bepi.Message,
+	}
+
+	return _be
+}
+
+// Model returns the BudgetEvent patched entity,
+// after validating.
+func (bepi *BudgetEventPatchInput) Model() *BudgetEvent {
+	if bepi == nil {
+		return nil
+	}
+
+	return bepi.patchedEntity
+}
+
+// Validate checks the BudgetEventPatchInput entity.
+func (bepi *BudgetEventPatchInput) Validate() error {
+	if bepi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return bepi.ValidateWith(bepi.inputConfig.Context, bepi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetEventPatchInput entity with the given context and client set.
+func (bepi *BudgetEventPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := bepi.BudgetEventQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.BudgetEvents().Query()
+
+	if bepi.Refer != nil {
+		if bepi.Refer.IsID() {
+			q.Where(
+				budgetevent.ID(bepi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of budgetevent")
+		}
+	} else if bepi.ID != "" {
+		q.Where(
+			budgetevent.ID(bepi.ID))
+	} else {
+		return errors.New("invalid identify of budgetevent")
+	}
+
+	q.Select(
+		budgetevent.WithoutFields(
+			budgetevent.FieldCreateTime,
+			budgetevent.FieldUpdateTime,
+		)...,
+	)
+
+	var e *BudgetEvent
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*BudgetEvent)
+		}
+	}
+
+	_pm := bepi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*BudgetEvent)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.ProjectID != _obj.ProjectID {
+		return errors.New("field projectID is immutable")
+	}
+	if e.EnvironmentID != _obj.EnvironmentID {
+		return errors.New("field environmentID is immutable")
+	}
+	if e.Name != _obj.Name {
+		return errors.New("field name is immutable")
+	}
+	if !e.PeriodStart.Equal(_obj.PeriodStart) {
+		return errors.New("field periodStart is immutable")
+	}
+	if !e.PeriodEnd.Equal(_obj.PeriodEnd) {
+		return errors.New("field periodEnd is immutable")
+	}
+	if e.ThresholdType != _obj.ThresholdType {
+		return errors.New("field thresholdType is immutable")
+	}
+	if e.ThresholdPercent != _obj.ThresholdPercent {
+		return errors.New("field thresholdPercent is immutable")
+	}
+	if e.Amount != _obj.Amount {
+		return errors.New("field amount is immutable")
+	}
+	if e.Cost != _obj.Cost {
+		return errors.New("field cost is immutable")
+	}
+	if e.Message != _obj.Message {
+		return errors.New("field message is immutable")
+	}
+
+	bepi.patchedEntity = _obj
+	return nil
+}
+
+// BudgetEventQueryInput holds the query input of the BudgetEvent entity,
+// please tags with `path:",inline"` if embedding.
+type BudgetEventQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Refer holds the route path reference of the BudgetEvent entity.
+	Refer *object.Refer `path:"budgetevent,default=" query:"-" json:"-"`
+	// ID of the BudgetEvent entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// Model returns the BudgetEvent entity for querying,
+// after validating.
+func (beqi *BudgetEventQueryInput) Model() *BudgetEvent {
+	if beqi == nil {
+		return nil
+	}
+
+	return &BudgetEvent{
+		ID: beqi.ID,
+	}
+}
+
+// Validate checks the BudgetEventQueryInput entity.
+func (beqi *BudgetEventQueryInput) Validate() error {
+	if beqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return beqi.ValidateWith(beqi.inputConfig.Context, beqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetEventQueryInput entity with the given context and client set.
+func (beqi *BudgetEventQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if beqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if beqi.Refer != nil && *beqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", budgetevent.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.BudgetEvents().Query()
+
+	if beqi.Refer != nil {
+		if beqi.Refer.IsID() {
+			q.Where(
+				budgetevent.ID(beqi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of budgetevent")
+		}
+	} else if beqi.ID != "" {
+		q.Where(
+			budgetevent.ID(beqi.ID))
+	} else {
+		return errors.New("invalid identify of budgetevent")
+	}
+
+	q.Select(
+		budgetevent.FieldID,
+	)
+
+	var e *BudgetEvent
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*BudgetEvent)
+		}
+	}
+
+	beqi.ID = e.ID
+	return nil
+}
+
+// BudgetEventQueryInputs holds the query input of the BudgetEvent entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type BudgetEventQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Validate checks the BudgetEventQueryInputs entity.
+func (beqi *BudgetEventQueryInputs) Validate() error {
+	if beqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return beqi.ValidateWith(beqi.inputConfig.Context, beqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetEventQueryInputs entity with the given context and client set.
+func (beqi *BudgetEventQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if beqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// BudgetEventUpdateInput holds the modification input of the BudgetEvent entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetEventUpdateInput struct {
+	BudgetEventQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Model returns the BudgetEvent entity for modifying,
+// after validating.
+func (beui *BudgetEventUpdateInput) Model() *BudgetEvent {
+	if beui == nil {
+		return nil
+	}
+
+	_be := &BudgetEvent{
+		ID: beui.ID,
+	}
+
+	return _be
+}
+
+// Validate checks the BudgetEventUpdateInput entity.
+func (beui *BudgetEventUpdateInput) Validate() error {
+	if beui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return beui.ValidateWith(beui.inputConfig.Context, beui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetEventUpdateInput entity with the given context and client set.
+func (beui *BudgetEventUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := beui.BudgetEventQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BudgetEventUpdateInputs holds the modification input item of the BudgetEvent entities.
+type BudgetEventUpdateInputsItem struct {
+	// ID of the BudgetEvent entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ValidateWith checks the BudgetEventUpdateInputsItem entity with the given context and client set.
+func (beui *BudgetEventUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if beui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// BudgetEventUpdateInputs holds the modification input of the BudgetEvent entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type BudgetEventUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*BudgetEventUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the BudgetEvent entities for modifying,
+// after validating.
+func (beui *BudgetEventUpdateInputs) Model() []*BudgetEvent {
+	if beui == nil || len(beui.Items) == 0 {
+		return nil
+	}
+
+	_bes := make([]*BudgetEvent, len(beui.Items))
+
+	for i := range beui.Items {
+		_be := &BudgetEvent{
+			ID: beui.Items[i].ID,
+		}
+
+		_bes[i] = _be
+	}
+
+	return _bes
+}
+
+// IDs returns the ID list of the BudgetEvent entities for modifying,
+// after validating.
+func (beui *BudgetEventUpdateInputs) IDs() []object.ID {
+	if beui == nil || len(beui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(beui.Items))
+	for i := range beui.Items {
+		ids[i] = beui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the BudgetEventUpdateInputs entity.
+func (beui *BudgetEventUpdateInputs) Validate() error {
+	if beui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return beui.ValidateWith(beui.inputConfig.Context, beui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the BudgetEventUpdateInputs entity with the given context and client set.
+func (beui *BudgetEventUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if beui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(beui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.BudgetEvents().Query()
+
+	ids := make([]object.ID, 0, len(beui.Items))
+
+	for i := range beui.Items {
+		if beui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if beui.Items[i].ID != "" {
+			ids = append(ids, beui.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(budgetevent.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range beui.Items {
+		if err := beui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BudgetEventOutput holds the output of the BudgetEvent entity.
+type BudgetEventOutput struct {
+	ID               object.ID  `json:"id,omitempty"`
+	CreateTime       *time.Time `json:"createTime,omitempty"`
+	UpdateTime       *time.Time `json:"updateTime,omitempty"`
+	ProjectID        object.ID  `json:"projectID,omitempty"`
+	EnvironmentID    object.ID  `json:"environmentID,omitempty"`
+	Name             string     `json:"name,omitempty"`
+	PeriodStart      time.Time  `json:"periodStart,omitempty"`
+	PeriodEnd        time.Time  `json:"periodEnd,omitempty"`
+	ThresholdType    string     `json:"thresholdType,omitempty"`
+	ThresholdPercent float64    `json:"thresholdPercent,omitempty"`
+	Amount           float64    `json:"amount,omitempty"`
+	Cost             float64    `json:"cost,omitempty"`
+	Message          string     `json:"message,omitempty"`
+
+	Budget *BudgetOutput `json:"budget,omitempty"`
+}
+
+// View returns the output of BudgetEvent entity.
+func (_be *BudgetEvent) View() *BudgetEventOutput {
+	return ExposeBudgetEvent(_be)
+}
+
+// View returns the output of BudgetEvent entities.
+func (_bes BudgetEvents) View() []*BudgetEventOutput {
+	return ExposeBudgetEvents(_bes)
+}
+
+// ExposeBudgetEvent converts the BudgetEvent to BudgetEventOutput.
+func ExposeBudgetEvent(_be *BudgetEvent) *BudgetEventOutput {
+	if _be == nil {
+		return nil
+	}
+
+	beo := &BudgetEventOutput{
+		ID:               _be.ID,
+		CreateTime:       _be.CreateTime,
+		UpdateTime:       _be.UpdateTime,
+		ProjectID:        _be.ProjectID,
+		EnvironmentID:    _be.EnvironmentID,
+		Name:             _be.Name,
+		PeriodStart:      _be.PeriodStart,
+		PeriodEnd:        _be.PeriodEnd,
+		ThresholdType:    _be.ThresholdType,
+		ThresholdPercent: _be.ThresholdPercent,
+		Amount:           _be.Amount,
+		Cost:             _be.Cost,
+		Message:          _be.Message,
+	}
+
+	if _be.Edges.Budget != nil {
+		beo.Budget = ExposeBudget(_be.Edges.Budget)
+	} else if _be.BudgetID != "" {
+		beo.Budget = &BudgetOutput{
+			ID: _be.BudgetID,
+		}
+	}
+	return beo
+}
+
+// ExposeBudgetEvents converts the BudgetEvent slice to BudgetEventOutput pointer slice.
+func ExposeBudgetEvents(_bes []*BudgetEvent) []*BudgetEventOutput {
+	if len(_bes) == 0 {
+		return nil
+	}
+
+	beos := make([]*BudgetEventOutput, len(_bes))
+	for i := range _bes {
+		beos[i] = ExposeBudgetEvent(_bes[i])
+	}
+	return beos
+}
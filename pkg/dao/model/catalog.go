@@ -16,6 +16,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
 	"github.com/seal-io/walrus/pkg/dao/model/project"
 	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/utils/json"
@@ -50,6 +51,14 @@ type Catalog struct {
 	ProjectID object.ID `json:"project_id,omitempty"`
 	// Catalog regexp pattern to filter the repositories by names.
 	FilterPattern string `json:"filter_pattern,omitempty"`
+	// URLs of the repositories to sync besides the source, only for the generic Git catalog.
+	Repositories []string `json:"repositories,omitempty"`
+	// Subdirectories of the source to sync as templates, or to discover the templates from in the monorepo mode, only for the generic Git catalog.
+	Directories []string `json:"directories,omitempty"`
+	// Discover the templates from the directories of the source, which contain the Terraform files or the schema.yaml, only for the generic Git catalog.
+	Monorepo bool `json:"monorepo,omitempty"`
+	// Credential to access the repositories, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CatalogQuery when eager-loading is set.
 	Edges        CatalogEdges `json:"edges,omitempty"`
@@ -94,10 +103,14 @@ func (*Catalog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case catalog.FieldLabels, catalog.FieldAnnotations, catalog.FieldStatus, catalog.FieldSync:
+		case catalog.FieldLabels, catalog.FieldAnnotations, catalog.FieldStatus, catalog.FieldSync, catalog.FieldRepositories, catalog.FieldDirectories:
 			values[i] = new([]byte)
+		case catalog.FieldCredential:
+			values[i] = new(crypto.Map[string, string])
 		case catalog.FieldID, catalog.FieldProjectID:
 			values[i] = new(object.ID)
+		case catalog.FieldMonorepo:
+			values[i] = new(sql.NullBool)
 		case catalog.FieldName, catalog.FieldDescription, catalog.FieldType, catalog.FieldSource, catalog.FieldFilterPattern:
 			values[i] = new(sql.NullString)
 		case catalog.FieldCreateTime, catalog.FieldUpdateTime:
@@ -205,6 +218,34 @@ func (c *Catalog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.FilterPattern = value.String
 			}
+		case catalog.FieldRepositories:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field repositories", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Repositories); err != nil {
+					return fmt.Errorf("unmarshal field repositories: %w", err)
+				}
+			}
+		case catalog.FieldDirectories:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field directories", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Directories); err != nil {
+					return fmt.Errorf("unmarshal field directories: %w", err)
+				}
+			}
+		case catalog.FieldMonorepo:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field monorepo", values[i])
+			} else if value.Valid {
+				c.Monorepo = value.Bool
+			}
+		case catalog.FieldCredential:
+			if value, ok := values[i].(*crypto.Map[string, string]); !ok {
+				return fmt.Errorf("unexpected type %T for field credential", values[i])
+			} else if value != nil {
+				c.Credential = *value
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -290,6 +331,17 @@ func (c *Catalog) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("filter_pattern=")
 	builder.WriteString(c.FilterPattern)
+	builder.WriteString(", ")
+	builder.WriteString("repositories=")
+	builder.WriteString(fmt.Sprintf("%v", c.Repositories))
+	builder.WriteString(", ")
+	builder.WriteString("directories=")
+	builder.WriteString(fmt.Sprintf("%v", c.Directories))
+	builder.WriteString(", ")
+	builder.WriteString("monorepo=")
+	builder.WriteString(fmt.Sprintf("%v", c.Monorepo))
+	builder.WriteString(", ")
+	builder.WriteString("credential=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
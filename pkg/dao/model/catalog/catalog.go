@@ -43,6 +43,14 @@ const (
 	FieldProjectID = "project_id"
 	// FieldFilterPattern holds the string denoting the filter_pattern field in the database.
 	FieldFilterPattern = "filter_pattern"
+	// FieldRepositories holds the string denoting the repositories field in the database.
+	FieldRepositories = "repositories"
+	// FieldDirectories holds the string denoting the directories field in the database.
+	FieldDirectories = "directories"
+	// FieldMonorepo holds the string denoting the monorepo field in the database.
+	FieldMonorepo = "monorepo"
+	// FieldCredential holds the string denoting the credential field in the database.
+	FieldCredential = "credential"
 	// EdgeTemplates holds the string denoting the templates edge name in mutations.
 	EdgeTemplates = "templates"
 	// EdgeProject holds the string denoting the project edge name in mutations.
@@ -80,6 +88,10 @@ var Columns = []string{
 	FieldSync,
 	FieldProjectID,
 	FieldFilterPattern,
+	FieldRepositories,
+	FieldDirectories,
+	FieldMonorepo,
+	FieldCredential,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -166,6 +178,16 @@ func ByFilterPattern(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFilterPattern, opts...).ToFunc()
 }
 
+// ByMonorepo orders the results by the monorepo field.
+func ByMonorepo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonorepo, opts...).ToFunc()
+}
+
+// ByCredential orders the results by the credential field.
+func ByCredential(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredential, opts...).ToFunc()
+}
+
 // ByTemplatesCount orders the results by templates count.
 func ByTemplatesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
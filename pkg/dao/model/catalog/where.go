@@ -13,6 +13,7 @@ import (
 
 	"github.com/seal-io/walrus/pkg/dao/model/internal"
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
 
@@ -101,6 +102,16 @@ func FilterPattern(v string) predicate.Catalog {
 	return predicate.Catalog(sql.FieldEQ(FieldFilterPattern, v))
 }
 
+// Monorepo applies equality check predicate on the "monorepo" field. It's identical to MonorepoEQ.
+func Monorepo(v bool) predicate.Catalog {
+	return predicate.Catalog(sql.FieldEQ(FieldMonorepo, v))
+}
+
+// Credential applies equality check predicate on the "credential" field. It's identical to CredentialEQ.
+func Credential(v crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldEQ(FieldCredential, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Catalog {
 	return predicate.Catalog(sql.FieldEQ(FieldName, v))
@@ -646,6 +657,96 @@ func FilterPatternContainsFold(v string) predicate.Catalog {
 	return predicate.Catalog(sql.FieldContainsFold(FieldFilterPattern, v))
 }
 
+// RepositoriesIsNil applies the IsNil predicate on the "repositories" field.
+func RepositoriesIsNil() predicate.Catalog {
+	return predicate.Catalog(sql.FieldIsNull(FieldRepositories))
+}
+
+// RepositoriesNotNil applies the NotNil predicate on the "repositories" field.
+func RepositoriesNotNil() predicate.Catalog {
+	return predicate.Catalog(sql.FieldNotNull(FieldRepositories))
+}
+
+// DirectoriesIsNil applies the IsNil predicate on the "directories" field.
+func DirectoriesIsNil() predicate.Catalog {
+	return predicate.Catalog(sql.FieldIsNull(FieldDirectories))
+}
+
+// DirectoriesNotNil applies the NotNil predicate on the "directories" field.
+func DirectoriesNotNil() predicate.Catalog {
+	return predicate.Catalog(sql.FieldNotNull(FieldDirectories))
+}
+
+// MonorepoEQ applies the EQ predicate on the "monorepo" field.
+func MonorepoEQ(v bool) predicate.Catalog {
+	return predicate.Catalog(sql.FieldEQ(FieldMonorepo, v))
+}
+
+// MonorepoNEQ applies the NEQ predicate on the "monorepo" field.
+func MonorepoNEQ(v bool) predicate.Catalog {
+	return predicate.Catalog(sql.FieldNEQ(FieldMonorepo, v))
+}
+
+// MonorepoIsNil applies the IsNil predicate on the "monorepo" field.
+func MonorepoIsNil() predicate.Catalog {
+	return predicate.Catalog(sql.FieldIsNull(FieldMonorepo))
+}
+
+// MonorepoNotNil applies the NotNil predicate on the "monorepo" field.
+func MonorepoNotNil() predicate.Catalog {
+	return predicate.Catalog(sql.FieldNotNull(FieldMonorepo))
+}
+
+// CredentialEQ applies the EQ predicate on the "credential" field.
+func CredentialEQ(v crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldEQ(FieldCredential, v))
+}
+
+// CredentialNEQ applies the NEQ predicate on the "credential" field.
+func CredentialNEQ(v crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldNEQ(FieldCredential, v))
+}
+
+// CredentialIn applies the In predicate on the "credential" field.
+func CredentialIn(vs ...crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldIn(FieldCredential, vs...))
+}
+
+// CredentialNotIn applies the NotIn predicate on the "credential" field.
+func CredentialNotIn(vs ...crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldNotIn(FieldCredential, vs...))
+}
+
+// CredentialGT applies the GT predicate on the "credential" field.
+func CredentialGT(v crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldGT(FieldCredential, v))
+}
+
+// CredentialGTE applies the GTE predicate on the "credential" field.
+func CredentialGTE(v crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldGTE(FieldCredential, v))
+}
+
+// CredentialLT applies the LT predicate on the "credential" field.
+func CredentialLT(v crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldLT(FieldCredential, v))
+}
+
+// CredentialLTE applies the LTE predicate on the "credential" field.
+func CredentialLTE(v crypto.Map[string, string]) predicate.Catalog {
+	return predicate.Catalog(sql.FieldLTE(FieldCredential, v))
+}
+
+// CredentialIsNil applies the IsNil predicate on the "credential" field.
+func CredentialIsNil() predicate.Catalog {
+	return predicate.Catalog(sql.FieldIsNull(FieldCredential))
+}
+
+// CredentialNotNil applies the NotNil predicate on the "credential" field.
+func CredentialNotNil() predicate.Catalog {
+	return predicate.Catalog(sql.FieldNotNull(FieldCredential))
+}
+
 // HasTemplates applies the HasEdge predicate on the "templates" edge.
 func HasTemplates() predicate.Catalog {
 	return predicate.Catalog(func(s *sql.Selector) {
@@ -21,6 +21,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/project"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
 	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 )
@@ -155,6 +156,38 @@ func (cc *CatalogCreate) SetNillableFilterPattern(s *string) *CatalogCreate {
 	return cc
 }
 
+// SetRepositories sets the "repositories" field.
+func (cc *CatalogCreate) SetRepositories(s []string) *CatalogCreate {
+	cc.mutation.SetRepositories(s)
+	return cc
+}
+
+// SetDirectories sets the "directories" field.
+func (cc *CatalogCreate) SetDirectories(s []string) *CatalogCreate {
+	cc.mutation.SetDirectories(s)
+	return cc
+}
+
+// SetMonorepo sets the "monorepo" field.
+func (cc *CatalogCreate) SetMonorepo(b bool) *CatalogCreate {
+	cc.mutation.SetMonorepo(b)
+	return cc
+}
+
+// SetNillableMonorepo sets the "monorepo" field if the given value is not nil.
+func (cc *CatalogCreate) SetNillableMonorepo(b *bool) *CatalogCreate {
+	if b != nil {
+		cc.SetMonorepo(*b)
+	}
+	return cc
+}
+
+// SetCredential sets the "credential" field.
+func (cc *CatalogCreate) SetCredential(c crypto.Map[string, string]) *CatalogCreate {
+	cc.mutation.SetCredential(c)
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CatalogCreate) SetID(o object.ID) *CatalogCreate {
 	cc.mutation.SetID(o)
@@ -356,6 +389,22 @@ func (cc *CatalogCreate) createSpec() (*Catalog, *sqlgraph.CreateSpec) {
 		_spec.SetField(catalog.FieldFilterPattern, field.TypeString, value)
 		_node.FilterPattern = value
 	}
+	if value, ok := cc.mutation.Repositories(); ok {
+		_spec.SetField(catalog.FieldRepositories, field.TypeJSON, value)
+		_node.Repositories = value
+	}
+	if value, ok := cc.mutation.Directories(); ok {
+		_spec.SetField(catalog.FieldDirectories, field.TypeJSON, value)
+		_node.Directories = value
+	}
+	if value, ok := cc.mutation.Monorepo(); ok {
+		_spec.SetField(catalog.FieldMonorepo, field.TypeBool, value)
+		_node.Monorepo = value
+	}
+	if value, ok := cc.mutation.Credential(); ok {
+		_spec.SetField(catalog.FieldCredential, field.TypeOther, value)
+		_node.Credential = value
+	}
 	if nodes := cc.mutation.TemplatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -446,6 +495,18 @@ func (cc *CatalogCreate) Set(obj *Catalog) *CatalogCreate {
 	if obj.FilterPattern != "" {
 		cc.SetFilterPattern(obj.FilterPattern)
 	}
+	if !reflect.ValueOf(obj.Repositories).IsZero() {
+		cc.SetRepositories(obj.Repositories)
+	}
+	if !reflect.ValueOf(obj.Directories).IsZero() {
+		cc.SetDirectories(obj.Directories)
+	}
+	if obj.Monorepo {
+		cc.SetMonorepo(obj.Monorepo)
+	}
+	if !reflect.ValueOf(obj.Credential).IsZero() {
+		cc.SetCredential(obj.Credential)
+	}
 
 	// Record the given object.
 	cc.object = obj
@@ -521,6 +582,18 @@ func (cc *CatalogCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Cont
 		if _, set := cc.mutation.Field(catalog.FieldFilterPattern); set {
 			obj.FilterPattern = x.FilterPattern
 		}
+		if _, set := cc.mutation.Field(catalog.FieldRepositories); set {
+			obj.Repositories = x.Repositories
+		}
+		if _, set := cc.mutation.Field(catalog.FieldDirectories); set {
+			obj.Directories = x.Directories
+		}
+		if _, set := cc.mutation.Field(catalog.FieldMonorepo); set {
+			obj.Monorepo = x.Monorepo
+		}
+		if _, set := cc.mutation.Field(catalog.FieldCredential); set {
+			obj.Credential = x.Credential
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -662,6 +735,18 @@ func (ccb *CatalogCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context
 			if _, set := ccb.builders[i].mutation.Field(catalog.FieldFilterPattern); set {
 				objs[i].FilterPattern = x[i].FilterPattern
 			}
+			if _, set := ccb.builders[i].mutation.Field(catalog.FieldRepositories); set {
+				objs[i].Repositories = x[i].Repositories
+			}
+			if _, set := ccb.builders[i].mutation.Field(catalog.FieldDirectories); set {
+				objs[i].Directories = x[i].Directories
+			}
+			if _, set := ccb.builders[i].mutation.Field(catalog.FieldMonorepo); set {
+				objs[i].Monorepo = x[i].Monorepo
+			}
+			if _, set := ccb.builders[i].mutation.Field(catalog.FieldCredential); set {
+				objs[i].Credential = x[i].Credential
+			}
 			objs[i].Edges = x[i].Edges
 		}
 	}
@@ -908,6 +993,78 @@ func (u *CatalogUpsert) ClearFilterPattern() *CatalogUpsert {
 	return u
 }
 
+// SetRepositories sets the "repositories" field.
+func (u *CatalogUpsert) SetRepositories(v []string) *CatalogUpsert {
+	u.Set(catalog.FieldRepositories, v)
+	return u
+}
+
+// UpdateRepositories sets the "repositories" field to the value that was provided on create.
+func (u *CatalogUpsert) UpdateRepositories() *CatalogUpsert {
+	u.SetExcluded(catalog.FieldRepositories)
+	return u
+}
+
+// ClearRepositories clears the value of the "repositories" field.
+func (u *CatalogUpsert) ClearRepositories() *CatalogUpsert {
+	u.SetNull(catalog.FieldRepositories)
+	return u
+}
+
+// SetDirectories sets the "directories" field.
+func (u *CatalogUpsert) SetDirectories(v []string) *CatalogUpsert {
+	u.Set(catalog.FieldDirectories, v)
+	return u
+}
+
+// UpdateDirectories sets the "directories" field to the value that was provided on create.
+func (u *CatalogUpsert) UpdateDirectories() *CatalogUpsert {
+	u.SetExcluded(catalog.FieldDirectories)
+	return u
+}
+
+// ClearDirectories clears the value of the "directories" field.
+func (u *CatalogUpsert) ClearDirectories() *CatalogUpsert {
+	u.SetNull(catalog.FieldDirectories)
+	return u
+}
+
+// SetMonorepo sets the "monorepo" field.
+func (u *CatalogUpsert) SetMonorepo(v bool) *CatalogUpsert {
+	u.Set(catalog.FieldMonorepo, v)
+	return u
+}
+
+// UpdateMonorepo sets the "monorepo" field to the value that was provided on create.
+func (u *CatalogUpsert) UpdateMonorepo() *CatalogUpsert {
+	u.SetExcluded(catalog.FieldMonorepo)
+	return u
+}
+
+// ClearMonorepo clears the value of the "monorepo" field.
+func (u *CatalogUpsert) ClearMonorepo() *CatalogUpsert {
+	u.SetNull(catalog.FieldMonorepo)
+	return u
+}
+
+// SetCredential sets the "credential" field.
+func (u *CatalogUpsert) SetCredential(v crypto.Map[string, string]) *CatalogUpsert {
+	u.Set(catalog.FieldCredential, v)
+	return u
+}
+
+// UpdateCredential sets the "credential" field to the value that was provided on create.
+func (u *CatalogUpsert) UpdateCredential() *CatalogUpsert {
+	u.SetExcluded(catalog.FieldCredential)
+	return u
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (u *CatalogUpsert) ClearCredential() *CatalogUpsert {
+	u.SetNull(catalog.FieldCredential)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1111,6 +1268,90 @@ func (u *CatalogUpsertOne) ClearFilterPattern() *CatalogUpsertOne {
 	})
 }
 
+// SetRepositories sets the "repositories" field.
+func (u *CatalogUpsertOne) SetRepositories(v []string) *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.SetRepositories(v)
+	})
+}
+
+// UpdateRepositories sets the "repositories" field to the value that was provided on create.
+func (u *CatalogUpsertOne) UpdateRepositories() *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.UpdateRepositories()
+	})
+}
+
+// ClearRepositories clears the value of the "repositories" field.
+func (u *CatalogUpsertOne) ClearRepositories() *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.ClearRepositories()
+	})
+}
+
+// SetDirectories sets the "directories" field.
+func (u *CatalogUpsertOne) SetDirectories(v []string) *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.SetDirectories(v)
+	})
+}
+
+// UpdateDirectories sets the "directories" field to the value that was provided on create.
+func (u *CatalogUpsertOne) UpdateDirectories() *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.UpdateDirectories()
+	})
+}
+
+// ClearDirectories clears the value of the "directories" field.
+func (u *CatalogUpsertOne) ClearDirectories() *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.ClearDirectories()
+	})
+}
+
+// SetMonorepo sets the "monorepo" field.
+func (u *CatalogUpsertOne) SetMonorepo(v bool) *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.SetMonorepo(v)
+	})
+}
+
+// UpdateMonorepo sets the "monorepo" field to the value that was provided on create.
+func (u *CatalogUpsertOne) UpdateMonorepo() *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.UpdateMonorepo()
+	})
+}
+
+// ClearMonorepo clears the value of the "monorepo" field.
+func (u *CatalogUpsertOne) ClearMonorepo() *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.ClearMonorepo()
+	})
+}
+
+// SetCredential sets the "credential" field.
+func (u *CatalogUpsertOne) SetCredential(v crypto.Map[string, string]) *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.SetCredential(v)
+	})
+}
+
+// UpdateCredential sets the "credential" field to the value that was provided on create.
+func (u *CatalogUpsertOne) UpdateCredential() *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.UpdateCredential()
+	})
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (u *CatalogUpsertOne) ClearCredential() *CatalogUpsertOne {
+	return u.Update(func(s *CatalogUpsert) {
+		s.ClearCredential()
+	})
+}
+
 // Exec executes the query.
 func (u *CatalogUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1483,6 +1724,90 @@ func (u *CatalogUpsertBulk) ClearFilterPattern() *CatalogUpsertBulk {
 	})
 }
 
+// SetRepositories sets the "repositories" field.
+func (u *CatalogUpsertBulk) SetRepositories(v []string) *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.SetRepositories(v)
+	})
+}
+
+// UpdateRepositories sets the "repositories" field to the value that was provided on create.
+func (u *CatalogUpsertBulk) UpdateRepositories() *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.UpdateRepositories()
+	})
+}
+
+// ClearRepositories clears the value of the "repositories" field.
+func (u *CatalogUpsertBulk) ClearRepositories() *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.ClearRepositories()
+	})
+}
+
+// SetDirectories sets the "directories" field.
+func (u *CatalogUpsertBulk) SetDirectories(v []string) *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.SetDirectories(v)
+	})
+}
+
+// UpdateDirectories sets the "directories" field to the value that was provided on create.
+func (u *CatalogUpsertBulk) UpdateDirectories() *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.UpdateDirectories()
+	})
+}
+
+// ClearDirectories clears the value of the "directories" field.
+func (u *CatalogUpsertBulk) ClearDirectories() *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.ClearDirectories()
+	})
+}
+
+// SetMonorepo sets the "monorepo" field.
+func (u *CatalogUpsertBulk) SetMonorepo(v bool) *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.SetMonorepo(v)
+	})
+}
+
+// UpdateMonorepo sets the "monorepo" field to the value that was provided on create.
+func (u *CatalogUpsertBulk) UpdateMonorepo() *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.UpdateMonorepo()
+	})
+}
+
+// ClearMonorepo clears the value of the "monorepo" field.
+func (u *CatalogUpsertBulk) ClearMonorepo() *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.ClearMonorepo()
+	})
+}
+
+// SetCredential sets the "credential" field.
+func (u *CatalogUpsertBulk) SetCredential(v crypto.Map[string, string]) *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.SetCredential(v)
+	})
+}
+
+// UpdateCredential sets the "credential" field to the value that was provided on create.
+func (u *CatalogUpsertBulk) UpdateCredential() *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.UpdateCredential()
+	})
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (u *CatalogUpsertBulk) ClearCredential() *CatalogUpsertBulk {
+	return u.Update(func(s *CatalogUpsert) {
+		s.ClearCredential()
+	})
+}
+
 // Exec executes the query.
 func (u *CatalogUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
@@ -23,6 +24,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
 	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 )
@@ -144,6 +146,74 @@ func (cu *CatalogUpdate) ClearFilterPattern() *CatalogUpdate {
 	return cu
 }
 
+// SetRepositories sets the "repositories" field.
+func (cu *CatalogUpdate) SetRepositories(s []string) *CatalogUpdate {
+	cu.mutation.SetRepositories(s)
+	return cu
+}
+
+// AppendRepositories appends s to the "repositories" field.
+func (cu *CatalogUpdate) AppendRepositories(s []string) *CatalogUpdate {
+	cu.mutation.AppendRepositories(s)
+	return cu
+}
+
+// ClearRepositories clears the value of the "repositories" field.
+func (cu *CatalogUpdate) ClearRepositories() *CatalogUpdate {
+	cu.mutation.ClearRepositories()
+	return cu
+}
+
+// SetDirectories sets the "directories" field.
+func (cu *CatalogUpdate) SetDirectories(s []string) *CatalogUpdate {
+	cu.mutation.SetDirectories(s)
+	return cu
+}
+
+// AppendDirectories appends s to the "directories" field.
+func (cu *CatalogUpdate) AppendDirectories(s []string) *CatalogUpdate {
+	cu.mutation.AppendDirectories(s)
+	return cu
+}
+
+// ClearDirectories clears the value of the "directories" field.
+func (cu *CatalogUpdate) ClearDirectories() *CatalogUpdate {
+	cu.mutation.ClearDirectories()
+	return cu
+}
+
+// SetMonorepo sets the "monorepo" field.
+func (cu *CatalogUpdate) SetMonorepo(b bool) *CatalogUpdate {
+	cu.mutation.SetMonorepo(b)
+	return cu
+}
+
+// SetNillableMonorepo sets the "monorepo" field if the given value is not nil.
+func (cu *CatalogUpdate) SetNillableMonorepo(b *bool) *CatalogUpdate {
+	if b != nil {
+		cu.SetMonorepo(*b)
+	}
+	return cu
+}
+
+// ClearMonorepo clears the value of the "monorepo" field.
+func (cu *CatalogUpdate) ClearMonorepo() *CatalogUpdate {
+	cu.mutation.ClearMonorepo()
+	return cu
+}
+
+// SetCredential sets the "credential" field.
+func (cu *CatalogUpdate) SetCredential(c crypto.Map[string, string]) *CatalogUpdate {
+	cu.mutation.SetCredential(c)
+	return cu
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (cu *CatalogUpdate) ClearCredential() *CatalogUpdate {
+	cu.mutation.ClearCredential()
+	return cu
+}
+
 // AddTemplateIDs adds the "templates" edge to the Template entity by IDs.
 func (cu *CatalogUpdate) AddTemplateIDs(ids ...object.ID) *CatalogUpdate {
 	cu.mutation.AddTemplateIDs(ids...)
@@ -282,6 +352,24 @@ func (cu *CatalogUpdate) Set(obj *Catalog) *CatalogUpdate {
 	} else {
 		cu.ClearFilterPattern()
 	}
+	if !reflect.ValueOf(obj.Repositories).IsZero() {
+		cu.SetRepositories(obj.Repositories)
+	} else {
+		cu.ClearRepositories()
+	}
+	if !reflect.ValueOf(obj.Directories).IsZero() {
+		cu.SetDirectories(obj.Directories)
+	} else {
+		cu.ClearDirectories()
+	}
+	if obj.Monorepo {
+		cu.SetMonorepo(obj.Monorepo)
+	} else {
+		cu.ClearMonorepo()
+	}
+	if !reflect.ValueOf(obj.Credential).IsZero() {
+		cu.SetCredential(obj.Credential)
+	}
 
 	// With Default.
 	if obj.UpdateTime != nil {
@@ -348,6 +436,40 @@ func (cu *CatalogUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.FilterPatternCleared() {
 		_spec.ClearField(catalog.FieldFilterPattern, field.TypeString)
 	}
+	if value, ok := cu.mutation.Repositories(); ok {
+		_spec.SetField(catalog.FieldRepositories, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedRepositories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, catalog.FieldRepositories, value)
+		})
+	}
+	if cu.mutation.RepositoriesCleared() {
+		_spec.ClearField(catalog.FieldRepositories, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.Directories(); ok {
+		_spec.SetField(catalog.FieldDirectories, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedDirectories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, catalog.FieldDirectories, value)
+		})
+	}
+	if cu.mutation.DirectoriesCleared() {
+		_spec.ClearField(catalog.FieldDirectories, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.Monorepo(); ok {
+		_spec.SetField(catalog.FieldMonorepo, field.TypeBool, value)
+	}
+	if cu.mutation.MonorepoCleared() {
+		_spec.ClearField(catalog.FieldMonorepo, field.TypeBool)
+	}
+	if value, ok := cu.mutation.Credential(); ok {
+		_spec.SetField(catalog.FieldCredential, field.TypeOther, value)
+	}
+	if cu.mutation.CredentialCleared() {
+		_spec.ClearField(catalog.FieldCredential, field.TypeOther)
+	}
 	if cu.mutation.TemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -523,6 +645,74 @@ func (cuo *CatalogUpdateOne) ClearFilterPattern() *CatalogUpdateOne {
 	return cuo
 }
 
+// SetRepositories sets the "repositories" field.
+func (cuo *CatalogUpdateOne) SetRepositories(s []string) *CatalogUpdateOne {
+	cuo.mutation.SetRepositories(s)
+	return cuo
+}
+
+// AppendRepositories appends s to the "repositories" field.
+func (cuo *CatalogUpdateOne) AppendRepositories(s []string) *CatalogUpdateOne {
+	cuo.mutation.AppendRepositories(s)
+	return cuo
+}
+
+// ClearRepositories clears the value of the "repositories" field.
+func (cuo *CatalogUpdateOne) ClearRepositories() *CatalogUpdateOne {
+	cuo.mutation.ClearRepositories()
+	return cuo
+}
+
+// SetDirectories sets the "directories" field.
+func (cuo *CatalogUpdateOne) SetDirectories(s []string) *CatalogUpdateOne {
+	cuo.mutation.SetDirectories(s)
+	return cuo
+}
+
+// AppendDirectories appends s to the "directories" field.
+func (cuo *CatalogUpdateOne) AppendDirectories(s []string) *CatalogUpdateOne {
+	cuo.mutation.AppendDirectories(s)
+	return cuo
+}
+
+// ClearDirectories clears the value of the "directories" field.
+func (cuo *CatalogUpdateOne) ClearDirectories() *CatalogUpdateOne {
+	cuo.mutation.ClearDirectories()
+	return cuo
+}
+
+// SetMonorepo sets the "monorepo" field.
+func (cuo *CatalogUpdateOne) SetMonorepo(b bool) *CatalogUpdateOne {
+	cuo.mutation.SetMonorepo(b)
+	return cuo
+}
+
+// SetNillableMonorepo sets the "monorepo" field if the given value is not nil.
+func (cuo *CatalogUpdateOne) SetNillableMonorepo(b *bool) *CatalogUpdateOne {
+	if b != nil {
+		cuo.SetMonorepo(*b)
+	}
+	return cuo
+}
+
+// ClearMonorepo clears the value of the "monorepo" field.
+func (cuo *CatalogUpdateOne) ClearMonorepo() *CatalogUpdateOne {
+	cuo.mutation.ClearMonorepo()
+	return cuo
+}
+
+// SetCredential sets the "credential" field.
+func (cuo *CatalogUpdateOne) SetCredential(c crypto.Map[string, string]) *CatalogUpdateOne {
+	cuo.mutation.SetCredential(c)
+	return cuo
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (cuo *CatalogUpdateOne) ClearCredential() *CatalogUpdateOne {
+	cuo.mutation.ClearCredential()
+	return cuo
+}
+
 // AddTemplateIDs adds the "templates" edge to the Template entity by IDs.
 func (cuo *CatalogUpdateOne) AddTemplateIDs(ids ...object.ID) *CatalogUpdateOne {
 	cuo.mutation.AddTemplateIDs(ids...)
@@ -696,6 +886,32 @@ func (cuo *CatalogUpdateOne) Set(obj *Catalog) *CatalogUpdateOne {
 			} else {
 				cuo.ClearFilterPattern()
 			}
+			if !reflect.ValueOf(obj.Repositories).IsZero() {
+				if !reflect.DeepEqual(db.Repositories, obj.Repositories) {
+					cuo.SetRepositories(obj.Repositories)
+				}
+			} else {
+				cuo.ClearRepositories()
+			}
+			if !reflect.ValueOf(obj.Directories).IsZero() {
+				if !reflect.DeepEqual(db.Directories, obj.Directories) {
+					cuo.SetDirectories(obj.Directories)
+				}
+			} else {
+				cuo.ClearDirectories()
+			}
+			if obj.Monorepo {
+				if db.Monorepo != obj.Monorepo {
+					cuo.SetMonorepo(obj.Monorepo)
+				}
+			} else {
+				cuo.ClearMonorepo()
+			}
+			if !reflect.ValueOf(obj.Credential).IsZero() {
+				if !reflect.DeepEqual(db.Credential, obj.Credential) {
+					cuo.SetCredential(obj.Credential)
+				}
+			}
 
 			// With Default.
 			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
@@ -764,6 +980,18 @@ func (cuo *CatalogUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.
 		if _, set := cuo.mutation.Field(catalog.FieldFilterPattern); set {
 			obj.FilterPattern = x.FilterPattern
 		}
+		if _, set := cuo.mutation.Field(catalog.FieldRepositories); set {
+			obj.Repositories = x.Repositories
+		}
+		if _, set := cuo.mutation.Field(catalog.FieldDirectories); set {
+			obj.Directories = x.Directories
+		}
+		if _, set := cuo.mutation.Field(catalog.FieldMonorepo); set {
+			obj.Monorepo = x.Monorepo
+		}
+		if _, set := cuo.mutation.Field(catalog.FieldCredential); set {
+			obj.Credential = x.Credential
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -870,6 +1098,40 @@ func (cuo *CatalogUpdateOne) sqlSave(ctx context.Context) (_node *Catalog, err e
 	if cuo.mutation.FilterPatternCleared() {
 		_spec.ClearField(catalog.FieldFilterPattern, field.TypeString)
 	}
+	if value, ok := cuo.mutation.Repositories(); ok {
+		_spec.SetField(catalog.FieldRepositories, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedRepositories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, catalog.FieldRepositories, value)
+		})
+	}
+	if cuo.mutation.RepositoriesCleared() {
+		_spec.ClearField(catalog.FieldRepositories, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.Directories(); ok {
+		_spec.SetField(catalog.FieldDirectories, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedDirectories(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, catalog.FieldDirectories, value)
+		})
+	}
+	if cuo.mutation.DirectoriesCleared() {
+		_spec.ClearField(catalog.FieldDirectories, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.Monorepo(); ok {
+		_spec.SetField(catalog.FieldMonorepo, field.TypeBool, value)
+	}
+	if cuo.mutation.MonorepoCleared() {
+		_spec.ClearField(catalog.FieldMonorepo, field.TypeBool)
+	}
+	if value, ok := cuo.mutation.Credential(); ok {
+		_spec.SetField(catalog.FieldCredential, field.TypeOther, value)
+	}
+	if cuo.mutation.CredentialCleared() {
+		_spec.ClearField(catalog.FieldCredential, field.TypeOther)
+	}
 	if cuo.mutation.TemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
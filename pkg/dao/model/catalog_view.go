@@ -16,6 +16,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
 	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/utils/json"
@@ -41,6 +42,14 @@ type CatalogCreateInput struct {
 	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
 	// Catalog regexp pattern to filter the repositories by names.
 	FilterPattern string `path:"-" query:"-" json:"filterPattern,omitempty"`
+	// URLs of the repositories to sync besides the source, only for the generic Git catalog.
+	Repositories []string `path:"-" query:"-" json:"repositories,omitempty"`
+	// Subdirectories of the source to sync as templates, or to discover the templates from in the monorepo mode, only for the generic Git catalog.
+	Directories []string `path:"-" query:"-" json:"directories,omitempty"`
+	// Discover the templates from the directories of the source, which contain the Terraform files or the schema.yaml, only for the generic Git catalog.
+	Monorepo bool `path:"-" query:"-" json:"monorepo,omitempty"`
+	// Credential to access the repositories, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 }
 
 // Model returns the Catalog entity for creating,
@@ -57,6 +66,10 @@ func (cci *CatalogCreateInput) Model() *Catalog {
 		Description:   cci.Description,
 		Labels:        cci.Labels,
 		FilterPattern: cci.FilterPattern,
+		Repositories:  cci.Repositories,
+		Directories:   cci.Directories,
+		Monorepo:      cci.Monorepo,
+		Credential:    cci.Credential,
 	}
 
 	if cci.Project != nil {
@@ -113,6 +126,14 @@ type CatalogCreateInputsItem struct {
 	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
 	// Catalog regexp pattern to filter the repositories by names.
 	FilterPattern string `path:"-" query:"-" json:"filterPattern,omitempty"`
+	// URLs of the repositories to sync besides the source, only for the generic Git catalog.
+	Repositories []string `path:"-" query:"-" json:"repositories,omitempty"`
+	// Subdirectories of the source to sync as templates, or to discover the templates from in the monorepo mode, only for the generic Git catalog.
+	Directories []string `path:"-" query:"-" json:"directories,omitempty"`
+	// Discover the templates from the directories of the source, which contain the Terraform files or the schema.yaml, only for the generic Git catalog.
+	Monorepo bool `path:"-" query:"-" json:"monorepo,omitempty"`
+	// Credential to access the repositories, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 }
 
 // ValidateWith checks the CatalogCreateInputsItem entity with the given context and client set.
@@ -157,6 +178,10 @@ func (cci *CatalogCreateInputs) Model() []*Catalog {
 			Description:   cci.Items[i].Description,
 			Labels:        cci.Items[i].Labels,
 			FilterPattern: cci.Items[i].FilterPattern,
+			Repositories:  cci.Items[i].Repositories,
+			Directories:   cci.Items[i].Directories,
+			Monorepo:      cci.Items[i].Monorepo,
+			Credential:    cci.Items[i].Credential,
 		}
 
 		if cci.Project != nil {
@@ -402,6 +427,14 @@ type CatalogPatchInput struct {
 	Sync *types.CatalogSync `path:"-" query:"-" json:"sync,omitempty"`
 	// Catalog regexp pattern to filter the repositories by names.
 	FilterPattern string `path:"-" query:"-" json:"filterPattern,omitempty"`
+	// URLs of the repositories to sync besides the source, only for the generic Git catalog.
+	Repositories []string `path:"-" query:"-" json:"repositories,omitempty"`
+	// Subdirectories of the source to sync as templates, or to discover the templates from in the monorepo mode, only for the generic Git catalog.
+	Directories []string `path:"-" query:"-" json:"directories,omitempty"`
+	// Discover the templates from the directories of the source, which contain the Terraform files or the schema.yaml, only for the generic Git catalog.
+	Monorepo bool `path:"-" query:"-" json:"monorepo,omitempty"`
+	// Credential to access the repositories, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 
 	patchedEntity *Catalog `path:"-" query:"-" json:"-"`
 }
@@ -424,6 +457,10 @@ func (cpi *CatalogPatchInput) PatchModel() *Catalog {
 		Source:        cpi.Source,
 		Sync:          cpi.Sync,
 		FilterPattern: cpi.FilterPattern,
+		Repositories:  cpi.Repositories,
+		Directories:   cpi.Directories,
+		Monorepo:      cpi.Monorepo,
+		Credential:    cpi.Credential,
 	}
 
 	if cpi.Project != nil {
@@ -735,6 +772,14 @@ type CatalogUpdateInput struct {
 	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
 	// Catalog regexp pattern to filter the repositories by names.
 	FilterPattern string `path:"-" query:"-" json:"filterPattern,omitempty"`
+	// URLs of the repositories to sync besides the source, only for the generic Git catalog.
+	Repositories []string `path:"-" query:"-" json:"repositories,omitempty"`
+	// Subdirectories of the source to sync as templates, or to discover the templates from in the monorepo mode, only for the generic Git catalog.
+	Directories []string `path:"-" query:"-" json:"directories,omitempty"`
+	// Discover the templates from the directories of the source, which contain the Terraform files or the schema.yaml, only for the generic Git catalog.
+	Monorepo bool `path:"-" query:"-" json:"monorepo,omitempty"`
+	// Credential to access the repositories, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 }
 
 // Model returns the Catalog entity for modifying,
@@ -750,6 +795,10 @@ func (cui *CatalogUpdateInput) Model() *Catalog {
 		Description:   cui.Description,
 		Labels:        cui.Labels,
 		FilterPattern: cui.FilterPattern,
+		Repositories:  cui.Repositories,
+		Directories:   cui.Directories,
+		Monorepo:      cui.Monorepo,
+		Credential:    cui.Credential,
 	}
 
 	return _c
@@ -790,6 +839,14 @@ type CatalogUpdateInputsItem struct {
 	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
 	// Catalog regexp pattern to filter the repositories by names.
 	FilterPattern string `path:"-" query:"-" json:"filterPattern,omitempty"`
+	// URLs of the repositories to sync besides the source, only for the generic Git catalog.
+	Repositories []string `path:"-" query:"-" json:"repositories,omitempty"`
+	// Subdirectories of the source to sync as templates, or to discover the templates from in the monorepo mode, only for the generic Git catalog.
+	Directories []string `path:"-" query:"-" json:"directories,omitempty"`
+	// Discover the templates from the directories of the source, which contain the Terraform files or the schema.yaml, only for the generic Git catalog.
+	Monorepo bool `path:"-" query:"-" json:"monorepo,omitempty"`
+	// Credential to access the repositories, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 }
 
 // ValidateWith checks the CatalogUpdateInputsItem entity with the given context and client set.
@@ -833,6 +890,10 @@ func (cui *CatalogUpdateInputs) Model() []*Catalog {
 			Description:   cui.Items[i].Description,
 			Labels:        cui.Items[i].Labels,
 			FilterPattern: cui.Items[i].FilterPattern,
+			Repositories:  cui.Items[i].Repositories,
+			Directories:   cui.Items[i].Directories,
+			Monorepo:      cui.Items[i].Monorepo,
+			Credential:    cui.Items[i].Credential,
 		}
 
 		_cs[i] = _c
@@ -977,6 +1038,9 @@ type CatalogOutput struct {
 	Source        string             `json:"source,omitempty"`
 	Sync          *types.CatalogSync `json:"sync,omitempty"`
 	FilterPattern string             `json:"filterPattern,omitempty"`
+	Repositories  []string           `json:"repositories,omitempty"`
+	Directories   []string           `json:"directories,omitempty"`
+	Monorepo      bool               `json:"monorepo,omitempty"`
 
 	Project *ProjectOutput `json:"project,omitempty"`
 }
@@ -1009,6 +1073,9 @@ func ExposeCatalog(_c *Catalog) *CatalogOutput {
 		Source:        _c.Source,
 		Sync:          _c.Sync,
 		FilterPattern: _c.FilterPattern,
+		Repositories:  _c.Repositories,
+		Directories:   _c.Directories,
+		Monorepo:      _c.Monorepo,
 	}
 
 	if _c.Edges.Project != nil {
@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ChargebackStatement is the model entity for the ChargebackStatement schema.
+type ChargebackStatement struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// Name of the statement, generated from the period.
+	Name string `json:"name,omitempty,cli-table-column"`
+	// Start time of the period.
+	StartTime time.Time `json:"startTime,omitempty,cli-table-column"`
+	// End time of the period.
+	EndTime time.Time `json:"endTime,omitempty,cli-table-column"`
+	// Filters to select the costs of the statement, empty means all costs.
+	Filters types.CostFilters `json:"filters,omitempty"`
+	// Options to distribute the shared, idle and management costs.
+	SharedOptions *types.SharedCostOptions `json:"shared_options,omitempty"`
+	// Total cost of the statement.
+	TotalCost float64 `json:"totalCost,omitempty,cli-table-column"`
+	// Costs per project and environment.
+	Items        types.ChargebackStatementItems `json:"items,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ChargebackStatement) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case chargebackstatement.FieldFilters, chargebackstatement.FieldSharedOptions, chargebackstatement.FieldItems:
+			values[i] = new([]byte)
+		case chargebackstatement.FieldID:
+			values[i] = new(object.ID)
+		case chargebackstatement.FieldTotalCost:
+			values[i] = new(sql.NullFloat64)
+		case chargebackstatement.FieldName:
+			values[i] = new(sql.NullString)
+		case chargebackstatement.FieldCreateTime, chargebackstatement.FieldStartTime, chargebackstatement.FieldEndTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ChargebackStatement fields.
+func (cs *ChargebackStatement) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case chargebackstatement.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cs.ID = *value
+			}
+		case chargebackstatement.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				cs.CreateTime = new(time.Time)
+				*cs.CreateTime = value.Time
+			}
+		case chargebackstatement.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cs.Name = value.String
+			}
+		case chargebackstatement.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				cs.StartTime = value.Time
+			}
+		case chargebackstatement.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				cs.EndTime = value.Time
+			}
+		case chargebackstatement.FieldFilters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cs.Filters); err != nil {
+					return fmt.Errorf("unmarshal field filters: %w", err)
+				}
+			}
+		case chargebackstatement.FieldSharedOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field shared_options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cs.SharedOptions); err != nil {
+					return fmt.Errorf("unmarshal field shared_options: %w", err)
+				}
+			}
+		case chargebackstatement.FieldTotalCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_cost", values[i])
+			} else if value.Valid {
+				cs.TotalCost = value.Float64
+			}
+		case chargebackstatement.FieldItems:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field items", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cs.Items); err != nil {
+					return fmt.Errorf("unmarshal field items: %w", err)
+				}
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ChargebackStatement.
+// This includes values selected through modifiers, order, etc.
+func (cs *ChargebackStatement) Value(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ChargebackStatement.
+// Note that you need to call ChargebackStatement.Unwrap() before calling this method if this ChargebackStatement
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *ChargebackStatement) Update() *ChargebackStatementUpdateOne {
+	return NewChargebackStatementClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the ChargebackStatement entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *ChargebackStatement) Unwrap() *ChargebackStatement {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("model: ChargebackStatement is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *ChargebackStatement) String() string {
+	var builder strings.Builder
+	builder.WriteString("ChargebackStatement(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	if v := cs.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cs.Name)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(cs.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(cs.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("filters=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Filters))
+	builder.WriteString(", ")
+	builder.WriteString("shared_options=")
+	builder.WriteString(fmt.Sprintf("%v", cs.SharedOptions))
+	builder.WriteString(", ")
+	builder.WriteString("total_cost=")
+	builder.WriteString(fmt.Sprintf("%v", cs.TotalCost))
+	builder.WriteString(", ")
+	builder.WriteString("items=")
+	builder.WriteString(fmt.Sprintf("%v", cs.Items))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ChargebackStatements is a parsable slice of ChargebackStatement.
+type ChargebackStatements []*ChargebackStatement
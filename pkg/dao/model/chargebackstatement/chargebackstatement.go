@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package chargebackstatement
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// Label holds the string label denoting the chargebackstatement type in the database.
+	Label = "chargeback_statement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldFilters holds the string denoting the filters field in the database.
+	FieldFilters = "filters"
+	// FieldSharedOptions holds the string denoting the shared_options field in the database.
+	FieldSharedOptions = "shared_options"
+	// FieldTotalCost holds the string denoting the total_cost field in the database.
+	FieldTotalCost = "total_cost"
+	// FieldItems holds the string denoting the items field in the database.
+	FieldItems = "items"
+	// Table holds the table name of the chargebackstatement in the database.
+	Table = "chargeback_statements"
+)
+
+// Columns holds all SQL columns for chargebackstatement fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldName,
+	FieldStartTime,
+	FieldEndTime,
+	FieldFilters,
+	FieldSharedOptions,
+	FieldTotalCost,
+	FieldItems,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultTotalCost holds the default value on creation for the "total_cost" field.
+	DefaultTotalCost float64
+)
+
+// OrderOption defines the ordering options for the ChargebackStatement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByTotalCost orders the results by the total_cost field.
+func ByTotalCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalCost, opts...).ToFunc()
+}
+
+// WithoutFields returns the fields ignored the given list.
+func WithoutFields(ignores ...string) []string {
+	if len(ignores) == 0 {
+		return slices.Clone(Columns)
+	}
+
+	var s = make(map[string]bool, len(ignores))
+	for i := range ignores {
+		s[ignores[i]] = true
+	}
+
+	var r = make([]string, 0, len(Columns)-len(s))
+	for i := range Columns {
+		if s[Columns[i]] {
+			continue
+		}
+		r = append(r, Columns[i])
+	}
+	return r
+}
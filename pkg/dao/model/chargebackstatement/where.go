@@ -0,0 +1,355 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package chargebackstatement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldName, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldEndTime, v))
+}
+
+// TotalCost applies equality check predicate on the "total_cost" field. It's identical to TotalCostEQ.
+func TotalCost(v float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldTotalCost, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldContainsFold(FieldName, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLTE(FieldEndTime, v))
+}
+
+// FiltersIsNil applies the IsNil predicate on the "filters" field.
+func FiltersIsNil() predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIsNull(FieldFilters))
+}
+
+// FiltersNotNil applies the NotNil predicate on the "filters" field.
+func FiltersNotNil() predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotNull(FieldFilters))
+}
+
+// SharedOptionsIsNil applies the IsNil predicate on the "shared_options" field.
+func SharedOptionsIsNil() predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIsNull(FieldSharedOptions))
+}
+
+// SharedOptionsNotNil applies the NotNil predicate on the "shared_options" field.
+func SharedOptionsNotNil() predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotNull(FieldSharedOptions))
+}
+
+// TotalCostEQ applies the EQ predicate on the "total_cost" field.
+func TotalCostEQ(v float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldEQ(FieldTotalCost, v))
+}
+
+// TotalCostNEQ applies the NEQ predicate on the "total_cost" field.
+func TotalCostNEQ(v float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNEQ(FieldTotalCost, v))
+}
+
+// TotalCostIn applies the In predicate on the "total_cost" field.
+func TotalCostIn(vs ...float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIn(FieldTotalCost, vs...))
+}
+
+// TotalCostNotIn applies the NotIn predicate on the "total_cost" field.
+func TotalCostNotIn(vs ...float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotIn(FieldTotalCost, vs...))
+}
+
+// TotalCostGT applies the GT predicate on the "total_cost" field.
+func TotalCostGT(v float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGT(FieldTotalCost, v))
+}
+
+// TotalCostGTE applies the GTE predicate on the "total_cost" field.
+func TotalCostGTE(v float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldGTE(FieldTotalCost, v))
+}
+
+// TotalCostLT applies the LT predicate on the "total_cost" field.
+func TotalCostLT(v float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLT(FieldTotalCost, v))
+}
+
+// TotalCostLTE applies the LTE predicate on the "total_cost" field.
+func TotalCostLTE(v float64) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldLTE(FieldTotalCost, v))
+}
+
+// ItemsIsNil applies the IsNil predicate on the "items" field.
+func ItemsIsNil() predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldIsNull(FieldItems))
+}
+
+// ItemsNotNil applies the NotNil predicate on the "items" field.
+func ItemsNotNil() predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.FieldNotNull(FieldItems))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ChargebackStatement) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ChargebackStatement) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ChargebackStatement) predicate.ChargebackStatement {
+	return predicate.ChargebackStatement(sql.NotPredicates(p))
+}
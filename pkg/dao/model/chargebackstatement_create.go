@@ -0,0 +1,912 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ChargebackStatementCreate is the builder for creating a ChargebackStatement entity.
+type ChargebackStatementCreate struct {
+	config
+	mutation   *ChargebackStatementMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *ChargebackStatement
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (csc *ChargebackStatementCreate) SetCreateTime(t time.Time) *ChargebackStatementCreate {
+	csc.mutation.SetCreateTime(t)
+	return csc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (csc *ChargebackStatementCreate) SetNillableCreateTime(t *time.Time) *ChargebackStatementCreate {
+	if t != nil {
+		csc.SetCreateTime(*t)
+	}
+	return csc
+}
+
+// SetName sets the "name" field.
+func (csc *ChargebackStatementCreate) SetName(s string) *ChargebackStatementCreate {
+	csc.mutation.SetName(s)
+	return csc
+}
+
+// SetStartTime sets the "start_time" field.
+func (csc *ChargebackStatementCreate) SetStartTime(t time.Time) *ChargebackStatementCreate {
+	csc.mutation.SetStartTime(t)
+	return csc
+}
+
+// SetEndTime sets the "end_time" field.
+func (csc *ChargebackStatementCreate) SetEndTime(t time.Time) *ChargebackStatementCreate {
+	csc.mutation.SetEndTime(t)
+	return csc
+}
+
+// SetFilters sets the "filters" field.
+func (csc *ChargebackStatementCreate) SetFilters(tf types.CostFilters) *ChargebackStatementCreate {
+	csc.mutation.SetFilters(tf)
+	return csc
+}
+
+// SetSharedOptions sets the "shared_options" field.
+func (csc *ChargebackStatementCreate) SetSharedOptions(tco *types.SharedCostOptions) *ChargebackStatementCreate {
+	csc.mutation.SetSharedOptions(tco)
+	return csc
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (csc *ChargebackStatementCreate) SetTotalCost(f float64) *ChargebackStatementCreate {
+	csc.mutation.SetTotalCost(f)
+	return csc
+}
+
+// SetNillableTotalCost sets the "total_cost" field if the given value is not nil.
+func (csc *ChargebackStatementCreate) SetNillableTotalCost(f *float64) *ChargebackStatementCreate {
+	if f != nil {
+		csc.SetTotalCost(*f)
+	}
+	return csc
+}
+
+// SetItems sets the "items" field.
+func (csc *ChargebackStatementCreate) SetItems(tsi types.ChargebackStatementItems) *ChargebackStatementCreate {
+	csc.mutation.SetItems(tsi)
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *ChargebackStatementCreate) SetID(o object.ID) *ChargebackStatementCreate {
+	csc.mutation.SetID(o)
+	return csc
+}
+
+// Mutation returns the ChargebackStatementMutation object of the builder.
+func (csc *ChargebackStatementCreate) Mutation() *ChargebackStatementMutation {
+	return csc.mutation
+}
+
+// Save creates the ChargebackStatement in the database.
+func (csc *ChargebackStatementCreate) Save(ctx context.Context) (*ChargebackStatement, error) {
+	if err := csc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *ChargebackStatementCreate) SaveX(ctx context.Context) *ChargebackStatement {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *ChargebackStatementCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *ChargebackStatementCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *ChargebackStatementCreate) defaults() error {
+	if _, ok := csc.mutation.CreateTime(); !ok {
+		if chargebackstatement.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized chargebackstatement.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := chargebackstatement.DefaultCreateTime()
+		csc.mutation.SetCreateTime(v)
+	}
+	if _, ok := csc.mutation.TotalCost(); !ok {
+		v := chargebackstatement.DefaultTotalCost
+		csc.mutation.SetTotalCost(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *ChargebackStatementCreate) check() error {
+	if _, ok := csc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "ChargebackStatement.create_time"`)}
+	}
+	if _, ok := csc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`model: missing required field "ChargebackStatement.name"`)}
+	}
+	if _, ok := csc.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`model: missing required field "ChargebackStatement.start_time"`)}
+	}
+	if _, ok := csc.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`model: missing required field "ChargebackStatement.end_time"`)}
+	}
+	if _, ok := csc.mutation.TotalCost(); !ok {
+		return &ValidationError{Name: "total_cost", err: errors.New(`model: missing required field "ChargebackStatement.total_cost"`)}
+	}
+	return nil
+}
+
+func (csc *ChargebackStatementCreate) sqlSave(ctx context.Context) (*ChargebackStatement, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *ChargebackStatementCreate) createSpec() (*ChargebackStatement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ChargebackStatement{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(chargebackstatement.Table, sqlgraph.NewFieldSpec(chargebackstatement.FieldID, field.TypeString))
+	)
+	_spec.Schema = csc.schemaConfig.ChargebackStatement
+	_spec.OnConflict = csc.conflict
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := csc.mutation.CreateTime(); ok {
+		_spec.SetField(chargebackstatement.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := csc.mutation.Name(); ok {
+		_spec.SetField(chargebackstatement.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := csc.mutation.StartTime(); ok {
+		_spec.SetField(chargebackstatement.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := csc.mutation.EndTime(); ok {
+		_spec.SetField(chargebackstatement.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := csc.mutation.Filters(); ok {
+		_spec.SetField(chargebackstatement.FieldFilters, field.TypeJSON, value)
+		_node.Filters = value
+	}
+	if value, ok := csc.mutation.SharedOptions(); ok {
+		_spec.SetField(chargebackstatement.FieldSharedOptions, field.TypeJSON, value)
+		_node.SharedOptions = value
+	}
+	if value, ok := csc.mutation.TotalCost(); ok {
+		_spec.SetField(chargebackstatement.FieldTotalCost, field.TypeFloat64, value)
+		_node.TotalCost = value
+	}
+	if value, ok := csc.mutation.Items(); ok {
+		_spec.SetField(chargebackstatement.FieldItems, field.TypeJSON, value)
+		_node.Items = value
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (csc *ChargebackStatementCreate) Set(obj *ChargebackStatement) *ChargebackStatementCreate {
+	// Required.
+	csc.SetName(obj.Name)
+	csc.SetStartTime(obj.StartTime)
+	csc.SetEndTime(obj.EndTime)
+	csc.SetTotalCost(obj.TotalCost)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		csc.SetCreateTime(*obj.CreateTime)
+	}
+	if !reflect.ValueOf(obj.Filters).IsZero() {
+		csc.SetFilters(obj.Filters)
+	}
+	if !reflect.ValueOf(obj.SharedOptions).IsZero() {
+		csc.SetSharedOptions(obj.SharedOptions)
+	}
+	if !reflect.ValueOf(obj.Items).IsZero() {
+		csc.SetItems(obj.Items)
+	}
+
+	// Record the given object.
+	csc.object = obj
+
+	return csc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (csc *ChargebackStatementCreate) getClientSet() (mc ClientSet) {
+	if _, ok := csc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: csc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: csc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ChargebackStatement entity,
+// which is always good for cascading create operations.
+func (csc *ChargebackStatementCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ChargebackStatement) error) (*ChargebackStatement, error) {
+	obj, err := csc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := csc.getClientSet()
+
+	if x := csc.object; x != nil {
+		if _, set := csc.mutation.Field(chargebackstatement.FieldName); set {
+			obj.Name = x.Name
+		}
+		if _, set := csc.mutation.Field(chargebackstatement.FieldStartTime); set {
+			obj.StartTime = x.StartTime
+		}
+		if _, set := csc.mutation.Field(chargebackstatement.FieldEndTime); set {
+			obj.EndTime = x.EndTime
+		}
+		if _, set := csc.mutation.Field(chargebackstatement.FieldFilters); set {
+			obj.Filters = x.Filters
+		}
+		if _, set := csc.mutation.Field(chargebackstatement.FieldSharedOptions); set {
+			obj.SharedOptions = x.SharedOptions
+		}
+		if _, set := csc.mutation.Field(chargebackstatement.FieldItems); set {
+			obj.Items = x.Items
+		}
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (csc *ChargebackStatementCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ChargebackStatement) error) *ChargebackStatement {
+	obj, err := csc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (csc *ChargebackStatementCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ChargebackStatement) error) error {
+	_, err := csc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (csc *ChargebackStatementCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ChargebackStatement) error) {
+	if err := csc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the ChargebackStatementCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (cscb *ChargebackStatementCreateBulk) Set(objs ...*ChargebackStatement) *ChargebackStatementCreateBulk {
+	if len(objs) != 0 {
+		client := NewChargebackStatementClient(cscb.config)
+
+		cscb.builders = make([]*ChargebackStatementCreate, len(objs))
+		for i := range objs {
+			cscb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		cscb.objects = objs
+	}
+
+	return cscb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (cscb *ChargebackStatementCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := cscb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: cscb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: cscb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ChargebackStatement entities,
+// which is always good for cascading create operations.
+func (cscb *ChargebackStatementCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ChargebackStatement) error) ([]*ChargebackStatement, error) {
+	objs, err := cscb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := cscb.getClientSet()
+
+	if x := cscb.objects; x != nil {
+		for i := range x {
+			if _, set := cscb.builders[i].mutation.Field(chargebackstatement.FieldName); set {
+				objs[i].Name = x[i].Name
+			}
+			if _, set := cscb.builders[i].mutation.Field(chargebackstatement.FieldStartTime); set {
+				objs[i].StartTime = x[i].StartTime
+			}
+			if _, set := cscb.builders[i].mutation.Field(chargebackstatement.FieldEndTime); set {
+				objs[i].EndTime = x[i].EndTime
+			}
+			if _, set := cscb.builders[i].mutation.Field(chargebackstatement.FieldFilters); set {
+				objs[i].Filters = x[i].Filters
+			}
+			if _, set := cscb.builders[i].mutation.Field(chargebackstatement.FieldSharedOptions); set {
+				objs[i].SharedOptions = x[i].SharedOptions
+			}
+			if _, set := cscb.builders[i].mutation.Field(chargebackstatement.FieldItems); set {
+				objs[i].Items = x[i].Items
+			}
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (cscb *ChargebackStatementCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ChargebackStatement) error) []*ChargebackStatement {
+	objs, err := cscb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (cscb *ChargebackStatementCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ChargebackStatement) error) error {
+	_, err := cscb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (cscb *ChargebackStatementCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ChargebackStatement) error) {
+	if err := cscb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ChargebackStatementUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ChargebackStatement) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ChargebackStatementUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ChargebackStatementUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ChargebackStatement) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ChargebackStatementUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ChargebackStatement) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ChargebackStatementUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ChargebackStatementUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ChargebackStatementUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ChargebackStatement) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChargebackStatement.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChargebackStatementUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (csc *ChargebackStatementCreate) OnConflict(opts ...sql.ConflictOption) *ChargebackStatementUpsertOne {
+	csc.conflict = opts
+	return &ChargebackStatementUpsertOne{
+		create: csc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChargebackStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (csc *ChargebackStatementCreate) OnConflictColumns(columns ...string) *ChargebackStatementUpsertOne {
+	csc.conflict = append(csc.conflict, sql.ConflictColumns(columns...))
+	return &ChargebackStatementUpsertOne{
+		create: csc,
+	}
+}
+
+type (
+	// ChargebackStatementUpsertOne is the builder for "upsert"-ing
+	//  one ChargebackStatement node.
+	ChargebackStatementUpsertOne struct {
+		create *ChargebackStatementCreate
+	}
+
+	// ChargebackStatementUpsert is the "OnConflict" setter.
+	ChargebackStatementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ChargebackStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chargebackstatement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChargebackStatementUpsertOne) UpdateNewValues() *ChargebackStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(chargebackstatement.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(chargebackstatement.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(chargebackstatement.FieldName)
+		}
+		if _, exists := u.create.mutation.StartTime(); exists {
+			s.SetIgnore(chargebackstatement.FieldStartTime)
+		}
+		if _, exists := u.create.mutation.EndTime(); exists {
+			s.SetIgnore(chargebackstatement.FieldEndTime)
+		}
+		if _, exists := u.create.mutation.Filters(); exists {
+			s.SetIgnore(chargebackstatement.FieldFilters)
+		}
+		if _, exists := u.create.mutation.SharedOptions(); exists {
+			s.SetIgnore(chargebackstatement.FieldSharedOptions)
+		}
+		if _, exists := u.create.mutation.TotalCost(); exists {
+			s.SetIgnore(chargebackstatement.FieldTotalCost)
+		}
+		if _, exists := u.create.mutation.Items(); exists {
+			s.SetIgnore(chargebackstatement.FieldItems)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChargebackStatement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ChargebackStatementUpsertOne) Ignore() *ChargebackStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChargebackStatementUpsertOne) DoNothing() *ChargebackStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChargebackStatementCreate.OnConflict
+// documentation for more info.
+func (u *ChargebackStatementUpsertOne) Update(set func(*ChargebackStatementUpsert)) *ChargebackStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChargebackStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ChargebackStatementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ChargebackStatementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChargebackStatementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ChargebackStatementUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: ChargebackStatementUpsertOne.ID is not supported by MySQL driver. Use ChargebackStatementUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ChargebackStatementUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ChargebackStatementCreateBulk is the builder for creating many ChargebackStatement entities in bulk.
+type ChargebackStatementCreateBulk struct {
+	config
+	err        error
+	builders   []*ChargebackStatementCreate
+	conflict   []sql.ConflictOption
+	objects    []*ChargebackStatement
+	fromUpsert bool
+}
+
+// Save creates the ChargebackStatement entities in the database.
+func (cscb *ChargebackStatementCreateBulk) Save(ctx context.Context) ([]*ChargebackStatement, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*ChargebackStatement, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ChargebackStatementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *ChargebackStatementCreateBulk) SaveX(ctx context.Context) []*ChargebackStatement {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *ChargebackStatementCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *ChargebackStatementCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ChargebackStatement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ChargebackStatementUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (cscb *ChargebackStatementCreateBulk) OnConflict(opts ...sql.ConflictOption) *ChargebackStatementUpsertBulk {
+	cscb.conflict = opts
+	return &ChargebackStatementUpsertBulk{
+		create: cscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ChargebackStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cscb *ChargebackStatementCreateBulk) OnConflictColumns(columns ...string) *ChargebackStatementUpsertBulk {
+	cscb.conflict = append(cscb.conflict, sql.ConflictColumns(columns...))
+	return &ChargebackStatementUpsertBulk{
+		create: cscb,
+	}
+}
+
+// ChargebackStatementUpsertBulk is the builder for "upsert"-ing
+// a bulk of ChargebackStatement nodes.
+type ChargebackStatementUpsertBulk struct {
+	create *ChargebackStatementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ChargebackStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(chargebackstatement.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ChargebackStatementUpsertBulk) UpdateNewValues() *ChargebackStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(chargebackstatement.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(chargebackstatement.FieldCreateTime)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(chargebackstatement.FieldName)
+			}
+			if _, exists := b.mutation.StartTime(); exists {
+				s.SetIgnore(chargebackstatement.FieldStartTime)
+			}
+			if _, exists := b.mutation.EndTime(); exists {
+				s.SetIgnore(chargebackstatement.FieldEndTime)
+			}
+			if _, exists := b.mutation.Filters(); exists {
+				s.SetIgnore(chargebackstatement.FieldFilters)
+			}
+			if _, exists := b.mutation.SharedOptions(); exists {
+				s.SetIgnore(chargebackstatement.FieldSharedOptions)
+			}
+			if _, exists := b.mutation.TotalCost(); exists {
+				s.SetIgnore(chargebackstatement.FieldTotalCost)
+			}
+			if _, exists := b.mutation.Items(); exists {
+				s.SetIgnore(chargebackstatement.FieldItems)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ChargebackStatement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ChargebackStatementUpsertBulk) Ignore() *ChargebackStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ChargebackStatementUpsertBulk) DoNothing() *ChargebackStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ChargebackStatementCreateBulk.OnConflict
+// documentation for more info.
+func (u *ChargebackStatementUpsertBulk) Update(set func(*ChargebackStatementUpsert)) *ChargebackStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ChargebackStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ChargebackStatementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ChargebackStatementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ChargebackStatementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ChargebackStatementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
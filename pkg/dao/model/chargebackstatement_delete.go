@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+// ChargebackStatementDelete is the builder for deleting a ChargebackStatement entity.
+type ChargebackStatementDelete struct {
+	config
+	hooks    []Hook
+	mutation *ChargebackStatementMutation
+}
+
+// Where appends a list predicates to the ChargebackStatementDelete builder.
+func (csd *ChargebackStatementDelete) Where(ps ...predicate.ChargebackStatement) *ChargebackStatementDelete {
+	csd.mutation.Where(ps...)
+	return csd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csd *ChargebackStatementDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csd.sqlExec, csd.mutation, csd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csd *ChargebackStatementDelete) ExecX(ctx context.Context) int {
+	n, err := csd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csd *ChargebackStatementDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(chargebackstatement.Table, sqlgraph.NewFieldSpec(chargebackstatement.FieldID, field.TypeString))
+	_spec.Node.Schema = csd.schemaConfig.ChargebackStatement
+	ctx = internal.NewSchemaConfigContext(ctx, csd.schemaConfig)
+	if ps := csd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csd.mutation.done = true
+	return affected, err
+}
+
+// ChargebackStatementDeleteOne is the builder for deleting a single ChargebackStatement entity.
+type ChargebackStatementDeleteOne struct {
+	csd *ChargebackStatementDelete
+}
+
+// Where appends a list predicates to the ChargebackStatementDelete builder.
+func (csdo *ChargebackStatementDeleteOne) Where(ps ...predicate.ChargebackStatement) *ChargebackStatementDeleteOne {
+	csdo.csd.mutation.Where(ps...)
+	return csdo
+}
+
+// Exec executes the deletion query.
+func (csdo *ChargebackStatementDeleteOne) Exec(ctx context.Context) error {
+	n, err := csdo.csd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{chargebackstatement.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csdo *ChargebackStatementDeleteOne) ExecX(ctx context.Context) {
+	if err := csdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,598 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ChargebackStatementQuery is the builder for querying ChargebackStatement entities.
+type ChargebackStatementQuery struct {
+	config
+	ctx        *QueryContext
+	order      []chargebackstatement.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ChargebackStatement
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ChargebackStatementQuery builder.
+func (csq *ChargebackStatementQuery) Where(ps ...predicate.ChargebackStatement) *ChargebackStatementQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit the number of records to be returned by this query.
+func (csq *ChargebackStatementQuery) Limit(limit int) *ChargebackStatementQuery {
+	csq.ctx.Limit = &limit
+	return csq
+}
+
+// Offset to start from.
+func (csq *ChargebackStatementQuery) Offset(offset int) *ChargebackStatementQuery {
+	csq.ctx.Offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *ChargebackStatementQuery) Unique(unique bool) *ChargebackStatementQuery {
+	csq.ctx.Unique = &unique
+	return csq
+}
+
+// Order specifies how the records should be ordered.
+func (csq *ChargebackStatementQuery) Order(o ...chargebackstatement.OrderOption) *ChargebackStatementQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// First returns the first ChargebackStatement entity from the query.
+// Returns a *NotFoundError when no ChargebackStatement was found.
+func (csq *ChargebackStatementQuery) First(ctx context.Context) (*ChargebackStatement, error) {
+	nodes, err := csq.Limit(1).All(setContextOp(ctx, csq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{chargebackstatement.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *ChargebackStatementQuery) FirstX(ctx context.Context) *ChargebackStatement {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ChargebackStatement ID from the query.
+// Returns a *NotFoundError when no ChargebackStatement ID was found.
+func (csq *ChargebackStatementQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = csq.Limit(1).IDs(setContextOp(ctx, csq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{chargebackstatement.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *ChargebackStatementQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ChargebackStatement entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ChargebackStatement entity is found.
+// Returns a *NotFoundError when no ChargebackStatement entities are found.
+func (csq *ChargebackStatementQuery) Only(ctx context.Context) (*ChargebackStatement, error) {
+	nodes, err := csq.Limit(2).All(setContextOp(ctx, csq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{chargebackstatement.Label}
+	default:
+		return nil, &NotSingularError{chargebackstatement.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *ChargebackStatementQuery) OnlyX(ctx context.Context) *ChargebackStatement {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ChargebackStatement ID in the query.
+// Returns a *NotSingularError when more than one ChargebackStatement ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *ChargebackStatementQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = csq.Limit(2).IDs(setContextOp(ctx, csq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{chargebackstatement.Label}
+	default:
+		err = &NotSingularError{chargebackstatement.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *ChargebackStatementQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ChargebackStatements.
+func (csq *ChargebackStatementQuery) All(ctx context.Context) ([]*ChargebackStatement, error) {
+	ctx = setContextOp(ctx, csq.ctx, "All")
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ChargebackStatement, *ChargebackStatementQuery]()
+	return withInterceptors[[]*ChargebackStatement](ctx, csq, qr, csq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *ChargebackStatementQuery) AllX(ctx context.Context) []*ChargebackStatement {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ChargebackStatement IDs.
+func (csq *ChargebackStatementQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if csq.ctx.Unique == nil && csq.path != nil {
+		csq.Unique(true)
+	}
+	ctx = setContextOp(ctx, csq.ctx, "IDs")
+	if err = csq.Select(chargebackstatement.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *ChargebackStatementQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *ChargebackStatementQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, csq.ctx, "Count")
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, csq, querierCount[*ChargebackStatementQuery](), csq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *ChargebackStatementQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *ChargebackStatementQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, csq.ctx, "Exist")
+	switch _, err := csq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *ChargebackStatementQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ChargebackStatementQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *ChargebackStatementQuery) Clone() *ChargebackStatementQuery {
+	if csq == nil {
+		return nil
+	}
+	return &ChargebackStatementQuery{
+		config:     csq.config,
+		ctx:        csq.ctx.Clone(),
+		order:      append([]chargebackstatement.OrderOption{}, csq.order...),
+		inters:     append([]Interceptor{}, csq.inters...),
+		predicates: append([]predicate.ChargebackStatement{}, csq.predicates...),
+		// clone intermediate query.
+		sql:  csq.sql.Clone(),
+		path: csq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ChargebackStatement.Query().
+//		GroupBy(chargebackstatement.FieldCreateTime).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (csq *ChargebackStatementQuery) GroupBy(field string, fields ...string) *ChargebackStatementGroupBy {
+	csq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ChargebackStatementGroupBy{build: csq}
+	grbuild.flds = &csq.ctx.Fields
+	grbuild.label = chargebackstatement.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ChargebackStatement.Query().
+//		Select(chargebackstatement.FieldCreateTime).
+//		Scan(ctx, &v)
+func (csq *ChargebackStatementQuery) Select(fields ...string) *ChargebackStatementSelect {
+	csq.ctx.Fields = append(csq.ctx.Fields, fields...)
+	sbuild := &ChargebackStatementSelect{ChargebackStatementQuery: csq}
+	sbuild.label = chargebackstatement.Label
+	sbuild.flds, sbuild.scan = &csq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ChargebackStatementSelect configured with the given aggregations.
+func (csq *ChargebackStatementQuery) Aggregate(fns ...AggregateFunc) *ChargebackStatementSelect {
+	return csq.Select().Aggregate(fns...)
+}
+
+func (csq *ChargebackStatementQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range csq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, csq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range csq.ctx.Fields {
+		if !chargebackstatement.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *ChargebackStatementQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ChargebackStatement, error) {
+	var (
+		nodes = []*ChargebackStatement{}
+		_spec = csq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ChargebackStatement).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ChargebackStatement{config: csq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = csq.schemaConfig.ChargebackStatement
+	ctx = internal.NewSchemaConfigContext(ctx, csq.schemaConfig)
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (csq *ChargebackStatementQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	_spec.Node.Schema = csq.schemaConfig.ChargebackStatement
+	ctx = internal.NewSchemaConfigContext(ctx, csq.schemaConfig)
+	if len(csq.modifiers) > 0 {
+		_spec.Modifiers = csq.modifiers
+	}
+	_spec.Node.Columns = csq.ctx.Fields
+	if len(csq.ctx.Fields) > 0 {
+		_spec.Unique = csq.ctx.Unique != nil && *csq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *ChargebackStatementQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(chargebackstatement.Table, chargebackstatement.Columns, sqlgraph.NewFieldSpec(chargebackstatement.FieldID, field.TypeString))
+	_spec.From = csq.sql
+	if unique := csq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if csq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := csq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chargebackstatement.FieldID)
+		for i := range fields {
+			if fields[i] != chargebackstatement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *ChargebackStatementQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(chargebackstatement.Table)
+	columns := csq.ctx.Fields
+	if len(columns) == 0 {
+		columns = chargebackstatement.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.ctx.Unique != nil && *csq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(csq.schemaConfig.ChargebackStatement)
+	ctx = internal.NewSchemaConfigContext(ctx, csq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range csq.modifiers {
+		m(selector)
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (csq *ChargebackStatementQuery) ForUpdate(opts ...sql.LockOption) *ChargebackStatementQuery {
+	if csq.driver.Dialect() == dialect.Postgres {
+		csq.Unique(false)
+	}
+	csq.modifiers = append(csq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return csq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (csq *ChargebackStatementQuery) ForShare(opts ...sql.LockOption) *ChargebackStatementQuery {
+	if csq.driver.Dialect() == dialect.Postgres {
+		csq.Unique(false)
+	}
+	csq.modifiers = append(csq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return csq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (csq *ChargebackStatementQuery) Modify(modifiers ...func(s *sql.Selector)) *ChargebackStatementSelect {
+	csq.modifiers = append(csq.modifiers, modifiers...)
+	return csq.Select()
+}
+
+// WhereP appends storage-level predicates to the ChargebackStatementQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (csq *ChargebackStatementQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.ChargebackStatement, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.ChargebackStatement(ps[i]))
+	}
+	csq.predicates = append(csq.predicates, wps...)
+}
+
+// ChargebackStatementGroupBy is the group-by builder for ChargebackStatement entities.
+type ChargebackStatementGroupBy struct {
+	selector
+	build *ChargebackStatementQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *ChargebackStatementGroupBy) Aggregate(fns ...AggregateFunc) *ChargebackStatementGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csgb *ChargebackStatementGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csgb.build.ctx, "GroupBy")
+	if err := csgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChargebackStatementQuery, *ChargebackStatementGroupBy](ctx, csgb.build, csgb, csgb.build.inters, v)
+}
+
+func (csgb *ChargebackStatementGroupBy) sqlScan(ctx context.Context, root *ChargebackStatementQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*csgb.flds)+len(csgb.fns))
+		for _, f := range *csgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*csgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ChargebackStatementSelect is the builder for selecting fields of ChargebackStatement entities.
+type ChargebackStatementSelect struct {
+	*ChargebackStatementQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (css *ChargebackStatementSelect) Aggregate(fns ...AggregateFunc) *ChargebackStatementSelect {
+	css.fns = append(css.fns, fns...)
+	return css
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *ChargebackStatementSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, css.ctx, "Select")
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ChargebackStatementQuery, *ChargebackStatementSelect](ctx, css.ChargebackStatementQuery, css, css.inters, v)
+}
+
+func (css *ChargebackStatementSelect) sqlScan(ctx context.Context, root *ChargebackStatementQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(css.fns))
+	for _, fn := range css.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*css.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (css *ChargebackStatementSelect) Modify(modifiers ...func(s *sql.Selector)) *ChargebackStatementSelect {
+	css.modifiers = append(css.modifiers, modifiers...)
+	return css
+}
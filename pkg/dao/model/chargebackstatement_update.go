@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+// ChargebackStatementUpdate is the builder for updating ChargebackStatement entities.
+type ChargebackStatementUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ChargebackStatementMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ChargebackStatement
+}
+
+// Where appends a list predicates to the ChargebackStatementUpdate builder.
+func (csu *ChargebackStatementUpdate) Where(ps ...predicate.ChargebackStatement) *ChargebackStatementUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// Mutation returns the ChargebackStatementMutation object of the builder.
+func (csu *ChargebackStatementUpdate) Mutation() *ChargebackStatementMutation {
+	return csu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *ChargebackStatementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *ChargebackStatementUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *ChargebackStatementUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *ChargebackStatementUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (csu *ChargebackStatementUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChargebackStatementUpdate {
+	csu.modifiers = append(csu.modifiers, modifiers...)
+	return csu
+}
+
+func (csu *ChargebackStatementUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(chargebackstatement.Table, chargebackstatement.Columns, sqlgraph.NewFieldSpec(chargebackstatement.FieldID, field.TypeString))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if csu.mutation.FiltersCleared() {
+		_spec.ClearField(chargebackstatement.FieldFilters, field.TypeJSON)
+	}
+	if csu.mutation.SharedOptionsCleared() {
+		_spec.ClearField(chargebackstatement.FieldSharedOptions, field.TypeJSON)
+	}
+	if csu.mutation.ItemsCleared() {
+		_spec.ClearField(chargebackstatement.FieldItems, field.TypeJSON)
+	}
+	_spec.Node.Schema = csu.schemaConfig.ChargebackStatement
+	ctx = internal.NewSchemaConfigContext(ctx, csu.schemaConfig)
+	_spec.AddModifiers(csu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chargebackstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// ChargebackStatementUpdateOne is the builder for updating a single ChargebackStatement entity.
+type ChargebackStatementUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ChargebackStatementMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ChargebackStatement
+}
+
+// Mutation returns the ChargebackStatementMutation object of the builder.
+func (csuo *ChargebackStatementUpdateOne) Mutation() *ChargebackStatementMutation {
+	return csuo.mutation
+}
+
+// Where appends a list predicates to the ChargebackStatementUpdate builder.
+func (csuo *ChargebackStatementUpdateOne) Where(ps ...predicate.ChargebackStatement) *ChargebackStatementUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *ChargebackStatementUpdateOne) Select(field string, fields ...string) *ChargebackStatementUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated ChargebackStatement entity.
+func (csuo *ChargebackStatementUpdateOne) Save(ctx context.Context) (*ChargebackStatement, error) {
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *ChargebackStatementUpdateOne) SaveX(ctx context.Context) *ChargebackStatement {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *ChargebackStatementUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *ChargebackStatementUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (csuo *ChargebackStatementUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ChargebackStatementUpdateOne {
+	csuo.modifiers = append(csuo.modifiers, modifiers...)
+	return csuo
+}
+
+func (csuo *ChargebackStatementUpdateOne) sqlSave(ctx context.Context) (_node *ChargebackStatement, err error) {
+	_spec := sqlgraph.NewUpdateSpec(chargebackstatement.Table, chargebackstatement.Columns, sqlgraph.NewFieldSpec(chargebackstatement.FieldID, field.TypeString))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "ChargebackStatement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, chargebackstatement.FieldID)
+		for _, f := range fields {
+			if !chargebackstatement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != chargebackstatement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if csuo.mutation.FiltersCleared() {
+		_spec.ClearField(chargebackstatement.FieldFilters, field.TypeJSON)
+	}
+	if csuo.mutation.SharedOptionsCleared() {
+		_spec.ClearField(chargebackstatement.FieldSharedOptions, field.TypeJSON)
+	}
+	if csuo.mutation.ItemsCleared() {
+		_spec.ClearField(chargebackstatement.FieldItems, field.TypeJSON)
+	}
+	_spec.Node.Schema = csuo.schemaConfig.ChargebackStatement
+	ctx = internal.NewSchemaConfigContext(ctx, csuo.schemaConfig)
+	_spec.AddModifiers(csuo.modifiers...)
+	_node = &ChargebackStatement{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{chargebackstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
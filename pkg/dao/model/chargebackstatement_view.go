@@ -0,0 +1,769 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ChargebackStatementCreateInput holds the creation input of the ChargebackStatement entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ChargebackStatementCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// End time of the period.
+	EndTime time.Time `path:"-" query:"-" json:"endTime,cli-table-column"`
+	// Start time of the period.
+	StartTime time.Time `path:"-" query:"-" json:"startTime,cli-table-column"`
+	// Filters to select the costs of the statement, empty means all costs.
+	Filters types.CostFilters `path:"-" query:"-" json:"filters,omitempty"`
+	// Options to distribute the shared, idle and management costs.
+	SharedOptions *types.SharedCostOptions `path:"-" query:"-" json:"sharedOptions,omitempty"`
+}
+
+// Model returns the ChargebackStatement entity for creating,
+// after validating.
+func (csci *ChargebackStatementCreateInput) Model() *ChargebackStatement {
+	if csci == nil {
+		return nil
+	}
+
+	_cs := &ChargebackStatement{
+		EndTime:       csci.EndTime,
+		StartTime:     csci.StartTime,
+		Filters:       csci.Filters,
+		SharedOptions: csci.SharedOptions,
+	}
+
+	return _cs
+}
+
+// Validate checks the ChargebackStatementCreateInput entity.
+func (csci *ChargebackStatementCreateInput) Validate() error {
+	if csci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return csci.ValidateWith(csci.inputConfig.Context, csci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ChargebackStatementCreateInput entity with the given context and client set.
+func (csci *ChargebackStatementCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if csci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ChargebackStatementCreateInputs holds the creation input item of the ChargebackStatement entities.
+type ChargebackStatementCreateInputsItem struct {
+	// End time of the period.
+	EndTime time.Time `path:"-" query:"-" json:"endTime,cli-table-column"`
+	// Start time of the period.
+	StartTime time.Time `path:"-" query:"-" json:"startTime,cli-table-column"`
+	// Filters to select the costs of the statement, empty means all costs.
+	Filters types.CostFilters `path:"-" query:"-" json:"filters,omitempty"`
+	// Options to distribute the shared, idle and management costs.
+	SharedOptions *types.SharedCostOptions `path:"-" query:"-" json:"sharedOptions,omitempty"`
+}
+
+// ValidateWith checks the ChargebackStatementCreateInputsItem entity with the given context and client set.
+func (csci *ChargebackStatementCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if csci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ChargebackStatementCreateInputs holds the creation input of the ChargebackStatement entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ChargebackStatementCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ChargebackStatementCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ChargebackStatement entities for creating,
+// after validating.
+func (csci *ChargebackStatementCreateInputs) Model() []*ChargebackStatement {
+	if csci == nil || len(csci.Items) == 0 {
+		return nil
+	}
+
+	_css := make([]*ChargebackStatement, len(csci.Items))
+
+	for i := range csci.Items {
+		_cs := &ChargebackStatement{
+			EndTime:       csci.Items[i].EndTime,
+			StartTime:     csci.Items[i].StartTime,
+			Filters:       csci.Items[i].Filters,
+			SharedOptions: csci.Items[i].SharedOptions,
+		}
+
+		_css[i] = _cs
+	}
+
+	return _css
+}
+
+// Validate checks the ChargebackStatementCreateInputs entity .
+func (csci *ChargebackStatementCreateInputs) Validate() error {
+	if csci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return csci.ValidateWith(csci.inputConfig.Context, csci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ChargebackStatementCreateInputs entity with the given context and client set.
+func (csci *ChargebackStatementCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if csci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(csci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	for i := range csci.Items {
+		if csci.Items[i] == nil {
+			continue
+		}
+
+		if err := csci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChargebackStatementDeleteInput holds the deletion input of the ChargebackStatement entity,
+// please tags with `path:",inline"` if embedding.
+type ChargebackStatementDeleteInput struct {
+	ChargebackStatementQueryInput `path:",inline"`
+}
+
+// ChargebackStatementDeleteInputs holds the deletion input item of the ChargebackStatement entities.
+type ChargebackStatementDeleteInputsItem struct {
+	// ID of the ChargebackStatement entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ChargebackStatementDeleteInputs holds the deletion input of the ChargebackStatement entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ChargebackStatementDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ChargebackStatementDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ChargebackStatement entities for deleting,
+// after validating.
+func (csdi *ChargebackStatementDeleteInputs) Model() []*ChargebackStatement {
+	if csdi == nil || len(csdi.Items) == 0 {
+		return nil
+	}
+
+	_css := make([]*ChargebackStatement, len(csdi.Items))
+	for i := range csdi.Items {
+		_css[i] = &ChargebackStatement{
+			ID: csdi.Items[i].ID,
+		}
+	}
+	return _css
+}
+
+// IDs returns the ID list of the ChargebackStatement entities for deleting,
+// after validating.
+func (csdi *ChargebackStatementDeleteInputs) IDs() []object.ID {
+	if csdi == nil || len(csdi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(csdi.Items))
+	for i := range csdi.Items {
+		ids[i] = csdi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ChargebackStatementDeleteInputs entity.
+func (csdi *ChargebackStatementDeleteInputs) Validate() error {
+	if csdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return csdi.ValidateWith(csdi.inputConfig.Context, csdi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ChargebackStatementDeleteInputs entity with the given context and client set.
+func (csdi *ChargebackStatementDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if csdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(csdi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ChargebackStatements().Query()
+
+	ids := make([]object.ID, 0, len(csdi.Items))
+
+	for i := range csdi.Items {
+		if csdi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if csdi.Items[i].ID != "" {
+			ids = append(ids, csdi.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(chargebackstatement.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	return nil
+}
+
+// ChargebackStatementPatchInput holds the patch input of the ChargebackStatement entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ChargebackStatementPatchInput struct {
+	ChargebackStatementQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// Name of the statement, generated from the period.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+	// Start time of the period.
+	StartTime time.Time `path:"-" query:"-" json:"startTime,omitempty"`
+	// End time of the period.
+	EndTime time.Time `path:"-" query:"-" json:"endTime,omitempty"`
+	// Filters to select the costs of the statement, empty means all costs.
+	Filters types.CostFilters `path:"-" query:"-" json:"filters,omitempty"`
+	// Options to distribute the shared, idle and management costs.
+	SharedOptions *types.SharedCostOptions `path:"-" query:"-" json:"sharedOptions,omitempty"`
+	// Total cost of the statement.
+	TotalCost float64 `path:"-" query:"-" json:"totalCost,omitempty"`
+	// Costs per project and environment.
+	Items types.ChargebackStatementItems `path:"-" query:"-" json:"items,omitempty"`
+
+	patchedEntity *ChargebackStatement `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the ChargebackStatement partition entity for patching.
+func (cspi *ChargebackStatementPatchInput) PatchModel() *ChargebackStatement {
+	if cspi == nil {
+		return nil
+	}
+
+	_cs := &ChargebackStatement{
+		CreateTime:    cspi.CreateTime,
+		Name:          cspi.Name,
+		StartTime:     cspi.StartTime,
+		EndTime:       cspi.EndTime,
+		Filters:       cspi.Filters,
+		SharedOptions: cspi.SharedOptions,
+		TotalCost:     cspi.TotalCost,
+		Items:         cspi.Items,
+	}
+
+	return _cs
+}
+
+// Model returns the ChargebackStatement patched entity,
+// after validating.
+func (cspi *ChargebackStatementPatchInput) Model() *ChargebackStatement {
+	if cspi == nil {
+		return nil
+	}
+
+	return cspi.patchedEntity
+}
+
+// Validate checks the ChargebackStatementPatchInput entity.
+func (cspi *ChargebackStatementPatchInput) Validate() error {
+	if cspi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return cspi.ValidateWith(cspi.inputConfig.Context, cspi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ChargebackStatementPatchInput entity with the given context and client set.
+func (cspi *ChargebackStatementPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := cspi.ChargebackStatementQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.ChargebackStatements().Query()
+
+	if cspi.Refer != nil {
+		if cspi.Refer.IsID() {
+			q.Where(
+				chargebackstatement.ID(cspi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of chargebackstatement")
+		}
+	} else if cspi.ID != "" {
+		q.Where(
+			chargebackstatement.ID(cspi.ID))
+	} else {
+		return errors.New("invalid identify of chargebackstatement")
+	}
+
+	q.Select(
+		chargebackstatement.WithoutFields(
+			chargebackstatement.FieldCreateTime,
+			chargebackstatement.FieldName,
+			chargebackstatement.FieldTotalCost,
+			chargebackstatement.FieldItems,
+		)...,
+	)
+
+	var e *ChargebackStatement
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ChargebackStatement)
+		}
+	}
+
+	_pm := cspi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*ChargebackStatement)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.Name != _obj.Name {
+		return errors.New("field name is immutable")
+	}
+	if !e.StartTime.Equal(_obj.StartTime) {
+		return errors.New("field startTime is immutable")
+	}
+	if !e.EndTime.Equal(_obj.EndTime) {
+		return errors.New("field endTime is immutable")
+	}
+	if !reflect.DeepEqual(e.Filters, _obj.Filters) {
+		return errors.New("field filters is immutable")
+	}
+	if !reflect.DeepEqual(e.SharedOptions, _obj.SharedOptions) {
+		return errors.New("field sharedOptions is immutable")
+	}
+	if e.TotalCost != _obj.TotalCost {
+		return errors.New("field totalCost is immutable")
+	}
+	if !reflect.DeepEqual(e.Items, _obj.Items) {
+		return errors.New("field items is immutable")
+	}
+
+	cspi.patchedEntity = _obj
+	return nil
+}
+
+// ChargebackStatementQueryInput holds the query input of the ChargebackStatement entity,
+// please tags with `path:",inline"` if embedding.
+type ChargebackStatementQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Refer holds the route path reference of the ChargebackStatement entity.
+	Refer *object.Refer `path:"chargebackstatement,default=" query:"-" json:"-"`
+	// ID of the ChargebackStatement entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// Model returns the ChargebackStatement entity for querying,
+// after validating.
+func (csqi *ChargebackStatementQueryInput) Model() *ChargebackStatement {
+	if csqi == nil {
+		return nil
+	}
+
+	return &ChargebackStatement{
+		ID: csqi.ID,
+	}
+}
+
+// Validate checks the ChargebackStatementQueryInput entity.
+func (csqi *ChargebackStatementQueryInput) Validate() error {
+	if csqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return csqi.ValidateWith(csqi.inputConfig.Context, csqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ChargebackStatementQueryInput entity with the given context and client set.
+func (csqi *ChargebackStatementQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if csqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if csqi.Refer != nil && *csqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", chargebackstatement.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ChargebackStatements().Query()
+
+	if csqi.Refer != nil {
+		if csqi.Refer.IsID() {
+			q.Where(
+				chargebackstatement.ID(csqi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of chargebackstatement")
+		}
+	} else if csqi.ID != "" {
+		q.Where(
+			chargebackstatement.ID(csqi.ID))
+	} else {
+		return errors.New("invalid identify of chargebackstatement")
+	}
+
+	q.Select(
+		chargebackstatement.FieldID,
+	)
+
+	var e *ChargebackStatement
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ChargebackStatement)
+		}
+	}
+
+	csqi.ID = e.ID
+	return nil
+}
+
+// ChargebackStatementQueryInputs holds the query input of the ChargebackStatement entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type ChargebackStatementQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Validate checks the ChargebackStatementQueryInputs entity.
+func (csqi *ChargebackStatementQueryInputs) Validate() error {
+	if csqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return csqi.ValidateWith(csqi.inputConfig.Context, csqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ChargebackStatementQueryInputs entity with the given context and client set.
+func (csqi *ChargebackStatementQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if csqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ChargebackStatementUpdateInput holds the modification input of the ChargebackStatement entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ChargebackStatementUpdateInput struct {
+	ChargebackStatementQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Model returns the ChargebackStatement entity for modifying,
+// after validating.
+func (csui *ChargebackStatementUpdateInput) Model() *ChargebackStatement {
+	if csui == nil {
+		return nil
+	}
+
+	_cs := &ChargebackStatement{
+		ID: csui.ID,
+	}
+
+	return _cs
+}
+
+// Validate checks the ChargebackStatementUpdateInput entity.
+func (csui *ChargebackStatementUpdateInput) Validate() error {
+	if csui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return csui.ValidateWith(csui.inputConfig.Context, csui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ChargebackStatementUpdateInput entity with the given context and client set.
+func (csui *ChargebackStatementUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := csui.ChargebackStatementQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ChargebackStatementUpdateInputs holds the modification input item of the ChargebackStatement entities.
+type ChargebackStatementUpdateInputsItem struct {
+	// ID of the ChargebackStatement entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ValidateWith checks the ChargebackStatementUpdateInputsItem entity with the given context and client set.
+func (csui *ChargebackStatementUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if csui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ChargebackStatementUpdateInputs holds the modification input of the ChargebackStatement entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ChargebackStatementUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ChargebackStatementUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ChargebackStatement entities for modifying,
+// after validating.
+func (csui *ChargebackStatementUpdateInputs) Model() []*ChargebackStatement {
+	if csui == nil || len(csui.Items) == 0 {
+		return nil
+	}
+
+	_css := make([]*ChargebackStatement, len(csui.Items))
+
+	for i := range csui.Items {
+		_cs := &ChargebackStatement{
+			ID: csui.Items[i].ID,
+		}
+
+		_css[i] = _cs
+	}
+
+	return _css
+}
+
+// IDs returns the ID list of the ChargebackStatement entities for modifying,
+// after validating.
+func (csui *ChargebackStatementUpdateInputs) IDs() []object.ID {
+	if csui == nil || len(csui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(csui.Items))
+	for i := range csui.Items {
+		ids[i] = csui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ChargebackStatementUpdateInputs entity.
+func (csui *ChargebackStatementUpdateInputs) Validate() error {
+	if csui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return csui.ValidateWith(csui.inputConfig.Context, csui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ChargebackStatementUpdateInputs entity with the given context and client set.
+func (csui *ChargebackStatementUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if csui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(csui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ChargebackStatements().Query()
+
+	ids := make([]object.ID, 0, len(csui.Items))
+
+	for i := range csui.Items {
+		if csui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if csui.Items[i].ID != "" {
+			ids = append(ids, csui.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(chargebackstatement.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range csui.Items {
+		if err := csui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChargebackStatementOutput holds the output of the ChargebackStatement entity.
+type ChargebackStatementOutput struct {
+	ID            object.ID                      `json:"id,omitempty"`
+	CreateTime    *time.Time                     `json:"createTime,omitempty"`
+	Name          string                         `json:"name,cli-table-column,omitempty"`
+	StartTime     time.Time                      `json:"startTime,cli-table-column,omitempty"`
+	EndTime       time.Time                      `json:"endTime,cli-table-column,omitempty"`
+	Filters       types.CostFilters              `json:"filters,omitempty"`
+	SharedOptions *types.SharedCostOptions       `json:"sharedOptions,omitempty"`
+	TotalCost     float64                        `json:"totalCost,cli-table-column,omitempty"`
+	Items         types.ChargebackStatementItems `json:"items,omitempty"`
+}
+
+// View returns the output of ChargebackStatement entity.
+func (_cs *ChargebackStatement) View() *ChargebackStatementOutput {
+	return ExposeChargebackStatement(_cs)
+}
+
+// View returns the output of ChargebackStatement entities.
+func (_css ChargebackStatements) View() []*ChargebackStatementOutput {
+	return ExposeChargebackStatements(_css)
+}
+
+// ExposeChargebackStatement converts the ChargebackStatement to ChargebackStatementOutput.
+func ExposeChargebackStatement(_cs *ChargebackStatement) *ChargebackStatementOutput {
+	if _cs == nil {
+		return nil
+	}
+
+	cso := &ChargebackStatementOutput{
+		ID:            _cs.ID,
+		CreateTime:    _cs.CreateTime,
+		Name:          _cs.Name,
+		StartTime:     _cs.StartTime,
+		EndTime:       _cs.EndTime,
+		Filters:       _cs.Filters,
+		SharedOptions: _cs.SharedOptions,
+		TotalCost:     _cs.TotalCost,
+		Items:         _cs.Items,
+	}
+
+	return cso
+}
+
+// ExposeChargebackStatements converts the ChargebackStatement slice to ChargebackStatementOutput pointer slice.
+func ExposeChargebackStatements(_css []*ChargebackStatement) []*ChargebackStatementOutput {
+	if len(_css) == 0 {
+		return nil
+	}
+
+	csos := make([]*ChargebackStatementOutput, len(_css))
+	for i := range _css {
+		csos[i] = ExposeChargebackStatement(_css[i])
+	}
+	return csos
+}
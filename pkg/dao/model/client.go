@@ -20,9 +20,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
 	"github.com/seal-io/walrus/pkg/dao/model/costreport"
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
 	"github.com/seal-io/walrus/pkg/dao/model/distributelock"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
@@ -35,15 +41,23 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinitionmatchingrule"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/role"
 	"github.com/seal-io/walrus/pkg/dao/model/setting"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
 	"github.com/seal-io/walrus/pkg/dao/model/subject"
 	"github.com/seal-io/walrus/pkg/dao/model/subjectrolerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/token"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
 	"github.com/seal-io/walrus/pkg/dao/model/workflow"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowexecution"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowstage"
@@ -61,12 +75,24 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Budget is the client for interacting with the Budget builders.
+	Budget *BudgetClient
+	// BudgetEvent is the client for interacting with the BudgetEvent builders.
+	BudgetEvent *BudgetEventClient
 	// Catalog is the client for interacting with the Catalog builders.
 	Catalog *CatalogClient
+	// ChargebackStatement is the client for interacting with the ChargebackStatement builders.
+	ChargebackStatement *ChargebackStatementClient
 	// Connector is the client for interacting with the Connector builders.
 	Connector *ConnectorClient
+	// CostAnomaly is the client for interacting with the CostAnomaly builders.
+	CostAnomaly *CostAnomalyClient
 	// CostReport is the client for interacting with the CostReport builders.
 	CostReport *CostReportClient
+	// DataEncryptionKey is the client for interacting with the DataEncryptionKey builders.
+	DataEncryptionKey *DataEncryptionKeyClient
 	// DistributeLock is the client for interacting with the DistributeLock builders.
 	DistributeLock *DistributeLockClient
 	// Environment is the client for interacting with the Environment builders.
@@ -91,24 +117,40 @@ type Client struct {
 	ResourceRelationship *ResourceRelationshipClient
 	// ResourceRun is the client for interacting with the ResourceRun builders.
 	ResourceRun *ResourceRunClient
+	// ResourceRunApprovalRule is the client for interacting with the ResourceRunApprovalRule builders.
+	ResourceRunApprovalRule *ResourceRunApprovalRuleClient
+	// ResourceRunPolicy is the client for interacting with the ResourceRunPolicy builders.
+	ResourceRunPolicy *ResourceRunPolicyClient
 	// ResourceState is the client for interacting with the ResourceState builders.
 	ResourceState *ResourceStateClient
+	// ResourceStateLock is the client for interacting with the ResourceStateLock builders.
+	ResourceStateLock *ResourceStateLockClient
+	// ResourceStateVersion is the client for interacting with the ResourceStateVersion builders.
+	ResourceStateVersion *ResourceStateVersionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// StorageObject is the client for interacting with the StorageObject builders.
+	StorageObject *StorageObjectClient
 	// Subject is the client for interacting with the Subject builders.
 	Subject *SubjectClient
 	// SubjectRoleRelationship is the client for interacting with the SubjectRoleRelationship builders.
 	SubjectRoleRelationship *SubjectRoleRelationshipClient
 	// Template is the client for interacting with the Template builders.
 	Template *TemplateClient
+	// TemplateUpgradeCampaign is the client for interacting with the TemplateUpgradeCampaign builders.
+	TemplateUpgradeCampaign *TemplateUpgradeCampaignClient
 	// TemplateVersion is the client for interacting with the TemplateVersion builders.
 	TemplateVersion *TemplateVersionClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// Variable is the client for interacting with the Variable builders.
 	Variable *VariableClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 	// Workflow is the client for interacting with the Workflow builders.
 	Workflow *WorkflowClient
 	// WorkflowExecution is the client for interacting with the WorkflowExecution builders.
@@ -132,9 +174,15 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.Budget = NewBudgetClient(c.config)
+	c.BudgetEvent = NewBudgetEventClient(c.config)
 	c.Catalog = NewCatalogClient(c.config)
+	c.ChargebackStatement = NewChargebackStatementClient(c.config)
 	c.Connector = NewConnectorClient(c.config)
+	c.CostAnomaly = NewCostAnomalyClient(c.config)
 	c.CostReport = NewCostReportClient(c.config)
+	c.DataEncryptionKey = NewDataEncryptionKeyClient(c.config)
 	c.DistributeLock = NewDistributeLockClient(c.config)
 	c.Environment = NewEnvironmentClient(c.config)
 	c.EnvironmentConnectorRelationship = NewEnvironmentConnectorRelationshipClient(c.config)
@@ -147,15 +195,23 @@ func (c *Client) init() {
 	c.ResourceDefinitionMatchingRule = NewResourceDefinitionMatchingRuleClient(c.config)
 	c.ResourceRelationship = NewResourceRelationshipClient(c.config)
 	c.ResourceRun = NewResourceRunClient(c.config)
+	c.ResourceRunApprovalRule = NewResourceRunApprovalRuleClient(c.config)
+	c.ResourceRunPolicy = NewResourceRunPolicyClient(c.config)
 	c.ResourceState = NewResourceStateClient(c.config)
+	c.ResourceStateLock = NewResourceStateLockClient(c.config)
+	c.ResourceStateVersion = NewResourceStateVersionClient(c.config)
 	c.Role = NewRoleClient(c.config)
 	c.Setting = NewSettingClient(c.config)
+	c.StorageObject = NewStorageObjectClient(c.config)
 	c.Subject = NewSubjectClient(c.config)
 	c.SubjectRoleRelationship = NewSubjectRoleRelationshipClient(c.config)
 	c.Template = NewTemplateClient(c.config)
+	c.TemplateUpgradeCampaign = NewTemplateUpgradeCampaignClient(c.config)
 	c.TemplateVersion = NewTemplateVersionClient(c.config)
 	c.Token = NewTokenClient(c.config)
 	c.Variable = NewVariableClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 	c.Workflow = NewWorkflowClient(c.config)
 	c.WorkflowExecution = NewWorkflowExecutionClient(c.config)
 	c.WorkflowStage = NewWorkflowStageClient(c.config)
@@ -256,9 +312,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                              ctx,
 		config:                           cfg,
+		AuditLog:                         NewAuditLogClient(cfg),
+		Budget:                           NewBudgetClient(cfg),
+		BudgetEvent:                      NewBudgetEventClient(cfg),
 		Catalog:                          NewCatalogClient(cfg),
+		ChargebackStatement:              NewChargebackStatementClient(cfg),
 		Connector:                        NewConnectorClient(cfg),
+		CostAnomaly:                      NewCostAnomalyClient(cfg),
 		CostReport:                       NewCostReportClient(cfg),
+		DataEncryptionKey:                NewDataEncryptionKeyClient(cfg),
 		DistributeLock:                   NewDistributeLockClient(cfg),
 		Environment:                      NewEnvironmentClient(cfg),
 		EnvironmentConnectorRelationship: NewEnvironmentConnectorRelationshipClient(cfg),
@@ -271,15 +333,23 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ResourceDefinitionMatchingRule:   NewResourceDefinitionMatchingRuleClient(cfg),
 		ResourceRelationship:             NewResourceRelationshipClient(cfg),
 		ResourceRun:                      NewResourceRunClient(cfg),
+		ResourceRunApprovalRule:          NewResourceRunApprovalRuleClient(cfg),
+		ResourceRunPolicy:                NewResourceRunPolicyClient(cfg),
 		ResourceState:                    NewResourceStateClient(cfg),
+		ResourceStateLock:                NewResourceStateLockClient(cfg),
+		ResourceStateVersion:             NewResourceStateVersionClient(cfg),
 		Role:                             NewRoleClient(cfg),
 		Setting:                          NewSettingClient(cfg),
+		StorageObject:                    NewStorageObjectClient(cfg),
 		Subject:                          NewSubjectClient(cfg),
 		SubjectRoleRelationship:          NewSubjectRoleRelationshipClient(cfg),
 		Template:                         NewTemplateClient(cfg),
+		TemplateUpgradeCampaign:          NewTemplateUpgradeCampaignClient(cfg),
 		TemplateVersion:                  NewTemplateVersionClient(cfg),
 		Token:                            NewTokenClient(cfg),
 		Variable:                         NewVariableClient(cfg),
+		Webhook:                          NewWebhookClient(cfg),
+		WebhookDelivery:                  NewWebhookDeliveryClient(cfg),
 		Workflow:                         NewWorkflowClient(cfg),
 		WorkflowExecution:                NewWorkflowExecutionClient(cfg),
 		WorkflowStage:                    NewWorkflowStageClient(cfg),
@@ -305,9 +375,15 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                              ctx,
 		config:                           cfg,
+		AuditLog:                         NewAuditLogClient(cfg),
+		Budget:                           NewBudgetClient(cfg),
+		BudgetEvent:                      NewBudgetEventClient(cfg),
 		Catalog:                          NewCatalogClient(cfg),
+		ChargebackStatement:              NewChargebackStatementClient(cfg),
 		Connector:                        NewConnectorClient(cfg),
+		CostAnomaly:                      NewCostAnomalyClient(cfg),
 		CostReport:                       NewCostReportClient(cfg),
+		DataEncryptionKey:                NewDataEncryptionKeyClient(cfg),
 		DistributeLock:                   NewDistributeLockClient(cfg),
 		Environment:                      NewEnvironmentClient(cfg),
 		EnvironmentConnectorRelationship: NewEnvironmentConnectorRelationshipClient(cfg),
@@ -320,15 +396,23 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ResourceDefinitionMatchingRule:   NewResourceDefinitionMatchingRuleClient(cfg),
 		ResourceRelationship:             NewResourceRelationshipClient(cfg),
 		ResourceRun:                      NewResourceRunClient(cfg),
+		ResourceRunApprovalRule:          NewResourceRunApprovalRuleClient(cfg),
+		ResourceRunPolicy:                NewResourceRunPolicyClient(cfg),
 		ResourceState:                    NewResourceStateClient(cfg),
+		ResourceStateLock:                NewResourceStateLockClient(cfg),
+		ResourceStateVersion:             NewResourceStateVersionClient(cfg),
 		Role:                             NewRoleClient(cfg),
 		Setting:                          NewSettingClient(cfg),
+		StorageObject:                    NewStorageObjectClient(cfg),
 		Subject:                          NewSubjectClient(cfg),
 		SubjectRoleRelationship:          NewSubjectRoleRelationshipClient(cfg),
 		Template:                         NewTemplateClient(cfg),
+		TemplateUpgradeCampaign:          NewTemplateUpgradeCampaignClient(cfg),
 		TemplateVersion:                  NewTemplateVersionClient(cfg),
 		Token:                            NewTokenClient(cfg),
 		Variable:                         NewVariableClient(cfg),
+		Webhook:                          NewWebhookClient(cfg),
+		WebhookDelivery:                  NewWebhookDeliveryClient(cfg),
 		Workflow:                         NewWorkflowClient(cfg),
 		WorkflowExecution:                NewWorkflowExecutionClient(cfg),
 		WorkflowStage:                    NewWorkflowStageClient(cfg),
@@ -341,7 +425,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Catalog.
+//		AuditLog.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -364,14 +448,18 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Catalog, c.Connector, c.CostReport, c.DistributeLock, c.Environment,
-		c.EnvironmentConnectorRelationship, c.Perspective, c.Project, c.Resource,
-		c.ResourceComponent, c.ResourceComponentRelationship, c.ResourceDefinition,
+		c.AuditLog, c.Budget, c.BudgetEvent, c.Catalog, c.ChargebackStatement,
+		c.Connector, c.CostAnomaly, c.CostReport, c.DataEncryptionKey,
+		c.DistributeLock, c.Environment, c.EnvironmentConnectorRelationship,
+		c.Perspective, c.Project, c.Resource, c.ResourceComponent,
+		c.ResourceComponentRelationship, c.ResourceDefinition,
 		c.ResourceDefinitionMatchingRule, c.ResourceRelationship, c.ResourceRun,
-		c.ResourceState, c.Role, c.Setting, c.Subject, c.SubjectRoleRelationship,
-		c.Template, c.TemplateVersion, c.Token, c.Variable, c.Workflow,
-		c.WorkflowExecution, c.WorkflowStage, c.WorkflowStageExecution, c.WorkflowStep,
-		c.WorkflowStepExecution,
+		c.ResourceRunApprovalRule, c.ResourceRunPolicy, c.ResourceState,
+		c.ResourceStateLock, c.ResourceStateVersion, c.Role, c.Setting,
+		c.StorageObject, c.Subject, c.SubjectRoleRelationship, c.Template,
+		c.TemplateUpgradeCampaign, c.TemplateVersion, c.Token, c.Variable, c.Webhook,
+		c.WebhookDelivery, c.Workflow, c.WorkflowExecution, c.WorkflowStage,
+		c.WorkflowStageExecution, c.WorkflowStep, c.WorkflowStepExecution,
 	} {
 		n.Use(hooks...)
 	}
@@ -381,34 +469,68 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Catalog, c.Connector, c.CostReport, c.DistributeLock, c.Environment,
-		c.EnvironmentConnectorRelationship, c.Perspective, c.Project, c.Resource,
-		c.ResourceComponent, c.ResourceComponentRelationship, c.ResourceDefinition,
+		c.AuditLog, c.Budget, c.BudgetEvent, c.Catalog, c.ChargebackStatement,
+		c.Connector, c.CostAnomaly, c.CostReport, c.DataEncryptionKey,
+		c.DistributeLock, c.Environment, c.EnvironmentConnectorRelationship,
+		c.Perspective, c.Project, c.Resource, c.ResourceComponent,
+		c.ResourceComponentRelationship, c.ResourceDefinition,
 		c.ResourceDefinitionMatchingRule, c.ResourceRelationship, c.ResourceRun,
-		c.ResourceState, c.Role, c.Setting, c.Subject, c.SubjectRoleRelationship,
-		c.Template, c.TemplateVersion, c.Token, c.Variable, c.Workflow,
-		c.WorkflowExecution, c.WorkflowStage, c.WorkflowStageExecution, c.WorkflowStep,
-		c.WorkflowStepExecution,
+		c.ResourceRunApprovalRule, c.ResourceRunPolicy, c.ResourceState,
+		c.ResourceStateLock, c.ResourceStateVersion, c.Role, c.Setting,
+		c.StorageObject, c.Subject, c.SubjectRoleRelationship, c.Template,
+		c.TemplateUpgradeCampaign, c.TemplateVersion, c.Token, c.Variable, c.Webhook,
+		c.WebhookDelivery, c.Workflow, c.WorkflowExecution, c.WorkflowStage,
+		c.WorkflowStageExecution, c.WorkflowStep, c.WorkflowStepExecution,
 	} {
 		n.Intercept(interceptors...)
 	}
 }
 
+// AuditLogs implements the ClientSet.
+func (c *Client) AuditLogs() *AuditLogClient {
+	return c.AuditLog
+}
+
+// Budgets implements the ClientSet.
+func (c *Client) Budgets() *BudgetClient {
+	return c.Budget
+}
+
+// BudgetEvents implements the ClientSet.
+func (c *Client) BudgetEvents() *BudgetEventClient {
+	return c.BudgetEvent
+}
+
 // Catalogs implements the ClientSet.
 func (c *Client) Catalogs() *CatalogClient {
 	return c.Catalog
 }
 
+// ChargebackStatements implements the ClientSet.
+func (c *Client) ChargebackStatements() *ChargebackStatementClient {
+	return c.ChargebackStatement
+}
+
 // Connectors implements the ClientSet.
 func (c *Client) Connectors() *ConnectorClient {
 	return c.Connector
 }
 
+// CostAnomalies implements the ClientSet.
+func (c *Client) CostAnomalies() *CostAnomalyClient {
+	return c.CostAnomaly
+}
+
 // CostReports implements the ClientSet.
 func (c *Client) CostReports() *CostReportClient {
 	return c.CostReport
 }
 
+// DataEncryptionKeys implements the ClientSet.
+func (c *Client) DataEncryptionKeys() *DataEncryptionKeyClient {
+	return c.DataEncryptionKey
+}
+
 // DistributeLocks implements the ClientSet.
 func (c *Client) DistributeLocks() *DistributeLockClient {
 	return c.DistributeLock
@@ -469,11 +591,31 @@ func (c *Client) ResourceRuns() *ResourceRunClient {
 	return c.ResourceRun
 }
 
+// ResourceRunApprovalRules implements the ClientSet.
+func (c *Client) ResourceRunApprovalRules() *ResourceRunApprovalRuleClient {
+	return c.ResourceRunApprovalRule
+}
+
+// ResourceRunPolicies implements the ClientSet.
+func (c *Client) ResourceRunPolicies() *ResourceRunPolicyClient {
+	return c.ResourceRunPolicy
+}
+
 // ResourceStates implements the ClientSet.
 func (c *Client) ResourceStates() *ResourceStateClient {
 	return c.ResourceState
 }
 
+// ResourceStateLocks implements the ClientSet.
+func (c *Client) ResourceStateLocks() *ResourceStateLockClient {
+	return c.ResourceStateLock
+}
+
+// ResourceStateVersions implements the ClientSet.
+func (c *Client) ResourceStateVersions() *ResourceStateVersionClient {
+	return c.ResourceStateVersion
+}
+
 // Roles implements the ClientSet.
 func (c *Client) Roles() *RoleClient {
 	return c.Role
@@ -484,6 +626,11 @@ func (c *Client) Settings() *SettingClient {
 	return c.Setting
 }
 
+// StorageObjects implements the ClientSet.
+func (c *Client) StorageObjects() *StorageObjectClient {
+	return c.StorageObject
+}
+
 // Subjects implements the ClientSet.
 func (c *Client) Subjects() *SubjectClient {
 	return c.Subject
@@ -499,6 +646,11 @@ func (c *Client) Templates() *TemplateClient {
 	return c.Template
 }
 
+// TemplateUpgradeCampaigns implements the ClientSet.
+func (c *Client) TemplateUpgradeCampaigns() *TemplateUpgradeCampaignClient {
+	return c.TemplateUpgradeCampaign
+}
+
 // TemplateVersions implements the ClientSet.
 func (c *Client) TemplateVersions() *TemplateVersionClient {
 	return c.TemplateVersion
@@ -514,6 +666,16 @@ func (c *Client) Variables() *VariableClient {
 	return c.Variable
 }
 
+// Webhooks implements the ClientSet.
+func (c *Client) Webhooks() *WebhookClient {
+	return c.Webhook
+}
+
+// WebhookDeliveries implements the ClientSet.
+func (c *Client) WebhookDeliveries() *WebhookDeliveryClient {
+	return c.WebhookDelivery
+}
+
 // Workflows implements the ClientSet.
 func (c *Client) Workflows() *WorkflowClient {
 	return c.Workflow
@@ -590,12 +752,24 @@ func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error) (err error)
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
+	case *BudgetMutation:
+		return c.Budget.mutate(ctx, m)
+	case *BudgetEventMutation:
+		return c.BudgetEvent.mutate(ctx, m)
 	case *CatalogMutation:
 		return c.Catalog.mutate(ctx, m)
+	case *ChargebackStatementMutation:
+		return c.ChargebackStatement.mutate(ctx, m)
 	case *ConnectorMutation:
 		return c.Connector.mutate(ctx, m)
+	case *CostAnomalyMutation:
+		return c.CostAnomaly.mutate(ctx, m)
 	case *CostReportMutation:
 		return c.CostReport.mutate(ctx, m)
+	case *DataEncryptionKeyMutation:
+		return c.DataEncryptionKey.mutate(ctx, m)
 	case *DistributeLockMutation:
 		return c.DistributeLock.mutate(ctx, m)
 	case *EnvironmentMutation:
@@ -620,24 +794,40 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ResourceRelationship.mutate(ctx, m)
 	case *ResourceRunMutation:
 		return c.ResourceRun.mutate(ctx, m)
+	case *ResourceRunApprovalRuleMutation:
+		return c.ResourceRunApprovalRule.mutate(ctx, m)
+	case *ResourceRunPolicyMutation:
+		return c.ResourceRunPolicy.mutate(ctx, m)
 	case *ResourceStateMutation:
 		return c.ResourceState.mutate(ctx, m)
+	case *ResourceStateLockMutation:
+		return c.ResourceStateLock.mutate(ctx, m)
+	case *ResourceStateVersionMutation:
+		return c.ResourceStateVersion.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
+	case *StorageObjectMutation:
+		return c.StorageObject.mutate(ctx, m)
 	case *SubjectMutation:
 		return c.Subject.mutate(ctx, m)
 	case *SubjectRoleRelationshipMutation:
 		return c.SubjectRoleRelationship.mutate(ctx, m)
 	case *TemplateMutation:
 		return c.Template.mutate(ctx, m)
+	case *TemplateUpgradeCampaignMutation:
+		return c.TemplateUpgradeCampaign.mutate(ctx, m)
 	case *TemplateVersionMutation:
 		return c.TemplateVersion.mutate(ctx, m)
 	case *TokenMutation:
 		return c.Token.mutate(ctx, m)
 	case *VariableMutation:
 		return c.Variable.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	case *WorkflowMutation:
 		return c.Workflow.mutate(ctx, m)
 	case *WorkflowExecutionMutation:
@@ -655,107 +845,107 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
-// CatalogClient is a client for the Catalog schema.
-type CatalogClient struct {
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
 	config
 }
 
-// NewCatalogClient returns a client for the Catalog from the given config.
-func NewCatalogClient(c config) *CatalogClient {
-	return &CatalogClient{config: c}
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `catalog.Hooks(f(g(h())))`.
-func (c *CatalogClient) Use(hooks ...Hook) {
-	c.hooks.Catalog = append(c.hooks.Catalog, hooks...)
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `catalog.Intercept(f(g(h())))`.
-func (c *CatalogClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Catalog = append(c.inters.Catalog, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
 }
 
-// Create returns a builder for creating a Catalog entity.
-func (c *CatalogClient) Create() *CatalogCreate {
-	mutation := newCatalogMutation(c.config, OpCreate)
-	return &CatalogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Catalog entities.
-func (c *CatalogClient) CreateBulk(builders ...*CatalogCreate) *CatalogCreateBulk {
-	return &CatalogCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CatalogClient) MapCreateBulk(slice any, setFunc func(*CatalogCreate, int)) *CatalogCreateBulk {
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CatalogCreateBulk{err: fmt.Errorf("calling to CatalogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CatalogCreate, rv.Len())
+	builders := make([]*AuditLogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CatalogCreateBulk{config: c.config, builders: builders}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Catalog.
-func (c *CatalogClient) Update() *CatalogUpdate {
-	mutation := newCatalogMutation(c.config, OpUpdate)
-	return &CatalogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CatalogClient) UpdateOne(ca *Catalog) *CatalogUpdateOne {
-	mutation := newCatalogMutation(c.config, OpUpdateOne, withCatalog(ca))
-	return &CatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CatalogClient) UpdateOneID(id object.ID) *CatalogUpdateOne {
-	mutation := newCatalogMutation(c.config, OpUpdateOne, withCatalogID(id))
-	return &CatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditLogClient) UpdateOneID(id object.ID) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Catalog.
-func (c *CatalogClient) Delete() *CatalogDelete {
-	mutation := newCatalogMutation(c.config, OpDelete)
-	return &CatalogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CatalogClient) DeleteOne(ca *Catalog) *CatalogDeleteOne {
-	return c.DeleteOneID(ca.ID)
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CatalogClient) DeleteOneID(id object.ID) *CatalogDeleteOne {
-	builder := c.Delete().Where(catalog.ID(id))
+func (c *AuditLogClient) DeleteOneID(id object.ID) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CatalogDeleteOne{builder}
+	return &AuditLogDeleteOne{builder}
 }
 
-// Query returns a query builder for Catalog.
-func (c *CatalogClient) Query() *CatalogQuery {
-	return &CatalogQuery{
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCatalog},
+		ctx:    &QueryContext{Type: TypeAuditLog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Catalog entity by its id.
-func (c *CatalogClient) Get(ctx context.Context, id object.ID) (*Catalog, error) {
-	return c.Query().Where(catalog.ID(id)).Only(ctx)
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id object.ID) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CatalogClient) GetX(ctx context.Context, id object.ID) *Catalog {
+func (c *AuditLogClient) GetX(ctx context.Context, id object.ID) *AuditLog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -763,172 +953,133 @@ func (c *CatalogClient) GetX(ctx context.Context, id object.ID) *Catalog {
 	return obj
 }
 
-// QueryTemplates queries the templates edge of a Catalog.
-func (c *CatalogClient) QueryTemplates(ca *Catalog) *TemplateQuery {
-	query := (&TemplateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ca.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(catalog.Table, catalog.FieldID, id),
-			sqlgraph.To(template.Table, template.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, catalog.TemplatesTable, catalog.TemplatesColumn),
-		)
-		schemaConfig := ca.schemaConfig
-		step.To.Schema = schemaConfig.Template
-		step.Edge.Schema = schemaConfig.Template
-		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryProject queries the project edge of a Catalog.
-func (c *CatalogClient) QueryProject(ca *Catalog) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ca.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(catalog.Table, catalog.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, catalog.ProjectTable, catalog.ProjectColumn),
-		)
-		schemaConfig := ca.schemaConfig
-		step.To.Schema = schemaConfig.Project
-		step.Edge.Schema = schemaConfig.Catalog
-		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *CatalogClient) Hooks() []Hook {
-	hooks := c.hooks.Catalog
-	return append(hooks[:len(hooks):len(hooks)], catalog.Hooks[:]...)
+func (c *AuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *CatalogClient) Interceptors() []Interceptor {
-	inters := c.inters.Catalog
-	return append(inters[:len(inters):len(inters)], catalog.Interceptors[:]...)
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
 }
 
-func (c *CatalogClient) mutate(ctx context.Context, m *CatalogMutation) (Value, error) {
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CatalogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CatalogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CatalogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown Catalog mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown AuditLog mutation op: %q", m.Op())
 	}
 }
 
-// ConnectorClient is a client for the Connector schema.
-type ConnectorClient struct {
+// BudgetClient is a client for the Budget schema.
+type BudgetClient struct {
 	config
 }
 
-// NewConnectorClient returns a client for the Connector from the given config.
-func NewConnectorClient(c config) *ConnectorClient {
-	return &ConnectorClient{config: c}
+// NewBudgetClient returns a client for the Budget from the given config.
+func NewBudgetClient(c config) *BudgetClient {
+	return &BudgetClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `connector.Hooks(f(g(h())))`.
-func (c *ConnectorClient) Use(hooks ...Hook) {
-	c.hooks.Connector = append(c.hooks.Connector, hooks...)
+// A call to `Use(f, g, h)` equals to `budget.Hooks(f(g(h())))`.
+func (c *BudgetClient) Use(hooks ...Hook) {
+	c.hooks.Budget = append(c.hooks.Budget, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `connector.Intercept(f(g(h())))`.
-func (c *ConnectorClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Connector = append(c.inters.Connector, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `budget.Intercept(f(g(h())))`.
+func (c *BudgetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Budget = append(c.inters.Budget, interceptors...)
 }
 
-// Create returns a builder for creating a Connector entity.
-func (c *ConnectorClient) Create() *ConnectorCreate {
-	mutation := newConnectorMutation(c.config, OpCreate)
-	return &ConnectorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Budget entity.
+func (c *BudgetClient) Create() *BudgetCreate {
+	mutation := newBudgetMutation(c.config, OpCreate)
+	return &BudgetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Connector entities.
-func (c *ConnectorClient) CreateBulk(builders ...*ConnectorCreate) *ConnectorCreateBulk {
-	return &ConnectorCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Budget entities.
+func (c *BudgetClient) CreateBulk(builders ...*BudgetCreate) *BudgetCreateBulk {
+	return &BudgetCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ConnectorClient) MapCreateBulk(slice any, setFunc func(*ConnectorCreate, int)) *ConnectorCreateBulk {
+func (c *BudgetClient) MapCreateBulk(slice any, setFunc func(*BudgetCreate, int)) *BudgetCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ConnectorCreateBulk{err: fmt.Errorf("calling to ConnectorClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BudgetCreateBulk{err: fmt.Errorf("calling to BudgetClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ConnectorCreate, rv.Len())
+	builders := make([]*BudgetCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ConnectorCreateBulk{config: c.config, builders: builders}
+	return &BudgetCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Connector.
-func (c *ConnectorClient) Update() *ConnectorUpdate {
-	mutation := newConnectorMutation(c.config, OpUpdate)
-	return &ConnectorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Budget.
+func (c *BudgetClient) Update() *BudgetUpdate {
+	mutation := newBudgetMutation(c.config, OpUpdate)
+	return &BudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ConnectorClient) UpdateOne(co *Connector) *ConnectorUpdateOne {
-	mutation := newConnectorMutation(c.config, OpUpdateOne, withConnector(co))
-	return &ConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetClient) UpdateOne(b *Budget) *BudgetUpdateOne {
+	mutation := newBudgetMutation(c.config, OpUpdateOne, withBudget(b))
+	return &BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ConnectorClient) UpdateOneID(id object.ID) *ConnectorUpdateOne {
-	mutation := newConnectorMutation(c.config, OpUpdateOne, withConnectorID(id))
-	return &ConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetClient) UpdateOneID(id object.ID) *BudgetUpdateOne {
+	mutation := newBudgetMutation(c.config, OpUpdateOne, withBudgetID(id))
+	return &BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Connector.
-func (c *ConnectorClient) Delete() *ConnectorDelete {
-	mutation := newConnectorMutation(c.config, OpDelete)
-	return &ConnectorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Budget.
+func (c *BudgetClient) Delete() *BudgetDelete {
+	mutation := newBudgetMutation(c.config, OpDelete)
+	return &BudgetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ConnectorClient) DeleteOne(co *Connector) *ConnectorDeleteOne {
-	return c.DeleteOneID(co.ID)
+func (c *BudgetClient) DeleteOne(b *Budget) *BudgetDeleteOne {
+	return c.DeleteOneID(b.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ConnectorClient) DeleteOneID(id object.ID) *ConnectorDeleteOne {
-	builder := c.Delete().Where(connector.ID(id))
+func (c *BudgetClient) DeleteOneID(id object.ID) *BudgetDeleteOne {
+	builder := c.Delete().Where(budget.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ConnectorDeleteOne{builder}
+	return &BudgetDeleteOne{builder}
 }
 
-// Query returns a query builder for Connector.
-func (c *ConnectorClient) Query() *ConnectorQuery {
-	return &ConnectorQuery{
+// Query returns a query builder for Budget.
+func (c *BudgetClient) Query() *BudgetQuery {
+	return &BudgetQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeConnector},
+		ctx:    &QueryContext{Type: TypeBudget},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Connector entity by its id.
-func (c *ConnectorClient) Get(ctx context.Context, id object.ID) (*Connector, error) {
-	return c.Query().Where(connector.ID(id)).Only(ctx)
+// Get returns a Budget entity by its id.
+func (c *BudgetClient) Get(ctx context.Context, id object.ID) (*Budget, error) {
+	return c.Query().Where(budget.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ConnectorClient) GetX(ctx context.Context, id object.ID) *Connector {
+func (c *BudgetClient) GetX(ctx context.Context, id object.ID) *Budget {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -936,210 +1087,191 @@ func (c *ConnectorClient) GetX(ctx context.Context, id object.ID) *Connector {
 	return obj
 }
 
-// QueryProject queries the project edge of a Connector.
-func (c *ConnectorClient) QueryProject(co *Connector) *ProjectQuery {
+// QueryProject queries the project edge of a Budget.
+func (c *BudgetClient) QueryProject(b *Budget) *ProjectQuery {
 	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
+		id := b.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(connector.Table, connector.FieldID, id),
+			sqlgraph.From(budget.Table, budget.FieldID, id),
 			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, connector.ProjectTable, connector.ProjectColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, budget.ProjectTable, budget.ProjectColumn),
 		)
-		schemaConfig := co.schemaConfig
+		schemaConfig := b.schemaConfig
 		step.To.Schema = schemaConfig.Project
-		step.Edge.Schema = schemaConfig.Connector
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEnvironments queries the environments edge of a Connector.
-func (c *ConnectorClient) QueryEnvironments(co *Connector) *EnvironmentConnectorRelationshipQuery {
-	query := (&EnvironmentConnectorRelationshipClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(connector.Table, connector.FieldID, id),
-			sqlgraph.To(environmentconnectorrelationship.Table, environmentconnectorrelationship.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, connector.EnvironmentsTable, connector.EnvironmentsColumn),
-		)
-		schemaConfig := co.schemaConfig
-		step.To.Schema = schemaConfig.EnvironmentConnectorRelationship
-		step.Edge.Schema = schemaConfig.EnvironmentConnectorRelationship
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		step.Edge.Schema = schemaConfig.Budget
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryResourceComponents queries the resource_components edge of a Connector.
-func (c *ConnectorClient) QueryResourceComponents(co *Connector) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
+// QueryEnvironment queries the environment edge of a Budget.
+func (c *BudgetClient) QueryEnvironment(b *Budget) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
+		id := b.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(connector.Table, connector.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, connector.ResourceComponentsTable, connector.ResourceComponentsColumn),
+			sqlgraph.From(budget.Table, budget.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budget.EnvironmentTable, budget.EnvironmentColumn),
 		)
-		schemaConfig := co.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		schemaConfig := b.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.Budget
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryCostReports queries the cost_reports edge of a Connector.
-func (c *ConnectorClient) QueryCostReports(co *Connector) *CostReportQuery {
-	query := (&CostReportClient{config: c.config}).Query()
+// QueryEvents queries the events edge of a Budget.
+func (c *BudgetClient) QueryEvents(b *Budget) *BudgetEventQuery {
+	query := (&BudgetEventClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := co.ID
+		id := b.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(connector.Table, connector.FieldID, id),
-			sqlgraph.To(costreport.Table, costreport.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, connector.CostReportsTable, connector.CostReportsColumn),
+			sqlgraph.From(budget.Table, budget.FieldID, id),
+			sqlgraph.To(budgetevent.Table, budgetevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, budget.EventsTable, budget.EventsColumn),
 		)
-		schemaConfig := co.schemaConfig
-		step.To.Schema = schemaConfig.CostReport
-		step.Edge.Schema = schemaConfig.CostReport
-		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		schemaConfig := b.schemaConfig
+		step.To.Schema = schemaConfig.BudgetEvent
+		step.Edge.Schema = schemaConfig.BudgetEvent
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ConnectorClient) Hooks() []Hook {
-	hooks := c.hooks.Connector
-	return append(hooks[:len(hooks):len(hooks)], connector.Hooks[:]...)
+func (c *BudgetClient) Hooks() []Hook {
+	hooks := c.hooks.Budget
+	return append(hooks[:len(hooks):len(hooks)], budget.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ConnectorClient) Interceptors() []Interceptor {
-	inters := c.inters.Connector
-	return append(inters[:len(inters):len(inters)], connector.Interceptors[:]...)
+func (c *BudgetClient) Interceptors() []Interceptor {
+	inters := c.inters.Budget
+	return append(inters[:len(inters):len(inters)], budget.Interceptors[:]...)
 }
 
-func (c *ConnectorClient) mutate(ctx context.Context, m *ConnectorMutation) (Value, error) {
+func (c *BudgetClient) mutate(ctx context.Context, m *BudgetMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ConnectorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ConnectorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ConnectorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BudgetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown Connector mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown Budget mutation op: %q", m.Op())
 	}
 }
 
-// CostReportClient is a client for the CostReport schema.
-type CostReportClient struct {
+// BudgetEventClient is a client for the BudgetEvent schema.
+type BudgetEventClient struct {
 	config
 }
 
-// NewCostReportClient returns a client for the CostReport from the given config.
-func NewCostReportClient(c config) *CostReportClient {
-	return &CostReportClient{config: c}
+// NewBudgetEventClient returns a client for the BudgetEvent from the given config.
+func NewBudgetEventClient(c config) *BudgetEventClient {
+	return &BudgetEventClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `costreport.Hooks(f(g(h())))`.
-func (c *CostReportClient) Use(hooks ...Hook) {
-	c.hooks.CostReport = append(c.hooks.CostReport, hooks...)
+// A call to `Use(f, g, h)` equals to `budgetevent.Hooks(f(g(h())))`.
+func (c *BudgetEventClient) Use(hooks ...Hook) {
+	c.hooks.BudgetEvent = append(c.hooks.BudgetEvent, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `costreport.Intercept(f(g(h())))`.
-func (c *CostReportClient) Intercept(interceptors ...Interceptor) {
-	c.inters.CostReport = append(c.inters.CostReport, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `budgetevent.Intercept(f(g(h())))`.
+func (c *BudgetEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BudgetEvent = append(c.inters.BudgetEvent, interceptors...)
 }
 
-// Create returns a builder for creating a CostReport entity.
-func (c *CostReportClient) Create() *CostReportCreate {
-	mutation := newCostReportMutation(c.config, OpCreate)
-	return &CostReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BudgetEvent entity.
+func (c *BudgetEventClient) Create() *BudgetEventCreate {
+	mutation := newBudgetEventMutation(c.config, OpCreate)
+	return &BudgetEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of CostReport entities.
-func (c *CostReportClient) CreateBulk(builders ...*CostReportCreate) *CostReportCreateBulk {
-	return &CostReportCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BudgetEvent entities.
+func (c *BudgetEventClient) CreateBulk(builders ...*BudgetEventCreate) *BudgetEventCreateBulk {
+	return &BudgetEventCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *CostReportClient) MapCreateBulk(slice any, setFunc func(*CostReportCreate, int)) *CostReportCreateBulk {
+func (c *BudgetEventClient) MapCreateBulk(slice any, setFunc func(*BudgetEventCreate, int)) *BudgetEventCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &CostReportCreateBulk{err: fmt.Errorf("calling to CostReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BudgetEventCreateBulk{err: fmt.Errorf("calling to BudgetEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*CostReportCreate, rv.Len())
+	builders := make([]*BudgetEventCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &CostReportCreateBulk{config: c.config, builders: builders}
+	return &BudgetEventCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for CostReport.
-func (c *CostReportClient) Update() *CostReportUpdate {
-	mutation := newCostReportMutation(c.config, OpUpdate)
-	return &CostReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BudgetEvent.
+func (c *BudgetEventClient) Update() *BudgetEventUpdate {
+	mutation := newBudgetEventMutation(c.config, OpUpdate)
+	return &BudgetEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *CostReportClient) UpdateOne(cr *CostReport) *CostReportUpdateOne {
-	mutation := newCostReportMutation(c.config, OpUpdateOne, withCostReport(cr))
-	return &CostReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetEventClient) UpdateOne(be *BudgetEvent) *BudgetEventUpdateOne {
+	mutation := newBudgetEventMutation(c.config, OpUpdateOne, withBudgetEvent(be))
+	return &BudgetEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *CostReportClient) UpdateOneID(id int) *CostReportUpdateOne {
-	mutation := newCostReportMutation(c.config, OpUpdateOne, withCostReportID(id))
-	return &CostReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BudgetEventClient) UpdateOneID(id object.ID) *BudgetEventUpdateOne {
+	mutation := newBudgetEventMutation(c.config, OpUpdateOne, withBudgetEventID(id))
+	return &BudgetEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for CostReport.
-func (c *CostReportClient) Delete() *CostReportDelete {
-	mutation := newCostReportMutation(c.config, OpDelete)
-	return &CostReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BudgetEvent.
+func (c *BudgetEventClient) Delete() *BudgetEventDelete {
+	mutation := newBudgetEventMutation(c.config, OpDelete)
+	return &BudgetEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *CostReportClient) DeleteOne(cr *CostReport) *CostReportDeleteOne {
-	return c.DeleteOneID(cr.ID)
+func (c *BudgetEventClient) DeleteOne(be *BudgetEvent) *BudgetEventDeleteOne {
+	return c.DeleteOneID(be.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *CostReportClient) DeleteOneID(id int) *CostReportDeleteOne {
-	builder := c.Delete().Where(costreport.ID(id))
+func (c *BudgetEventClient) DeleteOneID(id object.ID) *BudgetEventDeleteOne {
+	builder := c.Delete().Where(budgetevent.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &CostReportDeleteOne{builder}
+	return &BudgetEventDeleteOne{builder}
 }
 
-// Query returns a query builder for CostReport.
-func (c *CostReportClient) Query() *CostReportQuery {
-	return &CostReportQuery{
+// Query returns a query builder for BudgetEvent.
+func (c *BudgetEventClient) Query() *BudgetEventQuery {
+	return &BudgetEventQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeCostReport},
+		ctx:    &QueryContext{Type: TypeBudgetEvent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a CostReport entity by its id.
-func (c *CostReportClient) Get(ctx context.Context, id int) (*CostReport, error) {
-	return c.Query().Where(costreport.ID(id)).Only(ctx)
+// Get returns a BudgetEvent entity by its id.
+func (c *BudgetEventClient) Get(ctx context.Context, id object.ID) (*BudgetEvent, error) {
+	return c.Query().Where(budgetevent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *CostReportClient) GetX(ctx context.Context, id int) *CostReport {
+func (c *BudgetEventClient) GetX(ctx context.Context, id object.ID) *BudgetEvent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1147,152 +1279,153 @@ func (c *CostReportClient) GetX(ctx context.Context, id int) *CostReport {
 	return obj
 }
 
-// QueryConnector queries the connector edge of a CostReport.
-func (c *CostReportClient) QueryConnector(cr *CostReport) *ConnectorQuery {
-	query := (&ConnectorClient{config: c.config}).Query()
+// QueryBudget queries the budget edge of a BudgetEvent.
+func (c *BudgetEventClient) QueryBudget(be *BudgetEvent) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := cr.ID
+		id := be.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(costreport.Table, costreport.FieldID, id),
-			sqlgraph.To(connector.Table, connector.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, costreport.ConnectorTable, costreport.ConnectorColumn),
+			sqlgraph.From(budgetevent.Table, budgetevent.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budgetevent.BudgetTable, budgetevent.BudgetColumn),
 		)
-		schemaConfig := cr.schemaConfig
-		step.To.Schema = schemaConfig.Connector
-		step.Edge.Schema = schemaConfig.CostReport
-		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		schemaConfig := be.schemaConfig
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.BudgetEvent
+		fromV = sqlgraph.Neighbors(be.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *CostReportClient) Hooks() []Hook {
-	hooks := c.hooks.CostReport
-	return append(hooks[:len(hooks):len(hooks)], costreport.Hooks[:]...)
+func (c *BudgetEventClient) Hooks() []Hook {
+	hooks := c.hooks.BudgetEvent
+	return append(hooks[:len(hooks):len(hooks)], budgetevent.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *CostReportClient) Interceptors() []Interceptor {
-	return c.inters.CostReport
+func (c *BudgetEventClient) Interceptors() []Interceptor {
+	inters := c.inters.BudgetEvent
+	return append(inters[:len(inters):len(inters)], budgetevent.Interceptors[:]...)
 }
 
-func (c *CostReportClient) mutate(ctx context.Context, m *CostReportMutation) (Value, error) {
+func (c *BudgetEventClient) mutate(ctx context.Context, m *BudgetEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&CostReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&CostReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&CostReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BudgetEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&CostReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BudgetEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown CostReport mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown BudgetEvent mutation op: %q", m.Op())
 	}
 }
 
-// DistributeLockClient is a client for the DistributeLock schema.
-type DistributeLockClient struct {
+// CatalogClient is a client for the Catalog schema.
+type CatalogClient struct {
 	config
 }
 
-// NewDistributeLockClient returns a client for the DistributeLock from the given config.
-func NewDistributeLockClient(c config) *DistributeLockClient {
-	return &DistributeLockClient{config: c}
+// NewCatalogClient returns a client for the Catalog from the given config.
+func NewCatalogClient(c config) *CatalogClient {
+	return &CatalogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `distributelock.Hooks(f(g(h())))`.
-func (c *DistributeLockClient) Use(hooks ...Hook) {
-	c.hooks.DistributeLock = append(c.hooks.DistributeLock, hooks...)
+// A call to `Use(f, g, h)` equals to `catalog.Hooks(f(g(h())))`.
+func (c *CatalogClient) Use(hooks ...Hook) {
+	c.hooks.Catalog = append(c.hooks.Catalog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `distributelock.Intercept(f(g(h())))`.
-func (c *DistributeLockClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DistributeLock = append(c.inters.DistributeLock, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `catalog.Intercept(f(g(h())))`.
+func (c *CatalogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Catalog = append(c.inters.Catalog, interceptors...)
 }
 
-// Create returns a builder for creating a DistributeLock entity.
-func (c *DistributeLockClient) Create() *DistributeLockCreate {
-	mutation := newDistributeLockMutation(c.config, OpCreate)
-	return &DistributeLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Catalog entity.
+func (c *CatalogClient) Create() *CatalogCreate {
+	mutation := newCatalogMutation(c.config, OpCreate)
+	return &CatalogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DistributeLock entities.
-func (c *DistributeLockClient) CreateBulk(builders ...*DistributeLockCreate) *DistributeLockCreateBulk {
-	return &DistributeLockCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Catalog entities.
+func (c *CatalogClient) CreateBulk(builders ...*CatalogCreate) *CatalogCreateBulk {
+	return &CatalogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DistributeLockClient) MapCreateBulk(slice any, setFunc func(*DistributeLockCreate, int)) *DistributeLockCreateBulk {
+func (c *CatalogClient) MapCreateBulk(slice any, setFunc func(*CatalogCreate, int)) *CatalogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DistributeLockCreateBulk{err: fmt.Errorf("calling to DistributeLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CatalogCreateBulk{err: fmt.Errorf("calling to CatalogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DistributeLockCreate, rv.Len())
+	builders := make([]*CatalogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DistributeLockCreateBulk{config: c.config, builders: builders}
+	return &CatalogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DistributeLock.
-func (c *DistributeLockClient) Update() *DistributeLockUpdate {
-	mutation := newDistributeLockMutation(c.config, OpUpdate)
-	return &DistributeLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Catalog.
+func (c *CatalogClient) Update() *CatalogUpdate {
+	mutation := newCatalogMutation(c.config, OpUpdate)
+	return &CatalogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DistributeLockClient) UpdateOne(dl *DistributeLock) *DistributeLockUpdateOne {
-	mutation := newDistributeLockMutation(c.config, OpUpdateOne, withDistributeLock(dl))
-	return &DistributeLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CatalogClient) UpdateOne(ca *Catalog) *CatalogUpdateOne {
+	mutation := newCatalogMutation(c.config, OpUpdateOne, withCatalog(ca))
+	return &CatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DistributeLockClient) UpdateOneID(id string) *DistributeLockUpdateOne {
-	mutation := newDistributeLockMutation(c.config, OpUpdateOne, withDistributeLockID(id))
-	return &DistributeLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CatalogClient) UpdateOneID(id object.ID) *CatalogUpdateOne {
+	mutation := newCatalogMutation(c.config, OpUpdateOne, withCatalogID(id))
+	return &CatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DistributeLock.
-func (c *DistributeLockClient) Delete() *DistributeLockDelete {
-	mutation := newDistributeLockMutation(c.config, OpDelete)
-	return &DistributeLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Catalog.
+func (c *CatalogClient) Delete() *CatalogDelete {
+	mutation := newCatalogMutation(c.config, OpDelete)
+	return &CatalogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DistributeLockClient) DeleteOne(dl *DistributeLock) *DistributeLockDeleteOne {
-	return c.DeleteOneID(dl.ID)
+func (c *CatalogClient) DeleteOne(ca *Catalog) *CatalogDeleteOne {
+	return c.DeleteOneID(ca.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DistributeLockClient) DeleteOneID(id string) *DistributeLockDeleteOne {
-	builder := c.Delete().Where(distributelock.ID(id))
+func (c *CatalogClient) DeleteOneID(id object.ID) *CatalogDeleteOne {
+	builder := c.Delete().Where(catalog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DistributeLockDeleteOne{builder}
+	return &CatalogDeleteOne{builder}
 }
 
-// Query returns a query builder for DistributeLock.
-func (c *DistributeLockClient) Query() *DistributeLockQuery {
-	return &DistributeLockQuery{
+// Query returns a query builder for Catalog.
+func (c *CatalogClient) Query() *CatalogQuery {
+	return &CatalogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDistributeLock},
+		ctx:    &QueryContext{Type: TypeCatalog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DistributeLock entity by its id.
-func (c *DistributeLockClient) Get(ctx context.Context, id string) (*DistributeLock, error) {
-	return c.Query().Where(distributelock.ID(id)).Only(ctx)
+// Get returns a Catalog entity by its id.
+func (c *CatalogClient) Get(ctx context.Context, id object.ID) (*Catalog, error) {
+	return c.Query().Where(catalog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DistributeLockClient) GetX(ctx context.Context, id string) *DistributeLock {
+func (c *CatalogClient) GetX(ctx context.Context, id object.ID) *Catalog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1300,132 +1433,172 @@ func (c *DistributeLockClient) GetX(ctx context.Context, id string) *DistributeL
 	return obj
 }
 
+// QueryTemplates queries the templates edge of a Catalog.
+func (c *CatalogClient) QueryTemplates(ca *Catalog) *TemplateQuery {
+	query := (&TemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(catalog.Table, catalog.FieldID, id),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, catalog.TemplatesTable, catalog.TemplatesColumn),
+		)
+		schemaConfig := ca.schemaConfig
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProject queries the project edge of a Catalog.
+func (c *CatalogClient) QueryProject(ca *Catalog) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(catalog.Table, catalog.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, catalog.ProjectTable, catalog.ProjectColumn),
+		)
+		schemaConfig := ca.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.Catalog
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *DistributeLockClient) Hooks() []Hook {
-	return c.hooks.DistributeLock
+func (c *CatalogClient) Hooks() []Hook {
+	hooks := c.hooks.Catalog
+	return append(hooks[:len(hooks):len(hooks)], catalog.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *DistributeLockClient) Interceptors() []Interceptor {
-	return c.inters.DistributeLock
+func (c *CatalogClient) Interceptors() []Interceptor {
+	inters := c.inters.Catalog
+	return append(inters[:len(inters):len(inters)], catalog.Interceptors[:]...)
 }
 
-func (c *DistributeLockClient) mutate(ctx context.Context, m *DistributeLockMutation) (Value, error) {
+func (c *CatalogClient) mutate(ctx context.Context, m *CatalogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DistributeLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CatalogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DistributeLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CatalogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DistributeLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DistributeLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CatalogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown DistributeLock mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown Catalog mutation op: %q", m.Op())
 	}
 }
 
-// EnvironmentClient is a client for the Environment schema.
-type EnvironmentClient struct {
+// ChargebackStatementClient is a client for the ChargebackStatement schema.
+type ChargebackStatementClient struct {
 	config
 }
 
-// NewEnvironmentClient returns a client for the Environment from the given config.
-func NewEnvironmentClient(c config) *EnvironmentClient {
-	return &EnvironmentClient{config: c}
+// NewChargebackStatementClient returns a client for the ChargebackStatement from the given config.
+func NewChargebackStatementClient(c config) *ChargebackStatementClient {
+	return &ChargebackStatementClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `environment.Hooks(f(g(h())))`.
-func (c *EnvironmentClient) Use(hooks ...Hook) {
-	c.hooks.Environment = append(c.hooks.Environment, hooks...)
+// A call to `Use(f, g, h)` equals to `chargebackstatement.Hooks(f(g(h())))`.
+func (c *ChargebackStatementClient) Use(hooks ...Hook) {
+	c.hooks.ChargebackStatement = append(c.hooks.ChargebackStatement, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `environment.Intercept(f(g(h())))`.
-func (c *EnvironmentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Environment = append(c.inters.Environment, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `chargebackstatement.Intercept(f(g(h())))`.
+func (c *ChargebackStatementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ChargebackStatement = append(c.inters.ChargebackStatement, interceptors...)
 }
 
-// Create returns a builder for creating a Environment entity.
-func (c *EnvironmentClient) Create() *EnvironmentCreate {
-	mutation := newEnvironmentMutation(c.config, OpCreate)
-	return &EnvironmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ChargebackStatement entity.
+func (c *ChargebackStatementClient) Create() *ChargebackStatementCreate {
+	mutation := newChargebackStatementMutation(c.config, OpCreate)
+	return &ChargebackStatementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Environment entities.
-func (c *EnvironmentClient) CreateBulk(builders ...*EnvironmentCreate) *EnvironmentCreateBulk {
-	return &EnvironmentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ChargebackStatement entities.
+func (c *ChargebackStatementClient) CreateBulk(builders ...*ChargebackStatementCreate) *ChargebackStatementCreateBulk {
+	return &ChargebackStatementCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EnvironmentClient) MapCreateBulk(slice any, setFunc func(*EnvironmentCreate, int)) *EnvironmentCreateBulk {
+func (c *ChargebackStatementClient) MapCreateBulk(slice any, setFunc func(*ChargebackStatementCreate, int)) *ChargebackStatementCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EnvironmentCreateBulk{err: fmt.Errorf("calling to EnvironmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ChargebackStatementCreateBulk{err: fmt.Errorf("calling to ChargebackStatementClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EnvironmentCreate, rv.Len())
+	builders := make([]*ChargebackStatementCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EnvironmentCreateBulk{config: c.config, builders: builders}
+	return &ChargebackStatementCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Environment.
-func (c *EnvironmentClient) Update() *EnvironmentUpdate {
-	mutation := newEnvironmentMutation(c.config, OpUpdate)
-	return &EnvironmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ChargebackStatement.
+func (c *ChargebackStatementClient) Update() *ChargebackStatementUpdate {
+	mutation := newChargebackStatementMutation(c.config, OpUpdate)
+	return &ChargebackStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EnvironmentClient) UpdateOne(e *Environment) *EnvironmentUpdateOne {
-	mutation := newEnvironmentMutation(c.config, OpUpdateOne, withEnvironment(e))
-	return &EnvironmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChargebackStatementClient) UpdateOne(cs *ChargebackStatement) *ChargebackStatementUpdateOne {
+	mutation := newChargebackStatementMutation(c.config, OpUpdateOne, withChargebackStatement(cs))
+	return &ChargebackStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EnvironmentClient) UpdateOneID(id object.ID) *EnvironmentUpdateOne {
-	mutation := newEnvironmentMutation(c.config, OpUpdateOne, withEnvironmentID(id))
-	return &EnvironmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ChargebackStatementClient) UpdateOneID(id object.ID) *ChargebackStatementUpdateOne {
+	mutation := newChargebackStatementMutation(c.config, OpUpdateOne, withChargebackStatementID(id))
+	return &ChargebackStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Environment.
-func (c *EnvironmentClient) Delete() *EnvironmentDelete {
-	mutation := newEnvironmentMutation(c.config, OpDelete)
-	return &EnvironmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ChargebackStatement.
+func (c *ChargebackStatementClient) Delete() *ChargebackStatementDelete {
+	mutation := newChargebackStatementMutation(c.config, OpDelete)
+	return &ChargebackStatementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EnvironmentClient) DeleteOne(e *Environment) *EnvironmentDeleteOne {
-	return c.DeleteOneID(e.ID)
+func (c *ChargebackStatementClient) DeleteOne(cs *ChargebackStatement) *ChargebackStatementDeleteOne {
+	return c.DeleteOneID(cs.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EnvironmentClient) DeleteOneID(id object.ID) *EnvironmentDeleteOne {
-	builder := c.Delete().Where(environment.ID(id))
+func (c *ChargebackStatementClient) DeleteOneID(id object.ID) *ChargebackStatementDeleteOne {
+	builder := c.Delete().Where(chargebackstatement.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EnvironmentDeleteOne{builder}
+	return &ChargebackStatementDeleteOne{builder}
 }
 
-// Query returns a query builder for Environment.
-func (c *EnvironmentClient) Query() *EnvironmentQuery {
-	return &EnvironmentQuery{
+// Query returns a query builder for ChargebackStatement.
+func (c *ChargebackStatementClient) Query() *ChargebackStatementQuery {
+	return &ChargebackStatementQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEnvironment},
+		ctx:    &QueryContext{Type: TypeChargebackStatement},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Environment entity by its id.
-func (c *EnvironmentClient) Get(ctx context.Context, id object.ID) (*Environment, error) {
-	return c.Query().Where(environment.ID(id)).Only(ctx)
+// Get returns a ChargebackStatement entity by its id.
+func (c *ChargebackStatementClient) Get(ctx context.Context, id object.ID) (*ChargebackStatement, error) {
+	return c.Query().Where(chargebackstatement.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EnvironmentClient) GetX(ctx context.Context, id object.ID) *Environment {
+func (c *ChargebackStatementClient) GetX(ctx context.Context, id object.ID) *ChargebackStatement {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1433,248 +1606,133 @@ func (c *EnvironmentClient) GetX(ctx context.Context, id object.ID) *Environment
 	return obj
 }
 
-// QueryProject queries the project edge of a Environment.
-func (c *EnvironmentClient) QueryProject(e *Environment) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(environment.Table, environment.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, environment.ProjectTable, environment.ProjectColumn),
-		)
-		schemaConfig := e.schemaConfig
-		step.To.Schema = schemaConfig.Project
-		step.Edge.Schema = schemaConfig.Environment
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryConnectors queries the connectors edge of a Environment.
-func (c *EnvironmentClient) QueryConnectors(e *Environment) *EnvironmentConnectorRelationshipQuery {
-	query := (&EnvironmentConnectorRelationshipClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(environment.Table, environment.FieldID, id),
-			sqlgraph.To(environmentconnectorrelationship.Table, environmentconnectorrelationship.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, environment.ConnectorsTable, environment.ConnectorsColumn),
-		)
-		schemaConfig := e.schemaConfig
-		step.To.Schema = schemaConfig.EnvironmentConnectorRelationship
-		step.Edge.Schema = schemaConfig.EnvironmentConnectorRelationship
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryResources queries the resources edge of a Environment.
-func (c *EnvironmentClient) QueryResources(e *Environment) *ResourceQuery {
-	query := (&ResourceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(environment.Table, environment.FieldID, id),
-			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourcesTable, environment.ResourcesColumn),
-		)
-		schemaConfig := e.schemaConfig
-		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryResourceRuns queries the resource_runs edge of a Environment.
-func (c *EnvironmentClient) QueryResourceRuns(e *Environment) *ResourceRunQuery {
-	query := (&ResourceRunClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(environment.Table, environment.FieldID, id),
-			sqlgraph.To(resourcerun.Table, resourcerun.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceRunsTable, environment.ResourceRunsColumn),
-		)
-		schemaConfig := e.schemaConfig
-		step.To.Schema = schemaConfig.ResourceRun
-		step.Edge.Schema = schemaConfig.ResourceRun
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryResourceComponents queries the resource_components edge of a Environment.
-func (c *EnvironmentClient) QueryResourceComponents(e *Environment) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(environment.Table, environment.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceComponentsTable, environment.ResourceComponentsColumn),
-		)
-		schemaConfig := e.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryVariables queries the variables edge of a Environment.
-func (c *EnvironmentClient) QueryVariables(e *Environment) *VariableQuery {
-	query := (&VariableClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := e.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(environment.Table, environment.FieldID, id),
-			sqlgraph.To(variable.Table, variable.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, environment.VariablesTable, environment.VariablesColumn),
-		)
-		schemaConfig := e.schemaConfig
-		step.To.Schema = schemaConfig.Variable
-		step.Edge.Schema = schemaConfig.Variable
-		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *EnvironmentClient) Hooks() []Hook {
-	hooks := c.hooks.Environment
-	return append(hooks[:len(hooks):len(hooks)], environment.Hooks[:]...)
+func (c *ChargebackStatementClient) Hooks() []Hook {
+	hooks := c.hooks.ChargebackStatement
+	return append(hooks[:len(hooks):len(hooks)], chargebackstatement.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *EnvironmentClient) Interceptors() []Interceptor {
-	inters := c.inters.Environment
-	return append(inters[:len(inters):len(inters)], environment.Interceptors[:]...)
+func (c *ChargebackStatementClient) Interceptors() []Interceptor {
+	return c.inters.ChargebackStatement
 }
 
-func (c *EnvironmentClient) mutate(ctx context.Context, m *EnvironmentMutation) (Value, error) {
+func (c *ChargebackStatementClient) mutate(ctx context.Context, m *ChargebackStatementMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EnvironmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChargebackStatementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EnvironmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChargebackStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EnvironmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ChargebackStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EnvironmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ChargebackStatementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown Environment mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ChargebackStatement mutation op: %q", m.Op())
 	}
 }
 
-// EnvironmentConnectorRelationshipClient is a client for the EnvironmentConnectorRelationship schema.
-type EnvironmentConnectorRelationshipClient struct {
+// ConnectorClient is a client for the Connector schema.
+type ConnectorClient struct {
 	config
 }
 
-// NewEnvironmentConnectorRelationshipClient returns a client for the EnvironmentConnectorRelationship from the given config.
-func NewEnvironmentConnectorRelationshipClient(c config) *EnvironmentConnectorRelationshipClient {
-	return &EnvironmentConnectorRelationshipClient{config: c}
+// NewConnectorClient returns a client for the Connector from the given config.
+func NewConnectorClient(c config) *ConnectorClient {
+	return &ConnectorClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `environmentconnectorrelationship.Hooks(f(g(h())))`.
-func (c *EnvironmentConnectorRelationshipClient) Use(hooks ...Hook) {
-	c.hooks.EnvironmentConnectorRelationship = append(c.hooks.EnvironmentConnectorRelationship, hooks...)
+// A call to `Use(f, g, h)` equals to `connector.Hooks(f(g(h())))`.
+func (c *ConnectorClient) Use(hooks ...Hook) {
+	c.hooks.Connector = append(c.hooks.Connector, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `environmentconnectorrelationship.Intercept(f(g(h())))`.
-func (c *EnvironmentConnectorRelationshipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EnvironmentConnectorRelationship = append(c.inters.EnvironmentConnectorRelationship, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `connector.Intercept(f(g(h())))`.
+func (c *ConnectorClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Connector = append(c.inters.Connector, interceptors...)
 }
 
-// Create returns a builder for creating a EnvironmentConnectorRelationship entity.
-func (c *EnvironmentConnectorRelationshipClient) Create() *EnvironmentConnectorRelationshipCreate {
-	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpCreate)
-	return &EnvironmentConnectorRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Connector entity.
+func (c *ConnectorClient) Create() *ConnectorCreate {
+	mutation := newConnectorMutation(c.config, OpCreate)
+	return &ConnectorCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EnvironmentConnectorRelationship entities.
-func (c *EnvironmentConnectorRelationshipClient) CreateBulk(builders ...*EnvironmentConnectorRelationshipCreate) *EnvironmentConnectorRelationshipCreateBulk {
-	return &EnvironmentConnectorRelationshipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Connector entities.
+func (c *ConnectorClient) CreateBulk(builders ...*ConnectorCreate) *ConnectorCreateBulk {
+	return &ConnectorCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EnvironmentConnectorRelationshipClient) MapCreateBulk(slice any, setFunc func(*EnvironmentConnectorRelationshipCreate, int)) *EnvironmentConnectorRelationshipCreateBulk {
+func (c *ConnectorClient) MapCreateBulk(slice any, setFunc func(*ConnectorCreate, int)) *ConnectorCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EnvironmentConnectorRelationshipCreateBulk{err: fmt.Errorf("calling to EnvironmentConnectorRelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ConnectorCreateBulk{err: fmt.Errorf("calling to ConnectorClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EnvironmentConnectorRelationshipCreate, rv.Len())
+	builders := make([]*ConnectorCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EnvironmentConnectorRelationshipCreateBulk{config: c.config, builders: builders}
+	return &ConnectorCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EnvironmentConnectorRelationship.
-func (c *EnvironmentConnectorRelationshipClient) Update() *EnvironmentConnectorRelationshipUpdate {
-	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpUpdate)
-	return &EnvironmentConnectorRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Connector.
+func (c *ConnectorClient) Update() *ConnectorUpdate {
+	mutation := newConnectorMutation(c.config, OpUpdate)
+	return &ConnectorUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EnvironmentConnectorRelationshipClient) UpdateOne(ecr *EnvironmentConnectorRelationship) *EnvironmentConnectorRelationshipUpdateOne {
-	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpUpdateOne, withEnvironmentConnectorRelationship(ecr))
-	return &EnvironmentConnectorRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConnectorClient) UpdateOne(co *Connector) *ConnectorUpdateOne {
+	mutation := newConnectorMutation(c.config, OpUpdateOne, withConnector(co))
+	return &ConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EnvironmentConnectorRelationshipClient) UpdateOneID(id object.ID) *EnvironmentConnectorRelationshipUpdateOne {
-	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpUpdateOne, withEnvironmentConnectorRelationshipID(id))
-	return &EnvironmentConnectorRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ConnectorClient) UpdateOneID(id object.ID) *ConnectorUpdateOne {
+	mutation := newConnectorMutation(c.config, OpUpdateOne, withConnectorID(id))
+	return &ConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EnvironmentConnectorRelationship.
-func (c *EnvironmentConnectorRelationshipClient) Delete() *EnvironmentConnectorRelationshipDelete {
-	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpDelete)
-	return &EnvironmentConnectorRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Connector.
+func (c *ConnectorClient) Delete() *ConnectorDelete {
+	mutation := newConnectorMutation(c.config, OpDelete)
+	return &ConnectorDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EnvironmentConnectorRelationshipClient) DeleteOne(ecr *EnvironmentConnectorRelationship) *EnvironmentConnectorRelationshipDeleteOne {
-	return c.DeleteOneID(ecr.ID)
+func (c *ConnectorClient) DeleteOne(co *Connector) *ConnectorDeleteOne {
+	return c.DeleteOneID(co.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EnvironmentConnectorRelationshipClient) DeleteOneID(id object.ID) *EnvironmentConnectorRelationshipDeleteOne {
-	builder := c.Delete().Where(environmentconnectorrelationship.ID(id))
+func (c *ConnectorClient) DeleteOneID(id object.ID) *ConnectorDeleteOne {
+	builder := c.Delete().Where(connector.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EnvironmentConnectorRelationshipDeleteOne{builder}
+	return &ConnectorDeleteOne{builder}
 }
 
-// Query returns a query builder for EnvironmentConnectorRelationship.
-func (c *EnvironmentConnectorRelationshipClient) Query() *EnvironmentConnectorRelationshipQuery {
-	return &EnvironmentConnectorRelationshipQuery{
+// Query returns a query builder for Connector.
+func (c *ConnectorClient) Query() *ConnectorQuery {
+	return &ConnectorQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEnvironmentConnectorRelationship},
+		ctx:    &QueryContext{Type: TypeConnector},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EnvironmentConnectorRelationship entity by its id.
-func (c *EnvironmentConnectorRelationshipClient) Get(ctx context.Context, id object.ID) (*EnvironmentConnectorRelationship, error) {
-	return c.Query().Where(environmentconnectorrelationship.ID(id)).Only(ctx)
+// Get returns a Connector entity by its id.
+func (c *ConnectorClient) Get(ctx context.Context, id object.ID) (*Connector, error) {
+	return c.Query().Where(connector.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EnvironmentConnectorRelationshipClient) GetX(ctx context.Context, id object.ID) *EnvironmentConnectorRelationship {
+func (c *ConnectorClient) GetX(ctx context.Context, id object.ID) *Connector {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1682,171 +1740,229 @@ func (c *EnvironmentConnectorRelationshipClient) GetX(ctx context.Context, id ob
 	return obj
 }
 
-// QueryEnvironment queries the environment edge of a EnvironmentConnectorRelationship.
-func (c *EnvironmentConnectorRelationshipClient) QueryEnvironment(ecr *EnvironmentConnectorRelationship) *EnvironmentQuery {
-	query := (&EnvironmentClient{config: c.config}).Query()
+// QueryProject queries the project edge of a Connector.
+func (c *ConnectorClient) QueryProject(co *Connector) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ecr.ID
+		id := co.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(environmentconnectorrelationship.Table, environmentconnectorrelationship.FieldID, id),
-			sqlgraph.To(environment.Table, environment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, environmentconnectorrelationship.EnvironmentTable, environmentconnectorrelationship.EnvironmentColumn),
+			sqlgraph.From(connector.Table, connector.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, connector.ProjectTable, connector.ProjectColumn),
 		)
-		schemaConfig := ecr.schemaConfig
-		step.To.Schema = schemaConfig.Environment
-		step.Edge.Schema = schemaConfig.EnvironmentConnectorRelationship
-		fromV = sqlgraph.Neighbors(ecr.driver.Dialect(), step)
+		schemaConfig := co.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.Connector
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryConnector queries the connector edge of a EnvironmentConnectorRelationship.
-func (c *EnvironmentConnectorRelationshipClient) QueryConnector(ecr *EnvironmentConnectorRelationship) *ConnectorQuery {
-	query := (&ConnectorClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := ecr.ID
+// QueryEnvironments queries the environments edge of a Connector.
+func (c *ConnectorClient) QueryEnvironments(co *Connector) *EnvironmentConnectorRelationshipQuery {
+	query := (&EnvironmentConnectorRelationshipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(environmentconnectorrelationship.Table, environmentconnectorrelationship.FieldID, id),
-			sqlgraph.To(connector.Table, connector.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, environmentconnectorrelationship.ConnectorTable, environmentconnectorrelationship.ConnectorColumn),
+			sqlgraph.From(connector.Table, connector.FieldID, id),
+			sqlgraph.To(environmentconnectorrelationship.Table, environmentconnectorrelationship.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, connector.EnvironmentsTable, connector.EnvironmentsColumn),
 		)
-		schemaConfig := ecr.schemaConfig
-		step.To.Schema = schemaConfig.Connector
+		schemaConfig := co.schemaConfig
+		step.To.Schema = schemaConfig.EnvironmentConnectorRelationship
 		step.Edge.Schema = schemaConfig.EnvironmentConnectorRelationship
-		fromV = sqlgraph.Neighbors(ecr.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceComponents queries the resource_components edge of a Connector.
+func (c *ConnectorClient) QueryResourceComponents(co *Connector) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connector.Table, connector.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connector.ResourceComponentsTable, connector.ResourceComponentsColumn),
+		)
+		schemaConfig := co.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCostReports queries the cost_reports edge of a Connector.
+func (c *ConnectorClient) QueryCostReports(co *Connector) *CostReportQuery {
+	query := (&CostReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connector.Table, connector.FieldID, id),
+			sqlgraph.To(costreport.Table, costreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connector.CostReportsTable, connector.CostReportsColumn),
+		)
+		schemaConfig := co.schemaConfig
+		step.To.Schema = schemaConfig.CostReport
+		step.Edge.Schema = schemaConfig.CostReport
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCostAnomalies queries the cost_anomalies edge of a Connector.
+func (c *ConnectorClient) QueryCostAnomalies(co *Connector) *CostAnomalyQuery {
+	query := (&CostAnomalyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connector.Table, connector.FieldID, id),
+			sqlgraph.To(costanomaly.Table, costanomaly.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connector.CostAnomaliesTable, connector.CostAnomaliesColumn),
+		)
+		schemaConfig := co.schemaConfig
+		step.To.Schema = schemaConfig.CostAnomaly
+		step.Edge.Schema = schemaConfig.CostAnomaly
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *EnvironmentConnectorRelationshipClient) Hooks() []Hook {
-	hooks := c.hooks.EnvironmentConnectorRelationship
-	return append(hooks[:len(hooks):len(hooks)], environmentconnectorrelationship.Hooks[:]...)
+func (c *ConnectorClient) Hooks() []Hook {
+	hooks := c.hooks.Connector
+	return append(hooks[:len(hooks):len(hooks)], connector.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *EnvironmentConnectorRelationshipClient) Interceptors() []Interceptor {
-	return c.inters.EnvironmentConnectorRelationship
+func (c *ConnectorClient) Interceptors() []Interceptor {
+	inters := c.inters.Connector
+	return append(inters[:len(inters):len(inters)], connector.Interceptors[:]...)
 }
 
-func (c *EnvironmentConnectorRelationshipClient) mutate(ctx context.Context, m *EnvironmentConnectorRelationshipMutation) (Value, error) {
+func (c *ConnectorClient) mutate(ctx context.Context, m *ConnectorMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EnvironmentConnectorRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectorCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EnvironmentConnectorRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectorUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EnvironmentConnectorRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ConnectorUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EnvironmentConnectorRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ConnectorDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown EnvironmentConnectorRelationship mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown Connector mutation op: %q", m.Op())
 	}
 }
 
-// PerspectiveClient is a client for the Perspective schema.
-type PerspectiveClient struct {
+// CostAnomalyClient is a client for the CostAnomaly schema.
+type CostAnomalyClient struct {
 	config
 }
 
-// NewPerspectiveClient returns a client for the Perspective from the given config.
-func NewPerspectiveClient(c config) *PerspectiveClient {
-	return &PerspectiveClient{config: c}
+// NewCostAnomalyClient returns a client for the CostAnomaly from the given config.
+func NewCostAnomalyClient(c config) *CostAnomalyClient {
+	return &CostAnomalyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `perspective.Hooks(f(g(h())))`.
-func (c *PerspectiveClient) Use(hooks ...Hook) {
-	c.hooks.Perspective = append(c.hooks.Perspective, hooks...)
+// A call to `Use(f, g, h)` equals to `costanomaly.Hooks(f(g(h())))`.
+func (c *CostAnomalyClient) Use(hooks ...Hook) {
+	c.hooks.CostAnomaly = append(c.hooks.CostAnomaly, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `perspective.Intercept(f(g(h())))`.
-func (c *PerspectiveClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Perspective = append(c.inters.Perspective, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `costanomaly.Intercept(f(g(h())))`.
+func (c *CostAnomalyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CostAnomaly = append(c.inters.CostAnomaly, interceptors...)
 }
 
-// Create returns a builder for creating a Perspective entity.
-func (c *PerspectiveClient) Create() *PerspectiveCreate {
-	mutation := newPerspectiveMutation(c.config, OpCreate)
-	return &PerspectiveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CostAnomaly entity.
+func (c *CostAnomalyClient) Create() *CostAnomalyCreate {
+	mutation := newCostAnomalyMutation(c.config, OpCreate)
+	return &CostAnomalyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Perspective entities.
-func (c *PerspectiveClient) CreateBulk(builders ...*PerspectiveCreate) *PerspectiveCreateBulk {
-	return &PerspectiveCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CostAnomaly entities.
+func (c *CostAnomalyClient) CreateBulk(builders ...*CostAnomalyCreate) *CostAnomalyCreateBulk {
+	return &CostAnomalyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PerspectiveClient) MapCreateBulk(slice any, setFunc func(*PerspectiveCreate, int)) *PerspectiveCreateBulk {
+func (c *CostAnomalyClient) MapCreateBulk(slice any, setFunc func(*CostAnomalyCreate, int)) *CostAnomalyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PerspectiveCreateBulk{err: fmt.Errorf("calling to PerspectiveClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CostAnomalyCreateBulk{err: fmt.Errorf("calling to CostAnomalyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PerspectiveCreate, rv.Len())
+	builders := make([]*CostAnomalyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PerspectiveCreateBulk{config: c.config, builders: builders}
+	return &CostAnomalyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Perspective.
-func (c *PerspectiveClient) Update() *PerspectiveUpdate {
-	mutation := newPerspectiveMutation(c.config, OpUpdate)
-	return &PerspectiveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CostAnomaly.
+func (c *CostAnomalyClient) Update() *CostAnomalyUpdate {
+	mutation := newCostAnomalyMutation(c.config, OpUpdate)
+	return &CostAnomalyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PerspectiveClient) UpdateOne(pe *Perspective) *PerspectiveUpdateOne {
-	mutation := newPerspectiveMutation(c.config, OpUpdateOne, withPerspective(pe))
-	return &PerspectiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CostAnomalyClient) UpdateOne(ca *CostAnomaly) *CostAnomalyUpdateOne {
+	mutation := newCostAnomalyMutation(c.config, OpUpdateOne, withCostAnomaly(ca))
+	return &CostAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PerspectiveClient) UpdateOneID(id object.ID) *PerspectiveUpdateOne {
-	mutation := newPerspectiveMutation(c.config, OpUpdateOne, withPerspectiveID(id))
-	return &PerspectiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CostAnomalyClient) UpdateOneID(id object.ID) *CostAnomalyUpdateOne {
+	mutation := newCostAnomalyMutation(c.config, OpUpdateOne, withCostAnomalyID(id))
+	return &CostAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Perspective.
-func (c *PerspectiveClient) Delete() *PerspectiveDelete {
-	mutation := newPerspectiveMutation(c.config, OpDelete)
-	return &PerspectiveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CostAnomaly.
+func (c *CostAnomalyClient) Delete() *CostAnomalyDelete {
+	mutation := newCostAnomalyMutation(c.config, OpDelete)
+	return &CostAnomalyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PerspectiveClient) DeleteOne(pe *Perspective) *PerspectiveDeleteOne {
-	return c.DeleteOneID(pe.ID)
+func (c *CostAnomalyClient) DeleteOne(ca *CostAnomaly) *CostAnomalyDeleteOne {
+	return c.DeleteOneID(ca.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PerspectiveClient) DeleteOneID(id object.ID) *PerspectiveDeleteOne {
-	builder := c.Delete().Where(perspective.ID(id))
+func (c *CostAnomalyClient) DeleteOneID(id object.ID) *CostAnomalyDeleteOne {
+	builder := c.Delete().Where(costanomaly.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PerspectiveDeleteOne{builder}
+	return &CostAnomalyDeleteOne{builder}
 }
 
-// Query returns a query builder for Perspective.
-func (c *PerspectiveClient) Query() *PerspectiveQuery {
-	return &PerspectiveQuery{
+// Query returns a query builder for CostAnomaly.
+func (c *CostAnomalyClient) Query() *CostAnomalyQuery {
+	return &CostAnomalyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePerspective},
+		ctx:    &QueryContext{Type: TypeCostAnomaly},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Perspective entity by its id.
-func (c *PerspectiveClient) Get(ctx context.Context, id object.ID) (*Perspective, error) {
-	return c.Query().Where(perspective.ID(id)).Only(ctx)
+// Get returns a CostAnomaly entity by its id.
+func (c *CostAnomalyClient) Get(ctx context.Context, id object.ID) (*CostAnomaly, error) {
+	return c.Query().Where(costanomaly.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PerspectiveClient) GetX(ctx context.Context, id object.ID) *Perspective {
+func (c *CostAnomalyClient) GetX(ctx context.Context, id object.ID) *CostAnomaly {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1854,122 +1970,1192 @@ func (c *PerspectiveClient) GetX(ctx context.Context, id object.ID) *Perspective
 	return obj
 }
 
+// QueryConnector queries the connector edge of a CostAnomaly.
+func (c *CostAnomalyClient) QueryConnector(ca *CostAnomaly) *ConnectorQuery {
+	query := (&ConnectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(costanomaly.Table, costanomaly.FieldID, id),
+			sqlgraph.To(connector.Table, connector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, costanomaly.ConnectorTable, costanomaly.ConnectorColumn),
+		)
+		schemaConfig := ca.schemaConfig
+		step.To.Schema = schemaConfig.Connector
+		step.Edge.Schema = schemaConfig.CostAnomaly
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *PerspectiveClient) Hooks() []Hook {
-	hooks := c.hooks.Perspective
-	return append(hooks[:len(hooks):len(hooks)], perspective.Hooks[:]...)
+func (c *CostAnomalyClient) Hooks() []Hook {
+	hooks := c.hooks.CostAnomaly
+	return append(hooks[:len(hooks):len(hooks)], costanomaly.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *PerspectiveClient) Interceptors() []Interceptor {
-	return c.inters.Perspective
+func (c *CostAnomalyClient) Interceptors() []Interceptor {
+	return c.inters.CostAnomaly
 }
 
-func (c *PerspectiveClient) mutate(ctx context.Context, m *PerspectiveMutation) (Value, error) {
+func (c *CostAnomalyClient) mutate(ctx context.Context, m *CostAnomalyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PerspectiveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CostAnomalyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PerspectiveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CostAnomalyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PerspectiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CostAnomalyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PerspectiveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CostAnomalyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown Perspective mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown CostAnomaly mutation op: %q", m.Op())
 	}
 }
 
-// ProjectClient is a client for the Project schema.
-type ProjectClient struct {
+// CostReportClient is a client for the CostReport schema.
+type CostReportClient struct {
 	config
 }
 
-// NewProjectClient returns a client for the Project from the given config.
-func NewProjectClient(c config) *ProjectClient {
-	return &ProjectClient{config: c}
+// NewCostReportClient returns a client for the CostReport from the given config.
+func NewCostReportClient(c config) *CostReportClient {
+	return &CostReportClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
-func (c *ProjectClient) Use(hooks ...Hook) {
-	c.hooks.Project = append(c.hooks.Project, hooks...)
+// A call to `Use(f, g, h)` equals to `costreport.Hooks(f(g(h())))`.
+func (c *CostReportClient) Use(hooks ...Hook) {
+	c.hooks.CostReport = append(c.hooks.CostReport, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
-func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Project = append(c.inters.Project, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `costreport.Intercept(f(g(h())))`.
+func (c *CostReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CostReport = append(c.inters.CostReport, interceptors...)
 }
 
-// Create returns a builder for creating a Project entity.
-func (c *ProjectClient) Create() *ProjectCreate {
-	mutation := newProjectMutation(c.config, OpCreate)
-	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CostReport entity.
+func (c *CostReportClient) Create() *CostReportCreate {
+	mutation := newCostReportMutation(c.config, OpCreate)
+	return &CostReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Project entities.
-func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
-	return &ProjectCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CostReport entities.
+func (c *CostReportClient) CreateBulk(builders ...*CostReportCreate) *CostReportCreateBulk {
+	return &CostReportCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+func (c *CostReportClient) MapCreateBulk(slice any, setFunc func(*CostReportCreate, int)) *CostReportCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CostReportCreateBulk{err: fmt.Errorf("calling to CostReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProjectCreate, rv.Len())
+	builders := make([]*CostReportCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProjectCreateBulk{config: c.config, builders: builders}
+	return &CostReportCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Project.
-func (c *ProjectClient) Update() *ProjectUpdate {
-	mutation := newProjectMutation(c.config, OpUpdate)
-	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CostReport.
+func (c *CostReportClient) Update() *CostReportUpdate {
+	mutation := newCostReportMutation(c.config, OpUpdate)
+	return &CostReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProjectClient) UpdateOne(pr *Project) *ProjectUpdateOne {
-	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(pr))
-	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CostReportClient) UpdateOne(cr *CostReport) *CostReportUpdateOne {
+	mutation := newCostReportMutation(c.config, OpUpdateOne, withCostReport(cr))
+	return &CostReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProjectClient) UpdateOneID(id object.ID) *ProjectUpdateOne {
-	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
-	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CostReportClient) UpdateOneID(id int) *CostReportUpdateOne {
+	mutation := newCostReportMutation(c.config, OpUpdateOne, withCostReportID(id))
+	return &CostReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Project.
-func (c *ProjectClient) Delete() *ProjectDelete {
-	mutation := newProjectMutation(c.config, OpDelete)
-	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CostReport.
+func (c *CostReportClient) Delete() *CostReportDelete {
+	mutation := newCostReportMutation(c.config, OpDelete)
+	return &CostReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProjectClient) DeleteOne(pr *Project) *ProjectDeleteOne {
-	return c.DeleteOneID(pr.ID)
+func (c *CostReportClient) DeleteOne(cr *CostReport) *CostReportDeleteOne {
+	return c.DeleteOneID(cr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProjectClient) DeleteOneID(id object.ID) *ProjectDeleteOne {
-	builder := c.Delete().Where(project.ID(id))
+func (c *CostReportClient) DeleteOneID(id int) *CostReportDeleteOne {
+	builder := c.Delete().Where(costreport.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProjectDeleteOne{builder}
+	return &CostReportDeleteOne{builder}
 }
 
-// Query returns a query builder for Project.
-func (c *ProjectClient) Query() *ProjectQuery {
-	return &ProjectQuery{
+// Query returns a query builder for CostReport.
+func (c *CostReportClient) Query() *CostReportQuery {
+	return &CostReportQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProject},
+		ctx:    &QueryContext{Type: TypeCostReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CostReport entity by its id.
+func (c *CostReportClient) Get(ctx context.Context, id int) (*CostReport, error) {
+	return c.Query().Where(costreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CostReportClient) GetX(ctx context.Context, id int) *CostReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryConnector queries the connector edge of a CostReport.
+func (c *CostReportClient) QueryConnector(cr *CostReport) *ConnectorQuery {
+	query := (&ConnectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(costreport.Table, costreport.FieldID, id),
+			sqlgraph.To(connector.Table, connector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, costreport.ConnectorTable, costreport.ConnectorColumn),
+		)
+		schemaConfig := cr.schemaConfig
+		step.To.Schema = schemaConfig.Connector
+		step.Edge.Schema = schemaConfig.CostReport
+		fromV = sqlgraph.Neighbors(cr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CostReportClient) Hooks() []Hook {
+	hooks := c.hooks.CostReport
+	return append(hooks[:len(hooks):len(hooks)], costreport.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CostReportClient) Interceptors() []Interceptor {
+	return c.inters.CostReport
+}
+
+func (c *CostReportClient) mutate(ctx context.Context, m *CostReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CostReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CostReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CostReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CostReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown CostReport mutation op: %q", m.Op())
+	}
+}
+
+// DataEncryptionKeyClient is a client for the DataEncryptionKey schema.
+type DataEncryptionKeyClient struct {
+	config
+}
+
+// NewDataEncryptionKeyClient returns a client for the DataEncryptionKey from the given config.
+func NewDataEncryptionKeyClient(c config) *DataEncryptionKeyClient {
+	return &DataEncryptionKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dataencryptionkey.Hooks(f(g(h())))`.
+func (c *DataEncryptionKeyClient) Use(hooks ...Hook) {
+	c.hooks.DataEncryptionKey = append(c.hooks.DataEncryptionKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dataencryptionkey.Intercept(f(g(h())))`.
+func (c *DataEncryptionKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataEncryptionKey = append(c.inters.DataEncryptionKey, interceptors...)
+}
+
+// Create returns a builder for creating a DataEncryptionKey entity.
+func (c *DataEncryptionKeyClient) Create() *DataEncryptionKeyCreate {
+	mutation := newDataEncryptionKeyMutation(c.config, OpCreate)
+	return &DataEncryptionKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DataEncryptionKey entities.
+func (c *DataEncryptionKeyClient) CreateBulk(builders ...*DataEncryptionKeyCreate) *DataEncryptionKeyCreateBulk {
+	return &DataEncryptionKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DataEncryptionKeyClient) MapCreateBulk(slice any, setFunc func(*DataEncryptionKeyCreate, int)) *DataEncryptionKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DataEncryptionKeyCreateBulk{err: fmt.Errorf("calling to DataEncryptionKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DataEncryptionKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DataEncryptionKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DataEncryptionKey.
+func (c *DataEncryptionKeyClient) Update() *DataEncryptionKeyUpdate {
+	mutation := newDataEncryptionKeyMutation(c.config, OpUpdate)
+	return &DataEncryptionKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DataEncryptionKeyClient) UpdateOne(dek *DataEncryptionKey) *DataEncryptionKeyUpdateOne {
+	mutation := newDataEncryptionKeyMutation(c.config, OpUpdateOne, withDataEncryptionKey(dek))
+	return &DataEncryptionKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DataEncryptionKeyClient) UpdateOneID(id object.ID) *DataEncryptionKeyUpdateOne {
+	mutation := newDataEncryptionKeyMutation(c.config, OpUpdateOne, withDataEncryptionKeyID(id))
+	return &DataEncryptionKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DataEncryptionKey.
+func (c *DataEncryptionKeyClient) Delete() *DataEncryptionKeyDelete {
+	mutation := newDataEncryptionKeyMutation(c.config, OpDelete)
+	return &DataEncryptionKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DataEncryptionKeyClient) DeleteOne(dek *DataEncryptionKey) *DataEncryptionKeyDeleteOne {
+	return c.DeleteOneID(dek.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DataEncryptionKeyClient) DeleteOneID(id object.ID) *DataEncryptionKeyDeleteOne {
+	builder := c.Delete().Where(dataencryptionkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DataEncryptionKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for DataEncryptionKey.
+func (c *DataEncryptionKeyClient) Query() *DataEncryptionKeyQuery {
+	return &DataEncryptionKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDataEncryptionKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DataEncryptionKey entity by its id.
+func (c *DataEncryptionKeyClient) Get(ctx context.Context, id object.ID) (*DataEncryptionKey, error) {
+	return c.Query().Where(dataencryptionkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DataEncryptionKeyClient) GetX(ctx context.Context, id object.ID) *DataEncryptionKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DataEncryptionKeyClient) Hooks() []Hook {
+	hooks := c.hooks.DataEncryptionKey
+	return append(hooks[:len(hooks):len(hooks)], dataencryptionkey.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DataEncryptionKeyClient) Interceptors() []Interceptor {
+	return c.inters.DataEncryptionKey
+}
+
+func (c *DataEncryptionKeyClient) mutate(ctx context.Context, m *DataEncryptionKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DataEncryptionKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DataEncryptionKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DataEncryptionKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DataEncryptionKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown DataEncryptionKey mutation op: %q", m.Op())
+	}
+}
+
+// DistributeLockClient is a client for the DistributeLock schema.
+type DistributeLockClient struct {
+	config
+}
+
+// NewDistributeLockClient returns a client for the DistributeLock from the given config.
+func NewDistributeLockClient(c config) *DistributeLockClient {
+	return &DistributeLockClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `distributelock.Hooks(f(g(h())))`.
+func (c *DistributeLockClient) Use(hooks ...Hook) {
+	c.hooks.DistributeLock = append(c.hooks.DistributeLock, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `distributelock.Intercept(f(g(h())))`.
+func (c *DistributeLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DistributeLock = append(c.inters.DistributeLock, interceptors...)
+}
+
+// Create returns a builder for creating a DistributeLock entity.
+func (c *DistributeLockClient) Create() *DistributeLockCreate {
+	mutation := newDistributeLockMutation(c.config, OpCreate)
+	return &DistributeLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DistributeLock entities.
+func (c *DistributeLockClient) CreateBulk(builders ...*DistributeLockCreate) *DistributeLockCreateBulk {
+	return &DistributeLockCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DistributeLockClient) MapCreateBulk(slice any, setFunc func(*DistributeLockCreate, int)) *DistributeLockCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DistributeLockCreateBulk{err: fmt.Errorf("calling to DistributeLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DistributeLockCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DistributeLockCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DistributeLock.
+func (c *DistributeLockClient) Update() *DistributeLockUpdate {
+	mutation := newDistributeLockMutation(c.config, OpUpdate)
+	return &DistributeLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DistributeLockClient) UpdateOne(dl *DistributeLock) *DistributeLockUpdateOne {
+	mutation := newDistributeLockMutation(c.config, OpUpdateOne, withDistributeLock(dl))
+	return &DistributeLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DistributeLockClient) UpdateOneID(id string) *DistributeLockUpdateOne {
+	mutation := newDistributeLockMutation(c.config, OpUpdateOne, withDistributeLockID(id))
+	return &DistributeLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DistributeLock.
+func (c *DistributeLockClient) Delete() *DistributeLockDelete {
+	mutation := newDistributeLockMutation(c.config, OpDelete)
+	return &DistributeLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DistributeLockClient) DeleteOne(dl *DistributeLock) *DistributeLockDeleteOne {
+	return c.DeleteOneID(dl.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DistributeLockClient) DeleteOneID(id string) *DistributeLockDeleteOne {
+	builder := c.Delete().Where(distributelock.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DistributeLockDeleteOne{builder}
+}
+
+// Query returns a query builder for DistributeLock.
+func (c *DistributeLockClient) Query() *DistributeLockQuery {
+	return &DistributeLockQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDistributeLock},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DistributeLock entity by its id.
+func (c *DistributeLockClient) Get(ctx context.Context, id string) (*DistributeLock, error) {
+	return c.Query().Where(distributelock.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DistributeLockClient) GetX(ctx context.Context, id string) *DistributeLock {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DistributeLockClient) Hooks() []Hook {
+	return c.hooks.DistributeLock
+}
+
+// Interceptors returns the client interceptors.
+func (c *DistributeLockClient) Interceptors() []Interceptor {
+	return c.inters.DistributeLock
+}
+
+func (c *DistributeLockClient) mutate(ctx context.Context, m *DistributeLockMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DistributeLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DistributeLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DistributeLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DistributeLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown DistributeLock mutation op: %q", m.Op())
+	}
+}
+
+// EnvironmentClient is a client for the Environment schema.
+type EnvironmentClient struct {
+	config
+}
+
+// NewEnvironmentClient returns a client for the Environment from the given config.
+func NewEnvironmentClient(c config) *EnvironmentClient {
+	return &EnvironmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `environment.Hooks(f(g(h())))`.
+func (c *EnvironmentClient) Use(hooks ...Hook) {
+	c.hooks.Environment = append(c.hooks.Environment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `environment.Intercept(f(g(h())))`.
+func (c *EnvironmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Environment = append(c.inters.Environment, interceptors...)
+}
+
+// Create returns a builder for creating a Environment entity.
+func (c *EnvironmentClient) Create() *EnvironmentCreate {
+	mutation := newEnvironmentMutation(c.config, OpCreate)
+	return &EnvironmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Environment entities.
+func (c *EnvironmentClient) CreateBulk(builders ...*EnvironmentCreate) *EnvironmentCreateBulk {
+	return &EnvironmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnvironmentClient) MapCreateBulk(slice any, setFunc func(*EnvironmentCreate, int)) *EnvironmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnvironmentCreateBulk{err: fmt.Errorf("calling to EnvironmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnvironmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnvironmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Environment.
+func (c *EnvironmentClient) Update() *EnvironmentUpdate {
+	mutation := newEnvironmentMutation(c.config, OpUpdate)
+	return &EnvironmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnvironmentClient) UpdateOne(e *Environment) *EnvironmentUpdateOne {
+	mutation := newEnvironmentMutation(c.config, OpUpdateOne, withEnvironment(e))
+	return &EnvironmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnvironmentClient) UpdateOneID(id object.ID) *EnvironmentUpdateOne {
+	mutation := newEnvironmentMutation(c.config, OpUpdateOne, withEnvironmentID(id))
+	return &EnvironmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Environment.
+func (c *EnvironmentClient) Delete() *EnvironmentDelete {
+	mutation := newEnvironmentMutation(c.config, OpDelete)
+	return &EnvironmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnvironmentClient) DeleteOne(e *Environment) *EnvironmentDeleteOne {
+	return c.DeleteOneID(e.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnvironmentClient) DeleteOneID(id object.ID) *EnvironmentDeleteOne {
+	builder := c.Delete().Where(environment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnvironmentDeleteOne{builder}
+}
+
+// Query returns a query builder for Environment.
+func (c *EnvironmentClient) Query() *EnvironmentQuery {
+	return &EnvironmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnvironment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Environment entity by its id.
+func (c *EnvironmentClient) Get(ctx context.Context, id object.ID) (*Environment, error) {
+	return c.Query().Where(environment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnvironmentClient) GetX(ctx context.Context, id object.ID) *Environment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Environment.
+func (c *EnvironmentClient) QueryProject(e *Environment) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, environment.ProjectTable, environment.ProjectColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.Environment
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConnectors queries the connectors edge of a Environment.
+func (c *EnvironmentClient) QueryConnectors(e *Environment) *EnvironmentConnectorRelationshipQuery {
+	query := (&EnvironmentConnectorRelationshipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(environmentconnectorrelationship.Table, environmentconnectorrelationship.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, environment.ConnectorsTable, environment.ConnectorsColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.EnvironmentConnectorRelationship
+		step.Edge.Schema = schemaConfig.EnvironmentConnectorRelationship
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResources queries the resources edge of a Environment.
+func (c *EnvironmentClient) QueryResources(e *Environment) *ResourceQuery {
+	query := (&ResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourcesTable, environment.ResourcesColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.Resource
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceRuns queries the resource_runs edge of a Environment.
+func (c *EnvironmentClient) QueryResourceRuns(e *Environment) *ResourceRunQuery {
+	query := (&ResourceRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(resourcerun.Table, resourcerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceRunsTable, environment.ResourceRunsColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRun
+		step.Edge.Schema = schemaConfig.ResourceRun
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceStateVersions queries the resource_state_versions edge of a Environment.
+func (c *EnvironmentClient) QueryResourceStateVersions(e *Environment) *ResourceStateVersionQuery {
+	query := (&ResourceStateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(resourcestateversion.Table, resourcestateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceStateVersionsTable, environment.ResourceStateVersionsColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceComponents queries the resource_components edge of a Environment.
+func (c *EnvironmentClient) QueryResourceComponents(e *Environment) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceComponentsTable, environment.ResourceComponentsColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceRunApprovalRules queries the resource_run_approval_rules edge of a Environment.
+func (c *EnvironmentClient) QueryResourceRunApprovalRules(e *Environment) *ResourceRunApprovalRuleQuery {
+	query := (&ResourceRunApprovalRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(resourcerunapprovalrule.Table, resourcerunapprovalrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceRunApprovalRulesTable, environment.ResourceRunApprovalRulesColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRunApprovalRule
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceRunPolicies queries the resource_run_policies edge of a Environment.
+func (c *EnvironmentClient) QueryResourceRunPolicies(e *Environment) *ResourceRunPolicyQuery {
+	query := (&ResourceRunPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(resourcerunpolicy.Table, resourcerunpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceRunPoliciesTable, environment.ResourceRunPoliciesColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRunPolicy
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgets queries the budgets edge of a Environment.
+func (c *EnvironmentClient) QueryBudgets(e *Environment) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.BudgetsTable, environment.BudgetsColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.Budget
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVariables queries the variables edge of a Environment.
+func (c *EnvironmentClient) QueryVariables(e *Environment) *VariableQuery {
+	query := (&VariableClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := e.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(variable.Table, variable.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.VariablesTable, environment.VariablesColumn),
+		)
+		schemaConfig := e.schemaConfig
+		step.To.Schema = schemaConfig.Variable
+		step.Edge.Schema = schemaConfig.Variable
+		fromV = sqlgraph.Neighbors(e.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnvironmentClient) Hooks() []Hook {
+	hooks := c.hooks.Environment
+	return append(hooks[:len(hooks):len(hooks)], environment.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnvironmentClient) Interceptors() []Interceptor {
+	inters := c.inters.Environment
+	return append(inters[:len(inters):len(inters)], environment.Interceptors[:]...)
+}
+
+func (c *EnvironmentClient) mutate(ctx context.Context, m *EnvironmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnvironmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnvironmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnvironmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnvironmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown Environment mutation op: %q", m.Op())
+	}
+}
+
+// EnvironmentConnectorRelationshipClient is a client for the EnvironmentConnectorRelationship schema.
+type EnvironmentConnectorRelationshipClient struct {
+	config
+}
+
+// NewEnvironmentConnectorRelationshipClient returns a client for the EnvironmentConnectorRelationship from the given config.
+func NewEnvironmentConnectorRelationshipClient(c config) *EnvironmentConnectorRelationshipClient {
+	return &EnvironmentConnectorRelationshipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `environmentconnectorrelationship.Hooks(f(g(h())))`.
+func (c *EnvironmentConnectorRelationshipClient) Use(hooks ...Hook) {
+	c.hooks.EnvironmentConnectorRelationship = append(c.hooks.EnvironmentConnectorRelationship, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `environmentconnectorrelationship.Intercept(f(g(h())))`.
+func (c *EnvironmentConnectorRelationshipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnvironmentConnectorRelationship = append(c.inters.EnvironmentConnectorRelationship, interceptors...)
+}
+
+// Create returns a builder for creating a EnvironmentConnectorRelationship entity.
+func (c *EnvironmentConnectorRelationshipClient) Create() *EnvironmentConnectorRelationshipCreate {
+	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpCreate)
+	return &EnvironmentConnectorRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnvironmentConnectorRelationship entities.
+func (c *EnvironmentConnectorRelationshipClient) CreateBulk(builders ...*EnvironmentConnectorRelationshipCreate) *EnvironmentConnectorRelationshipCreateBulk {
+	return &EnvironmentConnectorRelationshipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnvironmentConnectorRelationshipClient) MapCreateBulk(slice any, setFunc func(*EnvironmentConnectorRelationshipCreate, int)) *EnvironmentConnectorRelationshipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnvironmentConnectorRelationshipCreateBulk{err: fmt.Errorf("calling to EnvironmentConnectorRelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnvironmentConnectorRelationshipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnvironmentConnectorRelationshipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnvironmentConnectorRelationship.
+func (c *EnvironmentConnectorRelationshipClient) Update() *EnvironmentConnectorRelationshipUpdate {
+	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpUpdate)
+	return &EnvironmentConnectorRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnvironmentConnectorRelationshipClient) UpdateOne(ecr *EnvironmentConnectorRelationship) *EnvironmentConnectorRelationshipUpdateOne {
+	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpUpdateOne, withEnvironmentConnectorRelationship(ecr))
+	return &EnvironmentConnectorRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnvironmentConnectorRelationshipClient) UpdateOneID(id object.ID) *EnvironmentConnectorRelationshipUpdateOne {
+	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpUpdateOne, withEnvironmentConnectorRelationshipID(id))
+	return &EnvironmentConnectorRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnvironmentConnectorRelationship.
+func (c *EnvironmentConnectorRelationshipClient) Delete() *EnvironmentConnectorRelationshipDelete {
+	mutation := newEnvironmentConnectorRelationshipMutation(c.config, OpDelete)
+	return &EnvironmentConnectorRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnvironmentConnectorRelationshipClient) DeleteOne(ecr *EnvironmentConnectorRelationship) *EnvironmentConnectorRelationshipDeleteOne {
+	return c.DeleteOneID(ecr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnvironmentConnectorRelationshipClient) DeleteOneID(id object.ID) *EnvironmentConnectorRelationshipDeleteOne {
+	builder := c.Delete().Where(environmentconnectorrelationship.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnvironmentConnectorRelationshipDeleteOne{builder}
+}
+
+// Query returns a query builder for EnvironmentConnectorRelationship.
+func (c *EnvironmentConnectorRelationshipClient) Query() *EnvironmentConnectorRelationshipQuery {
+	return &EnvironmentConnectorRelationshipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnvironmentConnectorRelationship},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnvironmentConnectorRelationship entity by its id.
+func (c *EnvironmentConnectorRelationshipClient) Get(ctx context.Context, id object.ID) (*EnvironmentConnectorRelationship, error) {
+	return c.Query().Where(environmentconnectorrelationship.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnvironmentConnectorRelationshipClient) GetX(ctx context.Context, id object.ID) *EnvironmentConnectorRelationship {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnvironment queries the environment edge of a EnvironmentConnectorRelationship.
+func (c *EnvironmentConnectorRelationshipClient) QueryEnvironment(ecr *EnvironmentConnectorRelationship) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ecr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environmentconnectorrelationship.Table, environmentconnectorrelationship.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, environmentconnectorrelationship.EnvironmentTable, environmentconnectorrelationship.EnvironmentColumn),
+		)
+		schemaConfig := ecr.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.EnvironmentConnectorRelationship
+		fromV = sqlgraph.Neighbors(ecr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConnector queries the connector edge of a EnvironmentConnectorRelationship.
+func (c *EnvironmentConnectorRelationshipClient) QueryConnector(ecr *EnvironmentConnectorRelationship) *ConnectorQuery {
+	query := (&ConnectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ecr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environmentconnectorrelationship.Table, environmentconnectorrelationship.FieldID, id),
+			sqlgraph.To(connector.Table, connector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, environmentconnectorrelationship.ConnectorTable, environmentconnectorrelationship.ConnectorColumn),
+		)
+		schemaConfig := ecr.schemaConfig
+		step.To.Schema = schemaConfig.Connector
+		step.Edge.Schema = schemaConfig.EnvironmentConnectorRelationship
+		fromV = sqlgraph.Neighbors(ecr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EnvironmentConnectorRelationshipClient) Hooks() []Hook {
+	hooks := c.hooks.EnvironmentConnectorRelationship
+	return append(hooks[:len(hooks):len(hooks)], environmentconnectorrelationship.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnvironmentConnectorRelationshipClient) Interceptors() []Interceptor {
+	return c.inters.EnvironmentConnectorRelationship
+}
+
+func (c *EnvironmentConnectorRelationshipClient) mutate(ctx context.Context, m *EnvironmentConnectorRelationshipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnvironmentConnectorRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnvironmentConnectorRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnvironmentConnectorRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnvironmentConnectorRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown EnvironmentConnectorRelationship mutation op: %q", m.Op())
+	}
+}
+
+// PerspectiveClient is a client for the Perspective schema.
+type PerspectiveClient struct {
+	config
+}
+
+// NewPerspectiveClient returns a client for the Perspective from the given config.
+func NewPerspectiveClient(c config) *PerspectiveClient {
+	return &PerspectiveClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `perspective.Hooks(f(g(h())))`.
+func (c *PerspectiveClient) Use(hooks ...Hook) {
+	c.hooks.Perspective = append(c.hooks.Perspective, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `perspective.Intercept(f(g(h())))`.
+func (c *PerspectiveClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Perspective = append(c.inters.Perspective, interceptors...)
+}
+
+// Create returns a builder for creating a Perspective entity.
+func (c *PerspectiveClient) Create() *PerspectiveCreate {
+	mutation := newPerspectiveMutation(c.config, OpCreate)
+	return &PerspectiveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Perspective entities.
+func (c *PerspectiveClient) CreateBulk(builders ...*PerspectiveCreate) *PerspectiveCreateBulk {
+	return &PerspectiveCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PerspectiveClient) MapCreateBulk(slice any, setFunc func(*PerspectiveCreate, int)) *PerspectiveCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PerspectiveCreateBulk{err: fmt.Errorf("calling to PerspectiveClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PerspectiveCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PerspectiveCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Perspective.
+func (c *PerspectiveClient) Update() *PerspectiveUpdate {
+	mutation := newPerspectiveMutation(c.config, OpUpdate)
+	return &PerspectiveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PerspectiveClient) UpdateOne(pe *Perspective) *PerspectiveUpdateOne {
+	mutation := newPerspectiveMutation(c.config, OpUpdateOne, withPerspective(pe))
+	return &PerspectiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PerspectiveClient) UpdateOneID(id object.ID) *PerspectiveUpdateOne {
+	mutation := newPerspectiveMutation(c.config, OpUpdateOne, withPerspectiveID(id))
+	return &PerspectiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Perspective.
+func (c *PerspectiveClient) Delete() *PerspectiveDelete {
+	mutation := newPerspectiveMutation(c.config, OpDelete)
+	return &PerspectiveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PerspectiveClient) DeleteOne(pe *Perspective) *PerspectiveDeleteOne {
+	return c.DeleteOneID(pe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PerspectiveClient) DeleteOneID(id object.ID) *PerspectiveDeleteOne {
+	builder := c.Delete().Where(perspective.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PerspectiveDeleteOne{builder}
+}
+
+// Query returns a query builder for Perspective.
+func (c *PerspectiveClient) Query() *PerspectiveQuery {
+	return &PerspectiveQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePerspective},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Perspective entity by its id.
+func (c *PerspectiveClient) Get(ctx context.Context, id object.ID) (*Perspective, error) {
+	return c.Query().Where(perspective.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PerspectiveClient) GetX(ctx context.Context, id object.ID) *Perspective {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PerspectiveClient) Hooks() []Hook {
+	hooks := c.hooks.Perspective
+	return append(hooks[:len(hooks):len(hooks)], perspective.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *PerspectiveClient) Interceptors() []Interceptor {
+	return c.inters.Perspective
+}
+
+func (c *PerspectiveClient) mutate(ctx context.Context, m *PerspectiveMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PerspectiveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PerspectiveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PerspectiveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PerspectiveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown Perspective mutation op: %q", m.Op())
+	}
+}
+
+// ProjectClient is a client for the Project schema.
+type ProjectClient struct {
+	config
+}
+
+// NewProjectClient returns a client for the Project from the given config.
+func NewProjectClient(c config) *ProjectClient {
+	return &ProjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
+func (c *ProjectClient) Use(hooks ...Hook) {
+	c.hooks.Project = append(c.hooks.Project, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
+func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Project = append(c.inters.Project, interceptors...)
+}
+
+// Create returns a builder for creating a Project entity.
+func (c *ProjectClient) Create() *ProjectCreate {
+	mutation := newProjectMutation(c.config, OpCreate)
+	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Project entities.
+func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Project.
+func (c *ProjectClient) Update() *ProjectUpdate {
+	mutation := newProjectMutation(c.config, OpUpdate)
+	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProjectClient) UpdateOne(pr *Project) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(pr))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProjectClient) UpdateOneID(id object.ID) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Project.
+func (c *ProjectClient) Delete() *ProjectDelete {
+	mutation := newProjectMutation(c.config, OpDelete)
+	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProjectClient) DeleteOne(pr *Project) *ProjectDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProjectClient) DeleteOneID(id object.ID) *ProjectDeleteOne {
+	builder := c.Delete().Where(project.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProjectDeleteOne{builder}
+}
+
+// Query returns a query builder for Project.
+func (c *ProjectClient) Query() *ProjectQuery {
+	return &ProjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProject},
 		inters: c.Interceptors(),
 	}
 }
@@ -1979,447 +3165,1555 @@ func (c *ProjectClient) Get(ctx context.Context, id object.ID) (*Project, error)
 	return c.Query().Where(project.ID(id)).Only(ctx)
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *ProjectClient) GetX(ctx context.Context, id object.ID) *Project {
-	obj, err := c.Get(ctx, id)
-	if err != nil {
-		panic(err)
+// GetX is like Get, but panics if an error occurs.
+func (c *ProjectClient) GetX(ctx context.Context, id object.ID) *Project {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnvironments queries the environments edge of a Project.
+func (c *ProjectClient) QueryEnvironments(pr *Project) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.EnvironmentsTable, project.EnvironmentsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.Environment
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConnectors queries the connectors edge of a Project.
+func (c *ProjectClient) QueryConnectors(pr *Project) *ConnectorQuery {
+	query := (&ConnectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(connector.Table, connector.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ConnectorsTable, project.ConnectorsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Connector
+		step.Edge.Schema = schemaConfig.Connector
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubjectRoles queries the subject_roles edge of a Project.
+func (c *ProjectClient) QuerySubjectRoles(pr *Project) *SubjectRoleRelationshipQuery {
+	query := (&SubjectRoleRelationshipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(subjectrolerelationship.Table, subjectrolerelationship.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.SubjectRolesTable, project.SubjectRolesColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.SubjectRoleRelationship
+		step.Edge.Schema = schemaConfig.SubjectRoleRelationship
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResources queries the resources edge of a Project.
+func (c *ProjectClient) QueryResources(pr *Project) *ResourceQuery {
+	query := (&ResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourcesTable, project.ResourcesColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.Resource
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceComponents queries the resource_components edge of a Project.
+func (c *ProjectClient) QueryResourceComponents(pr *Project) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceComponentsTable, project.ResourceComponentsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceRuns queries the resource_runs edge of a Project.
+func (c *ProjectClient) QueryResourceRuns(pr *Project) *ResourceRunQuery {
+	query := (&ResourceRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(resourcerun.Table, resourcerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceRunsTable, project.ResourceRunsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRun
+		step.Edge.Schema = schemaConfig.ResourceRun
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceStateVersions queries the resource_state_versions edge of a Project.
+func (c *ProjectClient) QueryResourceStateVersions(pr *Project) *ResourceStateVersionQuery {
+	query := (&ResourceStateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(resourcestateversion.Table, resourcestateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceStateVersionsTable, project.ResourceStateVersionsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceRunApprovalRules queries the resource_run_approval_rules edge of a Project.
+func (c *ProjectClient) QueryResourceRunApprovalRules(pr *Project) *ResourceRunApprovalRuleQuery {
+	query := (&ResourceRunApprovalRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(resourcerunapprovalrule.Table, resourcerunapprovalrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceRunApprovalRulesTable, project.ResourceRunApprovalRulesColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRunApprovalRule
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceRunPolicies queries the resource_run_policies edge of a Project.
+func (c *ProjectClient) QueryResourceRunPolicies(pr *Project) *ResourceRunPolicyQuery {
+	query := (&ResourceRunPolicyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(resourcerunpolicy.Table, resourcerunpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceRunPoliciesTable, project.ResourceRunPoliciesColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRunPolicy
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplateUpgradeCampaigns queries the template_upgrade_campaigns edge of a Project.
+func (c *ProjectClient) QueryTemplateUpgradeCampaigns(pr *Project) *TemplateUpgradeCampaignQuery {
+	query := (&TemplateUpgradeCampaignClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(templateupgradecampaign.Table, templateupgradecampaign.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TemplateUpgradeCampaignsTable, project.TemplateUpgradeCampaignsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.TemplateUpgradeCampaign
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhooks queries the webhooks edge of a Project.
+func (c *ProjectClient) QueryWebhooks(pr *Project) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WebhooksTable, project.WebhooksColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgets queries the budgets edge of a Project.
+func (c *ProjectClient) QueryBudgets(pr *Project) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.BudgetsTable, project.BudgetsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.Budget
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVariables queries the variables edge of a Project.
+func (c *ProjectClient) QueryVariables(pr *Project) *VariableQuery {
+	query := (&VariableClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(variable.Table, variable.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.VariablesTable, project.VariablesColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Variable
+		step.Edge.Schema = schemaConfig.Variable
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplates queries the templates edge of a Project.
+func (c *ProjectClient) QueryTemplates(pr *Project) *TemplateQuery {
+	query := (&TemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(template.Table, template.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TemplatesTable, project.TemplatesColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Template
+		step.Edge.Schema = schemaConfig.Template
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplateVersions queries the template_versions edge of a Project.
+func (c *ProjectClient) QueryTemplateVersions(pr *Project) *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TemplateVersionsTable, project.TemplateVersionsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCatalogs queries the catalogs edge of a Project.
+func (c *ProjectClient) QueryCatalogs(pr *Project) *CatalogQuery {
+	query := (&CatalogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(catalog.Table, catalog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.CatalogsTable, project.CatalogsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Catalog
+		step.Edge.Schema = schemaConfig.Catalog
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflows queries the workflows edge of a Project.
+func (c *ProjectClient) QueryWorkflows(pr *Project) *WorkflowQuery {
+	query := (&WorkflowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(workflow.Table, workflow.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowsTable, project.WorkflowsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.Workflow
+		step.Edge.Schema = schemaConfig.Workflow
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflowStages queries the workflow_stages edge of a Project.
+func (c *ProjectClient) QueryWorkflowStages(pr *Project) *WorkflowStageQuery {
+	query := (&WorkflowStageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(workflowstage.Table, workflowstage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStagesTable, project.WorkflowStagesColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.WorkflowStage
+		step.Edge.Schema = schemaConfig.WorkflowStage
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflowSteps queries the workflow_steps edge of a Project.
+func (c *ProjectClient) QueryWorkflowSteps(pr *Project) *WorkflowStepQuery {
+	query := (&WorkflowStepClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(workflowstep.Table, workflowstep.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStepsTable, project.WorkflowStepsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.WorkflowStep
+		step.Edge.Schema = schemaConfig.WorkflowStep
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflowExecutions queries the workflow_executions edge of a Project.
+func (c *ProjectClient) QueryWorkflowExecutions(pr *Project) *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowExecutionsTable, project.WorkflowExecutionsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.WorkflowExecution
+		step.Edge.Schema = schemaConfig.WorkflowExecution
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflowStageExecutions queries the workflow_stage_executions edge of a Project.
+func (c *ProjectClient) QueryWorkflowStageExecutions(pr *Project) *WorkflowStageExecutionQuery {
+	query := (&WorkflowStageExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(workflowstageexecution.Table, workflowstageexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStageExecutionsTable, project.WorkflowStageExecutionsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.WorkflowStageExecution
+		step.Edge.Schema = schemaConfig.WorkflowStageExecution
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflowStepExecutions queries the workflow_step_executions edge of a Project.
+func (c *ProjectClient) QueryWorkflowStepExecutions(pr *Project) *WorkflowStepExecutionQuery {
+	query := (&WorkflowStepExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(workflowstepexecution.Table, workflowstepexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStepExecutionsTable, project.WorkflowStepExecutionsColumn),
+		)
+		schemaConfig := pr.schemaConfig
+		step.To.Schema = schemaConfig.WorkflowStepExecution
+		step.Edge.Schema = schemaConfig.WorkflowStepExecution
+		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProjectClient) Hooks() []Hook {
+	hooks := c.hooks.Project
+	return append(hooks[:len(hooks):len(hooks)], project.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProjectClient) Interceptors() []Interceptor {
+	inters := c.inters.Project
+	return append(inters[:len(inters):len(inters)], project.Interceptors[:]...)
+}
+
+func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown Project mutation op: %q", m.Op())
+	}
+}
+
+// ResourceClient is a client for the Resource schema.
+type ResourceClient struct {
+	config
+}
+
+// NewResourceClient returns a client for the Resource from the given config.
+func NewResourceClient(c config) *ResourceClient {
+	return &ResourceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resource.Hooks(f(g(h())))`.
+func (c *ResourceClient) Use(hooks ...Hook) {
+	c.hooks.Resource = append(c.hooks.Resource, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resource.Intercept(f(g(h())))`.
+func (c *ResourceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Resource = append(c.inters.Resource, interceptors...)
+}
+
+// Create returns a builder for creating a Resource entity.
+func (c *ResourceClient) Create() *ResourceCreate {
+	mutation := newResourceMutation(c.config, OpCreate)
+	return &ResourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Resource entities.
+func (c *ResourceClient) CreateBulk(builders ...*ResourceCreate) *ResourceCreateBulk {
+	return &ResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResourceClient) MapCreateBulk(slice any, setFunc func(*ResourceCreate, int)) *ResourceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResourceCreateBulk{err: fmt.Errorf("calling to ResourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResourceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResourceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Resource.
+func (c *ResourceClient) Update() *ResourceUpdate {
+	mutation := newResourceMutation(c.config, OpUpdate)
+	return &ResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceClient) UpdateOne(r *Resource) *ResourceUpdateOne {
+	mutation := newResourceMutation(c.config, OpUpdateOne, withResource(r))
+	return &ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceClient) UpdateOneID(id object.ID) *ResourceUpdateOne {
+	mutation := newResourceMutation(c.config, OpUpdateOne, withResourceID(id))
+	return &ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Resource.
+func (c *ResourceClient) Delete() *ResourceDelete {
+	mutation := newResourceMutation(c.config, OpDelete)
+	return &ResourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResourceClient) DeleteOne(r *Resource) *ResourceDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResourceClient) DeleteOneID(id object.ID) *ResourceDeleteOne {
+	builder := c.Delete().Where(resource.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceDeleteOne{builder}
+}
+
+// Query returns a query builder for Resource.
+func (c *ResourceClient) Query() *ResourceQuery {
+	return &ResourceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResource},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Resource entity by its id.
+func (c *ResourceClient) Get(ctx context.Context, id object.ID) (*Resource, error) {
+	return c.Query().Where(resource.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResourceClient) GetX(ctx context.Context, id object.ID) *Resource {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Resource.
+func (c *ResourceClient) QueryProject(r *Resource) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resource.ProjectTable, resource.ProjectColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.Resource
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvironment queries the environment edge of a Resource.
+func (c *ResourceClient) QueryEnvironment(r *Resource) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resource.EnvironmentTable, resource.EnvironmentColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.Resource
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplate queries the template edge of a Resource.
+func (c *ResourceClient) QueryTemplate(r *Resource) *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resource.TemplateTable, resource.TemplateColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.Resource
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceDefinition queries the resource_definition edge of a Resource.
+func (c *ResourceClient) QueryResourceDefinition(r *Resource) *ResourceDefinitionQuery {
+	query := (&ResourceDefinitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(resourcedefinition.Table, resourcedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resource.ResourceDefinitionTable, resource.ResourceDefinitionColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.ResourceDefinition
+		step.Edge.Schema = schemaConfig.Resource
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResourceDefinitionMatchingRule queries the resource_definition_matching_rule edge of a Resource.
+func (c *ResourceClient) QueryResourceDefinitionMatchingRule(r *Resource) *ResourceDefinitionMatchingRuleQuery {
+	query := (&ResourceDefinitionMatchingRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resource.ResourceDefinitionMatchingRuleTable, resource.ResourceDefinitionMatchingRuleColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.ResourceDefinitionMatchingRule
+		step.Edge.Schema = schemaConfig.Resource
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRuns queries the runs edge of a Resource.
+func (c *ResourceClient) QueryRuns(r *Resource) *ResourceRunQuery {
+	query := (&ResourceRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(resourcerun.Table, resourcerun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resource.RunsTable, resource.RunsColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRun
+		step.Edge.Schema = schemaConfig.ResourceRun
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComponents queries the components edge of a Resource.
+func (c *ResourceClient) QueryComponents(r *Resource) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resource.ComponentsTable, resource.ComponentsColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDependencies queries the dependencies edge of a Resource.
+func (c *ResourceClient) QueryDependencies(r *Resource) *ResourceRelationshipQuery {
+	query := (&ResourceRelationshipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(resourcerelationship.Table, resourcerelationship.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, resource.DependenciesTable, resource.DependenciesColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRelationship
+		step.Edge.Schema = schemaConfig.ResourceRelationship
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryState queries the state edge of a Resource.
+func (c *ResourceClient) QueryState(r *Resource) *ResourceStateQuery {
+	query := (&ResourceStateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(resourcestate.Table, resourcestate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, resource.StateTable, resource.StateColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.ResourceState
+		step.Edge.Schema = schemaConfig.ResourceState
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStateVersions queries the state_versions edge of a Resource.
+func (c *ResourceClient) QueryStateVersions(r *Resource) *ResourceStateVersionQuery {
+	query := (&ResourceStateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(resourcestateversion.Table, resourcestateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resource.StateVersionsTable, resource.StateVersionsColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStateLock queries the state_lock edge of a Resource.
+func (c *ResourceClient) QueryStateLock(r *Resource) *ResourceStateLockQuery {
+	query := (&ResourceStateLockClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.To(resourcestatelock.Table, resourcestatelock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, resource.StateLockTable, resource.StateLockColumn),
+		)
+		schemaConfig := r.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateLock
+		step.Edge.Schema = schemaConfig.ResourceStateLock
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResourceClient) Hooks() []Hook {
+	hooks := c.hooks.Resource
+	return append(hooks[:len(hooks):len(hooks)], resource.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResourceClient) Interceptors() []Interceptor {
+	inters := c.inters.Resource
+	return append(inters[:len(inters):len(inters)], resource.Interceptors[:]...)
+}
+
+func (c *ResourceClient) mutate(ctx context.Context, m *ResourceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown Resource mutation op: %q", m.Op())
+	}
+}
+
+// ResourceComponentClient is a client for the ResourceComponent schema.
+type ResourceComponentClient struct {
+	config
+}
+
+// NewResourceComponentClient returns a client for the ResourceComponent from the given config.
+func NewResourceComponentClient(c config) *ResourceComponentClient {
+	return &ResourceComponentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resourcecomponent.Hooks(f(g(h())))`.
+func (c *ResourceComponentClient) Use(hooks ...Hook) {
+	c.hooks.ResourceComponent = append(c.hooks.ResourceComponent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resourcecomponent.Intercept(f(g(h())))`.
+func (c *ResourceComponentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceComponent = append(c.inters.ResourceComponent, interceptors...)
+}
+
+// Create returns a builder for creating a ResourceComponent entity.
+func (c *ResourceComponentClient) Create() *ResourceComponentCreate {
+	mutation := newResourceComponentMutation(c.config, OpCreate)
+	return &ResourceComponentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResourceComponent entities.
+func (c *ResourceComponentClient) CreateBulk(builders ...*ResourceComponentCreate) *ResourceComponentCreateBulk {
+	return &ResourceComponentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResourceComponentClient) MapCreateBulk(slice any, setFunc func(*ResourceComponentCreate, int)) *ResourceComponentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResourceComponentCreateBulk{err: fmt.Errorf("calling to ResourceComponentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResourceComponentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResourceComponentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResourceComponent.
+func (c *ResourceComponentClient) Update() *ResourceComponentUpdate {
+	mutation := newResourceComponentMutation(c.config, OpUpdate)
+	return &ResourceComponentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceComponentClient) UpdateOne(rc *ResourceComponent) *ResourceComponentUpdateOne {
+	mutation := newResourceComponentMutation(c.config, OpUpdateOne, withResourceComponent(rc))
+	return &ResourceComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceComponentClient) UpdateOneID(id object.ID) *ResourceComponentUpdateOne {
+	mutation := newResourceComponentMutation(c.config, OpUpdateOne, withResourceComponentID(id))
+	return &ResourceComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResourceComponent.
+func (c *ResourceComponentClient) Delete() *ResourceComponentDelete {
+	mutation := newResourceComponentMutation(c.config, OpDelete)
+	return &ResourceComponentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResourceComponentClient) DeleteOne(rc *ResourceComponent) *ResourceComponentDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResourceComponentClient) DeleteOneID(id object.ID) *ResourceComponentDeleteOne {
+	builder := c.Delete().Where(resourcecomponent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceComponentDeleteOne{builder}
+}
+
+// Query returns a query builder for ResourceComponent.
+func (c *ResourceComponentClient) Query() *ResourceComponentQuery {
+	return &ResourceComponentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResourceComponent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResourceComponent entity by its id.
+func (c *ResourceComponentClient) Get(ctx context.Context, id object.ID) (*ResourceComponent, error) {
+	return c.Query().Where(resourcecomponent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResourceComponentClient) GetX(ctx context.Context, id object.ID) *ResourceComponent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryProject(rc *ResourceComponent) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.ProjectTable, resourcecomponent.ProjectColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvironment queries the environment edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryEnvironment(rc *ResourceComponent) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.EnvironmentTable, resourcecomponent.EnvironmentColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResource queries the resource edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryResource(rc *ResourceComponent) *ResourceQuery {
+	query := (&ResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.ResourceTable, resourcecomponent.ResourceColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConnector queries the connector edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryConnector(rc *ResourceComponent) *ConnectorQuery {
+	query := (&ConnectorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(connector.Table, connector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.ConnectorTable, resourcecomponent.ConnectorColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.Connector
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComposition queries the composition edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryComposition(rc *ResourceComponent) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.CompositionTable, resourcecomponent.CompositionColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryComponents queries the components edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryComponents(rc *ResourceComponent) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resourcecomponent.ComponentsTable, resourcecomponent.ComponentsColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClass queries the class edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryClass(rc *ResourceComponent) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.ClassTable, resourcecomponent.ClassColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInstances queries the instances edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryInstances(rc *ResourceComponent) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resourcecomponent.InstancesTable, resourcecomponent.InstancesColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponent
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDependencies queries the dependencies edge of a ResourceComponent.
+func (c *ResourceComponentClient) QueryDependencies(rc *ResourceComponent) *ResourceComponentRelationshipQuery {
+	query := (&ResourceComponentRelationshipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
+			sqlgraph.To(resourcecomponentrelationship.Table, resourcecomponentrelationship.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, resourcecomponent.DependenciesTable, resourcecomponent.DependenciesColumn),
+		)
+		schemaConfig := rc.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponentRelationship
+		step.Edge.Schema = schemaConfig.ResourceComponentRelationship
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ResourceComponentClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceComponent
+	return append(hooks[:len(hooks):len(hooks)], resourcecomponent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResourceComponentClient) Interceptors() []Interceptor {
+	inters := c.inters.ResourceComponent
+	return append(inters[:len(inters):len(inters)], resourcecomponent.Interceptors[:]...)
+}
+
+func (c *ResourceComponentClient) mutate(ctx context.Context, m *ResourceComponentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResourceComponentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResourceComponentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResourceComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResourceComponentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown ResourceComponent mutation op: %q", m.Op())
+	}
+}
+
+// ResourceComponentRelationshipClient is a client for the ResourceComponentRelationship schema.
+type ResourceComponentRelationshipClient struct {
+	config
+}
+
+// NewResourceComponentRelationshipClient returns a client for the ResourceComponentRelationship from the given config.
+func NewResourceComponentRelationshipClient(c config) *ResourceComponentRelationshipClient {
+	return &ResourceComponentRelationshipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resourcecomponentrelationship.Hooks(f(g(h())))`.
+func (c *ResourceComponentRelationshipClient) Use(hooks ...Hook) {
+	c.hooks.ResourceComponentRelationship = append(c.hooks.ResourceComponentRelationship, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resourcecomponentrelationship.Intercept(f(g(h())))`.
+func (c *ResourceComponentRelationshipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceComponentRelationship = append(c.inters.ResourceComponentRelationship, interceptors...)
+}
+
+// Create returns a builder for creating a ResourceComponentRelationship entity.
+func (c *ResourceComponentRelationshipClient) Create() *ResourceComponentRelationshipCreate {
+	mutation := newResourceComponentRelationshipMutation(c.config, OpCreate)
+	return &ResourceComponentRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResourceComponentRelationship entities.
+func (c *ResourceComponentRelationshipClient) CreateBulk(builders ...*ResourceComponentRelationshipCreate) *ResourceComponentRelationshipCreateBulk {
+	return &ResourceComponentRelationshipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResourceComponentRelationshipClient) MapCreateBulk(slice any, setFunc func(*ResourceComponentRelationshipCreate, int)) *ResourceComponentRelationshipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResourceComponentRelationshipCreateBulk{err: fmt.Errorf("calling to ResourceComponentRelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return obj
+	builders := make([]*ResourceComponentRelationshipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResourceComponentRelationshipCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryEnvironments queries the environments edge of a Project.
-func (c *ProjectClient) QueryEnvironments(pr *Project) *EnvironmentQuery {
-	query := (&EnvironmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(environment.Table, environment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.EnvironmentsTable, project.EnvironmentsColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.Environment
-		step.Edge.Schema = schemaConfig.Environment
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Update returns an update builder for ResourceComponentRelationship.
+func (c *ResourceComponentRelationshipClient) Update() *ResourceComponentRelationshipUpdate {
+	mutation := newResourceComponentRelationshipMutation(c.config, OpUpdate)
+	return &ResourceComponentRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryConnectors queries the connectors edge of a Project.
-func (c *ProjectClient) QueryConnectors(pr *Project) *ConnectorQuery {
-	query := (&ConnectorClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(connector.Table, connector.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.ConnectorsTable, project.ConnectorsColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.Connector
-		step.Edge.Schema = schemaConfig.Connector
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceComponentRelationshipClient) UpdateOne(rcr *ResourceComponentRelationship) *ResourceComponentRelationshipUpdateOne {
+	mutation := newResourceComponentRelationshipMutation(c.config, OpUpdateOne, withResourceComponentRelationship(rcr))
+	return &ResourceComponentRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceComponentRelationshipClient) UpdateOneID(id object.ID) *ResourceComponentRelationshipUpdateOne {
+	mutation := newResourceComponentRelationshipMutation(c.config, OpUpdateOne, withResourceComponentRelationshipID(id))
+	return &ResourceComponentRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResourceComponentRelationship.
+func (c *ResourceComponentRelationshipClient) Delete() *ResourceComponentRelationshipDelete {
+	mutation := newResourceComponentRelationshipMutation(c.config, OpDelete)
+	return &ResourceComponentRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResourceComponentRelationshipClient) DeleteOne(rcr *ResourceComponentRelationship) *ResourceComponentRelationshipDeleteOne {
+	return c.DeleteOneID(rcr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResourceComponentRelationshipClient) DeleteOneID(id object.ID) *ResourceComponentRelationshipDeleteOne {
+	builder := c.Delete().Where(resourcecomponentrelationship.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceComponentRelationshipDeleteOne{builder}
+}
+
+// Query returns a query builder for ResourceComponentRelationship.
+func (c *ResourceComponentRelationshipClient) Query() *ResourceComponentRelationshipQuery {
+	return &ResourceComponentRelationshipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResourceComponentRelationship},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QuerySubjectRoles queries the subject_roles edge of a Project.
-func (c *ProjectClient) QuerySubjectRoles(pr *Project) *SubjectRoleRelationshipQuery {
-	query := (&SubjectRoleRelationshipClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(subjectrolerelationship.Table, subjectrolerelationship.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.SubjectRolesTable, project.SubjectRolesColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.SubjectRoleRelationship
-		step.Edge.Schema = schemaConfig.SubjectRoleRelationship
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a ResourceComponentRelationship entity by its id.
+func (c *ResourceComponentRelationshipClient) Get(ctx context.Context, id object.ID) (*ResourceComponentRelationship, error) {
+	return c.Query().Where(resourcecomponentrelationship.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResourceComponentRelationshipClient) GetX(ctx context.Context, id object.ID) *ResourceComponentRelationship {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryResources queries the resources edge of a Project.
-func (c *ProjectClient) QueryResources(pr *Project) *ResourceQuery {
-	query := (&ResourceClient{config: c.config}).Query()
+// QueryResourceComponent queries the resource_component edge of a ResourceComponentRelationship.
+func (c *ResourceComponentRelationshipClient) QueryResourceComponent(rcr *ResourceComponentRelationship) *ResourceComponentQuery {
+	query := (&ResourceComponentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := rcr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourcesTable, project.ResourcesColumn),
+			sqlgraph.From(resourcecomponentrelationship.Table, resourcecomponentrelationship.FieldID, id),
+			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, resourcecomponentrelationship.ResourceComponentTable, resourcecomponentrelationship.ResourceComponentColumn),
 		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		schemaConfig := rcr.schemaConfig
+		step.To.Schema = schemaConfig.ResourceComponent
+		step.Edge.Schema = schemaConfig.ResourceComponentRelationship
+		fromV = sqlgraph.Neighbors(rcr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryResourceComponents queries the resource_components edge of a Project.
-func (c *ProjectClient) QueryResourceComponents(pr *Project) *ResourceComponentQuery {
+// QueryDependency queries the dependency edge of a ResourceComponentRelationship.
+func (c *ResourceComponentRelationshipClient) QueryDependency(rcr *ResourceComponentRelationship) *ResourceComponentQuery {
 	query := (&ResourceComponentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := rcr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.From(resourcecomponentrelationship.Table, resourcecomponentrelationship.FieldID, id),
 			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceComponentsTable, project.ResourceComponentsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, resourcecomponentrelationship.DependencyTable, resourcecomponentrelationship.DependencyColumn),
 		)
-		schemaConfig := pr.schemaConfig
+		schemaConfig := rcr.schemaConfig
 		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		step.Edge.Schema = schemaConfig.ResourceComponentRelationship
+		fromV = sqlgraph.Neighbors(rcr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryResourceRuns queries the resource_runs edge of a Project.
-func (c *ProjectClient) QueryResourceRuns(pr *Project) *ResourceRunQuery {
-	query := (&ResourceRunClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(resourcerun.Table, resourcerun.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceRunsTable, project.ResourceRunsColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.ResourceRun
-		step.Edge.Schema = schemaConfig.ResourceRun
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *ResourceComponentRelationshipClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceComponentRelationship
+	return append(hooks[:len(hooks):len(hooks)], resourcecomponentrelationship.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResourceComponentRelationshipClient) Interceptors() []Interceptor {
+	return c.inters.ResourceComponentRelationship
+}
+
+func (c *ResourceComponentRelationshipClient) mutate(ctx context.Context, m *ResourceComponentRelationshipMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResourceComponentRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResourceComponentRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResourceComponentRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResourceComponentRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown ResourceComponentRelationship mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryVariables queries the variables edge of a Project.
-func (c *ProjectClient) QueryVariables(pr *Project) *VariableQuery {
-	query := (&VariableClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(variable.Table, variable.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.VariablesTable, project.VariablesColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.Variable
-		step.Edge.Schema = schemaConfig.Variable
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// ResourceDefinitionClient is a client for the ResourceDefinition schema.
+type ResourceDefinitionClient struct {
+	config
+}
+
+// NewResourceDefinitionClient returns a client for the ResourceDefinition from the given config.
+func NewResourceDefinitionClient(c config) *ResourceDefinitionClient {
+	return &ResourceDefinitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `resourcedefinition.Hooks(f(g(h())))`.
+func (c *ResourceDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.ResourceDefinition = append(c.hooks.ResourceDefinition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `resourcedefinition.Intercept(f(g(h())))`.
+func (c *ResourceDefinitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceDefinition = append(c.inters.ResourceDefinition, interceptors...)
+}
+
+// Create returns a builder for creating a ResourceDefinition entity.
+func (c *ResourceDefinitionClient) Create() *ResourceDefinitionCreate {
+	mutation := newResourceDefinitionMutation(c.config, OpCreate)
+	return &ResourceDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResourceDefinition entities.
+func (c *ResourceDefinitionClient) CreateBulk(builders ...*ResourceDefinitionCreate) *ResourceDefinitionCreateBulk {
+	return &ResourceDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResourceDefinitionClient) MapCreateBulk(slice any, setFunc func(*ResourceDefinitionCreate, int)) *ResourceDefinitionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResourceDefinitionCreateBulk{err: fmt.Errorf("calling to ResourceDefinitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*ResourceDefinitionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResourceDefinitionCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryTemplates queries the templates edge of a Project.
-func (c *ProjectClient) QueryTemplates(pr *Project) *TemplateQuery {
-	query := (&TemplateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(template.Table, template.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.TemplatesTable, project.TemplatesColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.Template
-		step.Edge.Schema = schemaConfig.Template
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Update returns an update builder for ResourceDefinition.
+func (c *ResourceDefinitionClient) Update() *ResourceDefinitionUpdate {
+	mutation := newResourceDefinitionMutation(c.config, OpUpdate)
+	return &ResourceDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryTemplateVersions queries the template_versions edge of a Project.
-func (c *ProjectClient) QueryTemplateVersions(pr *Project) *TemplateVersionQuery {
-	query := (&TemplateVersionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(templateversion.Table, templateversion.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.TemplateVersionsTable, project.TemplateVersionsColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.TemplateVersion
-		step.Edge.Schema = schemaConfig.TemplateVersion
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// UpdateOne returns an update builder for the given entity.
+func (c *ResourceDefinitionClient) UpdateOne(rd *ResourceDefinition) *ResourceDefinitionUpdateOne {
+	mutation := newResourceDefinitionMutation(c.config, OpUpdateOne, withResourceDefinition(rd))
+	return &ResourceDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryCatalogs queries the catalogs edge of a Project.
-func (c *ProjectClient) QueryCatalogs(pr *Project) *CatalogQuery {
-	query := (&CatalogClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(catalog.Table, catalog.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.CatalogsTable, project.CatalogsColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.Catalog
-		step.Edge.Schema = schemaConfig.Catalog
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// UpdateOneID returns an update builder for the given id.
+func (c *ResourceDefinitionClient) UpdateOneID(id object.ID) *ResourceDefinitionUpdateOne {
+	mutation := newResourceDefinitionMutation(c.config, OpUpdateOne, withResourceDefinitionID(id))
+	return &ResourceDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryWorkflows queries the workflows edge of a Project.
-func (c *ProjectClient) QueryWorkflows(pr *Project) *WorkflowQuery {
-	query := (&WorkflowClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(workflow.Table, workflow.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowsTable, project.WorkflowsColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.Workflow
-		step.Edge.Schema = schemaConfig.Workflow
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Delete returns a delete builder for ResourceDefinition.
+func (c *ResourceDefinitionClient) Delete() *ResourceDefinitionDelete {
+	mutation := newResourceDefinitionMutation(c.config, OpDelete)
+	return &ResourceDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// QueryWorkflowStages queries the workflow_stages edge of a Project.
-func (c *ProjectClient) QueryWorkflowStages(pr *Project) *WorkflowStageQuery {
-	query := (&WorkflowStageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(workflowstage.Table, workflowstage.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStagesTable, project.WorkflowStagesColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.WorkflowStage
-		step.Edge.Schema = schemaConfig.WorkflowStage
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResourceDefinitionClient) DeleteOne(rd *ResourceDefinition) *ResourceDefinitionDeleteOne {
+	return c.DeleteOneID(rd.ID)
 }
 
-// QueryWorkflowSteps queries the workflow_steps edge of a Project.
-func (c *ProjectClient) QueryWorkflowSteps(pr *Project) *WorkflowStepQuery {
-	query := (&WorkflowStepClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(workflowstep.Table, workflowstep.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStepsTable, project.WorkflowStepsColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.WorkflowStep
-		step.Edge.Schema = schemaConfig.WorkflowStep
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResourceDefinitionClient) DeleteOneID(id object.ID) *ResourceDefinitionDeleteOne {
+	builder := c.Delete().Where(resourcedefinition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResourceDefinitionDeleteOne{builder}
+}
+
+// Query returns a query builder for ResourceDefinition.
+func (c *ResourceDefinitionClient) Query() *ResourceDefinitionQuery {
+	return &ResourceDefinitionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResourceDefinition},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryWorkflowExecutions queries the workflow_executions edge of a Project.
-func (c *ProjectClient) QueryWorkflowExecutions(pr *Project) *WorkflowExecutionQuery {
-	query := (&WorkflowExecutionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowExecutionsTable, project.WorkflowExecutionsColumn),
-		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.WorkflowExecution
-		step.Edge.Schema = schemaConfig.WorkflowExecution
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a ResourceDefinition entity by its id.
+func (c *ResourceDefinitionClient) Get(ctx context.Context, id object.ID) (*ResourceDefinition, error) {
+	return c.Query().Where(resourcedefinition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResourceDefinitionClient) GetX(ctx context.Context, id object.ID) *ResourceDefinition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryWorkflowStageExecutions queries the workflow_stage_executions edge of a Project.
-func (c *ProjectClient) QueryWorkflowStageExecutions(pr *Project) *WorkflowStageExecutionQuery {
-	query := (&WorkflowStageExecutionClient{config: c.config}).Query()
+// QueryMatchingRules queries the matching_rules edge of a ResourceDefinition.
+func (c *ResourceDefinitionClient) QueryMatchingRules(rd *ResourceDefinition) *ResourceDefinitionMatchingRuleQuery {
+	query := (&ResourceDefinitionMatchingRuleClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := rd.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(workflowstageexecution.Table, workflowstageexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStageExecutionsTable, project.WorkflowStageExecutionsColumn),
+			sqlgraph.From(resourcedefinition.Table, resourcedefinition.FieldID, id),
+			sqlgraph.To(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, resourcedefinition.MatchingRulesTable, resourcedefinition.MatchingRulesColumn),
 		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.WorkflowStageExecution
-		step.Edge.Schema = schemaConfig.WorkflowStageExecution
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		schemaConfig := rd.schemaConfig
+		step.To.Schema = schemaConfig.ResourceDefinitionMatchingRule
+		step.Edge.Schema = schemaConfig.ResourceDefinitionMatchingRule
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryWorkflowStepExecutions queries the workflow_step_executions edge of a Project.
-func (c *ProjectClient) QueryWorkflowStepExecutions(pr *Project) *WorkflowStepExecutionQuery {
-	query := (&WorkflowStepExecutionClient{config: c.config}).Query()
+// QueryResources queries the resources edge of a ResourceDefinition.
+func (c *ResourceDefinitionClient) QueryResources(rd *ResourceDefinition) *ResourceQuery {
+	query := (&ResourceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pr.ID
+		id := rd.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(workflowstepexecution.Table, workflowstepexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.WorkflowStepExecutionsTable, project.WorkflowStepExecutionsColumn),
+			sqlgraph.From(resourcedefinition.Table, resourcedefinition.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resourcedefinition.ResourcesTable, resourcedefinition.ResourcesColumn),
 		)
-		schemaConfig := pr.schemaConfig
-		step.To.Schema = schemaConfig.WorkflowStepExecution
-		step.Edge.Schema = schemaConfig.WorkflowStepExecution
-		fromV = sqlgraph.Neighbors(pr.driver.Dialect(), step)
+		schemaConfig := rd.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.Resource
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ProjectClient) Hooks() []Hook {
-	hooks := c.hooks.Project
-	return append(hooks[:len(hooks):len(hooks)], project.Hooks[:]...)
+func (c *ResourceDefinitionClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceDefinition
+	return append(hooks[:len(hooks):len(hooks)], resourcedefinition.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProjectClient) Interceptors() []Interceptor {
-	inters := c.inters.Project
-	return append(inters[:len(inters):len(inters)], project.Interceptors[:]...)
+func (c *ResourceDefinitionClient) Interceptors() []Interceptor {
+	return c.inters.ResourceDefinition
 }
 
-func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+func (c *ResourceDefinitionClient) mutate(ctx context.Context, m *ResourceDefinitionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown Project mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceDefinition mutation op: %q", m.Op())
 	}
 }
 
-// ResourceClient is a client for the Resource schema.
-type ResourceClient struct {
+// ResourceDefinitionMatchingRuleClient is a client for the ResourceDefinitionMatchingRule schema.
+type ResourceDefinitionMatchingRuleClient struct {
 	config
 }
 
-// NewResourceClient returns a client for the Resource from the given config.
-func NewResourceClient(c config) *ResourceClient {
-	return &ResourceClient{config: c}
+// NewResourceDefinitionMatchingRuleClient returns a client for the ResourceDefinitionMatchingRule from the given config.
+func NewResourceDefinitionMatchingRuleClient(c config) *ResourceDefinitionMatchingRuleClient {
+	return &ResourceDefinitionMatchingRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resource.Hooks(f(g(h())))`.
-func (c *ResourceClient) Use(hooks ...Hook) {
-	c.hooks.Resource = append(c.hooks.Resource, hooks...)
+// A call to `Use(f, g, h)` equals to `resourcedefinitionmatchingrule.Hooks(f(g(h())))`.
+func (c *ResourceDefinitionMatchingRuleClient) Use(hooks ...Hook) {
+	c.hooks.ResourceDefinitionMatchingRule = append(c.hooks.ResourceDefinitionMatchingRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resource.Intercept(f(g(h())))`.
-func (c *ResourceClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Resource = append(c.inters.Resource, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `resourcedefinitionmatchingrule.Intercept(f(g(h())))`.
+func (c *ResourceDefinitionMatchingRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceDefinitionMatchingRule = append(c.inters.ResourceDefinitionMatchingRule, interceptors...)
 }
 
-// Create returns a builder for creating a Resource entity.
-func (c *ResourceClient) Create() *ResourceCreate {
-	mutation := newResourceMutation(c.config, OpCreate)
-	return &ResourceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResourceDefinitionMatchingRule entity.
+func (c *ResourceDefinitionMatchingRuleClient) Create() *ResourceDefinitionMatchingRuleCreate {
+	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpCreate)
+	return &ResourceDefinitionMatchingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Resource entities.
-func (c *ResourceClient) CreateBulk(builders ...*ResourceCreate) *ResourceCreateBulk {
-	return &ResourceCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResourceDefinitionMatchingRule entities.
+func (c *ResourceDefinitionMatchingRuleClient) CreateBulk(builders ...*ResourceDefinitionMatchingRuleCreate) *ResourceDefinitionMatchingRuleCreateBulk {
+	return &ResourceDefinitionMatchingRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceClient) MapCreateBulk(slice any, setFunc func(*ResourceCreate, int)) *ResourceCreateBulk {
+func (c *ResourceDefinitionMatchingRuleClient) MapCreateBulk(slice any, setFunc func(*ResourceDefinitionMatchingRuleCreate, int)) *ResourceDefinitionMatchingRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceCreateBulk{err: fmt.Errorf("calling to ResourceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResourceDefinitionMatchingRuleCreateBulk{err: fmt.Errorf("calling to ResourceDefinitionMatchingRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceCreate, rv.Len())
+	builders := make([]*ResourceDefinitionMatchingRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceCreateBulk{config: c.config, builders: builders}
+	return &ResourceDefinitionMatchingRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Resource.
-func (c *ResourceClient) Update() *ResourceUpdate {
-	mutation := newResourceMutation(c.config, OpUpdate)
-	return &ResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResourceDefinitionMatchingRule.
+func (c *ResourceDefinitionMatchingRuleClient) Update() *ResourceDefinitionMatchingRuleUpdate {
+	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpUpdate)
+	return &ResourceDefinitionMatchingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceClient) UpdateOne(r *Resource) *ResourceUpdateOne {
-	mutation := newResourceMutation(c.config, OpUpdateOne, withResource(r))
-	return &ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceDefinitionMatchingRuleClient) UpdateOne(rdmr *ResourceDefinitionMatchingRule) *ResourceDefinitionMatchingRuleUpdateOne {
+	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpUpdateOne, withResourceDefinitionMatchingRule(rdmr))
+	return &ResourceDefinitionMatchingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceClient) UpdateOneID(id object.ID) *ResourceUpdateOne {
-	mutation := newResourceMutation(c.config, OpUpdateOne, withResourceID(id))
-	return &ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceDefinitionMatchingRuleClient) UpdateOneID(id object.ID) *ResourceDefinitionMatchingRuleUpdateOne {
+	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpUpdateOne, withResourceDefinitionMatchingRuleID(id))
+	return &ResourceDefinitionMatchingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Resource.
-func (c *ResourceClient) Delete() *ResourceDelete {
-	mutation := newResourceMutation(c.config, OpDelete)
-	return &ResourceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResourceDefinitionMatchingRule.
+func (c *ResourceDefinitionMatchingRuleClient) Delete() *ResourceDefinitionMatchingRuleDelete {
+	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpDelete)
+	return &ResourceDefinitionMatchingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceClient) DeleteOne(r *Resource) *ResourceDeleteOne {
-	return c.DeleteOneID(r.ID)
+func (c *ResourceDefinitionMatchingRuleClient) DeleteOne(rdmr *ResourceDefinitionMatchingRule) *ResourceDefinitionMatchingRuleDeleteOne {
+	return c.DeleteOneID(rdmr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceClient) DeleteOneID(id object.ID) *ResourceDeleteOne {
-	builder := c.Delete().Where(resource.ID(id))
+func (c *ResourceDefinitionMatchingRuleClient) DeleteOneID(id object.ID) *ResourceDefinitionMatchingRuleDeleteOne {
+	builder := c.Delete().Where(resourcedefinitionmatchingrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceDeleteOne{builder}
+	return &ResourceDefinitionMatchingRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for Resource.
-func (c *ResourceClient) Query() *ResourceQuery {
-	return &ResourceQuery{
+// Query returns a query builder for ResourceDefinitionMatchingRule.
+func (c *ResourceDefinitionMatchingRuleClient) Query() *ResourceDefinitionMatchingRuleQuery {
+	return &ResourceDefinitionMatchingRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResource},
+		ctx:    &QueryContext{Type: TypeResourceDefinitionMatchingRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Resource entity by its id.
-func (c *ResourceClient) Get(ctx context.Context, id object.ID) (*Resource, error) {
-	return c.Query().Where(resource.ID(id)).Only(ctx)
+// Get returns a ResourceDefinitionMatchingRule entity by its id.
+func (c *ResourceDefinitionMatchingRuleClient) Get(ctx context.Context, id object.ID) (*ResourceDefinitionMatchingRule, error) {
+	return c.Query().Where(resourcedefinitionmatchingrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceClient) GetX(ctx context.Context, id object.ID) *Resource {
+func (c *ResourceDefinitionMatchingRuleClient) GetX(ctx context.Context, id object.ID) *ResourceDefinitionMatchingRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2427,305 +4721,190 @@ func (c *ResourceClient) GetX(ctx context.Context, id object.ID) *Resource {
 	return obj
 }
 
-// QueryProject queries the project edge of a Resource.
-func (c *ResourceClient) QueryProject(r *Resource) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resource.ProjectTable, resource.ProjectColumn),
-		)
-		schemaConfig := r.schemaConfig
-		step.To.Schema = schemaConfig.Project
-		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEnvironment queries the environment edge of a Resource.
-func (c *ResourceClient) QueryEnvironment(r *Resource) *EnvironmentQuery {
-	query := (&EnvironmentClient{config: c.config}).Query()
+// QueryResourceDefinition queries the resource_definition edge of a ResourceDefinitionMatchingRule.
+func (c *ResourceDefinitionMatchingRuleClient) QueryResourceDefinition(rdmr *ResourceDefinitionMatchingRule) *ResourceDefinitionQuery {
+	query := (&ResourceDefinitionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := rdmr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
-			sqlgraph.To(environment.Table, environment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resource.EnvironmentTable, resource.EnvironmentColumn),
+			sqlgraph.From(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID, id),
+			sqlgraph.To(resourcedefinition.Table, resourcedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, resourcedefinitionmatchingrule.ResourceDefinitionTable, resourcedefinitionmatchingrule.ResourceDefinitionColumn),
 		)
-		schemaConfig := r.schemaConfig
-		step.To.Schema = schemaConfig.Environment
-		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		schemaConfig := rdmr.schemaConfig
+		step.To.Schema = schemaConfig.ResourceDefinition
+		step.Edge.Schema = schemaConfig.ResourceDefinitionMatchingRule
+		fromV = sqlgraph.Neighbors(rdmr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryTemplate queries the template edge of a Resource.
-func (c *ResourceClient) QueryTemplate(r *Resource) *TemplateVersionQuery {
+// QueryTemplate queries the template edge of a ResourceDefinitionMatchingRule.
+func (c *ResourceDefinitionMatchingRuleClient) QueryTemplate(rdmr *ResourceDefinitionMatchingRule) *TemplateVersionQuery {
 	query := (&TemplateVersionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := rdmr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
+			sqlgraph.From(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID, id),
 			sqlgraph.To(templateversion.Table, templateversion.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resource.TemplateTable, resource.TemplateColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, resourcedefinitionmatchingrule.TemplateTable, resourcedefinitionmatchingrule.TemplateColumn),
 		)
-		schemaConfig := r.schemaConfig
+		schemaConfig := rdmr.schemaConfig
 		step.To.Schema = schemaConfig.TemplateVersion
-		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryResourceDefinition queries the resource_definition edge of a Resource.
-func (c *ResourceClient) QueryResourceDefinition(r *Resource) *ResourceDefinitionQuery {
-	query := (&ResourceDefinitionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
-			sqlgraph.To(resourcedefinition.Table, resourcedefinition.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resource.ResourceDefinitionTable, resource.ResourceDefinitionColumn),
-		)
-		schemaConfig := r.schemaConfig
-		step.To.Schema = schemaConfig.ResourceDefinition
-		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		step.Edge.Schema = schemaConfig.ResourceDefinitionMatchingRule
+		fromV = sqlgraph.Neighbors(rdmr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryResourceDefinitionMatchingRule queries the resource_definition_matching_rule edge of a Resource.
-func (c *ResourceClient) QueryResourceDefinitionMatchingRule(r *Resource) *ResourceDefinitionMatchingRuleQuery {
-	query := (&ResourceDefinitionMatchingRuleClient{config: c.config}).Query()
+// QueryResources queries the resources edge of a ResourceDefinitionMatchingRule.
+func (c *ResourceDefinitionMatchingRuleClient) QueryResources(rdmr *ResourceDefinitionMatchingRule) *ResourceQuery {
+	query := (&ResourceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
+		id := rdmr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
-			sqlgraph.To(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resource.ResourceDefinitionMatchingRuleTable, resource.ResourceDefinitionMatchingRuleColumn),
+			sqlgraph.From(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resourcedefinitionmatchingrule.ResourcesTable, resourcedefinitionmatchingrule.ResourcesColumn),
 		)
-		schemaConfig := r.schemaConfig
-		step.To.Schema = schemaConfig.ResourceDefinitionMatchingRule
+		schemaConfig := rdmr.schemaConfig
+		step.To.Schema = schemaConfig.Resource
 		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryRuns queries the runs edge of a Resource.
-func (c *ResourceClient) QueryRuns(r *Resource) *ResourceRunQuery {
-	query := (&ResourceRunClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
-			sqlgraph.To(resourcerun.Table, resourcerun.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, resource.RunsTable, resource.RunsColumn),
-		)
-		schemaConfig := r.schemaConfig
-		step.To.Schema = schemaConfig.ResourceRun
-		step.Edge.Schema = schemaConfig.ResourceRun
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryComponents queries the components edge of a Resource.
-func (c *ResourceClient) QueryComponents(r *Resource) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, resource.ComponentsTable, resource.ComponentsColumn),
-		)
-		schemaConfig := r.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDependencies queries the dependencies edge of a Resource.
-func (c *ResourceClient) QueryDependencies(r *Resource) *ResourceRelationshipQuery {
-	query := (&ResourceRelationshipClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
-			sqlgraph.To(resourcerelationship.Table, resourcerelationship.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, resource.DependenciesTable, resource.DependenciesColumn),
-		)
-		schemaConfig := r.schemaConfig
-		step.To.Schema = schemaConfig.ResourceRelationship
-		step.Edge.Schema = schemaConfig.ResourceRelationship
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryState queries the state edge of a Resource.
-func (c *ResourceClient) QueryState(r *Resource) *ResourceStateQuery {
-	query := (&ResourceStateClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := r.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resource.Table, resource.FieldID, id),
-			sqlgraph.To(resourcestate.Table, resourcestate.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, resource.StateTable, resource.StateColumn),
-		)
-		schemaConfig := r.schemaConfig
-		step.To.Schema = schemaConfig.ResourceState
-		step.Edge.Schema = schemaConfig.ResourceState
-		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(rdmr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResourceClient) Hooks() []Hook {
-	hooks := c.hooks.Resource
-	return append(hooks[:len(hooks):len(hooks)], resource.Hooks[:]...)
+func (c *ResourceDefinitionMatchingRuleClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceDefinitionMatchingRule
+	return append(hooks[:len(hooks):len(hooks)], resourcedefinitionmatchingrule.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceClient) Interceptors() []Interceptor {
-	inters := c.inters.Resource
-	return append(inters[:len(inters):len(inters)], resource.Interceptors[:]...)
+func (c *ResourceDefinitionMatchingRuleClient) Interceptors() []Interceptor {
+	return c.inters.ResourceDefinitionMatchingRule
 }
 
-func (c *ResourceClient) mutate(ctx context.Context, m *ResourceMutation) (Value, error) {
+func (c *ResourceDefinitionMatchingRuleClient) mutate(ctx context.Context, m *ResourceDefinitionMatchingRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceDefinitionMatchingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceDefinitionMatchingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceDefinitionMatchingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceDefinitionMatchingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown Resource mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceDefinitionMatchingRule mutation op: %q", m.Op())
 	}
 }
 
-// ResourceComponentClient is a client for the ResourceComponent schema.
-type ResourceComponentClient struct {
+// ResourceRelationshipClient is a client for the ResourceRelationship schema.
+type ResourceRelationshipClient struct {
 	config
 }
 
-// NewResourceComponentClient returns a client for the ResourceComponent from the given config.
-func NewResourceComponentClient(c config) *ResourceComponentClient {
-	return &ResourceComponentClient{config: c}
+// NewResourceRelationshipClient returns a client for the ResourceRelationship from the given config.
+func NewResourceRelationshipClient(c config) *ResourceRelationshipClient {
+	return &ResourceRelationshipClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resourcecomponent.Hooks(f(g(h())))`.
-func (c *ResourceComponentClient) Use(hooks ...Hook) {
-	c.hooks.ResourceComponent = append(c.hooks.ResourceComponent, hooks...)
+// A call to `Use(f, g, h)` equals to `resourcerelationship.Hooks(f(g(h())))`.
+func (c *ResourceRelationshipClient) Use(hooks ...Hook) {
+	c.hooks.ResourceRelationship = append(c.hooks.ResourceRelationship, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resourcecomponent.Intercept(f(g(h())))`.
-func (c *ResourceComponentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ResourceComponent = append(c.inters.ResourceComponent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `resourcerelationship.Intercept(f(g(h())))`.
+func (c *ResourceRelationshipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceRelationship = append(c.inters.ResourceRelationship, interceptors...)
 }
 
-// Create returns a builder for creating a ResourceComponent entity.
-func (c *ResourceComponentClient) Create() *ResourceComponentCreate {
-	mutation := newResourceComponentMutation(c.config, OpCreate)
-	return &ResourceComponentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResourceRelationship entity.
+func (c *ResourceRelationshipClient) Create() *ResourceRelationshipCreate {
+	mutation := newResourceRelationshipMutation(c.config, OpCreate)
+	return &ResourceRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ResourceComponent entities.
-func (c *ResourceComponentClient) CreateBulk(builders ...*ResourceComponentCreate) *ResourceComponentCreateBulk {
-	return &ResourceComponentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResourceRelationship entities.
+func (c *ResourceRelationshipClient) CreateBulk(builders ...*ResourceRelationshipCreate) *ResourceRelationshipCreateBulk {
+	return &ResourceRelationshipCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceComponentClient) MapCreateBulk(slice any, setFunc func(*ResourceComponentCreate, int)) *ResourceComponentCreateBulk {
+func (c *ResourceRelationshipClient) MapCreateBulk(slice any, setFunc func(*ResourceRelationshipCreate, int)) *ResourceRelationshipCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceComponentCreateBulk{err: fmt.Errorf("calling to ResourceComponentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResourceRelationshipCreateBulk{err: fmt.Errorf("calling to ResourceRelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceComponentCreate, rv.Len())
+	builders := make([]*ResourceRelationshipCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceComponentCreateBulk{config: c.config, builders: builders}
+	return &ResourceRelationshipCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ResourceComponent.
-func (c *ResourceComponentClient) Update() *ResourceComponentUpdate {
-	mutation := newResourceComponentMutation(c.config, OpUpdate)
-	return &ResourceComponentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResourceRelationship.
+func (c *ResourceRelationshipClient) Update() *ResourceRelationshipUpdate {
+	mutation := newResourceRelationshipMutation(c.config, OpUpdate)
+	return &ResourceRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceComponentClient) UpdateOne(rc *ResourceComponent) *ResourceComponentUpdateOne {
-	mutation := newResourceComponentMutation(c.config, OpUpdateOne, withResourceComponent(rc))
-	return &ResourceComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceRelationshipClient) UpdateOne(rr *ResourceRelationship) *ResourceRelationshipUpdateOne {
+	mutation := newResourceRelationshipMutation(c.config, OpUpdateOne, withResourceRelationship(rr))
+	return &ResourceRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceComponentClient) UpdateOneID(id object.ID) *ResourceComponentUpdateOne {
-	mutation := newResourceComponentMutation(c.config, OpUpdateOne, withResourceComponentID(id))
-	return &ResourceComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceRelationshipClient) UpdateOneID(id object.ID) *ResourceRelationshipUpdateOne {
+	mutation := newResourceRelationshipMutation(c.config, OpUpdateOne, withResourceRelationshipID(id))
+	return &ResourceRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ResourceComponent.
-func (c *ResourceComponentClient) Delete() *ResourceComponentDelete {
-	mutation := newResourceComponentMutation(c.config, OpDelete)
-	return &ResourceComponentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResourceRelationship.
+func (c *ResourceRelationshipClient) Delete() *ResourceRelationshipDelete {
+	mutation := newResourceRelationshipMutation(c.config, OpDelete)
+	return &ResourceRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceComponentClient) DeleteOne(rc *ResourceComponent) *ResourceComponentDeleteOne {
-	return c.DeleteOneID(rc.ID)
+func (c *ResourceRelationshipClient) DeleteOne(rr *ResourceRelationship) *ResourceRelationshipDeleteOne {
+	return c.DeleteOneID(rr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceComponentClient) DeleteOneID(id object.ID) *ResourceComponentDeleteOne {
-	builder := c.Delete().Where(resourcecomponent.ID(id))
+func (c *ResourceRelationshipClient) DeleteOneID(id object.ID) *ResourceRelationshipDeleteOne {
+	builder := c.Delete().Where(resourcerelationship.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceComponentDeleteOne{builder}
+	return &ResourceRelationshipDeleteOne{builder}
 }
 
-// Query returns a query builder for ResourceComponent.
-func (c *ResourceComponentClient) Query() *ResourceComponentQuery {
-	return &ResourceComponentQuery{
+// Query returns a query builder for ResourceRelationship.
+func (c *ResourceRelationshipClient) Query() *ResourceRelationshipQuery {
+	return &ResourceRelationshipQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResourceComponent},
+		ctx:    &QueryContext{Type: TypeResourceRelationship},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ResourceComponent entity by its id.
-func (c *ResourceComponentClient) Get(ctx context.Context, id object.ID) (*ResourceComponent, error) {
-	return c.Query().Where(resourcecomponent.ID(id)).Only(ctx)
+// Get returns a ResourceRelationship entity by its id.
+func (c *ResourceRelationshipClient) Get(ctx context.Context, id object.ID) (*ResourceRelationship, error) {
+	return c.Query().Where(resourcerelationship.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceComponentClient) GetX(ctx context.Context, id object.ID) *ResourceComponent {
+func (c *ResourceRelationshipClient) GetX(ctx context.Context, id object.ID) *ResourceRelationship {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2733,305 +4912,171 @@ func (c *ResourceComponentClient) GetX(ctx context.Context, id object.ID) *Resou
 	return obj
 }
 
-// QueryProject queries the project edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryProject(rc *ResourceComponent) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.ProjectTable, resourcecomponent.ProjectColumn),
-		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.Project
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEnvironment queries the environment edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryEnvironment(rc *ResourceComponent) *EnvironmentQuery {
-	query := (&EnvironmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(environment.Table, environment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.EnvironmentTable, resourcecomponent.EnvironmentColumn),
-		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.Environment
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryResource queries the resource edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryResource(rc *ResourceComponent) *ResourceQuery {
+// QueryResource queries the resource edge of a ResourceRelationship.
+func (c *ResourceRelationshipClient) QueryResource(rr *ResourceRelationship) *ResourceQuery {
 	query := (&ResourceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.ResourceTable, resourcecomponent.ResourceColumn),
-		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryConnector queries the connector edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryConnector(rc *ResourceComponent) *ConnectorQuery {
-	query := (&ConnectorClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(connector.Table, connector.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.ConnectorTable, resourcecomponent.ConnectorColumn),
-		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.Connector
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryComposition queries the composition edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryComposition(rc *ResourceComponent) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.CompositionTable, resourcecomponent.CompositionColumn),
-		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryComponents queries the components edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryComponents(rc *ResourceComponent) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, resourcecomponent.ComponentsTable, resourcecomponent.ComponentsColumn),
-		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryClass queries the class edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryClass(rc *ResourceComponent) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcecomponent.ClassTable, resourcecomponent.ClassColumn),
-		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryInstances queries the instances edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryInstances(rc *ResourceComponent) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
+		id := rr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, resourcecomponent.InstancesTable, resourcecomponent.InstancesColumn),
-		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponent
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+			sqlgraph.From(resourcerelationship.Table, resourcerelationship.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, resourcerelationship.ResourceTable, resourcerelationship.ResourceColumn),
+		)
+		schemaConfig := rr.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceRelationship
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryDependencies queries the dependencies edge of a ResourceComponent.
-func (c *ResourceComponentClient) QueryDependencies(rc *ResourceComponent) *ResourceComponentRelationshipQuery {
-	query := (&ResourceComponentRelationshipClient{config: c.config}).Query()
+// QueryDependency queries the dependency edge of a ResourceRelationship.
+func (c *ResourceRelationshipClient) QueryDependency(rr *ResourceRelationship) *ResourceQuery {
+	query := (&ResourceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rc.ID
+		id := rr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponent.Table, resourcecomponent.FieldID, id),
-			sqlgraph.To(resourcecomponentrelationship.Table, resourcecomponentrelationship.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, resourcecomponent.DependenciesTable, resourcecomponent.DependenciesColumn),
+			sqlgraph.From(resourcerelationship.Table, resourcerelationship.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, resourcerelationship.DependencyTable, resourcerelationship.DependencyColumn),
 		)
-		schemaConfig := rc.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponentRelationship
-		step.Edge.Schema = schemaConfig.ResourceComponentRelationship
-		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		schemaConfig := rr.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceRelationship
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResourceComponentClient) Hooks() []Hook {
-	hooks := c.hooks.ResourceComponent
-	return append(hooks[:len(hooks):len(hooks)], resourcecomponent.Hooks[:]...)
+func (c *ResourceRelationshipClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceRelationship
+	return append(hooks[:len(hooks):len(hooks)], resourcerelationship.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceComponentClient) Interceptors() []Interceptor {
-	inters := c.inters.ResourceComponent
-	return append(inters[:len(inters):len(inters)], resourcecomponent.Interceptors[:]...)
+func (c *ResourceRelationshipClient) Interceptors() []Interceptor {
+	return c.inters.ResourceRelationship
 }
 
-func (c *ResourceComponentClient) mutate(ctx context.Context, m *ResourceComponentMutation) (Value, error) {
+func (c *ResourceRelationshipClient) mutate(ctx context.Context, m *ResourceRelationshipMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceComponentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceComponentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceComponentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceComponentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown ResourceComponent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceRelationship mutation op: %q", m.Op())
 	}
 }
 
-// ResourceComponentRelationshipClient is a client for the ResourceComponentRelationship schema.
-type ResourceComponentRelationshipClient struct {
+// ResourceRunClient is a client for the ResourceRun schema.
+type ResourceRunClient struct {
 	config
 }
 
-// NewResourceComponentRelationshipClient returns a client for the ResourceComponentRelationship from the given config.
-func NewResourceComponentRelationshipClient(c config) *ResourceComponentRelationshipClient {
-	return &ResourceComponentRelationshipClient{config: c}
+// NewResourceRunClient returns a client for the ResourceRun from the given config.
+func NewResourceRunClient(c config) *ResourceRunClient {
+	return &ResourceRunClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resourcecomponentrelationship.Hooks(f(g(h())))`.
-func (c *ResourceComponentRelationshipClient) Use(hooks ...Hook) {
-	c.hooks.ResourceComponentRelationship = append(c.hooks.ResourceComponentRelationship, hooks...)
+// A call to `Use(f, g, h)` equals to `resourcerun.Hooks(f(g(h())))`.
+func (c *ResourceRunClient) Use(hooks ...Hook) {
+	c.hooks.ResourceRun = append(c.hooks.ResourceRun, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resourcecomponentrelationship.Intercept(f(g(h())))`.
-func (c *ResourceComponentRelationshipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ResourceComponentRelationship = append(c.inters.ResourceComponentRelationship, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `resourcerun.Intercept(f(g(h())))`.
+func (c *ResourceRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceRun = append(c.inters.ResourceRun, interceptors...)
 }
 
-// Create returns a builder for creating a ResourceComponentRelationship entity.
-func (c *ResourceComponentRelationshipClient) Create() *ResourceComponentRelationshipCreate {
-	mutation := newResourceComponentRelationshipMutation(c.config, OpCreate)
-	return &ResourceComponentRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResourceRun entity.
+func (c *ResourceRunClient) Create() *ResourceRunCreate {
+	mutation := newResourceRunMutation(c.config, OpCreate)
+	return &ResourceRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ResourceComponentRelationship entities.
-func (c *ResourceComponentRelationshipClient) CreateBulk(builders ...*ResourceComponentRelationshipCreate) *ResourceComponentRelationshipCreateBulk {
-	return &ResourceComponentRelationshipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResourceRun entities.
+func (c *ResourceRunClient) CreateBulk(builders ...*ResourceRunCreate) *ResourceRunCreateBulk {
+	return &ResourceRunCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceComponentRelationshipClient) MapCreateBulk(slice any, setFunc func(*ResourceComponentRelationshipCreate, int)) *ResourceComponentRelationshipCreateBulk {
+func (c *ResourceRunClient) MapCreateBulk(slice any, setFunc func(*ResourceRunCreate, int)) *ResourceRunCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceComponentRelationshipCreateBulk{err: fmt.Errorf("calling to ResourceComponentRelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResourceRunCreateBulk{err: fmt.Errorf("calling to ResourceRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceComponentRelationshipCreate, rv.Len())
+	builders := make([]*ResourceRunCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceComponentRelationshipCreateBulk{config: c.config, builders: builders}
+	return &ResourceRunCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ResourceComponentRelationship.
-func (c *ResourceComponentRelationshipClient) Update() *ResourceComponentRelationshipUpdate {
-	mutation := newResourceComponentRelationshipMutation(c.config, OpUpdate)
-	return &ResourceComponentRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResourceRun.
+func (c *ResourceRunClient) Update() *ResourceRunUpdate {
+	mutation := newResourceRunMutation(c.config, OpUpdate)
+	return &ResourceRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceComponentRelationshipClient) UpdateOne(rcr *ResourceComponentRelationship) *ResourceComponentRelationshipUpdateOne {
-	mutation := newResourceComponentRelationshipMutation(c.config, OpUpdateOne, withResourceComponentRelationship(rcr))
-	return &ResourceComponentRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceRunClient) UpdateOne(rr *ResourceRun) *ResourceRunUpdateOne {
+	mutation := newResourceRunMutation(c.config, OpUpdateOne, withResourceRun(rr))
+	return &ResourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceComponentRelationshipClient) UpdateOneID(id object.ID) *ResourceComponentRelationshipUpdateOne {
-	mutation := newResourceComponentRelationshipMutation(c.config, OpUpdateOne, withResourceComponentRelationshipID(id))
-	return &ResourceComponentRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceRunClient) UpdateOneID(id object.ID) *ResourceRunUpdateOne {
+	mutation := newResourceRunMutation(c.config, OpUpdateOne, withResourceRunID(id))
+	return &ResourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ResourceComponentRelationship.
-func (c *ResourceComponentRelationshipClient) Delete() *ResourceComponentRelationshipDelete {
-	mutation := newResourceComponentRelationshipMutation(c.config, OpDelete)
-	return &ResourceComponentRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResourceRun.
+func (c *ResourceRunClient) Delete() *ResourceRunDelete {
+	mutation := newResourceRunMutation(c.config, OpDelete)
+	return &ResourceRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceComponentRelationshipClient) DeleteOne(rcr *ResourceComponentRelationship) *ResourceComponentRelationshipDeleteOne {
-	return c.DeleteOneID(rcr.ID)
+func (c *ResourceRunClient) DeleteOne(rr *ResourceRun) *ResourceRunDeleteOne {
+	return c.DeleteOneID(rr.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceComponentRelationshipClient) DeleteOneID(id object.ID) *ResourceComponentRelationshipDeleteOne {
-	builder := c.Delete().Where(resourcecomponentrelationship.ID(id))
+func (c *ResourceRunClient) DeleteOneID(id object.ID) *ResourceRunDeleteOne {
+	builder := c.Delete().Where(resourcerun.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceComponentRelationshipDeleteOne{builder}
+	return &ResourceRunDeleteOne{builder}
 }
 
-// Query returns a query builder for ResourceComponentRelationship.
-func (c *ResourceComponentRelationshipClient) Query() *ResourceComponentRelationshipQuery {
-	return &ResourceComponentRelationshipQuery{
+// Query returns a query builder for ResourceRun.
+func (c *ResourceRunClient) Query() *ResourceRunQuery {
+	return &ResourceRunQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResourceComponentRelationship},
+		ctx:    &QueryContext{Type: TypeResourceRun},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ResourceComponentRelationship entity by its id.
-func (c *ResourceComponentRelationshipClient) Get(ctx context.Context, id object.ID) (*ResourceComponentRelationship, error) {
-	return c.Query().Where(resourcecomponentrelationship.ID(id)).Only(ctx)
+// Get returns a ResourceRun entity by its id.
+func (c *ResourceRunClient) Get(ctx context.Context, id object.ID) (*ResourceRun, error) {
+	return c.Query().Where(resourcerun.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceComponentRelationshipClient) GetX(ctx context.Context, id object.ID) *ResourceComponentRelationship {
+func (c *ResourceRunClient) GetX(ctx context.Context, id object.ID) *ResourceRun {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3039,171 +5084,210 @@ func (c *ResourceComponentRelationshipClient) GetX(ctx context.Context, id objec
 	return obj
 }
 
-// QueryResourceComponent queries the resource_component edge of a ResourceComponentRelationship.
-func (c *ResourceComponentRelationshipClient) QueryResourceComponent(rcr *ResourceComponentRelationship) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
+// QueryProject queries the project edge of a ResourceRun.
+func (c *ResourceRunClient) QueryProject(rr *ResourceRun) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rcr.ID
+		id := rr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponentrelationship.Table, resourcecomponentrelationship.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, resourcecomponentrelationship.ResourceComponentTable, resourcecomponentrelationship.ResourceComponentColumn),
+			sqlgraph.From(resourcerun.Table, resourcerun.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerun.ProjectTable, resourcerun.ProjectColumn),
 		)
-		schemaConfig := rcr.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponentRelationship
-		fromV = sqlgraph.Neighbors(rcr.driver.Dialect(), step)
+		schemaConfig := rr.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRun
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryDependency queries the dependency edge of a ResourceComponentRelationship.
-func (c *ResourceComponentRelationshipClient) QueryDependency(rcr *ResourceComponentRelationship) *ResourceComponentQuery {
-	query := (&ResourceComponentClient{config: c.config}).Query()
+// QueryEnvironment queries the environment edge of a ResourceRun.
+func (c *ResourceRunClient) QueryEnvironment(rr *ResourceRun) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rcr.ID
+		id := rr.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcecomponentrelationship.Table, resourcecomponentrelationship.FieldID, id),
-			sqlgraph.To(resourcecomponent.Table, resourcecomponent.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, resourcecomponentrelationship.DependencyTable, resourcecomponentrelationship.DependencyColumn),
+			sqlgraph.From(resourcerun.Table, resourcerun.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerun.EnvironmentTable, resourcerun.EnvironmentColumn),
 		)
-		schemaConfig := rcr.schemaConfig
-		step.To.Schema = schemaConfig.ResourceComponent
-		step.Edge.Schema = schemaConfig.ResourceComponentRelationship
-		fromV = sqlgraph.Neighbors(rcr.driver.Dialect(), step)
+		schemaConfig := rr.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRun
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryResource queries the resource edge of a ResourceRun.
+func (c *ResourceRunClient) QueryResource(rr *ResourceRun) *ResourceQuery {
+	query := (&ResourceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcerun.Table, resourcerun.FieldID, id),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerun.ResourceTable, resourcerun.ResourceColumn),
+		)
+		schemaConfig := rr.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceRun
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStateVersions queries the state_versions edge of a ResourceRun.
+func (c *ResourceRunClient) QueryStateVersions(rr *ResourceRun) *ResourceStateVersionQuery {
+	query := (&ResourceStateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcerun.Table, resourcerun.FieldID, id),
+			sqlgraph.To(resourcestateversion.Table, resourcestateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resourcerun.StateVersionsTable, resourcerun.StateVersionsColumn),
+		)
+		schemaConfig := rr.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResourceComponentRelationshipClient) Hooks() []Hook {
-	hooks := c.hooks.ResourceComponentRelationship
-	return append(hooks[:len(hooks):len(hooks)], resourcecomponentrelationship.Hooks[:]...)
+func (c *ResourceRunClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceRun
+	return append(hooks[:len(hooks):len(hooks)], resourcerun.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceComponentRelationshipClient) Interceptors() []Interceptor {
-	return c.inters.ResourceComponentRelationship
+func (c *ResourceRunClient) Interceptors() []Interceptor {
+	inters := c.inters.ResourceRun
+	return append(inters[:len(inters):len(inters)], resourcerun.Interceptors[:]...)
 }
 
-func (c *ResourceComponentRelationshipClient) mutate(ctx context.Context, m *ResourceComponentRelationshipMutation) (Value, error) {
+func (c *ResourceRunClient) mutate(ctx context.Context, m *ResourceRunMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceComponentRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceComponentRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceComponentRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceComponentRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown ResourceComponentRelationship mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceRun mutation op: %q", m.Op())
 	}
 }
 
-// ResourceDefinitionClient is a client for the ResourceDefinition schema.
-type ResourceDefinitionClient struct {
+// ResourceRunApprovalRuleClient is a client for the ResourceRunApprovalRule schema.
+type ResourceRunApprovalRuleClient struct {
 	config
 }
 
-// NewResourceDefinitionClient returns a client for the ResourceDefinition from the given config.
-func NewResourceDefinitionClient(c config) *ResourceDefinitionClient {
-	return &ResourceDefinitionClient{config: c}
+// NewResourceRunApprovalRuleClient returns a client for the ResourceRunApprovalRule from the given config.
+func NewResourceRunApprovalRuleClient(c config) *ResourceRunApprovalRuleClient {
+	return &ResourceRunApprovalRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resourcedefinition.Hooks(f(g(h())))`.
-func (c *ResourceDefinitionClient) Use(hooks ...Hook) {
-	c.hooks.ResourceDefinition = append(c.hooks.ResourceDefinition, hooks...)
+// A call to `Use(f, g, h)` equals to `resourcerunapprovalrule.Hooks(f(g(h())))`.
+func (c *ResourceRunApprovalRuleClient) Use(hooks ...Hook) {
+	c.hooks.ResourceRunApprovalRule = append(c.hooks.ResourceRunApprovalRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resourcedefinition.Intercept(f(g(h())))`.
-func (c *ResourceDefinitionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ResourceDefinition = append(c.inters.ResourceDefinition, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `resourcerunapprovalrule.Intercept(f(g(h())))`.
+func (c *ResourceRunApprovalRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceRunApprovalRule = append(c.inters.ResourceRunApprovalRule, interceptors...)
 }
 
-// Create returns a builder for creating a ResourceDefinition entity.
-func (c *ResourceDefinitionClient) Create() *ResourceDefinitionCreate {
-	mutation := newResourceDefinitionMutation(c.config, OpCreate)
-	return &ResourceDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResourceRunApprovalRule entity.
+func (c *ResourceRunApprovalRuleClient) Create() *ResourceRunApprovalRuleCreate {
+	mutation := newResourceRunApprovalRuleMutation(c.config, OpCreate)
+	return &ResourceRunApprovalRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ResourceDefinition entities.
-func (c *ResourceDefinitionClient) CreateBulk(builders ...*ResourceDefinitionCreate) *ResourceDefinitionCreateBulk {
-	return &ResourceDefinitionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResourceRunApprovalRule entities.
+func (c *ResourceRunApprovalRuleClient) CreateBulk(builders ...*ResourceRunApprovalRuleCreate) *ResourceRunApprovalRuleCreateBulk {
+	return &ResourceRunApprovalRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceDefinitionClient) MapCreateBulk(slice any, setFunc func(*ResourceDefinitionCreate, int)) *ResourceDefinitionCreateBulk {
+func (c *ResourceRunApprovalRuleClient) MapCreateBulk(slice any, setFunc func(*ResourceRunApprovalRuleCreate, int)) *ResourceRunApprovalRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceDefinitionCreateBulk{err: fmt.Errorf("calling to ResourceDefinitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResourceRunApprovalRuleCreateBulk{err: fmt.Errorf("calling to ResourceRunApprovalRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceDefinitionCreate, rv.Len())
+	builders := make([]*ResourceRunApprovalRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceDefinitionCreateBulk{config: c.config, builders: builders}
+	return &ResourceRunApprovalRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ResourceDefinition.
-func (c *ResourceDefinitionClient) Update() *ResourceDefinitionUpdate {
-	mutation := newResourceDefinitionMutation(c.config, OpUpdate)
-	return &ResourceDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResourceRunApprovalRule.
+func (c *ResourceRunApprovalRuleClient) Update() *ResourceRunApprovalRuleUpdate {
+	mutation := newResourceRunApprovalRuleMutation(c.config, OpUpdate)
+	return &ResourceRunApprovalRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceDefinitionClient) UpdateOne(rd *ResourceDefinition) *ResourceDefinitionUpdateOne {
-	mutation := newResourceDefinitionMutation(c.config, OpUpdateOne, withResourceDefinition(rd))
-	return &ResourceDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceRunApprovalRuleClient) UpdateOne(rrar *ResourceRunApprovalRule) *ResourceRunApprovalRuleUpdateOne {
+	mutation := newResourceRunApprovalRuleMutation(c.config, OpUpdateOne, withResourceRunApprovalRule(rrar))
+	return &ResourceRunApprovalRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceDefinitionClient) UpdateOneID(id object.ID) *ResourceDefinitionUpdateOne {
-	mutation := newResourceDefinitionMutation(c.config, OpUpdateOne, withResourceDefinitionID(id))
-	return &ResourceDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceRunApprovalRuleClient) UpdateOneID(id object.ID) *ResourceRunApprovalRuleUpdateOne {
+	mutation := newResourceRunApprovalRuleMutation(c.config, OpUpdateOne, withResourceRunApprovalRuleID(id))
+	return &ResourceRunApprovalRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ResourceDefinition.
-func (c *ResourceDefinitionClient) Delete() *ResourceDefinitionDelete {
-	mutation := newResourceDefinitionMutation(c.config, OpDelete)
-	return &ResourceDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResourceRunApprovalRule.
+func (c *ResourceRunApprovalRuleClient) Delete() *ResourceRunApprovalRuleDelete {
+	mutation := newResourceRunApprovalRuleMutation(c.config, OpDelete)
+	return &ResourceRunApprovalRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceDefinitionClient) DeleteOne(rd *ResourceDefinition) *ResourceDefinitionDeleteOne {
-	return c.DeleteOneID(rd.ID)
+func (c *ResourceRunApprovalRuleClient) DeleteOne(rrar *ResourceRunApprovalRule) *ResourceRunApprovalRuleDeleteOne {
+	return c.DeleteOneID(rrar.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceDefinitionClient) DeleteOneID(id object.ID) *ResourceDefinitionDeleteOne {
-	builder := c.Delete().Where(resourcedefinition.ID(id))
+func (c *ResourceRunApprovalRuleClient) DeleteOneID(id object.ID) *ResourceRunApprovalRuleDeleteOne {
+	builder := c.Delete().Where(resourcerunapprovalrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceDefinitionDeleteOne{builder}
+	return &ResourceRunApprovalRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for ResourceDefinition.
-func (c *ResourceDefinitionClient) Query() *ResourceDefinitionQuery {
-	return &ResourceDefinitionQuery{
+// Query returns a query builder for ResourceRunApprovalRule.
+func (c *ResourceRunApprovalRuleClient) Query() *ResourceRunApprovalRuleQuery {
+	return &ResourceRunApprovalRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResourceDefinition},
+		ctx:    &QueryContext{Type: TypeResourceRunApprovalRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ResourceDefinition entity by its id.
-func (c *ResourceDefinitionClient) Get(ctx context.Context, id object.ID) (*ResourceDefinition, error) {
-	return c.Query().Where(resourcedefinition.ID(id)).Only(ctx)
+// Get returns a ResourceRunApprovalRule entity by its id.
+func (c *ResourceRunApprovalRuleClient) Get(ctx context.Context, id object.ID) (*ResourceRunApprovalRule, error) {
+	return c.Query().Where(resourcerunapprovalrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceDefinitionClient) GetX(ctx context.Context, id object.ID) *ResourceDefinition {
+func (c *ResourceRunApprovalRuleClient) GetX(ctx context.Context, id object.ID) *ResourceRunApprovalRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3211,171 +5295,172 @@ func (c *ResourceDefinitionClient) GetX(ctx context.Context, id object.ID) *Reso
 	return obj
 }
 
-// QueryMatchingRules queries the matching_rules edge of a ResourceDefinition.
-func (c *ResourceDefinitionClient) QueryMatchingRules(rd *ResourceDefinition) *ResourceDefinitionMatchingRuleQuery {
-	query := (&ResourceDefinitionMatchingRuleClient{config: c.config}).Query()
+// QueryProject queries the project edge of a ResourceRunApprovalRule.
+func (c *ResourceRunApprovalRuleClient) QueryProject(rrar *ResourceRunApprovalRule) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rd.ID
+		id := rrar.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcedefinition.Table, resourcedefinition.FieldID, id),
-			sqlgraph.To(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, resourcedefinition.MatchingRulesTable, resourcedefinition.MatchingRulesColumn),
+			sqlgraph.From(resourcerunapprovalrule.Table, resourcerunapprovalrule.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerunapprovalrule.ProjectTable, resourcerunapprovalrule.ProjectColumn),
 		)
-		schemaConfig := rd.schemaConfig
-		step.To.Schema = schemaConfig.ResourceDefinitionMatchingRule
-		step.Edge.Schema = schemaConfig.ResourceDefinitionMatchingRule
-		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
+		schemaConfig := rrar.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		fromV = sqlgraph.Neighbors(rrar.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryResources queries the resources edge of a ResourceDefinition.
-func (c *ResourceDefinitionClient) QueryResources(rd *ResourceDefinition) *ResourceQuery {
-	query := (&ResourceClient{config: c.config}).Query()
+// QueryEnvironment queries the environment edge of a ResourceRunApprovalRule.
+func (c *ResourceRunApprovalRuleClient) QueryEnvironment(rrar *ResourceRunApprovalRule) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rd.ID
+		id := rrar.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcedefinition.Table, resourcedefinition.FieldID, id),
-			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, resourcedefinition.ResourcesTable, resourcedefinition.ResourcesColumn),
+			sqlgraph.From(resourcerunapprovalrule.Table, resourcerunapprovalrule.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerunapprovalrule.EnvironmentTable, resourcerunapprovalrule.EnvironmentColumn),
 		)
-		schemaConfig := rd.schemaConfig
-		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
+		schemaConfig := rrar.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		fromV = sqlgraph.Neighbors(rrar.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResourceDefinitionClient) Hooks() []Hook {
-	hooks := c.hooks.ResourceDefinition
-	return append(hooks[:len(hooks):len(hooks)], resourcedefinition.Hooks[:]...)
+func (c *ResourceRunApprovalRuleClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceRunApprovalRule
+	return append(hooks[:len(hooks):len(hooks)], resourcerunapprovalrule.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceDefinitionClient) Interceptors() []Interceptor {
-	return c.inters.ResourceDefinition
+func (c *ResourceRunApprovalRuleClient) Interceptors() []Interceptor {
+	inters := c.inters.ResourceRunApprovalRule
+	return append(inters[:len(inters):len(inters)], resourcerunapprovalrule.Interceptors[:]...)
 }
 
-func (c *ResourceDefinitionClient) mutate(ctx context.Context, m *ResourceDefinitionMutation) (Value, error) {
+func (c *ResourceRunApprovalRuleClient) mutate(ctx context.Context, m *ResourceRunApprovalRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunApprovalRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunApprovalRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunApprovalRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceRunApprovalRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown ResourceDefinition mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceRunApprovalRule mutation op: %q", m.Op())
 	}
 }
 
-// ResourceDefinitionMatchingRuleClient is a client for the ResourceDefinitionMatchingRule schema.
-type ResourceDefinitionMatchingRuleClient struct {
+// ResourceRunPolicyClient is a client for the ResourceRunPolicy schema.
+type ResourceRunPolicyClient struct {
 	config
 }
 
-// NewResourceDefinitionMatchingRuleClient returns a client for the ResourceDefinitionMatchingRule from the given config.
-func NewResourceDefinitionMatchingRuleClient(c config) *ResourceDefinitionMatchingRuleClient {
-	return &ResourceDefinitionMatchingRuleClient{config: c}
+// NewResourceRunPolicyClient returns a client for the ResourceRunPolicy from the given config.
+func NewResourceRunPolicyClient(c config) *ResourceRunPolicyClient {
+	return &ResourceRunPolicyClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resourcedefinitionmatchingrule.Hooks(f(g(h())))`.
-func (c *ResourceDefinitionMatchingRuleClient) Use(hooks ...Hook) {
-	c.hooks.ResourceDefinitionMatchingRule = append(c.hooks.ResourceDefinitionMatchingRule, hooks...)
+// A call to `Use(f, g, h)` equals to `resourcerunpolicy.Hooks(f(g(h())))`.
+func (c *ResourceRunPolicyClient) Use(hooks ...Hook) {
+	c.hooks.ResourceRunPolicy = append(c.hooks.ResourceRunPolicy, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resourcedefinitionmatchingrule.Intercept(f(g(h())))`.
-func (c *ResourceDefinitionMatchingRuleClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ResourceDefinitionMatchingRule = append(c.inters.ResourceDefinitionMatchingRule, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `resourcerunpolicy.Intercept(f(g(h())))`.
+func (c *ResourceRunPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceRunPolicy = append(c.inters.ResourceRunPolicy, interceptors...)
 }
 
-// Create returns a builder for creating a ResourceDefinitionMatchingRule entity.
-func (c *ResourceDefinitionMatchingRuleClient) Create() *ResourceDefinitionMatchingRuleCreate {
-	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpCreate)
-	return &ResourceDefinitionMatchingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResourceRunPolicy entity.
+func (c *ResourceRunPolicyClient) Create() *ResourceRunPolicyCreate {
+	mutation := newResourceRunPolicyMutation(c.config, OpCreate)
+	return &ResourceRunPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ResourceDefinitionMatchingRule entities.
-func (c *ResourceDefinitionMatchingRuleClient) CreateBulk(builders ...*ResourceDefinitionMatchingRuleCreate) *ResourceDefinitionMatchingRuleCreateBulk {
-	return &ResourceDefinitionMatchingRuleCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResourceRunPolicy entities.
+func (c *ResourceRunPolicyClient) CreateBulk(builders ...*ResourceRunPolicyCreate) *ResourceRunPolicyCreateBulk {
+	return &ResourceRunPolicyCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceDefinitionMatchingRuleClient) MapCreateBulk(slice any, setFunc func(*ResourceDefinitionMatchingRuleCreate, int)) *ResourceDefinitionMatchingRuleCreateBulk {
+func (c *ResourceRunPolicyClient) MapCreateBulk(slice any, setFunc func(*ResourceRunPolicyCreate, int)) *ResourceRunPolicyCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceDefinitionMatchingRuleCreateBulk{err: fmt.Errorf("calling to ResourceDefinitionMatchingRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResourceRunPolicyCreateBulk{err: fmt.Errorf("calling to ResourceRunPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceDefinitionMatchingRuleCreate, rv.Len())
+	builders := make([]*ResourceRunPolicyCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceDefinitionMatchingRuleCreateBulk{config: c.config, builders: builders}
+	return &ResourceRunPolicyCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ResourceDefinitionMatchingRule.
-func (c *ResourceDefinitionMatchingRuleClient) Update() *ResourceDefinitionMatchingRuleUpdate {
-	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpUpdate)
-	return &ResourceDefinitionMatchingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResourceRunPolicy.
+func (c *ResourceRunPolicyClient) Update() *ResourceRunPolicyUpdate {
+	mutation := newResourceRunPolicyMutation(c.config, OpUpdate)
+	return &ResourceRunPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceDefinitionMatchingRuleClient) UpdateOne(rdmr *ResourceDefinitionMatchingRule) *ResourceDefinitionMatchingRuleUpdateOne {
-	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpUpdateOne, withResourceDefinitionMatchingRule(rdmr))
-	return &ResourceDefinitionMatchingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceRunPolicyClient) UpdateOne(rrp *ResourceRunPolicy) *ResourceRunPolicyUpdateOne {
+	mutation := newResourceRunPolicyMutation(c.config, OpUpdateOne, withResourceRunPolicy(rrp))
+	return &ResourceRunPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceDefinitionMatchingRuleClient) UpdateOneID(id object.ID) *ResourceDefinitionMatchingRuleUpdateOne {
-	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpUpdateOne, withResourceDefinitionMatchingRuleID(id))
-	return &ResourceDefinitionMatchingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceRunPolicyClient) UpdateOneID(id object.ID) *ResourceRunPolicyUpdateOne {
+	mutation := newResourceRunPolicyMutation(c.config, OpUpdateOne, withResourceRunPolicyID(id))
+	return &ResourceRunPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ResourceDefinitionMatchingRule.
-func (c *ResourceDefinitionMatchingRuleClient) Delete() *ResourceDefinitionMatchingRuleDelete {
-	mutation := newResourceDefinitionMatchingRuleMutation(c.config, OpDelete)
-	return &ResourceDefinitionMatchingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResourceRunPolicy.
+func (c *ResourceRunPolicyClient) Delete() *ResourceRunPolicyDelete {
+	mutation := newResourceRunPolicyMutation(c.config, OpDelete)
+	return &ResourceRunPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceDefinitionMatchingRuleClient) DeleteOne(rdmr *ResourceDefinitionMatchingRule) *ResourceDefinitionMatchingRuleDeleteOne {
-	return c.DeleteOneID(rdmr.ID)
+func (c *ResourceRunPolicyClient) DeleteOne(rrp *ResourceRunPolicy) *ResourceRunPolicyDeleteOne {
+	return c.DeleteOneID(rrp.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceDefinitionMatchingRuleClient) DeleteOneID(id object.ID) *ResourceDefinitionMatchingRuleDeleteOne {
-	builder := c.Delete().Where(resourcedefinitionmatchingrule.ID(id))
+func (c *ResourceRunPolicyClient) DeleteOneID(id object.ID) *ResourceRunPolicyDeleteOne {
+	builder := c.Delete().Where(resourcerunpolicy.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceDefinitionMatchingRuleDeleteOne{builder}
+	return &ResourceRunPolicyDeleteOne{builder}
 }
 
-// Query returns a query builder for ResourceDefinitionMatchingRule.
-func (c *ResourceDefinitionMatchingRuleClient) Query() *ResourceDefinitionMatchingRuleQuery {
-	return &ResourceDefinitionMatchingRuleQuery{
+// Query returns a query builder for ResourceRunPolicy.
+func (c *ResourceRunPolicyClient) Query() *ResourceRunPolicyQuery {
+	return &ResourceRunPolicyQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResourceDefinitionMatchingRule},
+		ctx:    &QueryContext{Type: TypeResourceRunPolicy},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ResourceDefinitionMatchingRule entity by its id.
-func (c *ResourceDefinitionMatchingRuleClient) Get(ctx context.Context, id object.ID) (*ResourceDefinitionMatchingRule, error) {
-	return c.Query().Where(resourcedefinitionmatchingrule.ID(id)).Only(ctx)
+// Get returns a ResourceRunPolicy entity by its id.
+func (c *ResourceRunPolicyClient) Get(ctx context.Context, id object.ID) (*ResourceRunPolicy, error) {
+	return c.Query().Where(resourcerunpolicy.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceDefinitionMatchingRuleClient) GetX(ctx context.Context, id object.ID) *ResourceDefinitionMatchingRule {
+func (c *ResourceRunPolicyClient) GetX(ctx context.Context, id object.ID) *ResourceRunPolicy {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3383,190 +5468,172 @@ func (c *ResourceDefinitionMatchingRuleClient) GetX(ctx context.Context, id obje
 	return obj
 }
 
-// QueryResourceDefinition queries the resource_definition edge of a ResourceDefinitionMatchingRule.
-func (c *ResourceDefinitionMatchingRuleClient) QueryResourceDefinition(rdmr *ResourceDefinitionMatchingRule) *ResourceDefinitionQuery {
-	query := (&ResourceDefinitionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rdmr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID, id),
-			sqlgraph.To(resourcedefinition.Table, resourcedefinition.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, resourcedefinitionmatchingrule.ResourceDefinitionTable, resourcedefinitionmatchingrule.ResourceDefinitionColumn),
-		)
-		schemaConfig := rdmr.schemaConfig
-		step.To.Schema = schemaConfig.ResourceDefinition
-		step.Edge.Schema = schemaConfig.ResourceDefinitionMatchingRule
-		fromV = sqlgraph.Neighbors(rdmr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTemplate queries the template edge of a ResourceDefinitionMatchingRule.
-func (c *ResourceDefinitionMatchingRuleClient) QueryTemplate(rdmr *ResourceDefinitionMatchingRule) *TemplateVersionQuery {
-	query := (&TemplateVersionClient{config: c.config}).Query()
+// QueryProject queries the project edge of a ResourceRunPolicy.
+func (c *ResourceRunPolicyClient) QueryProject(rrp *ResourceRunPolicy) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rdmr.ID
+		id := rrp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID, id),
-			sqlgraph.To(templateversion.Table, templateversion.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, resourcedefinitionmatchingrule.TemplateTable, resourcedefinitionmatchingrule.TemplateColumn),
+			sqlgraph.From(resourcerunpolicy.Table, resourcerunpolicy.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerunpolicy.ProjectTable, resourcerunpolicy.ProjectColumn),
 		)
-		schemaConfig := rdmr.schemaConfig
-		step.To.Schema = schemaConfig.TemplateVersion
-		step.Edge.Schema = schemaConfig.ResourceDefinitionMatchingRule
-		fromV = sqlgraph.Neighbors(rdmr.driver.Dialect(), step)
+		schemaConfig := rrp.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		fromV = sqlgraph.Neighbors(rrp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryResources queries the resources edge of a ResourceDefinitionMatchingRule.
-func (c *ResourceDefinitionMatchingRuleClient) QueryResources(rdmr *ResourceDefinitionMatchingRule) *ResourceQuery {
-	query := (&ResourceClient{config: c.config}).Query()
+// QueryEnvironment queries the environment edge of a ResourceRunPolicy.
+func (c *ResourceRunPolicyClient) QueryEnvironment(rrp *ResourceRunPolicy) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rdmr.ID
+		id := rrp.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcedefinitionmatchingrule.Table, resourcedefinitionmatchingrule.FieldID, id),
-			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, resourcedefinitionmatchingrule.ResourcesTable, resourcedefinitionmatchingrule.ResourcesColumn),
+			sqlgraph.From(resourcerunpolicy.Table, resourcerunpolicy.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerunpolicy.EnvironmentTable, resourcerunpolicy.EnvironmentColumn),
 		)
-		schemaConfig := rdmr.schemaConfig
-		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.Resource
-		fromV = sqlgraph.Neighbors(rdmr.driver.Dialect(), step)
+		schemaConfig := rrp.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		fromV = sqlgraph.Neighbors(rrp.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResourceDefinitionMatchingRuleClient) Hooks() []Hook {
-	hooks := c.hooks.ResourceDefinitionMatchingRule
-	return append(hooks[:len(hooks):len(hooks)], resourcedefinitionmatchingrule.Hooks[:]...)
+func (c *ResourceRunPolicyClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceRunPolicy
+	return append(hooks[:len(hooks):len(hooks)], resourcerunpolicy.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceDefinitionMatchingRuleClient) Interceptors() []Interceptor {
-	return c.inters.ResourceDefinitionMatchingRule
+func (c *ResourceRunPolicyClient) Interceptors() []Interceptor {
+	inters := c.inters.ResourceRunPolicy
+	return append(inters[:len(inters):len(inters)], resourcerunpolicy.Interceptors[:]...)
 }
 
-func (c *ResourceDefinitionMatchingRuleClient) mutate(ctx context.Context, m *ResourceDefinitionMatchingRuleMutation) (Value, error) {
+func (c *ResourceRunPolicyClient) mutate(ctx context.Context, m *ResourceRunPolicyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceDefinitionMatchingRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceDefinitionMatchingRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceDefinitionMatchingRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceRunPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceDefinitionMatchingRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceRunPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown ResourceDefinitionMatchingRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceRunPolicy mutation op: %q", m.Op())
 	}
 }
 
-// ResourceRelationshipClient is a client for the ResourceRelationship schema.
-type ResourceRelationshipClient struct {
+// ResourceStateClient is a client for the ResourceState schema.
+type ResourceStateClient struct {
 	config
 }
 
-// NewResourceRelationshipClient returns a client for the ResourceRelationship from the given config.
-func NewResourceRelationshipClient(c config) *ResourceRelationshipClient {
-	return &ResourceRelationshipClient{config: c}
+// NewResourceStateClient returns a client for the ResourceState from the given config.
+func NewResourceStateClient(c config) *ResourceStateClient {
+	return &ResourceStateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resourcerelationship.Hooks(f(g(h())))`.
-func (c *ResourceRelationshipClient) Use(hooks ...Hook) {
-	c.hooks.ResourceRelationship = append(c.hooks.ResourceRelationship, hooks...)
+// A call to `Use(f, g, h)` equals to `resourcestate.Hooks(f(g(h())))`.
+func (c *ResourceStateClient) Use(hooks ...Hook) {
+	c.hooks.ResourceState = append(c.hooks.ResourceState, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resourcerelationship.Intercept(f(g(h())))`.
-func (c *ResourceRelationshipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ResourceRelationship = append(c.inters.ResourceRelationship, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `resourcestate.Intercept(f(g(h())))`.
+func (c *ResourceStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceState = append(c.inters.ResourceState, interceptors...)
 }
 
-// Create returns a builder for creating a ResourceRelationship entity.
-func (c *ResourceRelationshipClient) Create() *ResourceRelationshipCreate {
-	mutation := newResourceRelationshipMutation(c.config, OpCreate)
-	return &ResourceRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResourceState entity.
+func (c *ResourceStateClient) Create() *ResourceStateCreate {
+	mutation := newResourceStateMutation(c.config, OpCreate)
+	return &ResourceStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ResourceRelationship entities.
-func (c *ResourceRelationshipClient) CreateBulk(builders ...*ResourceRelationshipCreate) *ResourceRelationshipCreateBulk {
-	return &ResourceRelationshipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResourceState entities.
+func (c *ResourceStateClient) CreateBulk(builders ...*ResourceStateCreate) *ResourceStateCreateBulk {
+	return &ResourceStateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceRelationshipClient) MapCreateBulk(slice any, setFunc func(*ResourceRelationshipCreate, int)) *ResourceRelationshipCreateBulk {
+func (c *ResourceStateClient) MapCreateBulk(slice any, setFunc func(*ResourceStateCreate, int)) *ResourceStateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceRelationshipCreateBulk{err: fmt.Errorf("calling to ResourceRelationshipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResourceStateCreateBulk{err: fmt.Errorf("calling to ResourceStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceRelationshipCreate, rv.Len())
+	builders := make([]*ResourceStateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceRelationshipCreateBulk{config: c.config, builders: builders}
+	return &ResourceStateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ResourceRelationship.
-func (c *ResourceRelationshipClient) Update() *ResourceRelationshipUpdate {
-	mutation := newResourceRelationshipMutation(c.config, OpUpdate)
-	return &ResourceRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResourceState.
+func (c *ResourceStateClient) Update() *ResourceStateUpdate {
+	mutation := newResourceStateMutation(c.config, OpUpdate)
+	return &ResourceStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceRelationshipClient) UpdateOne(rr *ResourceRelationship) *ResourceRelationshipUpdateOne {
-	mutation := newResourceRelationshipMutation(c.config, OpUpdateOne, withResourceRelationship(rr))
-	return &ResourceRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceStateClient) UpdateOne(rs *ResourceState) *ResourceStateUpdateOne {
+	mutation := newResourceStateMutation(c.config, OpUpdateOne, withResourceState(rs))
+	return &ResourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceRelationshipClient) UpdateOneID(id object.ID) *ResourceRelationshipUpdateOne {
-	mutation := newResourceRelationshipMutation(c.config, OpUpdateOne, withResourceRelationshipID(id))
-	return &ResourceRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceStateClient) UpdateOneID(id object.ID) *ResourceStateUpdateOne {
+	mutation := newResourceStateMutation(c.config, OpUpdateOne, withResourceStateID(id))
+	return &ResourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ResourceRelationship.
-func (c *ResourceRelationshipClient) Delete() *ResourceRelationshipDelete {
-	mutation := newResourceRelationshipMutation(c.config, OpDelete)
-	return &ResourceRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResourceState.
+func (c *ResourceStateClient) Delete() *ResourceStateDelete {
+	mutation := newResourceStateMutation(c.config, OpDelete)
+	return &ResourceStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceRelationshipClient) DeleteOne(rr *ResourceRelationship) *ResourceRelationshipDeleteOne {
-	return c.DeleteOneID(rr.ID)
+func (c *ResourceStateClient) DeleteOne(rs *ResourceState) *ResourceStateDeleteOne {
+	return c.DeleteOneID(rs.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceRelationshipClient) DeleteOneID(id object.ID) *ResourceRelationshipDeleteOne {
-	builder := c.Delete().Where(resourcerelationship.ID(id))
+func (c *ResourceStateClient) DeleteOneID(id object.ID) *ResourceStateDeleteOne {
+	builder := c.Delete().Where(resourcestate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceRelationshipDeleteOne{builder}
+	return &ResourceStateDeleteOne{builder}
 }
 
-// Query returns a query builder for ResourceRelationship.
-func (c *ResourceRelationshipClient) Query() *ResourceRelationshipQuery {
-	return &ResourceRelationshipQuery{
+// Query returns a query builder for ResourceState.
+func (c *ResourceStateClient) Query() *ResourceStateQuery {
+	return &ResourceStateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResourceRelationship},
+		ctx:    &QueryContext{Type: TypeResourceState},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ResourceRelationship entity by its id.
-func (c *ResourceRelationshipClient) Get(ctx context.Context, id object.ID) (*ResourceRelationship, error) {
-	return c.Query().Where(resourcerelationship.ID(id)).Only(ctx)
+// Get returns a ResourceState entity by its id.
+func (c *ResourceStateClient) Get(ctx context.Context, id object.ID) (*ResourceState, error) {
+	return c.Query().Where(resourcestate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceRelationshipClient) GetX(ctx context.Context, id object.ID) *ResourceRelationship {
+func (c *ResourceStateClient) GetX(ctx context.Context, id object.ID) *ResourceState {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3574,171 +5641,152 @@ func (c *ResourceRelationshipClient) GetX(ctx context.Context, id object.ID) *Re
 	return obj
 }
 
-// QueryResource queries the resource edge of a ResourceRelationship.
-func (c *ResourceRelationshipClient) QueryResource(rr *ResourceRelationship) *ResourceQuery {
-	query := (&ResourceClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcerelationship.Table, resourcerelationship.FieldID, id),
-			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, resourcerelationship.ResourceTable, resourcerelationship.ResourceColumn),
-		)
-		schemaConfig := rr.schemaConfig
-		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.ResourceRelationship
-		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryDependency queries the dependency edge of a ResourceRelationship.
-func (c *ResourceRelationshipClient) QueryDependency(rr *ResourceRelationship) *ResourceQuery {
+// QueryResource queries the resource edge of a ResourceState.
+func (c *ResourceStateClient) QueryResource(rs *ResourceState) *ResourceQuery {
 	query := (&ResourceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rr.ID
+		id := rs.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcerelationship.Table, resourcerelationship.FieldID, id),
+			sqlgraph.From(resourcestate.Table, resourcestate.FieldID, id),
 			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, false, resourcerelationship.DependencyTable, resourcerelationship.DependencyColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, resourcestate.ResourceTable, resourcestate.ResourceColumn),
 		)
-		schemaConfig := rr.schemaConfig
+		schemaConfig := rs.schemaConfig
 		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.ResourceRelationship
-		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
+		step.Edge.Schema = schemaConfig.ResourceState
+		fromV = sqlgraph.Neighbors(rs.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResourceRelationshipClient) Hooks() []Hook {
-	hooks := c.hooks.ResourceRelationship
-	return append(hooks[:len(hooks):len(hooks)], resourcerelationship.Hooks[:]...)
+func (c *ResourceStateClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceState
+	return append(hooks[:len(hooks):len(hooks)], resourcestate.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceRelationshipClient) Interceptors() []Interceptor {
-	return c.inters.ResourceRelationship
+func (c *ResourceStateClient) Interceptors() []Interceptor {
+	return c.inters.ResourceState
 }
 
-func (c *ResourceRelationshipClient) mutate(ctx context.Context, m *ResourceRelationshipMutation) (Value, error) {
+func (c *ResourceStateClient) mutate(ctx context.Context, m *ResourceStateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceRelationshipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceRelationshipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceRelationshipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceRelationshipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown ResourceRelationship mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceState mutation op: %q", m.Op())
 	}
 }
 
-// ResourceRunClient is a client for the ResourceRun schema.
-type ResourceRunClient struct {
+// ResourceStateLockClient is a client for the ResourceStateLock schema.
+type ResourceStateLockClient struct {
 	config
 }
 
-// NewResourceRunClient returns a client for the ResourceRun from the given config.
-func NewResourceRunClient(c config) *ResourceRunClient {
-	return &ResourceRunClient{config: c}
+// NewResourceStateLockClient returns a client for the ResourceStateLock from the given config.
+func NewResourceStateLockClient(c config) *ResourceStateLockClient {
+	return &ResourceStateLockClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resourcerun.Hooks(f(g(h())))`.
-func (c *ResourceRunClient) Use(hooks ...Hook) {
-	c.hooks.ResourceRun = append(c.hooks.ResourceRun, hooks...)
+// A call to `Use(f, g, h)` equals to `resourcestatelock.Hooks(f(g(h())))`.
+func (c *ResourceStateLockClient) Use(hooks ...Hook) {
+	c.hooks.ResourceStateLock = append(c.hooks.ResourceStateLock, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resourcerun.Intercept(f(g(h())))`.
-func (c *ResourceRunClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ResourceRun = append(c.inters.ResourceRun, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `resourcestatelock.Intercept(f(g(h())))`.
+func (c *ResourceStateLockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceStateLock = append(c.inters.ResourceStateLock, interceptors...)
 }
 
-// Create returns a builder for creating a ResourceRun entity.
-func (c *ResourceRunClient) Create() *ResourceRunCreate {
-	mutation := newResourceRunMutation(c.config, OpCreate)
-	return &ResourceRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResourceStateLock entity.
+func (c *ResourceStateLockClient) Create() *ResourceStateLockCreate {
+	mutation := newResourceStateLockMutation(c.config, OpCreate)
+	return &ResourceStateLockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ResourceRun entities.
-func (c *ResourceRunClient) CreateBulk(builders ...*ResourceRunCreate) *ResourceRunCreateBulk {
-	return &ResourceRunCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResourceStateLock entities.
+func (c *ResourceStateLockClient) CreateBulk(builders ...*ResourceStateLockCreate) *ResourceStateLockCreateBulk {
+	return &ResourceStateLockCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceRunClient) MapCreateBulk(slice any, setFunc func(*ResourceRunCreate, int)) *ResourceRunCreateBulk {
+func (c *ResourceStateLockClient) MapCreateBulk(slice any, setFunc func(*ResourceStateLockCreate, int)) *ResourceStateLockCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceRunCreateBulk{err: fmt.Errorf("calling to ResourceRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResourceStateLockCreateBulk{err: fmt.Errorf("calling to ResourceStateLockClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceRunCreate, rv.Len())
+	builders := make([]*ResourceStateLockCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceRunCreateBulk{config: c.config, builders: builders}
+	return &ResourceStateLockCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ResourceRun.
-func (c *ResourceRunClient) Update() *ResourceRunUpdate {
-	mutation := newResourceRunMutation(c.config, OpUpdate)
-	return &ResourceRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResourceStateLock.
+func (c *ResourceStateLockClient) Update() *ResourceStateLockUpdate {
+	mutation := newResourceStateLockMutation(c.config, OpUpdate)
+	return &ResourceStateLockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceRunClient) UpdateOne(rr *ResourceRun) *ResourceRunUpdateOne {
-	mutation := newResourceRunMutation(c.config, OpUpdateOne, withResourceRun(rr))
-	return &ResourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceStateLockClient) UpdateOne(rsl *ResourceStateLock) *ResourceStateLockUpdateOne {
+	mutation := newResourceStateLockMutation(c.config, OpUpdateOne, withResourceStateLock(rsl))
+	return &ResourceStateLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceRunClient) UpdateOneID(id object.ID) *ResourceRunUpdateOne {
-	mutation := newResourceRunMutation(c.config, OpUpdateOne, withResourceRunID(id))
-	return &ResourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceStateLockClient) UpdateOneID(id object.ID) *ResourceStateLockUpdateOne {
+	mutation := newResourceStateLockMutation(c.config, OpUpdateOne, withResourceStateLockID(id))
+	return &ResourceStateLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ResourceRun.
-func (c *ResourceRunClient) Delete() *ResourceRunDelete {
-	mutation := newResourceRunMutation(c.config, OpDelete)
-	return &ResourceRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResourceStateLock.
+func (c *ResourceStateLockClient) Delete() *ResourceStateLockDelete {
+	mutation := newResourceStateLockMutation(c.config, OpDelete)
+	return &ResourceStateLockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceRunClient) DeleteOne(rr *ResourceRun) *ResourceRunDeleteOne {
-	return c.DeleteOneID(rr.ID)
+func (c *ResourceStateLockClient) DeleteOne(rsl *ResourceStateLock) *ResourceStateLockDeleteOne {
+	return c.DeleteOneID(rsl.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceRunClient) DeleteOneID(id object.ID) *ResourceRunDeleteOne {
-	builder := c.Delete().Where(resourcerun.ID(id))
+func (c *ResourceStateLockClient) DeleteOneID(id object.ID) *ResourceStateLockDeleteOne {
+	builder := c.Delete().Where(resourcestatelock.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceRunDeleteOne{builder}
+	return &ResourceStateLockDeleteOne{builder}
 }
 
-// Query returns a query builder for ResourceRun.
-func (c *ResourceRunClient) Query() *ResourceRunQuery {
-	return &ResourceRunQuery{
+// Query returns a query builder for ResourceStateLock.
+func (c *ResourceStateLockClient) Query() *ResourceStateLockQuery {
+	return &ResourceStateLockQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResourceRun},
+		ctx:    &QueryContext{Type: TypeResourceStateLock},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ResourceRun entity by its id.
-func (c *ResourceRunClient) Get(ctx context.Context, id object.ID) (*ResourceRun, error) {
-	return c.Query().Where(resourcerun.ID(id)).Only(ctx)
+// Get returns a ResourceStateLock entity by its id.
+func (c *ResourceStateLockClient) Get(ctx context.Context, id object.ID) (*ResourceStateLock, error) {
+	return c.Query().Where(resourcestatelock.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceRunClient) GetX(ctx context.Context, id object.ID) *ResourceRun {
+func (c *ResourceStateLockClient) GetX(ctx context.Context, id object.ID) *ResourceStateLock {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3746,240 +5794,259 @@ func (c *ResourceRunClient) GetX(ctx context.Context, id object.ID) *ResourceRun
 	return obj
 }
 
-// QueryProject queries the project edge of a ResourceRun.
-func (c *ResourceRunClient) QueryProject(rr *ResourceRun) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcerun.Table, resourcerun.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcerun.ProjectTable, resourcerun.ProjectColumn),
-		)
-		schemaConfig := rr.schemaConfig
-		step.To.Schema = schemaConfig.Project
-		step.Edge.Schema = schemaConfig.ResourceRun
-		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEnvironment queries the environment edge of a ResourceRun.
-func (c *ResourceRunClient) QueryEnvironment(rr *ResourceRun) *EnvironmentQuery {
-	query := (&EnvironmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rr.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcerun.Table, resourcerun.FieldID, id),
-			sqlgraph.To(environment.Table, environment.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcerun.EnvironmentTable, resourcerun.EnvironmentColumn),
-		)
-		schemaConfig := rr.schemaConfig
-		step.To.Schema = schemaConfig.Environment
-		step.Edge.Schema = schemaConfig.ResourceRun
-		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryResource queries the resource edge of a ResourceRun.
-func (c *ResourceRunClient) QueryResource(rr *ResourceRun) *ResourceQuery {
+// QueryResource queries the resource edge of a ResourceStateLock.
+func (c *ResourceStateLockClient) QueryResource(rsl *ResourceStateLock) *ResourceQuery {
 	query := (&ResourceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rr.ID
+		id := rsl.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcerun.Table, resourcerun.FieldID, id),
+			sqlgraph.From(resourcestatelock.Table, resourcestatelock.FieldID, id),
 			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, resourcerun.ResourceTable, resourcerun.ResourceColumn),
+			sqlgraph.Edge(sqlgraph.O2O, true, resourcestatelock.ResourceTable, resourcestatelock.ResourceColumn),
 		)
-		schemaConfig := rr.schemaConfig
+		schemaConfig := rsl.schemaConfig
 		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.ResourceRun
-		fromV = sqlgraph.Neighbors(rr.driver.Dialect(), step)
+		step.Edge.Schema = schemaConfig.ResourceStateLock
+		fromV = sqlgraph.Neighbors(rsl.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResourceRunClient) Hooks() []Hook {
-	hooks := c.hooks.ResourceRun
-	return append(hooks[:len(hooks):len(hooks)], resourcerun.Hooks[:]...)
+func (c *ResourceStateLockClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceStateLock
+	return append(hooks[:len(hooks):len(hooks)], resourcestatelock.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceRunClient) Interceptors() []Interceptor {
-	inters := c.inters.ResourceRun
-	return append(inters[:len(inters):len(inters)], resourcerun.Interceptors[:]...)
+func (c *ResourceStateLockClient) Interceptors() []Interceptor {
+	return c.inters.ResourceStateLock
 }
 
-func (c *ResourceRunClient) mutate(ctx context.Context, m *ResourceRunMutation) (Value, error) {
+func (c *ResourceStateLockClient) mutate(ctx context.Context, m *ResourceStateLockMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateLockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateLockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateLockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceStateLockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown ResourceRun mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceStateLock mutation op: %q", m.Op())
 	}
 }
 
-// ResourceStateClient is a client for the ResourceState schema.
-type ResourceStateClient struct {
+// ResourceStateVersionClient is a client for the ResourceStateVersion schema.
+type ResourceStateVersionClient struct {
 	config
 }
 
-// NewResourceStateClient returns a client for the ResourceState from the given config.
-func NewResourceStateClient(c config) *ResourceStateClient {
-	return &ResourceStateClient{config: c}
+// NewResourceStateVersionClient returns a client for the ResourceStateVersion from the given config.
+func NewResourceStateVersionClient(c config) *ResourceStateVersionClient {
+	return &ResourceStateVersionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `resourcestate.Hooks(f(g(h())))`.
-func (c *ResourceStateClient) Use(hooks ...Hook) {
-	c.hooks.ResourceState = append(c.hooks.ResourceState, hooks...)
+// A call to `Use(f, g, h)` equals to `resourcestateversion.Hooks(f(g(h())))`.
+func (c *ResourceStateVersionClient) Use(hooks ...Hook) {
+	c.hooks.ResourceStateVersion = append(c.hooks.ResourceStateVersion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `resourcestate.Intercept(f(g(h())))`.
-func (c *ResourceStateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ResourceState = append(c.inters.ResourceState, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `resourcestateversion.Intercept(f(g(h())))`.
+func (c *ResourceStateVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResourceStateVersion = append(c.inters.ResourceStateVersion, interceptors...)
 }
 
-// Create returns a builder for creating a ResourceState entity.
-func (c *ResourceStateClient) Create() *ResourceStateCreate {
-	mutation := newResourceStateMutation(c.config, OpCreate)
-	return &ResourceStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ResourceStateVersion entity.
+func (c *ResourceStateVersionClient) Create() *ResourceStateVersionCreate {
+	mutation := newResourceStateVersionMutation(c.config, OpCreate)
+	return &ResourceStateVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ResourceState entities.
-func (c *ResourceStateClient) CreateBulk(builders ...*ResourceStateCreate) *ResourceStateCreateBulk {
-	return &ResourceStateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ResourceStateVersion entities.
+func (c *ResourceStateVersionClient) CreateBulk(builders ...*ResourceStateVersionCreate) *ResourceStateVersionCreateBulk {
+	return &ResourceStateVersionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ResourceStateClient) MapCreateBulk(slice any, setFunc func(*ResourceStateCreate, int)) *ResourceStateCreateBulk {
+func (c *ResourceStateVersionClient) MapCreateBulk(slice any, setFunc func(*ResourceStateVersionCreate, int)) *ResourceStateVersionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ResourceStateCreateBulk{err: fmt.Errorf("calling to ResourceStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ResourceStateVersionCreateBulk{err: fmt.Errorf("calling to ResourceStateVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ResourceStateCreate, rv.Len())
+	builders := make([]*ResourceStateVersionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ResourceStateCreateBulk{config: c.config, builders: builders}
+	return &ResourceStateVersionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ResourceState.
-func (c *ResourceStateClient) Update() *ResourceStateUpdate {
-	mutation := newResourceStateMutation(c.config, OpUpdate)
-	return &ResourceStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ResourceStateVersion.
+func (c *ResourceStateVersionClient) Update() *ResourceStateVersionUpdate {
+	mutation := newResourceStateVersionMutation(c.config, OpUpdate)
+	return &ResourceStateVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ResourceStateClient) UpdateOne(rs *ResourceState) *ResourceStateUpdateOne {
-	mutation := newResourceStateMutation(c.config, OpUpdateOne, withResourceState(rs))
-	return &ResourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceStateVersionClient) UpdateOne(rsv *ResourceStateVersion) *ResourceStateVersionUpdateOne {
+	mutation := newResourceStateVersionMutation(c.config, OpUpdateOne, withResourceStateVersion(rsv))
+	return &ResourceStateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ResourceStateClient) UpdateOneID(id object.ID) *ResourceStateUpdateOne {
-	mutation := newResourceStateMutation(c.config, OpUpdateOne, withResourceStateID(id))
-	return &ResourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ResourceStateVersionClient) UpdateOneID(id object.ID) *ResourceStateVersionUpdateOne {
+	mutation := newResourceStateVersionMutation(c.config, OpUpdateOne, withResourceStateVersionID(id))
+	return &ResourceStateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ResourceState.
-func (c *ResourceStateClient) Delete() *ResourceStateDelete {
-	mutation := newResourceStateMutation(c.config, OpDelete)
-	return &ResourceStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ResourceStateVersion.
+func (c *ResourceStateVersionClient) Delete() *ResourceStateVersionDelete {
+	mutation := newResourceStateVersionMutation(c.config, OpDelete)
+	return &ResourceStateVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ResourceStateClient) DeleteOne(rs *ResourceState) *ResourceStateDeleteOne {
-	return c.DeleteOneID(rs.ID)
+func (c *ResourceStateVersionClient) DeleteOne(rsv *ResourceStateVersion) *ResourceStateVersionDeleteOne {
+	return c.DeleteOneID(rsv.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ResourceStateClient) DeleteOneID(id object.ID) *ResourceStateDeleteOne {
-	builder := c.Delete().Where(resourcestate.ID(id))
+func (c *ResourceStateVersionClient) DeleteOneID(id object.ID) *ResourceStateVersionDeleteOne {
+	builder := c.Delete().Where(resourcestateversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ResourceStateDeleteOne{builder}
+	return &ResourceStateVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for ResourceState.
-func (c *ResourceStateClient) Query() *ResourceStateQuery {
-	return &ResourceStateQuery{
+// Query returns a query builder for ResourceStateVersion.
+func (c *ResourceStateVersionClient) Query() *ResourceStateVersionQuery {
+	return &ResourceStateVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeResourceState},
+		ctx:    &QueryContext{Type: TypeResourceStateVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ResourceState entity by its id.
-func (c *ResourceStateClient) Get(ctx context.Context, id object.ID) (*ResourceState, error) {
-	return c.Query().Where(resourcestate.ID(id)).Only(ctx)
+// Get returns a ResourceStateVersion entity by its id.
+func (c *ResourceStateVersionClient) Get(ctx context.Context, id object.ID) (*ResourceStateVersion, error) {
+	return c.Query().Where(resourcestateversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ResourceStateClient) GetX(ctx context.Context, id object.ID) *ResourceState {
+func (c *ResourceStateVersionClient) GetX(ctx context.Context, id object.ID) *ResourceStateVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
 	}
-	return obj
+	return obj
+}
+
+// QueryProject queries the project edge of a ResourceStateVersion.
+func (c *ResourceStateVersionClient) QueryProject(rsv *ResourceStateVersion) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rsv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcestateversion.Table, resourcestateversion.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcestateversion.ProjectTable, resourcestateversion.ProjectColumn),
+		)
+		schemaConfig := rsv.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromV = sqlgraph.Neighbors(rsv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEnvironment queries the environment edge of a ResourceStateVersion.
+func (c *ResourceStateVersionClient) QueryEnvironment(rsv *ResourceStateVersion) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rsv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcestateversion.Table, resourcestateversion.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcestateversion.EnvironmentTable, resourcestateversion.EnvironmentColumn),
+		)
+		schemaConfig := rsv.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromV = sqlgraph.Neighbors(rsv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-// QueryResource queries the resource edge of a ResourceState.
-func (c *ResourceStateClient) QueryResource(rs *ResourceState) *ResourceQuery {
+// QueryResource queries the resource edge of a ResourceStateVersion.
+func (c *ResourceStateVersionClient) QueryResource(rsv *ResourceStateVersion) *ResourceQuery {
 	query := (&ResourceClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := rs.ID
+		id := rsv.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(resourcestate.Table, resourcestate.FieldID, id),
+			sqlgraph.From(resourcestateversion.Table, resourcestateversion.FieldID, id),
 			sqlgraph.To(resource.Table, resource.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, resourcestate.ResourceTable, resourcestate.ResourceColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcestateversion.ResourceTable, resourcestateversion.ResourceColumn),
 		)
-		schemaConfig := rs.schemaConfig
+		schemaConfig := rsv.schemaConfig
 		step.To.Schema = schemaConfig.Resource
-		step.Edge.Schema = schemaConfig.ResourceState
-		fromV = sqlgraph.Neighbors(rs.driver.Dialect(), step)
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromV = sqlgraph.Neighbors(rsv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRun queries the run edge of a ResourceStateVersion.
+func (c *ResourceStateVersionClient) QueryRun(rsv *ResourceStateVersion) *ResourceRunQuery {
+	query := (&ResourceRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rsv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcestateversion.Table, resourcestateversion.FieldID, id),
+			sqlgraph.To(resourcerun.Table, resourcerun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcestateversion.RunTable, resourcestateversion.RunColumn),
+		)
+		schemaConfig := rsv.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRun
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromV = sqlgraph.Neighbors(rsv.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *ResourceStateClient) Hooks() []Hook {
-	hooks := c.hooks.ResourceState
-	return append(hooks[:len(hooks):len(hooks)], resourcestate.Hooks[:]...)
+func (c *ResourceStateVersionClient) Hooks() []Hook {
+	hooks := c.hooks.ResourceStateVersion
+	return append(hooks[:len(hooks):len(hooks)], resourcestateversion.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *ResourceStateClient) Interceptors() []Interceptor {
-	return c.inters.ResourceState
+func (c *ResourceStateVersionClient) Interceptors() []Interceptor {
+	inters := c.inters.ResourceStateVersion
+	return append(inters[:len(inters):len(inters)], resourcestateversion.Interceptors[:]...)
 }
 
-func (c *ResourceStateClient) mutate(ctx context.Context, m *ResourceStateMutation) (Value, error) {
+func (c *ResourceStateVersionClient) mutate(ctx context.Context, m *ResourceStateVersionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ResourceStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ResourceStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ResourceStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ResourceStateVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ResourceStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ResourceStateVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown ResourceState mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown ResourceStateVersion mutation op: %q", m.Op())
 	}
 }
 
@@ -4270,6 +6337,140 @@ func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value,
 	}
 }
 
+// StorageObjectClient is a client for the StorageObject schema.
+type StorageObjectClient struct {
+	config
+}
+
+// NewStorageObjectClient returns a client for the StorageObject from the given config.
+func NewStorageObjectClient(c config) *StorageObjectClient {
+	return &StorageObjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `storageobject.Hooks(f(g(h())))`.
+func (c *StorageObjectClient) Use(hooks ...Hook) {
+	c.hooks.StorageObject = append(c.hooks.StorageObject, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `storageobject.Intercept(f(g(h())))`.
+func (c *StorageObjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StorageObject = append(c.inters.StorageObject, interceptors...)
+}
+
+// Create returns a builder for creating a StorageObject entity.
+func (c *StorageObjectClient) Create() *StorageObjectCreate {
+	mutation := newStorageObjectMutation(c.config, OpCreate)
+	return &StorageObjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StorageObject entities.
+func (c *StorageObjectClient) CreateBulk(builders ...*StorageObjectCreate) *StorageObjectCreateBulk {
+	return &StorageObjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StorageObjectClient) MapCreateBulk(slice any, setFunc func(*StorageObjectCreate, int)) *StorageObjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StorageObjectCreateBulk{err: fmt.Errorf("calling to StorageObjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StorageObjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StorageObjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StorageObject.
+func (c *StorageObjectClient) Update() *StorageObjectUpdate {
+	mutation := newStorageObjectMutation(c.config, OpUpdate)
+	return &StorageObjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StorageObjectClient) UpdateOne(so *StorageObject) *StorageObjectUpdateOne {
+	mutation := newStorageObjectMutation(c.config, OpUpdateOne, withStorageObject(so))
+	return &StorageObjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StorageObjectClient) UpdateOneID(id object.ID) *StorageObjectUpdateOne {
+	mutation := newStorageObjectMutation(c.config, OpUpdateOne, withStorageObjectID(id))
+	return &StorageObjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StorageObject.
+func (c *StorageObjectClient) Delete() *StorageObjectDelete {
+	mutation := newStorageObjectMutation(c.config, OpDelete)
+	return &StorageObjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StorageObjectClient) DeleteOne(so *StorageObject) *StorageObjectDeleteOne {
+	return c.DeleteOneID(so.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StorageObjectClient) DeleteOneID(id object.ID) *StorageObjectDeleteOne {
+	builder := c.Delete().Where(storageobject.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StorageObjectDeleteOne{builder}
+}
+
+// Query returns a query builder for StorageObject.
+func (c *StorageObjectClient) Query() *StorageObjectQuery {
+	return &StorageObjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStorageObject},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StorageObject entity by its id.
+func (c *StorageObjectClient) Get(ctx context.Context, id object.ID) (*StorageObject, error) {
+	return c.Query().Where(storageobject.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StorageObjectClient) GetX(ctx context.Context, id object.ID) *StorageObject {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *StorageObjectClient) Hooks() []Hook {
+	hooks := c.hooks.StorageObject
+	return append(hooks[:len(hooks):len(hooks)], storageobject.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *StorageObjectClient) Interceptors() []Interceptor {
+	return c.inters.StorageObject
+}
+
+func (c *StorageObjectClient) mutate(ctx context.Context, m *StorageObjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StorageObjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StorageObjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StorageObjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StorageObjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown StorageObject mutation op: %q", m.Op())
+	}
+}
+
 // SubjectClient is a client for the Subject schema.
 type SubjectClient struct {
 	config
@@ -4734,7 +6935,199 @@ func (c *TemplateClient) Get(ctx context.Context, id object.ID) (*Template, erro
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TemplateClient) GetX(ctx context.Context, id object.ID) *Template {
+func (c *TemplateClient) GetX(ctx context.Context, id object.ID) *Template {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVersions queries the versions edge of a Template.
+func (c *TemplateClient) QueryVersions(t *Template) *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, template.VersionsTable, template.VersionsColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateVersion
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCatalog queries the catalog edge of a Template.
+func (c *TemplateClient) QueryCatalog(t *Template) *CatalogQuery {
+	query := (&CatalogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(catalog.Table, catalog.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, template.CatalogTable, template.CatalogColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.Catalog
+		step.Edge.Schema = schemaConfig.Template
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProject queries the project edge of a Template.
+func (c *TemplateClient) QueryProject(t *Template) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(template.Table, template.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, template.ProjectTable, template.ProjectColumn),
+		)
+		schemaConfig := t.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.Template
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TemplateClient) Hooks() []Hook {
+	hooks := c.hooks.Template
+	return append(hooks[:len(hooks):len(hooks)], template.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TemplateClient) Interceptors() []Interceptor {
+	inters := c.inters.Template
+	return append(inters[:len(inters):len(inters)], template.Interceptors[:]...)
+}
+
+func (c *TemplateClient) mutate(ctx context.Context, m *TemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown Template mutation op: %q", m.Op())
+	}
+}
+
+// TemplateUpgradeCampaignClient is a client for the TemplateUpgradeCampaign schema.
+type TemplateUpgradeCampaignClient struct {
+	config
+}
+
+// NewTemplateUpgradeCampaignClient returns a client for the TemplateUpgradeCampaign from the given config.
+func NewTemplateUpgradeCampaignClient(c config) *TemplateUpgradeCampaignClient {
+	return &TemplateUpgradeCampaignClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `templateupgradecampaign.Hooks(f(g(h())))`.
+func (c *TemplateUpgradeCampaignClient) Use(hooks ...Hook) {
+	c.hooks.TemplateUpgradeCampaign = append(c.hooks.TemplateUpgradeCampaign, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `templateupgradecampaign.Intercept(f(g(h())))`.
+func (c *TemplateUpgradeCampaignClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TemplateUpgradeCampaign = append(c.inters.TemplateUpgradeCampaign, interceptors...)
+}
+
+// Create returns a builder for creating a TemplateUpgradeCampaign entity.
+func (c *TemplateUpgradeCampaignClient) Create() *TemplateUpgradeCampaignCreate {
+	mutation := newTemplateUpgradeCampaignMutation(c.config, OpCreate)
+	return &TemplateUpgradeCampaignCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TemplateUpgradeCampaign entities.
+func (c *TemplateUpgradeCampaignClient) CreateBulk(builders ...*TemplateUpgradeCampaignCreate) *TemplateUpgradeCampaignCreateBulk {
+	return &TemplateUpgradeCampaignCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TemplateUpgradeCampaignClient) MapCreateBulk(slice any, setFunc func(*TemplateUpgradeCampaignCreate, int)) *TemplateUpgradeCampaignCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TemplateUpgradeCampaignCreateBulk{err: fmt.Errorf("calling to TemplateUpgradeCampaignClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TemplateUpgradeCampaignCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TemplateUpgradeCampaignCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TemplateUpgradeCampaign.
+func (c *TemplateUpgradeCampaignClient) Update() *TemplateUpgradeCampaignUpdate {
+	mutation := newTemplateUpgradeCampaignMutation(c.config, OpUpdate)
+	return &TemplateUpgradeCampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TemplateUpgradeCampaignClient) UpdateOne(tuc *TemplateUpgradeCampaign) *TemplateUpgradeCampaignUpdateOne {
+	mutation := newTemplateUpgradeCampaignMutation(c.config, OpUpdateOne, withTemplateUpgradeCampaign(tuc))
+	return &TemplateUpgradeCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TemplateUpgradeCampaignClient) UpdateOneID(id object.ID) *TemplateUpgradeCampaignUpdateOne {
+	mutation := newTemplateUpgradeCampaignMutation(c.config, OpUpdateOne, withTemplateUpgradeCampaignID(id))
+	return &TemplateUpgradeCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TemplateUpgradeCampaign.
+func (c *TemplateUpgradeCampaignClient) Delete() *TemplateUpgradeCampaignDelete {
+	mutation := newTemplateUpgradeCampaignMutation(c.config, OpDelete)
+	return &TemplateUpgradeCampaignDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TemplateUpgradeCampaignClient) DeleteOne(tuc *TemplateUpgradeCampaign) *TemplateUpgradeCampaignDeleteOne {
+	return c.DeleteOneID(tuc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TemplateUpgradeCampaignClient) DeleteOneID(id object.ID) *TemplateUpgradeCampaignDeleteOne {
+	builder := c.Delete().Where(templateupgradecampaign.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TemplateUpgradeCampaignDeleteOne{builder}
+}
+
+// Query returns a query builder for TemplateUpgradeCampaign.
+func (c *TemplateUpgradeCampaignClient) Query() *TemplateUpgradeCampaignQuery {
+	return &TemplateUpgradeCampaignQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTemplateUpgradeCampaign},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TemplateUpgradeCampaign entity by its id.
+func (c *TemplateUpgradeCampaignClient) Get(ctx context.Context, id object.ID) (*TemplateUpgradeCampaign, error) {
+	return c.Query().Where(templateupgradecampaign.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TemplateUpgradeCampaignClient) GetX(ctx context.Context, id object.ID) *TemplateUpgradeCampaign {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4742,87 +7135,68 @@ func (c *TemplateClient) GetX(ctx context.Context, id object.ID) *Template {
 	return obj
 }
 
-// QueryVersions queries the versions edge of a Template.
-func (c *TemplateClient) QueryVersions(t *Template) *TemplateVersionQuery {
-	query := (&TemplateVersionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(template.Table, template.FieldID, id),
-			sqlgraph.To(templateversion.Table, templateversion.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, template.VersionsTable, template.VersionsColumn),
-		)
-		schemaConfig := t.schemaConfig
-		step.To.Schema = schemaConfig.TemplateVersion
-		step.Edge.Schema = schemaConfig.TemplateVersion
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryCatalog queries the catalog edge of a Template.
-func (c *TemplateClient) QueryCatalog(t *Template) *CatalogQuery {
-	query := (&CatalogClient{config: c.config}).Query()
+// QueryProject queries the project edge of a TemplateUpgradeCampaign.
+func (c *TemplateUpgradeCampaignClient) QueryProject(tuc *TemplateUpgradeCampaign) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := tuc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(template.Table, template.FieldID, id),
-			sqlgraph.To(catalog.Table, catalog.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, template.CatalogTable, template.CatalogColumn),
+			sqlgraph.From(templateupgradecampaign.Table, templateupgradecampaign.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, templateupgradecampaign.ProjectTable, templateupgradecampaign.ProjectColumn),
 		)
-		schemaConfig := t.schemaConfig
-		step.To.Schema = schemaConfig.Catalog
-		step.Edge.Schema = schemaConfig.Template
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		schemaConfig := tuc.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		fromV = sqlgraph.Neighbors(tuc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
-// QueryProject queries the project edge of a Template.
-func (c *TemplateClient) QueryProject(t *Template) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
+// QueryTemplateVersion queries the template_version edge of a TemplateUpgradeCampaign.
+func (c *TemplateUpgradeCampaignClient) QueryTemplateVersion(tuc *TemplateUpgradeCampaign) *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := t.ID
+		id := tuc.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(template.Table, template.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, template.ProjectTable, template.ProjectColumn),
+			sqlgraph.From(templateupgradecampaign.Table, templateupgradecampaign.FieldID, id),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, templateupgradecampaign.TemplateVersionTable, templateupgradecampaign.TemplateVersionColumn),
 		)
-		schemaConfig := t.schemaConfig
-		step.To.Schema = schemaConfig.Project
-		step.Edge.Schema = schemaConfig.Template
-		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		schemaConfig := tuc.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		fromV = sqlgraph.Neighbors(tuc.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *TemplateClient) Hooks() []Hook {
-	hooks := c.hooks.Template
-	return append(hooks[:len(hooks):len(hooks)], template.Hooks[:]...)
+func (c *TemplateUpgradeCampaignClient) Hooks() []Hook {
+	hooks := c.hooks.TemplateUpgradeCampaign
+	return append(hooks[:len(hooks):len(hooks)], templateupgradecampaign.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
-func (c *TemplateClient) Interceptors() []Interceptor {
-	inters := c.inters.Template
-	return append(inters[:len(inters):len(inters)], template.Interceptors[:]...)
+func (c *TemplateUpgradeCampaignClient) Interceptors() []Interceptor {
+	inters := c.inters.TemplateUpgradeCampaign
+	return append(inters[:len(inters):len(inters)], templateupgradecampaign.Interceptors[:]...)
 }
 
-func (c *TemplateClient) mutate(ctx context.Context, m *TemplateMutation) (Value, error) {
+func (c *TemplateUpgradeCampaignClient) mutate(ctx context.Context, m *TemplateUpgradeCampaignMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TemplateUpgradeCampaignCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TemplateUpgradeCampaignUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TemplateUpgradeCampaignUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TemplateUpgradeCampaignDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("model: unknown Template mutation op: %q", m.Op())
+		return nil, fmt.Errorf("model: unknown TemplateUpgradeCampaign mutation op: %q", m.Op())
 	}
 }
 
@@ -4972,6 +7346,25 @@ func (c *TemplateVersionClient) QueryResources(tv *TemplateVersion) *ResourceQue
 	return query
 }
 
+// QueryUpgradeCampaigns queries the upgrade_campaigns edge of a TemplateVersion.
+func (c *TemplateVersionClient) QueryUpgradeCampaigns(tv *TemplateVersion) *TemplateUpgradeCampaignQuery {
+	query := (&TemplateUpgradeCampaignClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(templateversion.Table, templateversion.FieldID, id),
+			sqlgraph.To(templateupgradecampaign.Table, templateupgradecampaign.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, templateversion.UpgradeCampaignsTable, templateversion.UpgradeCampaignsColumn),
+		)
+		schemaConfig := tv.schemaConfig
+		step.To.Schema = schemaConfig.TemplateUpgradeCampaign
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		fromV = sqlgraph.Neighbors(tv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryResourceDefinitions queries the resource_definitions edge of a TemplateVersion.
 func (c *TemplateVersionClient) QueryResourceDefinitions(tv *TemplateVersion) *ResourceDefinitionMatchingRuleQuery {
 	query := (&ResourceDefinitionMatchingRuleClient{config: c.config}).Query()
@@ -5364,6 +7757,332 @@ func (c *VariableClient) mutate(ctx context.Context, m *VariableMutation) (Value
 	}
 }
 
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(w))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id object.ID) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id object.ID) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id object.ID) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id object.ID) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Webhook.
+func (c *WebhookClient) QueryProject(w *Webhook) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhook.ProjectTable, webhook.ProjectColumn),
+		)
+		schemaConfig := w.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.Webhook
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(w *Webhook) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		schemaConfig := w.schemaConfig
+		step.To.Schema = schemaConfig.WebhookDelivery
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	hooks := c.hooks.Webhook
+	return append(hooks[:len(hooks):len(hooks)], webhook.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookClient) Interceptors() []Interceptor {
+	inters := c.inters.Webhook
+	return append(inters[:len(inters):len(inters)], webhook.Interceptors[:]...)
+}
+
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown Webhook mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id object.ID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id object.ID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id object.ID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id object.ID) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		schemaConfig := wd.schemaConfig
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.WebhookDelivery
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.WebhookDelivery
+	return append(hooks[:len(hooks):len(hooks)], webhookdelivery.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("model: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // WorkflowClient is a client for the Workflow schema.
 type WorkflowClient struct {
 	config
@@ -6481,22 +9200,30 @@ func (c *WorkflowStepExecutionClient) mutate(ctx context.Context, m *WorkflowSte
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Catalog, Connector, CostReport, DistributeLock, Environment,
+		AuditLog, Budget, BudgetEvent, Catalog, ChargebackStatement, Connector,
+		CostAnomaly, CostReport, DataEncryptionKey, DistributeLock, Environment,
 		EnvironmentConnectorRelationship, Perspective, Project, Resource,
 		ResourceComponent, ResourceComponentRelationship, ResourceDefinition,
 		ResourceDefinitionMatchingRule, ResourceRelationship, ResourceRun,
-		ResourceState, Role, Setting, Subject, SubjectRoleRelationship, Template,
-		TemplateVersion, Token, Variable, Workflow, WorkflowExecution, WorkflowStage,
-		WorkflowStageExecution, WorkflowStep, WorkflowStepExecution []ent.Hook
+		ResourceRunApprovalRule, ResourceRunPolicy, ResourceState, ResourceStateLock,
+		ResourceStateVersion, Role, Setting, StorageObject, Subject,
+		SubjectRoleRelationship, Template, TemplateUpgradeCampaign, TemplateVersion,
+		Token, Variable, Webhook, WebhookDelivery, Workflow, WorkflowExecution,
+		WorkflowStage, WorkflowStageExecution, WorkflowStep,
+		WorkflowStepExecution []ent.Hook
 	}
 	inters struct {
-		Catalog, Connector, CostReport, DistributeLock, Environment,
+		AuditLog, Budget, BudgetEvent, Catalog, ChargebackStatement, Connector,
+		CostAnomaly, CostReport, DataEncryptionKey, DistributeLock, Environment,
 		EnvironmentConnectorRelationship, Perspective, Project, Resource,
 		ResourceComponent, ResourceComponentRelationship, ResourceDefinition,
 		ResourceDefinitionMatchingRule, ResourceRelationship, ResourceRun,
-		ResourceState, Role, Setting, Subject, SubjectRoleRelationship, Template,
-		TemplateVersion, Token, Variable, Workflow, WorkflowExecution, WorkflowStage,
-		WorkflowStageExecution, WorkflowStep, WorkflowStepExecution []ent.Interceptor
+		ResourceRunApprovalRule, ResourceRunPolicy, ResourceState, ResourceStateLock,
+		ResourceStateVersion, Role, Setting, StorageObject, Subject,
+		SubjectRoleRelationship, Template, TemplateUpgradeCampaign, TemplateVersion,
+		Token, Variable, Webhook, WebhookDelivery, Workflow, WorkflowExecution,
+		WorkflowStage, WorkflowStageExecution, WorkflowStep,
+		WorkflowStepExecution []ent.Interceptor
 	}
 )
 
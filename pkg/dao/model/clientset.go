@@ -9,15 +9,33 @@ import "context"
 
 // ClientSet is an interface that allows getting all clients.
 type ClientSet interface {
+	// AuditLogs returns the client for interacting with the AuditLog builders.
+	AuditLogs() *AuditLogClient
+
+	// Budgets returns the client for interacting with the Budget builders.
+	Budgets() *BudgetClient
+
+	// BudgetEvents returns the client for interacting with the BudgetEvent builders.
+	BudgetEvents() *BudgetEventClient
+
 	// Catalogs returns the client for interacting with the Catalog builders.
 	Catalogs() *CatalogClient
 
+	// ChargebackStatements returns the client for interacting with the ChargebackStatement builders.
+	ChargebackStatements() *ChargebackStatementClient
+
 	// Connectors returns the client for interacting with the Connector builders.
 	Connectors() *ConnectorClient
 
+	// CostAnomalies returns the client for interacting with the CostAnomaly builders.
+	CostAnomalies() *CostAnomalyClient
+
 	// CostReports returns the client for interacting with the CostReport builders.
 	CostReports() *CostReportClient
 
+	// DataEncryptionKeys returns the client for interacting with the DataEncryptionKey builders.
+	DataEncryptionKeys() *DataEncryptionKeyClient
+
 	// DistributeLocks returns the client for interacting with the DistributeLock builders.
 	DistributeLocks() *DistributeLockClient
 
@@ -54,15 +72,30 @@ type ClientSet interface {
 	// ResourceRuns returns the client for interacting with the ResourceRun builders.
 	ResourceRuns() *ResourceRunClient
 
+	// ResourceRunApprovalRules returns the client for interacting with the ResourceRunApprovalRule builders.
+	ResourceRunApprovalRules() *ResourceRunApprovalRuleClient
+
+	// ResourceRunPolicies returns the client for interacting with the ResourceRunPolicy builders.
+	ResourceRunPolicies() *ResourceRunPolicyClient
+
 	// ResourceStates returns the client for interacting with the ResourceState builders.
 	ResourceStates() *ResourceStateClient
 
+	// ResourceStateLocks returns the client for interacting with the ResourceStateLock builders.
+	ResourceStateLocks() *ResourceStateLockClient
+
+	// ResourceStateVersions returns the client for interacting with the ResourceStateVersion builders.
+	ResourceStateVersions() *ResourceStateVersionClient
+
 	// Roles returns the client for interacting with the Role builders.
 	Roles() *RoleClient
 
 	// Settings returns the client for interacting with the Setting builders.
 	Settings() *SettingClient
 
+	// StorageObjects returns the client for interacting with the StorageObject builders.
+	StorageObjects() *StorageObjectClient
+
 	// Subjects returns the client for interacting with the Subject builders.
 	Subjects() *SubjectClient
 
@@ -72,6 +105,9 @@ type ClientSet interface {
 	// Templates returns the client for interacting with the Template builders.
 	Templates() *TemplateClient
 
+	// TemplateUpgradeCampaigns returns the client for interacting with the TemplateUpgradeCampaign builders.
+	TemplateUpgradeCampaigns() *TemplateUpgradeCampaignClient
+
 	// TemplateVersions returns the client for interacting with the TemplateVersion builders.
 	TemplateVersions() *TemplateVersionClient
 
@@ -81,6 +117,12 @@ type ClientSet interface {
 	// Variables returns the client for interacting with the Variable builders.
 	Variables() *VariableClient
 
+	// Webhooks returns the client for interacting with the Webhook builders.
+	Webhooks() *WebhookClient
+
+	// WebhookDeliveries returns the client for interacting with the WebhookDelivery builders.
+	WebhookDeliveries() *WebhookDeliveryClient
+
 	// Workflows returns the client for interacting with the Workflow builders.
 	Workflows() *WorkflowClient
 
@@ -116,24 +158,60 @@ type ClientSet interface {
 	WithTx(context.Context, func(tx *Tx) error) error
 }
 
+// AuditLogClientGetter is an interface that allows getting AuditLogClient.
+type AuditLogClientGetter interface {
+	// AuditLogs returns the client for interacting with the AuditLog builders.
+	AuditLogs() *AuditLogClient
+}
+
+// BudgetClientGetter is an interface that allows getting BudgetClient.
+type BudgetClientGetter interface {
+	// Budgets returns the client for interacting with the Budget builders.
+	Budgets() *BudgetClient
+}
+
+// BudgetEventClientGetter is an interface that allows getting BudgetEventClient.
+type BudgetEventClientGetter interface {
+	// BudgetEvents returns the client for interacting with the BudgetEvent builders.
+	BudgetEvents() *BudgetEventClient
+}
+
 // CatalogClientGetter is an interface that allows getting CatalogClient.
 type CatalogClientGetter interface {
 	// Catalogs returns the client for interacting with the Catalog builders.
 	Catalogs() *CatalogClient
 }
 
+// ChargebackStatementClientGetter is an interface that allows getting ChargebackStatementClient.
+type ChargebackStatementClientGetter interface {
+	// ChargebackStatements returns the client for interacting with the ChargebackStatement builders.
+	ChargebackStatements() *ChargebackStatementClient
+}
+
 // ConnectorClientGetter is an interface that allows getting ConnectorClient.
 type ConnectorClientGetter interface {
 	// Connectors returns the client for interacting with the Connector builders.
 	Connectors() *ConnectorClient
 }
 
+// CostAnomalyClientGetter is an interface that allows getting CostAnomalyClient.
+type CostAnomalyClientGetter interface {
+	// CostAnomalies returns the client for interacting with the CostAnomaly builders.
+	CostAnomalies() *CostAnomalyClient
+}
+
 // CostReportClientGetter is an interface that allows getting CostReportClient.
 type CostReportClientGetter interface {
 	// CostReports returns the client for interacting with the CostReport builders.
 	CostReports() *CostReportClient
 }
 
+// DataEncryptionKeyClientGetter is an interface that allows getting DataEncryptionKeyClient.
+type DataEncryptionKeyClientGetter interface {
+	// DataEncryptionKeys returns the client for interacting with the DataEncryptionKey builders.
+	DataEncryptionKeys() *DataEncryptionKeyClient
+}
+
 // DistributeLockClientGetter is an interface that allows getting DistributeLockClient.
 type DistributeLockClientGetter interface {
 	// DistributeLocks returns the client for interacting with the DistributeLock builders.
@@ -206,12 +284,36 @@ type ResourceRunClientGetter interface {
 	ResourceRuns() *ResourceRunClient
 }
 
+// ResourceRunApprovalRuleClientGetter is an interface that allows getting ResourceRunApprovalRuleClient.
+type ResourceRunApprovalRuleClientGetter interface {
+	// ResourceRunApprovalRules returns the client for interacting with the ResourceRunApprovalRule builders.
+	ResourceRunApprovalRules() *ResourceRunApprovalRuleClient
+}
+
+// ResourceRunPolicyClientGetter is an interface that allows getting ResourceRunPolicyClient.
+type ResourceRunPolicyClientGetter interface {
+	// ResourceRunPolicies returns the client for interacting with the ResourceRunPolicy builders.
+	ResourceRunPolicies() *ResourceRunPolicyClient
+}
+
 // ResourceStateClientGetter is an interface that allows getting ResourceStateClient.
 type ResourceStateClientGetter interface {
 	// ResourceStates returns the client for interacting with the ResourceState builders.
 	ResourceStates() *ResourceStateClient
 }
 
+// ResourceStateLockClientGetter is an interface that allows getting ResourceStateLockClient.
+type ResourceStateLockClientGetter interface {
+	// ResourceStateLocks returns the client for interacting with the ResourceStateLock builders.
+	ResourceStateLocks() *ResourceStateLockClient
+}
+
+// ResourceStateVersionClientGetter is an interface that allows getting ResourceStateVersionClient.
+type ResourceStateVersionClientGetter interface {
+	// ResourceStateVersions returns the client for interacting with the ResourceStateVersion builders.
+	ResourceStateVersions() *ResourceStateVersionClient
+}
+
 // RoleClientGetter is an interface that allows getting RoleClient.
 type RoleClientGetter interface {
 	// Roles returns the client for interacting with the Role builders.
@@ -224,6 +326,12 @@ type SettingClientGetter interface {
 	Settings() *SettingClient
 }
 
+// StorageObjectClientGetter is an interface that allows getting StorageObjectClient.
+type StorageObjectClientGetter interface {
+	// StorageObjects returns the client for interacting with the StorageObject builders.
+	StorageObjects() *StorageObjectClient
+}
+
 // SubjectClientGetter is an interface that allows getting SubjectClient.
 type SubjectClientGetter interface {
 	// Subjects returns the client for interacting with the Subject builders.
@@ -242,6 +350,12 @@ type TemplateClientGetter interface {
 	Templates() *TemplateClient
 }
 
+// TemplateUpgradeCampaignClientGetter is an interface that allows getting TemplateUpgradeCampaignClient.
+type TemplateUpgradeCampaignClientGetter interface {
+	// TemplateUpgradeCampaigns returns the client for interacting with the TemplateUpgradeCampaign builders.
+	TemplateUpgradeCampaigns() *TemplateUpgradeCampaignClient
+}
+
 // TemplateVersionClientGetter is an interface that allows getting TemplateVersionClient.
 type TemplateVersionClientGetter interface {
 	// TemplateVersions returns the client for interacting with the TemplateVersion builders.
@@ -260,6 +374,18 @@ type VariableClientGetter interface {
 	Variables() *VariableClient
 }
 
+// WebhookClientGetter is an interface that allows getting WebhookClient.
+type WebhookClientGetter interface {
+	// Webhooks returns the client for interacting with the Webhook builders.
+	Webhooks() *WebhookClient
+}
+
+// WebhookDeliveryClientGetter is an interface that allows getting WebhookDeliveryClient.
+type WebhookDeliveryClientGetter interface {
+	// WebhookDeliveries returns the client for interacting with the WebhookDelivery builders.
+	WebhookDeliveries() *WebhookDeliveryClient
+}
+
 // WorkflowClientGetter is an interface that allows getting WorkflowClient.
 type WorkflowClientGetter interface {
 	// Workflows returns the client for interacting with the Workflow builders.
@@ -57,6 +57,8 @@ type Connector struct {
 	EnableFinOps bool `json:"enable_fin_ops,omitempty"`
 	// Custom pricing user defined.
 	FinOpsCustomPricing *types.FinOpsCustomPricing `json:"fin_ops_custom_pricing,omitempty"`
+	// Billing export source to import the cost of the cloud provider connector.
+	FinOpsBillingSource *types.FinOpsBillingSource `json:"fin_ops_billing_source,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ConnectorQuery when eager-loading is set.
 	Edges        ConnectorEdges `json:"edges,omitempty"`
@@ -73,9 +75,11 @@ type ConnectorEdges struct {
 	ResourceComponents []*ResourceComponent `json:"resource_components,omitempty"`
 	// CostReports that linked to the connection.
 	CostReports []*CostReport `json:"cost_reports,omitempty"`
+	// CostAnomalies that detected from the namespaces of the connection.
+	CostAnomalies []*CostAnomaly `json:"cost_anomalies,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -118,12 +122,21 @@ func (e ConnectorEdges) CostReportsOrErr() ([]*CostReport, error) {
 	return nil, &NotLoadedError{edge: "cost_reports"}
 }
 
+// CostAnomaliesOrErr returns the CostAnomalies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ConnectorEdges) CostAnomaliesOrErr() ([]*CostAnomaly, error) {
+	if e.loadedTypes[4] {
+		return e.CostAnomalies, nil
+	}
+	return nil, &NotLoadedError{edge: "cost_anomalies"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Connector) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case connector.FieldLabels, connector.FieldAnnotations, connector.FieldStatus, connector.FieldFinOpsCustomPricing:
+		case connector.FieldLabels, connector.FieldAnnotations, connector.FieldStatus, connector.FieldFinOpsCustomPricing, connector.FieldFinOpsBillingSource:
 			values[i] = new([]byte)
 		case connector.FieldConfigData:
 			values[i] = new(crypto.Properties)
@@ -256,6 +269,14 @@ func (c *Connector) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field fin_ops_custom_pricing: %w", err)
 				}
 			}
+		case connector.FieldFinOpsBillingSource:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fin_ops_billing_source", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.FinOpsBillingSource); err != nil {
+					return fmt.Errorf("unmarshal field fin_ops_billing_source: %w", err)
+				}
+			}
 		default:
 			c.selectValues.Set(columns[i], values[i])
 		}
@@ -289,6 +310,11 @@ func (c *Connector) QueryCostReports() *CostReportQuery {
 	return NewConnectorClient(c.config).QueryCostReports(c)
 }
 
+// QueryCostAnomalies queries the "cost_anomalies" edge of the Connector entity.
+func (c *Connector) QueryCostAnomalies() *CostAnomalyQuery {
+	return NewConnectorClient(c.config).QueryCostAnomalies(c)
+}
+
 // Update returns a builder for updating this Connector.
 // Note that you need to call Connector.Unwrap() before calling this method if this Connector
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -360,6 +386,9 @@ func (c *Connector) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("fin_ops_custom_pricing=")
 	builder.WriteString(fmt.Sprintf("%v", c.FinOpsCustomPricing))
+	builder.WriteString(", ")
+	builder.WriteString("fin_ops_billing_source=")
+	builder.WriteString(fmt.Sprintf("%v", c.FinOpsBillingSource))
 	builder.WriteByte(')')
 	return builder.String()
 }
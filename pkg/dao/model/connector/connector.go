@@ -51,6 +51,8 @@ const (
 	FieldEnableFinOps = "enable_fin_ops"
 	// FieldFinOpsCustomPricing holds the string denoting the fin_ops_custom_pricing field in the database.
 	FieldFinOpsCustomPricing = "fin_ops_custom_pricing"
+	// FieldFinOpsBillingSource holds the string denoting the fin_ops_billing_source field in the database.
+	FieldFinOpsBillingSource = "fin_ops_billing_source"
 	// EdgeProject holds the string denoting the project edge name in mutations.
 	EdgeProject = "project"
 	// EdgeEnvironments holds the string denoting the environments edge name in mutations.
@@ -59,6 +61,8 @@ const (
 	EdgeResourceComponents = "resource_components"
 	// EdgeCostReports holds the string denoting the cost_reports edge name in mutations.
 	EdgeCostReports = "cost_reports"
+	// EdgeCostAnomalies holds the string denoting the cost_anomalies edge name in mutations.
+	EdgeCostAnomalies = "cost_anomalies"
 	// Table holds the table name of the connector in the database.
 	Table = "connectors"
 	// ProjectTable is the table that holds the project relation/edge.
@@ -89,6 +93,13 @@ const (
 	CostReportsInverseTable = "cost_reports"
 	// CostReportsColumn is the table column denoting the cost_reports relation/edge.
 	CostReportsColumn = "connector_id"
+	// CostAnomaliesTable is the table that holds the cost_anomalies relation/edge.
+	CostAnomaliesTable = "cost_anomalies"
+	// CostAnomaliesInverseTable is the table name for the CostAnomaly entity.
+	// It exists in this package in order to avoid circular dependency with the "costanomaly" package.
+	CostAnomaliesInverseTable = "cost_anomalies"
+	// CostAnomaliesColumn is the table column denoting the cost_anomalies relation/edge.
+	CostAnomaliesColumn = "connector_id"
 )
 
 // Columns holds all SQL columns for connector fields.
@@ -109,6 +120,7 @@ var Columns = []string{
 	FieldConfigData,
 	FieldEnableFinOps,
 	FieldFinOpsCustomPricing,
+	FieldFinOpsBillingSource,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -264,6 +276,20 @@ func ByCostReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCostReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCostAnomaliesCount orders the results by cost_anomalies count.
+func ByCostAnomaliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCostAnomaliesStep(), opts...)
+	}
+}
+
+// ByCostAnomalies orders the results by cost_anomalies terms.
+func ByCostAnomalies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCostAnomaliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProjectStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -292,6 +318,13 @@ func newCostReportsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CostReportsTable, CostReportsColumn),
 	)
 }
+func newCostAnomaliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CostAnomaliesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CostAnomaliesTable, CostAnomaliesColumn),
+	)
+}
 
 // WithoutFields returns the fields ignored the given list.
 func WithoutFields(ignores ...string) []string {
@@ -777,6 +777,16 @@ func FinOpsCustomPricingNotNil() predicate.Connector {
 	return predicate.Connector(sql.FieldNotNull(FieldFinOpsCustomPricing))
 }
 
+// FinOpsBillingSourceIsNil applies the IsNil predicate on the "fin_ops_billing_source" field.
+func FinOpsBillingSourceIsNil() predicate.Connector {
+	return predicate.Connector(sql.FieldIsNull(FieldFinOpsBillingSource))
+}
+
+// FinOpsBillingSourceNotNil applies the NotNil predicate on the "fin_ops_billing_source" field.
+func FinOpsBillingSourceNotNil() predicate.Connector {
+	return predicate.Connector(sql.FieldNotNull(FieldFinOpsBillingSource))
+}
+
 // HasProject applies the HasEdge predicate on the "project" edge.
 func HasProject() predicate.Connector {
 	return predicate.Connector(func(s *sql.Selector) {
@@ -893,6 +903,35 @@ func HasCostReportsWith(preds ...predicate.CostReport) predicate.Connector {
 	})
 }
 
+// HasCostAnomalies applies the HasEdge predicate on the "cost_anomalies" edge.
+func HasCostAnomalies() predicate.Connector {
+	return predicate.Connector(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CostAnomaliesTable, CostAnomaliesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.CostAnomaly
+		step.Edge.Schema = schemaConfig.CostAnomaly
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCostAnomaliesWith applies the HasEdge predicate on the "cost_anomalies" edge with a given conditions (other predicates).
+func HasCostAnomaliesWith(preds ...predicate.CostAnomaly) predicate.Connector {
+	return predicate.Connector(func(s *sql.Selector) {
+		step := newCostAnomaliesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.CostAnomaly
+		step.Edge.Schema = schemaConfig.CostAnomaly
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Connector) predicate.Connector {
 	return predicate.Connector(sql.AndPredicates(predicates...))
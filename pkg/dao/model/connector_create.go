@@ -18,6 +18,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
 	"github.com/seal-io/walrus/pkg/dao/model/costreport"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/project"
@@ -168,6 +169,12 @@ func (cc *ConnectorCreate) SetFinOpsCustomPricing(tocp *types.FinOpsCustomPricin
 	return cc
 }
 
+// SetFinOpsBillingSource sets the "fin_ops_billing_source" field.
+func (cc *ConnectorCreate) SetFinOpsBillingSource(tobs *types.FinOpsBillingSource) *ConnectorCreate {
+	cc.mutation.SetFinOpsBillingSource(tobs)
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *ConnectorCreate) SetID(o object.ID) *ConnectorCreate {
 	cc.mutation.SetID(o)
@@ -224,6 +231,21 @@ func (cc *ConnectorCreate) AddCostReports(c ...*CostReport) *ConnectorCreate {
 	return cc.AddCostReportIDs(ids...)
 }
 
+// AddCostAnomalyIDs adds the "cost_anomalies" edge to the CostAnomaly entity by IDs.
+func (cc *ConnectorCreate) AddCostAnomalyIDs(ids ...object.ID) *ConnectorCreate {
+	cc.mutation.AddCostAnomalyIDs(ids...)
+	return cc
+}
+
+// AddCostAnomalies adds the "cost_anomalies" edges to the CostAnomaly entity.
+func (cc *ConnectorCreate) AddCostAnomalies(c ...*CostAnomaly) *ConnectorCreate {
+	ids := make([]object.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cc.AddCostAnomalyIDs(ids...)
+}
+
 // Mutation returns the ConnectorMutation object of the builder.
 func (cc *ConnectorCreate) Mutation() *ConnectorMutation {
 	return cc.mutation
@@ -434,6 +456,10 @@ func (cc *ConnectorCreate) createSpec() (*Connector, *sqlgraph.CreateSpec) {
 		_spec.SetField(connector.FieldFinOpsCustomPricing, field.TypeJSON, value)
 		_node.FinOpsCustomPricing = value
 	}
+	if value, ok := cc.mutation.FinOpsBillingSource(); ok {
+		_spec.SetField(connector.FieldFinOpsBillingSource, field.TypeJSON, value)
+		_node.FinOpsBillingSource = value
+	}
 	if nodes := cc.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -503,6 +529,23 @@ func (cc *ConnectorCreate) createSpec() (*Connector, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cc.mutation.CostAnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connector.CostAnomaliesTable,
+			Columns: []string{connector.CostAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cc.schemaConfig.CostAnomaly
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -561,6 +604,9 @@ func (cc *ConnectorCreate) Set(obj *Connector) *ConnectorCreate {
 	if obj.FinOpsCustomPricing != nil && !obj.FinOpsCustomPricing.IsZero() {
 		cc.SetFinOpsCustomPricing(obj.FinOpsCustomPricing)
 	}
+	if obj.FinOpsBillingSource != nil && !obj.FinOpsBillingSource.IsZero() {
+		cc.SetFinOpsBillingSource(obj.FinOpsBillingSource)
+	}
 
 	// Record the given object.
 	cc.object = obj
@@ -642,6 +688,9 @@ func (cc *ConnectorCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Co
 		if _, set := cc.mutation.Field(connector.FieldFinOpsCustomPricing); set {
 			obj.FinOpsCustomPricing = x.FinOpsCustomPricing
 		}
+		if _, set := cc.mutation.Field(connector.FieldFinOpsBillingSource); set {
+			obj.FinOpsBillingSource = x.FinOpsBillingSource
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -789,6 +838,9 @@ func (ccb *ConnectorCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx conte
 			if _, set := ccb.builders[i].mutation.Field(connector.FieldFinOpsCustomPricing); set {
 				objs[i].FinOpsCustomPricing = x[i].FinOpsCustomPricing
 			}
+			if _, set := ccb.builders[i].mutation.Field(connector.FieldFinOpsBillingSource); set {
+				objs[i].FinOpsBillingSource = x[i].FinOpsBillingSource
+			}
 			objs[i].Edges = x[i].Edges
 		}
 	}
@@ -1059,6 +1111,24 @@ func (u *ConnectorUpsert) ClearFinOpsCustomPricing() *ConnectorUpsert {
 	return u
 }
 
+// SetFinOpsBillingSource sets the "fin_ops_billing_source" field.
+func (u *ConnectorUpsert) SetFinOpsBillingSource(v *types.FinOpsBillingSource) *ConnectorUpsert {
+	u.Set(connector.FieldFinOpsBillingSource, v)
+	return u
+}
+
+// UpdateFinOpsBillingSource sets the "fin_ops_billing_source" field to the value that was provided on create.
+func (u *ConnectorUpsert) UpdateFinOpsBillingSource() *ConnectorUpsert {
+	u.SetExcluded(connector.FieldFinOpsBillingSource)
+	return u
+}
+
+// ClearFinOpsBillingSource clears the value of the "fin_ops_billing_source" field.
+func (u *ConnectorUpsert) ClearFinOpsBillingSource() *ConnectorUpsert {
+	u.SetNull(connector.FieldFinOpsBillingSource)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1293,6 +1363,27 @@ func (u *ConnectorUpsertOne) ClearFinOpsCustomPricing() *ConnectorUpsertOne {
 	})
 }
 
+// SetFinOpsBillingSource sets the "fin_ops_billing_source" field.
+func (u *ConnectorUpsertOne) SetFinOpsBillingSource(v *types.FinOpsBillingSource) *ConnectorUpsertOne {
+	return u.Update(func(s *ConnectorUpsert) {
+		s.SetFinOpsBillingSource(v)
+	})
+}
+
+// UpdateFinOpsBillingSource sets the "fin_ops_billing_source" field to the value that was provided on create.
+func (u *ConnectorUpsertOne) UpdateFinOpsBillingSource() *ConnectorUpsertOne {
+	return u.Update(func(s *ConnectorUpsert) {
+		s.UpdateFinOpsBillingSource()
+	})
+}
+
+// ClearFinOpsBillingSource clears the value of the "fin_ops_billing_source" field.
+func (u *ConnectorUpsertOne) ClearFinOpsBillingSource() *ConnectorUpsertOne {
+	return u.Update(func(s *ConnectorUpsert) {
+		s.ClearFinOpsBillingSource()
+	})
+}
+
 // Exec executes the query.
 func (u *ConnectorUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1696,6 +1787,27 @@ func (u *ConnectorUpsertBulk) ClearFinOpsCustomPricing() *ConnectorUpsertBulk {
 	})
 }
 
+// SetFinOpsBillingSource sets the "fin_ops_billing_source" field.
+func (u *ConnectorUpsertBulk) SetFinOpsBillingSource(v *types.FinOpsBillingSource) *ConnectorUpsertBulk {
+	return u.Update(func(s *ConnectorUpsert) {
+		s.SetFinOpsBillingSource(v)
+	})
+}
+
+// UpdateFinOpsBillingSource sets the "fin_ops_billing_source" field to the value that was provided on create.
+func (u *ConnectorUpsertBulk) UpdateFinOpsBillingSource() *ConnectorUpsertBulk {
+	return u.Update(func(s *ConnectorUpsert) {
+		s.UpdateFinOpsBillingSource()
+	})
+}
+
+// ClearFinOpsBillingSource clears the value of the "fin_ops_billing_source" field.
+func (u *ConnectorUpsertBulk) ClearFinOpsBillingSource() *ConnectorUpsertBulk {
+	return u.Update(func(s *ConnectorUpsert) {
+		s.ClearFinOpsBillingSource()
+	})
+}
+
 // Exec executes the query.
 func (u *ConnectorUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
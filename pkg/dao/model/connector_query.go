@@ -17,6 +17,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
 	"github.com/seal-io/walrus/pkg/dao/model/costreport"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/internal"
@@ -37,6 +38,7 @@ type ConnectorQuery struct {
 	withEnvironments       *EnvironmentConnectorRelationshipQuery
 	withResourceComponents *ResourceComponentQuery
 	withCostReports        *CostReportQuery
+	withCostAnomalies      *CostAnomalyQuery
 	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -174,6 +176,31 @@ func (cq *ConnectorQuery) QueryCostReports() *CostReportQuery {
 	return query
 }
 
+// QueryCostAnomalies chains the current query on the "cost_anomalies" edge.
+func (cq *ConnectorQuery) QueryCostAnomalies() *CostAnomalyQuery {
+	query := (&CostAnomalyClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(connector.Table, connector.FieldID, selector),
+			sqlgraph.To(costanomaly.Table, costanomaly.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, connector.CostAnomaliesTable, connector.CostAnomaliesColumn),
+		)
+		schemaConfig := cq.schemaConfig
+		step.To.Schema = schemaConfig.CostAnomaly
+		step.Edge.Schema = schemaConfig.CostAnomaly
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Connector entity from the query.
 // Returns a *NotFoundError when no Connector was found.
 func (cq *ConnectorQuery) First(ctx context.Context) (*Connector, error) {
@@ -370,6 +397,7 @@ func (cq *ConnectorQuery) Clone() *ConnectorQuery {
 		withEnvironments:       cq.withEnvironments.Clone(),
 		withResourceComponents: cq.withResourceComponents.Clone(),
 		withCostReports:        cq.withCostReports.Clone(),
+		withCostAnomalies:      cq.withCostAnomalies.Clone(),
 		// clone intermediate query.
 		sql:  cq.sql.Clone(),
 		path: cq.path,
@@ -420,6 +448,17 @@ func (cq *ConnectorQuery) WithCostReports(opts ...func(*CostReportQuery)) *Conne
 	return cq
 }
 
+// WithCostAnomalies tells the query-builder to eager-load the nodes that are connected to
+// the "cost_anomalies" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *ConnectorQuery) WithCostAnomalies(opts ...func(*CostAnomalyQuery)) *ConnectorQuery {
+	query := (&CostAnomalyClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withCostAnomalies = query
+	return cq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -498,11 +537,12 @@ func (cq *ConnectorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Co
 	var (
 		nodes       = []*Connector{}
 		_spec       = cq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			cq.withProject != nil,
 			cq.withEnvironments != nil,
 			cq.withResourceComponents != nil,
 			cq.withCostReports != nil,
+			cq.withCostAnomalies != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -559,6 +599,13 @@ func (cq *ConnectorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Co
 			return nil, err
 		}
 	}
+	if query := cq.withCostAnomalies; query != nil {
+		if err := cq.loadCostAnomalies(ctx, query, nodes,
+			func(n *Connector) { n.Edges.CostAnomalies = []*CostAnomaly{} },
+			func(n *Connector, e *CostAnomaly) { n.Edges.CostAnomalies = append(n.Edges.CostAnomalies, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -681,6 +728,36 @@ func (cq *ConnectorQuery) loadCostReports(ctx context.Context, query *CostReport
 	}
 	return nil
 }
+func (cq *ConnectorQuery) loadCostAnomalies(ctx context.Context, query *CostAnomalyQuery, nodes []*Connector, init func(*Connector), assign func(*Connector, *CostAnomaly)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Connector)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(costanomaly.FieldConnectorID)
+	}
+	query.Where(predicate.CostAnomaly(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(connector.CostAnomaliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ConnectorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "connector_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (cq *ConnectorQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := cq.querySpec()
@@ -19,6 +19,7 @@ import (
 	"entgo.io/ent/schema/field"
 
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
 	"github.com/seal-io/walrus/pkg/dao/model/costreport"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/internal"
@@ -167,6 +168,18 @@ func (cu *ConnectorUpdate) ClearFinOpsCustomPricing() *ConnectorUpdate {
 	return cu
 }
 
+// SetFinOpsBillingSource sets the "fin_ops_billing_source" field.
+func (cu *ConnectorUpdate) SetFinOpsBillingSource(tobs *types.FinOpsBillingSource) *ConnectorUpdate {
+	cu.mutation.SetFinOpsBillingSource(tobs)
+	return cu
+}
+
+// ClearFinOpsBillingSource clears the value of the "fin_ops_billing_source" field.
+func (cu *ConnectorUpdate) ClearFinOpsBillingSource() *ConnectorUpdate {
+	cu.mutation.ClearFinOpsBillingSource()
+	return cu
+}
+
 // AddEnvironmentIDs adds the "environments" edge to the EnvironmentConnectorRelationship entity by IDs.
 func (cu *ConnectorUpdate) AddEnvironmentIDs(ids ...object.ID) *ConnectorUpdate {
 	cu.mutation.AddEnvironmentIDs(ids...)
@@ -212,6 +225,21 @@ func (cu *ConnectorUpdate) AddCostReports(c ...*CostReport) *ConnectorUpdate {
 	return cu.AddCostReportIDs(ids...)
 }
 
+// AddCostAnomalyIDs adds the "cost_anomalies" edge to the CostAnomaly entity by IDs.
+func (cu *ConnectorUpdate) AddCostAnomalyIDs(ids ...object.ID) *ConnectorUpdate {
+	cu.mutation.AddCostAnomalyIDs(ids...)
+	return cu
+}
+
+// AddCostAnomalies adds the "cost_anomalies" edges to the CostAnomaly entity.
+func (cu *ConnectorUpdate) AddCostAnomalies(c ...*CostAnomaly) *ConnectorUpdate {
+	ids := make([]object.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddCostAnomalyIDs(ids...)
+}
+
 // Mutation returns the ConnectorMutation object of the builder.
 func (cu *ConnectorUpdate) Mutation() *ConnectorMutation {
 	return cu.mutation
@@ -280,6 +308,27 @@ func (cu *ConnectorUpdate) RemoveCostReports(c ...*CostReport) *ConnectorUpdate
 	return cu.RemoveCostReportIDs(ids...)
 }
 
+// ClearCostAnomalies clears all "cost_anomalies" edges to the CostAnomaly entity.
+func (cu *ConnectorUpdate) ClearCostAnomalies() *ConnectorUpdate {
+	cu.mutation.ClearCostAnomalies()
+	return cu
+}
+
+// RemoveCostAnomalyIDs removes the "cost_anomalies" edge to CostAnomaly entities by IDs.
+func (cu *ConnectorUpdate) RemoveCostAnomalyIDs(ids ...object.ID) *ConnectorUpdate {
+	cu.mutation.RemoveCostAnomalyIDs(ids...)
+	return cu
+}
+
+// RemoveCostAnomalies removes "cost_anomalies" edges to CostAnomaly entities.
+func (cu *ConnectorUpdate) RemoveCostAnomalies(c ...*CostAnomaly) *ConnectorUpdate {
+	ids := make([]object.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveCostAnomalyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (cu *ConnectorUpdate) Save(ctx context.Context) (int, error) {
 	if err := cu.defaults(); err != nil {
@@ -387,6 +436,9 @@ func (cu *ConnectorUpdate) Set(obj *Connector) *ConnectorUpdate {
 	if obj.FinOpsCustomPricing != nil && !obj.FinOpsCustomPricing.IsZero() {
 		cu.SetFinOpsCustomPricing(obj.FinOpsCustomPricing)
 	}
+	if obj.FinOpsBillingSource != nil && !obj.FinOpsBillingSource.IsZero() {
+		cu.SetFinOpsBillingSource(obj.FinOpsBillingSource)
+	}
 
 	// With Default.
 	if obj.UpdateTime != nil {
@@ -462,6 +514,12 @@ func (cu *ConnectorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.FinOpsCustomPricingCleared() {
 		_spec.ClearField(connector.FieldFinOpsCustomPricing, field.TypeJSON)
 	}
+	if value, ok := cu.mutation.FinOpsBillingSource(); ok {
+		_spec.SetField(connector.FieldFinOpsBillingSource, field.TypeJSON, value)
+	}
+	if cu.mutation.FinOpsBillingSourceCleared() {
+		_spec.ClearField(connector.FieldFinOpsBillingSource, field.TypeJSON)
+	}
 	if cu.mutation.EnvironmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -606,6 +664,54 @@ func (cu *ConnectorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cu.mutation.CostAnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connector.CostAnomaliesTable,
+			Columns: []string{connector.CostAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cu.schemaConfig.CostAnomaly
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedCostAnomaliesIDs(); len(nodes) > 0 && !cu.mutation.CostAnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connector.CostAnomaliesTable,
+			Columns: []string{connector.CostAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cu.schemaConfig.CostAnomaly
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.CostAnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connector.CostAnomaliesTable,
+			Columns: []string{connector.CostAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cu.schemaConfig.CostAnomaly
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = cu.schemaConfig.Connector
 	ctx = internal.NewSchemaConfigContext(ctx, cu.schemaConfig)
 	_spec.AddModifiers(cu.modifiers...)
@@ -753,6 +859,18 @@ func (cuo *ConnectorUpdateOne) ClearFinOpsCustomPricing() *ConnectorUpdateOne {
 	return cuo
 }
 
+// SetFinOpsBillingSource sets the "fin_ops_billing_source" field.
+func (cuo *ConnectorUpdateOne) SetFinOpsBillingSource(tobs *types.FinOpsBillingSource) *ConnectorUpdateOne {
+	cuo.mutation.SetFinOpsBillingSource(tobs)
+	return cuo
+}
+
+// ClearFinOpsBillingSource clears the value of the "fin_ops_billing_source" field.
+func (cuo *ConnectorUpdateOne) ClearFinOpsBillingSource() *ConnectorUpdateOne {
+	cuo.mutation.ClearFinOpsBillingSource()
+	return cuo
+}
+
 // AddEnvironmentIDs adds the "environments" edge to the EnvironmentConnectorRelationship entity by IDs.
 func (cuo *ConnectorUpdateOne) AddEnvironmentIDs(ids ...object.ID) *ConnectorUpdateOne {
 	cuo.mutation.AddEnvironmentIDs(ids...)
@@ -798,6 +916,21 @@ func (cuo *ConnectorUpdateOne) AddCostReports(c ...*CostReport) *ConnectorUpdate
 	return cuo.AddCostReportIDs(ids...)
 }
 
+// AddCostAnomalyIDs adds the "cost_anomalies" edge to the CostAnomaly entity by IDs.
+func (cuo *ConnectorUpdateOne) AddCostAnomalyIDs(ids ...object.ID) *ConnectorUpdateOne {
+	cuo.mutation.AddCostAnomalyIDs(ids...)
+	return cuo
+}
+
+// AddCostAnomalies adds the "cost_anomalies" edges to the CostAnomaly entity.
+func (cuo *ConnectorUpdateOne) AddCostAnomalies(c ...*CostAnomaly) *ConnectorUpdateOne {
+	ids := make([]object.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddCostAnomalyIDs(ids...)
+}
+
 // Mutation returns the ConnectorMutation object of the builder.
 func (cuo *ConnectorUpdateOne) Mutation() *ConnectorMutation {
 	return cuo.mutation
@@ -866,6 +999,27 @@ func (cuo *ConnectorUpdateOne) RemoveCostReports(c ...*CostReport) *ConnectorUpd
 	return cuo.RemoveCostReportIDs(ids...)
 }
 
+// ClearCostAnomalies clears all "cost_anomalies" edges to the CostAnomaly entity.
+func (cuo *ConnectorUpdateOne) ClearCostAnomalies() *ConnectorUpdateOne {
+	cuo.mutation.ClearCostAnomalies()
+	return cuo
+}
+
+// RemoveCostAnomalyIDs removes the "cost_anomalies" edge to CostAnomaly entities by IDs.
+func (cuo *ConnectorUpdateOne) RemoveCostAnomalyIDs(ids ...object.ID) *ConnectorUpdateOne {
+	cuo.mutation.RemoveCostAnomalyIDs(ids...)
+	return cuo
+}
+
+// RemoveCostAnomalies removes "cost_anomalies" edges to CostAnomaly entities.
+func (cuo *ConnectorUpdateOne) RemoveCostAnomalies(c ...*CostAnomaly) *ConnectorUpdateOne {
+	ids := make([]object.ID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveCostAnomalyIDs(ids...)
+}
+
 // Where appends a list predicates to the ConnectorUpdate builder.
 func (cuo *ConnectorUpdateOne) Where(ps ...predicate.Connector) *ConnectorUpdateOne {
 	cuo.mutation.Where(ps...)
@@ -1012,6 +1166,11 @@ func (cuo *ConnectorUpdateOne) Set(obj *Connector) *ConnectorUpdateOne {
 					cuo.SetFinOpsCustomPricing(obj.FinOpsCustomPricing)
 				}
 			}
+			if obj.FinOpsBillingSource != nil && !obj.FinOpsBillingSource.IsZero() {
+				if !reflect.DeepEqual(db.FinOpsBillingSource, obj.FinOpsBillingSource) {
+					cuo.SetFinOpsBillingSource(obj.FinOpsBillingSource)
+				}
+			}
 
 			// With Default.
 			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
@@ -1086,6 +1245,9 @@ func (cuo *ConnectorUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx contex
 		if _, set := cuo.mutation.Field(connector.FieldFinOpsCustomPricing); set {
 			obj.FinOpsCustomPricing = x.FinOpsCustomPricing
 		}
+		if _, set := cuo.mutation.Field(connector.FieldFinOpsBillingSource); set {
+			obj.FinOpsBillingSource = x.FinOpsBillingSource
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -1201,6 +1363,12 @@ func (cuo *ConnectorUpdateOne) sqlSave(ctx context.Context) (_node *Connector, e
 	if cuo.mutation.FinOpsCustomPricingCleared() {
 		_spec.ClearField(connector.FieldFinOpsCustomPricing, field.TypeJSON)
 	}
+	if value, ok := cuo.mutation.FinOpsBillingSource(); ok {
+		_spec.SetField(connector.FieldFinOpsBillingSource, field.TypeJSON, value)
+	}
+	if cuo.mutation.FinOpsBillingSourceCleared() {
+		_spec.ClearField(connector.FieldFinOpsBillingSource, field.TypeJSON)
+	}
 	if cuo.mutation.EnvironmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1345,6 +1513,54 @@ func (cuo *ConnectorUpdateOne) sqlSave(ctx context.Context) (_node *Connector, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if cuo.mutation.CostAnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connector.CostAnomaliesTable,
+			Columns: []string{connector.CostAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cuo.schemaConfig.CostAnomaly
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedCostAnomaliesIDs(); len(nodes) > 0 && !cuo.mutation.CostAnomaliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connector.CostAnomaliesTable,
+			Columns: []string{connector.CostAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cuo.schemaConfig.CostAnomaly
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.CostAnomaliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   connector.CostAnomaliesTable,
+			Columns: []string{connector.CostAnomaliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cuo.schemaConfig.CostAnomaly
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = cuo.schemaConfig.Connector
 	ctx = internal.NewSchemaConfigContext(ctx, cuo.schemaConfig)
 	_spec.AddModifiers(cuo.modifiers...)
@@ -50,6 +50,8 @@ type ConnectorCreateInput struct {
 	ConfigData crypto.Properties `path:"-" query:"-" json:"configData,omitempty"`
 	// Custom pricing user defined.
 	FinOpsCustomPricing *types.FinOpsCustomPricing `path:"-" query:"-" json:"finOpsCustomPricing,omitempty"`
+	// Billing export source to import the cost of the cloud provider connector.
+	FinOpsBillingSource *types.FinOpsBillingSource `path:"-" query:"-" json:"finOpsBillingSource,omitempty"`
 }
 
 // Model returns the Connector entity for creating,
@@ -70,6 +72,7 @@ func (cci *ConnectorCreateInput) Model() *Connector {
 		Labels:                    cci.Labels,
 		ConfigData:                cci.ConfigData,
 		FinOpsCustomPricing:       cci.FinOpsCustomPricing,
+		FinOpsBillingSource:       cci.FinOpsBillingSource,
 	}
 
 	if cci.Project != nil {
@@ -134,6 +137,8 @@ type ConnectorCreateInputsItem struct {
 	ConfigData crypto.Properties `path:"-" query:"-" json:"configData,omitempty"`
 	// Custom pricing user defined.
 	FinOpsCustomPricing *types.FinOpsCustomPricing `path:"-" query:"-" json:"finOpsCustomPricing,omitempty"`
+	// Billing export source to import the cost of the cloud provider connector.
+	FinOpsBillingSource *types.FinOpsBillingSource `path:"-" query:"-" json:"finOpsBillingSource,omitempty"`
 }
 
 // ValidateWith checks the ConnectorCreateInputsItem entity with the given context and client set.
@@ -182,6 +187,7 @@ func (cci *ConnectorCreateInputs) Model() []*Connector {
 			Labels:                    cci.Items[i].Labels,
 			ConfigData:                cci.Items[i].ConfigData,
 			FinOpsCustomPricing:       cci.Items[i].FinOpsCustomPricing,
+			FinOpsBillingSource:       cci.Items[i].FinOpsBillingSource,
 		}
 
 		if cci.Project != nil {
@@ -433,6 +439,8 @@ type ConnectorPatchInput struct {
 	EnableFinOps bool `path:"-" query:"-" json:"enableFinOps,omitempty"`
 	// Custom pricing user defined.
 	FinOpsCustomPricing *types.FinOpsCustomPricing `path:"-" query:"-" json:"finOpsCustomPricing,omitempty"`
+	// Billing export source to import the cost of the cloud provider connector.
+	FinOpsBillingSource *types.FinOpsBillingSource `path:"-" query:"-" json:"finOpsBillingSource,omitempty"`
 
 	patchedEntity *Connector `path:"-" query:"-" json:"-"`
 }
@@ -458,6 +466,7 @@ func (cpi *ConnectorPatchInput) PatchModel() *Connector {
 		ConfigData:                cpi.ConfigData,
 		EnableFinOps:              cpi.EnableFinOps,
 		FinOpsCustomPricing:       cpi.FinOpsCustomPricing,
+		FinOpsBillingSource:       cpi.FinOpsBillingSource,
 	}
 
 	if cpi.Project != nil {
@@ -788,6 +797,8 @@ type ConnectorUpdateInput struct {
 	EnableFinOps bool `path:"-" query:"-" json:"enableFinOps,omitempty"`
 	// Custom pricing user defined.
 	FinOpsCustomPricing *types.FinOpsCustomPricing `path:"-" query:"-" json:"finOpsCustomPricing,omitempty"`
+	// Billing export source to import the cost of the cloud provider connector.
+	FinOpsBillingSource *types.FinOpsBillingSource `path:"-" query:"-" json:"finOpsBillingSource,omitempty"`
 }
 
 // Model returns the Connector entity for modifying,
@@ -808,6 +819,7 @@ func (cui *ConnectorUpdateInput) Model() *Connector {
 		ConfigData:          cui.ConfigData,
 		EnableFinOps:        cui.EnableFinOps,
 		FinOpsCustomPricing: cui.FinOpsCustomPricing,
+		FinOpsBillingSource: cui.FinOpsBillingSource,
 	}
 
 	return _c
@@ -858,6 +870,8 @@ type ConnectorUpdateInputsItem struct {
 	EnableFinOps bool `path:"-" query:"-" json:"enableFinOps"`
 	// Custom pricing user defined.
 	FinOpsCustomPricing *types.FinOpsCustomPricing `path:"-" query:"-" json:"finOpsCustomPricing,omitempty"`
+	// Billing export source to import the cost of the cloud provider connector.
+	FinOpsBillingSource *types.FinOpsBillingSource `path:"-" query:"-" json:"finOpsBillingSource,omitempty"`
 }
 
 // ValidateWith checks the ConnectorUpdateInputsItem entity with the given context and client set.
@@ -906,6 +920,7 @@ func (cui *ConnectorUpdateInputs) Model() []*Connector {
 			ConfigData:          cui.Items[i].ConfigData,
 			EnableFinOps:        cui.Items[i].EnableFinOps,
 			FinOpsCustomPricing: cui.Items[i].FinOpsCustomPricing,
+			FinOpsBillingSource: cui.Items[i].FinOpsBillingSource,
 		}
 
 		_cs[i] = _c
@@ -1053,6 +1068,7 @@ type ConnectorOutput struct {
 	ConfigData                crypto.Properties          `json:"configData,omitempty"`
 	EnableFinOps              bool                       `json:"enableFinOps,omitempty"`
 	FinOpsCustomPricing       *types.FinOpsCustomPricing `json:"finOpsCustomPricing,omitempty"`
+	FinOpsBillingSource       *types.FinOpsBillingSource `json:"finOpsBillingSource,omitempty"`
 
 	Project *ProjectOutput `json:"project,omitempty"`
 }
@@ -1088,6 +1104,7 @@ func ExposeConnector(_c *Connector) *ConnectorOutput {
 		ConfigData:                _c.ConfigData,
 		EnableFinOps:              _c.EnableFinOps,
 		FinOpsCustomPricing:       _c.FinOpsCustomPricing,
+		FinOpsBillingSource:       _c.FinOpsBillingSource,
 	}
 
 	if _c.Edges.Project != nil {
@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// CostAnomaly is the model entity for the CostAnomaly schema.
+type CostAnomaly struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// Scope of the anomaly, project or namespace.
+	Scope string `json:"scope,omitempty,cli-table-column"`
+	// Name of the project or namespace.
+	Name string `json:"name,omitempty,cli-table-column"`
+	// ID of the connector to which the namespace belongs.
+	ConnectorID object.ID `json:"connector_id,omitempty"`
+	// String generated from the scope, connector and name, used to identify the anomaly of a day.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// Start time of the anomalous day.
+	Day time.Time `json:"day,omitempty,cli-table-column"`
+	// Type of the anomaly, spike or drop.
+	Type string `json:"type,omitempty,cli-table-column"`
+	// Cost of the day.
+	Cost float64 `json:"cost,omitempty"`
+	// Average daily cost of the baseline days.
+	Baseline float64 `json:"baseline,omitempty"`
+	// Deviation of the cost from the baseline in percentage.
+	DeviationPercent float64 `json:"deviation_percent,omitempty"`
+	// Deviation of the cost from the baseline in standard deviations.
+	Score float64 `json:"score,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CostAnomalyQuery when eager-loading is set.
+	Edges        CostAnomalyEdges `json:"edges,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// CostAnomalyEdges holds the relations/edges for other nodes in the graph.
+type CostAnomalyEdges struct {
+	// Connector to which the namespace belongs.
+	Connector *Connector `json:"connector,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ConnectorOrErr returns the Connector value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CostAnomalyEdges) ConnectorOrErr() (*Connector, error) {
+	if e.loadedTypes[0] {
+		if e.Connector == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: connector.Label}
+		}
+		return e.Connector, nil
+	}
+	return nil, &NotLoadedError{edge: "connector"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CostAnomaly) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case costanomaly.FieldID, costanomaly.FieldConnectorID:
+			values[i] = new(object.ID)
+		case costanomaly.FieldCost, costanomaly.FieldBaseline, costanomaly.FieldDeviationPercent, costanomaly.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case costanomaly.FieldScope, costanomaly.FieldName, costanomaly.FieldFingerprint, costanomaly.FieldType:
+			values[i] = new(sql.NullString)
+		case costanomaly.FieldCreateTime, costanomaly.FieldUpdateTime, costanomaly.FieldDay:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CostAnomaly fields.
+func (ca *CostAnomaly) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case costanomaly.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ca.ID = *value
+			}
+		case costanomaly.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ca.CreateTime = new(time.Time)
+				*ca.CreateTime = value.Time
+			}
+		case costanomaly.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ca.UpdateTime = new(time.Time)
+				*ca.UpdateTime = value.Time
+			}
+		case costanomaly.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				ca.Scope = value.String
+			}
+		case costanomaly.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ca.Name = value.String
+			}
+		case costanomaly.FieldConnectorID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field connector_id", values[i])
+			} else if value != nil {
+				ca.ConnectorID = *value
+			}
+		case costanomaly.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				ca.Fingerprint = value.String
+			}
+		case costanomaly.FieldDay:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				ca.Day = value.Time
+			}
+		case costanomaly.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ca.Type = value.String
+			}
+		case costanomaly.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				ca.Cost = value.Float64
+			}
+		case costanomaly.FieldBaseline:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field baseline", values[i])
+			} else if value.Valid {
+				ca.Baseline = value.Float64
+			}
+		case costanomaly.FieldDeviationPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field deviation_percent", values[i])
+			} else if value.Valid {
+				ca.DeviationPercent = value.Float64
+			}
+		case costanomaly.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				ca.Score = value.Float64
+			}
+		default:
+			ca.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CostAnomaly.
+// This includes values selected through modifiers, order, etc.
+func (ca *CostAnomaly) Value(name string) (ent.Value, error) {
+	return ca.selectValues.Get(name)
+}
+
+// QueryConnector queries the "connector" edge of the CostAnomaly entity.
+func (ca *CostAnomaly) QueryConnector() *ConnectorQuery {
+	return NewCostAnomalyClient(ca.config).QueryConnector(ca)
+}
+
+// Update returns a builder for updating this CostAnomaly.
+// Note that you need to call CostAnomaly.Unwrap() before calling this method if this CostAnomaly
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ca *CostAnomaly) Update() *CostAnomalyUpdateOne {
+	return NewCostAnomalyClient(ca.config).UpdateOne(ca)
+}
+
+// Unwrap unwraps the CostAnomaly entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ca *CostAnomaly) Unwrap() *CostAnomaly {
+	_tx, ok := ca.config.driver.(*txDriver)
+	if !ok {
+		panic("model: CostAnomaly is not a transactional entity")
+	}
+	ca.config.driver = _tx.drv
+	return ca
+}
+
+// String implements the fmt.Stringer.
+func (ca *CostAnomaly) String() string {
+	var builder strings.Builder
+	builder.WriteString("CostAnomaly(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ca.ID))
+	if v := ca.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ca.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(ca.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ca.Name)
+	builder.WriteString(", ")
+	builder.WriteString("connector_id=")
+	builder.WriteString(fmt.Sprintf("%v", ca.ConnectorID))
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(ca.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("day=")
+	builder.WriteString(ca.Day.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(ca.Type)
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", ca.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("baseline=")
+	builder.WriteString(fmt.Sprintf("%v", ca.Baseline))
+	builder.WriteString(", ")
+	builder.WriteString("deviation_percent=")
+	builder.WriteString(fmt.Sprintf("%v", ca.DeviationPercent))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", ca.Score))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CostAnomalies is a parsable slice of CostAnomaly.
+type CostAnomalies []*CostAnomaly
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package costanomaly
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// Label holds the string label denoting the costanomaly type in the database.
+	Label = "cost_anomaly"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldConnectorID holds the string denoting the connector_id field in the database.
+	FieldConnectorID = "connector_id"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldBaseline holds the string denoting the baseline field in the database.
+	FieldBaseline = "baseline"
+	// FieldDeviationPercent holds the string denoting the deviation_percent field in the database.
+	FieldDeviationPercent = "deviation_percent"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// EdgeConnector holds the string denoting the connector edge name in mutations.
+	EdgeConnector = "connector"
+	// Table holds the table name of the costanomaly in the database.
+	Table = "cost_anomalies"
+	// ConnectorTable is the table that holds the connector relation/edge.
+	ConnectorTable = "cost_anomalies"
+	// ConnectorInverseTable is the table name for the Connector entity.
+	// It exists in this package in order to avoid circular dependency with the "connector" package.
+	ConnectorInverseTable = "connectors"
+	// ConnectorColumn is the table column denoting the connector relation/edge.
+	ConnectorColumn = "connector_id"
+)
+
+// Columns holds all SQL columns for costanomaly fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldScope,
+	FieldName,
+	FieldConnectorID,
+	FieldFingerprint,
+	FieldDay,
+	FieldType,
+	FieldCost,
+	FieldBaseline,
+	FieldDeviationPercent,
+	FieldScore,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	ScopeValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the CostAnomaly queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByConnectorID orders the results by the connector_id field.
+func ByConnectorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnectorID, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByCost orders the results by the cost field.
+func ByCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCost, opts...).ToFunc()
+}
+
+// ByBaseline orders the results by the baseline field.
+func ByBaseline(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseline, opts...).ToFunc()
+}
+
+// ByDeviationPercent orders the results by the deviation_percent field.
+func ByDeviationPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviationPercent, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByConnectorField orders the results by connector field.
+func ByConnectorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConnectorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newConnectorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConnectorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ConnectorTable, ConnectorColumn),
+	)
+}
+
+// WithoutFields returns the fields ignored the given list.
+func WithoutFields(ignores ...string) []string {
+	if len(ignores) == 0 {
+		return slices.Clone(Columns)
+	}
+
+	var s = make(map[string]bool, len(ignores))
+	for i := range ignores {
+		s[ignores[i]] = true
+	}
+
+	var r = make([]string, 0, len(Columns)-len(s))
+	for i := range Columns {
+		if s[Columns[i]] {
+			continue
+		}
+		r = append(r, Columns[i])
+	}
+	return r
+}
@@ -0,0 +1,786 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package costanomaly
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldScope, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldName, v))
+}
+
+// ConnectorID applies equality check predicate on the "connector_id" field. It's identical to ConnectorIDEQ.
+func ConnectorID(v object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldConnectorID, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldDay, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldType, v))
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldCost, v))
+}
+
+// Baseline applies equality check predicate on the "baseline" field. It's identical to BaselineEQ.
+func Baseline(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldBaseline, v))
+}
+
+// DeviationPercent applies equality check predicate on the "deviation_percent" field. It's identical to DeviationPercentEQ.
+func DeviationPercent(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldDeviationPercent, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldScore, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldContainsFold(FieldScope, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldContainsFold(FieldName, v))
+}
+
+// ConnectorIDEQ applies the EQ predicate on the "connector_id" field.
+func ConnectorIDEQ(v object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldConnectorID, v))
+}
+
+// ConnectorIDNEQ applies the NEQ predicate on the "connector_id" field.
+func ConnectorIDNEQ(v object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldConnectorID, v))
+}
+
+// ConnectorIDIn applies the In predicate on the "connector_id" field.
+func ConnectorIDIn(vs ...object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldConnectorID, vs...))
+}
+
+// ConnectorIDNotIn applies the NotIn predicate on the "connector_id" field.
+func ConnectorIDNotIn(vs ...object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldConnectorID, vs...))
+}
+
+// ConnectorIDGT applies the GT predicate on the "connector_id" field.
+func ConnectorIDGT(v object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldConnectorID, v))
+}
+
+// ConnectorIDGTE applies the GTE predicate on the "connector_id" field.
+func ConnectorIDGTE(v object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldConnectorID, v))
+}
+
+// ConnectorIDLT applies the LT predicate on the "connector_id" field.
+func ConnectorIDLT(v object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldConnectorID, v))
+}
+
+// ConnectorIDLTE applies the LTE predicate on the "connector_id" field.
+func ConnectorIDLTE(v object.ID) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldConnectorID, v))
+}
+
+// ConnectorIDContains applies the Contains predicate on the "connector_id" field.
+func ConnectorIDContains(v object.ID) predicate.CostAnomaly {
+	vc := string(v)
+	return predicate.CostAnomaly(sql.FieldContains(FieldConnectorID, vc))
+}
+
+// ConnectorIDHasPrefix applies the HasPrefix predicate on the "connector_id" field.
+func ConnectorIDHasPrefix(v object.ID) predicate.CostAnomaly {
+	vc := string(v)
+	return predicate.CostAnomaly(sql.FieldHasPrefix(FieldConnectorID, vc))
+}
+
+// ConnectorIDHasSuffix applies the HasSuffix predicate on the "connector_id" field.
+func ConnectorIDHasSuffix(v object.ID) predicate.CostAnomaly {
+	vc := string(v)
+	return predicate.CostAnomaly(sql.FieldHasSuffix(FieldConnectorID, vc))
+}
+
+// ConnectorIDIsNil applies the IsNil predicate on the "connector_id" field.
+func ConnectorIDIsNil() predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIsNull(FieldConnectorID))
+}
+
+// ConnectorIDNotNil applies the NotNil predicate on the "connector_id" field.
+func ConnectorIDNotNil() predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotNull(FieldConnectorID))
+}
+
+// ConnectorIDEqualFold applies the EqualFold predicate on the "connector_id" field.
+func ConnectorIDEqualFold(v object.ID) predicate.CostAnomaly {
+	vc := string(v)
+	return predicate.CostAnomaly(sql.FieldEqualFold(FieldConnectorID, vc))
+}
+
+// ConnectorIDContainsFold applies the ContainsFold predicate on the "connector_id" field.
+func ConnectorIDContainsFold(v object.ID) predicate.CostAnomaly {
+	vc := string(v)
+	return predicate.CostAnomaly(sql.FieldContainsFold(FieldConnectorID, vc))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v time.Time) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldDay, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldContainsFold(FieldType, v))
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldCost, v))
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldCost, v))
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldCost, vs...))
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldCost, vs...))
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldCost, v))
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldCost, v))
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldCost, v))
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldCost, v))
+}
+
+// BaselineEQ applies the EQ predicate on the "baseline" field.
+func BaselineEQ(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldBaseline, v))
+}
+
+// BaselineNEQ applies the NEQ predicate on the "baseline" field.
+func BaselineNEQ(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldBaseline, v))
+}
+
+// BaselineIn applies the In predicate on the "baseline" field.
+func BaselineIn(vs ...float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldBaseline, vs...))
+}
+
+// BaselineNotIn applies the NotIn predicate on the "baseline" field.
+func BaselineNotIn(vs ...float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldBaseline, vs...))
+}
+
+// BaselineGT applies the GT predicate on the "baseline" field.
+func BaselineGT(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldBaseline, v))
+}
+
+// BaselineGTE applies the GTE predicate on the "baseline" field.
+func BaselineGTE(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldBaseline, v))
+}
+
+// BaselineLT applies the LT predicate on the "baseline" field.
+func BaselineLT(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldBaseline, v))
+}
+
+// BaselineLTE applies the LTE predicate on the "baseline" field.
+func BaselineLTE(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldBaseline, v))
+}
+
+// DeviationPercentEQ applies the EQ predicate on the "deviation_percent" field.
+func DeviationPercentEQ(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldDeviationPercent, v))
+}
+
+// DeviationPercentNEQ applies the NEQ predicate on the "deviation_percent" field.
+func DeviationPercentNEQ(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldDeviationPercent, v))
+}
+
+// DeviationPercentIn applies the In predicate on the "deviation_percent" field.
+func DeviationPercentIn(vs ...float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldDeviationPercent, vs...))
+}
+
+// DeviationPercentNotIn applies the NotIn predicate on the "deviation_percent" field.
+func DeviationPercentNotIn(vs ...float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldDeviationPercent, vs...))
+}
+
+// DeviationPercentGT applies the GT predicate on the "deviation_percent" field.
+func DeviationPercentGT(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldDeviationPercent, v))
+}
+
+// DeviationPercentGTE applies the GTE predicate on the "deviation_percent" field.
+func DeviationPercentGTE(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldDeviationPercent, v))
+}
+
+// DeviationPercentLT applies the LT predicate on the "deviation_percent" field.
+func DeviationPercentLT(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldDeviationPercent, v))
+}
+
+// DeviationPercentLTE applies the LTE predicate on the "deviation_percent" field.
+func DeviationPercentLTE(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldDeviationPercent, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.FieldLTE(FieldScore, v))
+}
+
+// HasConnector applies the HasEdge predicate on the "connector" edge.
+func HasConnector() predicate.CostAnomaly {
+	return predicate.CostAnomaly(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ConnectorTable, ConnectorColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Connector
+		step.Edge.Schema = schemaConfig.CostAnomaly
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConnectorWith applies the HasEdge predicate on the "connector" edge with a given conditions (other predicates).
+func HasConnectorWith(preds ...predicate.Connector) predicate.CostAnomaly {
+	return predicate.CostAnomaly(func(s *sql.Selector) {
+		step := newConnectorStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Connector
+		step.Edge.Schema = schemaConfig.CostAnomaly
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CostAnomaly) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CostAnomaly) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CostAnomaly) predicate.CostAnomaly {
+	return predicate.CostAnomaly(sql.NotPredicates(p))
+}
@@ -0,0 +1,1345 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// CostAnomalyCreate is the builder for creating a CostAnomaly entity.
+type CostAnomalyCreate struct {
+	config
+	mutation   *CostAnomalyMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *CostAnomaly
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (cac *CostAnomalyCreate) SetCreateTime(t time.Time) *CostAnomalyCreate {
+	cac.mutation.SetCreateTime(t)
+	return cac
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (cac *CostAnomalyCreate) SetNillableCreateTime(t *time.Time) *CostAnomalyCreate {
+	if t != nil {
+		cac.SetCreateTime(*t)
+	}
+	return cac
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cac *CostAnomalyCreate) SetUpdateTime(t time.Time) *CostAnomalyCreate {
+	cac.mutation.SetUpdateTime(t)
+	return cac
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (cac *CostAnomalyCreate) SetNillableUpdateTime(t *time.Time) *CostAnomalyCreate {
+	if t != nil {
+		cac.SetUpdateTime(*t)
+	}
+	return cac
+}
+
+// SetScope sets the "scope" field.
+func (cac *CostAnomalyCreate) SetScope(s string) *CostAnomalyCreate {
+	cac.mutation.SetScope(s)
+	return cac
+}
+
+// SetName sets the "name" field.
+func (cac *CostAnomalyCreate) SetName(s string) *CostAnomalyCreate {
+	cac.mutation.SetName(s)
+	return cac
+}
+
+// SetConnectorID sets the "connector_id" field.
+func (cac *CostAnomalyCreate) SetConnectorID(o object.ID) *CostAnomalyCreate {
+	cac.mutation.SetConnectorID(o)
+	return cac
+}
+
+// SetNillableConnectorID sets the "connector_id" field if the given value is not nil.
+func (cac *CostAnomalyCreate) SetNillableConnectorID(o *object.ID) *CostAnomalyCreate {
+	if o != nil {
+		cac.SetConnectorID(*o)
+	}
+	return cac
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (cac *CostAnomalyCreate) SetFingerprint(s string) *CostAnomalyCreate {
+	cac.mutation.SetFingerprint(s)
+	return cac
+}
+
+// SetDay sets the "day" field.
+func (cac *CostAnomalyCreate) SetDay(t time.Time) *CostAnomalyCreate {
+	cac.mutation.SetDay(t)
+	return cac
+}
+
+// SetType sets the "type" field.
+func (cac *CostAnomalyCreate) SetType(s string) *CostAnomalyCreate {
+	cac.mutation.SetType(s)
+	return cac
+}
+
+// SetCost sets the "cost" field.
+func (cac *CostAnomalyCreate) SetCost(f float64) *CostAnomalyCreate {
+	cac.mutation.SetCost(f)
+	return cac
+}
+
+// SetBaseline sets the "baseline" field.
+func (cac *CostAnomalyCreate) SetBaseline(f float64) *CostAnomalyCreate {
+	cac.mutation.SetBaseline(f)
+	return cac
+}
+
+// SetDeviationPercent sets the "deviation_percent" field.
+func (cac *CostAnomalyCreate) SetDeviationPercent(f float64) *CostAnomalyCreate {
+	cac.mutation.SetDeviationPercent(f)
+	return cac
+}
+
+// SetScore sets the "score" field.
+func (cac *CostAnomalyCreate) SetScore(f float64) *CostAnomalyCreate {
+	cac.mutation.SetScore(f)
+	return cac
+}
+
+// SetID sets the "id" field.
+func (cac *CostAnomalyCreate) SetID(o object.ID) *CostAnomalyCreate {
+	cac.mutation.SetID(o)
+	return cac
+}
+
+// SetConnector sets the "connector" edge to the Connector entity.
+func (cac *CostAnomalyCreate) SetConnector(c *Connector) *CostAnomalyCreate {
+	return cac.SetConnectorID(c.ID)
+}
+
+// Mutation returns the CostAnomalyMutation object of the builder.
+func (cac *CostAnomalyCreate) Mutation() *CostAnomalyMutation {
+	return cac.mutation
+}
+
+// Save creates the CostAnomaly in the database.
+func (cac *CostAnomalyCreate) Save(ctx context.Context) (*CostAnomaly, error) {
+	if err := cac.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cac.sqlSave, cac.mutation, cac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cac *CostAnomalyCreate) SaveX(ctx context.Context) *CostAnomaly {
+	v, err := cac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cac *CostAnomalyCreate) Exec(ctx context.Context) error {
+	_, err := cac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cac *CostAnomalyCreate) ExecX(ctx context.Context) {
+	if err := cac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cac *CostAnomalyCreate) defaults() error {
+	if _, ok := cac.mutation.CreateTime(); !ok {
+		if costanomaly.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized costanomaly.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := costanomaly.DefaultCreateTime()
+		cac.mutation.SetCreateTime(v)
+	}
+	if _, ok := cac.mutation.UpdateTime(); !ok {
+		if costanomaly.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized costanomaly.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := costanomaly.DefaultUpdateTime()
+		cac.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cac *CostAnomalyCreate) check() error {
+	if _, ok := cac.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "CostAnomaly.create_time"`)}
+	}
+	if _, ok := cac.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "CostAnomaly.update_time"`)}
+	}
+	if _, ok := cac.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`model: missing required field "CostAnomaly.scope"`)}
+	}
+	if v, ok := cac.mutation.Scope(); ok {
+		if err := costanomaly.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`model: validator failed for field "CostAnomaly.scope": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`model: missing required field "CostAnomaly.name"`)}
+	}
+	if v, ok := cac.mutation.Name(); ok {
+		if err := costanomaly.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`model: validator failed for field "CostAnomaly.name": %w`, err)}
+		}
+	}
+	if _, ok := cac.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`model: missing required field "CostAnomaly.fingerprint"`)}
+	}
+	if _, ok := cac.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`model: missing required field "CostAnomaly.day"`)}
+	}
+	if _, ok := cac.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`model: missing required field "CostAnomaly.type"`)}
+	}
+	if _, ok := cac.mutation.Cost(); !ok {
+		return &ValidationError{Name: "cost", err: errors.New(`model: missing required field "CostAnomaly.cost"`)}
+	}
+	if _, ok := cac.mutation.Baseline(); !ok {
+		return &ValidationError{Name: "baseline", err: errors.New(`model: missing required field "CostAnomaly.baseline"`)}
+	}
+	if _, ok := cac.mutation.DeviationPercent(); !ok {
+		return &ValidationError{Name: "deviation_percent", err: errors.New(`model: missing required field "CostAnomaly.deviation_percent"`)}
+	}
+	if _, ok := cac.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`model: missing required field "CostAnomaly.score"`)}
+	}
+	return nil
+}
+
+func (cac *CostAnomalyCreate) sqlSave(ctx context.Context) (*CostAnomaly, error) {
+	if err := cac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cac.mutation.id = &_node.ID
+	cac.mutation.done = true
+	return _node, nil
+}
+
+func (cac *CostAnomalyCreate) createSpec() (*CostAnomaly, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CostAnomaly{config: cac.config}
+		_spec = sqlgraph.NewCreateSpec(costanomaly.Table, sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString))
+	)
+	_spec.Schema = cac.schemaConfig.CostAnomaly
+	_spec.OnConflict = cac.conflict
+	if id, ok := cac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cac.mutation.CreateTime(); ok {
+		_spec.SetField(costanomaly.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := cac.mutation.UpdateTime(); ok {
+		_spec.SetField(costanomaly.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := cac.mutation.Scope(); ok {
+		_spec.SetField(costanomaly.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := cac.mutation.Name(); ok {
+		_spec.SetField(costanomaly.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cac.mutation.Fingerprint(); ok {
+		_spec.SetField(costanomaly.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := cac.mutation.Day(); ok {
+		_spec.SetField(costanomaly.FieldDay, field.TypeTime, value)
+		_node.Day = value
+	}
+	if value, ok := cac.mutation.GetType(); ok {
+		_spec.SetField(costanomaly.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := cac.mutation.Cost(); ok {
+		_spec.SetField(costanomaly.FieldCost, field.TypeFloat64, value)
+		_node.Cost = value
+	}
+	if value, ok := cac.mutation.Baseline(); ok {
+		_spec.SetField(costanomaly.FieldBaseline, field.TypeFloat64, value)
+		_node.Baseline = value
+	}
+	if value, ok := cac.mutation.DeviationPercent(); ok {
+		_spec.SetField(costanomaly.FieldDeviationPercent, field.TypeFloat64, value)
+		_node.DeviationPercent = value
+	}
+	if value, ok := cac.mutation.Score(); ok {
+		_spec.SetField(costanomaly.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if nodes := cac.mutation.ConnectorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   costanomaly.ConnectorTable,
+			Columns: []string{costanomaly.ConnectorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(connector.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = cac.schemaConfig.CostAnomaly
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ConnectorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (cac *CostAnomalyCreate) Set(obj *CostAnomaly) *CostAnomalyCreate {
+	// Required.
+	cac.SetScope(obj.Scope)
+	cac.SetName(obj.Name)
+	cac.SetFingerprint(obj.Fingerprint)
+	cac.SetDay(obj.Day)
+	cac.SetType(obj.Type)
+	cac.SetCost(obj.Cost)
+	cac.SetBaseline(obj.Baseline)
+	cac.SetDeviationPercent(obj.DeviationPercent)
+	cac.SetScore(obj.Score)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		cac.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		cac.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.ConnectorID != "" {
+		cac.SetConnectorID(obj.ConnectorID)
+	}
+
+	// Record the given object.
+	cac.object = obj
+
+	return cac
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (cac *CostAnomalyCreate) getClientSet() (mc ClientSet) {
+	if _, ok := cac.config.driver.(*txDriver); ok {
+		tx := &Tx{config: cac.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: cac.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the CostAnomaly entity,
+// which is always good for cascading create operations.
+func (cac *CostAnomalyCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *CostAnomaly) error) (*CostAnomaly, error) {
+	obj, err := cac.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := cac.getClientSet()
+
+	if x := cac.object; x != nil {
+		if _, set := cac.mutation.Field(costanomaly.FieldScope); set {
+			obj.Scope = x.Scope
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldName); set {
+			obj.Name = x.Name
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldConnectorID); set {
+			obj.ConnectorID = x.ConnectorID
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldFingerprint); set {
+			obj.Fingerprint = x.Fingerprint
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldDay); set {
+			obj.Day = x.Day
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldType); set {
+			obj.Type = x.Type
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldCost); set {
+			obj.Cost = x.Cost
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldBaseline); set {
+			obj.Baseline = x.Baseline
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldDeviationPercent); set {
+			obj.DeviationPercent = x.DeviationPercent
+		}
+		if _, set := cac.mutation.Field(costanomaly.FieldScore); set {
+			obj.Score = x.Score
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (cac *CostAnomalyCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *CostAnomaly) error) *CostAnomaly {
+	obj, err := cac.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (cac *CostAnomalyCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *CostAnomaly) error) error {
+	_, err := cac.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (cac *CostAnomalyCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *CostAnomaly) error) {
+	if err := cac.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the CostAnomalyCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (cacb *CostAnomalyCreateBulk) Set(objs ...*CostAnomaly) *CostAnomalyCreateBulk {
+	if len(objs) != 0 {
+		client := NewCostAnomalyClient(cacb.config)
+
+		cacb.builders = make([]*CostAnomalyCreate, len(objs))
+		for i := range objs {
+			cacb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		cacb.objects = objs
+	}
+
+	return cacb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (cacb *CostAnomalyCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := cacb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: cacb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: cacb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the CostAnomaly entities,
+// which is always good for cascading create operations.
+func (cacb *CostAnomalyCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *CostAnomaly) error) ([]*CostAnomaly, error) {
+	objs, err := cacb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := cacb.getClientSet()
+
+	if x := cacb.objects; x != nil {
+		for i := range x {
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldScope); set {
+				objs[i].Scope = x[i].Scope
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldName); set {
+				objs[i].Name = x[i].Name
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldConnectorID); set {
+				objs[i].ConnectorID = x[i].ConnectorID
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldFingerprint); set {
+				objs[i].Fingerprint = x[i].Fingerprint
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldDay); set {
+				objs[i].Day = x[i].Day
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldType); set {
+				objs[i].Type = x[i].Type
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldCost); set {
+				objs[i].Cost = x[i].Cost
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldBaseline); set {
+				objs[i].Baseline = x[i].Baseline
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldDeviationPercent); set {
+				objs[i].DeviationPercent = x[i].DeviationPercent
+			}
+			if _, set := cacb.builders[i].mutation.Field(costanomaly.FieldScore); set {
+				objs[i].Score = x[i].Score
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (cacb *CostAnomalyCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *CostAnomaly) error) []*CostAnomaly {
+	objs, err := cacb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (cacb *CostAnomalyCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *CostAnomaly) error) error {
+	_, err := cacb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (cacb *CostAnomalyCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *CostAnomaly) error) {
+	if err := cacb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *CostAnomalyUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *CostAnomaly) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for CostAnomalyUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *CostAnomalyUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *CostAnomaly) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *CostAnomalyUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *CostAnomaly) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the CostAnomalyUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for CostAnomalyUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *CostAnomalyUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *CostAnomaly) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CostAnomaly.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CostAnomalyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (cac *CostAnomalyCreate) OnConflict(opts ...sql.ConflictOption) *CostAnomalyUpsertOne {
+	cac.conflict = opts
+	return &CostAnomalyUpsertOne{
+		create: cac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CostAnomaly.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cac *CostAnomalyCreate) OnConflictColumns(columns ...string) *CostAnomalyUpsertOne {
+	cac.conflict = append(cac.conflict, sql.ConflictColumns(columns...))
+	return &CostAnomalyUpsertOne{
+		create: cac,
+	}
+}
+
+type (
+	// CostAnomalyUpsertOne is the builder for "upsert"-ing
+	//  one CostAnomaly node.
+	CostAnomalyUpsertOne struct {
+		create *CostAnomalyCreate
+	}
+
+	// CostAnomalyUpsert is the "OnConflict" setter.
+	CostAnomalyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CostAnomalyUpsert) SetUpdateTime(v time.Time) *CostAnomalyUpsert {
+	u.Set(costanomaly.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CostAnomalyUpsert) UpdateUpdateTime() *CostAnomalyUpsert {
+	u.SetExcluded(costanomaly.FieldUpdateTime)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *CostAnomalyUpsert) SetType(v string) *CostAnomalyUpsert {
+	u.Set(costanomaly.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CostAnomalyUpsert) UpdateType() *CostAnomalyUpsert {
+	u.SetExcluded(costanomaly.FieldType)
+	return u
+}
+
+// SetCost sets the "cost" field.
+func (u *CostAnomalyUpsert) SetCost(v float64) *CostAnomalyUpsert {
+	u.Set(costanomaly.FieldCost, v)
+	return u
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *CostAnomalyUpsert) UpdateCost() *CostAnomalyUpsert {
+	u.SetExcluded(costanomaly.FieldCost)
+	return u
+}
+
+// AddCost adds v to the "cost" field.
+func (u *CostAnomalyUpsert) AddCost(v float64) *CostAnomalyUpsert {
+	u.Add(costanomaly.FieldCost, v)
+	return u
+}
+
+// SetBaseline sets the "baseline" field.
+func (u *CostAnomalyUpsert) SetBaseline(v float64) *CostAnomalyUpsert {
+	u.Set(costanomaly.FieldBaseline, v)
+	return u
+}
+
+// UpdateBaseline sets the "baseline" field to the value that was provided on create.
+func (u *CostAnomalyUpsert) UpdateBaseline() *CostAnomalyUpsert {
+	u.SetExcluded(costanomaly.FieldBaseline)
+	return u
+}
+
+// AddBaseline adds v to the "baseline" field.
+func (u *CostAnomalyUpsert) AddBaseline(v float64) *CostAnomalyUpsert {
+	u.Add(costanomaly.FieldBaseline, v)
+	return u
+}
+
+// SetDeviationPercent sets the "deviation_percent" field.
+func (u *CostAnomalyUpsert) SetDeviationPercent(v float64) *CostAnomalyUpsert {
+	u.Set(costanomaly.FieldDeviationPercent, v)
+	return u
+}
+
+// UpdateDeviationPercent sets the "deviation_percent" field to the value that was provided on create.
+func (u *CostAnomalyUpsert) UpdateDeviationPercent() *CostAnomalyUpsert {
+	u.SetExcluded(costanomaly.FieldDeviationPercent)
+	return u
+}
+
+// AddDeviationPercent adds v to the "deviation_percent" field.
+func (u *CostAnomalyUpsert) AddDeviationPercent(v float64) *CostAnomalyUpsert {
+	u.Add(costanomaly.FieldDeviationPercent, v)
+	return u
+}
+
+// SetScore sets the "score" field.
+func (u *CostAnomalyUpsert) SetScore(v float64) *CostAnomalyUpsert {
+	u.Set(costanomaly.FieldScore, v)
+	return u
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *CostAnomalyUpsert) UpdateScore() *CostAnomalyUpsert {
+	u.SetExcluded(costanomaly.FieldScore)
+	return u
+}
+
+// AddScore adds v to the "score" field.
+func (u *CostAnomalyUpsert) AddScore(v float64) *CostAnomalyUpsert {
+	u.Add(costanomaly.FieldScore, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CostAnomaly.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(costanomaly.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CostAnomalyUpsertOne) UpdateNewValues() *CostAnomalyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(costanomaly.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(costanomaly.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.Scope(); exists {
+			s.SetIgnore(costanomaly.FieldScope)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(costanomaly.FieldName)
+		}
+		if _, exists := u.create.mutation.ConnectorID(); exists {
+			s.SetIgnore(costanomaly.FieldConnectorID)
+		}
+		if _, exists := u.create.mutation.Fingerprint(); exists {
+			s.SetIgnore(costanomaly.FieldFingerprint)
+		}
+		if _, exists := u.create.mutation.Day(); exists {
+			s.SetIgnore(costanomaly.FieldDay)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CostAnomaly.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CostAnomalyUpsertOne) Ignore() *CostAnomalyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CostAnomalyUpsertOne) DoNothing() *CostAnomalyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CostAnomalyCreate.OnConflict
+// documentation for more info.
+func (u *CostAnomalyUpsertOne) Update(set func(*CostAnomalyUpsert)) *CostAnomalyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CostAnomalyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CostAnomalyUpsertOne) SetUpdateTime(v time.Time) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CostAnomalyUpsertOne) UpdateUpdateTime() *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *CostAnomalyUpsertOne) SetType(v string) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CostAnomalyUpsertOne) UpdateType() *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *CostAnomalyUpsertOne) SetCost(v float64) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *CostAnomalyUpsertOne) AddCost(v float64) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *CostAnomalyUpsertOne) UpdateCost() *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateCost()
+	})
+}
+
+// SetBaseline sets the "baseline" field.
+func (u *CostAnomalyUpsertOne) SetBaseline(v float64) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetBaseline(v)
+	})
+}
+
+// AddBaseline adds v to the "baseline" field.
+func (u *CostAnomalyUpsertOne) AddBaseline(v float64) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.AddBaseline(v)
+	})
+}
+
+// UpdateBaseline sets the "baseline" field to the value that was provided on create.
+func (u *CostAnomalyUpsertOne) UpdateBaseline() *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateBaseline()
+	})
+}
+
+// SetDeviationPercent sets the "deviation_percent" field.
+func (u *CostAnomalyUpsertOne) SetDeviationPercent(v float64) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetDeviationPercent(v)
+	})
+}
+
+// AddDeviationPercent adds v to the "deviation_percent" field.
+func (u *CostAnomalyUpsertOne) AddDeviationPercent(v float64) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.AddDeviationPercent(v)
+	})
+}
+
+// UpdateDeviationPercent sets the "deviation_percent" field to the value that was provided on create.
+func (u *CostAnomalyUpsertOne) UpdateDeviationPercent() *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateDeviationPercent()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *CostAnomalyUpsertOne) SetScore(v float64) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *CostAnomalyUpsertOne) AddScore(v float64) *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *CostAnomalyUpsertOne) UpdateScore() *CostAnomalyUpsertOne {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *CostAnomalyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for CostAnomalyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CostAnomalyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CostAnomalyUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: CostAnomalyUpsertOne.ID is not supported by MySQL driver. Use CostAnomalyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CostAnomalyUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CostAnomalyCreateBulk is the builder for creating many CostAnomaly entities in bulk.
+type CostAnomalyCreateBulk struct {
+	config
+	err        error
+	builders   []*CostAnomalyCreate
+	conflict   []sql.ConflictOption
+	objects    []*CostAnomaly
+	fromUpsert bool
+}
+
+// Save creates the CostAnomaly entities in the database.
+func (cacb *CostAnomalyCreateBulk) Save(ctx context.Context) ([]*CostAnomaly, error) {
+	if cacb.err != nil {
+		return nil, cacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cacb.builders))
+	nodes := make([]*CostAnomaly, len(cacb.builders))
+	mutators := make([]Mutator, len(cacb.builders))
+	for i := range cacb.builders {
+		func(i int, root context.Context) {
+			builder := cacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CostAnomalyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = cacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cacb *CostAnomalyCreateBulk) SaveX(ctx context.Context) []*CostAnomaly {
+	v, err := cacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cacb *CostAnomalyCreateBulk) Exec(ctx context.Context) error {
+	_, err := cacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cacb *CostAnomalyCreateBulk) ExecX(ctx context.Context) {
+	if err := cacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CostAnomaly.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CostAnomalyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (cacb *CostAnomalyCreateBulk) OnConflict(opts ...sql.ConflictOption) *CostAnomalyUpsertBulk {
+	cacb.conflict = opts
+	return &CostAnomalyUpsertBulk{
+		create: cacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CostAnomaly.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (cacb *CostAnomalyCreateBulk) OnConflictColumns(columns ...string) *CostAnomalyUpsertBulk {
+	cacb.conflict = append(cacb.conflict, sql.ConflictColumns(columns...))
+	return &CostAnomalyUpsertBulk{
+		create: cacb,
+	}
+}
+
+// CostAnomalyUpsertBulk is the builder for "upsert"-ing
+// a bulk of CostAnomaly nodes.
+type CostAnomalyUpsertBulk struct {
+	create *CostAnomalyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CostAnomaly.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(costanomaly.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CostAnomalyUpsertBulk) UpdateNewValues() *CostAnomalyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(costanomaly.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(costanomaly.FieldCreateTime)
+			}
+			if _, exists := b.mutation.Scope(); exists {
+				s.SetIgnore(costanomaly.FieldScope)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(costanomaly.FieldName)
+			}
+			if _, exists := b.mutation.ConnectorID(); exists {
+				s.SetIgnore(costanomaly.FieldConnectorID)
+			}
+			if _, exists := b.mutation.Fingerprint(); exists {
+				s.SetIgnore(costanomaly.FieldFingerprint)
+			}
+			if _, exists := b.mutation.Day(); exists {
+				s.SetIgnore(costanomaly.FieldDay)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CostAnomaly.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CostAnomalyUpsertBulk) Ignore() *CostAnomalyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CostAnomalyUpsertBulk) DoNothing() *CostAnomalyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CostAnomalyCreateBulk.OnConflict
+// documentation for more info.
+func (u *CostAnomalyUpsertBulk) Update(set func(*CostAnomalyUpsert)) *CostAnomalyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CostAnomalyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *CostAnomalyUpsertBulk) SetUpdateTime(v time.Time) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *CostAnomalyUpsertBulk) UpdateUpdateTime() *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *CostAnomalyUpsertBulk) SetType(v string) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *CostAnomalyUpsertBulk) UpdateType() *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *CostAnomalyUpsertBulk) SetCost(v float64) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *CostAnomalyUpsertBulk) AddCost(v float64) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *CostAnomalyUpsertBulk) UpdateCost() *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateCost()
+	})
+}
+
+// SetBaseline sets the "baseline" field.
+func (u *CostAnomalyUpsertBulk) SetBaseline(v float64) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetBaseline(v)
+	})
+}
+
+// AddBaseline adds v to the "baseline" field.
+func (u *CostAnomalyUpsertBulk) AddBaseline(v float64) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.AddBaseline(v)
+	})
+}
+
+// UpdateBaseline sets the "baseline" field to the value that was provided on create.
+func (u *CostAnomalyUpsertBulk) UpdateBaseline() *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateBaseline()
+	})
+}
+
+// SetDeviationPercent sets the "deviation_percent" field.
+func (u *CostAnomalyUpsertBulk) SetDeviationPercent(v float64) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetDeviationPercent(v)
+	})
+}
+
+// AddDeviationPercent adds v to the "deviation_percent" field.
+func (u *CostAnomalyUpsertBulk) AddDeviationPercent(v float64) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.AddDeviationPercent(v)
+	})
+}
+
+// UpdateDeviationPercent sets the "deviation_percent" field to the value that was provided on create.
+func (u *CostAnomalyUpsertBulk) UpdateDeviationPercent() *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateDeviationPercent()
+	})
+}
+
+// SetScore sets the "score" field.
+func (u *CostAnomalyUpsertBulk) SetScore(v float64) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.SetScore(v)
+	})
+}
+
+// AddScore adds v to the "score" field.
+func (u *CostAnomalyUpsertBulk) AddScore(v float64) *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.AddScore(v)
+	})
+}
+
+// UpdateScore sets the "score" field to the value that was provided on create.
+func (u *CostAnomalyUpsertBulk) UpdateScore() *CostAnomalyUpsertBulk {
+	return u.Update(func(s *CostAnomalyUpsert) {
+		s.UpdateScore()
+	})
+}
+
+// Exec executes the query.
+func (u *CostAnomalyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the CostAnomalyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for CostAnomalyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CostAnomalyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
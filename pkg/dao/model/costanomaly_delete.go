@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+// CostAnomalyDelete is the builder for deleting a CostAnomaly entity.
+type CostAnomalyDelete struct {
+	config
+	hooks    []Hook
+	mutation *CostAnomalyMutation
+}
+
+// Where appends a list predicates to the CostAnomalyDelete builder.
+func (cad *CostAnomalyDelete) Where(ps ...predicate.CostAnomaly) *CostAnomalyDelete {
+	cad.mutation.Where(ps...)
+	return cad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cad *CostAnomalyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cad.sqlExec, cad.mutation, cad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cad *CostAnomalyDelete) ExecX(ctx context.Context) int {
+	n, err := cad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cad *CostAnomalyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(costanomaly.Table, sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString))
+	_spec.Node.Schema = cad.schemaConfig.CostAnomaly
+	ctx = internal.NewSchemaConfigContext(ctx, cad.schemaConfig)
+	if ps := cad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cad.mutation.done = true
+	return affected, err
+}
+
+// CostAnomalyDeleteOne is the builder for deleting a single CostAnomaly entity.
+type CostAnomalyDeleteOne struct {
+	cad *CostAnomalyDelete
+}
+
+// Where appends a list predicates to the CostAnomalyDelete builder.
+func (cado *CostAnomalyDeleteOne) Where(ps ...predicate.CostAnomaly) *CostAnomalyDeleteOne {
+	cado.cad.mutation.Where(ps...)
+	return cado
+}
+
+// Exec executes the deletion query.
+func (cado *CostAnomalyDeleteOne) Exec(ctx context.Context) error {
+	n, err := cado.cad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{costanomaly.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cado *CostAnomalyDeleteOne) ExecX(ctx context.Context) {
+	if err := cado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,680 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// CostAnomalyQuery is the builder for querying CostAnomaly entities.
+type CostAnomalyQuery struct {
+	config
+	ctx           *QueryContext
+	order         []costanomaly.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.CostAnomaly
+	withConnector *ConnectorQuery
+	modifiers     []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CostAnomalyQuery builder.
+func (caq *CostAnomalyQuery) Where(ps ...predicate.CostAnomaly) *CostAnomalyQuery {
+	caq.predicates = append(caq.predicates, ps...)
+	return caq
+}
+
+// Limit the number of records to be returned by this query.
+func (caq *CostAnomalyQuery) Limit(limit int) *CostAnomalyQuery {
+	caq.ctx.Limit = &limit
+	return caq
+}
+
+// Offset to start from.
+func (caq *CostAnomalyQuery) Offset(offset int) *CostAnomalyQuery {
+	caq.ctx.Offset = &offset
+	return caq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (caq *CostAnomalyQuery) Unique(unique bool) *CostAnomalyQuery {
+	caq.ctx.Unique = &unique
+	return caq
+}
+
+// Order specifies how the records should be ordered.
+func (caq *CostAnomalyQuery) Order(o ...costanomaly.OrderOption) *CostAnomalyQuery {
+	caq.order = append(caq.order, o...)
+	return caq
+}
+
+// QueryConnector chains the current query on the "connector" edge.
+func (caq *CostAnomalyQuery) QueryConnector() *ConnectorQuery {
+	query := (&ConnectorClient{config: caq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := caq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := caq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(costanomaly.Table, costanomaly.FieldID, selector),
+			sqlgraph.To(connector.Table, connector.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, costanomaly.ConnectorTable, costanomaly.ConnectorColumn),
+		)
+		schemaConfig := caq.schemaConfig
+		step.To.Schema = schemaConfig.Connector
+		step.Edge.Schema = schemaConfig.CostAnomaly
+		fromU = sqlgraph.SetNeighbors(caq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CostAnomaly entity from the query.
+// Returns a *NotFoundError when no CostAnomaly was found.
+func (caq *CostAnomalyQuery) First(ctx context.Context) (*CostAnomaly, error) {
+	nodes, err := caq.Limit(1).All(setContextOp(ctx, caq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{costanomaly.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (caq *CostAnomalyQuery) FirstX(ctx context.Context) *CostAnomaly {
+	node, err := caq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CostAnomaly ID from the query.
+// Returns a *NotFoundError when no CostAnomaly ID was found.
+func (caq *CostAnomalyQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = caq.Limit(1).IDs(setContextOp(ctx, caq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{costanomaly.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (caq *CostAnomalyQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := caq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CostAnomaly entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CostAnomaly entity is found.
+// Returns a *NotFoundError when no CostAnomaly entities are found.
+func (caq *CostAnomalyQuery) Only(ctx context.Context) (*CostAnomaly, error) {
+	nodes, err := caq.Limit(2).All(setContextOp(ctx, caq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{costanomaly.Label}
+	default:
+		return nil, &NotSingularError{costanomaly.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (caq *CostAnomalyQuery) OnlyX(ctx context.Context) *CostAnomaly {
+	node, err := caq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CostAnomaly ID in the query.
+// Returns a *NotSingularError when more than one CostAnomaly ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (caq *CostAnomalyQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = caq.Limit(2).IDs(setContextOp(ctx, caq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{costanomaly.Label}
+	default:
+		err = &NotSingularError{costanomaly.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (caq *CostAnomalyQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := caq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CostAnomalies.
+func (caq *CostAnomalyQuery) All(ctx context.Context) ([]*CostAnomaly, error) {
+	ctx = setContextOp(ctx, caq.ctx, "All")
+	if err := caq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CostAnomaly, *CostAnomalyQuery]()
+	return withInterceptors[[]*CostAnomaly](ctx, caq, qr, caq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (caq *CostAnomalyQuery) AllX(ctx context.Context) []*CostAnomaly {
+	nodes, err := caq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CostAnomaly IDs.
+func (caq *CostAnomalyQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if caq.ctx.Unique == nil && caq.path != nil {
+		caq.Unique(true)
+	}
+	ctx = setContextOp(ctx, caq.ctx, "IDs")
+	if err = caq.Select(costanomaly.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (caq *CostAnomalyQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := caq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (caq *CostAnomalyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, caq.ctx, "Count")
+	if err := caq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, caq, querierCount[*CostAnomalyQuery](), caq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (caq *CostAnomalyQuery) CountX(ctx context.Context) int {
+	count, err := caq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (caq *CostAnomalyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, caq.ctx, "Exist")
+	switch _, err := caq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (caq *CostAnomalyQuery) ExistX(ctx context.Context) bool {
+	exist, err := caq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CostAnomalyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (caq *CostAnomalyQuery) Clone() *CostAnomalyQuery {
+	if caq == nil {
+		return nil
+	}
+	return &CostAnomalyQuery{
+		config:        caq.config,
+		ctx:           caq.ctx.Clone(),
+		order:         append([]costanomaly.OrderOption{}, caq.order...),
+		inters:        append([]Interceptor{}, caq.inters...),
+		predicates:    append([]predicate.CostAnomaly{}, caq.predicates...),
+		withConnector: caq.withConnector.Clone(),
+		// clone intermediate query.
+		sql:  caq.sql.Clone(),
+		path: caq.path,
+	}
+}
+
+// WithConnector tells the query-builder to eager-load the nodes that are connected to
+// the "connector" edge. The optional arguments are used to configure the query builder of the edge.
+func (caq *CostAnomalyQuery) WithConnector(opts ...func(*ConnectorQuery)) *CostAnomalyQuery {
+	query := (&ConnectorClient{config: caq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	caq.withConnector = query
+	return caq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CostAnomaly.Query().
+//		GroupBy(costanomaly.FieldCreateTime).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (caq *CostAnomalyQuery) GroupBy(field string, fields ...string) *CostAnomalyGroupBy {
+	caq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CostAnomalyGroupBy{build: caq}
+	grbuild.flds = &caq.ctx.Fields
+	grbuild.label = costanomaly.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.CostAnomaly.Query().
+//		Select(costanomaly.FieldCreateTime).
+//		Scan(ctx, &v)
+func (caq *CostAnomalyQuery) Select(fields ...string) *CostAnomalySelect {
+	caq.ctx.Fields = append(caq.ctx.Fields, fields...)
+	sbuild := &CostAnomalySelect{CostAnomalyQuery: caq}
+	sbuild.label = costanomaly.Label
+	sbuild.flds, sbuild.scan = &caq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CostAnomalySelect configured with the given aggregations.
+func (caq *CostAnomalyQuery) Aggregate(fns ...AggregateFunc) *CostAnomalySelect {
+	return caq.Select().Aggregate(fns...)
+}
+
+func (caq *CostAnomalyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range caq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, caq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range caq.ctx.Fields {
+		if !costanomaly.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if caq.path != nil {
+		prev, err := caq.path(ctx)
+		if err != nil {
+			return err
+		}
+		caq.sql = prev
+	}
+	return nil
+}
+
+func (caq *CostAnomalyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CostAnomaly, error) {
+	var (
+		nodes       = []*CostAnomaly{}
+		_spec       = caq.querySpec()
+		loadedTypes = [1]bool{
+			caq.withConnector != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CostAnomaly).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CostAnomaly{config: caq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = caq.schemaConfig.CostAnomaly
+	ctx = internal.NewSchemaConfigContext(ctx, caq.schemaConfig)
+	if len(caq.modifiers) > 0 {
+		_spec.Modifiers = caq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, caq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := caq.withConnector; query != nil {
+		if err := caq.loadConnector(ctx, query, nodes, nil,
+			func(n *CostAnomaly, e *Connector) { n.Edges.Connector = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (caq *CostAnomalyQuery) loadConnector(ctx context.Context, query *ConnectorQuery, nodes []*CostAnomaly, init func(*CostAnomaly), assign func(*CostAnomaly, *Connector)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*CostAnomaly)
+	for i := range nodes {
+		fk := nodes[i].ConnectorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(connector.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "connector_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (caq *CostAnomalyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := caq.querySpec()
+	_spec.Node.Schema = caq.schemaConfig.CostAnomaly
+	ctx = internal.NewSchemaConfigContext(ctx, caq.schemaConfig)
+	if len(caq.modifiers) > 0 {
+		_spec.Modifiers = caq.modifiers
+	}
+	_spec.Node.Columns = caq.ctx.Fields
+	if len(caq.ctx.Fields) > 0 {
+		_spec.Unique = caq.ctx.Unique != nil && *caq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, caq.driver, _spec)
+}
+
+func (caq *CostAnomalyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(costanomaly.Table, costanomaly.Columns, sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString))
+	_spec.From = caq.sql
+	if unique := caq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if caq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := caq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, costanomaly.FieldID)
+		for i := range fields {
+			if fields[i] != costanomaly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if caq.withConnector != nil {
+			_spec.Node.AddColumnOnce(costanomaly.FieldConnectorID)
+		}
+	}
+	if ps := caq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := caq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := caq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := caq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (caq *CostAnomalyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(caq.driver.Dialect())
+	t1 := builder.Table(costanomaly.Table)
+	columns := caq.ctx.Fields
+	if len(columns) == 0 {
+		columns = costanomaly.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if caq.sql != nil {
+		selector = caq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if caq.ctx.Unique != nil && *caq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(caq.schemaConfig.CostAnomaly)
+	ctx = internal.NewSchemaConfigContext(ctx, caq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range caq.modifiers {
+		m(selector)
+	}
+	for _, p := range caq.predicates {
+		p(selector)
+	}
+	for _, p := range caq.order {
+		p(selector)
+	}
+	if offset := caq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := caq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (caq *CostAnomalyQuery) ForUpdate(opts ...sql.LockOption) *CostAnomalyQuery {
+	if caq.driver.Dialect() == dialect.Postgres {
+		caq.Unique(false)
+	}
+	caq.modifiers = append(caq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return caq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (caq *CostAnomalyQuery) ForShare(opts ...sql.LockOption) *CostAnomalyQuery {
+	if caq.driver.Dialect() == dialect.Postgres {
+		caq.Unique(false)
+	}
+	caq.modifiers = append(caq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return caq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (caq *CostAnomalyQuery) Modify(modifiers ...func(s *sql.Selector)) *CostAnomalySelect {
+	caq.modifiers = append(caq.modifiers, modifiers...)
+	return caq.Select()
+}
+
+// WhereP appends storage-level predicates to the CostAnomalyQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (caq *CostAnomalyQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.CostAnomaly, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.CostAnomaly(ps[i]))
+	}
+	caq.predicates = append(caq.predicates, wps...)
+}
+
+// CostAnomalyGroupBy is the group-by builder for CostAnomaly entities.
+type CostAnomalyGroupBy struct {
+	selector
+	build *CostAnomalyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cagb *CostAnomalyGroupBy) Aggregate(fns ...AggregateFunc) *CostAnomalyGroupBy {
+	cagb.fns = append(cagb.fns, fns...)
+	return cagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cagb *CostAnomalyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cagb.build.ctx, "GroupBy")
+	if err := cagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CostAnomalyQuery, *CostAnomalyGroupBy](ctx, cagb.build, cagb, cagb.build.inters, v)
+}
+
+func (cagb *CostAnomalyGroupBy) sqlScan(ctx context.Context, root *CostAnomalyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cagb.fns))
+	for _, fn := range cagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cagb.flds)+len(cagb.fns))
+		for _, f := range *cagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CostAnomalySelect is the builder for selecting fields of CostAnomaly entities.
+type CostAnomalySelect struct {
+	*CostAnomalyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cas *CostAnomalySelect) Aggregate(fns ...AggregateFunc) *CostAnomalySelect {
+	cas.fns = append(cas.fns, fns...)
+	return cas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cas *CostAnomalySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cas.ctx, "Select")
+	if err := cas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CostAnomalyQuery, *CostAnomalySelect](ctx, cas.CostAnomalyQuery, cas, cas.inters, v)
+}
+
+func (cas *CostAnomalySelect) sqlScan(ctx context.Context, root *CostAnomalyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cas.fns))
+	for _, fn := range cas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (cas *CostAnomalySelect) Modify(modifiers ...func(s *sql.Selector)) *CostAnomalySelect {
+	cas.modifiers = append(cas.modifiers, modifiers...)
+	return cas
+}
@@ -0,0 +1,712 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+// CostAnomalyUpdate is the builder for updating CostAnomaly entities.
+type CostAnomalyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CostAnomalyMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *CostAnomaly
+}
+
+// Where appends a list predicates to the CostAnomalyUpdate builder.
+func (cau *CostAnomalyUpdate) Where(ps ...predicate.CostAnomaly) *CostAnomalyUpdate {
+	cau.mutation.Where(ps...)
+	return cau
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cau *CostAnomalyUpdate) SetUpdateTime(t time.Time) *CostAnomalyUpdate {
+	cau.mutation.SetUpdateTime(t)
+	return cau
+}
+
+// SetType sets the "type" field.
+func (cau *CostAnomalyUpdate) SetType(s string) *CostAnomalyUpdate {
+	cau.mutation.SetType(s)
+	return cau
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cau *CostAnomalyUpdate) SetNillableType(s *string) *CostAnomalyUpdate {
+	if s != nil {
+		cau.SetType(*s)
+	}
+	return cau
+}
+
+// SetCost sets the "cost" field.
+func (cau *CostAnomalyUpdate) SetCost(f float64) *CostAnomalyUpdate {
+	cau.mutation.ResetCost()
+	cau.mutation.SetCost(f)
+	return cau
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (cau *CostAnomalyUpdate) SetNillableCost(f *float64) *CostAnomalyUpdate {
+	if f != nil {
+		cau.SetCost(*f)
+	}
+	return cau
+}
+
+// AddCost adds f to the "cost" field.
+func (cau *CostAnomalyUpdate) AddCost(f float64) *CostAnomalyUpdate {
+	cau.mutation.AddCost(f)
+	return cau
+}
+
+// SetBaseline sets the "baseline" field.
+func (cau *CostAnomalyUpdate) SetBaseline(f float64) *CostAnomalyUpdate {
+	cau.mutation.ResetBaseline()
+	cau.mutation.SetBaseline(f)
+	return cau
+}
+
+// SetNillableBaseline sets the "baseline" field if the given value is not nil.
+func (cau *CostAnomalyUpdate) SetNillableBaseline(f *float64) *CostAnomalyUpdate {
+	if f != nil {
+		cau.SetBaseline(*f)
+	}
+	return cau
+}
+
+// AddBaseline adds f to the "baseline" field.
+func (cau *CostAnomalyUpdate) AddBaseline(f float64) *CostAnomalyUpdate {
+	cau.mutation.AddBaseline(f)
+	return cau
+}
+
+// SetDeviationPercent sets the "deviation_percent" field.
+func (cau *CostAnomalyUpdate) SetDeviationPercent(f float64) *CostAnomalyUpdate {
+	cau.mutation.ResetDeviationPercent()
+	cau.mutation.SetDeviationPercent(f)
+	return cau
+}
+
+// SetNillableDeviationPercent sets the "deviation_percent" field if the given value is not nil.
+func (cau *CostAnomalyUpdate) SetNillableDeviationPercent(f *float64) *CostAnomalyUpdate {
+	if f != nil {
+		cau.SetDeviationPercent(*f)
+	}
+	return cau
+}
+
+// AddDeviationPercent adds f to the "deviation_percent" field.
+func (cau *CostAnomalyUpdate) AddDeviationPercent(f float64) *CostAnomalyUpdate {
+	cau.mutation.AddDeviationPercent(f)
+	return cau
+}
+
+// SetScore sets the "score" field.
+func (cau *CostAnomalyUpdate) SetScore(f float64) *CostAnomalyUpdate {
+	cau.mutation.ResetScore()
+	cau.mutation.SetScore(f)
+	return cau
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (cau *CostAnomalyUpdate) SetNillableScore(f *float64) *CostAnomalyUpdate {
+	if f != nil {
+		cau.SetScore(*f)
+	}
+	return cau
+}
+
+// AddScore adds f to the "score" field.
+func (cau *CostAnomalyUpdate) AddScore(f float64) *CostAnomalyUpdate {
+	cau.mutation.AddScore(f)
+	return cau
+}
+
+// Mutation returns the CostAnomalyMutation object of the builder.
+func (cau *CostAnomalyUpdate) Mutation() *CostAnomalyMutation {
+	return cau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cau *CostAnomalyUpdate) Save(ctx context.Context) (int, error) {
+	if err := cau.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, cau.sqlSave, cau.mutation, cau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cau *CostAnomalyUpdate) SaveX(ctx context.Context) int {
+	affected, err := cau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cau *CostAnomalyUpdate) Exec(ctx context.Context) error {
+	_, err := cau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cau *CostAnomalyUpdate) ExecX(ctx context.Context) {
+	if err := cau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cau *CostAnomalyUpdate) defaults() error {
+	if _, ok := cau.mutation.UpdateTime(); !ok {
+		if costanomaly.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized costanomaly.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := costanomaly.UpdateDefaultUpdateTime()
+		cau.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (cau *CostAnomalyUpdate) Set(obj *CostAnomaly) *CostAnomalyUpdate {
+	// Without Default.
+	cau.SetType(obj.Type)
+	cau.SetCost(obj.Cost)
+	cau.SetBaseline(obj.Baseline)
+	cau.SetDeviationPercent(obj.DeviationPercent)
+	cau.SetScore(obj.Score)
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		cau.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	cau.object = obj
+
+	return cau
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cau *CostAnomalyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CostAnomalyUpdate {
+	cau.modifiers = append(cau.modifiers, modifiers...)
+	return cau
+}
+
+func (cau *CostAnomalyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(costanomaly.Table, costanomaly.Columns, sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString))
+	if ps := cau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cau.mutation.UpdateTime(); ok {
+		_spec.SetField(costanomaly.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := cau.mutation.GetType(); ok {
+		_spec.SetField(costanomaly.FieldType, field.TypeString, value)
+	}
+	if value, ok := cau.mutation.Cost(); ok {
+		_spec.SetField(costanomaly.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.AddedCost(); ok {
+		_spec.AddField(costanomaly.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.Baseline(); ok {
+		_spec.SetField(costanomaly.FieldBaseline, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.AddedBaseline(); ok {
+		_spec.AddField(costanomaly.FieldBaseline, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.DeviationPercent(); ok {
+		_spec.SetField(costanomaly.FieldDeviationPercent, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.AddedDeviationPercent(); ok {
+		_spec.AddField(costanomaly.FieldDeviationPercent, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.Score(); ok {
+		_spec.SetField(costanomaly.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := cau.mutation.AddedScore(); ok {
+		_spec.AddField(costanomaly.FieldScore, field.TypeFloat64, value)
+	}
+	_spec.Node.Schema = cau.schemaConfig.CostAnomaly
+	ctx = internal.NewSchemaConfigContext(ctx, cau.schemaConfig)
+	_spec.AddModifiers(cau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, cau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{costanomaly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cau.mutation.done = true
+	return n, nil
+}
+
+// CostAnomalyUpdateOne is the builder for updating a single CostAnomaly entity.
+type CostAnomalyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CostAnomalyMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *CostAnomaly
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (cauo *CostAnomalyUpdateOne) SetUpdateTime(t time.Time) *CostAnomalyUpdateOne {
+	cauo.mutation.SetUpdateTime(t)
+	return cauo
+}
+
+// SetType sets the "type" field.
+func (cauo *CostAnomalyUpdateOne) SetType(s string) *CostAnomalyUpdateOne {
+	cauo.mutation.SetType(s)
+	return cauo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (cauo *CostAnomalyUpdateOne) SetNillableType(s *string) *CostAnomalyUpdateOne {
+	if s != nil {
+		cauo.SetType(*s)
+	}
+	return cauo
+}
+
+// SetCost sets the "cost" field.
+func (cauo *CostAnomalyUpdateOne) SetCost(f float64) *CostAnomalyUpdateOne {
+	cauo.mutation.ResetCost()
+	cauo.mutation.SetCost(f)
+	return cauo
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (cauo *CostAnomalyUpdateOne) SetNillableCost(f *float64) *CostAnomalyUpdateOne {
+	if f != nil {
+		cauo.SetCost(*f)
+	}
+	return cauo
+}
+
+// AddCost adds f to the "cost" field.
+func (cauo *CostAnomalyUpdateOne) AddCost(f float64) *CostAnomalyUpdateOne {
+	cauo.mutation.AddCost(f)
+	return cauo
+}
+
+// SetBaseline sets the "baseline" field.
+func (cauo *CostAnomalyUpdateOne) SetBaseline(f float64) *CostAnomalyUpdateOne {
+	cauo.mutation.ResetBaseline()
+	cauo.mutation.SetBaseline(f)
+	return cauo
+}
+
+// SetNillableBaseline sets the "baseline" field if the given value is not nil.
+func (cauo *CostAnomalyUpdateOne) SetNillableBaseline(f *float64) *CostAnomalyUpdateOne {
+	if f != nil {
+		cauo.SetBaseline(*f)
+	}
+	return cauo
+}
+
+// AddBaseline adds f to the "baseline" field.
+func (cauo *CostAnomalyUpdateOne) AddBaseline(f float64) *CostAnomalyUpdateOne {
+	cauo.mutation.AddBaseline(f)
+	return cauo
+}
+
+// SetDeviationPercent sets the "deviation_percent" field.
+func (cauo *CostAnomalyUpdateOne) SetDeviationPercent(f float64) *CostAnomalyUpdateOne {
+	cauo.mutation.ResetDeviationPercent()
+	cauo.mutation.SetDeviationPercent(f)
+	return cauo
+}
+
+// SetNillableDeviationPercent sets the "deviation_percent" field if the given value is not nil.
+func (cauo *CostAnomalyUpdateOne) SetNillableDeviationPercent(f *float64) *CostAnomalyUpdateOne {
+	if f != nil {
+		cauo.SetDeviationPercent(*f)
+	}
+	return cauo
+}
+
+// AddDeviationPercent adds f to the "deviation_percent" field.
+func (cauo *CostAnomalyUpdateOne) AddDeviationPercent(f float64) *CostAnomalyUpdateOne {
+	cauo.mutation.AddDeviationPercent(f)
+	return cauo
+}
+
+// SetScore sets the "score" field.
+func (cauo *CostAnomalyUpdateOne) SetScore(f float64) *CostAnomalyUpdateOne {
+	cauo.mutation.ResetScore()
+	cauo.mutation.SetScore(f)
+	return cauo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (cauo *CostAnomalyUpdateOne) SetNillableScore(f *float64) *CostAnomalyUpdateOne {
+	if f != nil {
+		cauo.SetScore(*f)
+	}
+	return cauo
+}
+
+// AddScore adds f to the "score" field.
+func (cauo *CostAnomalyUpdateOne) AddScore(f float64) *CostAnomalyUpdateOne {
+	cauo.mutation.AddScore(f)
+	return cauo
+}
+
+// Mutation returns the CostAnomalyMutation object of the builder.
+func (cauo *CostAnomalyUpdateOne) Mutation() *CostAnomalyMutation {
+	return cauo.mutation
+}
+
+// Where appends a list predicates to the CostAnomalyUpdate builder.
+func (cauo *CostAnomalyUpdateOne) Where(ps ...predicate.CostAnomaly) *CostAnomalyUpdateOne {
+	cauo.mutation.Where(ps...)
+	return cauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cauo *CostAnomalyUpdateOne) Select(field string, fields ...string) *CostAnomalyUpdateOne {
+	cauo.fields = append([]string{field}, fields...)
+	return cauo
+}
+
+// Save executes the query and returns the updated CostAnomaly entity.
+func (cauo *CostAnomalyUpdateOne) Save(ctx context.Context) (*CostAnomaly, error) {
+	if err := cauo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, cauo.sqlSave, cauo.mutation, cauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cauo *CostAnomalyUpdateOne) SaveX(ctx context.Context) *CostAnomaly {
+	node, err := cauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cauo *CostAnomalyUpdateOne) Exec(ctx context.Context) error {
+	_, err := cauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cauo *CostAnomalyUpdateOne) ExecX(ctx context.Context) {
+	if err := cauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cauo *CostAnomalyUpdateOne) defaults() error {
+	if _, ok := cauo.mutation.UpdateTime(); !ok {
+		if costanomaly.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized costanomaly.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := costanomaly.UpdateDefaultUpdateTime()
+		cauo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (cauo *CostAnomalyUpdateOne) Set(obj *CostAnomaly) *CostAnomalyUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*CostAnomalyMutation)
+			db, err := mt.Client().CostAnomaly.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting CostAnomaly with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if db.Type != obj.Type {
+				cauo.SetType(obj.Type)
+			}
+			if db.Cost != obj.Cost {
+				cauo.SetCost(obj.Cost)
+			}
+			if db.Baseline != obj.Baseline {
+				cauo.SetBaseline(obj.Baseline)
+			}
+			if db.DeviationPercent != obj.DeviationPercent {
+				cauo.SetDeviationPercent(obj.DeviationPercent)
+			}
+			if db.Score != obj.Score {
+				cauo.SetScore(obj.Score)
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				cauo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			cauo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	cauo.hooks = append(cauo.hooks, h)
+
+	return cauo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (cauo *CostAnomalyUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := cauo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: cauo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: cauo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the CostAnomaly entity,
+// which is always good for cascading update operations.
+func (cauo *CostAnomalyUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *CostAnomaly) error) (*CostAnomaly, error) {
+	obj, err := cauo.Save(ctx)
+	if err != nil &&
+		(cauo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := cauo.getClientSet()
+
+	if obj == nil {
+		obj = cauo.object
+	} else if x := cauo.object; x != nil {
+		if _, set := cauo.mutation.Field(costanomaly.FieldType); set {
+			obj.Type = x.Type
+		}
+		if _, set := cauo.mutation.Field(costanomaly.FieldCost); set {
+			obj.Cost = x.Cost
+		}
+		if _, set := cauo.mutation.Field(costanomaly.FieldBaseline); set {
+			obj.Baseline = x.Baseline
+		}
+		if _, set := cauo.mutation.Field(costanomaly.FieldDeviationPercent); set {
+			obj.DeviationPercent = x.DeviationPercent
+		}
+		if _, set := cauo.mutation.Field(costanomaly.FieldScore); set {
+			obj.Score = x.Score
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (cauo *CostAnomalyUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *CostAnomaly) error) *CostAnomaly {
+	obj, err := cauo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (cauo *CostAnomalyUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *CostAnomaly) error) error {
+	_, err := cauo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cauo *CostAnomalyUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *CostAnomaly) error) {
+	if err := cauo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (cauo *CostAnomalyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CostAnomalyUpdateOne {
+	cauo.modifiers = append(cauo.modifiers, modifiers...)
+	return cauo
+}
+
+func (cauo *CostAnomalyUpdateOne) sqlSave(ctx context.Context) (_node *CostAnomaly, err error) {
+	_spec := sqlgraph.NewUpdateSpec(costanomaly.Table, costanomaly.Columns, sqlgraph.NewFieldSpec(costanomaly.FieldID, field.TypeString))
+	id, ok := cauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "CostAnomaly.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, costanomaly.FieldID)
+		for _, f := range fields {
+			if !costanomaly.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != costanomaly.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cauo.mutation.UpdateTime(); ok {
+		_spec.SetField(costanomaly.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := cauo.mutation.GetType(); ok {
+		_spec.SetField(costanomaly.FieldType, field.TypeString, value)
+	}
+	if value, ok := cauo.mutation.Cost(); ok {
+		_spec.SetField(costanomaly.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.AddedCost(); ok {
+		_spec.AddField(costanomaly.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.Baseline(); ok {
+		_spec.SetField(costanomaly.FieldBaseline, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.AddedBaseline(); ok {
+		_spec.AddField(costanomaly.FieldBaseline, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.DeviationPercent(); ok {
+		_spec.SetField(costanomaly.FieldDeviationPercent, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.AddedDeviationPercent(); ok {
+		_spec.AddField(costanomaly.FieldDeviationPercent, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.Score(); ok {
+		_spec.SetField(costanomaly.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := cauo.mutation.AddedScore(); ok {
+		_spec.AddField(costanomaly.FieldScore, field.TypeFloat64, value)
+	}
+	_spec.Node.Schema = cauo.schemaConfig.CostAnomaly
+	ctx = internal.NewSchemaConfigContext(ctx, cauo.schemaConfig)
+	_spec.AddModifiers(cauo.modifiers...)
+	_node = &CostAnomaly{config: cauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{costanomaly.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,827 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// CostAnomalyCreateInput holds the creation input of the CostAnomaly entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type CostAnomalyCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Deviation of the cost from the baseline in standard deviations.
+	Score float64 `path:"-" query:"-" json:"score"`
+	// Deviation of the cost from the baseline in percentage.
+	DeviationPercent float64 `path:"-" query:"-" json:"deviationPercent"`
+	// Average daily cost of the baseline days.
+	Baseline float64 `path:"-" query:"-" json:"baseline"`
+	// Cost of the day.
+	Cost float64 `path:"-" query:"-" json:"cost"`
+	// Type of the anomaly, spike or drop.
+	Type string `path:"-" query:"-" json:"type,cli-table-column"`
+	// Start time of the anomalous day.
+	Day time.Time `path:"-" query:"-" json:"day,cli-table-column"`
+	// Name of the project or namespace.
+	Name string `path:"-" query:"-" json:"name,cli-table-column"`
+	// Scope of the anomaly, project or namespace.
+	Scope string `path:"-" query:"-" json:"scope,cli-table-column"`
+}
+
+// Model returns the CostAnomaly entity for creating,
+// after validating.
+func (caci *CostAnomalyCreateInput) Model() *CostAnomaly {
+	if caci == nil {
+		return nil
+	}
+
+	_ca := &CostAnomaly{
+		Score:            caci.Score,
+		DeviationPercent: caci.DeviationPercent,
+		Baseline:         caci.Baseline,
+		Cost:             caci.Cost,
+		Type:             caci.Type,
+		Day:              caci.Day,
+		Name:             caci.Name,
+		Scope:            caci.Scope,
+	}
+
+	return _ca
+}
+
+// Validate checks the CostAnomalyCreateInput entity.
+func (caci *CostAnomalyCreateInput) Validate() error {
+	if caci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return caci.ValidateWith(caci.inputConfig.Context, caci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the CostAnomalyCreateInput entity with the given context and client set.
+func (caci *CostAnomalyCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if caci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// CostAnomalyCreateInputs holds the creation input item of the CostAnomaly entities.
+type CostAnomalyCreateInputsItem struct {
+	// Deviation of the cost from the baseline in standard deviations.
+	Score float64 `path:"-" query:"-" json:"score"`
+	// Deviation of the cost from the baseline in percentage.
+	DeviationPercent float64 `path:"-" query:"-" json:"deviationPercent"`
+	// Average daily cost of the baseline days.
+	Baseline float64 `path:"-" query:"-" json:"baseline"`
+	// Cost of the day.
+	Cost float64 `path:"-" query:"-" json:"cost"`
+	// Type of the anomaly, spike or drop.
+	Type string `path:"-" query:"-" json:"type,cli-table-column"`
+	// Start time of the anomalous day.
+	Day time.Time `path:"-" query:"-" json:"day,cli-table-column"`
+	// Name of the project or namespace.
+	Name string `path:"-" query:"-" json:"name,cli-table-column"`
+	// Scope of the anomaly, project or namespace.
+	Scope string `path:"-" query:"-" json:"scope,cli-table-column"`
+}
+
+// ValidateWith checks the CostAnomalyCreateInputsItem entity with the given context and client set.
+func (caci *CostAnomalyCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if caci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// CostAnomalyCreateInputs holds the creation input of the CostAnomaly entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type CostAnomalyCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*CostAnomalyCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the CostAnomaly entities for creating,
+// after validating.
+func (caci *CostAnomalyCreateInputs) Model() []*CostAnomaly {
+	if caci == nil || len(caci.Items) == 0 {
+		return nil
+	}
+
+	_cas := make([]*CostAnomaly, len(caci.Items))
+
+	for i := range caci.Items {
+		_ca := &CostAnomaly{
+			Score:            caci.Items[i].Score,
+			DeviationPercent: caci.Items[i].DeviationPercent,
+			Baseline:         caci.Items[i].Baseline,
+			Cost:             caci.Items[i].Cost,
+			Type:             caci.Items[i].Type,
+			Day:              caci.Items[i].Day,
+			Name:             caci.Items[i].Name,
+			Scope:            caci.Items[i].Scope,
+		}
+
+		_cas[i] = _ca
+	}
+
+	return _cas
+}
+
+// Validate checks the CostAnomalyCreateInputs entity .
+func (caci *CostAnomalyCreateInputs) Validate() error {
+	if caci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return caci.ValidateWith(caci.inputConfig.Context, caci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the CostAnomalyCreateInputs entity with the given context and client set.
+func (caci *CostAnomalyCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if caci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(caci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	for i := range caci.Items {
+		if caci.Items[i] == nil {
+			continue
+		}
+
+		if err := caci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CostAnomalyDeleteInput holds the deletion input of the CostAnomaly entity,
+// please tags with `path:",inline"` if embedding.
+type CostAnomalyDeleteInput struct {
+	CostAnomalyQueryInput `path:",inline"`
+}
+
+// CostAnomalyDeleteInputs holds the deletion input item of the CostAnomaly entities.
+type CostAnomalyDeleteInputsItem struct {
+	// ID of the CostAnomaly entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// CostAnomalyDeleteInputs holds the deletion input of the CostAnomaly entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type CostAnomalyDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*CostAnomalyDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the CostAnomaly entities for deleting,
+// after validating.
+func (cadi *CostAnomalyDeleteInputs) Model() []*CostAnomaly {
+	if cadi == nil || len(cadi.Items) == 0 {
+		return nil
+	}
+
+	_cas := make([]*CostAnomaly, len(cadi.Items))
+	for i := range cadi.Items {
+		_cas[i] = &CostAnomaly{
+			ID: cadi.Items[i].ID,
+		}
+	}
+	return _cas
+}
+
+// IDs returns the ID list of the CostAnomaly entities for deleting,
+// after validating.
+func (cadi *CostAnomalyDeleteInputs) IDs() []object.ID {
+	if cadi == nil || len(cadi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(cadi.Items))
+	for i := range cadi.Items {
+		ids[i] = cadi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the CostAnomalyDeleteInputs entity.
+func (cadi *CostAnomalyDeleteInputs) Validate() error {
+	if cadi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return cadi.ValidateWith(cadi.inputConfig.Context, cadi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the CostAnomalyDeleteInputs entity with the given context and client set.
+func (cadi *CostAnomalyDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cadi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(cadi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.CostAnomalies().Query()
+
+	ids := make([]object.ID, 0, len(cadi.Items))
+
+	for i := range cadi.Items {
+		if cadi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if cadi.Items[i].ID != "" {
+			ids = append(ids, cadi.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(costanomaly.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	return nil
+}
+
+// CostAnomalyPatchInput holds the patch input of the CostAnomaly entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type CostAnomalyPatchInput struct {
+	CostAnomalyQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// Scope of the anomaly, project or namespace.
+	Scope string `path:"-" query:"-" json:"scope,omitempty"`
+	// Name of the project or namespace.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+	// String generated from the scope, connector and name, used to identify the anomaly of a day.
+	Fingerprint string `path:"-" query:"-" json:"fingerprint,omitempty"`
+	// Start time of the anomalous day.
+	Day time.Time `path:"-" query:"-" json:"day,omitempty"`
+	// Type of the anomaly, spike or drop.
+	Type string `path:"-" query:"-" json:"type,omitempty"`
+	// Cost of the day.
+	Cost float64 `path:"-" query:"-" json:"cost,omitempty"`
+	// Average daily cost of the baseline days.
+	Baseline float64 `path:"-" query:"-" json:"baseline,omitempty"`
+	// Deviation of the cost from the baseline in percentage.
+	DeviationPercent float64 `path:"-" query:"-" json:"deviationPercent,omitempty"`
+	// Deviation of the cost from the baseline in standard deviations.
+	Score float64 `path:"-" query:"-" json:"score,omitempty"`
+
+	patchedEntity *CostAnomaly `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the CostAnomaly partition entity for patching.
+func (capi *CostAnomalyPatchInput) PatchModel() *CostAnomaly {
+	if capi == nil {
+		return nil
+	}
+
+	_ca := &CostAnomaly{
+		CreateTime:       capi.CreateTime,
+		UpdateTime:       capi.UpdateTime,
+		Scope:            capi.Scope,
+		Name:             capi.Name,
+		Fingerprint:      capi.Fingerprint,
+		Day:              capi.Day,
+		Type:             capi.Type,
+		Cost:             capi.Cost,
+		Baseline:         capi.Baseline,
+		DeviationPercent: capi.DeviationPercent,
+		Score:            capi.Score,
+	}
+
+	return _ca
+}
+
+// Model returns the CostAnomaly patched entity,
+// after validating.
+func (capi *CostAnomalyPatchInput) Model() *CostAnomaly {
+	if capi == nil {
+		return nil
+	}
+
+	return capi.patchedEntity
+}
+
+// Validate checks the CostAnomalyPatchInput entity.
+func (capi *CostAnomalyPatchInput) Validate() error {
+	if capi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return capi.ValidateWith(capi.inputConfig.Context, capi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the CostAnomalyPatchInput entity with the given context and client set.
+func (capi *CostAnomalyPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := capi.CostAnomalyQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.CostAnomalies().Query()
+
+	if capi.Refer != nil {
+		if capi.Refer.IsID() {
+			q.Where(
+				costanomaly.ID(capi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of costanomaly")
+		}
+	} else if capi.ID != "" {
+		q.Where(
+			costanomaly.ID(capi.ID))
+	} else {
+		return errors.New("invalid identify of costanomaly")
+	}
+
+	q.Select(
+		costanomaly.WithoutFields(
+			costanomaly.FieldCreateTime,
+			costanomaly.FieldUpdateTime,
+			costanomaly.FieldFingerprint,
+		)...,
+	)
+
+	var e *CostAnomaly
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*CostAnomaly)
+		}
+	}
+
+	_pm := capi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*CostAnomaly)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.Scope != _obj.Scope {
+		return errors.New("field scope is immutable")
+	}
+	if e.Name != _obj.Name {
+		return errors.New("field name is immutable")
+	}
+	if e.Fingerprint != _obj.Fingerprint {
+		return errors.New("field fingerprint is immutable")
+	}
+	if !e.Day.Equal(_obj.Day) {
+		return errors.New("field day is immutable")
+	}
+
+	capi.patchedEntity = _obj
+	return nil
+}
+
+// CostAnomalyQueryInput holds the query input of the CostAnomaly entity,
+// please tags with `path:",inline"` if embedding.
+type CostAnomalyQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Refer holds the route path reference of the CostAnomaly entity.
+	Refer *object.Refer `path:"costanomaly,default=" query:"-" json:"-"`
+	// ID of the CostAnomaly entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// Model returns the CostAnomaly entity for querying,
+// after validating.
+func (caqi *CostAnomalyQueryInput) Model() *CostAnomaly {
+	if caqi == nil {
+		return nil
+	}
+
+	return &CostAnomaly{
+		ID: caqi.ID,
+	}
+}
+
+// Validate checks the CostAnomalyQueryInput entity.
+func (caqi *CostAnomalyQueryInput) Validate() error {
+	if caqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return caqi.ValidateWith(caqi.inputConfig.Context, caqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the CostAnomalyQueryInput entity with the given context and client set.
+func (caqi *CostAnomalyQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if caqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if caqi.Refer != nil && *caqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", costanomaly.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.CostAnomalies().Query()
+
+	if caqi.Refer != nil {
+		if caqi.Refer.IsID() {
+			q.Where(
+				costanomaly.ID(caqi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of costanomaly")
+		}
+	} else if caqi.ID != "" {
+		q.Where(
+			costanomaly.ID(caqi.ID))
+	} else {
+		return errors.New("invalid identify of costanomaly")
+	}
+
+	q.Select(
+		costanomaly.FieldID,
+	)
+
+	var e *CostAnomaly
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*CostAnomaly)
+		}
+	}
+
+	caqi.ID = e.ID
+	return nil
+}
+
+// CostAnomalyQueryInputs holds the query input of the CostAnomaly entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type CostAnomalyQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Validate checks the CostAnomalyQueryInputs entity.
+func (caqi *CostAnomalyQueryInputs) Validate() error {
+	if caqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return caqi.ValidateWith(caqi.inputConfig.Context, caqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the CostAnomalyQueryInputs entity with the given context and client set.
+func (caqi *CostAnomalyQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if caqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// CostAnomalyUpdateInput holds the modification input of the CostAnomaly entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type CostAnomalyUpdateInput struct {
+	CostAnomalyQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Type of the anomaly, spike or drop.
+	Type string `path:"-" query:"-" json:"type,omitempty"`
+	// Cost of the day.
+	Cost float64 `path:"-" query:"-" json:"cost,omitempty"`
+	// Average daily cost of the baseline days.
+	Baseline float64 `path:"-" query:"-" json:"baseline,omitempty"`
+	// Deviation of the cost from the baseline in percentage.
+	DeviationPercent float64 `path:"-" query:"-" json:"deviationPercent,omitempty"`
+	// Deviation of the cost from the baseline in standard deviations.
+	Score float64 `path:"-" query:"-" json:"score,omitempty"`
+}
+
+// Model returns the CostAnomaly entity for modifying,
+// after validating.
+func (caui *CostAnomalyUpdateInput) Model() *CostAnomaly {
+	if caui == nil {
+		return nil
+	}
+
+	_ca := &CostAnomaly{
+		ID:               caui.ID,
+		Type:             caui.Type,
+		Cost:             caui.Cost,
+		Baseline:         caui.Baseline,
+		DeviationPercent: caui.DeviationPercent,
+		Score:            caui.Score,
+	}
+
+	return _ca
+}
+
+// Validate checks the CostAnomalyUpdateInput entity.
+func (caui *CostAnomalyUpdateInput) Validate() error {
+	if caui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return caui.ValidateWith(caui.inputConfig.Context, caui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the CostAnomalyUpdateInput entity with the given context and client set.
+func (caui *CostAnomalyUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := caui.CostAnomalyQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CostAnomalyUpdateInputs holds the modification input item of the CostAnomaly entities.
+type CostAnomalyUpdateInputsItem struct {
+	// ID of the CostAnomaly entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+
+	// Type of the anomaly, spike or drop.
+	Type string `path:"-" query:"-" json:"type,cli-table-column"`
+	// Cost of the day.
+	Cost float64 `path:"-" query:"-" json:"cost"`
+	// Average daily cost of the baseline days.
+	Baseline float64 `path:"-" query:"-" json:"baseline"`
+	// Deviation of the cost from the baseline in percentage.
+	DeviationPercent float64 `path:"-" query:"-" json:"deviationPercent"`
+	// Deviation of the cost from the baseline in standard deviations.
+	Score float64 `path:"-" query:"-" json:"score"`
+}
+
+// ValidateWith checks the CostAnomalyUpdateInputsItem entity with the given context and client set.
+func (caui *CostAnomalyUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if caui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// CostAnomalyUpdateInputs holds the modification input of the CostAnomaly entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type CostAnomalyUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*CostAnomalyUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the CostAnomaly entities for modifying,
+// after validating.
+func (caui *CostAnomalyUpdateInputs) Model() []*CostAnomaly {
+	if caui == nil || len(caui.Items) == 0 {
+		return nil
+	}
+
+	_cas := make([]*CostAnomaly, len(caui.Items))
+
+	for i := range caui.Items {
+		_ca := &CostAnomaly{
+			ID:               caui.Items[i].ID,
+			Type:             caui.Items[i].Type,
+			Cost:             caui.Items[i].Cost,
+			Baseline:         caui.Items[i].Baseline,
+			DeviationPercent: caui.Items[i].DeviationPercent,
+			Score:            caui.Items[i].Score,
+		}
+
+		_cas[i] = _ca
+	}
+
+	return _cas
+}
+
+// IDs returns the ID list of the CostAnomaly entities for modifying,
+// after validating.
+func (caui *CostAnomalyUpdateInputs) IDs() []object.ID {
+	if caui == nil || len(caui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(caui.Items))
+	for i := range caui.Items {
+		ids[i] = caui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the CostAnomalyUpdateInputs entity.
+func (caui *CostAnomalyUpdateInputs) Validate() error {
+	if caui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return caui.ValidateWith(caui.inputConfig.Context, caui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the CostAnomalyUpdateInputs entity with the given context and client set.
+func (caui *CostAnomalyUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if caui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(caui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.CostAnomalies().Query()
+
+	ids := make([]object.ID, 0, len(caui.Items))
+
+	for i := range caui.Items {
+		if caui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if caui.Items[i].ID != "" {
+			ids = append(ids, caui.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(costanomaly.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range caui.Items {
+		if err := caui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CostAnomalyOutput holds the output of the CostAnomaly entity.
+type CostAnomalyOutput struct {
+	ID               object.ID  `json:"id,omitempty"`
+	CreateTime       *time.Time `json:"createTime,omitempty"`
+	UpdateTime       *time.Time `json:"updateTime,omitempty"`
+	Scope            string     `json:"scope,cli-table-column,omitempty"`
+	Name             string     `json:"name,cli-table-column,omitempty"`
+	Day              time.Time  `json:"day,cli-table-column,omitempty"`
+	Type             string     `json:"type,cli-table-column,omitempty"`
+	Cost             float64    `json:"cost,omitempty"`
+	Baseline         float64    `json:"baseline,omitempty"`
+	DeviationPercent float64    `json:"deviationPercent,omitempty"`
+	Score            float64    `json:"score,omitempty"`
+}
+
+// View returns the output of CostAnomaly entity.
+func (_ca *CostAnomaly) View() *CostAnomalyOutput {
+	return ExposeCostAnomaly(_ca)
+}
+
+// View returns the output of CostAnomaly entities.
+func (_cas CostAnomalies) View() []*CostAnomalyOutput {
+	return ExposeCostAnomalies(_cas)
+}
+
+// ExposeCostAnomaly converts the CostAnomaly to CostAnomalyOutput.
+func ExposeCostAnomaly(_ca *CostAnomaly) *CostAnomalyOutput {
+	if _ca == nil {
+		return nil
+	}
+
+	cao := &CostAnomalyOutput{
+		ID:               _ca.ID,
+		CreateTime:       _ca.CreateTime,
+		UpdateTime:       _ca.UpdateTime,
+		Scope:            _ca.Scope,
+		Name:             _ca.Name,
+		Day:              _ca.Day,
+		Type:             _ca.Type,
+		Cost:             _ca.Cost,
+		Baseline:         _ca.Baseline,
+		DeviationPercent: _ca.DeviationPercent,
+		Score:            _ca.Score,
+	}
+
+	return cao
+}
+
+// ExposeCostAnomalies converts the CostAnomaly slice to CostAnomalyOutput pointer slice.
+func ExposeCostAnomalies(_cas []*CostAnomaly) []*CostAnomalyOutput {
+	if len(_cas) == 0 {
+		return nil
+	}
+
+	caos := make([]*CostAnomalyOutput, len(_cas))
+	for i := range _cas {
+		caos[i] = ExposeCostAnomaly(_cas[i])
+	}
+	return caos
+}
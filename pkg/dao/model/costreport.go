@@ -33,7 +33,7 @@ type CostReport struct {
 	Minutes float64 `json:"minutes,omitempty"`
 	// ID of the connector.
 	ConnectorID object.ID `json:"connector_id,omitempty"`
-	// Resource name for current cost, could be __unmounted__.
+	// Resource name for current cost, could be __unmounted__ or the cloud resource ID.
 	Name string `json:"name,omitempty"`
 	// String generated from resource properties, used to identify this cost.
 	Fingerprint string `json:"fingerprint,omitempty"`
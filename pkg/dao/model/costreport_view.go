@@ -25,7 +25,7 @@ type CostReportCreateInput struct {
 
 	// String generated from resource properties, used to identify this cost.
 	Fingerprint string `path:"-" query:"-" json:"fingerprint"`
-	// Resource name for current cost, could be __unmounted__.
+	// Resource name for current cost, could be __unmounted__ or the cloud resource ID.
 	Name string `path:"-" query:"-" json:"name"`
 	// Usage minutes from start time to end time.
 	Minutes float64 `path:"-" query:"-" json:"minutes"`
@@ -151,7 +151,7 @@ func (crci *CostReportCreateInput) ValidateWith(ctx context.Context, cs ClientSe
 type CostReportCreateInputsItem struct {
 	// String generated from resource properties, used to identify this cost.
 	Fingerprint string `path:"-" query:"-" json:"fingerprint"`
-	// Resource name for current cost, could be __unmounted__.
+	// Resource name for current cost, could be __unmounted__ or the cloud resource ID.
 	Name string `path:"-" query:"-" json:"name"`
 	// Usage minutes from start time to end time.
 	Minutes float64 `path:"-" query:"-" json:"minutes"`
@@ -433,7 +433,7 @@ type CostReportPatchInput struct {
 	EndTime time.Time `path:"-" query:"-" json:"endTime,omitempty"`
 	// Usage minutes from start time to end time.
 	Minutes float64 `path:"-" query:"-" json:"minutes,omitempty"`
-	// Resource name for current cost, could be __unmounted__.
+	// Resource name for current cost, could be __unmounted__ or the cloud resource ID.
 	Name string `path:"-" query:"-" json:"name,omitempty"`
 	// String generated from resource properties, used to identify this cost.
 	Fingerprint string `path:"-" query:"-" json:"fingerprint,omitempty"`
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// DataEncryptionKey is the model entity for the DataEncryptionKey schema.
+type DataEncryptionKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// Provider of the key encryption key which wraps the key, i.e. local, file.
+	Provider string `json:"provider,omitempty"`
+	// Wrapped key.
+	WrappedKey []byte `json:"-"`
+	// Indicate whether the key is used to encrypt the new data.
+	Active bool `json:"active,omitempty"`
+	// Status of re-encrypting the existing data with the key, i.e. running, succeeded, failed.
+	RotationStatus string `json:"rotation_status,omitempty"`
+	// Total count of the data to re-encrypt.
+	RotationTotal int `json:"rotation_total,omitempty"`
+	// Processed count of the data to re-encrypt.
+	RotationProcessed int `json:"rotation_processed,omitempty"`
+	// Message of re-encrypting, i.e. the error message if failed.
+	RotationMessage string `json:"rotation_message,omitempty"`
+	selectValues    sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataEncryptionKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dataencryptionkey.FieldWrappedKey:
+			values[i] = new([]byte)
+		case dataencryptionkey.FieldID:
+			values[i] = new(object.ID)
+		case dataencryptionkey.FieldActive:
+			values[i] = new(sql.NullBool)
+		case dataencryptionkey.FieldRotationTotal, dataencryptionkey.FieldRotationProcessed:
+			values[i] = new(sql.NullInt64)
+		case dataencryptionkey.FieldProvider, dataencryptionkey.FieldRotationStatus, dataencryptionkey.FieldRotationMessage:
+			values[i] = new(sql.NullString)
+		case dataencryptionkey.FieldCreateTime, dataencryptionkey.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataEncryptionKey fields.
+func (dek *DataEncryptionKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dataencryptionkey.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dek.ID = *value
+			}
+		case dataencryptionkey.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				dek.CreateTime = new(time.Time)
+				*dek.CreateTime = value.Time
+			}
+		case dataencryptionkey.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				dek.UpdateTime = new(time.Time)
+				*dek.UpdateTime = value.Time
+			}
+		case dataencryptionkey.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				dek.Provider = value.String
+			}
+		case dataencryptionkey.FieldWrappedKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field wrapped_key", values[i])
+			} else if value != nil {
+				dek.WrappedKey = *value
+			}
+		case dataencryptionkey.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				dek.Active = value.Bool
+			}
+		case dataencryptionkey.FieldRotationStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_status", values[i])
+			} else if value.Valid {
+				dek.RotationStatus = value.String
+			}
+		case dataencryptionkey.FieldRotationTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_total", values[i])
+			} else if value.Valid {
+				dek.RotationTotal = int(value.Int64)
+			}
+		case dataencryptionkey.FieldRotationProcessed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_processed", values[i])
+			} else if value.Valid {
+				dek.RotationProcessed = int(value.Int64)
+			}
+		case dataencryptionkey.FieldRotationMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_message", values[i])
+			} else if value.Valid {
+				dek.RotationMessage = value.String
+			}
+		default:
+			dek.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DataEncryptionKey.
+// This includes values selected through modifiers, order, etc.
+func (dek *DataEncryptionKey) Value(name string) (ent.Value, error) {
+	return dek.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DataEncryptionKey.
+// Note that you need to call DataEncryptionKey.Unwrap() before calling this method if this DataEncryptionKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dek *DataEncryptionKey) Update() *DataEncryptionKeyUpdateOne {
+	return NewDataEncryptionKeyClient(dek.config).UpdateOne(dek)
+}
+
+// Unwrap unwraps the DataEncryptionKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dek *DataEncryptionKey) Unwrap() *DataEncryptionKey {
+	_tx, ok := dek.config.driver.(*txDriver)
+	if !ok {
+		panic("model: DataEncryptionKey is not a transactional entity")
+	}
+	dek.config.driver = _tx.drv
+	return dek
+}
+
+// String implements the fmt.Stringer.
+func (dek *DataEncryptionKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataEncryptionKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dek.ID))
+	if v := dek.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := dek.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(dek.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("wrapped_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", dek.Active))
+	builder.WriteString(", ")
+	builder.WriteString("rotation_status=")
+	builder.WriteString(dek.RotationStatus)
+	builder.WriteString(", ")
+	builder.WriteString("rotation_total=")
+	builder.WriteString(fmt.Sprintf("%v", dek.RotationTotal))
+	builder.WriteString(", ")
+	builder.WriteString("rotation_processed=")
+	builder.WriteString(fmt.Sprintf("%v", dek.RotationProcessed))
+	builder.WriteString(", ")
+	builder.WriteString("rotation_message=")
+	builder.WriteString(dek.RotationMessage)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataEncryptionKeys is a parsable slice of DataEncryptionKey.
+type DataEncryptionKeys []*DataEncryptionKey
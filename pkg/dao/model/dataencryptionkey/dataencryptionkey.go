@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package dataencryptionkey
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// Label holds the string label denoting the dataencryptionkey type in the database.
+	Label = "data_encryption_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldWrappedKey holds the string denoting the wrapped_key field in the database.
+	FieldWrappedKey = "wrapped_key"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldRotationStatus holds the string denoting the rotation_status field in the database.
+	FieldRotationStatus = "rotation_status"
+	// FieldRotationTotal holds the string denoting the rotation_total field in the database.
+	FieldRotationTotal = "rotation_total"
+	// FieldRotationProcessed holds the string denoting the rotation_processed field in the database.
+	FieldRotationProcessed = "rotation_processed"
+	// FieldRotationMessage holds the string denoting the rotation_message field in the database.
+	FieldRotationMessage = "rotation_message"
+	// Table holds the table name of the dataencryptionkey in the database.
+	Table = "data_encryption_keys"
+)
+
+// Columns holds all SQL columns for dataencryptionkey fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldProvider,
+	FieldWrappedKey,
+	FieldActive,
+	FieldRotationStatus,
+	FieldRotationTotal,
+	FieldRotationProcessed,
+	FieldRotationMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// WrappedKeyValidator is a validator for the "wrapped_key" field. It is called by the builders before save.
+	WrappedKeyValidator func([]byte) error
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultRotationTotal holds the default value on creation for the "rotation_total" field.
+	DefaultRotationTotal int
+	// DefaultRotationProcessed holds the default value on creation for the "rotation_processed" field.
+	DefaultRotationProcessed int
+)
+
+// OrderOption defines the ordering options for the DataEncryptionKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
+// ByRotationStatus orders the results by the rotation_status field.
+func ByRotationStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotationStatus, opts...).ToFunc()
+}
+
+// ByRotationTotal orders the results by the rotation_total field.
+func ByRotationTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotationTotal, opts...).ToFunc()
+}
+
+// ByRotationProcessed orders the results by the rotation_processed field.
+func ByRotationProcessed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotationProcessed, opts...).ToFunc()
+}
+
+// ByRotationMessage orders the results by the rotation_message field.
+func ByRotationMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotationMessage, opts...).ToFunc()
+}
+
+// WithoutFields returns the fields ignored the given list.
+func WithoutFields(ignores ...string) []string {
+	if len(ignores) == 0 {
+		return slices.Clone(Columns)
+	}
+
+	var s = make(map[string]bool, len(ignores))
+	for i := range ignores {
+		s[ignores[i]] = true
+	}
+
+	var r = make([]string, 0, len(Columns)-len(s))
+	for i := range Columns {
+		if s[Columns[i]] {
+			continue
+		}
+		r = append(r, Columns[i])
+	}
+	return r
+}
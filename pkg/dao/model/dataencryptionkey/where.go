@@ -0,0 +1,545 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package dataencryptionkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldProvider, v))
+}
+
+// WrappedKey applies equality check predicate on the "wrapped_key" field. It's identical to WrappedKeyEQ.
+func WrappedKey(v []byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldWrappedKey, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldActive, v))
+}
+
+// RotationStatus applies equality check predicate on the "rotation_status" field. It's identical to RotationStatusEQ.
+func RotationStatus(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldRotationStatus, v))
+}
+
+// RotationTotal applies equality check predicate on the "rotation_total" field. It's identical to RotationTotalEQ.
+func RotationTotal(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldRotationTotal, v))
+}
+
+// RotationProcessed applies equality check predicate on the "rotation_processed" field. It's identical to RotationProcessedEQ.
+func RotationProcessed(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldRotationProcessed, v))
+}
+
+// RotationMessage applies equality check predicate on the "rotation_message" field. It's identical to RotationMessageEQ.
+func RotationMessage(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldRotationMessage, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// WrappedKeyEQ applies the EQ predicate on the "wrapped_key" field.
+func WrappedKeyEQ(v []byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldWrappedKey, v))
+}
+
+// WrappedKeyNEQ applies the NEQ predicate on the "wrapped_key" field.
+func WrappedKeyNEQ(v []byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldWrappedKey, v))
+}
+
+// WrappedKeyIn applies the In predicate on the "wrapped_key" field.
+func WrappedKeyIn(vs ...[]byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldWrappedKey, vs...))
+}
+
+// WrappedKeyNotIn applies the NotIn predicate on the "wrapped_key" field.
+func WrappedKeyNotIn(vs ...[]byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldWrappedKey, vs...))
+}
+
+// WrappedKeyGT applies the GT predicate on the "wrapped_key" field.
+func WrappedKeyGT(v []byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldWrappedKey, v))
+}
+
+// WrappedKeyGTE applies the GTE predicate on the "wrapped_key" field.
+func WrappedKeyGTE(v []byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldWrappedKey, v))
+}
+
+// WrappedKeyLT applies the LT predicate on the "wrapped_key" field.
+func WrappedKeyLT(v []byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldWrappedKey, v))
+}
+
+// WrappedKeyLTE applies the LTE predicate on the "wrapped_key" field.
+func WrappedKeyLTE(v []byte) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldWrappedKey, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldActive, v))
+}
+
+// RotationStatusEQ applies the EQ predicate on the "rotation_status" field.
+func RotationStatusEQ(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldRotationStatus, v))
+}
+
+// RotationStatusNEQ applies the NEQ predicate on the "rotation_status" field.
+func RotationStatusNEQ(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldRotationStatus, v))
+}
+
+// RotationStatusIn applies the In predicate on the "rotation_status" field.
+func RotationStatusIn(vs ...string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldRotationStatus, vs...))
+}
+
+// RotationStatusNotIn applies the NotIn predicate on the "rotation_status" field.
+func RotationStatusNotIn(vs ...string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldRotationStatus, vs...))
+}
+
+// RotationStatusGT applies the GT predicate on the "rotation_status" field.
+func RotationStatusGT(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldRotationStatus, v))
+}
+
+// RotationStatusGTE applies the GTE predicate on the "rotation_status" field.
+func RotationStatusGTE(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldRotationStatus, v))
+}
+
+// RotationStatusLT applies the LT predicate on the "rotation_status" field.
+func RotationStatusLT(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldRotationStatus, v))
+}
+
+// RotationStatusLTE applies the LTE predicate on the "rotation_status" field.
+func RotationStatusLTE(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldRotationStatus, v))
+}
+
+// RotationStatusContains applies the Contains predicate on the "rotation_status" field.
+func RotationStatusContains(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldContains(FieldRotationStatus, v))
+}
+
+// RotationStatusHasPrefix applies the HasPrefix predicate on the "rotation_status" field.
+func RotationStatusHasPrefix(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldHasPrefix(FieldRotationStatus, v))
+}
+
+// RotationStatusHasSuffix applies the HasSuffix predicate on the "rotation_status" field.
+func RotationStatusHasSuffix(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldHasSuffix(FieldRotationStatus, v))
+}
+
+// RotationStatusIsNil applies the IsNil predicate on the "rotation_status" field.
+func RotationStatusIsNil() predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIsNull(FieldRotationStatus))
+}
+
+// RotationStatusNotNil applies the NotNil predicate on the "rotation_status" field.
+func RotationStatusNotNil() predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotNull(FieldRotationStatus))
+}
+
+// RotationStatusEqualFold applies the EqualFold predicate on the "rotation_status" field.
+func RotationStatusEqualFold(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEqualFold(FieldRotationStatus, v))
+}
+
+// RotationStatusContainsFold applies the ContainsFold predicate on the "rotation_status" field.
+func RotationStatusContainsFold(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldContainsFold(FieldRotationStatus, v))
+}
+
+// RotationTotalEQ applies the EQ predicate on the "rotation_total" field.
+func RotationTotalEQ(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldRotationTotal, v))
+}
+
+// RotationTotalNEQ applies the NEQ predicate on the "rotation_total" field.
+func RotationTotalNEQ(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldRotationTotal, v))
+}
+
+// RotationTotalIn applies the In predicate on the "rotation_total" field.
+func RotationTotalIn(vs ...int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldRotationTotal, vs...))
+}
+
+// RotationTotalNotIn applies the NotIn predicate on the "rotation_total" field.
+func RotationTotalNotIn(vs ...int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldRotationTotal, vs...))
+}
+
+// RotationTotalGT applies the GT predicate on the "rotation_total" field.
+func RotationTotalGT(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldRotationTotal, v))
+}
+
+// RotationTotalGTE applies the GTE predicate on the "rotation_total" field.
+func RotationTotalGTE(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldRotationTotal, v))
+}
+
+// RotationTotalLT applies the LT predicate on the "rotation_total" field.
+func RotationTotalLT(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldRotationTotal, v))
+}
+
+// RotationTotalLTE applies the LTE predicate on the "rotation_total" field.
+func RotationTotalLTE(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldRotationTotal, v))
+}
+
+// RotationProcessedEQ applies the EQ predicate on the "rotation_processed" field.
+func RotationProcessedEQ(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldRotationProcessed, v))
+}
+
+// RotationProcessedNEQ applies the NEQ predicate on the "rotation_processed" field.
+func RotationProcessedNEQ(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldRotationProcessed, v))
+}
+
+// RotationProcessedIn applies the In predicate on the "rotation_processed" field.
+func RotationProcessedIn(vs ...int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldRotationProcessed, vs...))
+}
+
+// RotationProcessedNotIn applies the NotIn predicate on the "rotation_processed" field.
+func RotationProcessedNotIn(vs ...int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldRotationProcessed, vs...))
+}
+
+// RotationProcessedGT applies the GT predicate on the "rotation_processed" field.
+func RotationProcessedGT(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldRotationProcessed, v))
+}
+
+// RotationProcessedGTE applies the GTE predicate on the "rotation_processed" field.
+func RotationProcessedGTE(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldRotationProcessed, v))
+}
+
+// RotationProcessedLT applies the LT predicate on the "rotation_processed" field.
+func RotationProcessedLT(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldRotationProcessed, v))
+}
+
+// RotationProcessedLTE applies the LTE predicate on the "rotation_processed" field.
+func RotationProcessedLTE(v int) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldRotationProcessed, v))
+}
+
+// RotationMessageEQ applies the EQ predicate on the "rotation_message" field.
+func RotationMessageEQ(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEQ(FieldRotationMessage, v))
+}
+
+// RotationMessageNEQ applies the NEQ predicate on the "rotation_message" field.
+func RotationMessageNEQ(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNEQ(FieldRotationMessage, v))
+}
+
+// RotationMessageIn applies the In predicate on the "rotation_message" field.
+func RotationMessageIn(vs ...string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIn(FieldRotationMessage, vs...))
+}
+
+// RotationMessageNotIn applies the NotIn predicate on the "rotation_message" field.
+func RotationMessageNotIn(vs ...string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotIn(FieldRotationMessage, vs...))
+}
+
+// RotationMessageGT applies the GT predicate on the "rotation_message" field.
+func RotationMessageGT(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGT(FieldRotationMessage, v))
+}
+
+// RotationMessageGTE applies the GTE predicate on the "rotation_message" field.
+func RotationMessageGTE(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldGTE(FieldRotationMessage, v))
+}
+
+// RotationMessageLT applies the LT predicate on the "rotation_message" field.
+func RotationMessageLT(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLT(FieldRotationMessage, v))
+}
+
+// RotationMessageLTE applies the LTE predicate on the "rotation_message" field.
+func RotationMessageLTE(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldLTE(FieldRotationMessage, v))
+}
+
+// RotationMessageContains applies the Contains predicate on the "rotation_message" field.
+func RotationMessageContains(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldContains(FieldRotationMessage, v))
+}
+
+// RotationMessageHasPrefix applies the HasPrefix predicate on the "rotation_message" field.
+func RotationMessageHasPrefix(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldHasPrefix(FieldRotationMessage, v))
+}
+
+// RotationMessageHasSuffix applies the HasSuffix predicate on the "rotation_message" field.
+func RotationMessageHasSuffix(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldHasSuffix(FieldRotationMessage, v))
+}
+
+// RotationMessageIsNil applies the IsNil predicate on the "rotation_message" field.
+func RotationMessageIsNil() predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldIsNull(FieldRotationMessage))
+}
+
+// RotationMessageNotNil applies the NotNil predicate on the "rotation_message" field.
+func RotationMessageNotNil() predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldNotNull(FieldRotationMessage))
+}
+
+// RotationMessageEqualFold applies the EqualFold predicate on the "rotation_message" field.
+func RotationMessageEqualFold(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldEqualFold(FieldRotationMessage, v))
+}
+
+// RotationMessageContainsFold applies the ContainsFold predicate on the "rotation_message" field.
+func RotationMessageContainsFold(v string) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.FieldContainsFold(FieldRotationMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DataEncryptionKey) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DataEncryptionKey) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DataEncryptionKey) predicate.DataEncryptionKey {
+	return predicate.DataEncryptionKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,1270 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// DataEncryptionKeyCreate is the builder for creating a DataEncryptionKey entity.
+type DataEncryptionKeyCreate struct {
+	config
+	mutation   *DataEncryptionKeyMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *DataEncryptionKey
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (dekc *DataEncryptionKeyCreate) SetCreateTime(t time.Time) *DataEncryptionKeyCreate {
+	dekc.mutation.SetCreateTime(t)
+	return dekc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (dekc *DataEncryptionKeyCreate) SetNillableCreateTime(t *time.Time) *DataEncryptionKeyCreate {
+	if t != nil {
+		dekc.SetCreateTime(*t)
+	}
+	return dekc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dekc *DataEncryptionKeyCreate) SetUpdateTime(t time.Time) *DataEncryptionKeyCreate {
+	dekc.mutation.SetUpdateTime(t)
+	return dekc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (dekc *DataEncryptionKeyCreate) SetNillableUpdateTime(t *time.Time) *DataEncryptionKeyCreate {
+	if t != nil {
+		dekc.SetUpdateTime(*t)
+	}
+	return dekc
+}
+
+// SetProvider sets the "provider" field.
+func (dekc *DataEncryptionKeyCreate) SetProvider(s string) *DataEncryptionKeyCreate {
+	dekc.mutation.SetProvider(s)
+	return dekc
+}
+
+// SetWrappedKey sets the "wrapped_key" field.
+func (dekc *DataEncryptionKeyCreate) SetWrappedKey(b []byte) *DataEncryptionKeyCreate {
+	dekc.mutation.SetWrappedKey(b)
+	return dekc
+}
+
+// SetActive sets the "active" field.
+func (dekc *DataEncryptionKeyCreate) SetActive(b bool) *DataEncryptionKeyCreate {
+	dekc.mutation.SetActive(b)
+	return dekc
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (dekc *DataEncryptionKeyCreate) SetNillableActive(b *bool) *DataEncryptionKeyCreate {
+	if b != nil {
+		dekc.SetActive(*b)
+	}
+	return dekc
+}
+
+// SetRotationStatus sets the "rotation_status" field.
+func (dekc *DataEncryptionKeyCreate) SetRotationStatus(s string) *DataEncryptionKeyCreate {
+	dekc.mutation.SetRotationStatus(s)
+	return dekc
+}
+
+// SetNillableRotationStatus sets the "rotation_status" field if the given value is not nil.
+func (dekc *DataEncryptionKeyCreate) SetNillableRotationStatus(s *string) *DataEncryptionKeyCreate {
+	if s != nil {
+		dekc.SetRotationStatus(*s)
+	}
+	return dekc
+}
+
+// SetRotationTotal sets the "rotation_total" field.
+func (dekc *DataEncryptionKeyCreate) SetRotationTotal(i int) *DataEncryptionKeyCreate {
+	dekc.mutation.SetRotationTotal(i)
+	return dekc
+}
+
+// SetNillableRotationTotal sets the "rotation_total" field if the given value is not nil.
+func (dekc *DataEncryptionKeyCreate) SetNillableRotationTotal(i *int) *DataEncryptionKeyCreate {
+	if i != nil {
+		dekc.SetRotationTotal(*i)
+	}
+	return dekc
+}
+
+// SetRotationProcessed sets the "rotation_processed" field.
+func (dekc *DataEncryptionKeyCreate) SetRotationProcessed(i int) *DataEncryptionKeyCreate {
+	dekc.mutation.SetRotationProcessed(i)
+	return dekc
+}
+
+// SetNillableRotationProcessed sets the "rotation_processed" field if the given value is not nil.
+func (dekc *DataEncryptionKeyCreate) SetNillableRotationProcessed(i *int) *DataEncryptionKeyCreate {
+	if i != nil {
+		dekc.SetRotationProcessed(*i)
+	}
+	return dekc
+}
+
+// SetRotationMessage sets the "rotation_message" field.
+func (dekc *DataEncryptionKeyCreate) SetRotationMessage(s string) *DataEncryptionKeyCreate {
+	dekc.mutation.SetRotationMessage(s)
+	return dekc
+}
+
+// SetNillableRotationMessage sets the "rotation_message" field if the given value is not nil.
+func (dekc *DataEncryptionKeyCreate) SetNillableRotationMessage(s *string) *DataEncryptionKeyCreate {
+	if s != nil {
+		dekc.SetRotationMessage(*s)
+	}
+	return dekc
+}
+
+// SetID sets the "id" field.
+func (dekc *DataEncryptionKeyCreate) SetID(o object.ID) *DataEncryptionKeyCreate {
+	dekc.mutation.SetID(o)
+	return dekc
+}
+
+// Mutation returns the DataEncryptionKeyMutation object of the builder.
+func (dekc *DataEncryptionKeyCreate) Mutation() *DataEncryptionKeyMutation {
+	return dekc.mutation
+}
+
+// Save creates the DataEncryptionKey in the database.
+func (dekc *DataEncryptionKeyCreate) Save(ctx context.Context) (*DataEncryptionKey, error) {
+	if err := dekc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, dekc.sqlSave, dekc.mutation, dekc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dekc *DataEncryptionKeyCreate) SaveX(ctx context.Context) *DataEncryptionKey {
+	v, err := dekc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dekc *DataEncryptionKeyCreate) Exec(ctx context.Context) error {
+	_, err := dekc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dekc *DataEncryptionKeyCreate) ExecX(ctx context.Context) {
+	if err := dekc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dekc *DataEncryptionKeyCreate) defaults() error {
+	if _, ok := dekc.mutation.CreateTime(); !ok {
+		if dataencryptionkey.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized dataencryptionkey.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := dataencryptionkey.DefaultCreateTime()
+		dekc.mutation.SetCreateTime(v)
+	}
+	if _, ok := dekc.mutation.UpdateTime(); !ok {
+		if dataencryptionkey.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized dataencryptionkey.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := dataencryptionkey.DefaultUpdateTime()
+		dekc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := dekc.mutation.Active(); !ok {
+		v := dataencryptionkey.DefaultActive
+		dekc.mutation.SetActive(v)
+	}
+	if _, ok := dekc.mutation.RotationTotal(); !ok {
+		v := dataencryptionkey.DefaultRotationTotal
+		dekc.mutation.SetRotationTotal(v)
+	}
+	if _, ok := dekc.mutation.RotationProcessed(); !ok {
+		v := dataencryptionkey.DefaultRotationProcessed
+		dekc.mutation.SetRotationProcessed(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dekc *DataEncryptionKeyCreate) check() error {
+	if _, ok := dekc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "DataEncryptionKey.create_time"`)}
+	}
+	if _, ok := dekc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "DataEncryptionKey.update_time"`)}
+	}
+	if _, ok := dekc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`model: missing required field "DataEncryptionKey.provider"`)}
+	}
+	if v, ok := dekc.mutation.Provider(); ok {
+		if err := dataencryptionkey.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`model: validator failed for field "DataEncryptionKey.provider": %w`, err)}
+		}
+	}
+	if _, ok := dekc.mutation.WrappedKey(); !ok {
+		return &ValidationError{Name: "wrapped_key", err: errors.New(`model: missing required field "DataEncryptionKey.wrapped_key"`)}
+	}
+	if v, ok := dekc.mutation.WrappedKey(); ok {
+		if err := dataencryptionkey.WrappedKeyValidator(v); err != nil {
+			return &ValidationError{Name: "wrapped_key", err: fmt.Errorf(`model: validator failed for field "DataEncryptionKey.wrapped_key": %w`, err)}
+		}
+	}
+	if _, ok := dekc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`model: missing required field "DataEncryptionKey.active"`)}
+	}
+	if _, ok := dekc.mutation.RotationTotal(); !ok {
+		return &ValidationError{Name: "rotation_total", err: errors.New(`model: missing required field "DataEncryptionKey.rotation_total"`)}
+	}
+	if _, ok := dekc.mutation.RotationProcessed(); !ok {
+		return &ValidationError{Name: "rotation_processed", err: errors.New(`model: missing required field "DataEncryptionKey.rotation_processed"`)}
+	}
+	return nil
+}
+
+func (dekc *DataEncryptionKeyCreate) sqlSave(ctx context.Context) (*DataEncryptionKey, error) {
+	if err := dekc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dekc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dekc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dekc.mutation.id = &_node.ID
+	dekc.mutation.done = true
+	return _node, nil
+}
+
+func (dekc *DataEncryptionKeyCreate) createSpec() (*DataEncryptionKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataEncryptionKey{config: dekc.config}
+		_spec = sqlgraph.NewCreateSpec(dataencryptionkey.Table, sqlgraph.NewFieldSpec(dataencryptionkey.FieldID, field.TypeString))
+	)
+	_spec.Schema = dekc.schemaConfig.DataEncryptionKey
+	_spec.OnConflict = dekc.conflict
+	if id, ok := dekc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dekc.mutation.CreateTime(); ok {
+		_spec.SetField(dataencryptionkey.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := dekc.mutation.UpdateTime(); ok {
+		_spec.SetField(dataencryptionkey.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := dekc.mutation.Provider(); ok {
+		_spec.SetField(dataencryptionkey.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := dekc.mutation.WrappedKey(); ok {
+		_spec.SetField(dataencryptionkey.FieldWrappedKey, field.TypeBytes, value)
+		_node.WrappedKey = value
+	}
+	if value, ok := dekc.mutation.Active(); ok {
+		_spec.SetField(dataencryptionkey.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := dekc.mutation.RotationStatus(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationStatus, field.TypeString, value)
+		_node.RotationStatus = value
+	}
+	if value, ok := dekc.mutation.RotationTotal(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationTotal, field.TypeInt, value)
+		_node.RotationTotal = value
+	}
+	if value, ok := dekc.mutation.RotationProcessed(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationProcessed, field.TypeInt, value)
+		_node.RotationProcessed = value
+	}
+	if value, ok := dekc.mutation.RotationMessage(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationMessage, field.TypeString, value)
+		_node.RotationMessage = value
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (dekc *DataEncryptionKeyCreate) Set(obj *DataEncryptionKey) *DataEncryptionKeyCreate {
+	// Required.
+	dekc.SetProvider(obj.Provider)
+	dekc.SetWrappedKey(obj.WrappedKey)
+	dekc.SetActive(obj.Active)
+	dekc.SetRotationTotal(obj.RotationTotal)
+	dekc.SetRotationProcessed(obj.RotationProcessed)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		dekc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		dekc.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.RotationStatus != "" {
+		dekc.SetRotationStatus(obj.RotationStatus)
+	}
+	if obj.RotationMessage != "" {
+		dekc.SetRotationMessage(obj.RotationMessage)
+	}
+
+	// Record the given object.
+	dekc.object = obj
+
+	return dekc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (dekc *DataEncryptionKeyCreate) getClientSet() (mc ClientSet) {
+	if _, ok := dekc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: dekc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: dekc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the DataEncryptionKey entity,
+// which is always good for cascading create operations.
+func (dekc *DataEncryptionKeyCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *DataEncryptionKey) error) (*DataEncryptionKey, error) {
+	obj, err := dekc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := dekc.getClientSet()
+
+	if x := dekc.object; x != nil {
+		if _, set := dekc.mutation.Field(dataencryptionkey.FieldProvider); set {
+			obj.Provider = x.Provider
+		}
+		if _, set := dekc.mutation.Field(dataencryptionkey.FieldWrappedKey); set {
+			obj.WrappedKey = x.WrappedKey
+		}
+		if _, set := dekc.mutation.Field(dataencryptionkey.FieldRotationStatus); set {
+			obj.RotationStatus = x.RotationStatus
+		}
+		if _, set := dekc.mutation.Field(dataencryptionkey.FieldRotationMessage); set {
+			obj.RotationMessage = x.RotationMessage
+		}
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (dekc *DataEncryptionKeyCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *DataEncryptionKey) error) *DataEncryptionKey {
+	obj, err := dekc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (dekc *DataEncryptionKeyCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *DataEncryptionKey) error) error {
+	_, err := dekc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (dekc *DataEncryptionKeyCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *DataEncryptionKey) error) {
+	if err := dekc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the DataEncryptionKeyCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (dekcb *DataEncryptionKeyCreateBulk) Set(objs ...*DataEncryptionKey) *DataEncryptionKeyCreateBulk {
+	if len(objs) != 0 {
+		client := NewDataEncryptionKeyClient(dekcb.config)
+
+		dekcb.builders = make([]*DataEncryptionKeyCreate, len(objs))
+		for i := range objs {
+			dekcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		dekcb.objects = objs
+	}
+
+	return dekcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (dekcb *DataEncryptionKeyCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := dekcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: dekcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: dekcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the DataEncryptionKey entities,
+// which is always good for cascading create operations.
+func (dekcb *DataEncryptionKeyCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *DataEncryptionKey) error) ([]*DataEncryptionKey, error) {
+	objs, err := dekcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := dekcb.getClientSet()
+
+	if x := dekcb.objects; x != nil {
+		for i := range x {
+			if _, set := dekcb.builders[i].mutation.Field(dataencryptionkey.FieldProvider); set {
+				objs[i].Provider = x[i].Provider
+			}
+			if _, set := dekcb.builders[i].mutation.Field(dataencryptionkey.FieldWrappedKey); set {
+				objs[i].WrappedKey = x[i].WrappedKey
+			}
+			if _, set := dekcb.builders[i].mutation.Field(dataencryptionkey.FieldRotationStatus); set {
+				objs[i].RotationStatus = x[i].RotationStatus
+			}
+			if _, set := dekcb.builders[i].mutation.Field(dataencryptionkey.FieldRotationMessage); set {
+				objs[i].RotationMessage = x[i].RotationMessage
+			}
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (dekcb *DataEncryptionKeyCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *DataEncryptionKey) error) []*DataEncryptionKey {
+	objs, err := dekcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (dekcb *DataEncryptionKeyCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *DataEncryptionKey) error) error {
+	_, err := dekcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (dekcb *DataEncryptionKeyCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *DataEncryptionKey) error) {
+	if err := dekcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *DataEncryptionKeyUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *DataEncryptionKey) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for DataEncryptionKeyUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *DataEncryptionKeyUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *DataEncryptionKey) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *DataEncryptionKeyUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *DataEncryptionKey) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the DataEncryptionKeyUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for DataEncryptionKeyUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *DataEncryptionKeyUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *DataEncryptionKey) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataEncryptionKey.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataEncryptionKeyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (dekc *DataEncryptionKeyCreate) OnConflict(opts ...sql.ConflictOption) *DataEncryptionKeyUpsertOne {
+	dekc.conflict = opts
+	return &DataEncryptionKeyUpsertOne{
+		create: dekc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataEncryptionKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dekc *DataEncryptionKeyCreate) OnConflictColumns(columns ...string) *DataEncryptionKeyUpsertOne {
+	dekc.conflict = append(dekc.conflict, sql.ConflictColumns(columns...))
+	return &DataEncryptionKeyUpsertOne{
+		create: dekc,
+	}
+}
+
+type (
+	// DataEncryptionKeyUpsertOne is the builder for "upsert"-ing
+	//  one DataEncryptionKey node.
+	DataEncryptionKeyUpsertOne struct {
+		create *DataEncryptionKeyCreate
+	}
+
+	// DataEncryptionKeyUpsert is the "OnConflict" setter.
+	DataEncryptionKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DataEncryptionKeyUpsert) SetUpdateTime(v time.Time) *DataEncryptionKeyUpsert {
+	u.Set(dataencryptionkey.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsert) UpdateUpdateTime() *DataEncryptionKeyUpsert {
+	u.SetExcluded(dataencryptionkey.FieldUpdateTime)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *DataEncryptionKeyUpsert) SetActive(v bool) *DataEncryptionKeyUpsert {
+	u.Set(dataencryptionkey.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsert) UpdateActive() *DataEncryptionKeyUpsert {
+	u.SetExcluded(dataencryptionkey.FieldActive)
+	return u
+}
+
+// SetRotationStatus sets the "rotation_status" field.
+func (u *DataEncryptionKeyUpsert) SetRotationStatus(v string) *DataEncryptionKeyUpsert {
+	u.Set(dataencryptionkey.FieldRotationStatus, v)
+	return u
+}
+
+// UpdateRotationStatus sets the "rotation_status" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsert) UpdateRotationStatus() *DataEncryptionKeyUpsert {
+	u.SetExcluded(dataencryptionkey.FieldRotationStatus)
+	return u
+}
+
+// ClearRotationStatus clears the value of the "rotation_status" field.
+func (u *DataEncryptionKeyUpsert) ClearRotationStatus() *DataEncryptionKeyUpsert {
+	u.SetNull(dataencryptionkey.FieldRotationStatus)
+	return u
+}
+
+// SetRotationTotal sets the "rotation_total" field.
+func (u *DataEncryptionKeyUpsert) SetRotationTotal(v int) *DataEncryptionKeyUpsert {
+	u.Set(dataencryptionkey.FieldRotationTotal, v)
+	return u
+}
+
+// UpdateRotationTotal sets the "rotation_total" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsert) UpdateRotationTotal() *DataEncryptionKeyUpsert {
+	u.SetExcluded(dataencryptionkey.FieldRotationTotal)
+	return u
+}
+
+// AddRotationTotal adds v to the "rotation_total" field.
+func (u *DataEncryptionKeyUpsert) AddRotationTotal(v int) *DataEncryptionKeyUpsert {
+	u.Add(dataencryptionkey.FieldRotationTotal, v)
+	return u
+}
+
+// SetRotationProcessed sets the "rotation_processed" field.
+func (u *DataEncryptionKeyUpsert) SetRotationProcessed(v int) *DataEncryptionKeyUpsert {
+	u.Set(dataencryptionkey.FieldRotationProcessed, v)
+	return u
+}
+
+// UpdateRotationProcessed sets the "rotation_processed" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsert) UpdateRotationProcessed() *DataEncryptionKeyUpsert {
+	u.SetExcluded(dataencryptionkey.FieldRotationProcessed)
+	return u
+}
+
+// AddRotationProcessed adds v to the "rotation_processed" field.
+func (u *DataEncryptionKeyUpsert) AddRotationProcessed(v int) *DataEncryptionKeyUpsert {
+	u.Add(dataencryptionkey.FieldRotationProcessed, v)
+	return u
+}
+
+// SetRotationMessage sets the "rotation_message" field.
+func (u *DataEncryptionKeyUpsert) SetRotationMessage(v string) *DataEncryptionKeyUpsert {
+	u.Set(dataencryptionkey.FieldRotationMessage, v)
+	return u
+}
+
+// UpdateRotationMessage sets the "rotation_message" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsert) UpdateRotationMessage() *DataEncryptionKeyUpsert {
+	u.SetExcluded(dataencryptionkey.FieldRotationMessage)
+	return u
+}
+
+// ClearRotationMessage clears the value of the "rotation_message" field.
+func (u *DataEncryptionKeyUpsert) ClearRotationMessage() *DataEncryptionKeyUpsert {
+	u.SetNull(dataencryptionkey.FieldRotationMessage)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DataEncryptionKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dataencryptionkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DataEncryptionKeyUpsertOne) UpdateNewValues() *DataEncryptionKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dataencryptionkey.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(dataencryptionkey.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.Provider(); exists {
+			s.SetIgnore(dataencryptionkey.FieldProvider)
+		}
+		if _, exists := u.create.mutation.WrappedKey(); exists {
+			s.SetIgnore(dataencryptionkey.FieldWrappedKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataEncryptionKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DataEncryptionKeyUpsertOne) Ignore() *DataEncryptionKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataEncryptionKeyUpsertOne) DoNothing() *DataEncryptionKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataEncryptionKeyCreate.OnConflict
+// documentation for more info.
+func (u *DataEncryptionKeyUpsertOne) Update(set func(*DataEncryptionKeyUpsert)) *DataEncryptionKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataEncryptionKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DataEncryptionKeyUpsertOne) SetUpdateTime(v time.Time) *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertOne) UpdateUpdateTime() *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *DataEncryptionKeyUpsertOne) SetActive(v bool) *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertOne) UpdateActive() *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetRotationStatus sets the "rotation_status" field.
+func (u *DataEncryptionKeyUpsertOne) SetRotationStatus(v string) *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetRotationStatus(v)
+	})
+}
+
+// UpdateRotationStatus sets the "rotation_status" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertOne) UpdateRotationStatus() *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateRotationStatus()
+	})
+}
+
+// ClearRotationStatus clears the value of the "rotation_status" field.
+func (u *DataEncryptionKeyUpsertOne) ClearRotationStatus() *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.ClearRotationStatus()
+	})
+}
+
+// SetRotationTotal sets the "rotation_total" field.
+func (u *DataEncryptionKeyUpsertOne) SetRotationTotal(v int) *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetRotationTotal(v)
+	})
+}
+
+// AddRotationTotal adds v to the "rotation_total" field.
+func (u *DataEncryptionKeyUpsertOne) AddRotationTotal(v int) *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.AddRotationTotal(v)
+	})
+}
+
+// UpdateRotationTotal sets the "rotation_total" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertOne) UpdateRotationTotal() *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateRotationTotal()
+	})
+}
+
+// SetRotationProcessed sets the "rotation_processed" field.
+func (u *DataEncryptionKeyUpsertOne) SetRotationProcessed(v int) *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetRotationProcessed(v)
+	})
+}
+
+// AddRotationProcessed adds v to the "rotation_processed" field.
+func (u *DataEncryptionKeyUpsertOne) AddRotationProcessed(v int) *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.AddRotationProcessed(v)
+	})
+}
+
+// UpdateRotationProcessed sets the "rotation_processed" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertOne) UpdateRotationProcessed() *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateRotationProcessed()
+	})
+}
+
+// SetRotationMessage sets the "rotation_message" field.
+func (u *DataEncryptionKeyUpsertOne) SetRotationMessage(v string) *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetRotationMessage(v)
+	})
+}
+
+// UpdateRotationMessage sets the "rotation_message" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertOne) UpdateRotationMessage() *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateRotationMessage()
+	})
+}
+
+// ClearRotationMessage clears the value of the "rotation_message" field.
+func (u *DataEncryptionKeyUpsertOne) ClearRotationMessage() *DataEncryptionKeyUpsertOne {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.ClearRotationMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *DataEncryptionKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for DataEncryptionKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataEncryptionKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DataEncryptionKeyUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: DataEncryptionKeyUpsertOne.ID is not supported by MySQL driver. Use DataEncryptionKeyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DataEncryptionKeyUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DataEncryptionKeyCreateBulk is the builder for creating many DataEncryptionKey entities in bulk.
+type DataEncryptionKeyCreateBulk struct {
+	config
+	err        error
+	builders   []*DataEncryptionKeyCreate
+	conflict   []sql.ConflictOption
+	objects    []*DataEncryptionKey
+	fromUpsert bool
+}
+
+// Save creates the DataEncryptionKey entities in the database.
+func (dekcb *DataEncryptionKeyCreateBulk) Save(ctx context.Context) ([]*DataEncryptionKey, error) {
+	if dekcb.err != nil {
+		return nil, dekcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dekcb.builders))
+	nodes := make([]*DataEncryptionKey, len(dekcb.builders))
+	mutators := make([]Mutator, len(dekcb.builders))
+	for i := range dekcb.builders {
+		func(i int, root context.Context) {
+			builder := dekcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataEncryptionKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dekcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dekcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dekcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dekcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dekcb *DataEncryptionKeyCreateBulk) SaveX(ctx context.Context) []*DataEncryptionKey {
+	v, err := dekcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dekcb *DataEncryptionKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := dekcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dekcb *DataEncryptionKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := dekcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DataEncryptionKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DataEncryptionKeyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (dekcb *DataEncryptionKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *DataEncryptionKeyUpsertBulk {
+	dekcb.conflict = opts
+	return &DataEncryptionKeyUpsertBulk{
+		create: dekcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DataEncryptionKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dekcb *DataEncryptionKeyCreateBulk) OnConflictColumns(columns ...string) *DataEncryptionKeyUpsertBulk {
+	dekcb.conflict = append(dekcb.conflict, sql.ConflictColumns(columns...))
+	return &DataEncryptionKeyUpsertBulk{
+		create: dekcb,
+	}
+}
+
+// DataEncryptionKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of DataEncryptionKey nodes.
+type DataEncryptionKeyUpsertBulk struct {
+	create *DataEncryptionKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DataEncryptionKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dataencryptionkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DataEncryptionKeyUpsertBulk) UpdateNewValues() *DataEncryptionKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dataencryptionkey.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(dataencryptionkey.FieldCreateTime)
+			}
+			if _, exists := b.mutation.Provider(); exists {
+				s.SetIgnore(dataencryptionkey.FieldProvider)
+			}
+			if _, exists := b.mutation.WrappedKey(); exists {
+				s.SetIgnore(dataencryptionkey.FieldWrappedKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DataEncryptionKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DataEncryptionKeyUpsertBulk) Ignore() *DataEncryptionKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DataEncryptionKeyUpsertBulk) DoNothing() *DataEncryptionKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DataEncryptionKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *DataEncryptionKeyUpsertBulk) Update(set func(*DataEncryptionKeyUpsert)) *DataEncryptionKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DataEncryptionKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DataEncryptionKeyUpsertBulk) SetUpdateTime(v time.Time) *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertBulk) UpdateUpdateTime() *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *DataEncryptionKeyUpsertBulk) SetActive(v bool) *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertBulk) UpdateActive() *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetRotationStatus sets the "rotation_status" field.
+func (u *DataEncryptionKeyUpsertBulk) SetRotationStatus(v string) *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetRotationStatus(v)
+	})
+}
+
+// UpdateRotationStatus sets the "rotation_status" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertBulk) UpdateRotationStatus() *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateRotationStatus()
+	})
+}
+
+// ClearRotationStatus clears the value of the "rotation_status" field.
+func (u *DataEncryptionKeyUpsertBulk) ClearRotationStatus() *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.ClearRotationStatus()
+	})
+}
+
+// SetRotationTotal sets the "rotation_total" field.
+func (u *DataEncryptionKeyUpsertBulk) SetRotationTotal(v int) *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetRotationTotal(v)
+	})
+}
+
+// AddRotationTotal adds v to the "rotation_total" field.
+func (u *DataEncryptionKeyUpsertBulk) AddRotationTotal(v int) *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.AddRotationTotal(v)
+	})
+}
+
+// UpdateRotationTotal sets the "rotation_total" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertBulk) UpdateRotationTotal() *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateRotationTotal()
+	})
+}
+
+// SetRotationProcessed sets the "rotation_processed" field.
+func (u *DataEncryptionKeyUpsertBulk) SetRotationProcessed(v int) *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetRotationProcessed(v)
+	})
+}
+
+// AddRotationProcessed adds v to the "rotation_processed" field.
+func (u *DataEncryptionKeyUpsertBulk) AddRotationProcessed(v int) *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.AddRotationProcessed(v)
+	})
+}
+
+// UpdateRotationProcessed sets the "rotation_processed" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertBulk) UpdateRotationProcessed() *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateRotationProcessed()
+	})
+}
+
+// SetRotationMessage sets the "rotation_message" field.
+func (u *DataEncryptionKeyUpsertBulk) SetRotationMessage(v string) *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.SetRotationMessage(v)
+	})
+}
+
+// UpdateRotationMessage sets the "rotation_message" field to the value that was provided on create.
+func (u *DataEncryptionKeyUpsertBulk) UpdateRotationMessage() *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.UpdateRotationMessage()
+	})
+}
+
+// ClearRotationMessage clears the value of the "rotation_message" field.
+func (u *DataEncryptionKeyUpsertBulk) ClearRotationMessage() *DataEncryptionKeyUpsertBulk {
+	return u.Update(func(s *DataEncryptionKeyUpsert) {
+		s.ClearRotationMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *DataEncryptionKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the DataEncryptionKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for DataEncryptionKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DataEncryptionKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+// DataEncryptionKeyDelete is the builder for deleting a DataEncryptionKey entity.
+type DataEncryptionKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *DataEncryptionKeyMutation
+}
+
+// Where appends a list predicates to the DataEncryptionKeyDelete builder.
+func (dekd *DataEncryptionKeyDelete) Where(ps ...predicate.DataEncryptionKey) *DataEncryptionKeyDelete {
+	dekd.mutation.Where(ps...)
+	return dekd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dekd *DataEncryptionKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dekd.sqlExec, dekd.mutation, dekd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dekd *DataEncryptionKeyDelete) ExecX(ctx context.Context) int {
+	n, err := dekd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dekd *DataEncryptionKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dataencryptionkey.Table, sqlgraph.NewFieldSpec(dataencryptionkey.FieldID, field.TypeString))
+	_spec.Node.Schema = dekd.schemaConfig.DataEncryptionKey
+	ctx = internal.NewSchemaConfigContext(ctx, dekd.schemaConfig)
+	if ps := dekd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dekd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dekd.mutation.done = true
+	return affected, err
+}
+
+// DataEncryptionKeyDeleteOne is the builder for deleting a single DataEncryptionKey entity.
+type DataEncryptionKeyDeleteOne struct {
+	dekd *DataEncryptionKeyDelete
+}
+
+// Where appends a list predicates to the DataEncryptionKeyDelete builder.
+func (dekdo *DataEncryptionKeyDeleteOne) Where(ps ...predicate.DataEncryptionKey) *DataEncryptionKeyDeleteOne {
+	dekdo.dekd.mutation.Where(ps...)
+	return dekdo
+}
+
+// Exec executes the deletion query.
+func (dekdo *DataEncryptionKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := dekdo.dekd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dataencryptionkey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dekdo *DataEncryptionKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := dekdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
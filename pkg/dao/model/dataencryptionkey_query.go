@@ -0,0 +1,598 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// DataEncryptionKeyQuery is the builder for querying DataEncryptionKey entities.
+type DataEncryptionKeyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dataencryptionkey.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DataEncryptionKey
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DataEncryptionKeyQuery builder.
+func (dekq *DataEncryptionKeyQuery) Where(ps ...predicate.DataEncryptionKey) *DataEncryptionKeyQuery {
+	dekq.predicates = append(dekq.predicates, ps...)
+	return dekq
+}
+
+// Limit the number of records to be returned by this query.
+func (dekq *DataEncryptionKeyQuery) Limit(limit int) *DataEncryptionKeyQuery {
+	dekq.ctx.Limit = &limit
+	return dekq
+}
+
+// Offset to start from.
+func (dekq *DataEncryptionKeyQuery) Offset(offset int) *DataEncryptionKeyQuery {
+	dekq.ctx.Offset = &offset
+	return dekq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dekq *DataEncryptionKeyQuery) Unique(unique bool) *DataEncryptionKeyQuery {
+	dekq.ctx.Unique = &unique
+	return dekq
+}
+
+// Order specifies how the records should be ordered.
+func (dekq *DataEncryptionKeyQuery) Order(o ...dataencryptionkey.OrderOption) *DataEncryptionKeyQuery {
+	dekq.order = append(dekq.order, o...)
+	return dekq
+}
+
+// First returns the first DataEncryptionKey entity from the query.
+// Returns a *NotFoundError when no DataEncryptionKey was found.
+func (dekq *DataEncryptionKeyQuery) First(ctx context.Context) (*DataEncryptionKey, error) {
+	nodes, err := dekq.Limit(1).All(setContextOp(ctx, dekq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dataencryptionkey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dekq *DataEncryptionKeyQuery) FirstX(ctx context.Context) *DataEncryptionKey {
+	node, err := dekq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DataEncryptionKey ID from the query.
+// Returns a *NotFoundError when no DataEncryptionKey ID was found.
+func (dekq *DataEncryptionKeyQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = dekq.Limit(1).IDs(setContextOp(ctx, dekq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dataencryptionkey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dekq *DataEncryptionKeyQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := dekq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DataEncryptionKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DataEncryptionKey entity is found.
+// Returns a *NotFoundError when no DataEncryptionKey entities are found.
+func (dekq *DataEncryptionKeyQuery) Only(ctx context.Context) (*DataEncryptionKey, error) {
+	nodes, err := dekq.Limit(2).All(setContextOp(ctx, dekq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dataencryptionkey.Label}
+	default:
+		return nil, &NotSingularError{dataencryptionkey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dekq *DataEncryptionKeyQuery) OnlyX(ctx context.Context) *DataEncryptionKey {
+	node, err := dekq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DataEncryptionKey ID in the query.
+// Returns a *NotSingularError when more than one DataEncryptionKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dekq *DataEncryptionKeyQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = dekq.Limit(2).IDs(setContextOp(ctx, dekq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dataencryptionkey.Label}
+	default:
+		err = &NotSingularError{dataencryptionkey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dekq *DataEncryptionKeyQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := dekq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DataEncryptionKeys.
+func (dekq *DataEncryptionKeyQuery) All(ctx context.Context) ([]*DataEncryptionKey, error) {
+	ctx = setContextOp(ctx, dekq.ctx, "All")
+	if err := dekq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DataEncryptionKey, *DataEncryptionKeyQuery]()
+	return withInterceptors[[]*DataEncryptionKey](ctx, dekq, qr, dekq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dekq *DataEncryptionKeyQuery) AllX(ctx context.Context) []*DataEncryptionKey {
+	nodes, err := dekq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DataEncryptionKey IDs.
+func (dekq *DataEncryptionKeyQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if dekq.ctx.Unique == nil && dekq.path != nil {
+		dekq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dekq.ctx, "IDs")
+	if err = dekq.Select(dataencryptionkey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dekq *DataEncryptionKeyQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := dekq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dekq *DataEncryptionKeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dekq.ctx, "Count")
+	if err := dekq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dekq, querierCount[*DataEncryptionKeyQuery](), dekq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dekq *DataEncryptionKeyQuery) CountX(ctx context.Context) int {
+	count, err := dekq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dekq *DataEncryptionKeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dekq.ctx, "Exist")
+	switch _, err := dekq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dekq *DataEncryptionKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := dekq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DataEncryptionKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dekq *DataEncryptionKeyQuery) Clone() *DataEncryptionKeyQuery {
+	if dekq == nil {
+		return nil
+	}
+	return &DataEncryptionKeyQuery{
+		config:     dekq.config,
+		ctx:        dekq.ctx.Clone(),
+		order:      append([]dataencryptionkey.OrderOption{}, dekq.order...),
+		inters:     append([]Interceptor{}, dekq.inters...),
+		predicates: append([]predicate.DataEncryptionKey{}, dekq.predicates...),
+		// clone intermediate query.
+		sql:  dekq.sql.Clone(),
+		path: dekq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DataEncryptionKey.Query().
+//		GroupBy(dataencryptionkey.FieldCreateTime).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (dekq *DataEncryptionKeyQuery) GroupBy(field string, fields ...string) *DataEncryptionKeyGroupBy {
+	dekq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DataEncryptionKeyGroupBy{build: dekq}
+	grbuild.flds = &dekq.ctx.Fields
+	grbuild.label = dataencryptionkey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.DataEncryptionKey.Query().
+//		Select(dataencryptionkey.FieldCreateTime).
+//		Scan(ctx, &v)
+func (dekq *DataEncryptionKeyQuery) Select(fields ...string) *DataEncryptionKeySelect {
+	dekq.ctx.Fields = append(dekq.ctx.Fields, fields...)
+	sbuild := &DataEncryptionKeySelect{DataEncryptionKeyQuery: dekq}
+	sbuild.label = dataencryptionkey.Label
+	sbuild.flds, sbuild.scan = &dekq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DataEncryptionKeySelect configured with the given aggregations.
+func (dekq *DataEncryptionKeyQuery) Aggregate(fns ...AggregateFunc) *DataEncryptionKeySelect {
+	return dekq.Select().Aggregate(fns...)
+}
+
+func (dekq *DataEncryptionKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dekq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dekq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dekq.ctx.Fields {
+		if !dataencryptionkey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if dekq.path != nil {
+		prev, err := dekq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dekq.sql = prev
+	}
+	return nil
+}
+
+func (dekq *DataEncryptionKeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DataEncryptionKey, error) {
+	var (
+		nodes = []*DataEncryptionKey{}
+		_spec = dekq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DataEncryptionKey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DataEncryptionKey{config: dekq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = dekq.schemaConfig.DataEncryptionKey
+	ctx = internal.NewSchemaConfigContext(ctx, dekq.schemaConfig)
+	if len(dekq.modifiers) > 0 {
+		_spec.Modifiers = dekq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dekq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dekq *DataEncryptionKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dekq.querySpec()
+	_spec.Node.Schema = dekq.schemaConfig.DataEncryptionKey
+	ctx = internal.NewSchemaConfigContext(ctx, dekq.schemaConfig)
+	if len(dekq.modifiers) > 0 {
+		_spec.Modifiers = dekq.modifiers
+	}
+	_spec.Node.Columns = dekq.ctx.Fields
+	if len(dekq.ctx.Fields) > 0 {
+		_spec.Unique = dekq.ctx.Unique != nil && *dekq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dekq.driver, _spec)
+}
+
+func (dekq *DataEncryptionKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dataencryptionkey.Table, dataencryptionkey.Columns, sqlgraph.NewFieldSpec(dataencryptionkey.FieldID, field.TypeString))
+	_spec.From = dekq.sql
+	if unique := dekq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dekq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dekq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataencryptionkey.FieldID)
+		for i := range fields {
+			if fields[i] != dataencryptionkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dekq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dekq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dekq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dekq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dekq *DataEncryptionKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dekq.driver.Dialect())
+	t1 := builder.Table(dataencryptionkey.Table)
+	columns := dekq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dataencryptionkey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dekq.sql != nil {
+		selector = dekq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dekq.ctx.Unique != nil && *dekq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(dekq.schemaConfig.DataEncryptionKey)
+	ctx = internal.NewSchemaConfigContext(ctx, dekq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range dekq.modifiers {
+		m(selector)
+	}
+	for _, p := range dekq.predicates {
+		p(selector)
+	}
+	for _, p := range dekq.order {
+		p(selector)
+	}
+	if offset := dekq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dekq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dekq *DataEncryptionKeyQuery) ForUpdate(opts ...sql.LockOption) *DataEncryptionKeyQuery {
+	if dekq.driver.Dialect() == dialect.Postgres {
+		dekq.Unique(false)
+	}
+	dekq.modifiers = append(dekq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dekq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dekq *DataEncryptionKeyQuery) ForShare(opts ...sql.LockOption) *DataEncryptionKeyQuery {
+	if dekq.driver.Dialect() == dialect.Postgres {
+		dekq.Unique(false)
+	}
+	dekq.modifiers = append(dekq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dekq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dekq *DataEncryptionKeyQuery) Modify(modifiers ...func(s *sql.Selector)) *DataEncryptionKeySelect {
+	dekq.modifiers = append(dekq.modifiers, modifiers...)
+	return dekq.Select()
+}
+
+// WhereP appends storage-level predicates to the DataEncryptionKeyQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (dekq *DataEncryptionKeyQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.DataEncryptionKey, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.DataEncryptionKey(ps[i]))
+	}
+	dekq.predicates = append(dekq.predicates, wps...)
+}
+
+// DataEncryptionKeyGroupBy is the group-by builder for DataEncryptionKey entities.
+type DataEncryptionKeyGroupBy struct {
+	selector
+	build *DataEncryptionKeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dekgb *DataEncryptionKeyGroupBy) Aggregate(fns ...AggregateFunc) *DataEncryptionKeyGroupBy {
+	dekgb.fns = append(dekgb.fns, fns...)
+	return dekgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dekgb *DataEncryptionKeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dekgb.build.ctx, "GroupBy")
+	if err := dekgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DataEncryptionKeyQuery, *DataEncryptionKeyGroupBy](ctx, dekgb.build, dekgb, dekgb.build.inters, v)
+}
+
+func (dekgb *DataEncryptionKeyGroupBy) sqlScan(ctx context.Context, root *DataEncryptionKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dekgb.fns))
+	for _, fn := range dekgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dekgb.flds)+len(dekgb.fns))
+		for _, f := range *dekgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dekgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dekgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DataEncryptionKeySelect is the builder for selecting fields of DataEncryptionKey entities.
+type DataEncryptionKeySelect struct {
+	*DataEncryptionKeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (deks *DataEncryptionKeySelect) Aggregate(fns ...AggregateFunc) *DataEncryptionKeySelect {
+	deks.fns = append(deks.fns, fns...)
+	return deks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (deks *DataEncryptionKeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, deks.ctx, "Select")
+	if err := deks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DataEncryptionKeyQuery, *DataEncryptionKeySelect](ctx, deks.DataEncryptionKeyQuery, deks, deks.inters, v)
+}
+
+func (deks *DataEncryptionKeySelect) sqlScan(ctx context.Context, root *DataEncryptionKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(deks.fns))
+	for _, fn := range deks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*deks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := deks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (deks *DataEncryptionKeySelect) Modify(modifiers ...func(s *sql.Selector)) *DataEncryptionKeySelect {
+	deks.modifiers = append(deks.modifiers, modifiers...)
+	return deks
+}
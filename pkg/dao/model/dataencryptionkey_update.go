@@ -0,0 +1,715 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+)
+
+// DataEncryptionKeyUpdate is the builder for updating DataEncryptionKey entities.
+type DataEncryptionKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DataEncryptionKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *DataEncryptionKey
+}
+
+// Where appends a list predicates to the DataEncryptionKeyUpdate builder.
+func (deku *DataEncryptionKeyUpdate) Where(ps ...predicate.DataEncryptionKey) *DataEncryptionKeyUpdate {
+	deku.mutation.Where(ps...)
+	return deku
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (deku *DataEncryptionKeyUpdate) SetUpdateTime(t time.Time) *DataEncryptionKeyUpdate {
+	deku.mutation.SetUpdateTime(t)
+	return deku
+}
+
+// SetActive sets the "active" field.
+func (deku *DataEncryptionKeyUpdate) SetActive(b bool) *DataEncryptionKeyUpdate {
+	deku.mutation.SetActive(b)
+	return deku
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (deku *DataEncryptionKeyUpdate) SetNillableActive(b *bool) *DataEncryptionKeyUpdate {
+	if b != nil {
+		deku.SetActive(*b)
+	}
+	return deku
+}
+
+// SetRotationStatus sets the "rotation_status" field.
+func (deku *DataEncryptionKeyUpdate) SetRotationStatus(s string) *DataEncryptionKeyUpdate {
+	deku.mutation.SetRotationStatus(s)
+	return deku
+}
+
+// SetNillableRotationStatus sets the "rotation_status" field if the given value is not nil.
+func (deku *DataEncryptionKeyUpdate) SetNillableRotationStatus(s *string) *DataEncryptionKeyUpdate {
+	if s != nil {
+		deku.SetRotationStatus(*s)
+	}
+	return deku
+}
+
+// ClearRotationStatus clears the value of the "rotation_status" field.
+func (deku *DataEncryptionKeyUpdate) ClearRotationStatus() *DataEncryptionKeyUpdate {
+	deku.mutation.ClearRotationStatus()
+	return deku
+}
+
+// SetRotationTotal sets the "rotation_total" field.
+func (deku *DataEncryptionKeyUpdate) SetRotationTotal(i int) *DataEncryptionKeyUpdate {
+	deku.mutation.ResetRotationTotal()
+	deku.mutation.SetRotationTotal(i)
+	return deku
+}
+
+// SetNillableRotationTotal sets the "rotation_total" field if the given value is not nil.
+func (deku *DataEncryptionKeyUpdate) SetNillableRotationTotal(i *int) *DataEncryptionKeyUpdate {
+	if i != nil {
+		deku.SetRotationTotal(*i)
+	}
+	return deku
+}
+
+// AddRotationTotal adds i to the "rotation_total" field.
+func (deku *DataEncryptionKeyUpdate) AddRotationTotal(i int) *DataEncryptionKeyUpdate {
+	deku.mutation.AddRotationTotal(i)
+	return deku
+}
+
+// SetRotationProcessed sets the "rotation_processed" field.
+func (deku *DataEncryptionKeyUpdate) SetRotationProcessed(i int) *DataEncryptionKeyUpdate {
+	deku.mutation.ResetRotationProcessed()
+	deku.mutation.SetRotationProcessed(i)
+	return deku
+}
+
+// SetNillableRotationProcessed sets the "rotation_processed" field if the given value is not nil.
+func (deku *DataEncryptionKeyUpdate) SetNillableRotationProcessed(i *int) *DataEncryptionKeyUpdate {
+	if i != nil {
+		deku.SetRotationProcessed(*i)
+	}
+	return deku
+}
+
+// AddRotationProcessed adds i to the "rotation_processed" field.
+func (deku *DataEncryptionKeyUpdate) AddRotationProcessed(i int) *DataEncryptionKeyUpdate {
+	deku.mutation.AddRotationProcessed(i)
+	return deku
+}
+
+// SetRotationMessage sets the "rotation_message" field.
+func (deku *DataEncryptionKeyUpdate) SetRotationMessage(s string) *DataEncryptionKeyUpdate {
+	deku.mutation.SetRotationMessage(s)
+	return deku
+}
+
+// SetNillableRotationMessage sets the "rotation_message" field if the given value is not nil.
+func (deku *DataEncryptionKeyUpdate) SetNillableRotationMessage(s *string) *DataEncryptionKeyUpdate {
+	if s != nil {
+		deku.SetRotationMessage(*s)
+	}
+	return deku
+}
+
+// ClearRotationMessage clears the value of the "rotation_message" field.
+func (deku *DataEncryptionKeyUpdate) ClearRotationMessage() *DataEncryptionKeyUpdate {
+	deku.mutation.ClearRotationMessage()
+	return deku
+}
+
+// Mutation returns the DataEncryptionKeyMutation object of the builder.
+func (deku *DataEncryptionKeyUpdate) Mutation() *DataEncryptionKeyMutation {
+	return deku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (deku *DataEncryptionKeyUpdate) Save(ctx context.Context) (int, error) {
+	if err := deku.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, deku.sqlSave, deku.mutation, deku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (deku *DataEncryptionKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := deku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (deku *DataEncryptionKeyUpdate) Exec(ctx context.Context) error {
+	_, err := deku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (deku *DataEncryptionKeyUpdate) ExecX(ctx context.Context) {
+	if err := deku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (deku *DataEncryptionKeyUpdate) defaults() error {
+	if _, ok := deku.mutation.UpdateTime(); !ok {
+		if dataencryptionkey.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized dataencryptionkey.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := dataencryptionkey.UpdateDefaultUpdateTime()
+		deku.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (deku *DataEncryptionKeyUpdate) Set(obj *DataEncryptionKey) *DataEncryptionKeyUpdate {
+	// Without Default.
+	deku.SetActive(obj.Active)
+	if obj.RotationStatus != "" {
+		deku.SetRotationStatus(obj.RotationStatus)
+	}
+	deku.SetRotationTotal(obj.RotationTotal)
+	deku.SetRotationProcessed(obj.RotationProcessed)
+	if obj.RotationMessage != "" {
+		deku.SetRotationMessage(obj.RotationMessage)
+	}
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		deku.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	deku.object = obj
+
+	return deku
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (deku *DataEncryptionKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataEncryptionKeyUpdate {
+	deku.modifiers = append(deku.modifiers, modifiers...)
+	return deku
+}
+
+func (deku *DataEncryptionKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dataencryptionkey.Table, dataencryptionkey.Columns, sqlgraph.NewFieldSpec(dataencryptionkey.FieldID, field.TypeString))
+	if ps := deku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := deku.mutation.UpdateTime(); ok {
+		_spec.SetField(dataencryptionkey.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := deku.mutation.Active(); ok {
+		_spec.SetField(dataencryptionkey.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := deku.mutation.RotationStatus(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationStatus, field.TypeString, value)
+	}
+	if deku.mutation.RotationStatusCleared() {
+		_spec.ClearField(dataencryptionkey.FieldRotationStatus, field.TypeString)
+	}
+	if value, ok := deku.mutation.RotationTotal(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationTotal, field.TypeInt, value)
+	}
+	if value, ok := deku.mutation.AddedRotationTotal(); ok {
+		_spec.AddField(dataencryptionkey.FieldRotationTotal, field.TypeInt, value)
+	}
+	if value, ok := deku.mutation.RotationProcessed(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationProcessed, field.TypeInt, value)
+	}
+	if value, ok := deku.mutation.AddedRotationProcessed(); ok {
+		_spec.AddField(dataencryptionkey.FieldRotationProcessed, field.TypeInt, value)
+	}
+	if value, ok := deku.mutation.RotationMessage(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationMessage, field.TypeString, value)
+	}
+	if deku.mutation.RotationMessageCleared() {
+		_spec.ClearField(dataencryptionkey.FieldRotationMessage, field.TypeString)
+	}
+	_spec.Node.Schema = deku.schemaConfig.DataEncryptionKey
+	ctx = internal.NewSchemaConfigContext(ctx, deku.schemaConfig)
+	_spec.AddModifiers(deku.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, deku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataencryptionkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	deku.mutation.done = true
+	return n, nil
+}
+
+// DataEncryptionKeyUpdateOne is the builder for updating a single DataEncryptionKey entity.
+type DataEncryptionKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DataEncryptionKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *DataEncryptionKey
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (dekuo *DataEncryptionKeyUpdateOne) SetUpdateTime(t time.Time) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.SetUpdateTime(t)
+	return dekuo
+}
+
+// SetActive sets the "active" field.
+func (dekuo *DataEncryptionKeyUpdateOne) SetActive(b bool) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.SetActive(b)
+	return dekuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (dekuo *DataEncryptionKeyUpdateOne) SetNillableActive(b *bool) *DataEncryptionKeyUpdateOne {
+	if b != nil {
+		dekuo.SetActive(*b)
+	}
+	return dekuo
+}
+
+// SetRotationStatus sets the "rotation_status" field.
+func (dekuo *DataEncryptionKeyUpdateOne) SetRotationStatus(s string) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.SetRotationStatus(s)
+	return dekuo
+}
+
+// SetNillableRotationStatus sets the "rotation_status" field if the given value is not nil.
+func (dekuo *DataEncryptionKeyUpdateOne) SetNillableRotationStatus(s *string) *DataEncryptionKeyUpdateOne {
+	if s != nil {
+		dekuo.SetRotationStatus(*s)
+	}
+	return dekuo
+}
+
+// ClearRotationStatus clears the value of the "rotation_status" field.
+func (dekuo *DataEncryptionKeyUpdateOne) ClearRotationStatus() *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.ClearRotationStatus()
+	return dekuo
+}
+
+// SetRotationTotal sets the "rotation_total" field.
+func (dekuo *DataEncryptionKeyUpdateOne) SetRotationTotal(i int) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.ResetRotationTotal()
+	dekuo.mutation.SetRotationTotal(i)
+	return dekuo
+}
+
+// SetNillableRotationTotal sets the "rotation_total" field if the given value is not nil.
+func (dekuo *DataEncryptionKeyUpdateOne) SetNillableRotationTotal(i *int) *DataEncryptionKeyUpdateOne {
+	if i != nil {
+		dekuo.SetRotationTotal(*i)
+	}
+	return dekuo
+}
+
+// AddRotationTotal adds i to the "rotation_total" field.
+func (dekuo *DataEncryptionKeyUpdateOne) AddRotationTotal(i int) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.AddRotationTotal(i)
+	return dekuo
+}
+
+// SetRotationProcessed sets the "rotation_processed" field.
+func (dekuo *DataEncryptionKeyUpdateOne) SetRotationProcessed(i int) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.ResetRotationProcessed()
+	dekuo.mutation.SetRotationProcessed(i)
+	return dekuo
+}
+
+// SetNillableRotationProcessed sets the "rotation_processed" field if the given value is not nil.
+func (dekuo *DataEncryptionKeyUpdateOne) SetNillableRotationProcessed(i *int) *DataEncryptionKeyUpdateOne {
+	if i != nil {
+		dekuo.SetRotationProcessed(*i)
+	}
+	return dekuo
+}
+
+// AddRotationProcessed adds i to the "rotation_processed" field.
+func (dekuo *DataEncryptionKeyUpdateOne) AddRotationProcessed(i int) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.AddRotationProcessed(i)
+	return dekuo
+}
+
+// SetRotationMessage sets the "rotation_message" field.
+func (dekuo *DataEncryptionKeyUpdateOne) SetRotationMessage(s string) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.SetRotationMessage(s)
+	return dekuo
+}
+
+// SetNillableRotationMessage sets the "rotation_message" field if the given value is not nil.
+func (dekuo *DataEncryptionKeyUpdateOne) SetNillableRotationMessage(s *string) *DataEncryptionKeyUpdateOne {
+	if s != nil {
+		dekuo.SetRotationMessage(*s)
+	}
+	return dekuo
+}
+
+// ClearRotationMessage clears the value of the "rotation_message" field.
+func (dekuo *DataEncryptionKeyUpdateOne) ClearRotationMessage() *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.ClearRotationMessage()
+	return dekuo
+}
+
+// Mutation returns the DataEncryptionKeyMutation object of the builder.
+func (dekuo *DataEncryptionKeyUpdateOne) Mutation() *DataEncryptionKeyMutation {
+	return dekuo.mutation
+}
+
+// Where appends a list predicates to the DataEncryptionKeyUpdate builder.
+func (dekuo *DataEncryptionKeyUpdateOne) Where(ps ...predicate.DataEncryptionKey) *DataEncryptionKeyUpdateOne {
+	dekuo.mutation.Where(ps...)
+	return dekuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dekuo *DataEncryptionKeyUpdateOne) Select(field string, fields ...string) *DataEncryptionKeyUpdateOne {
+	dekuo.fields = append([]string{field}, fields...)
+	return dekuo
+}
+
+// Save executes the query and returns the updated DataEncryptionKey entity.
+func (dekuo *DataEncryptionKeyUpdateOne) Save(ctx context.Context) (*DataEncryptionKey, error) {
+	if err := dekuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, dekuo.sqlSave, dekuo.mutation, dekuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dekuo *DataEncryptionKeyUpdateOne) SaveX(ctx context.Context) *DataEncryptionKey {
+	node, err := dekuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dekuo *DataEncryptionKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := dekuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dekuo *DataEncryptionKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := dekuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dekuo *DataEncryptionKeyUpdateOne) defaults() error {
+	if _, ok := dekuo.mutation.UpdateTime(); !ok {
+		if dataencryptionkey.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized dataencryptionkey.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := dataencryptionkey.UpdateDefaultUpdateTime()
+		dekuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (dekuo *DataEncryptionKeyUpdateOne) Set(obj *DataEncryptionKey) *DataEncryptionKeyUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*DataEncryptionKeyMutation)
+			db, err := mt.Client().DataEncryptionKey.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting DataEncryptionKey with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if db.Active != obj.Active {
+				dekuo.SetActive(obj.Active)
+			}
+			if obj.RotationStatus != "" {
+				if db.RotationStatus != obj.RotationStatus {
+					dekuo.SetRotationStatus(obj.RotationStatus)
+				}
+			}
+			if db.RotationTotal != obj.RotationTotal {
+				dekuo.SetRotationTotal(obj.RotationTotal)
+			}
+			if db.RotationProcessed != obj.RotationProcessed {
+				dekuo.SetRotationProcessed(obj.RotationProcessed)
+			}
+			if obj.RotationMessage != "" {
+				if db.RotationMessage != obj.RotationMessage {
+					dekuo.SetRotationMessage(obj.RotationMessage)
+				}
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				dekuo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			dekuo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	dekuo.hooks = append(dekuo.hooks, h)
+
+	return dekuo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (dekuo *DataEncryptionKeyUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := dekuo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: dekuo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: dekuo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the DataEncryptionKey entity,
+// which is always good for cascading update operations.
+func (dekuo *DataEncryptionKeyUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *DataEncryptionKey) error) (*DataEncryptionKey, error) {
+	obj, err := dekuo.Save(ctx)
+	if err != nil &&
+		(dekuo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := dekuo.getClientSet()
+
+	if obj == nil {
+		obj = dekuo.object
+	} else if x := dekuo.object; x != nil {
+		if _, set := dekuo.mutation.Field(dataencryptionkey.FieldActive); set {
+			obj.Active = x.Active
+		}
+		if _, set := dekuo.mutation.Field(dataencryptionkey.FieldRotationStatus); set {
+			obj.RotationStatus = x.RotationStatus
+		}
+		if _, set := dekuo.mutation.Field(dataencryptionkey.FieldRotationTotal); set {
+			obj.RotationTotal = x.RotationTotal
+		}
+		if _, set := dekuo.mutation.Field(dataencryptionkey.FieldRotationProcessed); set {
+			obj.RotationProcessed = x.RotationProcessed
+		}
+		if _, set := dekuo.mutation.Field(dataencryptionkey.FieldRotationMessage); set {
+			obj.RotationMessage = x.RotationMessage
+		}
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (dekuo *DataEncryptionKeyUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *DataEncryptionKey) error) *DataEncryptionKey {
+	obj, err := dekuo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (dekuo *DataEncryptionKeyUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *DataEncryptionKey) error) error {
+	_, err := dekuo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dekuo *DataEncryptionKeyUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *DataEncryptionKey) error) {
+	if err := dekuo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dekuo *DataEncryptionKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DataEncryptionKeyUpdateOne {
+	dekuo.modifiers = append(dekuo.modifiers, modifiers...)
+	return dekuo
+}
+
+func (dekuo *DataEncryptionKeyUpdateOne) sqlSave(ctx context.Context) (_node *DataEncryptionKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dataencryptionkey.Table, dataencryptionkey.Columns, sqlgraph.NewFieldSpec(dataencryptionkey.FieldID, field.TypeString))
+	id, ok := dekuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "DataEncryptionKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dekuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dataencryptionkey.FieldID)
+		for _, f := range fields {
+			if !dataencryptionkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != dataencryptionkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dekuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dekuo.mutation.UpdateTime(); ok {
+		_spec.SetField(dataencryptionkey.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := dekuo.mutation.Active(); ok {
+		_spec.SetField(dataencryptionkey.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := dekuo.mutation.RotationStatus(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationStatus, field.TypeString, value)
+	}
+	if dekuo.mutation.RotationStatusCleared() {
+		_spec.ClearField(dataencryptionkey.FieldRotationStatus, field.TypeString)
+	}
+	if value, ok := dekuo.mutation.RotationTotal(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationTotal, field.TypeInt, value)
+	}
+	if value, ok := dekuo.mutation.AddedRotationTotal(); ok {
+		_spec.AddField(dataencryptionkey.FieldRotationTotal, field.TypeInt, value)
+	}
+	if value, ok := dekuo.mutation.RotationProcessed(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationProcessed, field.TypeInt, value)
+	}
+	if value, ok := dekuo.mutation.AddedRotationProcessed(); ok {
+		_spec.AddField(dataencryptionkey.FieldRotationProcessed, field.TypeInt, value)
+	}
+	if value, ok := dekuo.mutation.RotationMessage(); ok {
+		_spec.SetField(dataencryptionkey.FieldRotationMessage, field.TypeString, value)
+	}
+	if dekuo.mutation.RotationMessageCleared() {
+		_spec.ClearField(dataencryptionkey.FieldRotationMessage, field.TypeString)
+	}
+	_spec.Node.Schema = dekuo.schemaConfig.DataEncryptionKey
+	ctx = internal.NewSchemaConfigContext(ctx, dekuo.schemaConfig)
+	_spec.AddModifiers(dekuo.modifiers...)
+	_node = &DataEncryptionKey{config: dekuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dekuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dataencryptionkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dekuo.mutation.done = true
+	return _node, nil
+}
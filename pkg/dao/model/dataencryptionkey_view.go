@@ -0,0 +1,735 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// DataEncryptionKeyCreateInput holds the creation input of the DataEncryptionKey entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type DataEncryptionKeyCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Model returns the DataEncryptionKey entity for creating,
+// after validating.
+func (dekci *DataEncryptionKeyCreateInput) Model() *DataEncryptionKey {
+	if dekci == nil {
+		return nil
+	}
+
+	_dek := &DataEncryptionKey{}
+
+	return _dek
+}
+
+// Validate checks the DataEncryptionKeyCreateInput entity.
+func (dekci *DataEncryptionKeyCreateInput) Validate() error {
+	if dekci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return dekci.ValidateWith(dekci.inputConfig.Context, dekci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the DataEncryptionKeyCreateInput entity with the given context and client set.
+func (dekci *DataEncryptionKeyCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if dekci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// DataEncryptionKeyCreateInputs holds the creation input item of the DataEncryptionKey entities.
+type DataEncryptionKeyCreateInputsItem struct {
+}
+
+// ValidateWith checks the DataEncryptionKeyCreateInputsItem entity with the given context and client set.
+func (dekci *DataEncryptionKeyCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if dekci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// DataEncryptionKeyCreateInputs holds the creation input of the DataEncryptionKey entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type DataEncryptionKeyCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*DataEncryptionKeyCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the DataEncryptionKey entities for creating,
+// after validating.
+func (dekci *DataEncryptionKeyCreateInputs) Model() []*DataEncryptionKey {
+	if dekci == nil || len(dekci.Items) == 0 {
+		return nil
+	}
+
+	_deks := make([]*DataEncryptionKey, len(dekci.Items))
+
+	for i := range dekci.Items {
+		_dek := &DataEncryptionKey{}
+
+		_deks[i] = _dek
+	}
+
+	return _deks
+}
+
+// Validate checks the DataEncryptionKeyCreateInputs entity .
+func (dekci *DataEncryptionKeyCreateInputs) Validate() error {
+	if dekci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return dekci.ValidateWith(dekci.inputConfig.Context, dekci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the DataEncryptionKeyCreateInputs entity with the given context and client set.
+func (dekci *DataEncryptionKeyCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if dekci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(dekci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	for i := range dekci.Items {
+		if dekci.Items[i] == nil {
+			continue
+		}
+
+		if err := dekci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DataEncryptionKeyDeleteInput holds the deletion input of the DataEncryptionKey entity,
+// please tags with `path:",inline"` if embedding.
+type DataEncryptionKeyDeleteInput struct {
+	DataEncryptionKeyQueryInput `path:",inline"`
+}
+
+// DataEncryptionKeyDeleteInputs holds the deletion input item of the DataEncryptionKey entities.
+type DataEncryptionKeyDeleteInputsItem struct {
+	// ID of the DataEncryptionKey entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// DataEncryptionKeyDeleteInputs holds the deletion input of the DataEncryptionKey entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type DataEncryptionKeyDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*DataEncryptionKeyDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the DataEncryptionKey entities for deleting,
+// after validating.
+func (dekdi *DataEncryptionKeyDeleteInputs) Model() []*DataEncryptionKey {
+	if dekdi == nil || len(dekdi.Items) == 0 {
+		return nil
+	}
+
+	_deks := make([]*DataEncryptionKey, len(dekdi.Items))
+	for i := range dekdi.Items {
+		_deks[i] = &DataEncryptionKey{
+			ID: dekdi.Items[i].ID,
+		}
+	}
+	return _deks
+}
+
+// IDs returns the ID list of the DataEncryptionKey entities for deleting,
+// after validating.
+func (dekdi *DataEncryptionKeyDeleteInputs) IDs() []object.ID {
+	if dekdi == nil || len(dekdi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(dekdi.Items))
+	for i := range dekdi.Items {
+		ids[i] = dekdi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the DataEncryptionKeyDeleteInputs entity.
+func (dekdi *DataEncryptionKeyDeleteInputs) Validate() error {
+	if dekdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return dekdi.ValidateWith(dekdi.inputConfig.Context, dekdi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the DataEncryptionKeyDeleteInputs entity with the given context and client set.
+func (dekdi *DataEncryptionKeyDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if dekdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(dekdi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.DataEncryptionKeys().Query()
+
+	ids := make([]object.ID, 0, len(dekdi.Items))
+
+	for i := range dekdi.Items {
+		if dekdi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if dekdi.Items[i].ID != "" {
+			ids = append(ids, dekdi.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(dataencryptionkey.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	return nil
+}
+
+// DataEncryptionKeyPatchInput holds the patch input of the DataEncryptionKey entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type DataEncryptionKeyPatchInput struct {
+	DataEncryptionKeyQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// Provider of the key encryption key which wraps the key, i.e. local, file.
+	Provider string `path:"-" query:"-" json:"provider,omitempty"`
+	// Wrapped key.
+	WrappedKey []byte `path:"-" query:"-" json:"wrappedKey,omitempty" sensitive:"true"`
+	// Indicate whether the key is used to encrypt the new data.
+	Active bool `path:"-" query:"-" json:"active,omitempty"`
+	// Status of re-encrypting the existing data with the key, i.e. running, succeeded, failed.
+	RotationStatus string `path:"-" query:"-" json:"rotationStatus,omitempty"`
+	// Total count of the data to re-encrypt.
+	RotationTotal int `path:"-" query:"-" json:"rotationTotal,omitempty"`
+	// Processed count of the data to re-encrypt.
+	RotationProcessed int `path:"-" query:"-" json:"rotationProcessed,omitempty"`
+	// Message of re-encrypting, i.e. the error message if failed.
+	RotationMessage string `path:"-" query:"-" json:"rotationMessage,omitempty"`
+
+	patchedEntity *DataEncryptionKey `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the DataEncryptionKey partition entity for patching.
+func (dekpi *DataEncryptionKeyPatchInput) PatchModel() *DataEncryptionKey {
+	if dekpi == nil {
+		return nil
+	}
+
+	_dek := &DataEncryptionKey{
+		CreateTime:        dekpi.CreateTime,
+		UpdateTime:        dekpi.UpdateTime,
+		Provider:          dekpi.Provider,
+		WrappedKey:        dekpi.WrappedKey,
+		Active:            dekpi.Active,
+		RotationStatus:    dekpi.RotationStatus,
+		RotationTotal:     dekpi.RotationTotal,
+		RotationProcessed: dekpi.RotationProcessed,
+		RotationMessage:   dekpi.RotationMessage,
+	}
+
+	return _dek
+}
+
+// Model returns the DataEncryptionKey patched entity,
+// after validating.
+func (dekpi *DataEncryptionKeyPatchInput) Model() *DataEncryptionKey {
+	if dekpi == nil {
+		return nil
+	}
+
+	return dekpi.patchedEntity
+}
+
+// Validate checks the DataEncryptionKeyPatchInput entity.
+func (dekpi *DataEncryptionKeyPatchInput) Validate() error {
+	if dekpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return dekpi.ValidateWith(dekpi.inputConfig.Context, dekpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the DataEncryptionKeyPatchInput entity with the given context and client set.
+func (dekpi *DataEncryptionKeyPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := dekpi.DataEncryptionKeyQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.DataEncryptionKeys().Query()
+
+	if dekpi.Refer != nil {
+		if dekpi.Refer.IsID() {
+			q.Where(
+				dataencryptionkey.ID(dekpi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of dataencryptionkey")
+		}
+	} else if dekpi.ID != "" {
+		q.Where(
+			dataencryptionkey.ID(dekpi.ID))
+	} else {
+		return errors.New("invalid identify of dataencryptionkey")
+	}
+
+	q.Select(
+		dataencryptionkey.WithoutFields(
+			dataencryptionkey.FieldCreateTime,
+			dataencryptionkey.FieldUpdateTime,
+			dataencryptionkey.FieldProvider,
+			dataencryptionkey.FieldWrappedKey,
+			dataencryptionkey.FieldActive,
+			dataencryptionkey.FieldRotationStatus,
+			dataencryptionkey.FieldRotationTotal,
+			dataencryptionkey.FieldRotationProcessed,
+			dataencryptionkey.FieldRotationMessage,
+		)...,
+	)
+
+	var e *DataEncryptionKey
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*DataEncryptionKey)
+		}
+	}
+
+	_pm := dekpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*DataEncryptionKey)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.Provider != _obj.Provider {
+		return errors.New("field provider is immutable")
+	}
+	if !bytes.Equal(e.WrappedKey, _obj.WrappedKey) {
+		return errors.New("field wrappedKey is immutable")
+	}
+
+	dekpi.patchedEntity = _obj
+	return nil
+}
+
+// DataEncryptionKeyQueryInput holds the query input of the DataEncryptionKey entity,
+// please tags with `path:",inline"` if embedding.
+type DataEncryptionKeyQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Refer holds the route path reference of the DataEncryptionKey entity.
+	Refer *object.Refer `path:"dataencryptionkey,default=" query:"-" json:"-"`
+	// ID of the DataEncryptionKey entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// Model returns the DataEncryptionKey entity for querying,
+// after validating.
+func (dekqi *DataEncryptionKeyQueryInput) Model() *DataEncryptionKey {
+	if dekqi == nil {
+		return nil
+	}
+
+	return &DataEncryptionKey{
+		ID: dekqi.ID,
+	}
+}
+
+// Validate checks the DataEncryptionKeyQueryInput entity.
+func (dekqi *DataEncryptionKeyQueryInput) Validate() error {
+	if dekqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return dekqi.ValidateWith(dekqi.inputConfig.Context, dekqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the DataEncryptionKeyQueryInput entity with the given context and client set.
+func (dekqi *DataEncryptionKeyQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if dekqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if dekqi.Refer != nil && *dekqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", dataencryptionkey.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.DataEncryptionKeys().Query()
+
+	if dekqi.Refer != nil {
+		if dekqi.Refer.IsID() {
+			q.Where(
+				dataencryptionkey.ID(dekqi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of dataencryptionkey")
+		}
+	} else if dekqi.ID != "" {
+		q.Where(
+			dataencryptionkey.ID(dekqi.ID))
+	} else {
+		return errors.New("invalid identify of dataencryptionkey")
+	}
+
+	q.Select(
+		dataencryptionkey.FieldID,
+	)
+
+	var e *DataEncryptionKey
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*DataEncryptionKey)
+		}
+	}
+
+	dekqi.ID = e.ID
+	return nil
+}
+
+// DataEncryptionKeyQueryInputs holds the query input of the DataEncryptionKey entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type DataEncryptionKeyQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Validate checks the DataEncryptionKeyQueryInputs entity.
+func (dekqi *DataEncryptionKeyQueryInputs) Validate() error {
+	if dekqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return dekqi.ValidateWith(dekqi.inputConfig.Context, dekqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the DataEncryptionKeyQueryInputs entity with the given context and client set.
+func (dekqi *DataEncryptionKeyQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if dekqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// DataEncryptionKeyUpdateInput holds the modification input of the DataEncryptionKey entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type DataEncryptionKeyUpdateInput struct {
+	DataEncryptionKeyQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Model returns the DataEncryptionKey entity for modifying,
+// after validating.
+func (dekui *DataEncryptionKeyUpdateInput) Model() *DataEncryptionKey {
+	if dekui == nil {
+		return nil
+	}
+
+	_dek := &DataEncryptionKey{
+		ID: dekui.ID,
+	}
+
+	return _dek
+}
+
+// Validate checks the DataEncryptionKeyUpdateInput entity.
+func (dekui *DataEncryptionKeyUpdateInput) Validate() error {
+	if dekui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return dekui.ValidateWith(dekui.inputConfig.Context, dekui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the DataEncryptionKeyUpdateInput entity with the given context and client set.
+func (dekui *DataEncryptionKeyUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := dekui.DataEncryptionKeyQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DataEncryptionKeyUpdateInputs holds the modification input item of the DataEncryptionKey entities.
+type DataEncryptionKeyUpdateInputsItem struct {
+	// ID of the DataEncryptionKey entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ValidateWith checks the DataEncryptionKeyUpdateInputsItem entity with the given context and client set.
+func (dekui *DataEncryptionKeyUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if dekui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// DataEncryptionKeyUpdateInputs holds the modification input of the DataEncryptionKey entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type DataEncryptionKeyUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*DataEncryptionKeyUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the DataEncryptionKey entities for modifying,
+// after validating.
+func (dekui *DataEncryptionKeyUpdateInputs) Model() []*DataEncryptionKey {
+	if dekui == nil || len(dekui.Items) == 0 {
+		return nil
+	}
+
+	_deks := make([]*DataEncryptionKey, len(dekui.Items))
+
+	for i := range dekui.Items {
+		_dek := &DataEncryptionKey{
+			ID: dekui.Items[i].ID,
+		}
+
+		_deks[i] = _dek
+	}
+
+	return _deks
+}
+
+// IDs returns the ID list of the DataEncryptionKey entities for modifying,
+// after validating.
+func (dekui *DataEncryptionKeyUpdateInputs) IDs() []object.ID {
+	if dekui == nil || len(dekui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(dekui.Items))
+	for i := range dekui.Items {
+		ids[i] = dekui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the DataEncryptionKeyUpdateInputs entity.
+func (dekui *DataEncryptionKeyUpdateInputs) Validate() error {
+	if dekui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return dekui.ValidateWith(dekui.inputConfig.Context, dekui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the DataEncryptionKeyUpdateInputs entity with the given context and client set.
+func (dekui *DataEncryptionKeyUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if dekui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(dekui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.DataEncryptionKeys().Query()
+
+	ids := make([]object.ID, 0, len(dekui.Items))
+
+	for i := range dekui.Items {
+		if dekui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if dekui.Items[i].ID != "" {
+			ids = append(ids, dekui.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(dataencryptionkey.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range dekui.Items {
+		if err := dekui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DataEncryptionKeyOutput holds the output of the DataEncryptionKey entity.
+type DataEncryptionKeyOutput struct {
+	ID                object.ID  `json:"id,omitempty"`
+	CreateTime        *time.Time `json:"createTime,omitempty"`
+	UpdateTime        *time.Time `json:"updateTime,omitempty"`
+	Provider          string     `json:"provider,omitempty"`
+	Active            bool       `json:"active,omitempty"`
+	RotationStatus    string     `json:"rotationStatus,omitempty"`
+	RotationTotal     int        `json:"rotationTotal,omitempty"`
+	RotationProcessed int        `json:"rotationProcessed,omitempty"`
+	RotationMessage   string     `json:"rotationMessage,omitempty"`
+}
+
+// View returns the output of DataEncryptionKey entity.
+func (_dek *DataEncryptionKey) View() *DataEncryptionKeyOutput {
+	return ExposeDataEncryptionKey(_dek)
+}
+
+// View returns the output of DataEncryptionKey entities.
+func (_deks DataEncryptionKeys) View() []*DataEncryptionKeyOutput {
+	return ExposeDataEncryptionKeys(_deks)
+}
+
+// ExposeDataEncryptionKey converts the DataEncryptionKey to DataEncryptionKeyOutput.
+func ExposeDataEncryptionKey(_dek *DataEncryptionKey) *DataEncryptionKeyOutput {
+	if _dek == nil {
+		return nil
+	}
+
+	deko := &DataEncryptionKeyOutput{
+		ID:                _dek.ID,
+		CreateTime:        _dek.CreateTime,
+		UpdateTime:        _dek.UpdateTime,
+		Provider:          _dek.Provider,
+		Active:            _dek.Active,
+		RotationStatus:    _dek.RotationStatus,
+		RotationTotal:     _dek.RotationTotal,
+		RotationProcessed: _dek.RotationProcessed,
+		RotationMessage:   _dek.RotationMessage,
+	}
+
+	return deko
+}
+
+// ExposeDataEncryptionKeys converts the DataEncryptionKey slice to DataEncryptionKeyOutput pointer slice.
+func ExposeDataEncryptionKeys(_deks []*DataEncryptionKey) []*DataEncryptionKeyOutput {
+	if len(_deks) == 0 {
+		return nil
+	}
+
+	dekos := make([]*DataEncryptionKeyOutput, len(_deks))
+	for i := range _deks {
+		dekos[i] = ExposeDataEncryptionKey(_deks[i])
+	}
+	return dekos
+}
@@ -16,9 +16,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
 	"github.com/seal-io/walrus/pkg/dao/model/costreport"
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
 	"github.com/seal-io/walrus/pkg/dao/model/distributelock"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
@@ -31,15 +37,23 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinitionmatchingrule"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/role"
 	"github.com/seal-io/walrus/pkg/dao/model/setting"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
 	"github.com/seal-io/walrus/pkg/dao/model/subject"
 	"github.com/seal-io/walrus/pkg/dao/model/subjectrolerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/token"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
 	"github.com/seal-io/walrus/pkg/dao/model/workflow"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowexecution"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowstage"
@@ -106,9 +120,15 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			auditlog.Table:                         auditlog.ValidColumn,
+			budget.Table:                           budget.ValidColumn,
+			budgetevent.Table:                      budgetevent.ValidColumn,
 			catalog.Table:                          catalog.ValidColumn,
+			chargebackstatement.Table:              chargebackstatement.ValidColumn,
 			connector.Table:                        connector.ValidColumn,
+			costanomaly.Table:                      costanomaly.ValidColumn,
 			costreport.Table:                       costreport.ValidColumn,
+			dataencryptionkey.Table:                dataencryptionkey.ValidColumn,
 			distributelock.Table:                   distributelock.ValidColumn,
 			environment.Table:                      environment.ValidColumn,
 			environmentconnectorrelationship.Table: environmentconnectorrelationship.ValidColumn,
@@ -121,15 +141,23 @@ func checkColumn(table, column string) error {
 			resourcedefinitionmatchingrule.Table:   resourcedefinitionmatchingrule.ValidColumn,
 			resourcerelationship.Table:             resourcerelationship.ValidColumn,
 			resourcerun.Table:                      resourcerun.ValidColumn,
+			resourcerunapprovalrule.Table:          resourcerunapprovalrule.ValidColumn,
+			resourcerunpolicy.Table:                resourcerunpolicy.ValidColumn,
 			resourcestate.Table:                    resourcestate.ValidColumn,
+			resourcestatelock.Table:                resourcestatelock.ValidColumn,
+			resourcestateversion.Table:             resourcestateversion.ValidColumn,
 			role.Table:                             role.ValidColumn,
 			setting.Table:                          setting.ValidColumn,
+			storageobject.Table:                    storageobject.ValidColumn,
 			subject.Table:                          subject.ValidColumn,
 			subjectrolerelationship.Table:          subjectrolerelationship.ValidColumn,
 			template.Table:                         template.ValidColumn,
+			templateupgradecampaign.Table:          templateupgradecampaign.ValidColumn,
 			templateversion.Table:                  templateversion.ValidColumn,
 			token.Table:                            token.ValidColumn,
 			variable.Table:                         variable.ValidColumn,
+			webhook.Table:                          webhook.ValidColumn,
+			webhookdelivery.Table:                  webhookdelivery.ValidColumn,
 			workflow.Table:                         workflow.ValidColumn,
 			workflowexecution.Table:                workflowexecution.ValidColumn,
 			workflowstage.Table:                    workflowstage.ValidColumn,
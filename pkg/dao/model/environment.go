@@ -56,13 +56,21 @@ type EnvironmentEdges struct {
 	Resources []*Resource `json:"resources,omitempty,cli-ignore"`
 	// ResourceRuns that belong to the environment.
 	ResourceRuns []*ResourceRun `json:"resource_runs,omitempty"`
+	// ResourceStateVersions that belong to the environment.
+	ResourceStateVersions []*ResourceStateVersion `json:"resource_state_versions,omitempty"`
 	// ResourceComponents that belong to the environment.
 	ResourceComponents []*ResourceComponent `json:"resource_components,omitempty"`
+	// ResourceRunApprovalRules that apply to the environment.
+	ResourceRunApprovalRules []*ResourceRunApprovalRule `json:"resource_run_approval_rules,omitempty"`
+	// ResourceRunPolicies that attach to the environment.
+	ResourceRunPolicies []*ResourceRunPolicy `json:"resource_run_policies,omitempty"`
+	// Budgets that watch the environment.
+	Budgets []*Budget `json:"budgets,omitempty"`
 	// Variables that belong to the environment.
 	Variables []*Variable `json:"variables,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [10]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -105,19 +113,55 @@ func (e EnvironmentEdges) ResourceRunsOrErr() ([]*ResourceRun, error) {
 	return nil, &NotLoadedError{edge: "resource_runs"}
 }
 
+// ResourceStateVersionsOrErr returns the ResourceStateVersions value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentEdges) ResourceStateVersionsOrErr() ([]*ResourceStateVersion, error) {
+	if e.loadedTypes[4] {
+		return e.ResourceStateVersions, nil
+	}
+	return nil, &NotLoadedError{edge: "resource_state_versions"}
+}
+
 // ResourceComponentsOrErr returns the ResourceComponents value or an error if the edge
 // was not loaded in eager-loading.
 func (e EnvironmentEdges) ResourceComponentsOrErr() ([]*ResourceComponent, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.ResourceComponents, nil
 	}
 	return nil, &NotLoadedError{edge: "resource_components"}
 }
 
+// ResourceRunApprovalRulesOrErr returns the ResourceRunApprovalRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentEdges) ResourceRunApprovalRulesOrErr() ([]*ResourceRunApprovalRule, error) {
+	if e.loadedTypes[6] {
+		return e.ResourceRunApprovalRules, nil
+	}
+	return nil, &NotLoadedError{edge: "resource_run_approval_rules"}
+}
+
+// ResourceRunPoliciesOrErr returns the ResourceRunPolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentEdges) ResourceRunPoliciesOrErr() ([]*ResourceRunPolicy, error) {
+	if e.loadedTypes[7] {
+		return e.ResourceRunPolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "resource_run_policies"}
+}
+
+// BudgetsOrErr returns the Budgets value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentEdges) BudgetsOrErr() ([]*Budget, error) {
+	if e.loadedTypes[8] {
+		return e.Budgets, nil
+	}
+	return nil, &NotLoadedError{edge: "budgets"}
+}
+
 // VariablesOrErr returns the Variables value or an error if the edge
 // was not loaded in eager-loading.
 func (e EnvironmentEdges) VariablesOrErr() ([]*Variable, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[9] {
 		return e.Variables, nil
 	}
 	return nil, &NotLoadedError{edge: "variables"}
@@ -244,11 +288,31 @@ func (e *Environment) QueryResourceRuns() *ResourceRunQuery {
 	return NewEnvironmentClient(e.config).QueryResourceRuns(e)
 }
 
+// QueryResourceStateVersions queries the "resource_state_versions" edge of the Environment entity.
+func (e *Environment) QueryResourceStateVersions() *ResourceStateVersionQuery {
+	return NewEnvironmentClient(e.config).QueryResourceStateVersions(e)
+}
+
 // QueryResourceComponents queries the "resource_components" edge of the Environment entity.
 func (e *Environment) QueryResourceComponents() *ResourceComponentQuery {
 	return NewEnvironmentClient(e.config).QueryResourceComponents(e)
 }
 
+// QueryResourceRunApprovalRules queries the "resource_run_approval_rules" edge of the Environment entity.
+func (e *Environment) QueryResourceRunApprovalRules() *ResourceRunApprovalRuleQuery {
+	return NewEnvironmentClient(e.config).QueryResourceRunApprovalRules(e)
+}
+
+// QueryResourceRunPolicies queries the "resource_run_policies" edge of the Environment entity.
+func (e *Environment) QueryResourceRunPolicies() *ResourceRunPolicyQuery {
+	return NewEnvironmentClient(e.config).QueryResourceRunPolicies(e)
+}
+
+// QueryBudgets queries the "budgets" edge of the Environment entity.
+func (e *Environment) QueryBudgets() *BudgetQuery {
+	return NewEnvironmentClient(e.config).QueryBudgets(e)
+}
+
 // QueryVariables queries the "variables" edge of the Environment entity.
 func (e *Environment) QueryVariables() *VariableQuery {
 	return NewEnvironmentClient(e.config).QueryVariables(e)
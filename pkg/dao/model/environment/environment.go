@@ -43,8 +43,16 @@ const (
 	EdgeResources = "resources"
 	// EdgeResourceRuns holds the string denoting the resource_runs edge name in mutations.
 	EdgeResourceRuns = "resource_runs"
+	// EdgeResourceStateVersions holds the string denoting the resource_state_versions edge name in mutations.
+	EdgeResourceStateVersions = "resource_state_versions"
 	// EdgeResourceComponents holds the string denoting the resource_components edge name in mutations.
 	EdgeResourceComponents = "resource_components"
+	// EdgeResourceRunApprovalRules holds the string denoting the resource_run_approval_rules edge name in mutations.
+	EdgeResourceRunApprovalRules = "resource_run_approval_rules"
+	// EdgeResourceRunPolicies holds the string denoting the resource_run_policies edge name in mutations.
+	EdgeResourceRunPolicies = "resource_run_policies"
+	// EdgeBudgets holds the string denoting the budgets edge name in mutations.
+	EdgeBudgets = "budgets"
 	// EdgeVariables holds the string denoting the variables edge name in mutations.
 	EdgeVariables = "variables"
 	// Table holds the table name of the environment in the database.
@@ -77,6 +85,13 @@ const (
 	ResourceRunsInverseTable = "resource_runs"
 	// ResourceRunsColumn is the table column denoting the resource_runs relation/edge.
 	ResourceRunsColumn = "environment_id"
+	// ResourceStateVersionsTable is the table that holds the resource_state_versions relation/edge.
+	ResourceStateVersionsTable = "resource_state_versions"
+	// ResourceStateVersionsInverseTable is the table name for the ResourceStateVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcestateversion" package.
+	ResourceStateVersionsInverseTable = "resource_state_versions"
+	// ResourceStateVersionsColumn is the table column denoting the resource_state_versions relation/edge.
+	ResourceStateVersionsColumn = "environment_id"
 	// ResourceComponentsTable is the table that holds the resource_components relation/edge.
 	ResourceComponentsTable = "resource_components"
 	// ResourceComponentsInverseTable is the table name for the ResourceComponent entity.
@@ -84,6 +99,27 @@ const (
 	ResourceComponentsInverseTable = "resource_components"
 	// ResourceComponentsColumn is the table column denoting the resource_components relation/edge.
 	ResourceComponentsColumn = "environment_id"
+	// ResourceRunApprovalRulesTable is the table that holds the resource_run_approval_rules relation/edge.
+	ResourceRunApprovalRulesTable = "resource_run_approval_rules"
+	// ResourceRunApprovalRulesInverseTable is the table name for the ResourceRunApprovalRule entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcerunapprovalrule" package.
+	ResourceRunApprovalRulesInverseTable = "resource_run_approval_rules"
+	// ResourceRunApprovalRulesColumn is the table column denoting the resource_run_approval_rules relation/edge.
+	ResourceRunApprovalRulesColumn = "environment_id"
+	// ResourceRunPoliciesTable is the table that holds the resource_run_policies relation/edge.
+	ResourceRunPoliciesTable = "resource_run_policies"
+	// ResourceRunPoliciesInverseTable is the table name for the ResourceRunPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcerunpolicy" package.
+	ResourceRunPoliciesInverseTable = "resource_run_policies"
+	// ResourceRunPoliciesColumn is the table column denoting the resource_run_policies relation/edge.
+	ResourceRunPoliciesColumn = "environment_id"
+	// BudgetsTable is the table that holds the budgets relation/edge.
+	BudgetsTable = "budgets"
+	// BudgetsInverseTable is the table name for the Budget entity.
+	// It exists in this package in order to avoid circular dependency with the "budget" package.
+	BudgetsInverseTable = "budgets"
+	// BudgetsColumn is the table column denoting the budgets relation/edge.
+	BudgetsColumn = "environment_id"
 	// VariablesTable is the table that holds the variables relation/edge.
 	VariablesTable = "variables"
 	// VariablesInverseTable is the table name for the Variable entity.
@@ -229,6 +265,20 @@ func ByResourceRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByResourceStateVersionsCount orders the results by resource_state_versions count.
+func ByResourceStateVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newResourceStateVersionsStep(), opts...)
+	}
+}
+
+// ByResourceStateVersions orders the results by resource_state_versions terms.
+func ByResourceStateVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResourceStateVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByResourceComponentsCount orders the results by resource_components count.
 func ByResourceComponentsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -243,6 +293,48 @@ func ByResourceComponents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 	}
 }
 
+// ByResourceRunApprovalRulesCount orders the results by resource_run_approval_rules count.
+func ByResourceRunApprovalRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newResourceRunApprovalRulesStep(), opts...)
+	}
+}
+
+// ByResourceRunApprovalRules orders the results by resource_run_approval_rules terms.
+func ByResourceRunApprovalRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResourceRunApprovalRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByResourceRunPoliciesCount orders the results by resource_run_policies count.
+func ByResourceRunPoliciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newResourceRunPoliciesStep(), opts...)
+	}
+}
+
+// ByResourceRunPolicies orders the results by resource_run_policies terms.
+func ByResourceRunPolicies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResourceRunPoliciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBudgetsCount orders the results by budgets count.
+func ByBudgetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBudgetsStep(), opts...)
+	}
+}
+
+// ByBudgets orders the results by budgets terms.
+func ByBudgets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByVariablesCount orders the results by variables count.
 func ByVariablesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -284,6 +376,13 @@ func newResourceRunsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunsTable, ResourceRunsColumn),
 	)
 }
+func newResourceStateVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResourceStateVersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ResourceStateVersionsTable, ResourceStateVersionsColumn),
+	)
+}
 func newResourceComponentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -291,6 +390,27 @@ func newResourceComponentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ResourceComponentsTable, ResourceComponentsColumn),
 	)
 }
+func newResourceRunApprovalRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResourceRunApprovalRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunApprovalRulesTable, ResourceRunApprovalRulesColumn),
+	)
+}
+func newResourceRunPoliciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResourceRunPoliciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunPoliciesTable, ResourceRunPoliciesColumn),
+	)
+}
+func newBudgetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BudgetsTable, BudgetsColumn),
+	)
+}
 func newVariablesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
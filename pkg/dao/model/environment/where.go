@@ -582,6 +582,35 @@ func HasResourceRunsWith(preds ...predicate.ResourceRun) predicate.Environment {
 	})
 }
 
+// HasResourceStateVersions applies the HasEdge predicate on the "resource_state_versions" edge.
+func HasResourceStateVersions() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResourceStateVersionsTable, ResourceStateVersionsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceStateVersionsWith applies the HasEdge predicate on the "resource_state_versions" edge with a given conditions (other predicates).
+func HasResourceStateVersionsWith(preds ...predicate.ResourceStateVersion) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newResourceStateVersionsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasResourceComponents applies the HasEdge predicate on the "resource_components" edge.
 func HasResourceComponents() predicate.Environment {
 	return predicate.Environment(func(s *sql.Selector) {
@@ -611,6 +640,93 @@ func HasResourceComponentsWith(preds ...predicate.ResourceComponent) predicate.E
 	})
 }
 
+// HasResourceRunApprovalRules applies the HasEdge predicate on the "resource_run_approval_rules" edge.
+func HasResourceRunApprovalRules() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunApprovalRulesTable, ResourceRunApprovalRulesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRunApprovalRule
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceRunApprovalRulesWith applies the HasEdge predicate on the "resource_run_approval_rules" edge with a given conditions (other predicates).
+func HasResourceRunApprovalRulesWith(preds ...predicate.ResourceRunApprovalRule) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newResourceRunApprovalRulesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRunApprovalRule
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResourceRunPolicies applies the HasEdge predicate on the "resource_run_policies" edge.
+func HasResourceRunPolicies() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunPoliciesTable, ResourceRunPoliciesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRunPolicy
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceRunPoliciesWith applies the HasEdge predicate on the "resource_run_policies" edge with a given conditions (other predicates).
+func HasResourceRunPoliciesWith(preds ...predicate.ResourceRunPolicy) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newResourceRunPoliciesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRunPolicy
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBudgets applies the HasEdge predicate on the "budgets" edge.
+func HasBudgets() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BudgetsTable, BudgetsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.Budget
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetsWith applies the HasEdge predicate on the "budgets" edge with a given conditions (other predicates).
+func HasBudgetsWith(preds ...predicate.Budget) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newBudgetsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.Budget
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasVariables applies the HasEdge predicate on the "variables" edge.
 func HasVariables() predicate.Environment {
 	return predicate.Environment(func(s *sql.Selector) {
@@ -17,12 +17,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/project"
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcecomponent"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
@@ -165,6 +169,21 @@ func (ec *EnvironmentCreate) AddResourceRuns(r ...*ResourceRun) *EnvironmentCrea
 	return ec.AddResourceRunIDs(ids...)
 }
 
+// AddResourceStateVersionIDs adds the "resource_state_versions" edge to the ResourceStateVersion entity by IDs.
+func (ec *EnvironmentCreate) AddResourceStateVersionIDs(ids ...object.ID) *EnvironmentCreate {
+	ec.mutation.AddResourceStateVersionIDs(ids...)
+	return ec
+}
+
+// AddResourceStateVersions adds the "resource_state_versions" edges to the ResourceStateVersion entity.
+func (ec *EnvironmentCreate) AddResourceStateVersions(r ...*ResourceStateVersion) *EnvironmentCreate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddResourceStateVersionIDs(ids...)
+}
+
 // AddResourceComponentIDs adds the "resource_components" edge to the ResourceComponent entity by IDs.
 func (ec *EnvironmentCreate) AddResourceComponentIDs(ids ...object.ID) *EnvironmentCreate {
 	ec.mutation.AddResourceComponentIDs(ids...)
@@ -180,6 +199,51 @@ func (ec *EnvironmentCreate) AddResourceComponents(r ...*ResourceComponent) *Env
 	return ec.AddResourceComponentIDs(ids...)
 }
 
+// AddResourceRunApprovalRuleIDs adds the "resource_run_approval_rules" edge to the ResourceRunApprovalRule entity by IDs.
+func (ec *EnvironmentCreate) AddResourceRunApprovalRuleIDs(ids ...object.ID) *EnvironmentCreate {
+	ec.mutation.AddResourceRunApprovalRuleIDs(ids...)
+	return ec
+}
+
+// AddResourceRunApprovalRules adds the "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (ec *EnvironmentCreate) AddResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *EnvironmentCreate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddResourceRunApprovalRuleIDs(ids...)
+}
+
+// AddResourceRunPolicyIDs adds the "resource_run_policies" edge to the ResourceRunPolicy entity by IDs.
+func (ec *EnvironmentCreate) AddResourceRunPolicyIDs(ids ...object.ID) *EnvironmentCreate {
+	ec.mutation.AddResourceRunPolicyIDs(ids...)
+	return ec
+}
+
+// AddResourceRunPolicies adds the "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (ec *EnvironmentCreate) AddResourceRunPolicies(r ...*ResourceRunPolicy) *EnvironmentCreate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ec.AddResourceRunPolicyIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (ec *EnvironmentCreate) AddBudgetIDs(ids ...object.ID) *EnvironmentCreate {
+	ec.mutation.AddBudgetIDs(ids...)
+	return ec
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (ec *EnvironmentCreate) AddBudgets(b ...*Budget) *EnvironmentCreate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return ec.AddBudgetIDs(ids...)
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (ec *EnvironmentCreate) AddVariableIDs(ids ...object.ID) *EnvironmentCreate {
 	ec.mutation.AddVariableIDs(ids...)
@@ -426,6 +490,23 @@ func (ec *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.ResourceStateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceStateVersionsTable,
+			Columns: []string{environment.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ec.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ec.mutation.ResourceComponentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -443,6 +524,57 @@ func (ec *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ec.mutation.ResourceRunApprovalRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunApprovalRulesTable,
+			Columns: []string{environment.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ec.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.ResourceRunPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunPoliciesTable,
+			Columns: []string{environment.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ec.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ec.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.BudgetsTable,
+			Columns: []string{environment.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ec.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := ec.mutation.VariablesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/internal"
@@ -24,6 +25,9 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcecomponent"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
@@ -31,17 +35,21 @@ import (
 // EnvironmentQuery is the builder for querying Environment entities.
 type EnvironmentQuery struct {
 	config
-	ctx                    *QueryContext
-	order                  []environment.OrderOption
-	inters                 []Interceptor
-	predicates             []predicate.Environment
-	withProject            *ProjectQuery
-	withConnectors         *EnvironmentConnectorRelationshipQuery
-	withResources          *ResourceQuery
-	withResourceRuns       *ResourceRunQuery
-	withResourceComponents *ResourceComponentQuery
-	withVariables          *VariableQuery
-	modifiers              []func(*sql.Selector)
+	ctx                          *QueryContext
+	order                        []environment.OrderOption
+	inters                       []Interceptor
+	predicates                   []predicate.Environment
+	withProject                  *ProjectQuery
+	withConnectors               *EnvironmentConnectorRelationshipQuery
+	withResources                *ResourceQuery
+	withResourceRuns             *ResourceRunQuery
+	withResourceStateVersions    *ResourceStateVersionQuery
+	withResourceComponents       *ResourceComponentQuery
+	withResourceRunApprovalRules *ResourceRunApprovalRuleQuery
+	withResourceRunPolicies      *ResourceRunPolicyQuery
+	withBudgets                  *BudgetQuery
+	withVariables                *VariableQuery
+	modifiers                    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -178,6 +186,31 @@ func (eq *EnvironmentQuery) QueryResourceRuns() *ResourceRunQuery {
 	return query
 }
 
+// QueryResourceStateVersions chains the current query on the "resource_state_versions" edge.
+func (eq *EnvironmentQuery) QueryResourceStateVersions() *ResourceStateVersionQuery {
+	query := (&ResourceStateVersionClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(resourcestateversion.Table, resourcestateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceStateVersionsTable, environment.ResourceStateVersionsColumn),
+		)
+		schemaConfig := eq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryResourceComponents chains the current query on the "resource_components" edge.
 func (eq *EnvironmentQuery) QueryResourceComponents() *ResourceComponentQuery {
 	query := (&ResourceComponentClient{config: eq.config}).Query()
@@ -203,6 +236,81 @@ func (eq *EnvironmentQuery) QueryResourceComponents() *ResourceComponentQuery {
 	return query
 }
 
+// QueryResourceRunApprovalRules chains the current query on the "resource_run_approval_rules" edge.
+func (eq *EnvironmentQuery) QueryResourceRunApprovalRules() *ResourceRunApprovalRuleQuery {
+	query := (&ResourceRunApprovalRuleClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(resourcerunapprovalrule.Table, resourcerunapprovalrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceRunApprovalRulesTable, environment.ResourceRunApprovalRulesColumn),
+		)
+		schemaConfig := eq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRunApprovalRule
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResourceRunPolicies chains the current query on the "resource_run_policies" edge.
+func (eq *EnvironmentQuery) QueryResourceRunPolicies() *ResourceRunPolicyQuery {
+	query := (&ResourceRunPolicyClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(resourcerunpolicy.Table, resourcerunpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.ResourceRunPoliciesTable, environment.ResourceRunPoliciesColumn),
+		)
+		schemaConfig := eq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRunPolicy
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBudgets chains the current query on the "budgets" edge.
+func (eq *EnvironmentQuery) QueryBudgets() *BudgetQuery {
+	query := (&BudgetClient{config: eq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.BudgetsTable, environment.BudgetsColumn),
+		)
+		schemaConfig := eq.schemaConfig
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.Budget
+		fromU = sqlgraph.SetNeighbors(eq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryVariables chains the current query on the "variables" edge.
 func (eq *EnvironmentQuery) QueryVariables() *VariableQuery {
 	query := (&VariableClient{config: eq.config}).Query()
@@ -415,17 +523,21 @@ func (eq *EnvironmentQuery) Clone() *EnvironmentQuery {
 		return nil
 	}
 	return &EnvironmentQuery{
-		config:                 eq.config,
-		ctx:                    eq.ctx.Clone(),
-		order:                  append([]environment.OrderOption{}, eq.order...),
-		inters:                 append([]Interceptor{}, eq.inters...),
-		predicates:             append([]predicate.Environment{}, eq.predicates...),
-		withProject:            eq.withProject.Clone(),
-		withConnectors:         eq.withConnectors.Clone(),
-		withResources:          eq.withResources.Clone(),
-		withResourceRuns:       eq.withResourceRuns.Clone(),
-		withResourceComponents: eq.withResourceComponents.Clone(),
-		withVariables:          eq.withVariables.Clone(),
+		config:                       eq.config,
+		ctx:                          eq.ctx.Clone(),
+		order:                        append([]environment.OrderOption{}, eq.order...),
+		inters:                       append([]Interceptor{}, eq.inters...),
+		predicates:                   append([]predicate.Environment{}, eq.predicates...),
+		withProject:                  eq.withProject.Clone(),
+		withConnectors:               eq.withConnectors.Clone(),
+		withResources:                eq.withResources.Clone(),
+		withResourceRuns:             eq.withResourceRuns.Clone(),
+		withResourceStateVersions:    eq.withResourceStateVersions.Clone(),
+		withResourceComponents:       eq.withResourceComponents.Clone(),
+		withResourceRunApprovalRules: eq.withResourceRunApprovalRules.Clone(),
+		withResourceRunPolicies:      eq.withResourceRunPolicies.Clone(),
+		withBudgets:                  eq.withBudgets.Clone(),
+		withVariables:                eq.withVariables.Clone(),
 		// clone intermediate query.
 		sql:  eq.sql.Clone(),
 		path: eq.path,
@@ -476,6 +588,17 @@ func (eq *EnvironmentQuery) WithResourceRuns(opts ...func(*ResourceRunQuery)) *E
 	return eq
 }
 
+// WithResourceStateVersions tells the query-builder to eager-load the nodes that are connected to
+// the "resource_state_versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvironmentQuery) WithResourceStateVersions(opts ...func(*ResourceStateVersionQuery)) *EnvironmentQuery {
+	query := (&ResourceStateVersionClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withResourceStateVersions = query
+	return eq
+}
+
 // WithResourceComponents tells the query-builder to eager-load the nodes that are connected to
 // the "resource_components" edge. The optional arguments are used to configure the query builder of the edge.
 func (eq *EnvironmentQuery) WithResourceComponents(opts ...func(*ResourceComponentQuery)) *EnvironmentQuery {
@@ -487,6 +610,39 @@ func (eq *EnvironmentQuery) WithResourceComponents(opts ...func(*ResourceCompone
 	return eq
 }
 
+// WithResourceRunApprovalRules tells the query-builder to eager-load the nodes that are connected to
+// the "resource_run_approval_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvironmentQuery) WithResourceRunApprovalRules(opts ...func(*ResourceRunApprovalRuleQuery)) *EnvironmentQuery {
+	query := (&ResourceRunApprovalRuleClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withResourceRunApprovalRules = query
+	return eq
+}
+
+// WithResourceRunPolicies tells the query-builder to eager-load the nodes that are connected to
+// the "resource_run_policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvironmentQuery) WithResourceRunPolicies(opts ...func(*ResourceRunPolicyQuery)) *EnvironmentQuery {
+	query := (&ResourceRunPolicyClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withResourceRunPolicies = query
+	return eq
+}
+
+// WithBudgets tells the query-builder to eager-load the nodes that are connected to
+// the "budgets" edge. The optional arguments are used to configure the query builder of the edge.
+func (eq *EnvironmentQuery) WithBudgets(opts ...func(*BudgetQuery)) *EnvironmentQuery {
+	query := (&BudgetClient{config: eq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eq.withBudgets = query
+	return eq
+}
+
 // WithVariables tells the query-builder to eager-load the nodes that are connected to
 // the "variables" edge. The optional arguments are used to configure the query builder of the edge.
 func (eq *EnvironmentQuery) WithVariables(opts ...func(*VariableQuery)) *EnvironmentQuery {
@@ -576,12 +732,16 @@ func (eq *EnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Environment{}
 		_spec       = eq.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [10]bool{
 			eq.withProject != nil,
 			eq.withConnectors != nil,
 			eq.withResources != nil,
 			eq.withResourceRuns != nil,
+			eq.withResourceStateVersions != nil,
 			eq.withResourceComponents != nil,
+			eq.withResourceRunApprovalRules != nil,
+			eq.withResourceRunPolicies != nil,
+			eq.withBudgets != nil,
 			eq.withVariables != nil,
 		}
 	)
@@ -637,6 +797,15 @@ func (eq *EnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := eq.withResourceStateVersions; query != nil {
+		if err := eq.loadResourceStateVersions(ctx, query, nodes,
+			func(n *Environment) { n.Edges.ResourceStateVersions = []*ResourceStateVersion{} },
+			func(n *Environment, e *ResourceStateVersion) {
+				n.Edges.ResourceStateVersions = append(n.Edges.ResourceStateVersions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := eq.withResourceComponents; query != nil {
 		if err := eq.loadResourceComponents(ctx, query, nodes,
 			func(n *Environment) { n.Edges.ResourceComponents = []*ResourceComponent{} },
@@ -646,6 +815,31 @@ func (eq *EnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := eq.withResourceRunApprovalRules; query != nil {
+		if err := eq.loadResourceRunApprovalRules(ctx, query, nodes,
+			func(n *Environment) { n.Edges.ResourceRunApprovalRules = []*ResourceRunApprovalRule{} },
+			func(n *Environment, e *ResourceRunApprovalRule) {
+				n.Edges.ResourceRunApprovalRules = append(n.Edges.ResourceRunApprovalRules, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withResourceRunPolicies; query != nil {
+		if err := eq.loadResourceRunPolicies(ctx, query, nodes,
+			func(n *Environment) { n.Edges.ResourceRunPolicies = []*ResourceRunPolicy{} },
+			func(n *Environment, e *ResourceRunPolicy) {
+				n.Edges.ResourceRunPolicies = append(n.Edges.ResourceRunPolicies, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := eq.withBudgets; query != nil {
+		if err := eq.loadBudgets(ctx, query, nodes,
+			func(n *Environment) { n.Edges.Budgets = []*Budget{} },
+			func(n *Environment, e *Budget) { n.Edges.Budgets = append(n.Edges.Budgets, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := eq.withVariables; query != nil {
 		if err := eq.loadVariables(ctx, query, nodes,
 			func(n *Environment) { n.Edges.Variables = []*Variable{} },
@@ -775,6 +969,36 @@ func (eq *EnvironmentQuery) loadResourceRuns(ctx context.Context, query *Resourc
 	}
 	return nil
 }
+func (eq *EnvironmentQuery) loadResourceStateVersions(ctx context.Context, query *ResourceStateVersionQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *ResourceStateVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Environment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcestateversion.FieldEnvironmentID)
+	}
+	query.Where(predicate.ResourceStateVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environment.ResourceStateVersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (eq *EnvironmentQuery) loadResourceComponents(ctx context.Context, query *ResourceComponentQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *ResourceComponent)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[object.ID]*Environment)
@@ -805,6 +1029,96 @@ func (eq *EnvironmentQuery) loadResourceComponents(ctx context.Context, query *R
 	}
 	return nil
 }
+func (eq *EnvironmentQuery) loadResourceRunApprovalRules(ctx context.Context, query *ResourceRunApprovalRuleQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *ResourceRunApprovalRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Environment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcerunapprovalrule.FieldEnvironmentID)
+	}
+	query.Where(predicate.ResourceRunApprovalRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environment.ResourceRunApprovalRulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EnvironmentQuery) loadResourceRunPolicies(ctx context.Context, query *ResourceRunPolicyQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *ResourceRunPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Environment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcerunpolicy.FieldEnvironmentID)
+	}
+	query.Where(predicate.ResourceRunPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environment.ResourceRunPoliciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (eq *EnvironmentQuery) loadBudgets(ctx context.Context, query *BudgetQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *Budget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Environment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(budget.FieldEnvironmentID)
+	}
+	query.Where(predicate.Budget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environment.BudgetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (eq *EnvironmentQuery) loadVariables(ctx context.Context, query *VariableQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *Variable)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[object.ID]*Environment)
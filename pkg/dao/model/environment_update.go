@@ -18,6 +18,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/internal"
@@ -25,6 +26,9 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcecomponent"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
@@ -139,6 +143,21 @@ func (eu *EnvironmentUpdate) AddResourceRuns(r ...*ResourceRun) *EnvironmentUpda
 	return eu.AddResourceRunIDs(ids...)
 }
 
+// AddResourceStateVersionIDs adds the "resource_state_versions" edge to the ResourceStateVersion entity by IDs.
+func (eu *EnvironmentUpdate) AddResourceStateVersionIDs(ids ...object.ID) *EnvironmentUpdate {
+	eu.mutation.AddResourceStateVersionIDs(ids...)
+	return eu
+}
+
+// AddResourceStateVersions adds the "resource_state_versions" edges to the ResourceStateVersion entity.
+func (eu *EnvironmentUpdate) AddResourceStateVersions(r ...*ResourceStateVersion) *EnvironmentUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddResourceStateVersionIDs(ids...)
+}
+
 // AddResourceComponentIDs adds the "resource_components" edge to the ResourceComponent entity by IDs.
 func (eu *EnvironmentUpdate) AddResourceComponentIDs(ids ...object.ID) *EnvironmentUpdate {
 	eu.mutation.AddResourceComponentIDs(ids...)
@@ -154,6 +173,51 @@ func (eu *EnvironmentUpdate) AddResourceComponents(r ...*ResourceComponent) *Env
 	return eu.AddResourceComponentIDs(ids...)
 }
 
+// AddResourceRunApprovalRuleIDs adds the "resource_run_approval_rules" edge to the ResourceRunApprovalRule entity by IDs.
+func (eu *EnvironmentUpdate) AddResourceRunApprovalRuleIDs(ids ...object.ID) *EnvironmentUpdate {
+	eu.mutation.AddResourceRunApprovalRuleIDs(ids...)
+	return eu
+}
+
+// AddResourceRunApprovalRules adds the "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (eu *EnvironmentUpdate) AddResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *EnvironmentUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddResourceRunApprovalRuleIDs(ids...)
+}
+
+// AddResourceRunPolicyIDs adds the "resource_run_policies" edge to the ResourceRunPolicy entity by IDs.
+func (eu *EnvironmentUpdate) AddResourceRunPolicyIDs(ids ...object.ID) *EnvironmentUpdate {
+	eu.mutation.AddResourceRunPolicyIDs(ids...)
+	return eu
+}
+
+// AddResourceRunPolicies adds the "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (eu *EnvironmentUpdate) AddResourceRunPolicies(r ...*ResourceRunPolicy) *EnvironmentUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.AddResourceRunPolicyIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (eu *EnvironmentUpdate) AddBudgetIDs(ids ...object.ID) *EnvironmentUpdate {
+	eu.mutation.AddBudgetIDs(ids...)
+	return eu
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (eu *EnvironmentUpdate) AddBudgets(b ...*Budget) *EnvironmentUpdate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return eu.AddBudgetIDs(ids...)
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (eu *EnvironmentUpdate) AddVariableIDs(ids ...object.ID) *EnvironmentUpdate {
 	eu.mutation.AddVariableIDs(ids...)
@@ -237,6 +301,27 @@ func (eu *EnvironmentUpdate) RemoveResourceRuns(r ...*ResourceRun) *EnvironmentU
 	return eu.RemoveResourceRunIDs(ids...)
 }
 
+// ClearResourceStateVersions clears all "resource_state_versions" edges to the ResourceStateVersion entity.
+func (eu *EnvironmentUpdate) ClearResourceStateVersions() *EnvironmentUpdate {
+	eu.mutation.ClearResourceStateVersions()
+	return eu
+}
+
+// RemoveResourceStateVersionIDs removes the "resource_state_versions" edge to ResourceStateVersion entities by IDs.
+func (eu *EnvironmentUpdate) RemoveResourceStateVersionIDs(ids ...object.ID) *EnvironmentUpdate {
+	eu.mutation.RemoveResourceStateVersionIDs(ids...)
+	return eu
+}
+
+// RemoveResourceStateVersions removes "resource_state_versions" edges to ResourceStateVersion entities.
+func (eu *EnvironmentUpdate) RemoveResourceStateVersions(r ...*ResourceStateVersion) *EnvironmentUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveResourceStateVersionIDs(ids...)
+}
+
 // ClearResourceComponents clears all "resource_components" edges to the ResourceComponent entity.
 func (eu *EnvironmentUpdate) ClearResourceComponents() *EnvironmentUpdate {
 	eu.mutation.ClearResourceComponents()
@@ -258,6 +343,69 @@ func (eu *EnvironmentUpdate) RemoveResourceComponents(r ...*ResourceComponent) *
 	return eu.RemoveResourceComponentIDs(ids...)
 }
 
+// ClearResourceRunApprovalRules clears all "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (eu *EnvironmentUpdate) ClearResourceRunApprovalRules() *EnvironmentUpdate {
+	eu.mutation.ClearResourceRunApprovalRules()
+	return eu
+}
+
+// RemoveResourceRunApprovalRuleIDs removes the "resource_run_approval_rules" edge to ResourceRunApprovalRule entities by IDs.
+func (eu *EnvironmentUpdate) RemoveResourceRunApprovalRuleIDs(ids ...object.ID) *EnvironmentUpdate {
+	eu.mutation.RemoveResourceRunApprovalRuleIDs(ids...)
+	return eu
+}
+
+// RemoveResourceRunApprovalRules removes "resource_run_approval_rules" edges to ResourceRunApprovalRule entities.
+func (eu *EnvironmentUpdate) RemoveResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *EnvironmentUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveResourceRunApprovalRuleIDs(ids...)
+}
+
+// ClearResourceRunPolicies clears all "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (eu *EnvironmentUpdate) ClearResourceRunPolicies() *EnvironmentUpdate {
+	eu.mutation.ClearResourceRunPolicies()
+	return eu
+}
+
+// RemoveResourceRunPolicyIDs removes the "resource_run_policies" edge to ResourceRunPolicy entities by IDs.
+func (eu *EnvironmentUpdate) RemoveResourceRunPolicyIDs(ids ...object.ID) *EnvironmentUpdate {
+	eu.mutation.RemoveResourceRunPolicyIDs(ids...)
+	return eu
+}
+
+// RemoveResourceRunPolicies removes "resource_run_policies" edges to ResourceRunPolicy entities.
+func (eu *EnvironmentUpdate) RemoveResourceRunPolicies(r ...*ResourceRunPolicy) *EnvironmentUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return eu.RemoveResourceRunPolicyIDs(ids...)
+}
+
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (eu *EnvironmentUpdate) ClearBudgets() *EnvironmentUpdate {
+	eu.mutation.ClearBudgets()
+	return eu
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (eu *EnvironmentUpdate) RemoveBudgetIDs(ids ...object.ID) *EnvironmentUpdate {
+	eu.mutation.RemoveBudgetIDs(ids...)
+	return eu
+}
+
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (eu *EnvironmentUpdate) RemoveBudgets(b ...*Budget) *EnvironmentUpdate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return eu.RemoveBudgetIDs(ids...)
+}
+
 // ClearVariables clears all "variables" edges to the Variable entity.
 func (eu *EnvironmentUpdate) ClearVariables() *EnvironmentUpdate {
 	eu.mutation.ClearVariables()
@@ -568,6 +716,54 @@ func (eu *EnvironmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if eu.mutation.ResourceStateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceStateVersionsTable,
+			Columns: []string{environment.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceStateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedResourceStateVersionsIDs(); len(nodes) > 0 && !eu.mutation.ResourceStateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceStateVersionsTable,
+			Columns: []string{environment.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ResourceStateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceStateVersionsTable,
+			Columns: []string{environment.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if eu.mutation.ResourceComponentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -616,6 +812,150 @@ func (eu *EnvironmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if eu.mutation.ResourceRunApprovalRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunApprovalRulesTable,
+			Columns: []string{environment.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceRunApprovalRule
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedResourceRunApprovalRulesIDs(); len(nodes) > 0 && !eu.mutation.ResourceRunApprovalRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunApprovalRulesTable,
+			Columns: []string{environment.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ResourceRunApprovalRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunApprovalRulesTable,
+			Columns: []string{environment.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.ResourceRunPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunPoliciesTable,
+			Columns: []string{environment.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceRunPolicy
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedResourceRunPoliciesIDs(); len(nodes) > 0 && !eu.mutation.ResourceRunPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunPoliciesTable,
+			Columns: []string{environment.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.ResourceRunPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunPoliciesTable,
+			Columns: []string{environment.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if eu.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.BudgetsTable,
+			Columns: []string{environment.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.Budget
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !eu.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.BudgetsTable,
+			Columns: []string{environment.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.BudgetsTable,
+			Columns: []string{environment.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = eu.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if eu.mutation.VariablesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -784,6 +1124,21 @@ func (euo *EnvironmentUpdateOne) AddResourceRuns(r ...*ResourceRun) *Environment
 	return euo.AddResourceRunIDs(ids...)
 }
 
+// AddResourceStateVersionIDs adds the "resource_state_versions" edge to the ResourceStateVersion entity by IDs.
+func (euo *EnvironmentUpdateOne) AddResourceStateVersionIDs(ids ...object.ID) *EnvironmentUpdateOne {
+	euo.mutation.AddResourceStateVersionIDs(ids...)
+	return euo
+}
+
+// AddResourceStateVersions adds the "resource_state_versions" edges to the ResourceStateVersion entity.
+func (euo *EnvironmentUpdateOne) AddResourceStateVersions(r ...*ResourceStateVersion) *EnvironmentUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddResourceStateVersionIDs(ids...)
+}
+
 // AddResourceComponentIDs adds the "resource_components" edge to the ResourceComponent entity by IDs.
 func (euo *EnvironmentUpdateOne) AddResourceComponentIDs(ids ...object.ID) *EnvironmentUpdateOne {
 	euo.mutation.AddResourceComponentIDs(ids...)
@@ -799,6 +1154,51 @@ func (euo *EnvironmentUpdateOne) AddResourceComponents(r ...*ResourceComponent)
 	return euo.AddResourceComponentIDs(ids...)
 }
 
+// AddResourceRunApprovalRuleIDs adds the "resource_run_approval_rules" edge to the ResourceRunApprovalRule entity by IDs.
+func (euo *EnvironmentUpdateOne) AddResourceRunApprovalRuleIDs(ids ...object.ID) *EnvironmentUpdateOne {
+	euo.mutation.AddResourceRunApprovalRuleIDs(ids...)
+	return euo
+}
+
+// AddResourceRunApprovalRules adds the "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (euo *EnvironmentUpdateOne) AddResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *EnvironmentUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddResourceRunApprovalRuleIDs(ids...)
+}
+
+// AddResourceRunPolicyIDs adds the "resource_run_policies" edge to the ResourceRunPolicy entity by IDs.
+func (euo *EnvironmentUpdateOne) AddResourceRunPolicyIDs(ids ...object.ID) *EnvironmentUpdateOne {
+	euo.mutation.AddResourceRunPolicyIDs(ids...)
+	return euo
+}
+
+// AddResourceRunPolicies adds the "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (euo *EnvironmentUpdateOne) AddResourceRunPolicies(r ...*ResourceRunPolicy) *EnvironmentUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.AddResourceRunPolicyIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (euo *EnvironmentUpdateOne) AddBudgetIDs(ids ...object.ID) *EnvironmentUpdateOne {
+	euo.mutation.AddBudgetIDs(ids...)
+	return euo
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (euo *EnvironmentUpdateOne) AddBudgets(b ...*Budget) *EnvironmentUpdateOne {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return euo.AddBudgetIDs(ids...)
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (euo *EnvironmentUpdateOne) AddVariableIDs(ids ...object.ID) *EnvironmentUpdateOne {
 	euo.mutation.AddVariableIDs(ids...)
@@ -882,6 +1282,27 @@ func (euo *EnvironmentUpdateOne) RemoveResourceRuns(r ...*ResourceRun) *Environm
 	return euo.RemoveResourceRunIDs(ids...)
 }
 
+// ClearResourceStateVersions clears all "resource_state_versions" edges to the ResourceStateVersion entity.
+func (euo *EnvironmentUpdateOne) ClearResourceStateVersions() *EnvironmentUpdateOne {
+	euo.mutation.ClearResourceStateVersions()
+	return euo
+}
+
+// RemoveResourceStateVersionIDs removes the "resource_state_versions" edge to ResourceStateVersion entities by IDs.
+func (euo *EnvironmentUpdateOne) RemoveResourceStateVersionIDs(ids ...object.ID) *EnvironmentUpdateOne {
+	euo.mutation.RemoveResourceStateVersionIDs(ids...)
+	return euo
+}
+
+// RemoveResourceStateVersions removes "resource_state_versions" edges to ResourceStateVersion entities.
+func (euo *EnvironmentUpdateOne) RemoveResourceStateVersions(r ...*ResourceStateVersion) *EnvironmentUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveResourceStateVersionIDs(ids...)
+}
+
 // ClearResourceComponents clears all "resource_components" edges to the ResourceComponent entity.
 func (euo *EnvironmentUpdateOne) ClearResourceComponents() *EnvironmentUpdateOne {
 	euo.mutation.ClearResourceComponents()
@@ -903,6 +1324,69 @@ func (euo *EnvironmentUpdateOne) RemoveResourceComponents(r ...*ResourceComponen
 	return euo.RemoveResourceComponentIDs(ids...)
 }
 
+// ClearResourceRunApprovalRules clears all "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (euo *EnvironmentUpdateOne) ClearResourceRunApprovalRules() *EnvironmentUpdateOne {
+	euo.mutation.ClearResourceRunApprovalRules()
+	return euo
+}
+
+// RemoveResourceRunApprovalRuleIDs removes the "resource_run_approval_rules" edge to ResourceRunApprovalRule entities by IDs.
+func (euo *EnvironmentUpdateOne) RemoveResourceRunApprovalRuleIDs(ids ...object.ID) *EnvironmentUpdateOne {
+	euo.mutation.RemoveResourceRunApprovalRuleIDs(ids...)
+	return euo
+}
+
+// RemoveResourceRunApprovalRules removes "resource_run_approval_rules" edges to ResourceRunApprovalRule entities.
+func (euo *EnvironmentUpdateOne) RemoveResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *EnvironmentUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveResourceRunApprovalRuleIDs(ids...)
+}
+
+// ClearResourceRunPolicies clears all "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (euo *EnvironmentUpdateOne) ClearResourceRunPolicies() *EnvironmentUpdateOne {
+	euo.mutation.ClearResourceRunPolicies()
+	return euo
+}
+
+// RemoveResourceRunPolicyIDs removes the "resource_run_policies" edge to ResourceRunPolicy entities by IDs.
+func (euo *EnvironmentUpdateOne) RemoveResourceRunPolicyIDs(ids ...object.ID) *EnvironmentUpdateOne {
+	euo.mutation.RemoveResourceRunPolicyIDs(ids...)
+	return euo
+}
+
+// RemoveResourceRunPolicies removes "resource_run_policies" edges to ResourceRunPolicy entities.
+func (euo *EnvironmentUpdateOne) RemoveResourceRunPolicies(r ...*ResourceRunPolicy) *EnvironmentUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return euo.RemoveResourceRunPolicyIDs(ids...)
+}
+
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (euo *EnvironmentUpdateOne) ClearBudgets() *EnvironmentUpdateOne {
+	euo.mutation.ClearBudgets()
+	return euo
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (euo *EnvironmentUpdateOne) RemoveBudgetIDs(ids ...object.ID) *EnvironmentUpdateOne {
+	euo.mutation.RemoveBudgetIDs(ids...)
+	return euo
+}
+
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (euo *EnvironmentUpdateOne) RemoveBudgets(b ...*Budget) *EnvironmentUpdateOne {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return euo.RemoveBudgetIDs(ids...)
+}
+
 // ClearVariables clears all "variables" edges to the Variable entity.
 func (euo *EnvironmentUpdateOne) ClearVariables() *EnvironmentUpdateOne {
 	euo.mutation.ClearVariables()
@@ -1341,6 +1825,54 @@ func (euo *EnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *Environmen
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if euo.mutation.ResourceStateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceStateVersionsTable,
+			Columns: []string{environment.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceStateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedResourceStateVersionsIDs(); len(nodes) > 0 && !euo.mutation.ResourceStateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceStateVersionsTable,
+			Columns: []string{environment.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ResourceStateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceStateVersionsTable,
+			Columns: []string{environment.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if euo.mutation.ResourceComponentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1389,6 +1921,150 @@ func (euo *EnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *Environmen
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if euo.mutation.ResourceRunApprovalRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunApprovalRulesTable,
+			Columns: []string{environment.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceRunApprovalRule
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedResourceRunApprovalRulesIDs(); len(nodes) > 0 && !euo.mutation.ResourceRunApprovalRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunApprovalRulesTable,
+			Columns: []string{environment.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ResourceRunApprovalRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunApprovalRulesTable,
+			Columns: []string{environment.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.ResourceRunPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunPoliciesTable,
+			Columns: []string{environment.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceRunPolicy
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedResourceRunPoliciesIDs(); len(nodes) > 0 && !euo.mutation.ResourceRunPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunPoliciesTable,
+			Columns: []string{environment.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.ResourceRunPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.ResourceRunPoliciesTable,
+			Columns: []string{environment.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if euo.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.BudgetsTable,
+			Columns: []string{environment.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.Budget
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !euo.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.BudgetsTable,
+			Columns: []string{environment.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.BudgetsTable,
+			Columns: []string{environment.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = euo.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if euo.mutation.VariablesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
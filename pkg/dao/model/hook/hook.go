@@ -12,6 +12,42 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model"
 )
 
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *model.AuditLogMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.AuditLogMutation", m)
+}
+
+// The BudgetFunc type is an adapter to allow the use of ordinary
+// function as Budget mutator.
+type BudgetFunc func(context.Context, *model.BudgetMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BudgetFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.BudgetMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.BudgetMutation", m)
+}
+
+// The BudgetEventFunc type is an adapter to allow the use of ordinary
+// function as BudgetEvent mutator.
+type BudgetEventFunc func(context.Context, *model.BudgetEventMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BudgetEventFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.BudgetEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.BudgetEventMutation", m)
+}
+
 // The CatalogFunc type is an adapter to allow the use of ordinary
 // function as Catalog mutator.
 type CatalogFunc func(context.Context, *model.CatalogMutation) (model.Value, error)
@@ -24,6 +60,18 @@ func (f CatalogFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.CatalogMutation", m)
 }
 
+// The ChargebackStatementFunc type is an adapter to allow the use of ordinary
+// function as ChargebackStatement mutator.
+type ChargebackStatementFunc func(context.Context, *model.ChargebackStatementMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ChargebackStatementFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.ChargebackStatementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.ChargebackStatementMutation", m)
+}
+
 // The ConnectorFunc type is an adapter to allow the use of ordinary
 // function as Connector mutator.
 type ConnectorFunc func(context.Context, *model.ConnectorMutation) (model.Value, error)
@@ -36,6 +84,18 @@ func (f ConnectorFunc) Mutate(ctx context.Context, m model.Mutation) (model.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.ConnectorMutation", m)
 }
 
+// The CostAnomalyFunc type is an adapter to allow the use of ordinary
+// function as CostAnomaly mutator.
+type CostAnomalyFunc func(context.Context, *model.CostAnomalyMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CostAnomalyFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.CostAnomalyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.CostAnomalyMutation", m)
+}
+
 // The CostReportFunc type is an adapter to allow the use of ordinary
 // function as CostReport mutator.
 type CostReportFunc func(context.Context, *model.CostReportMutation) (model.Value, error)
@@ -48,6 +108,18 @@ func (f CostReportFunc) Mutate(ctx context.Context, m model.Mutation) (model.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.CostReportMutation", m)
 }
 
+// The DataEncryptionKeyFunc type is an adapter to allow the use of ordinary
+// function as DataEncryptionKey mutator.
+type DataEncryptionKeyFunc func(context.Context, *model.DataEncryptionKeyMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataEncryptionKeyFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.DataEncryptionKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.DataEncryptionKeyMutation", m)
+}
+
 // The DistributeLockFunc type is an adapter to allow the use of ordinary
 // function as DistributeLock mutator.
 type DistributeLockFunc func(context.Context, *model.DistributeLockMutation) (model.Value, error)
@@ -192,6 +264,30 @@ func (f ResourceRunFunc) Mutate(ctx context.Context, m model.Mutation) (model.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.ResourceRunMutation", m)
 }
 
+// The ResourceRunApprovalRuleFunc type is an adapter to allow the use of ordinary
+// function as ResourceRunApprovalRule mutator.
+type ResourceRunApprovalRuleFunc func(context.Context, *model.ResourceRunApprovalRuleMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResourceRunApprovalRuleFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.ResourceRunApprovalRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.ResourceRunApprovalRuleMutation", m)
+}
+
+// The ResourceRunPolicyFunc type is an adapter to allow the use of ordinary
+// function as ResourceRunPolicy mutator.
+type ResourceRunPolicyFunc func(context.Context, *model.ResourceRunPolicyMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResourceRunPolicyFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.ResourceRunPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.ResourceRunPolicyMutation", m)
+}
+
 // The ResourceStateFunc type is an adapter to allow the use of ordinary
 // function as ResourceState mutator.
 type ResourceStateFunc func(context.Context, *model.ResourceStateMutation) (model.Value, error)
@@ -204,6 +300,30 @@ func (f ResourceStateFunc) Mutate(ctx context.Context, m model.Mutation) (model.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.ResourceStateMutation", m)
 }
 
+// The ResourceStateLockFunc type is an adapter to allow the use of ordinary
+// function as ResourceStateLock mutator.
+type ResourceStateLockFunc func(context.Context, *model.ResourceStateLockMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResourceStateLockFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.ResourceStateLockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.ResourceStateLockMutation", m)
+}
+
+// The ResourceStateVersionFunc type is an adapter to allow the use of ordinary
+// function as ResourceStateVersion mutator.
+type ResourceStateVersionFunc func(context.Context, *model.ResourceStateVersionMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResourceStateVersionFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.ResourceStateVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.ResourceStateVersionMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *model.RoleMutation) (model.Value, error)
@@ -228,6 +348,18 @@ func (f SettingFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.SettingMutation", m)
 }
 
+// The StorageObjectFunc type is an adapter to allow the use of ordinary
+// function as StorageObject mutator.
+type StorageObjectFunc func(context.Context, *model.StorageObjectMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StorageObjectFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.StorageObjectMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.StorageObjectMutation", m)
+}
+
 // The SubjectFunc type is an adapter to allow the use of ordinary
 // function as Subject mutator.
 type SubjectFunc func(context.Context, *model.SubjectMutation) (model.Value, error)
@@ -264,6 +396,18 @@ func (f TemplateFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.TemplateMutation", m)
 }
 
+// The TemplateUpgradeCampaignFunc type is an adapter to allow the use of ordinary
+// function as TemplateUpgradeCampaign mutator.
+type TemplateUpgradeCampaignFunc func(context.Context, *model.TemplateUpgradeCampaignMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TemplateUpgradeCampaignFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.TemplateUpgradeCampaignMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.TemplateUpgradeCampaignMutation", m)
+}
+
 // The TemplateVersionFunc type is an adapter to allow the use of ordinary
 // function as TemplateVersion mutator.
 type TemplateVersionFunc func(context.Context, *model.TemplateVersionMutation) (model.Value, error)
@@ -300,6 +444,30 @@ func (f VariableFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.VariableMutation", m)
 }
 
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *model.WebhookMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.WebhookMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *model.WebhookDeliveryMutation) (model.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m model.Mutation) (model.Value, error) {
+	if mv, ok := m.(*model.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *model.WebhookDeliveryMutation", m)
+}
+
 // The WorkflowFunc type is an adapter to allow the use of ordinary
 // function as Workflow mutator.
 type WorkflowFunc func(context.Context, *model.WorkflowMutation) (model.Value, error)
@@ -12,9 +12,15 @@ import (
 	"entgo.io/ent/dialect/sql"
 
 	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
 	"github.com/seal-io/walrus/pkg/dao/model/costreport"
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
 	"github.com/seal-io/walrus/pkg/dao/model/distributelock"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
@@ -28,15 +34,23 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinitionmatchingrule"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/role"
 	"github.com/seal-io/walrus/pkg/dao/model/setting"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
 	"github.com/seal-io/walrus/pkg/dao/model/subject"
 	"github.com/seal-io/walrus/pkg/dao/model/subjectrolerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/token"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
 	"github.com/seal-io/walrus/pkg/dao/model/workflow"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowexecution"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowstage"
@@ -101,6 +115,87 @@ func (f TraverseFunc) Traverse(ctx context.Context, q model.Query) error {
 	return f(ctx, query)
 }
 
+// The AuditLogFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditLogFunc func(context.Context, *model.AuditLogQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditLogFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.AuditLogQuery", q)
+}
+
+// The TraverseAuditLog type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditLog func(context.Context, *model.AuditLogQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditLog) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditLog) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.AuditLogQuery", q)
+}
+
+// The BudgetFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BudgetFunc func(context.Context, *model.BudgetQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f BudgetFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.BudgetQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.BudgetQuery", q)
+}
+
+// The TraverseBudget type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBudget func(context.Context, *model.BudgetQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBudget) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBudget) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.BudgetQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.BudgetQuery", q)
+}
+
+// The BudgetEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BudgetEventFunc func(context.Context, *model.BudgetEventQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f BudgetEventFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.BudgetEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.BudgetEventQuery", q)
+}
+
+// The TraverseBudgetEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBudgetEvent func(context.Context, *model.BudgetEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBudgetEvent) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBudgetEvent) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.BudgetEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.BudgetEventQuery", q)
+}
+
 // The CatalogFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CatalogFunc func(context.Context, *model.CatalogQuery) (model.Value, error)
 
@@ -128,6 +223,33 @@ func (f TraverseCatalog) Traverse(ctx context.Context, q model.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *model.CatalogQuery", q)
 }
 
+// The ChargebackStatementFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ChargebackStatementFunc func(context.Context, *model.ChargebackStatementQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f ChargebackStatementFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.ChargebackStatementQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.ChargebackStatementQuery", q)
+}
+
+// The TraverseChargebackStatement type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseChargebackStatement func(context.Context, *model.ChargebackStatementQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseChargebackStatement) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseChargebackStatement) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.ChargebackStatementQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.ChargebackStatementQuery", q)
+}
+
 // The ConnectorFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ConnectorFunc func(context.Context, *model.ConnectorQuery) (model.Value, error)
 
@@ -155,6 +277,33 @@ func (f TraverseConnector) Traverse(ctx context.Context, q model.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *model.ConnectorQuery", q)
 }
 
+// The CostAnomalyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CostAnomalyFunc func(context.Context, *model.CostAnomalyQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f CostAnomalyFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.CostAnomalyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.CostAnomalyQuery", q)
+}
+
+// The TraverseCostAnomaly type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCostAnomaly func(context.Context, *model.CostAnomalyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCostAnomaly) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCostAnomaly) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.CostAnomalyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.CostAnomalyQuery", q)
+}
+
 // The CostReportFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CostReportFunc func(context.Context, *model.CostReportQuery) (model.Value, error)
 
@@ -182,6 +331,33 @@ func (f TraverseCostReport) Traverse(ctx context.Context, q model.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *model.CostReportQuery", q)
 }
 
+// The DataEncryptionKeyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DataEncryptionKeyFunc func(context.Context, *model.DataEncryptionKeyQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f DataEncryptionKeyFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.DataEncryptionKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.DataEncryptionKeyQuery", q)
+}
+
+// The TraverseDataEncryptionKey type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDataEncryptionKey func(context.Context, *model.DataEncryptionKeyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDataEncryptionKey) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDataEncryptionKey) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.DataEncryptionKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.DataEncryptionKeyQuery", q)
+}
+
 // The DistributeLockFunc type is an adapter to allow the use of ordinary function as a Querier.
 type DistributeLockFunc func(context.Context, *model.DistributeLockQuery) (model.Value, error)
 
@@ -506,6 +682,60 @@ func (f TraverseResourceRun) Traverse(ctx context.Context, q model.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *model.ResourceRunQuery", q)
 }
 
+// The ResourceRunApprovalRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResourceRunApprovalRuleFunc func(context.Context, *model.ResourceRunApprovalRuleQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResourceRunApprovalRuleFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.ResourceRunApprovalRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.ResourceRunApprovalRuleQuery", q)
+}
+
+// The TraverseResourceRunApprovalRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResourceRunApprovalRule func(context.Context, *model.ResourceRunApprovalRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResourceRunApprovalRule) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResourceRunApprovalRule) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.ResourceRunApprovalRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.ResourceRunApprovalRuleQuery", q)
+}
+
+// The ResourceRunPolicyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResourceRunPolicyFunc func(context.Context, *model.ResourceRunPolicyQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResourceRunPolicyFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.ResourceRunPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.ResourceRunPolicyQuery", q)
+}
+
+// The TraverseResourceRunPolicy type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResourceRunPolicy func(context.Context, *model.ResourceRunPolicyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResourceRunPolicy) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResourceRunPolicy) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.ResourceRunPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.ResourceRunPolicyQuery", q)
+}
+
 // The ResourceStateFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ResourceStateFunc func(context.Context, *model.ResourceStateQuery) (model.Value, error)
 
@@ -533,6 +763,60 @@ func (f TraverseResourceState) Traverse(ctx context.Context, q model.Query) erro
 	return fmt.Errorf("unexpected query type %T. expect *model.ResourceStateQuery", q)
 }
 
+// The ResourceStateLockFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResourceStateLockFunc func(context.Context, *model.ResourceStateLockQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResourceStateLockFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.ResourceStateLockQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.ResourceStateLockQuery", q)
+}
+
+// The TraverseResourceStateLock type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResourceStateLock func(context.Context, *model.ResourceStateLockQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResourceStateLock) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResourceStateLock) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.ResourceStateLockQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.ResourceStateLockQuery", q)
+}
+
+// The ResourceStateVersionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResourceStateVersionFunc func(context.Context, *model.ResourceStateVersionQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResourceStateVersionFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.ResourceStateVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.ResourceStateVersionQuery", q)
+}
+
+// The TraverseResourceStateVersion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResourceStateVersion func(context.Context, *model.ResourceStateVersionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResourceStateVersion) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResourceStateVersion) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.ResourceStateVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.ResourceStateVersionQuery", q)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RoleFunc func(context.Context, *model.RoleQuery) (model.Value, error)
 
@@ -587,6 +871,33 @@ func (f TraverseSetting) Traverse(ctx context.Context, q model.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *model.SettingQuery", q)
 }
 
+// The StorageObjectFunc type is an adapter to allow the use of ordinary function as a Querier.
+type StorageObjectFunc func(context.Context, *model.StorageObjectQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f StorageObjectFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.StorageObjectQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.StorageObjectQuery", q)
+}
+
+// The TraverseStorageObject type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseStorageObject func(context.Context, *model.StorageObjectQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseStorageObject) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseStorageObject) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.StorageObjectQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.StorageObjectQuery", q)
+}
+
 // The SubjectFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SubjectFunc func(context.Context, *model.SubjectQuery) (model.Value, error)
 
@@ -668,6 +979,33 @@ func (f TraverseTemplate) Traverse(ctx context.Context, q model.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *model.TemplateQuery", q)
 }
 
+// The TemplateUpgradeCampaignFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TemplateUpgradeCampaignFunc func(context.Context, *model.TemplateUpgradeCampaignQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f TemplateUpgradeCampaignFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.TemplateUpgradeCampaignQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.TemplateUpgradeCampaignQuery", q)
+}
+
+// The TraverseTemplateUpgradeCampaign type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTemplateUpgradeCampaign func(context.Context, *model.TemplateUpgradeCampaignQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTemplateUpgradeCampaign) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTemplateUpgradeCampaign) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.TemplateUpgradeCampaignQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.TemplateUpgradeCampaignQuery", q)
+}
+
 // The TemplateVersionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TemplateVersionFunc func(context.Context, *model.TemplateVersionQuery) (model.Value, error)
 
@@ -749,6 +1087,60 @@ func (f TraverseVariable) Traverse(ctx context.Context, q model.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *model.VariableQuery", q)
 }
 
+// The WebhookFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookFunc func(context.Context, *model.WebhookQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.WebhookQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.WebhookQuery", q)
+}
+
+// The TraverseWebhook type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhook func(context.Context, *model.WebhookQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhook) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhook) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.WebhookQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.WebhookQuery", q)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookDeliveryFunc func(context.Context, *model.WebhookDeliveryQuery) (model.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookDeliveryFunc) Query(ctx context.Context, q model.Query) (model.Value, error) {
+	if q, ok := q.(*model.WebhookDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *model.WebhookDeliveryQuery", q)
+}
+
+// The TraverseWebhookDelivery type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookDelivery func(context.Context, *model.WebhookDeliveryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookDelivery) Intercept(next model.Querier) model.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookDelivery) Traverse(ctx context.Context, q model.Query) error {
+	if q, ok := q.(*model.WebhookDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *model.WebhookDeliveryQuery", q)
+}
+
 // The WorkflowFunc type is an adapter to allow the use of ordinary function as a Querier.
 type WorkflowFunc func(context.Context, *model.WorkflowQuery) (model.Value, error)
 
@@ -914,12 +1306,24 @@ func (f TraverseWorkflowStepExecution) Traverse(ctx context.Context, q model.Que
 // NewQuery returns the generic Query interface for the given typed query.
 func NewQuery(q model.Query) (Query, error) {
 	switch q := q.(type) {
+	case *model.AuditLogQuery:
+		return &query[*model.AuditLogQuery, predicate.AuditLog, auditlog.OrderOption]{typ: model.TypeAuditLog, tq: q}, nil
+	case *model.BudgetQuery:
+		return &query[*model.BudgetQuery, predicate.Budget, budget.OrderOption]{typ: model.TypeBudget, tq: q}, nil
+	case *model.BudgetEventQuery:
+		return &query[*model.BudgetEventQuery, predicate.BudgetEvent, budgetevent.OrderOption]{typ: model.TypeBudgetEvent, tq: q}, nil
 	case *model.CatalogQuery:
 		return &query[*model.CatalogQuery, predicate.Catalog, catalog.OrderOption]{typ: model.TypeCatalog, tq: q}, nil
+	case *model.ChargebackStatementQuery:
+		return &query[*model.ChargebackStatementQuery, predicate.ChargebackStatement, chargebackstatement.OrderOption]{typ: model.TypeChargebackStatement, tq: q}, nil
 	case *model.ConnectorQuery:
 		return &query[*model.ConnectorQuery, predicate.Connector, connector.OrderOption]{typ: model.TypeConnector, tq: q}, nil
+	case *model.CostAnomalyQuery:
+		return &query[*model.CostAnomalyQuery, predicate.CostAnomaly, costanomaly.OrderOption]{typ: model.TypeCostAnomaly, tq: q}, nil
 	case *model.CostReportQuery:
 		return &query[*model.CostReportQuery, predicate.CostReport, costreport.OrderOption]{typ: model.TypeCostReport, tq: q}, nil
+	case *model.DataEncryptionKeyQuery:
+		return &query[*model.DataEncryptionKeyQuery, predicate.DataEncryptionKey, dataencryptionkey.OrderOption]{typ: model.TypeDataEncryptionKey, tq: q}, nil
 	case *model.DistributeLockQuery:
 		return &query[*model.DistributeLockQuery, predicate.DistributeLock, distributelock.OrderOption]{typ: model.TypeDistributeLock, tq: q}, nil
 	case *model.EnvironmentQuery:
@@ -944,24 +1348,40 @@ func NewQuery(q model.Query) (Query, error) {
 		return &query[*model.ResourceRelationshipQuery, predicate.ResourceRelationship, resourcerelationship.OrderOption]{typ: model.TypeResourceRelationship, tq: q}, nil
 	case *model.ResourceRunQuery:
 		return &query[*model.ResourceRunQuery, predicate.ResourceRun, resourcerun.OrderOption]{typ: model.TypeResourceRun, tq: q}, nil
+	case *model.ResourceRunApprovalRuleQuery:
+		return &query[*model.ResourceRunApprovalRuleQuery, predicate.ResourceRunApprovalRule, resourcerunapprovalrule.OrderOption]{typ: model.TypeResourceRunApprovalRule, tq: q}, nil
+	case *model.ResourceRunPolicyQuery:
+		return &query[*model.ResourceRunPolicyQuery, predicate.ResourceRunPolicy, resourcerunpolicy.OrderOption]{typ: model.TypeResourceRunPolicy, tq: q}, nil
 	case *model.ResourceStateQuery:
 		return &query[*model.ResourceStateQuery, predicate.ResourceState, resourcestate.OrderOption]{typ: model.TypeResourceState, tq: q}, nil
+	case *model.ResourceStateLockQuery:
+		return &query[*model.ResourceStateLockQuery, predicate.ResourceStateLock, resourcestatelock.OrderOption]{typ: model.TypeResourceStateLock, tq: q}, nil
+	case *model.ResourceStateVersionQuery:
+		return &query[*model.ResourceStateVersionQuery, predicate.ResourceStateVersion, resourcestateversion.OrderOption]{typ: model.TypeResourceStateVersion, tq: q}, nil
 	case *model.RoleQuery:
 		return &query[*model.RoleQuery, predicate.Role, role.OrderOption]{typ: model.TypeRole, tq: q}, nil
 	case *model.SettingQuery:
 		return &query[*model.SettingQuery, predicate.Setting, setting.OrderOption]{typ: model.TypeSetting, tq: q}, nil
+	case *model.StorageObjectQuery:
+		return &query[*model.StorageObjectQuery, predicate.StorageObject, storageobject.OrderOption]{typ: model.TypeStorageObject, tq: q}, nil
 	case *model.SubjectQuery:
 		return &query[*model.SubjectQuery, predicate.Subject, subject.OrderOption]{typ: model.TypeSubject, tq: q}, nil
 	case *model.SubjectRoleRelationshipQuery:
 		return &query[*model.SubjectRoleRelationshipQuery, predicate.SubjectRoleRelationship, subjectrolerelationship.OrderOption]{typ: model.TypeSubjectRoleRelationship, tq: q}, nil
 	case *model.TemplateQuery:
 		return &query[*model.TemplateQuery, predicate.Template, template.OrderOption]{typ: model.TypeTemplate, tq: q}, nil
+	case *model.TemplateUpgradeCampaignQuery:
+		return &query[*model.TemplateUpgradeCampaignQuery, predicate.TemplateUpgradeCampaign, templateupgradecampaign.OrderOption]{typ: model.TypeTemplateUpgradeCampaign, tq: q}, nil
 	case *model.TemplateVersionQuery:
 		return &query[*model.TemplateVersionQuery, predicate.TemplateVersion, templateversion.OrderOption]{typ: model.TypeTemplateVersion, tq: q}, nil
 	case *model.TokenQuery:
 		return &query[*model.TokenQuery, predicate.Token, token.OrderOption]{typ: model.TypeToken, tq: q}, nil
 	case *model.VariableQuery:
 		return &query[*model.VariableQuery, predicate.Variable, variable.OrderOption]{typ: model.TypeVariable, tq: q}, nil
+	case *model.WebhookQuery:
+		return &query[*model.WebhookQuery, predicate.Webhook, webhook.OrderOption]{typ: model.TypeWebhook, tq: q}, nil
+	case *model.WebhookDeliveryQuery:
+		return &query[*model.WebhookDeliveryQuery, predicate.WebhookDelivery, webhookdelivery.OrderOption]{typ: model.TypeWebhookDelivery, tq: q}, nil
 	case *model.WorkflowQuery:
 		return &query[*model.WorkflowQuery, predicate.Workflow, workflow.OrderOption]{typ: model.TypeWorkflow, tq: q}, nil
 	case *model.WorkflowExecutionQuery:
@@ -9,4 +9,4 @@
 // Package internal holds a loadable version of the latest schema.
 package internal
 
-const Schema = `{"Schema":"github.com/seal-io/walrus/pkg/dao/schema","Package":"github.com/seal-io/walrus/pkg/dao/model","Schemas":[{"name":"Catalog","config":{"Table":""},"edges":[{"name":"templates","type":"Template","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Templates that belong to this catalog."},{"name":"project","type":"Project","field":"project_id","ref_name":"catalogs","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the catalog belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Type of the catalog."},{"name":"source","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Source of the catalog."},{"name":"sync","type":{"Type":3,"Ident":"*types.CatalogSync","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"CatalogSync","Ident":"types.CatalogSync","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Sync information of the catalog."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"filter_pattern","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Catalog regexp pattern to filter the repositories by names."}],"indexes":[{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Connector","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"connectors","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the connector belongs."},{"name":"environments","type":"Environment","ref_name":"connectors","through":{"N":"environment_connector_relationships","T":"EnvironmentConnectorRelationship"},"inverse":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Environments to which the connector configures."},{"name":"resource_components","type":"ResourceComponent","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceComponents that use the connector."},{"name":"cost_reports","type":"CostReport","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"CostReports that linked to the connection."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"category","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"category,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Create":true,"Query":true,"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Query":true,"Create":true,"Update":true}}},"comment":"Category of the connector."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Create":true,"Query":true,"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Query":true,"Create":true,"Update":true}}},"comment":"Type of the connector."},{"name":"applicable_environment_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Query":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Query":true}}},"comment":"Environment type of the connector to apply."},{"name":"config_version","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Connector config version."},{"name":"config_data","type":{"Type":8,"Ident":"crypto.Properties","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":true,"RType":{"Name":"Properties","Ident":"crypto.Properties","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Connector config data."},{"name":"enable_fin_ops","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Config whether enable finOps, will install prometheus and opencost while enable."},{"name":"fin_ops_custom_pricing","type":{"Type":3,"Ident":"*types.FinOpsCustomPricing","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"FinOpsCustomPricing","Ident":"types.FinOpsCustomPricing","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsZero":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Custom pricing user defined."}],"indexes":[{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"CostReport","config":{"Table":""},"edges":[{"name":"connector","type":"Connector","field":"connector_id","ref_name":"cost_reports","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Connector current cost item linked."}],"fields":[{"name":"start_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Usage start time for current cost."},{"name":"end_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Usage end time for current cost."},{"name":"minutes","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Usage minutes from start time to end time."},{"name":"connector_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the connector."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Resource name for current cost, could be __unmounted__."},{"name":"fingerprint","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"String generated from resource properties, used to identify this cost."},{"name":"cluster_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Cluster name for current cost."},{"name":"namespace","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Namespace for current cost."},{"name":"node","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Node for current cost."},{"name":"controller","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Controller name for the cost linked resource."},{"name":"controller_kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Controller kind for the cost linked resource, deployment, statefulSet etc."},{"name":"pod","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"comment":"Pod name for current cost."},{"name":"container","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"comment":"Container name for current cost."},{"name":"pvs","type":{"Type":3,"Ident":"map[string]types.PVCost","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"map[string]types.PVCost","Kind":21,"PkgPath":"","Methods":{}}},"default":true,"default_value":{},"default_kind":21,"immutable":true,"position":{"Index":13,"MixedIn":false,"MixinIndex":0},"comment":"PV list for current cost linked."},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"default":true,"default_value":{},"default_kind":21,"immutable":true,"position":{"Index":14,"MixedIn":false,"MixinIndex":0},"comment":"Labels for the cost linked resource."},{"name":"totalCost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":15,"MixedIn":false,"MixinIndex":0},"comment":"Cost number."},{"name":"currency","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":16,"MixedIn":false,"MixinIndex":0},"comment":"Cost currency."},{"name":"cpu_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":17,"MixedIn":false,"MixinIndex":0},"comment":"Cpu cost for current cost."},{"name":"cpu_core_request","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"immutable":true,"position":{"Index":18,"MixedIn":false,"MixinIndex":0},"comment":"Cpu core requested."},{"name":"gpu_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":19,"MixedIn":false,"MixinIndex":0},"comment":"GPU cost for current cost."},{"name":"gpu_count","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"immutable":true,"position":{"Index":20,"MixedIn":false,"MixinIndex":0},"comment":"GPU core count."},{"name":"ram_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":21,"MixedIn":false,"MixinIndex":0},"comment":"Ram cost for current cost."},{"name":"ram_byte_request","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"immutable":true,"position":{"Index":22,"MixedIn":false,"MixinIndex":0},"comment":"Ram requested in byte."},{"name":"pv_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":23,"MixedIn":false,"MixinIndex":0},"comment":"PV cost for current cost linked."},{"name":"pv_bytes","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":24,"MixedIn":false,"MixinIndex":0},"comment":"PV bytes for current cost linked."},{"name":"load_balancer_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":25,"MixedIn":false,"MixinIndex":0},"comment":"LoadBalancer cost for current cost linked."},{"name":"cpu_core_usage_average","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":26,"MixedIn":false,"MixinIndex":0},"comment":"CPU core average usage."},{"name":"cpu_core_usage_max","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":27,"MixedIn":false,"MixinIndex":0},"comment":"CPU core max usage."},{"name":"ram_byte_usage_average","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":28,"MixedIn":false,"MixinIndex":0},"comment":"Ram average usage in byte."},{"name":"ram_byte_usage_max","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":29,"MixedIn":false,"MixinIndex":0},"comment":"Ram max usage in byte."}],"indexes":[{"unique":true,"fields":["start_time","end_time","connector_id","fingerprint"]}],"hooks":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"DistributeLock","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"ID is the lock key."},{"name":"expireAt","type":{"Type":13,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Expiration timestamp to prevent the lock be occupied for long time."},{"name":"holder","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Holder is the id for current key owner"}]},{"name":"Environment","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"environments","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the environment belongs."},{"name":"connectors","type":"Connector","through":{"N":"environment_connector_relationships","T":"EnvironmentConnectorRelationship"},"comment":"Connectors that configure to the environment."},{"name":"resources","type":"Resource","tag":"json:\"resources,omitempty,cli-ignore\"","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that belong to the environment."},{"name":"resource_runs","type":"ResourceRun","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceRuns that belong to the environment."},{"name":"resource_components","type":"ResourceComponent","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceComponents that belong to the environment."},{"name":"variables","type":"Variable","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Variables that belong to the environment."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Type of the environment."}],"indexes":[{"unique":true,"fields":["project_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"EnvironmentConnectorRelationship","config":{"Table":""},"edges":[{"name":"environment","type":"Environment","field":"environment_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Environment that connect to the relationship."},{"name":"connector","type":"Connector","field":"connector_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Connector that connect to the relationship."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the relationship connects."},{"name":"connector_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the connector to which the relationship connects."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["environment_id","connector_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Perspective","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"start_time","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Start time for the perspective."},{"name":"end_time","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"End time for the perspective."},{"name":"builtin","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Is builtin perspective."},{"name":"cost_queries","type":{"Type":3,"Ident":"[]types.QueryCondition","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"[]types.QueryCondition","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Indicated the perspective included cost queries, record the used query condition."}],"indexes":[{"unique":true,"fields":["name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"Project","config":{"Table":""},"edges":[{"name":"environments","type":"Environment","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Environments that belong to the project."},{"name":"connectors","type":"Connector","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Connectors that belong to the project."},{"name":"subject_roles","type":"SubjectRoleRelationship","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{},"SkipOutput":true},"_EntX_":{"SkipInput":{},"Input":{},"SkipOutput":true}},"comment":"Roles of a subject that belong to the project."},{"name":"resources","type":"Resource","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that belong to the project."},{"name":"resource_components","type":"ResourceComponent","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceComponents that belong to the project."},{"name":"resource_runs","type":"ResourceRun","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceRuns that belong to the project."},{"name":"variables","type":"Variable","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Variables that belong to the project."},{"name":"templates","type":"Template","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Templates that belong to the project."},{"name":"template_versions","type":"TemplateVersion","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"TemplateVersions that belong to the project."},{"name":"catalogs","type":"Catalog","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Catalogs that belong to the project."},{"name":"workflows","type":"Workflow","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Workflows that belong to the project."},{"name":"workflow_stages","type":"WorkflowStage","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowStages that belong to the project."},{"name":"workflow_steps","type":"WorkflowStep","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowSteps that belong to the project."},{"name":"workflow_executions","type":"WorkflowExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowExecutions that belong to the project."},{"name":"workflow_stage_executions","type":"WorkflowStageExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowStageExecutions that belong to the project."},{"name":"workflow_step_executions","type":"WorkflowStepExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowStepExecutions that belong to the project."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}}],"indexes":[{"unique":true,"fields":["name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}}},{"name":"Resource","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resources","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the resource belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resources","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Environment to which the resource belongs."},{"name":"template","type":"TemplateVersion","field":"template_id","ref_name":"resources","unique":true,"inverse":true,"annotations":{"EntX":{"Input":{"Create":true,"Update":true},"SkipInput":{"Query":true}},"_EntX_":{"SkipInput":{"Query":true},"Input":{"Create":true,"Update":true}}},"comment":"Template to which the resource belongs."},{"name":"resource_definition","type":"ResourceDefinition","tag":"json:\"-\"","field":"resource_definition_id","ref_name":"resources","unique":true,"inverse":true,"annotations":{"EntX":{"Input":{"Create":true,"Update":true},"SkipInput":{"Query":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true},"Input":{"Create":true,"Update":true},"SkipOutput":true}},"comment":"Definition of the resource."},{"name":"resource_definition_matching_rule","type":"ResourceDefinitionMatchingRule","field":"resource_definition_matching_rule_id","ref_name":"resources","unique":true,"inverse":true,"annotations":{"EntX":{"Input":{"Create":true,"Update":true},"SkipInput":{"Query":true}},"_EntX_":{"SkipInput":{"Query":true},"Input":{"Create":true,"Update":true}}},"comment":"Resource definition matching rule which the resource matches."},{"name":"runs","type":"ResourceRun","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Runs that belong to the resource."},{"name":"components","type":"ResourceComponent","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Components that belong to the resource."},{"name":"dependencies","type":"Resource","through":{"N":"resource_relationships","T":"ResourceRelationship"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Dependencies that requires for the resource."},{"name":"state","type":"ResourceState","unique":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"State of the resource."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the resource deploys."},{"name":"template_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"nillable":true,"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template version to which the resource belong."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Create":true,"Query":true,"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Query":true,"Create":true,"Update":true}}},"comment":"Type of the resource referring to a resource definition type."},{"name":"resource_definition_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"nillable":true,"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ID of the resource definition to which the resource use."},{"name":"resource_definition_matching_rule_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"nillable":true,"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ID of the resource definition matching rule to which the resource use."},{"name":"attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"tag":"json:\"attributes\"","optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Attributes to configure the template."},{"name":"computed_attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"tag":"json:\"computedAttributes\"","optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Computed attributes generated from attributes and schemas."},{"name":"endpoints","type":{"Type":3,"Ident":"types.ResourceEndpoints","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ResourceEndpoints","Ident":"types.ResourceEndpoints","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Len":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Less":{"In":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null},{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Sort":{"In":[],"Out":[{"Name":"ResourceEndpoints","Ident":"types.ResourceEndpoints","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Swap":{"In":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null},{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}],"Out":[]}}}},"tag":"json:\"endpoints,omitempty,cli-table-column\"","optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Endpoints of the resource."}],"indexes":[{"unique":true,"fields":["project_id","environment_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ResourceComponent","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resource_components","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the component belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resource_components","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Environment to which the component deploys."},{"name":"resource","type":"Resource","field":"resource_id","ref_name":"components","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Resource to which the component belongs."},{"name":"connector","type":"Connector","field":"connector_id","ref_name":"resource_components","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Connector to which the component deploys."},{"name":"composition","type":"ResourceComponent","field":"composition_id","ref":{"name":"components","type":"ResourceComponent","comment":"Components that makes up the resource component."},"unique":true,"inverse":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"}}},{"name":"class","type":"ResourceComponent","field":"class_id","ref":{"name":"instances","type":"ResourceComponent","comment":"Instances that realizes the resource component."},"unique":true,"inverse":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"}}},{"name":"dependencies","type":"ResourceComponent","through":{"N":"resource_component_relationships","T":"ResourceComponentRelationship"},"comment":"Dependencies that requires for the resource component."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the component belongs."},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource to which the component belongs."},{"name":"connector_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the connector to which the component deploys."},{"name":"composition_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the parent component."},{"name":"class_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the parent class of the component realization."},{"name":"mode","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Mode that manages the generated component, it is the management way of the deployer to the component, which provides by deployer."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Type of the generated component, it is the type of the resource which the deployer observes, which provides by deployer."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Name of the generated component, it is the real identifier of the component, which provides by deployer."},{"name":"deployer_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Type of deployer."},{"name":"shape","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Shape of the component, it can be class or instance shape."},{"name":"keys","type":{"Type":3,"Ident":"*types.ResourceComponentOperationKeys","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ResourceComponentOperationKeys","Ident":"types.ResourceComponentOperationKeys","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipStoring":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipStoring":true}},"comment":"Keys of the component."},{"name":"index_key","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Index key to identify the component instance."}],"indexes":[{"fields":["create_time"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ResourceComponentRelationship","config":{"Table":""},"edges":[{"name":"resource_component","type":"ResourceComponent","field":"resource_component_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceComponent to which it currently belongs."},{"name":"dependency","type":"ResourceComponent","field":"dependency_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"ResourceComponent to which the dependency belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"resource_component_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource component."},{"name":"dependency_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource that resource depends on."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Type of the relationship."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["resource_component_id","dependency_id","type"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"ResourceDefinition","config":{"Table":""},"edges":[{"name":"matching_rules","type":"TemplateVersion","through":{"N":"resource_definition_matching_rules","T":"ResourceDefinitionMatchingRule"},"comment":"Template versions that configure to the resource definition."},{"name":"resources","type":"Resource","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that use the definition."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Type of the resources generated from the resource definition."},{"name":"schema","type":{"Type":3,"Ident":"types.Schema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"Schema","Ident":"types.Schema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Expose":{"In":[{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"UISchema","Ident":"types.UISchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Intersect":{"In":[{"Name":"","Ident":"*types.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"OutputSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]},"RemoveVariableContext":{"In":[],"Out":[]},"SetOutputSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{"openAPISchema":null},"default_kind":25,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Generated schema of the resource definition."},{"name":"ui_schema","type":{"Type":3,"Ident":"*types.UISchema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"UISchema","Ident":"types.UISchema","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsUserEdited":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetUserEdited":{"In":[],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"UnsetUserEdited":{"In":[],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"optional":true,"default":true,"default_value":{"openAPISchema":null},"default_kind":22,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true},"SkipClearing":true}},"comment":"UI schema of the resource definition."},{"name":"builtin","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"builtin,omitempty,cli-table-column\"","default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate whether the resource definition is builtin, decided when creating."}],"indexes":[{"fields":["type"]},{"unique":true,"fields":["name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"ResourceDefinitionMatchingRule","config":{"Table":""},"edges":[{"name":"resource_definition","type":"ResourceDefinition","field":"resource_definition_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resource definition that connect to the relationship."},{"name":"template","type":"TemplateVersion","field":"template_id","unique":true,"required":true,"annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Template version that connect to the relationship."},{"name":"resources","type":"Resource","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that match the rule."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"resource_definition_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource definition to which the relationship connects."},{"name":"template_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template version to which the relationship connects."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Name of the matching rule."},{"name":"selector","type":{"Type":3,"Ident":"types.Selector","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"Selector","Ident":"types.Selector","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Resource selector."},{"name":"attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"comment":"Attributes to configure the template."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the matching rule."},{"name":"schema_default_value","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Default value generated from resource definition's schema, ui schema and attributes"}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["resource_definition_id","name"]},{"unique":true,"fields":["resource_definition_id","template_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"ResourceRelationship","config":{"Table":""},"edges":[{"name":"resource","type":"Resource","field":"resource_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resource to which it currently belongs."},{"name":"dependency","type":"Resource","field":"dependency_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Resource to which the dependency belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource that deploys after the dependency finished."},{"name":"dependency_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource that deploys before the resource begins."},{"name":"path","type":{"Type":3,"Ident":"[]object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":true,"RType":{"Name":"","Ident":"[]object.ID","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"ID list of the resource includes all dependencies and the resource itself."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Type of the relationship."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["resource_id","dependency_id","path"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"ResourceRun","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resource_runs","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the run belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resource_runs","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Environment to which the run deploys."},{"name":"resource","type":"Resource","field":"resource_id","ref_name":"runs","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Resource to which the run belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the run belongs."},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource to which the run belongs."},{"name":"template_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Name of the template."},{"name":"template_version","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Version of the template."},{"name":"template_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template."},{"name":"attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"comment":"Attributes to configure the template."},{"name":"computed_attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"comment":"Computed attributes generated from attributes and schemas."},{"name":"variables","type":{"Type":8,"Ident":"crypto.Map[string,string]","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":true,"RType":{"Name":"Map[string,string]","Ident":"crypto.Map[string,string]","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{},"default_kind":21,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"Variables of the run."},{"name":"input_configs","type":{"Type":3,"Ident":"map[string][]uint8","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string][]uint8","Kind":21,"PkgPath":"","Methods":{}}},"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"sensitive":true,"comment":"Input configs of the run."},{"name":"deployer_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"Terraform","default_kind":24,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Type of deployer."},{"name":"duration","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"comment":"Duration in seconds of the run deploying."},{"name":"previous_required_providers","type":{"Type":3,"Ident":"[]types.ProviderRequirement","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"[]types.ProviderRequirement","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"comment":"Previous provider requirement of the run."},{"name":"plan_record","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":13,"MixedIn":false,"MixinIndex":0},"comment":"Record of the run plan."},{"name":"record","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":14,"MixedIn":false,"MixinIndex":0},"comment":"Record of the run."},{"name":"change_comment","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":15,"MixedIn":false,"MixinIndex":0},"comment":"Change comment of the run."},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":16,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"User who created the run."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":17,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Type of the run."},{"name":"preview","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":18,"MixedIn":false,"MixinIndex":0},"comment":"If the run is preview."},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":19,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":20,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"component_changes","type":{"Type":3,"Ident":"[]*types.ResourceComponentChange","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"[]*types.ResourceComponentChange","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":21,"MixedIn":false,"MixinIndex":0},"comment":"Changes of the resource components."},{"name":"component_change_summary","type":{"Type":3,"Ident":"types.ResourceComponentChangeSummary","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"ResourceComponentChangeSummary","Ident":"types.ResourceComponentChangeSummary","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":22,"MixedIn":false,"MixinIndex":0},"comment":"Change summary of the resource."}],"indexes":[{"fields":["create_time"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ResourceState","config":{"Table":""},"edges":[{"name":"resource","type":"Resource","field":"resource_id","ref_name":"state","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Resource to which the state belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"data","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"State data of the resource."},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource to which the state belongs."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"Role","config":{"Table":""},"edges":[{"name":"subjects","type":"Subject","ref_name":"roles","through":{"N":"subject_role_relationships","T":"SubjectRoleRelationship"},"inverse":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Subjects to which the role configures."}],"fields":[{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"It is also the name of the role."},{"name":"kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"kind,omitempty,cli-table-column\"","default":true,"default_value":"system","default_kind":24,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"The kind of the role."},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"The detail of the role."},{"name":"policies","type":{"Type":3,"Ident":"types.RolePolicies","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Clone":{"In":[],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Deduplicate":{"In":[],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Delete":{"In":[{"Name":"","Ident":"[]types.RolePolicy","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Len":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Less":{"In":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null},{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Normalize":{"In":[],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Sort":{"In":[],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Swap":{"In":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null},{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}],"Out":[]}}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"The policy list of the role."},{"name":"applicable_environment_types","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":[],"default_kind":23,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"The environment type list of the role to apply, only for system kind role."},{"name":"session","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Indicate whether the role is session level, decide when creating."},{"name":"builtin","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"builtin,omitempty,cli-table-column\"","default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate whether the role is builtin, decide when creating."}],"indexes":[{"fields":["create_time"]}],"hooks":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Setting","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Update":true}},"_EntX_":{"SkipInput":{"Create":true,"Update":true},"Input":{}}},"comment":"The name of system setting."},{"name":"value","type":{"Type":7,"Ident":"crypto.String","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":false,"RType":{"Name":"String","Ident":"crypto.String","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"tag":"json:\"value,omitempty,cli-table-column\"","position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"The value of system setting, store in string."},{"name":"hidden","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"default":true,"default_value":false,"default_kind":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate the system setting should be hidden or not, default is visible."},{"name":"editable","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"default":true,"default_value":false,"default_kind":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate the system setting should be edited or not, default is readonly."},{"name":"sensitive","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"default":true,"default_value":false,"default_kind":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate the system setting should be sanitized or not before exposing, default is not."},{"name":"private","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"default":true,"default_value":false,"default_kind":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Indicate the system setting should be exposed or not, default is exposed."},{"name":"configured","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipStoring":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipStoring":true}},"comment":"Configured indicates the setting whether to be configured."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Subject","config":{"Table":""},"edges":[{"name":"tokens","type":"Token","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Tokens that belong to the subject."},{"name":"roles","type":"Role","through":{"N":"subject_role_relationships","T":"SubjectRoleRelationship"},"comment":"Roles that configure to the subject."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"kind,omitempty,cli-table-column\"","default":true,"default_value":"user","default_kind":24,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"The kind of the subject."},{"name":"domain","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"builtin","default_kind":24,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"The domain of the subject."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"The name of the subject."},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"The detail of the subject."},{"name":"builtin","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Indicate whether the subject is builtin, decide when creating."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["kind","domain","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"SubjectRoleRelationship","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"subject_roles","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the subject role belongs."},{"name":"subject","type":"Subject","field":"subject_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Subject that connect to the relationship."},{"name":"role","type":"Role","field":"role_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Role that connect to the relationship."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"subject_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the subject to which the relationship connects."},{"name":"role_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"ID of the role to which the relationship connects."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["project_id","subject_id","role_id"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["subject_id","role_id"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Template","config":{"Table":""},"edges":[{"name":"versions","type":"TemplateVersion","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Versions that belong to the template."},{"name":"catalog","type":"Catalog","field":"catalog_id","ref_name":"templates","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Catalog to which the template belongs."},{"name":"project","type":"Project","field":"project_id","ref_name":"templates","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the template belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"icon","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"A URL to an SVG or PNG image to be used as an icon."},{"name":"source","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Source of the template."},{"name":"catalog_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template catalog."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."}],"indexes":[{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"TemplateVersion","config":{"Table":""},"edges":[{"name":"template","type":"Template","field":"template_id","ref_name":"versions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Template to which the template version belongs."},{"name":"resources","type":"Resource","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that belong to the template version."},{"name":"resource_definitions","type":"ResourceDefinition","ref_name":"matching_rules","through":{"N":"resource_definition_matching_rules","T":"ResourceDefinitionMatchingRule"},"inverse":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resource definitions that use the template version."},{"name":"project","type":"Project","field":"project_id","ref_name":"template_versions","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the template version belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"template_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Name of the template."},{"name":"version","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"version,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Version of the template."},{"name":"source","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Source of the template."},{"name":"schema","type":{"Type":3,"Ident":"types.TemplateVersionSchema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"TemplateVersionSchema","Ident":"types.TemplateVersionSchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Expose":{"In":[{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"UISchema","Ident":"types.UISchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Intersect":{"In":[{"Name":"","Ident":"*types.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"OutputSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]},"RemoveVariableContext":{"In":[],"Out":[]},"SetOutputSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{"openAPISchema":null,"readme":"","requiredProviders":null},"default_kind":25,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Generated schema and data of the template."},{"name":"original_ui_schema","type":{"Type":3,"Ident":"types.UISchema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"UISchema","Ident":"types.UISchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsUserEdited":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetUserEdited":{"In":[],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"UnsetUserEdited":{"In":[],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{"openAPISchema":null},"default_kind":25,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"store the original ui schema of the template."},{"name":"ui_schema","type":{"Type":3,"Ident":"types.UISchema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"UISchema","Ident":"types.UISchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsUserEdited":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetUserEdited":{"In":[],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"UnsetUserEdited":{"In":[],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{"openAPISchema":null},"default_kind":25,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"ui schema of the template."},{"name":"schema_default_value","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Default value generated from schema and ui schema"},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["name","version","project_id"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["name","version"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Token","config":{"Table":""},"edges":[{"name":"subject","type":"Subject","field":"subject_id","ref_name":"tokens","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"ValidateContextFuncs":["intercept.WithSubjectInterceptor"]},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"ValidateContextFuncs":["intercept.WithSubjectInterceptor"]}},"comment":"Subject to which the token belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"subject_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the subject to belong."},{"name":"kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"api","default_kind":24,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"The kind of token."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"The name of token."},{"name":"expiration","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"The time of expiration, empty means forever."},{"name":"value","type":{"Type":7,"Ident":"crypto.String","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":false,"RType":{"Name":"String","Ident":"crypto.String","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"sensitive":true,"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"The value of token, store in string."},{"name":"access_token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipStoring":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipStoring":true}},"comment":"AccessToken is the token used for authentication."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["subject_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Variable","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"variables","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the variable belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"variables","unique":true,"inverse":true,"immutable":true,"comment":"Environment to which the variable belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the variable belongs to."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"The name of variable."},{"name":"value","type":{"Type":7,"Ident":"crypto.String","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":false,"RType":{"Name":"String","Ident":"crypto.String","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"The value of variable, store in string."},{"name":"sensitive","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"The value is sensitive or not."},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Description of the variable."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["project_id","environment_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL and environment_id IS NOT NULL"}}},{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL AND environment_id IS NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL AND environment_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Workflow","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflows","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow belongs."},{"name":"stages","type":"WorkflowStage","annotations":{"EntSQL":{"on_delete":"CASCADE"}},"comment":"Stages that belong to this workflow."},{"name":"executions","type":"WorkflowExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Workflow executions that belong to this workflow."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project that this workflow belongs to."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment that this workflow belongs to."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Type of the workflow."},{"name":"parallelism","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":10,"default_kind":2,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Number of task pods that can be executed in parallel of workflow."},{"name":"timeout","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Timeout seconds of the workflow."},{"name":"version","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Execution version of the workflow."},{"name":"variables","type":{"Type":3,"Ident":"types.WorkflowVariables","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"WorkflowVariables","Ident":"types.WorkflowVariables","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Configs of workflow variables."}],"indexes":[{"unique":true,"fields":["project_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowExecution","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_executions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Update":true},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{"Create":true,"Update":true},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow execution belongs."},{"name":"stages","type":"WorkflowStageExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Update":true}},"_EntX_":{"SkipInput":{"Create":true,"Update":true},"Input":{}}},"comment":"Workflow stage executions that belong to this workflow execution."},{"name":"workflow","type":"Workflow","field":"workflow_id","ref_name":"executions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Update":true}},"_EntX_":{"SkipInput":{"Create":true,"Update":true},"Input":{}}},"comment":"Workflow that this workflow execution belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"version","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"version,omitempty,cli-table-column\"","validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Version of the workflow execution."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Type of the workflow execution."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow execution belongs to."},{"name":"subject_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"ID of the subject that create workflow execution."},{"name":"execute_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Time of the workflow execution started."},{"name":"times","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":1,"default_kind":2,"validators":1,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Number of times that this workflow execution has been executed."},{"name":"duration","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Duration seconds of the workflow execution."},{"name":"parallelism","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":10,"default_kind":2,"validators":1,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Number of task pods that can be executed in parallel of workflow."},{"name":"timeout","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Timeout of the workflow execution."},{"name":"trigger","type":{"Type":3,"Ident":"types.WorkflowExecutionTrigger","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"WorkflowExecutionTrigger","Ident":"types.WorkflowExecutionTrigger","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"default":true,"default_value":{"type":"","user":""},"default_kind":25,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Trigger of the workflow execution."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowStage","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_stages","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow stage belongs."},{"name":"steps","type":"WorkflowStep","annotations":{"EntSQL":{"on_delete":"CASCADE"}},"comment":"Workflow steps that belong to this workflow stage."},{"name":"workflow","type":"Workflow","field":"workflow_id","ref_name":"stages","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Workflow that this workflow stage belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow stage belongs to."},{"name":"dependencies","type":{"Type":3,"Ident":"[]object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":true,"RType":{"Name":"","Ident":"[]object.ID","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"ID list of the workflow stages that this workflow stage depends on."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the workflow stage."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowStageExecution","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_stage_executions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow stage executions belongs."},{"name":"steps","type":"WorkflowStepExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"}},"comment":"Workflow step executions that belong to this workflow stage execution."},{"name":"workflow_execution","type":"WorkflowExecution","field":"workflow_execution_id","ref_name":"stages","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Workflow execution that this workflow stage execution belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow execution belongs to."},{"name":"workflow_stage_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow stage that this workflow stage execution belongs to."},{"name":"workflow_execution_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow execution that this workflow stage execution belongs to."},{"name":"execute_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Time of the stage execution started."},{"name":"duration","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Duration of the workflow stage execution."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the workflow stage execution."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowStep","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_steps","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the step belongs."},{"name":"stage","type":"WorkflowStage","field":"workflow_stage_id","ref_name":"steps","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Workflow stage that this workflow step belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Type of the workflow step."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow step belongs to."},{"name":"workflow_stage_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the stage that this workflow step belongs to."},{"name":"attributes","type":{"Type":3,"Ident":"map[string]interface {}","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]interface {}","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Attributes of the workflow step."},{"name":"inputs","type":{"Type":3,"Ident":"map[string]interface {}","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]interface {}","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Inputs of the workflow step."},{"name":"outputs","type":{"Type":3,"Ident":"map[string]interface {}","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]interface {}","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Outputs of the workflow step."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the workflow step."},{"name":"dependencies","type":{"Type":3,"Ident":"[]object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":true,"RType":{"Name":"","Ident":"[]object.ID","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"ID list of the workflow steps that this workflow step depends on."},{"name":"retryStrategy","type":{"Type":3,"Ident":"*types.RetryStrategy","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"RetryStrategy","Ident":"types.RetryStrategy","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Retry policy of the workflow step."},{"name":"timeout","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Timeout seconds of the workflow step, 0 means no timeout."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowStepExecution","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_step_executions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow step execution belongs."},{"name":"stage_execution","type":"WorkflowStageExecution","field":"workflow_stage_execution_id","ref_name":"steps","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true}},"_EntX_":{"SkipInput":{"Create":true},"Input":{}}},"comment":"Workflow stage execution that this workflow step execution belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"workflow_step_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow step that this workflow step execution belongs to."},{"name":"workflow_execution_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow execution that this workflow step execution belongs to."},{"name":"workflow_stage_execution_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow stage execution that this workflow step execution belongs to."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow step execution belongs to."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Type of the workflow step execution."},{"name":"attributes","type":{"Type":3,"Ident":"map[string]interface {}","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]interface {}","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Attributes of the workflow step execution."},{"name":"times","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":1,"default_kind":2,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Number of times that this workflow step execution has been executed."},{"name":"execute_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Time of the step execution started."},{"name":"duration","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Execution duration seconds of the workflow step."},{"name":"retryStrategy","type":{"Type":3,"Ident":"*types.RetryStrategy","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"RetryStrategy","Ident":"types.RetryStrategy","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Retry policy of the workflow step."},{"name":"timeout","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"comment":"Timeout of the workflow step execution."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the workflow step execution."},{"name":"record","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"default":true,"default_value":"","default_kind":24,"position":{"Index":13,"MixedIn":false,"MixinIndex":0},"comment":"Log record of the workflow step execution."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]}],"Features":["intercept","schema/snapshot","sql/schemaconfig","sql/lock","sql/modifier","sql/execquery","sql/upsert","sql/versioned-migration"]}`
+const Schema = `{"Schema":"github.com/seal-io/walrus/pkg/dao/schema","Package":"github.com/seal-io/walrus/pkg/dao/model","Schemas":[{"name":"AuditLog","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"subject_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"ID of the subject who performs the call, empty means anonymous."},{"name":"subject_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Name of the subject who performs the call."},{"name":"action","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Action of the call, i.e. create, update, delete or the name of the custom route."},{"name":"method","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"HTTP method of the call."},{"name":"path","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Path of the call."},{"name":"resource_kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Kind of the resource to operate."},{"name":"resource_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"ID or name of the resource to operate, empty means operating a collection."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to which the resource belongs."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the resource belongs."},{"name":"request","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"immutable":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Summary of the request, sensitive fields are redacted."},{"name":"status","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"HTTP status code of the response."},{"name":"result","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"comment":"Result of the call, succeeded or failed."},{"name":"message","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"immutable":true,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"comment":"Message of the result, i.e. the error message if failed."}],"indexes":[{"fields":["create_time"]},{"fields":["subject_id"]},{"fields":["project_id","environment_id"]},{"fields":["resource_kind","resource_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Budget","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"budgets","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the budget belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"budgets","unique":true,"inverse":true,"immutable":true,"comment":"Environment which the budget watches."},{"name":"events","type":"BudgetEvent","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Events of the budget."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to watch, empty means the whole project."},{"name":"filters","type":{"Type":3,"Ident":"types.CostFilters","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"CostFilters","Ident":"types.CostFilters","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Filters to narrow down the watched cost, within the project or environment."},{"name":"amount","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"amount,omitempty,cli-table-column\"","validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Amount of the budget within a period."},{"name":"period","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"period,omitempty,cli-table-column\"","default":true,"default_value":"monthly","default_kind":24,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Period of the budget, monthly or custom."},{"name":"start_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Start time of the custom period."},{"name":"end_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"End time of the custom period."},{"name":"thresholds","type":{"Type":3,"Ident":"types.BudgetThresholds","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"BudgetThresholds","Ident":"types.BudgetThresholds","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"default":true,"default_value":[{"percent":50,"type":"actual"},{"percent":80,"type":"actual"},{"percent":100,"type":"actual"},{"percent":100,"type":"forecast"}],"default_kind":23,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Thresholds to alert, in percentage of the amount."},{"name":"spend","type":{"Type":3,"Ident":"*types.BudgetSpend","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"BudgetSpend","Ident":"types.BudgetSpend","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Spend of the budget at the last evaluation."}],"indexes":[{"unique":true,"fields":["project_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"BudgetEvent","config":{"Table":""},"edges":[{"name":"budget","type":"Budget","field":"budget_id","ref_name":"events","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Budget to which the event belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment which the budget watches."},{"name":"budget_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the budget to belong."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Name of the budget."},{"name":"period_start","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Start time of the period which the event occurs in."},{"name":"period_end","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"End time of the period which the event occurs in."},{"name":"threshold_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Type of the breached threshold, actual or forecast."},{"name":"threshold_percent","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Percentage of the breached threshold."},{"name":"amount","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Amount of the budget."},{"name":"cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Actual or forecast cost breaching the threshold."},{"name":"message","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Message of the event."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["budget_id","period_start","threshold_type","threshold_percent"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Catalog","config":{"Table":""},"edges":[{"name":"templates","type":"Template","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Templates that belong to this catalog."},{"name":"project","type":"Project","field":"project_id","ref_name":"catalogs","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the catalog belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Type of the catalog."},{"name":"source","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Source of the catalog."},{"name":"sync","type":{"Type":3,"Ident":"*types.CatalogSync","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"CatalogSync","Ident":"types.CatalogSync","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Sync information of the catalog."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"filter_pattern","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Catalog regexp pattern to filter the repositories by names."},{"name":"repositories","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"URLs of the repositories to sync besides the source, only for the generic Git catalog."},{"name":"directories","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Subdirectories of the source to sync as templates, or to discover the templates from in the monorepo mode, only for the generic Git catalog."},{"name":"monorepo","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Discover the templates from the directories of the source, which contain the Terraform files or the schema.yaml, only for the generic Git catalog."},{"name":"credential","type":{"Type":8,"Ident":"crypto.Map[string,string]","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":true,"RType":{"Name":"Map[string,string]","Ident":"crypto.Map[string,string]","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"sensitive":true,"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Credential to access the repositories, keeps the previous one if not provided."}],"indexes":[{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ChargebackStatement","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"name,omitempty,cli-table-column\"","immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Name of the statement, generated from the period."},{"name":"start_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"startTime,omitempty,cli-table-column\"","immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Start time of the period."},{"name":"end_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"endTime,omitempty,cli-table-column\"","immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"End time of the period."},{"name":"filters","type":{"Type":3,"Ident":"types.CostFilters","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"CostFilters","Ident":"types.CostFilters","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Filters to select the costs of the statement, empty means all costs."},{"name":"shared_options","type":{"Type":3,"Ident":"*types.SharedCostOptions","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"SharedCostOptions","Ident":"types.SharedCostOptions","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Options to distribute the shared, idle and management costs."},{"name":"total_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"totalCost,omitempty,cli-table-column\"","default":true,"default_value":0,"default_kind":14,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Total cost of the statement."},{"name":"items","type":{"Type":3,"Ident":"types.ChargebackStatementItems","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ChargebackStatementItems","Ident":"types.ChargebackStatementItems","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Costs per project and environment."}],"indexes":[{"fields":["create_time"]},{"fields":["start_time","end_time"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"Connector","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"connectors","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the connector belongs."},{"name":"environments","type":"Environment","ref_name":"connectors","through":{"N":"environment_connector_relationships","T":"EnvironmentConnectorRelationship"},"inverse":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Environments to which the connector configures."},{"name":"resource_components","type":"ResourceComponent","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceComponents that use the connector."},{"name":"cost_reports","type":"CostReport","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"CostReports that linked to the connection."},{"name":"cost_anomalies","type":"CostAnomaly","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"CostAnomalies that detected from the namespaces of the connection."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"category","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"category,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Create":true,"Query":true,"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Query":true,"Create":true,"Update":true}}},"comment":"Category of the connector."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Create":true,"Query":true,"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Query":true,"Create":true,"Update":true}}},"comment":"Type of the connector."},{"name":"applicable_environment_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Query":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Query":true}}},"comment":"Environment type of the connector to apply."},{"name":"config_version","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Connector config version."},{"name":"config_data","type":{"Type":8,"Ident":"crypto.Properties","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":true,"RType":{"Name":"Properties","Ident":"crypto.Properties","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Connector config data."},{"name":"enable_fin_ops","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Config whether enable finOps, will install prometheus and opencost while enable."},{"name":"fin_ops_custom_pricing","type":{"Type":3,"Ident":"*types.FinOpsCustomPricing","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"FinOpsCustomPricing","Ident":"types.FinOpsCustomPricing","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsZero":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Custom pricing user defined."},{"name":"fin_ops_billing_source","type":{"Type":3,"Ident":"*types.FinOpsBillingSource","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"FinOpsBillingSource","Ident":"types.FinOpsBillingSource","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsZero":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Billing export source to import the cost of the cloud provider connector."}],"indexes":[{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"CostAnomaly","config":{"Table":""},"edges":[{"name":"connector","type":"Connector","field":"connector_id","ref_name":"cost_anomalies","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Connector to which the namespace belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"scope","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"scope,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Scope of the anomaly, project or namespace."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"name,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Name of the project or namespace."},{"name":"connector_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the connector to which the namespace belongs."},{"name":"fingerprint","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"String generated from the scope, connector and name, used to identify the anomaly of a day."},{"name":"day","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"day,omitempty,cli-table-column\"","immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Start time of the anomalous day."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Type of the anomaly, spike or drop."},{"name":"cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Cost of the day."},{"name":"baseline","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Average daily cost of the baseline days."},{"name":"deviation_percent","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Deviation of the cost from the baseline in percentage."},{"name":"score","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Deviation of the cost from the baseline in standard deviations."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["fingerprint","day"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"CostReport","config":{"Table":""},"edges":[{"name":"connector","type":"Connector","field":"connector_id","ref_name":"cost_reports","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Connector current cost item linked."}],"fields":[{"name":"start_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Usage start time for current cost."},{"name":"end_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Usage end time for current cost."},{"name":"minutes","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Usage minutes from start time to end time."},{"name":"connector_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the connector."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Resource name for current cost, could be __unmounted__ or the cloud resource ID."},{"name":"fingerprint","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"String generated from resource properties, used to identify this cost."},{"name":"cluster_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Cluster name for current cost."},{"name":"namespace","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Namespace for current cost."},{"name":"node","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Node for current cost."},{"name":"controller","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Controller name for the cost linked resource."},{"name":"controller_kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Controller kind for the cost linked resource, deployment, statefulSet etc."},{"name":"pod","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"comment":"Pod name for current cost."},{"name":"container","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"comment":"Container name for current cost."},{"name":"pvs","type":{"Type":3,"Ident":"map[string]types.PVCost","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"map[string]types.PVCost","Kind":21,"PkgPath":"","Methods":{}}},"default":true,"default_value":{},"default_kind":21,"immutable":true,"position":{"Index":13,"MixedIn":false,"MixinIndex":0},"comment":"PV list for current cost linked."},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"default":true,"default_value":{},"default_kind":21,"immutable":true,"position":{"Index":14,"MixedIn":false,"MixinIndex":0},"comment":"Labels for the cost linked resource."},{"name":"totalCost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":15,"MixedIn":false,"MixinIndex":0},"comment":"Cost number."},{"name":"currency","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":16,"MixedIn":false,"MixinIndex":0},"comment":"Cost currency."},{"name":"cpu_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":17,"MixedIn":false,"MixinIndex":0},"comment":"Cpu cost for current cost."},{"name":"cpu_core_request","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"immutable":true,"position":{"Index":18,"MixedIn":false,"MixinIndex":0},"comment":"Cpu core requested."},{"name":"gpu_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":19,"MixedIn":false,"MixinIndex":0},"comment":"GPU cost for current cost."},{"name":"gpu_count","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"immutable":true,"position":{"Index":20,"MixedIn":false,"MixinIndex":0},"comment":"GPU core count."},{"name":"ram_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":21,"MixedIn":false,"MixinIndex":0},"comment":"Ram cost for current cost."},{"name":"ram_byte_request","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"immutable":true,"position":{"Index":22,"MixedIn":false,"MixinIndex":0},"comment":"Ram requested in byte."},{"name":"pv_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":23,"MixedIn":false,"MixinIndex":0},"comment":"PV cost for current cost linked."},{"name":"pv_bytes","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":24,"MixedIn":false,"MixinIndex":0},"comment":"PV bytes for current cost linked."},{"name":"load_balancer_cost","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":25,"MixedIn":false,"MixinIndex":0},"comment":"LoadBalancer cost for current cost linked."},{"name":"cpu_core_usage_average","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":26,"MixedIn":false,"MixinIndex":0},"comment":"CPU core average usage."},{"name":"cpu_core_usage_max","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":27,"MixedIn":false,"MixinIndex":0},"comment":"CPU core max usage."},{"name":"ram_byte_usage_average","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":28,"MixedIn":false,"MixinIndex":0},"comment":"Ram average usage in byte."},{"name":"ram_byte_usage_max","type":{"Type":20,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":14,"position":{"Index":29,"MixedIn":false,"MixinIndex":0},"comment":"Ram max usage in byte."}],"indexes":[{"unique":true,"fields":["start_time","end_time","connector_id","fingerprint"]}],"hooks":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"DataEncryptionKey","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"provider","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Provider of the key encryption key which wraps the key, i.e. local, file."},{"name":"wrapped_key","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Wrapped key."},{"name":"active","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate whether the key is used to encrypt the new data."},{"name":"rotation_status","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Status of re-encrypting the existing data with the key, i.e. running, succeeded, failed."},{"name":"rotation_total","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Total count of the data to re-encrypt."},{"name":"rotation_processed","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Processed count of the data to re-encrypt."},{"name":"rotation_message","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Message of re-encrypting, i.e. the error message if failed."}],"indexes":[{"fields":["create_time"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"DistributeLock","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"ID is the lock key."},{"name":"expireAt","type":{"Type":13,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Expiration timestamp to prevent the lock be occupied for long time."},{"name":"holder","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Holder is the id for current key owner"}]},{"name":"Environment","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"environments","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the environment belongs."},{"name":"connectors","type":"Connector","through":{"N":"environment_connector_relationships","T":"EnvironmentConnectorRelationship"},"comment":"Connectors that configure to the environment."},{"name":"resources","type":"Resource","tag":"json:\"resources,omitempty,cli-ignore\"","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that belong to the environment."},{"name":"resource_runs","type":"ResourceRun","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceRuns that belong to the environment."},{"name":"resource_state_versions","type":"ResourceStateVersion","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceStateVersions that belong to the environment."},{"name":"resource_components","type":"ResourceComponent","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceComponents that belong to the environment."},{"name":"resource_run_approval_rules","type":"ResourceRunApprovalRule","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceRunApprovalRules that apply to the environment."},{"name":"resource_run_policies","type":"ResourceRunPolicy","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceRunPolicies that attach to the environment."},{"name":"budgets","type":"Budget","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Budgets that watch the environment."},{"name":"variables","type":"Variable","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Variables that belong to the environment."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Type of the environment."}],"indexes":[{"unique":true,"fields":["project_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"EnvironmentConnectorRelationship","config":{"Table":""},"edges":[{"name":"environment","type":"Environment","field":"environment_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Environment that connect to the relationship."},{"name":"connector","type":"Connector","field":"connector_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Connector that connect to the relationship."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the relationship connects."},{"name":"connector_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the connector to which the relationship connects."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["environment_id","connector_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Perspective","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"start_time","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Start time for the perspective."},{"name":"end_time","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"End time for the perspective."},{"name":"builtin","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Is builtin perspective."},{"name":"cost_queries","type":{"Type":3,"Ident":"[]types.QueryCondition","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"[]types.QueryCondition","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Indicated the perspective included cost queries, record the used query condition."}],"indexes":[{"unique":true,"fields":["name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"Project","config":{"Table":""},"edges":[{"name":"environments","type":"Environment","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Environments that belong to the project."},{"name":"connectors","type":"Connector","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Connectors that belong to the project."},{"name":"subject_roles","type":"SubjectRoleRelationship","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{},"SkipOutput":true},"_EntX_":{"SkipInput":{},"Input":{},"SkipOutput":true}},"comment":"Roles of a subject that belong to the project."},{"name":"resources","type":"Resource","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that belong to the project."},{"name":"resource_components","type":"ResourceComponent","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceComponents that belong to the project."},{"name":"resource_runs","type":"ResourceRun","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceRuns that belong to the project."},{"name":"resource_state_versions","type":"ResourceStateVersion","annotations":{"EntSQL":{"on_delete":"NO ACTION"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceStateVersions that belong to the project."},{"name":"resource_run_approval_rules","type":"ResourceRunApprovalRule","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceRunApprovalRules that belong to the project."},{"name":"resource_run_policies","type":"ResourceRunPolicy","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceRunPolicies that belong to the project."},{"name":"template_upgrade_campaigns","type":"TemplateUpgradeCampaign","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"TemplateUpgradeCampaigns that belong to the project."},{"name":"webhooks","type":"Webhook","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Webhooks that belong to the project."},{"name":"budgets","type":"Budget","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Budgets that belong to the project."},{"name":"variables","type":"Variable","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Variables that belong to the project."},{"name":"templates","type":"Template","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Templates that belong to the project."},{"name":"template_versions","type":"TemplateVersion","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"TemplateVersions that belong to the project."},{"name":"catalogs","type":"Catalog","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Catalogs that belong to the project."},{"name":"workflows","type":"Workflow","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Workflows that belong to the project."},{"name":"workflow_stages","type":"WorkflowStage","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowStages that belong to the project."},{"name":"workflow_steps","type":"WorkflowStep","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowSteps that belong to the project."},{"name":"workflow_executions","type":"WorkflowExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowExecutions that belong to the project."},{"name":"workflow_stage_executions","type":"WorkflowStageExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowStageExecutions that belong to the project."},{"name":"workflow_step_executions","type":"WorkflowStepExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"WorkflowStepExecutions that belong to the project."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}}],"indexes":[{"unique":true,"fields":["name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}}},{"name":"Resource","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resources","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the resource belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resources","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Environment to which the resource belongs."},{"name":"template","type":"TemplateVersion","field":"template_id","ref_name":"resources","unique":true,"inverse":true,"annotations":{"EntX":{"Input":{"Create":true,"Update":true},"SkipInput":{"Query":true}},"_EntX_":{"SkipInput":{"Query":true},"Input":{"Create":true,"Update":true}}},"comment":"Template to which the resource belongs."},{"name":"resource_definition","type":"ResourceDefinition","tag":"json:\"-\"","field":"resource_definition_id","ref_name":"resources","unique":true,"inverse":true,"annotations":{"EntX":{"Input":{"Create":true,"Update":true},"SkipInput":{"Query":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true},"Input":{"Create":true,"Update":true},"SkipOutput":true}},"comment":"Definition of the resource."},{"name":"resource_definition_matching_rule","type":"ResourceDefinitionMatchingRule","field":"resource_definition_matching_rule_id","ref_name":"resources","unique":true,"inverse":true,"annotations":{"EntX":{"Input":{"Create":true,"Update":true},"SkipInput":{"Query":true}},"_EntX_":{"SkipInput":{"Query":true},"Input":{"Create":true,"Update":true}}},"comment":"Resource definition matching rule which the resource matches."},{"name":"runs","type":"ResourceRun","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Runs that belong to the resource."},{"name":"components","type":"ResourceComponent","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Components that belong to the resource."},{"name":"dependencies","type":"Resource","through":{"N":"resource_relationships","T":"ResourceRelationship"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Dependencies that requires for the resource."},{"name":"state","type":"ResourceState","unique":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"State of the resource."},{"name":"state_versions","type":"ResourceStateVersion","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"State versions of the resource."},{"name":"state_lock","type":"ResourceStateLock","unique":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Lock of the resource state."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the resource deploys."},{"name":"template_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"nillable":true,"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template version to which the resource belong."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Create":true,"Query":true,"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Query":true,"Create":true,"Update":true}}},"comment":"Type of the resource referring to a resource definition type."},{"name":"resource_definition_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"nillable":true,"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ID of the resource definition to which the resource use."},{"name":"resource_definition_matching_rule_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"nillable":true,"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ID of the resource definition matching rule to which the resource use."},{"name":"attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"tag":"json:\"attributes\"","optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Attributes to configure the template."},{"name":"computed_attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"tag":"json:\"computedAttributes\"","optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Computed attributes generated from attributes and schemas."},{"name":"endpoints","type":{"Type":3,"Ident":"types.ResourceEndpoints","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ResourceEndpoints","Ident":"types.ResourceEndpoints","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Len":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Less":{"In":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null},{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Sort":{"In":[],"Out":[{"Name":"ResourceEndpoints","Ident":"types.ResourceEndpoints","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Swap":{"In":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null},{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}],"Out":[]}}}},"tag":"json:\"endpoints,omitempty,cli-table-column\"","optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Endpoints of the resource."},{"name":"drift","type":{"Type":3,"Ident":"*types.ResourceDrift","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ResourceDrift","Ident":"types.ResourceDrift","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsDrifted":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Drift detection result of the resource."}],"indexes":[{"unique":true,"fields":["project_id","environment_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ResourceComponent","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resource_components","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the component belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resource_components","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Environment to which the component deploys."},{"name":"resource","type":"Resource","field":"resource_id","ref_name":"components","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Resource to which the component belongs."},{"name":"connector","type":"Connector","field":"connector_id","ref_name":"resource_components","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Connector to which the component deploys."},{"name":"composition","type":"ResourceComponent","field":"composition_id","ref":{"name":"components","type":"ResourceComponent","comment":"Components that makes up the resource component."},"unique":true,"inverse":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"}}},{"name":"class","type":"ResourceComponent","field":"class_id","ref":{"name":"instances","type":"ResourceComponent","comment":"Instances that realizes the resource component."},"unique":true,"inverse":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"}}},{"name":"dependencies","type":"ResourceComponent","through":{"N":"resource_component_relationships","T":"ResourceComponentRelationship"},"comment":"Dependencies that requires for the resource component."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the component belongs."},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource to which the component belongs."},{"name":"connector_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the connector to which the component deploys."},{"name":"composition_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the parent component."},{"name":"class_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the parent class of the component realization."},{"name":"mode","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Mode that manages the generated component, it is the management way of the deployer to the component, which provides by deployer."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Type of the generated component, it is the type of the resource which the deployer observes, which provides by deployer."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Name of the generated component, it is the real identifier of the component, which provides by deployer."},{"name":"deployer_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Type of deployer."},{"name":"shape","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Shape of the component, it can be class or instance shape."},{"name":"keys","type":{"Type":3,"Ident":"*types.ResourceComponentOperationKeys","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ResourceComponentOperationKeys","Ident":"types.ResourceComponentOperationKeys","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipStoring":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipStoring":true}},"comment":"Keys of the component."},{"name":"index_key","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Index key to identify the component instance."}],"indexes":[{"fields":["create_time"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ResourceComponentRelationship","config":{"Table":""},"edges":[{"name":"resource_component","type":"ResourceComponent","field":"resource_component_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"ResourceComponent to which it currently belongs."},{"name":"dependency","type":"ResourceComponent","field":"dependency_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"ResourceComponent to which the dependency belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"resource_component_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource component."},{"name":"dependency_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource that resource depends on."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Type of the relationship."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["resource_component_id","dependency_id","type"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"ResourceDefinition","config":{"Table":""},"edges":[{"name":"matching_rules","type":"TemplateVersion","through":{"N":"resource_definition_matching_rules","T":"ResourceDefinitionMatchingRule"},"comment":"Template versions that configure to the resource definition."},{"name":"resources","type":"Resource","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that use the definition."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"type,omitempty,cli-table-column\"","immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Type of the resources generated from the resource definition."},{"name":"schema","type":{"Type":3,"Ident":"types.Schema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"Schema","Ident":"types.Schema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Expose":{"In":[{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"UISchema","Ident":"types.UISchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Intersect":{"In":[{"Name":"","Ident":"*types.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"OutputSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]},"RemoveVariableContext":{"In":[],"Out":[]},"SetOutputSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{"openAPISchema":null},"default_kind":25,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Generated schema of the resource definition."},{"name":"ui_schema","type":{"Type":3,"Ident":"*types.UISchema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"UISchema","Ident":"types.UISchema","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsUserEdited":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetUserEdited":{"In":[],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"UnsetUserEdited":{"In":[],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"optional":true,"default":true,"default_value":{"openAPISchema":null},"default_kind":22,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true},"SkipClearing":true}},"comment":"UI schema of the resource definition."},{"name":"builtin","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"builtin,omitempty,cli-table-column\"","default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate whether the resource definition is builtin, decided when creating."}],"indexes":[{"fields":["type"]},{"unique":true,"fields":["name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"ResourceDefinitionMatchingRule","config":{"Table":""},"edges":[{"name":"resource_definition","type":"ResourceDefinition","field":"resource_definition_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resource definition that connect to the relationship."},{"name":"template","type":"TemplateVersion","field":"template_id","unique":true,"required":true,"annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Template version that connect to the relationship."},{"name":"resources","type":"Resource","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that match the rule."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"resource_definition_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource definition to which the relationship connects."},{"name":"template_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template version to which the relationship connects."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Name of the matching rule."},{"name":"selector","type":{"Type":3,"Ident":"types.Selector","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"Selector","Ident":"types.Selector","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Resource selector."},{"name":"attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"comment":"Attributes to configure the template."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the matching rule."},{"name":"schema_default_value","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Default value generated from resource definition's schema, ui schema and attributes"}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["resource_definition_id","name"]},{"unique":true,"fields":["resource_definition_id","template_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"ResourceRelationship","config":{"Table":""},"edges":[{"name":"resource","type":"Resource","field":"resource_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resource to which it currently belongs."},{"name":"dependency","type":"Resource","field":"dependency_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Resource to which the dependency belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource that deploys after the dependency finished."},{"name":"dependency_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource that deploys before the resource begins."},{"name":"path","type":{"Type":3,"Ident":"[]object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":true,"RType":{"Name":"","Ident":"[]object.ID","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"ID list of the resource includes all dependencies and the resource itself."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Type of the relationship."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["resource_id","dependency_id","path"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"ResourceRun","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resource_runs","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the run belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resource_runs","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Environment to which the run deploys."},{"name":"resource","type":"Resource","field":"resource_id","ref_name":"runs","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Resource to which the run belongs."},{"name":"state_versions","type":"ResourceStateVersion","annotations":{"EntSQL":{"on_delete":"SET NULL"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"State versions written by the run."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the run belongs."},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource to which the run belongs."},{"name":"template_name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Name of the template."},{"name":"template_version","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Version of the template."},{"name":"template_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template."},{"name":"attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"comment":"Attributes to configure the template."},{"name":"computed_attributes","type":{"Type":8,"Ident":"property.Values","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","PkgName":"property","Nillable":true,"RType":{"Name":"Values","Ident":"property.Values","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/property","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"ValidateWith":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"json","postgres":"jsonb","sqlite3":"text"},"comment":"Computed attributes generated from attributes and schemas."},{"name":"variables","type":{"Type":8,"Ident":"crypto.Map[string,string]","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":true,"RType":{"Name":"Map[string,string]","Ident":"crypto.Map[string,string]","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{},"default_kind":21,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"Variables of the run."},{"name":"input_configs","type":{"Type":3,"Ident":"map[string][]uint8","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string][]uint8","Kind":21,"PkgPath":"","Methods":{}}},"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"sensitive":true,"comment":"Input configs of the run."},{"name":"deployer_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"Terraform","default_kind":24,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Type of deployer."},{"name":"duration","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"comment":"Duration in seconds of the run deploying."},{"name":"previous_required_providers","type":{"Type":3,"Ident":"[]types.ProviderRequirement","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"[]types.ProviderRequirement","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"comment":"Previous provider requirement of the run."},{"name":"plan_record","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":13,"MixedIn":false,"MixinIndex":0},"comment":"Record of the run plan."},{"name":"record","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":14,"MixedIn":false,"MixinIndex":0},"comment":"Record of the run."},{"name":"change_comment","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":15,"MixedIn":false,"MixinIndex":0},"comment":"Change comment of the run."},{"name":"created_by","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":16,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"User who created the run."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"position":{"Index":17,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Type of the run."},{"name":"preview","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":18,"MixedIn":false,"MixinIndex":0},"comment":"If the run is preview."},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":19,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":20,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"component_changes","type":{"Type":3,"Ident":"[]*types.ResourceComponentChange","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"[]*types.ResourceComponentChange","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":21,"MixedIn":false,"MixinIndex":0},"comment":"Changes of the resource components."},{"name":"component_change_summary","type":{"Type":3,"Ident":"types.ResourceComponentChangeSummary","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"ResourceComponentChangeSummary","Ident":"types.ResourceComponentChangeSummary","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":22,"MixedIn":false,"MixinIndex":0},"comment":"Change summary of the resource."},{"name":"cost_estimate","type":{"Type":3,"Ident":"*types.ResourceRunCostEstimate","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ResourceRunCostEstimate","Ident":"types.ResourceRunCostEstimate","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"DeltaString":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":23,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Estimated monthly cost of the planned changes of the resource."},{"name":"policy_results","type":{"Type":3,"Ident":"types.ResourceRunPolicyResults","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ResourceRunPolicyResults","Ident":"types.ResourceRunPolicyResults","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Denied":{"In":[],"Out":[{"Name":"ResourceRunPolicyResults","Ident":"types.ResourceRunPolicyResults","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Warned":{"In":[],"Out":[{"Name":"ResourceRunPolicyResults","Ident":"types.ResourceRunPolicyResults","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]}}}},"optional":true,"position":{"Index":24,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Evaluation results of the policies against the plan of the run."},{"name":"approval","type":{"Type":3,"Ident":"*types.ResourceRunApproval","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"ResourceRunApproval","Ident":"types.ResourceRunApproval","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Count":{"In":[{"Name":"ResourceRunApprovalRequirement","Ident":"types.ResourceRunApprovalRequirement","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"HasRecord":{"In":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsSatisfied":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":25,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Approval requirements and records of the run."}],"indexes":[{"fields":["create_time"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0},{"Index":0,"MixedIn":false,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ResourceRunApprovalRule","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resource_run_approval_rules","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the rule belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resource_run_approval_rules","unique":true,"inverse":true,"immutable":true,"comment":"Environment to which the rule applies."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the rule applies, empty means for all environments."},{"name":"environment_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Type of the environments to which the rule applies, empty means for all types."},{"name":"run_types","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Types of the runs which require approvals."},{"name":"approvals","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"approvals,omitempty,cli-table-column\"","default":true,"default_value":1,"default_kind":2,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Number of the approvals required."},{"name":"roles","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Roles which the approvers must hold one of, empty means any subject."}],"indexes":[{"unique":true,"fields":["project_id","environment_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL AND environment_id IS NOT NULL"}}},{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL AND environment_id IS NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL AND environment_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ResourceRunPolicy","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resource_run_policies","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the policy belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resource_run_policies","unique":true,"inverse":true,"immutable":true,"comment":"Environment to which the policy attaches."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the policy attaches, empty means for the whole project."},{"name":"enforcement","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"enforcement,omitempty,cli-table-column\"","default":true,"default_value":"deny","default_kind":24,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Enforcement of the policy, deny blocks the run from applying, warn annotates the run only."},{"name":"expression","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Expression of the policy, evaluates the plan of the run, returns true or a non-empty list of violation messages if violated."},{"name":"message","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Message to show if the policy is violated."},{"name":"disabled","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Indicate whether the policy is disabled."}],"indexes":[{"unique":true,"fields":["project_id","environment_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"environment_id IS NOT NULL"}}},{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"environment_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"ResourceState","config":{"Table":""},"edges":[{"name":"resource","type":"Resource","field":"resource_id","ref_name":"state","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Resource to which the state belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"data","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"","default_kind":24,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"State data of the resource."},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource to which the state belongs."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"ResourceStateLock","config":{"Table":""},"edges":[{"name":"resource","type":"Resource","field":"resource_id","ref_name":"state_lock","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resource to which the locked state belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource to which the locked state belongs."},{"name":"run_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the run which holds the lock, empty if the lock is not requested by the run's deployment."},{"name":"lock_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"ID of the lock generated by the client."},{"name":"holder","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Holder of the lock."},{"name":"info","type":{"Type":3,"Ident":"types.ResourceStateLockInfo","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"ResourceStateLockInfo","Ident":"types.ResourceStateLockInfo","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Original lock information submitted by the client."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["resource_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"ResourceStateVersion","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"resource_state_versions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the state version belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"resource_state_versions","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Environment to which the state version belongs."},{"name":"resource","type":"Resource","field":"resource_id","ref_name":"state_versions","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Resource to which the state version belongs."},{"name":"run","type":"ResourceRun","field":"run_id","ref_name":"state_versions","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Run which writes the state version."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the resource deploys."},{"name":"resource_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the resource to which the state version belongs."},{"name":"run_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the run which writes the state version."},{"name":"serial","type":{"Type":13,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Serial of the state, increased by the client on every change."},{"name":"lineage","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Lineage of the state, generated by the client when the state is initialized."},{"name":"data","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"State data of the version."}],"indexes":[{"fields":["create_time"]},{"fields":["resource_id","serial"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Role","config":{"Table":""},"edges":[{"name":"subjects","type":"Subject","ref_name":"roles","through":{"N":"subject_role_relationships","T":"SubjectRoleRelationship"},"inverse":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Subjects to which the role configures."}],"fields":[{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"unique":true,"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"It is also the name of the role."},{"name":"kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"kind,omitempty,cli-table-column\"","default":true,"default_value":"system","default_kind":24,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"The kind of the role."},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"The detail of the role."},{"name":"policies","type":{"Type":3,"Ident":"types.RolePolicies","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Clone":{"In":[],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Deduplicate":{"In":[],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Delete":{"In":[{"Name":"","Ident":"[]types.RolePolicy","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Len":{"In":[],"Out":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}]},"Less":{"In":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null},{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Normalize":{"In":[],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Sort":{"In":[],"Out":[{"Name":"RolePolicies","Ident":"types.RolePolicies","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Swap":{"In":[{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null},{"Name":"int","Ident":"int","Kind":2,"PkgPath":"","Methods":null}],"Out":[]}}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"The policy list of the role."},{"name":"applicable_environment_types","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":[],"default_kind":23,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"The environment type list of the role to apply, only for system kind role."},{"name":"session","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Indicate whether the role is session level, decide when creating."},{"name":"builtin","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"builtin,omitempty,cli-table-column\"","default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate whether the role is builtin, decide when creating."}],"indexes":[{"fields":["create_time"]}],"hooks":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Setting","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Update":true}},"_EntX_":{"SkipInput":{"Create":true,"Update":true},"Input":{}}},"comment":"The name of system setting."},{"name":"value","type":{"Type":7,"Ident":"crypto.String","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":false,"RType":{"Name":"String","Ident":"crypto.String","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"tag":"json:\"value,omitempty,cli-table-column\"","position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"The value of system setting, store in string."},{"name":"hidden","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"default":true,"default_value":false,"default_kind":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate the system setting should be hidden or not, default is visible."},{"name":"editable","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"default":true,"default_value":false,"default_kind":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate the system setting should be edited or not, default is readonly."},{"name":"sensitive","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"default":true,"default_value":false,"default_kind":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Indicate the system setting should be sanitized or not before exposing, default is not."},{"name":"private","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"default":true,"default_value":false,"default_kind":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Indicate the system setting should be exposed or not, default is exposed."},{"name":"configured","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipStoring":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipStoring":true}},"comment":"Configured indicates the setting whether to be configured."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"StorageObject","config":{"Table":""},"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"key","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Key of the object, i.e. walrus/project/xxx/environment/xxx/resource/xxx.zip."},{"name":"data","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"sensitive":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Data of the object."},{"name":"size","type":{"Type":13,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":6,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Size of the data in bytes."},{"name":"checksum","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Checksum of the data, i.e. sha256:xxx."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["key"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Subject","config":{"Table":""},"edges":[{"name":"tokens","type":"Token","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Tokens that belong to the subject."},{"name":"roles","type":"Role","through":{"N":"subject_role_relationships","T":"SubjectRoleRelationship"},"comment":"Roles that configure to the subject."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"kind,omitempty,cli-table-column\"","default":true,"default_value":"user","default_kind":24,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"The kind of the subject."},{"name":"domain","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"builtin","default_kind":24,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"The domain of the subject."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"The name of the subject."},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"The detail of the subject."},{"name":"builtin","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Indicate whether the subject is builtin, decide when creating."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["kind","domain","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"SubjectRoleRelationship","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"subject_roles","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the subject role belongs."},{"name":"subject","type":"Subject","field":"subject_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Subject that connect to the relationship."},{"name":"role","type":"Role","field":"role_id","unique":true,"required":true,"immutable":true,"annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"Role that connect to the relationship."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"subject_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the subject to which the relationship connects."},{"name":"role_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"ID of the role to which the relationship connects."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["project_id","subject_id","role_id"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["subject_id","role_id"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Template","config":{"Table":""},"edges":[{"name":"versions","type":"TemplateVersion","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Versions that belong to the template."},{"name":"catalog","type":"Catalog","field":"catalog_id","ref_name":"templates","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Catalog to which the template belongs."},{"name":"project","type":"Project","field":"project_id","ref_name":"templates","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the template belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"icon","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"A URL to an SVG or PNG image to be used as an icon."},{"name":"source","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Source of the template."},{"name":"catalog_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template catalog."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"credential","type":{"Type":8,"Ident":"crypto.Map[string,string]","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":true,"RType":{"Name":"Map[string,string]","Ident":"crypto.Map[string,string]","Kind":21,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"sensitive":true,"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}},"comment":"Credential to access the private registry of the source, keeps the previous one if not provided."}],"indexes":[{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"TemplateUpgradeCampaign","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"template_upgrade_campaigns","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the campaign belongs."},{"name":"template_version","type":"TemplateVersion","field":"template_version_id","ref_name":"upgrade_campaigns","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Template version to upgrade from."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means upgrading the resources of all projects."},{"name":"template_version_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template version to upgrade from."},{"name":"target_version_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template version to upgrade to."},{"name":"preview","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Preview the upgrade without applying, the planned runs can be applied later."},{"name":"batch_size","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":10,"default_kind":2,"immutable":true,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Count of the resources to upgrade in each batch."},{"name":"change_comment","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"immutable":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Change comment of the upgrade runs."},{"name":"subject_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"ID of the subject who creates the campaign, the upgrade runs are acted as the subject."},{"name":"status","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"status,omitempty,cli-table-column\"","default":true,"default_value":"pending","default_kind":24,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Status of the campaign, pending, running, succeeded, failed or canceled."},{"name":"message","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Message of the campaign status."},{"name":"results","type":{"Type":3,"Ident":"[]types.TemplateUpgradeCampaignResult","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"[]types.TemplateUpgradeCampaignResult","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Upgrade results of the affected resources."}],"indexes":[{"fields":["create_time"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"TemplateVersion","config":{"Table":""},"edges":[{"name":"template","type":"Template","field":"template_id","ref_name":"versions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Template to which the template version belongs."},{"name":"resources","type":"Resource","annotations":{"EntSQL":{"on_delete":"RESTRICT"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resources that belong to the template version."},{"name":"upgrade_campaigns","type":"TemplateUpgradeCampaign","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Upgrade campaigns of the resources that use the template version."},{"name":"resource_definitions","type":"ResourceDefinition","ref_name":"matching_rules","through":{"N":"resource_definition_matching_rules","T":"ResourceDefinitionMatchingRule"},"inverse":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Resource definitions that use the template version."},{"name":"project","type":"Project","field":"project_id","ref_name":"template_versions","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the template version belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":2},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"template_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the template."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"Name of the template."},{"name":"version","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"version,omitempty,cli-table-column\"","immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Version of the template."},{"name":"source","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Source of the template."},{"name":"schema","type":{"Type":3,"Ident":"types.TemplateVersionSchema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"TemplateVersionSchema","Ident":"types.TemplateVersionSchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Expose":{"In":[{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":null}],"Out":[{"Name":"UISchema","Ident":"types.UISchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":null}]},"Intersect":{"In":[{"Name":"","Ident":"*types.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"OutputSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]},"RemoveVariableContext":{"In":[],"Out":[]},"SetOutputSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{"openAPISchema":null,"readme":"","requiredProviders":null},"default_kind":25,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Generated schema and data of the template."},{"name":"original_ui_schema","type":{"Type":3,"Ident":"types.UISchema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"UISchema","Ident":"types.UISchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsUserEdited":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetUserEdited":{"In":[],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"UnsetUserEdited":{"In":[],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{"openAPISchema":null},"default_kind":25,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"store the original ui schema of the template."},{"name":"ui_schema","type":{"Type":3,"Ident":"types.UISchema","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"UISchema","Ident":"types.UISchema","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"IsEmpty":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"IsUserEdited":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetUserEdited":{"In":[],"Out":[]},"SetVariableSchema":{"In":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}],"Out":[]},"UnsetUserEdited":{"In":[],"Out":[]},"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"VariableSchema":{"In":[],"Out":[{"Name":"","Ident":"*openapi3.Schema","Kind":22,"PkgPath":"","Methods":null}]}}}},"default":true,"default_value":{"openAPISchema":null},"default_kind":25,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{"Update":true},"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{"Update":true}}},"comment":"ui schema of the template."},{"name":"schema_default_value","type":{"Type":5,"Ident":"","PkgPath":"","PkgName":"","Nillable":true,"RType":null},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Default value generated from schema and ui schema"},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"lifecycle","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"default":true,"default_value":"active","default_kind":24,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Lifecycle state of the template version, active, deprecated or blocked."},{"name":"lifecycle_reason","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Reason of the lifecycle state."},{"name":"test_results","type":{"Type":3,"Ident":"[]types.TemplateVersionTestResult","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"","Ident":"[]types.TemplateVersionTestResult","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Results of testing the template version with the fixtures."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["name","version","project_id"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL"}}},{"unique":true,"fields":["name","version"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Token","config":{"Table":""},"edges":[{"name":"subject","type":"Subject","field":"subject_id","ref_name":"tokens","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"ValidateContextFuncs":["intercept.WithSubjectInterceptor"]},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"ValidateContextFuncs":["intercept.WithSubjectInterceptor"]}},"comment":"Subject to which the token belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"subject_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the subject to belong."},{"name":"kind","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"api","default_kind":24,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"The kind of token."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"The name of token."},{"name":"expiration","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"optional":true,"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"The time of expiration, empty means forever."},{"name":"value","type":{"Type":7,"Ident":"crypto.String","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":false,"RType":{"Name":"String","Ident":"crypto.String","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"sensitive":true,"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"The value of token, store in string."},{"name":"access_token","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipStoring":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipStoring":true}},"comment":"AccessToken is the token used for authentication."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["subject_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"Variable","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"variables","unique":true,"inverse":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the variable belongs."},{"name":"environment","type":"Environment","field":"environment_id","ref_name":"variables","unique":true,"inverse":true,"immutable":true,"comment":"Environment to which the variable belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong, empty means for all projects."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment to which the variable belongs to."},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"The name of variable."},{"name":"value","type":{"Type":7,"Ident":"crypto.String","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":false,"RType":{"Name":"String","Ident":"crypto.String","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"The value of variable, store in string."},{"name":"sensitive","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"The value is sensitive or not."},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Description of the variable."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["project_id","environment_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL and environment_id IS NOT NULL"}}},{"unique":true,"fields":["project_id","name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NOT NULL AND environment_id IS NULL"}}},{"unique":true,"fields":["name"],"annotations":{"EntSQLIndexes":{"Desc":false,"DescColumns":null,"IncludeColumns":null,"OpClass":"","OpClassColumns":null,"Prefix":0,"PrefixColumns":null,"Type":"","Types":null,"Where":"project_id IS NULL AND environment_id IS NULL"}}}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"Webhook","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"webhooks","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the webhook belongs."},{"name":"deliveries","type":"WebhookDelivery","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Deliveries of the webhook."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"url","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"url,omitempty,cli-table-column\"","validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"URL to deliver the events."},{"name":"secret","type":{"Type":7,"Ident":"crypto.String","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","PkgName":"crypto","Nillable":false,"RType":{"Name":"String","Ident":"crypto.String","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/crypto","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"sensitive":true,"schema_type":{"mysql":"blob","postgres":"bytea","sqlite3":"blob"},"comment":"Secret to sign the payloads with HMAC-SHA256, keeps the previous one if not provided."},{"name":"topics","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Topics of the model changes to subscribe, i.e. resources, resource_runs."},{"name":"event_types","type":{"Type":3,"Ident":"[]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"[]string","Kind":23,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Types of the events to subscribe, empty means all of create, update and delete."},{"name":"disabled","type":{"Type":1,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":false,"default_kind":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Indicate whether the webhook is disabled."}],"indexes":[{"unique":true,"fields":["project_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}],"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"WebhookDelivery","config":{"Table":""},"edges":[{"name":"webhook","type":"Webhook","field":"webhook_id","ref_name":"deliveries","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Webhook to which the delivery belongs."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":1,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"webhook_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the webhook to belong."},{"name":"event_id","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"comment":"ID of the event to deliver."},{"name":"topic","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Topic of the event."},{"name":"event_type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Type of the event."},{"name":"payload","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Payload of the event to deliver."},{"name":"status","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":"pending","default_kind":24,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Status of the delivery, pending, succeeded or failed."},{"name":"attempts","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Count of the delivering attempts."},{"name":"response_status","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Status code of the last response."},{"name":"response_body","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Body of the last response, truncated if too large."},{"name":"message","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Error message of the last attempt."}],"indexes":[{"fields":["create_time"]},{"unique":true,"fields":["webhook_id","event_id"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}]},{"name":"Workflow","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflows","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow belongs."},{"name":"stages","type":"WorkflowStage","annotations":{"EntSQL":{"on_delete":"CASCADE"}},"comment":"Stages that belong to this workflow."},{"name":"executions","type":"WorkflowExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Workflow executions that belong to this workflow."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project that this workflow belongs to."},{"name":"environment_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the environment that this workflow belongs to."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"Type of the workflow."},{"name":"parallelism","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":10,"default_kind":2,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"comment":"Number of task pods that can be executed in parallel of workflow."},{"name":"timeout","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Timeout seconds of the workflow."},{"name":"version","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Execution version of the workflow."},{"name":"variables","type":{"Type":3,"Ident":"types.WorkflowVariables","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"WorkflowVariables","Ident":"types.WorkflowVariables","Kind":23,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{"Validate":{"In":[],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Configs of workflow variables."}],"indexes":[{"unique":true,"fields":["project_id","name"]}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowExecution","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_executions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Update":true},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{"Create":true,"Update":true},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow execution belongs."},{"name":"stages","type":"WorkflowStageExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"},"EntX":{"Input":{},"SkipInput":{"Create":true,"Update":true}},"_EntX_":{"SkipInput":{"Create":true,"Update":true},"Input":{}}},"comment":"Workflow stage executions that belong to this workflow execution."},{"name":"workflow","type":"Workflow","field":"workflow_id","ref_name":"executions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Update":true}},"_EntX_":{"SkipInput":{"Create":true,"Update":true},"Input":{}}},"comment":"Workflow that this workflow execution belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"version","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"tag":"json:\"version,omitempty,cli-table-column\"","validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Version of the workflow execution."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Type of the workflow execution."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow execution belongs to."},{"name":"subject_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"ID of the subject that create workflow execution."},{"name":"execute_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Time of the workflow execution started."},{"name":"times","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":1,"default_kind":2,"validators":1,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Number of times that this workflow execution has been executed."},{"name":"duration","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"comment":"Duration seconds of the workflow execution."},{"name":"parallelism","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":10,"default_kind":2,"validators":1,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"Number of task pods that can be executed in parallel of workflow."},{"name":"timeout","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Timeout of the workflow execution."},{"name":"trigger","type":{"Type":3,"Ident":"types.WorkflowExecutionTrigger","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":false,"RType":{"Name":"WorkflowExecutionTrigger","Ident":"types.WorkflowExecutionTrigger","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"default":true,"default_value":{"type":"","user":""},"default_kind":25,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Trigger of the workflow execution."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowStage","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_stages","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow stage belongs."},{"name":"steps","type":"WorkflowStep","annotations":{"EntSQL":{"on_delete":"CASCADE"}},"comment":"Workflow steps that belong to this workflow stage."},{"name":"workflow","type":"Workflow","field":"workflow_id","ref_name":"stages","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Workflow that this workflow stage belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow stage belongs to."},{"name":"dependencies","type":{"Type":3,"Ident":"[]object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":true,"RType":{"Name":"","Ident":"[]object.ID","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"comment":"ID list of the workflow stages that this workflow stage depends on."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the workflow stage."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowStageExecution","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_stage_executions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow stage executions belongs."},{"name":"steps","type":"WorkflowStepExecution","annotations":{"EntSQL":{"on_delete":"CASCADE"}},"comment":"Workflow step executions that belong to this workflow stage execution."},{"name":"workflow_execution","type":"WorkflowExecution","field":"workflow_execution_id","ref_name":"stages","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Workflow execution that this workflow stage execution belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow execution belongs to."},{"name":"workflow_stage_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow stage that this workflow stage execution belongs to."},{"name":"workflow_execution_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow execution that this workflow stage execution belongs to."},{"name":"execute_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Time of the stage execution started."},{"name":"duration","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Duration of the workflow stage execution."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the workflow stage execution."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowStep","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_steps","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the step belongs."},{"name":"stage","type":"WorkflowStage","field":"workflow_stage_id","ref_name":"steps","unique":true,"inverse":true,"required":true,"immutable":true,"comment":"Workflow stage that this workflow step belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"comment":"Type of the workflow step."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow step belongs to."},{"name":"workflow_stage_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the stage that this workflow step belongs to."},{"name":"attributes","type":{"Type":3,"Ident":"map[string]interface {}","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]interface {}","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"comment":"Attributes of the workflow step."},{"name":"inputs","type":{"Type":3,"Ident":"map[string]interface {}","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]interface {}","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Inputs of the workflow step."},{"name":"outputs","type":{"Type":3,"Ident":"map[string]interface {}","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]interface {}","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Outputs of the workflow step."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the workflow step."},{"name":"dependencies","type":{"Type":3,"Ident":"[]object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":true,"RType":{"Name":"","Ident":"[]object.ID","Kind":23,"PkgPath":"","Methods":{}}},"default":true,"default_value":[],"default_kind":23,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"comment":"ID list of the workflow steps that this workflow step depends on."},{"name":"retryStrategy","type":{"Type":3,"Ident":"*types.RetryStrategy","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"RetryStrategy","Ident":"types.RetryStrategy","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"comment":"Retry policy of the workflow step."},{"name":"timeout","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Timeout seconds of the workflow step, 0 means no timeout."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]},{"name":"WorkflowStepExecution","config":{"Table":""},"edges":[{"name":"project","type":"Project","field":"project_id","ref_name":"workflow_step_executions","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]},"_EntX_":{"SkipInput":{},"Input":{},"ValidateContextFuncs":["intercept.WithProjectInterceptor"]}},"comment":"Project to which the workflow step execution belongs."},{"name":"stage_execution","type":"WorkflowStageExecution","field":"workflow_stage_execution_id","ref_name":"steps","unique":true,"inverse":true,"required":true,"immutable":true,"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true}},"_EntX_":{"SkipInput":{"Create":true},"Input":{}}},"comment":"Workflow stage execution that this workflow step execution belongs to."}],"fields":[{"name":"id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"}},{"name":"name","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":1,"MixedIn":true,"MixinIndex":0}},{"name":"description","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":2,"MixedIn":true,"MixinIndex":0}},{"name":"labels","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":3,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipClearing":true,"SkipInput":{}},"_EntX_":{"SkipInput":{},"Input":{},"SkipClearing":true}}},{"name":"annotations","type":{"Type":3,"Ident":"map[string]string","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]string","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"default":true,"default_value":{},"default_kind":21,"position":{"Index":4,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}}},{"name":"create_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"immutable":true,"position":{"Index":5,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"update_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"nillable":true,"default":true,"default_kind":19,"update_default":true,"position":{"Index":6,"MixedIn":true,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"status","type":{"Type":3,"Ident":"status.Status","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","PkgName":"status","Nillable":false,"RType":{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":{"Changed":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Equal":{"In":[{"Name":"Status","Ident":"status.Status","Kind":25,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/status","Methods":null}],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"SetConditions":{"In":[{"Name":"","Ident":"[]status.Condition","Kind":23,"PkgPath":"","Methods":null}],"Out":[]},"SetSummary":{"In":[{"Name":"","Ident":"*status.Summary","Kind":22,"PkgPath":"","Methods":null}],"Out":[]}}}},"optional":true,"position":{"Index":0,"MixedIn":true,"MixinIndex":1},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}}},{"name":"workflow_step_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":0,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow step that this workflow step execution belongs to."},{"name":"workflow_execution_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":1,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow execution that this workflow step execution belongs to."},{"name":"workflow_stage_execution_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"position":{"Index":2,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow stage execution that this workflow step execution belongs to."},{"name":"project_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":3,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the project to belong."},{"name":"workflow_id","type":{"Type":7,"Ident":"object.ID","PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","PkgName":"object","Nillable":false,"RType":{"Name":"ID","Ident":"object.ID","Kind":24,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types/object","Methods":{"Scan":{"In":[{"Name":"","Ident":"interface {}","Kind":20,"PkgPath":"","Methods":null}],"Out":[{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]},"String":{"In":[],"Out":[{"Name":"string","Ident":"string","Kind":24,"PkgPath":"","Methods":null}]},"Valid":{"In":[],"Out":[{"Name":"bool","Ident":"bool","Kind":1,"PkgPath":"","Methods":null}]},"Value":{"In":[],"Out":[{"Name":"Value","Ident":"driver.Value","Kind":20,"PkgPath":"database/sql/driver","Methods":null},{"Name":"error","Ident":"error","Kind":20,"PkgPath":"","Methods":null}]}}}},"immutable":true,"validators":1,"position":{"Index":4,"MixedIn":false,"MixinIndex":0},"schema_type":{"mysql":"bigint","postgres":"bigint","sqlite3":"integer"},"comment":"ID of the workflow that this workflow step execution belongs to."},{"name":"type","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"immutable":true,"validators":1,"position":{"Index":5,"MixedIn":false,"MixinIndex":0},"comment":"Type of the workflow step execution."},{"name":"attributes","type":{"Type":3,"Ident":"map[string]interface {}","PkgPath":"","PkgName":"","Nillable":true,"RType":{"Name":"","Ident":"map[string]interface {}","Kind":21,"PkgPath":"","Methods":{}}},"optional":true,"position":{"Index":6,"MixedIn":false,"MixinIndex":0},"comment":"Attributes of the workflow step execution."},{"name":"times","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":1,"default_kind":2,"validators":1,"position":{"Index":7,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Number of times that this workflow step execution has been executed."},{"name":"execute_time","type":{"Type":2,"Ident":"","PkgPath":"time","PkgName":"","Nillable":false,"RType":null},"optional":true,"position":{"Index":8,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Time of the step execution started."},{"name":"duration","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":9,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true}},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{}}},"comment":"Execution duration seconds of the workflow step."},{"name":"retryStrategy","type":{"Type":3,"Ident":"*types.RetryStrategy","PkgPath":"github.com/seal-io/walrus/pkg/dao/types","PkgName":"types","Nillable":true,"RType":{"Name":"RetryStrategy","Ident":"types.RetryStrategy","Kind":22,"PkgPath":"github.com/seal-io/walrus/pkg/dao/types","Methods":{}}},"optional":true,"position":{"Index":10,"MixedIn":false,"MixinIndex":0},"comment":"Retry policy of the workflow step."},{"name":"timeout","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":11,"MixedIn":false,"MixinIndex":0},"comment":"Timeout of the workflow step execution."},{"name":"order","type":{"Type":12,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"default":true,"default_value":0,"default_kind":2,"validators":1,"position":{"Index":12,"MixedIn":false,"MixinIndex":0},"annotations":{"EntX":{"Input":{},"SkipInput":{"Create":true,"Query":true,"Update":true},"SkipOutput":true},"_EntX_":{"SkipInput":{"Query":true,"Create":true,"Update":true},"Input":{},"SkipOutput":true}},"comment":"Order of the workflow step execution."},{"name":"record","type":{"Type":7,"Ident":"","PkgPath":"","PkgName":"","Nillable":false,"RType":null},"size":2147483647,"default":true,"default_value":"","default_kind":24,"position":{"Index":13,"MixedIn":false,"MixinIndex":0},"comment":"Log record of the workflow step execution."}],"hooks":[{"Index":0,"MixedIn":true,"MixinIndex":0}],"interceptors":[{"Index":0,"MixedIn":false,"MixinIndex":0}]}],"Features":["intercept","schema/snapshot","sql/schemaconfig","sql/lock","sql/modifier","sql/execquery","sql/upsert","sql/versioned-migration"]}`
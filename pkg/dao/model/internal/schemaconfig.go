@@ -10,9 +10,15 @@ import "context"
 // SchemaConfig represents alternative schema names for all tables
 // that can be passed at runtime.
 type SchemaConfig struct {
+	AuditLog                         string // AuditLog table.
+	Budget                           string // Budget table.
+	BudgetEvent                      string // BudgetEvent table.
 	Catalog                          string // Catalog table.
+	ChargebackStatement              string // ChargebackStatement table.
 	Connector                        string // Connector table.
+	CostAnomaly                      string // CostAnomaly table.
 	CostReport                       string // CostReport table.
+	DataEncryptionKey                string // DataEncryptionKey table.
 	DistributeLock                   string // DistributeLock table.
 	Environment                      string // Environment table.
 	EnvironmentConnectorRelationship string // EnvironmentConnectorRelationship table.
@@ -25,15 +31,23 @@ type SchemaConfig struct {
 	ResourceDefinitionMatchingRule   string // ResourceDefinitionMatchingRule table.
 	ResourceRelationship             string // ResourceRelationship table.
 	ResourceRun                      string // ResourceRun table.
+	ResourceRunApprovalRule          string // ResourceRunApprovalRule table.
+	ResourceRunPolicy                string // ResourceRunPolicy table.
 	ResourceState                    string // ResourceState table.
+	ResourceStateLock                string // ResourceStateLock table.
+	ResourceStateVersion             string // ResourceStateVersion table.
 	Role                             string // Role table.
 	Setting                          string // Setting table.
+	StorageObject                    string // StorageObject table.
 	Subject                          string // Subject table.
 	SubjectRoleRelationship          string // SubjectRoleRelationship table.
 	Template                         string // Template table.
+	TemplateUpgradeCampaign          string // TemplateUpgradeCampaign table.
 	TemplateVersion                  string // TemplateVersion table.
 	Token                            string // Token table.
 	Variable                         string // Variable table.
+	Webhook                          string // Webhook table.
+	WebhookDelivery                  string // WebhookDelivery table.
 	Workflow                         string // Workflow table.
 	WorkflowExecution                string // WorkflowExecution table.
 	WorkflowStage                    string // WorkflowStage table.
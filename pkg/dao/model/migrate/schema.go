@@ -12,6 +12,140 @@ import (
 )
 
 var (
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "subject_id", Type: field.TypeString, Nullable: true},
+		{Name: "subject_name", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "method", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString},
+		{Name: "resource_kind", Type: field.TypeString, Nullable: true},
+		{Name: "resource_id", Type: field.TypeString, Nullable: true},
+		{Name: "project_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "request", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeInt},
+		{Name: "result", Type: field.TypeString},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1]},
+			},
+			{
+				Name:    "auditlog_subject_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2]},
+			},
+			{
+				Name:    "auditlog_project_id_environment_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9], AuditLogsColumns[10]},
+			},
+			{
+				Name:    "auditlog_resource_kind_resource_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[7], AuditLogsColumns[8]},
+			},
+		},
+	}
+	// BudgetsColumns holds the columns for the "budgets" table.
+	BudgetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "period", Type: field.TypeString, Default: "monthly"},
+		{Name: "start_time", Type: field.TypeTime, Nullable: true},
+		{Name: "end_time", Type: field.TypeTime, Nullable: true},
+		{Name: "thresholds", Type: field.TypeJSON},
+		{Name: "spend", Type: field.TypeJSON, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "project_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// BudgetsTable holds the schema information for the "budgets" table.
+	BudgetsTable = &schema.Table{
+		Name:       "budgets",
+		Columns:    BudgetsColumns,
+		PrimaryKey: []*schema.Column{BudgetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budgets_environments_budgets",
+				Columns:    []*schema.Column{BudgetsColumns[13]},
+				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "budgets_projects_budgets",
+				Columns:    []*schema.Column{BudgetsColumns[14]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budget_project_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{BudgetsColumns[14], BudgetsColumns[1]},
+			},
+		},
+	}
+	// BudgetEventsColumns holds the columns for the "budget_events" table.
+	BudgetEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "threshold_type", Type: field.TypeString},
+		{Name: "threshold_percent", Type: field.TypeFloat64},
+		{Name: "amount", Type: field.TypeFloat64},
+		{Name: "cost", Type: field.TypeFloat64},
+		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "budget_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// BudgetEventsTable holds the schema information for the "budget_events" table.
+	BudgetEventsTable = &schema.Table{
+		Name:       "budget_events",
+		Columns:    BudgetEventsColumns,
+		PrimaryKey: []*schema.Column{BudgetEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budget_events_budgets_events",
+				Columns:    []*schema.Column{BudgetEventsColumns[13]},
+				RefColumns: []*schema.Column{BudgetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budgetevent_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetEventsColumns[1]},
+			},
+			{
+				Name:    "budgetevent_budget_id_period_start_threshold_type_threshold_percent",
+				Unique:  true,
+				Columns: []*schema.Column{BudgetEventsColumns[13], BudgetEventsColumns[6], BudgetEventsColumns[8], BudgetEventsColumns[9]},
+			},
+		},
+	}
 	// CatalogsColumns holds the columns for the "catalogs" table.
 	CatalogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
@@ -26,6 +160,10 @@ var (
 		{Name: "source", Type: field.TypeString},
 		{Name: "sync", Type: field.TypeJSON, Nullable: true},
 		{Name: "filter_pattern", Type: field.TypeString, Nullable: true},
+		{Name: "repositories", Type: field.TypeJSON, Nullable: true},
+		{Name: "directories", Type: field.TypeJSON, Nullable: true},
+		{Name: "monorepo", Type: field.TypeBool, Nullable: true},
+		{Name: "credential", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "blob", "postgres": "bytea", "sqlite3": "blob"}},
 		{Name: "project_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 	}
 	// CatalogsTable holds the schema information for the "catalogs" table.
@@ -36,7 +174,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "catalogs_projects_catalogs",
-				Columns:    []*schema.Column{CatalogsColumns[12]},
+				Columns:    []*schema.Column{CatalogsColumns[16]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -45,7 +183,7 @@ var (
 			{
 				Name:    "catalog_project_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{CatalogsColumns[12], CatalogsColumns[1]},
+				Columns: []*schema.Column{CatalogsColumns[16], CatalogsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "project_id IS NOT NULL",
 				},
@@ -60,6 +198,36 @@ var (
 			},
 		},
 	}
+	// ChargebackStatementsColumns holds the columns for the "chargeback_statements" table.
+	ChargebackStatementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "shared_options", Type: field.TypeJSON, Nullable: true},
+		{Name: "total_cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "items", Type: field.TypeJSON, Nullable: true},
+	}
+	// ChargebackStatementsTable holds the schema information for the "chargeback_statements" table.
+	ChargebackStatementsTable = &schema.Table{
+		Name:       "chargeback_statements",
+		Columns:    ChargebackStatementsColumns,
+		PrimaryKey: []*schema.Column{ChargebackStatementsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "chargebackstatement_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ChargebackStatementsColumns[1]},
+			},
+			{
+				Name:    "chargebackstatement_start_time_end_time",
+				Unique:  false,
+				Columns: []*schema.Column{ChargebackStatementsColumns[3], ChargebackStatementsColumns[4]},
+			},
+		},
+	}
 	// ConnectorsColumns holds the columns for the "connectors" table.
 	ConnectorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
@@ -77,6 +245,7 @@ var (
 		{Name: "config_data", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "blob", "postgres": "bytea", "sqlite3": "blob"}},
 		{Name: "enable_fin_ops", Type: field.TypeBool},
 		{Name: "fin_ops_custom_pricing", Type: field.TypeJSON, Nullable: true},
+		{Name: "fin_ops_billing_source", Type: field.TypeJSON, Nullable: true},
 		{Name: "project_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 	}
 	// ConnectorsTable holds the schema information for the "connectors" table.
@@ -87,7 +256,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "connectors_projects_connectors",
-				Columns:    []*schema.Column{ConnectorsColumns[15]},
+				Columns:    []*schema.Column{ConnectorsColumns[16]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -96,7 +265,7 @@ var (
 			{
 				Name:    "connector_project_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{ConnectorsColumns[15], ConnectorsColumns[1]},
+				Columns: []*schema.Column{ConnectorsColumns[16], ConnectorsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "project_id IS NOT NULL",
 				},
@@ -111,6 +280,48 @@ var (
 			},
 		},
 	}
+	// CostAnomaliesColumns holds the columns for the "cost_anomalies" table.
+	CostAnomaliesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "fingerprint", Type: field.TypeString},
+		{Name: "day", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeString},
+		{Name: "cost", Type: field.TypeFloat64},
+		{Name: "baseline", Type: field.TypeFloat64},
+		{Name: "deviation_percent", Type: field.TypeFloat64},
+		{Name: "score", Type: field.TypeFloat64},
+		{Name: "connector_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// CostAnomaliesTable holds the schema information for the "cost_anomalies" table.
+	CostAnomaliesTable = &schema.Table{
+		Name:       "cost_anomalies",
+		Columns:    CostAnomaliesColumns,
+		PrimaryKey: []*schema.Column{CostAnomaliesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cost_anomalies_connectors_cost_anomalies",
+				Columns:    []*schema.Column{CostAnomaliesColumns[12]},
+				RefColumns: []*schema.Column{ConnectorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "costanomaly_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{CostAnomaliesColumns[1]},
+			},
+			{
+				Name:    "costanomaly_fingerprint_day",
+				Unique:  true,
+				Columns: []*schema.Column{CostAnomaliesColumns[5], CostAnomaliesColumns[6]},
+			},
+		},
+	}
 	// CostReportsColumns holds the columns for the "cost_reports" table.
 	CostReportsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -166,6 +377,32 @@ var (
 			},
 		},
 	}
+	// DataEncryptionKeysColumns holds the columns for the "data_encryption_keys" table.
+	DataEncryptionKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "wrapped_key", Type: field.TypeBytes},
+		{Name: "active", Type: field.TypeBool, Default: false},
+		{Name: "rotation_status", Type: field.TypeString, Nullable: true},
+		{Name: "rotation_total", Type: field.TypeInt, Default: 0},
+		{Name: "rotation_processed", Type: field.TypeInt, Default: 0},
+		{Name: "rotation_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// DataEncryptionKeysTable holds the schema information for the "data_encryption_keys" table.
+	DataEncryptionKeysTable = &schema.Table{
+		Name:       "data_encryption_keys",
+		Columns:    DataEncryptionKeysColumns,
+		PrimaryKey: []*schema.Column{DataEncryptionKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dataencryptionkey_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{DataEncryptionKeysColumns[1]},
+			},
+		},
+	}
 	// DistributeLocksColumns holds the columns for the "distribute_locks" table.
 	DistributeLocksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -314,6 +551,7 @@ var (
 		{Name: "attributes", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb", "sqlite3": "text"}},
 		{Name: "computed_attributes", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb", "sqlite3": "text"}},
 		{Name: "endpoints", Type: field.TypeJSON, Nullable: true},
+		{Name: "drift", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 		{Name: "project_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 		{Name: "resource_definition_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
@@ -328,31 +566,31 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "resources_environments_resources",
-				Columns:    []*schema.Column{ResourcesColumns[12]},
+				Columns:    []*schema.Column{ResourcesColumns[13]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
 			{
 				Symbol:     "resources_projects_resources",
-				Columns:    []*schema.Column{ResourcesColumns[13]},
+				Columns:    []*schema.Column{ResourcesColumns[14]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "resources_resource_definitions_resources",
-				Columns:    []*schema.Column{ResourcesColumns[14]},
+				Columns:    []*schema.Column{ResourcesColumns[15]},
 				RefColumns: []*schema.Column{ResourceDefinitionsColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
 			{
 				Symbol:     "resources_resource_definition_matching_rules_resources",
-				Columns:    []*schema.Column{ResourcesColumns[15]},
+				Columns:    []*schema.Column{ResourcesColumns[16]},
 				RefColumns: []*schema.Column{ResourceDefinitionMatchingRulesColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
 			{
 				Symbol:     "resources_template_versions_resources",
-				Columns:    []*schema.Column{ResourcesColumns[16]},
+				Columns:    []*schema.Column{ResourcesColumns[17]},
 				RefColumns: []*schema.Column{TemplateVersionsColumns[0]},
 				OnDelete:   schema.Restrict,
 			},
@@ -361,7 +599,7 @@ var (
 			{
 				Name:    "resource_project_id_environment_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{ResourcesColumns[13], ResourcesColumns[12], ResourcesColumns[1]},
+				Columns: []*schema.Column{ResourcesColumns[14], ResourcesColumns[13], ResourcesColumns[1]},
 			},
 		},
 	}
@@ -622,6 +860,9 @@ var (
 		{Name: "labels", Type: field.TypeJSON, Nullable: true},
 		{Name: "component_changes", Type: field.TypeJSON, Nullable: true},
 		{Name: "component_change_summary", Type: field.TypeJSON, Nullable: true},
+		{Name: "cost_estimate", Type: field.TypeJSON, Nullable: true},
+		{Name: "policy_results", Type: field.TypeJSON, Nullable: true},
+		{Name: "approval", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 		{Name: "project_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 		{Name: "resource_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
@@ -634,19 +875,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "resource_runs_environments_resource_runs",
-				Columns:    []*schema.Column{ResourceRunsColumns[23]},
+				Columns:    []*schema.Column{ResourceRunsColumns[26]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "resource_runs_projects_resource_runs",
-				Columns:    []*schema.Column{ResourceRunsColumns[24]},
+				Columns:    []*schema.Column{ResourceRunsColumns[27]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "resource_runs_resources_runs",
-				Columns:    []*schema.Column{ResourceRunsColumns[25]},
+				Columns:    []*schema.Column{ResourceRunsColumns[28]},
 				RefColumns: []*schema.Column{ResourcesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -659,6 +900,120 @@ var (
 			},
 		},
 	}
+	// ResourceRunApprovalRulesColumns holds the columns for the "resource_run_approval_rules" table.
+	ResourceRunApprovalRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "environment_type", Type: field.TypeString, Nullable: true},
+		{Name: "run_types", Type: field.TypeJSON},
+		{Name: "approvals", Type: field.TypeInt, Default: 1},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "project_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// ResourceRunApprovalRulesTable holds the schema information for the "resource_run_approval_rules" table.
+	ResourceRunApprovalRulesTable = &schema.Table{
+		Name:       "resource_run_approval_rules",
+		Columns:    ResourceRunApprovalRulesColumns,
+		PrimaryKey: []*schema.Column{ResourceRunApprovalRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resource_run_approval_rules_environments_resource_run_approval_rules",
+				Columns:    []*schema.Column{ResourceRunApprovalRulesColumns[10]},
+				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "resource_run_approval_rules_projects_resource_run_approval_rules",
+				Columns:    []*schema.Column{ResourceRunApprovalRulesColumns[11]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resourcerunapprovalrule_project_id_environment_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceRunApprovalRulesColumns[11], ResourceRunApprovalRulesColumns[10], ResourceRunApprovalRulesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "project_id IS NOT NULL AND environment_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "resourcerunapprovalrule_project_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceRunApprovalRulesColumns[11], ResourceRunApprovalRulesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "project_id IS NOT NULL AND environment_id IS NULL",
+				},
+			},
+			{
+				Name:    "resourcerunapprovalrule_name",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceRunApprovalRulesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "project_id IS NULL AND environment_id IS NULL",
+				},
+			},
+		},
+	}
+	// ResourceRunPoliciesColumns holds the columns for the "resource_run_policies" table.
+	ResourceRunPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "enforcement", Type: field.TypeString, Default: "deny"},
+		{Name: "expression", Type: field.TypeString, Size: 2147483647},
+		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "environment_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "project_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// ResourceRunPoliciesTable holds the schema information for the "resource_run_policies" table.
+	ResourceRunPoliciesTable = &schema.Table{
+		Name:       "resource_run_policies",
+		Columns:    ResourceRunPoliciesColumns,
+		PrimaryKey: []*schema.Column{ResourceRunPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resource_run_policies_environments_resource_run_policies",
+				Columns:    []*schema.Column{ResourceRunPoliciesColumns[10]},
+				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "resource_run_policies_projects_resource_run_policies",
+				Columns:    []*schema.Column{ResourceRunPoliciesColumns[11]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resourcerunpolicy_project_id_environment_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceRunPoliciesColumns[11], ResourceRunPoliciesColumns[10], ResourceRunPoliciesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "environment_id IS NOT NULL",
+				},
+			},
+			{
+				Name:    "resourcerunpolicy_project_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceRunPoliciesColumns[11], ResourceRunPoliciesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "environment_id IS NULL",
+				},
+			},
+		},
+	}
 	// ResourceStatesColumns holds the columns for the "resource_states" table.
 	ResourceStatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
@@ -679,6 +1034,98 @@ var (
 			},
 		},
 	}
+	// ResourceStateLocksColumns holds the columns for the "resource_state_locks" table.
+	ResourceStateLocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "run_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "lock_id", Type: field.TypeString},
+		{Name: "holder", Type: field.TypeString},
+		{Name: "info", Type: field.TypeJSON},
+		{Name: "resource_id", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// ResourceStateLocksTable holds the schema information for the "resource_state_locks" table.
+	ResourceStateLocksTable = &schema.Table{
+		Name:       "resource_state_locks",
+		Columns:    ResourceStateLocksColumns,
+		PrimaryKey: []*schema.Column{ResourceStateLocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resource_state_locks_resources_state_lock",
+				Columns:    []*schema.Column{ResourceStateLocksColumns[6]},
+				RefColumns: []*schema.Column{ResourcesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resourcestatelock_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ResourceStateLocksColumns[1]},
+			},
+			{
+				Name:    "resourcestatelock_resource_id",
+				Unique:  true,
+				Columns: []*schema.Column{ResourceStateLocksColumns[6]},
+			},
+		},
+	}
+	// ResourceStateVersionsColumns holds the columns for the "resource_state_versions" table.
+	ResourceStateVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "serial", Type: field.TypeInt64},
+		{Name: "lineage", Type: field.TypeString},
+		{Name: "data", Type: field.TypeString, Size: 2147483647},
+		{Name: "environment_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "project_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "resource_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "run_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// ResourceStateVersionsTable holds the schema information for the "resource_state_versions" table.
+	ResourceStateVersionsTable = &schema.Table{
+		Name:       "resource_state_versions",
+		Columns:    ResourceStateVersionsColumns,
+		PrimaryKey: []*schema.Column{ResourceStateVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "resource_state_versions_environments_resource_state_versions",
+				Columns:    []*schema.Column{ResourceStateVersionsColumns[5]},
+				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "resource_state_versions_projects_resource_state_versions",
+				Columns:    []*schema.Column{ResourceStateVersionsColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "resource_state_versions_resources_state_versions",
+				Columns:    []*schema.Column{ResourceStateVersionsColumns[7]},
+				RefColumns: []*schema.Column{ResourcesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "resource_state_versions_resource_runs_state_versions",
+				Columns:    []*schema.Column{ResourceStateVersionsColumns[8]},
+				RefColumns: []*schema.Column{ResourceRunsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "resourcestateversion_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ResourceStateVersionsColumns[1]},
+			},
+			{
+				Name:    "resourcestateversion_resource_id_serial",
+				Unique:  false,
+				Columns: []*schema.Column{ResourceStateVersionsColumns[7], ResourceStateVersionsColumns[2]},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -734,6 +1181,34 @@ var (
 			},
 		},
 	}
+	// StorageObjectsColumns holds the columns for the "storage_objects" table.
+	StorageObjectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString},
+		{Name: "data", Type: field.TypeBytes},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "checksum", Type: field.TypeString, Nullable: true},
+	}
+	// StorageObjectsTable holds the schema information for the "storage_objects" table.
+	StorageObjectsTable = &schema.Table{
+		Name:       "storage_objects",
+		Columns:    StorageObjectsColumns,
+		PrimaryKey: []*schema.Column{StorageObjectsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "storageobject_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{StorageObjectsColumns[1]},
+			},
+			{
+				Name:    "storageobject_key",
+				Unique:  true,
+				Columns: []*schema.Column{StorageObjectsColumns[3]},
+			},
+		},
+	}
 	// SubjectsColumns holds the columns for the "subjects" table.
 	SubjectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
@@ -831,6 +1306,7 @@ var (
 		{Name: "status", Type: field.TypeJSON, Nullable: true},
 		{Name: "icon", Type: field.TypeString, Nullable: true},
 		{Name: "source", Type: field.TypeString},
+		{Name: "credential", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "blob", "postgres": "bytea", "sqlite3": "blob"}},
 		{Name: "catalog_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 		{Name: "project_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 	}
@@ -842,13 +1318,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "templates_catalogs_templates",
-				Columns:    []*schema.Column{TemplatesColumns[9]},
+				Columns:    []*schema.Column{TemplatesColumns[10]},
 				RefColumns: []*schema.Column{CatalogsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "templates_projects_templates",
-				Columns:    []*schema.Column{TemplatesColumns[10]},
+				Columns:    []*schema.Column{TemplatesColumns[11]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -857,7 +1333,7 @@ var (
 			{
 				Name:    "template_project_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{TemplatesColumns[10], TemplatesColumns[1]},
+				Columns: []*schema.Column{TemplatesColumns[11], TemplatesColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "project_id IS NOT NULL",
 				},
@@ -872,11 +1348,55 @@ var (
 			},
 		},
 	}
+	// TemplateUpgradeCampaignsColumns holds the columns for the "template_upgrade_campaigns" table.
+	TemplateUpgradeCampaignsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "target_version_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "preview", Type: field.TypeBool, Default: false},
+		{Name: "batch_size", Type: field.TypeInt, Default: 10},
+		{Name: "change_comment", Type: field.TypeString, Nullable: true},
+		{Name: "subject_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "results", Type: field.TypeJSON, Nullable: true},
+		{Name: "project_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "template_version_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// TemplateUpgradeCampaignsTable holds the schema information for the "template_upgrade_campaigns" table.
+	TemplateUpgradeCampaignsTable = &schema.Table{
+		Name:       "template_upgrade_campaigns",
+		Columns:    TemplateUpgradeCampaignsColumns,
+		PrimaryKey: []*schema.Column{TemplateUpgradeCampaignsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "template_upgrade_campaigns_projects_template_upgrade_campaigns",
+				Columns:    []*schema.Column{TemplateUpgradeCampaignsColumns[11]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "template_upgrade_campaigns_template_versions_upgrade_campaigns",
+				Columns:    []*schema.Column{TemplateUpgradeCampaignsColumns[12]},
+				RefColumns: []*schema.Column{TemplateVersionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "templateupgradecampaign_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{TemplateUpgradeCampaignsColumns[1]},
+			},
+		},
+	}
 	// TemplateVersionsColumns holds the columns for the "template_versions" table.
 	TemplateVersionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeJSON, Nullable: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "version", Type: field.TypeString},
 		{Name: "source", Type: field.TypeString},
@@ -884,6 +1404,9 @@ var (
 		{Name: "original_ui_schema", Type: field.TypeJSON},
 		{Name: "ui_schema", Type: field.TypeJSON},
 		{Name: "schema_default_value", Type: field.TypeBytes, Nullable: true},
+		{Name: "lifecycle", Type: field.TypeString, Nullable: true, Default: "active"},
+		{Name: "lifecycle_reason", Type: field.TypeString, Nullable: true},
+		{Name: "test_results", Type: field.TypeJSON, Nullable: true},
 		{Name: "project_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 		{Name: "template_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
 	}
@@ -895,13 +1418,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "template_versions_projects_template_versions",
-				Columns:    []*schema.Column{TemplateVersionsColumns[10]},
+				Columns:    []*schema.Column{TemplateVersionsColumns[14]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "template_versions_templates_versions",
-				Columns:    []*schema.Column{TemplateVersionsColumns[11]},
+				Columns:    []*schema.Column{TemplateVersionsColumns[15]},
 				RefColumns: []*schema.Column{TemplatesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -915,7 +1438,7 @@ var (
 			{
 				Name:    "templateversion_name_version_project_id",
 				Unique:  true,
-				Columns: []*schema.Column{TemplateVersionsColumns[3], TemplateVersionsColumns[4], TemplateVersionsColumns[10]},
+				Columns: []*schema.Column{TemplateVersionsColumns[4], TemplateVersionsColumns[5], TemplateVersionsColumns[14]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "project_id IS NOT NULL",
 				},
@@ -923,7 +1446,7 @@ var (
 			{
 				Name:    "templateversion_name_version",
 				Unique:  true,
-				Columns: []*schema.Column{TemplateVersionsColumns[3], TemplateVersionsColumns[4]},
+				Columns: []*schema.Column{TemplateVersionsColumns[4], TemplateVersionsColumns[5]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "project_id IS NULL",
 				},
@@ -1029,6 +1552,84 @@ var (
 			},
 		},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "blob", "postgres": "bytea", "sqlite3": "blob"}},
+		{Name: "topics", Type: field.TypeJSON},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "project_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhooks_projects_webhooks",
+				Columns:    []*schema.Column{WebhooksColumns[11]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhook_project_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{WebhooksColumns[11], WebhooksColumns[1]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "topic", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "webhook_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1]},
+			},
+			{
+				Name:    "webhookdelivery_webhook_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12], WebhookDeliveriesColumns[3]},
+			},
+		},
+	}
 	// WorkflowsColumns holds the columns for the "workflows" table.
 	WorkflowsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "bigint", "postgres": "bigint", "sqlite3": "integer"}},
@@ -1269,9 +1870,15 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditLogsTable,
+		BudgetsTable,
+		BudgetEventsTable,
 		CatalogsTable,
+		ChargebackStatementsTable,
 		ConnectorsTable,
+		CostAnomaliesTable,
 		CostReportsTable,
+		DataEncryptionKeysTable,
 		DistributeLocksTable,
 		EnvironmentsTable,
 		EnvironmentConnectorRelationshipsTable,
@@ -1284,15 +1891,23 @@ var (
 		ResourceDefinitionMatchingRulesTable,
 		ResourceRelationshipsTable,
 		ResourceRunsTable,
+		ResourceRunApprovalRulesTable,
+		ResourceRunPoliciesTable,
 		ResourceStatesTable,
+		ResourceStateLocksTable,
+		ResourceStateVersionsTable,
 		RolesTable,
 		SettingsTable,
+		StorageObjectsTable,
 		SubjectsTable,
 		SubjectRoleRelationshipsTable,
 		TemplatesTable,
+		TemplateUpgradeCampaignsTable,
 		TemplateVersionsTable,
 		TokensTable,
 		VariablesTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 		WorkflowsTable,
 		WorkflowExecutionsTable,
 		WorkflowStagesTable,
@@ -1303,8 +1918,12 @@ var (
 )
 
 func init() {
+	BudgetsTable.ForeignKeys[0].RefTable = EnvironmentsTable
+	BudgetsTable.ForeignKeys[1].RefTable = ProjectsTable
+	BudgetEventsTable.ForeignKeys[0].RefTable = BudgetsTable
 	CatalogsTable.ForeignKeys[0].RefTable = ProjectsTable
 	ConnectorsTable.ForeignKeys[0].RefTable = ProjectsTable
+	CostAnomaliesTable.ForeignKeys[0].RefTable = ConnectorsTable
 	CostReportsTable.ForeignKeys[0].RefTable = ConnectorsTable
 	EnvironmentsTable.ForeignKeys[0].RefTable = ProjectsTable
 	EnvironmentConnectorRelationshipsTable.ForeignKeys[0].RefTable = EnvironmentsTable
@@ -1329,17 +1948,30 @@ func init() {
 	ResourceRunsTable.ForeignKeys[0].RefTable = EnvironmentsTable
 	ResourceRunsTable.ForeignKeys[1].RefTable = ProjectsTable
 	ResourceRunsTable.ForeignKeys[2].RefTable = ResourcesTable
+	ResourceRunApprovalRulesTable.ForeignKeys[0].RefTable = EnvironmentsTable
+	ResourceRunApprovalRulesTable.ForeignKeys[1].RefTable = ProjectsTable
+	ResourceRunPoliciesTable.ForeignKeys[0].RefTable = EnvironmentsTable
+	ResourceRunPoliciesTable.ForeignKeys[1].RefTable = ProjectsTable
 	ResourceStatesTable.ForeignKeys[0].RefTable = ResourcesTable
+	ResourceStateLocksTable.ForeignKeys[0].RefTable = ResourcesTable
+	ResourceStateVersionsTable.ForeignKeys[0].RefTable = EnvironmentsTable
+	ResourceStateVersionsTable.ForeignKeys[1].RefTable = ProjectsTable
+	ResourceStateVersionsTable.ForeignKeys[2].RefTable = ResourcesTable
+	ResourceStateVersionsTable.ForeignKeys[3].RefTable = ResourceRunsTable
 	SubjectRoleRelationshipsTable.ForeignKeys[0].RefTable = ProjectsTable
 	SubjectRoleRelationshipsTable.ForeignKeys[1].RefTable = SubjectsTable
 	SubjectRoleRelationshipsTable.ForeignKeys[2].RefTable = RolesTable
 	TemplatesTable.ForeignKeys[0].RefTable = CatalogsTable
 	TemplatesTable.ForeignKeys[1].RefTable = ProjectsTable
+	TemplateUpgradeCampaignsTable.ForeignKeys[0].RefTable = ProjectsTable
+	TemplateUpgradeCampaignsTable.ForeignKeys[1].RefTable = TemplateVersionsTable
 	TemplateVersionsTable.ForeignKeys[0].RefTable = ProjectsTable
 	TemplateVersionsTable.ForeignKeys[1].RefTable = TemplatesTable
 	TokensTable.ForeignKeys[0].RefTable = SubjectsTable
 	VariablesTable.ForeignKeys[0].RefTable = EnvironmentsTable
 	VariablesTable.ForeignKeys[1].RefTable = ProjectsTable
+	WebhooksTable.ForeignKeys[0].RefTable = ProjectsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 	WorkflowsTable.ForeignKeys[0].RefTable = ProjectsTable
 	WorkflowExecutionsTable.ForeignKeys[0].RefTable = ProjectsTable
 	WorkflowExecutionsTable.ForeignKeys[1].RefTable = WorkflowsTable
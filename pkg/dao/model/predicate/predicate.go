@@ -9,15 +9,33 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Budget is the predicate function for budget builders.
+type Budget func(*sql.Selector)
+
+// BudgetEvent is the predicate function for budgetevent builders.
+type BudgetEvent func(*sql.Selector)
+
 // Catalog is the predicate function for catalog builders.
 type Catalog func(*sql.Selector)
 
+// ChargebackStatement is the predicate function for chargebackstatement builders.
+type ChargebackStatement func(*sql.Selector)
+
 // Connector is the predicate function for connector builders.
 type Connector func(*sql.Selector)
 
+// CostAnomaly is the predicate function for costanomaly builders.
+type CostAnomaly func(*sql.Selector)
+
 // CostReport is the predicate function for costreport builders.
 type CostReport func(*sql.Selector)
 
+// DataEncryptionKey is the predicate function for dataencryptionkey builders.
+type DataEncryptionKey func(*sql.Selector)
+
 // DistributeLock is the predicate function for distributelock builders.
 type DistributeLock func(*sql.Selector)
 
@@ -54,15 +72,30 @@ type ResourceRelationship func(*sql.Selector)
 // ResourceRun is the predicate function for resourcerun builders.
 type ResourceRun func(*sql.Selector)
 
+// ResourceRunApprovalRule is the predicate function for resourcerunapprovalrule builders.
+type ResourceRunApprovalRule func(*sql.Selector)
+
+// ResourceRunPolicy is the predicate function for resourcerunpolicy builders.
+type ResourceRunPolicy func(*sql.Selector)
+
 // ResourceState is the predicate function for resourcestate builders.
 type ResourceState func(*sql.Selector)
 
+// ResourceStateLock is the predicate function for resourcestatelock builders.
+type ResourceStateLock func(*sql.Selector)
+
+// ResourceStateVersion is the predicate function for resourcestateversion builders.
+type ResourceStateVersion func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
+// StorageObject is the predicate function for storageobject builders.
+type StorageObject func(*sql.Selector)
+
 // Subject is the predicate function for subject builders.
 type Subject func(*sql.Selector)
 
@@ -72,6 +105,9 @@ type SubjectRoleRelationship func(*sql.Selector)
 // Template is the predicate function for template builders.
 type Template func(*sql.Selector)
 
+// TemplateUpgradeCampaign is the predicate function for templateupgradecampaign builders.
+type TemplateUpgradeCampaign func(*sql.Selector)
+
 // TemplateVersion is the predicate function for templateversion builders.
 type TemplateVersion func(*sql.Selector)
 
@@ -81,6 +117,12 @@ type Token func(*sql.Selector)
 // Variable is the predicate function for variable builders.
 type Variable func(*sql.Selector)
 
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
 // Workflow is the predicate function for workflow builders.
 type Workflow func(*sql.Selector)
 
@@ -55,6 +55,18 @@ type ProjectEdges struct {
 	ResourceComponents []*ResourceComponent `json:"resource_components,omitempty"`
 	// ResourceRuns that belong to the project.
 	ResourceRuns []*ResourceRun `json:"resource_runs,omitempty"`
+	// ResourceStateVersions that belong to the project.
+	ResourceStateVersions []*ResourceStateVersion `json:"resource_state_versions,omitempty"`
+	// ResourceRunApprovalRules that belong to the project.
+	ResourceRunApprovalRules []*ResourceRunApprovalRule `json:"resource_run_approval_rules,omitempty"`
+	// ResourceRunPolicies that belong to the project.
+	ResourceRunPolicies []*ResourceRunPolicy `json:"resource_run_policies,omitempty"`
+	// TemplateUpgradeCampaigns that belong to the project.
+	TemplateUpgradeCampaigns []*TemplateUpgradeCampaign `json:"template_upgrade_campaigns,omitempty"`
+	// Webhooks that belong to the project.
+	Webhooks []*Webhook `json:"webhooks,omitempty"`
+	// Budgets that belong to the project.
+	Budgets []*Budget `json:"budgets,omitempty"`
 	// Variables that belong to the project.
 	Variables []*Variable `json:"variables,omitempty"`
 	// Templates that belong to the project.
@@ -77,7 +89,7 @@ type ProjectEdges struct {
 	WorkflowStepExecutions []*WorkflowStepExecution `json:"workflow_step_executions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [16]bool
+	loadedTypes [22]bool
 }
 
 // EnvironmentsOrErr returns the Environments value or an error if the edge
@@ -134,10 +146,64 @@ func (e ProjectEdges) ResourceRunsOrErr() ([]*ResourceRun, error) {
 	return nil, &NotLoadedError{edge: "resource_runs"}
 }
 
+// ResourceStateVersionsOrErr returns the ResourceStateVersions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) ResourceStateVersionsOrErr() ([]*ResourceStateVersion, error) {
+	if e.loadedTypes[6] {
+		return e.ResourceStateVersions, nil
+	}
+	return nil, &NotLoadedError{edge: "resource_state_versions"}
+}
+
+// ResourceRunApprovalRulesOrErr returns the ResourceRunApprovalRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) ResourceRunApprovalRulesOrErr() ([]*ResourceRunApprovalRule, error) {
+	if e.loadedTypes[7] {
+		return e.ResourceRunApprovalRules, nil
+	}
+	return nil, &NotLoadedError{edge: "resource_run_approval_rules"}
+}
+
+// ResourceRunPoliciesOrErr returns the ResourceRunPolicies value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) ResourceRunPoliciesOrErr() ([]*ResourceRunPolicy, error) {
+	if e.loadedTypes[8] {
+		return e.ResourceRunPolicies, nil
+	}
+	return nil, &NotLoadedError{edge: "resource_run_policies"}
+}
+
+// TemplateUpgradeCampaignsOrErr returns the TemplateUpgradeCampaigns value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TemplateUpgradeCampaignsOrErr() ([]*TemplateUpgradeCampaign, error) {
+	if e.loadedTypes[9] {
+		return e.TemplateUpgradeCampaigns, nil
+	}
+	return nil, &NotLoadedError{edge: "template_upgrade_campaigns"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[10] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// BudgetsOrErr returns the Budgets value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) BudgetsOrErr() ([]*Budget, error) {
+	if e.loadedTypes[11] {
+		return e.Budgets, nil
+	}
+	return nil, &NotLoadedError{edge: "budgets"}
+}
+
 // VariablesOrErr returns the Variables value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) VariablesOrErr() ([]*Variable, error) {
-	if e.loadedTypes[6] {
+	if e.loadedTypes[12] {
 		return e.Variables, nil
 	}
 	return nil, &NotLoadedError{edge: "variables"}
@@ -146,7 +212,7 @@ func (e ProjectEdges) VariablesOrErr() ([]*Variable, error) {
 // TemplatesOrErr returns the Templates value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) TemplatesOrErr() ([]*Template, error) {
-	if e.loadedTypes[7] {
+	if e.loadedTypes[13] {
 		return e.Templates, nil
 	}
 	return nil, &NotLoadedError{edge: "templates"}
@@ -155,7 +221,7 @@ func (e ProjectEdges) TemplatesOrErr() ([]*Template, error) {
 // TemplateVersionsOrErr returns the TemplateVersions value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) TemplateVersionsOrErr() ([]*TemplateVersion, error) {
-	if e.loadedTypes[8] {
+	if e.loadedTypes[14] {
 		return e.TemplateVersions, nil
 	}
 	return nil, &NotLoadedError{edge: "template_versions"}
@@ -164,7 +230,7 @@ func (e ProjectEdges) TemplateVersionsOrErr() ([]*TemplateVersion, error) {
 // CatalogsOrErr returns the Catalogs value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) CatalogsOrErr() ([]*Catalog, error) {
-	if e.loadedTypes[9] {
+	if e.loadedTypes[15] {
 		return e.Catalogs, nil
 	}
 	return nil, &NotLoadedError{edge: "catalogs"}
@@ -173,7 +239,7 @@ func (e ProjectEdges) CatalogsOrErr() ([]*Catalog, error) {
 // WorkflowsOrErr returns the Workflows value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) WorkflowsOrErr() ([]*Workflow, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[16] {
 		return e.Workflows, nil
 	}
 	return nil, &NotLoadedError{edge: "workflows"}
@@ -182,7 +248,7 @@ func (e ProjectEdges) WorkflowsOrErr() ([]*Workflow, error) {
 // WorkflowStagesOrErr returns the WorkflowStages value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) WorkflowStagesOrErr() ([]*WorkflowStage, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[17] {
 		return e.WorkflowStages, nil
 	}
 	return nil, &NotLoadedError{edge: "workflow_stages"}
@@ -191,7 +257,7 @@ func (e ProjectEdges) WorkflowStagesOrErr() ([]*WorkflowStage, error) {
 // WorkflowStepsOrErr returns the WorkflowSteps value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) WorkflowStepsOrErr() ([]*WorkflowStep, error) {
-	if e.loadedTypes[12] {
+	if e.loadedTypes[18] {
 		return e.WorkflowSteps, nil
 	}
 	return nil, &NotLoadedError{edge: "workflow_steps"}
@@ -200,7 +266,7 @@ func (e ProjectEdges) WorkflowStepsOrErr() ([]*WorkflowStep, error) {
 // WorkflowExecutionsOrErr returns the WorkflowExecutions value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) WorkflowExecutionsOrErr() ([]*WorkflowExecution, error) {
-	if e.loadedTypes[13] {
+	if e.loadedTypes[19] {
 		return e.WorkflowExecutions, nil
 	}
 	return nil, &NotLoadedError{edge: "workflow_executions"}
@@ -209,7 +275,7 @@ func (e ProjectEdges) WorkflowExecutionsOrErr() ([]*WorkflowExecution, error) {
 // WorkflowStageExecutionsOrErr returns the WorkflowStageExecutions value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) WorkflowStageExecutionsOrErr() ([]*WorkflowStageExecution, error) {
-	if e.loadedTypes[14] {
+	if e.loadedTypes[20] {
 		return e.WorkflowStageExecutions, nil
 	}
 	return nil, &NotLoadedError{edge: "workflow_stage_executions"}
@@ -218,7 +284,7 @@ func (e ProjectEdges) WorkflowStageExecutionsOrErr() ([]*WorkflowStageExecution,
 // WorkflowStepExecutionsOrErr returns the WorkflowStepExecutions value or an error if the edge
 // was not loaded in eager-loading.
 func (e ProjectEdges) WorkflowStepExecutionsOrErr() ([]*WorkflowStepExecution, error) {
-	if e.loadedTypes[15] {
+	if e.loadedTypes[21] {
 		return e.WorkflowStepExecutions, nil
 	}
 	return nil, &NotLoadedError{edge: "workflow_step_executions"}
@@ -343,6 +409,36 @@ func (pr *Project) QueryResourceRuns() *ResourceRunQuery {
 	return NewProjectClient(pr.config).QueryResourceRuns(pr)
 }
 
+// QueryResourceStateVersions queries the "resource_state_versions" edge of the Project entity.
+func (pr *Project) QueryResourceStateVersions() *ResourceStateVersionQuery {
+	return NewProjectClient(pr.config).QueryResourceStateVersions(pr)
+}
+
+// QueryResourceRunApprovalRules queries the "resource_run_approval_rules" edge of the Project entity.
+func (pr *Project) QueryResourceRunApprovalRules() *ResourceRunApprovalRuleQuery {
+	return NewProjectClient(pr.config).QueryResourceRunApprovalRules(pr)
+}
+
+// QueryResourceRunPolicies queries the "resource_run_policies" edge of the Project entity.
+func (pr *Project) QueryResourceRunPolicies() *ResourceRunPolicyQuery {
+	return NewProjectClient(pr.config).QueryResourceRunPolicies(pr)
+}
+
+// QueryTemplateUpgradeCampaigns queries the "template_upgrade_campaigns" edge of the Project entity.
+func (pr *Project) QueryTemplateUpgradeCampaigns() *TemplateUpgradeCampaignQuery {
+	return NewProjectClient(pr.config).QueryTemplateUpgradeCampaigns(pr)
+}
+
+// QueryWebhooks queries the "webhooks" edge of the Project entity.
+func (pr *Project) QueryWebhooks() *WebhookQuery {
+	return NewProjectClient(pr.config).QueryWebhooks(pr)
+}
+
+// QueryBudgets queries the "budgets" edge of the Project entity.
+func (pr *Project) QueryBudgets() *BudgetQuery {
+	return NewProjectClient(pr.config).QueryBudgets(pr)
+}
+
 // QueryVariables queries the "variables" edge of the Project entity.
 func (pr *Project) QueryVariables() *VariableQuery {
 	return NewProjectClient(pr.config).QueryVariables(pr)
@@ -43,6 +43,18 @@ const (
 	EdgeResourceComponents = "resource_components"
 	// EdgeResourceRuns holds the string denoting the resource_runs edge name in mutations.
 	EdgeResourceRuns = "resource_runs"
+	// EdgeResourceStateVersions holds the string denoting the resource_state_versions edge name in mutations.
+	EdgeResourceStateVersions = "resource_state_versions"
+	// EdgeResourceRunApprovalRules holds the string denoting the resource_run_approval_rules edge name in mutations.
+	EdgeResourceRunApprovalRules = "resource_run_approval_rules"
+	// EdgeResourceRunPolicies holds the string denoting the resource_run_policies edge name in mutations.
+	EdgeResourceRunPolicies = "resource_run_policies"
+	// EdgeTemplateUpgradeCampaigns holds the string denoting the template_upgrade_campaigns edge name in mutations.
+	EdgeTemplateUpgradeCampaigns = "template_upgrade_campaigns"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
+	// EdgeBudgets holds the string denoting the budgets edge name in mutations.
+	EdgeBudgets = "budgets"
 	// EdgeVariables holds the string denoting the variables edge name in mutations.
 	EdgeVariables = "variables"
 	// EdgeTemplates holds the string denoting the templates edge name in mutations.
@@ -107,6 +119,48 @@ const (
 	ResourceRunsInverseTable = "resource_runs"
 	// ResourceRunsColumn is the table column denoting the resource_runs relation/edge.
 	ResourceRunsColumn = "project_id"
+	// ResourceStateVersionsTable is the table that holds the resource_state_versions relation/edge.
+	ResourceStateVersionsTable = "resource_state_versions"
+	// ResourceStateVersionsInverseTable is the table name for the ResourceStateVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcestateversion" package.
+	ResourceStateVersionsInverseTable = "resource_state_versions"
+	// ResourceStateVersionsColumn is the table column denoting the resource_state_versions relation/edge.
+	ResourceStateVersionsColumn = "project_id"
+	// ResourceRunApprovalRulesTable is the table that holds the resource_run_approval_rules relation/edge.
+	ResourceRunApprovalRulesTable = "resource_run_approval_rules"
+	// ResourceRunApprovalRulesInverseTable is the table name for the ResourceRunApprovalRule entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcerunapprovalrule" package.
+	ResourceRunApprovalRulesInverseTable = "resource_run_approval_rules"
+	// ResourceRunApprovalRulesColumn is the table column denoting the resource_run_approval_rules relation/edge.
+	ResourceRunApprovalRulesColumn = "project_id"
+	// ResourceRunPoliciesTable is the table that holds the resource_run_policies relation/edge.
+	ResourceRunPoliciesTable = "resource_run_policies"
+	// ResourceRunPoliciesInverseTable is the table name for the ResourceRunPolicy entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcerunpolicy" package.
+	ResourceRunPoliciesInverseTable = "resource_run_policies"
+	// ResourceRunPoliciesColumn is the table column denoting the resource_run_policies relation/edge.
+	ResourceRunPoliciesColumn = "project_id"
+	// TemplateUpgradeCampaignsTable is the table that holds the template_upgrade_campaigns relation/edge.
+	TemplateUpgradeCampaignsTable = "template_upgrade_campaigns"
+	// TemplateUpgradeCampaignsInverseTable is the table name for the TemplateUpgradeCampaign entity.
+	// It exists in this package in order to avoid circular dependency with the "templateupgradecampaign" package.
+	TemplateUpgradeCampaignsInverseTable = "template_upgrade_campaigns"
+	// TemplateUpgradeCampaignsColumn is the table column denoting the template_upgrade_campaigns relation/edge.
+	TemplateUpgradeCampaignsColumn = "project_id"
+	// WebhooksTable is the table that holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "project_id"
+	// BudgetsTable is the table that holds the budgets relation/edge.
+	BudgetsTable = "budgets"
+	// BudgetsInverseTable is the table name for the Budget entity.
+	// It exists in this package in order to avoid circular dependency with the "budget" package.
+	BudgetsInverseTable = "budgets"
+	// BudgetsColumn is the table column denoting the budgets relation/edge.
+	BudgetsColumn = "project_id"
 	// VariablesTable is the table that holds the variables relation/edge.
 	VariablesTable = "variables"
 	// VariablesInverseTable is the table name for the Variable entity.
@@ -334,6 +388,90 @@ func ByResourceRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByResourceStateVersionsCount orders the results by resource_state_versions count.
+func ByResourceStateVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newResourceStateVersionsStep(), opts...)
+	}
+}
+
+// ByResourceStateVersions orders the results by resource_state_versions terms.
+func ByResourceStateVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResourceStateVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByResourceRunApprovalRulesCount orders the results by resource_run_approval_rules count.
+func ByResourceRunApprovalRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newResourceRunApprovalRulesStep(), opts...)
+	}
+}
+
+// ByResourceRunApprovalRules orders the results by resource_run_approval_rules terms.
+func ByResourceRunApprovalRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResourceRunApprovalRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByResourceRunPoliciesCount orders the results by resource_run_policies count.
+func ByResourceRunPoliciesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newResourceRunPoliciesStep(), opts...)
+	}
+}
+
+// ByResourceRunPolicies orders the results by resource_run_policies terms.
+func ByResourceRunPolicies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResourceRunPoliciesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTemplateUpgradeCampaignsCount orders the results by template_upgrade_campaigns count.
+func ByTemplateUpgradeCampaignsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTemplateUpgradeCampaignsStep(), opts...)
+	}
+}
+
+// ByTemplateUpgradeCampaigns orders the results by template_upgrade_campaigns terms.
+func ByTemplateUpgradeCampaigns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTemplateUpgradeCampaignsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhooksCount orders the results by webhooks count.
+func ByWebhooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhooksStep(), opts...)
+	}
+}
+
+// ByWebhooks orders the results by webhooks terms.
+func ByWebhooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBudgetsCount orders the results by budgets count.
+func ByBudgetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBudgetsStep(), opts...)
+	}
+}
+
+// ByBudgets orders the results by budgets terms.
+func ByBudgets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByVariablesCount orders the results by variables count.
 func ByVariablesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -515,6 +653,48 @@ func newResourceRunsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunsTable, ResourceRunsColumn),
 	)
 }
+func newResourceStateVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResourceStateVersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ResourceStateVersionsTable, ResourceStateVersionsColumn),
+	)
+}
+func newResourceRunApprovalRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResourceRunApprovalRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunApprovalRulesTable, ResourceRunApprovalRulesColumn),
+	)
+}
+func newResourceRunPoliciesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResourceRunPoliciesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunPoliciesTable, ResourceRunPoliciesColumn),
+	)
+}
+func newTemplateUpgradeCampaignsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TemplateUpgradeCampaignsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TemplateUpgradeCampaignsTable, TemplateUpgradeCampaignsColumn),
+	)
+}
+func newWebhooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+	)
+}
+func newBudgetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BudgetsTable, BudgetsColumn),
+	)
+}
 func newVariablesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -495,6 +495,180 @@ func HasResourceRunsWith(preds ...predicate.ResourceRun) predicate.Project {
 	})
 }
 
+// HasResourceStateVersions applies the HasEdge predicate on the "resource_state_versions" edge.
+func HasResourceStateVersions() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResourceStateVersionsTable, ResourceStateVersionsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceStateVersionsWith applies the HasEdge predicate on the "resource_state_versions" edge with a given conditions (other predicates).
+func HasResourceStateVersionsWith(preds ...predicate.ResourceStateVersion) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newResourceStateVersionsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResourceRunApprovalRules applies the HasEdge predicate on the "resource_run_approval_rules" edge.
+func HasResourceRunApprovalRules() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunApprovalRulesTable, ResourceRunApprovalRulesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRunApprovalRule
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceRunApprovalRulesWith applies the HasEdge predicate on the "resource_run_approval_rules" edge with a given conditions (other predicates).
+func HasResourceRunApprovalRulesWith(preds ...predicate.ResourceRunApprovalRule) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newResourceRunApprovalRulesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRunApprovalRule
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResourceRunPolicies applies the HasEdge predicate on the "resource_run_policies" edge.
+func HasResourceRunPolicies() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ResourceRunPoliciesTable, ResourceRunPoliciesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRunPolicy
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceRunPoliciesWith applies the HasEdge predicate on the "resource_run_policies" edge with a given conditions (other predicates).
+func HasResourceRunPoliciesWith(preds ...predicate.ResourceRunPolicy) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newResourceRunPoliciesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRunPolicy
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTemplateUpgradeCampaigns applies the HasEdge predicate on the "template_upgrade_campaigns" edge.
+func HasTemplateUpgradeCampaigns() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TemplateUpgradeCampaignsTable, TemplateUpgradeCampaignsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TemplateUpgradeCampaign
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTemplateUpgradeCampaignsWith applies the HasEdge predicate on the "template_upgrade_campaigns" edge with a given conditions (other predicates).
+func HasTemplateUpgradeCampaignsWith(preds ...predicate.TemplateUpgradeCampaign) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newTemplateUpgradeCampaignsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TemplateUpgradeCampaign
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhooks applies the HasEdge predicate on the "webhooks" edge.
+func HasWebhooks() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhooksTable, WebhooksColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhooksWith applies the HasEdge predicate on the "webhooks" edge with a given conditions (other predicates).
+func HasWebhooksWith(preds ...predicate.Webhook) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newWebhooksStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBudgets applies the HasEdge predicate on the "budgets" edge.
+func HasBudgets() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BudgetsTable, BudgetsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.Budget
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetsWith applies the HasEdge predicate on the "budgets" edge with a given conditions (other predicates).
+func HasBudgetsWith(preds ...predicate.Budget) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newBudgetsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.Budget
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasVariables applies the HasEdge predicate on the "variables" edge.
 func HasVariables() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
@@ -17,6 +17,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
@@ -24,10 +25,15 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcecomponent"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/subjectrolerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
 	"github.com/seal-io/walrus/pkg/dao/model/workflow"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowexecution"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowstage"
@@ -203,6 +209,96 @@ func (pc *ProjectCreate) AddResourceRuns(r ...*ResourceRun) *ProjectCreate {
 	return pc.AddResourceRunIDs(ids...)
 }
 
+// AddResourceStateVersionIDs adds the "resource_state_versions" edge to the ResourceStateVersion entity by IDs.
+func (pc *ProjectCreate) AddResourceStateVersionIDs(ids ...object.ID) *ProjectCreate {
+	pc.mutation.AddResourceStateVersionIDs(ids...)
+	return pc
+}
+
+// AddResourceStateVersions adds the "resource_state_versions" edges to the ResourceStateVersion entity.
+func (pc *ProjectCreate) AddResourceStateVersions(r ...*ResourceStateVersion) *ProjectCreate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddResourceStateVersionIDs(ids...)
+}
+
+// AddResourceRunApprovalRuleIDs adds the "resource_run_approval_rules" edge to the ResourceRunApprovalRule entity by IDs.
+func (pc *ProjectCreate) AddResourceRunApprovalRuleIDs(ids ...object.ID) *ProjectCreate {
+	pc.mutation.AddResourceRunApprovalRuleIDs(ids...)
+	return pc
+}
+
+// AddResourceRunApprovalRules adds the "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (pc *ProjectCreate) AddResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *ProjectCreate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddResourceRunApprovalRuleIDs(ids...)
+}
+
+// AddResourceRunPolicyIDs adds the "resource_run_policies" edge to the ResourceRunPolicy entity by IDs.
+func (pc *ProjectCreate) AddResourceRunPolicyIDs(ids ...object.ID) *ProjectCreate {
+	pc.mutation.AddResourceRunPolicyIDs(ids...)
+	return pc
+}
+
+// AddResourceRunPolicies adds the "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (pc *ProjectCreate) AddResourceRunPolicies(r ...*ResourceRunPolicy) *ProjectCreate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddResourceRunPolicyIDs(ids...)
+}
+
+// AddTemplateUpgradeCampaignIDs adds the "template_upgrade_campaigns" edge to the TemplateUpgradeCampaign entity by IDs.
+func (pc *ProjectCreate) AddTemplateUpgradeCampaignIDs(ids ...object.ID) *ProjectCreate {
+	pc.mutation.AddTemplateUpgradeCampaignIDs(ids...)
+	return pc
+}
+
+// AddTemplateUpgradeCampaigns adds the "template_upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (pc *ProjectCreate) AddTemplateUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *ProjectCreate {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pc.AddTemplateUpgradeCampaignIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (pc *ProjectCreate) AddWebhookIDs(ids ...object.ID) *ProjectCreate {
+	pc.mutation.AddWebhookIDs(ids...)
+	return pc
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (pc *ProjectCreate) AddWebhooks(w ...*Webhook) *ProjectCreate {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pc.AddWebhookIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (pc *ProjectCreate) AddBudgetIDs(ids ...object.ID) *ProjectCreate {
+	pc.mutation.AddBudgetIDs(ids...)
+	return pc
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (pc *ProjectCreate) AddBudgets(b ...*Budget) *ProjectCreate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pc.AddBudgetIDs(ids...)
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (pc *ProjectCreate) AddVariableIDs(ids ...object.ID) *ProjectCreate {
 	pc.mutation.AddVariableIDs(ids...)
@@ -594,6 +690,108 @@ func (pc *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.ResourceStateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceStateVersionsTable,
+			Columns: []string{project.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pc.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ResourceRunApprovalRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceRunApprovalRulesTable,
+			Columns: []string{project.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pc.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ResourceRunPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceRunPoliciesTable,
+			Columns: []string{project.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pc.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.TemplateUpgradeCampaignsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TemplateUpgradeCampaignsTable,
+			Columns: []string{project.TemplateUpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pc.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pc.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pc.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := pc.mutation.VariablesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -16,6 +16,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
@@ -25,10 +26,15 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcecomponent"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/subjectrolerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
 	"github.com/seal-io/walrus/pkg/dao/model/workflow"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowexecution"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowstage"
@@ -41,27 +47,33 @@ import (
 // ProjectQuery is the builder for querying Project entities.
 type ProjectQuery struct {
 	config
-	ctx                         *QueryContext
-	order                       []project.OrderOption
-	inters                      []Interceptor
-	predicates                  []predicate.Project
-	withEnvironments            *EnvironmentQuery
-	withConnectors              *ConnectorQuery
-	withSubjectRoles            *SubjectRoleRelationshipQuery
-	withResources               *ResourceQuery
-	withResourceComponents      *ResourceComponentQuery
-	withResourceRuns            *ResourceRunQuery
-	withVariables               *VariableQuery
-	withTemplates               *TemplateQuery
-	withTemplateVersions        *TemplateVersionQuery
-	withCatalogs                *CatalogQuery
-	withWorkflows               *WorkflowQuery
-	withWorkflowStages          *WorkflowStageQuery
-	withWorkflowSteps           *WorkflowStepQuery
-	withWorkflowExecutions      *WorkflowExecutionQuery
-	withWorkflowStageExecutions *WorkflowStageExecutionQuery
-	withWorkflowStepExecutions  *WorkflowStepExecutionQuery
-	modifiers                   []func(*sql.Selector)
+	ctx                          *QueryContext
+	order                        []project.OrderOption
+	inters                       []Interceptor
+	predicates                   []predicate.Project
+	withEnvironments             *EnvironmentQuery
+	withConnectors               *ConnectorQuery
+	withSubjectRoles             *SubjectRoleRelationshipQuery
+	withResources                *ResourceQuery
+	withResourceComponents       *ResourceComponentQuery
+	withResourceRuns             *ResourceRunQuery
+	withResourceStateVersions    *ResourceStateVersionQuery
+	withResourceRunApprovalRules *ResourceRunApprovalRuleQuery
+	withResourceRunPolicies      *ResourceRunPolicyQuery
+	withTemplateUpgradeCampaigns *TemplateUpgradeCampaignQuery
+	withWebhooks                 *WebhookQuery
+	withBudgets                  *BudgetQuery
+	withVariables                *VariableQuery
+	withTemplates                *TemplateQuery
+	withTemplateVersions         *TemplateVersionQuery
+	withCatalogs                 *CatalogQuery
+	withWorkflows                *WorkflowQuery
+	withWorkflowStages           *WorkflowStageQuery
+	withWorkflowSteps            *WorkflowStepQuery
+	withWorkflowExecutions       *WorkflowExecutionQuery
+	withWorkflowStageExecutions  *WorkflowStageExecutionQuery
+	withWorkflowStepExecutions   *WorkflowStepExecutionQuery
+	modifiers                    []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -248,6 +260,156 @@ func (pq *ProjectQuery) QueryResourceRuns() *ResourceRunQuery {
 	return query
 }
 
+// QueryResourceStateVersions chains the current query on the "resource_state_versions" edge.
+func (pq *ProjectQuery) QueryResourceStateVersions() *ResourceStateVersionQuery {
+	query := (&ResourceStateVersionClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(resourcestateversion.Table, resourcestateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceStateVersionsTable, project.ResourceStateVersionsColumn),
+		)
+		schemaConfig := pq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResourceRunApprovalRules chains the current query on the "resource_run_approval_rules" edge.
+func (pq *ProjectQuery) QueryResourceRunApprovalRules() *ResourceRunApprovalRuleQuery {
+	query := (&ResourceRunApprovalRuleClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(resourcerunapprovalrule.Table, resourcerunapprovalrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceRunApprovalRulesTable, project.ResourceRunApprovalRulesColumn),
+		)
+		schemaConfig := pq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRunApprovalRule
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResourceRunPolicies chains the current query on the "resource_run_policies" edge.
+func (pq *ProjectQuery) QueryResourceRunPolicies() *ResourceRunPolicyQuery {
+	query := (&ResourceRunPolicyClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(resourcerunpolicy.Table, resourcerunpolicy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ResourceRunPoliciesTable, project.ResourceRunPoliciesColumn),
+		)
+		schemaConfig := pq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRunPolicy
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTemplateUpgradeCampaigns chains the current query on the "template_upgrade_campaigns" edge.
+func (pq *ProjectQuery) QueryTemplateUpgradeCampaigns() *TemplateUpgradeCampaignQuery {
+	query := (&TemplateUpgradeCampaignClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(templateupgradecampaign.Table, templateupgradecampaign.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TemplateUpgradeCampaignsTable, project.TemplateUpgradeCampaignsColumn),
+		)
+		schemaConfig := pq.schemaConfig
+		step.To.Schema = schemaConfig.TemplateUpgradeCampaign
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhooks chains the current query on the "webhooks" edge.
+func (pq *ProjectQuery) QueryWebhooks() *WebhookQuery {
+	query := (&WebhookClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.WebhooksTable, project.WebhooksColumn),
+		)
+		schemaConfig := pq.schemaConfig
+		step.To.Schema = schemaConfig.Webhook
+		step.Edge.Schema = schemaConfig.Webhook
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBudgets chains the current query on the "budgets" edge.
+func (pq *ProjectQuery) QueryBudgets() *BudgetQuery {
+	query := (&BudgetClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.BudgetsTable, project.BudgetsColumn),
+		)
+		schemaConfig := pq.schemaConfig
+		step.To.Schema = schemaConfig.Budget
+		step.Edge.Schema = schemaConfig.Budget
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryVariables chains the current query on the "variables" edge.
 func (pq *ProjectQuery) QueryVariables() *VariableQuery {
 	query := (&VariableClient{config: pq.config}).Query()
@@ -685,27 +847,33 @@ func (pq *ProjectQuery) Clone() *ProjectQuery {
 		return nil
 	}
 	return &ProjectQuery{
-		config:                      pq.config,
-		ctx:                         pq.ctx.Clone(),
-		order:                       append([]project.OrderOption{}, pq.order...),
-		inters:                      append([]Interceptor{}, pq.inters...),
-		predicates:                  append([]predicate.Project{}, pq.predicates...),
-		withEnvironments:            pq.withEnvironments.Clone(),
-		withConnectors:              pq.withConnectors.Clone(),
-		withSubjectRoles:            pq.withSubjectRoles.Clone(),
-		withResources:               pq.withResources.Clone(),
-		withResourceComponents:      pq.withResourceComponents.Clone(),
-		withResourceRuns:            pq.withResourceRuns.Clone(),
-		withVariables:               pq.withVariables.Clone(),
-		withTemplates:               pq.withTemplates.Clone(),
-		withTemplateVersions:        pq.withTemplateVersions.Clone(),
-		withCatalogs:                pq.withCatalogs.Clone(),
-		withWorkflows:               pq.withWorkflows.Clone(),
-		withWorkflowStages:          pq.withWorkflowStages.Clone(),
-		withWorkflowSteps:           pq.withWorkflowSteps.Clone(),
-		withWorkflowExecutions:      pq.withWorkflowExecutions.Clone(),
-		withWorkflowStageExecutions: pq.withWorkflowStageExecutions.Clone(),
-		withWorkflowStepExecutions:  pq.withWorkflowStepExecutions.Clone(),
+		config:                       pq.config,
+		ctx:                          pq.ctx.Clone(),
+		order:                        append([]project.OrderOption{}, pq.order...),
+		inters:                       append([]Interceptor{}, pq.inters...),
+		predicates:                   append([]predicate.Project{}, pq.predicates...),
+		withEnvironments:             pq.withEnvironments.Clone(),
+		withConnectors:               pq.withConnectors.Clone(),
+		withSubjectRoles:             pq.withSubjectRoles.Clone(),
+		withResources:                pq.withResources.Clone(),
+		withResourceComponents:       pq.withResourceComponents.Clone(),
+		withResourceRuns:             pq.withResourceRuns.Clone(),
+		withResourceStateVersions:    pq.withResourceStateVersions.Clone(),
+		withResourceRunApprovalRules: pq.withResourceRunApprovalRules.Clone(),
+		withResourceRunPolicies:      pq.withResourceRunPolicies.Clone(),
+		withTemplateUpgradeCampaigns: pq.withTemplateUpgradeCampaigns.Clone(),
+		withWebhooks:                 pq.withWebhooks.Clone(),
+		withBudgets:                  pq.withBudgets.Clone(),
+		withVariables:                pq.withVariables.Clone(),
+		withTemplates:                pq.withTemplates.Clone(),
+		withTemplateVersions:         pq.withTemplateVersions.Clone(),
+		withCatalogs:                 pq.withCatalogs.Clone(),
+		withWorkflows:                pq.withWorkflows.Clone(),
+		withWorkflowStages:           pq.withWorkflowStages.Clone(),
+		withWorkflowSteps:            pq.withWorkflowSteps.Clone(),
+		withWorkflowExecutions:       pq.withWorkflowExecutions.Clone(),
+		withWorkflowStageExecutions:  pq.withWorkflowStageExecutions.Clone(),
+		withWorkflowStepExecutions:   pq.withWorkflowStepExecutions.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -778,6 +946,72 @@ func (pq *ProjectQuery) WithResourceRuns(opts ...func(*ResourceRunQuery)) *Proje
 	return pq
 }
 
+// WithResourceStateVersions tells the query-builder to eager-load the nodes that are connected to
+// the "resource_state_versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithResourceStateVersions(opts ...func(*ResourceStateVersionQuery)) *ProjectQuery {
+	query := (&ResourceStateVersionClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withResourceStateVersions = query
+	return pq
+}
+
+// WithResourceRunApprovalRules tells the query-builder to eager-load the nodes that are connected to
+// the "resource_run_approval_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithResourceRunApprovalRules(opts ...func(*ResourceRunApprovalRuleQuery)) *ProjectQuery {
+	query := (&ResourceRunApprovalRuleClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withResourceRunApprovalRules = query
+	return pq
+}
+
+// WithResourceRunPolicies tells the query-builder to eager-load the nodes that are connected to
+// the "resource_run_policies" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithResourceRunPolicies(opts ...func(*ResourceRunPolicyQuery)) *ProjectQuery {
+	query := (&ResourceRunPolicyClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withResourceRunPolicies = query
+	return pq
+}
+
+// WithTemplateUpgradeCampaigns tells the query-builder to eager-load the nodes that are connected to
+// the "template_upgrade_campaigns" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithTemplateUpgradeCampaigns(opts ...func(*TemplateUpgradeCampaignQuery)) *ProjectQuery {
+	query := (&TemplateUpgradeCampaignClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withTemplateUpgradeCampaigns = query
+	return pq
+}
+
+// WithWebhooks tells the query-builder to eager-load the nodes that are connected to
+// the "webhooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithWebhooks(opts ...func(*WebhookQuery)) *ProjectQuery {
+	query := (&WebhookClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withWebhooks = query
+	return pq
+}
+
+// WithBudgets tells the query-builder to eager-load the nodes that are connected to
+// the "budgets" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProjectQuery) WithBudgets(opts ...func(*BudgetQuery)) *ProjectQuery {
+	query := (&BudgetClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withBudgets = query
+	return pq
+}
+
 // WithVariables tells the query-builder to eager-load the nodes that are connected to
 // the "variables" edge. The optional arguments are used to configure the query builder of the edge.
 func (pq *ProjectQuery) WithVariables(opts ...func(*VariableQuery)) *ProjectQuery {
@@ -966,13 +1200,19 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 	var (
 		nodes       = []*Project{}
 		_spec       = pq.querySpec()
-		loadedTypes = [16]bool{
+		loadedTypes = [22]bool{
 			pq.withEnvironments != nil,
 			pq.withConnectors != nil,
 			pq.withSubjectRoles != nil,
 			pq.withResources != nil,
 			pq.withResourceComponents != nil,
 			pq.withResourceRuns != nil,
+			pq.withResourceStateVersions != nil,
+			pq.withResourceRunApprovalRules != nil,
+			pq.withResourceRunPolicies != nil,
+			pq.withTemplateUpgradeCampaigns != nil,
+			pq.withWebhooks != nil,
+			pq.withBudgets != nil,
 			pq.withVariables != nil,
 			pq.withTemplates != nil,
 			pq.withTemplateVersions != nil,
@@ -1052,6 +1292,56 @@ func (pq *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			return nil, err
 		}
 	}
+	if query := pq.withResourceStateVersions; query != nil {
+		if err := pq.loadResourceStateVersions(ctx, query, nodes,
+			func(n *Project) { n.Edges.ResourceStateVersions = []*ResourceStateVersion{} },
+			func(n *Project, e *ResourceStateVersion) {
+				n.Edges.ResourceStateVersions = append(n.Edges.ResourceStateVersions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withResourceRunApprovalRules; query != nil {
+		if err := pq.loadResourceRunApprovalRules(ctx, query, nodes,
+			func(n *Project) { n.Edges.ResourceRunApprovalRules = []*ResourceRunApprovalRule{} },
+			func(n *Project, e *ResourceRunApprovalRule) {
+				n.Edges.ResourceRunApprovalRules = append(n.Edges.ResourceRunApprovalRules, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withResourceRunPolicies; query != nil {
+		if err := pq.loadResourceRunPolicies(ctx, query, nodes,
+			func(n *Project) { n.Edges.ResourceRunPolicies = []*ResourceRunPolicy{} },
+			func(n *Project, e *ResourceRunPolicy) {
+				n.Edges.ResourceRunPolicies = append(n.Edges.ResourceRunPolicies, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withTemplateUpgradeCampaigns; query != nil {
+		if err := pq.loadTemplateUpgradeCampaigns(ctx, query, nodes,
+			func(n *Project) { n.Edges.TemplateUpgradeCampaigns = []*TemplateUpgradeCampaign{} },
+			func(n *Project, e *TemplateUpgradeCampaign) {
+				n.Edges.TemplateUpgradeCampaigns = append(n.Edges.TemplateUpgradeCampaigns, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withWebhooks; query != nil {
+		if err := pq.loadWebhooks(ctx, query, nodes,
+			func(n *Project) { n.Edges.Webhooks = []*Webhook{} },
+			func(n *Project, e *Webhook) { n.Edges.Webhooks = append(n.Edges.Webhooks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withBudgets; query != nil {
+		if err := pq.loadBudgets(ctx, query, nodes,
+			func(n *Project) { n.Edges.Budgets = []*Budget{} },
+			func(n *Project, e *Budget) { n.Edges.Budgets = append(n.Edges.Budgets, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := pq.withVariables; query != nil {
 		if err := pq.loadVariables(ctx, query, nodes,
 			func(n *Project) { n.Edges.Variables = []*Variable{} },
@@ -1311,6 +1601,186 @@ func (pq *ProjectQuery) loadResourceRuns(ctx context.Context, query *ResourceRun
 	}
 	return nil
 }
+func (pq *ProjectQuery) loadResourceStateVersions(ctx context.Context, query *ResourceStateVersionQuery, nodes []*Project, init func(*Project), assign func(*Project, *ResourceStateVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcestateversion.FieldProjectID)
+	}
+	query.Where(predicate.ResourceStateVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.ResourceStateVersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadResourceRunApprovalRules(ctx context.Context, query *ResourceRunApprovalRuleQuery, nodes []*Project, init func(*Project), assign func(*Project, *ResourceRunApprovalRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcerunapprovalrule.FieldProjectID)
+	}
+	query.Where(predicate.ResourceRunApprovalRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.ResourceRunApprovalRulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadResourceRunPolicies(ctx context.Context, query *ResourceRunPolicyQuery, nodes []*Project, init func(*Project), assign func(*Project, *ResourceRunPolicy)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcerunpolicy.FieldProjectID)
+	}
+	query.Where(predicate.ResourceRunPolicy(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.ResourceRunPoliciesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadTemplateUpgradeCampaigns(ctx context.Context, query *TemplateUpgradeCampaignQuery, nodes []*Project, init func(*Project), assign func(*Project, *TemplateUpgradeCampaign)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(templateupgradecampaign.FieldProjectID)
+	}
+	query.Where(predicate.TemplateUpgradeCampaign(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.TemplateUpgradeCampaignsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadWebhooks(ctx context.Context, query *WebhookQuery, nodes []*Project, init func(*Project), assign func(*Project, *Webhook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(webhook.FieldProjectID)
+	}
+	query.Where(predicate.Webhook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.WebhooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProjectQuery) loadBudgets(ctx context.Context, query *BudgetQuery, nodes []*Project, init func(*Project), assign func(*Project, *Budget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(budget.FieldProjectID)
+	}
+	query.Where(predicate.Budget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.BudgetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (pq *ProjectQuery) loadVariables(ctx context.Context, query *VariableQuery, nodes []*Project, init func(*Project), assign func(*Project, *Variable)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[object.ID]*Project)
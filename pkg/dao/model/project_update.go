@@ -18,6 +18,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
@@ -27,10 +28,15 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcecomponent"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/subjectrolerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
 	"github.com/seal-io/walrus/pkg/dao/model/workflow"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowexecution"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowstage"
@@ -195,6 +201,96 @@ func (pu *ProjectUpdate) AddResourceRuns(r ...*ResourceRun) *ProjectUpdate {
 	return pu.AddResourceRunIDs(ids...)
 }
 
+// AddResourceStateVersionIDs adds the "resource_state_versions" edge to the ResourceStateVersion entity by IDs.
+func (pu *ProjectUpdate) AddResourceStateVersionIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.AddResourceStateVersionIDs(ids...)
+	return pu
+}
+
+// AddResourceStateVersions adds the "resource_state_versions" edges to the ResourceStateVersion entity.
+func (pu *ProjectUpdate) AddResourceStateVersions(r ...*ResourceStateVersion) *ProjectUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddResourceStateVersionIDs(ids...)
+}
+
+// AddResourceRunApprovalRuleIDs adds the "resource_run_approval_rules" edge to the ResourceRunApprovalRule entity by IDs.
+func (pu *ProjectUpdate) AddResourceRunApprovalRuleIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.AddResourceRunApprovalRuleIDs(ids...)
+	return pu
+}
+
+// AddResourceRunApprovalRules adds the "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (pu *ProjectUpdate) AddResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *ProjectUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddResourceRunApprovalRuleIDs(ids...)
+}
+
+// AddResourceRunPolicyIDs adds the "resource_run_policies" edge to the ResourceRunPolicy entity by IDs.
+func (pu *ProjectUpdate) AddResourceRunPolicyIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.AddResourceRunPolicyIDs(ids...)
+	return pu
+}
+
+// AddResourceRunPolicies adds the "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (pu *ProjectUpdate) AddResourceRunPolicies(r ...*ResourceRunPolicy) *ProjectUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddResourceRunPolicyIDs(ids...)
+}
+
+// AddTemplateUpgradeCampaignIDs adds the "template_upgrade_campaigns" edge to the TemplateUpgradeCampaign entity by IDs.
+func (pu *ProjectUpdate) AddTemplateUpgradeCampaignIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.AddTemplateUpgradeCampaignIDs(ids...)
+	return pu
+}
+
+// AddTemplateUpgradeCampaigns adds the "template_upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (pu *ProjectUpdate) AddTemplateUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *ProjectUpdate {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.AddTemplateUpgradeCampaignIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (pu *ProjectUpdate) AddWebhookIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.AddWebhookIDs(ids...)
+	return pu
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (pu *ProjectUpdate) AddWebhooks(w ...*Webhook) *ProjectUpdate {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.AddWebhookIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (pu *ProjectUpdate) AddBudgetIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.AddBudgetIDs(ids...)
+	return pu
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (pu *ProjectUpdate) AddBudgets(b ...*Budget) *ProjectUpdate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pu.AddBudgetIDs(ids...)
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (pu *ProjectUpdate) AddVariableIDs(ids ...object.ID) *ProjectUpdate {
 	pu.mutation.AddVariableIDs(ids...)
@@ -476,6 +572,132 @@ func (pu *ProjectUpdate) RemoveResourceRuns(r ...*ResourceRun) *ProjectUpdate {
 	return pu.RemoveResourceRunIDs(ids...)
 }
 
+// ClearResourceStateVersions clears all "resource_state_versions" edges to the ResourceStateVersion entity.
+func (pu *ProjectUpdate) ClearResourceStateVersions() *ProjectUpdate {
+	pu.mutation.ClearResourceStateVersions()
+	return pu
+}
+
+// RemoveResourceStateVersionIDs removes the "resource_state_versions" edge to ResourceStateVersion entities by IDs.
+func (pu *ProjectUpdate) RemoveResourceStateVersionIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.RemoveResourceStateVersionIDs(ids...)
+	return pu
+}
+
+// RemoveResourceStateVersions removes "resource_state_versions" edges to ResourceStateVersion entities.
+func (pu *ProjectUpdate) RemoveResourceStateVersions(r ...*ResourceStateVersion) *ProjectUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveResourceStateVersionIDs(ids...)
+}
+
+// ClearResourceRunApprovalRules clears all "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (pu *ProjectUpdate) ClearResourceRunApprovalRules() *ProjectUpdate {
+	pu.mutation.ClearResourceRunApprovalRules()
+	return pu
+}
+
+// RemoveResourceRunApprovalRuleIDs removes the "resource_run_approval_rules" edge to ResourceRunApprovalRule entities by IDs.
+func (pu *ProjectUpdate) RemoveResourceRunApprovalRuleIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.RemoveResourceRunApprovalRuleIDs(ids...)
+	return pu
+}
+
+// RemoveResourceRunApprovalRules removes "resource_run_approval_rules" edges to ResourceRunApprovalRule entities.
+func (pu *ProjectUpdate) RemoveResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *ProjectUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveResourceRunApprovalRuleIDs(ids...)
+}
+
+// ClearResourceRunPolicies clears all "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (pu *ProjectUpdate) ClearResourceRunPolicies() *ProjectUpdate {
+	pu.mutation.ClearResourceRunPolicies()
+	return pu
+}
+
+// RemoveResourceRunPolicyIDs removes the "resource_run_policies" edge to ResourceRunPolicy entities by IDs.
+func (pu *ProjectUpdate) RemoveResourceRunPolicyIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.RemoveResourceRunPolicyIDs(ids...)
+	return pu
+}
+
+// RemoveResourceRunPolicies removes "resource_run_policies" edges to ResourceRunPolicy entities.
+func (pu *ProjectUpdate) RemoveResourceRunPolicies(r ...*ResourceRunPolicy) *ProjectUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveResourceRunPolicyIDs(ids...)
+}
+
+// ClearTemplateUpgradeCampaigns clears all "template_upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (pu *ProjectUpdate) ClearTemplateUpgradeCampaigns() *ProjectUpdate {
+	pu.mutation.ClearTemplateUpgradeCampaigns()
+	return pu
+}
+
+// RemoveTemplateUpgradeCampaignIDs removes the "template_upgrade_campaigns" edge to TemplateUpgradeCampaign entities by IDs.
+func (pu *ProjectUpdate) RemoveTemplateUpgradeCampaignIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.RemoveTemplateUpgradeCampaignIDs(ids...)
+	return pu
+}
+
+// RemoveTemplateUpgradeCampaigns removes "template_upgrade_campaigns" edges to TemplateUpgradeCampaign entities.
+func (pu *ProjectUpdate) RemoveTemplateUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *ProjectUpdate {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return pu.RemoveTemplateUpgradeCampaignIDs(ids...)
+}
+
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (pu *ProjectUpdate) ClearWebhooks() *ProjectUpdate {
+	pu.mutation.ClearWebhooks()
+	return pu
+}
+
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (pu *ProjectUpdate) RemoveWebhookIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.RemoveWebhookIDs(ids...)
+	return pu
+}
+
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (pu *ProjectUpdate) RemoveWebhooks(w ...*Webhook) *ProjectUpdate {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return pu.RemoveWebhookIDs(ids...)
+}
+
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (pu *ProjectUpdate) ClearBudgets() *ProjectUpdate {
+	pu.mutation.ClearBudgets()
+	return pu
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (pu *ProjectUpdate) RemoveBudgetIDs(ids ...object.ID) *ProjectUpdate {
+	pu.mutation.RemoveBudgetIDs(ids...)
+	return pu
+}
+
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (pu *ProjectUpdate) RemoveBudgets(b ...*Budget) *ProjectUpdate {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return pu.RemoveBudgetIDs(ids...)
+}
+
 // ClearVariables clears all "variables" edges to the Variable entity.
 func (pu *ProjectUpdate) ClearVariables() *ProjectUpdate {
 	pu.mutation.ClearVariables()
@@ -1108,362 +1330,650 @@ func (pu *ProjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.VariablesCleared() {
+	if pu.mutation.ResourceStateVersionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.VariablesTable,
-			Columns: []string{project.VariablesColumn},
+			Table:   project.ResourceStateVersionsTable,
+			Columns: []string{project.ResourceStateVersionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(variable.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Variable
+		edge.Schema = pu.schemaConfig.ResourceStateVersion
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedVariablesIDs(); len(nodes) > 0 && !pu.mutation.VariablesCleared() {
+	if nodes := pu.mutation.RemovedResourceStateVersionsIDs(); len(nodes) > 0 && !pu.mutation.ResourceStateVersionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.VariablesTable,
-			Columns: []string{project.VariablesColumn},
+			Table:   project.ResourceStateVersionsTable,
+			Columns: []string{project.ResourceStateVersionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(variable.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Variable
+		edge.Schema = pu.schemaConfig.ResourceStateVersion
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.VariablesIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.ResourceStateVersionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.VariablesTable,
-			Columns: []string{project.VariablesColumn},
+			Table:   project.ResourceStateVersionsTable,
+			Columns: []string{project.ResourceStateVersionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(variable.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Variable
+		edge.Schema = pu.schemaConfig.ResourceStateVersion
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.TemplatesCleared() {
+	if pu.mutation.ResourceRunApprovalRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TemplatesTable,
-			Columns: []string{project.TemplatesColumn},
+			Table:   project.ResourceRunApprovalRulesTable,
+			Columns: []string{project.ResourceRunApprovalRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Template
+		edge.Schema = pu.schemaConfig.ResourceRunApprovalRule
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedTemplatesIDs(); len(nodes) > 0 && !pu.mutation.TemplatesCleared() {
+	if nodes := pu.mutation.RemovedResourceRunApprovalRulesIDs(); len(nodes) > 0 && !pu.mutation.ResourceRunApprovalRulesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TemplatesTable,
-			Columns: []string{project.TemplatesColumn},
+			Table:   project.ResourceRunApprovalRulesTable,
+			Columns: []string{project.ResourceRunApprovalRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Template
+		edge.Schema = pu.schemaConfig.ResourceRunApprovalRule
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.TemplatesIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.ResourceRunApprovalRulesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TemplatesTable,
-			Columns: []string{project.TemplatesColumn},
+			Table:   project.ResourceRunApprovalRulesTable,
+			Columns: []string{project.ResourceRunApprovalRulesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Template
+		edge.Schema = pu.schemaConfig.ResourceRunApprovalRule
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.TemplateVersionsCleared() {
+	if pu.mutation.ResourceRunPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TemplateVersionsTable,
-			Columns: []string{project.TemplateVersionsColumn},
+			Table:   project.ResourceRunPoliciesTable,
+			Columns: []string{project.ResourceRunPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.TemplateVersion
+		edge.Schema = pu.schemaConfig.ResourceRunPolicy
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedTemplateVersionsIDs(); len(nodes) > 0 && !pu.mutation.TemplateVersionsCleared() {
+	if nodes := pu.mutation.RemovedResourceRunPoliciesIDs(); len(nodes) > 0 && !pu.mutation.ResourceRunPoliciesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TemplateVersionsTable,
-			Columns: []string{project.TemplateVersionsColumn},
+			Table:   project.ResourceRunPoliciesTable,
+			Columns: []string{project.ResourceRunPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.TemplateVersion
+		edge.Schema = pu.schemaConfig.ResourceRunPolicy
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.TemplateVersionsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.ResourceRunPoliciesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.TemplateVersionsTable,
-			Columns: []string{project.TemplateVersionsColumn},
+			Table:   project.ResourceRunPoliciesTable,
+			Columns: []string{project.ResourceRunPoliciesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.TemplateVersion
+		edge.Schema = pu.schemaConfig.ResourceRunPolicy
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.CatalogsCleared() {
+	if pu.mutation.TemplateUpgradeCampaignsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.CatalogsTable,
-			Columns: []string{project.CatalogsColumn},
+			Table:   project.TemplateUpgradeCampaignsTable,
+			Columns: []string{project.TemplateUpgradeCampaignsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(catalog.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Catalog
+		edge.Schema = pu.schemaConfig.TemplateUpgradeCampaign
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedCatalogsIDs(); len(nodes) > 0 && !pu.mutation.CatalogsCleared() {
+	if nodes := pu.mutation.RemovedTemplateUpgradeCampaignsIDs(); len(nodes) > 0 && !pu.mutation.TemplateUpgradeCampaignsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.CatalogsTable,
-			Columns: []string{project.CatalogsColumn},
+			Table:   project.TemplateUpgradeCampaignsTable,
+			Columns: []string{project.TemplateUpgradeCampaignsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(catalog.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Catalog
+		edge.Schema = pu.schemaConfig.TemplateUpgradeCampaign
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.CatalogsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.TemplateUpgradeCampaignsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.CatalogsTable,
-			Columns: []string{project.CatalogsColumn},
+			Table:   project.TemplateUpgradeCampaignsTable,
+			Columns: []string{project.TemplateUpgradeCampaignsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(catalog.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Catalog
+		edge.Schema = pu.schemaConfig.TemplateUpgradeCampaign
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.WorkflowsCleared() {
+	if pu.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowsTable,
-			Columns: []string{project.WorkflowsColumn},
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Workflow
+		edge.Schema = pu.schemaConfig.Webhook
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedWorkflowsIDs(); len(nodes) > 0 && !pu.mutation.WorkflowsCleared() {
+	if nodes := pu.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !pu.mutation.WebhooksCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowsTable,
-			Columns: []string{project.WorkflowsColumn},
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Workflow
+		edge.Schema = pu.schemaConfig.Webhook
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.WorkflowsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.WebhooksIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowsTable,
-			Columns: []string{project.WorkflowsColumn},
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.Workflow
+		edge.Schema = pu.schemaConfig.Webhook
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.WorkflowStagesCleared() {
+	if pu.mutation.BudgetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowStagesTable,
-			Columns: []string{project.WorkflowStagesColumn},
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflowstage.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.WorkflowStage
+		edge.Schema = pu.schemaConfig.Budget
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedWorkflowStagesIDs(); len(nodes) > 0 && !pu.mutation.WorkflowStagesCleared() {
+	if nodes := pu.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !pu.mutation.BudgetsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowStagesTable,
-			Columns: []string{project.WorkflowStagesColumn},
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflowstage.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.WorkflowStage
+		edge.Schema = pu.schemaConfig.Budget
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.WorkflowStagesIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.BudgetsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowStagesTable,
-			Columns: []string{project.WorkflowStagesColumn},
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflowstage.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.WorkflowStage
+		edge.Schema = pu.schemaConfig.Budget
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.WorkflowStepsCleared() {
+	if pu.mutation.VariablesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowStepsTable,
-			Columns: []string{project.WorkflowStepsColumn},
+			Table:   project.VariablesTable,
+			Columns: []string{project.VariablesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflowstep.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(variable.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.WorkflowStep
+		edge.Schema = pu.schemaConfig.Variable
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedWorkflowStepsIDs(); len(nodes) > 0 && !pu.mutation.WorkflowStepsCleared() {
+	if nodes := pu.mutation.RemovedVariablesIDs(); len(nodes) > 0 && !pu.mutation.VariablesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowStepsTable,
-			Columns: []string{project.WorkflowStepsColumn},
+			Table:   project.VariablesTable,
+			Columns: []string{project.VariablesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflowstep.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(variable.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.WorkflowStep
+		edge.Schema = pu.schemaConfig.Variable
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.WorkflowStepsIDs(); len(nodes) > 0 {
+	if nodes := pu.mutation.VariablesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowStepsTable,
-			Columns: []string{project.WorkflowStepsColumn},
+			Table:   project.VariablesTable,
+			Columns: []string{project.VariablesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflowstep.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(variable.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.WorkflowStep
+		edge.Schema = pu.schemaConfig.Variable
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if pu.mutation.WorkflowExecutionsCleared() {
+	if pu.mutation.TemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowExecutionsTable,
-			Columns: []string{project.WorkflowExecutionsColumn},
+			Table:   project.TemplatesTable,
+			Columns: []string{project.TemplatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
 			},
 		}
-		edge.Schema = pu.schemaConfig.WorkflowExecution
+		edge.Schema = pu.schemaConfig.Template
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := pu.mutation.RemovedWorkflowExecutionsIDs(); len(nodes) > 0 && !pu.mutation.WorkflowExecutionsCleared() {
+	if nodes := pu.mutation.RemovedTemplatesIDs(); len(nodes) > 0 && !pu.mutation.TemplatesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   project.WorkflowExecutionsTable,
-			Columns: []string{project.WorkflowExecutionsColumn},
+			Table:   project.TemplatesTable,
+			Columns: []string{project.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TemplatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TemplatesTable,
+			Columns: []string{project.TemplatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(template.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.Template
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.TemplateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TemplateVersionsTable,
+			Columns: []string{project.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.TemplateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedTemplateVersionsIDs(); len(nodes) > 0 && !pu.mutation.TemplateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TemplateVersionsTable,
+			Columns: []string{project.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TemplateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TemplateVersionsTable,
+			Columns: []string{project.TemplateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.TemplateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.CatalogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CatalogsTable,
+			Columns: []string{project.CatalogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(catalog.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.Catalog
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedCatalogsIDs(); len(nodes) > 0 && !pu.mutation.CatalogsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CatalogsTable,
+			Columns: []string{project.CatalogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(catalog.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.Catalog
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CatalogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.CatalogsTable,
+			Columns: []string{project.CatalogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(catalog.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.Catalog
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowsTable,
+			Columns: []string{project.WorkflowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.Workflow
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedWorkflowsIDs(); len(nodes) > 0 && !pu.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowsTable,
+			Columns: []string{project.WorkflowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.Workflow
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WorkflowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowsTable,
+			Columns: []string{project.WorkflowsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.Workflow
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.WorkflowStagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStagesTable,
+			Columns: []string{project.WorkflowStagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstage.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.WorkflowStage
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedWorkflowStagesIDs(); len(nodes) > 0 && !pu.mutation.WorkflowStagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStagesTable,
+			Columns: []string{project.WorkflowStagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstage.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.WorkflowStage
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WorkflowStagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStagesTable,
+			Columns: []string{project.WorkflowStagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstage.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.WorkflowStage
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.WorkflowStepsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStepsTable,
+			Columns: []string{project.WorkflowStepsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstep.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.WorkflowStep
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedWorkflowStepsIDs(); len(nodes) > 0 && !pu.mutation.WorkflowStepsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStepsTable,
+			Columns: []string{project.WorkflowStepsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstep.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.WorkflowStep
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.WorkflowStepsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowStepsTable,
+			Columns: []string{project.WorkflowStepsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowstep.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.WorkflowStep
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.WorkflowExecutionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowExecutionsTable,
+			Columns: []string{project.WorkflowExecutionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = pu.schemaConfig.WorkflowExecution
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedWorkflowExecutionsIDs(); len(nodes) > 0 && !pu.mutation.WorkflowExecutionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WorkflowExecutionsTable,
+			Columns: []string{project.WorkflowExecutionsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
@@ -1753,6 +2263,96 @@ func (puo *ProjectUpdateOne) AddResourceRuns(r ...*ResourceRun) *ProjectUpdateOn
 	return puo.AddResourceRunIDs(ids...)
 }
 
+// AddResourceStateVersionIDs adds the "resource_state_versions" edge to the ResourceStateVersion entity by IDs.
+func (puo *ProjectUpdateOne) AddResourceStateVersionIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.AddResourceStateVersionIDs(ids...)
+	return puo
+}
+
+// AddResourceStateVersions adds the "resource_state_versions" edges to the ResourceStateVersion entity.
+func (puo *ProjectUpdateOne) AddResourceStateVersions(r ...*ResourceStateVersion) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddResourceStateVersionIDs(ids...)
+}
+
+// AddResourceRunApprovalRuleIDs adds the "resource_run_approval_rules" edge to the ResourceRunApprovalRule entity by IDs.
+func (puo *ProjectUpdateOne) AddResourceRunApprovalRuleIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.AddResourceRunApprovalRuleIDs(ids...)
+	return puo
+}
+
+// AddResourceRunApprovalRules adds the "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (puo *ProjectUpdateOne) AddResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddResourceRunApprovalRuleIDs(ids...)
+}
+
+// AddResourceRunPolicyIDs adds the "resource_run_policies" edge to the ResourceRunPolicy entity by IDs.
+func (puo *ProjectUpdateOne) AddResourceRunPolicyIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.AddResourceRunPolicyIDs(ids...)
+	return puo
+}
+
+// AddResourceRunPolicies adds the "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (puo *ProjectUpdateOne) AddResourceRunPolicies(r ...*ResourceRunPolicy) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddResourceRunPolicyIDs(ids...)
+}
+
+// AddTemplateUpgradeCampaignIDs adds the "template_upgrade_campaigns" edge to the TemplateUpgradeCampaign entity by IDs.
+func (puo *ProjectUpdateOne) AddTemplateUpgradeCampaignIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.AddTemplateUpgradeCampaignIDs(ids...)
+	return puo
+}
+
+// AddTemplateUpgradeCampaigns adds the "template_upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (puo *ProjectUpdateOne) AddTemplateUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *ProjectUpdateOne {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return puo.AddTemplateUpgradeCampaignIDs(ids...)
+}
+
+// AddWebhookIDs adds the "webhooks" edge to the Webhook entity by IDs.
+func (puo *ProjectUpdateOne) AddWebhookIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.AddWebhookIDs(ids...)
+	return puo
+}
+
+// AddWebhooks adds the "webhooks" edges to the Webhook entity.
+func (puo *ProjectUpdateOne) AddWebhooks(w ...*Webhook) *ProjectUpdateOne {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return puo.AddWebhookIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (puo *ProjectUpdateOne) AddBudgetIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.AddBudgetIDs(ids...)
+	return puo
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (puo *ProjectUpdateOne) AddBudgets(b ...*Budget) *ProjectUpdateOne {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return puo.AddBudgetIDs(ids...)
+}
+
 // AddVariableIDs adds the "variables" edge to the Variable entity by IDs.
 func (puo *ProjectUpdateOne) AddVariableIDs(ids ...object.ID) *ProjectUpdateOne {
 	puo.mutation.AddVariableIDs(ids...)
@@ -1947,91 +2547,217 @@ func (puo *ProjectUpdateOne) RemoveConnectors(c ...*Connector) *ProjectUpdateOne
 	for i := range c {
 		ids[i] = c[i].ID
 	}
-	return puo.RemoveConnectorIDs(ids...)
+	return puo.RemoveConnectorIDs(ids...)
+}
+
+// ClearSubjectRoles clears all "subject_roles" edges to the SubjectRoleRelationship entity.
+func (puo *ProjectUpdateOne) ClearSubjectRoles() *ProjectUpdateOne {
+	puo.mutation.ClearSubjectRoles()
+	return puo
+}
+
+// RemoveSubjectRoleIDs removes the "subject_roles" edge to SubjectRoleRelationship entities by IDs.
+func (puo *ProjectUpdateOne) RemoveSubjectRoleIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveSubjectRoleIDs(ids...)
+	return puo
+}
+
+// RemoveSubjectRoles removes "subject_roles" edges to SubjectRoleRelationship entities.
+func (puo *ProjectUpdateOne) RemoveSubjectRoles(s ...*SubjectRoleRelationship) *ProjectUpdateOne {
+	ids := make([]object.ID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return puo.RemoveSubjectRoleIDs(ids...)
+}
+
+// ClearResources clears all "resources" edges to the Resource entity.
+func (puo *ProjectUpdateOne) ClearResources() *ProjectUpdateOne {
+	puo.mutation.ClearResources()
+	return puo
+}
+
+// RemoveResourceIDs removes the "resources" edge to Resource entities by IDs.
+func (puo *ProjectUpdateOne) RemoveResourceIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveResourceIDs(ids...)
+	return puo
+}
+
+// RemoveResources removes "resources" edges to Resource entities.
+func (puo *ProjectUpdateOne) RemoveResources(r ...*Resource) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveResourceIDs(ids...)
+}
+
+// ClearResourceComponents clears all "resource_components" edges to the ResourceComponent entity.
+func (puo *ProjectUpdateOne) ClearResourceComponents() *ProjectUpdateOne {
+	puo.mutation.ClearResourceComponents()
+	return puo
+}
+
+// RemoveResourceComponentIDs removes the "resource_components" edge to ResourceComponent entities by IDs.
+func (puo *ProjectUpdateOne) RemoveResourceComponentIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveResourceComponentIDs(ids...)
+	return puo
+}
+
+// RemoveResourceComponents removes "resource_components" edges to ResourceComponent entities.
+func (puo *ProjectUpdateOne) RemoveResourceComponents(r ...*ResourceComponent) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveResourceComponentIDs(ids...)
+}
+
+// ClearResourceRuns clears all "resource_runs" edges to the ResourceRun entity.
+func (puo *ProjectUpdateOne) ClearResourceRuns() *ProjectUpdateOne {
+	puo.mutation.ClearResourceRuns()
+	return puo
+}
+
+// RemoveResourceRunIDs removes the "resource_runs" edge to ResourceRun entities by IDs.
+func (puo *ProjectUpdateOne) RemoveResourceRunIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveResourceRunIDs(ids...)
+	return puo
+}
+
+// RemoveResourceRuns removes "resource_runs" edges to ResourceRun entities.
+func (puo *ProjectUpdateOne) RemoveResourceRuns(r ...*ResourceRun) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveResourceRunIDs(ids...)
+}
+
+// ClearResourceStateVersions clears all "resource_state_versions" edges to the ResourceStateVersion entity.
+func (puo *ProjectUpdateOne) ClearResourceStateVersions() *ProjectUpdateOne {
+	puo.mutation.ClearResourceStateVersions()
+	return puo
+}
+
+// RemoveResourceStateVersionIDs removes the "resource_state_versions" edge to ResourceStateVersion entities by IDs.
+func (puo *ProjectUpdateOne) RemoveResourceStateVersionIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveResourceStateVersionIDs(ids...)
+	return puo
+}
+
+// RemoveResourceStateVersions removes "resource_state_versions" edges to ResourceStateVersion entities.
+func (puo *ProjectUpdateOne) RemoveResourceStateVersions(r ...*ResourceStateVersion) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveResourceStateVersionIDs(ids...)
+}
+
+// ClearResourceRunApprovalRules clears all "resource_run_approval_rules" edges to the ResourceRunApprovalRule entity.
+func (puo *ProjectUpdateOne) ClearResourceRunApprovalRules() *ProjectUpdateOne {
+	puo.mutation.ClearResourceRunApprovalRules()
+	return puo
+}
+
+// RemoveResourceRunApprovalRuleIDs removes the "resource_run_approval_rules" edge to ResourceRunApprovalRule entities by IDs.
+func (puo *ProjectUpdateOne) RemoveResourceRunApprovalRuleIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveResourceRunApprovalRuleIDs(ids...)
+	return puo
+}
+
+// RemoveResourceRunApprovalRules removes "resource_run_approval_rules" edges to ResourceRunApprovalRule entities.
+func (puo *ProjectUpdateOne) RemoveResourceRunApprovalRules(r ...*ResourceRunApprovalRule) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveResourceRunApprovalRuleIDs(ids...)
 }
 
-// ClearSubjectRoles clears all "subject_roles" edges to the SubjectRoleRelationship entity.
-func (puo *ProjectUpdateOne) ClearSubjectRoles() *ProjectUpdateOne {
-	puo.mutation.ClearSubjectRoles()
+// ClearResourceRunPolicies clears all "resource_run_policies" edges to the ResourceRunPolicy entity.
+func (puo *ProjectUpdateOne) ClearResourceRunPolicies() *ProjectUpdateOne {
+	puo.mutation.ClearResourceRunPolicies()
 	return puo
 }
 
-// RemoveSubjectRoleIDs removes the "subject_roles" edge to SubjectRoleRelationship entities by IDs.
-func (puo *ProjectUpdateOne) RemoveSubjectRoleIDs(ids ...object.ID) *ProjectUpdateOne {
-	puo.mutation.RemoveSubjectRoleIDs(ids...)
+// RemoveResourceRunPolicyIDs removes the "resource_run_policies" edge to ResourceRunPolicy entities by IDs.
+func (puo *ProjectUpdateOne) RemoveResourceRunPolicyIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveResourceRunPolicyIDs(ids...)
 	return puo
 }
 
-// RemoveSubjectRoles removes "subject_roles" edges to SubjectRoleRelationship entities.
-func (puo *ProjectUpdateOne) RemoveSubjectRoles(s ...*SubjectRoleRelationship) *ProjectUpdateOne {
-	ids := make([]object.ID, len(s))
-	for i := range s {
-		ids[i] = s[i].ID
+// RemoveResourceRunPolicies removes "resource_run_policies" edges to ResourceRunPolicy entities.
+func (puo *ProjectUpdateOne) RemoveResourceRunPolicies(r ...*ResourceRunPolicy) *ProjectUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
 	}
-	return puo.RemoveSubjectRoleIDs(ids...)
+	return puo.RemoveResourceRunPolicyIDs(ids...)
 }
 
-// ClearResources clears all "resources" edges to the Resource entity.
-func (puo *ProjectUpdateOne) ClearResources() *ProjectUpdateOne {
-	puo.mutation.ClearResources()
+// ClearTemplateUpgradeCampaigns clears all "template_upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (puo *ProjectUpdateOne) ClearTemplateUpgradeCampaigns() *ProjectUpdateOne {
+	puo.mutation.ClearTemplateUpgradeCampaigns()
 	return puo
 }
 
-// RemoveResourceIDs removes the "resources" edge to Resource entities by IDs.
-func (puo *ProjectUpdateOne) RemoveResourceIDs(ids ...object.ID) *ProjectUpdateOne {
-	puo.mutation.RemoveResourceIDs(ids...)
+// RemoveTemplateUpgradeCampaignIDs removes the "template_upgrade_campaigns" edge to TemplateUpgradeCampaign entities by IDs.
+func (puo *ProjectUpdateOne) RemoveTemplateUpgradeCampaignIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveTemplateUpgradeCampaignIDs(ids...)
 	return puo
 }
 
-// RemoveResources removes "resources" edges to Resource entities.
-func (puo *ProjectUpdateOne) RemoveResources(r ...*Resource) *ProjectUpdateOne {
-	ids := make([]object.ID, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+// RemoveTemplateUpgradeCampaigns removes "template_upgrade_campaigns" edges to TemplateUpgradeCampaign entities.
+func (puo *ProjectUpdateOne) RemoveTemplateUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *ProjectUpdateOne {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
 	}
-	return puo.RemoveResourceIDs(ids...)
+	return puo.RemoveTemplateUpgradeCampaignIDs(ids...)
 }
 
-// ClearResourceComponents clears all "resource_components" edges to the ResourceComponent entity.
-func (puo *ProjectUpdateOne) ClearResourceComponents() *ProjectUpdateOne {
-	puo.mutation.ClearResourceComponents()
+// ClearWebhooks clears all "webhooks" edges to the Webhook entity.
+func (puo *ProjectUpdateOne) ClearWebhooks() *ProjectUpdateOne {
+	puo.mutation.ClearWebhooks()
 	return puo
 }
 
-// RemoveResourceComponentIDs removes the "resource_components" edge to ResourceComponent entities by IDs.
-func (puo *ProjectUpdateOne) RemoveResourceComponentIDs(ids ...object.ID) *ProjectUpdateOne {
-	puo.mutation.RemoveResourceComponentIDs(ids...)
+// RemoveWebhookIDs removes the "webhooks" edge to Webhook entities by IDs.
+func (puo *ProjectUpdateOne) RemoveWebhookIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveWebhookIDs(ids...)
 	return puo
 }
 
-// RemoveResourceComponents removes "resource_components" edges to ResourceComponent entities.
-func (puo *ProjectUpdateOne) RemoveResourceComponents(r ...*ResourceComponent) *ProjectUpdateOne {
-	ids := make([]object.ID, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+// RemoveWebhooks removes "webhooks" edges to Webhook entities.
+func (puo *ProjectUpdateOne) RemoveWebhooks(w ...*Webhook) *ProjectUpdateOne {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
 	}
-	return puo.RemoveResourceComponentIDs(ids...)
+	return puo.RemoveWebhookIDs(ids...)
 }
 
-// ClearResourceRuns clears all "resource_runs" edges to the ResourceRun entity.
-func (puo *ProjectUpdateOne) ClearResourceRuns() *ProjectUpdateOne {
-	puo.mutation.ClearResourceRuns()
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (puo *ProjectUpdateOne) ClearBudgets() *ProjectUpdateOne {
+	puo.mutation.ClearBudgets()
 	return puo
 }
 
-// RemoveResourceRunIDs removes the "resource_runs" edge to ResourceRun entities by IDs.
-func (puo *ProjectUpdateOne) RemoveResourceRunIDs(ids ...object.ID) *ProjectUpdateOne {
-	puo.mutation.RemoveResourceRunIDs(ids...)
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (puo *ProjectUpdateOne) RemoveBudgetIDs(ids ...object.ID) *ProjectUpdateOne {
+	puo.mutation.RemoveBudgetIDs(ids...)
 	return puo
 }
 
-// RemoveResourceRuns removes "resource_runs" edges to ResourceRun entities.
-func (puo *ProjectUpdateOne) RemoveResourceRuns(r ...*ResourceRun) *ProjectUpdateOne {
-	ids := make([]object.ID, len(r))
-	for i := range r {
-		ids[i] = r[i].ID
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (puo *ProjectUpdateOne) RemoveBudgets(b ...*Budget) *ProjectUpdateOne {
+	ids := make([]object.ID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
 	}
-	return puo.RemoveResourceRunIDs(ids...)
+	return puo.RemoveBudgetIDs(ids...)
 }
 
 // ClearVariables clears all "variables" edges to the Variable entity.
@@ -2794,6 +3520,294 @@ func (puo *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.ResourceStateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceStateVersionsTable,
+			Columns: []string{project.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceStateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedResourceStateVersionsIDs(); len(nodes) > 0 && !puo.mutation.ResourceStateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceStateVersionsTable,
+			Columns: []string{project.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ResourceStateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceStateVersionsTable,
+			Columns: []string{project.ResourceStateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ResourceRunApprovalRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceRunApprovalRulesTable,
+			Columns: []string{project.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceRunApprovalRule
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedResourceRunApprovalRulesIDs(); len(nodes) > 0 && !puo.mutation.ResourceRunApprovalRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceRunApprovalRulesTable,
+			Columns: []string{project.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ResourceRunApprovalRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceRunApprovalRulesTable,
+			Columns: []string{project.ResourceRunApprovalRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ResourceRunPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceRunPoliciesTable,
+			Columns: []string{project.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceRunPolicy
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedResourceRunPoliciesIDs(); len(nodes) > 0 && !puo.mutation.ResourceRunPoliciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceRunPoliciesTable,
+			Columns: []string{project.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ResourceRunPoliciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ResourceRunPoliciesTable,
+			Columns: []string{project.ResourceRunPoliciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.TemplateUpgradeCampaignsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TemplateUpgradeCampaignsTable,
+			Columns: []string{project.TemplateUpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.TemplateUpgradeCampaign
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedTemplateUpgradeCampaignsIDs(); len(nodes) > 0 && !puo.mutation.TemplateUpgradeCampaignsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TemplateUpgradeCampaignsTable,
+			Columns: []string{project.TemplateUpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TemplateUpgradeCampaignsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TemplateUpgradeCampaignsTable,
+			Columns: []string{project.TemplateUpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.Webhook
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedWebhooksIDs(); len(nodes) > 0 && !puo.mutation.WebhooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.WebhooksTable,
+			Columns: []string{project.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.Budget
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !puo.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.BudgetsTable,
+			Columns: []string{project.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = puo.schemaConfig.Budget
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if puo.mutation.VariablesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -19,6 +19,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinition"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinitionmatchingrule"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
@@ -64,6 +65,8 @@ type Resource struct {
 	ComputedAttributes property.Values `json:"computedAttributes"`
 	// Endpoints of the resource.
 	Endpoints types.ResourceEndpoints `json:"endpoints,omitempty,cli-table-column"`
+	// Drift detection result of the resource.
+	Drift *types.ResourceDrift `json:"drift,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ResourceQuery when eager-loading is set.
 	Edges        ResourceEdges `json:"edges,omitempty"`
@@ -90,9 +93,13 @@ type ResourceEdges struct {
 	Dependencies []*ResourceRelationship `json:"dependencies,omitempty"`
 	// State of the resource.
 	State *ResourceState `json:"state,omitempty"`
+	// State versions of the resource.
+	StateVersions []*ResourceStateVersion `json:"state_versions,omitempty"`
+	// Lock of the resource state.
+	StateLock *ResourceStateLock `json:"state_lock,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [9]bool
+	loadedTypes [11]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -200,6 +207,28 @@ func (e ResourceEdges) StateOrErr() (*ResourceState, error) {
 	return nil, &NotLoadedError{edge: "state"}
 }
 
+// StateVersionsOrErr returns the StateVersions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResourceEdges) StateVersionsOrErr() ([]*ResourceStateVersion, error) {
+	if e.loadedTypes[9] {
+		return e.StateVersions, nil
+	}
+	return nil, &NotLoadedError{edge: "state_versions"}
+}
+
+// StateLockOrErr returns the StateLock value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceEdges) StateLockOrErr() (*ResourceStateLock, error) {
+	if e.loadedTypes[10] {
+		if e.StateLock == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: resourcestatelock.Label}
+		}
+		return e.StateLock, nil
+	}
+	return nil, &NotLoadedError{edge: "state_lock"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Resource) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -207,7 +236,7 @@ func (*Resource) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case resource.FieldTemplateID, resource.FieldResourceDefinitionID, resource.FieldResourceDefinitionMatchingRuleID:
 			values[i] = &sql.NullScanner{S: new(object.ID)}
-		case resource.FieldLabels, resource.FieldAnnotations, resource.FieldStatus, resource.FieldEndpoints:
+		case resource.FieldLabels, resource.FieldAnnotations, resource.FieldStatus, resource.FieldEndpoints, resource.FieldDrift:
 			values[i] = new([]byte)
 		case resource.FieldID, resource.FieldProjectID, resource.FieldEnvironmentID:
 			values[i] = new(object.ID)
@@ -347,6 +376,14 @@ func (r *Resource) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field endpoints: %w", err)
 				}
 			}
+		case resource.FieldDrift:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field drift", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &r.Drift); err != nil {
+					return fmt.Errorf("unmarshal field drift: %w", err)
+				}
+			}
 		default:
 			r.selectValues.Set(columns[i], values[i])
 		}
@@ -405,6 +442,16 @@ func (r *Resource) QueryState() *ResourceStateQuery {
 	return NewResourceClient(r.config).QueryState(r)
 }
 
+// QueryStateVersions queries the "state_versions" edge of the Resource entity.
+func (r *Resource) QueryStateVersions() *ResourceStateVersionQuery {
+	return NewResourceClient(r.config).QueryStateVersions(r)
+}
+
+// QueryStateLock queries the "state_lock" edge of the Resource entity.
+func (r *Resource) QueryStateLock() *ResourceStateLockQuery {
+	return NewResourceClient(r.config).QueryStateLock(r)
+}
+
 // Update returns a builder for updating this Resource.
 // Note that you need to call Resource.Unwrap() before calling this method if this Resource
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -485,6 +532,9 @@ func (r *Resource) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("endpoints=")
 	builder.WriteString(fmt.Sprintf("%v", r.Endpoints))
+	builder.WriteString(", ")
+	builder.WriteString("drift=")
+	builder.WriteString(fmt.Sprintf("%v", r.Drift))
 	builder.WriteByte(')')
 	return builder.String()
 }
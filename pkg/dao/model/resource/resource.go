@@ -53,6 +53,8 @@ const (
 	FieldComputedAttributes = "computed_attributes"
 	// FieldEndpoints holds the string denoting the endpoints field in the database.
 	FieldEndpoints = "endpoints"
+	// FieldDrift holds the string denoting the drift field in the database.
+	FieldDrift = "drift"
 	// EdgeProject holds the string denoting the project edge name in mutations.
 	EdgeProject = "project"
 	// EdgeEnvironment holds the string denoting the environment edge name in mutations.
@@ -71,6 +73,10 @@ const (
 	EdgeDependencies = "dependencies"
 	// EdgeState holds the string denoting the state edge name in mutations.
 	EdgeState = "state"
+	// EdgeStateVersions holds the string denoting the state_versions edge name in mutations.
+	EdgeStateVersions = "state_versions"
+	// EdgeStateLock holds the string denoting the state_lock edge name in mutations.
+	EdgeStateLock = "state_lock"
 	// Table holds the table name of the resource in the database.
 	Table = "resources"
 	// ProjectTable is the table that holds the project relation/edge.
@@ -136,6 +142,20 @@ const (
 	StateInverseTable = "resource_states"
 	// StateColumn is the table column denoting the state relation/edge.
 	StateColumn = "resource_id"
+	// StateVersionsTable is the table that holds the state_versions relation/edge.
+	StateVersionsTable = "resource_state_versions"
+	// StateVersionsInverseTable is the table name for the ResourceStateVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcestateversion" package.
+	StateVersionsInverseTable = "resource_state_versions"
+	// StateVersionsColumn is the table column denoting the state_versions relation/edge.
+	StateVersionsColumn = "resource_id"
+	// StateLockTable is the table that holds the state_lock relation/edge.
+	StateLockTable = "resource_state_locks"
+	// StateLockInverseTable is the table name for the ResourceStateLock entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcestatelock" package.
+	StateLockInverseTable = "resource_state_locks"
+	// StateLockColumn is the table column denoting the state_lock relation/edge.
+	StateLockColumn = "resource_id"
 )
 
 // Columns holds all SQL columns for resource fields.
@@ -157,6 +177,7 @@ var Columns = []string{
 	FieldAttributes,
 	FieldComputedAttributes,
 	FieldEndpoints,
+	FieldDrift,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -350,6 +371,27 @@ func ByStateField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newStateStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByStateVersionsCount orders the results by state_versions count.
+func ByStateVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStateVersionsStep(), opts...)
+	}
+}
+
+// ByStateVersions orders the results by state_versions terms.
+func ByStateVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStateVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStateLockField orders the results by state_lock field.
+func ByStateLockField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStateLockStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newProjectStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -413,6 +455,20 @@ func newStateStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, StateTable, StateColumn),
 	)
 }
+func newStateVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StateVersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StateVersionsTable, StateVersionsColumn),
+	)
+}
+func newStateLockStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StateLockInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, StateLockTable, StateLockColumn),
+	)
+}
 
 // WithoutFields returns the fields ignored the given list.
 func WithoutFields(ignores ...string) []string {
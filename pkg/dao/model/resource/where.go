@@ -937,6 +937,16 @@ func EndpointsNotNil() predicate.Resource {
 	return predicate.Resource(sql.FieldNotNull(FieldEndpoints))
 }
 
+// DriftIsNil applies the IsNil predicate on the "drift" field.
+func DriftIsNil() predicate.Resource {
+	return predicate.Resource(sql.FieldIsNull(FieldDrift))
+}
+
+// DriftNotNil applies the NotNil predicate on the "drift" field.
+func DriftNotNil() predicate.Resource {
+	return predicate.Resource(sql.FieldNotNull(FieldDrift))
+}
+
 // HasProject applies the HasEdge predicate on the "project" edge.
 func HasProject() predicate.Resource {
 	return predicate.Resource(func(s *sql.Selector) {
@@ -1198,6 +1208,64 @@ func HasStateWith(preds ...predicate.ResourceState) predicate.Resource {
 	})
 }
 
+// HasStateVersions applies the HasEdge predicate on the "state_versions" edge.
+func HasStateVersions() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StateVersionsTable, StateVersionsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStateVersionsWith applies the HasEdge predicate on the "state_versions" edge with a given conditions (other predicates).
+func HasStateVersionsWith(preds ...predicate.ResourceStateVersion) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := newStateVersionsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStateLock applies the HasEdge predicate on the "state_lock" edge.
+func HasStateLock() predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, StateLockTable, StateLockColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateLock
+		step.Edge.Schema = schemaConfig.ResourceStateLock
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStateLockWith applies the HasEdge predicate on the "state_lock" edge with a given conditions (other predicates).
+func HasStateLockWith(preds ...predicate.ResourceStateLock) predicate.Resource {
+	return predicate.Resource(func(s *sql.Selector) {
+		step := newStateLockStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateLock
+		step.Edge.Schema = schemaConfig.ResourceStateLock
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Resource) predicate.Resource {
 	return predicate.Resource(sql.AndPredicates(predicates...))
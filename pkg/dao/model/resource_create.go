@@ -26,6 +26,8 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
@@ -203,6 +205,12 @@ func (rc *ResourceCreate) SetEndpoints(te types.ResourceEndpoints) *ResourceCrea
 	return rc
 }
 
+// SetDrift sets the "drift" field.
+func (rc *ResourceCreate) SetDrift(td *types.ResourceDrift) *ResourceCreate {
+	rc.mutation.SetDrift(td)
+	return rc
+}
+
 // SetID sets the "id" field.
 func (rc *ResourceCreate) SetID(o object.ID) *ResourceCreate {
 	rc.mutation.SetID(o)
@@ -298,6 +306,40 @@ func (rc *ResourceCreate) SetState(r *ResourceState) *ResourceCreate {
 	return rc.SetStateID(r.ID)
 }
 
+// AddStateVersionIDs adds the "state_versions" edge to the ResourceStateVersion entity by IDs.
+func (rc *ResourceCreate) AddStateVersionIDs(ids ...object.ID) *ResourceCreate {
+	rc.mutation.AddStateVersionIDs(ids...)
+	return rc
+}
+
+// AddStateVersions adds the "state_versions" edges to the ResourceStateVersion entity.
+func (rc *ResourceCreate) AddStateVersions(r ...*ResourceStateVersion) *ResourceCreate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rc.AddStateVersionIDs(ids...)
+}
+
+// SetStateLockID sets the "state_lock" edge to the ResourceStateLock entity by ID.
+func (rc *ResourceCreate) SetStateLockID(id object.ID) *ResourceCreate {
+	rc.mutation.SetStateLockID(id)
+	return rc
+}
+
+// SetNillableStateLockID sets the "state_lock" edge to the ResourceStateLock entity by ID if the given value is not nil.
+func (rc *ResourceCreate) SetNillableStateLockID(id *object.ID) *ResourceCreate {
+	if id != nil {
+		rc = rc.SetStateLockID(*id)
+	}
+	return rc
+}
+
+// SetStateLock sets the "state_lock" edge to the ResourceStateLock entity.
+func (rc *ResourceCreate) SetStateLock(r *ResourceStateLock) *ResourceCreate {
+	return rc.SetStateLockID(r.ID)
+}
+
 // Mutation returns the ResourceMutation object of the builder.
 func (rc *ResourceCreate) Mutation() *ResourceMutation {
 	return rc.mutation
@@ -487,6 +529,10 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 		_spec.SetField(resource.FieldEndpoints, field.TypeJSON, value)
 		_node.Endpoints = value
 	}
+	if value, ok := rc.mutation.Drift(); ok {
+		_spec.SetField(resource.FieldDrift, field.TypeJSON, value)
+		_node.Drift = value
+	}
 	if nodes := rc.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -645,6 +691,40 @@ func (rc *ResourceCreate) createSpec() (*Resource, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rc.mutation.StateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.StateVersionsTable,
+			Columns: []string{resource.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rc.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rc.mutation.StateLockIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   resource.StateLockTable,
+			Columns: []string{resource.StateLockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rc.schemaConfig.ResourceStateLock
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -712,6 +792,9 @@ func (rc *ResourceCreate) Set(obj *Resource) *ResourceCreate {
 	if !reflect.ValueOf(obj.Endpoints).IsZero() {
 		rc.SetEndpoints(obj.Endpoints)
 	}
+	if !reflect.ValueOf(obj.Drift).IsZero() {
+		rc.SetDrift(obj.Drift)
+	}
 
 	// Record the given object.
 	rc.object = obj
@@ -790,6 +873,9 @@ func (rc *ResourceCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Con
 		if _, set := rc.mutation.Field(resource.FieldEndpoints); set {
 			obj.Endpoints = x.Endpoints
 		}
+		if _, set := rc.mutation.Field(resource.FieldDrift); set {
+			obj.Drift = x.Drift
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -934,6 +1020,9 @@ func (rcb *ResourceCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx contex
 			if _, set := rcb.builders[i].mutation.Field(resource.FieldEndpoints); set {
 				objs[i].Endpoints = x[i].Endpoints
 			}
+			if _, set := rcb.builders[i].mutation.Field(resource.FieldDrift); set {
+				objs[i].Drift = x[i].Drift
+			}
 			objs[i].Edges = x[i].Edges
 		}
 	}
@@ -1252,6 +1341,24 @@ func (u *ResourceUpsert) ClearEndpoints() *ResourceUpsert {
 	return u
 }
 
+// SetDrift sets the "drift" field.
+func (u *ResourceUpsert) SetDrift(v *types.ResourceDrift) *ResourceUpsert {
+	u.Set(resource.FieldDrift, v)
+	return u
+}
+
+// UpdateDrift sets the "drift" field to the value that was provided on create.
+func (u *ResourceUpsert) UpdateDrift() *ResourceUpsert {
+	u.SetExcluded(resource.FieldDrift)
+	return u
+}
+
+// ClearDrift clears the value of the "drift" field.
+func (u *ResourceUpsert) ClearDrift() *ResourceUpsert {
+	u.SetNull(resource.FieldDrift)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1539,6 +1646,27 @@ func (u *ResourceUpsertOne) ClearEndpoints() *ResourceUpsertOne {
 	})
 }
 
+// SetDrift sets the "drift" field.
+func (u *ResourceUpsertOne) SetDrift(v *types.ResourceDrift) *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetDrift(v)
+	})
+}
+
+// UpdateDrift sets the "drift" field to the value that was provided on create.
+func (u *ResourceUpsertOne) UpdateDrift() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateDrift()
+	})
+}
+
+// ClearDrift clears the value of the "drift" field.
+func (u *ResourceUpsertOne) ClearDrift() *ResourceUpsertOne {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearDrift()
+	})
+}
+
 // Exec executes the query.
 func (u *ResourceUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1995,6 +2123,27 @@ func (u *ResourceUpsertBulk) ClearEndpoints() *ResourceUpsertBulk {
 	})
 }
 
+// SetDrift sets the "drift" field.
+func (u *ResourceUpsertBulk) SetDrift(v *types.ResourceDrift) *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.SetDrift(v)
+	})
+}
+
+// UpdateDrift sets the "drift" field to the value that was provided on create.
+func (u *ResourceUpsertBulk) UpdateDrift() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.UpdateDrift()
+	})
+}
+
+// ClearDrift clears the value of the "drift" field.
+func (u *ResourceUpsertBulk) ClearDrift() *ResourceUpsertBulk {
+	return u.Update(func(s *ResourceUpsert) {
+		s.ClearDrift()
+	})
+}
+
 // Exec executes the query.
 func (u *ResourceUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -27,6 +27,8 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
@@ -47,6 +49,8 @@ type ResourceQuery struct {
 	withComponents                     *ResourceComponentQuery
 	withDependencies                   *ResourceRelationshipQuery
 	withState                          *ResourceStateQuery
+	withStateVersions                  *ResourceStateVersionQuery
+	withStateLock                      *ResourceStateLockQuery
 	modifiers                          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -309,6 +313,56 @@ func (rq *ResourceQuery) QueryState() *ResourceStateQuery {
 	return query
 }
 
+// QueryStateVersions chains the current query on the "state_versions" edge.
+func (rq *ResourceQuery) QueryStateVersions() *ResourceStateVersionQuery {
+	query := (&ResourceStateVersionClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, selector),
+			sqlgraph.To(resourcestateversion.Table, resourcestateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resource.StateVersionsTable, resource.StateVersionsColumn),
+		)
+		schemaConfig := rq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStateLock chains the current query on the "state_lock" edge.
+func (rq *ResourceQuery) QueryStateLock() *ResourceStateLockQuery {
+	query := (&ResourceStateLockClient{config: rq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resource.Table, resource.FieldID, selector),
+			sqlgraph.To(resourcestatelock.Table, resourcestatelock.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, resource.StateLockTable, resource.StateLockColumn),
+		)
+		schemaConfig := rq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateLock
+		step.Edge.Schema = schemaConfig.ResourceStateLock
+		fromU = sqlgraph.SetNeighbors(rq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Resource entity from the query.
 // Returns a *NotFoundError when no Resource was found.
 func (rq *ResourceQuery) First(ctx context.Context) (*Resource, error) {
@@ -510,6 +564,8 @@ func (rq *ResourceQuery) Clone() *ResourceQuery {
 		withComponents:                     rq.withComponents.Clone(),
 		withDependencies:                   rq.withDependencies.Clone(),
 		withState:                          rq.withState.Clone(),
+		withStateVersions:                  rq.withStateVersions.Clone(),
+		withStateLock:                      rq.withStateLock.Clone(),
 		// clone intermediate query.
 		sql:  rq.sql.Clone(),
 		path: rq.path,
@@ -615,6 +671,28 @@ func (rq *ResourceQuery) WithState(opts ...func(*ResourceStateQuery)) *ResourceQ
 	return rq
 }
 
+// WithStateVersions tells the query-builder to eager-load the nodes that are connected to
+// the "state_versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResourceQuery) WithStateVersions(opts ...func(*ResourceStateVersionQuery)) *ResourceQuery {
+	query := (&ResourceStateVersionClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withStateVersions = query
+	return rq
+}
+
+// WithStateLock tells the query-builder to eager-load the nodes that are connected to
+// the "state_lock" edge. The optional arguments are used to configure the query builder of the edge.
+func (rq *ResourceQuery) WithStateLock(opts ...func(*ResourceStateLockQuery)) *ResourceQuery {
+	query := (&ResourceStateLockClient{config: rq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rq.withStateLock = query
+	return rq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -693,7 +771,7 @@ func (rq *ResourceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Res
 	var (
 		nodes       = []*Resource{}
 		_spec       = rq.querySpec()
-		loadedTypes = [9]bool{
+		loadedTypes = [11]bool{
 			rq.withProject != nil,
 			rq.withEnvironment != nil,
 			rq.withTemplate != nil,
@@ -703,6 +781,8 @@ func (rq *ResourceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Res
 			rq.withComponents != nil,
 			rq.withDependencies != nil,
 			rq.withState != nil,
+			rq.withStateVersions != nil,
+			rq.withStateLock != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -785,6 +865,19 @@ func (rq *ResourceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Res
 			return nil, err
 		}
 	}
+	if query := rq.withStateVersions; query != nil {
+		if err := rq.loadStateVersions(ctx, query, nodes,
+			func(n *Resource) { n.Edges.StateVersions = []*ResourceStateVersion{} },
+			func(n *Resource, e *ResourceStateVersion) { n.Edges.StateVersions = append(n.Edges.StateVersions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rq.withStateLock; query != nil {
+		if err := rq.loadStateLock(ctx, query, nodes, nil,
+			func(n *Resource, e *ResourceStateLock) { n.Edges.StateLock = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1059,6 +1152,63 @@ func (rq *ResourceQuery) loadState(ctx context.Context, query *ResourceStateQuer
 	}
 	return nil
 }
+func (rq *ResourceQuery) loadStateVersions(ctx context.Context, query *ResourceStateVersionQuery, nodes []*Resource, init func(*Resource), assign func(*Resource, *ResourceStateVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Resource)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcestateversion.FieldResourceID)
+	}
+	query.Where(predicate.ResourceStateVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(resource.StateVersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ResourceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "resource_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (rq *ResourceQuery) loadStateLock(ctx context.Context, query *ResourceStateLockQuery, nodes []*Resource, init func(*Resource), assign func(*Resource, *ResourceStateLock)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*Resource)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcestatelock.FieldResourceID)
+	}
+	query.Where(predicate.ResourceStateLock(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(resource.StateLockColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ResourceID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "resource_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (rq *ResourceQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rq.querySpec()
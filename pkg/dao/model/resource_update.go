@@ -28,6 +28,8 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
@@ -222,6 +224,18 @@ func (ru *ResourceUpdate) ClearEndpoints() *ResourceUpdate {
 	return ru
 }
 
+// SetDrift sets the "drift" field.
+func (ru *ResourceUpdate) SetDrift(td *types.ResourceDrift) *ResourceUpdate {
+	ru.mutation.SetDrift(td)
+	return ru
+}
+
+// ClearDrift clears the value of the "drift" field.
+func (ru *ResourceUpdate) ClearDrift() *ResourceUpdate {
+	ru.mutation.ClearDrift()
+	return ru
+}
+
 // SetTemplate sets the "template" edge to the TemplateVersion entity.
 func (ru *ResourceUpdate) SetTemplate(t *TemplateVersion) *ResourceUpdate {
 	return ru.SetTemplateID(t.ID)
@@ -301,6 +315,40 @@ func (ru *ResourceUpdate) SetState(r *ResourceState) *ResourceUpdate {
 	return ru.SetStateID(r.ID)
 }
 
+// AddStateVersionIDs adds the "state_versions" edge to the ResourceStateVersion entity by IDs.
+func (ru *ResourceUpdate) AddStateVersionIDs(ids ...object.ID) *ResourceUpdate {
+	ru.mutation.AddStateVersionIDs(ids...)
+	return ru
+}
+
+// AddStateVersions adds the "state_versions" edges to the ResourceStateVersion entity.
+func (ru *ResourceUpdate) AddStateVersions(r ...*ResourceStateVersion) *ResourceUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.AddStateVersionIDs(ids...)
+}
+
+// SetStateLockID sets the "state_lock" edge to the ResourceStateLock entity by ID.
+func (ru *ResourceUpdate) SetStateLockID(id object.ID) *ResourceUpdate {
+	ru.mutation.SetStateLockID(id)
+	return ru
+}
+
+// SetNillableStateLockID sets the "state_lock" edge to the ResourceStateLock entity by ID if the given value is not nil.
+func (ru *ResourceUpdate) SetNillableStateLockID(id *object.ID) *ResourceUpdate {
+	if id != nil {
+		ru = ru.SetStateLockID(*id)
+	}
+	return ru
+}
+
+// SetStateLock sets the "state_lock" edge to the ResourceStateLock entity.
+func (ru *ResourceUpdate) SetStateLock(r *ResourceStateLock) *ResourceUpdate {
+	return ru.SetStateLockID(r.ID)
+}
+
 // Mutation returns the ResourceMutation object of the builder.
 func (ru *ResourceUpdate) Mutation() *ResourceMutation {
 	return ru.mutation
@@ -393,6 +441,33 @@ func (ru *ResourceUpdate) ClearState() *ResourceUpdate {
 	return ru
 }
 
+// ClearStateVersions clears all "state_versions" edges to the ResourceStateVersion entity.
+func (ru *ResourceUpdate) ClearStateVersions() *ResourceUpdate {
+	ru.mutation.ClearStateVersions()
+	return ru
+}
+
+// RemoveStateVersionIDs removes the "state_versions" edge to ResourceStateVersion entities by IDs.
+func (ru *ResourceUpdate) RemoveStateVersionIDs(ids ...object.ID) *ResourceUpdate {
+	ru.mutation.RemoveStateVersionIDs(ids...)
+	return ru
+}
+
+// RemoveStateVersions removes "state_versions" edges to ResourceStateVersion entities.
+func (ru *ResourceUpdate) RemoveStateVersions(r ...*ResourceStateVersion) *ResourceUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ru.RemoveStateVersionIDs(ids...)
+}
+
+// ClearStateLock clears the "state_lock" edge to the ResourceStateLock entity.
+func (ru *ResourceUpdate) ClearStateLock() *ResourceUpdate {
+	ru.mutation.ClearStateLock()
+	return ru
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ru *ResourceUpdate) Save(ctx context.Context) (int, error) {
 	if err := ru.defaults(); err != nil {
@@ -515,6 +590,9 @@ func (ru *ResourceUpdate) Set(obj *Resource) *ResourceUpdate {
 	} else {
 		ru.ClearEndpoints()
 	}
+	if !reflect.ValueOf(obj.Drift).IsZero() {
+		ru.SetDrift(obj.Drift)
+	}
 
 	// With Default.
 	if obj.UpdateTime != nil {
@@ -598,6 +676,12 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if ru.mutation.EndpointsCleared() {
 		_spec.ClearField(resource.FieldEndpoints, field.TypeJSON)
 	}
+	if value, ok := ru.mutation.Drift(); ok {
+		_spec.SetField(resource.FieldDrift, field.TypeJSON, value)
+	}
+	if ru.mutation.DriftCleared() {
+		_spec.ClearField(resource.FieldDrift, field.TypeJSON)
+	}
 	if ru.mutation.TemplateCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -866,6 +950,85 @@ func (ru *ResourceUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ru.mutation.StateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.StateVersionsTable,
+			Columns: []string{resource.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ru.schemaConfig.ResourceStateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.RemovedStateVersionsIDs(); len(nodes) > 0 && !ru.mutation.StateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.StateVersionsTable,
+			Columns: []string{resource.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ru.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.StateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.StateVersionsTable,
+			Columns: []string{resource.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ru.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ru.mutation.StateLockCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   resource.StateLockTable,
+			Columns: []string{resource.StateLockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ru.schemaConfig.ResourceStateLock
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.StateLockIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   resource.StateLockTable,
+			Columns: []string{resource.StateLockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ru.schemaConfig.ResourceStateLock
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ru.schemaConfig.Resource
 	ctx = internal.NewSchemaConfigContext(ctx, ru.schemaConfig)
 	_spec.AddModifiers(ru.modifiers...)
@@ -1063,6 +1226,18 @@ func (ruo *ResourceUpdateOne) ClearEndpoints() *ResourceUpdateOne {
 	return ruo
 }
 
+// SetDrift sets the "drift" field.
+func (ruo *ResourceUpdateOne) SetDrift(td *types.ResourceDrift) *ResourceUpdateOne {
+	ruo.mutation.SetDrift(td)
+	return ruo
+}
+
+// ClearDrift clears the value of the "drift" field.
+func (ruo *ResourceUpdateOne) ClearDrift() *ResourceUpdateOne {
+	ruo.mutation.ClearDrift()
+	return ruo
+}
+
 // SetTemplate sets the "template" edge to the TemplateVersion entity.
 func (ruo *ResourceUpdateOne) SetTemplate(t *TemplateVersion) *ResourceUpdateOne {
 	return ruo.SetTemplateID(t.ID)
@@ -1142,6 +1317,40 @@ func (ruo *ResourceUpdateOne) SetState(r *ResourceState) *ResourceUpdateOne {
 	return ruo.SetStateID(r.ID)
 }
 
+// AddStateVersionIDs adds the "state_versions" edge to the ResourceStateVersion entity by IDs.
+func (ruo *ResourceUpdateOne) AddStateVersionIDs(ids ...object.ID) *ResourceUpdateOne {
+	ruo.mutation.AddStateVersionIDs(ids...)
+	return ruo
+}
+
+// AddStateVersions adds the "state_versions" edges to the ResourceStateVersion entity.
+func (ruo *ResourceUpdateOne) AddStateVersions(r ...*ResourceStateVersion) *ResourceUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.AddStateVersionIDs(ids...)
+}
+
+// SetStateLockID sets the "state_lock" edge to the ResourceStateLock entity by ID.
+func (ruo *ResourceUpdateOne) SetStateLockID(id object.ID) *ResourceUpdateOne {
+	ruo.mutation.SetStateLockID(id)
+	return ruo
+}
+
+// SetNillableStateLockID sets the "state_lock" edge to the ResourceStateLock entity by ID if the given value is not nil.
+func (ruo *ResourceUpdateOne) SetNillableStateLockID(id *object.ID) *ResourceUpdateOne {
+	if id != nil {
+		ruo = ruo.SetStateLockID(*id)
+	}
+	return ruo
+}
+
+// SetStateLock sets the "state_lock" edge to the ResourceStateLock entity.
+func (ruo *ResourceUpdateOne) SetStateLock(r *ResourceStateLock) *ResourceUpdateOne {
+	return ruo.SetStateLockID(r.ID)
+}
+
 // Mutation returns the ResourceMutation object of the builder.
 func (ruo *ResourceUpdateOne) Mutation() *ResourceMutation {
 	return ruo.mutation
@@ -1234,6 +1443,33 @@ func (ruo *ResourceUpdateOne) ClearState() *ResourceUpdateOne {
 	return ruo
 }
 
+// ClearStateVersions clears all "state_versions" edges to the ResourceStateVersion entity.
+func (ruo *ResourceUpdateOne) ClearStateVersions() *ResourceUpdateOne {
+	ruo.mutation.ClearStateVersions()
+	return ruo
+}
+
+// RemoveStateVersionIDs removes the "state_versions" edge to ResourceStateVersion entities by IDs.
+func (ruo *ResourceUpdateOne) RemoveStateVersionIDs(ids ...object.ID) *ResourceUpdateOne {
+	ruo.mutation.RemoveStateVersionIDs(ids...)
+	return ruo
+}
+
+// RemoveStateVersions removes "state_versions" edges to ResourceStateVersion entities.
+func (ruo *ResourceUpdateOne) RemoveStateVersions(r ...*ResourceStateVersion) *ResourceUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ruo.RemoveStateVersionIDs(ids...)
+}
+
+// ClearStateLock clears the "state_lock" edge to the ResourceStateLock entity.
+func (ruo *ResourceUpdateOne) ClearStateLock() *ResourceUpdateOne {
+	ruo.mutation.ClearStateLock()
+	return ruo
+}
+
 // Where appends a list predicates to the ResourceUpdate builder.
 func (ruo *ResourceUpdateOne) Where(ps ...predicate.Resource) *ResourceUpdateOne {
 	ruo.mutation.Where(ps...)
@@ -1399,6 +1635,11 @@ func (ruo *ResourceUpdateOne) Set(obj *Resource) *ResourceUpdateOne {
 			} else {
 				ruo.ClearEndpoints()
 			}
+			if !reflect.ValueOf(obj.Drift).IsZero() {
+				if !reflect.DeepEqual(db.Drift, obj.Drift) {
+					ruo.SetDrift(obj.Drift)
+				}
+			}
 
 			// With Default.
 			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
@@ -1479,6 +1720,9 @@ func (ruo *ResourceUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context
 		if _, set := ruo.mutation.Field(resource.FieldEndpoints); set {
 			obj.Endpoints = x.Endpoints
 		}
+		if _, set := ruo.mutation.Field(resource.FieldDrift); set {
+			obj.Drift = x.Drift
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -1602,6 +1846,12 @@ func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err
 	if ruo.mutation.EndpointsCleared() {
 		_spec.ClearField(resource.FieldEndpoints, field.TypeJSON)
 	}
+	if value, ok := ruo.mutation.Drift(); ok {
+		_spec.SetField(resource.FieldDrift, field.TypeJSON, value)
+	}
+	if ruo.mutation.DriftCleared() {
+		_spec.ClearField(resource.FieldDrift, field.TypeJSON)
+	}
 	if ruo.mutation.TemplateCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1870,6 +2120,85 @@ func (ruo *ResourceUpdateOne) sqlSave(ctx context.Context) (_node *Resource, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ruo.mutation.StateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.StateVersionsTable,
+			Columns: []string{resource.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ruo.schemaConfig.ResourceStateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.RemovedStateVersionsIDs(); len(nodes) > 0 && !ruo.mutation.StateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.StateVersionsTable,
+			Columns: []string{resource.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ruo.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.StateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resource.StateVersionsTable,
+			Columns: []string{resource.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ruo.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ruo.mutation.StateLockCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   resource.StateLockTable,
+			Columns: []string{resource.StateLockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ruo.schemaConfig.ResourceStateLock
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.StateLockIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   resource.StateLockTable,
+			Columns: []string{resource.StateLockColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = ruo.schemaConfig.ResourceStateLock
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = ruo.schemaConfig.Resource
 	ctx = internal.NewSchemaConfigContext(ctx, ruo.schemaConfig)
 	_spec.AddModifiers(ruo.modifiers...)
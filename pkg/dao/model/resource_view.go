@@ -527,6 +527,8 @@ type ResourcePatchInput struct {
 	ComputedAttributes property.Values `path:"-" query:"-" json:"computedAttributes,omitempty"`
 	// Endpoints of the resource.
 	Endpoints types.ResourceEndpoints `path:"-" query:"-" json:"endpoints,omitempty"`
+	// Drift detection result of the resource.
+	Drift *types.ResourceDrift `path:"-" query:"-" json:"drift,omitempty"`
 
 	// Template indicates replacing the stale TemplateVersion entity.
 	Template *TemplateVersionQueryInput `uri:"-" query:"-" json:"template,omitempty"`
@@ -556,6 +558,7 @@ func (rpi *ResourcePatchInput) PatchModel() *Resource {
 		Attributes:         rpi.Attributes,
 		ComputedAttributes: rpi.ComputedAttributes,
 		Endpoints:          rpi.Endpoints,
+		Drift:              rpi.Drift,
 	}
 
 	if rpi.Project != nil {
@@ -685,6 +688,7 @@ func (rpi *ResourcePatchInput) ValidateWith(ctx context.Context, cs ClientSet, c
 			resource.FieldCreateTime,
 			resource.FieldUpdateTime,
 			resource.FieldStatus,
+			resource.FieldDrift,
 		)...,
 	)
 
@@ -1273,6 +1277,7 @@ type ResourceOutput struct {
 	Attributes         property.Values         `json:"attributes"`
 	ComputedAttributes property.Values         `json:"computedAttributes"`
 	Endpoints          types.ResourceEndpoints `json:"endpoints,cli-table-column,omitempty"`
+	Drift              *types.ResourceDrift    `json:"drift,omitempty"`
 
 	Project                        *ProjectOutput                        `json:"project,omitempty"`
 	Environment                    *EnvironmentOutput                    `json:"environment,omitempty"`
@@ -1309,6 +1314,7 @@ func ExposeResource(_r *Resource) *ResourceOutput {
 		Attributes:         _r.Attributes,
 		ComputedAttributes: _r.ComputedAttributes,
 		Endpoints:          _r.Endpoints,
+		Drift:              _r.Drift,
 	}
 
 	if _r.Edges.Project != nil {
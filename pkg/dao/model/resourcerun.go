@@ -80,6 +80,12 @@ type ResourceRun struct {
 	ComponentChanges []*types.ResourceComponentChange `json:"component_changes,omitempty"`
 	// Change summary of the resource.
 	ComponentChangeSummary types.ResourceComponentChangeSummary `json:"component_change_summary,omitempty"`
+	// Estimated monthly cost of the planned changes of the resource.
+	CostEstimate *types.ResourceRunCostEstimate `json:"cost_estimate,omitempty"`
+	// Evaluation results of the policies against the plan of the run.
+	PolicyResults types.ResourceRunPolicyResults `json:"policy_results,omitempty"`
+	// Approval requirements and records of the run.
+	Approval *types.ResourceRunApproval `json:"approval,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ResourceRunQuery when eager-loading is set.
 	Edges        ResourceRunEdges `json:"edges,omitempty"`
@@ -94,9 +100,11 @@ type ResourceRunEdges struct {
 	Environment *Environment `json:"environment,omitempty"`
 	// Resource to which the run belongs.
 	Resource *Resource `json:"resource,omitempty"`
+	// State versions written by the run.
+	StateVersions []*ResourceStateVersion `json:"state_versions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -138,12 +146,21 @@ func (e ResourceRunEdges) ResourceOrErr() (*Resource, error) {
 	return nil, &NotLoadedError{edge: "resource"}
 }
 
+// StateVersionsOrErr returns the StateVersions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ResourceRunEdges) StateVersionsOrErr() ([]*ResourceStateVersion, error) {
+	if e.loadedTypes[3] {
+		return e.StateVersions, nil
+	}
+	return nil, &NotLoadedError{edge: "state_versions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ResourceRun) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case resourcerun.FieldStatus, resourcerun.FieldInputConfigs, resourcerun.FieldPreviousRequiredProviders, resourcerun.FieldAnnotations, resourcerun.FieldLabels, resourcerun.FieldComponentChanges, resourcerun.FieldComponentChangeSummary:
+		case resourcerun.FieldStatus, resourcerun.FieldInputConfigs, resourcerun.FieldPreviousRequiredProviders, resourcerun.FieldAnnotations, resourcerun.FieldLabels, resourcerun.FieldComponentChanges, resourcerun.FieldComponentChangeSummary, resourcerun.FieldCostEstimate, resourcerun.FieldPolicyResults, resourcerun.FieldApproval:
 			values[i] = new([]byte)
 		case resourcerun.FieldVariables:
 			values[i] = new(crypto.Map[string, string])
@@ -345,6 +362,30 @@ func (rr *ResourceRun) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field component_change_summary: %w", err)
 				}
 			}
+		case resourcerun.FieldCostEstimate:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_estimate", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.CostEstimate); err != nil {
+					return fmt.Errorf("unmarshal field cost_estimate: %w", err)
+				}
+			}
+		case resourcerun.FieldPolicyResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.PolicyResults); err != nil {
+					return fmt.Errorf("unmarshal field policy_results: %w", err)
+				}
+			}
+		case resourcerun.FieldApproval:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field approval", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.Approval); err != nil {
+					return fmt.Errorf("unmarshal field approval: %w", err)
+				}
+			}
 		default:
 			rr.selectValues.Set(columns[i], values[i])
 		}
@@ -373,6 +414,11 @@ func (rr *ResourceRun) QueryResource() *ResourceQuery {
 	return NewResourceRunClient(rr.config).QueryResource(rr)
 }
 
+// QueryStateVersions queries the "state_versions" edge of the ResourceRun entity.
+func (rr *ResourceRun) QueryStateVersions() *ResourceStateVersionQuery {
+	return NewResourceRunClient(rr.config).QueryStateVersions(rr)
+}
+
 // Update returns a builder for updating this ResourceRun.
 // Note that you need to call ResourceRun.Unwrap() before calling this method if this ResourceRun
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -471,6 +517,15 @@ func (rr *ResourceRun) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("component_change_summary=")
 	builder.WriteString(fmt.Sprintf("%v", rr.ComponentChangeSummary))
+	builder.WriteString(", ")
+	builder.WriteString("cost_estimate=")
+	builder.WriteString(fmt.Sprintf("%v", rr.CostEstimate))
+	builder.WriteString(", ")
+	builder.WriteString("policy_results=")
+	builder.WriteString(fmt.Sprintf("%v", rr.PolicyResults))
+	builder.WriteString(", ")
+	builder.WriteString("approval=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Approval))
 	builder.WriteByte(')')
 	return builder.String()
 }
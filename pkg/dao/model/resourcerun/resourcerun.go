@@ -72,12 +72,20 @@ const (
 	FieldComponentChanges = "component_changes"
 	// FieldComponentChangeSummary holds the string denoting the component_change_summary field in the database.
 	FieldComponentChangeSummary = "component_change_summary"
+	// FieldCostEstimate holds the string denoting the cost_estimate field in the database.
+	FieldCostEstimate = "cost_estimate"
+	// FieldPolicyResults holds the string denoting the policy_results field in the database.
+	FieldPolicyResults = "policy_results"
+	// FieldApproval holds the string denoting the approval field in the database.
+	FieldApproval = "approval"
 	// EdgeProject holds the string denoting the project edge name in mutations.
 	EdgeProject = "project"
 	// EdgeEnvironment holds the string denoting the environment edge name in mutations.
 	EdgeEnvironment = "environment"
 	// EdgeResource holds the string denoting the resource edge name in mutations.
 	EdgeResource = "resource"
+	// EdgeStateVersions holds the string denoting the state_versions edge name in mutations.
+	EdgeStateVersions = "state_versions"
 	// Table holds the table name of the resourcerun in the database.
 	Table = "resource_runs"
 	// ProjectTable is the table that holds the project relation/edge.
@@ -101,6 +109,13 @@ const (
 	ResourceInverseTable = "resources"
 	// ResourceColumn is the table column denoting the resource relation/edge.
 	ResourceColumn = "resource_id"
+	// StateVersionsTable is the table that holds the state_versions relation/edge.
+	StateVersionsTable = "resource_state_versions"
+	// StateVersionsInverseTable is the table name for the ResourceStateVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "resourcestateversion" package.
+	StateVersionsInverseTable = "resource_state_versions"
+	// StateVersionsColumn is the table column denoting the state_versions relation/edge.
+	StateVersionsColumn = "run_id"
 )
 
 // Columns holds all SQL columns for resourcerun fields.
@@ -131,6 +146,9 @@ var Columns = []string{
 	FieldLabels,
 	FieldComponentChanges,
 	FieldComponentChangeSummary,
+	FieldCostEstimate,
+	FieldPolicyResults,
+	FieldApproval,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -299,6 +317,20 @@ func ByResourceField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newResourceStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByStateVersionsCount orders the results by state_versions count.
+func ByStateVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStateVersionsStep(), opts...)
+	}
+}
+
+// ByStateVersions orders the results by state_versions terms.
+func ByStateVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStateVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProjectStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -320,6 +352,13 @@ func newResourceStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ResourceTable, ResourceColumn),
 	)
 }
+func newStateVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StateVersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StateVersionsTable, StateVersionsColumn),
+	)
+}
 
 // WithoutFields returns the fields ignored the given list.
 func WithoutFields(ignores ...string) []string {
@@ -1263,6 +1263,36 @@ func ComponentChangeSummaryNotNil() predicate.ResourceRun {
 	return predicate.ResourceRun(sql.FieldNotNull(FieldComponentChangeSummary))
 }
 
+// CostEstimateIsNil applies the IsNil predicate on the "cost_estimate" field.
+func CostEstimateIsNil() predicate.ResourceRun {
+	return predicate.ResourceRun(sql.FieldIsNull(FieldCostEstimate))
+}
+
+// CostEstimateNotNil applies the NotNil predicate on the "cost_estimate" field.
+func CostEstimateNotNil() predicate.ResourceRun {
+	return predicate.ResourceRun(sql.FieldNotNull(FieldCostEstimate))
+}
+
+// PolicyResultsIsNil applies the IsNil predicate on the "policy_results" field.
+func PolicyResultsIsNil() predicate.ResourceRun {
+	return predicate.ResourceRun(sql.FieldIsNull(FieldPolicyResults))
+}
+
+// PolicyResultsNotNil applies the NotNil predicate on the "policy_results" field.
+func PolicyResultsNotNil() predicate.ResourceRun {
+	return predicate.ResourceRun(sql.FieldNotNull(FieldPolicyResults))
+}
+
+// ApprovalIsNil applies the IsNil predicate on the "approval" field.
+func ApprovalIsNil() predicate.ResourceRun {
+	return predicate.ResourceRun(sql.FieldIsNull(FieldApproval))
+}
+
+// ApprovalNotNil applies the NotNil predicate on the "approval" field.
+func ApprovalNotNil() predicate.ResourceRun {
+	return predicate.ResourceRun(sql.FieldNotNull(FieldApproval))
+}
+
 // HasProject applies the HasEdge predicate on the "project" edge.
 func HasProject() predicate.ResourceRun {
 	return predicate.ResourceRun(func(s *sql.Selector) {
@@ -1350,6 +1380,35 @@ func HasResourceWith(preds ...predicate.Resource) predicate.ResourceRun {
 	})
 }
 
+// HasStateVersions applies the HasEdge predicate on the "state_versions" edge.
+func HasStateVersions() predicate.ResourceRun {
+	return predicate.ResourceRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StateVersionsTable, StateVersionsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStateVersionsWith applies the HasEdge predicate on the "state_versions" edge with a given conditions (other predicates).
+func HasStateVersionsWith(preds ...predicate.ResourceStateVersion) predicate.ResourceRun {
+	return predicate.ResourceRun(func(s *sql.Selector) {
+		step := newStateVersionsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ResourceRun) predicate.ResourceRun {
 	return predicate.ResourceRun(sql.AndPredicates(predicates...))
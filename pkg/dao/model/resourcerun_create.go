@@ -21,6 +21,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/project"
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
@@ -260,6 +261,24 @@ func (rrc *ResourceRunCreate) SetNillableComponentChangeSummary(tccs *types.Reso
 	return rrc
 }
 
+// SetCostEstimate sets the "cost_estimate" field.
+func (rrc *ResourceRunCreate) SetCostEstimate(trce *types.ResourceRunCostEstimate) *ResourceRunCreate {
+	rrc.mutation.SetCostEstimate(trce)
+	return rrc
+}
+
+// SetPolicyResults sets the "policy_results" field.
+func (rrc *ResourceRunCreate) SetPolicyResults(trpr types.ResourceRunPolicyResults) *ResourceRunCreate {
+	rrc.mutation.SetPolicyResults(trpr)
+	return rrc
+}
+
+// SetApproval sets the "approval" field.
+func (rrc *ResourceRunCreate) SetApproval(tra *types.ResourceRunApproval) *ResourceRunCreate {
+	rrc.mutation.SetApproval(tra)
+	return rrc
+}
+
 // SetID sets the "id" field.
 func (rrc *ResourceRunCreate) SetID(o object.ID) *ResourceRunCreate {
 	rrc.mutation.SetID(o)
@@ -281,6 +300,21 @@ func (rrc *ResourceRunCreate) SetResource(r *Resource) *ResourceRunCreate {
 	return rrc.SetResourceID(r.ID)
 }
 
+// AddStateVersionIDs adds the "state_versions" edge to the ResourceStateVersion entity by IDs.
+func (rrc *ResourceRunCreate) AddStateVersionIDs(ids ...object.ID) *ResourceRunCreate {
+	rrc.mutation.AddStateVersionIDs(ids...)
+	return rrc
+}
+
+// AddStateVersions adds the "state_versions" edges to the ResourceStateVersion entity.
+func (rrc *ResourceRunCreate) AddStateVersions(r ...*ResourceStateVersion) *ResourceRunCreate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rrc.AddStateVersionIDs(ids...)
+}
+
 // Mutation returns the ResourceRunMutation object of the builder.
 func (rrc *ResourceRunCreate) Mutation() *ResourceRunMutation {
 	return rrc.mutation
@@ -567,6 +601,18 @@ func (rrc *ResourceRunCreate) createSpec() (*ResourceRun, *sqlgraph.CreateSpec)
 		_spec.SetField(resourcerun.FieldComponentChangeSummary, field.TypeJSON, value)
 		_node.ComponentChangeSummary = value
 	}
+	if value, ok := rrc.mutation.CostEstimate(); ok {
+		_spec.SetField(resourcerun.FieldCostEstimate, field.TypeJSON, value)
+		_node.CostEstimate = value
+	}
+	if value, ok := rrc.mutation.PolicyResults(); ok {
+		_spec.SetField(resourcerun.FieldPolicyResults, field.TypeJSON, value)
+		_node.PolicyResults = value
+	}
+	if value, ok := rrc.mutation.Approval(); ok {
+		_spec.SetField(resourcerun.FieldApproval, field.TypeJSON, value)
+		_node.Approval = value
+	}
 	if nodes := rrc.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -621,6 +667,23 @@ func (rrc *ResourceRunCreate) createSpec() (*ResourceRun, *sqlgraph.CreateSpec)
 		_node.ResourceID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := rrc.mutation.StateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resourcerun.StateVersionsTable,
+			Columns: []string{resourcerun.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rrc.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -693,6 +756,15 @@ func (rrc *ResourceRunCreate) Set(obj *ResourceRun) *ResourceRunCreate {
 	if !reflect.ValueOf(obj.ComponentChangeSummary).IsZero() {
 		rrc.SetComponentChangeSummary(obj.ComponentChangeSummary)
 	}
+	if !reflect.ValueOf(obj.CostEstimate).IsZero() {
+		rrc.SetCostEstimate(obj.CostEstimate)
+	}
+	if !reflect.ValueOf(obj.PolicyResults).IsZero() {
+		rrc.SetPolicyResults(obj.PolicyResults)
+	}
+	if !reflect.ValueOf(obj.Approval).IsZero() {
+		rrc.SetApproval(obj.Approval)
+	}
 
 	// Record the given object.
 	rrc.object = obj
@@ -780,6 +852,15 @@ func (rrc *ResourceRunCreate) SaveE(ctx context.Context, cbs ...func(ctx context
 		if _, set := rrc.mutation.Field(resourcerun.FieldComponentChangeSummary); set {
 			obj.ComponentChangeSummary = x.ComponentChangeSummary
 		}
+		if _, set := rrc.mutation.Field(resourcerun.FieldCostEstimate); set {
+			obj.CostEstimate = x.CostEstimate
+		}
+		if _, set := rrc.mutation.Field(resourcerun.FieldPolicyResults); set {
+			obj.PolicyResults = x.PolicyResults
+		}
+		if _, set := rrc.mutation.Field(resourcerun.FieldApproval); set {
+			obj.Approval = x.Approval
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -930,6 +1011,15 @@ func (rrcb *ResourceRunCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx co
 			if _, set := rrcb.builders[i].mutation.Field(resourcerun.FieldComponentChangeSummary); set {
 				objs[i].ComponentChangeSummary = x[i].ComponentChangeSummary
 			}
+			if _, set := rrcb.builders[i].mutation.Field(resourcerun.FieldCostEstimate); set {
+				objs[i].CostEstimate = x[i].CostEstimate
+			}
+			if _, set := rrcb.builders[i].mutation.Field(resourcerun.FieldPolicyResults); set {
+				objs[i].PolicyResults = x[i].PolicyResults
+			}
+			if _, set := rrcb.builders[i].mutation.Field(resourcerun.FieldApproval); set {
+				objs[i].Approval = x[i].Approval
+			}
 			objs[i].Edges = x[i].Edges
 		}
 	}
@@ -1350,6 +1440,60 @@ func (u *ResourceRunUpsert) ClearComponentChangeSummary() *ResourceRunUpsert {
 	return u
 }
 
+// SetCostEstimate sets the "cost_estimate" field.
+func (u *ResourceRunUpsert) SetCostEstimate(v *types.ResourceRunCostEstimate) *ResourceRunUpsert {
+	u.Set(resourcerun.FieldCostEstimate, v)
+	return u
+}
+
+// UpdateCostEstimate sets the "cost_estimate" field to the value that was provided on create.
+func (u *ResourceRunUpsert) UpdateCostEstimate() *ResourceRunUpsert {
+	u.SetExcluded(resourcerun.FieldCostEstimate)
+	return u
+}
+
+// ClearCostEstimate clears the value of the "cost_estimate" field.
+func (u *ResourceRunUpsert) ClearCostEstimate() *ResourceRunUpsert {
+	u.SetNull(resourcerun.FieldCostEstimate)
+	return u
+}
+
+// SetPolicyResults sets the "policy_results" field.
+func (u *ResourceRunUpsert) SetPolicyResults(v types.ResourceRunPolicyResults) *ResourceRunUpsert {
+	u.Set(resourcerun.FieldPolicyResults, v)
+	return u
+}
+
+// UpdatePolicyResults sets the "policy_results" field to the value that was provided on create.
+func (u *ResourceRunUpsert) UpdatePolicyResults() *ResourceRunUpsert {
+	u.SetExcluded(resourcerun.FieldPolicyResults)
+	return u
+}
+
+// ClearPolicyResults clears the value of the "policy_results" field.
+func (u *ResourceRunUpsert) ClearPolicyResults() *ResourceRunUpsert {
+	u.SetNull(resourcerun.FieldPolicyResults)
+	return u
+}
+
+// SetApproval sets the "approval" field.
+func (u *ResourceRunUpsert) SetApproval(v *types.ResourceRunApproval) *ResourceRunUpsert {
+	u.Set(resourcerun.FieldApproval, v)
+	return u
+}
+
+// UpdateApproval sets the "approval" field to the value that was provided on create.
+func (u *ResourceRunUpsert) UpdateApproval() *ResourceRunUpsert {
+	u.SetExcluded(resourcerun.FieldApproval)
+	return u
+}
+
+// ClearApproval clears the value of the "approval" field.
+func (u *ResourceRunUpsert) ClearApproval() *ResourceRunUpsert {
+	u.SetNull(resourcerun.FieldApproval)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1759,6 +1903,69 @@ func (u *ResourceRunUpsertOne) ClearComponentChangeSummary() *ResourceRunUpsertO
 	})
 }
 
+// SetCostEstimate sets the "cost_estimate" field.
+func (u *ResourceRunUpsertOne) SetCostEstimate(v *types.ResourceRunCostEstimate) *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.SetCostEstimate(v)
+	})
+}
+
+// UpdateCostEstimate sets the "cost_estimate" field to the value that was provided on create.
+func (u *ResourceRunUpsertOne) UpdateCostEstimate() *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.UpdateCostEstimate()
+	})
+}
+
+// ClearCostEstimate clears the value of the "cost_estimate" field.
+func (u *ResourceRunUpsertOne) ClearCostEstimate() *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.ClearCostEstimate()
+	})
+}
+
+// SetPolicyResults sets the "policy_results" field.
+func (u *ResourceRunUpsertOne) SetPolicyResults(v types.ResourceRunPolicyResults) *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.SetPolicyResults(v)
+	})
+}
+
+// UpdatePolicyResults sets the "policy_results" field to the value that was provided on create.
+func (u *ResourceRunUpsertOne) UpdatePolicyResults() *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.UpdatePolicyResults()
+	})
+}
+
+// ClearPolicyResults clears the value of the "policy_results" field.
+func (u *ResourceRunUpsertOne) ClearPolicyResults() *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.ClearPolicyResults()
+	})
+}
+
+// SetApproval sets the "approval" field.
+func (u *ResourceRunUpsertOne) SetApproval(v *types.ResourceRunApproval) *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.SetApproval(v)
+	})
+}
+
+// UpdateApproval sets the "approval" field to the value that was provided on create.
+func (u *ResourceRunUpsertOne) UpdateApproval() *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.UpdateApproval()
+	})
+}
+
+// ClearApproval clears the value of the "approval" field.
+func (u *ResourceRunUpsertOne) ClearApproval() *ResourceRunUpsertOne {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.ClearApproval()
+	})
+}
+
 // Exec executes the query.
 func (u *ResourceRunUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2337,6 +2544,69 @@ func (u *ResourceRunUpsertBulk) ClearComponentChangeSummary() *ResourceRunUpsert
 	})
 }
 
+// SetCostEstimate sets the "cost_estimate" field.
+func (u *ResourceRunUpsertBulk) SetCostEstimate(v *types.ResourceRunCostEstimate) *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.SetCostEstimate(v)
+	})
+}
+
+// UpdateCostEstimate sets the "cost_estimate" field to the value that was provided on create.
+func (u *ResourceRunUpsertBulk) UpdateCostEstimate() *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.UpdateCostEstimate()
+	})
+}
+
+// ClearCostEstimate clears the value of the "cost_estimate" field.
+func (u *ResourceRunUpsertBulk) ClearCostEstimate() *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.ClearCostEstimate()
+	})
+}
+
+// SetPolicyResults sets the "policy_results" field.
+func (u *ResourceRunUpsertBulk) SetPolicyResults(v types.ResourceRunPolicyResults) *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.SetPolicyResults(v)
+	})
+}
+
+// UpdatePolicyResults sets the "policy_results" field to the value that was provided on create.
+func (u *ResourceRunUpsertBulk) UpdatePolicyResults() *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.UpdatePolicyResults()
+	})
+}
+
+// ClearPolicyResults clears the value of the "policy_results" field.
+func (u *ResourceRunUpsertBulk) ClearPolicyResults() *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.ClearPolicyResults()
+	})
+}
+
+// SetApproval sets the "approval" field.
+func (u *ResourceRunUpsertBulk) SetApproval(v *types.ResourceRunApproval) *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.SetApproval(v)
+	})
+}
+
+// UpdateApproval sets the "approval" field to the value that was provided on create.
+func (u *ResourceRunUpsertBulk) UpdateApproval() *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.UpdateApproval()
+	})
+}
+
+// ClearApproval clears the value of the "approval" field.
+func (u *ResourceRunUpsertBulk) ClearApproval() *ResourceRunUpsertBulk {
+	return u.Update(func(s *ResourceRunUpsert) {
+		s.ClearApproval()
+	})
+}
+
 // Exec executes the query.
 func (u *ResourceRunUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
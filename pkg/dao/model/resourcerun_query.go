@@ -7,6 +7,7 @@ package model
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -21,20 +22,22 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/project"
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
 
 // ResourceRunQuery is the builder for querying ResourceRun entities.
 type ResourceRunQuery struct {
 	config
-	ctx             *QueryContext
-	order           []resourcerun.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.ResourceRun
-	withProject     *ProjectQuery
-	withEnvironment *EnvironmentQuery
-	withResource    *ResourceQuery
-	modifiers       []func(*sql.Selector)
+	ctx               *QueryContext
+	order             []resourcerun.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.ResourceRun
+	withProject       *ProjectQuery
+	withEnvironment   *EnvironmentQuery
+	withResource      *ResourceQuery
+	withStateVersions *ResourceStateVersionQuery
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -146,6 +149,31 @@ func (rrq *ResourceRunQuery) QueryResource() *ResourceQuery {
 	return query
 }
 
+// QueryStateVersions chains the current query on the "state_versions" edge.
+func (rrq *ResourceRunQuery) QueryStateVersions() *ResourceStateVersionQuery {
+	query := (&ResourceStateVersionClient{config: rrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcerun.Table, resourcerun.FieldID, selector),
+			sqlgraph.To(resourcestateversion.Table, resourcestateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, resourcerun.StateVersionsTable, resourcerun.StateVersionsColumn),
+		)
+		schemaConfig := rrq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceStateVersion
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromU = sqlgraph.SetNeighbors(rrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ResourceRun entity from the query.
 // Returns a *NotFoundError when no ResourceRun was found.
 func (rrq *ResourceRunQuery) First(ctx context.Context) (*ResourceRun, error) {
@@ -333,14 +361,15 @@ func (rrq *ResourceRunQuery) Clone() *ResourceRunQuery {
 		return nil
 	}
 	return &ResourceRunQuery{
-		config:          rrq.config,
-		ctx:             rrq.ctx.Clone(),
-		order:           append([]resourcerun.OrderOption{}, rrq.order...),
-		inters:          append([]Interceptor{}, rrq.inters...),
-		predicates:      append([]predicate.ResourceRun{}, rrq.predicates...),
-		withProject:     rrq.withProject.Clone(),
-		withEnvironment: rrq.withEnvironment.Clone(),
-		withResource:    rrq.withResource.Clone(),
+		config:            rrq.config,
+		ctx:               rrq.ctx.Clone(),
+		order:             append([]resourcerun.OrderOption{}, rrq.order...),
+		inters:            append([]Interceptor{}, rrq.inters...),
+		predicates:        append([]predicate.ResourceRun{}, rrq.predicates...),
+		withProject:       rrq.withProject.Clone(),
+		withEnvironment:   rrq.withEnvironment.Clone(),
+		withResource:      rrq.withResource.Clone(),
+		withStateVersions: rrq.withStateVersions.Clone(),
 		// clone intermediate query.
 		sql:  rrq.sql.Clone(),
 		path: rrq.path,
@@ -380,6 +409,17 @@ func (rrq *ResourceRunQuery) WithResource(opts ...func(*ResourceQuery)) *Resourc
 	return rrq
 }
 
+// WithStateVersions tells the query-builder to eager-load the nodes that are connected to
+// the "state_versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrq *ResourceRunQuery) WithStateVersions(opts ...func(*ResourceStateVersionQuery)) *ResourceRunQuery {
+	query := (&ResourceStateVersionClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrq.withStateVersions = query
+	return rrq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -458,10 +498,11 @@ func (rrq *ResourceRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*ResourceRun{}
 		_spec       = rrq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			rrq.withProject != nil,
 			rrq.withEnvironment != nil,
 			rrq.withResource != nil,
+			rrq.withStateVersions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -505,6 +546,15 @@ func (rrq *ResourceRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := rrq.withStateVersions; query != nil {
+		if err := rrq.loadStateVersions(ctx, query, nodes,
+			func(n *ResourceRun) { n.Edges.StateVersions = []*ResourceStateVersion{} },
+			func(n *ResourceRun, e *ResourceStateVersion) {
+				n.Edges.StateVersions = append(n.Edges.StateVersions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -595,6 +645,36 @@ func (rrq *ResourceRunQuery) loadResource(ctx context.Context, query *ResourceQu
 	}
 	return nil
 }
+func (rrq *ResourceRunQuery) loadStateVersions(ctx context.Context, query *ResourceStateVersionQuery, nodes []*ResourceRun, init func(*ResourceRun), assign func(*ResourceRun, *ResourceStateVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*ResourceRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(resourcestateversion.FieldRunID)
+	}
+	query.Where(predicate.ResourceStateVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(resourcerun.StateVersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RunID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "run_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (rrq *ResourceRunQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := rrq.querySpec()
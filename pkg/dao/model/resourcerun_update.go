@@ -21,8 +21,10 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/internal"
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/property"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 )
@@ -323,11 +325,89 @@ func (rru *ResourceRunUpdate) ClearComponentChangeSummary() *ResourceRunUpdate {
 	return rru
 }
 
+// SetCostEstimate sets the "cost_estimate" field.
+func (rru *ResourceRunUpdate) SetCostEstimate(trce *types.ResourceRunCostEstimate) *ResourceRunUpdate {
+	rru.mutation.SetCostEstimate(trce)
+	return rru
+}
+
+// ClearCostEstimate clears the value of the "cost_estimate" field.
+func (rru *ResourceRunUpdate) ClearCostEstimate() *ResourceRunUpdate {
+	rru.mutation.ClearCostEstimate()
+	return rru
+}
+
+// SetPolicyResults sets the "policy_results" field.
+func (rru *ResourceRunUpdate) SetPolicyResults(trpr types.ResourceRunPolicyResults) *ResourceRunUpdate {
+	rru.mutation.SetPolicyResults(trpr)
+	return rru
+}
+
+// AppendPolicyResults appends trpr to the "policy_results" field.
+func (rru *ResourceRunUpdate) AppendPolicyResults(trpr types.ResourceRunPolicyResults) *ResourceRunUpdate {
+	rru.mutation.AppendPolicyResults(trpr)
+	return rru
+}
+
+// ClearPolicyResults clears the value of the "policy_results" field.
+func (rru *ResourceRunUpdate) ClearPolicyResults() *ResourceRunUpdate {
+	rru.mutation.ClearPolicyResults()
+	return rru
+}
+
+// SetApproval sets the "approval" field.
+func (rru *ResourceRunUpdate) SetApproval(tra *types.ResourceRunApproval) *ResourceRunUpdate {
+	rru.mutation.SetApproval(tra)
+	return rru
+}
+
+// ClearApproval clears the value of the "approval" field.
+func (rru *ResourceRunUpdate) ClearApproval() *ResourceRunUpdate {
+	rru.mutation.ClearApproval()
+	return rru
+}
+
+// AddStateVersionIDs adds the "state_versions" edge to the ResourceStateVersion entity by IDs.
+func (rru *ResourceRunUpdate) AddStateVersionIDs(ids ...object.ID) *ResourceRunUpdate {
+	rru.mutation.AddStateVersionIDs(ids...)
+	return rru
+}
+
+// AddStateVersions adds the "state_versions" edges to the ResourceStateVersion entity.
+func (rru *ResourceRunUpdate) AddStateVersions(r ...*ResourceStateVersion) *ResourceRunUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rru.AddStateVersionIDs(ids...)
+}
+
 // Mutation returns the ResourceRunMutation object of the builder.
 func (rru *ResourceRunUpdate) Mutation() *ResourceRunMutation {
 	return rru.mutation
 }
 
+// ClearStateVersions clears all "state_versions" edges to the ResourceStateVersion entity.
+func (rru *ResourceRunUpdate) ClearStateVersions() *ResourceRunUpdate {
+	rru.mutation.ClearStateVersions()
+	return rru
+}
+
+// RemoveStateVersionIDs removes the "state_versions" edge to ResourceStateVersion entities by IDs.
+func (rru *ResourceRunUpdate) RemoveStateVersionIDs(ids ...object.ID) *ResourceRunUpdate {
+	rru.mutation.RemoveStateVersionIDs(ids...)
+	return rru
+}
+
+// RemoveStateVersions removes "state_versions" edges to ResourceStateVersion entities.
+func (rru *ResourceRunUpdate) RemoveStateVersions(r ...*ResourceStateVersion) *ResourceRunUpdate {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rru.RemoveStateVersionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (rru *ResourceRunUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
@@ -460,6 +540,15 @@ func (rru *ResourceRunUpdate) Set(obj *ResourceRun) *ResourceRunUpdate {
 	} else {
 		rru.ClearComponentChangeSummary()
 	}
+	if !reflect.ValueOf(obj.CostEstimate).IsZero() {
+		rru.SetCostEstimate(obj.CostEstimate)
+	}
+	if !reflect.ValueOf(obj.PolicyResults).IsZero() {
+		rru.SetPolicyResults(obj.PolicyResults)
+	}
+	if !reflect.ValueOf(obj.Approval).IsZero() {
+		rru.SetApproval(obj.Approval)
+	}
 
 	// With Default.
 
@@ -587,6 +676,77 @@ func (rru *ResourceRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if rru.mutation.ComponentChangeSummaryCleared() {
 		_spec.ClearField(resourcerun.FieldComponentChangeSummary, field.TypeJSON)
 	}
+	if value, ok := rru.mutation.CostEstimate(); ok {
+		_spec.SetField(resourcerun.FieldCostEstimate, field.TypeJSON, value)
+	}
+	if rru.mutation.CostEstimateCleared() {
+		_spec.ClearField(resourcerun.FieldCostEstimate, field.TypeJSON)
+	}
+	if value, ok := rru.mutation.PolicyResults(); ok {
+		_spec.SetField(resourcerun.FieldPolicyResults, field.TypeJSON, value)
+	}
+	if value, ok := rru.mutation.AppendedPolicyResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resourcerun.FieldPolicyResults, value)
+		})
+	}
+	if rru.mutation.PolicyResultsCleared() {
+		_spec.ClearField(resourcerun.FieldPolicyResults, field.TypeJSON)
+	}
+	if value, ok := rru.mutation.Approval(); ok {
+		_spec.SetField(resourcerun.FieldApproval, field.TypeJSON, value)
+	}
+	if rru.mutation.ApprovalCleared() {
+		_spec.ClearField(resourcerun.FieldApproval, field.TypeJSON)
+	}
+	if rru.mutation.StateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resourcerun.StateVersionsTable,
+			Columns: []string{resourcerun.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rru.schemaConfig.ResourceStateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.RemovedStateVersionsIDs(); len(nodes) > 0 && !rru.mutation.StateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resourcerun.StateVersionsTable,
+			Columns: []string{resourcerun.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rru.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.StateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resourcerun.StateVersionsTable,
+			Columns: []string{resourcerun.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rru.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = rru.schemaConfig.ResourceRun
 	ctx = internal.NewSchemaConfigContext(ctx, rru.schemaConfig)
 	_spec.AddModifiers(rru.modifiers...)
@@ -893,11 +1053,89 @@ func (rruo *ResourceRunUpdateOne) ClearComponentChangeSummary() *ResourceRunUpda
 	return rruo
 }
 
+// SetCostEstimate sets the "cost_estimate" field.
+func (rruo *ResourceRunUpdateOne) SetCostEstimate(trce *types.ResourceRunCostEstimate) *ResourceRunUpdateOne {
+	rruo.mutation.SetCostEstimate(trce)
+	return rruo
+}
+
+// ClearCostEstimate clears the value of the "cost_estimate" field.
+func (rruo *ResourceRunUpdateOne) ClearCostEstimate() *ResourceRunUpdateOne {
+	rruo.mutation.ClearCostEstimate()
+	return rruo
+}
+
+// SetPolicyResults sets the "policy_results" field.
+func (rruo *ResourceRunUpdateOne) SetPolicyResults(trpr types.ResourceRunPolicyResults) *ResourceRunUpdateOne {
+	rruo.mutation.SetPolicyResults(trpr)
+	return rruo
+}
+
+// AppendPolicyResults appends trpr to the "policy_results" field.
+func (rruo *ResourceRunUpdateOne) AppendPolicyResults(trpr types.ResourceRunPolicyResults) *ResourceRunUpdateOne {
+	rruo.mutation.AppendPolicyResults(trpr)
+	return rruo
+}
+
+// ClearPolicyResults clears the value of the "policy_results" field.
+func (rruo *ResourceRunUpdateOne) ClearPolicyResults() *ResourceRunUpdateOne {
+	rruo.mutation.ClearPolicyResults()
+	return rruo
+}
+
+// SetApproval sets the "approval" field.
+func (rruo *ResourceRunUpdateOne) SetApproval(tra *types.ResourceRunApproval) *ResourceRunUpdateOne {
+	rruo.mutation.SetApproval(tra)
+	return rruo
+}
+
+// ClearApproval clears the value of the "approval" field.
+func (rruo *ResourceRunUpdateOne) ClearApproval() *ResourceRunUpdateOne {
+	rruo.mutation.ClearApproval()
+	return rruo
+}
+
+// AddStateVersionIDs adds the "state_versions" edge to the ResourceStateVersion entity by IDs.
+func (rruo *ResourceRunUpdateOne) AddStateVersionIDs(ids ...object.ID) *ResourceRunUpdateOne {
+	rruo.mutation.AddStateVersionIDs(ids...)
+	return rruo
+}
+
+// AddStateVersions adds the "state_versions" edges to the ResourceStateVersion entity.
+func (rruo *ResourceRunUpdateOne) AddStateVersions(r ...*ResourceStateVersion) *ResourceRunUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rruo.AddStateVersionIDs(ids...)
+}
+
 // Mutation returns the ResourceRunMutation object of the builder.
 func (rruo *ResourceRunUpdateOne) Mutation() *ResourceRunMutation {
 	return rruo.mutation
 }
 
+// ClearStateVersions clears all "state_versions" edges to the ResourceStateVersion entity.
+func (rruo *ResourceRunUpdateOne) ClearStateVersions() *ResourceRunUpdateOne {
+	rruo.mutation.ClearStateVersions()
+	return rruo
+}
+
+// RemoveStateVersionIDs removes the "state_versions" edge to ResourceStateVersion entities by IDs.
+func (rruo *ResourceRunUpdateOne) RemoveStateVersionIDs(ids ...object.ID) *ResourceRunUpdateOne {
+	rruo.mutation.RemoveStateVersionIDs(ids...)
+	return rruo
+}
+
+// RemoveStateVersions removes "state_versions" edges to ResourceStateVersion entities.
+func (rruo *ResourceRunUpdateOne) RemoveStateVersions(r ...*ResourceStateVersion) *ResourceRunUpdateOne {
+	ids := make([]object.ID, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rruo.RemoveStateVersionIDs(ids...)
+}
+
 // Where appends a list predicates to the ResourceRunUpdate builder.
 func (rruo *ResourceRunUpdateOne) Where(ps ...predicate.ResourceRun) *ResourceRunUpdateOne {
 	rruo.mutation.Where(ps...)
@@ -1091,6 +1329,21 @@ func (rruo *ResourceRunUpdateOne) Set(obj *ResourceRun) *ResourceRunUpdateOne {
 			} else {
 				rruo.ClearComponentChangeSummary()
 			}
+			if !reflect.ValueOf(obj.CostEstimate).IsZero() {
+				if !reflect.DeepEqual(db.CostEstimate, obj.CostEstimate) {
+					rruo.SetCostEstimate(obj.CostEstimate)
+				}
+			}
+			if !reflect.ValueOf(obj.PolicyResults).IsZero() {
+				if !reflect.DeepEqual(db.PolicyResults, obj.PolicyResults) {
+					rruo.SetPolicyResults(obj.PolicyResults)
+				}
+			}
+			if !reflect.ValueOf(obj.Approval).IsZero() {
+				if !reflect.DeepEqual(db.Approval, obj.Approval) {
+					rruo.SetApproval(obj.Approval)
+				}
+			}
 
 			// With Default.
 
@@ -1195,6 +1448,15 @@ func (rruo *ResourceRunUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx con
 		if _, set := rruo.mutation.Field(resourcerun.FieldComponentChangeSummary); set {
 			obj.ComponentChangeSummary = x.ComponentChangeSummary
 		}
+		if _, set := rruo.mutation.Field(resourcerun.FieldCostEstimate); set {
+			obj.CostEstimate = x.CostEstimate
+		}
+		if _, set := rruo.mutation.Field(resourcerun.FieldPolicyResults); set {
+			obj.PolicyResults = x.PolicyResults
+		}
+		if _, set := rruo.mutation.Field(resourcerun.FieldApproval); set {
+			obj.Approval = x.Approval
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -1365,6 +1627,77 @@ func (rruo *ResourceRunUpdateOne) sqlSave(ctx context.Context) (_node *ResourceR
 	if rruo.mutation.ComponentChangeSummaryCleared() {
 		_spec.ClearField(resourcerun.FieldComponentChangeSummary, field.TypeJSON)
 	}
+	if value, ok := rruo.mutation.CostEstimate(); ok {
+		_spec.SetField(resourcerun.FieldCostEstimate, field.TypeJSON, value)
+	}
+	if rruo.mutation.CostEstimateCleared() {
+		_spec.ClearField(resourcerun.FieldCostEstimate, field.TypeJSON)
+	}
+	if value, ok := rruo.mutation.PolicyResults(); ok {
+		_spec.SetField(resourcerun.FieldPolicyResults, field.TypeJSON, value)
+	}
+	if value, ok := rruo.mutation.AppendedPolicyResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resourcerun.FieldPolicyResults, value)
+		})
+	}
+	if rruo.mutation.PolicyResultsCleared() {
+		_spec.ClearField(resourcerun.FieldPolicyResults, field.TypeJSON)
+	}
+	if value, ok := rruo.mutation.Approval(); ok {
+		_spec.SetField(resourcerun.FieldApproval, field.TypeJSON, value)
+	}
+	if rruo.mutation.ApprovalCleared() {
+		_spec.ClearField(resourcerun.FieldApproval, field.TypeJSON)
+	}
+	if rruo.mutation.StateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resourcerun.StateVersionsTable,
+			Columns: []string{resourcerun.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rruo.schemaConfig.ResourceStateVersion
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.RemovedStateVersionsIDs(); len(nodes) > 0 && !rruo.mutation.StateVersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resourcerun.StateVersionsTable,
+			Columns: []string{resourcerun.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rruo.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.StateVersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   resourcerun.StateVersionsTable,
+			Columns: []string{resourcerun.StateVersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rruo.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.Node.Schema = rruo.schemaConfig.ResourceRun
 	ctx = internal.NewSchemaConfigContext(ctx, rruo.schemaConfig)
 	_spec.AddModifiers(rruo.modifiers...)
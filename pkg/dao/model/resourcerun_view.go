@@ -35,7 +35,7 @@ type ResourceRunCreateInput struct {
 	Resource *ResourceQueryInput `path:",inline" query:"-" json:"-"`
 
 	// Input configs of the run.
-	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs"`
+	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs" sensitive:"true"`
 	// ID of the template.
 	TemplateID object.ID `path:"-" query:"-" json:"templateID"`
 	// Version of the template.
@@ -154,7 +154,7 @@ func (rrci *ResourceRunCreateInput) ValidateWith(ctx context.Context, cs ClientS
 // ResourceRunCreateInputs holds the creation input item of the ResourceRun entities.
 type ResourceRunCreateInputsItem struct {
 	// Input configs of the run.
-	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs"`
+	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs" sensitive:"true"`
 	// ID of the template.
 	TemplateID object.ID `path:"-" query:"-" json:"templateID"`
 	// Version of the template.
@@ -498,7 +498,7 @@ type ResourceRunPatchInput struct {
 	// Variables of the run.
 	Variables crypto.Map[string, string] `path:"-" query:"-" json:"variables,omitempty"`
 	// Input configs of the run.
-	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs,omitempty"`
+	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs,omitempty" sensitive:"true"`
 	// Type of deployer.
 	DeployerType string `path:"-" query:"-" json:"deployerType,omitempty"`
 	// Duration in seconds of the run deploying.
@@ -525,6 +525,12 @@ type ResourceRunPatchInput struct {
 	ComponentChanges []*types.ResourceComponentChange `path:"-" query:"-" json:"componentChanges,omitempty"`
 	// Change summary of the resource.
 	ComponentChangeSummary types.ResourceComponentChangeSummary `path:"-" query:"-" json:"componentChangeSummary,omitempty"`
+	// Estimated monthly cost of the planned changes of the resource.
+	CostEstimate *types.ResourceRunCostEstimate `path:"-" query:"-" json:"costEstimate,omitempty"`
+	// Evaluation results of the policies against the plan of the run.
+	PolicyResults types.ResourceRunPolicyResults `path:"-" query:"-" json:"policyResults,omitempty"`
+	// Approval requirements and records of the run.
+	Approval *types.ResourceRunApproval `path:"-" query:"-" json:"approval,omitempty"`
 
 	patchedEntity *ResourceRun `path:"-" query:"-" json:"-"`
 }
@@ -558,6 +564,9 @@ func (rrpi *ResourceRunPatchInput) PatchModel() *ResourceRun {
 		Labels:                    rrpi.Labels,
 		ComponentChanges:          rrpi.ComponentChanges,
 		ComponentChangeSummary:    rrpi.ComponentChangeSummary,
+		CostEstimate:              rrpi.CostEstimate,
+		PolicyResults:             rrpi.PolicyResults,
+		Approval:                  rrpi.Approval,
 	}
 
 	if rrpi.Project != nil {
@@ -656,6 +665,9 @@ func (rrpi *ResourceRunPatchInput) ValidateWith(ctx context.Context, cs ClientSe
 			resourcerun.FieldCreatedBy,
 			resourcerun.FieldType,
 			resourcerun.FieldAnnotations,
+			resourcerun.FieldCostEstimate,
+			resourcerun.FieldPolicyResults,
+			resourcerun.FieldApproval,
 		)...,
 	)
 
@@ -898,7 +910,7 @@ type ResourceRunUpdateInput struct {
 	// Variables of the run.
 	Variables crypto.Map[string, string] `path:"-" query:"-" json:"variables,omitempty"`
 	// Input configs of the run.
-	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs,omitempty"`
+	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs,omitempty" sensitive:"true"`
 	// Type of deployer.
 	DeployerType string `path:"-" query:"-" json:"deployerType,omitempty"`
 	// Duration in seconds of the run deploying.
@@ -986,7 +998,7 @@ type ResourceRunUpdateInputsItem struct {
 	// Variables of the run.
 	Variables crypto.Map[string, string] `path:"-" query:"-" json:"variables"`
 	// Input configs of the run.
-	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs"`
+	InputConfigs map[string][]uint8 `path:"-" query:"-" json:"inputConfigs" sensitive:"true"`
 	// Type of deployer.
 	DeployerType string `path:"-" query:"-" json:"deployerType"`
 	// Duration in seconds of the run deploying.
@@ -1203,6 +1215,9 @@ type ResourceRunOutput struct {
 	Labels                    map[string]string                    `json:"labels,omitempty"`
 	ComponentChanges          []*types.ResourceComponentChange     `json:"componentChanges,omitempty"`
 	ComponentChangeSummary    types.ResourceComponentChangeSummary `json:"componentChangeSummary,omitempty"`
+	CostEstimate              *types.ResourceRunCostEstimate       `json:"costEstimate,omitempty"`
+	PolicyResults             types.ResourceRunPolicyResults       `json:"policyResults,omitempty"`
+	Approval                  *types.ResourceRunApproval           `json:"approval,omitempty"`
 
 	Project     *ProjectOutput     `json:"project,omitempty"`
 	Environment *EnvironmentOutput `json:"environment,omitempty"`
@@ -1247,6 +1262,9 @@ func ExposeResourceRun(_rr *ResourceRun) *ResourceRunOutput {
 		Labels:                    _rr.Labels,
 		ComponentChanges:          _rr.ComponentChanges,
 		ComponentChangeSummary:    _rr.ComponentChangeSummary,
+		CostEstimate:              _rr.CostEstimate,
+		PolicyResults:             _rr.PolicyResults,
+		Approval:                  _rr.Approval,
 	}
 
 	if _rr.Edges.Project != nil {
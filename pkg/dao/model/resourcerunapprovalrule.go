@@ -0,0 +1,290 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ResourceRunApprovalRule is the model entity for the ResourceRunApprovalRule schema.
+type ResourceRunApprovalRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `json:"labels,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// ID of the project to belong, empty means for all projects.
+	ProjectID object.ID `json:"project_id,omitempty"`
+	// ID of the environment to which the rule applies, empty means for all environments.
+	EnvironmentID object.ID `json:"environment_id,omitempty"`
+	// Type of the environments to which the rule applies, empty means for all types.
+	EnvironmentType string `json:"environment_type,omitempty"`
+	// Types of the runs which require approvals.
+	RunTypes []string `json:"run_types,omitempty"`
+	// Number of the approvals required.
+	Approvals int `json:"approvals,omitempty,cli-table-column"`
+	// Roles which the approvers must hold one of, empty means any subject.
+	Roles []string `json:"roles,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResourceRunApprovalRuleQuery when eager-loading is set.
+	Edges        ResourceRunApprovalRuleEdges `json:"edges,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// ResourceRunApprovalRuleEdges holds the relations/edges for other nodes in the graph.
+type ResourceRunApprovalRuleEdges struct {
+	// Project to which the rule belongs.
+	Project *Project `json:"project,omitempty"`
+	// Environment to which the rule applies.
+	Environment *Environment `json:"environment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceRunApprovalRuleEdges) ProjectOrErr() (*Project, error) {
+	if e.loadedTypes[0] {
+		if e.Project == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: project.Label}
+		}
+		return e.Project, nil
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// EnvironmentOrErr returns the Environment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceRunApprovalRuleEdges) EnvironmentOrErr() (*Environment, error) {
+	if e.loadedTypes[1] {
+		if e.Environment == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: environment.Label}
+		}
+		return e.Environment, nil
+	}
+	return nil, &NotLoadedError{edge: "environment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResourceRunApprovalRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resourcerunapprovalrule.FieldLabels, resourcerunapprovalrule.FieldRunTypes, resourcerunapprovalrule.FieldRoles:
+			values[i] = new([]byte)
+		case resourcerunapprovalrule.FieldID, resourcerunapprovalrule.FieldProjectID, resourcerunapprovalrule.FieldEnvironmentID:
+			values[i] = new(object.ID)
+		case resourcerunapprovalrule.FieldApprovals:
+			values[i] = new(sql.NullInt64)
+		case resourcerunapprovalrule.FieldName, resourcerunapprovalrule.FieldDescription, resourcerunapprovalrule.FieldEnvironmentType:
+			values[i] = new(sql.NullString)
+		case resourcerunapprovalrule.FieldCreateTime, resourcerunapprovalrule.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResourceRunApprovalRule fields.
+func (rrar *ResourceRunApprovalRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resourcerunapprovalrule.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rrar.ID = *value
+			}
+		case resourcerunapprovalrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				rrar.Name = value.String
+			}
+		case resourcerunapprovalrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				rrar.Description = value.String
+			}
+		case resourcerunapprovalrule.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rrar.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case resourcerunapprovalrule.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				rrar.CreateTime = new(time.Time)
+				*rrar.CreateTime = value.Time
+			}
+		case resourcerunapprovalrule.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				rrar.UpdateTime = new(time.Time)
+				*rrar.UpdateTime = value.Time
+			}
+		case resourcerunapprovalrule.FieldProjectID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				rrar.ProjectID = *value
+			}
+		case resourcerunapprovalrule.FieldEnvironmentID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value != nil {
+				rrar.EnvironmentID = *value
+			}
+		case resourcerunapprovalrule.FieldEnvironmentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_type", values[i])
+			} else if value.Valid {
+				rrar.EnvironmentType = value.String
+			}
+		case resourcerunapprovalrule.FieldRunTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field run_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rrar.RunTypes); err != nil {
+					return fmt.Errorf("unmarshal field run_types: %w", err)
+				}
+			}
+		case resourcerunapprovalrule.FieldApprovals:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field approvals", values[i])
+			} else if value.Valid {
+				rrar.Approvals = int(value.Int64)
+			}
+		case resourcerunapprovalrule.FieldRoles:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field roles", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rrar.Roles); err != nil {
+					return fmt.Errorf("unmarshal field roles: %w", err)
+				}
+			}
+		default:
+			rrar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResourceRunApprovalRule.
+// This includes values selected through modifiers, order, etc.
+func (rrar *ResourceRunApprovalRule) Value(name string) (ent.Value, error) {
+	return rrar.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the ResourceRunApprovalRule entity.
+func (rrar *ResourceRunApprovalRule) QueryProject() *ProjectQuery {
+	return NewResourceRunApprovalRuleClient(rrar.config).QueryProject(rrar)
+}
+
+// QueryEnvironment queries the "environment" edge of the ResourceRunApprovalRule entity.
+func (rrar *ResourceRunApprovalRule) QueryEnvironment() *EnvironmentQuery {
+	return NewResourceRunApprovalRuleClient(rrar.config).QueryEnvironment(rrar)
+}
+
+// Update returns a builder for updating this ResourceRunApprovalRule.
+// Note that you need to call ResourceRunApprovalRule.Unwrap() before calling this method if this ResourceRunApprovalRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rrar *ResourceRunApprovalRule) Update() *ResourceRunApprovalRuleUpdateOne {
+	return NewResourceRunApprovalRuleClient(rrar.config).UpdateOne(rrar)
+}
+
+// Unwrap unwraps the ResourceRunApprovalRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rrar *ResourceRunApprovalRule) Unwrap() *ResourceRunApprovalRule {
+	_tx, ok := rrar.config.driver.(*txDriver)
+	if !ok {
+		panic("model: ResourceRunApprovalRule is not a transactional entity")
+	}
+	rrar.config.driver = _tx.drv
+	return rrar
+}
+
+// String implements the fmt.Stringer.
+func (rrar *ResourceRunApprovalRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResourceRunApprovalRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rrar.ID))
+	builder.WriteString("name=")
+	builder.WriteString(rrar.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(rrar.Description)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", rrar.Labels))
+	builder.WriteString(", ")
+	if v := rrar.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := rrar.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", rrar.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", rrar.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("environment_type=")
+	builder.WriteString(rrar.EnvironmentType)
+	builder.WriteString(", ")
+	builder.WriteString("run_types=")
+	builder.WriteString(fmt.Sprintf("%v", rrar.RunTypes))
+	builder.WriteString(", ")
+	builder.WriteString("approvals=")
+	builder.WriteString(fmt.Sprintf("%v", rrar.Approvals))
+	builder.WriteString(", ")
+	builder.WriteString("roles=")
+	builder.WriteString(fmt.Sprintf("%v", rrar.Roles))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResourceRunApprovalRules is a parsable slice of ResourceRunApprovalRule.
+type ResourceRunApprovalRules []*ResourceRunApprovalRule
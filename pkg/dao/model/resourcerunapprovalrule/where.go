@@ -0,0 +1,690 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package resourcerunapprovalrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldProjectID, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentType applies equality check predicate on the "environment_type" field. It's identical to EnvironmentTypeEQ.
+func EnvironmentType(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldEnvironmentType, v))
+}
+
+// Approvals applies equality check predicate on the "approvals" field. It's identical to ApprovalsEQ.
+func Approvals(v int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldApprovals, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotNull(FieldLabels))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDContains applies the Contains predicate on the "project_id" field.
+func ProjectIDContains(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldContains(FieldProjectID, vc))
+}
+
+// ProjectIDHasPrefix applies the HasPrefix predicate on the "project_id" field.
+func ProjectIDHasPrefix(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldHasPrefix(FieldProjectID, vc))
+}
+
+// ProjectIDHasSuffix applies the HasSuffix predicate on the "project_id" field.
+func ProjectIDHasSuffix(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldHasSuffix(FieldProjectID, vc))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotNull(FieldProjectID))
+}
+
+// ProjectIDEqualFold applies the EqualFold predicate on the "project_id" field.
+func ProjectIDEqualFold(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldEqualFold(FieldProjectID, vc))
+}
+
+// ProjectIDContainsFold applies the ContainsFold predicate on the "project_id" field.
+func ProjectIDContainsFold(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldContainsFold(FieldProjectID, vc))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v object.ID) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldContains(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldHasPrefix(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldHasSuffix(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldEqualFold(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v object.ID) predicate.ResourceRunApprovalRule {
+	vc := string(v)
+	return predicate.ResourceRunApprovalRule(sql.FieldContainsFold(FieldEnvironmentID, vc))
+}
+
+// EnvironmentTypeEQ applies the EQ predicate on the "environment_type" field.
+func EnvironmentTypeEQ(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeNEQ applies the NEQ predicate on the "environment_type" field.
+func EnvironmentTypeNEQ(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeIn applies the In predicate on the "environment_type" field.
+func EnvironmentTypeIn(vs ...string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldEnvironmentType, vs...))
+}
+
+// EnvironmentTypeNotIn applies the NotIn predicate on the "environment_type" field.
+func EnvironmentTypeNotIn(vs ...string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldEnvironmentType, vs...))
+}
+
+// EnvironmentTypeGT applies the GT predicate on the "environment_type" field.
+func EnvironmentTypeGT(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeGTE applies the GTE predicate on the "environment_type" field.
+func EnvironmentTypeGTE(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeLT applies the LT predicate on the "environment_type" field.
+func EnvironmentTypeLT(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeLTE applies the LTE predicate on the "environment_type" field.
+func EnvironmentTypeLTE(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeContains applies the Contains predicate on the "environment_type" field.
+func EnvironmentTypeContains(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldContains(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeHasPrefix applies the HasPrefix predicate on the "environment_type" field.
+func EnvironmentTypeHasPrefix(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldHasPrefix(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeHasSuffix applies the HasSuffix predicate on the "environment_type" field.
+func EnvironmentTypeHasSuffix(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldHasSuffix(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeIsNil applies the IsNil predicate on the "environment_type" field.
+func EnvironmentTypeIsNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIsNull(FieldEnvironmentType))
+}
+
+// EnvironmentTypeNotNil applies the NotNil predicate on the "environment_type" field.
+func EnvironmentTypeNotNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotNull(FieldEnvironmentType))
+}
+
+// EnvironmentTypeEqualFold applies the EqualFold predicate on the "environment_type" field.
+func EnvironmentTypeEqualFold(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEqualFold(FieldEnvironmentType, v))
+}
+
+// EnvironmentTypeContainsFold applies the ContainsFold predicate on the "environment_type" field.
+func EnvironmentTypeContainsFold(v string) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldContainsFold(FieldEnvironmentType, v))
+}
+
+// ApprovalsEQ applies the EQ predicate on the "approvals" field.
+func ApprovalsEQ(v int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldEQ(FieldApprovals, v))
+}
+
+// ApprovalsNEQ applies the NEQ predicate on the "approvals" field.
+func ApprovalsNEQ(v int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNEQ(FieldApprovals, v))
+}
+
+// ApprovalsIn applies the In predicate on the "approvals" field.
+func ApprovalsIn(vs ...int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIn(FieldApprovals, vs...))
+}
+
+// ApprovalsNotIn applies the NotIn predicate on the "approvals" field.
+func ApprovalsNotIn(vs ...int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotIn(FieldApprovals, vs...))
+}
+
+// ApprovalsGT applies the GT predicate on the "approvals" field.
+func ApprovalsGT(v int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGT(FieldApprovals, v))
+}
+
+// ApprovalsGTE applies the GTE predicate on the "approvals" field.
+func ApprovalsGTE(v int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldGTE(FieldApprovals, v))
+}
+
+// ApprovalsLT applies the LT predicate on the "approvals" field.
+func ApprovalsLT(v int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLT(FieldApprovals, v))
+}
+
+// ApprovalsLTE applies the LTE predicate on the "approvals" field.
+func ApprovalsLTE(v int) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldLTE(FieldApprovals, v))
+}
+
+// RolesIsNil applies the IsNil predicate on the "roles" field.
+func RolesIsNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldIsNull(FieldRoles))
+}
+
+// RolesNotNil applies the NotNil predicate on the "roles" field.
+func RolesNotNil() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.FieldNotNull(FieldRoles))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(func(s *sql.Selector) {
+		step := newProjectStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnvironment applies the HasEdge predicate on the "environment" edge.
+func HasEnvironment() predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EnvironmentTable, EnvironmentColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvironmentWith applies the HasEdge predicate on the "environment" edge with a given conditions (other predicates).
+func HasEnvironmentWith(preds ...predicate.Environment) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(func(s *sql.Selector) {
+		step := newEnvironmentStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResourceRunApprovalRule) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResourceRunApprovalRule) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResourceRunApprovalRule) predicate.ResourceRunApprovalRule {
+	return predicate.ResourceRunApprovalRule(sql.NotPredicates(p))
+}
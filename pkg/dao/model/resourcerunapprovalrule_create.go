@@ -0,0 +1,1460 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceRunApprovalRuleCreate is the builder for creating a ResourceRunApprovalRule entity.
+type ResourceRunApprovalRuleCreate struct {
+	config
+	mutation   *ResourceRunApprovalRuleMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *ResourceRunApprovalRule
+	fromUpsert bool
+}
+
+// SetName sets the "name" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetName(s string) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetName(s)
+	return rrarc
+}
+
+// SetDescription sets the "description" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetDescription(s string) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetDescription(s)
+	return rrarc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rrarc *ResourceRunApprovalRuleCreate) SetNillableDescription(s *string) *ResourceRunApprovalRuleCreate {
+	if s != nil {
+		rrarc.SetDescription(*s)
+	}
+	return rrarc
+}
+
+// SetLabels sets the "labels" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetLabels(m map[string]string) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetLabels(m)
+	return rrarc
+}
+
+// SetCreateTime sets the "create_time" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetCreateTime(t time.Time) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetCreateTime(t)
+	return rrarc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (rrarc *ResourceRunApprovalRuleCreate) SetNillableCreateTime(t *time.Time) *ResourceRunApprovalRuleCreate {
+	if t != nil {
+		rrarc.SetCreateTime(*t)
+	}
+	return rrarc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetUpdateTime(t time.Time) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetUpdateTime(t)
+	return rrarc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (rrarc *ResourceRunApprovalRuleCreate) SetNillableUpdateTime(t *time.Time) *ResourceRunApprovalRuleCreate {
+	if t != nil {
+		rrarc.SetUpdateTime(*t)
+	}
+	return rrarc
+}
+
+// SetProjectID sets the "project_id" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetProjectID(o object.ID) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetProjectID(o)
+	return rrarc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (rrarc *ResourceRunApprovalRuleCreate) SetNillableProjectID(o *object.ID) *ResourceRunApprovalRuleCreate {
+	if o != nil {
+		rrarc.SetProjectID(*o)
+	}
+	return rrarc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetEnvironmentID(o object.ID) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetEnvironmentID(o)
+	return rrarc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (rrarc *ResourceRunApprovalRuleCreate) SetNillableEnvironmentID(o *object.ID) *ResourceRunApprovalRuleCreate {
+	if o != nil {
+		rrarc.SetEnvironmentID(*o)
+	}
+	return rrarc
+}
+
+// SetEnvironmentType sets the "environment_type" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetEnvironmentType(s string) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetEnvironmentType(s)
+	return rrarc
+}
+
+// SetNillableEnvironmentType sets the "environment_type" field if the given value is not nil.
+func (rrarc *ResourceRunApprovalRuleCreate) SetNillableEnvironmentType(s *string) *ResourceRunApprovalRuleCreate {
+	if s != nil {
+		rrarc.SetEnvironmentType(*s)
+	}
+	return rrarc
+}
+
+// SetRunTypes sets the "run_types" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetRunTypes(s []string) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetRunTypes(s)
+	return rrarc
+}
+
+// SetApprovals sets the "approvals" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetApprovals(i int) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetApprovals(i)
+	return rrarc
+}
+
+// SetNillableApprovals sets the "approvals" field if the given value is not nil.
+func (rrarc *ResourceRunApprovalRuleCreate) SetNillableApprovals(i *int) *ResourceRunApprovalRuleCreate {
+	if i != nil {
+		rrarc.SetApprovals(*i)
+	}
+	return rrarc
+}
+
+// SetRoles sets the "roles" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetRoles(s []string) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetRoles(s)
+	return rrarc
+}
+
+// SetID sets the "id" field.
+func (rrarc *ResourceRunApprovalRuleCreate) SetID(o object.ID) *ResourceRunApprovalRuleCreate {
+	rrarc.mutation.SetID(o)
+	return rrarc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (rrarc *ResourceRunApprovalRuleCreate) SetProject(p *Project) *ResourceRunApprovalRuleCreate {
+	return rrarc.SetProjectID(p.ID)
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (rrarc *ResourceRunApprovalRuleCreate) SetEnvironment(e *Environment) *ResourceRunApprovalRuleCreate {
+	return rrarc.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the ResourceRunApprovalRuleMutation object of the builder.
+func (rrarc *ResourceRunApprovalRuleCreate) Mutation() *ResourceRunApprovalRuleMutation {
+	return rrarc.mutation
+}
+
+// Save creates the ResourceRunApprovalRule in the database.
+func (rrarc *ResourceRunApprovalRuleCreate) Save(ctx context.Context) (*ResourceRunApprovalRule, error) {
+	if err := rrarc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rrarc.sqlSave, rrarc.mutation, rrarc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrarc *ResourceRunApprovalRuleCreate) SaveX(ctx context.Context) *ResourceRunApprovalRule {
+	v, err := rrarc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrarc *ResourceRunApprovalRuleCreate) Exec(ctx context.Context) error {
+	_, err := rrarc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrarc *ResourceRunApprovalRuleCreate) ExecX(ctx context.Context) {
+	if err := rrarc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrarc *ResourceRunApprovalRuleCreate) defaults() error {
+	if _, ok := rrarc.mutation.Labels(); !ok {
+		v := resourcerunapprovalrule.DefaultLabels
+		rrarc.mutation.SetLabels(v)
+	}
+	if _, ok := rrarc.mutation.CreateTime(); !ok {
+		if resourcerunapprovalrule.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcerunapprovalrule.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := resourcerunapprovalrule.DefaultCreateTime()
+		rrarc.mutation.SetCreateTime(v)
+	}
+	if _, ok := rrarc.mutation.UpdateTime(); !ok {
+		if resourcerunapprovalrule.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcerunapprovalrule.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := resourcerunapprovalrule.DefaultUpdateTime()
+		rrarc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := rrarc.mutation.Approvals(); !ok {
+		v := resourcerunapprovalrule.DefaultApprovals
+		rrarc.mutation.SetApprovals(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrarc *ResourceRunApprovalRuleCreate) check() error {
+	if _, ok := rrarc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`model: missing required field "ResourceRunApprovalRule.name"`)}
+	}
+	if v, ok := rrarc.mutation.Name(); ok {
+		if err := resourcerunapprovalrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`model: validator failed for field "ResourceRunApprovalRule.name": %w`, err)}
+		}
+	}
+	if _, ok := rrarc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "ResourceRunApprovalRule.create_time"`)}
+	}
+	if _, ok := rrarc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "ResourceRunApprovalRule.update_time"`)}
+	}
+	if _, ok := rrarc.mutation.RunTypes(); !ok {
+		return &ValidationError{Name: "run_types", err: errors.New(`model: missing required field "ResourceRunApprovalRule.run_types"`)}
+	}
+	if _, ok := rrarc.mutation.Approvals(); !ok {
+		return &ValidationError{Name: "approvals", err: errors.New(`model: missing required field "ResourceRunApprovalRule.approvals"`)}
+	}
+	if v, ok := rrarc.mutation.Approvals(); ok {
+		if err := resourcerunapprovalrule.ApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "approvals", err: fmt.Errorf(`model: validator failed for field "ResourceRunApprovalRule.approvals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rrarc *ResourceRunApprovalRuleCreate) sqlSave(ctx context.Context) (*ResourceRunApprovalRule, error) {
+	if err := rrarc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrarc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrarc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rrarc.mutation.id = &_node.ID
+	rrarc.mutation.done = true
+	return _node, nil
+}
+
+func (rrarc *ResourceRunApprovalRuleCreate) createSpec() (*ResourceRunApprovalRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResourceRunApprovalRule{config: rrarc.config}
+		_spec = sqlgraph.NewCreateSpec(resourcerunapprovalrule.Table, sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString))
+	)
+	_spec.Schema = rrarc.schemaConfig.ResourceRunApprovalRule
+	_spec.OnConflict = rrarc.conflict
+	if id, ok := rrarc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rrarc.mutation.Name(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rrarc.mutation.Description(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := rrarc.mutation.Labels(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := rrarc.mutation.CreateTime(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := rrarc.mutation.UpdateTime(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := rrarc.mutation.EnvironmentType(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldEnvironmentType, field.TypeString, value)
+		_node.EnvironmentType = value
+	}
+	if value, ok := rrarc.mutation.RunTypes(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldRunTypes, field.TypeJSON, value)
+		_node.RunTypes = value
+	}
+	if value, ok := rrarc.mutation.Approvals(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldApprovals, field.TypeInt, value)
+		_node.Approvals = value
+	}
+	if value, ok := rrarc.mutation.Roles(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if nodes := rrarc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resourcerunapprovalrule.ProjectTable,
+			Columns: []string{resourcerunapprovalrule.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rrarc.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rrarc.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resourcerunapprovalrule.EnvironmentTable,
+			Columns: []string{resourcerunapprovalrule.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rrarc.schemaConfig.ResourceRunApprovalRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rrarc *ResourceRunApprovalRuleCreate) Set(obj *ResourceRunApprovalRule) *ResourceRunApprovalRuleCreate {
+	// Required.
+	rrarc.SetName(obj.Name)
+	rrarc.SetRunTypes(obj.RunTypes)
+	rrarc.SetApprovals(obj.Approvals)
+
+	// Optional.
+	if obj.Description != "" {
+		rrarc.SetDescription(obj.Description)
+	}
+	if !reflect.ValueOf(obj.Labels).IsZero() {
+		rrarc.SetLabels(obj.Labels)
+	}
+	if obj.CreateTime != nil {
+		rrarc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		rrarc.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.ProjectID != "" {
+		rrarc.SetProjectID(obj.ProjectID)
+	}
+	if obj.EnvironmentID != "" {
+		rrarc.SetEnvironmentID(obj.EnvironmentID)
+	}
+	if obj.EnvironmentType != "" {
+		rrarc.SetEnvironmentType(obj.EnvironmentType)
+	}
+	if !reflect.ValueOf(obj.Roles).IsZero() {
+		rrarc.SetRoles(obj.Roles)
+	}
+
+	// Record the given object.
+	rrarc.object = obj
+
+	return rrarc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rrarc *ResourceRunApprovalRuleCreate) getClientSet() (mc ClientSet) {
+	if _, ok := rrarc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rrarc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rrarc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ResourceRunApprovalRule entity,
+// which is always good for cascading create operations.
+func (rrarc *ResourceRunApprovalRuleCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunApprovalRule) error) (*ResourceRunApprovalRule, error) {
+	obj, err := rrarc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := rrarc.getClientSet()
+	if rrarc.fromUpsert {
+		q := mc.ResourceRunApprovalRules().Query().
+			Where(
+				resourcerunapprovalrule.Name(obj.Name),
+			)
+		if obj.ProjectID != "" {
+			q.Where(resourcerunapprovalrule.ProjectID(obj.ProjectID))
+		} else {
+			q.Where(resourcerunapprovalrule.ProjectIDIsNil())
+		}
+		if obj.EnvironmentID != "" {
+			q.Where(resourcerunapprovalrule.EnvironmentID(obj.EnvironmentID))
+		} else {
+			q.Where(resourcerunapprovalrule.EnvironmentIDIsNil())
+		}
+		obj.ID, err = q.OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("model: failed to query id of ResourceRunApprovalRule entity: %w", err)
+		}
+	}
+
+	if x := rrarc.object; x != nil {
+		if _, set := rrarc.mutation.Field(resourcerunapprovalrule.FieldName); set {
+			obj.Name = x.Name
+		}
+		if _, set := rrarc.mutation.Field(resourcerunapprovalrule.FieldDescription); set {
+			obj.Description = x.Description
+		}
+		if _, set := rrarc.mutation.Field(resourcerunapprovalrule.FieldProjectID); set {
+			obj.ProjectID = x.ProjectID
+		}
+		if _, set := rrarc.mutation.Field(resourcerunapprovalrule.FieldEnvironmentID); set {
+			obj.EnvironmentID = x.EnvironmentID
+		}
+		if _, set := rrarc.mutation.Field(resourcerunapprovalrule.FieldEnvironmentType); set {
+			obj.EnvironmentType = x.EnvironmentType
+		}
+		if _, set := rrarc.mutation.Field(resourcerunapprovalrule.FieldRunTypes); set {
+			obj.RunTypes = x.RunTypes
+		}
+		if _, set := rrarc.mutation.Field(resourcerunapprovalrule.FieldRoles); set {
+			obj.Roles = x.Roles
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rrarc *ResourceRunApprovalRuleCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunApprovalRule) error) *ResourceRunApprovalRule {
+	obj, err := rrarc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (rrarc *ResourceRunApprovalRuleCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunApprovalRule) error) error {
+	_, err := rrarc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (rrarc *ResourceRunApprovalRuleCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunApprovalRule) error) {
+	if err := rrarc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the ResourceRunApprovalRuleCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) Set(objs ...*ResourceRunApprovalRule) *ResourceRunApprovalRuleCreateBulk {
+	if len(objs) != 0 {
+		client := NewResourceRunApprovalRuleClient(rrarcb.config)
+
+		rrarcb.builders = make([]*ResourceRunApprovalRuleCreate, len(objs))
+		for i := range objs {
+			rrarcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		rrarcb.objects = objs
+	}
+
+	return rrarcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := rrarcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rrarcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rrarcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ResourceRunApprovalRule entities,
+// which is always good for cascading create operations.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunApprovalRule) error) ([]*ResourceRunApprovalRule, error) {
+	objs, err := rrarcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := rrarcb.getClientSet()
+	if rrarcb.fromUpsert {
+		for i := range objs {
+			obj := objs[i]
+			q := mc.ResourceRunApprovalRules().Query().
+				Where(
+					resourcerunapprovalrule.Name(obj.Name),
+				)
+			if obj.ProjectID != "" {
+				q.Where(resourcerunapprovalrule.ProjectID(obj.ProjectID))
+			} else {
+				q.Where(resourcerunapprovalrule.ProjectIDIsNil())
+			}
+			if obj.EnvironmentID != "" {
+				q.Where(resourcerunapprovalrule.EnvironmentID(obj.EnvironmentID))
+			} else {
+				q.Where(resourcerunapprovalrule.EnvironmentIDIsNil())
+			}
+			objs[i].ID, err = q.OnlyID(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("model: failed to query id of ResourceRunApprovalRule entity: %w", err)
+			}
+		}
+	}
+
+	if x := rrarcb.objects; x != nil {
+		for i := range x {
+			if _, set := rrarcb.builders[i].mutation.Field(resourcerunapprovalrule.FieldName); set {
+				objs[i].Name = x[i].Name
+			}
+			if _, set := rrarcb.builders[i].mutation.Field(resourcerunapprovalrule.FieldDescription); set {
+				objs[i].Description = x[i].Description
+			}
+			if _, set := rrarcb.builders[i].mutation.Field(resourcerunapprovalrule.FieldProjectID); set {
+				objs[i].ProjectID = x[i].ProjectID
+			}
+			if _, set := rrarcb.builders[i].mutation.Field(resourcerunapprovalrule.FieldEnvironmentID); set {
+				objs[i].EnvironmentID = x[i].EnvironmentID
+			}
+			if _, set := rrarcb.builders[i].mutation.Field(resourcerunapprovalrule.FieldEnvironmentType); set {
+				objs[i].EnvironmentType = x[i].EnvironmentType
+			}
+			if _, set := rrarcb.builders[i].mutation.Field(resourcerunapprovalrule.FieldRunTypes); set {
+				objs[i].RunTypes = x[i].RunTypes
+			}
+			if _, set := rrarcb.builders[i].mutation.Field(resourcerunapprovalrule.FieldRoles); set {
+				objs[i].Roles = x[i].Roles
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunApprovalRule) error) []*ResourceRunApprovalRule {
+	objs, err := rrarcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunApprovalRule) error) error {
+	_, err := rrarcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunApprovalRule) error) {
+	if err := rrarcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ResourceRunApprovalRuleUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunApprovalRule) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceRunApprovalRuleUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ResourceRunApprovalRuleUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunApprovalRule) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ResourceRunApprovalRuleUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunApprovalRule) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ResourceRunApprovalRuleUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceRunApprovalRuleUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ResourceRunApprovalRuleUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunApprovalRule) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceRunApprovalRule.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceRunApprovalRuleUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rrarc *ResourceRunApprovalRuleCreate) OnConflict(opts ...sql.ConflictOption) *ResourceRunApprovalRuleUpsertOne {
+	rrarc.conflict = opts
+	return &ResourceRunApprovalRuleUpsertOne{
+		create: rrarc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceRunApprovalRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrarc *ResourceRunApprovalRuleCreate) OnConflictColumns(columns ...string) *ResourceRunApprovalRuleUpsertOne {
+	rrarc.conflict = append(rrarc.conflict, sql.ConflictColumns(columns...))
+	return &ResourceRunApprovalRuleUpsertOne{
+		create: rrarc,
+	}
+}
+
+type (
+	// ResourceRunApprovalRuleUpsertOne is the builder for "upsert"-ing
+	//  one ResourceRunApprovalRule node.
+	ResourceRunApprovalRuleUpsertOne struct {
+		create *ResourceRunApprovalRuleCreate
+	}
+
+	// ResourceRunApprovalRuleUpsert is the "OnConflict" setter.
+	ResourceRunApprovalRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *ResourceRunApprovalRuleUpsert) SetDescription(v string) *ResourceRunApprovalRuleUpsert {
+	u.Set(resourcerunapprovalrule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsert) UpdateDescription() *ResourceRunApprovalRuleUpsert {
+	u.SetExcluded(resourcerunapprovalrule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ResourceRunApprovalRuleUpsert) ClearDescription() *ResourceRunApprovalRuleUpsert {
+	u.SetNull(resourcerunapprovalrule.FieldDescription)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *ResourceRunApprovalRuleUpsert) SetLabels(v map[string]string) *ResourceRunApprovalRuleUpsert {
+	u.Set(resourcerunapprovalrule.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsert) UpdateLabels() *ResourceRunApprovalRuleUpsert {
+	u.SetExcluded(resourcerunapprovalrule.FieldLabels)
+	return u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *ResourceRunApprovalRuleUpsert) ClearLabels() *ResourceRunApprovalRuleUpsert {
+	u.SetNull(resourcerunapprovalrule.FieldLabels)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ResourceRunApprovalRuleUpsert) SetUpdateTime(v time.Time) *ResourceRunApprovalRuleUpsert {
+	u.Set(resourcerunapprovalrule.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsert) UpdateUpdateTime() *ResourceRunApprovalRuleUpsert {
+	u.SetExcluded(resourcerunapprovalrule.FieldUpdateTime)
+	return u
+}
+
+// SetEnvironmentType sets the "environment_type" field.
+func (u *ResourceRunApprovalRuleUpsert) SetEnvironmentType(v string) *ResourceRunApprovalRuleUpsert {
+	u.Set(resourcerunapprovalrule.FieldEnvironmentType, v)
+	return u
+}
+
+// UpdateEnvironmentType sets the "environment_type" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsert) UpdateEnvironmentType() *ResourceRunApprovalRuleUpsert {
+	u.SetExcluded(resourcerunapprovalrule.FieldEnvironmentType)
+	return u
+}
+
+// ClearEnvironmentType clears the value of the "environment_type" field.
+func (u *ResourceRunApprovalRuleUpsert) ClearEnvironmentType() *ResourceRunApprovalRuleUpsert {
+	u.SetNull(resourcerunapprovalrule.FieldEnvironmentType)
+	return u
+}
+
+// SetRunTypes sets the "run_types" field.
+func (u *ResourceRunApprovalRuleUpsert) SetRunTypes(v []string) *ResourceRunApprovalRuleUpsert {
+	u.Set(resourcerunapprovalrule.FieldRunTypes, v)
+	return u
+}
+
+// UpdateRunTypes sets the "run_types" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsert) UpdateRunTypes() *ResourceRunApprovalRuleUpsert {
+	u.SetExcluded(resourcerunapprovalrule.FieldRunTypes)
+	return u
+}
+
+// SetApprovals sets the "approvals" field.
+func (u *ResourceRunApprovalRuleUpsert) SetApprovals(v int) *ResourceRunApprovalRuleUpsert {
+	u.Set(resourcerunapprovalrule.FieldApprovals, v)
+	return u
+}
+
+// UpdateApprovals sets the "approvals" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsert) UpdateApprovals() *ResourceRunApprovalRuleUpsert {
+	u.SetExcluded(resourcerunapprovalrule.FieldApprovals)
+	return u
+}
+
+// AddApprovals adds v to the "approvals" field.
+func (u *ResourceRunApprovalRuleUpsert) AddApprovals(v int) *ResourceRunApprovalRuleUpsert {
+	u.Add(resourcerunapprovalrule.FieldApprovals, v)
+	return u
+}
+
+// SetRoles sets the "roles" field.
+func (u *ResourceRunApprovalRuleUpsert) SetRoles(v []string) *ResourceRunApprovalRuleUpsert {
+	u.Set(resourcerunapprovalrule.FieldRoles, v)
+	return u
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsert) UpdateRoles() *ResourceRunApprovalRuleUpsert {
+	u.SetExcluded(resourcerunapprovalrule.FieldRoles)
+	return u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *ResourceRunApprovalRuleUpsert) ClearRoles() *ResourceRunApprovalRuleUpsert {
+	u.SetNull(resourcerunapprovalrule.FieldRoles)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ResourceRunApprovalRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resourcerunapprovalrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResourceRunApprovalRuleUpsertOne) UpdateNewValues() *ResourceRunApprovalRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(resourcerunapprovalrule.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(resourcerunapprovalrule.FieldName)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(resourcerunapprovalrule.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(resourcerunapprovalrule.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.EnvironmentID(); exists {
+			s.SetIgnore(resourcerunapprovalrule.FieldEnvironmentID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceRunApprovalRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResourceRunApprovalRuleUpsertOne) Ignore() *ResourceRunApprovalRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceRunApprovalRuleUpsertOne) DoNothing() *ResourceRunApprovalRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceRunApprovalRuleCreate.OnConflict
+// documentation for more info.
+func (u *ResourceRunApprovalRuleUpsertOne) Update(set func(*ResourceRunApprovalRuleUpsert)) *ResourceRunApprovalRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceRunApprovalRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ResourceRunApprovalRuleUpsertOne) SetDescription(v string) *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertOne) UpdateDescription() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ResourceRunApprovalRuleUpsertOne) ClearDescription() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *ResourceRunApprovalRuleUpsertOne) SetLabels(v map[string]string) *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertOne) UpdateLabels() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *ResourceRunApprovalRuleUpsertOne) ClearLabels() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ResourceRunApprovalRuleUpsertOne) SetUpdateTime(v time.Time) *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertOne) UpdateUpdateTime() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetEnvironmentType sets the "environment_type" field.
+func (u *ResourceRunApprovalRuleUpsertOne) SetEnvironmentType(v string) *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetEnvironmentType(v)
+	})
+}
+
+// UpdateEnvironmentType sets the "environment_type" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertOne) UpdateEnvironmentType() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateEnvironmentType()
+	})
+}
+
+// ClearEnvironmentType clears the value of the "environment_type" field.
+func (u *ResourceRunApprovalRuleUpsertOne) ClearEnvironmentType() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.ClearEnvironmentType()
+	})
+}
+
+// SetRunTypes sets the "run_types" field.
+func (u *ResourceRunApprovalRuleUpsertOne) SetRunTypes(v []string) *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetRunTypes(v)
+	})
+}
+
+// UpdateRunTypes sets the "run_types" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertOne) UpdateRunTypes() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateRunTypes()
+	})
+}
+
+// SetApprovals sets the "approvals" field.
+func (u *ResourceRunApprovalRuleUpsertOne) SetApprovals(v int) *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetApprovals(v)
+	})
+}
+
+// AddApprovals adds v to the "approvals" field.
+func (u *ResourceRunApprovalRuleUpsertOne) AddApprovals(v int) *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.AddApprovals(v)
+	})
+}
+
+// UpdateApprovals sets the "approvals" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertOne) UpdateApprovals() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateApprovals()
+	})
+}
+
+// SetRoles sets the "roles" field.
+func (u *ResourceRunApprovalRuleUpsertOne) SetRoles(v []string) *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetRoles(v)
+	})
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertOne) UpdateRoles() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateRoles()
+	})
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *ResourceRunApprovalRuleUpsertOne) ClearRoles() *ResourceRunApprovalRuleUpsertOne {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.ClearRoles()
+	})
+}
+
+// Exec executes the query.
+func (u *ResourceRunApprovalRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceRunApprovalRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceRunApprovalRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResourceRunApprovalRuleUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: ResourceRunApprovalRuleUpsertOne.ID is not supported by MySQL driver. Use ResourceRunApprovalRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResourceRunApprovalRuleUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResourceRunApprovalRuleCreateBulk is the builder for creating many ResourceRunApprovalRule entities in bulk.
+type ResourceRunApprovalRuleCreateBulk struct {
+	config
+	err        error
+	builders   []*ResourceRunApprovalRuleCreate
+	conflict   []sql.ConflictOption
+	objects    []*ResourceRunApprovalRule
+	fromUpsert bool
+}
+
+// Save creates the ResourceRunApprovalRule entities in the database.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) Save(ctx context.Context) ([]*ResourceRunApprovalRule, error) {
+	if rrarcb.err != nil {
+		return nil, rrarcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrarcb.builders))
+	nodes := make([]*ResourceRunApprovalRule, len(rrarcb.builders))
+	mutators := make([]Mutator, len(rrarcb.builders))
+	for i := range rrarcb.builders {
+		func(i int, root context.Context) {
+			builder := rrarcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResourceRunApprovalRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrarcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rrarcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrarcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrarcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) SaveX(ctx context.Context) []*ResourceRunApprovalRule {
+	v, err := rrarcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrarcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := rrarcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceRunApprovalRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceRunApprovalRuleUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResourceRunApprovalRuleUpsertBulk {
+	rrarcb.conflict = opts
+	return &ResourceRunApprovalRuleUpsertBulk{
+		create: rrarcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceRunApprovalRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrarcb *ResourceRunApprovalRuleCreateBulk) OnConflictColumns(columns ...string) *ResourceRunApprovalRuleUpsertBulk {
+	rrarcb.conflict = append(rrarcb.conflict, sql.ConflictColumns(columns...))
+	return &ResourceRunApprovalRuleUpsertBulk{
+		create: rrarcb,
+	}
+}
+
+// ResourceRunApprovalRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResourceRunApprovalRule nodes.
+type ResourceRunApprovalRuleUpsertBulk struct {
+	create *ResourceRunApprovalRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResourceRunApprovalRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resourcerunapprovalrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResourceRunApprovalRuleUpsertBulk) UpdateNewValues() *ResourceRunApprovalRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(resourcerunapprovalrule.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(resourcerunapprovalrule.FieldName)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(resourcerunapprovalrule.FieldCreateTime)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(resourcerunapprovalrule.FieldProjectID)
+			}
+			if _, exists := b.mutation.EnvironmentID(); exists {
+				s.SetIgnore(resourcerunapprovalrule.FieldEnvironmentID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceRunApprovalRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResourceRunApprovalRuleUpsertBulk) Ignore() *ResourceRunApprovalRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceRunApprovalRuleUpsertBulk) DoNothing() *ResourceRunApprovalRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceRunApprovalRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResourceRunApprovalRuleUpsertBulk) Update(set func(*ResourceRunApprovalRuleUpsert)) *ResourceRunApprovalRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceRunApprovalRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) SetDescription(v string) *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertBulk) UpdateDescription() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) ClearDescription() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) SetLabels(v map[string]string) *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertBulk) UpdateLabels() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) ClearLabels() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) SetUpdateTime(v time.Time) *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertBulk) UpdateUpdateTime() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetEnvironmentType sets the "environment_type" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) SetEnvironmentType(v string) *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetEnvironmentType(v)
+	})
+}
+
+// UpdateEnvironmentType sets the "environment_type" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertBulk) UpdateEnvironmentType() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateEnvironmentType()
+	})
+}
+
+// ClearEnvironmentType clears the value of the "environment_type" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) ClearEnvironmentType() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.ClearEnvironmentType()
+	})
+}
+
+// SetRunTypes sets the "run_types" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) SetRunTypes(v []string) *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetRunTypes(v)
+	})
+}
+
+// UpdateRunTypes sets the "run_types" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertBulk) UpdateRunTypes() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateRunTypes()
+	})
+}
+
+// SetApprovals sets the "approvals" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) SetApprovals(v int) *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetApprovals(v)
+	})
+}
+
+// AddApprovals adds v to the "approvals" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) AddApprovals(v int) *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.AddApprovals(v)
+	})
+}
+
+// UpdateApprovals sets the "approvals" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertBulk) UpdateApprovals() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateApprovals()
+	})
+}
+
+// SetRoles sets the "roles" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) SetRoles(v []string) *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.SetRoles(v)
+	})
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *ResourceRunApprovalRuleUpsertBulk) UpdateRoles() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.UpdateRoles()
+	})
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *ResourceRunApprovalRuleUpsertBulk) ClearRoles() *ResourceRunApprovalRuleUpsertBulk {
+	return u.Update(func(s *ResourceRunApprovalRuleUpsert) {
+		s.ClearRoles()
+	})
+}
+
+// Exec executes the query.
+func (u *ResourceRunApprovalRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ResourceRunApprovalRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceRunApprovalRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceRunApprovalRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+)
+
+// ResourceRunApprovalRuleDelete is the builder for deleting a ResourceRunApprovalRule entity.
+type ResourceRunApprovalRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResourceRunApprovalRuleMutation
+}
+
+// Where appends a list predicates to the ResourceRunApprovalRuleDelete builder.
+func (rrard *ResourceRunApprovalRuleDelete) Where(ps ...predicate.ResourceRunApprovalRule) *ResourceRunApprovalRuleDelete {
+	rrard.mutation.Where(ps...)
+	return rrard
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rrard *ResourceRunApprovalRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrard.sqlExec, rrard.mutation, rrard.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrard *ResourceRunApprovalRuleDelete) ExecX(ctx context.Context) int {
+	n, err := rrard.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rrard *ResourceRunApprovalRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(resourcerunapprovalrule.Table, sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString))
+	_spec.Node.Schema = rrard.schemaConfig.ResourceRunApprovalRule
+	ctx = internal.NewSchemaConfigContext(ctx, rrard.schemaConfig)
+	if ps := rrard.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rrard.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rrard.mutation.done = true
+	return affected, err
+}
+
+// ResourceRunApprovalRuleDeleteOne is the builder for deleting a single ResourceRunApprovalRule entity.
+type ResourceRunApprovalRuleDeleteOne struct {
+	rrard *ResourceRunApprovalRuleDelete
+}
+
+// Where appends a list predicates to the ResourceRunApprovalRuleDelete builder.
+func (rrardo *ResourceRunApprovalRuleDeleteOne) Where(ps ...predicate.ResourceRunApprovalRule) *ResourceRunApprovalRuleDeleteOne {
+	rrardo.rrard.mutation.Where(ps...)
+	return rrardo
+}
+
+// Exec executes the deletion query.
+func (rrardo *ResourceRunApprovalRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := rrardo.rrard.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{resourcerunapprovalrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrardo *ResourceRunApprovalRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := rrardo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,758 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceRunApprovalRuleQuery is the builder for querying ResourceRunApprovalRule entities.
+type ResourceRunApprovalRuleQuery struct {
+	config
+	ctx             *QueryContext
+	order           []resourcerunapprovalrule.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.ResourceRunApprovalRule
+	withProject     *ProjectQuery
+	withEnvironment *EnvironmentQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResourceRunApprovalRuleQuery builder.
+func (rrarq *ResourceRunApprovalRuleQuery) Where(ps ...predicate.ResourceRunApprovalRule) *ResourceRunApprovalRuleQuery {
+	rrarq.predicates = append(rrarq.predicates, ps...)
+	return rrarq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrarq *ResourceRunApprovalRuleQuery) Limit(limit int) *ResourceRunApprovalRuleQuery {
+	rrarq.ctx.Limit = &limit
+	return rrarq
+}
+
+// Offset to start from.
+func (rrarq *ResourceRunApprovalRuleQuery) Offset(offset int) *ResourceRunApprovalRuleQuery {
+	rrarq.ctx.Offset = &offset
+	return rrarq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrarq *ResourceRunApprovalRuleQuery) Unique(unique bool) *ResourceRunApprovalRuleQuery {
+	rrarq.ctx.Unique = &unique
+	return rrarq
+}
+
+// Order specifies how the records should be ordered.
+func (rrarq *ResourceRunApprovalRuleQuery) Order(o ...resourcerunapprovalrule.OrderOption) *ResourceRunApprovalRuleQuery {
+	rrarq.order = append(rrarq.order, o...)
+	return rrarq
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (rrarq *ResourceRunApprovalRuleQuery) QueryProject() *ProjectQuery {
+	query := (&ProjectClient{config: rrarq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrarq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrarq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcerunapprovalrule.Table, resourcerunapprovalrule.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerunapprovalrule.ProjectTable, resourcerunapprovalrule.ProjectColumn),
+		)
+		schemaConfig := rrarq.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		fromU = sqlgraph.SetNeighbors(rrarq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnvironment chains the current query on the "environment" edge.
+func (rrarq *ResourceRunApprovalRuleQuery) QueryEnvironment() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: rrarq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrarq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrarq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcerunapprovalrule.Table, resourcerunapprovalrule.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerunapprovalrule.EnvironmentTable, resourcerunapprovalrule.EnvironmentColumn),
+		)
+		schemaConfig := rrarq.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRunApprovalRule
+		fromU = sqlgraph.SetNeighbors(rrarq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResourceRunApprovalRule entity from the query.
+// Returns a *NotFoundError when no ResourceRunApprovalRule was found.
+func (rrarq *ResourceRunApprovalRuleQuery) First(ctx context.Context) (*ResourceRunApprovalRule, error) {
+	nodes, err := rrarq.Limit(1).All(setContextOp(ctx, rrarq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{resourcerunapprovalrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrarq *ResourceRunApprovalRuleQuery) FirstX(ctx context.Context) *ResourceRunApprovalRule {
+	node, err := rrarq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResourceRunApprovalRule ID from the query.
+// Returns a *NotFoundError when no ResourceRunApprovalRule ID was found.
+func (rrarq *ResourceRunApprovalRuleQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = rrarq.Limit(1).IDs(setContextOp(ctx, rrarq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{resourcerunapprovalrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrarq *ResourceRunApprovalRuleQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := rrarq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResourceRunApprovalRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResourceRunApprovalRule entity is found.
+// Returns a *NotFoundError when no ResourceRunApprovalRule entities are found.
+func (rrarq *ResourceRunApprovalRuleQuery) Only(ctx context.Context) (*ResourceRunApprovalRule, error) {
+	nodes, err := rrarq.Limit(2).All(setContextOp(ctx, rrarq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{resourcerunapprovalrule.Label}
+	default:
+		return nil, &NotSingularError{resourcerunapprovalrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrarq *ResourceRunApprovalRuleQuery) OnlyX(ctx context.Context) *ResourceRunApprovalRule {
+	node, err := rrarq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResourceRunApprovalRule ID in the query.
+// Returns a *NotSingularError when more than one ResourceRunApprovalRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrarq *ResourceRunApprovalRuleQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = rrarq.Limit(2).IDs(setContextOp(ctx, rrarq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{resourcerunapprovalrule.Label}
+	default:
+		err = &NotSingularError{resourcerunapprovalrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrarq *ResourceRunApprovalRuleQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := rrarq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResourceRunApprovalRules.
+func (rrarq *ResourceRunApprovalRuleQuery) All(ctx context.Context) ([]*ResourceRunApprovalRule, error) {
+	ctx = setContextOp(ctx, rrarq.ctx, "All")
+	if err := rrarq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResourceRunApprovalRule, *ResourceRunApprovalRuleQuery]()
+	return withInterceptors[[]*ResourceRunApprovalRule](ctx, rrarq, qr, rrarq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrarq *ResourceRunApprovalRuleQuery) AllX(ctx context.Context) []*ResourceRunApprovalRule {
+	nodes, err := rrarq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResourceRunApprovalRule IDs.
+func (rrarq *ResourceRunApprovalRuleQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if rrarq.ctx.Unique == nil && rrarq.path != nil {
+		rrarq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrarq.ctx, "IDs")
+	if err = rrarq.Select(resourcerunapprovalrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrarq *ResourceRunApprovalRuleQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := rrarq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrarq *ResourceRunApprovalRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrarq.ctx, "Count")
+	if err := rrarq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrarq, querierCount[*ResourceRunApprovalRuleQuery](), rrarq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrarq *ResourceRunApprovalRuleQuery) CountX(ctx context.Context) int {
+	count, err := rrarq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrarq *ResourceRunApprovalRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrarq.ctx, "Exist")
+	switch _, err := rrarq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrarq *ResourceRunApprovalRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrarq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResourceRunApprovalRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrarq *ResourceRunApprovalRuleQuery) Clone() *ResourceRunApprovalRuleQuery {
+	if rrarq == nil {
+		return nil
+	}
+	return &ResourceRunApprovalRuleQuery{
+		config:          rrarq.config,
+		ctx:             rrarq.ctx.Clone(),
+		order:           append([]resourcerunapprovalrule.OrderOption{}, rrarq.order...),
+		inters:          append([]Interceptor{}, rrarq.inters...),
+		predicates:      append([]predicate.ResourceRunApprovalRule{}, rrarq.predicates...),
+		withProject:     rrarq.withProject.Clone(),
+		withEnvironment: rrarq.withEnvironment.Clone(),
+		// clone intermediate query.
+		sql:  rrarq.sql.Clone(),
+		path: rrarq.path,
+	}
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrarq *ResourceRunApprovalRuleQuery) WithProject(opts ...func(*ProjectQuery)) *ResourceRunApprovalRuleQuery {
+	query := (&ProjectClient{config: rrarq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrarq.withProject = query
+	return rrarq
+}
+
+// WithEnvironment tells the query-builder to eager-load the nodes that are connected to
+// the "environment" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrarq *ResourceRunApprovalRuleQuery) WithEnvironment(opts ...func(*EnvironmentQuery)) *ResourceRunApprovalRuleQuery {
+	query := (&EnvironmentClient{config: rrarq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrarq.withEnvironment = query
+	return rrarq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResourceRunApprovalRule.Query().
+//		GroupBy(resourcerunapprovalrule.FieldName).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (rrarq *ResourceRunApprovalRuleQuery) GroupBy(field string, fields ...string) *ResourceRunApprovalRuleGroupBy {
+	rrarq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResourceRunApprovalRuleGroupBy{build: rrarq}
+	grbuild.flds = &rrarq.ctx.Fields
+	grbuild.label = resourcerunapprovalrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.ResourceRunApprovalRule.Query().
+//		Select(resourcerunapprovalrule.FieldName).
+//		Scan(ctx, &v)
+func (rrarq *ResourceRunApprovalRuleQuery) Select(fields ...string) *ResourceRunApprovalRuleSelect {
+	rrarq.ctx.Fields = append(rrarq.ctx.Fields, fields...)
+	sbuild := &ResourceRunApprovalRuleSelect{ResourceRunApprovalRuleQuery: rrarq}
+	sbuild.label = resourcerunapprovalrule.Label
+	sbuild.flds, sbuild.scan = &rrarq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResourceRunApprovalRuleSelect configured with the given aggregations.
+func (rrarq *ResourceRunApprovalRuleQuery) Aggregate(fns ...AggregateFunc) *ResourceRunApprovalRuleSelect {
+	return rrarq.Select().Aggregate(fns...)
+}
+
+func (rrarq *ResourceRunApprovalRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrarq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrarq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrarq.ctx.Fields {
+		if !resourcerunapprovalrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if rrarq.path != nil {
+		prev, err := rrarq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrarq.sql = prev
+	}
+	return nil
+}
+
+func (rrarq *ResourceRunApprovalRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResourceRunApprovalRule, error) {
+	var (
+		nodes       = []*ResourceRunApprovalRule{}
+		_spec       = rrarq.querySpec()
+		loadedTypes = [2]bool{
+			rrarq.withProject != nil,
+			rrarq.withEnvironment != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResourceRunApprovalRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResourceRunApprovalRule{config: rrarq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = rrarq.schemaConfig.ResourceRunApprovalRule
+	ctx = internal.NewSchemaConfigContext(ctx, rrarq.schemaConfig)
+	if len(rrarq.modifiers) > 0 {
+		_spec.Modifiers = rrarq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrarq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rrarq.withProject; query != nil {
+		if err := rrarq.loadProject(ctx, query, nodes, nil,
+			func(n *ResourceRunApprovalRule, e *Project) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rrarq.withEnvironment; query != nil {
+		if err := rrarq.loadEnvironment(ctx, query, nodes, nil,
+			func(n *ResourceRunApprovalRule, e *Environment) { n.Edges.Environment = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rrarq *ResourceRunApprovalRuleQuery) loadProject(ctx context.Context, query *ProjectQuery, nodes []*ResourceRunApprovalRule, init func(*ResourceRunApprovalRule), assign func(*ResourceRunApprovalRule, *Project)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceRunApprovalRule)
+	for i := range nodes {
+		fk := nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(project.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rrarq *ResourceRunApprovalRuleQuery) loadEnvironment(ctx context.Context, query *EnvironmentQuery, nodes []*ResourceRunApprovalRule, init func(*ResourceRunApprovalRule), assign func(*ResourceRunApprovalRule, *Environment)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceRunApprovalRule)
+	for i := range nodes {
+		fk := nodes[i].EnvironmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "environment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rrarq *ResourceRunApprovalRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrarq.querySpec()
+	_spec.Node.Schema = rrarq.schemaConfig.ResourceRunApprovalRule
+	ctx = internal.NewSchemaConfigContext(ctx, rrarq.schemaConfig)
+	if len(rrarq.modifiers) > 0 {
+		_spec.Modifiers = rrarq.modifiers
+	}
+	_spec.Node.Columns = rrarq.ctx.Fields
+	if len(rrarq.ctx.Fields) > 0 {
+		_spec.Unique = rrarq.ctx.Unique != nil && *rrarq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrarq.driver, _spec)
+}
+
+func (rrarq *ResourceRunApprovalRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(resourcerunapprovalrule.Table, resourcerunapprovalrule.Columns, sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString))
+	_spec.From = rrarq.sql
+	if unique := rrarq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrarq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrarq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resourcerunapprovalrule.FieldID)
+		for i := range fields {
+			if fields[i] != resourcerunapprovalrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rrarq.withProject != nil {
+			_spec.Node.AddColumnOnce(resourcerunapprovalrule.FieldProjectID)
+		}
+		if rrarq.withEnvironment != nil {
+			_spec.Node.AddColumnOnce(resourcerunapprovalrule.FieldEnvironmentID)
+		}
+	}
+	if ps := rrarq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrarq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrarq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrarq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrarq *ResourceRunApprovalRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrarq.driver.Dialect())
+	t1 := builder.Table(resourcerunapprovalrule.Table)
+	columns := rrarq.ctx.Fields
+	if len(columns) == 0 {
+		columns = resourcerunapprovalrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrarq.sql != nil {
+		selector = rrarq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrarq.ctx.Unique != nil && *rrarq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(rrarq.schemaConfig.ResourceRunApprovalRule)
+	ctx = internal.NewSchemaConfigContext(ctx, rrarq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range rrarq.modifiers {
+		m(selector)
+	}
+	for _, p := range rrarq.predicates {
+		p(selector)
+	}
+	for _, p := range rrarq.order {
+		p(selector)
+	}
+	if offset := rrarq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrarq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rrarq *ResourceRunApprovalRuleQuery) ForUpdate(opts ...sql.LockOption) *ResourceRunApprovalRuleQuery {
+	if rrarq.driver.Dialect() == dialect.Postgres {
+		rrarq.Unique(false)
+	}
+	rrarq.modifiers = append(rrarq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rrarq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rrarq *ResourceRunApprovalRuleQuery) ForShare(opts ...sql.LockOption) *ResourceRunApprovalRuleQuery {
+	if rrarq.driver.Dialect() == dialect.Postgres {
+		rrarq.Unique(false)
+	}
+	rrarq.modifiers = append(rrarq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rrarq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rrarq *ResourceRunApprovalRuleQuery) Modify(modifiers ...func(s *sql.Selector)) *ResourceRunApprovalRuleSelect {
+	rrarq.modifiers = append(rrarq.modifiers, modifiers...)
+	return rrarq.Select()
+}
+
+// WhereP appends storage-level predicates to the ResourceRunApprovalRuleQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (rrarq *ResourceRunApprovalRuleQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.ResourceRunApprovalRule, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.ResourceRunApprovalRule(ps[i]))
+	}
+	rrarq.predicates = append(rrarq.predicates, wps...)
+}
+
+// ResourceRunApprovalRuleGroupBy is the group-by builder for ResourceRunApprovalRule entities.
+type ResourceRunApprovalRuleGroupBy struct {
+	selector
+	build *ResourceRunApprovalRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrargb *ResourceRunApprovalRuleGroupBy) Aggregate(fns ...AggregateFunc) *ResourceRunApprovalRuleGroupBy {
+	rrargb.fns = append(rrargb.fns, fns...)
+	return rrargb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrargb *ResourceRunApprovalRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrargb.build.ctx, "GroupBy")
+	if err := rrargb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResourceRunApprovalRuleQuery, *ResourceRunApprovalRuleGroupBy](ctx, rrargb.build, rrargb, rrargb.build.inters, v)
+}
+
+func (rrargb *ResourceRunApprovalRuleGroupBy) sqlScan(ctx context.Context, root *ResourceRunApprovalRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrargb.fns))
+	for _, fn := range rrargb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrargb.flds)+len(rrargb.fns))
+		for _, f := range *rrargb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrargb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrargb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResourceRunApprovalRuleSelect is the builder for selecting fields of ResourceRunApprovalRule entities.
+type ResourceRunApprovalRuleSelect struct {
+	*ResourceRunApprovalRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrars *ResourceRunApprovalRuleSelect) Aggregate(fns ...AggregateFunc) *ResourceRunApprovalRuleSelect {
+	rrars.fns = append(rrars.fns, fns...)
+	return rrars
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrars *ResourceRunApprovalRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrars.ctx, "Select")
+	if err := rrars.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResourceRunApprovalRuleQuery, *ResourceRunApprovalRuleSelect](ctx, rrars.ResourceRunApprovalRuleQuery, rrars, rrars.inters, v)
+}
+
+func (rrars *ResourceRunApprovalRuleSelect) sqlScan(ctx context.Context, root *ResourceRunApprovalRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrars.fns))
+	for _, fn := range rrars.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrars.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrars.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rrars *ResourceRunApprovalRuleSelect) Modify(modifiers ...func(s *sql.Selector)) *ResourceRunApprovalRuleSelect {
+	rrars.modifiers = append(rrars.modifiers, modifiers...)
+	return rrars
+}
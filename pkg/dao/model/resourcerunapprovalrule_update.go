@@ -0,0 +1,816 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+)
+
+// ResourceRunApprovalRuleUpdate is the builder for updating ResourceRunApprovalRule entities.
+type ResourceRunApprovalRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ResourceRunApprovalRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ResourceRunApprovalRule
+}
+
+// Where appends a list predicates to the ResourceRunApprovalRuleUpdate builder.
+func (rraru *ResourceRunApprovalRuleUpdate) Where(ps ...predicate.ResourceRunApprovalRule) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.Where(ps...)
+	return rraru
+}
+
+// SetDescription sets the "description" field.
+func (rraru *ResourceRunApprovalRuleUpdate) SetDescription(s string) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.SetDescription(s)
+	return rraru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rraru *ResourceRunApprovalRuleUpdate) SetNillableDescription(s *string) *ResourceRunApprovalRuleUpdate {
+	if s != nil {
+		rraru.SetDescription(*s)
+	}
+	return rraru
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rraru *ResourceRunApprovalRuleUpdate) ClearDescription() *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.ClearDescription()
+	return rraru
+}
+
+// SetLabels sets the "labels" field.
+func (rraru *ResourceRunApprovalRuleUpdate) SetLabels(m map[string]string) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.SetLabels(m)
+	return rraru
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (rraru *ResourceRunApprovalRuleUpdate) ClearLabels() *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.ClearLabels()
+	return rraru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rraru *ResourceRunApprovalRuleUpdate) SetUpdateTime(t time.Time) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.SetUpdateTime(t)
+	return rraru
+}
+
+// SetEnvironmentType sets the "environment_type" field.
+func (rraru *ResourceRunApprovalRuleUpdate) SetEnvironmentType(s string) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.SetEnvironmentType(s)
+	return rraru
+}
+
+// SetNillableEnvironmentType sets the "environment_type" field if the given value is not nil.
+func (rraru *ResourceRunApprovalRuleUpdate) SetNillableEnvironmentType(s *string) *ResourceRunApprovalRuleUpdate {
+	if s != nil {
+		rraru.SetEnvironmentType(*s)
+	}
+	return rraru
+}
+
+// ClearEnvironmentType clears the value of the "environment_type" field.
+func (rraru *ResourceRunApprovalRuleUpdate) ClearEnvironmentType() *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.ClearEnvironmentType()
+	return rraru
+}
+
+// SetRunTypes sets the "run_types" field.
+func (rraru *ResourceRunApprovalRuleUpdate) SetRunTypes(s []string) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.SetRunTypes(s)
+	return rraru
+}
+
+// AppendRunTypes appends s to the "run_types" field.
+func (rraru *ResourceRunApprovalRuleUpdate) AppendRunTypes(s []string) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.AppendRunTypes(s)
+	return rraru
+}
+
+// SetApprovals sets the "approvals" field.
+func (rraru *ResourceRunApprovalRuleUpdate) SetApprovals(i int) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.ResetApprovals()
+	rraru.mutation.SetApprovals(i)
+	return rraru
+}
+
+// SetNillableApprovals sets the "approvals" field if the given value is not nil.
+func (rraru *ResourceRunApprovalRuleUpdate) SetNillableApprovals(i *int) *ResourceRunApprovalRuleUpdate {
+	if i != nil {
+		rraru.SetApprovals(*i)
+	}
+	return rraru
+}
+
+// AddApprovals adds i to the "approvals" field.
+func (rraru *ResourceRunApprovalRuleUpdate) AddApprovals(i int) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.AddApprovals(i)
+	return rraru
+}
+
+// SetRoles sets the "roles" field.
+func (rraru *ResourceRunApprovalRuleUpdate) SetRoles(s []string) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.SetRoles(s)
+	return rraru
+}
+
+// AppendRoles appends s to the "roles" field.
+func (rraru *ResourceRunApprovalRuleUpdate) AppendRoles(s []string) *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.AppendRoles(s)
+	return rraru
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (rraru *ResourceRunApprovalRuleUpdate) ClearRoles() *ResourceRunApprovalRuleUpdate {
+	rraru.mutation.ClearRoles()
+	return rraru
+}
+
+// Mutation returns the ResourceRunApprovalRuleMutation object of the builder.
+func (rraru *ResourceRunApprovalRuleUpdate) Mutation() *ResourceRunApprovalRuleMutation {
+	return rraru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rraru *ResourceRunApprovalRuleUpdate) Save(ctx context.Context) (int, error) {
+	if err := rraru.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, rraru.sqlSave, rraru.mutation, rraru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rraru *ResourceRunApprovalRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := rraru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rraru *ResourceRunApprovalRuleUpdate) Exec(ctx context.Context) error {
+	_, err := rraru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rraru *ResourceRunApprovalRuleUpdate) ExecX(ctx context.Context) {
+	if err := rraru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rraru *ResourceRunApprovalRuleUpdate) defaults() error {
+	if _, ok := rraru.mutation.UpdateTime(); !ok {
+		if resourcerunapprovalrule.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcerunapprovalrule.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := resourcerunapprovalrule.UpdateDefaultUpdateTime()
+		rraru.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rraru *ResourceRunApprovalRuleUpdate) check() error {
+	if v, ok := rraru.mutation.Approvals(); ok {
+		if err := resourcerunapprovalrule.ApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "approvals", err: fmt.Errorf(`model: validator failed for field "ResourceRunApprovalRule.approvals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rraru *ResourceRunApprovalRuleUpdate) Set(obj *ResourceRunApprovalRule) *ResourceRunApprovalRuleUpdate {
+	// Without Default.
+	if obj.Description != "" {
+		rraru.SetDescription(obj.Description)
+	} else {
+		rraru.ClearDescription()
+	}
+	if !reflect.ValueOf(obj.Labels).IsZero() {
+		rraru.SetLabels(obj.Labels)
+	}
+	if obj.EnvironmentType != "" {
+		rraru.SetEnvironmentType(obj.EnvironmentType)
+	} else {
+		rraru.ClearEnvironmentType()
+	}
+	rraru.SetRunTypes(obj.RunTypes)
+	rraru.SetApprovals(obj.Approvals)
+	if !reflect.ValueOf(obj.Roles).IsZero() {
+		rraru.SetRoles(obj.Roles)
+	} else {
+		rraru.ClearRoles()
+	}
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		rraru.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	rraru.object = obj
+
+	return rraru
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rraru *ResourceRunApprovalRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceRunApprovalRuleUpdate {
+	rraru.modifiers = append(rraru.modifiers, modifiers...)
+	return rraru
+}
+
+func (rraru *ResourceRunApprovalRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rraru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resourcerunapprovalrule.Table, resourcerunapprovalrule.Columns, sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString))
+	if ps := rraru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rraru.mutation.Description(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldDescription, field.TypeString, value)
+	}
+	if rraru.mutation.DescriptionCleared() {
+		_spec.ClearField(resourcerunapprovalrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := rraru.mutation.Labels(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldLabels, field.TypeJSON, value)
+	}
+	if rraru.mutation.LabelsCleared() {
+		_spec.ClearField(resourcerunapprovalrule.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := rraru.mutation.UpdateTime(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := rraru.mutation.EnvironmentType(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldEnvironmentType, field.TypeString, value)
+	}
+	if rraru.mutation.EnvironmentTypeCleared() {
+		_spec.ClearField(resourcerunapprovalrule.FieldEnvironmentType, field.TypeString)
+	}
+	if value, ok := rraru.mutation.RunTypes(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldRunTypes, field.TypeJSON, value)
+	}
+	if value, ok := rraru.mutation.AppendedRunTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resourcerunapprovalrule.FieldRunTypes, value)
+		})
+	}
+	if value, ok := rraru.mutation.Approvals(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldApprovals, field.TypeInt, value)
+	}
+	if value, ok := rraru.mutation.AddedApprovals(); ok {
+		_spec.AddField(resourcerunapprovalrule.FieldApprovals, field.TypeInt, value)
+	}
+	if value, ok := rraru.mutation.Roles(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := rraru.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resourcerunapprovalrule.FieldRoles, value)
+		})
+	}
+	if rraru.mutation.RolesCleared() {
+		_spec.ClearField(resourcerunapprovalrule.FieldRoles, field.TypeJSON)
+	}
+	_spec.Node.Schema = rraru.schemaConfig.ResourceRunApprovalRule
+	ctx = internal.NewSchemaConfigContext(ctx, rraru.schemaConfig)
+	_spec.AddModifiers(rraru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rraru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resourcerunapprovalrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rraru.mutation.done = true
+	return n, nil
+}
+
+// ResourceRunApprovalRuleUpdateOne is the builder for updating a single ResourceRunApprovalRule entity.
+type ResourceRunApprovalRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ResourceRunApprovalRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ResourceRunApprovalRule
+}
+
+// SetDescription sets the "description" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetDescription(s string) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.SetDescription(s)
+	return rraruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetNillableDescription(s *string) *ResourceRunApprovalRuleUpdateOne {
+	if s != nil {
+		rraruo.SetDescription(*s)
+	}
+	return rraruo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) ClearDescription() *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.ClearDescription()
+	return rraruo
+}
+
+// SetLabels sets the "labels" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetLabels(m map[string]string) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.SetLabels(m)
+	return rraruo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) ClearLabels() *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.ClearLabels()
+	return rraruo
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetUpdateTime(t time.Time) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.SetUpdateTime(t)
+	return rraruo
+}
+
+// SetEnvironmentType sets the "environment_type" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetEnvironmentType(s string) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.SetEnvironmentType(s)
+	return rraruo
+}
+
+// SetNillableEnvironmentType sets the "environment_type" field if the given value is not nil.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetNillableEnvironmentType(s *string) *ResourceRunApprovalRuleUpdateOne {
+	if s != nil {
+		rraruo.SetEnvironmentType(*s)
+	}
+	return rraruo
+}
+
+// ClearEnvironmentType clears the value of the "environment_type" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) ClearEnvironmentType() *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.ClearEnvironmentType()
+	return rraruo
+}
+
+// SetRunTypes sets the "run_types" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetRunTypes(s []string) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.SetRunTypes(s)
+	return rraruo
+}
+
+// AppendRunTypes appends s to the "run_types" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) AppendRunTypes(s []string) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.AppendRunTypes(s)
+	return rraruo
+}
+
+// SetApprovals sets the "approvals" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetApprovals(i int) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.ResetApprovals()
+	rraruo.mutation.SetApprovals(i)
+	return rraruo
+}
+
+// SetNillableApprovals sets the "approvals" field if the given value is not nil.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetNillableApprovals(i *int) *ResourceRunApprovalRuleUpdateOne {
+	if i != nil {
+		rraruo.SetApprovals(*i)
+	}
+	return rraruo
+}
+
+// AddApprovals adds i to the "approvals" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) AddApprovals(i int) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.AddApprovals(i)
+	return rraruo
+}
+
+// SetRoles sets the "roles" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SetRoles(s []string) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.SetRoles(s)
+	return rraruo
+}
+
+// AppendRoles appends s to the "roles" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) AppendRoles(s []string) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.AppendRoles(s)
+	return rraruo
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) ClearRoles() *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.ClearRoles()
+	return rraruo
+}
+
+// Mutation returns the ResourceRunApprovalRuleMutation object of the builder.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) Mutation() *ResourceRunApprovalRuleMutation {
+	return rraruo.mutation
+}
+
+// Where appends a list predicates to the ResourceRunApprovalRuleUpdate builder.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) Where(ps ...predicate.ResourceRunApprovalRule) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.mutation.Where(ps...)
+	return rraruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) Select(field string, fields ...string) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.fields = append([]string{field}, fields...)
+	return rraruo
+}
+
+// Save executes the query and returns the updated ResourceRunApprovalRule entity.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) Save(ctx context.Context) (*ResourceRunApprovalRule, error) {
+	if err := rraruo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rraruo.sqlSave, rraruo.mutation, rraruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SaveX(ctx context.Context) *ResourceRunApprovalRule {
+	node, err := rraruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := rraruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := rraruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) defaults() error {
+	if _, ok := rraruo.mutation.UpdateTime(); !ok {
+		if resourcerunapprovalrule.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcerunapprovalrule.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := resourcerunapprovalrule.UpdateDefaultUpdateTime()
+		rraruo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) check() error {
+	if v, ok := rraruo.mutation.Approvals(); ok {
+		if err := resourcerunapprovalrule.ApprovalsValidator(v); err != nil {
+			return &ValidationError{Name: "approvals", err: fmt.Errorf(`model: validator failed for field "ResourceRunApprovalRule.approvals": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rraruo *ResourceRunApprovalRuleUpdateOne) Set(obj *ResourceRunApprovalRule) *ResourceRunApprovalRuleUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*ResourceRunApprovalRuleMutation)
+			db, err := mt.Client().ResourceRunApprovalRule.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting ResourceRunApprovalRule with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if obj.Description != "" {
+				if db.Description != obj.Description {
+					rraruo.SetDescription(obj.Description)
+				}
+			} else {
+				rraruo.ClearDescription()
+			}
+			if !reflect.ValueOf(obj.Labels).IsZero() {
+				if !reflect.DeepEqual(db.Labels, obj.Labels) {
+					rraruo.SetLabels(obj.Labels)
+				}
+			}
+			if obj.EnvironmentType != "" {
+				if db.EnvironmentType != obj.EnvironmentType {
+					rraruo.SetEnvironmentType(obj.EnvironmentType)
+				}
+			} else {
+				rraruo.ClearEnvironmentType()
+			}
+			if !reflect.DeepEqual(db.RunTypes, obj.RunTypes) {
+				rraruo.SetRunTypes(obj.RunTypes)
+			}
+			if db.Approvals != obj.Approvals {
+				rraruo.SetApprovals(obj.Approvals)
+			}
+			if !reflect.ValueOf(obj.Roles).IsZero() {
+				if !reflect.DeepEqual(db.Roles, obj.Roles) {
+					rraruo.SetRoles(obj.Roles)
+				}
+			} else {
+				rraruo.ClearRoles()
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				rraruo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			rraruo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	rraruo.hooks = append(rraruo.hooks, h)
+
+	return rraruo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := rraruo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rraruo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rraruo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the ResourceRunApprovalRule entity,
+// which is always good for cascading update operations.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunApprovalRule) error) (*ResourceRunApprovalRule, error) {
+	obj, err := rraruo.Save(ctx)
+	if err != nil &&
+		(rraruo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := rraruo.getClientSet()
+
+	if obj == nil {
+		obj = rraruo.object
+	} else if x := rraruo.object; x != nil {
+		if _, set := rraruo.mutation.Field(resourcerunapprovalrule.FieldDescription); set {
+			obj.Description = x.Description
+		}
+		if _, set := rraruo.mutation.Field(resourcerunapprovalrule.FieldLabels); set {
+			obj.Labels = x.Labels
+		}
+		if _, set := rraruo.mutation.Field(resourcerunapprovalrule.FieldEnvironmentType); set {
+			obj.EnvironmentType = x.EnvironmentType
+		}
+		if _, set := rraruo.mutation.Field(resourcerunapprovalrule.FieldRunTypes); set {
+			obj.RunTypes = x.RunTypes
+		}
+		if _, set := rraruo.mutation.Field(resourcerunapprovalrule.FieldApprovals); set {
+			obj.Approvals = x.Approvals
+		}
+		if _, set := rraruo.mutation.Field(resourcerunapprovalrule.FieldRoles); set {
+			obj.Roles = x.Roles
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunApprovalRule) error) *ResourceRunApprovalRule {
+	obj, err := rraruo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunApprovalRule) error) error {
+	_, err := rraruo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunApprovalRule) error) {
+	if err := rraruo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rraruo *ResourceRunApprovalRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceRunApprovalRuleUpdateOne {
+	rraruo.modifiers = append(rraruo.modifiers, modifiers...)
+	return rraruo
+}
+
+func (rraruo *ResourceRunApprovalRuleUpdateOne) sqlSave(ctx context.Context) (_node *ResourceRunApprovalRule, err error) {
+	if err := rraruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resourcerunapprovalrule.Table, resourcerunapprovalrule.Columns, sqlgraph.NewFieldSpec(resourcerunapprovalrule.FieldID, field.TypeString))
+	id, ok := rraruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "ResourceRunApprovalRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rraruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resourcerunapprovalrule.FieldID)
+		for _, f := range fields {
+			if !resourcerunapprovalrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != resourcerunapprovalrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rraruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rraruo.mutation.Description(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldDescription, field.TypeString, value)
+	}
+	if rraruo.mutation.DescriptionCleared() {
+		_spec.ClearField(resourcerunapprovalrule.FieldDescription, field.TypeString)
+	}
+	if value, ok := rraruo.mutation.Labels(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldLabels, field.TypeJSON, value)
+	}
+	if rraruo.mutation.LabelsCleared() {
+		_spec.ClearField(resourcerunapprovalrule.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := rraruo.mutation.UpdateTime(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := rraruo.mutation.EnvironmentType(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldEnvironmentType, field.TypeString, value)
+	}
+	if rraruo.mutation.EnvironmentTypeCleared() {
+		_spec.ClearField(resourcerunapprovalrule.FieldEnvironmentType, field.TypeString)
+	}
+	if value, ok := rraruo.mutation.RunTypes(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldRunTypes, field.TypeJSON, value)
+	}
+	if value, ok := rraruo.mutation.AppendedRunTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resourcerunapprovalrule.FieldRunTypes, value)
+		})
+	}
+	if value, ok := rraruo.mutation.Approvals(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldApprovals, field.TypeInt, value)
+	}
+	if value, ok := rraruo.mutation.AddedApprovals(); ok {
+		_spec.AddField(resourcerunapprovalrule.FieldApprovals, field.TypeInt, value)
+	}
+	if value, ok := rraruo.mutation.Roles(); ok {
+		_spec.SetField(resourcerunapprovalrule.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := rraruo.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, resourcerunapprovalrule.FieldRoles, value)
+		})
+	}
+	if rraruo.mutation.RolesCleared() {
+		_spec.ClearField(resourcerunapprovalrule.FieldRoles, field.TypeJSON)
+	}
+	_spec.Node.Schema = rraruo.schemaConfig.ResourceRunApprovalRule
+	ctx = internal.NewSchemaConfigContext(ctx, rraruo.schemaConfig)
+	_spec.AddModifiers(rraruo.modifiers...)
+	_node = &ResourceRunApprovalRule{config: rraruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rraruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resourcerunapprovalrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rraruo.mutation.done = true
+	return _node, nil
+}
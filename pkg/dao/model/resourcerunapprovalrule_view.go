@@ -0,0 +1,1176 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ResourceRunApprovalRuleCreateInput holds the creation input of the ResourceRunApprovalRule entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunApprovalRuleCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create ResourceRunApprovalRule entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to create ResourceRunApprovalRule entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Types of the runs which require approvals.
+	RunTypes []string `path:"-" query:"-" json:"runTypes"`
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Type of the environments to which the rule applies, empty means for all types.
+	EnvironmentType string `path:"-" query:"-" json:"environmentType,omitempty"`
+	// Number of the approvals required.
+	Approvals int `path:"-" query:"-" json:"approvals,cli-table-column,omitempty"`
+	// Roles which the approvers must hold one of, empty means any subject.
+	Roles []string `path:"-" query:"-" json:"roles,omitempty"`
+}
+
+// Model returns the ResourceRunApprovalRule entity for creating,
+// after validating.
+func (rrarci *ResourceRunApprovalRuleCreateInput) Model() *ResourceRunApprovalRule {
+	if rrarci == nil {
+		return nil
+	}
+
+	_rrar := &ResourceRunApprovalRule{
+		RunTypes:        rrarci.RunTypes,
+		Name:            rrarci.Name,
+		Description:     rrarci.Description,
+		Labels:          rrarci.Labels,
+		EnvironmentType: rrarci.EnvironmentType,
+		Approvals:       rrarci.Approvals,
+		Roles:           rrarci.Roles,
+	}
+
+	if rrarci.Project != nil {
+		_rrar.ProjectID = rrarci.Project.ID
+	}
+	if rrarci.Environment != nil {
+		_rrar.EnvironmentID = rrarci.Environment.ID
+	}
+
+	return _rrar
+}
+
+// Validate checks the ResourceRunApprovalRuleCreateInput entity.
+func (rrarci *ResourceRunApprovalRuleCreateInput) Validate() error {
+	if rrarci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrarci.ValidateWith(rrarci.inputConfig.Context, rrarci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleCreateInput entity with the given context and client set.
+func (rrarci *ResourceRunApprovalRuleCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrarci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if rrarci.Project != nil {
+		if err := rrarci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarci.Project = nil
+			}
+		}
+	}
+	// Validate when creating under the Environment route.
+	if rrarci.Environment != nil {
+		if err := rrarci.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarci.Environment = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunApprovalRuleCreateInputs holds the creation input item of the ResourceRunApprovalRule entities.
+type ResourceRunApprovalRuleCreateInputsItem struct {
+	// Types of the runs which require approvals.
+	RunTypes []string `path:"-" query:"-" json:"runTypes"`
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Type of the environments to which the rule applies, empty means for all types.
+	EnvironmentType string `path:"-" query:"-" json:"environmentType,omitempty"`
+	// Number of the approvals required.
+	Approvals int `path:"-" query:"-" json:"approvals,cli-table-column,omitempty"`
+	// Roles which the approvers must hold one of, empty means any subject.
+	Roles []string `path:"-" query:"-" json:"roles,omitempty"`
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleCreateInputsItem entity with the given context and client set.
+func (rrarci *ResourceRunApprovalRuleCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrarci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceRunApprovalRuleCreateInputs holds the creation input of the ResourceRunApprovalRule entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunApprovalRuleCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create ResourceRunApprovalRule entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to create ResourceRunApprovalRule entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceRunApprovalRuleCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceRunApprovalRule entities for creating,
+// after validating.
+func (rrarci *ResourceRunApprovalRuleCreateInputs) Model() []*ResourceRunApprovalRule {
+	if rrarci == nil || len(rrarci.Items) == 0 {
+		return nil
+	}
+
+	_rrars := make([]*ResourceRunApprovalRule, len(rrarci.Items))
+
+	for i := range rrarci.Items {
+		_rrar := &ResourceRunApprovalRule{
+			RunTypes:        rrarci.Items[i].RunTypes,
+			Name:            rrarci.Items[i].Name,
+			Description:     rrarci.Items[i].Description,
+			Labels:          rrarci.Items[i].Labels,
+			EnvironmentType: rrarci.Items[i].EnvironmentType,
+			Approvals:       rrarci.Items[i].Approvals,
+			Roles:           rrarci.Items[i].Roles,
+		}
+
+		if rrarci.Project != nil {
+			_rrar.ProjectID = rrarci.Project.ID
+		}
+		if rrarci.Environment != nil {
+			_rrar.EnvironmentID = rrarci.Environment.ID
+		}
+
+		_rrars[i] = _rrar
+	}
+
+	return _rrars
+}
+
+// Validate checks the ResourceRunApprovalRuleCreateInputs entity .
+func (rrarci *ResourceRunApprovalRuleCreateInputs) Validate() error {
+	if rrarci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrarci.ValidateWith(rrarci.inputConfig.Context, rrarci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleCreateInputs entity with the given context and client set.
+func (rrarci *ResourceRunApprovalRuleCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrarci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rrarci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if rrarci.Project != nil {
+		if err := rrarci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarci.Project = nil
+			}
+		}
+	}
+	// Validate when creating under the Environment route.
+	if rrarci.Environment != nil {
+		if err := rrarci.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarci.Environment = nil
+			}
+		}
+	}
+
+	for i := range rrarci.Items {
+		if rrarci.Items[i] == nil {
+			continue
+		}
+
+		if err := rrarci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunApprovalRuleDeleteInput holds the deletion input of the ResourceRunApprovalRule entity,
+// please tags with `path:",inline"` if embedding.
+type ResourceRunApprovalRuleDeleteInput struct {
+	ResourceRunApprovalRuleQueryInput `path:",inline"`
+}
+
+// ResourceRunApprovalRuleDeleteInputs holds the deletion input item of the ResourceRunApprovalRule entities.
+type ResourceRunApprovalRuleDeleteInputsItem struct {
+	// ID of the ResourceRunApprovalRule entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the ResourceRunApprovalRule entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+}
+
+// ResourceRunApprovalRuleDeleteInputs holds the deletion input of the ResourceRunApprovalRule entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunApprovalRuleDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to delete ResourceRunApprovalRule entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to delete ResourceRunApprovalRule entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceRunApprovalRuleDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceRunApprovalRule entities for deleting,
+// after validating.
+func (rrardi *ResourceRunApprovalRuleDeleteInputs) Model() []*ResourceRunApprovalRule {
+	if rrardi == nil || len(rrardi.Items) == 0 {
+		return nil
+	}
+
+	_rrars := make([]*ResourceRunApprovalRule, len(rrardi.Items))
+	for i := range rrardi.Items {
+		_rrars[i] = &ResourceRunApprovalRule{
+			ID: rrardi.Items[i].ID,
+		}
+	}
+	return _rrars
+}
+
+// IDs returns the ID list of the ResourceRunApprovalRule entities for deleting,
+// after validating.
+func (rrardi *ResourceRunApprovalRuleDeleteInputs) IDs() []object.ID {
+	if rrardi == nil || len(rrardi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(rrardi.Items))
+	for i := range rrardi.Items {
+		ids[i] = rrardi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ResourceRunApprovalRuleDeleteInputs entity.
+func (rrardi *ResourceRunApprovalRuleDeleteInputs) Validate() error {
+	if rrardi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrardi.ValidateWith(rrardi.inputConfig.Context, rrardi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleDeleteInputs entity with the given context and client set.
+func (rrardi *ResourceRunApprovalRuleDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrardi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rrardi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceRunApprovalRules().Query()
+
+	// Validate when deleting under the Project route.
+	if rrardi.Project != nil {
+		if err := rrardi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrardi.Project = nil
+				q.Where(
+					resourcerunapprovalrule.ProjectIDIsNil())
+			}
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcerunapprovalrule.ProjectID(rrardi.Project.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunapprovalrule.ProjectIDIsNil())
+	}
+
+	// Validate when deleting under the Environment route.
+	if rrardi.Environment != nil {
+		if err := rrardi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrardi.Environment = nil
+				q.Where(
+					resourcerunapprovalrule.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				resourcerunapprovalrule.EnvironmentID(rrardi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunapprovalrule.EnvironmentIDIsNil())
+	}
+
+	ids := make([]object.ID, 0, len(rrardi.Items))
+	ors := make([]predicate.ResourceRunApprovalRule, 0, len(rrardi.Items))
+	indexers := make(map[any][]int)
+
+	for i := range rrardi.Items {
+		if rrardi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if rrardi.Items[i].ID != "" {
+			ids = append(ids, rrardi.Items[i].ID)
+			ors = append(ors, resourcerunapprovalrule.ID(rrardi.Items[i].ID))
+			indexers[rrardi.Items[i].ID] = append(indexers[rrardi.Items[i].ID], i)
+		} else if rrardi.Items[i].Name != "" {
+			ors = append(ors, resourcerunapprovalrule.And(
+				resourcerunapprovalrule.Name(rrardi.Items[i].Name)))
+			indexerKey := fmt.Sprint("/", rrardi.Items[i].Name)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := resourcerunapprovalrule.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = resourcerunapprovalrule.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			resourcerunapprovalrule.FieldID,
+			resourcerunapprovalrule.FieldName,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Name)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			rrardi.Items[j].ID = es[i].ID
+			rrardi.Items[j].Name = es[i].Name
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunApprovalRulePatchInput holds the patch input of the ResourceRunApprovalRule entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunApprovalRulePatchInput struct {
+	ResourceRunApprovalRuleQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// Type of the environments to which the rule applies, empty means for all types.
+	EnvironmentType string `path:"-" query:"-" json:"environmentType,omitempty"`
+	// Types of the runs which require approvals.
+	RunTypes []string `path:"-" query:"-" json:"runTypes,omitempty"`
+	// Number of the approvals required.
+	Approvals int `path:"-" query:"-" json:"approvals,omitempty"`
+	// Roles which the approvers must hold one of, empty means any subject.
+	Roles []string `path:"-" query:"-" json:"roles,omitempty"`
+
+	patchedEntity *ResourceRunApprovalRule `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the ResourceRunApprovalRule partition entity for patching.
+func (rrarpi *ResourceRunApprovalRulePatchInput) PatchModel() *ResourceRunApprovalRule {
+	if rrarpi == nil {
+		return nil
+	}
+
+	_rrar := &ResourceRunApprovalRule{
+		Name:            rrarpi.Name,
+		Description:     rrarpi.Description,
+		Labels:          rrarpi.Labels,
+		CreateTime:      rrarpi.CreateTime,
+		UpdateTime:      rrarpi.UpdateTime,
+		EnvironmentType: rrarpi.EnvironmentType,
+		RunTypes:        rrarpi.RunTypes,
+		Approvals:       rrarpi.Approvals,
+		Roles:           rrarpi.Roles,
+	}
+
+	if rrarpi.Project != nil {
+		_rrar.ProjectID = rrarpi.Project.ID
+	}
+	if rrarpi.Environment != nil {
+		_rrar.EnvironmentID = rrarpi.Environment.ID
+	}
+
+	return _rrar
+}
+
+// Model returns the ResourceRunApprovalRule patched entity,
+// after validating.
+func (rrarpi *ResourceRunApprovalRulePatchInput) Model() *ResourceRunApprovalRule {
+	if rrarpi == nil {
+		return nil
+	}
+
+	return rrarpi.patchedEntity
+}
+
+// Validate checks the ResourceRunApprovalRulePatchInput entity.
+func (rrarpi *ResourceRunApprovalRulePatchInput) Validate() error {
+	if rrarpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrarpi.ValidateWith(rrarpi.inputConfig.Context, rrarpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunApprovalRulePatchInput entity with the given context and client set.
+func (rrarpi *ResourceRunApprovalRulePatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := rrarpi.ResourceRunApprovalRuleQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.ResourceRunApprovalRules().Query()
+
+	// Validate when querying under the Project route.
+	if rrarpi.Project != nil {
+		if err := rrarpi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarpi.Project = nil
+				q.Where(
+					resourcerunapprovalrule.ProjectIDIsNil())
+			}
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcerunapprovalrule.ProjectID(rrarpi.Project.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunapprovalrule.ProjectIDIsNil())
+	}
+
+	// Validate when querying under the Environment route.
+	if rrarpi.Environment != nil {
+		if err := rrarpi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarpi.Environment = nil
+				q.Where(
+					resourcerunapprovalrule.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				resourcerunapprovalrule.EnvironmentID(rrarpi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunapprovalrule.EnvironmentIDIsNil())
+	}
+
+	if rrarpi.Refer != nil {
+		if rrarpi.Refer.IsID() {
+			q.Where(
+				resourcerunapprovalrule.ID(rrarpi.Refer.ID()))
+		} else if refers := rrarpi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				resourcerunapprovalrule.Name(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of resourcerunapprovalrule")
+		}
+	} else if rrarpi.ID != "" {
+		q.Where(
+			resourcerunapprovalrule.ID(rrarpi.ID))
+	} else if rrarpi.Name != "" {
+		q.Where(
+			resourcerunapprovalrule.Name(rrarpi.Name))
+	} else {
+		return errors.New("invalid identify of resourcerunapprovalrule")
+	}
+
+	q.Select(
+		resourcerunapprovalrule.WithoutFields(
+			resourcerunapprovalrule.FieldCreateTime,
+			resourcerunapprovalrule.FieldUpdateTime,
+		)...,
+	)
+
+	var e *ResourceRunApprovalRule
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ResourceRunApprovalRule)
+		}
+	}
+
+	_pm := rrarpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*ResourceRunApprovalRule)
+
+	if e.Name != _obj.Name {
+		return errors.New("field name is immutable")
+	}
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+
+	rrarpi.patchedEntity = _obj
+	return nil
+}
+
+// ResourceRunApprovalRuleQueryInput holds the query input of the ResourceRunApprovalRule entity,
+// please tags with `path:",inline"` if embedding.
+type ResourceRunApprovalRuleQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query ResourceRunApprovalRule entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"project,omitempty"`
+	// Environment indicates to query ResourceRunApprovalRule entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"environment,omitempty"`
+
+	// Refer holds the route path reference of the ResourceRunApprovalRule entity.
+	Refer *object.Refer `path:"resourcerunapprovalrule,default=" query:"-" json:"-"`
+	// ID of the ResourceRunApprovalRule entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the ResourceRunApprovalRule entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+}
+
+// Model returns the ResourceRunApprovalRule entity for querying,
+// after validating.
+func (rrarqi *ResourceRunApprovalRuleQueryInput) Model() *ResourceRunApprovalRule {
+	if rrarqi == nil {
+		return nil
+	}
+
+	return &ResourceRunApprovalRule{
+		ID:   rrarqi.ID,
+		Name: rrarqi.Name,
+	}
+}
+
+// Validate checks the ResourceRunApprovalRuleQueryInput entity.
+func (rrarqi *ResourceRunApprovalRuleQueryInput) Validate() error {
+	if rrarqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrarqi.ValidateWith(rrarqi.inputConfig.Context, rrarqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleQueryInput entity with the given context and client set.
+func (rrarqi *ResourceRunApprovalRuleQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrarqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if rrarqi.Refer != nil && *rrarqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", resourcerunapprovalrule.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceRunApprovalRules().Query()
+
+	// Validate when querying under the Project route.
+	if rrarqi.Project != nil {
+		if err := rrarqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarqi.Project = nil
+				q.Where(
+					resourcerunapprovalrule.ProjectIDIsNil())
+			}
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcerunapprovalrule.ProjectID(rrarqi.Project.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunapprovalrule.ProjectIDIsNil())
+	}
+
+	// Validate when querying under the Environment route.
+	if rrarqi.Environment != nil {
+		if err := rrarqi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarqi.Environment = nil
+				q.Where(
+					resourcerunapprovalrule.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				resourcerunapprovalrule.EnvironmentID(rrarqi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunapprovalrule.EnvironmentIDIsNil())
+	}
+
+	if rrarqi.Refer != nil {
+		if rrarqi.Refer.IsID() {
+			q.Where(
+				resourcerunapprovalrule.ID(rrarqi.Refer.ID()))
+		} else if refers := rrarqi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				resourcerunapprovalrule.Name(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of resourcerunapprovalrule")
+		}
+	} else if rrarqi.ID != "" {
+		q.Where(
+			resourcerunapprovalrule.ID(rrarqi.ID))
+	} else if rrarqi.Name != "" {
+		q.Where(
+			resourcerunapprovalrule.Name(rrarqi.Name))
+	} else {
+		return errors.New("invalid identify of resourcerunapprovalrule")
+	}
+
+	q.Select(
+		resourcerunapprovalrule.FieldID,
+		resourcerunapprovalrule.FieldName,
+	)
+
+	var e *ResourceRunApprovalRule
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ResourceRunApprovalRule)
+		}
+	}
+
+	rrarqi.ID = e.ID
+	rrarqi.Name = e.Name
+	return nil
+}
+
+// ResourceRunApprovalRuleQueryInputs holds the query input of the ResourceRunApprovalRule entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type ResourceRunApprovalRuleQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query ResourceRunApprovalRule entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to query ResourceRunApprovalRule entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Validate checks the ResourceRunApprovalRuleQueryInputs entity.
+func (rrarqi *ResourceRunApprovalRuleQueryInputs) Validate() error {
+	if rrarqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrarqi.ValidateWith(rrarqi.inputConfig.Context, rrarqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleQueryInputs entity with the given context and client set.
+func (rrarqi *ResourceRunApprovalRuleQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrarqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when querying under the Project route.
+	if rrarqi.Project != nil {
+		if err := rrarqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarqi.Project = nil
+			}
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if rrarqi.Environment != nil {
+		if err := rrarqi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarqi.Environment = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunApprovalRuleUpdateInput holds the modification input of the ResourceRunApprovalRule entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunApprovalRuleUpdateInput struct {
+	ResourceRunApprovalRuleQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Type of the environments to which the rule applies, empty means for all types.
+	EnvironmentType string `path:"-" query:"-" json:"environmentType,omitempty"`
+	// Types of the runs which require approvals.
+	RunTypes []string `path:"-" query:"-" json:"runTypes,omitempty"`
+	// Number of the approvals required.
+	Approvals int `path:"-" query:"-" json:"approvals,omitempty"`
+	// Roles which the approvers must hold one of, empty means any subject.
+	Roles []string `path:"-" query:"-" json:"roles,omitempty"`
+}
+
+// Model returns the ResourceRunApprovalRule entity for modifying,
+// after validating.
+func (rrarui *ResourceRunApprovalRuleUpdateInput) Model() *ResourceRunApprovalRule {
+	if rrarui == nil {
+		return nil
+	}
+
+	_rrar := &ResourceRunApprovalRule{
+		ID:              rrarui.ID,
+		Name:            rrarui.Name,
+		Description:     rrarui.Description,
+		Labels:          rrarui.Labels,
+		EnvironmentType: rrarui.EnvironmentType,
+		RunTypes:        rrarui.RunTypes,
+		Approvals:       rrarui.Approvals,
+		Roles:           rrarui.Roles,
+	}
+
+	return _rrar
+}
+
+// Validate checks the ResourceRunApprovalRuleUpdateInput entity.
+func (rrarui *ResourceRunApprovalRuleUpdateInput) Validate() error {
+	if rrarui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrarui.ValidateWith(rrarui.inputConfig.Context, rrarui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleUpdateInput entity with the given context and client set.
+func (rrarui *ResourceRunApprovalRuleUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := rrarui.ResourceRunApprovalRuleQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResourceRunApprovalRuleUpdateInputs holds the modification input item of the ResourceRunApprovalRule entities.
+type ResourceRunApprovalRuleUpdateInputsItem struct {
+	// ID of the ResourceRunApprovalRule entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the ResourceRunApprovalRule entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Type of the environments to which the rule applies, empty means for all types.
+	EnvironmentType string `path:"-" query:"-" json:"environmentType,omitempty"`
+	// Types of the runs which require approvals.
+	RunTypes []string `path:"-" query:"-" json:"runTypes"`
+	// Number of the approvals required.
+	Approvals int `path:"-" query:"-" json:"approvals,cli-table-column"`
+	// Roles which the approvers must hold one of, empty means any subject.
+	Roles []string `path:"-" query:"-" json:"roles,omitempty"`
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleUpdateInputsItem entity with the given context and client set.
+func (rrarui *ResourceRunApprovalRuleUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrarui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceRunApprovalRuleUpdateInputs holds the modification input of the ResourceRunApprovalRule entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunApprovalRuleUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to update ResourceRunApprovalRule entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to update ResourceRunApprovalRule entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceRunApprovalRuleUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceRunApprovalRule entities for modifying,
+// after validating.
+func (rrarui *ResourceRunApprovalRuleUpdateInputs) Model() []*ResourceRunApprovalRule {
+	if rrarui == nil || len(rrarui.Items) == 0 {
+		return nil
+	}
+
+	_rrars := make([]*ResourceRunApprovalRule, len(rrarui.Items))
+
+	for i := range rrarui.Items {
+		_rrar := &ResourceRunApprovalRule{
+			ID:              rrarui.Items[i].ID,
+			Name:            rrarui.Items[i].Name,
+			Description:     rrarui.Items[i].Description,
+			Labels:          rrarui.Items[i].Labels,
+			EnvironmentType: rrarui.Items[i].EnvironmentType,
+			RunTypes:        rrarui.Items[i].RunTypes,
+			Approvals:       rrarui.Items[i].Approvals,
+			Roles:           rrarui.Items[i].Roles,
+		}
+
+		_rrars[i] = _rrar
+	}
+
+	return _rrars
+}
+
+// IDs returns the ID list of the ResourceRunApprovalRule entities for modifying,
+// after validating.
+func (rrarui *ResourceRunApprovalRuleUpdateInputs) IDs() []object.ID {
+	if rrarui == nil || len(rrarui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(rrarui.Items))
+	for i := range rrarui.Items {
+		ids[i] = rrarui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ResourceRunApprovalRuleUpdateInputs entity.
+func (rrarui *ResourceRunApprovalRuleUpdateInputs) Validate() error {
+	if rrarui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrarui.ValidateWith(rrarui.inputConfig.Context, rrarui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunApprovalRuleUpdateInputs entity with the given context and client set.
+func (rrarui *ResourceRunApprovalRuleUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrarui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rrarui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceRunApprovalRules().Query()
+
+	// Validate when updating under the Project route.
+	if rrarui.Project != nil {
+		if err := rrarui.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarui.Project = nil
+				q.Where(
+					resourcerunapprovalrule.ProjectIDIsNil())
+			}
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcerunapprovalrule.ProjectID(rrarui.Project.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunapprovalrule.ProjectIDIsNil())
+	}
+
+	// Validate when updating under the Environment route.
+	if rrarui.Environment != nil {
+		if err := rrarui.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrarui.Environment = nil
+				q.Where(
+					resourcerunapprovalrule.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				resourcerunapprovalrule.EnvironmentID(rrarui.Environment.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunapprovalrule.EnvironmentIDIsNil())
+	}
+
+	ids := make([]object.ID, 0, len(rrarui.Items))
+	ors := make([]predicate.ResourceRunApprovalRule, 0, len(rrarui.Items))
+	indexers := make(map[any][]int)
+
+	for i := range rrarui.Items {
+		if rrarui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if rrarui.Items[i].ID != "" {
+			ids = append(ids, rrarui.Items[i].ID)
+			ors = append(ors, resourcerunapprovalrule.ID(rrarui.Items[i].ID))
+			indexers[rrarui.Items[i].ID] = append(indexers[rrarui.Items[i].ID], i)
+		} else if rrarui.Items[i].Name != "" {
+			ors = append(ors, resourcerunapprovalrule.And(
+				resourcerunapprovalrule.Name(rrarui.Items[i].Name)))
+			indexerKey := fmt.Sprint("/", rrarui.Items[i].Name)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := resourcerunapprovalrule.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = resourcerunapprovalrule.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			resourcerunapprovalrule.FieldID,
+			resourcerunapprovalrule.FieldName,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Name)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			rrarui.Items[j].ID = es[i].ID
+			rrarui.Items[j].Name = es[i].Name
+		}
+	}
+
+	for i := range rrarui.Items {
+		if err := rrarui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunApprovalRuleOutput holds the output of the ResourceRunApprovalRule entity.
+type ResourceRunApprovalRuleOutput struct {
+	ID              object.ID         `json:"id,omitempty"`
+	Name            string            `json:"name,omitempty"`
+	Description     string            `json:"description,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	CreateTime      *time.Time        `json:"createTime,omitempty"`
+	UpdateTime      *time.Time        `json:"updateTime,omitempty"`
+	EnvironmentType string            `json:"environmentType,omitempty"`
+	RunTypes        []string          `json:"runTypes,omitempty"`
+	Approvals       int               `json:"approvals,cli-table-column,omitempty"`
+	Roles           []string          `json:"roles,omitempty"`
+
+	Project     *ProjectOutput     `json:"project,omitempty"`
+	Environment *EnvironmentOutput `json:"environment,omitempty"`
+}
+
+// View returns the output of ResourceRunApprovalRule entity.
+func (_rrar *ResourceRunApprovalRule) View() *ResourceRunApprovalRuleOutput {
+	return ExposeResourceRunApprovalRule(_rrar)
+}
+
+// View returns the output of ResourceRunApprovalRule entities.
+func (_rrars ResourceRunApprovalRules) View() []*ResourceRunApprovalRuleOutput {
+	return ExposeResourceRunApprovalRules(_rrars)
+}
+
+// ExposeResourceRunApprovalRule converts the ResourceRunApprovalRule to ResourceRunApprovalRuleOutput.
+func ExposeResourceRunApprovalRule(_rrar *ResourceRunApprovalRule) *ResourceRunApprovalRuleOutput {
+	if _rrar == nil {
+		return nil
+	}
+
+	rraro := &ResourceRunApprovalRuleOutput{
+		ID:              _rrar.ID,
+		Name:            _rrar.Name,
+		Description:     _rrar.Description,
+		Labels:          _rrar.Labels,
+		CreateTime:      _rrar.CreateTime,
+		UpdateTime:      _rrar.UpdateTime,
+		EnvironmentType: _rrar.EnvironmentType,
+		RunTypes:        _rrar.RunTypes,
+		Approvals:       _rrar.Approvals,
+		Roles:           _rrar.Roles,
+	}
+
+	if _rrar.Edges.Project != nil {
+		rraro.Project = ExposeProject(_rrar.Edges.Project)
+	} else if _rrar.ProjectID != "" {
+		rraro.Project = &ProjectOutput{
+			ID: _rrar.ProjectID,
+		}
+	}
+	if _rrar.Edges.Environment != nil {
+		rraro.Environment = ExposeEnvironment(_rrar.Edges.Environment)
+	} else if _rrar.EnvironmentID != "" {
+		rraro.Environment = &EnvironmentOutput{
+			ID: _rrar.EnvironmentID,
+		}
+	}
+	return rraro
+}
+
+// ExposeResourceRunApprovalRules converts the ResourceRunApprovalRule slice to ResourceRunApprovalRuleOutput pointer slice.
+func ExposeResourceRunApprovalRules(_rrars []*ResourceRunApprovalRule) []*ResourceRunApprovalRuleOutput {
+	if len(_rrars) == 0 {
+		return nil
+	}
+
+	rraros := make([]*ResourceRunApprovalRuleOutput, len(_rrars))
+	for i := range _rrars {
+		rraros[i] = ExposeResourceRunApprovalRule(_rrars[i])
+	}
+	return rraros
+}
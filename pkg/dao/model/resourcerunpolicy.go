@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ResourceRunPolicy is the model entity for the ResourceRunPolicy schema.
+type ResourceRunPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `json:"labels,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// ID of the project to belong.
+	ProjectID object.ID `json:"project_id,omitempty"`
+	// ID of the environment to which the policy attaches, empty means for the whole project.
+	EnvironmentID object.ID `json:"environment_id,omitempty"`
+	// Enforcement of the policy, deny blocks the run from applying, warn annotates the run only.
+	Enforcement string `json:"enforcement,omitempty,cli-table-column"`
+	// Expression of the policy, evaluates the plan of the run, returns true or a non-empty list of violation messages if violated.
+	Expression string `json:"expression,omitempty"`
+	// Message to show if the policy is violated.
+	Message string `json:"message,omitempty"`
+	// Indicate whether the policy is disabled.
+	Disabled bool `json:"disabled,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResourceRunPolicyQuery when eager-loading is set.
+	Edges        ResourceRunPolicyEdges `json:"edges,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// ResourceRunPolicyEdges holds the relations/edges for other nodes in the graph.
+type ResourceRunPolicyEdges struct {
+	// Project to which the policy belongs.
+	Project *Project `json:"project,omitempty"`
+	// Environment to which the policy attaches.
+	Environment *Environment `json:"environment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceRunPolicyEdges) ProjectOrErr() (*Project, error) {
+	if e.loadedTypes[0] {
+		if e.Project == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: project.Label}
+		}
+		return e.Project, nil
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// EnvironmentOrErr returns the Environment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceRunPolicyEdges) EnvironmentOrErr() (*Environment, error) {
+	if e.loadedTypes[1] {
+		if e.Environment == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: environment.Label}
+		}
+		return e.Environment, nil
+	}
+	return nil, &NotLoadedError{edge: "environment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResourceRunPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resourcerunpolicy.FieldLabels:
+			values[i] = new([]byte)
+		case resourcerunpolicy.FieldID, resourcerunpolicy.FieldProjectID, resourcerunpolicy.FieldEnvironmentID:
+			values[i] = new(object.ID)
+		case resourcerunpolicy.FieldDisabled:
+			values[i] = new(sql.NullBool)
+		case resourcerunpolicy.FieldName, resourcerunpolicy.FieldDescription, resourcerunpolicy.FieldEnforcement, resourcerunpolicy.FieldExpression, resourcerunpolicy.FieldMessage:
+			values[i] = new(sql.NullString)
+		case resourcerunpolicy.FieldCreateTime, resourcerunpolicy.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResourceRunPolicy fields.
+func (rrp *ResourceRunPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resourcerunpolicy.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rrp.ID = *value
+			}
+		case resourcerunpolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				rrp.Name = value.String
+			}
+		case resourcerunpolicy.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				rrp.Description = value.String
+			}
+		case resourcerunpolicy.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rrp.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case resourcerunpolicy.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				rrp.CreateTime = new(time.Time)
+				*rrp.CreateTime = value.Time
+			}
+		case resourcerunpolicy.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				rrp.UpdateTime = new(time.Time)
+				*rrp.UpdateTime = value.Time
+			}
+		case resourcerunpolicy.FieldProjectID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				rrp.ProjectID = *value
+			}
+		case resourcerunpolicy.FieldEnvironmentID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value != nil {
+				rrp.EnvironmentID = *value
+			}
+		case resourcerunpolicy.FieldEnforcement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field enforcement", values[i])
+			} else if value.Valid {
+				rrp.Enforcement = value.String
+			}
+		case resourcerunpolicy.FieldExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expression", values[i])
+			} else if value.Valid {
+				rrp.Expression = value.String
+			}
+		case resourcerunpolicy.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				rrp.Message = value.String
+			}
+		case resourcerunpolicy.FieldDisabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled", values[i])
+			} else if value.Valid {
+				rrp.Disabled = value.Bool
+			}
+		default:
+			rrp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResourceRunPolicy.
+// This includes values selected through modifiers, order, etc.
+func (rrp *ResourceRunPolicy) Value(name string) (ent.Value, error) {
+	return rrp.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the ResourceRunPolicy entity.
+func (rrp *ResourceRunPolicy) QueryProject() *ProjectQuery {
+	return NewResourceRunPolicyClient(rrp.config).QueryProject(rrp)
+}
+
+// QueryEnvironment queries the "environment" edge of the ResourceRunPolicy entity.
+func (rrp *ResourceRunPolicy) QueryEnvironment() *EnvironmentQuery {
+	return NewResourceRunPolicyClient(rrp.config).QueryEnvironment(rrp)
+}
+
+// Update returns a builder for updating this ResourceRunPolicy.
+// Note that you need to call ResourceRunPolicy.Unwrap() before calling this method if this ResourceRunPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rrp *ResourceRunPolicy) Update() *ResourceRunPolicyUpdateOne {
+	return NewResourceRunPolicyClient(rrp.config).UpdateOne(rrp)
+}
+
+// Unwrap unwraps the ResourceRunPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rrp *ResourceRunPolicy) Unwrap() *ResourceRunPolicy {
+	_tx, ok := rrp.config.driver.(*txDriver)
+	if !ok {
+		panic("model: ResourceRunPolicy is not a transactional entity")
+	}
+	rrp.config.driver = _tx.drv
+	return rrp
+}
+
+// String implements the fmt.Stringer.
+func (rrp *ResourceRunPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResourceRunPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rrp.ID))
+	builder.WriteString("name=")
+	builder.WriteString(rrp.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(rrp.Description)
+	builder.WriteString(", ")
+	builder.WriteString("labels=")
+	builder.WriteString(fmt.Sprintf("%v", rrp.Labels))
+	builder.WriteString(", ")
+	if v := rrp.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := rrp.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", rrp.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", rrp.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("enforcement=")
+	builder.WriteString(rrp.Enforcement)
+	builder.WriteString(", ")
+	builder.WriteString("expression=")
+	builder.WriteString(rrp.Expression)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(rrp.Message)
+	builder.WriteString(", ")
+	builder.WriteString("disabled=")
+	builder.WriteString(fmt.Sprintf("%v", rrp.Disabled))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResourceRunPolicies is a parsable slice of ResourceRunPolicy.
+type ResourceRunPolicies []*ResourceRunPolicy
@@ -0,0 +1,780 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package resourcerunpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldProjectID, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// Enforcement applies equality check predicate on the "enforcement" field. It's identical to EnforcementEQ.
+func Enforcement(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldEnforcement, v))
+}
+
+// Expression applies equality check predicate on the "expression" field. It's identical to ExpressionEQ.
+func Expression(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldExpression, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldMessage, v))
+}
+
+// Disabled applies equality check predicate on the "disabled" field. It's identical to DisabledEQ.
+func Disabled(v bool) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldDisabled, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// LabelsIsNil applies the IsNil predicate on the "labels" field.
+func LabelsIsNil() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIsNull(FieldLabels))
+}
+
+// LabelsNotNil applies the NotNil predicate on the "labels" field.
+func LabelsNotNil() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotNull(FieldLabels))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDContains applies the Contains predicate on the "project_id" field.
+func ProjectIDContains(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldContains(FieldProjectID, vc))
+}
+
+// ProjectIDHasPrefix applies the HasPrefix predicate on the "project_id" field.
+func ProjectIDHasPrefix(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldHasPrefix(FieldProjectID, vc))
+}
+
+// ProjectIDHasSuffix applies the HasSuffix predicate on the "project_id" field.
+func ProjectIDHasSuffix(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldHasSuffix(FieldProjectID, vc))
+}
+
+// ProjectIDEqualFold applies the EqualFold predicate on the "project_id" field.
+func ProjectIDEqualFold(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldEqualFold(FieldProjectID, vc))
+}
+
+// ProjectIDContainsFold applies the ContainsFold predicate on the "project_id" field.
+func ProjectIDContainsFold(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldContainsFold(FieldProjectID, vc))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v object.ID) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldContains(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldHasPrefix(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldHasSuffix(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDIsNil applies the IsNil predicate on the "environment_id" field.
+func EnvironmentIDIsNil() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIsNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDNotNil applies the NotNil predicate on the "environment_id" field.
+func EnvironmentIDNotNil() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotNull(FieldEnvironmentID))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldEqualFold(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v object.ID) predicate.ResourceRunPolicy {
+	vc := string(v)
+	return predicate.ResourceRunPolicy(sql.FieldContainsFold(FieldEnvironmentID, vc))
+}
+
+// EnforcementEQ applies the EQ predicate on the "enforcement" field.
+func EnforcementEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldEnforcement, v))
+}
+
+// EnforcementNEQ applies the NEQ predicate on the "enforcement" field.
+func EnforcementNEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldEnforcement, v))
+}
+
+// EnforcementIn applies the In predicate on the "enforcement" field.
+func EnforcementIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldEnforcement, vs...))
+}
+
+// EnforcementNotIn applies the NotIn predicate on the "enforcement" field.
+func EnforcementNotIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldEnforcement, vs...))
+}
+
+// EnforcementGT applies the GT predicate on the "enforcement" field.
+func EnforcementGT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldEnforcement, v))
+}
+
+// EnforcementGTE applies the GTE predicate on the "enforcement" field.
+func EnforcementGTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldEnforcement, v))
+}
+
+// EnforcementLT applies the LT predicate on the "enforcement" field.
+func EnforcementLT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldEnforcement, v))
+}
+
+// EnforcementLTE applies the LTE predicate on the "enforcement" field.
+func EnforcementLTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldEnforcement, v))
+}
+
+// EnforcementContains applies the Contains predicate on the "enforcement" field.
+func EnforcementContains(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContains(FieldEnforcement, v))
+}
+
+// EnforcementHasPrefix applies the HasPrefix predicate on the "enforcement" field.
+func EnforcementHasPrefix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasPrefix(FieldEnforcement, v))
+}
+
+// EnforcementHasSuffix applies the HasSuffix predicate on the "enforcement" field.
+func EnforcementHasSuffix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasSuffix(FieldEnforcement, v))
+}
+
+// EnforcementEqualFold applies the EqualFold predicate on the "enforcement" field.
+func EnforcementEqualFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEqualFold(FieldEnforcement, v))
+}
+
+// EnforcementContainsFold applies the ContainsFold predicate on the "enforcement" field.
+func EnforcementContainsFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContainsFold(FieldEnforcement, v))
+}
+
+// ExpressionEQ applies the EQ predicate on the "expression" field.
+func ExpressionEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldExpression, v))
+}
+
+// ExpressionNEQ applies the NEQ predicate on the "expression" field.
+func ExpressionNEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldExpression, v))
+}
+
+// ExpressionIn applies the In predicate on the "expression" field.
+func ExpressionIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldExpression, vs...))
+}
+
+// ExpressionNotIn applies the NotIn predicate on the "expression" field.
+func ExpressionNotIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldExpression, vs...))
+}
+
+// ExpressionGT applies the GT predicate on the "expression" field.
+func ExpressionGT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldExpression, v))
+}
+
+// ExpressionGTE applies the GTE predicate on the "expression" field.
+func ExpressionGTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldExpression, v))
+}
+
+// ExpressionLT applies the LT predicate on the "expression" field.
+func ExpressionLT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldExpression, v))
+}
+
+// ExpressionLTE applies the LTE predicate on the "expression" field.
+func ExpressionLTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldExpression, v))
+}
+
+// ExpressionContains applies the Contains predicate on the "expression" field.
+func ExpressionContains(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContains(FieldExpression, v))
+}
+
+// ExpressionHasPrefix applies the HasPrefix predicate on the "expression" field.
+func ExpressionHasPrefix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasPrefix(FieldExpression, v))
+}
+
+// ExpressionHasSuffix applies the HasSuffix predicate on the "expression" field.
+func ExpressionHasSuffix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasSuffix(FieldExpression, v))
+}
+
+// ExpressionEqualFold applies the EqualFold predicate on the "expression" field.
+func ExpressionEqualFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEqualFold(FieldExpression, v))
+}
+
+// ExpressionContainsFold applies the ContainsFold predicate on the "expression" field.
+func ExpressionContainsFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContainsFold(FieldExpression, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// DisabledEQ applies the EQ predicate on the "disabled" field.
+func DisabledEQ(v bool) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldEQ(FieldDisabled, v))
+}
+
+// DisabledNEQ applies the NEQ predicate on the "disabled" field.
+func DisabledNEQ(v bool) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.FieldNEQ(FieldDisabled, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(func(s *sql.Selector) {
+		step := newProjectStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnvironment applies the HasEdge predicate on the "environment" edge.
+func HasEnvironment() predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EnvironmentTable, EnvironmentColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvironmentWith applies the HasEdge predicate on the "environment" edge with a given conditions (other predicates).
+func HasEnvironmentWith(preds ...predicate.Environment) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(func(s *sql.Selector) {
+		step := newEnvironmentStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResourceRunPolicy) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResourceRunPolicy) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResourceRunPolicy) predicate.ResourceRunPolicy {
+	return predicate.ResourceRunPolicy(sql.NotPredicates(p))
+}
@@ -0,0 +1,1425 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceRunPolicyCreate is the builder for creating a ResourceRunPolicy entity.
+type ResourceRunPolicyCreate struct {
+	config
+	mutation   *ResourceRunPolicyMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *ResourceRunPolicy
+	fromUpsert bool
+}
+
+// SetName sets the "name" field.
+func (rrpc *ResourceRunPolicyCreate) SetName(s string) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetName(s)
+	return rrpc
+}
+
+// SetDescription sets the "description" field.
+func (rrpc *ResourceRunPolicyCreate) SetDescription(s string) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetDescription(s)
+	return rrpc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rrpc *ResourceRunPolicyCreate) SetNillableDescription(s *string) *ResourceRunPolicyCreate {
+	if s != nil {
+		rrpc.SetDescription(*s)
+	}
+	return rrpc
+}
+
+// SetLabels sets the "labels" field.
+func (rrpc *ResourceRunPolicyCreate) SetLabels(m map[string]string) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetLabels(m)
+	return rrpc
+}
+
+// SetCreateTime sets the "create_time" field.
+func (rrpc *ResourceRunPolicyCreate) SetCreateTime(t time.Time) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetCreateTime(t)
+	return rrpc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (rrpc *ResourceRunPolicyCreate) SetNillableCreateTime(t *time.Time) *ResourceRunPolicyCreate {
+	if t != nil {
+		rrpc.SetCreateTime(*t)
+	}
+	return rrpc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rrpc *ResourceRunPolicyCreate) SetUpdateTime(t time.Time) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetUpdateTime(t)
+	return rrpc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (rrpc *ResourceRunPolicyCreate) SetNillableUpdateTime(t *time.Time) *ResourceRunPolicyCreate {
+	if t != nil {
+		rrpc.SetUpdateTime(*t)
+	}
+	return rrpc
+}
+
+// SetProjectID sets the "project_id" field.
+func (rrpc *ResourceRunPolicyCreate) SetProjectID(o object.ID) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetProjectID(o)
+	return rrpc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (rrpc *ResourceRunPolicyCreate) SetEnvironmentID(o object.ID) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetEnvironmentID(o)
+	return rrpc
+}
+
+// SetNillableEnvironmentID sets the "environment_id" field if the given value is not nil.
+func (rrpc *ResourceRunPolicyCreate) SetNillableEnvironmentID(o *object.ID) *ResourceRunPolicyCreate {
+	if o != nil {
+		rrpc.SetEnvironmentID(*o)
+	}
+	return rrpc
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (rrpc *ResourceRunPolicyCreate) SetEnforcement(s string) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetEnforcement(s)
+	return rrpc
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (rrpc *ResourceRunPolicyCreate) SetNillableEnforcement(s *string) *ResourceRunPolicyCreate {
+	if s != nil {
+		rrpc.SetEnforcement(*s)
+	}
+	return rrpc
+}
+
+// SetExpression sets the "expression" field.
+func (rrpc *ResourceRunPolicyCreate) SetExpression(s string) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetExpression(s)
+	return rrpc
+}
+
+// SetMessage sets the "message" field.
+func (rrpc *ResourceRunPolicyCreate) SetMessage(s string) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetMessage(s)
+	return rrpc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rrpc *ResourceRunPolicyCreate) SetNillableMessage(s *string) *ResourceRunPolicyCreate {
+	if s != nil {
+		rrpc.SetMessage(*s)
+	}
+	return rrpc
+}
+
+// SetDisabled sets the "disabled" field.
+func (rrpc *ResourceRunPolicyCreate) SetDisabled(b bool) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetDisabled(b)
+	return rrpc
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (rrpc *ResourceRunPolicyCreate) SetNillableDisabled(b *bool) *ResourceRunPolicyCreate {
+	if b != nil {
+		rrpc.SetDisabled(*b)
+	}
+	return rrpc
+}
+
+// SetID sets the "id" field.
+func (rrpc *ResourceRunPolicyCreate) SetID(o object.ID) *ResourceRunPolicyCreate {
+	rrpc.mutation.SetID(o)
+	return rrpc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (rrpc *ResourceRunPolicyCreate) SetProject(p *Project) *ResourceRunPolicyCreate {
+	return rrpc.SetProjectID(p.ID)
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (rrpc *ResourceRunPolicyCreate) SetEnvironment(e *Environment) *ResourceRunPolicyCreate {
+	return rrpc.SetEnvironmentID(e.ID)
+}
+
+// Mutation returns the ResourceRunPolicyMutation object of the builder.
+func (rrpc *ResourceRunPolicyCreate) Mutation() *ResourceRunPolicyMutation {
+	return rrpc.mutation
+}
+
+// Save creates the ResourceRunPolicy in the database.
+func (rrpc *ResourceRunPolicyCreate) Save(ctx context.Context) (*ResourceRunPolicy, error) {
+	if err := rrpc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rrpc.sqlSave, rrpc.mutation, rrpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrpc *ResourceRunPolicyCreate) SaveX(ctx context.Context) *ResourceRunPolicy {
+	v, err := rrpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrpc *ResourceRunPolicyCreate) Exec(ctx context.Context) error {
+	_, err := rrpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrpc *ResourceRunPolicyCreate) ExecX(ctx context.Context) {
+	if err := rrpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrpc *ResourceRunPolicyCreate) defaults() error {
+	if _, ok := rrpc.mutation.Labels(); !ok {
+		v := resourcerunpolicy.DefaultLabels
+		rrpc.mutation.SetLabels(v)
+	}
+	if _, ok := rrpc.mutation.CreateTime(); !ok {
+		if resourcerunpolicy.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcerunpolicy.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := resourcerunpolicy.DefaultCreateTime()
+		rrpc.mutation.SetCreateTime(v)
+	}
+	if _, ok := rrpc.mutation.UpdateTime(); !ok {
+		if resourcerunpolicy.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcerunpolicy.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := resourcerunpolicy.DefaultUpdateTime()
+		rrpc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := rrpc.mutation.Enforcement(); !ok {
+		v := resourcerunpolicy.DefaultEnforcement
+		rrpc.mutation.SetEnforcement(v)
+	}
+	if _, ok := rrpc.mutation.Disabled(); !ok {
+		v := resourcerunpolicy.DefaultDisabled
+		rrpc.mutation.SetDisabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrpc *ResourceRunPolicyCreate) check() error {
+	if _, ok := rrpc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`model: missing required field "ResourceRunPolicy.name"`)}
+	}
+	if v, ok := rrpc.mutation.Name(); ok {
+		if err := resourcerunpolicy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`model: validator failed for field "ResourceRunPolicy.name": %w`, err)}
+		}
+	}
+	if _, ok := rrpc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "ResourceRunPolicy.create_time"`)}
+	}
+	if _, ok := rrpc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "ResourceRunPolicy.update_time"`)}
+	}
+	if _, ok := rrpc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`model: missing required field "ResourceRunPolicy.project_id"`)}
+	}
+	if v, ok := rrpc.mutation.ProjectID(); ok {
+		if err := resourcerunpolicy.ProjectIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "project_id", err: fmt.Errorf(`model: validator failed for field "ResourceRunPolicy.project_id": %w`, err)}
+		}
+	}
+	if _, ok := rrpc.mutation.Enforcement(); !ok {
+		return &ValidationError{Name: "enforcement", err: errors.New(`model: missing required field "ResourceRunPolicy.enforcement"`)}
+	}
+	if v, ok := rrpc.mutation.Enforcement(); ok {
+		if err := resourcerunpolicy.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`model: validator failed for field "ResourceRunPolicy.enforcement": %w`, err)}
+		}
+	}
+	if _, ok := rrpc.mutation.Expression(); !ok {
+		return &ValidationError{Name: "expression", err: errors.New(`model: missing required field "ResourceRunPolicy.expression"`)}
+	}
+	if v, ok := rrpc.mutation.Expression(); ok {
+		if err := resourcerunpolicy.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`model: validator failed for field "ResourceRunPolicy.expression": %w`, err)}
+		}
+	}
+	if _, ok := rrpc.mutation.Disabled(); !ok {
+		return &ValidationError{Name: "disabled", err: errors.New(`model: missing required field "ResourceRunPolicy.disabled"`)}
+	}
+	if _, ok := rrpc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project", err: errors.New(`model: missing required edge "ResourceRunPolicy.project"`)}
+	}
+	return nil
+}
+
+func (rrpc *ResourceRunPolicyCreate) sqlSave(ctx context.Context) (*ResourceRunPolicy, error) {
+	if err := rrpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rrpc.mutation.id = &_node.ID
+	rrpc.mutation.done = true
+	return _node, nil
+}
+
+func (rrpc *ResourceRunPolicyCreate) createSpec() (*ResourceRunPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResourceRunPolicy{config: rrpc.config}
+		_spec = sqlgraph.NewCreateSpec(resourcerunpolicy.Table, sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString))
+	)
+	_spec.Schema = rrpc.schemaConfig.ResourceRunPolicy
+	_spec.OnConflict = rrpc.conflict
+	if id, ok := rrpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rrpc.mutation.Name(); ok {
+		_spec.SetField(resourcerunpolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := rrpc.mutation.Description(); ok {
+		_spec.SetField(resourcerunpolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := rrpc.mutation.Labels(); ok {
+		_spec.SetField(resourcerunpolicy.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := rrpc.mutation.CreateTime(); ok {
+		_spec.SetField(resourcerunpolicy.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := rrpc.mutation.UpdateTime(); ok {
+		_spec.SetField(resourcerunpolicy.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := rrpc.mutation.Enforcement(); ok {
+		_spec.SetField(resourcerunpolicy.FieldEnforcement, field.TypeString, value)
+		_node.Enforcement = value
+	}
+	if value, ok := rrpc.mutation.Expression(); ok {
+		_spec.SetField(resourcerunpolicy.FieldExpression, field.TypeString, value)
+		_node.Expression = value
+	}
+	if value, ok := rrpc.mutation.Message(); ok {
+		_spec.SetField(resourcerunpolicy.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := rrpc.mutation.Disabled(); ok {
+		_spec.SetField(resourcerunpolicy.FieldDisabled, field.TypeBool, value)
+		_node.Disabled = value
+	}
+	if nodes := rrpc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resourcerunpolicy.ProjectTable,
+			Columns: []string{resourcerunpolicy.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rrpc.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rrpc.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resourcerunpolicy.EnvironmentTable,
+			Columns: []string{resourcerunpolicy.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rrpc.schemaConfig.ResourceRunPolicy
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rrpc *ResourceRunPolicyCreate) Set(obj *ResourceRunPolicy) *ResourceRunPolicyCreate {
+	// Required.
+	rrpc.SetName(obj.Name)
+	rrpc.SetProjectID(obj.ProjectID)
+	rrpc.SetEnforcement(obj.Enforcement)
+	rrpc.SetExpression(obj.Expression)
+	rrpc.SetDisabled(obj.Disabled)
+
+	// Optional.
+	if obj.Description != "" {
+		rrpc.SetDescription(obj.Description)
+	}
+	if !reflect.ValueOf(obj.Labels).IsZero() {
+		rrpc.SetLabels(obj.Labels)
+	}
+	if obj.CreateTime != nil {
+		rrpc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		rrpc.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.EnvironmentID != "" {
+		rrpc.SetEnvironmentID(obj.EnvironmentID)
+	}
+	if obj.Message != "" {
+		rrpc.SetMessage(obj.Message)
+	}
+
+	// Record the given object.
+	rrpc.object = obj
+
+	return rrpc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rrpc *ResourceRunPolicyCreate) getClientSet() (mc ClientSet) {
+	if _, ok := rrpc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rrpc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rrpc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ResourceRunPolicy entity,
+// which is always good for cascading create operations.
+func (rrpc *ResourceRunPolicyCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunPolicy) error) (*ResourceRunPolicy, error) {
+	obj, err := rrpc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := rrpc.getClientSet()
+	if rrpc.fromUpsert {
+		q := mc.ResourceRunPolicies().Query().
+			Where(
+				resourcerunpolicy.ProjectID(obj.ProjectID),
+				resourcerunpolicy.Name(obj.Name),
+			)
+		if obj.EnvironmentID != "" {
+			q.Where(resourcerunpolicy.EnvironmentID(obj.EnvironmentID))
+		} else {
+			q.Where(resourcerunpolicy.EnvironmentIDIsNil())
+		}
+		obj.ID, err = q.OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("model: failed to query id of ResourceRunPolicy entity: %w", err)
+		}
+	}
+
+	if x := rrpc.object; x != nil {
+		if _, set := rrpc.mutation.Field(resourcerunpolicy.FieldName); set {
+			obj.Name = x.Name
+		}
+		if _, set := rrpc.mutation.Field(resourcerunpolicy.FieldDescription); set {
+			obj.Description = x.Description
+		}
+		if _, set := rrpc.mutation.Field(resourcerunpolicy.FieldProjectID); set {
+			obj.ProjectID = x.ProjectID
+		}
+		if _, set := rrpc.mutation.Field(resourcerunpolicy.FieldEnvironmentID); set {
+			obj.EnvironmentID = x.EnvironmentID
+		}
+		if _, set := rrpc.mutation.Field(resourcerunpolicy.FieldExpression); set {
+			obj.Expression = x.Expression
+		}
+		if _, set := rrpc.mutation.Field(resourcerunpolicy.FieldMessage); set {
+			obj.Message = x.Message
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rrpc *ResourceRunPolicyCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunPolicy) error) *ResourceRunPolicy {
+	obj, err := rrpc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (rrpc *ResourceRunPolicyCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunPolicy) error) error {
+	_, err := rrpc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (rrpc *ResourceRunPolicyCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunPolicy) error) {
+	if err := rrpc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the ResourceRunPolicyCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rrpcb *ResourceRunPolicyCreateBulk) Set(objs ...*ResourceRunPolicy) *ResourceRunPolicyCreateBulk {
+	if len(objs) != 0 {
+		client := NewResourceRunPolicyClient(rrpcb.config)
+
+		rrpcb.builders = make([]*ResourceRunPolicyCreate, len(objs))
+		for i := range objs {
+			rrpcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		rrpcb.objects = objs
+	}
+
+	return rrpcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rrpcb *ResourceRunPolicyCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := rrpcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rrpcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rrpcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ResourceRunPolicy entities,
+// which is always good for cascading create operations.
+func (rrpcb *ResourceRunPolicyCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunPolicy) error) ([]*ResourceRunPolicy, error) {
+	objs, err := rrpcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := rrpcb.getClientSet()
+	if rrpcb.fromUpsert {
+		for i := range objs {
+			obj := objs[i]
+			q := mc.ResourceRunPolicies().Query().
+				Where(
+					resourcerunpolicy.ProjectID(obj.ProjectID),
+					resourcerunpolicy.Name(obj.Name),
+				)
+			if obj.EnvironmentID != "" {
+				q.Where(resourcerunpolicy.EnvironmentID(obj.EnvironmentID))
+			} else {
+				q.Where(resourcerunpolicy.EnvironmentIDIsNil())
+			}
+			objs[i].ID, err = q.OnlyID(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("model: failed to query id of ResourceRunPolicy entity: %w", err)
+			}
+		}
+	}
+
+	if x := rrpcb.objects; x != nil {
+		for i := range x {
+			if _, set := rrpcb.builders[i].mutation.Field(resourcerunpolicy.FieldName); set {
+				objs[i].Name = x[i].Name
+			}
+			if _, set := rrpcb.builders[i].mutation.Field(resourcerunpolicy.FieldDescription); set {
+				objs[i].Description = x[i].Description
+			}
+			if _, set := rrpcb.builders[i].mutation.Field(resourcerunpolicy.FieldProjectID); set {
+				objs[i].ProjectID = x[i].ProjectID
+			}
+			if _, set := rrpcb.builders[i].mutation.Field(resourcerunpolicy.FieldEnvironmentID); set {
+				objs[i].EnvironmentID = x[i].EnvironmentID
+			}
+			if _, set := rrpcb.builders[i].mutation.Field(resourcerunpolicy.FieldExpression); set {
+				objs[i].Expression = x[i].Expression
+			}
+			if _, set := rrpcb.builders[i].mutation.Field(resourcerunpolicy.FieldMessage); set {
+				objs[i].Message = x[i].Message
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rrpcb *ResourceRunPolicyCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunPolicy) error) []*ResourceRunPolicy {
+	objs, err := rrpcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (rrpcb *ResourceRunPolicyCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunPolicy) error) error {
+	_, err := rrpcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (rrpcb *ResourceRunPolicyCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceRunPolicy) error) {
+	if err := rrpcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ResourceRunPolicyUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunPolicy) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceRunPolicyUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ResourceRunPolicyUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunPolicy) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ResourceRunPolicyUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunPolicy) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ResourceRunPolicyUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceRunPolicyUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ResourceRunPolicyUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunPolicy) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceRunPolicy.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceRunPolicyUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rrpc *ResourceRunPolicyCreate) OnConflict(opts ...sql.ConflictOption) *ResourceRunPolicyUpsertOne {
+	rrpc.conflict = opts
+	return &ResourceRunPolicyUpsertOne{
+		create: rrpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceRunPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrpc *ResourceRunPolicyCreate) OnConflictColumns(columns ...string) *ResourceRunPolicyUpsertOne {
+	rrpc.conflict = append(rrpc.conflict, sql.ConflictColumns(columns...))
+	return &ResourceRunPolicyUpsertOne{
+		create: rrpc,
+	}
+}
+
+type (
+	// ResourceRunPolicyUpsertOne is the builder for "upsert"-ing
+	//  one ResourceRunPolicy node.
+	ResourceRunPolicyUpsertOne struct {
+		create *ResourceRunPolicyCreate
+	}
+
+	// ResourceRunPolicyUpsert is the "OnConflict" setter.
+	ResourceRunPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *ResourceRunPolicyUpsert) SetDescription(v string) *ResourceRunPolicyUpsert {
+	u.Set(resourcerunpolicy.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsert) UpdateDescription() *ResourceRunPolicyUpsert {
+	u.SetExcluded(resourcerunpolicy.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ResourceRunPolicyUpsert) ClearDescription() *ResourceRunPolicyUpsert {
+	u.SetNull(resourcerunpolicy.FieldDescription)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *ResourceRunPolicyUpsert) SetLabels(v map[string]string) *ResourceRunPolicyUpsert {
+	u.Set(resourcerunpolicy.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsert) UpdateLabels() *ResourceRunPolicyUpsert {
+	u.SetExcluded(resourcerunpolicy.FieldLabels)
+	return u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *ResourceRunPolicyUpsert) ClearLabels() *ResourceRunPolicyUpsert {
+	u.SetNull(resourcerunpolicy.FieldLabels)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ResourceRunPolicyUpsert) SetUpdateTime(v time.Time) *ResourceRunPolicyUpsert {
+	u.Set(resourcerunpolicy.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsert) UpdateUpdateTime() *ResourceRunPolicyUpsert {
+	u.SetExcluded(resourcerunpolicy.FieldUpdateTime)
+	return u
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *ResourceRunPolicyUpsert) SetEnforcement(v string) *ResourceRunPolicyUpsert {
+	u.Set(resourcerunpolicy.FieldEnforcement, v)
+	return u
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsert) UpdateEnforcement() *ResourceRunPolicyUpsert {
+	u.SetExcluded(resourcerunpolicy.FieldEnforcement)
+	return u
+}
+
+// SetExpression sets the "expression" field.
+func (u *ResourceRunPolicyUpsert) SetExpression(v string) *ResourceRunPolicyUpsert {
+	u.Set(resourcerunpolicy.FieldExpression, v)
+	return u
+}
+
+// UpdateExpression sets the "expression" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsert) UpdateExpression() *ResourceRunPolicyUpsert {
+	u.SetExcluded(resourcerunpolicy.FieldExpression)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *ResourceRunPolicyUpsert) SetMessage(v string) *ResourceRunPolicyUpsert {
+	u.Set(resourcerunpolicy.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsert) UpdateMessage() *ResourceRunPolicyUpsert {
+	u.SetExcluded(resourcerunpolicy.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ResourceRunPolicyUpsert) ClearMessage() *ResourceRunPolicyUpsert {
+	u.SetNull(resourcerunpolicy.FieldMessage)
+	return u
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *ResourceRunPolicyUpsert) SetDisabled(v bool) *ResourceRunPolicyUpsert {
+	u.Set(resourcerunpolicy.FieldDisabled, v)
+	return u
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsert) UpdateDisabled() *ResourceRunPolicyUpsert {
+	u.SetExcluded(resourcerunpolicy.FieldDisabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ResourceRunPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resourcerunpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResourceRunPolicyUpsertOne) UpdateNewValues() *ResourceRunPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(resourcerunpolicy.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(resourcerunpolicy.FieldName)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(resourcerunpolicy.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(resourcerunpolicy.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.EnvironmentID(); exists {
+			s.SetIgnore(resourcerunpolicy.FieldEnvironmentID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceRunPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResourceRunPolicyUpsertOne) Ignore() *ResourceRunPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceRunPolicyUpsertOne) DoNothing() *ResourceRunPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceRunPolicyCreate.OnConflict
+// documentation for more info.
+func (u *ResourceRunPolicyUpsertOne) Update(set func(*ResourceRunPolicyUpsert)) *ResourceRunPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceRunPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ResourceRunPolicyUpsertOne) SetDescription(v string) *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertOne) UpdateDescription() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ResourceRunPolicyUpsertOne) ClearDescription() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *ResourceRunPolicyUpsertOne) SetLabels(v map[string]string) *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertOne) UpdateLabels() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *ResourceRunPolicyUpsertOne) ClearLabels() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ResourceRunPolicyUpsertOne) SetUpdateTime(v time.Time) *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertOne) UpdateUpdateTime() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *ResourceRunPolicyUpsertOne) SetEnforcement(v string) *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetEnforcement(v)
+	})
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertOne) UpdateEnforcement() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateEnforcement()
+	})
+}
+
+// SetExpression sets the "expression" field.
+func (u *ResourceRunPolicyUpsertOne) SetExpression(v string) *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetExpression(v)
+	})
+}
+
+// UpdateExpression sets the "expression" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertOne) UpdateExpression() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateExpression()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ResourceRunPolicyUpsertOne) SetMessage(v string) *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertOne) UpdateMessage() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ResourceRunPolicyUpsertOne) ClearMessage() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *ResourceRunPolicyUpsertOne) SetDisabled(v bool) *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetDisabled(v)
+	})
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertOne) UpdateDisabled() *ResourceRunPolicyUpsertOne {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateDisabled()
+	})
+}
+
+// Exec executes the query.
+func (u *ResourceRunPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceRunPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceRunPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResourceRunPolicyUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: ResourceRunPolicyUpsertOne.ID is not supported by MySQL driver. Use ResourceRunPolicyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResourceRunPolicyUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResourceRunPolicyCreateBulk is the builder for creating many ResourceRunPolicy entities in bulk.
+type ResourceRunPolicyCreateBulk struct {
+	config
+	err        error
+	builders   []*ResourceRunPolicyCreate
+	conflict   []sql.ConflictOption
+	objects    []*ResourceRunPolicy
+	fromUpsert bool
+}
+
+// Save creates the ResourceRunPolicy entities in the database.
+func (rrpcb *ResourceRunPolicyCreateBulk) Save(ctx context.Context) ([]*ResourceRunPolicy, error) {
+	if rrpcb.err != nil {
+		return nil, rrpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrpcb.builders))
+	nodes := make([]*ResourceRunPolicy, len(rrpcb.builders))
+	mutators := make([]Mutator, len(rrpcb.builders))
+	for i := range rrpcb.builders {
+		func(i int, root context.Context) {
+			builder := rrpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResourceRunPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rrpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrpcb *ResourceRunPolicyCreateBulk) SaveX(ctx context.Context) []*ResourceRunPolicy {
+	v, err := rrpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrpcb *ResourceRunPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrpcb *ResourceRunPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := rrpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceRunPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceRunPolicyUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (rrpcb *ResourceRunPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResourceRunPolicyUpsertBulk {
+	rrpcb.conflict = opts
+	return &ResourceRunPolicyUpsertBulk{
+		create: rrpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceRunPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rrpcb *ResourceRunPolicyCreateBulk) OnConflictColumns(columns ...string) *ResourceRunPolicyUpsertBulk {
+	rrpcb.conflict = append(rrpcb.conflict, sql.ConflictColumns(columns...))
+	return &ResourceRunPolicyUpsertBulk{
+		create: rrpcb,
+	}
+}
+
+// ResourceRunPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResourceRunPolicy nodes.
+type ResourceRunPolicyUpsertBulk struct {
+	create *ResourceRunPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResourceRunPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resourcerunpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResourceRunPolicyUpsertBulk) UpdateNewValues() *ResourceRunPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(resourcerunpolicy.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(resourcerunpolicy.FieldName)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(resourcerunpolicy.FieldCreateTime)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(resourcerunpolicy.FieldProjectID)
+			}
+			if _, exists := b.mutation.EnvironmentID(); exists {
+				s.SetIgnore(resourcerunpolicy.FieldEnvironmentID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceRunPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResourceRunPolicyUpsertBulk) Ignore() *ResourceRunPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceRunPolicyUpsertBulk) DoNothing() *ResourceRunPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceRunPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResourceRunPolicyUpsertBulk) Update(set func(*ResourceRunPolicyUpsert)) *ResourceRunPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceRunPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *ResourceRunPolicyUpsertBulk) SetDescription(v string) *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertBulk) UpdateDescription() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *ResourceRunPolicyUpsertBulk) ClearDescription() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *ResourceRunPolicyUpsertBulk) SetLabels(v map[string]string) *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertBulk) UpdateLabels() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *ResourceRunPolicyUpsertBulk) ClearLabels() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *ResourceRunPolicyUpsertBulk) SetUpdateTime(v time.Time) *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertBulk) UpdateUpdateTime() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (u *ResourceRunPolicyUpsertBulk) SetEnforcement(v string) *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetEnforcement(v)
+	})
+}
+
+// UpdateEnforcement sets the "enforcement" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertBulk) UpdateEnforcement() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateEnforcement()
+	})
+}
+
+// SetExpression sets the "expression" field.
+func (u *ResourceRunPolicyUpsertBulk) SetExpression(v string) *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetExpression(v)
+	})
+}
+
+// UpdateExpression sets the "expression" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertBulk) UpdateExpression() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateExpression()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *ResourceRunPolicyUpsertBulk) SetMessage(v string) *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertBulk) UpdateMessage() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *ResourceRunPolicyUpsertBulk) ClearMessage() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *ResourceRunPolicyUpsertBulk) SetDisabled(v bool) *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.SetDisabled(v)
+	})
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *ResourceRunPolicyUpsertBulk) UpdateDisabled() *ResourceRunPolicyUpsertBulk {
+	return u.Update(func(s *ResourceRunPolicyUpsert) {
+		s.UpdateDisabled()
+	})
+}
+
+// Exec executes the query.
+func (u *ResourceRunPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ResourceRunPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceRunPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceRunPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
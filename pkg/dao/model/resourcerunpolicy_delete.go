@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+)
+
+// ResourceRunPolicyDelete is the builder for deleting a ResourceRunPolicy entity.
+type ResourceRunPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResourceRunPolicyMutation
+}
+
+// Where appends a list predicates to the ResourceRunPolicyDelete builder.
+func (rrpd *ResourceRunPolicyDelete) Where(ps ...predicate.ResourceRunPolicy) *ResourceRunPolicyDelete {
+	rrpd.mutation.Where(ps...)
+	return rrpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rrpd *ResourceRunPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrpd.sqlExec, rrpd.mutation, rrpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrpd *ResourceRunPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := rrpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rrpd *ResourceRunPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(resourcerunpolicy.Table, sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString))
+	_spec.Node.Schema = rrpd.schemaConfig.ResourceRunPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, rrpd.schemaConfig)
+	if ps := rrpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rrpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rrpd.mutation.done = true
+	return affected, err
+}
+
+// ResourceRunPolicyDeleteOne is the builder for deleting a single ResourceRunPolicy entity.
+type ResourceRunPolicyDeleteOne struct {
+	rrpd *ResourceRunPolicyDelete
+}
+
+// Where appends a list predicates to the ResourceRunPolicyDelete builder.
+func (rrpdo *ResourceRunPolicyDeleteOne) Where(ps ...predicate.ResourceRunPolicy) *ResourceRunPolicyDeleteOne {
+	rrpdo.rrpd.mutation.Where(ps...)
+	return rrpdo
+}
+
+// Exec executes the deletion query.
+func (rrpdo *ResourceRunPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := rrpdo.rrpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{resourcerunpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrpdo *ResourceRunPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := rrpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,758 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceRunPolicyQuery is the builder for querying ResourceRunPolicy entities.
+type ResourceRunPolicyQuery struct {
+	config
+	ctx             *QueryContext
+	order           []resourcerunpolicy.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.ResourceRunPolicy
+	withProject     *ProjectQuery
+	withEnvironment *EnvironmentQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResourceRunPolicyQuery builder.
+func (rrpq *ResourceRunPolicyQuery) Where(ps ...predicate.ResourceRunPolicy) *ResourceRunPolicyQuery {
+	rrpq.predicates = append(rrpq.predicates, ps...)
+	return rrpq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrpq *ResourceRunPolicyQuery) Limit(limit int) *ResourceRunPolicyQuery {
+	rrpq.ctx.Limit = &limit
+	return rrpq
+}
+
+// Offset to start from.
+func (rrpq *ResourceRunPolicyQuery) Offset(offset int) *ResourceRunPolicyQuery {
+	rrpq.ctx.Offset = &offset
+	return rrpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrpq *ResourceRunPolicyQuery) Unique(unique bool) *ResourceRunPolicyQuery {
+	rrpq.ctx.Unique = &unique
+	return rrpq
+}
+
+// Order specifies how the records should be ordered.
+func (rrpq *ResourceRunPolicyQuery) Order(o ...resourcerunpolicy.OrderOption) *ResourceRunPolicyQuery {
+	rrpq.order = append(rrpq.order, o...)
+	return rrpq
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (rrpq *ResourceRunPolicyQuery) QueryProject() *ProjectQuery {
+	query := (&ProjectClient{config: rrpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcerunpolicy.Table, resourcerunpolicy.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerunpolicy.ProjectTable, resourcerunpolicy.ProjectColumn),
+		)
+		schemaConfig := rrpq.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		fromU = sqlgraph.SetNeighbors(rrpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnvironment chains the current query on the "environment" edge.
+func (rrpq *ResourceRunPolicyQuery) QueryEnvironment() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: rrpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcerunpolicy.Table, resourcerunpolicy.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcerunpolicy.EnvironmentTable, resourcerunpolicy.EnvironmentColumn),
+		)
+		schemaConfig := rrpq.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceRunPolicy
+		fromU = sqlgraph.SetNeighbors(rrpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResourceRunPolicy entity from the query.
+// Returns a *NotFoundError when no ResourceRunPolicy was found.
+func (rrpq *ResourceRunPolicyQuery) First(ctx context.Context) (*ResourceRunPolicy, error) {
+	nodes, err := rrpq.Limit(1).All(setContextOp(ctx, rrpq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{resourcerunpolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrpq *ResourceRunPolicyQuery) FirstX(ctx context.Context) *ResourceRunPolicy {
+	node, err := rrpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResourceRunPolicy ID from the query.
+// Returns a *NotFoundError when no ResourceRunPolicy ID was found.
+func (rrpq *ResourceRunPolicyQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = rrpq.Limit(1).IDs(setContextOp(ctx, rrpq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{resourcerunpolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrpq *ResourceRunPolicyQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := rrpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResourceRunPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResourceRunPolicy entity is found.
+// Returns a *NotFoundError when no ResourceRunPolicy entities are found.
+func (rrpq *ResourceRunPolicyQuery) Only(ctx context.Context) (*ResourceRunPolicy, error) {
+	nodes, err := rrpq.Limit(2).All(setContextOp(ctx, rrpq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{resourcerunpolicy.Label}
+	default:
+		return nil, &NotSingularError{resourcerunpolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrpq *ResourceRunPolicyQuery) OnlyX(ctx context.Context) *ResourceRunPolicy {
+	node, err := rrpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResourceRunPolicy ID in the query.
+// Returns a *NotSingularError when more than one ResourceRunPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrpq *ResourceRunPolicyQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = rrpq.Limit(2).IDs(setContextOp(ctx, rrpq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{resourcerunpolicy.Label}
+	default:
+		err = &NotSingularError{resourcerunpolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrpq *ResourceRunPolicyQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := rrpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResourceRunPolicies.
+func (rrpq *ResourceRunPolicyQuery) All(ctx context.Context) ([]*ResourceRunPolicy, error) {
+	ctx = setContextOp(ctx, rrpq.ctx, "All")
+	if err := rrpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResourceRunPolicy, *ResourceRunPolicyQuery]()
+	return withInterceptors[[]*ResourceRunPolicy](ctx, rrpq, qr, rrpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrpq *ResourceRunPolicyQuery) AllX(ctx context.Context) []*ResourceRunPolicy {
+	nodes, err := rrpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResourceRunPolicy IDs.
+func (rrpq *ResourceRunPolicyQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if rrpq.ctx.Unique == nil && rrpq.path != nil {
+		rrpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrpq.ctx, "IDs")
+	if err = rrpq.Select(resourcerunpolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrpq *ResourceRunPolicyQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := rrpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrpq *ResourceRunPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrpq.ctx, "Count")
+	if err := rrpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrpq, querierCount[*ResourceRunPolicyQuery](), rrpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrpq *ResourceRunPolicyQuery) CountX(ctx context.Context) int {
+	count, err := rrpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrpq *ResourceRunPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrpq.ctx, "Exist")
+	switch _, err := rrpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrpq *ResourceRunPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResourceRunPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrpq *ResourceRunPolicyQuery) Clone() *ResourceRunPolicyQuery {
+	if rrpq == nil {
+		return nil
+	}
+	return &ResourceRunPolicyQuery{
+		config:          rrpq.config,
+		ctx:             rrpq.ctx.Clone(),
+		order:           append([]resourcerunpolicy.OrderOption{}, rrpq.order...),
+		inters:          append([]Interceptor{}, rrpq.inters...),
+		predicates:      append([]predicate.ResourceRunPolicy{}, rrpq.predicates...),
+		withProject:     rrpq.withProject.Clone(),
+		withEnvironment: rrpq.withEnvironment.Clone(),
+		// clone intermediate query.
+		sql:  rrpq.sql.Clone(),
+		path: rrpq.path,
+	}
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrpq *ResourceRunPolicyQuery) WithProject(opts ...func(*ProjectQuery)) *ResourceRunPolicyQuery {
+	query := (&ProjectClient{config: rrpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrpq.withProject = query
+	return rrpq
+}
+
+// WithEnvironment tells the query-builder to eager-load the nodes that are connected to
+// the "environment" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrpq *ResourceRunPolicyQuery) WithEnvironment(opts ...func(*EnvironmentQuery)) *ResourceRunPolicyQuery {
+	query := (&EnvironmentClient{config: rrpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrpq.withEnvironment = query
+	return rrpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResourceRunPolicy.Query().
+//		GroupBy(resourcerunpolicy.FieldName).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (rrpq *ResourceRunPolicyQuery) GroupBy(field string, fields ...string) *ResourceRunPolicyGroupBy {
+	rrpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResourceRunPolicyGroupBy{build: rrpq}
+	grbuild.flds = &rrpq.ctx.Fields
+	grbuild.label = resourcerunpolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.ResourceRunPolicy.Query().
+//		Select(resourcerunpolicy.FieldName).
+//		Scan(ctx, &v)
+func (rrpq *ResourceRunPolicyQuery) Select(fields ...string) *ResourceRunPolicySelect {
+	rrpq.ctx.Fields = append(rrpq.ctx.Fields, fields...)
+	sbuild := &ResourceRunPolicySelect{ResourceRunPolicyQuery: rrpq}
+	sbuild.label = resourcerunpolicy.Label
+	sbuild.flds, sbuild.scan = &rrpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResourceRunPolicySelect configured with the given aggregations.
+func (rrpq *ResourceRunPolicyQuery) Aggregate(fns ...AggregateFunc) *ResourceRunPolicySelect {
+	return rrpq.Select().Aggregate(fns...)
+}
+
+func (rrpq *ResourceRunPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrpq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrpq.ctx.Fields {
+		if !resourcerunpolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if rrpq.path != nil {
+		prev, err := rrpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrpq.sql = prev
+	}
+	return nil
+}
+
+func (rrpq *ResourceRunPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResourceRunPolicy, error) {
+	var (
+		nodes       = []*ResourceRunPolicy{}
+		_spec       = rrpq.querySpec()
+		loadedTypes = [2]bool{
+			rrpq.withProject != nil,
+			rrpq.withEnvironment != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResourceRunPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResourceRunPolicy{config: rrpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = rrpq.schemaConfig.ResourceRunPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, rrpq.schemaConfig)
+	if len(rrpq.modifiers) > 0 {
+		_spec.Modifiers = rrpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rrpq.withProject; query != nil {
+		if err := rrpq.loadProject(ctx, query, nodes, nil,
+			func(n *ResourceRunPolicy, e *Project) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rrpq.withEnvironment; query != nil {
+		if err := rrpq.loadEnvironment(ctx, query, nodes, nil,
+			func(n *ResourceRunPolicy, e *Environment) { n.Edges.Environment = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rrpq *ResourceRunPolicyQuery) loadProject(ctx context.Context, query *ProjectQuery, nodes []*ResourceRunPolicy, init func(*ResourceRunPolicy), assign func(*ResourceRunPolicy, *Project)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceRunPolicy)
+	for i := range nodes {
+		fk := nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(project.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rrpq *ResourceRunPolicyQuery) loadEnvironment(ctx context.Context, query *EnvironmentQuery, nodes []*ResourceRunPolicy, init func(*ResourceRunPolicy), assign func(*ResourceRunPolicy, *Environment)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceRunPolicy)
+	for i := range nodes {
+		fk := nodes[i].EnvironmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "environment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rrpq *ResourceRunPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrpq.querySpec()
+	_spec.Node.Schema = rrpq.schemaConfig.ResourceRunPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, rrpq.schemaConfig)
+	if len(rrpq.modifiers) > 0 {
+		_spec.Modifiers = rrpq.modifiers
+	}
+	_spec.Node.Columns = rrpq.ctx.Fields
+	if len(rrpq.ctx.Fields) > 0 {
+		_spec.Unique = rrpq.ctx.Unique != nil && *rrpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrpq.driver, _spec)
+}
+
+func (rrpq *ResourceRunPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(resourcerunpolicy.Table, resourcerunpolicy.Columns, sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString))
+	_spec.From = rrpq.sql
+	if unique := rrpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resourcerunpolicy.FieldID)
+		for i := range fields {
+			if fields[i] != resourcerunpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rrpq.withProject != nil {
+			_spec.Node.AddColumnOnce(resourcerunpolicy.FieldProjectID)
+		}
+		if rrpq.withEnvironment != nil {
+			_spec.Node.AddColumnOnce(resourcerunpolicy.FieldEnvironmentID)
+		}
+	}
+	if ps := rrpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrpq *ResourceRunPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrpq.driver.Dialect())
+	t1 := builder.Table(resourcerunpolicy.Table)
+	columns := rrpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = resourcerunpolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrpq.sql != nil {
+		selector = rrpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrpq.ctx.Unique != nil && *rrpq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(rrpq.schemaConfig.ResourceRunPolicy)
+	ctx = internal.NewSchemaConfigContext(ctx, rrpq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range rrpq.modifiers {
+		m(selector)
+	}
+	for _, p := range rrpq.predicates {
+		p(selector)
+	}
+	for _, p := range rrpq.order {
+		p(selector)
+	}
+	if offset := rrpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rrpq *ResourceRunPolicyQuery) ForUpdate(opts ...sql.LockOption) *ResourceRunPolicyQuery {
+	if rrpq.driver.Dialect() == dialect.Postgres {
+		rrpq.Unique(false)
+	}
+	rrpq.modifiers = append(rrpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rrpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rrpq *ResourceRunPolicyQuery) ForShare(opts ...sql.LockOption) *ResourceRunPolicyQuery {
+	if rrpq.driver.Dialect() == dialect.Postgres {
+		rrpq.Unique(false)
+	}
+	rrpq.modifiers = append(rrpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rrpq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rrpq *ResourceRunPolicyQuery) Modify(modifiers ...func(s *sql.Selector)) *ResourceRunPolicySelect {
+	rrpq.modifiers = append(rrpq.modifiers, modifiers...)
+	return rrpq.Select()
+}
+
+// WhereP appends storage-level predicates to the ResourceRunPolicyQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (rrpq *ResourceRunPolicyQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.ResourceRunPolicy, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.ResourceRunPolicy(ps[i]))
+	}
+	rrpq.predicates = append(rrpq.predicates, wps...)
+}
+
+// ResourceRunPolicyGroupBy is the group-by builder for ResourceRunPolicy entities.
+type ResourceRunPolicyGroupBy struct {
+	selector
+	build *ResourceRunPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrpgb *ResourceRunPolicyGroupBy) Aggregate(fns ...AggregateFunc) *ResourceRunPolicyGroupBy {
+	rrpgb.fns = append(rrpgb.fns, fns...)
+	return rrpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrpgb *ResourceRunPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrpgb.build.ctx, "GroupBy")
+	if err := rrpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResourceRunPolicyQuery, *ResourceRunPolicyGroupBy](ctx, rrpgb.build, rrpgb, rrpgb.build.inters, v)
+}
+
+func (rrpgb *ResourceRunPolicyGroupBy) sqlScan(ctx context.Context, root *ResourceRunPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrpgb.fns))
+	for _, fn := range rrpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrpgb.flds)+len(rrpgb.fns))
+		for _, f := range *rrpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResourceRunPolicySelect is the builder for selecting fields of ResourceRunPolicy entities.
+type ResourceRunPolicySelect struct {
+	*ResourceRunPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrps *ResourceRunPolicySelect) Aggregate(fns ...AggregateFunc) *ResourceRunPolicySelect {
+	rrps.fns = append(rrps.fns, fns...)
+	return rrps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrps *ResourceRunPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrps.ctx, "Select")
+	if err := rrps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResourceRunPolicyQuery, *ResourceRunPolicySelect](ctx, rrps.ResourceRunPolicyQuery, rrps, rrps.inters, v)
+}
+
+func (rrps *ResourceRunPolicySelect) sqlScan(ctx context.Context, root *ResourceRunPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrps.fns))
+	for _, fn := range rrps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rrps *ResourceRunPolicySelect) Modify(modifiers ...func(s *sql.Selector)) *ResourceRunPolicySelect {
+	rrps.modifiers = append(rrps.modifiers, modifiers...)
+	return rrps
+}
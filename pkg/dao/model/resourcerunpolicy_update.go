@@ -0,0 +1,773 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+)
+
+// ResourceRunPolicyUpdate is the builder for updating ResourceRunPolicy entities.
+type ResourceRunPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ResourceRunPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ResourceRunPolicy
+}
+
+// Where appends a list predicates to the ResourceRunPolicyUpdate builder.
+func (rrpu *ResourceRunPolicyUpdate) Where(ps ...predicate.ResourceRunPolicy) *ResourceRunPolicyUpdate {
+	rrpu.mutation.Where(ps...)
+	return rrpu
+}
+
+// SetDescription sets the "description" field.
+func (rrpu *ResourceRunPolicyUpdate) SetDescription(s string) *ResourceRunPolicyUpdate {
+	rrpu.mutation.SetDescription(s)
+	return rrpu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rrpu *ResourceRunPolicyUpdate) SetNillableDescription(s *string) *ResourceRunPolicyUpdate {
+	if s != nil {
+		rrpu.SetDescription(*s)
+	}
+	return rrpu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rrpu *ResourceRunPolicyUpdate) ClearDescription() *ResourceRunPolicyUpdate {
+	rrpu.mutation.ClearDescription()
+	return rrpu
+}
+
+// SetLabels sets the "labels" field.
+func (rrpu *ResourceRunPolicyUpdate) SetLabels(m map[string]string) *ResourceRunPolicyUpdate {
+	rrpu.mutation.SetLabels(m)
+	return rrpu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (rrpu *ResourceRunPolicyUpdate) ClearLabels() *ResourceRunPolicyUpdate {
+	rrpu.mutation.ClearLabels()
+	return rrpu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rrpu *ResourceRunPolicyUpdate) SetUpdateTime(t time.Time) *ResourceRunPolicyUpdate {
+	rrpu.mutation.SetUpdateTime(t)
+	return rrpu
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (rrpu *ResourceRunPolicyUpdate) SetEnforcement(s string) *ResourceRunPolicyUpdate {
+	rrpu.mutation.SetEnforcement(s)
+	return rrpu
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (rrpu *ResourceRunPolicyUpdate) SetNillableEnforcement(s *string) *ResourceRunPolicyUpdate {
+	if s != nil {
+		rrpu.SetEnforcement(*s)
+	}
+	return rrpu
+}
+
+// SetExpression sets the "expression" field.
+func (rrpu *ResourceRunPolicyUpdate) SetExpression(s string) *ResourceRunPolicyUpdate {
+	rrpu.mutation.SetExpression(s)
+	return rrpu
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (rrpu *ResourceRunPolicyUpdate) SetNillableExpression(s *string) *ResourceRunPolicyUpdate {
+	if s != nil {
+		rrpu.SetExpression(*s)
+	}
+	return rrpu
+}
+
+// SetMessage sets the "message" field.
+func (rrpu *ResourceRunPolicyUpdate) SetMessage(s string) *ResourceRunPolicyUpdate {
+	rrpu.mutation.SetMessage(s)
+	return rrpu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rrpu *ResourceRunPolicyUpdate) SetNillableMessage(s *string) *ResourceRunPolicyUpdate {
+	if s != nil {
+		rrpu.SetMessage(*s)
+	}
+	return rrpu
+}
+
+// ClearMessage clears the value of the "message" field.
+func (rrpu *ResourceRunPolicyUpdate) ClearMessage() *ResourceRunPolicyUpdate {
+	rrpu.mutation.ClearMessage()
+	return rrpu
+}
+
+// SetDisabled sets the "disabled" field.
+func (rrpu *ResourceRunPolicyUpdate) SetDisabled(b bool) *ResourceRunPolicyUpdate {
+	rrpu.mutation.SetDisabled(b)
+	return rrpu
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (rrpu *ResourceRunPolicyUpdate) SetNillableDisabled(b *bool) *ResourceRunPolicyUpdate {
+	if b != nil {
+		rrpu.SetDisabled(*b)
+	}
+	return rrpu
+}
+
+// Mutation returns the ResourceRunPolicyMutation object of the builder.
+func (rrpu *ResourceRunPolicyUpdate) Mutation() *ResourceRunPolicyMutation {
+	return rrpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rrpu *ResourceRunPolicyUpdate) Save(ctx context.Context) (int, error) {
+	if err := rrpu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, rrpu.sqlSave, rrpu.mutation, rrpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrpu *ResourceRunPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := rrpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rrpu *ResourceRunPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := rrpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrpu *ResourceRunPolicyUpdate) ExecX(ctx context.Context) {
+	if err := rrpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrpu *ResourceRunPolicyUpdate) defaults() error {
+	if _, ok := rrpu.mutation.UpdateTime(); !ok {
+		if resourcerunpolicy.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcerunpolicy.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := resourcerunpolicy.UpdateDefaultUpdateTime()
+		rrpu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrpu *ResourceRunPolicyUpdate) check() error {
+	if v, ok := rrpu.mutation.Enforcement(); ok {
+		if err := resourcerunpolicy.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`model: validator failed for field "ResourceRunPolicy.enforcement": %w`, err)}
+		}
+	}
+	if v, ok := rrpu.mutation.Expression(); ok {
+		if err := resourcerunpolicy.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`model: validator failed for field "ResourceRunPolicy.expression": %w`, err)}
+		}
+	}
+	if _, ok := rrpu.mutation.ProjectID(); rrpu.mutation.ProjectCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceRunPolicy.project"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rrpu *ResourceRunPolicyUpdate) Set(obj *ResourceRunPolicy) *ResourceRunPolicyUpdate {
+	// Without Default.
+	if obj.Description != "" {
+		rrpu.SetDescription(obj.Description)
+	} else {
+		rrpu.ClearDescription()
+	}
+	if !reflect.ValueOf(obj.Labels).IsZero() {
+		rrpu.SetLabels(obj.Labels)
+	}
+	rrpu.SetEnforcement(obj.Enforcement)
+	rrpu.SetExpression(obj.Expression)
+	if obj.Message != "" {
+		rrpu.SetMessage(obj.Message)
+	} else {
+		rrpu.ClearMessage()
+	}
+	rrpu.SetDisabled(obj.Disabled)
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		rrpu.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	rrpu.object = obj
+
+	return rrpu
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rrpu *ResourceRunPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceRunPolicyUpdate {
+	rrpu.modifiers = append(rrpu.modifiers, modifiers...)
+	return rrpu
+}
+
+func (rrpu *ResourceRunPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rrpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resourcerunpolicy.Table, resourcerunpolicy.Columns, sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString))
+	if ps := rrpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rrpu.mutation.Description(); ok {
+		_spec.SetField(resourcerunpolicy.FieldDescription, field.TypeString, value)
+	}
+	if rrpu.mutation.DescriptionCleared() {
+		_spec.ClearField(resourcerunpolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := rrpu.mutation.Labels(); ok {
+		_spec.SetField(resourcerunpolicy.FieldLabels, field.TypeJSON, value)
+	}
+	if rrpu.mutation.LabelsCleared() {
+		_spec.ClearField(resourcerunpolicy.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := rrpu.mutation.UpdateTime(); ok {
+		_spec.SetField(resourcerunpolicy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := rrpu.mutation.Enforcement(); ok {
+		_spec.SetField(resourcerunpolicy.FieldEnforcement, field.TypeString, value)
+	}
+	if value, ok := rrpu.mutation.Expression(); ok {
+		_spec.SetField(resourcerunpolicy.FieldExpression, field.TypeString, value)
+	}
+	if value, ok := rrpu.mutation.Message(); ok {
+		_spec.SetField(resourcerunpolicy.FieldMessage, field.TypeString, value)
+	}
+	if rrpu.mutation.MessageCleared() {
+		_spec.ClearField(resourcerunpolicy.FieldMessage, field.TypeString)
+	}
+	if value, ok := rrpu.mutation.Disabled(); ok {
+		_spec.SetField(resourcerunpolicy.FieldDisabled, field.TypeBool, value)
+	}
+	_spec.Node.Schema = rrpu.schemaConfig.ResourceRunPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, rrpu.schemaConfig)
+	_spec.AddModifiers(rrpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rrpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resourcerunpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rrpu.mutation.done = true
+	return n, nil
+}
+
+// ResourceRunPolicyUpdateOne is the builder for updating a single ResourceRunPolicy entity.
+type ResourceRunPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ResourceRunPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ResourceRunPolicy
+}
+
+// SetDescription sets the "description" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetDescription(s string) *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.SetDescription(s)
+	return rrpuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetNillableDescription(s *string) *ResourceRunPolicyUpdateOne {
+	if s != nil {
+		rrpuo.SetDescription(*s)
+	}
+	return rrpuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) ClearDescription() *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.ClearDescription()
+	return rrpuo
+}
+
+// SetLabels sets the "labels" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetLabels(m map[string]string) *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.SetLabels(m)
+	return rrpuo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) ClearLabels() *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.ClearLabels()
+	return rrpuo
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetUpdateTime(t time.Time) *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.SetUpdateTime(t)
+	return rrpuo
+}
+
+// SetEnforcement sets the "enforcement" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetEnforcement(s string) *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.SetEnforcement(s)
+	return rrpuo
+}
+
+// SetNillableEnforcement sets the "enforcement" field if the given value is not nil.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetNillableEnforcement(s *string) *ResourceRunPolicyUpdateOne {
+	if s != nil {
+		rrpuo.SetEnforcement(*s)
+	}
+	return rrpuo
+}
+
+// SetExpression sets the "expression" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetExpression(s string) *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.SetExpression(s)
+	return rrpuo
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetNillableExpression(s *string) *ResourceRunPolicyUpdateOne {
+	if s != nil {
+		rrpuo.SetExpression(*s)
+	}
+	return rrpuo
+}
+
+// SetMessage sets the "message" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetMessage(s string) *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.SetMessage(s)
+	return rrpuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetNillableMessage(s *string) *ResourceRunPolicyUpdateOne {
+	if s != nil {
+		rrpuo.SetMessage(*s)
+	}
+	return rrpuo
+}
+
+// ClearMessage clears the value of the "message" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) ClearMessage() *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.ClearMessage()
+	return rrpuo
+}
+
+// SetDisabled sets the "disabled" field.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetDisabled(b bool) *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.SetDisabled(b)
+	return rrpuo
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (rrpuo *ResourceRunPolicyUpdateOne) SetNillableDisabled(b *bool) *ResourceRunPolicyUpdateOne {
+	if b != nil {
+		rrpuo.SetDisabled(*b)
+	}
+	return rrpuo
+}
+
+// Mutation returns the ResourceRunPolicyMutation object of the builder.
+func (rrpuo *ResourceRunPolicyUpdateOne) Mutation() *ResourceRunPolicyMutation {
+	return rrpuo.mutation
+}
+
+// Where appends a list predicates to the ResourceRunPolicyUpdate builder.
+func (rrpuo *ResourceRunPolicyUpdateOne) Where(ps ...predicate.ResourceRunPolicy) *ResourceRunPolicyUpdateOne {
+	rrpuo.mutation.Where(ps...)
+	return rrpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rrpuo *ResourceRunPolicyUpdateOne) Select(field string, fields ...string) *ResourceRunPolicyUpdateOne {
+	rrpuo.fields = append([]string{field}, fields...)
+	return rrpuo
+}
+
+// Save executes the query and returns the updated ResourceRunPolicy entity.
+func (rrpuo *ResourceRunPolicyUpdateOne) Save(ctx context.Context) (*ResourceRunPolicy, error) {
+	if err := rrpuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rrpuo.sqlSave, rrpuo.mutation, rrpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrpuo *ResourceRunPolicyUpdateOne) SaveX(ctx context.Context) *ResourceRunPolicy {
+	node, err := rrpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rrpuo *ResourceRunPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := rrpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrpuo *ResourceRunPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := rrpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrpuo *ResourceRunPolicyUpdateOne) defaults() error {
+	if _, ok := rrpuo.mutation.UpdateTime(); !ok {
+		if resourcerunpolicy.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcerunpolicy.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := resourcerunpolicy.UpdateDefaultUpdateTime()
+		rrpuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrpuo *ResourceRunPolicyUpdateOne) check() error {
+	if v, ok := rrpuo.mutation.Enforcement(); ok {
+		if err := resourcerunpolicy.EnforcementValidator(v); err != nil {
+			return &ValidationError{Name: "enforcement", err: fmt.Errorf(`model: validator failed for field "ResourceRunPolicy.enforcement": %w`, err)}
+		}
+	}
+	if v, ok := rrpuo.mutation.Expression(); ok {
+		if err := resourcerunpolicy.ExpressionValidator(v); err != nil {
+			return &ValidationError{Name: "expression", err: fmt.Errorf(`model: validator failed for field "ResourceRunPolicy.expression": %w`, err)}
+		}
+	}
+	if _, ok := rrpuo.mutation.ProjectID(); rrpuo.mutation.ProjectCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceRunPolicy.project"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rrpuo *ResourceRunPolicyUpdateOne) Set(obj *ResourceRunPolicy) *ResourceRunPolicyUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*ResourceRunPolicyMutation)
+			db, err := mt.Client().ResourceRunPolicy.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting ResourceRunPolicy with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if obj.Description != "" {
+				if db.Description != obj.Description {
+					rrpuo.SetDescription(obj.Description)
+				}
+			} else {
+				rrpuo.ClearDescription()
+			}
+			if !reflect.ValueOf(obj.Labels).IsZero() {
+				if !reflect.DeepEqual(db.Labels, obj.Labels) {
+					rrpuo.SetLabels(obj.Labels)
+				}
+			}
+			if db.Enforcement != obj.Enforcement {
+				rrpuo.SetEnforcement(obj.Enforcement)
+			}
+			if db.Expression != obj.Expression {
+				rrpuo.SetExpression(obj.Expression)
+			}
+			if obj.Message != "" {
+				if db.Message != obj.Message {
+					rrpuo.SetMessage(obj.Message)
+				}
+			} else {
+				rrpuo.ClearMessage()
+			}
+			if db.Disabled != obj.Disabled {
+				rrpuo.SetDisabled(obj.Disabled)
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				rrpuo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			rrpuo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	rrpuo.hooks = append(rrpuo.hooks, h)
+
+	return rrpuo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rrpuo *ResourceRunPolicyUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := rrpuo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rrpuo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rrpuo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the ResourceRunPolicy entity,
+// which is always good for cascading update operations.
+func (rrpuo *ResourceRunPolicyUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunPolicy) error) (*ResourceRunPolicy, error) {
+	obj, err := rrpuo.Save(ctx)
+	if err != nil &&
+		(rrpuo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := rrpuo.getClientSet()
+
+	if obj == nil {
+		obj = rrpuo.object
+	} else if x := rrpuo.object; x != nil {
+		if _, set := rrpuo.mutation.Field(resourcerunpolicy.FieldDescription); set {
+			obj.Description = x.Description
+		}
+		if _, set := rrpuo.mutation.Field(resourcerunpolicy.FieldLabels); set {
+			obj.Labels = x.Labels
+		}
+		if _, set := rrpuo.mutation.Field(resourcerunpolicy.FieldEnforcement); set {
+			obj.Enforcement = x.Enforcement
+		}
+		if _, set := rrpuo.mutation.Field(resourcerunpolicy.FieldExpression); set {
+			obj.Expression = x.Expression
+		}
+		if _, set := rrpuo.mutation.Field(resourcerunpolicy.FieldMessage); set {
+			obj.Message = x.Message
+		}
+		if _, set := rrpuo.mutation.Field(resourcerunpolicy.FieldDisabled); set {
+			obj.Disabled = x.Disabled
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rrpuo *ResourceRunPolicyUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunPolicy) error) *ResourceRunPolicy {
+	obj, err := rrpuo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (rrpuo *ResourceRunPolicyUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunPolicy) error) error {
+	_, err := rrpuo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrpuo *ResourceRunPolicyUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceRunPolicy) error) {
+	if err := rrpuo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rrpuo *ResourceRunPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceRunPolicyUpdateOne {
+	rrpuo.modifiers = append(rrpuo.modifiers, modifiers...)
+	return rrpuo
+}
+
+func (rrpuo *ResourceRunPolicyUpdateOne) sqlSave(ctx context.Context) (_node *ResourceRunPolicy, err error) {
+	if err := rrpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resourcerunpolicy.Table, resourcerunpolicy.Columns, sqlgraph.NewFieldSpec(resourcerunpolicy.FieldID, field.TypeString))
+	id, ok := rrpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "ResourceRunPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rrpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resourcerunpolicy.FieldID)
+		for _, f := range fields {
+			if !resourcerunpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != resourcerunpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rrpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rrpuo.mutation.Description(); ok {
+		_spec.SetField(resourcerunpolicy.FieldDescription, field.TypeString, value)
+	}
+	if rrpuo.mutation.DescriptionCleared() {
+		_spec.ClearField(resourcerunpolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := rrpuo.mutation.Labels(); ok {
+		_spec.SetField(resourcerunpolicy.FieldLabels, field.TypeJSON, value)
+	}
+	if rrpuo.mutation.LabelsCleared() {
+		_spec.ClearField(resourcerunpolicy.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := rrpuo.mutation.UpdateTime(); ok {
+		_spec.SetField(resourcerunpolicy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := rrpuo.mutation.Enforcement(); ok {
+		_spec.SetField(resourcerunpolicy.FieldEnforcement, field.TypeString, value)
+	}
+	if value, ok := rrpuo.mutation.Expression(); ok {
+		_spec.SetField(resourcerunpolicy.FieldExpression, field.TypeString, value)
+	}
+	if value, ok := rrpuo.mutation.Message(); ok {
+		_spec.SetField(resourcerunpolicy.FieldMessage, field.TypeString, value)
+	}
+	if rrpuo.mutation.MessageCleared() {
+		_spec.ClearField(resourcerunpolicy.FieldMessage, field.TypeString)
+	}
+	if value, ok := rrpuo.mutation.Disabled(); ok {
+		_spec.SetField(resourcerunpolicy.FieldDisabled, field.TypeBool, value)
+	}
+	_spec.Node.Schema = rrpuo.schemaConfig.ResourceRunPolicy
+	ctx = internal.NewSchemaConfigContext(ctx, rrpuo.schemaConfig)
+	_spec.AddModifiers(rrpuo.modifiers...)
+	_node = &ResourceRunPolicy{config: rrpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rrpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resourcerunpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rrpuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1132 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ResourceRunPolicyCreateInput holds the creation input of the ResourceRunPolicy entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunPolicyCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create ResourceRunPolicy entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to create ResourceRunPolicy entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Expression of the policy, evaluates the plan of the run, returns true or a non-empty list of violation messages if violated.
+	Expression string `path:"-" query:"-" json:"expression"`
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Enforcement of the policy, deny blocks the run from applying, warn annotates the run only.
+	Enforcement string `path:"-" query:"-" json:"enforcement,cli-table-column,omitempty"`
+	// Message to show if the policy is violated.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+	// Indicate whether the policy is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled,omitempty"`
+}
+
+// Model returns the ResourceRunPolicy entity for creating,
+// after validating.
+func (rrpci *ResourceRunPolicyCreateInput) Model() *ResourceRunPolicy {
+	if rrpci == nil {
+		return nil
+	}
+
+	_rrp := &ResourceRunPolicy{
+		Expression:  rrpci.Expression,
+		Name:        rrpci.Name,
+		Description: rrpci.Description,
+		Labels:      rrpci.Labels,
+		Enforcement: rrpci.Enforcement,
+		Message:     rrpci.Message,
+		Disabled:    rrpci.Disabled,
+	}
+
+	if rrpci.Project != nil {
+		_rrp.ProjectID = rrpci.Project.ID
+	}
+	if rrpci.Environment != nil {
+		_rrp.EnvironmentID = rrpci.Environment.ID
+	}
+
+	return _rrp
+}
+
+// Validate checks the ResourceRunPolicyCreateInput entity.
+func (rrpci *ResourceRunPolicyCreateInput) Validate() error {
+	if rrpci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrpci.ValidateWith(rrpci.inputConfig.Context, rrpci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunPolicyCreateInput entity with the given context and client set.
+func (rrpci *ResourceRunPolicyCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrpci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if rrpci.Project != nil {
+		if err := rrpci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+	// Validate when creating under the Environment route.
+	if rrpci.Environment != nil {
+		if err := rrpci.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrpci.Environment = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunPolicyCreateInputs holds the creation input item of the ResourceRunPolicy entities.
+type ResourceRunPolicyCreateInputsItem struct {
+	// Expression of the policy, evaluates the plan of the run, returns true or a non-empty list of violation messages if violated.
+	Expression string `path:"-" query:"-" json:"expression"`
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Enforcement of the policy, deny blocks the run from applying, warn annotates the run only.
+	Enforcement string `path:"-" query:"-" json:"enforcement,cli-table-column,omitempty"`
+	// Message to show if the policy is violated.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+	// Indicate whether the policy is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled,omitempty"`
+}
+
+// ValidateWith checks the ResourceRunPolicyCreateInputsItem entity with the given context and client set.
+func (rrpci *ResourceRunPolicyCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrpci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceRunPolicyCreateInputs holds the creation input of the ResourceRunPolicy entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunPolicyCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create ResourceRunPolicy entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to create ResourceRunPolicy entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceRunPolicyCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceRunPolicy entities for creating,
+// after validating.
+func (rrpci *ResourceRunPolicyCreateInputs) Model() []*ResourceRunPolicy {
+	if rrpci == nil || len(rrpci.Items) == 0 {
+		return nil
+	}
+
+	_rrps := make([]*ResourceRunPolicy, len(rrpci.Items))
+
+	for i := range rrpci.Items {
+		_rrp := &ResourceRunPolicy{
+			Expression:  rrpci.Items[i].Expression,
+			Name:        rrpci.Items[i].Name,
+			Description: rrpci.Items[i].Description,
+			Labels:      rrpci.Items[i].Labels,
+			Enforcement: rrpci.Items[i].Enforcement,
+			Message:     rrpci.Items[i].Message,
+			Disabled:    rrpci.Items[i].Disabled,
+		}
+
+		if rrpci.Project != nil {
+			_rrp.ProjectID = rrpci.Project.ID
+		}
+		if rrpci.Environment != nil {
+			_rrp.EnvironmentID = rrpci.Environment.ID
+		}
+
+		_rrps[i] = _rrp
+	}
+
+	return _rrps
+}
+
+// Validate checks the ResourceRunPolicyCreateInputs entity .
+func (rrpci *ResourceRunPolicyCreateInputs) Validate() error {
+	if rrpci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrpci.ValidateWith(rrpci.inputConfig.Context, rrpci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunPolicyCreateInputs entity with the given context and client set.
+func (rrpci *ResourceRunPolicyCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrpci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rrpci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if rrpci.Project != nil {
+		if err := rrpci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrpci.Project = nil
+			}
+		}
+	}
+	// Validate when creating under the Environment route.
+	if rrpci.Environment != nil {
+		if err := rrpci.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrpci.Environment = nil
+			}
+		}
+	}
+
+	for i := range rrpci.Items {
+		if rrpci.Items[i] == nil {
+			continue
+		}
+
+		if err := rrpci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunPolicyDeleteInput holds the deletion input of the ResourceRunPolicy entity,
+// please tags with `path:",inline"` if embedding.
+type ResourceRunPolicyDeleteInput struct {
+	ResourceRunPolicyQueryInput `path:",inline"`
+}
+
+// ResourceRunPolicyDeleteInputs holds the deletion input item of the ResourceRunPolicy entities.
+type ResourceRunPolicyDeleteInputsItem struct {
+	// ID of the ResourceRunPolicy entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the ResourceRunPolicy entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+}
+
+// ResourceRunPolicyDeleteInputs holds the deletion input of the ResourceRunPolicy entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunPolicyDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to delete ResourceRunPolicy entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to delete ResourceRunPolicy entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceRunPolicyDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceRunPolicy entities for deleting,
+// after validating.
+func (rrpdi *ResourceRunPolicyDeleteInputs) Model() []*ResourceRunPolicy {
+	if rrpdi == nil || len(rrpdi.Items) == 0 {
+		return nil
+	}
+
+	_rrps := make([]*ResourceRunPolicy, len(rrpdi.Items))
+	for i := range rrpdi.Items {
+		_rrps[i] = &ResourceRunPolicy{
+			ID: rrpdi.Items[i].ID,
+		}
+	}
+	return _rrps
+}
+
+// IDs returns the ID list of the ResourceRunPolicy entities for deleting,
+// after validating.
+func (rrpdi *ResourceRunPolicyDeleteInputs) IDs() []object.ID {
+	if rrpdi == nil || len(rrpdi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(rrpdi.Items))
+	for i := range rrpdi.Items {
+		ids[i] = rrpdi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ResourceRunPolicyDeleteInputs entity.
+func (rrpdi *ResourceRunPolicyDeleteInputs) Validate() error {
+	if rrpdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrpdi.ValidateWith(rrpdi.inputConfig.Context, rrpdi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunPolicyDeleteInputs entity with the given context and client set.
+func (rrpdi *ResourceRunPolicyDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrpdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rrpdi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceRunPolicies().Query()
+
+	// Validate when deleting under the Project route.
+	if rrpdi.Project != nil {
+		if err := rrpdi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcerunpolicy.ProjectID(rrpdi.Project.ID))
+		}
+	}
+
+	// Validate when deleting under the Environment route.
+	if rrpdi.Environment != nil {
+		if err := rrpdi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrpdi.Environment = nil
+				q.Where(
+					resourcerunpolicy.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				resourcerunpolicy.EnvironmentID(rrpdi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunpolicy.EnvironmentIDIsNil())
+	}
+
+	ids := make([]object.ID, 0, len(rrpdi.Items))
+	ors := make([]predicate.ResourceRunPolicy, 0, len(rrpdi.Items))
+	indexers := make(map[any][]int)
+
+	for i := range rrpdi.Items {
+		if rrpdi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if rrpdi.Items[i].ID != "" {
+			ids = append(ids, rrpdi.Items[i].ID)
+			ors = append(ors, resourcerunpolicy.ID(rrpdi.Items[i].ID))
+			indexers[rrpdi.Items[i].ID] = append(indexers[rrpdi.Items[i].ID], i)
+		} else if rrpdi.Items[i].Name != "" {
+			ors = append(ors, resourcerunpolicy.And(
+				resourcerunpolicy.Name(rrpdi.Items[i].Name)))
+			indexerKey := fmt.Sprint("/", rrpdi.Items[i].Name)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := resourcerunpolicy.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = resourcerunpolicy.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			resourcerunpolicy.FieldID,
+			resourcerunpolicy.FieldName,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Name)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			rrpdi.Items[j].ID = es[i].ID
+			rrpdi.Items[j].Name = es[i].Name
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunPolicyPatchInput holds the patch input of the ResourceRunPolicy entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunPolicyPatchInput struct {
+	ResourceRunPolicyQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// Enforcement of the policy, deny blocks the run from applying, warn annotates the run only.
+	Enforcement string `path:"-" query:"-" json:"enforcement,omitempty"`
+	// Expression of the policy, evaluates the plan of the run, returns true or a non-empty list of violation messages if violated.
+	Expression string `path:"-" query:"-" json:"expression,omitempty"`
+	// Message to show if the policy is violated.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+	// Indicate whether the policy is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled,omitempty"`
+
+	patchedEntity *ResourceRunPolicy `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the ResourceRunPolicy partition entity for patching.
+func (rrppi *ResourceRunPolicyPatchInput) PatchModel() *ResourceRunPolicy {
+	if rrppi == nil {
+		return nil
+	}
+
+	_rrp := &ResourceRunPolicy{
+		Name:        rrppi.Name,
+		Description: rrppi.Description,
+		Labels:      rrppi.Labels,
+		CreateTime:  rrppi.CreateTime,
+		UpdateTime:  rrppi.UpdateTime,
+		Enforcement: rrppi.Enforcement,
+		Expression:  rrppi.Expression,
+		Message:     rrppi.Message,
+		Disabled:    rrppi.Disabled,
+	}
+
+	if rrppi.Project != nil {
+		_rrp.ProjectID = rrppi.Project.ID
+	}
+	if rrppi.Environment != nil {
+		_rrp.EnvironmentID = rrppi.Environment.ID
+	}
+
+	return _rrp
+}
+
+// Model returns the ResourceRunPolicy patched entity,
+// after validating.
+func (rrppi *ResourceRunPolicyPatchInput) Model() *ResourceRunPolicy {
+	if rrppi == nil {
+		return nil
+	}
+
+	return rrppi.patchedEntity
+}
+
+// Validate checks the ResourceRunPolicyPatchInput entity.
+func (rrppi *ResourceRunPolicyPatchInput) Validate() error {
+	if rrppi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrppi.ValidateWith(rrppi.inputConfig.Context, rrppi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunPolicyPatchInput entity with the given context and client set.
+func (rrppi *ResourceRunPolicyPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := rrppi.ResourceRunPolicyQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.ResourceRunPolicies().Query()
+
+	// Validate when querying under the Project route.
+	if rrppi.Project != nil {
+		if err := rrppi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcerunpolicy.ProjectID(rrppi.Project.ID))
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if rrppi.Environment != nil {
+		if err := rrppi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrppi.Environment = nil
+				q.Where(
+					resourcerunpolicy.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				resourcerunpolicy.EnvironmentID(rrppi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunpolicy.EnvironmentIDIsNil())
+	}
+
+	if rrppi.Refer != nil {
+		if rrppi.Refer.IsID() {
+			q.Where(
+				resourcerunpolicy.ID(rrppi.Refer.ID()))
+		} else if refers := rrppi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				resourcerunpolicy.Name(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of resourcerunpolicy")
+		}
+	} else if rrppi.ID != "" {
+		q.Where(
+			resourcerunpolicy.ID(rrppi.ID))
+	} else if rrppi.Name != "" {
+		q.Where(
+			resourcerunpolicy.Name(rrppi.Name))
+	} else {
+		return errors.New("invalid identify of resourcerunpolicy")
+	}
+
+	q.Select(
+		resourcerunpolicy.WithoutFields(
+			resourcerunpolicy.FieldCreateTime,
+			resourcerunpolicy.FieldUpdateTime,
+		)...,
+	)
+
+	var e *ResourceRunPolicy
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ResourceRunPolicy)
+		}
+	}
+
+	_pm := rrppi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*ResourceRunPolicy)
+
+	if e.Name != _obj.Name {
+		return errors.New("field name is immutable")
+	}
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+
+	rrppi.patchedEntity = _obj
+	return nil
+}
+
+// ResourceRunPolicyQueryInput holds the query input of the ResourceRunPolicy entity,
+// please tags with `path:",inline"` if embedding.
+type ResourceRunPolicyQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query ResourceRunPolicy entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"project"`
+	// Environment indicates to query ResourceRunPolicy entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"environment,omitempty"`
+
+	// Refer holds the route path reference of the ResourceRunPolicy entity.
+	Refer *object.Refer `path:"resourcerunpolicy,default=" query:"-" json:"-"`
+	// ID of the ResourceRunPolicy entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the ResourceRunPolicy entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+}
+
+// Model returns the ResourceRunPolicy entity for querying,
+// after validating.
+func (rrpqi *ResourceRunPolicyQueryInput) Model() *ResourceRunPolicy {
+	if rrpqi == nil {
+		return nil
+	}
+
+	return &ResourceRunPolicy{
+		ID:   rrpqi.ID,
+		Name: rrpqi.Name,
+	}
+}
+
+// Validate checks the ResourceRunPolicyQueryInput entity.
+func (rrpqi *ResourceRunPolicyQueryInput) Validate() error {
+	if rrpqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrpqi.ValidateWith(rrpqi.inputConfig.Context, rrpqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunPolicyQueryInput entity with the given context and client set.
+func (rrpqi *ResourceRunPolicyQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrpqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if rrpqi.Refer != nil && *rrpqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", resourcerunpolicy.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceRunPolicies().Query()
+
+	// Validate when querying under the Project route.
+	if rrpqi.Project != nil {
+		if err := rrpqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcerunpolicy.ProjectID(rrpqi.Project.ID))
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if rrpqi.Environment != nil {
+		if err := rrpqi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrpqi.Environment = nil
+				q.Where(
+					resourcerunpolicy.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				resourcerunpolicy.EnvironmentID(rrpqi.Environment.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunpolicy.EnvironmentIDIsNil())
+	}
+
+	if rrpqi.Refer != nil {
+		if rrpqi.Refer.IsID() {
+			q.Where(
+				resourcerunpolicy.ID(rrpqi.Refer.ID()))
+		} else if refers := rrpqi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				resourcerunpolicy.Name(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of resourcerunpolicy")
+		}
+	} else if rrpqi.ID != "" {
+		q.Where(
+			resourcerunpolicy.ID(rrpqi.ID))
+	} else if rrpqi.Name != "" {
+		q.Where(
+			resourcerunpolicy.Name(rrpqi.Name))
+	} else {
+		return errors.New("invalid identify of resourcerunpolicy")
+	}
+
+	q.Select(
+		resourcerunpolicy.FieldID,
+		resourcerunpolicy.FieldName,
+	)
+
+	var e *ResourceRunPolicy
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ResourceRunPolicy)
+		}
+	}
+
+	rrpqi.ID = e.ID
+	rrpqi.Name = e.Name
+	return nil
+}
+
+// ResourceRunPolicyQueryInputs holds the query input of the ResourceRunPolicy entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type ResourceRunPolicyQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query ResourceRunPolicy entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to query ResourceRunPolicy entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Validate checks the ResourceRunPolicyQueryInputs entity.
+func (rrpqi *ResourceRunPolicyQueryInputs) Validate() error {
+	if rrpqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrpqi.ValidateWith(rrpqi.inputConfig.Context, rrpqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunPolicyQueryInputs entity with the given context and client set.
+func (rrpqi *ResourceRunPolicyQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrpqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when querying under the Project route.
+	if rrpqi.Project != nil {
+		if err := rrpqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if rrpqi.Environment != nil {
+		if err := rrpqi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrpqi.Environment = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunPolicyUpdateInput holds the modification input of the ResourceRunPolicy entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunPolicyUpdateInput struct {
+	ResourceRunPolicyQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Enforcement of the policy, deny blocks the run from applying, warn annotates the run only.
+	Enforcement string `path:"-" query:"-" json:"enforcement,omitempty"`
+	// Expression of the policy, evaluates the plan of the run, returns true or a non-empty list of violation messages if violated.
+	Expression string `path:"-" query:"-" json:"expression,omitempty"`
+	// Message to show if the policy is violated.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+	// Indicate whether the policy is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled,omitempty"`
+}
+
+// Model returns the ResourceRunPolicy entity for modifying,
+// after validating.
+func (rrpui *ResourceRunPolicyUpdateInput) Model() *ResourceRunPolicy {
+	if rrpui == nil {
+		return nil
+	}
+
+	_rrp := &ResourceRunPolicy{
+		ID:          rrpui.ID,
+		Name:        rrpui.Name,
+		Description: rrpui.Description,
+		Labels:      rrpui.Labels,
+		Enforcement: rrpui.Enforcement,
+		Expression:  rrpui.Expression,
+		Message:     rrpui.Message,
+		Disabled:    rrpui.Disabled,
+	}
+
+	return _rrp
+}
+
+// Validate checks the ResourceRunPolicyUpdateInput entity.
+func (rrpui *ResourceRunPolicyUpdateInput) Validate() error {
+	if rrpui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrpui.ValidateWith(rrpui.inputConfig.Context, rrpui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunPolicyUpdateInput entity with the given context and client set.
+func (rrpui *ResourceRunPolicyUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := rrpui.ResourceRunPolicyQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResourceRunPolicyUpdateInputs holds the modification input item of the ResourceRunPolicy entities.
+type ResourceRunPolicyUpdateInputsItem struct {
+	// ID of the ResourceRunPolicy entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the ResourceRunPolicy entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Enforcement of the policy, deny blocks the run from applying, warn annotates the run only.
+	Enforcement string `path:"-" query:"-" json:"enforcement,cli-table-column"`
+	// Expression of the policy, evaluates the plan of the run, returns true or a non-empty list of violation messages if violated.
+	Expression string `path:"-" query:"-" json:"expression"`
+	// Message to show if the policy is violated.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+	// Indicate whether the policy is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled"`
+}
+
+// ValidateWith checks the ResourceRunPolicyUpdateInputsItem entity with the given context and client set.
+func (rrpui *ResourceRunPolicyUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrpui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceRunPolicyUpdateInputs holds the modification input of the ResourceRunPolicy entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceRunPolicyUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to update ResourceRunPolicy entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to update ResourceRunPolicy entity CAN under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceRunPolicyUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceRunPolicy entities for modifying,
+// after validating.
+func (rrpui *ResourceRunPolicyUpdateInputs) Model() []*ResourceRunPolicy {
+	if rrpui == nil || len(rrpui.Items) == 0 {
+		return nil
+	}
+
+	_rrps := make([]*ResourceRunPolicy, len(rrpui.Items))
+
+	for i := range rrpui.Items {
+		_rrp := &ResourceRunPolicy{
+			ID:          rrpui.Items[i].ID,
+			Name:        rrpui.Items[i].Name,
+			Description: rrpui.Items[i].Description,
+			Labels:      rrpui.Items[i].Labels,
+			Enforcement: rrpui.Items[i].Enforcement,
+			Expression:  rrpui.Items[i].Expression,
+			Message:     rrpui.Items[i].Message,
+			Disabled:    rrpui.Items[i].Disabled,
+		}
+
+		_rrps[i] = _rrp
+	}
+
+	return _rrps
+}
+
+// IDs returns the ID list of the ResourceRunPolicy entities for modifying,
+// after validating.
+func (rrpui *ResourceRunPolicyUpdateInputs) IDs() []object.ID {
+	if rrpui == nil || len(rrpui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(rrpui.Items))
+	for i := range rrpui.Items {
+		ids[i] = rrpui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ResourceRunPolicyUpdateInputs entity.
+func (rrpui *ResourceRunPolicyUpdateInputs) Validate() error {
+	if rrpui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rrpui.ValidateWith(rrpui.inputConfig.Context, rrpui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceRunPolicyUpdateInputs entity with the given context and client set.
+func (rrpui *ResourceRunPolicyUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rrpui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rrpui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceRunPolicies().Query()
+
+	// Validate when updating under the Project route.
+	if rrpui.Project != nil {
+		if err := rrpui.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcerunpolicy.ProjectID(rrpui.Project.ID))
+		}
+	}
+
+	// Validate when updating under the Environment route.
+	if rrpui.Environment != nil {
+		if err := rrpui.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rrpui.Environment = nil
+				q.Where(
+					resourcerunpolicy.EnvironmentIDIsNil())
+			}
+		} else {
+			q.Where(
+				resourcerunpolicy.EnvironmentID(rrpui.Environment.ID))
+		}
+	} else {
+		q.Where(
+			resourcerunpolicy.EnvironmentIDIsNil())
+	}
+
+	ids := make([]object.ID, 0, len(rrpui.Items))
+	ors := make([]predicate.ResourceRunPolicy, 0, len(rrpui.Items))
+	indexers := make(map[any][]int)
+
+	for i := range rrpui.Items {
+		if rrpui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if rrpui.Items[i].ID != "" {
+			ids = append(ids, rrpui.Items[i].ID)
+			ors = append(ors, resourcerunpolicy.ID(rrpui.Items[i].ID))
+			indexers[rrpui.Items[i].ID] = append(indexers[rrpui.Items[i].ID], i)
+		} else if rrpui.Items[i].Name != "" {
+			ors = append(ors, resourcerunpolicy.And(
+				resourcerunpolicy.Name(rrpui.Items[i].Name)))
+			indexerKey := fmt.Sprint("/", rrpui.Items[i].Name)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := resourcerunpolicy.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = resourcerunpolicy.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			resourcerunpolicy.FieldID,
+			resourcerunpolicy.FieldName,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Name)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			rrpui.Items[j].ID = es[i].ID
+			rrpui.Items[j].Name = es[i].Name
+		}
+	}
+
+	for i := range rrpui.Items {
+		if err := rrpui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceRunPolicyOutput holds the output of the ResourceRunPolicy entity.
+type ResourceRunPolicyOutput struct {
+	ID          object.ID         `json:"id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	CreateTime  *time.Time        `json:"createTime,omitempty"`
+	UpdateTime  *time.Time        `json:"updateTime,omitempty"`
+	Enforcement string            `json:"enforcement,cli-table-column,omitempty"`
+	Expression  string            `json:"expression,omitempty"`
+	Message     string            `json:"message,omitempty"`
+	Disabled    bool              `json:"disabled,omitempty"`
+
+	Project     *ProjectOutput     `json:"project,omitempty"`
+	Environment *EnvironmentOutput `json:"environment,omitempty"`
+}
+
+// View returns the output of ResourceRunPolicy entity.
+func (_rrp *ResourceRunPolicy) View() *ResourceRunPolicyOutput {
+	return ExposeResourceRunPolicy(_rrp)
+}
+
+// View returns the output of ResourceRunPolicy entities.
+func (_rrps ResourceRunPolicies) View() []*ResourceRunPolicyOutput {
+	return ExposeResourceRunPolicies(_rrps)
+}
+
+// ExposeResourceRunPolicy converts the ResourceRunPolicy to ResourceRunPolicyOutput.
+func ExposeResourceRunPolicy(_rrp *ResourceRunPolicy) *ResourceRunPolicyOutput {
+	if _rrp == nil {
+		return nil
+	}
+
+	rrpo := &ResourceRunPolicyOutput{
+		ID:          _rrp.ID,
+		Name:        _rrp.Name,
+		Description: _rrp.Description,
+		Labels:      _rrp.Labels,
+		CreateTime:  _rrp.CreateTime,
+		UpdateTime:  _rrp.UpdateTime,
+		Enforcement: _rrp.Enforcement,
+		Expression:  _rrp.Expression,
+		Message:     _rrp.Message,
+		Disabled:    _rrp.Disabled,
+	}
+
+	if _rrp.Edges.Project != nil {
+		rrpo.Project = ExposeProject(_rrp.Edges.Project)
+	} else if _rrp.ProjectID != "" {
+		rrpo.Project = &ProjectOutput{
+			ID: _rrp.ProjectID,
+		}
+	}
+	if _rrp.Edges.Environment != nil {
+		rrpo.Environment = ExposeEnvironment(_rrp.Edges.Environment)
+	} else if _rrp.EnvironmentID != "" {
+		rrpo.Environment = &EnvironmentOutput{
+			ID: _rrp.EnvironmentID,
+		}
+	}
+	return rrpo
+}
+
+// ExposeResourceRunPolicies converts the ResourceRunPolicy slice to ResourceRunPolicyOutput pointer slice.
+func ExposeResourceRunPolicies(_rrps []*ResourceRunPolicy) []*ResourceRunPolicyOutput {
+	if len(_rrps) == 0 {
+		return nil
+	}
+
+	rrpos := make([]*ResourceRunPolicyOutput, len(_rrps))
+	for i := range _rrps {
+		rrpos[i] = ExposeResourceRunPolicy(_rrps[i])
+	}
+	return rrpos
+}
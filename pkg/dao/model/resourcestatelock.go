@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ResourceStateLock is the model entity for the ResourceStateLock schema.
+type ResourceStateLock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// ID of the resource to which the locked state belongs.
+	ResourceID object.ID `json:"resource_id,omitempty"`
+	// ID of the run which holds the lock, empty if the lock is not requested by the run's deployment.
+	RunID object.ID `json:"run_id,omitempty"`
+	// ID of the lock generated by the client.
+	LockID string `json:"lock_id,omitempty"`
+	// Holder of the lock.
+	Holder string `json:"holder,omitempty"`
+	// Original lock information submitted by the client.
+	Info types.ResourceStateLockInfo `json:"info,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResourceStateLockQuery when eager-loading is set.
+	Edges        ResourceStateLockEdges `json:"edges,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// ResourceStateLockEdges holds the relations/edges for other nodes in the graph.
+type ResourceStateLockEdges struct {
+	// Resource to which the locked state belongs.
+	Resource *Resource `json:"resource,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ResourceOrErr returns the Resource value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceStateLockEdges) ResourceOrErr() (*Resource, error) {
+	if e.loadedTypes[0] {
+		if e.Resource == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: resource.Label}
+		}
+		return e.Resource, nil
+	}
+	return nil, &NotLoadedError{edge: "resource"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResourceStateLock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resourcestatelock.FieldInfo:
+			values[i] = new([]byte)
+		case resourcestatelock.FieldID, resourcestatelock.FieldResourceID, resourcestatelock.FieldRunID:
+			values[i] = new(object.ID)
+		case resourcestatelock.FieldLockID, resourcestatelock.FieldHolder:
+			values[i] = new(sql.NullString)
+		case resourcestatelock.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResourceStateLock fields.
+func (rsl *ResourceStateLock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resourcestatelock.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rsl.ID = *value
+			}
+		case resourcestatelock.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				rsl.CreateTime = new(time.Time)
+				*rsl.CreateTime = value.Time
+			}
+		case resourcestatelock.FieldResourceID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value != nil {
+				rsl.ResourceID = *value
+			}
+		case resourcestatelock.FieldRunID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value != nil {
+				rsl.RunID = *value
+			}
+		case resourcestatelock.FieldLockID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lock_id", values[i])
+			} else if value.Valid {
+				rsl.LockID = value.String
+			}
+		case resourcestatelock.FieldHolder:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field holder", values[i])
+			} else if value.Valid {
+				rsl.Holder = value.String
+			}
+		case resourcestatelock.FieldInfo:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field info", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rsl.Info); err != nil {
+					return fmt.Errorf("unmarshal field info: %w", err)
+				}
+			}
+		default:
+			rsl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResourceStateLock.
+// This includes values selected through modifiers, order, etc.
+func (rsl *ResourceStateLock) Value(name string) (ent.Value, error) {
+	return rsl.selectValues.Get(name)
+}
+
+// QueryResource queries the "resource" edge of the ResourceStateLock entity.
+func (rsl *ResourceStateLock) QueryResource() *ResourceQuery {
+	return NewResourceStateLockClient(rsl.config).QueryResource(rsl)
+}
+
+// Update returns a builder for updating this ResourceStateLock.
+// Note that you need to call ResourceStateLock.Unwrap() before calling this method if this ResourceStateLock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rsl *ResourceStateLock) Update() *ResourceStateLockUpdateOne {
+	return NewResourceStateLockClient(rsl.config).UpdateOne(rsl)
+}
+
+// Unwrap unwraps the ResourceStateLock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rsl *ResourceStateLock) Unwrap() *ResourceStateLock {
+	_tx, ok := rsl.config.driver.(*txDriver)
+	if !ok {
+		panic("model: ResourceStateLock is not a transactional entity")
+	}
+	rsl.config.driver = _tx.drv
+	return rsl
+}
+
+// String implements the fmt.Stringer.
+func (rsl *ResourceStateLock) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResourceStateLock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rsl.ID))
+	if v := rsl.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(fmt.Sprintf("%v", rsl.ResourceID))
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", rsl.RunID))
+	builder.WriteString(", ")
+	builder.WriteString("lock_id=")
+	builder.WriteString(rsl.LockID)
+	builder.WriteString(", ")
+	builder.WriteString("holder=")
+	builder.WriteString(rsl.Holder)
+	builder.WriteString(", ")
+	builder.WriteString("info=")
+	builder.WriteString(fmt.Sprintf("%v", rsl.Info))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResourceStateLocks is a parsable slice of ResourceStateLock.
+type ResourceStateLocks []*ResourceStateLock
@@ -0,0 +1,451 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package resourcestatelock
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldResourceID, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldRunID, v))
+}
+
+// LockID applies equality check predicate on the "lock_id" field. It's identical to LockIDEQ.
+func LockID(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldLockID, v))
+}
+
+// Holder applies equality check predicate on the "holder" field. It's identical to HolderEQ.
+func Holder(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldHolder, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldContains(FieldResourceID, vc))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldHasPrefix(FieldResourceID, vc))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldHasSuffix(FieldResourceID, vc))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldEqualFold(FieldResourceID, vc))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldContainsFold(FieldResourceID, vc))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// RunIDGT applies the GT predicate on the "run_id" field.
+func RunIDGT(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGT(FieldRunID, v))
+}
+
+// RunIDGTE applies the GTE predicate on the "run_id" field.
+func RunIDGTE(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGTE(FieldRunID, v))
+}
+
+// RunIDLT applies the LT predicate on the "run_id" field.
+func RunIDLT(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLT(FieldRunID, v))
+}
+
+// RunIDLTE applies the LTE predicate on the "run_id" field.
+func RunIDLTE(v object.ID) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLTE(FieldRunID, v))
+}
+
+// RunIDContains applies the Contains predicate on the "run_id" field.
+func RunIDContains(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldContains(FieldRunID, vc))
+}
+
+// RunIDHasPrefix applies the HasPrefix predicate on the "run_id" field.
+func RunIDHasPrefix(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldHasPrefix(FieldRunID, vc))
+}
+
+// RunIDHasSuffix applies the HasSuffix predicate on the "run_id" field.
+func RunIDHasSuffix(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldHasSuffix(FieldRunID, vc))
+}
+
+// RunIDIsNil applies the IsNil predicate on the "run_id" field.
+func RunIDIsNil() predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldIsNull(FieldRunID))
+}
+
+// RunIDNotNil applies the NotNil predicate on the "run_id" field.
+func RunIDNotNil() predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNotNull(FieldRunID))
+}
+
+// RunIDEqualFold applies the EqualFold predicate on the "run_id" field.
+func RunIDEqualFold(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldEqualFold(FieldRunID, vc))
+}
+
+// RunIDContainsFold applies the ContainsFold predicate on the "run_id" field.
+func RunIDContainsFold(v object.ID) predicate.ResourceStateLock {
+	vc := string(v)
+	return predicate.ResourceStateLock(sql.FieldContainsFold(FieldRunID, vc))
+}
+
+// LockIDEQ applies the EQ predicate on the "lock_id" field.
+func LockIDEQ(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldLockID, v))
+}
+
+// LockIDNEQ applies the NEQ predicate on the "lock_id" field.
+func LockIDNEQ(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNEQ(FieldLockID, v))
+}
+
+// LockIDIn applies the In predicate on the "lock_id" field.
+func LockIDIn(vs ...string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldIn(FieldLockID, vs...))
+}
+
+// LockIDNotIn applies the NotIn predicate on the "lock_id" field.
+func LockIDNotIn(vs ...string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNotIn(FieldLockID, vs...))
+}
+
+// LockIDGT applies the GT predicate on the "lock_id" field.
+func LockIDGT(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGT(FieldLockID, v))
+}
+
+// LockIDGTE applies the GTE predicate on the "lock_id" field.
+func LockIDGTE(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGTE(FieldLockID, v))
+}
+
+// LockIDLT applies the LT predicate on the "lock_id" field.
+func LockIDLT(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLT(FieldLockID, v))
+}
+
+// LockIDLTE applies the LTE predicate on the "lock_id" field.
+func LockIDLTE(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLTE(FieldLockID, v))
+}
+
+// LockIDContains applies the Contains predicate on the "lock_id" field.
+func LockIDContains(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldContains(FieldLockID, v))
+}
+
+// LockIDHasPrefix applies the HasPrefix predicate on the "lock_id" field.
+func LockIDHasPrefix(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldHasPrefix(FieldLockID, v))
+}
+
+// LockIDHasSuffix applies the HasSuffix predicate on the "lock_id" field.
+func LockIDHasSuffix(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldHasSuffix(FieldLockID, v))
+}
+
+// LockIDEqualFold applies the EqualFold predicate on the "lock_id" field.
+func LockIDEqualFold(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEqualFold(FieldLockID, v))
+}
+
+// LockIDContainsFold applies the ContainsFold predicate on the "lock_id" field.
+func LockIDContainsFold(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldContainsFold(FieldLockID, v))
+}
+
+// HolderEQ applies the EQ predicate on the "holder" field.
+func HolderEQ(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEQ(FieldHolder, v))
+}
+
+// HolderNEQ applies the NEQ predicate on the "holder" field.
+func HolderNEQ(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNEQ(FieldHolder, v))
+}
+
+// HolderIn applies the In predicate on the "holder" field.
+func HolderIn(vs ...string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldIn(FieldHolder, vs...))
+}
+
+// HolderNotIn applies the NotIn predicate on the "holder" field.
+func HolderNotIn(vs ...string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldNotIn(FieldHolder, vs...))
+}
+
+// HolderGT applies the GT predicate on the "holder" field.
+func HolderGT(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGT(FieldHolder, v))
+}
+
+// HolderGTE applies the GTE predicate on the "holder" field.
+func HolderGTE(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldGTE(FieldHolder, v))
+}
+
+// HolderLT applies the LT predicate on the "holder" field.
+func HolderLT(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLT(FieldHolder, v))
+}
+
+// HolderLTE applies the LTE predicate on the "holder" field.
+func HolderLTE(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldLTE(FieldHolder, v))
+}
+
+// HolderContains applies the Contains predicate on the "holder" field.
+func HolderContains(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldContains(FieldHolder, v))
+}
+
+// HolderHasPrefix applies the HasPrefix predicate on the "holder" field.
+func HolderHasPrefix(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldHasPrefix(FieldHolder, v))
+}
+
+// HolderHasSuffix applies the HasSuffix predicate on the "holder" field.
+func HolderHasSuffix(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldHasSuffix(FieldHolder, v))
+}
+
+// HolderEqualFold applies the EqualFold predicate on the "holder" field.
+func HolderEqualFold(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldEqualFold(FieldHolder, v))
+}
+
+// HolderContainsFold applies the ContainsFold predicate on the "holder" field.
+func HolderContainsFold(v string) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.FieldContainsFold(FieldHolder, v))
+}
+
+// HasResource applies the HasEdge predicate on the "resource" edge.
+func HasResource() predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ResourceTable, ResourceColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceStateLock
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceWith applies the HasEdge predicate on the "resource" edge with a given conditions (other predicates).
+func HasResourceWith(preds ...predicate.Resource) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(func(s *sql.Selector) {
+		step := newResourceStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceStateLock
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResourceStateLock) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResourceStateLock) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResourceStateLock) predicate.ResourceStateLock {
+	return predicate.ResourceStateLock(sql.NotPredicates(p))
+}
@@ -0,0 +1,921 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceStateLockCreate is the builder for creating a ResourceStateLock entity.
+type ResourceStateLockCreate struct {
+	config
+	mutation   *ResourceStateLockMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *ResourceStateLock
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (rslc *ResourceStateLockCreate) SetCreateTime(t time.Time) *ResourceStateLockCreate {
+	rslc.mutation.SetCreateTime(t)
+	return rslc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (rslc *ResourceStateLockCreate) SetNillableCreateTime(t *time.Time) *ResourceStateLockCreate {
+	if t != nil {
+		rslc.SetCreateTime(*t)
+	}
+	return rslc
+}
+
+// SetResourceID sets the "resource_id" field.
+func (rslc *ResourceStateLockCreate) SetResourceID(o object.ID) *ResourceStateLockCreate {
+	rslc.mutation.SetResourceID(o)
+	return rslc
+}
+
+// SetRunID sets the "run_id" field.
+func (rslc *ResourceStateLockCreate) SetRunID(o object.ID) *ResourceStateLockCreate {
+	rslc.mutation.SetRunID(o)
+	return rslc
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (rslc *ResourceStateLockCreate) SetNillableRunID(o *object.ID) *ResourceStateLockCreate {
+	if o != nil {
+		rslc.SetRunID(*o)
+	}
+	return rslc
+}
+
+// SetLockID sets the "lock_id" field.
+func (rslc *ResourceStateLockCreate) SetLockID(s string) *ResourceStateLockCreate {
+	rslc.mutation.SetLockID(s)
+	return rslc
+}
+
+// SetHolder sets the "holder" field.
+func (rslc *ResourceStateLockCreate) SetHolder(s string) *ResourceStateLockCreate {
+	rslc.mutation.SetHolder(s)
+	return rslc
+}
+
+// SetInfo sets the "info" field.
+func (rslc *ResourceStateLockCreate) SetInfo(tsli types.ResourceStateLockInfo) *ResourceStateLockCreate {
+	rslc.mutation.SetInfo(tsli)
+	return rslc
+}
+
+// SetID sets the "id" field.
+func (rslc *ResourceStateLockCreate) SetID(o object.ID) *ResourceStateLockCreate {
+	rslc.mutation.SetID(o)
+	return rslc
+}
+
+// SetResource sets the "resource" edge to the Resource entity.
+func (rslc *ResourceStateLockCreate) SetResource(r *Resource) *ResourceStateLockCreate {
+	return rslc.SetResourceID(r.ID)
+}
+
+// Mutation returns the ResourceStateLockMutation object of the builder.
+func (rslc *ResourceStateLockCreate) Mutation() *ResourceStateLockMutation {
+	return rslc.mutation
+}
+
+// Save creates the ResourceStateLock in the database.
+func (rslc *ResourceStateLockCreate) Save(ctx context.Context) (*ResourceStateLock, error) {
+	if err := rslc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rslc.sqlSave, rslc.mutation, rslc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rslc *ResourceStateLockCreate) SaveX(ctx context.Context) *ResourceStateLock {
+	v, err := rslc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rslc *ResourceStateLockCreate) Exec(ctx context.Context) error {
+	_, err := rslc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rslc *ResourceStateLockCreate) ExecX(ctx context.Context) {
+	if err := rslc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rslc *ResourceStateLockCreate) defaults() error {
+	if _, ok := rslc.mutation.CreateTime(); !ok {
+		if resourcestatelock.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcestatelock.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := resourcestatelock.DefaultCreateTime()
+		rslc.mutation.SetCreateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rslc *ResourceStateLockCreate) check() error {
+	if _, ok := rslc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "ResourceStateLock.create_time"`)}
+	}
+	if _, ok := rslc.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`model: missing required field "ResourceStateLock.resource_id"`)}
+	}
+	if v, ok := rslc.mutation.ResourceID(); ok {
+		if err := resourcestatelock.ResourceIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`model: validator failed for field "ResourceStateLock.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := rslc.mutation.LockID(); !ok {
+		return &ValidationError{Name: "lock_id", err: errors.New(`model: missing required field "ResourceStateLock.lock_id"`)}
+	}
+	if v, ok := rslc.mutation.LockID(); ok {
+		if err := resourcestatelock.LockIDValidator(v); err != nil {
+			return &ValidationError{Name: "lock_id", err: fmt.Errorf(`model: validator failed for field "ResourceStateLock.lock_id": %w`, err)}
+		}
+	}
+	if _, ok := rslc.mutation.Holder(); !ok {
+		return &ValidationError{Name: "holder", err: errors.New(`model: missing required field "ResourceStateLock.holder"`)}
+	}
+	if _, ok := rslc.mutation.Info(); !ok {
+		return &ValidationError{Name: "info", err: errors.New(`model: missing required field "ResourceStateLock.info"`)}
+	}
+	if _, ok := rslc.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource", err: errors.New(`model: missing required edge "ResourceStateLock.resource"`)}
+	}
+	return nil
+}
+
+func (rslc *ResourceStateLockCreate) sqlSave(ctx context.Context) (*ResourceStateLock, error) {
+	if err := rslc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rslc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rslc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rslc.mutation.id = &_node.ID
+	rslc.mutation.done = true
+	return _node, nil
+}
+
+func (rslc *ResourceStateLockCreate) createSpec() (*ResourceStateLock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResourceStateLock{config: rslc.config}
+		_spec = sqlgraph.NewCreateSpec(resourcestatelock.Table, sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString))
+	)
+	_spec.Schema = rslc.schemaConfig.ResourceStateLock
+	_spec.OnConflict = rslc.conflict
+	if id, ok := rslc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rslc.mutation.CreateTime(); ok {
+		_spec.SetField(resourcestatelock.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := rslc.mutation.RunID(); ok {
+		_spec.SetField(resourcestatelock.FieldRunID, field.TypeString, value)
+		_node.RunID = value
+	}
+	if value, ok := rslc.mutation.LockID(); ok {
+		_spec.SetField(resourcestatelock.FieldLockID, field.TypeString, value)
+		_node.LockID = value
+	}
+	if value, ok := rslc.mutation.Holder(); ok {
+		_spec.SetField(resourcestatelock.FieldHolder, field.TypeString, value)
+		_node.Holder = value
+	}
+	if value, ok := rslc.mutation.Info(); ok {
+		_spec.SetField(resourcestatelock.FieldInfo, field.TypeJSON, value)
+		_node.Info = value
+	}
+	if nodes := rslc.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   resourcestatelock.ResourceTable,
+			Columns: []string{resourcestatelock.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resource.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rslc.schemaConfig.ResourceStateLock
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResourceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rslc *ResourceStateLockCreate) Set(obj *ResourceStateLock) *ResourceStateLockCreate {
+	// Required.
+	rslc.SetResourceID(obj.ResourceID)
+	rslc.SetLockID(obj.LockID)
+	rslc.SetHolder(obj.Holder)
+	rslc.SetInfo(obj.Info)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		rslc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.RunID != "" {
+		rslc.SetRunID(obj.RunID)
+	}
+
+	// Record the given object.
+	rslc.object = obj
+
+	return rslc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rslc *ResourceStateLockCreate) getClientSet() (mc ClientSet) {
+	if _, ok := rslc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rslc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rslc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ResourceStateLock entity,
+// which is always good for cascading create operations.
+func (rslc *ResourceStateLockCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateLock) error) (*ResourceStateLock, error) {
+	obj, err := rslc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := rslc.getClientSet()
+	if rslc.fromUpsert {
+		q := mc.ResourceStateLocks().Query().
+			Where(
+				resourcestatelock.ResourceID(obj.ResourceID),
+			)
+		obj.ID, err = q.OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("model: failed to query id of ResourceStateLock entity: %w", err)
+		}
+	}
+
+	if x := rslc.object; x != nil {
+		if _, set := rslc.mutation.Field(resourcestatelock.FieldResourceID); set {
+			obj.ResourceID = x.ResourceID
+		}
+		if _, set := rslc.mutation.Field(resourcestatelock.FieldRunID); set {
+			obj.RunID = x.RunID
+		}
+		if _, set := rslc.mutation.Field(resourcestatelock.FieldLockID); set {
+			obj.LockID = x.LockID
+		}
+		if _, set := rslc.mutation.Field(resourcestatelock.FieldHolder); set {
+			obj.Holder = x.Holder
+		}
+		if _, set := rslc.mutation.Field(resourcestatelock.FieldInfo); set {
+			obj.Info = x.Info
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rslc *ResourceStateLockCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateLock) error) *ResourceStateLock {
+	obj, err := rslc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (rslc *ResourceStateLockCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateLock) error) error {
+	_, err := rslc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (rslc *ResourceStateLockCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateLock) error) {
+	if err := rslc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the ResourceStateLockCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rslcb *ResourceStateLockCreateBulk) Set(objs ...*ResourceStateLock) *ResourceStateLockCreateBulk {
+	if len(objs) != 0 {
+		client := NewResourceStateLockClient(rslcb.config)
+
+		rslcb.builders = make([]*ResourceStateLockCreate, len(objs))
+		for i := range objs {
+			rslcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		rslcb.objects = objs
+	}
+
+	return rslcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rslcb *ResourceStateLockCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := rslcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rslcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rslcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ResourceStateLock entities,
+// which is always good for cascading create operations.
+func (rslcb *ResourceStateLockCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateLock) error) ([]*ResourceStateLock, error) {
+	objs, err := rslcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := rslcb.getClientSet()
+	if rslcb.fromUpsert {
+		for i := range objs {
+			obj := objs[i]
+			q := mc.ResourceStateLocks().Query().
+				Where(
+					resourcestatelock.ResourceID(obj.ResourceID),
+				)
+			objs[i].ID, err = q.OnlyID(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("model: failed to query id of ResourceStateLock entity: %w", err)
+			}
+		}
+	}
+
+	if x := rslcb.objects; x != nil {
+		for i := range x {
+			if _, set := rslcb.builders[i].mutation.Field(resourcestatelock.FieldResourceID); set {
+				objs[i].ResourceID = x[i].ResourceID
+			}
+			if _, set := rslcb.builders[i].mutation.Field(resourcestatelock.FieldRunID); set {
+				objs[i].RunID = x[i].RunID
+			}
+			if _, set := rslcb.builders[i].mutation.Field(resourcestatelock.FieldLockID); set {
+				objs[i].LockID = x[i].LockID
+			}
+			if _, set := rslcb.builders[i].mutation.Field(resourcestatelock.FieldHolder); set {
+				objs[i].Holder = x[i].Holder
+			}
+			if _, set := rslcb.builders[i].mutation.Field(resourcestatelock.FieldInfo); set {
+				objs[i].Info = x[i].Info
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rslcb *ResourceStateLockCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateLock) error) []*ResourceStateLock {
+	objs, err := rslcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (rslcb *ResourceStateLockCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateLock) error) error {
+	_, err := rslcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (rslcb *ResourceStateLockCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateLock) error) {
+	if err := rslcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ResourceStateLockUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceStateLock) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceStateLockUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ResourceStateLockUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceStateLock) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ResourceStateLockUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceStateLock) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ResourceStateLockUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceStateLockUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ResourceStateLockUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceStateLock) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceStateLock.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceStateLockUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (rslc *ResourceStateLockCreate) OnConflict(opts ...sql.ConflictOption) *ResourceStateLockUpsertOne {
+	rslc.conflict = opts
+	return &ResourceStateLockUpsertOne{
+		create: rslc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceStateLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rslc *ResourceStateLockCreate) OnConflictColumns(columns ...string) *ResourceStateLockUpsertOne {
+	rslc.conflict = append(rslc.conflict, sql.ConflictColumns(columns...))
+	return &ResourceStateLockUpsertOne{
+		create: rslc,
+	}
+}
+
+type (
+	// ResourceStateLockUpsertOne is the builder for "upsert"-ing
+	//  one ResourceStateLock node.
+	ResourceStateLockUpsertOne struct {
+		create *ResourceStateLockCreate
+	}
+
+	// ResourceStateLockUpsert is the "OnConflict" setter.
+	ResourceStateLockUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ResourceStateLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resourcestatelock.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResourceStateLockUpsertOne) UpdateNewValues() *ResourceStateLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(resourcestatelock.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(resourcestatelock.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.ResourceID(); exists {
+			s.SetIgnore(resourcestatelock.FieldResourceID)
+		}
+		if _, exists := u.create.mutation.RunID(); exists {
+			s.SetIgnore(resourcestatelock.FieldRunID)
+		}
+		if _, exists := u.create.mutation.LockID(); exists {
+			s.SetIgnore(resourcestatelock.FieldLockID)
+		}
+		if _, exists := u.create.mutation.Holder(); exists {
+			s.SetIgnore(resourcestatelock.FieldHolder)
+		}
+		if _, exists := u.create.mutation.Info(); exists {
+			s.SetIgnore(resourcestatelock.FieldInfo)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceStateLock.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResourceStateLockUpsertOne) Ignore() *ResourceStateLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceStateLockUpsertOne) DoNothing() *ResourceStateLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceStateLockCreate.OnConflict
+// documentation for more info.
+func (u *ResourceStateLockUpsertOne) Update(set func(*ResourceStateLockUpsert)) *ResourceStateLockUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceStateLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ResourceStateLockUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceStateLockCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceStateLockUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResourceStateLockUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: ResourceStateLockUpsertOne.ID is not supported by MySQL driver. Use ResourceStateLockUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResourceStateLockUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResourceStateLockCreateBulk is the builder for creating many ResourceStateLock entities in bulk.
+type ResourceStateLockCreateBulk struct {
+	config
+	err        error
+	builders   []*ResourceStateLockCreate
+	conflict   []sql.ConflictOption
+	objects    []*ResourceStateLock
+	fromUpsert bool
+}
+
+// Save creates the ResourceStateLock entities in the database.
+func (rslcb *ResourceStateLockCreateBulk) Save(ctx context.Context) ([]*ResourceStateLock, error) {
+	if rslcb.err != nil {
+		return nil, rslcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rslcb.builders))
+	nodes := make([]*ResourceStateLock, len(rslcb.builders))
+	mutators := make([]Mutator, len(rslcb.builders))
+	for i := range rslcb.builders {
+		func(i int, root context.Context) {
+			builder := rslcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResourceStateLockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rslcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rslcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rslcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rslcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rslcb *ResourceStateLockCreateBulk) SaveX(ctx context.Context) []*ResourceStateLock {
+	v, err := rslcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rslcb *ResourceStateLockCreateBulk) Exec(ctx context.Context) error {
+	_, err := rslcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rslcb *ResourceStateLockCreateBulk) ExecX(ctx context.Context) {
+	if err := rslcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceStateLock.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceStateLockUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (rslcb *ResourceStateLockCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResourceStateLockUpsertBulk {
+	rslcb.conflict = opts
+	return &ResourceStateLockUpsertBulk{
+		create: rslcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceStateLock.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rslcb *ResourceStateLockCreateBulk) OnConflictColumns(columns ...string) *ResourceStateLockUpsertBulk {
+	rslcb.conflict = append(rslcb.conflict, sql.ConflictColumns(columns...))
+	return &ResourceStateLockUpsertBulk{
+		create: rslcb,
+	}
+}
+
+// ResourceStateLockUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResourceStateLock nodes.
+type ResourceStateLockUpsertBulk struct {
+	create *ResourceStateLockCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResourceStateLock.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resourcestatelock.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResourceStateLockUpsertBulk) UpdateNewValues() *ResourceStateLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(resourcestatelock.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(resourcestatelock.FieldCreateTime)
+			}
+			if _, exists := b.mutation.ResourceID(); exists {
+				s.SetIgnore(resourcestatelock.FieldResourceID)
+			}
+			if _, exists := b.mutation.RunID(); exists {
+				s.SetIgnore(resourcestatelock.FieldRunID)
+			}
+			if _, exists := b.mutation.LockID(); exists {
+				s.SetIgnore(resourcestatelock.FieldLockID)
+			}
+			if _, exists := b.mutation.Holder(); exists {
+				s.SetIgnore(resourcestatelock.FieldHolder)
+			}
+			if _, exists := b.mutation.Info(); exists {
+				s.SetIgnore(resourcestatelock.FieldInfo)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceStateLock.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResourceStateLockUpsertBulk) Ignore() *ResourceStateLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceStateLockUpsertBulk) DoNothing() *ResourceStateLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceStateLockCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResourceStateLockUpsertBulk) Update(set func(*ResourceStateLockUpsert)) *ResourceStateLockUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceStateLockUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ResourceStateLockUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ResourceStateLockCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceStateLockCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceStateLockUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+)
+
+// ResourceStateLockDelete is the builder for deleting a ResourceStateLock entity.
+type ResourceStateLockDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResourceStateLockMutation
+}
+
+// Where appends a list predicates to the ResourceStateLockDelete builder.
+func (rsld *ResourceStateLockDelete) Where(ps ...predicate.ResourceStateLock) *ResourceStateLockDelete {
+	rsld.mutation.Where(ps...)
+	return rsld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rsld *ResourceStateLockDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsld.sqlExec, rsld.mutation, rsld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsld *ResourceStateLockDelete) ExecX(ctx context.Context) int {
+	n, err := rsld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rsld *ResourceStateLockDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(resourcestatelock.Table, sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString))
+	_spec.Node.Schema = rsld.schemaConfig.ResourceStateLock
+	ctx = internal.NewSchemaConfigContext(ctx, rsld.schemaConfig)
+	if ps := rsld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rsld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rsld.mutation.done = true
+	return affected, err
+}
+
+// ResourceStateLockDeleteOne is the builder for deleting a single ResourceStateLock entity.
+type ResourceStateLockDeleteOne struct {
+	rsld *ResourceStateLockDelete
+}
+
+// Where appends a list predicates to the ResourceStateLockDelete builder.
+func (rsldo *ResourceStateLockDeleteOne) Where(ps ...predicate.ResourceStateLock) *ResourceStateLockDeleteOne {
+	rsldo.rsld.mutation.Where(ps...)
+	return rsldo
+}
+
+// Exec executes the deletion query.
+func (rsldo *ResourceStateLockDeleteOne) Exec(ctx context.Context) error {
+	n, err := rsldo.rsld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{resourcestatelock.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsldo *ResourceStateLockDeleteOne) ExecX(ctx context.Context) {
+	if err := rsldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
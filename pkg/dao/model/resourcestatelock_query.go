@@ -0,0 +1,680 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceStateLockQuery is the builder for querying ResourceStateLock entities.
+type ResourceStateLockQuery struct {
+	config
+	ctx          *QueryContext
+	order        []resourcestatelock.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.ResourceStateLock
+	withResource *ResourceQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResourceStateLockQuery builder.
+func (rslq *ResourceStateLockQuery) Where(ps ...predicate.ResourceStateLock) *ResourceStateLockQuery {
+	rslq.predicates = append(rslq.predicates, ps...)
+	return rslq
+}
+
+// Limit the number of records to be returned by this query.
+func (rslq *ResourceStateLockQuery) Limit(limit int) *ResourceStateLockQuery {
+	rslq.ctx.Limit = &limit
+	return rslq
+}
+
+// Offset to start from.
+func (rslq *ResourceStateLockQuery) Offset(offset int) *ResourceStateLockQuery {
+	rslq.ctx.Offset = &offset
+	return rslq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rslq *ResourceStateLockQuery) Unique(unique bool) *ResourceStateLockQuery {
+	rslq.ctx.Unique = &unique
+	return rslq
+}
+
+// Order specifies how the records should be ordered.
+func (rslq *ResourceStateLockQuery) Order(o ...resourcestatelock.OrderOption) *ResourceStateLockQuery {
+	rslq.order = append(rslq.order, o...)
+	return rslq
+}
+
+// QueryResource chains the current query on the "resource" edge.
+func (rslq *ResourceStateLockQuery) QueryResource() *ResourceQuery {
+	query := (&ResourceClient{config: rslq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rslq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rslq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcestatelock.Table, resourcestatelock.FieldID, selector),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, resourcestatelock.ResourceTable, resourcestatelock.ResourceColumn),
+		)
+		schemaConfig := rslq.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceStateLock
+		fromU = sqlgraph.SetNeighbors(rslq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResourceStateLock entity from the query.
+// Returns a *NotFoundError when no ResourceStateLock was found.
+func (rslq *ResourceStateLockQuery) First(ctx context.Context) (*ResourceStateLock, error) {
+	nodes, err := rslq.Limit(1).All(setContextOp(ctx, rslq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{resourcestatelock.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rslq *ResourceStateLockQuery) FirstX(ctx context.Context) *ResourceStateLock {
+	node, err := rslq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResourceStateLock ID from the query.
+// Returns a *NotFoundError when no ResourceStateLock ID was found.
+func (rslq *ResourceStateLockQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = rslq.Limit(1).IDs(setContextOp(ctx, rslq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{resourcestatelock.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rslq *ResourceStateLockQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := rslq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResourceStateLock entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResourceStateLock entity is found.
+// Returns a *NotFoundError when no ResourceStateLock entities are found.
+func (rslq *ResourceStateLockQuery) Only(ctx context.Context) (*ResourceStateLock, error) {
+	nodes, err := rslq.Limit(2).All(setContextOp(ctx, rslq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{resourcestatelock.Label}
+	default:
+		return nil, &NotSingularError{resourcestatelock.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rslq *ResourceStateLockQuery) OnlyX(ctx context.Context) *ResourceStateLock {
+	node, err := rslq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResourceStateLock ID in the query.
+// Returns a *NotSingularError when more than one ResourceStateLock ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rslq *ResourceStateLockQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = rslq.Limit(2).IDs(setContextOp(ctx, rslq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{resourcestatelock.Label}
+	default:
+		err = &NotSingularError{resourcestatelock.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rslq *ResourceStateLockQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := rslq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResourceStateLocks.
+func (rslq *ResourceStateLockQuery) All(ctx context.Context) ([]*ResourceStateLock, error) {
+	ctx = setContextOp(ctx, rslq.ctx, "All")
+	if err := rslq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResourceStateLock, *ResourceStateLockQuery]()
+	return withInterceptors[[]*ResourceStateLock](ctx, rslq, qr, rslq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rslq *ResourceStateLockQuery) AllX(ctx context.Context) []*ResourceStateLock {
+	nodes, err := rslq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResourceStateLock IDs.
+func (rslq *ResourceStateLockQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if rslq.ctx.Unique == nil && rslq.path != nil {
+		rslq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rslq.ctx, "IDs")
+	if err = rslq.Select(resourcestatelock.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rslq *ResourceStateLockQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := rslq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rslq *ResourceStateLockQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rslq.ctx, "Count")
+	if err := rslq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rslq, querierCount[*ResourceStateLockQuery](), rslq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rslq *ResourceStateLockQuery) CountX(ctx context.Context) int {
+	count, err := rslq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rslq *ResourceStateLockQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rslq.ctx, "Exist")
+	switch _, err := rslq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rslq *ResourceStateLockQuery) ExistX(ctx context.Context) bool {
+	exist, err := rslq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResourceStateLockQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rslq *ResourceStateLockQuery) Clone() *ResourceStateLockQuery {
+	if rslq == nil {
+		return nil
+	}
+	return &ResourceStateLockQuery{
+		config:       rslq.config,
+		ctx:          rslq.ctx.Clone(),
+		order:        append([]resourcestatelock.OrderOption{}, rslq.order...),
+		inters:       append([]Interceptor{}, rslq.inters...),
+		predicates:   append([]predicate.ResourceStateLock{}, rslq.predicates...),
+		withResource: rslq.withResource.Clone(),
+		// clone intermediate query.
+		sql:  rslq.sql.Clone(),
+		path: rslq.path,
+	}
+}
+
+// WithResource tells the query-builder to eager-load the nodes that are connected to
+// the "resource" edge. The optional arguments are used to configure the query builder of the edge.
+func (rslq *ResourceStateLockQuery) WithResource(opts ...func(*ResourceQuery)) *ResourceStateLockQuery {
+	query := (&ResourceClient{config: rslq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rslq.withResource = query
+	return rslq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResourceStateLock.Query().
+//		GroupBy(resourcestatelock.FieldCreateTime).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (rslq *ResourceStateLockQuery) GroupBy(field string, fields ...string) *ResourceStateLockGroupBy {
+	rslq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResourceStateLockGroupBy{build: rslq}
+	grbuild.flds = &rslq.ctx.Fields
+	grbuild.label = resourcestatelock.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ResourceStateLock.Query().
+//		Select(resourcestatelock.FieldCreateTime).
+//		Scan(ctx, &v)
+func (rslq *ResourceStateLockQuery) Select(fields ...string) *ResourceStateLockSelect {
+	rslq.ctx.Fields = append(rslq.ctx.Fields, fields...)
+	sbuild := &ResourceStateLockSelect{ResourceStateLockQuery: rslq}
+	sbuild.label = resourcestatelock.Label
+	sbuild.flds, sbuild.scan = &rslq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResourceStateLockSelect configured with the given aggregations.
+func (rslq *ResourceStateLockQuery) Aggregate(fns ...AggregateFunc) *ResourceStateLockSelect {
+	return rslq.Select().Aggregate(fns...)
+}
+
+func (rslq *ResourceStateLockQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rslq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rslq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rslq.ctx.Fields {
+		if !resourcestatelock.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if rslq.path != nil {
+		prev, err := rslq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rslq.sql = prev
+	}
+	return nil
+}
+
+func (rslq *ResourceStateLockQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResourceStateLock, error) {
+	var (
+		nodes       = []*ResourceStateLock{}
+		_spec       = rslq.querySpec()
+		loadedTypes = [1]bool{
+			rslq.withResource != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResourceStateLock).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResourceStateLock{config: rslq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = rslq.schemaConfig.ResourceStateLock
+	ctx = internal.NewSchemaConfigContext(ctx, rslq.schemaConfig)
+	if len(rslq.modifiers) > 0 {
+		_spec.Modifiers = rslq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rslq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rslq.withResource; query != nil {
+		if err := rslq.loadResource(ctx, query, nodes, nil,
+			func(n *ResourceStateLock, e *Resource) { n.Edges.Resource = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rslq *ResourceStateLockQuery) loadResource(ctx context.Context, query *ResourceQuery, nodes []*ResourceStateLock, init func(*ResourceStateLock), assign func(*ResourceStateLock, *Resource)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceStateLock)
+	for i := range nodes {
+		fk := nodes[i].ResourceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(resource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "resource_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rslq *ResourceStateLockQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rslq.querySpec()
+	_spec.Node.Schema = rslq.schemaConfig.ResourceStateLock
+	ctx = internal.NewSchemaConfigContext(ctx, rslq.schemaConfig)
+	if len(rslq.modifiers) > 0 {
+		_spec.Modifiers = rslq.modifiers
+	}
+	_spec.Node.Columns = rslq.ctx.Fields
+	if len(rslq.ctx.Fields) > 0 {
+		_spec.Unique = rslq.ctx.Unique != nil && *rslq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rslq.driver, _spec)
+}
+
+func (rslq *ResourceStateLockQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(resourcestatelock.Table, resourcestatelock.Columns, sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString))
+	_spec.From = rslq.sql
+	if unique := rslq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rslq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rslq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resourcestatelock.FieldID)
+		for i := range fields {
+			if fields[i] != resourcestatelock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rslq.withResource != nil {
+			_spec.Node.AddColumnOnce(resourcestatelock.FieldResourceID)
+		}
+	}
+	if ps := rslq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rslq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rslq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rslq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rslq *ResourceStateLockQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rslq.driver.Dialect())
+	t1 := builder.Table(resourcestatelock.Table)
+	columns := rslq.ctx.Fields
+	if len(columns) == 0 {
+		columns = resourcestatelock.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rslq.sql != nil {
+		selector = rslq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rslq.ctx.Unique != nil && *rslq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(rslq.schemaConfig.ResourceStateLock)
+	ctx = internal.NewSchemaConfigContext(ctx, rslq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range rslq.modifiers {
+		m(selector)
+	}
+	for _, p := range rslq.predicates {
+		p(selector)
+	}
+	for _, p := range rslq.order {
+		p(selector)
+	}
+	if offset := rslq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rslq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rslq *ResourceStateLockQuery) ForUpdate(opts ...sql.LockOption) *ResourceStateLockQuery {
+	if rslq.driver.Dialect() == dialect.Postgres {
+		rslq.Unique(false)
+	}
+	rslq.modifiers = append(rslq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rslq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rslq *ResourceStateLockQuery) ForShare(opts ...sql.LockOption) *ResourceStateLockQuery {
+	if rslq.driver.Dialect() == dialect.Postgres {
+		rslq.Unique(false)
+	}
+	rslq.modifiers = append(rslq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rslq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rslq *ResourceStateLockQuery) Modify(modifiers ...func(s *sql.Selector)) *ResourceStateLockSelect {
+	rslq.modifiers = append(rslq.modifiers, modifiers...)
+	return rslq.Select()
+}
+
+// WhereP appends storage-level predicates to the ResourceStateLockQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (rslq *ResourceStateLockQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.ResourceStateLock, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.ResourceStateLock(ps[i]))
+	}
+	rslq.predicates = append(rslq.predicates, wps...)
+}
+
+// ResourceStateLockGroupBy is the group-by builder for ResourceStateLock entities.
+type ResourceStateLockGroupBy struct {
+	selector
+	build *ResourceStateLockQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rslgb *ResourceStateLockGroupBy) Aggregate(fns ...AggregateFunc) *ResourceStateLockGroupBy {
+	rslgb.fns = append(rslgb.fns, fns...)
+	return rslgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rslgb *ResourceStateLockGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rslgb.build.ctx, "GroupBy")
+	if err := rslgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResourceStateLockQuery, *ResourceStateLockGroupBy](ctx, rslgb.build, rslgb, rslgb.build.inters, v)
+}
+
+func (rslgb *ResourceStateLockGroupBy) sqlScan(ctx context.Context, root *ResourceStateLockQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rslgb.fns))
+	for _, fn := range rslgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rslgb.flds)+len(rslgb.fns))
+		for _, f := range *rslgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rslgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rslgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResourceStateLockSelect is the builder for selecting fields of ResourceStateLock entities.
+type ResourceStateLockSelect struct {
+	*ResourceStateLockQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rsls *ResourceStateLockSelect) Aggregate(fns ...AggregateFunc) *ResourceStateLockSelect {
+	rsls.fns = append(rsls.fns, fns...)
+	return rsls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rsls *ResourceStateLockSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rsls.ctx, "Select")
+	if err := rsls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResourceStateLockQuery, *ResourceStateLockSelect](ctx, rsls.ResourceStateLockQuery, rsls, rsls.inters, v)
+}
+
+func (rsls *ResourceStateLockSelect) sqlScan(ctx context.Context, root *ResourceStateLockQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rsls.fns))
+	for _, fn := range rsls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rsls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rsls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rsls *ResourceStateLockSelect) Modify(modifiers ...func(s *sql.Selector)) *ResourceStateLockSelect {
+	rsls.modifiers = append(rsls.modifiers, modifiers...)
+	return rsls
+}
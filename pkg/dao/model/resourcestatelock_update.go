@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+)
+
+// ResourceStateLockUpdate is the builder for updating ResourceStateLock entities.
+type ResourceStateLockUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ResourceStateLockMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ResourceStateLock
+}
+
+// Where appends a list predicates to the ResourceStateLockUpdate builder.
+func (rslu *ResourceStateLockUpdate) Where(ps ...predicate.ResourceStateLock) *ResourceStateLockUpdate {
+	rslu.mutation.Where(ps...)
+	return rslu
+}
+
+// Mutation returns the ResourceStateLockMutation object of the builder.
+func (rslu *ResourceStateLockUpdate) Mutation() *ResourceStateLockMutation {
+	return rslu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rslu *ResourceStateLockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rslu.sqlSave, rslu.mutation, rslu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rslu *ResourceStateLockUpdate) SaveX(ctx context.Context) int {
+	affected, err := rslu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rslu *ResourceStateLockUpdate) Exec(ctx context.Context) error {
+	_, err := rslu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rslu *ResourceStateLockUpdate) ExecX(ctx context.Context) {
+	if err := rslu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rslu *ResourceStateLockUpdate) check() error {
+	if _, ok := rslu.mutation.ResourceID(); rslu.mutation.ResourceCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceStateLock.resource"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rslu *ResourceStateLockUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceStateLockUpdate {
+	rslu.modifiers = append(rslu.modifiers, modifiers...)
+	return rslu
+}
+
+func (rslu *ResourceStateLockUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rslu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resourcestatelock.Table, resourcestatelock.Columns, sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString))
+	if ps := rslu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rslu.mutation.RunIDCleared() {
+		_spec.ClearField(resourcestatelock.FieldRunID, field.TypeString)
+	}
+	_spec.Node.Schema = rslu.schemaConfig.ResourceStateLock
+	ctx = internal.NewSchemaConfigContext(ctx, rslu.schemaConfig)
+	_spec.AddModifiers(rslu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rslu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resourcestatelock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rslu.mutation.done = true
+	return n, nil
+}
+
+// ResourceStateLockUpdateOne is the builder for updating a single ResourceStateLock entity.
+type ResourceStateLockUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ResourceStateLockMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ResourceStateLock
+}
+
+// Mutation returns the ResourceStateLockMutation object of the builder.
+func (rsluo *ResourceStateLockUpdateOne) Mutation() *ResourceStateLockMutation {
+	return rsluo.mutation
+}
+
+// Where appends a list predicates to the ResourceStateLockUpdate builder.
+func (rsluo *ResourceStateLockUpdateOne) Where(ps ...predicate.ResourceStateLock) *ResourceStateLockUpdateOne {
+	rsluo.mutation.Where(ps...)
+	return rsluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rsluo *ResourceStateLockUpdateOne) Select(field string, fields ...string) *ResourceStateLockUpdateOne {
+	rsluo.fields = append([]string{field}, fields...)
+	return rsluo
+}
+
+// Save executes the query and returns the updated ResourceStateLock entity.
+func (rsluo *ResourceStateLockUpdateOne) Save(ctx context.Context) (*ResourceStateLock, error) {
+	return withHooks(ctx, rsluo.sqlSave, rsluo.mutation, rsluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsluo *ResourceStateLockUpdateOne) SaveX(ctx context.Context) *ResourceStateLock {
+	node, err := rsluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rsluo *ResourceStateLockUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsluo *ResourceStateLockUpdateOne) ExecX(ctx context.Context) {
+	if err := rsluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsluo *ResourceStateLockUpdateOne) check() error {
+	if _, ok := rsluo.mutation.ResourceID(); rsluo.mutation.ResourceCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceStateLock.resource"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rsluo *ResourceStateLockUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceStateLockUpdateOne {
+	rsluo.modifiers = append(rsluo.modifiers, modifiers...)
+	return rsluo
+}
+
+func (rsluo *ResourceStateLockUpdateOne) sqlSave(ctx context.Context) (_node *ResourceStateLock, err error) {
+	if err := rsluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resourcestatelock.Table, resourcestatelock.Columns, sqlgraph.NewFieldSpec(resourcestatelock.FieldID, field.TypeString))
+	id, ok := rsluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "ResourceStateLock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rsluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resourcestatelock.FieldID)
+		for _, f := range fields {
+			if !resourcestatelock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != resourcestatelock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rsluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rsluo.mutation.RunIDCleared() {
+		_spec.ClearField(resourcestatelock.FieldRunID, field.TypeString)
+	}
+	_spec.Node.Schema = rsluo.schemaConfig.ResourceStateLock
+	ctx = internal.NewSchemaConfigContext(ctx, rsluo.schemaConfig)
+	_spec.AddModifiers(rsluo.modifiers...)
+	_node = &ResourceStateLock{config: rsluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rsluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resourcestatelock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rsluo.mutation.done = true
+	return _node, nil
+}
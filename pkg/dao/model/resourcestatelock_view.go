@@ -0,0 +1,742 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ResourceStateLockCreateInput holds the creation input of the ResourceStateLock entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateLockCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Original lock information submitted by the client.
+	Info types.ResourceStateLockInfo `path:"-" query:"-" json:"info"`
+	// Holder of the lock.
+	Holder string `path:"-" query:"-" json:"holder"`
+	// ID of the lock generated by the client.
+	LockID string `path:"-" query:"-" json:"lockID"`
+	// ID of the run which holds the lock, empty if the lock is not requested by the run's deployment.
+	RunID object.ID `path:"-" query:"-" json:"runID,omitempty"`
+}
+
+// Model returns the ResourceStateLock entity for creating,
+// after validating.
+func (rslci *ResourceStateLockCreateInput) Model() *ResourceStateLock {
+	if rslci == nil {
+		return nil
+	}
+
+	_rsl := &ResourceStateLock{
+		Info:   rslci.Info,
+		Holder: rslci.Holder,
+		LockID: rslci.LockID,
+		RunID:  rslci.RunID,
+	}
+
+	return _rsl
+}
+
+// Validate checks the ResourceStateLockCreateInput entity.
+func (rslci *ResourceStateLockCreateInput) Validate() error {
+	if rslci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rslci.ValidateWith(rslci.inputConfig.Context, rslci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateLockCreateInput entity with the given context and client set.
+func (rslci *ResourceStateLockCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rslci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceStateLockCreateInputs holds the creation input item of the ResourceStateLock entities.
+type ResourceStateLockCreateInputsItem struct {
+	// Original lock information submitted by the client.
+	Info types.ResourceStateLockInfo `path:"-" query:"-" json:"info"`
+	// Holder of the lock.
+	Holder string `path:"-" query:"-" json:"holder"`
+	// ID of the lock generated by the client.
+	LockID string `path:"-" query:"-" json:"lockID"`
+	// ID of the run which holds the lock, empty if the lock is not requested by the run's deployment.
+	RunID object.ID `path:"-" query:"-" json:"runID,omitempty"`
+}
+
+// ValidateWith checks the ResourceStateLockCreateInputsItem entity with the given context and client set.
+func (rslci *ResourceStateLockCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rslci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceStateLockCreateInputs holds the creation input of the ResourceStateLock entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateLockCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceStateLockCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceStateLock entities for creating,
+// after validating.
+func (rslci *ResourceStateLockCreateInputs) Model() []*ResourceStateLock {
+	if rslci == nil || len(rslci.Items) == 0 {
+		return nil
+	}
+
+	_rsls := make([]*ResourceStateLock, len(rslci.Items))
+
+	for i := range rslci.Items {
+		_rsl := &ResourceStateLock{
+			Info:   rslci.Items[i].Info,
+			Holder: rslci.Items[i].Holder,
+			LockID: rslci.Items[i].LockID,
+			RunID:  rslci.Items[i].RunID,
+		}
+
+		_rsls[i] = _rsl
+	}
+
+	return _rsls
+}
+
+// Validate checks the ResourceStateLockCreateInputs entity .
+func (rslci *ResourceStateLockCreateInputs) Validate() error {
+	if rslci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rslci.ValidateWith(rslci.inputConfig.Context, rslci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateLockCreateInputs entity with the given context and client set.
+func (rslci *ResourceStateLockCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rslci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rslci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	for i := range rslci.Items {
+		if rslci.Items[i] == nil {
+			continue
+		}
+
+		if err := rslci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceStateLockDeleteInput holds the deletion input of the ResourceStateLock entity,
+// please tags with `path:",inline"` if embedding.
+type ResourceStateLockDeleteInput struct {
+	ResourceStateLockQueryInput `path:",inline"`
+}
+
+// ResourceStateLockDeleteInputs holds the deletion input item of the ResourceStateLock entities.
+type ResourceStateLockDeleteInputsItem struct {
+	// ID of the ResourceStateLock entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ResourceStateLockDeleteInputs holds the deletion input of the ResourceStateLock entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateLockDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceStateLockDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceStateLock entities for deleting,
+// after validating.
+func (rsldi *ResourceStateLockDeleteInputs) Model() []*ResourceStateLock {
+	if rsldi == nil || len(rsldi.Items) == 0 {
+		return nil
+	}
+
+	_rsls := make([]*ResourceStateLock, len(rsldi.Items))
+	for i := range rsldi.Items {
+		_rsls[i] = &ResourceStateLock{
+			ID: rsldi.Items[i].ID,
+		}
+	}
+	return _rsls
+}
+
+// IDs returns the ID list of the ResourceStateLock entities for deleting,
+// after validating.
+func (rsldi *ResourceStateLockDeleteInputs) IDs() []object.ID {
+	if rsldi == nil || len(rsldi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(rsldi.Items))
+	for i := range rsldi.Items {
+		ids[i] = rsldi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ResourceStateLockDeleteInputs entity.
+func (rsldi *ResourceStateLockDeleteInputs) Validate() error {
+	if rsldi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsldi.ValidateWith(rsldi.inputConfig.Context, rsldi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateLockDeleteInputs entity with the given context and client set.
+func (rsldi *ResourceStateLockDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsldi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rsldi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceStateLocks().Query()
+
+	ids := make([]object.ID, 0, len(rsldi.Items))
+
+	for i := range rsldi.Items {
+		if rsldi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if rsldi.Items[i].ID != "" {
+			ids = append(ids, rsldi.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(resourcestatelock.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	return nil
+}
+
+// ResourceStateLockPatchInput holds the patch input of the ResourceStateLock entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateLockPatchInput struct {
+	ResourceStateLockQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// ID of the run which holds the lock, empty if the lock is not requested by the run's deployment.
+	RunID object.ID `path:"-" query:"-" json:"runID,omitempty"`
+	// ID of the lock generated by the client.
+	LockID string `path:"-" query:"-" json:"lockID,omitempty"`
+	// Holder of the lock.
+	Holder string `path:"-" query:"-" json:"holder,omitempty"`
+	// Original lock information submitted by the client.
+	Info types.ResourceStateLockInfo `path:"-" query:"-" json:"info,omitempty"`
+
+	patchedEntity *ResourceStateLock `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the ResourceStateLock partition entity for patching.
+func (rslpi *ResourceStateLockPatchInput) PatchModel() *ResourceStateLock {
+	if rslpi == nil {
+		return nil
+	}
+
+	_rsl := &ResourceStateLock{
+		CreateTime: rslpi.CreateTime,
+		RunID:      rslpi.RunID,
+		LockID:     rslpi.LockID,
+		Holder:     rslpi.Holder,
+		Info:       rslpi.Info,
+	}
+
+	return _rsl
+}
+
+// Model returns the ResourceStateLock patched entity,
+// after validating.
+func (rslpi *ResourceStateLockPatchInput) Model() *ResourceStateLock {
+	if rslpi == nil {
+		return nil
+	}
+
+	return rslpi.patchedEntity
+}
+
+// Validate checks the ResourceStateLockPatchInput entity.
+func (rslpi *ResourceStateLockPatchInput) Validate() error {
+	if rslpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rslpi.ValidateWith(rslpi.inputConfig.Context, rslpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateLockPatchInput entity with the given context and client set.
+func (rslpi *ResourceStateLockPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := rslpi.ResourceStateLockQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.ResourceStateLocks().Query()
+
+	if rslpi.Refer != nil {
+		if rslpi.Refer.IsID() {
+			q.Where(
+				resourcestatelock.ID(rslpi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of resourcestatelock")
+		}
+	} else if rslpi.ID != "" {
+		q.Where(
+			resourcestatelock.ID(rslpi.ID))
+	} else {
+		return errors.New("invalid identify of resourcestatelock")
+	}
+
+	q.Select(
+		resourcestatelock.WithoutFields(
+			resourcestatelock.FieldCreateTime,
+		)...,
+	)
+
+	var e *ResourceStateLock
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ResourceStateLock)
+		}
+	}
+
+	_pm := rslpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*ResourceStateLock)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.RunID != _obj.RunID {
+		return errors.New("field runID is immutable")
+	}
+	if e.LockID != _obj.LockID {
+		return errors.New("field lockID is immutable")
+	}
+	if e.Holder != _obj.Holder {
+		return errors.New("field holder is immutable")
+	}
+	if !reflect.DeepEqual(e.Info, _obj.Info) {
+		return errors.New("field info is immutable")
+	}
+
+	rslpi.patchedEntity = _obj
+	return nil
+}
+
+// ResourceStateLockQueryInput holds the query input of the ResourceStateLock entity,
+// please tags with `path:",inline"` if embedding.
+type ResourceStateLockQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Refer holds the route path reference of the ResourceStateLock entity.
+	Refer *object.Refer `path:"resourcestatelock,default=" query:"-" json:"-"`
+	// ID of the ResourceStateLock entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// Model returns the ResourceStateLock entity for querying,
+// after validating.
+func (rslqi *ResourceStateLockQueryInput) Model() *ResourceStateLock {
+	if rslqi == nil {
+		return nil
+	}
+
+	return &ResourceStateLock{
+		ID: rslqi.ID,
+	}
+}
+
+// Validate checks the ResourceStateLockQueryInput entity.
+func (rslqi *ResourceStateLockQueryInput) Validate() error {
+	if rslqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rslqi.ValidateWith(rslqi.inputConfig.Context, rslqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateLockQueryInput entity with the given context and client set.
+func (rslqi *ResourceStateLockQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rslqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if rslqi.Refer != nil && *rslqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", resourcestatelock.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceStateLocks().Query()
+
+	if rslqi.Refer != nil {
+		if rslqi.Refer.IsID() {
+			q.Where(
+				resourcestatelock.ID(rslqi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of resourcestatelock")
+		}
+	} else if rslqi.ID != "" {
+		q.Where(
+			resourcestatelock.ID(rslqi.ID))
+	} else {
+		return errors.New("invalid identify of resourcestatelock")
+	}
+
+	q.Select(
+		resourcestatelock.FieldID,
+	)
+
+	var e *ResourceStateLock
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ResourceStateLock)
+		}
+	}
+
+	rslqi.ID = e.ID
+	return nil
+}
+
+// ResourceStateLockQueryInputs holds the query input of the ResourceStateLock entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type ResourceStateLockQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Validate checks the ResourceStateLockQueryInputs entity.
+func (rslqi *ResourceStateLockQueryInputs) Validate() error {
+	if rslqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rslqi.ValidateWith(rslqi.inputConfig.Context, rslqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateLockQueryInputs entity with the given context and client set.
+func (rslqi *ResourceStateLockQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rslqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceStateLockUpdateInput holds the modification input of the ResourceStateLock entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateLockUpdateInput struct {
+	ResourceStateLockQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Model returns the ResourceStateLock entity for modifying,
+// after validating.
+func (rslui *ResourceStateLockUpdateInput) Model() *ResourceStateLock {
+	if rslui == nil {
+		return nil
+	}
+
+	_rsl := &ResourceStateLock{
+		ID: rslui.ID,
+	}
+
+	return _rsl
+}
+
+// Validate checks the ResourceStateLockUpdateInput entity.
+func (rslui *ResourceStateLockUpdateInput) Validate() error {
+	if rslui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rslui.ValidateWith(rslui.inputConfig.Context, rslui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateLockUpdateInput entity with the given context and client set.
+func (rslui *ResourceStateLockUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := rslui.ResourceStateLockQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResourceStateLockUpdateInputs holds the modification input item of the ResourceStateLock entities.
+type ResourceStateLockUpdateInputsItem struct {
+	// ID of the ResourceStateLock entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ValidateWith checks the ResourceStateLockUpdateInputsItem entity with the given context and client set.
+func (rslui *ResourceStateLockUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rslui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceStateLockUpdateInputs holds the modification input of the ResourceStateLock entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateLockUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceStateLockUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceStateLock entities for modifying,
+// after validating.
+func (rslui *ResourceStateLockUpdateInputs) Model() []*ResourceStateLock {
+	if rslui == nil || len(rslui.Items) == 0 {
+		return nil
+	}
+
+	_rsls := make([]*ResourceStateLock, len(rslui.Items))
+
+	for i := range rslui.Items {
+		_rsl := &ResourceStateLock{
+			ID: rslui.Items[i].ID,
+		}
+
+		_rsls[i] = _rsl
+	}
+
+	return _rsls
+}
+
+// IDs returns the ID list of the ResourceStateLock entities for modifying,
+// after validating.
+func (rslui *ResourceStateLockUpdateInputs) IDs() []object.ID {
+	if rslui == nil || len(rslui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(rslui.Items))
+	for i := range rslui.Items {
+		ids[i] = rslui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ResourceStateLockUpdateInputs entity.
+func (rslui *ResourceStateLockUpdateInputs) Validate() error {
+	if rslui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rslui.ValidateWith(rslui.inputConfig.Context, rslui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateLockUpdateInputs entity with the given context and client set.
+func (rslui *ResourceStateLockUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rslui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rslui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceStateLocks().Query()
+
+	ids := make([]object.ID, 0, len(rslui.Items))
+
+	for i := range rslui.Items {
+		if rslui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if rslui.Items[i].ID != "" {
+			ids = append(ids, rslui.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(resourcestatelock.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range rslui.Items {
+		if err := rslui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceStateLockOutput holds the output of the ResourceStateLock entity.
+type ResourceStateLockOutput struct {
+	ID         object.ID                   `json:"id,omitempty"`
+	CreateTime *time.Time                  `json:"createTime,omitempty"`
+	RunID      object.ID                   `json:"runID,omitempty"`
+	LockID     string                      `json:"lockID,omitempty"`
+	Holder     string                      `json:"holder,omitempty"`
+	Info       types.ResourceStateLockInfo `json:"info,omitempty"`
+}
+
+// View returns the output of ResourceStateLock entity.
+func (_rsl *ResourceStateLock) View() *ResourceStateLockOutput {
+	return ExposeResourceStateLock(_rsl)
+}
+
+// View returns the output of ResourceStateLock entities.
+func (_rsls ResourceStateLocks) View() []*ResourceStateLockOutput {
+	return ExposeResourceStateLocks(_rsls)
+}
+
+// ExposeResourceStateLock converts the ResourceStateLock to ResourceStateLockOutput.
+func ExposeResourceStateLock(_rsl *ResourceStateLock) *ResourceStateLockOutput {
+	if _rsl == nil {
+		return nil
+	}
+
+	rslo := &ResourceStateLockOutput{
+		ID:         _rsl.ID,
+		CreateTime: _rsl.CreateTime,
+		RunID:      _rsl.RunID,
+		LockID:     _rsl.LockID,
+		Holder:     _rsl.Holder,
+		Info:       _rsl.Info,
+	}
+
+	return rslo
+}
+
+// ExposeResourceStateLocks converts the ResourceStateLock slice to ResourceStateLockOutput pointer slice.
+func ExposeResourceStateLocks(_rsls []*ResourceStateLock) []*ResourceStateLockOutput {
+	if len(_rsls) == 0 {
+		return nil
+	}
+
+	rslos := make([]*ResourceStateLockOutput, len(_rsls))
+	for i := range _rsls {
+		rslos[i] = ExposeResourceStateLock(_rsls[i])
+	}
+	return rslos
+}
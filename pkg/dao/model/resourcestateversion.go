@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceStateVersion is the model entity for the ResourceStateVersion schema.
+type ResourceStateVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// ID of the project to belong.
+	ProjectID object.ID `json:"project_id,omitempty"`
+	// ID of the environment to which the resource deploys.
+	EnvironmentID object.ID `json:"environment_id,omitempty"`
+	// ID of the resource to which the state version belongs.
+	ResourceID object.ID `json:"resource_id,omitempty"`
+	// ID of the run which writes the state version.
+	RunID object.ID `json:"run_id,omitempty"`
+	// Serial of the state, increased by the client on every change.
+	Serial int64 `json:"serial,omitempty"`
+	// Lineage of the state, generated by the client when the state is initialized.
+	Lineage string `json:"lineage,omitempty"`
+	// State data of the version.
+	Data string `json:"data,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ResourceStateVersionQuery when eager-loading is set.
+	Edges        ResourceStateVersionEdges `json:"edges,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// ResourceStateVersionEdges holds the relations/edges for other nodes in the graph.
+type ResourceStateVersionEdges struct {
+	// Project to which the state version belongs.
+	Project *Project `json:"project,omitempty"`
+	// Environment to which the state version belongs.
+	Environment *Environment `json:"environment,omitempty"`
+	// Resource to which the state version belongs.
+	Resource *Resource `json:"resource,omitempty"`
+	// Run which writes the state version.
+	Run *ResourceRun `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceStateVersionEdges) ProjectOrErr() (*Project, error) {
+	if e.loadedTypes[0] {
+		if e.Project == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: project.Label}
+		}
+		return e.Project, nil
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// EnvironmentOrErr returns the Environment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceStateVersionEdges) EnvironmentOrErr() (*Environment, error) {
+	if e.loadedTypes[1] {
+		if e.Environment == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: environment.Label}
+		}
+		return e.Environment, nil
+	}
+	return nil, &NotLoadedError{edge: "environment"}
+}
+
+// ResourceOrErr returns the Resource value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceStateVersionEdges) ResourceOrErr() (*Resource, error) {
+	if e.loadedTypes[2] {
+		if e.Resource == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: resource.Label}
+		}
+		return e.Resource, nil
+	}
+	return nil, &NotLoadedError{edge: "resource"}
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ResourceStateVersionEdges) RunOrErr() (*ResourceRun, error) {
+	if e.loadedTypes[3] {
+		if e.Run == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: resourcerun.Label}
+		}
+		return e.Run, nil
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResourceStateVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case resourcestateversion.FieldID, resourcestateversion.FieldProjectID, resourcestateversion.FieldEnvironmentID, resourcestateversion.FieldResourceID, resourcestateversion.FieldRunID:
+			values[i] = new(object.ID)
+		case resourcestateversion.FieldSerial:
+			values[i] = new(sql.NullInt64)
+		case resourcestateversion.FieldLineage, resourcestateversion.FieldData:
+			values[i] = new(sql.NullString)
+		case resourcestateversion.FieldCreateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResourceStateVersion fields.
+func (rsv *ResourceStateVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case resourcestateversion.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rsv.ID = *value
+			}
+		case resourcestateversion.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				rsv.CreateTime = new(time.Time)
+				*rsv.CreateTime = value.Time
+			}
+		case resourcestateversion.FieldProjectID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				rsv.ProjectID = *value
+			}
+		case resourcestateversion.FieldEnvironmentID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field environment_id", values[i])
+			} else if value != nil {
+				rsv.EnvironmentID = *value
+			}
+		case resourcestateversion.FieldResourceID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field resource_id", values[i])
+			} else if value != nil {
+				rsv.ResourceID = *value
+			}
+		case resourcestateversion.FieldRunID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value != nil {
+				rsv.RunID = *value
+			}
+		case resourcestateversion.FieldSerial:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field serial", values[i])
+			} else if value.Valid {
+				rsv.Serial = value.Int64
+			}
+		case resourcestateversion.FieldLineage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lineage", values[i])
+			} else if value.Valid {
+				rsv.Lineage = value.String
+			}
+		case resourcestateversion.FieldData:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value.Valid {
+				rsv.Data = value.String
+			}
+		default:
+			rsv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResourceStateVersion.
+// This includes values selected through modifiers, order, etc.
+func (rsv *ResourceStateVersion) Value(name string) (ent.Value, error) {
+	return rsv.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the ResourceStateVersion entity.
+func (rsv *ResourceStateVersion) QueryProject() *ProjectQuery {
+	return NewResourceStateVersionClient(rsv.config).QueryProject(rsv)
+}
+
+// QueryEnvironment queries the "environment" edge of the ResourceStateVersion entity.
+func (rsv *ResourceStateVersion) QueryEnvironment() *EnvironmentQuery {
+	return NewResourceStateVersionClient(rsv.config).QueryEnvironment(rsv)
+}
+
+// QueryResource queries the "resource" edge of the ResourceStateVersion entity.
+func (rsv *ResourceStateVersion) QueryResource() *ResourceQuery {
+	return NewResourceStateVersionClient(rsv.config).QueryResource(rsv)
+}
+
+// QueryRun queries the "run" edge of the ResourceStateVersion entity.
+func (rsv *ResourceStateVersion) QueryRun() *ResourceRunQuery {
+	return NewResourceStateVersionClient(rsv.config).QueryRun(rsv)
+}
+
+// Update returns a builder for updating this ResourceStateVersion.
+// Note that you need to call ResourceStateVersion.Unwrap() before calling this method if this ResourceStateVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rsv *ResourceStateVersion) Update() *ResourceStateVersionUpdateOne {
+	return NewResourceStateVersionClient(rsv.config).UpdateOne(rsv)
+}
+
+// Unwrap unwraps the ResourceStateVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rsv *ResourceStateVersion) Unwrap() *ResourceStateVersion {
+	_tx, ok := rsv.config.driver.(*txDriver)
+	if !ok {
+		panic("model: ResourceStateVersion is not a transactional entity")
+	}
+	rsv.config.driver = _tx.drv
+	return rsv
+}
+
+// String implements the fmt.Stringer.
+func (rsv *ResourceStateVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResourceStateVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rsv.ID))
+	if v := rsv.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", rsv.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", rsv.EnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("resource_id=")
+	builder.WriteString(fmt.Sprintf("%v", rsv.ResourceID))
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", rsv.RunID))
+	builder.WriteString(", ")
+	builder.WriteString("serial=")
+	builder.WriteString(fmt.Sprintf("%v", rsv.Serial))
+	builder.WriteString(", ")
+	builder.WriteString("lineage=")
+	builder.WriteString(rsv.Lineage)
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(rsv.Data)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResourceStateVersions is a parsable slice of ResourceStateVersion.
+type ResourceStateVersions []*ResourceStateVersion
@@ -0,0 +1,733 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package resourcestateversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldProjectID, v))
+}
+
+// EnvironmentID applies equality check predicate on the "environment_id" field. It's identical to EnvironmentIDEQ.
+func EnvironmentID(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// ResourceID applies equality check predicate on the "resource_id" field. It's identical to ResourceIDEQ.
+func ResourceID(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldResourceID, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldRunID, v))
+}
+
+// Serial applies equality check predicate on the "serial" field. It's identical to SerialEQ.
+func Serial(v int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldSerial, v))
+}
+
+// Lineage applies equality check predicate on the "lineage" field. It's identical to LineageEQ.
+func Lineage(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldLineage, v))
+}
+
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldData, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDContains applies the Contains predicate on the "project_id" field.
+func ProjectIDContains(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldContains(FieldProjectID, vc))
+}
+
+// ProjectIDHasPrefix applies the HasPrefix predicate on the "project_id" field.
+func ProjectIDHasPrefix(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldHasPrefix(FieldProjectID, vc))
+}
+
+// ProjectIDHasSuffix applies the HasSuffix predicate on the "project_id" field.
+func ProjectIDHasSuffix(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldHasSuffix(FieldProjectID, vc))
+}
+
+// ProjectIDEqualFold applies the EqualFold predicate on the "project_id" field.
+func ProjectIDEqualFold(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldEqualFold(FieldProjectID, vc))
+}
+
+// ProjectIDContainsFold applies the ContainsFold predicate on the "project_id" field.
+func ProjectIDContainsFold(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldContainsFold(FieldProjectID, vc))
+}
+
+// EnvironmentIDEQ applies the EQ predicate on the "environment_id" field.
+func EnvironmentIDEQ(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDNEQ applies the NEQ predicate on the "environment_id" field.
+func EnvironmentIDNEQ(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDIn applies the In predicate on the "environment_id" field.
+func EnvironmentIDIn(vs ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDNotIn applies the NotIn predicate on the "environment_id" field.
+func EnvironmentIDNotIn(vs ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldEnvironmentID, vs...))
+}
+
+// EnvironmentIDGT applies the GT predicate on the "environment_id" field.
+func EnvironmentIDGT(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDGTE applies the GTE predicate on the "environment_id" field.
+func EnvironmentIDGTE(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLT applies the LT predicate on the "environment_id" field.
+func EnvironmentIDLT(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDLTE applies the LTE predicate on the "environment_id" field.
+func EnvironmentIDLTE(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldEnvironmentID, v))
+}
+
+// EnvironmentIDContains applies the Contains predicate on the "environment_id" field.
+func EnvironmentIDContains(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldContains(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDHasPrefix applies the HasPrefix predicate on the "environment_id" field.
+func EnvironmentIDHasPrefix(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldHasPrefix(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDHasSuffix applies the HasSuffix predicate on the "environment_id" field.
+func EnvironmentIDHasSuffix(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldHasSuffix(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDEqualFold applies the EqualFold predicate on the "environment_id" field.
+func EnvironmentIDEqualFold(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldEqualFold(FieldEnvironmentID, vc))
+}
+
+// EnvironmentIDContainsFold applies the ContainsFold predicate on the "environment_id" field.
+func EnvironmentIDContainsFold(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldContainsFold(FieldEnvironmentID, vc))
+}
+
+// ResourceIDEQ applies the EQ predicate on the "resource_id" field.
+func ResourceIDEQ(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldResourceID, v))
+}
+
+// ResourceIDNEQ applies the NEQ predicate on the "resource_id" field.
+func ResourceIDNEQ(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldResourceID, v))
+}
+
+// ResourceIDIn applies the In predicate on the "resource_id" field.
+func ResourceIDIn(vs ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldResourceID, vs...))
+}
+
+// ResourceIDNotIn applies the NotIn predicate on the "resource_id" field.
+func ResourceIDNotIn(vs ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldResourceID, vs...))
+}
+
+// ResourceIDGT applies the GT predicate on the "resource_id" field.
+func ResourceIDGT(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldResourceID, v))
+}
+
+// ResourceIDGTE applies the GTE predicate on the "resource_id" field.
+func ResourceIDGTE(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldResourceID, v))
+}
+
+// ResourceIDLT applies the LT predicate on the "resource_id" field.
+func ResourceIDLT(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldResourceID, v))
+}
+
+// ResourceIDLTE applies the LTE predicate on the "resource_id" field.
+func ResourceIDLTE(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldResourceID, v))
+}
+
+// ResourceIDContains applies the Contains predicate on the "resource_id" field.
+func ResourceIDContains(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldContains(FieldResourceID, vc))
+}
+
+// ResourceIDHasPrefix applies the HasPrefix predicate on the "resource_id" field.
+func ResourceIDHasPrefix(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldHasPrefix(FieldResourceID, vc))
+}
+
+// ResourceIDHasSuffix applies the HasSuffix predicate on the "resource_id" field.
+func ResourceIDHasSuffix(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldHasSuffix(FieldResourceID, vc))
+}
+
+// ResourceIDEqualFold applies the EqualFold predicate on the "resource_id" field.
+func ResourceIDEqualFold(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldEqualFold(FieldResourceID, vc))
+}
+
+// ResourceIDContainsFold applies the ContainsFold predicate on the "resource_id" field.
+func ResourceIDContainsFold(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldContainsFold(FieldResourceID, vc))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// RunIDGT applies the GT predicate on the "run_id" field.
+func RunIDGT(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldRunID, v))
+}
+
+// RunIDGTE applies the GTE predicate on the "run_id" field.
+func RunIDGTE(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldRunID, v))
+}
+
+// RunIDLT applies the LT predicate on the "run_id" field.
+func RunIDLT(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldRunID, v))
+}
+
+// RunIDLTE applies the LTE predicate on the "run_id" field.
+func RunIDLTE(v object.ID) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldRunID, v))
+}
+
+// RunIDContains applies the Contains predicate on the "run_id" field.
+func RunIDContains(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldContains(FieldRunID, vc))
+}
+
+// RunIDHasPrefix applies the HasPrefix predicate on the "run_id" field.
+func RunIDHasPrefix(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldHasPrefix(FieldRunID, vc))
+}
+
+// RunIDHasSuffix applies the HasSuffix predicate on the "run_id" field.
+func RunIDHasSuffix(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldHasSuffix(FieldRunID, vc))
+}
+
+// RunIDIsNil applies the IsNil predicate on the "run_id" field.
+func RunIDIsNil() predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIsNull(FieldRunID))
+}
+
+// RunIDNotNil applies the NotNil predicate on the "run_id" field.
+func RunIDNotNil() predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotNull(FieldRunID))
+}
+
+// RunIDEqualFold applies the EqualFold predicate on the "run_id" field.
+func RunIDEqualFold(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldEqualFold(FieldRunID, vc))
+}
+
+// RunIDContainsFold applies the ContainsFold predicate on the "run_id" field.
+func RunIDContainsFold(v object.ID) predicate.ResourceStateVersion {
+	vc := string(v)
+	return predicate.ResourceStateVersion(sql.FieldContainsFold(FieldRunID, vc))
+}
+
+// SerialEQ applies the EQ predicate on the "serial" field.
+func SerialEQ(v int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldSerial, v))
+}
+
+// SerialNEQ applies the NEQ predicate on the "serial" field.
+func SerialNEQ(v int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldSerial, v))
+}
+
+// SerialIn applies the In predicate on the "serial" field.
+func SerialIn(vs ...int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldSerial, vs...))
+}
+
+// SerialNotIn applies the NotIn predicate on the "serial" field.
+func SerialNotIn(vs ...int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldSerial, vs...))
+}
+
+// SerialGT applies the GT predicate on the "serial" field.
+func SerialGT(v int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldSerial, v))
+}
+
+// SerialGTE applies the GTE predicate on the "serial" field.
+func SerialGTE(v int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldSerial, v))
+}
+
+// SerialLT applies the LT predicate on the "serial" field.
+func SerialLT(v int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldSerial, v))
+}
+
+// SerialLTE applies the LTE predicate on the "serial" field.
+func SerialLTE(v int64) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldSerial, v))
+}
+
+// LineageEQ applies the EQ predicate on the "lineage" field.
+func LineageEQ(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldLineage, v))
+}
+
+// LineageNEQ applies the NEQ predicate on the "lineage" field.
+func LineageNEQ(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldLineage, v))
+}
+
+// LineageIn applies the In predicate on the "lineage" field.
+func LineageIn(vs ...string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldLineage, vs...))
+}
+
+// LineageNotIn applies the NotIn predicate on the "lineage" field.
+func LineageNotIn(vs ...string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldLineage, vs...))
+}
+
+// LineageGT applies the GT predicate on the "lineage" field.
+func LineageGT(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldLineage, v))
+}
+
+// LineageGTE applies the GTE predicate on the "lineage" field.
+func LineageGTE(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldLineage, v))
+}
+
+// LineageLT applies the LT predicate on the "lineage" field.
+func LineageLT(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldLineage, v))
+}
+
+// LineageLTE applies the LTE predicate on the "lineage" field.
+func LineageLTE(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldLineage, v))
+}
+
+// LineageContains applies the Contains predicate on the "lineage" field.
+func LineageContains(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldContains(FieldLineage, v))
+}
+
+// LineageHasPrefix applies the HasPrefix predicate on the "lineage" field.
+func LineageHasPrefix(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldHasPrefix(FieldLineage, v))
+}
+
+// LineageHasSuffix applies the HasSuffix predicate on the "lineage" field.
+func LineageHasSuffix(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldHasSuffix(FieldLineage, v))
+}
+
+// LineageEqualFold applies the EqualFold predicate on the "lineage" field.
+func LineageEqualFold(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEqualFold(FieldLineage, v))
+}
+
+// LineageContainsFold applies the ContainsFold predicate on the "lineage" field.
+func LineageContainsFold(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldContainsFold(FieldLineage, v))
+}
+
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldLTE(FieldData, v))
+}
+
+// DataContains applies the Contains predicate on the "data" field.
+func DataContains(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldContains(FieldData, v))
+}
+
+// DataHasPrefix applies the HasPrefix predicate on the "data" field.
+func DataHasPrefix(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldHasPrefix(FieldData, v))
+}
+
+// DataHasSuffix applies the HasSuffix predicate on the "data" field.
+func DataHasSuffix(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldHasSuffix(FieldData, v))
+}
+
+// DataEqualFold applies the EqualFold predicate on the "data" field.
+func DataEqualFold(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldEqualFold(FieldData, v))
+}
+
+// DataContainsFold applies the ContainsFold predicate on the "data" field.
+func DataContainsFold(v string) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.FieldContainsFold(FieldData, v))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(func(s *sql.Selector) {
+		step := newProjectStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnvironment applies the HasEdge predicate on the "environment" edge.
+func HasEnvironment() predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, EnvironmentTable, EnvironmentColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnvironmentWith applies the HasEdge predicate on the "environment" edge with a given conditions (other predicates).
+func HasEnvironmentWith(preds ...predicate.Environment) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(func(s *sql.Selector) {
+		step := newEnvironmentStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasResource applies the HasEdge predicate on the "resource" edge.
+func HasResource() predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResourceTable, ResourceColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResourceWith applies the HasEdge predicate on the "resource" edge with a given conditions (other predicates).
+func HasResourceWith(preds ...predicate.Resource) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(func(s *sql.Selector) {
+		step := newResourceStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRun
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.ResourceRun) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(func(s *sql.Selector) {
+		step := newRunStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.ResourceRun
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResourceStateVersion) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResourceStateVersion) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResourceStateVersion) predicate.ResourceStateVersion {
+	return predicate.ResourceStateVersion(sql.NotPredicates(p))
+}
@@ -0,0 +1,1020 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceStateVersionCreate is the builder for creating a ResourceStateVersion entity.
+type ResourceStateVersionCreate struct {
+	config
+	mutation   *ResourceStateVersionMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *ResourceStateVersion
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (rsvc *ResourceStateVersionCreate) SetCreateTime(t time.Time) *ResourceStateVersionCreate {
+	rsvc.mutation.SetCreateTime(t)
+	return rsvc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (rsvc *ResourceStateVersionCreate) SetNillableCreateTime(t *time.Time) *ResourceStateVersionCreate {
+	if t != nil {
+		rsvc.SetCreateTime(*t)
+	}
+	return rsvc
+}
+
+// SetProjectID sets the "project_id" field.
+func (rsvc *ResourceStateVersionCreate) SetProjectID(o object.ID) *ResourceStateVersionCreate {
+	rsvc.mutation.SetProjectID(o)
+	return rsvc
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (rsvc *ResourceStateVersionCreate) SetEnvironmentID(o object.ID) *ResourceStateVersionCreate {
+	rsvc.mutation.SetEnvironmentID(o)
+	return rsvc
+}
+
+// SetResourceID sets the "resource_id" field.
+func (rsvc *ResourceStateVersionCreate) SetResourceID(o object.ID) *ResourceStateVersionCreate {
+	rsvc.mutation.SetResourceID(o)
+	return rsvc
+}
+
+// SetRunID sets the "run_id" field.
+func (rsvc *ResourceStateVersionCreate) SetRunID(o object.ID) *ResourceStateVersionCreate {
+	rsvc.mutation.SetRunID(o)
+	return rsvc
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (rsvc *ResourceStateVersionCreate) SetNillableRunID(o *object.ID) *ResourceStateVersionCreate {
+	if o != nil {
+		rsvc.SetRunID(*o)
+	}
+	return rsvc
+}
+
+// SetSerial sets the "serial" field.
+func (rsvc *ResourceStateVersionCreate) SetSerial(i int64) *ResourceStateVersionCreate {
+	rsvc.mutation.SetSerial(i)
+	return rsvc
+}
+
+// SetLineage sets the "lineage" field.
+func (rsvc *ResourceStateVersionCreate) SetLineage(s string) *ResourceStateVersionCreate {
+	rsvc.mutation.SetLineage(s)
+	return rsvc
+}
+
+// SetData sets the "data" field.
+func (rsvc *ResourceStateVersionCreate) SetData(s string) *ResourceStateVersionCreate {
+	rsvc.mutation.SetData(s)
+	return rsvc
+}
+
+// SetID sets the "id" field.
+func (rsvc *ResourceStateVersionCreate) SetID(o object.ID) *ResourceStateVersionCreate {
+	rsvc.mutation.SetID(o)
+	return rsvc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (rsvc *ResourceStateVersionCreate) SetProject(p *Project) *ResourceStateVersionCreate {
+	return rsvc.SetProjectID(p.ID)
+}
+
+// SetEnvironment sets the "environment" edge to the Environment entity.
+func (rsvc *ResourceStateVersionCreate) SetEnvironment(e *Environment) *ResourceStateVersionCreate {
+	return rsvc.SetEnvironmentID(e.ID)
+}
+
+// SetResource sets the "resource" edge to the Resource entity.
+func (rsvc *ResourceStateVersionCreate) SetResource(r *Resource) *ResourceStateVersionCreate {
+	return rsvc.SetResourceID(r.ID)
+}
+
+// SetRun sets the "run" edge to the ResourceRun entity.
+func (rsvc *ResourceStateVersionCreate) SetRun(r *ResourceRun) *ResourceStateVersionCreate {
+	return rsvc.SetRunID(r.ID)
+}
+
+// Mutation returns the ResourceStateVersionMutation object of the builder.
+func (rsvc *ResourceStateVersionCreate) Mutation() *ResourceStateVersionMutation {
+	return rsvc.mutation
+}
+
+// Save creates the ResourceStateVersion in the database.
+func (rsvc *ResourceStateVersionCreate) Save(ctx context.Context) (*ResourceStateVersion, error) {
+	if err := rsvc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, rsvc.sqlSave, rsvc.mutation, rsvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rsvc *ResourceStateVersionCreate) SaveX(ctx context.Context) *ResourceStateVersion {
+	v, err := rsvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rsvc *ResourceStateVersionCreate) Exec(ctx context.Context) error {
+	_, err := rsvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsvc *ResourceStateVersionCreate) ExecX(ctx context.Context) {
+	if err := rsvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsvc *ResourceStateVersionCreate) defaults() error {
+	if _, ok := rsvc.mutation.CreateTime(); !ok {
+		if resourcestateversion.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized resourcestateversion.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := resourcestateversion.DefaultCreateTime()
+		rsvc.mutation.SetCreateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsvc *ResourceStateVersionCreate) check() error {
+	if _, ok := rsvc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "ResourceStateVersion.create_time"`)}
+	}
+	if _, ok := rsvc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`model: missing required field "ResourceStateVersion.project_id"`)}
+	}
+	if v, ok := rsvc.mutation.ProjectID(); ok {
+		if err := resourcestateversion.ProjectIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "project_id", err: fmt.Errorf(`model: validator failed for field "ResourceStateVersion.project_id": %w`, err)}
+		}
+	}
+	if _, ok := rsvc.mutation.EnvironmentID(); !ok {
+		return &ValidationError{Name: "environment_id", err: errors.New(`model: missing required field "ResourceStateVersion.environment_id"`)}
+	}
+	if v, ok := rsvc.mutation.EnvironmentID(); ok {
+		if err := resourcestateversion.EnvironmentIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "environment_id", err: fmt.Errorf(`model: validator failed for field "ResourceStateVersion.environment_id": %w`, err)}
+		}
+	}
+	if _, ok := rsvc.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource_id", err: errors.New(`model: missing required field "ResourceStateVersion.resource_id"`)}
+	}
+	if v, ok := rsvc.mutation.ResourceID(); ok {
+		if err := resourcestateversion.ResourceIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "resource_id", err: fmt.Errorf(`model: validator failed for field "ResourceStateVersion.resource_id": %w`, err)}
+		}
+	}
+	if _, ok := rsvc.mutation.Serial(); !ok {
+		return &ValidationError{Name: "serial", err: errors.New(`model: missing required field "ResourceStateVersion.serial"`)}
+	}
+	if _, ok := rsvc.mutation.Lineage(); !ok {
+		return &ValidationError{Name: "lineage", err: errors.New(`model: missing required field "ResourceStateVersion.lineage"`)}
+	}
+	if _, ok := rsvc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`model: missing required field "ResourceStateVersion.data"`)}
+	}
+	if _, ok := rsvc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project", err: errors.New(`model: missing required edge "ResourceStateVersion.project"`)}
+	}
+	if _, ok := rsvc.mutation.EnvironmentID(); !ok {
+		return &ValidationError{Name: "environment", err: errors.New(`model: missing required edge "ResourceStateVersion.environment"`)}
+	}
+	if _, ok := rsvc.mutation.ResourceID(); !ok {
+		return &ValidationError{Name: "resource", err: errors.New(`model: missing required edge "ResourceStateVersion.resource"`)}
+	}
+	return nil
+}
+
+func (rsvc *ResourceStateVersionCreate) sqlSave(ctx context.Context) (*ResourceStateVersion, error) {
+	if err := rsvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rsvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rsvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rsvc.mutation.id = &_node.ID
+	rsvc.mutation.done = true
+	return _node, nil
+}
+
+func (rsvc *ResourceStateVersionCreate) createSpec() (*ResourceStateVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResourceStateVersion{config: rsvc.config}
+		_spec = sqlgraph.NewCreateSpec(resourcestateversion.Table, sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString))
+	)
+	_spec.Schema = rsvc.schemaConfig.ResourceStateVersion
+	_spec.OnConflict = rsvc.conflict
+	if id, ok := rsvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rsvc.mutation.CreateTime(); ok {
+		_spec.SetField(resourcestateversion.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := rsvc.mutation.Serial(); ok {
+		_spec.SetField(resourcestateversion.FieldSerial, field.TypeInt64, value)
+		_node.Serial = value
+	}
+	if value, ok := rsvc.mutation.Lineage(); ok {
+		_spec.SetField(resourcestateversion.FieldLineage, field.TypeString, value)
+		_node.Lineage = value
+	}
+	if value, ok := rsvc.mutation.Data(); ok {
+		_spec.SetField(resourcestateversion.FieldData, field.TypeString, value)
+		_node.Data = value
+	}
+	if nodes := rsvc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resourcestateversion.ProjectTable,
+			Columns: []string{resourcestateversion.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rsvc.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsvc.mutation.EnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resourcestateversion.EnvironmentTable,
+			Columns: []string{resourcestateversion.EnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rsvc.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsvc.mutation.ResourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resourcestateversion.ResourceTable,
+			Columns: []string{resourcestateversion.ResourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resource.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rsvc.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResourceID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rsvc.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   resourcestateversion.RunTable,
+			Columns: []string{resourcestateversion.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(resourcerun.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = rsvc.schemaConfig.ResourceStateVersion
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rsvc *ResourceStateVersionCreate) Set(obj *ResourceStateVersion) *ResourceStateVersionCreate {
+	// Required.
+	rsvc.SetProjectID(obj.ProjectID)
+	rsvc.SetEnvironmentID(obj.EnvironmentID)
+	rsvc.SetResourceID(obj.ResourceID)
+	rsvc.SetSerial(obj.Serial)
+	rsvc.SetLineage(obj.Lineage)
+	rsvc.SetData(obj.Data)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		rsvc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.RunID != "" {
+		rsvc.SetRunID(obj.RunID)
+	}
+
+	// Record the given object.
+	rsvc.object = obj
+
+	return rsvc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rsvc *ResourceStateVersionCreate) getClientSet() (mc ClientSet) {
+	if _, ok := rsvc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rsvc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rsvc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ResourceStateVersion entity,
+// which is always good for cascading create operations.
+func (rsvc *ResourceStateVersionCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateVersion) error) (*ResourceStateVersion, error) {
+	obj, err := rsvc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := rsvc.getClientSet()
+
+	if x := rsvc.object; x != nil {
+		if _, set := rsvc.mutation.Field(resourcestateversion.FieldProjectID); set {
+			obj.ProjectID = x.ProjectID
+		}
+		if _, set := rsvc.mutation.Field(resourcestateversion.FieldEnvironmentID); set {
+			obj.EnvironmentID = x.EnvironmentID
+		}
+		if _, set := rsvc.mutation.Field(resourcestateversion.FieldResourceID); set {
+			obj.ResourceID = x.ResourceID
+		}
+		if _, set := rsvc.mutation.Field(resourcestateversion.FieldRunID); set {
+			obj.RunID = x.RunID
+		}
+		if _, set := rsvc.mutation.Field(resourcestateversion.FieldSerial); set {
+			obj.Serial = x.Serial
+		}
+		if _, set := rsvc.mutation.Field(resourcestateversion.FieldLineage); set {
+			obj.Lineage = x.Lineage
+		}
+		if _, set := rsvc.mutation.Field(resourcestateversion.FieldData); set {
+			obj.Data = x.Data
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rsvc *ResourceStateVersionCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateVersion) error) *ResourceStateVersion {
+	obj, err := rsvc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (rsvc *ResourceStateVersionCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateVersion) error) error {
+	_, err := rsvc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (rsvc *ResourceStateVersionCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateVersion) error) {
+	if err := rsvc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the ResourceStateVersionCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (rsvcb *ResourceStateVersionCreateBulk) Set(objs ...*ResourceStateVersion) *ResourceStateVersionCreateBulk {
+	if len(objs) != 0 {
+		client := NewResourceStateVersionClient(rsvcb.config)
+
+		rsvcb.builders = make([]*ResourceStateVersionCreate, len(objs))
+		for i := range objs {
+			rsvcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		rsvcb.objects = objs
+	}
+
+	return rsvcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (rsvcb *ResourceStateVersionCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := rsvcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: rsvcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: rsvcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the ResourceStateVersion entities,
+// which is always good for cascading create operations.
+func (rsvcb *ResourceStateVersionCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateVersion) error) ([]*ResourceStateVersion, error) {
+	objs, err := rsvcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := rsvcb.getClientSet()
+
+	if x := rsvcb.objects; x != nil {
+		for i := range x {
+			if _, set := rsvcb.builders[i].mutation.Field(resourcestateversion.FieldProjectID); set {
+				objs[i].ProjectID = x[i].ProjectID
+			}
+			if _, set := rsvcb.builders[i].mutation.Field(resourcestateversion.FieldEnvironmentID); set {
+				objs[i].EnvironmentID = x[i].EnvironmentID
+			}
+			if _, set := rsvcb.builders[i].mutation.Field(resourcestateversion.FieldResourceID); set {
+				objs[i].ResourceID = x[i].ResourceID
+			}
+			if _, set := rsvcb.builders[i].mutation.Field(resourcestateversion.FieldRunID); set {
+				objs[i].RunID = x[i].RunID
+			}
+			if _, set := rsvcb.builders[i].mutation.Field(resourcestateversion.FieldSerial); set {
+				objs[i].Serial = x[i].Serial
+			}
+			if _, set := rsvcb.builders[i].mutation.Field(resourcestateversion.FieldLineage); set {
+				objs[i].Lineage = x[i].Lineage
+			}
+			if _, set := rsvcb.builders[i].mutation.Field(resourcestateversion.FieldData); set {
+				objs[i].Data = x[i].Data
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (rsvcb *ResourceStateVersionCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateVersion) error) []*ResourceStateVersion {
+	objs, err := rsvcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (rsvcb *ResourceStateVersionCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateVersion) error) error {
+	_, err := rsvcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (rsvcb *ResourceStateVersionCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *ResourceStateVersion) error) {
+	if err := rsvcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ResourceStateVersionUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceStateVersion) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceStateVersionUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ResourceStateVersionUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceStateVersion) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *ResourceStateVersionUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceStateVersion) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ResourceStateVersionUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceStateVersionUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *ResourceStateVersionUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *ResourceStateVersion) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceStateVersion.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceStateVersionUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (rsvc *ResourceStateVersionCreate) OnConflict(opts ...sql.ConflictOption) *ResourceStateVersionUpsertOne {
+	rsvc.conflict = opts
+	return &ResourceStateVersionUpsertOne{
+		create: rsvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceStateVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rsvc *ResourceStateVersionCreate) OnConflictColumns(columns ...string) *ResourceStateVersionUpsertOne {
+	rsvc.conflict = append(rsvc.conflict, sql.ConflictColumns(columns...))
+	return &ResourceStateVersionUpsertOne{
+		create: rsvc,
+	}
+}
+
+type (
+	// ResourceStateVersionUpsertOne is the builder for "upsert"-ing
+	//  one ResourceStateVersion node.
+	ResourceStateVersionUpsertOne struct {
+		create *ResourceStateVersionCreate
+	}
+
+	// ResourceStateVersionUpsert is the "OnConflict" setter.
+	ResourceStateVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ResourceStateVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resourcestateversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResourceStateVersionUpsertOne) UpdateNewValues() *ResourceStateVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(resourcestateversion.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(resourcestateversion.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(resourcestateversion.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.EnvironmentID(); exists {
+			s.SetIgnore(resourcestateversion.FieldEnvironmentID)
+		}
+		if _, exists := u.create.mutation.ResourceID(); exists {
+			s.SetIgnore(resourcestateversion.FieldResourceID)
+		}
+		if _, exists := u.create.mutation.RunID(); exists {
+			s.SetIgnore(resourcestateversion.FieldRunID)
+		}
+		if _, exists := u.create.mutation.Serial(); exists {
+			s.SetIgnore(resourcestateversion.FieldSerial)
+		}
+		if _, exists := u.create.mutation.Lineage(); exists {
+			s.SetIgnore(resourcestateversion.FieldLineage)
+		}
+		if _, exists := u.create.mutation.Data(); exists {
+			s.SetIgnore(resourcestateversion.FieldData)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceStateVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResourceStateVersionUpsertOne) Ignore() *ResourceStateVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceStateVersionUpsertOne) DoNothing() *ResourceStateVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceStateVersionCreate.OnConflict
+// documentation for more info.
+func (u *ResourceStateVersionUpsertOne) Update(set func(*ResourceStateVersionUpsert)) *ResourceStateVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceStateVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ResourceStateVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceStateVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceStateVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResourceStateVersionUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: ResourceStateVersionUpsertOne.ID is not supported by MySQL driver. Use ResourceStateVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResourceStateVersionUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResourceStateVersionCreateBulk is the builder for creating many ResourceStateVersion entities in bulk.
+type ResourceStateVersionCreateBulk struct {
+	config
+	err        error
+	builders   []*ResourceStateVersionCreate
+	conflict   []sql.ConflictOption
+	objects    []*ResourceStateVersion
+	fromUpsert bool
+}
+
+// Save creates the ResourceStateVersion entities in the database.
+func (rsvcb *ResourceStateVersionCreateBulk) Save(ctx context.Context) ([]*ResourceStateVersion, error) {
+	if rsvcb.err != nil {
+		return nil, rsvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rsvcb.builders))
+	nodes := make([]*ResourceStateVersion, len(rsvcb.builders))
+	mutators := make([]Mutator, len(rsvcb.builders))
+	for i := range rsvcb.builders {
+		func(i int, root context.Context) {
+			builder := rsvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResourceStateVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rsvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rsvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rsvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rsvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsvcb *ResourceStateVersionCreateBulk) SaveX(ctx context.Context) []*ResourceStateVersion {
+	v, err := rsvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rsvcb *ResourceStateVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rsvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsvcb *ResourceStateVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := rsvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResourceStateVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResourceStateVersionUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (rsvcb *ResourceStateVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResourceStateVersionUpsertBulk {
+	rsvcb.conflict = opts
+	return &ResourceStateVersionUpsertBulk{
+		create: rsvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResourceStateVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rsvcb *ResourceStateVersionCreateBulk) OnConflictColumns(columns ...string) *ResourceStateVersionUpsertBulk {
+	rsvcb.conflict = append(rsvcb.conflict, sql.ConflictColumns(columns...))
+	return &ResourceStateVersionUpsertBulk{
+		create: rsvcb,
+	}
+}
+
+// ResourceStateVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResourceStateVersion nodes.
+type ResourceStateVersionUpsertBulk struct {
+	create *ResourceStateVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResourceStateVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(resourcestateversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResourceStateVersionUpsertBulk) UpdateNewValues() *ResourceStateVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(resourcestateversion.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(resourcestateversion.FieldCreateTime)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(resourcestateversion.FieldProjectID)
+			}
+			if _, exists := b.mutation.EnvironmentID(); exists {
+				s.SetIgnore(resourcestateversion.FieldEnvironmentID)
+			}
+			if _, exists := b.mutation.ResourceID(); exists {
+				s.SetIgnore(resourcestateversion.FieldResourceID)
+			}
+			if _, exists := b.mutation.RunID(); exists {
+				s.SetIgnore(resourcestateversion.FieldRunID)
+			}
+			if _, exists := b.mutation.Serial(); exists {
+				s.SetIgnore(resourcestateversion.FieldSerial)
+			}
+			if _, exists := b.mutation.Lineage(); exists {
+				s.SetIgnore(resourcestateversion.FieldLineage)
+			}
+			if _, exists := b.mutation.Data(); exists {
+				s.SetIgnore(resourcestateversion.FieldData)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResourceStateVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResourceStateVersionUpsertBulk) Ignore() *ResourceStateVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResourceStateVersionUpsertBulk) DoNothing() *ResourceStateVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResourceStateVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResourceStateVersionUpsertBulk) Update(set func(*ResourceStateVersionUpsert)) *ResourceStateVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResourceStateVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ResourceStateVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the ResourceStateVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for ResourceStateVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResourceStateVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+)
+
+// ResourceStateVersionDelete is the builder for deleting a ResourceStateVersion entity.
+type ResourceStateVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ResourceStateVersionMutation
+}
+
+// Where appends a list predicates to the ResourceStateVersionDelete builder.
+func (rsvd *ResourceStateVersionDelete) Where(ps ...predicate.ResourceStateVersion) *ResourceStateVersionDelete {
+	rsvd.mutation.Where(ps...)
+	return rsvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rsvd *ResourceStateVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsvd.sqlExec, rsvd.mutation, rsvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsvd *ResourceStateVersionDelete) ExecX(ctx context.Context) int {
+	n, err := rsvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rsvd *ResourceStateVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(resourcestateversion.Table, sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString))
+	_spec.Node.Schema = rsvd.schemaConfig.ResourceStateVersion
+	ctx = internal.NewSchemaConfigContext(ctx, rsvd.schemaConfig)
+	if ps := rsvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rsvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rsvd.mutation.done = true
+	return affected, err
+}
+
+// ResourceStateVersionDeleteOne is the builder for deleting a single ResourceStateVersion entity.
+type ResourceStateVersionDeleteOne struct {
+	rsvd *ResourceStateVersionDelete
+}
+
+// Where appends a list predicates to the ResourceStateVersionDelete builder.
+func (rsvdo *ResourceStateVersionDeleteOne) Where(ps ...predicate.ResourceStateVersion) *ResourceStateVersionDeleteOne {
+	rsvdo.rsvd.mutation.Where(ps...)
+	return rsvdo
+}
+
+// Exec executes the deletion query.
+func (rsvdo *ResourceStateVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := rsvdo.rsvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{resourcestateversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsvdo *ResourceStateVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := rsvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
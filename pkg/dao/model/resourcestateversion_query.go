@@ -0,0 +1,914 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ResourceStateVersionQuery is the builder for querying ResourceStateVersion entities.
+type ResourceStateVersionQuery struct {
+	config
+	ctx             *QueryContext
+	order           []resourcestateversion.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.ResourceStateVersion
+	withProject     *ProjectQuery
+	withEnvironment *EnvironmentQuery
+	withResource    *ResourceQuery
+	withRun         *ResourceRunQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ResourceStateVersionQuery builder.
+func (rsvq *ResourceStateVersionQuery) Where(ps ...predicate.ResourceStateVersion) *ResourceStateVersionQuery {
+	rsvq.predicates = append(rsvq.predicates, ps...)
+	return rsvq
+}
+
+// Limit the number of records to be returned by this query.
+func (rsvq *ResourceStateVersionQuery) Limit(limit int) *ResourceStateVersionQuery {
+	rsvq.ctx.Limit = &limit
+	return rsvq
+}
+
+// Offset to start from.
+func (rsvq *ResourceStateVersionQuery) Offset(offset int) *ResourceStateVersionQuery {
+	rsvq.ctx.Offset = &offset
+	return rsvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rsvq *ResourceStateVersionQuery) Unique(unique bool) *ResourceStateVersionQuery {
+	rsvq.ctx.Unique = &unique
+	return rsvq
+}
+
+// Order specifies how the records should be ordered.
+func (rsvq *ResourceStateVersionQuery) Order(o ...resourcestateversion.OrderOption) *ResourceStateVersionQuery {
+	rsvq.order = append(rsvq.order, o...)
+	return rsvq
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (rsvq *ResourceStateVersionQuery) QueryProject() *ProjectQuery {
+	query := (&ProjectClient{config: rsvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rsvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcestateversion.Table, resourcestateversion.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcestateversion.ProjectTable, resourcestateversion.ProjectColumn),
+		)
+		schemaConfig := rsvq.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromU = sqlgraph.SetNeighbors(rsvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnvironment chains the current query on the "environment" edge.
+func (rsvq *ResourceStateVersionQuery) QueryEnvironment() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: rsvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rsvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcestateversion.Table, resourcestateversion.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcestateversion.EnvironmentTable, resourcestateversion.EnvironmentColumn),
+		)
+		schemaConfig := rsvq.schemaConfig
+		step.To.Schema = schemaConfig.Environment
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromU = sqlgraph.SetNeighbors(rsvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryResource chains the current query on the "resource" edge.
+func (rsvq *ResourceStateVersionQuery) QueryResource() *ResourceQuery {
+	query := (&ResourceClient{config: rsvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rsvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcestateversion.Table, resourcestateversion.FieldID, selector),
+			sqlgraph.To(resource.Table, resource.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcestateversion.ResourceTable, resourcestateversion.ResourceColumn),
+		)
+		schemaConfig := rsvq.schemaConfig
+		step.To.Schema = schemaConfig.Resource
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromU = sqlgraph.SetNeighbors(rsvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (rsvq *ResourceStateVersionQuery) QueryRun() *ResourceRunQuery {
+	query := (&ResourceRunClient{config: rsvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rsvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rsvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(resourcestateversion.Table, resourcestateversion.FieldID, selector),
+			sqlgraph.To(resourcerun.Table, resourcerun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, resourcestateversion.RunTable, resourcestateversion.RunColumn),
+		)
+		schemaConfig := rsvq.schemaConfig
+		step.To.Schema = schemaConfig.ResourceRun
+		step.Edge.Schema = schemaConfig.ResourceStateVersion
+		fromU = sqlgraph.SetNeighbors(rsvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ResourceStateVersion entity from the query.
+// Returns a *NotFoundError when no ResourceStateVersion was found.
+func (rsvq *ResourceStateVersionQuery) First(ctx context.Context) (*ResourceStateVersion, error) {
+	nodes, err := rsvq.Limit(1).All(setContextOp(ctx, rsvq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{resourcestateversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rsvq *ResourceStateVersionQuery) FirstX(ctx context.Context) *ResourceStateVersion {
+	node, err := rsvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ResourceStateVersion ID from the query.
+// Returns a *NotFoundError when no ResourceStateVersion ID was found.
+func (rsvq *ResourceStateVersionQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = rsvq.Limit(1).IDs(setContextOp(ctx, rsvq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{resourcestateversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rsvq *ResourceStateVersionQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := rsvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ResourceStateVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ResourceStateVersion entity is found.
+// Returns a *NotFoundError when no ResourceStateVersion entities are found.
+func (rsvq *ResourceStateVersionQuery) Only(ctx context.Context) (*ResourceStateVersion, error) {
+	nodes, err := rsvq.Limit(2).All(setContextOp(ctx, rsvq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{resourcestateversion.Label}
+	default:
+		return nil, &NotSingularError{resourcestateversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rsvq *ResourceStateVersionQuery) OnlyX(ctx context.Context) *ResourceStateVersion {
+	node, err := rsvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ResourceStateVersion ID in the query.
+// Returns a *NotSingularError when more than one ResourceStateVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rsvq *ResourceStateVersionQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = rsvq.Limit(2).IDs(setContextOp(ctx, rsvq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{resourcestateversion.Label}
+	default:
+		err = &NotSingularError{resourcestateversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rsvq *ResourceStateVersionQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := rsvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ResourceStateVersions.
+func (rsvq *ResourceStateVersionQuery) All(ctx context.Context) ([]*ResourceStateVersion, error) {
+	ctx = setContextOp(ctx, rsvq.ctx, "All")
+	if err := rsvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ResourceStateVersion, *ResourceStateVersionQuery]()
+	return withInterceptors[[]*ResourceStateVersion](ctx, rsvq, qr, rsvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rsvq *ResourceStateVersionQuery) AllX(ctx context.Context) []*ResourceStateVersion {
+	nodes, err := rsvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ResourceStateVersion IDs.
+func (rsvq *ResourceStateVersionQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if rsvq.ctx.Unique == nil && rsvq.path != nil {
+		rsvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rsvq.ctx, "IDs")
+	if err = rsvq.Select(resourcestateversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rsvq *ResourceStateVersionQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := rsvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rsvq *ResourceStateVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rsvq.ctx, "Count")
+	if err := rsvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rsvq, querierCount[*ResourceStateVersionQuery](), rsvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rsvq *ResourceStateVersionQuery) CountX(ctx context.Context) int {
+	count, err := rsvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rsvq *ResourceStateVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rsvq.ctx, "Exist")
+	switch _, err := rsvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rsvq *ResourceStateVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := rsvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ResourceStateVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rsvq *ResourceStateVersionQuery) Clone() *ResourceStateVersionQuery {
+	if rsvq == nil {
+		return nil
+	}
+	return &ResourceStateVersionQuery{
+		config:          rsvq.config,
+		ctx:             rsvq.ctx.Clone(),
+		order:           append([]resourcestateversion.OrderOption{}, rsvq.order...),
+		inters:          append([]Interceptor{}, rsvq.inters...),
+		predicates:      append([]predicate.ResourceStateVersion{}, rsvq.predicates...),
+		withProject:     rsvq.withProject.Clone(),
+		withEnvironment: rsvq.withEnvironment.Clone(),
+		withResource:    rsvq.withResource.Clone(),
+		withRun:         rsvq.withRun.Clone(),
+		// clone intermediate query.
+		sql:  rsvq.sql.Clone(),
+		path: rsvq.path,
+	}
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (rsvq *ResourceStateVersionQuery) WithProject(opts ...func(*ProjectQuery)) *ResourceStateVersionQuery {
+	query := (&ProjectClient{config: rsvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsvq.withProject = query
+	return rsvq
+}
+
+// WithEnvironment tells the query-builder to eager-load the nodes that are connected to
+// the "environment" edge. The optional arguments are used to configure the query builder of the edge.
+func (rsvq *ResourceStateVersionQuery) WithEnvironment(opts ...func(*EnvironmentQuery)) *ResourceStateVersionQuery {
+	query := (&EnvironmentClient{config: rsvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsvq.withEnvironment = query
+	return rsvq
+}
+
+// WithResource tells the query-builder to eager-load the nodes that are connected to
+// the "resource" edge. The optional arguments are used to configure the query builder of the edge.
+func (rsvq *ResourceStateVersionQuery) WithResource(opts ...func(*ResourceQuery)) *ResourceStateVersionQuery {
+	query := (&ResourceClient{config: rsvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsvq.withResource = query
+	return rsvq
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (rsvq *ResourceStateVersionQuery) WithRun(opts ...func(*ResourceRunQuery)) *ResourceStateVersionQuery {
+	query := (&ResourceRunClient{config: rsvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rsvq.withRun = query
+	return rsvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ResourceStateVersion.Query().
+//		GroupBy(resourcestateversion.FieldCreateTime).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (rsvq *ResourceStateVersionQuery) GroupBy(field string, fields ...string) *ResourceStateVersionGroupBy {
+	rsvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ResourceStateVersionGroupBy{build: rsvq}
+	grbuild.flds = &rsvq.ctx.Fields
+	grbuild.label = resourcestateversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ResourceStateVersion.Query().
+//		Select(resourcestateversion.FieldCreateTime).
+//		Scan(ctx, &v)
+func (rsvq *ResourceStateVersionQuery) Select(fields ...string) *ResourceStateVersionSelect {
+	rsvq.ctx.Fields = append(rsvq.ctx.Fields, fields...)
+	sbuild := &ResourceStateVersionSelect{ResourceStateVersionQuery: rsvq}
+	sbuild.label = resourcestateversion.Label
+	sbuild.flds, sbuild.scan = &rsvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ResourceStateVersionSelect configured with the given aggregations.
+func (rsvq *ResourceStateVersionQuery) Aggregate(fns ...AggregateFunc) *ResourceStateVersionSelect {
+	return rsvq.Select().Aggregate(fns...)
+}
+
+func (rsvq *ResourceStateVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rsvq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rsvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rsvq.ctx.Fields {
+		if !resourcestateversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if rsvq.path != nil {
+		prev, err := rsvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rsvq.sql = prev
+	}
+	return nil
+}
+
+func (rsvq *ResourceStateVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ResourceStateVersion, error) {
+	var (
+		nodes       = []*ResourceStateVersion{}
+		_spec       = rsvq.querySpec()
+		loadedTypes = [4]bool{
+			rsvq.withProject != nil,
+			rsvq.withEnvironment != nil,
+			rsvq.withResource != nil,
+			rsvq.withRun != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ResourceStateVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ResourceStateVersion{config: rsvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = rsvq.schemaConfig.ResourceStateVersion
+	ctx = internal.NewSchemaConfigContext(ctx, rsvq.schemaConfig)
+	if len(rsvq.modifiers) > 0 {
+		_spec.Modifiers = rsvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rsvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rsvq.withProject; query != nil {
+		if err := rsvq.loadProject(ctx, query, nodes, nil,
+			func(n *ResourceStateVersion, e *Project) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rsvq.withEnvironment; query != nil {
+		if err := rsvq.loadEnvironment(ctx, query, nodes, nil,
+			func(n *ResourceStateVersion, e *Environment) { n.Edges.Environment = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rsvq.withResource; query != nil {
+		if err := rsvq.loadResource(ctx, query, nodes, nil,
+			func(n *ResourceStateVersion, e *Resource) { n.Edges.Resource = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rsvq.withRun; query != nil {
+		if err := rsvq.loadRun(ctx, query, nodes, nil,
+			func(n *ResourceStateVersion, e *ResourceRun) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rsvq *ResourceStateVersionQuery) loadProject(ctx context.Context, query *ProjectQuery, nodes []*ResourceStateVersion, init func(*ResourceStateVersion), assign func(*ResourceStateVersion, *Project)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceStateVersion)
+	for i := range nodes {
+		fk := nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(project.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rsvq *ResourceStateVersionQuery) loadEnvironment(ctx context.Context, query *EnvironmentQuery, nodes []*ResourceStateVersion, init func(*ResourceStateVersion), assign func(*ResourceStateVersion, *Environment)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceStateVersion)
+	for i := range nodes {
+		fk := nodes[i].EnvironmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "environment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rsvq *ResourceStateVersionQuery) loadResource(ctx context.Context, query *ResourceQuery, nodes []*ResourceStateVersion, init func(*ResourceStateVersion), assign func(*ResourceStateVersion, *Resource)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceStateVersion)
+	for i := range nodes {
+		fk := nodes[i].ResourceID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(resource.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "resource_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rsvq *ResourceStateVersionQuery) loadRun(ctx context.Context, query *ResourceRunQuery, nodes []*ResourceStateVersion, init func(*ResourceStateVersion), assign func(*ResourceStateVersion, *ResourceRun)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*ResourceStateVersion)
+	for i := range nodes {
+		fk := nodes[i].RunID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(resourcerun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "run_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (rsvq *ResourceStateVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rsvq.querySpec()
+	_spec.Node.Schema = rsvq.schemaConfig.ResourceStateVersion
+	ctx = internal.NewSchemaConfigContext(ctx, rsvq.schemaConfig)
+	if len(rsvq.modifiers) > 0 {
+		_spec.Modifiers = rsvq.modifiers
+	}
+	_spec.Node.Columns = rsvq.ctx.Fields
+	if len(rsvq.ctx.Fields) > 0 {
+		_spec.Unique = rsvq.ctx.Unique != nil && *rsvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rsvq.driver, _spec)
+}
+
+func (rsvq *ResourceStateVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(resourcestateversion.Table, resourcestateversion.Columns, sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString))
+	_spec.From = rsvq.sql
+	if unique := rsvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rsvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rsvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resourcestateversion.FieldID)
+		for i := range fields {
+			if fields[i] != resourcestateversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rsvq.withProject != nil {
+			_spec.Node.AddColumnOnce(resourcestateversion.FieldProjectID)
+		}
+		if rsvq.withEnvironment != nil {
+			_spec.Node.AddColumnOnce(resourcestateversion.FieldEnvironmentID)
+		}
+		if rsvq.withResource != nil {
+			_spec.Node.AddColumnOnce(resourcestateversion.FieldResourceID)
+		}
+		if rsvq.withRun != nil {
+			_spec.Node.AddColumnOnce(resourcestateversion.FieldRunID)
+		}
+	}
+	if ps := rsvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rsvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rsvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rsvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rsvq *ResourceStateVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rsvq.driver.Dialect())
+	t1 := builder.Table(resourcestateversion.Table)
+	columns := rsvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = resourcestateversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rsvq.sql != nil {
+		selector = rsvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rsvq.ctx.Unique != nil && *rsvq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(rsvq.schemaConfig.ResourceStateVersion)
+	ctx = internal.NewSchemaConfigContext(ctx, rsvq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range rsvq.modifiers {
+		m(selector)
+	}
+	for _, p := range rsvq.predicates {
+		p(selector)
+	}
+	for _, p := range rsvq.order {
+		p(selector)
+	}
+	if offset := rsvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rsvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (rsvq *ResourceStateVersionQuery) ForUpdate(opts ...sql.LockOption) *ResourceStateVersionQuery {
+	if rsvq.driver.Dialect() == dialect.Postgres {
+		rsvq.Unique(false)
+	}
+	rsvq.modifiers = append(rsvq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return rsvq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (rsvq *ResourceStateVersionQuery) ForShare(opts ...sql.LockOption) *ResourceStateVersionQuery {
+	if rsvq.driver.Dialect() == dialect.Postgres {
+		rsvq.Unique(false)
+	}
+	rsvq.modifiers = append(rsvq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return rsvq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rsvq *ResourceStateVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *ResourceStateVersionSelect {
+	rsvq.modifiers = append(rsvq.modifiers, modifiers...)
+	return rsvq.Select()
+}
+
+// WhereP appends storage-level predicates to the ResourceStateVersionQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (rsvq *ResourceStateVersionQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.ResourceStateVersion, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.ResourceStateVersion(ps[i]))
+	}
+	rsvq.predicates = append(rsvq.predicates, wps...)
+}
+
+// ResourceStateVersionGroupBy is the group-by builder for ResourceStateVersion entities.
+type ResourceStateVersionGroupBy struct {
+	selector
+	build *ResourceStateVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rsvgb *ResourceStateVersionGroupBy) Aggregate(fns ...AggregateFunc) *ResourceStateVersionGroupBy {
+	rsvgb.fns = append(rsvgb.fns, fns...)
+	return rsvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rsvgb *ResourceStateVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rsvgb.build.ctx, "GroupBy")
+	if err := rsvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResourceStateVersionQuery, *ResourceStateVersionGroupBy](ctx, rsvgb.build, rsvgb, rsvgb.build.inters, v)
+}
+
+func (rsvgb *ResourceStateVersionGroupBy) sqlScan(ctx context.Context, root *ResourceStateVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rsvgb.fns))
+	for _, fn := range rsvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rsvgb.flds)+len(rsvgb.fns))
+		for _, f := range *rsvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rsvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rsvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ResourceStateVersionSelect is the builder for selecting fields of ResourceStateVersion entities.
+type ResourceStateVersionSelect struct {
+	*ResourceStateVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rsvs *ResourceStateVersionSelect) Aggregate(fns ...AggregateFunc) *ResourceStateVersionSelect {
+	rsvs.fns = append(rsvs.fns, fns...)
+	return rsvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rsvs *ResourceStateVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rsvs.ctx, "Select")
+	if err := rsvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ResourceStateVersionQuery, *ResourceStateVersionSelect](ctx, rsvs.ResourceStateVersionQuery, rsvs, rsvs.inters, v)
+}
+
+func (rsvs *ResourceStateVersionSelect) sqlScan(ctx context.Context, root *ResourceStateVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rsvs.fns))
+	for _, fn := range rsvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rsvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rsvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (rsvs *ResourceStateVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *ResourceStateVersionSelect {
+	rsvs.modifiers = append(rsvs.modifiers, modifiers...)
+	return rsvs
+}
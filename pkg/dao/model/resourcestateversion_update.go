@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+)
+
+// ResourceStateVersionUpdate is the builder for updating ResourceStateVersion entities.
+type ResourceStateVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ResourceStateVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ResourceStateVersion
+}
+
+// Where appends a list predicates to the ResourceStateVersionUpdate builder.
+func (rsvu *ResourceStateVersionUpdate) Where(ps ...predicate.ResourceStateVersion) *ResourceStateVersionUpdate {
+	rsvu.mutation.Where(ps...)
+	return rsvu
+}
+
+// Mutation returns the ResourceStateVersionMutation object of the builder.
+func (rsvu *ResourceStateVersionUpdate) Mutation() *ResourceStateVersionMutation {
+	return rsvu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rsvu *ResourceStateVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsvu.sqlSave, rsvu.mutation, rsvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsvu *ResourceStateVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := rsvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rsvu *ResourceStateVersionUpdate) Exec(ctx context.Context) error {
+	_, err := rsvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsvu *ResourceStateVersionUpdate) ExecX(ctx context.Context) {
+	if err := rsvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsvu *ResourceStateVersionUpdate) check() error {
+	if _, ok := rsvu.mutation.ProjectID(); rsvu.mutation.ProjectCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceStateVersion.project"`)
+	}
+	if _, ok := rsvu.mutation.EnvironmentID(); rsvu.mutation.EnvironmentCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceStateVersion.environment"`)
+	}
+	if _, ok := rsvu.mutation.ResourceID(); rsvu.mutation.ResourceCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceStateVersion.resource"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rsvu *ResourceStateVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceStateVersionUpdate {
+	rsvu.modifiers = append(rsvu.modifiers, modifiers...)
+	return rsvu
+}
+
+func (rsvu *ResourceStateVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rsvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resourcestateversion.Table, resourcestateversion.Columns, sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString))
+	if ps := rsvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = rsvu.schemaConfig.ResourceStateVersion
+	ctx = internal.NewSchemaConfigContext(ctx, rsvu.schemaConfig)
+	_spec.AddModifiers(rsvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rsvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resourcestateversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rsvu.mutation.done = true
+	return n, nil
+}
+
+// ResourceStateVersionUpdateOne is the builder for updating a single ResourceStateVersion entity.
+type ResourceStateVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ResourceStateVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *ResourceStateVersion
+}
+
+// Mutation returns the ResourceStateVersionMutation object of the builder.
+func (rsvuo *ResourceStateVersionUpdateOne) Mutation() *ResourceStateVersionMutation {
+	return rsvuo.mutation
+}
+
+// Where appends a list predicates to the ResourceStateVersionUpdate builder.
+func (rsvuo *ResourceStateVersionUpdateOne) Where(ps ...predicate.ResourceStateVersion) *ResourceStateVersionUpdateOne {
+	rsvuo.mutation.Where(ps...)
+	return rsvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rsvuo *ResourceStateVersionUpdateOne) Select(field string, fields ...string) *ResourceStateVersionUpdateOne {
+	rsvuo.fields = append([]string{field}, fields...)
+	return rsvuo
+}
+
+// Save executes the query and returns the updated ResourceStateVersion entity.
+func (rsvuo *ResourceStateVersionUpdateOne) Save(ctx context.Context) (*ResourceStateVersion, error) {
+	return withHooks(ctx, rsvuo.sqlSave, rsvuo.mutation, rsvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsvuo *ResourceStateVersionUpdateOne) SaveX(ctx context.Context) *ResourceStateVersion {
+	node, err := rsvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rsvuo *ResourceStateVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsvuo *ResourceStateVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := rsvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsvuo *ResourceStateVersionUpdateOne) check() error {
+	if _, ok := rsvuo.mutation.ProjectID(); rsvuo.mutation.ProjectCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceStateVersion.project"`)
+	}
+	if _, ok := rsvuo.mutation.EnvironmentID(); rsvuo.mutation.EnvironmentCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceStateVersion.environment"`)
+	}
+	if _, ok := rsvuo.mutation.ResourceID(); rsvuo.mutation.ResourceCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "ResourceStateVersion.resource"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rsvuo *ResourceStateVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResourceStateVersionUpdateOne {
+	rsvuo.modifiers = append(rsvuo.modifiers, modifiers...)
+	return rsvuo
+}
+
+func (rsvuo *ResourceStateVersionUpdateOne) sqlSave(ctx context.Context) (_node *ResourceStateVersion, err error) {
+	if err := rsvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(resourcestateversion.Table, resourcestateversion.Columns, sqlgraph.NewFieldSpec(resourcestateversion.FieldID, field.TypeString))
+	id, ok := rsvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "ResourceStateVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rsvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, resourcestateversion.FieldID)
+		for _, f := range fields {
+			if !resourcestateversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != resourcestateversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rsvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.Node.Schema = rsvuo.schemaConfig.ResourceStateVersion
+	ctx = internal.NewSchemaConfigContext(ctx, rsvuo.schemaConfig)
+	_spec.AddModifiers(rsvuo.modifiers...)
+	_node = &ResourceStateVersion{config: rsvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rsvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{resourcestateversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rsvuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1021 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// ResourceStateVersionCreateInput holds the creation input of the ResourceStateVersion entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateVersionCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create ResourceStateVersion entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to create ResourceStateVersion entity MUST under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+	// Resource indicates to create ResourceStateVersion entity MUST under the Resource route.
+	Resource *ResourceQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Lineage of the state, generated by the client when the state is initialized.
+	Lineage string `path:"-" query:"-" json:"lineage"`
+	// Serial of the state, increased by the client on every change.
+	Serial int64 `path:"-" query:"-" json:"serial"`
+}
+
+// Model returns the ResourceStateVersion entity for creating,
+// after validating.
+func (rsvci *ResourceStateVersionCreateInput) Model() *ResourceStateVersion {
+	if rsvci == nil {
+		return nil
+	}
+
+	_rsv := &ResourceStateVersion{
+		Lineage: rsvci.Lineage,
+		Serial:  rsvci.Serial,
+	}
+
+	if rsvci.Project != nil {
+		_rsv.ProjectID = rsvci.Project.ID
+	}
+	if rsvci.Environment != nil {
+		_rsv.EnvironmentID = rsvci.Environment.ID
+	}
+	if rsvci.Resource != nil {
+		_rsv.ResourceID = rsvci.Resource.ID
+	}
+
+	return _rsv
+}
+
+// Validate checks the ResourceStateVersionCreateInput entity.
+func (rsvci *ResourceStateVersionCreateInput) Validate() error {
+	if rsvci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsvci.ValidateWith(rsvci.inputConfig.Context, rsvci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateVersionCreateInput entity with the given context and client set.
+func (rsvci *ResourceStateVersionCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsvci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if rsvci.Project != nil {
+		if err := rsvci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+	// Validate when creating under the Environment route.
+	if rsvci.Environment != nil {
+		if err := rsvci.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+	// Validate when creating under the Resource route.
+	if rsvci.Resource != nil {
+		if err := rsvci.Resource.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceStateVersionCreateInputs holds the creation input item of the ResourceStateVersion entities.
+type ResourceStateVersionCreateInputsItem struct {
+	// Lineage of the state, generated by the client when the state is initialized.
+	Lineage string `path:"-" query:"-" json:"lineage"`
+	// Serial of the state, increased by the client on every change.
+	Serial int64 `path:"-" query:"-" json:"serial"`
+}
+
+// ValidateWith checks the ResourceStateVersionCreateInputsItem entity with the given context and client set.
+func (rsvci *ResourceStateVersionCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsvci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceStateVersionCreateInputs holds the creation input of the ResourceStateVersion entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateVersionCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create ResourceStateVersion entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to create ResourceStateVersion entity MUST under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+	// Resource indicates to create ResourceStateVersion entity MUST under the Resource route.
+	Resource *ResourceQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceStateVersionCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceStateVersion entities for creating,
+// after validating.
+func (rsvci *ResourceStateVersionCreateInputs) Model() []*ResourceStateVersion {
+	if rsvci == nil || len(rsvci.Items) == 0 {
+		return nil
+	}
+
+	_rsvs := make([]*ResourceStateVersion, len(rsvci.Items))
+
+	for i := range rsvci.Items {
+		_rsv := &ResourceStateVersion{
+			Lineage: rsvci.Items[i].Lineage,
+			Serial:  rsvci.Items[i].Serial,
+		}
+
+		if rsvci.Project != nil {
+			_rsv.ProjectID = rsvci.Project.ID
+		}
+		if rsvci.Environment != nil {
+			_rsv.EnvironmentID = rsvci.Environment.ID
+		}
+		if rsvci.Resource != nil {
+			_rsv.ResourceID = rsvci.Resource.ID
+		}
+
+		_rsvs[i] = _rsv
+	}
+
+	return _rsvs
+}
+
+// Validate checks the ResourceStateVersionCreateInputs entity .
+func (rsvci *ResourceStateVersionCreateInputs) Validate() error {
+	if rsvci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsvci.ValidateWith(rsvci.inputConfig.Context, rsvci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateVersionCreateInputs entity with the given context and client set.
+func (rsvci *ResourceStateVersionCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsvci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rsvci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if rsvci.Project != nil {
+		if err := rsvci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rsvci.Project = nil
+			}
+		}
+	}
+	// Validate when creating under the Environment route.
+	if rsvci.Environment != nil {
+		if err := rsvci.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rsvci.Environment = nil
+			}
+		}
+	}
+	// Validate when creating under the Resource route.
+	if rsvci.Resource != nil {
+		if err := rsvci.Resource.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				rsvci.Resource = nil
+			}
+		}
+	}
+
+	for i := range rsvci.Items {
+		if rsvci.Items[i] == nil {
+			continue
+		}
+
+		if err := rsvci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceStateVersionDeleteInput holds the deletion input of the ResourceStateVersion entity,
+// please tags with `path:",inline"` if embedding.
+type ResourceStateVersionDeleteInput struct {
+	ResourceStateVersionQueryInput `path:",inline"`
+}
+
+// ResourceStateVersionDeleteInputs holds the deletion input item of the ResourceStateVersion entities.
+type ResourceStateVersionDeleteInputsItem struct {
+	// ID of the ResourceStateVersion entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ResourceStateVersionDeleteInputs holds the deletion input of the ResourceStateVersion entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateVersionDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to delete ResourceStateVersion entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to delete ResourceStateVersion entity MUST under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+	// Resource indicates to delete ResourceStateVersion entity MUST under the Resource route.
+	Resource *ResourceQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceStateVersionDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceStateVersion entities for deleting,
+// after validating.
+func (rsvdi *ResourceStateVersionDeleteInputs) Model() []*ResourceStateVersion {
+	if rsvdi == nil || len(rsvdi.Items) == 0 {
+		return nil
+	}
+
+	_rsvs := make([]*ResourceStateVersion, len(rsvdi.Items))
+	for i := range rsvdi.Items {
+		_rsvs[i] = &ResourceStateVersion{
+			ID: rsvdi.Items[i].ID,
+		}
+	}
+	return _rsvs
+}
+
+// IDs returns the ID list of the ResourceStateVersion entities for deleting,
+// after validating.
+func (rsvdi *ResourceStateVersionDeleteInputs) IDs() []object.ID {
+	if rsvdi == nil || len(rsvdi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(rsvdi.Items))
+	for i := range rsvdi.Items {
+		ids[i] = rsvdi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ResourceStateVersionDeleteInputs entity.
+func (rsvdi *ResourceStateVersionDeleteInputs) Validate() error {
+	if rsvdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsvdi.ValidateWith(rsvdi.inputConfig.Context, rsvdi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateVersionDeleteInputs entity with the given context and client set.
+func (rsvdi *ResourceStateVersionDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsvdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rsvdi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceStateVersions().Query()
+
+	// Validate when deleting under the Project route.
+	if rsvdi.Project != nil {
+		if err := rsvdi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcestateversion.ProjectID(rsvdi.Project.ID))
+		}
+	}
+
+	// Validate when deleting under the Environment route.
+	if rsvdi.Environment != nil {
+		if err := rsvdi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				resourcestateversion.EnvironmentID(rsvdi.Environment.ID))
+		}
+	}
+
+	// Validate when deleting under the Resource route.
+	if rsvdi.Resource != nil {
+		if err := rsvdi.Resource.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				resourcestateversion.ResourceID(rsvdi.Resource.ID))
+		}
+	}
+
+	ids := make([]object.ID, 0, len(rsvdi.Items))
+
+	for i := range rsvdi.Items {
+		if rsvdi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if rsvdi.Items[i].ID != "" {
+			ids = append(ids, rsvdi.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(resourcestateversion.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	return nil
+}
+
+// ResourceStateVersionPatchInput holds the patch input of the ResourceStateVersion entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateVersionPatchInput struct {
+	ResourceStateVersionQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// Serial of the state, increased by the client on every change.
+	Serial int64 `path:"-" query:"-" json:"serial,omitempty"`
+	// Lineage of the state, generated by the client when the state is initialized.
+	Lineage string `path:"-" query:"-" json:"lineage,omitempty"`
+	// State data of the version.
+	Data string `path:"-" query:"-" json:"data,omitempty"`
+
+	patchedEntity *ResourceStateVersion `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the ResourceStateVersion partition entity for patching.
+func (rsvpi *ResourceStateVersionPatchInput) PatchModel() *ResourceStateVersion {
+	if rsvpi == nil {
+		return nil
+	}
+
+	_rsv := &ResourceStateVersion{
+		CreateTime: rsvpi.CreateTime,
+		Serial:     rsvpi.Serial,
+		Lineage:    rsvpi.Lineage,
+		Data:       rsvpi.Data,
+	}
+
+	if rsvpi.Project != nil {
+		_rsv.ProjectID = rsvpi.Project.ID
+	}
+	if rsvpi.Environment != nil {
+		_rsv.EnvironmentID = rsvpi.Environment.ID
+	}
+	if rsvpi.Resource != nil {
+		_rsv.ResourceID = rsvpi.Resource.ID
+	}
+
+	return _rsv
+}
+
+// Model returns the ResourceStateVersion patched entity,
+// after validating.
+func (rsvpi *ResourceStateVersionPatchInput) Model() *ResourceStateVersion {
+	if rsvpi == nil {
+		return nil
+	}
+
+	return rsvpi.patchedEntity
+}
+
+// Validate checks the ResourceStateVersionPatchInput entity.
+func (rsvpi *ResourceStateVersionPatchInput) Validate() error {
+	if rsvpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsvpi.ValidateWith(rsvpi.inputConfig.Context, rsvpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateVersionPatchInput entity with the given context and client set.
+func (rsvpi *ResourceStateVersionPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := rsvpi.ResourceStateVersionQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.ResourceStateVersions().Query()
+
+	// Validate when querying under the Project route.
+	if rsvpi.Project != nil {
+		if err := rsvpi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcestateversion.ProjectID(rsvpi.Project.ID))
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if rsvpi.Environment != nil {
+		if err := rsvpi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				resourcestateversion.EnvironmentID(rsvpi.Environment.ID))
+		}
+	}
+
+	// Validate when querying under the Resource route.
+	if rsvpi.Resource != nil {
+		if err := rsvpi.Resource.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				resourcestateversion.ResourceID(rsvpi.Resource.ID))
+		}
+	}
+
+	if rsvpi.Refer != nil {
+		if rsvpi.Refer.IsID() {
+			q.Where(
+				resourcestateversion.ID(rsvpi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of resourcestateversion")
+		}
+	} else if rsvpi.ID != "" {
+		q.Where(
+			resourcestateversion.ID(rsvpi.ID))
+	} else {
+		return errors.New("invalid identify of resourcestateversion")
+	}
+
+	q.Select(
+		resourcestateversion.WithoutFields(
+			resourcestateversion.FieldCreateTime,
+			resourcestateversion.FieldData,
+		)...,
+	)
+
+	var e *ResourceStateVersion
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ResourceStateVersion)
+		}
+	}
+
+	_pm := rsvpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*ResourceStateVersion)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.Serial != _obj.Serial {
+		return errors.New("field serial is immutable")
+	}
+	if e.Lineage != _obj.Lineage {
+		return errors.New("field lineage is immutable")
+	}
+	if e.Data != _obj.Data {
+		return errors.New("field data is immutable")
+	}
+
+	rsvpi.patchedEntity = _obj
+	return nil
+}
+
+// ResourceStateVersionQueryInput holds the query input of the ResourceStateVersion entity,
+// please tags with `path:",inline"` if embedding.
+type ResourceStateVersionQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query ResourceStateVersion entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"project"`
+	// Environment indicates to query ResourceStateVersion entity MUST under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"environment"`
+	// Resource indicates to query ResourceStateVersion entity MUST under the Resource route.
+	Resource *ResourceQueryInput `path:",inline" query:"-" json:"resource"`
+
+	// Refer holds the route path reference of the ResourceStateVersion entity.
+	Refer *object.Refer `path:"resourcestateversion,default=" query:"-" json:"-"`
+	// ID of the ResourceStateVersion entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// Model returns the ResourceStateVersion entity for querying,
+// after validating.
+func (rsvqi *ResourceStateVersionQueryInput) Model() *ResourceStateVersion {
+	if rsvqi == nil {
+		return nil
+	}
+
+	return &ResourceStateVersion{
+		ID: rsvqi.ID,
+	}
+}
+
+// Validate checks the ResourceStateVersionQueryInput entity.
+func (rsvqi *ResourceStateVersionQueryInput) Validate() error {
+	if rsvqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsvqi.ValidateWith(rsvqi.inputConfig.Context, rsvqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateVersionQueryInput entity with the given context and client set.
+func (rsvqi *ResourceStateVersionQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsvqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if rsvqi.Refer != nil && *rsvqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", resourcestateversion.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceStateVersions().Query()
+
+	// Validate when querying under the Project route.
+	if rsvqi.Project != nil {
+		if err := rsvqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcestateversion.ProjectID(rsvqi.Project.ID))
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if rsvqi.Environment != nil {
+		if err := rsvqi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				resourcestateversion.EnvironmentID(rsvqi.Environment.ID))
+		}
+	}
+
+	// Validate when querying under the Resource route.
+	if rsvqi.Resource != nil {
+		if err := rsvqi.Resource.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				resourcestateversion.ResourceID(rsvqi.Resource.ID))
+		}
+	}
+
+	if rsvqi.Refer != nil {
+		if rsvqi.Refer.IsID() {
+			q.Where(
+				resourcestateversion.ID(rsvqi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of resourcestateversion")
+		}
+	} else if rsvqi.ID != "" {
+		q.Where(
+			resourcestateversion.ID(rsvqi.ID))
+	} else {
+		return errors.New("invalid identify of resourcestateversion")
+	}
+
+	q.Select(
+		resourcestateversion.FieldID,
+	)
+
+	var e *ResourceStateVersion
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*ResourceStateVersion)
+		}
+	}
+
+	rsvqi.ID = e.ID
+	return nil
+}
+
+// ResourceStateVersionQueryInputs holds the query input of the ResourceStateVersion entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type ResourceStateVersionQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query ResourceStateVersion entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to query ResourceStateVersion entity MUST under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+	// Resource indicates to query ResourceStateVersion entity MUST under the Resource route.
+	Resource *ResourceQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Validate checks the ResourceStateVersionQueryInputs entity.
+func (rsvqi *ResourceStateVersionQueryInputs) Validate() error {
+	if rsvqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsvqi.ValidateWith(rsvqi.inputConfig.Context, rsvqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateVersionQueryInputs entity with the given context and client set.
+func (rsvqi *ResourceStateVersionQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsvqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when querying under the Project route.
+	if rsvqi.Project != nil {
+		if err := rsvqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	// Validate when querying under the Environment route.
+	if rsvqi.Environment != nil {
+		if err := rsvqi.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	// Validate when querying under the Resource route.
+	if rsvqi.Resource != nil {
+		if err := rsvqi.Resource.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceStateVersionUpdateInput holds the modification input of the ResourceStateVersion entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateVersionUpdateInput struct {
+	ResourceStateVersionQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Model returns the ResourceStateVersion entity for modifying,
+// after validating.
+func (rsvui *ResourceStateVersionUpdateInput) Model() *ResourceStateVersion {
+	if rsvui == nil {
+		return nil
+	}
+
+	_rsv := &ResourceStateVersion{
+		ID: rsvui.ID,
+	}
+
+	return _rsv
+}
+
+// Validate checks the ResourceStateVersionUpdateInput entity.
+func (rsvui *ResourceStateVersionUpdateInput) Validate() error {
+	if rsvui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsvui.ValidateWith(rsvui.inputConfig.Context, rsvui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateVersionUpdateInput entity with the given context and client set.
+func (rsvui *ResourceStateVersionUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := rsvui.ResourceStateVersionQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResourceStateVersionUpdateInputs holds the modification input item of the ResourceStateVersion entities.
+type ResourceStateVersionUpdateInputsItem struct {
+	// ID of the ResourceStateVersion entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ValidateWith checks the ResourceStateVersionUpdateInputsItem entity with the given context and client set.
+func (rsvui *ResourceStateVersionUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsvui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// ResourceStateVersionUpdateInputs holds the modification input of the ResourceStateVersion entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type ResourceStateVersionUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to update ResourceStateVersion entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// Environment indicates to update ResourceStateVersion entity MUST under the Environment route.
+	Environment *EnvironmentQueryInput `path:",inline" query:"-" json:"-"`
+	// Resource indicates to update ResourceStateVersion entity MUST under the Resource route.
+	Resource *ResourceQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*ResourceStateVersionUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the ResourceStateVersion entities for modifying,
+// after validating.
+func (rsvui *ResourceStateVersionUpdateInputs) Model() []*ResourceStateVersion {
+	if rsvui == nil || len(rsvui.Items) == 0 {
+		return nil
+	}
+
+	_rsvs := make([]*ResourceStateVersion, len(rsvui.Items))
+
+	for i := range rsvui.Items {
+		_rsv := &ResourceStateVersion{
+			ID: rsvui.Items[i].ID,
+		}
+
+		_rsvs[i] = _rsv
+	}
+
+	return _rsvs
+}
+
+// IDs returns the ID list of the ResourceStateVersion entities for modifying,
+// after validating.
+func (rsvui *ResourceStateVersionUpdateInputs) IDs() []object.ID {
+	if rsvui == nil || len(rsvui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(rsvui.Items))
+	for i := range rsvui.Items {
+		ids[i] = rsvui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the ResourceStateVersionUpdateInputs entity.
+func (rsvui *ResourceStateVersionUpdateInputs) Validate() error {
+	if rsvui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return rsvui.ValidateWith(rsvui.inputConfig.Context, rsvui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the ResourceStateVersionUpdateInputs entity with the given context and client set.
+func (rsvui *ResourceStateVersionUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if rsvui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(rsvui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.ResourceStateVersions().Query()
+
+	// Validate when updating under the Project route.
+	if rsvui.Project != nil {
+		if err := rsvui.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				resourcestateversion.ProjectID(rsvui.Project.ID))
+		}
+	}
+
+	// Validate when updating under the Environment route.
+	if rsvui.Environment != nil {
+		if err := rsvui.Environment.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				resourcestateversion.EnvironmentID(rsvui.Environment.ID))
+		}
+	}
+
+	// Validate when updating under the Resource route.
+	if rsvui.Resource != nil {
+		if err := rsvui.Resource.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				resourcestateversion.ResourceID(rsvui.Resource.ID))
+		}
+	}
+
+	ids := make([]object.ID, 0, len(rsvui.Items))
+
+	for i := range rsvui.Items {
+		if rsvui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if rsvui.Items[i].ID != "" {
+			ids = append(ids, rsvui.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(resourcestateversion.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range rsvui.Items {
+		if err := rsvui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResourceStateVersionOutput holds the output of the ResourceStateVersion entity.
+type ResourceStateVersionOutput struct {
+	ID         object.ID  `json:"id,omitempty"`
+	CreateTime *time.Time `json:"createTime,omitempty"`
+	Serial     int64      `json:"serial,omitempty"`
+	Lineage    string     `json:"lineage,omitempty"`
+
+	Project     *ProjectOutput     `json:"project,omitempty"`
+	Environment *EnvironmentOutput `json:"environment,omitempty"`
+	Resource    *ResourceOutput    `json:"resource,omitempty"`
+	Run         *ResourceRunOutput `json:"run,omitempty"`
+}
+
+// View returns the output of ResourceStateVersion entity.
+func (_rsv *ResourceStateVersion) View() *ResourceStateVersionOutput {
+	return ExposeResourceStateVersion(_rsv)
+}
+
+// View returns the output of ResourceStateVersion entities.
+func (_rsvs ResourceStateVersions) View() []*ResourceStateVersionOutput {
+	return ExposeResourceStateVersions(_rsvs)
+}
+
+// ExposeResourceStateVersion converts the ResourceStateVersion to ResourceStateVersionOutput.
+func ExposeResourceStateVersion(_rsv *ResourceStateVersion) *ResourceStateVersionOutput {
+	if _rsv == nil {
+		return nil
+	}
+
+	rsvo := &ResourceStateVersionOutput{
+		ID:         _rsv.ID,
+		CreateTime: _rsv.CreateTime,
+		Serial:     _rsv.Serial,
+		Lineage:    _rsv.Lineage,
+	}
+
+	if _rsv.Edges.Project != nil {
+		rsvo.Project = ExposeProject(_rsv.Edges.Project)
+	} else if _rsv.ProjectID != "" {
+		rsvo.Project = &ProjectOutput{
+			ID: _rsv.ProjectID,
+		}
+	}
+	if _rsv.Edges.Environment != nil {
+		rsvo.Environment = ExposeEnvironment(_rsv.Edges.Environment)
+	} else if _rsv.EnvironmentID != "" {
+		rsvo.Environment = &EnvironmentOutput{
+			ID: _rsv.EnvironmentID,
+		}
+	}
+	if _rsv.Edges.Resource != nil {
+		rsvo.Resource = ExposeResource(_rsv.Edges.Resource)
+	} else if _rsv.ResourceID != "" {
+		rsvo.Resource = &ResourceOutput{
+			ID: _rsv.ResourceID,
+		}
+	}
+	if _rsv.Edges.Run != nil {
+		rsvo.Run = ExposeResourceRun(_rsv.Edges.Run)
+	} else if _rsv.RunID != "" {
+		rsvo.Run = &ResourceRunOutput{
+			ID: _rsv.RunID,
+		}
+	}
+	return rsvo
+}
+
+// ExposeResourceStateVersions converts the ResourceStateVersion slice to ResourceStateVersionOutput pointer slice.
+func ExposeResourceStateVersions(_rsvs []*ResourceStateVersion) []*ResourceStateVersionOutput {
+	if len(_rsvs) == 0 {
+		return nil
+	}
+
+	rsvos := make([]*ResourceStateVersionOutput, len(_rsvs))
+	for i := range _rsvs {
+		rsvos[i] = ExposeResourceStateVersion(_rsvs[i])
+	}
+	return rsvos
+}
@@ -8,9 +8,15 @@ package runtime
 import (
 	"time"
 
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/dao/model/budget"
+	"github.com/seal-io/walrus/pkg/dao/model/budgetevent"
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
+	"github.com/seal-io/walrus/pkg/dao/model/chargebackstatement"
 	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/costanomaly"
 	"github.com/seal-io/walrus/pkg/dao/model/costreport"
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
 	"github.com/seal-io/walrus/pkg/dao/model/distributelock"
 	"github.com/seal-io/walrus/pkg/dao/model/environment"
 	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
@@ -23,15 +29,23 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinitionmatchingrule"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcestate"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/role"
 	"github.com/seal-io/walrus/pkg/dao/model/setting"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
 	"github.com/seal-io/walrus/pkg/dao/model/subject"
 	"github.com/seal-io/walrus/pkg/dao/model/subjectrolerelationship"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/model/token"
 	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
 	"github.com/seal-io/walrus/pkg/dao/model/workflow"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowexecution"
 	"github.com/seal-io/walrus/pkg/dao/model/workflowstage"
@@ -49,6 +63,113 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditlogMixin := schema.AuditLog{}.Mixin()
+	auditlogMixinHooks0 := auditlogMixin[0].Hooks()
+	auditlog.Hooks[0] = auditlogMixinHooks0[0]
+	auditlogMixinFields1 := auditlogMixin[1].Fields()
+	_ = auditlogMixinFields1
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreateTime is the schema descriptor for create_time field.
+	auditlogDescCreateTime := auditlogMixinFields1[0].Descriptor()
+	// auditlog.DefaultCreateTime holds the default value on creation for the create_time field.
+	auditlog.DefaultCreateTime = auditlogDescCreateTime.Default.(func() time.Time)
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[2].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescMethod is the schema descriptor for method field.
+	auditlogDescMethod := auditlogFields[3].Descriptor()
+	// auditlog.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	auditlog.MethodValidator = auditlogDescMethod.Validators[0].(func(string) error)
+	// auditlogDescPath is the schema descriptor for path field.
+	auditlogDescPath := auditlogFields[4].Descriptor()
+	// auditlog.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	auditlog.PathValidator = auditlogDescPath.Validators[0].(func(string) error)
+	// auditlogDescResult is the schema descriptor for result field.
+	auditlogDescResult := auditlogFields[11].Descriptor()
+	// auditlog.ResultValidator is a validator for the "result" field. It is called by the builders before save.
+	auditlog.ResultValidator = auditlogDescResult.Validators[0].(func(string) error)
+	budgetMixin := schema.Budget{}.Mixin()
+	budgetMixinHooks0 := budgetMixin[0].Hooks()
+	budget.Hooks[0] = budgetMixinHooks0[0]
+	budgetInters := schema.Budget{}.Interceptors()
+	budget.Interceptors[0] = budgetInters[0]
+	budgetMixinFields0 := budgetMixin[0].Fields()
+	_ = budgetMixinFields0
+	budgetFields := schema.Budget{}.Fields()
+	_ = budgetFields
+	// budgetDescName is the schema descriptor for name field.
+	budgetDescName := budgetMixinFields0[1].Descriptor()
+	// budget.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	budget.NameValidator = budgetDescName.Validators[0].(func(string) error)
+	// budgetDescLabels is the schema descriptor for labels field.
+	budgetDescLabels := budgetMixinFields0[3].Descriptor()
+	// budget.DefaultLabels holds the default value on creation for the labels field.
+	budget.DefaultLabels = budgetDescLabels.Default.(map[string]string)
+	// budgetDescCreateTime is the schema descriptor for create_time field.
+	budgetDescCreateTime := budgetMixinFields0[4].Descriptor()
+	// budget.DefaultCreateTime holds the default value on creation for the create_time field.
+	budget.DefaultCreateTime = budgetDescCreateTime.Default.(func() time.Time)
+	// budgetDescUpdateTime is the schema descriptor for update_time field.
+	budgetDescUpdateTime := budgetMixinFields0[5].Descriptor()
+	// budget.DefaultUpdateTime holds the default value on creation for the update_time field.
+	budget.DefaultUpdateTime = budgetDescUpdateTime.Default.(func() time.Time)
+	// budget.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	budget.UpdateDefaultUpdateTime = budgetDescUpdateTime.UpdateDefault.(func() time.Time)
+	// budgetDescProjectID is the schema descriptor for project_id field.
+	budgetDescProjectID := budgetFields[0].Descriptor()
+	// budget.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	budget.ProjectIDValidator = budgetDescProjectID.Validators[0].(func(string) error)
+	// budgetDescAmount is the schema descriptor for amount field.
+	budgetDescAmount := budgetFields[3].Descriptor()
+	// budget.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	budget.AmountValidator = budgetDescAmount.Validators[0].(func(float64) error)
+	// budgetDescPeriod is the schema descriptor for period field.
+	budgetDescPeriod := budgetFields[4].Descriptor()
+	// budget.DefaultPeriod holds the default value on creation for the period field.
+	budget.DefaultPeriod = budgetDescPeriod.Default.(string)
+	// budget.PeriodValidator is a validator for the "period" field. It is called by the builders before save.
+	budget.PeriodValidator = budgetDescPeriod.Validators[0].(func(string) error)
+	// budgetDescThresholds is the schema descriptor for thresholds field.
+	budgetDescThresholds := budgetFields[7].Descriptor()
+	// budget.DefaultThresholds holds the default value on creation for the thresholds field.
+	budget.DefaultThresholds = budgetDescThresholds.Default.(types.BudgetThresholds)
+	budgeteventMixin := schema.BudgetEvent{}.Mixin()
+	budgeteventMixinHooks0 := budgeteventMixin[0].Hooks()
+	budgetevent.Hooks[0] = budgeteventMixinHooks0[0]
+	budgeteventInters := schema.BudgetEvent{}.Interceptors()
+	budgetevent.Interceptors[0] = budgeteventInters[0]
+	budgeteventMixinFields1 := budgeteventMixin[1].Fields()
+	_ = budgeteventMixinFields1
+	budgeteventFields := schema.BudgetEvent{}.Fields()
+	_ = budgeteventFields
+	// budgeteventDescCreateTime is the schema descriptor for create_time field.
+	budgeteventDescCreateTime := budgeteventMixinFields1[0].Descriptor()
+	// budgetevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	budgetevent.DefaultCreateTime = budgeteventDescCreateTime.Default.(func() time.Time)
+	// budgeteventDescUpdateTime is the schema descriptor for update_time field.
+	budgeteventDescUpdateTime := budgeteventMixinFields1[1].Descriptor()
+	// budgetevent.DefaultUpdateTime holds the default value on creation for the update_time field.
+	budgetevent.DefaultUpdateTime = budgeteventDescUpdateTime.Default.(func() time.Time)
+	// budgetevent.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	budgetevent.UpdateDefaultUpdateTime = budgeteventDescUpdateTime.UpdateDefault.(func() time.Time)
+	// budgeteventDescProjectID is the schema descriptor for project_id field.
+	budgeteventDescProjectID := budgeteventFields[0].Descriptor()
+	// budgetevent.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	budgetevent.ProjectIDValidator = budgeteventDescProjectID.Validators[0].(func(string) error)
+	// budgeteventDescBudgetID is the schema descriptor for budget_id field.
+	budgeteventDescBudgetID := budgeteventFields[2].Descriptor()
+	// budgetevent.BudgetIDValidator is a validator for the "budget_id" field. It is called by the builders before save.
+	budgetevent.BudgetIDValidator = budgeteventDescBudgetID.Validators[0].(func(string) error)
+	// budgeteventDescName is the schema descriptor for name field.
+	budgeteventDescName := budgeteventFields[3].Descriptor()
+	// budgetevent.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	budgetevent.NameValidator = budgeteventDescName.Validators[0].(func(string) error)
+	// budgeteventDescThresholdType is the schema descriptor for threshold_type field.
+	budgeteventDescThresholdType := budgeteventFields[6].Descriptor()
+	// budgetevent.ThresholdTypeValidator is a validator for the "threshold_type" field. It is called by the builders before save.
+	budgetevent.ThresholdTypeValidator = budgeteventDescThresholdType.Validators[0].(func(string) error)
 	catalogMixin := schema.Catalog{}.Mixin()
 	catalogMixinHooks0 := catalogMixin[0].Hooks()
 	catalog.Hooks[0] = catalogMixinHooks0[0]
@@ -88,6 +209,21 @@ func init() {
 	catalogDescSource := catalogFields[1].Descriptor()
 	// catalog.SourceValidator is a validator for the "source" field. It is called by the builders before save.
 	catalog.SourceValidator = catalogDescSource.Validators[0].(func(string) error)
+	chargebackstatementMixin := schema.ChargebackStatement{}.Mixin()
+	chargebackstatementMixinHooks0 := chargebackstatementMixin[0].Hooks()
+	chargebackstatement.Hooks[0] = chargebackstatementMixinHooks0[0]
+	chargebackstatementMixinFields1 := chargebackstatementMixin[1].Fields()
+	_ = chargebackstatementMixinFields1
+	chargebackstatementFields := schema.ChargebackStatement{}.Fields()
+	_ = chargebackstatementFields
+	// chargebackstatementDescCreateTime is the schema descriptor for create_time field.
+	chargebackstatementDescCreateTime := chargebackstatementMixinFields1[0].Descriptor()
+	// chargebackstatement.DefaultCreateTime holds the default value on creation for the create_time field.
+	chargebackstatement.DefaultCreateTime = chargebackstatementDescCreateTime.Default.(func() time.Time)
+	// chargebackstatementDescTotalCost is the schema descriptor for total_cost field.
+	chargebackstatementDescTotalCost := chargebackstatementFields[5].Descriptor()
+	// chargebackstatement.DefaultTotalCost holds the default value on creation for the total_cost field.
+	chargebackstatement.DefaultTotalCost = chargebackstatementDescTotalCost.Default.(float64)
 	connectorMixin := schema.Connector{}.Mixin()
 	connectorMixinHooks0 := connectorMixin[0].Hooks()
 	connectorHooks := schema.Connector{}.Hooks()
@@ -141,6 +277,31 @@ func init() {
 	connectorDescConfigData := connectorFields[5].Descriptor()
 	// connector.DefaultConfigData holds the default value on creation for the config_data field.
 	connector.DefaultConfigData = connectorDescConfigData.Default.(crypto.Properties)
+	costanomalyMixin := schema.CostAnomaly{}.Mixin()
+	costanomalyMixinHooks0 := costanomalyMixin[0].Hooks()
+	costanomaly.Hooks[0] = costanomalyMixinHooks0[0]
+	costanomalyMixinFields1 := costanomalyMixin[1].Fields()
+	_ = costanomalyMixinFields1
+	costanomalyFields := schema.CostAnomaly{}.Fields()
+	_ = costanomalyFields
+	// costanomalyDescCreateTime is the schema descriptor for create_time field.
+	costanomalyDescCreateTime := costanomalyMixinFields1[0].Descriptor()
+	// costanomaly.DefaultCreateTime holds the default value on creation for the create_time field.
+	costanomaly.DefaultCreateTime = costanomalyDescCreateTime.Default.(func() time.Time)
+	// costanomalyDescUpdateTime is the schema descriptor for update_time field.
+	costanomalyDescUpdateTime := costanomalyMixinFields1[1].Descriptor()
+	// costanomaly.DefaultUpdateTime holds the default value on creation for the update_time field.
+	costanomaly.DefaultUpdateTime = costanomalyDescUpdateTime.Default.(func() time.Time)
+	// costanomaly.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	costanomaly.UpdateDefaultUpdateTime = costanomalyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// costanomalyDescScope is the schema descriptor for scope field.
+	costanomalyDescScope := costanomalyFields[0].Descriptor()
+	// costanomaly.ScopeValidator is a validator for the "scope" field. It is called by the builders before save.
+	costanomaly.ScopeValidator = costanomalyDescScope.Validators[0].(func(string) error)
+	// costanomalyDescName is the schema descriptor for name field.
+	costanomalyDescName := costanomalyFields[1].Descriptor()
+	// costanomaly.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	costanomaly.NameValidator = costanomalyDescName.Validators[0].(func(string) error)
 	costreportHooks := schema.CostReport{}.Hooks()
 	costreport.Hooks[0] = costreportHooks[0]
 	costreportFields := schema.CostReport{}.Fields()
@@ -213,6 +374,43 @@ func init() {
 	costreportDescRAMByteUsageMax := costreportFields[29].Descriptor()
 	// costreport.DefaultRAMByteUsageMax holds the default value on creation for the ram_byte_usage_max field.
 	costreport.DefaultRAMByteUsageMax = costreportDescRAMByteUsageMax.Default.(float64)
+	dataencryptionkeyMixin := schema.DataEncryptionKey{}.Mixin()
+	dataencryptionkeyMixinHooks0 := dataencryptionkeyMixin[0].Hooks()
+	dataencryptionkey.Hooks[0] = dataencryptionkeyMixinHooks0[0]
+	dataencryptionkeyMixinFields1 := dataencryptionkeyMixin[1].Fields()
+	_ = dataencryptionkeyMixinFields1
+	dataencryptionkeyFields := schema.DataEncryptionKey{}.Fields()
+	_ = dataencryptionkeyFields
+	// dataencryptionkeyDescCreateTime is the schema descriptor for create_time field.
+	dataencryptionkeyDescCreateTime := dataencryptionkeyMixinFields1[0].Descriptor()
+	// dataencryptionkey.DefaultCreateTime holds the default value on creation for the create_time field.
+	dataencryptionkey.DefaultCreateTime = dataencryptionkeyDescCreateTime.Default.(func() time.Time)
+	// dataencryptionkeyDescUpdateTime is the schema descriptor for update_time field.
+	dataencryptionkeyDescUpdateTime := dataencryptionkeyMixinFields1[1].Descriptor()
+	// dataencryptionkey.DefaultUpdateTime holds the default value on creation for the update_time field.
+	dataencryptionkey.DefaultUpdateTime = dataencryptionkeyDescUpdateTime.Default.(func() time.Time)
+	// dataencryptionkey.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	dataencryptionkey.UpdateDefaultUpdateTime = dataencryptionkeyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// dataencryptionkeyDescProvider is the schema descriptor for provider field.
+	dataencryptionkeyDescProvider := dataencryptionkeyFields[0].Descriptor()
+	// dataencryptionkey.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	dataencryptionkey.ProviderValidator = dataencryptionkeyDescProvider.Validators[0].(func(string) error)
+	// dataencryptionkeyDescWrappedKey is the schema descriptor for wrapped_key field.
+	dataencryptionkeyDescWrappedKey := dataencryptionkeyFields[1].Descriptor()
+	// dataencryptionkey.WrappedKeyValidator is a validator for the "wrapped_key" field. It is called by the builders before save.
+	dataencryptionkey.WrappedKeyValidator = dataencryptionkeyDescWrappedKey.Validators[0].(func([]byte) error)
+	// dataencryptionkeyDescActive is the schema descriptor for active field.
+	dataencryptionkeyDescActive := dataencryptionkeyFields[2].Descriptor()
+	// dataencryptionkey.DefaultActive holds the default value on creation for the active field.
+	dataencryptionkey.DefaultActive = dataencryptionkeyDescActive.Default.(bool)
+	// dataencryptionkeyDescRotationTotal is the schema descriptor for rotation_total field.
+	dataencryptionkeyDescRotationTotal := dataencryptionkeyFields[4].Descriptor()
+	// dataencryptionkey.DefaultRotationTotal holds the default value on creation for the rotation_total field.
+	dataencryptionkey.DefaultRotationTotal = dataencryptionkeyDescRotationTotal.Default.(int)
+	// dataencryptionkeyDescRotationProcessed is the schema descriptor for rotation_processed field.
+	dataencryptionkeyDescRotationProcessed := dataencryptionkeyFields[5].Descriptor()
+	// dataencryptionkey.DefaultRotationProcessed holds the default value on creation for the rotation_processed field.
+	dataencryptionkey.DefaultRotationProcessed = dataencryptionkeyDescRotationProcessed.Default.(int)
 	distributelockFields := schema.DistributeLock{}.Fields()
 	_ = distributelockFields
 	// distributelockDescHolder is the schema descriptor for holder field.
@@ -640,6 +838,84 @@ func init() {
 	resourcerunDescLabels := resourcerunFields[20].Descriptor()
 	// resourcerun.DefaultLabels holds the default value on creation for the labels field.
 	resourcerun.DefaultLabels = resourcerunDescLabels.Default.(map[string]string)
+	resourcerunapprovalruleMixin := schema.ResourceRunApprovalRule{}.Mixin()
+	resourcerunapprovalruleMixinHooks0 := resourcerunapprovalruleMixin[0].Hooks()
+	resourcerunapprovalrule.Hooks[0] = resourcerunapprovalruleMixinHooks0[0]
+	resourcerunapprovalruleInters := schema.ResourceRunApprovalRule{}.Interceptors()
+	resourcerunapprovalrule.Interceptors[0] = resourcerunapprovalruleInters[0]
+	resourcerunapprovalruleMixinFields0 := resourcerunapprovalruleMixin[0].Fields()
+	_ = resourcerunapprovalruleMixinFields0
+	resourcerunapprovalruleFields := schema.ResourceRunApprovalRule{}.Fields()
+	_ = resourcerunapprovalruleFields
+	// resourcerunapprovalruleDescName is the schema descriptor for name field.
+	resourcerunapprovalruleDescName := resourcerunapprovalruleMixinFields0[1].Descriptor()
+	// resourcerunapprovalrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	resourcerunapprovalrule.NameValidator = resourcerunapprovalruleDescName.Validators[0].(func(string) error)
+	// resourcerunapprovalruleDescLabels is the schema descriptor for labels field.
+	resourcerunapprovalruleDescLabels := resourcerunapprovalruleMixinFields0[3].Descriptor()
+	// resourcerunapprovalrule.DefaultLabels holds the default value on creation for the labels field.
+	resourcerunapprovalrule.DefaultLabels = resourcerunapprovalruleDescLabels.Default.(map[string]string)
+	// resourcerunapprovalruleDescCreateTime is the schema descriptor for create_time field.
+	resourcerunapprovalruleDescCreateTime := resourcerunapprovalruleMixinFields0[4].Descriptor()
+	// resourcerunapprovalrule.DefaultCreateTime holds the default value on creation for the create_time field.
+	resourcerunapprovalrule.DefaultCreateTime = resourcerunapprovalruleDescCreateTime.Default.(func() time.Time)
+	// resourcerunapprovalruleDescUpdateTime is the schema descriptor for update_time field.
+	resourcerunapprovalruleDescUpdateTime := resourcerunapprovalruleMixinFields0[5].Descriptor()
+	// resourcerunapprovalrule.DefaultUpdateTime holds the default value on creation for the update_time field.
+	resourcerunapprovalrule.DefaultUpdateTime = resourcerunapprovalruleDescUpdateTime.Default.(func() time.Time)
+	// resourcerunapprovalrule.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	resourcerunapprovalrule.UpdateDefaultUpdateTime = resourcerunapprovalruleDescUpdateTime.UpdateDefault.(func() time.Time)
+	// resourcerunapprovalruleDescApprovals is the schema descriptor for approvals field.
+	resourcerunapprovalruleDescApprovals := resourcerunapprovalruleFields[4].Descriptor()
+	// resourcerunapprovalrule.DefaultApprovals holds the default value on creation for the approvals field.
+	resourcerunapprovalrule.DefaultApprovals = resourcerunapprovalruleDescApprovals.Default.(int)
+	// resourcerunapprovalrule.ApprovalsValidator is a validator for the "approvals" field. It is called by the builders before save.
+	resourcerunapprovalrule.ApprovalsValidator = resourcerunapprovalruleDescApprovals.Validators[0].(func(int) error)
+	resourcerunpolicyMixin := schema.ResourceRunPolicy{}.Mixin()
+	resourcerunpolicyMixinHooks0 := resourcerunpolicyMixin[0].Hooks()
+	resourcerunpolicy.Hooks[0] = resourcerunpolicyMixinHooks0[0]
+	resourcerunpolicyInters := schema.ResourceRunPolicy{}.Interceptors()
+	resourcerunpolicy.Interceptors[0] = resourcerunpolicyInters[0]
+	resourcerunpolicyMixinFields0 := resourcerunpolicyMixin[0].Fields()
+	_ = resourcerunpolicyMixinFields0
+	resourcerunpolicyFields := schema.ResourceRunPolicy{}.Fields()
+	_ = resourcerunpolicyFields
+	// resourcerunpolicyDescName is the schema descriptor for name field.
+	resourcerunpolicyDescName := resourcerunpolicyMixinFields0[1].Descriptor()
+	// resourcerunpolicy.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	resourcerunpolicy.NameValidator = resourcerunpolicyDescName.Validators[0].(func(string) error)
+	// resourcerunpolicyDescLabels is the schema descriptor for labels field.
+	resourcerunpolicyDescLabels := resourcerunpolicyMixinFields0[3].Descriptor()
+	// resourcerunpolicy.DefaultLabels holds the default value on creation for the labels field.
+	resourcerunpolicy.DefaultLabels = resourcerunpolicyDescLabels.Default.(map[string]string)
+	// resourcerunpolicyDescCreateTime is the schema descriptor for create_time field.
+	resourcerunpolicyDescCreateTime := resourcerunpolicyMixinFields0[4].Descriptor()
+	// resourcerunpolicy.DefaultCreateTime holds the default value on creation for the create_time field.
+	resourcerunpolicy.DefaultCreateTime = resourcerunpolicyDescCreateTime.Default.(func() time.Time)
+	// resourcerunpolicyDescUpdateTime is the schema descriptor for update_time field.
+	resourcerunpolicyDescUpdateTime := resourcerunpolicyMixinFields0[5].Descriptor()
+	// resourcerunpolicy.DefaultUpdateTime holds the default value on creation for the update_time field.
+	resourcerunpolicy.DefaultUpdateTime = resourcerunpolicyDescUpdateTime.Default.(func() time.Time)
+	// resourcerunpolicy.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	resourcerunpolicy.UpdateDefaultUpdateTime = resourcerunpolicyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// resourcerunpolicyDescProjectID is the schema descriptor for project_id field.
+	resourcerunpolicyDescProjectID := resourcerunpolicyFields[0].Descriptor()
+	// resourcerunpolicy.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	resourcerunpolicy.ProjectIDValidator = resourcerunpolicyDescProjectID.Validators[0].(func(string) error)
+	// resourcerunpolicyDescEnforcement is the schema descriptor for enforcement field.
+	resourcerunpolicyDescEnforcement := resourcerunpolicyFields[2].Descriptor()
+	// resourcerunpolicy.DefaultEnforcement holds the default value on creation for the enforcement field.
+	resourcerunpolicy.DefaultEnforcement = resourcerunpolicyDescEnforcement.Default.(string)
+	// resourcerunpolicy.EnforcementValidator is a validator for the "enforcement" field. It is called by the builders before save.
+	resourcerunpolicy.EnforcementValidator = resourcerunpolicyDescEnforcement.Validators[0].(func(string) error)
+	// resourcerunpolicyDescExpression is the schema descriptor for expression field.
+	resourcerunpolicyDescExpression := resourcerunpolicyFields[3].Descriptor()
+	// resourcerunpolicy.ExpressionValidator is a validator for the "expression" field. It is called by the builders before save.
+	resourcerunpolicy.ExpressionValidator = resourcerunpolicyDescExpression.Validators[0].(func(string) error)
+	// resourcerunpolicyDescDisabled is the schema descriptor for disabled field.
+	resourcerunpolicyDescDisabled := resourcerunpolicyFields[5].Descriptor()
+	// resourcerunpolicy.DefaultDisabled holds the default value on creation for the disabled field.
+	resourcerunpolicy.DefaultDisabled = resourcerunpolicyDescDisabled.Default.(bool)
 	resourcestateMixin := schema.ResourceState{}.Mixin()
 	resourcestateMixinHooks0 := resourcestateMixin[0].Hooks()
 	resourcestate.Hooks[0] = resourcestateMixinHooks0[0]
@@ -653,6 +929,50 @@ func init() {
 	resourcestateDescResourceID := resourcestateFields[1].Descriptor()
 	// resourcestate.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
 	resourcestate.ResourceIDValidator = resourcestateDescResourceID.Validators[0].(func(string) error)
+	resourcestatelockMixin := schema.ResourceStateLock{}.Mixin()
+	resourcestatelockMixinHooks0 := resourcestatelockMixin[0].Hooks()
+	resourcestatelock.Hooks[0] = resourcestatelockMixinHooks0[0]
+	resourcestatelockMixinFields1 := resourcestatelockMixin[1].Fields()
+	_ = resourcestatelockMixinFields1
+	resourcestatelockFields := schema.ResourceStateLock{}.Fields()
+	_ = resourcestatelockFields
+	// resourcestatelockDescCreateTime is the schema descriptor for create_time field.
+	resourcestatelockDescCreateTime := resourcestatelockMixinFields1[0].Descriptor()
+	// resourcestatelock.DefaultCreateTime holds the default value on creation for the create_time field.
+	resourcestatelock.DefaultCreateTime = resourcestatelockDescCreateTime.Default.(func() time.Time)
+	// resourcestatelockDescResourceID is the schema descriptor for resource_id field.
+	resourcestatelockDescResourceID := resourcestatelockFields[0].Descriptor()
+	// resourcestatelock.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	resourcestatelock.ResourceIDValidator = resourcestatelockDescResourceID.Validators[0].(func(string) error)
+	// resourcestatelockDescLockID is the schema descriptor for lock_id field.
+	resourcestatelockDescLockID := resourcestatelockFields[2].Descriptor()
+	// resourcestatelock.LockIDValidator is a validator for the "lock_id" field. It is called by the builders before save.
+	resourcestatelock.LockIDValidator = resourcestatelockDescLockID.Validators[0].(func(string) error)
+	resourcestateversionMixin := schema.ResourceStateVersion{}.Mixin()
+	resourcestateversionMixinHooks0 := resourcestateversionMixin[0].Hooks()
+	resourcestateversion.Hooks[0] = resourcestateversionMixinHooks0[0]
+	resourcestateversionInters := schema.ResourceStateVersion{}.Interceptors()
+	resourcestateversion.Interceptors[0] = resourcestateversionInters[0]
+	resourcestateversionMixinFields1 := resourcestateversionMixin[1].Fields()
+	_ = resourcestateversionMixinFields1
+	resourcestateversionFields := schema.ResourceStateVersion{}.Fields()
+	_ = resourcestateversionFields
+	// resourcestateversionDescCreateTime is the schema descriptor for create_time field.
+	resourcestateversionDescCreateTime := resourcestateversionMixinFields1[0].Descriptor()
+	// resourcestateversion.DefaultCreateTime holds the default value on creation for the create_time field.
+	resourcestateversion.DefaultCreateTime = resourcestateversionDescCreateTime.Default.(func() time.Time)
+	// resourcestateversionDescProjectID is the schema descriptor for project_id field.
+	resourcestateversionDescProjectID := resourcestateversionFields[0].Descriptor()
+	// resourcestateversion.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	resourcestateversion.ProjectIDValidator = resourcestateversionDescProjectID.Validators[0].(func(string) error)
+	// resourcestateversionDescEnvironmentID is the schema descriptor for environment_id field.
+	resourcestateversionDescEnvironmentID := resourcestateversionFields[1].Descriptor()
+	// resourcestateversion.EnvironmentIDValidator is a validator for the "environment_id" field. It is called by the builders before save.
+	resourcestateversion.EnvironmentIDValidator = resourcestateversionDescEnvironmentID.Validators[0].(func(string) error)
+	// resourcestateversionDescResourceID is the schema descriptor for resource_id field.
+	resourcestateversionDescResourceID := resourcestateversionFields[2].Descriptor()
+	// resourcestateversion.ResourceIDValidator is a validator for the "resource_id" field. It is called by the builders before save.
+	resourcestateversion.ResourceIDValidator = resourcestateversionDescResourceID.Validators[0].(func(string) error)
 	roleMixin := schema.Role{}.Mixin()
 	roleHooks := schema.Role{}.Hooks()
 	role.Hooks[0] = roleHooks[0]
@@ -731,6 +1051,31 @@ func init() {
 	settingDescPrivate := settingFields[5].Descriptor()
 	// setting.DefaultPrivate holds the default value on creation for the private field.
 	setting.DefaultPrivate = settingDescPrivate.Default.(bool)
+	storageobjectMixin := schema.StorageObject{}.Mixin()
+	storageobjectMixinHooks0 := storageobjectMixin[0].Hooks()
+	storageobject.Hooks[0] = storageobjectMixinHooks0[0]
+	storageobjectMixinFields1 := storageobjectMixin[1].Fields()
+	_ = storageobjectMixinFields1
+	storageobjectFields := schema.StorageObject{}.Fields()
+	_ = storageobjectFields
+	// storageobjectDescCreateTime is the schema descriptor for create_time field.
+	storageobjectDescCreateTime := storageobjectMixinFields1[0].Descriptor()
+	// storageobject.DefaultCreateTime holds the default value on creation for the create_time field.
+	storageobject.DefaultCreateTime = storageobjectDescCreateTime.Default.(func() time.Time)
+	// storageobjectDescUpdateTime is the schema descriptor for update_time field.
+	storageobjectDescUpdateTime := storageobjectMixinFields1[1].Descriptor()
+	// storageobject.DefaultUpdateTime holds the default value on creation for the update_time field.
+	storageobject.DefaultUpdateTime = storageobjectDescUpdateTime.Default.(func() time.Time)
+	// storageobject.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	storageobject.UpdateDefaultUpdateTime = storageobjectDescUpdateTime.UpdateDefault.(func() time.Time)
+	// storageobjectDescKey is the schema descriptor for key field.
+	storageobjectDescKey := storageobjectFields[0].Descriptor()
+	// storageobject.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	storageobject.KeyValidator = storageobjectDescKey.Validators[0].(func(string) error)
+	// storageobjectDescSize is the schema descriptor for size field.
+	storageobjectDescSize := storageobjectFields[2].Descriptor()
+	// storageobject.DefaultSize holds the default value on creation for the size field.
+	storageobject.DefaultSize = storageobjectDescSize.Default.(int64)
 	subjectMixin := schema.Subject{}.Mixin()
 	subjectMixinHooks0 := subjectMixin[0].Hooks()
 	subject.Hooks[0] = subjectMixinHooks0[0]
@@ -816,6 +1161,47 @@ func init() {
 	templateDescSource := templateFields[1].Descriptor()
 	// template.SourceValidator is a validator for the "source" field. It is called by the builders before save.
 	template.SourceValidator = templateDescSource.Validators[0].(func(string) error)
+	templateupgradecampaignMixin := schema.TemplateUpgradeCampaign{}.Mixin()
+	templateupgradecampaignMixinHooks0 := templateupgradecampaignMixin[0].Hooks()
+	templateupgradecampaign.Hooks[0] = templateupgradecampaignMixinHooks0[0]
+	templateupgradecampaignInters := schema.TemplateUpgradeCampaign{}.Interceptors()
+	templateupgradecampaign.Interceptors[0] = templateupgradecampaignInters[0]
+	templateupgradecampaignMixinFields1 := templateupgradecampaignMixin[1].Fields()
+	_ = templateupgradecampaignMixinFields1
+	templateupgradecampaignFields := schema.TemplateUpgradeCampaign{}.Fields()
+	_ = templateupgradecampaignFields
+	// templateupgradecampaignDescCreateTime is the schema descriptor for create_time field.
+	templateupgradecampaignDescCreateTime := templateupgradecampaignMixinFields1[0].Descriptor()
+	// templateupgradecampaign.DefaultCreateTime holds the default value on creation for the create_time field.
+	templateupgradecampaign.DefaultCreateTime = templateupgradecampaignDescCreateTime.Default.(func() time.Time)
+	// templateupgradecampaignDescUpdateTime is the schema descriptor for update_time field.
+	templateupgradecampaignDescUpdateTime := templateupgradecampaignMixinFields1[1].Descriptor()
+	// templateupgradecampaign.DefaultUpdateTime holds the default value on creation for the update_time field.
+	templateupgradecampaign.DefaultUpdateTime = templateupgradecampaignDescUpdateTime.Default.(func() time.Time)
+	// templateupgradecampaign.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	templateupgradecampaign.UpdateDefaultUpdateTime = templateupgradecampaignDescUpdateTime.UpdateDefault.(func() time.Time)
+	// templateupgradecampaignDescTemplateVersionID is the schema descriptor for template_version_id field.
+	templateupgradecampaignDescTemplateVersionID := templateupgradecampaignFields[1].Descriptor()
+	// templateupgradecampaign.TemplateVersionIDValidator is a validator for the "template_version_id" field. It is called by the builders before save.
+	templateupgradecampaign.TemplateVersionIDValidator = templateupgradecampaignDescTemplateVersionID.Validators[0].(func(string) error)
+	// templateupgradecampaignDescTargetVersionID is the schema descriptor for target_version_id field.
+	templateupgradecampaignDescTargetVersionID := templateupgradecampaignFields[2].Descriptor()
+	// templateupgradecampaign.TargetVersionIDValidator is a validator for the "target_version_id" field. It is called by the builders before save.
+	templateupgradecampaign.TargetVersionIDValidator = templateupgradecampaignDescTargetVersionID.Validators[0].(func(string) error)
+	// templateupgradecampaignDescPreview is the schema descriptor for preview field.
+	templateupgradecampaignDescPreview := templateupgradecampaignFields[3].Descriptor()
+	// templateupgradecampaign.DefaultPreview holds the default value on creation for the preview field.
+	templateupgradecampaign.DefaultPreview = templateupgradecampaignDescPreview.Default.(bool)
+	// templateupgradecampaignDescBatchSize is the schema descriptor for batch_size field.
+	templateupgradecampaignDescBatchSize := templateupgradecampaignFields[4].Descriptor()
+	// templateupgradecampaign.DefaultBatchSize holds the default value on creation for the batch_size field.
+	templateupgradecampaign.DefaultBatchSize = templateupgradecampaignDescBatchSize.Default.(int)
+	// templateupgradecampaign.BatchSizeValidator is a validator for the "batch_size" field. It is called by the builders before save.
+	templateupgradecampaign.BatchSizeValidator = templateupgradecampaignDescBatchSize.Validators[0].(func(int) error)
+	// templateupgradecampaignDescStatus is the schema descriptor for status field.
+	templateupgradecampaignDescStatus := templateupgradecampaignFields[7].Descriptor()
+	// templateupgradecampaign.DefaultStatus holds the default value on creation for the status field.
+	templateupgradecampaign.DefaultStatus = templateupgradecampaignDescStatus.Default.(string)
 	templateversionMixin := schema.TemplateVersion{}.Mixin()
 	templateversionMixinHooks0 := templateversionMixin[0].Hooks()
 	templateversion.Hooks[0] = templateversionMixinHooks0[0]
@@ -863,6 +1249,10 @@ func init() {
 	templateversionDescUISchema := templateversionFields[6].Descriptor()
 	// templateversion.DefaultUISchema holds the default value on creation for the ui_schema field.
 	templateversion.DefaultUISchema = templateversionDescUISchema.Default.(types.UISchema)
+	// templateversionDescLifecycle is the schema descriptor for lifecycle field.
+	templateversionDescLifecycle := templateversionFields[9].Descriptor()
+	// templateversion.DefaultLifecycle holds the default value on creation for the lifecycle field.
+	templateversion.DefaultLifecycle = templateversionDescLifecycle.Default.(string)
 	tokenMixin := schema.Token{}.Mixin()
 	tokenMixinHooks0 := tokenMixin[0].Hooks()
 	token.Hooks[0] = tokenMixinHooks0[0]
@@ -923,6 +1313,86 @@ func init() {
 	variableDescSensitive := variableFields[4].Descriptor()
 	// variable.DefaultSensitive holds the default value on creation for the sensitive field.
 	variable.DefaultSensitive = variableDescSensitive.Default.(bool)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinHooks0 := webhookMixin[0].Hooks()
+	webhook.Hooks[0] = webhookMixinHooks0[0]
+	webhookInters := schema.Webhook{}.Interceptors()
+	webhook.Interceptors[0] = webhookInters[0]
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescName is the schema descriptor for name field.
+	webhookDescName := webhookMixinFields0[1].Descriptor()
+	// webhook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhook.NameValidator = webhookDescName.Validators[0].(func(string) error)
+	// webhookDescLabels is the schema descriptor for labels field.
+	webhookDescLabels := webhookMixinFields0[3].Descriptor()
+	// webhook.DefaultLabels holds the default value on creation for the labels field.
+	webhook.DefaultLabels = webhookDescLabels.Default.(map[string]string)
+	// webhookDescCreateTime is the schema descriptor for create_time field.
+	webhookDescCreateTime := webhookMixinFields0[4].Descriptor()
+	// webhook.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhook.DefaultCreateTime = webhookDescCreateTime.Default.(func() time.Time)
+	// webhookDescUpdateTime is the schema descriptor for update_time field.
+	webhookDescUpdateTime := webhookMixinFields0[5].Descriptor()
+	// webhook.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhook.DefaultUpdateTime = webhookDescUpdateTime.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhook.UpdateDefaultUpdateTime = webhookDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookDescProjectID is the schema descriptor for project_id field.
+	webhookDescProjectID := webhookFields[0].Descriptor()
+	// webhook.ProjectIDValidator is a validator for the "project_id" field. It is called by the builders before save.
+	webhook.ProjectIDValidator = webhookDescProjectID.Validators[0].(func(string) error)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescDisabled is the schema descriptor for disabled field.
+	webhookDescDisabled := webhookFields[5].Descriptor()
+	// webhook.DefaultDisabled holds the default value on creation for the disabled field.
+	webhook.DefaultDisabled = webhookDescDisabled.Default.(bool)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinHooks0 := webhookdeliveryMixin[0].Hooks()
+	webhookdelivery.Hooks[0] = webhookdeliveryMixinHooks0[0]
+	webhookdeliveryMixinFields1 := webhookdeliveryMixin[1].Fields()
+	_ = webhookdeliveryMixinFields1
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreateTime is the schema descriptor for create_time field.
+	webhookdeliveryDescCreateTime := webhookdeliveryMixinFields1[0].Descriptor()
+	// webhookdelivery.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdelivery.DefaultCreateTime = webhookdeliveryDescCreateTime.Default.(func() time.Time)
+	// webhookdeliveryDescUpdateTime is the schema descriptor for update_time field.
+	webhookdeliveryDescUpdateTime := webhookdeliveryMixinFields1[1].Descriptor()
+	// webhookdelivery.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhookdelivery.DefaultUpdateTime = webhookdeliveryDescUpdateTime.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhookdelivery.UpdateDefaultUpdateTime = webhookdeliveryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescWebhookID is the schema descriptor for webhook_id field.
+	webhookdeliveryDescWebhookID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.WebhookIDValidator is a validator for the "webhook_id" field. It is called by the builders before save.
+	webhookdelivery.WebhookIDValidator = webhookdeliveryDescWebhookID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = webhookdeliveryDescEventID.Validators[0].(func(string) error)
+	// webhookdeliveryDescTopic is the schema descriptor for topic field.
+	webhookdeliveryDescTopic := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.TopicValidator is a validator for the "topic" field. It is called by the builders before save.
+	webhookdelivery.TopicValidator = webhookdeliveryDescTopic.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[3].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescStatus is the schema descriptor for status field.
+	webhookdeliveryDescStatus := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultStatus holds the default value on creation for the status field.
+	webhookdelivery.DefaultStatus = webhookdeliveryDescStatus.Default.(string)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
 	workflowMixin := schema.Workflow{}.Mixin()
 	workflowMixinHooks0 := workflowMixin[0].Hooks()
 	workflow.Hooks[0] = workflowMixinHooks0[0]
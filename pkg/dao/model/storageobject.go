@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// StorageObject is the model entity for the StorageObject schema.
+type StorageObject struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// Key of the object, i.e. walrus/project/xxx/environment/xxx/resource/xxx.zip.
+	Key string `json:"key,omitempty"`
+	// Data of the object.
+	Data []byte `json:"-"`
+	// Size of the data in bytes.
+	Size int64 `json:"size,omitempty"`
+	// Checksum of the data, i.e. sha256:xxx.
+	Checksum     string `json:"checksum,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StorageObject) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case storageobject.FieldData:
+			values[i] = new([]byte)
+		case storageobject.FieldID:
+			values[i] = new(object.ID)
+		case storageobject.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case storageobject.FieldKey, storageobject.FieldChecksum:
+			values[i] = new(sql.NullString)
+		case storageobject.FieldCreateTime, storageobject.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StorageObject fields.
+func (so *StorageObject) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case storageobject.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				so.ID = *value
+			}
+		case storageobject.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				so.CreateTime = new(time.Time)
+				*so.CreateTime = value.Time
+			}
+		case storageobject.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				so.UpdateTime = new(time.Time)
+				*so.UpdateTime = value.Time
+			}
+		case storageobject.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				so.Key = value.String
+			}
+		case storageobject.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				so.Data = *value
+			}
+		case storageobject.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				so.Size = value.Int64
+			}
+		case storageobject.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				so.Checksum = value.String
+			}
+		default:
+			so.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StorageObject.
+// This includes values selected through modifiers, order, etc.
+func (so *StorageObject) Value(name string) (ent.Value, error) {
+	return so.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this StorageObject.
+// Note that you need to call StorageObject.Unwrap() before calling this method if this StorageObject
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (so *StorageObject) Update() *StorageObjectUpdateOne {
+	return NewStorageObjectClient(so.config).UpdateOne(so)
+}
+
+// Unwrap unwraps the StorageObject entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (so *StorageObject) Unwrap() *StorageObject {
+	_tx, ok := so.config.driver.(*txDriver)
+	if !ok {
+		panic("model: StorageObject is not a transactional entity")
+	}
+	so.config.driver = _tx.drv
+	return so
+}
+
+// String implements the fmt.Stringer.
+func (so *StorageObject) String() string {
+	var builder strings.Builder
+	builder.WriteString("StorageObject(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", so.ID))
+	if v := so.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := so.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(so.Key)
+	builder.WriteString(", ")
+	builder.WriteString("data=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", so.Size))
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(so.Checksum)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StorageObjects is a parsable slice of StorageObject.
+type StorageObjects []*StorageObject
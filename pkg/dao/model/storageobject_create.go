@@ -0,0 +1,1083 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// StorageObjectCreate is the builder for creating a StorageObject entity.
+type StorageObjectCreate struct {
+	config
+	mutation   *StorageObjectMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *StorageObject
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (soc *StorageObjectCreate) SetCreateTime(t time.Time) *StorageObjectCreate {
+	soc.mutation.SetCreateTime(t)
+	return soc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (soc *StorageObjectCreate) SetNillableCreateTime(t *time.Time) *StorageObjectCreate {
+	if t != nil {
+		soc.SetCreateTime(*t)
+	}
+	return soc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (soc *StorageObjectCreate) SetUpdateTime(t time.Time) *StorageObjectCreate {
+	soc.mutation.SetUpdateTime(t)
+	return soc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (soc *StorageObjectCreate) SetNillableUpdateTime(t *time.Time) *StorageObjectCreate {
+	if t != nil {
+		soc.SetUpdateTime(*t)
+	}
+	return soc
+}
+
+// SetKey sets the "key" field.
+func (soc *StorageObjectCreate) SetKey(s string) *StorageObjectCreate {
+	soc.mutation.SetKey(s)
+	return soc
+}
+
+// SetData sets the "data" field.
+func (soc *StorageObjectCreate) SetData(b []byte) *StorageObjectCreate {
+	soc.mutation.SetData(b)
+	return soc
+}
+
+// SetSize sets the "size" field.
+func (soc *StorageObjectCreate) SetSize(i int64) *StorageObjectCreate {
+	soc.mutation.SetSize(i)
+	return soc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (soc *StorageObjectCreate) SetNillableSize(i *int64) *StorageObjectCreate {
+	if i != nil {
+		soc.SetSize(*i)
+	}
+	return soc
+}
+
+// SetChecksum sets the "checksum" field.
+func (soc *StorageObjectCreate) SetChecksum(s string) *StorageObjectCreate {
+	soc.mutation.SetChecksum(s)
+	return soc
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (soc *StorageObjectCreate) SetNillableChecksum(s *string) *StorageObjectCreate {
+	if s != nil {
+		soc.SetChecksum(*s)
+	}
+	return soc
+}
+
+// SetID sets the "id" field.
+func (soc *StorageObjectCreate) SetID(o object.ID) *StorageObjectCreate {
+	soc.mutation.SetID(o)
+	return soc
+}
+
+// Mutation returns the StorageObjectMutation object of the builder.
+func (soc *StorageObjectCreate) Mutation() *StorageObjectMutation {
+	return soc.mutation
+}
+
+// Save creates the StorageObject in the database.
+func (soc *StorageObjectCreate) Save(ctx context.Context) (*StorageObject, error) {
+	if err := soc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, soc.sqlSave, soc.mutation, soc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (soc *StorageObjectCreate) SaveX(ctx context.Context) *StorageObject {
+	v, err := soc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (soc *StorageObjectCreate) Exec(ctx context.Context) error {
+	_, err := soc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (soc *StorageObjectCreate) ExecX(ctx context.Context) {
+	if err := soc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (soc *StorageObjectCreate) defaults() error {
+	if _, ok := soc.mutation.CreateTime(); !ok {
+		if storageobject.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized storageobject.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := storageobject.DefaultCreateTime()
+		soc.mutation.SetCreateTime(v)
+	}
+	if _, ok := soc.mutation.UpdateTime(); !ok {
+		if storageobject.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized storageobject.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := storageobject.DefaultUpdateTime()
+		soc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := soc.mutation.Size(); !ok {
+		v := storageobject.DefaultSize
+		soc.mutation.SetSize(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (soc *StorageObjectCreate) check() error {
+	if _, ok := soc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "StorageObject.create_time"`)}
+	}
+	if _, ok := soc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "StorageObject.update_time"`)}
+	}
+	if _, ok := soc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`model: missing required field "StorageObject.key"`)}
+	}
+	if v, ok := soc.mutation.Key(); ok {
+		if err := storageobject.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`model: validator failed for field "StorageObject.key": %w`, err)}
+		}
+	}
+	if _, ok := soc.mutation.Data(); !ok {
+		return &ValidationError{Name: "data", err: errors.New(`model: missing required field "StorageObject.data"`)}
+	}
+	if _, ok := soc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`model: missing required field "StorageObject.size"`)}
+	}
+	return nil
+}
+
+func (soc *StorageObjectCreate) sqlSave(ctx context.Context) (*StorageObject, error) {
+	if err := soc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := soc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, soc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	soc.mutation.id = &_node.ID
+	soc.mutation.done = true
+	return _node, nil
+}
+
+func (soc *StorageObjectCreate) createSpec() (*StorageObject, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StorageObject{config: soc.config}
+		_spec = sqlgraph.NewCreateSpec(storageobject.Table, sqlgraph.NewFieldSpec(storageobject.FieldID, field.TypeString))
+	)
+	_spec.Schema = soc.schemaConfig.StorageObject
+	_spec.OnConflict = soc.conflict
+	if id, ok := soc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := soc.mutation.CreateTime(); ok {
+		_spec.SetField(storageobject.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := soc.mutation.UpdateTime(); ok {
+		_spec.SetField(storageobject.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := soc.mutation.Key(); ok {
+		_spec.SetField(storageobject.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := soc.mutation.Data(); ok {
+		_spec.SetField(storageobject.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
+	if value, ok := soc.mutation.Size(); ok {
+		_spec.SetField(storageobject.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := soc.mutation.Checksum(); ok {
+		_spec.SetField(storageobject.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (soc *StorageObjectCreate) Set(obj *StorageObject) *StorageObjectCreate {
+	// Required.
+	soc.SetKey(obj.Key)
+	soc.SetData(obj.Data)
+	soc.SetSize(obj.Size)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		soc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		soc.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.Checksum != "" {
+		soc.SetChecksum(obj.Checksum)
+	}
+
+	// Record the given object.
+	soc.object = obj
+
+	return soc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (soc *StorageObjectCreate) getClientSet() (mc ClientSet) {
+	if _, ok := soc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: soc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: soc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the StorageObject entity,
+// which is always good for cascading create operations.
+func (soc *StorageObjectCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *StorageObject) error) (*StorageObject, error) {
+	obj, err := soc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := soc.getClientSet()
+	if soc.fromUpsert {
+		q := mc.StorageObjects().Query().
+			Where(
+				storageobject.Key(obj.Key),
+			)
+		obj.ID, err = q.OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("model: failed to query id of StorageObject entity: %w", err)
+		}
+	}
+
+	if x := soc.object; x != nil {
+		if _, set := soc.mutation.Field(storageobject.FieldKey); set {
+			obj.Key = x.Key
+		}
+		if _, set := soc.mutation.Field(storageobject.FieldData); set {
+			obj.Data = x.Data
+		}
+		if _, set := soc.mutation.Field(storageobject.FieldChecksum); set {
+			obj.Checksum = x.Checksum
+		}
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (soc *StorageObjectCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *StorageObject) error) *StorageObject {
+	obj, err := soc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (soc *StorageObjectCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *StorageObject) error) error {
+	_, err := soc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (soc *StorageObjectCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *StorageObject) error) {
+	if err := soc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the StorageObjectCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (socb *StorageObjectCreateBulk) Set(objs ...*StorageObject) *StorageObjectCreateBulk {
+	if len(objs) != 0 {
+		client := NewStorageObjectClient(socb.config)
+
+		socb.builders = make([]*StorageObjectCreate, len(objs))
+		for i := range objs {
+			socb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		socb.objects = objs
+	}
+
+	return socb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (socb *StorageObjectCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := socb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: socb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: socb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the StorageObject entities,
+// which is always good for cascading create operations.
+func (socb *StorageObjectCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *StorageObject) error) ([]*StorageObject, error) {
+	objs, err := socb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := socb.getClientSet()
+	if socb.fromUpsert {
+		for i := range objs {
+			obj := objs[i]
+			q := mc.StorageObjects().Query().
+				Where(
+					storageobject.Key(obj.Key),
+				)
+			objs[i].ID, err = q.OnlyID(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("model: failed to query id of StorageObject entity: %w", err)
+			}
+		}
+	}
+
+	if x := socb.objects; x != nil {
+		for i := range x {
+			if _, set := socb.builders[i].mutation.Field(storageobject.FieldKey); set {
+				objs[i].Key = x[i].Key
+			}
+			if _, set := socb.builders[i].mutation.Field(storageobject.FieldData); set {
+				objs[i].Data = x[i].Data
+			}
+			if _, set := socb.builders[i].mutation.Field(storageobject.FieldChecksum); set {
+				objs[i].Checksum = x[i].Checksum
+			}
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (socb *StorageObjectCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *StorageObject) error) []*StorageObject {
+	objs, err := socb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (socb *StorageObjectCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *StorageObject) error) error {
+	_, err := socb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (socb *StorageObjectCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *StorageObject) error) {
+	if err := socb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *StorageObjectUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *StorageObject) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for StorageObjectUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *StorageObjectUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *StorageObject) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *StorageObjectUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *StorageObject) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the StorageObjectUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for StorageObjectUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *StorageObjectUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *StorageObject) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StorageObject.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StorageObjectUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (soc *StorageObjectCreate) OnConflict(opts ...sql.ConflictOption) *StorageObjectUpsertOne {
+	soc.conflict = opts
+	return &StorageObjectUpsertOne{
+		create: soc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (soc *StorageObjectCreate) OnConflictColumns(columns ...string) *StorageObjectUpsertOne {
+	soc.conflict = append(soc.conflict, sql.ConflictColumns(columns...))
+	return &StorageObjectUpsertOne{
+		create: soc,
+	}
+}
+
+type (
+	// StorageObjectUpsertOne is the builder for "upsert"-ing
+	//  one StorageObject node.
+	StorageObjectUpsertOne struct {
+		create *StorageObjectCreate
+	}
+
+	// StorageObjectUpsert is the "OnConflict" setter.
+	StorageObjectUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StorageObjectUpsert) SetUpdateTime(v time.Time) *StorageObjectUpsert {
+	u.Set(storageobject.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateUpdateTime() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldUpdateTime)
+	return u
+}
+
+// SetData sets the "data" field.
+func (u *StorageObjectUpsert) SetData(v []byte) *StorageObjectUpsert {
+	u.Set(storageobject.FieldData, v)
+	return u
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateData() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldData)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *StorageObjectUpsert) SetSize(v int64) *StorageObjectUpsert {
+	u.Set(storageobject.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateSize() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *StorageObjectUpsert) AddSize(v int64) *StorageObjectUpsert {
+	u.Add(storageobject.FieldSize, v)
+	return u
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *StorageObjectUpsert) SetChecksum(v string) *StorageObjectUpsert {
+	u.Set(storageobject.FieldChecksum, v)
+	return u
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *StorageObjectUpsert) UpdateChecksum() *StorageObjectUpsert {
+	u.SetExcluded(storageobject.FieldChecksum)
+	return u
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (u *StorageObjectUpsert) ClearChecksum() *StorageObjectUpsert {
+	u.SetNull(storageobject.FieldChecksum)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(storageobject.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StorageObjectUpsertOne) UpdateNewValues() *StorageObjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(storageobject.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(storageobject.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.Key(); exists {
+			s.SetIgnore(storageobject.FieldKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *StorageObjectUpsertOne) Ignore() *StorageObjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StorageObjectUpsertOne) DoNothing() *StorageObjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StorageObjectCreate.OnConflict
+// documentation for more info.
+func (u *StorageObjectUpsertOne) Update(set func(*StorageObjectUpsert)) *StorageObjectUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StorageObjectUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StorageObjectUpsertOne) SetUpdateTime(v time.Time) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateUpdateTime() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *StorageObjectUpsertOne) SetData(v []byte) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateData() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateData()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *StorageObjectUpsertOne) SetSize(v int64) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *StorageObjectUpsertOne) AddSize(v int64) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateSize() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *StorageObjectUpsertOne) SetChecksum(v string) *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *StorageObjectUpsertOne) UpdateChecksum() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (u *StorageObjectUpsertOne) ClearChecksum() *StorageObjectUpsertOne {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.ClearChecksum()
+	})
+}
+
+// Exec executes the query.
+func (u *StorageObjectUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for StorageObjectCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StorageObjectUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *StorageObjectUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: StorageObjectUpsertOne.ID is not supported by MySQL driver. Use StorageObjectUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *StorageObjectUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// StorageObjectCreateBulk is the builder for creating many StorageObject entities in bulk.
+type StorageObjectCreateBulk struct {
+	config
+	err        error
+	builders   []*StorageObjectCreate
+	conflict   []sql.ConflictOption
+	objects    []*StorageObject
+	fromUpsert bool
+}
+
+// Save creates the StorageObject entities in the database.
+func (socb *StorageObjectCreateBulk) Save(ctx context.Context) ([]*StorageObject, error) {
+	if socb.err != nil {
+		return nil, socb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(socb.builders))
+	nodes := make([]*StorageObject, len(socb.builders))
+	mutators := make([]Mutator, len(socb.builders))
+	for i := range socb.builders {
+		func(i int, root context.Context) {
+			builder := socb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StorageObjectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, socb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = socb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, socb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, socb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (socb *StorageObjectCreateBulk) SaveX(ctx context.Context) []*StorageObject {
+	v, err := socb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (socb *StorageObjectCreateBulk) Exec(ctx context.Context) error {
+	_, err := socb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (socb *StorageObjectCreateBulk) ExecX(ctx context.Context) {
+	if err := socb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.StorageObject.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.StorageObjectUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (socb *StorageObjectCreateBulk) OnConflict(opts ...sql.ConflictOption) *StorageObjectUpsertBulk {
+	socb.conflict = opts
+	return &StorageObjectUpsertBulk{
+		create: socb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (socb *StorageObjectCreateBulk) OnConflictColumns(columns ...string) *StorageObjectUpsertBulk {
+	socb.conflict = append(socb.conflict, sql.ConflictColumns(columns...))
+	return &StorageObjectUpsertBulk{
+		create: socb,
+	}
+}
+
+// StorageObjectUpsertBulk is the builder for "upsert"-ing
+// a bulk of StorageObject nodes.
+type StorageObjectUpsertBulk struct {
+	create *StorageObjectCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(storageobject.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *StorageObjectUpsertBulk) UpdateNewValues() *StorageObjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(storageobject.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(storageobject.FieldCreateTime)
+			}
+			if _, exists := b.mutation.Key(); exists {
+				s.SetIgnore(storageobject.FieldKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.StorageObject.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *StorageObjectUpsertBulk) Ignore() *StorageObjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *StorageObjectUpsertBulk) DoNothing() *StorageObjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the StorageObjectCreateBulk.OnConflict
+// documentation for more info.
+func (u *StorageObjectUpsertBulk) Update(set func(*StorageObjectUpsert)) *StorageObjectUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&StorageObjectUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *StorageObjectUpsertBulk) SetUpdateTime(v time.Time) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateUpdateTime() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetData sets the "data" field.
+func (u *StorageObjectUpsertBulk) SetData(v []byte) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetData(v)
+	})
+}
+
+// UpdateData sets the "data" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateData() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateData()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *StorageObjectUpsertBulk) SetSize(v int64) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *StorageObjectUpsertBulk) AddSize(v int64) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateSize() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *StorageObjectUpsertBulk) SetChecksum(v string) *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *StorageObjectUpsertBulk) UpdateChecksum() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (u *StorageObjectUpsertBulk) ClearChecksum() *StorageObjectUpsertBulk {
+	return u.Update(func(s *StorageObjectUpsert) {
+		s.ClearChecksum()
+	})
+}
+
+// Exec executes the query.
+func (u *StorageObjectUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the StorageObjectCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for StorageObjectCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *StorageObjectUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
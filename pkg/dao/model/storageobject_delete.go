@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
+)
+
+// StorageObjectDelete is the builder for deleting a StorageObject entity.
+type StorageObjectDelete struct {
+	config
+	hooks    []Hook
+	mutation *StorageObjectMutation
+}
+
+// Where appends a list predicates to the StorageObjectDelete builder.
+func (sod *StorageObjectDelete) Where(ps ...predicate.StorageObject) *StorageObjectDelete {
+	sod.mutation.Where(ps...)
+	return sod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sod *StorageObjectDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sod.sqlExec, sod.mutation, sod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sod *StorageObjectDelete) ExecX(ctx context.Context) int {
+	n, err := sod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sod *StorageObjectDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(storageobject.Table, sqlgraph.NewFieldSpec(storageobject.FieldID, field.TypeString))
+	_spec.Node.Schema = sod.schemaConfig.StorageObject
+	ctx = internal.NewSchemaConfigContext(ctx, sod.schemaConfig)
+	if ps := sod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sod.mutation.done = true
+	return affected, err
+}
+
+// StorageObjectDeleteOne is the builder for deleting a single StorageObject entity.
+type StorageObjectDeleteOne struct {
+	sod *StorageObjectDelete
+}
+
+// Where appends a list predicates to the StorageObjectDelete builder.
+func (sodo *StorageObjectDeleteOne) Where(ps ...predicate.StorageObject) *StorageObjectDeleteOne {
+	sodo.sod.mutation.Where(ps...)
+	return sodo
+}
+
+// Exec executes the deletion query.
+func (sodo *StorageObjectDeleteOne) Exec(ctx context.Context) error {
+	n, err := sodo.sod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{storageobject.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sodo *StorageObjectDeleteOne) ExecX(ctx context.Context) {
+	if err := sodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,598 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// StorageObjectQuery is the builder for querying StorageObject entities.
+type StorageObjectQuery struct {
+	config
+	ctx        *QueryContext
+	order      []storageobject.OrderOption
+	inters     []Interceptor
+	predicates []predicate.StorageObject
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the StorageObjectQuery builder.
+func (soq *StorageObjectQuery) Where(ps ...predicate.StorageObject) *StorageObjectQuery {
+	soq.predicates = append(soq.predicates, ps...)
+	return soq
+}
+
+// Limit the number of records to be returned by this query.
+func (soq *StorageObjectQuery) Limit(limit int) *StorageObjectQuery {
+	soq.ctx.Limit = &limit
+	return soq
+}
+
+// Offset to start from.
+func (soq *StorageObjectQuery) Offset(offset int) *StorageObjectQuery {
+	soq.ctx.Offset = &offset
+	return soq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (soq *StorageObjectQuery) Unique(unique bool) *StorageObjectQuery {
+	soq.ctx.Unique = &unique
+	return soq
+}
+
+// Order specifies how the records should be ordered.
+func (soq *StorageObjectQuery) Order(o ...storageobject.OrderOption) *StorageObjectQuery {
+	soq.order = append(soq.order, o...)
+	return soq
+}
+
+// First returns the first StorageObject entity from the query.
+// Returns a *NotFoundError when no StorageObject was found.
+func (soq *StorageObjectQuery) First(ctx context.Context) (*StorageObject, error) {
+	nodes, err := soq.Limit(1).All(setContextOp(ctx, soq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{storageobject.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (soq *StorageObjectQuery) FirstX(ctx context.Context) *StorageObject {
+	node, err := soq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first StorageObject ID from the query.
+// Returns a *NotFoundError when no StorageObject ID was found.
+func (soq *StorageObjectQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = soq.Limit(1).IDs(setContextOp(ctx, soq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{storageobject.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (soq *StorageObjectQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := soq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single StorageObject entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one StorageObject entity is found.
+// Returns a *NotFoundError when no StorageObject entities are found.
+func (soq *StorageObjectQuery) Only(ctx context.Context) (*StorageObject, error) {
+	nodes, err := soq.Limit(2).All(setContextOp(ctx, soq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{storageobject.Label}
+	default:
+		return nil, &NotSingularError{storageobject.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (soq *StorageObjectQuery) OnlyX(ctx context.Context) *StorageObject {
+	node, err := soq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only StorageObject ID in the query.
+// Returns a *NotSingularError when more than one StorageObject ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (soq *StorageObjectQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = soq.Limit(2).IDs(setContextOp(ctx, soq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{storageobject.Label}
+	default:
+		err = &NotSingularError{storageobject.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (soq *StorageObjectQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := soq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of StorageObjects.
+func (soq *StorageObjectQuery) All(ctx context.Context) ([]*StorageObject, error) {
+	ctx = setContextOp(ctx, soq.ctx, "All")
+	if err := soq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*StorageObject, *StorageObjectQuery]()
+	return withInterceptors[[]*StorageObject](ctx, soq, qr, soq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (soq *StorageObjectQuery) AllX(ctx context.Context) []*StorageObject {
+	nodes, err := soq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of StorageObject IDs.
+func (soq *StorageObjectQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if soq.ctx.Unique == nil && soq.path != nil {
+		soq.Unique(true)
+	}
+	ctx = setContextOp(ctx, soq.ctx, "IDs")
+	if err = soq.Select(storageobject.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (soq *StorageObjectQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := soq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (soq *StorageObjectQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, soq.ctx, "Count")
+	if err := soq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, soq, querierCount[*StorageObjectQuery](), soq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (soq *StorageObjectQuery) CountX(ctx context.Context) int {
+	count, err := soq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (soq *StorageObjectQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, soq.ctx, "Exist")
+	switch _, err := soq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (soq *StorageObjectQuery) ExistX(ctx context.Context) bool {
+	exist, err := soq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the StorageObjectQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (soq *StorageObjectQuery) Clone() *StorageObjectQuery {
+	if soq == nil {
+		return nil
+	}
+	return &StorageObjectQuery{
+		config:     soq.config,
+		ctx:        soq.ctx.Clone(),
+		order:      append([]storageobject.OrderOption{}, soq.order...),
+		inters:     append([]Interceptor{}, soq.inters...),
+		predicates: append([]predicate.StorageObject{}, soq.predicates...),
+		// clone intermediate query.
+		sql:  soq.sql.Clone(),
+		path: soq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.StorageObject.Query().
+//		GroupBy(storageobject.FieldCreateTime).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (soq *StorageObjectQuery) GroupBy(field string, fields ...string) *StorageObjectGroupBy {
+	soq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &StorageObjectGroupBy{build: soq}
+	grbuild.flds = &soq.ctx.Fields
+	grbuild.label = storageobject.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.StorageObject.Query().
+//		Select(storageobject.FieldCreateTime).
+//		Scan(ctx, &v)
+func (soq *StorageObjectQuery) Select(fields ...string) *StorageObjectSelect {
+	soq.ctx.Fields = append(soq.ctx.Fields, fields...)
+	sbuild := &StorageObjectSelect{StorageObjectQuery: soq}
+	sbuild.label = storageobject.Label
+	sbuild.flds, sbuild.scan = &soq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a StorageObjectSelect configured with the given aggregations.
+func (soq *StorageObjectQuery) Aggregate(fns ...AggregateFunc) *StorageObjectSelect {
+	return soq.Select().Aggregate(fns...)
+}
+
+func (soq *StorageObjectQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range soq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, soq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range soq.ctx.Fields {
+		if !storageobject.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if soq.path != nil {
+		prev, err := soq.path(ctx)
+		if err != nil {
+			return err
+		}
+		soq.sql = prev
+	}
+	return nil
+}
+
+func (soq *StorageObjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*StorageObject, error) {
+	var (
+		nodes = []*StorageObject{}
+		_spec = soq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*StorageObject).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &StorageObject{config: soq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = soq.schemaConfig.StorageObject
+	ctx = internal.NewSchemaConfigContext(ctx, soq.schemaConfig)
+	if len(soq.modifiers) > 0 {
+		_spec.Modifiers = soq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, soq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (soq *StorageObjectQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := soq.querySpec()
+	_spec.Node.Schema = soq.schemaConfig.StorageObject
+	ctx = internal.NewSchemaConfigContext(ctx, soq.schemaConfig)
+	if len(soq.modifiers) > 0 {
+		_spec.Modifiers = soq.modifiers
+	}
+	_spec.Node.Columns = soq.ctx.Fields
+	if len(soq.ctx.Fields) > 0 {
+		_spec.Unique = soq.ctx.Unique != nil && *soq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, soq.driver, _spec)
+}
+
+func (soq *StorageObjectQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(storageobject.Table, storageobject.Columns, sqlgraph.NewFieldSpec(storageobject.FieldID, field.TypeString))
+	_spec.From = soq.sql
+	if unique := soq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if soq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := soq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storageobject.FieldID)
+		for i := range fields {
+			if fields[i] != storageobject.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := soq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := soq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := soq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := soq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (soq *StorageObjectQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(soq.driver.Dialect())
+	t1 := builder.Table(storageobject.Table)
+	columns := soq.ctx.Fields
+	if len(columns) == 0 {
+		columns = storageobject.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if soq.sql != nil {
+		selector = soq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if soq.ctx.Unique != nil && *soq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(soq.schemaConfig.StorageObject)
+	ctx = internal.NewSchemaConfigContext(ctx, soq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range soq.modifiers {
+		m(selector)
+	}
+	for _, p := range soq.predicates {
+		p(selector)
+	}
+	for _, p := range soq.order {
+		p(selector)
+	}
+	if offset := soq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := soq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (soq *StorageObjectQuery) ForUpdate(opts ...sql.LockOption) *StorageObjectQuery {
+	if soq.driver.Dialect() == dialect.Postgres {
+		soq.Unique(false)
+	}
+	soq.modifiers = append(soq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return soq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (soq *StorageObjectQuery) ForShare(opts ...sql.LockOption) *StorageObjectQuery {
+	if soq.driver.Dialect() == dialect.Postgres {
+		soq.Unique(false)
+	}
+	soq.modifiers = append(soq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return soq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (soq *StorageObjectQuery) Modify(modifiers ...func(s *sql.Selector)) *StorageObjectSelect {
+	soq.modifiers = append(soq.modifiers, modifiers...)
+	return soq.Select()
+}
+
+// WhereP appends storage-level predicates to the StorageObjectQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (soq *StorageObjectQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.StorageObject, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.StorageObject(ps[i]))
+	}
+	soq.predicates = append(soq.predicates, wps...)
+}
+
+// StorageObjectGroupBy is the group-by builder for StorageObject entities.
+type StorageObjectGroupBy struct {
+	selector
+	build *StorageObjectQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sogb *StorageObjectGroupBy) Aggregate(fns ...AggregateFunc) *StorageObjectGroupBy {
+	sogb.fns = append(sogb.fns, fns...)
+	return sogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sogb *StorageObjectGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sogb.build.ctx, "GroupBy")
+	if err := sogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StorageObjectQuery, *StorageObjectGroupBy](ctx, sogb.build, sogb, sogb.build.inters, v)
+}
+
+func (sogb *StorageObjectGroupBy) sqlScan(ctx context.Context, root *StorageObjectQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sogb.fns))
+	for _, fn := range sogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sogb.flds)+len(sogb.fns))
+		for _, f := range *sogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// StorageObjectSelect is the builder for selecting fields of StorageObject entities.
+type StorageObjectSelect struct {
+	*StorageObjectQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sos *StorageObjectSelect) Aggregate(fns ...AggregateFunc) *StorageObjectSelect {
+	sos.fns = append(sos.fns, fns...)
+	return sos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sos *StorageObjectSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sos.ctx, "Select")
+	if err := sos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*StorageObjectQuery, *StorageObjectSelect](ctx, sos.StorageObjectQuery, sos, sos.inters, v)
+}
+
+func (sos *StorageObjectSelect) sqlScan(ctx context.Context, root *StorageObjectQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sos.fns))
+	for _, fn := range sos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sos *StorageObjectSelect) Modify(modifiers ...func(s *sql.Selector)) *StorageObjectSelect {
+	sos.modifiers = append(sos.modifiers, modifiers...)
+	return sos
+}
@@ -0,0 +1,576 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
+)
+
+// StorageObjectUpdate is the builder for updating StorageObject entities.
+type StorageObjectUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *StorageObjectMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *StorageObject
+}
+
+// Where appends a list predicates to the StorageObjectUpdate builder.
+func (sou *StorageObjectUpdate) Where(ps ...predicate.StorageObject) *StorageObjectUpdate {
+	sou.mutation.Where(ps...)
+	return sou
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (sou *StorageObjectUpdate) SetUpdateTime(t time.Time) *StorageObjectUpdate {
+	sou.mutation.SetUpdateTime(t)
+	return sou
+}
+
+// SetData sets the "data" field.
+func (sou *StorageObjectUpdate) SetData(b []byte) *StorageObjectUpdate {
+	sou.mutation.SetData(b)
+	return sou
+}
+
+// SetSize sets the "size" field.
+func (sou *StorageObjectUpdate) SetSize(i int64) *StorageObjectUpdate {
+	sou.mutation.ResetSize()
+	sou.mutation.SetSize(i)
+	return sou
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (sou *StorageObjectUpdate) SetNillableSize(i *int64) *StorageObjectUpdate {
+	if i != nil {
+		sou.SetSize(*i)
+	}
+	return sou
+}
+
+// AddSize adds i to the "size" field.
+func (sou *StorageObjectUpdate) AddSize(i int64) *StorageObjectUpdate {
+	sou.mutation.AddSize(i)
+	return sou
+}
+
+// SetChecksum sets the "checksum" field.
+func (sou *StorageObjectUpdate) SetChecksum(s string) *StorageObjectUpdate {
+	sou.mutation.SetChecksum(s)
+	return sou
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (sou *StorageObjectUpdate) SetNillableChecksum(s *string) *StorageObjectUpdate {
+	if s != nil {
+		sou.SetChecksum(*s)
+	}
+	return sou
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (sou *StorageObjectUpdate) ClearChecksum() *StorageObjectUpdate {
+	sou.mutation.ClearChecksum()
+	return sou
+}
+
+// Mutation returns the StorageObjectMutation object of the builder.
+func (sou *StorageObjectUpdate) Mutation() *StorageObjectMutation {
+	return sou.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sou *StorageObjectUpdate) Save(ctx context.Context) (int, error) {
+	if err := sou.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, sou.sqlSave, sou.mutation, sou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sou *StorageObjectUpdate) SaveX(ctx context.Context) int {
+	affected, err := sou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sou *StorageObjectUpdate) Exec(ctx context.Context) error {
+	_, err := sou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sou *StorageObjectUpdate) ExecX(ctx context.Context) {
+	if err := sou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sou *StorageObjectUpdate) defaults() error {
+	if _, ok := sou.mutation.UpdateTime(); !ok {
+		if storageobject.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized storageobject.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := storageobject.UpdateDefaultUpdateTime()
+		sou.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (sou *StorageObjectUpdate) Set(obj *StorageObject) *StorageObjectUpdate {
+	// Without Default.
+	sou.SetData(obj.Data)
+	sou.SetSize(obj.Size)
+	if obj.Checksum != "" {
+		sou.SetChecksum(obj.Checksum)
+	}
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		sou.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	sou.object = obj
+
+	return sou
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sou *StorageObjectUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StorageObjectUpdate {
+	sou.modifiers = append(sou.modifiers, modifiers...)
+	return sou
+}
+
+func (sou *StorageObjectUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(storageobject.Table, storageobject.Columns, sqlgraph.NewFieldSpec(storageobject.FieldID, field.TypeString))
+	if ps := sou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sou.mutation.UpdateTime(); ok {
+		_spec.SetField(storageobject.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := sou.mutation.Data(); ok {
+		_spec.SetField(storageobject.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := sou.mutation.Size(); ok {
+		_spec.SetField(storageobject.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := sou.mutation.AddedSize(); ok {
+		_spec.AddField(storageobject.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := sou.mutation.Checksum(); ok {
+		_spec.SetField(storageobject.FieldChecksum, field.TypeString, value)
+	}
+	if sou.mutation.ChecksumCleared() {
+		_spec.ClearField(storageobject.FieldChecksum, field.TypeString)
+	}
+	_spec.Node.Schema = sou.schemaConfig.StorageObject
+	ctx = internal.NewSchemaConfigContext(ctx, sou.schemaConfig)
+	_spec.AddModifiers(sou.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storageobject.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sou.mutation.done = true
+	return n, nil
+}
+
+// StorageObjectUpdateOne is the builder for updating a single StorageObject entity.
+type StorageObjectUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *StorageObjectMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *StorageObject
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (souo *StorageObjectUpdateOne) SetUpdateTime(t time.Time) *StorageObjectUpdateOne {
+	souo.mutation.SetUpdateTime(t)
+	return souo
+}
+
+// SetData sets the "data" field.
+func (souo *StorageObjectUpdateOne) SetData(b []byte) *StorageObjectUpdateOne {
+	souo.mutation.SetData(b)
+	return souo
+}
+
+// SetSize sets the "size" field.
+func (souo *StorageObjectUpdateOne) SetSize(i int64) *StorageObjectUpdateOne {
+	souo.mutation.ResetSize()
+	souo.mutation.SetSize(i)
+	return souo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (souo *StorageObjectUpdateOne) SetNillableSize(i *int64) *StorageObjectUpdateOne {
+	if i != nil {
+		souo.SetSize(*i)
+	}
+	return souo
+}
+
+// AddSize adds i to the "size" field.
+func (souo *StorageObjectUpdateOne) AddSize(i int64) *StorageObjectUpdateOne {
+	souo.mutation.AddSize(i)
+	return souo
+}
+
+// SetChecksum sets the "checksum" field.
+func (souo *StorageObjectUpdateOne) SetChecksum(s string) *StorageObjectUpdateOne {
+	souo.mutation.SetChecksum(s)
+	return souo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (souo *StorageObjectUpdateOne) SetNillableChecksum(s *string) *StorageObjectUpdateOne {
+	if s != nil {
+		souo.SetChecksum(*s)
+	}
+	return souo
+}
+
+// ClearChecksum clears the value of the "checksum" field.
+func (souo *StorageObjectUpdateOne) ClearChecksum() *StorageObjectUpdateOne {
+	souo.mutation.ClearChecksum()
+	return souo
+}
+
+// Mutation returns the StorageObjectMutation object of the builder.
+func (souo *StorageObjectUpdateOne) Mutation() *StorageObjectMutation {
+	return souo.mutation
+}
+
+// Where appends a list predicates to the StorageObjectUpdate builder.
+func (souo *StorageObjectUpdateOne) Where(ps ...predicate.StorageObject) *StorageObjectUpdateOne {
+	souo.mutation.Where(ps...)
+	return souo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (souo *StorageObjectUpdateOne) Select(field string, fields ...string) *StorageObjectUpdateOne {
+	souo.fields = append([]string{field}, fields...)
+	return souo
+}
+
+// Save executes the query and returns the updated StorageObject entity.
+func (souo *StorageObjectUpdateOne) Save(ctx context.Context) (*StorageObject, error) {
+	if err := souo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, souo.sqlSave, souo.mutation, souo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (souo *StorageObjectUpdateOne) SaveX(ctx context.Context) *StorageObject {
+	node, err := souo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (souo *StorageObjectUpdateOne) Exec(ctx context.Context) error {
+	_, err := souo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (souo *StorageObjectUpdateOne) ExecX(ctx context.Context) {
+	if err := souo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (souo *StorageObjectUpdateOne) defaults() error {
+	if _, ok := souo.mutation.UpdateTime(); !ok {
+		if storageobject.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized storageobject.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := storageobject.UpdateDefaultUpdateTime()
+		souo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (souo *StorageObjectUpdateOne) Set(obj *StorageObject) *StorageObjectUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*StorageObjectMutation)
+			db, err := mt.Client().StorageObject.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting StorageObject with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if !bytes.Equal(db.Data, obj.Data) {
+				souo.SetData(obj.Data)
+			}
+			if db.Size != obj.Size {
+				souo.SetSize(obj.Size)
+			}
+			if obj.Checksum != "" {
+				if db.Checksum != obj.Checksum {
+					souo.SetChecksum(obj.Checksum)
+				}
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				souo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			souo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	souo.hooks = append(souo.hooks, h)
+
+	return souo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (souo *StorageObjectUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := souo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: souo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: souo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the StorageObject entity,
+// which is always good for cascading update operations.
+func (souo *StorageObjectUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *StorageObject) error) (*StorageObject, error) {
+	obj, err := souo.Save(ctx)
+	if err != nil &&
+		(souo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := souo.getClientSet()
+
+	if obj == nil {
+		obj = souo.object
+	} else if x := souo.object; x != nil {
+		if _, set := souo.mutation.Field(storageobject.FieldData); set {
+			obj.Data = x.Data
+		}
+		if _, set := souo.mutation.Field(storageobject.FieldSize); set {
+			obj.Size = x.Size
+		}
+		if _, set := souo.mutation.Field(storageobject.FieldChecksum); set {
+			obj.Checksum = x.Checksum
+		}
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (souo *StorageObjectUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *StorageObject) error) *StorageObject {
+	obj, err := souo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (souo *StorageObjectUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *StorageObject) error) error {
+	_, err := souo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (souo *StorageObjectUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *StorageObject) error) {
+	if err := souo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (souo *StorageObjectUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *StorageObjectUpdateOne {
+	souo.modifiers = append(souo.modifiers, modifiers...)
+	return souo
+}
+
+func (souo *StorageObjectUpdateOne) sqlSave(ctx context.Context) (_node *StorageObject, err error) {
+	_spec := sqlgraph.NewUpdateSpec(storageobject.Table, storageobject.Columns, sqlgraph.NewFieldSpec(storageobject.FieldID, field.TypeString))
+	id, ok := souo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "StorageObject.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := souo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, storageobject.FieldID)
+		for _, f := range fields {
+			if !storageobject.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != storageobject.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := souo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := souo.mutation.UpdateTime(); ok {
+		_spec.SetField(storageobject.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := souo.mutation.Data(); ok {
+		_spec.SetField(storageobject.FieldData, field.TypeBytes, value)
+	}
+	if value, ok := souo.mutation.Size(); ok {
+		_spec.SetField(storageobject.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := souo.mutation.AddedSize(); ok {
+		_spec.AddField(storageobject.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := souo.mutation.Checksum(); ok {
+		_spec.SetField(storageobject.FieldChecksum, field.TypeString, value)
+	}
+	if souo.mutation.ChecksumCleared() {
+		_spec.ClearField(storageobject.FieldChecksum, field.TypeString)
+	}
+	_spec.Node.Schema = souo.schemaConfig.StorageObject
+	ctx = internal.NewSchemaConfigContext(ctx, souo.schemaConfig)
+	_spec.AddModifiers(souo.modifiers...)
+	_node = &StorageObject{config: souo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, souo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{storageobject.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	souo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,791 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// StorageObjectCreateInput holds the creation input of the StorageObject entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type StorageObjectCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Model returns the StorageObject entity for creating,
+// after validating.
+func (soci *StorageObjectCreateInput) Model() *StorageObject {
+	if soci == nil {
+		return nil
+	}
+
+	_so := &StorageObject{}
+
+	return _so
+}
+
+// Validate checks the StorageObjectCreateInput entity.
+func (soci *StorageObjectCreateInput) Validate() error {
+	if soci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return soci.ValidateWith(soci.inputConfig.Context, soci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the StorageObjectCreateInput entity with the given context and client set.
+func (soci *StorageObjectCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if soci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// StorageObjectCreateInputs holds the creation input item of the StorageObject entities.
+type StorageObjectCreateInputsItem struct {
+}
+
+// ValidateWith checks the StorageObjectCreateInputsItem entity with the given context and client set.
+func (soci *StorageObjectCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if soci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// StorageObjectCreateInputs holds the creation input of the StorageObject entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type StorageObjectCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*StorageObjectCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the StorageObject entities for creating,
+// after validating.
+func (soci *StorageObjectCreateInputs) Model() []*StorageObject {
+	if soci == nil || len(soci.Items) == 0 {
+		return nil
+	}
+
+	_sos := make([]*StorageObject, len(soci.Items))
+
+	for i := range soci.Items {
+		_so := &StorageObject{}
+
+		_sos[i] = _so
+	}
+
+	return _sos
+}
+
+// Validate checks the StorageObjectCreateInputs entity .
+func (soci *StorageObjectCreateInputs) Validate() error {
+	if soci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return soci.ValidateWith(soci.inputConfig.Context, soci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the StorageObjectCreateInputs entity with the given context and client set.
+func (soci *StorageObjectCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if soci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(soci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	for i := range soci.Items {
+		if soci.Items[i] == nil {
+			continue
+		}
+
+		if err := soci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StorageObjectDeleteInput holds the deletion input of the StorageObject entity,
+// please tags with `path:",inline"` if embedding.
+type StorageObjectDeleteInput struct {
+	StorageObjectQueryInput `path:",inline"`
+}
+
+// StorageObjectDeleteInputs holds the deletion input item of the StorageObject entities.
+type StorageObjectDeleteInputsItem struct {
+	// ID of the StorageObject entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Key of the StorageObject entity, a part of the unique index.
+	Key string `path:"-" query:"-" json:"key,omitempty"`
+}
+
+// StorageObjectDeleteInputs holds the deletion input of the StorageObject entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type StorageObjectDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*StorageObjectDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the StorageObject entities for deleting,
+// after validating.
+func (sodi *StorageObjectDeleteInputs) Model() []*StorageObject {
+	if sodi == nil || len(sodi.Items) == 0 {
+		return nil
+	}
+
+	_sos := make([]*StorageObject, len(sodi.Items))
+	for i := range sodi.Items {
+		_sos[i] = &StorageObject{
+			ID: sodi.Items[i].ID,
+		}
+	}
+	return _sos
+}
+
+// IDs returns the ID list of the StorageObject entities for deleting,
+// after validating.
+func (sodi *StorageObjectDeleteInputs) IDs() []object.ID {
+	if sodi == nil || len(sodi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(sodi.Items))
+	for i := range sodi.Items {
+		ids[i] = sodi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the StorageObjectDeleteInputs entity.
+func (sodi *StorageObjectDeleteInputs) Validate() error {
+	if sodi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return sodi.ValidateWith(sodi.inputConfig.Context, sodi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the StorageObjectDeleteInputs entity with the given context and client set.
+func (sodi *StorageObjectDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if sodi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(sodi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.StorageObjects().Query()
+
+	ids := make([]object.ID, 0, len(sodi.Items))
+	ors := make([]predicate.StorageObject, 0, len(sodi.Items))
+	indexers := make(map[any][]int)
+
+	for i := range sodi.Items {
+		if sodi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if sodi.Items[i].ID != "" {
+			ids = append(ids, sodi.Items[i].ID)
+			ors = append(ors, storageobject.ID(sodi.Items[i].ID))
+			indexers[sodi.Items[i].ID] = append(indexers[sodi.Items[i].ID], i)
+		} else if sodi.Items[i].Key != "" {
+			ors = append(ors, storageobject.And(
+				storageobject.Key(sodi.Items[i].Key)))
+			indexerKey := fmt.Sprint("/", sodi.Items[i].Key)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := storageobject.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = storageobject.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			storageobject.FieldID,
+			storageobject.FieldKey,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Key)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			sodi.Items[j].ID = es[i].ID
+			sodi.Items[j].Key = es[i].Key
+		}
+	}
+
+	return nil
+}
+
+// StorageObjectPatchInput holds the patch input of the StorageObject entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type StorageObjectPatchInput struct {
+	StorageObjectQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// Key of the object, i.e. walrus/project/xxx/environment/xxx/resource/xxx.zip.
+	Key string `path:"-" query:"-" json:"key,omitempty"`
+	// Data of the object.
+	Data []byte `path:"-" query:"-" json:"data,omitempty" sensitive:"true"`
+	// Size of the data in bytes.
+	Size int64 `path:"-" query:"-" json:"size,omitempty"`
+	// Checksum of the data, i.e. sha256:xxx.
+	Checksum string `path:"-" query:"-" json:"checksum,omitempty"`
+
+	patchedEntity *StorageObject `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the StorageObject partition entity for patching.
+func (sopi *StorageObjectPatchInput) PatchModel() *StorageObject {
+	if sopi == nil {
+		return nil
+	}
+
+	_so := &StorageObject{
+		CreateTime: sopi.CreateTime,
+		UpdateTime: sopi.UpdateTime,
+		Key:        sopi.Key,
+		Data:       sopi.Data,
+		Size:       sopi.Size,
+		Checksum:   sopi.Checksum,
+	}
+
+	return _so
+}
+
+// Model returns the StorageObject patched entity,
+// after validating.
+func (sopi *StorageObjectPatchInput) Model() *StorageObject {
+	if sopi == nil {
+		return nil
+	}
+
+	return sopi.patchedEntity
+}
+
+// Validate checks the StorageObjectPatchInput entity.
+func (sopi *StorageObjectPatchInput) Validate() error {
+	if sopi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return sopi.ValidateWith(sopi.inputConfig.Context, sopi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the StorageObjectPatchInput entity with the given context and client set.
+func (sopi *StorageObjectPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := sopi.StorageObjectQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.StorageObjects().Query()
+
+	if sopi.Refer != nil {
+		if sopi.Refer.IsID() {
+			q.Where(
+				storageobject.ID(sopi.Refer.ID()))
+		} else if refers := sopi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				storageobject.Key(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of storageobject")
+		}
+	} else if sopi.ID != "" {
+		q.Where(
+			storageobject.ID(sopi.ID))
+	} else if sopi.Key != "" {
+		q.Where(
+			storageobject.Key(sopi.Key))
+	} else {
+		return errors.New("invalid identify of storageobject")
+	}
+
+	q.Select(
+		storageobject.WithoutFields(
+			storageobject.FieldCreateTime,
+			storageobject.FieldUpdateTime,
+			storageobject.FieldKey,
+			storageobject.FieldData,
+			storageobject.FieldSize,
+			storageobject.FieldChecksum,
+		)...,
+	)
+
+	var e *StorageObject
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*StorageObject)
+		}
+	}
+
+	_pm := sopi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*StorageObject)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.Key != _obj.Key {
+		return errors.New("field key is immutable")
+	}
+
+	sopi.patchedEntity = _obj
+	return nil
+}
+
+// StorageObjectQueryInput holds the query input of the StorageObject entity,
+// please tags with `path:",inline"` if embedding.
+type StorageObjectQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Refer holds the route path reference of the StorageObject entity.
+	Refer *object.Refer `path:"storageobject,default=" query:"-" json:"-"`
+	// ID of the StorageObject entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Key of the StorageObject entity, a part of the unique index.
+	Key string `path:"-" query:"-" json:"key,omitempty"`
+}
+
+// Model returns the StorageObject entity for querying,
+// after validating.
+func (soqi *StorageObjectQueryInput) Model() *StorageObject {
+	if soqi == nil {
+		return nil
+	}
+
+	return &StorageObject{
+		ID:  soqi.ID,
+		Key: soqi.Key,
+	}
+}
+
+// Validate checks the StorageObjectQueryInput entity.
+func (soqi *StorageObjectQueryInput) Validate() error {
+	if soqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return soqi.ValidateWith(soqi.inputConfig.Context, soqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the StorageObjectQueryInput entity with the given context and client set.
+func (soqi *StorageObjectQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if soqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if soqi.Refer != nil && *soqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", storageobject.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.StorageObjects().Query()
+
+	if soqi.Refer != nil {
+		if soqi.Refer.IsID() {
+			q.Where(
+				storageobject.ID(soqi.Refer.ID()))
+		} else if refers := soqi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				storageobject.Key(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of storageobject")
+		}
+	} else if soqi.ID != "" {
+		q.Where(
+			storageobject.ID(soqi.ID))
+	} else if soqi.Key != "" {
+		q.Where(
+			storageobject.Key(soqi.Key))
+	} else {
+		return errors.New("invalid identify of storageobject")
+	}
+
+	q.Select(
+		storageobject.FieldID,
+		storageobject.FieldKey,
+	)
+
+	var e *StorageObject
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*StorageObject)
+		}
+	}
+
+	soqi.ID = e.ID
+	soqi.Key = e.Key
+	return nil
+}
+
+// StorageObjectQueryInputs holds the query input of the StorageObject entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type StorageObjectQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Validate checks the StorageObjectQueryInputs entity.
+func (soqi *StorageObjectQueryInputs) Validate() error {
+	if soqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return soqi.ValidateWith(soqi.inputConfig.Context, soqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the StorageObjectQueryInputs entity with the given context and client set.
+func (soqi *StorageObjectQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if soqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// StorageObjectUpdateInput holds the modification input of the StorageObject entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type StorageObjectUpdateInput struct {
+	StorageObjectQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Model returns the StorageObject entity for modifying,
+// after validating.
+func (soui *StorageObjectUpdateInput) Model() *StorageObject {
+	if soui == nil {
+		return nil
+	}
+
+	_so := &StorageObject{
+		ID:  soui.ID,
+		Key: soui.Key,
+	}
+
+	return _so
+}
+
+// Validate checks the StorageObjectUpdateInput entity.
+func (soui *StorageObjectUpdateInput) Validate() error {
+	if soui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return soui.ValidateWith(soui.inputConfig.Context, soui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the StorageObjectUpdateInput entity with the given context and client set.
+func (soui *StorageObjectUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := soui.StorageObjectQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StorageObjectUpdateInputs holds the modification input item of the StorageObject entities.
+type StorageObjectUpdateInputsItem struct {
+	// ID of the StorageObject entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Key of the StorageObject entity, a part of the unique index.
+	Key string `path:"-" query:"-" json:"key,omitempty"`
+}
+
+// ValidateWith checks the StorageObjectUpdateInputsItem entity with the given context and client set.
+func (soui *StorageObjectUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if soui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// StorageObjectUpdateInputs holds the modification input of the StorageObject entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type StorageObjectUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*StorageObjectUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the StorageObject entities for modifying,
+// after validating.
+func (soui *StorageObjectUpdateInputs) Model() []*StorageObject {
+	if soui == nil || len(soui.Items) == 0 {
+		return nil
+	}
+
+	_sos := make([]*StorageObject, len(soui.Items))
+
+	for i := range soui.Items {
+		_so := &StorageObject{
+			ID:  soui.Items[i].ID,
+			Key: soui.Items[i].Key,
+		}
+
+		_sos[i] = _so
+	}
+
+	return _sos
+}
+
+// IDs returns the ID list of the StorageObject entities for modifying,
+// after validating.
+func (soui *StorageObjectUpdateInputs) IDs() []object.ID {
+	if soui == nil || len(soui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(soui.Items))
+	for i := range soui.Items {
+		ids[i] = soui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the StorageObjectUpdateInputs entity.
+func (soui *StorageObjectUpdateInputs) Validate() error {
+	if soui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return soui.ValidateWith(soui.inputConfig.Context, soui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the StorageObjectUpdateInputs entity with the given context and client set.
+func (soui *StorageObjectUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if soui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(soui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.StorageObjects().Query()
+
+	ids := make([]object.ID, 0, len(soui.Items))
+	ors := make([]predicate.StorageObject, 0, len(soui.Items))
+	indexers := make(map[any][]int)
+
+	for i := range soui.Items {
+		if soui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if soui.Items[i].ID != "" {
+			ids = append(ids, soui.Items[i].ID)
+			ors = append(ors, storageobject.ID(soui.Items[i].ID))
+			indexers[soui.Items[i].ID] = append(indexers[soui.Items[i].ID], i)
+		} else if soui.Items[i].Key != "" {
+			ors = append(ors, storageobject.And(
+				storageobject.Key(soui.Items[i].Key)))
+			indexerKey := fmt.Sprint("/", soui.Items[i].Key)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := storageobject.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = storageobject.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			storageobject.FieldID,
+			storageobject.FieldKey,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Key)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			soui.Items[j].ID = es[i].ID
+			soui.Items[j].Key = es[i].Key
+		}
+	}
+
+	for i := range soui.Items {
+		if err := soui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// StorageObjectOutput holds the output of the StorageObject entity.
+type StorageObjectOutput struct {
+	ID         object.ID  `json:"id,omitempty"`
+	CreateTime *time.Time `json:"createTime,omitempty"`
+	UpdateTime *time.Time `json:"updateTime,omitempty"`
+	Key        string     `json:"key,omitempty"`
+	Size       int64      `json:"size,omitempty"`
+	Checksum   string     `json:"checksum,omitempty"`
+}
+
+// View returns the output of StorageObject entity.
+func (_so *StorageObject) View() *StorageObjectOutput {
+	return ExposeStorageObject(_so)
+}
+
+// View returns the output of StorageObject entities.
+func (_sos StorageObjects) View() []*StorageObjectOutput {
+	return ExposeStorageObjects(_sos)
+}
+
+// ExposeStorageObject converts the StorageObject to StorageObjectOutput.
+func ExposeStorageObject(_so *StorageObject) *StorageObjectOutput {
+	if _so == nil {
+		return nil
+	}
+
+	soo := &StorageObjectOutput{
+		ID:         _so.ID,
+		CreateTime: _so.CreateTime,
+		UpdateTime: _so.UpdateTime,
+		Key:        _so.Key,
+		Size:       _so.Size,
+		Checksum:   _so.Checksum,
+	}
+
+	return soo
+}
+
+// ExposeStorageObjects converts the StorageObject slice to StorageObjectOutput pointer slice.
+func ExposeStorageObjects(_sos []*StorageObject) []*StorageObjectOutput {
+	if len(_sos) == 0 {
+		return nil
+	}
+
+	soos := make([]*StorageObjectOutput, len(_sos))
+	for i := range _sos {
+		soos[i] = ExposeStorageObject(_sos[i])
+	}
+	return soos
+}
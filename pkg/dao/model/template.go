@@ -16,6 +16,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/catalog"
 	"github.com/seal-io/walrus/pkg/dao/model/project"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/utils/json"
@@ -46,6 +47,8 @@ type Template struct {
 	CatalogID object.ID `json:"catalog_id,omitempty"`
 	// ID of the project to belong, empty means for all projects.
 	ProjectID object.ID `json:"project_id,omitempty"`
+	// Credential to access the private registry of the source, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TemplateQuery when eager-loading is set.
 	Edges        TemplateEdges `json:"edges,omitempty"`
@@ -107,6 +110,8 @@ func (*Template) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case template.FieldLabels, template.FieldStatus:
 			values[i] = new([]byte)
+		case template.FieldCredential:
+			values[i] = new(crypto.Map[string, string])
 		case template.FieldID, template.FieldCatalogID, template.FieldProjectID:
 			values[i] = new(object.ID)
 		case template.FieldName, template.FieldDescription, template.FieldIcon, template.FieldSource:
@@ -200,6 +205,12 @@ func (t *Template) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				t.ProjectID = *value
 			}
+		case template.FieldCredential:
+			if value, ok := values[i].(*crypto.Map[string, string]); !ok {
+				return fmt.Errorf("unexpected type %T for field credential", values[i])
+			} else if value != nil {
+				t.Credential = *value
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -284,6 +295,8 @@ func (t *Template) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("project_id=")
 	builder.WriteString(fmt.Sprintf("%v", t.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("credential=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
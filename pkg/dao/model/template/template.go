@@ -39,6 +39,8 @@ const (
 	FieldCatalogID = "catalog_id"
 	// FieldProjectID holds the string denoting the project_id field in the database.
 	FieldProjectID = "project_id"
+	// FieldCredential holds the string denoting the credential field in the database.
+	FieldCredential = "credential"
 	// EdgeVersions holds the string denoting the versions edge name in mutations.
 	EdgeVersions = "versions"
 	// EdgeCatalog holds the string denoting the catalog edge name in mutations.
@@ -83,6 +85,7 @@ var Columns = []string{
 	FieldSource,
 	FieldCatalogID,
 	FieldProjectID,
+	FieldCredential,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -165,6 +168,11 @@ func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
 }
 
+// ByCredential orders the results by the credential field.
+func ByCredential(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredential, opts...).ToFunc()
+}
+
 // ByVersionsCount orders the results by versions count.
 func ByVersionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/seal-io/walrus/pkg/dao/model/internal"
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
 
@@ -101,6 +102,11 @@ func ProjectID(v object.ID) predicate.Template {
 	return predicate.Template(sql.FieldEQ(FieldProjectID, v))
 }
 
+// Credential applies equality check predicate on the "credential" field. It's identical to CredentialEQ.
+func Credential(v crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldCredential, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Template {
 	return predicate.Template(sql.FieldEQ(FieldName, v))
@@ -641,6 +647,56 @@ func ProjectIDContainsFold(v object.ID) predicate.Template {
 	return predicate.Template(sql.FieldContainsFold(FieldProjectID, vc))
 }
 
+// CredentialEQ applies the EQ predicate on the "credential" field.
+func CredentialEQ(v crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldEQ(FieldCredential, v))
+}
+
+// CredentialNEQ applies the NEQ predicate on the "credential" field.
+func CredentialNEQ(v crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldNEQ(FieldCredential, v))
+}
+
+// CredentialIn applies the In predicate on the "credential" field.
+func CredentialIn(vs ...crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldIn(FieldCredential, vs...))
+}
+
+// CredentialNotIn applies the NotIn predicate on the "credential" field.
+func CredentialNotIn(vs ...crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldNotIn(FieldCredential, vs...))
+}
+
+// CredentialGT applies the GT predicate on the "credential" field.
+func CredentialGT(v crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldGT(FieldCredential, v))
+}
+
+// CredentialGTE applies the GTE predicate on the "credential" field.
+func CredentialGTE(v crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldGTE(FieldCredential, v))
+}
+
+// CredentialLT applies the LT predicate on the "credential" field.
+func CredentialLT(v crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldLT(FieldCredential, v))
+}
+
+// CredentialLTE applies the LTE predicate on the "credential" field.
+func CredentialLTE(v crypto.Map[string, string]) predicate.Template {
+	return predicate.Template(sql.FieldLTE(FieldCredential, v))
+}
+
+// CredentialIsNil applies the IsNil predicate on the "credential" field.
+func CredentialIsNil() predicate.Template {
+	return predicate.Template(sql.FieldIsNull(FieldCredential))
+}
+
+// CredentialNotNil applies the NotNil predicate on the "credential" field.
+func CredentialNotNil() predicate.Template {
+	return predicate.Template(sql.FieldNotNull(FieldCredential))
+}
+
 // HasVersions applies the HasEdge predicate on the "versions" edge.
 func HasVersions() predicate.Template {
 	return predicate.Template(func(s *sql.Selector) {
@@ -21,6 +21,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/project"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 )
@@ -151,6 +152,12 @@ func (tc *TemplateCreate) SetNillableProjectID(o *object.ID) *TemplateCreate {
 	return tc
 }
 
+// SetCredential sets the "credential" field.
+func (tc *TemplateCreate) SetCredential(c crypto.Map[string, string]) *TemplateCreate {
+	tc.mutation.SetCredential(c)
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TemplateCreate) SetID(o object.ID) *TemplateCreate {
 	tc.mutation.SetID(o)
@@ -333,6 +340,10 @@ func (tc *TemplateCreate) createSpec() (*Template, *sqlgraph.CreateSpec) {
 		_spec.SetField(template.FieldSource, field.TypeString, value)
 		_node.Source = value
 	}
+	if value, ok := tc.mutation.Credential(); ok {
+		_spec.SetField(template.FieldCredential, field.TypeOther, value)
+		_node.Credential = value
+	}
 	if nodes := tc.mutation.VersionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -437,6 +448,9 @@ func (tc *TemplateCreate) Set(obj *Template) *TemplateCreate {
 	if obj.ProjectID != "" {
 		tc.SetProjectID(obj.ProjectID)
 	}
+	if !reflect.ValueOf(obj.Credential).IsZero() {
+		tc.SetCredential(obj.Credential)
+	}
 
 	// Record the given object.
 	tc.object = obj
@@ -509,6 +523,9 @@ func (tc *TemplateCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Con
 		if _, set := tc.mutation.Field(template.FieldProjectID); set {
 			obj.ProjectID = x.ProjectID
 		}
+		if _, set := tc.mutation.Field(template.FieldCredential); set {
+			obj.Credential = x.Credential
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -647,6 +664,9 @@ func (tcb *TemplateCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx contex
 			if _, set := tcb.builders[i].mutation.Field(template.FieldProjectID); set {
 				objs[i].ProjectID = x[i].ProjectID
 			}
+			if _, set := tcb.builders[i].mutation.Field(template.FieldCredential); set {
+				objs[i].Credential = x[i].Credential
+			}
 			objs[i].Edges = x[i].Edges
 		}
 	}
@@ -857,6 +877,24 @@ func (u *TemplateUpsert) ClearIcon() *TemplateUpsert {
 	return u
 }
 
+// SetCredential sets the "credential" field.
+func (u *TemplateUpsert) SetCredential(v crypto.Map[string, string]) *TemplateUpsert {
+	u.Set(template.FieldCredential, v)
+	return u
+}
+
+// UpdateCredential sets the "credential" field to the value that was provided on create.
+func (u *TemplateUpsert) UpdateCredential() *TemplateUpsert {
+	u.SetExcluded(template.FieldCredential)
+	return u
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (u *TemplateUpsert) ClearCredential() *TemplateUpsert {
+	u.SetNull(template.FieldCredential)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1018,6 +1056,27 @@ func (u *TemplateUpsertOne) ClearIcon() *TemplateUpsertOne {
 	})
 }
 
+// SetCredential sets the "credential" field.
+func (u *TemplateUpsertOne) SetCredential(v crypto.Map[string, string]) *TemplateUpsertOne {
+	return u.Update(func(s *TemplateUpsert) {
+		s.SetCredential(v)
+	})
+}
+
+// UpdateCredential sets the "credential" field to the value that was provided on create.
+func (u *TemplateUpsertOne) UpdateCredential() *TemplateUpsertOne {
+	return u.Update(func(s *TemplateUpsert) {
+		s.UpdateCredential()
+	})
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (u *TemplateUpsertOne) ClearCredential() *TemplateUpsertOne {
+	return u.Update(func(s *TemplateUpsert) {
+		s.ClearCredential()
+	})
+}
+
 // Exec executes the query.
 func (u *TemplateUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1348,6 +1407,27 @@ func (u *TemplateUpsertBulk) ClearIcon() *TemplateUpsertBulk {
 	})
 }
 
+// SetCredential sets the "credential" field.
+func (u *TemplateUpsertBulk) SetCredential(v crypto.Map[string, string]) *TemplateUpsertBulk {
+	return u.Update(func(s *TemplateUpsert) {
+		s.SetCredential(v)
+	})
+}
+
+// UpdateCredential sets the "credential" field to the value that was provided on create.
+func (u *TemplateUpsertBulk) UpdateCredential() *TemplateUpsertBulk {
+	return u.Update(func(s *TemplateUpsert) {
+		s.UpdateCredential()
+	})
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (u *TemplateUpsertBulk) ClearCredential() *TemplateUpsertBulk {
+	return u.Update(func(s *TemplateUpsert) {
+		s.ClearCredential()
+	})
+}
+
 // Exec executes the query.
 func (u *TemplateUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
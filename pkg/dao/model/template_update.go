@@ -22,6 +22,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 )
@@ -119,6 +120,18 @@ func (tu *TemplateUpdate) ClearIcon() *TemplateUpdate {
 	return tu
 }
 
+// SetCredential sets the "credential" field.
+func (tu *TemplateUpdate) SetCredential(c crypto.Map[string, string]) *TemplateUpdate {
+	tu.mutation.SetCredential(c)
+	return tu
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (tu *TemplateUpdate) ClearCredential() *TemplateUpdate {
+	tu.mutation.ClearCredential()
+	return tu
+}
+
 // AddVersionIDs adds the "versions" edge to the TemplateVersion entity by IDs.
 func (tu *TemplateUpdate) AddVersionIDs(ids ...object.ID) *TemplateUpdate {
 	tu.mutation.AddVersionIDs(ids...)
@@ -249,6 +262,9 @@ func (tu *TemplateUpdate) Set(obj *Template) *TemplateUpdate {
 	if obj.Icon != "" {
 		tu.SetIcon(obj.Icon)
 	}
+	if !reflect.ValueOf(obj.Credential).IsZero() {
+		tu.SetCredential(obj.Credential)
+	}
 
 	// With Default.
 	if obj.UpdateTime != nil {
@@ -303,6 +319,12 @@ func (tu *TemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.IconCleared() {
 		_spec.ClearField(template.FieldIcon, field.TypeString)
 	}
+	if value, ok := tu.mutation.Credential(); ok {
+		_spec.SetField(template.FieldCredential, field.TypeOther, value)
+	}
+	if tu.mutation.CredentialCleared() {
+		_spec.ClearField(template.FieldCredential, field.TypeOther)
+	}
 	if tu.mutation.VersionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -454,6 +476,18 @@ func (tuo *TemplateUpdateOne) ClearIcon() *TemplateUpdateOne {
 	return tuo
 }
 
+// SetCredential sets the "credential" field.
+func (tuo *TemplateUpdateOne) SetCredential(c crypto.Map[string, string]) *TemplateUpdateOne {
+	tuo.mutation.SetCredential(c)
+	return tuo
+}
+
+// ClearCredential clears the value of the "credential" field.
+func (tuo *TemplateUpdateOne) ClearCredential() *TemplateUpdateOne {
+	tuo.mutation.ClearCredential()
+	return tuo
+}
+
 // AddVersionIDs adds the "versions" edge to the TemplateVersion entity by IDs.
 func (tuo *TemplateUpdateOne) AddVersionIDs(ids ...object.ID) *TemplateUpdateOne {
 	tuo.mutation.AddVersionIDs(ids...)
@@ -615,6 +649,11 @@ func (tuo *TemplateUpdateOne) Set(obj *Template) *TemplateUpdateOne {
 					tuo.SetIcon(obj.Icon)
 				}
 			}
+			if !reflect.ValueOf(obj.Credential).IsZero() {
+				if !reflect.DeepEqual(db.Credential, obj.Credential) {
+					tuo.SetCredential(obj.Credential)
+				}
+			}
 
 			// With Default.
 			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
@@ -677,6 +716,9 @@ func (tuo *TemplateUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context
 		if _, set := tuo.mutation.Field(template.FieldIcon); set {
 			obj.Icon = x.Icon
 		}
+		if _, set := tuo.mutation.Field(template.FieldCredential); set {
+			obj.Credential = x.Credential
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -771,6 +813,12 @@ func (tuo *TemplateUpdateOne) sqlSave(ctx context.Context) (_node *Template, err
 	if tuo.mutation.IconCleared() {
 		_spec.ClearField(template.FieldIcon, field.TypeString)
 	}
+	if value, ok := tuo.mutation.Credential(); ok {
+		_spec.SetField(template.FieldCredential, field.TypeOther, value)
+	}
+	if tuo.mutation.CredentialCleared() {
+		_spec.ClearField(template.FieldCredential, field.TypeOther)
+	}
 	if tuo.mutation.VersionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -15,6 +15,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
 	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/utils/json"
@@ -36,6 +37,8 @@ type TemplateCreateInput struct {
 	Description string `path:"-" query:"-" json:"description,omitempty"`
 	// Labels holds the value of the "labels" field.
 	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Credential to access the private registry of the source, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 }
 
 // Model returns the Template entity for creating,
@@ -50,6 +53,7 @@ func (tci *TemplateCreateInput) Model() *Template {
 		Name:        tci.Name,
 		Description: tci.Description,
 		Labels:      tci.Labels,
+		Credential:  tci.Credential,
 	}
 
 	if tci.Project != nil {
@@ -102,6 +106,8 @@ type TemplateCreateInputsItem struct {
 	Description string `path:"-" query:"-" json:"description,omitempty"`
 	// Labels holds the value of the "labels" field.
 	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Credential to access the private registry of the source, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 }
 
 // ValidateWith checks the TemplateCreateInputsItem entity with the given context and client set.
@@ -144,6 +150,7 @@ func (tci *TemplateCreateInputs) Model() []*Template {
 			Name:        tci.Items[i].Name,
 			Description: tci.Items[i].Description,
 			Labels:      tci.Items[i].Labels,
+			Credential:  tci.Items[i].Credential,
 		}
 
 		if tci.Project != nil {
@@ -383,6 +390,8 @@ type TemplatePatchInput struct {
 	Icon string `path:"-" query:"-" json:"icon,omitempty"`
 	// Source of the template.
 	Source string `path:"-" query:"-" json:"source,omitempty"`
+	// Credential to access the private registry of the source, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 
 	patchedEntity *Template `path:"-" query:"-" json:"-"`
 }
@@ -402,6 +411,7 @@ func (tpi *TemplatePatchInput) PatchModel() *Template {
 		Status:      tpi.Status,
 		Icon:        tpi.Icon,
 		Source:      tpi.Source,
+		Credential:  tpi.Credential,
 	}
 
 	if tpi.Project != nil {
@@ -707,6 +717,8 @@ type TemplateUpdateInput struct {
 	Description string `path:"-" query:"-" json:"description,omitempty"`
 	// Labels holds the value of the "labels" field.
 	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Credential to access the private registry of the source, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 }
 
 // Model returns the Template entity for modifying,
@@ -721,6 +733,7 @@ func (tui *TemplateUpdateInput) Model() *Template {
 		Name:        tui.Name,
 		Description: tui.Description,
 		Labels:      tui.Labels,
+		Credential:  tui.Credential,
 	}
 
 	return _t
@@ -759,6 +772,8 @@ type TemplateUpdateInputsItem struct {
 	Description string `path:"-" query:"-" json:"description,omitempty"`
 	// Labels holds the value of the "labels" field.
 	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Credential to access the private registry of the source, keeps the previous one if not provided.
+	Credential crypto.Map[string, string] `path:"-" query:"-" json:"credential,omitempty" sensitive:"true"`
 }
 
 // ValidateWith checks the TemplateUpdateInputsItem entity with the given context and client set.
@@ -801,6 +816,7 @@ func (tui *TemplateUpdateInputs) Model() []*Template {
 			Name:        tui.Items[i].Name,
 			Description: tui.Items[i].Description,
 			Labels:      tui.Items[i].Labels,
+			Credential:  tui.Items[i].Credential,
 		}
 
 		_ts[i] = _t
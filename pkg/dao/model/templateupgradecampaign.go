@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// TemplateUpgradeCampaign is the model entity for the TemplateUpgradeCampaign schema.
+type TemplateUpgradeCampaign struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID object.ID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// ID of the project to belong, empty means upgrading the resources of all projects.
+	ProjectID object.ID `json:"project_id,omitempty"`
+	// ID of the template version to upgrade from.
+	TemplateVersionID object.ID `json:"template_version_id,omitempty"`
+	// ID of the template version to upgrade to.
+	TargetVersionID object.ID `json:"target_version_id,omitempty"`
+	// Preview the upgrade without applying, the planned runs can be applied later.
+	Preview bool `json:"preview,omitempty"`
+	// Count of the resources to upgrade in each batch.
+	BatchSize int `json:"batch_size,omitempty"`
+	// Change comment of the upgrade runs.
+	ChangeComment string `json:"change_comment,omitempty"`
+	// ID of the subject who creates the campaign, the upgrade runs are acted as the subject.
+	SubjectID object.ID `json:"subject_id,omitempty"`
+	// Status of the campaign, pending, running, succeeded, failed or canceled.
+	Status string `json:"status,omitempty,cli-table-column"`
+	// Message of the campaign status.
+	Message string `json:"message,omitempty"`
+	// Upgrade results of the affected resources.
+	Results []types.TemplateUpgradeCampaignResult `json:"results,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TemplateUpgradeCampaignQuery when eager-loading is set.
+	Edges        TemplateUpgradeCampaignEdges `json:"edges,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// TemplateUpgradeCampaignEdges holds the relations/edges for other nodes in the graph.
+type TemplateUpgradeCampaignEdges struct {
+	// Project to which the campaign belongs.
+	Project *Project `json:"project,omitempty"`
+	// Template version to upgrade from.
+	TemplateVersion *TemplateVersion `json:"template_version,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateUpgradeCampaignEdges) ProjectOrErr() (*Project, error) {
+	if e.loadedTypes[0] {
+		if e.Project == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: project.Label}
+		}
+		return e.Project, nil
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// TemplateVersionOrErr returns the TemplateVersion value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TemplateUpgradeCampaignEdges) TemplateVersionOrErr() (*TemplateVersion, error) {
+	if e.loadedTypes[1] {
+		if e.TemplateVersion == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: templateversion.Label}
+		}
+		return e.TemplateVersion, nil
+	}
+	return nil, &NotLoadedError{edge: "template_version"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TemplateUpgradeCampaign) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case templateupgradecampaign.FieldResults:
+			values[i] = new([]byte)
+		case templateupgradecampaign.FieldID, templateupgradecampaign.FieldProjectID, templateupgradecampaign.FieldTemplateVersionID, templateupgradecampaign.FieldTargetVersionID, templateupgradecampaign.FieldSubjectID:
+			values[i] = new(object.ID)
+		case templateupgradecampaign.FieldPreview:
+			values[i] = new(sql.NullBool)
+		case templateupgradecampaign.FieldBatchSize:
+			values[i] = new(sql.NullInt64)
+		case templateupgradecampaign.FieldChangeComment, templateupgradecampaign.FieldStatus, templateupgradecampaign.FieldMessage:
+			values[i] = new(sql.NullString)
+		case templateupgradecampaign.FieldCreateTime, templateupgradecampaign.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TemplateUpgradeCampaign fields.
+func (tuc *TemplateUpgradeCampaign) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case templateupgradecampaign.FieldID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tuc.ID = *value
+			}
+		case templateupgradecampaign.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				tuc.CreateTime = new(time.Time)
+				*tuc.CreateTime = value.Time
+			}
+		case templateupgradecampaign.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				tuc.UpdateTime = new(time.Time)
+				*tuc.UpdateTime = value.Time
+			}
+		case templateupgradecampaign.FieldProjectID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				tuc.ProjectID = *value
+			}
+		case templateupgradecampaign.FieldTemplateVersionID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field template_version_id", values[i])
+			} else if value != nil {
+				tuc.TemplateVersionID = *value
+			}
+		case templateupgradecampaign.FieldTargetVersionID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field target_version_id", values[i])
+			} else if value != nil {
+				tuc.TargetVersionID = *value
+			}
+		case templateupgradecampaign.FieldPreview:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field preview", values[i])
+			} else if value.Valid {
+				tuc.Preview = value.Bool
+			}
+		case templateupgradecampaign.FieldBatchSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_size", values[i])
+			} else if value.Valid {
+				tuc.BatchSize = int(value.Int64)
+			}
+		case templateupgradecampaign.FieldChangeComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field change_comment", values[i])
+			} else if value.Valid {
+				tuc.ChangeComment = value.String
+			}
+		case templateupgradecampaign.FieldSubjectID:
+			if value, ok := values[i].(*object.ID); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_id", values[i])
+			} else if value != nil {
+				tuc.SubjectID = *value
+			}
+		case templateupgradecampaign.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				tuc.Status = value.String
+			}
+		case templateupgradecampaign.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				tuc.Message = value.String
+			}
+		case templateupgradecampaign.FieldResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tuc.Results); err != nil {
+					return fmt.Errorf("unmarshal field results: %w", err)
+				}
+			}
+		default:
+			tuc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TemplateUpgradeCampaign.
+// This includes values selected through modifiers, order, etc.
+func (tuc *TemplateUpgradeCampaign) Value(name string) (ent.Value, error) {
+	return tuc.selectValues.Get(name)
+}
+
+// QueryProject queries the "project" edge of the TemplateUpgradeCampaign entity.
+func (tuc *TemplateUpgradeCampaign) QueryProject() *ProjectQuery {
+	return NewTemplateUpgradeCampaignClient(tuc.config).QueryProject(tuc)
+}
+
+// QueryTemplateVersion queries the "template_version" edge of the TemplateUpgradeCampaign entity.
+func (tuc *TemplateUpgradeCampaign) QueryTemplateVersion() *TemplateVersionQuery {
+	return NewTemplateUpgradeCampaignClient(tuc.config).QueryTemplateVersion(tuc)
+}
+
+// Update returns a builder for updating this TemplateUpgradeCampaign.
+// Note that you need to call TemplateUpgradeCampaign.Unwrap() before calling this method if this TemplateUpgradeCampaign
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tuc *TemplateUpgradeCampaign) Update() *TemplateUpgradeCampaignUpdateOne {
+	return NewTemplateUpgradeCampaignClient(tuc.config).UpdateOne(tuc)
+}
+
+// Unwrap unwraps the TemplateUpgradeCampaign entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tuc *TemplateUpgradeCampaign) Unwrap() *TemplateUpgradeCampaign {
+	_tx, ok := tuc.config.driver.(*txDriver)
+	if !ok {
+		panic("model: TemplateUpgradeCampaign is not a transactional entity")
+	}
+	tuc.config.driver = _tx.drv
+	return tuc
+}
+
+// String implements the fmt.Stringer.
+func (tuc *TemplateUpgradeCampaign) String() string {
+	var builder strings.Builder
+	builder.WriteString("TemplateUpgradeCampaign(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tuc.ID))
+	if v := tuc.CreateTime; v != nil {
+		builder.WriteString("create_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := tuc.UpdateTime; v != nil {
+		builder.WriteString("update_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", tuc.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("template_version_id=")
+	builder.WriteString(fmt.Sprintf("%v", tuc.TemplateVersionID))
+	builder.WriteString(", ")
+	builder.WriteString("target_version_id=")
+	builder.WriteString(fmt.Sprintf("%v", tuc.TargetVersionID))
+	builder.WriteString(", ")
+	builder.WriteString("preview=")
+	builder.WriteString(fmt.Sprintf("%v", tuc.Preview))
+	builder.WriteString(", ")
+	builder.WriteString("batch_size=")
+	builder.WriteString(fmt.Sprintf("%v", tuc.BatchSize))
+	builder.WriteString(", ")
+	builder.WriteString("change_comment=")
+	builder.WriteString(tuc.ChangeComment)
+	builder.WriteString(", ")
+	builder.WriteString("subject_id=")
+	builder.WriteString(fmt.Sprintf("%v", tuc.SubjectID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(tuc.Status)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(tuc.Message)
+	builder.WriteString(", ")
+	builder.WriteString("results=")
+	builder.WriteString(fmt.Sprintf("%v", tuc.Results))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TemplateUpgradeCampaigns is a parsable slice of TemplateUpgradeCampaign.
+type TemplateUpgradeCampaigns []*TemplateUpgradeCampaign
@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package templateupgradecampaign
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// Label holds the string label denoting the templateupgradecampaign type in the database.
+	Label = "template_upgrade_campaign"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldTemplateVersionID holds the string denoting the template_version_id field in the database.
+	FieldTemplateVersionID = "template_version_id"
+	// FieldTargetVersionID holds the string denoting the target_version_id field in the database.
+	FieldTargetVersionID = "target_version_id"
+	// FieldPreview holds the string denoting the preview field in the database.
+	FieldPreview = "preview"
+	// FieldBatchSize holds the string denoting the batch_size field in the database.
+	FieldBatchSize = "batch_size"
+	// FieldChangeComment holds the string denoting the change_comment field in the database.
+	FieldChangeComment = "change_comment"
+	// FieldSubjectID holds the string denoting the subject_id field in the database.
+	FieldSubjectID = "subject_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldResults holds the string denoting the results field in the database.
+	FieldResults = "results"
+	// EdgeProject holds the string denoting the project edge name in mutations.
+	EdgeProject = "project"
+	// EdgeTemplateVersion holds the string denoting the template_version edge name in mutations.
+	EdgeTemplateVersion = "template_version"
+	// Table holds the table name of the templateupgradecampaign in the database.
+	Table = "template_upgrade_campaigns"
+	// ProjectTable is the table that holds the project relation/edge.
+	ProjectTable = "template_upgrade_campaigns"
+	// ProjectInverseTable is the table name for the Project entity.
+	// It exists in this package in order to avoid circular dependency with the "project" package.
+	ProjectInverseTable = "projects"
+	// ProjectColumn is the table column denoting the project relation/edge.
+	ProjectColumn = "project_id"
+	// TemplateVersionTable is the table that holds the template_version relation/edge.
+	TemplateVersionTable = "template_upgrade_campaigns"
+	// TemplateVersionInverseTable is the table name for the TemplateVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "templateversion" package.
+	TemplateVersionInverseTable = "template_versions"
+	// TemplateVersionColumn is the table column denoting the template_version relation/edge.
+	TemplateVersionColumn = "template_version_id"
+)
+
+// Columns holds all SQL columns for templateupgradecampaign fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldProjectID,
+	FieldTemplateVersionID,
+	FieldTargetVersionID,
+	FieldPreview,
+	FieldBatchSize,
+	FieldChangeComment,
+	FieldSubjectID,
+	FieldStatus,
+	FieldMessage,
+	FieldResults,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// TemplateVersionIDValidator is a validator for the "template_version_id" field. It is called by the builders before save.
+	TemplateVersionIDValidator func(string) error
+	// TargetVersionIDValidator is a validator for the "target_version_id" field. It is called by the builders before save.
+	TargetVersionIDValidator func(string) error
+	// DefaultPreview holds the default value on creation for the "preview" field.
+	DefaultPreview bool
+	// DefaultBatchSize holds the default value on creation for the "batch_size" field.
+	DefaultBatchSize int
+	// BatchSizeValidator is a validator for the "batch_size" field. It is called by the builders before save.
+	BatchSizeValidator func(int) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+)
+
+// OrderOption defines the ordering options for the TemplateUpgradeCampaign queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByTemplateVersionID orders the results by the template_version_id field.
+func ByTemplateVersionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateVersionID, opts...).ToFunc()
+}
+
+// ByTargetVersionID orders the results by the target_version_id field.
+func ByTargetVersionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetVersionID, opts...).ToFunc()
+}
+
+// ByPreview orders the results by the preview field.
+func ByPreview(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreview, opts...).ToFunc()
+}
+
+// ByBatchSize orders the results by the batch_size field.
+func ByBatchSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchSize, opts...).ToFunc()
+}
+
+// ByChangeComment orders the results by the change_comment field.
+func ByChangeComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangeComment, opts...).ToFunc()
+}
+
+// BySubjectID orders the results by the subject_id field.
+func BySubjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubjectID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByProjectField orders the results by project field.
+func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProjectStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTemplateVersionField orders the results by template_version field.
+func ByTemplateVersionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTemplateVersionStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newProjectStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProjectInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+	)
+}
+func newTemplateVersionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TemplateVersionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TemplateVersionTable, TemplateVersionColumn),
+	)
+}
+
+// WithoutFields returns the fields ignored the given list.
+func WithoutFields(ignores ...string) []string {
+	if len(ignores) == 0 {
+		return slices.Clone(Columns)
+	}
+
+	var s = make(map[string]bool, len(ignores))
+	for i := range ignores {
+		s[ignores[i]] = true
+	}
+
+	var r = make([]string, 0, len(Columns)-len(s))
+	for i := range Columns {
+		if s[Columns[i]] {
+			continue
+		}
+		r = append(r, Columns[i])
+	}
+	return r
+}
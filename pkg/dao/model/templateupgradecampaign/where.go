@@ -0,0 +1,845 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package templateupgradecampaign
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldProjectID, v))
+}
+
+// TemplateVersionID applies equality check predicate on the "template_version_id" field. It's identical to TemplateVersionIDEQ.
+func TemplateVersionID(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldTemplateVersionID, v))
+}
+
+// TargetVersionID applies equality check predicate on the "target_version_id" field. It's identical to TargetVersionIDEQ.
+func TargetVersionID(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldTargetVersionID, v))
+}
+
+// Preview applies equality check predicate on the "preview" field. It's identical to PreviewEQ.
+func Preview(v bool) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldPreview, v))
+}
+
+// BatchSize applies equality check predicate on the "batch_size" field. It's identical to BatchSizeEQ.
+func BatchSize(v int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldBatchSize, v))
+}
+
+// ChangeComment applies equality check predicate on the "change_comment" field. It's identical to ChangeCommentEQ.
+func ChangeComment(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldChangeComment, v))
+}
+
+// SubjectID applies equality check predicate on the "subject_id" field. It's identical to SubjectIDEQ.
+func SubjectID(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldStatus, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldProjectID, v))
+}
+
+// ProjectIDContains applies the Contains predicate on the "project_id" field.
+func ProjectIDContains(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldContains(FieldProjectID, vc))
+}
+
+// ProjectIDHasPrefix applies the HasPrefix predicate on the "project_id" field.
+func ProjectIDHasPrefix(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasPrefix(FieldProjectID, vc))
+}
+
+// ProjectIDHasSuffix applies the HasSuffix predicate on the "project_id" field.
+func ProjectIDHasSuffix(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasSuffix(FieldProjectID, vc))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotNull(FieldProjectID))
+}
+
+// ProjectIDEqualFold applies the EqualFold predicate on the "project_id" field.
+func ProjectIDEqualFold(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldEqualFold(FieldProjectID, vc))
+}
+
+// ProjectIDContainsFold applies the ContainsFold predicate on the "project_id" field.
+func ProjectIDContainsFold(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldContainsFold(FieldProjectID, vc))
+}
+
+// TemplateVersionIDEQ applies the EQ predicate on the "template_version_id" field.
+func TemplateVersionIDEQ(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldTemplateVersionID, v))
+}
+
+// TemplateVersionIDNEQ applies the NEQ predicate on the "template_version_id" field.
+func TemplateVersionIDNEQ(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldTemplateVersionID, v))
+}
+
+// TemplateVersionIDIn applies the In predicate on the "template_version_id" field.
+func TemplateVersionIDIn(vs ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldTemplateVersionID, vs...))
+}
+
+// TemplateVersionIDNotIn applies the NotIn predicate on the "template_version_id" field.
+func TemplateVersionIDNotIn(vs ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldTemplateVersionID, vs...))
+}
+
+// TemplateVersionIDGT applies the GT predicate on the "template_version_id" field.
+func TemplateVersionIDGT(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldTemplateVersionID, v))
+}
+
+// TemplateVersionIDGTE applies the GTE predicate on the "template_version_id" field.
+func TemplateVersionIDGTE(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldTemplateVersionID, v))
+}
+
+// TemplateVersionIDLT applies the LT predicate on the "template_version_id" field.
+func TemplateVersionIDLT(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldTemplateVersionID, v))
+}
+
+// TemplateVersionIDLTE applies the LTE predicate on the "template_version_id" field.
+func TemplateVersionIDLTE(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldTemplateVersionID, v))
+}
+
+// TemplateVersionIDContains applies the Contains predicate on the "template_version_id" field.
+func TemplateVersionIDContains(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldContains(FieldTemplateVersionID, vc))
+}
+
+// TemplateVersionIDHasPrefix applies the HasPrefix predicate on the "template_version_id" field.
+func TemplateVersionIDHasPrefix(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasPrefix(FieldTemplateVersionID, vc))
+}
+
+// TemplateVersionIDHasSuffix applies the HasSuffix predicate on the "template_version_id" field.
+func TemplateVersionIDHasSuffix(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasSuffix(FieldTemplateVersionID, vc))
+}
+
+// TemplateVersionIDEqualFold applies the EqualFold predicate on the "template_version_id" field.
+func TemplateVersionIDEqualFold(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldEqualFold(FieldTemplateVersionID, vc))
+}
+
+// TemplateVersionIDContainsFold applies the ContainsFold predicate on the "template_version_id" field.
+func TemplateVersionIDContainsFold(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldContainsFold(FieldTemplateVersionID, vc))
+}
+
+// TargetVersionIDEQ applies the EQ predicate on the "target_version_id" field.
+func TargetVersionIDEQ(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldTargetVersionID, v))
+}
+
+// TargetVersionIDNEQ applies the NEQ predicate on the "target_version_id" field.
+func TargetVersionIDNEQ(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldTargetVersionID, v))
+}
+
+// TargetVersionIDIn applies the In predicate on the "target_version_id" field.
+func TargetVersionIDIn(vs ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldTargetVersionID, vs...))
+}
+
+// TargetVersionIDNotIn applies the NotIn predicate on the "target_version_id" field.
+func TargetVersionIDNotIn(vs ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldTargetVersionID, vs...))
+}
+
+// TargetVersionIDGT applies the GT predicate on the "target_version_id" field.
+func TargetVersionIDGT(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldTargetVersionID, v))
+}
+
+// TargetVersionIDGTE applies the GTE predicate on the "target_version_id" field.
+func TargetVersionIDGTE(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldTargetVersionID, v))
+}
+
+// TargetVersionIDLT applies the LT predicate on the "target_version_id" field.
+func TargetVersionIDLT(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldTargetVersionID, v))
+}
+
+// TargetVersionIDLTE applies the LTE predicate on the "target_version_id" field.
+func TargetVersionIDLTE(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldTargetVersionID, v))
+}
+
+// TargetVersionIDContains applies the Contains predicate on the "target_version_id" field.
+func TargetVersionIDContains(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldContains(FieldTargetVersionID, vc))
+}
+
+// TargetVersionIDHasPrefix applies the HasPrefix predicate on the "target_version_id" field.
+func TargetVersionIDHasPrefix(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasPrefix(FieldTargetVersionID, vc))
+}
+
+// TargetVersionIDHasSuffix applies the HasSuffix predicate on the "target_version_id" field.
+func TargetVersionIDHasSuffix(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasSuffix(FieldTargetVersionID, vc))
+}
+
+// TargetVersionIDEqualFold applies the EqualFold predicate on the "target_version_id" field.
+func TargetVersionIDEqualFold(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldEqualFold(FieldTargetVersionID, vc))
+}
+
+// TargetVersionIDContainsFold applies the ContainsFold predicate on the "target_version_id" field.
+func TargetVersionIDContainsFold(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldContainsFold(FieldTargetVersionID, vc))
+}
+
+// PreviewEQ applies the EQ predicate on the "preview" field.
+func PreviewEQ(v bool) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldPreview, v))
+}
+
+// PreviewNEQ applies the NEQ predicate on the "preview" field.
+func PreviewNEQ(v bool) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldPreview, v))
+}
+
+// BatchSizeEQ applies the EQ predicate on the "batch_size" field.
+func BatchSizeEQ(v int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldBatchSize, v))
+}
+
+// BatchSizeNEQ applies the NEQ predicate on the "batch_size" field.
+func BatchSizeNEQ(v int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldBatchSize, v))
+}
+
+// BatchSizeIn applies the In predicate on the "batch_size" field.
+func BatchSizeIn(vs ...int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldBatchSize, vs...))
+}
+
+// BatchSizeNotIn applies the NotIn predicate on the "batch_size" field.
+func BatchSizeNotIn(vs ...int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldBatchSize, vs...))
+}
+
+// BatchSizeGT applies the GT predicate on the "batch_size" field.
+func BatchSizeGT(v int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldBatchSize, v))
+}
+
+// BatchSizeGTE applies the GTE predicate on the "batch_size" field.
+func BatchSizeGTE(v int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldBatchSize, v))
+}
+
+// BatchSizeLT applies the LT predicate on the "batch_size" field.
+func BatchSizeLT(v int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldBatchSize, v))
+}
+
+// BatchSizeLTE applies the LTE predicate on the "batch_size" field.
+func BatchSizeLTE(v int) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldBatchSize, v))
+}
+
+// ChangeCommentEQ applies the EQ predicate on the "change_comment" field.
+func ChangeCommentEQ(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldChangeComment, v))
+}
+
+// ChangeCommentNEQ applies the NEQ predicate on the "change_comment" field.
+func ChangeCommentNEQ(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldChangeComment, v))
+}
+
+// ChangeCommentIn applies the In predicate on the "change_comment" field.
+func ChangeCommentIn(vs ...string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldChangeComment, vs...))
+}
+
+// ChangeCommentNotIn applies the NotIn predicate on the "change_comment" field.
+func ChangeCommentNotIn(vs ...string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldChangeComment, vs...))
+}
+
+// ChangeCommentGT applies the GT predicate on the "change_comment" field.
+func ChangeCommentGT(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldChangeComment, v))
+}
+
+// ChangeCommentGTE applies the GTE predicate on the "change_comment" field.
+func ChangeCommentGTE(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldChangeComment, v))
+}
+
+// ChangeCommentLT applies the LT predicate on the "change_comment" field.
+func ChangeCommentLT(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldChangeComment, v))
+}
+
+// ChangeCommentLTE applies the LTE predicate on the "change_comment" field.
+func ChangeCommentLTE(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldChangeComment, v))
+}
+
+// ChangeCommentContains applies the Contains predicate on the "change_comment" field.
+func ChangeCommentContains(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldContains(FieldChangeComment, v))
+}
+
+// ChangeCommentHasPrefix applies the HasPrefix predicate on the "change_comment" field.
+func ChangeCommentHasPrefix(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasPrefix(FieldChangeComment, v))
+}
+
+// ChangeCommentHasSuffix applies the HasSuffix predicate on the "change_comment" field.
+func ChangeCommentHasSuffix(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasSuffix(FieldChangeComment, v))
+}
+
+// ChangeCommentIsNil applies the IsNil predicate on the "change_comment" field.
+func ChangeCommentIsNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIsNull(FieldChangeComment))
+}
+
+// ChangeCommentNotNil applies the NotNil predicate on the "change_comment" field.
+func ChangeCommentNotNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotNull(FieldChangeComment))
+}
+
+// ChangeCommentEqualFold applies the EqualFold predicate on the "change_comment" field.
+func ChangeCommentEqualFold(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEqualFold(FieldChangeComment, v))
+}
+
+// ChangeCommentContainsFold applies the ContainsFold predicate on the "change_comment" field.
+func ChangeCommentContainsFold(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldContainsFold(FieldChangeComment, v))
+}
+
+// SubjectIDEQ applies the EQ predicate on the "subject_id" field.
+func SubjectIDEQ(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldSubjectID, v))
+}
+
+// SubjectIDNEQ applies the NEQ predicate on the "subject_id" field.
+func SubjectIDNEQ(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldSubjectID, v))
+}
+
+// SubjectIDIn applies the In predicate on the "subject_id" field.
+func SubjectIDIn(vs ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDNotIn applies the NotIn predicate on the "subject_id" field.
+func SubjectIDNotIn(vs ...object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldSubjectID, vs...))
+}
+
+// SubjectIDGT applies the GT predicate on the "subject_id" field.
+func SubjectIDGT(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldSubjectID, v))
+}
+
+// SubjectIDGTE applies the GTE predicate on the "subject_id" field.
+func SubjectIDGTE(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldSubjectID, v))
+}
+
+// SubjectIDLT applies the LT predicate on the "subject_id" field.
+func SubjectIDLT(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldSubjectID, v))
+}
+
+// SubjectIDLTE applies the LTE predicate on the "subject_id" field.
+func SubjectIDLTE(v object.ID) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldSubjectID, v))
+}
+
+// SubjectIDContains applies the Contains predicate on the "subject_id" field.
+func SubjectIDContains(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldContains(FieldSubjectID, vc))
+}
+
+// SubjectIDHasPrefix applies the HasPrefix predicate on the "subject_id" field.
+func SubjectIDHasPrefix(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasPrefix(FieldSubjectID, vc))
+}
+
+// SubjectIDHasSuffix applies the HasSuffix predicate on the "subject_id" field.
+func SubjectIDHasSuffix(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasSuffix(FieldSubjectID, vc))
+}
+
+// SubjectIDIsNil applies the IsNil predicate on the "subject_id" field.
+func SubjectIDIsNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIsNull(FieldSubjectID))
+}
+
+// SubjectIDNotNil applies the NotNil predicate on the "subject_id" field.
+func SubjectIDNotNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotNull(FieldSubjectID))
+}
+
+// SubjectIDEqualFold applies the EqualFold predicate on the "subject_id" field.
+func SubjectIDEqualFold(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldEqualFold(FieldSubjectID, vc))
+}
+
+// SubjectIDContainsFold applies the ContainsFold predicate on the "subject_id" field.
+func SubjectIDContainsFold(v object.ID) predicate.TemplateUpgradeCampaign {
+	vc := string(v)
+	return predicate.TemplateUpgradeCampaign(sql.FieldContainsFold(FieldSubjectID, vc))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ResultsIsNil applies the IsNil predicate on the "results" field.
+func ResultsIsNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldIsNull(FieldResults))
+}
+
+// ResultsNotNil applies the NotNil predicate on the "results" field.
+func ResultsNotNil() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.FieldNotNull(FieldResults))
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProjectTable, ProjectColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(func(s *sql.Selector) {
+		step := newProjectStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTemplateVersion applies the HasEdge predicate on the "template_version" edge.
+func HasTemplateVersion() predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TemplateVersionTable, TemplateVersionColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTemplateVersionWith applies the HasEdge predicate on the "template_version" edge with a given conditions (other predicates).
+func HasTemplateVersionWith(preds ...predicate.TemplateVersion) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(func(s *sql.Selector) {
+		step := newTemplateVersionStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TemplateUpgradeCampaign) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TemplateUpgradeCampaign) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TemplateUpgradeCampaign) predicate.TemplateUpgradeCampaign {
+	return predicate.TemplateUpgradeCampaign(sql.NotPredicates(p))
+}
@@ -0,0 +1,1305 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// TemplateUpgradeCampaignCreate is the builder for creating a TemplateUpgradeCampaign entity.
+type TemplateUpgradeCampaignCreate struct {
+	config
+	mutation   *TemplateUpgradeCampaignMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *TemplateUpgradeCampaign
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetCreateTime(t time.Time) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetCreateTime(t)
+	return tucc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillableCreateTime(t *time.Time) *TemplateUpgradeCampaignCreate {
+	if t != nil {
+		tucc.SetCreateTime(*t)
+	}
+	return tucc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetUpdateTime(t time.Time) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetUpdateTime(t)
+	return tucc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillableUpdateTime(t *time.Time) *TemplateUpgradeCampaignCreate {
+	if t != nil {
+		tucc.SetUpdateTime(*t)
+	}
+	return tucc
+}
+
+// SetProjectID sets the "project_id" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetProjectID(o object.ID) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetProjectID(o)
+	return tucc
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillableProjectID(o *object.ID) *TemplateUpgradeCampaignCreate {
+	if o != nil {
+		tucc.SetProjectID(*o)
+	}
+	return tucc
+}
+
+// SetTemplateVersionID sets the "template_version_id" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetTemplateVersionID(o object.ID) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetTemplateVersionID(o)
+	return tucc
+}
+
+// SetTargetVersionID sets the "target_version_id" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetTargetVersionID(o object.ID) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetTargetVersionID(o)
+	return tucc
+}
+
+// SetPreview sets the "preview" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetPreview(b bool) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetPreview(b)
+	return tucc
+}
+
+// SetNillablePreview sets the "preview" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillablePreview(b *bool) *TemplateUpgradeCampaignCreate {
+	if b != nil {
+		tucc.SetPreview(*b)
+	}
+	return tucc
+}
+
+// SetBatchSize sets the "batch_size" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetBatchSize(i int) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetBatchSize(i)
+	return tucc
+}
+
+// SetNillableBatchSize sets the "batch_size" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillableBatchSize(i *int) *TemplateUpgradeCampaignCreate {
+	if i != nil {
+		tucc.SetBatchSize(*i)
+	}
+	return tucc
+}
+
+// SetChangeComment sets the "change_comment" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetChangeComment(s string) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetChangeComment(s)
+	return tucc
+}
+
+// SetNillableChangeComment sets the "change_comment" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillableChangeComment(s *string) *TemplateUpgradeCampaignCreate {
+	if s != nil {
+		tucc.SetChangeComment(*s)
+	}
+	return tucc
+}
+
+// SetSubjectID sets the "subject_id" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetSubjectID(o object.ID) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetSubjectID(o)
+	return tucc
+}
+
+// SetNillableSubjectID sets the "subject_id" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillableSubjectID(o *object.ID) *TemplateUpgradeCampaignCreate {
+	if o != nil {
+		tucc.SetSubjectID(*o)
+	}
+	return tucc
+}
+
+// SetStatus sets the "status" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetStatus(s string) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetStatus(s)
+	return tucc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillableStatus(s *string) *TemplateUpgradeCampaignCreate {
+	if s != nil {
+		tucc.SetStatus(*s)
+	}
+	return tucc
+}
+
+// SetMessage sets the "message" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetMessage(s string) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetMessage(s)
+	return tucc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (tucc *TemplateUpgradeCampaignCreate) SetNillableMessage(s *string) *TemplateUpgradeCampaignCreate {
+	if s != nil {
+		tucc.SetMessage(*s)
+	}
+	return tucc
+}
+
+// SetResults sets the "results" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetResults(tucr []types.TemplateUpgradeCampaignResult) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetResults(tucr)
+	return tucc
+}
+
+// SetID sets the "id" field.
+func (tucc *TemplateUpgradeCampaignCreate) SetID(o object.ID) *TemplateUpgradeCampaignCreate {
+	tucc.mutation.SetID(o)
+	return tucc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (tucc *TemplateUpgradeCampaignCreate) SetProject(p *Project) *TemplateUpgradeCampaignCreate {
+	return tucc.SetProjectID(p.ID)
+}
+
+// SetTemplateVersion sets the "template_version" edge to the TemplateVersion entity.
+func (tucc *TemplateUpgradeCampaignCreate) SetTemplateVersion(t *TemplateVersion) *TemplateUpgradeCampaignCreate {
+	return tucc.SetTemplateVersionID(t.ID)
+}
+
+// Mutation returns the TemplateUpgradeCampaignMutation object of the builder.
+func (tucc *TemplateUpgradeCampaignCreate) Mutation() *TemplateUpgradeCampaignMutation {
+	return tucc.mutation
+}
+
+// Save creates the TemplateUpgradeCampaign in the database.
+func (tucc *TemplateUpgradeCampaignCreate) Save(ctx context.Context) (*TemplateUpgradeCampaign, error) {
+	if err := tucc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tucc.sqlSave, tucc.mutation, tucc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tucc *TemplateUpgradeCampaignCreate) SaveX(ctx context.Context) *TemplateUpgradeCampaign {
+	v, err := tucc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tucc *TemplateUpgradeCampaignCreate) Exec(ctx context.Context) error {
+	_, err := tucc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tucc *TemplateUpgradeCampaignCreate) ExecX(ctx context.Context) {
+	if err := tucc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tucc *TemplateUpgradeCampaignCreate) defaults() error {
+	if _, ok := tucc.mutation.CreateTime(); !ok {
+		if templateupgradecampaign.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized templateupgradecampaign.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := templateupgradecampaign.DefaultCreateTime()
+		tucc.mutation.SetCreateTime(v)
+	}
+	if _, ok := tucc.mutation.UpdateTime(); !ok {
+		if templateupgradecampaign.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized templateupgradecampaign.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := templateupgradecampaign.DefaultUpdateTime()
+		tucc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := tucc.mutation.Preview(); !ok {
+		v := templateupgradecampaign.DefaultPreview
+		tucc.mutation.SetPreview(v)
+	}
+	if _, ok := tucc.mutation.BatchSize(); !ok {
+		v := templateupgradecampaign.DefaultBatchSize
+		tucc.mutation.SetBatchSize(v)
+	}
+	if _, ok := tucc.mutation.Status(); !ok {
+		v := templateupgradecampaign.DefaultStatus
+		tucc.mutation.SetStatus(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tucc *TemplateUpgradeCampaignCreate) check() error {
+	if _, ok := tucc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "TemplateUpgradeCampaign.create_time"`)}
+	}
+	if _, ok := tucc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "TemplateUpgradeCampaign.update_time"`)}
+	}
+	if _, ok := tucc.mutation.TemplateVersionID(); !ok {
+		return &ValidationError{Name: "template_version_id", err: errors.New(`model: missing required field "TemplateUpgradeCampaign.template_version_id"`)}
+	}
+	if v, ok := tucc.mutation.TemplateVersionID(); ok {
+		if err := templateupgradecampaign.TemplateVersionIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "template_version_id", err: fmt.Errorf(`model: validator failed for field "TemplateUpgradeCampaign.template_version_id": %w`, err)}
+		}
+	}
+	if _, ok := tucc.mutation.TargetVersionID(); !ok {
+		return &ValidationError{Name: "target_version_id", err: errors.New(`model: missing required field "TemplateUpgradeCampaign.target_version_id"`)}
+	}
+	if v, ok := tucc.mutation.TargetVersionID(); ok {
+		if err := templateupgradecampaign.TargetVersionIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "target_version_id", err: fmt.Errorf(`model: validator failed for field "TemplateUpgradeCampaign.target_version_id": %w`, err)}
+		}
+	}
+	if _, ok := tucc.mutation.Preview(); !ok {
+		return &ValidationError{Name: "preview", err: errors.New(`model: missing required field "TemplateUpgradeCampaign.preview"`)}
+	}
+	if _, ok := tucc.mutation.BatchSize(); !ok {
+		return &ValidationError{Name: "batch_size", err: errors.New(`model: missing required field "TemplateUpgradeCampaign.batch_size"`)}
+	}
+	if v, ok := tucc.mutation.BatchSize(); ok {
+		if err := templateupgradecampaign.BatchSizeValidator(v); err != nil {
+			return &ValidationError{Name: "batch_size", err: fmt.Errorf(`model: validator failed for field "TemplateUpgradeCampaign.batch_size": %w`, err)}
+		}
+	}
+	if _, ok := tucc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`model: missing required field "TemplateUpgradeCampaign.status"`)}
+	}
+	if _, ok := tucc.mutation.TemplateVersionID(); !ok {
+		return &ValidationError{Name: "template_version", err: errors.New(`model: missing required edge "TemplateUpgradeCampaign.template_version"`)}
+	}
+	return nil
+}
+
+func (tucc *TemplateUpgradeCampaignCreate) sqlSave(ctx context.Context) (*TemplateUpgradeCampaign, error) {
+	if err := tucc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tucc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tucc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tucc.mutation.id = &_node.ID
+	tucc.mutation.done = true
+	return _node, nil
+}
+
+func (tucc *TemplateUpgradeCampaignCreate) createSpec() (*TemplateUpgradeCampaign, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TemplateUpgradeCampaign{config: tucc.config}
+		_spec = sqlgraph.NewCreateSpec(templateupgradecampaign.Table, sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString))
+	)
+	_spec.Schema = tucc.schemaConfig.TemplateUpgradeCampaign
+	_spec.OnConflict = tucc.conflict
+	if id, ok := tucc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tucc.mutation.CreateTime(); ok {
+		_spec.SetField(templateupgradecampaign.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := tucc.mutation.UpdateTime(); ok {
+		_spec.SetField(templateupgradecampaign.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := tucc.mutation.TargetVersionID(); ok {
+		_spec.SetField(templateupgradecampaign.FieldTargetVersionID, field.TypeString, value)
+		_node.TargetVersionID = value
+	}
+	if value, ok := tucc.mutation.Preview(); ok {
+		_spec.SetField(templateupgradecampaign.FieldPreview, field.TypeBool, value)
+		_node.Preview = value
+	}
+	if value, ok := tucc.mutation.BatchSize(); ok {
+		_spec.SetField(templateupgradecampaign.FieldBatchSize, field.TypeInt, value)
+		_node.BatchSize = value
+	}
+	if value, ok := tucc.mutation.ChangeComment(); ok {
+		_spec.SetField(templateupgradecampaign.FieldChangeComment, field.TypeString, value)
+		_node.ChangeComment = value
+	}
+	if value, ok := tucc.mutation.SubjectID(); ok {
+		_spec.SetField(templateupgradecampaign.FieldSubjectID, field.TypeString, value)
+		_node.SubjectID = value
+	}
+	if value, ok := tucc.mutation.Status(); ok {
+		_spec.SetField(templateupgradecampaign.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := tucc.mutation.Message(); ok {
+		_spec.SetField(templateupgradecampaign.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := tucc.mutation.Results(); ok {
+		_spec.SetField(templateupgradecampaign.FieldResults, field.TypeJSON, value)
+		_node.Results = value
+	}
+	if nodes := tucc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateupgradecampaign.ProjectTable,
+			Columns: []string{templateupgradecampaign.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tucc.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tucc.mutation.TemplateVersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   templateupgradecampaign.TemplateVersionTable,
+			Columns: []string{templateupgradecampaign.TemplateVersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateversion.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tucc.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TemplateVersionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (tucc *TemplateUpgradeCampaignCreate) Set(obj *TemplateUpgradeCampaign) *TemplateUpgradeCampaignCreate {
+	// Required.
+	tucc.SetTemplateVersionID(obj.TemplateVersionID)
+	tucc.SetTargetVersionID(obj.TargetVersionID)
+	tucc.SetPreview(obj.Preview)
+	tucc.SetBatchSize(obj.BatchSize)
+	tucc.SetStatus(obj.Status)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		tucc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		tucc.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.ProjectID != "" {
+		tucc.SetProjectID(obj.ProjectID)
+	}
+	if obj.ChangeComment != "" {
+		tucc.SetChangeComment(obj.ChangeComment)
+	}
+	if obj.SubjectID != "" {
+		tucc.SetSubjectID(obj.SubjectID)
+	}
+	if obj.Message != "" {
+		tucc.SetMessage(obj.Message)
+	}
+	if !reflect.ValueOf(obj.Results).IsZero() {
+		tucc.SetResults(obj.Results)
+	}
+
+	// Record the given object.
+	tucc.object = obj
+
+	return tucc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (tucc *TemplateUpgradeCampaignCreate) getClientSet() (mc ClientSet) {
+	if _, ok := tucc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: tucc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: tucc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the TemplateUpgradeCampaign entity,
+// which is always good for cascading create operations.
+func (tucc *TemplateUpgradeCampaignCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *TemplateUpgradeCampaign) error) (*TemplateUpgradeCampaign, error) {
+	obj, err := tucc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := tucc.getClientSet()
+
+	if x := tucc.object; x != nil {
+		if _, set := tucc.mutation.Field(templateupgradecampaign.FieldProjectID); set {
+			obj.ProjectID = x.ProjectID
+		}
+		if _, set := tucc.mutation.Field(templateupgradecampaign.FieldTemplateVersionID); set {
+			obj.TemplateVersionID = x.TemplateVersionID
+		}
+		if _, set := tucc.mutation.Field(templateupgradecampaign.FieldTargetVersionID); set {
+			obj.TargetVersionID = x.TargetVersionID
+		}
+		if _, set := tucc.mutation.Field(templateupgradecampaign.FieldChangeComment); set {
+			obj.ChangeComment = x.ChangeComment
+		}
+		if _, set := tucc.mutation.Field(templateupgradecampaign.FieldSubjectID); set {
+			obj.SubjectID = x.SubjectID
+		}
+		if _, set := tucc.mutation.Field(templateupgradecampaign.FieldMessage); set {
+			obj.Message = x.Message
+		}
+		if _, set := tucc.mutation.Field(templateupgradecampaign.FieldResults); set {
+			obj.Results = x.Results
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (tucc *TemplateUpgradeCampaignCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *TemplateUpgradeCampaign) error) *TemplateUpgradeCampaign {
+	obj, err := tucc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (tucc *TemplateUpgradeCampaignCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *TemplateUpgradeCampaign) error) error {
+	_, err := tucc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (tucc *TemplateUpgradeCampaignCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *TemplateUpgradeCampaign) error) {
+	if err := tucc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the TemplateUpgradeCampaignCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (tuccb *TemplateUpgradeCampaignCreateBulk) Set(objs ...*TemplateUpgradeCampaign) *TemplateUpgradeCampaignCreateBulk {
+	if len(objs) != 0 {
+		client := NewTemplateUpgradeCampaignClient(tuccb.config)
+
+		tuccb.builders = make([]*TemplateUpgradeCampaignCreate, len(objs))
+		for i := range objs {
+			tuccb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		tuccb.objects = objs
+	}
+
+	return tuccb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := tuccb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: tuccb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: tuccb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the TemplateUpgradeCampaign entities,
+// which is always good for cascading create operations.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *TemplateUpgradeCampaign) error) ([]*TemplateUpgradeCampaign, error) {
+	objs, err := tuccb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := tuccb.getClientSet()
+
+	if x := tuccb.objects; x != nil {
+		for i := range x {
+			if _, set := tuccb.builders[i].mutation.Field(templateupgradecampaign.FieldProjectID); set {
+				objs[i].ProjectID = x[i].ProjectID
+			}
+			if _, set := tuccb.builders[i].mutation.Field(templateupgradecampaign.FieldTemplateVersionID); set {
+				objs[i].TemplateVersionID = x[i].TemplateVersionID
+			}
+			if _, set := tuccb.builders[i].mutation.Field(templateupgradecampaign.FieldTargetVersionID); set {
+				objs[i].TargetVersionID = x[i].TargetVersionID
+			}
+			if _, set := tuccb.builders[i].mutation.Field(templateupgradecampaign.FieldChangeComment); set {
+				objs[i].ChangeComment = x[i].ChangeComment
+			}
+			if _, set := tuccb.builders[i].mutation.Field(templateupgradecampaign.FieldSubjectID); set {
+				objs[i].SubjectID = x[i].SubjectID
+			}
+			if _, set := tuccb.builders[i].mutation.Field(templateupgradecampaign.FieldMessage); set {
+				objs[i].Message = x[i].Message
+			}
+			if _, set := tuccb.builders[i].mutation.Field(templateupgradecampaign.FieldResults); set {
+				objs[i].Results = x[i].Results
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *TemplateUpgradeCampaign) error) []*TemplateUpgradeCampaign {
+	objs, err := tuccb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *TemplateUpgradeCampaign) error) error {
+	_, err := tuccb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *TemplateUpgradeCampaign) error) {
+	if err := tuccb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *TemplateUpgradeCampaignUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *TemplateUpgradeCampaign) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for TemplateUpgradeCampaignUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *TemplateUpgradeCampaignUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *TemplateUpgradeCampaign) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *TemplateUpgradeCampaignUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *TemplateUpgradeCampaign) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the TemplateUpgradeCampaignUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for TemplateUpgradeCampaignUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *TemplateUpgradeCampaignUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *TemplateUpgradeCampaign) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TemplateUpgradeCampaign.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TemplateUpgradeCampaignUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (tucc *TemplateUpgradeCampaignCreate) OnConflict(opts ...sql.ConflictOption) *TemplateUpgradeCampaignUpsertOne {
+	tucc.conflict = opts
+	return &TemplateUpgradeCampaignUpsertOne{
+		create: tucc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TemplateUpgradeCampaign.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tucc *TemplateUpgradeCampaignCreate) OnConflictColumns(columns ...string) *TemplateUpgradeCampaignUpsertOne {
+	tucc.conflict = append(tucc.conflict, sql.ConflictColumns(columns...))
+	return &TemplateUpgradeCampaignUpsertOne{
+		create: tucc,
+	}
+}
+
+type (
+	// TemplateUpgradeCampaignUpsertOne is the builder for "upsert"-ing
+	//  one TemplateUpgradeCampaign node.
+	TemplateUpgradeCampaignUpsertOne struct {
+		create *TemplateUpgradeCampaignCreate
+	}
+
+	// TemplateUpgradeCampaignUpsert is the "OnConflict" setter.
+	TemplateUpgradeCampaignUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TemplateUpgradeCampaignUpsert) SetUpdateTime(v time.Time) *TemplateUpgradeCampaignUpsert {
+	u.Set(templateupgradecampaign.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsert) UpdateUpdateTime() *TemplateUpgradeCampaignUpsert {
+	u.SetExcluded(templateupgradecampaign.FieldUpdateTime)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TemplateUpgradeCampaignUpsert) SetStatus(v string) *TemplateUpgradeCampaignUpsert {
+	u.Set(templateupgradecampaign.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsert) UpdateStatus() *TemplateUpgradeCampaignUpsert {
+	u.SetExcluded(templateupgradecampaign.FieldStatus)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *TemplateUpgradeCampaignUpsert) SetMessage(v string) *TemplateUpgradeCampaignUpsert {
+	u.Set(templateupgradecampaign.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsert) UpdateMessage() *TemplateUpgradeCampaignUpsert {
+	u.SetExcluded(templateupgradecampaign.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *TemplateUpgradeCampaignUpsert) ClearMessage() *TemplateUpgradeCampaignUpsert {
+	u.SetNull(templateupgradecampaign.FieldMessage)
+	return u
+}
+
+// SetResults sets the "results" field.
+func (u *TemplateUpgradeCampaignUpsert) SetResults(v []types.TemplateUpgradeCampaignResult) *TemplateUpgradeCampaignUpsert {
+	u.Set(templateupgradecampaign.FieldResults, v)
+	return u
+}
+
+// UpdateResults sets the "results" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsert) UpdateResults() *TemplateUpgradeCampaignUpsert {
+	u.SetExcluded(templateupgradecampaign.FieldResults)
+	return u
+}
+
+// ClearResults clears the value of the "results" field.
+func (u *TemplateUpgradeCampaignUpsert) ClearResults() *TemplateUpgradeCampaignUpsert {
+	u.SetNull(templateupgradecampaign.FieldResults)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TemplateUpgradeCampaign.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(templateupgradecampaign.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TemplateUpgradeCampaignUpsertOne) UpdateNewValues() *TemplateUpgradeCampaignUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.TemplateVersionID(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldTemplateVersionID)
+		}
+		if _, exists := u.create.mutation.TargetVersionID(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldTargetVersionID)
+		}
+		if _, exists := u.create.mutation.Preview(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldPreview)
+		}
+		if _, exists := u.create.mutation.BatchSize(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldBatchSize)
+		}
+		if _, exists := u.create.mutation.ChangeComment(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldChangeComment)
+		}
+		if _, exists := u.create.mutation.SubjectID(); exists {
+			s.SetIgnore(templateupgradecampaign.FieldSubjectID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TemplateUpgradeCampaign.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TemplateUpgradeCampaignUpsertOne) Ignore() *TemplateUpgradeCampaignUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TemplateUpgradeCampaignUpsertOne) DoNothing() *TemplateUpgradeCampaignUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TemplateUpgradeCampaignCreate.OnConflict
+// documentation for more info.
+func (u *TemplateUpgradeCampaignUpsertOne) Update(set func(*TemplateUpgradeCampaignUpsert)) *TemplateUpgradeCampaignUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TemplateUpgradeCampaignUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TemplateUpgradeCampaignUpsertOne) SetUpdateTime(v time.Time) *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsertOne) UpdateUpdateTime() *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TemplateUpgradeCampaignUpsertOne) SetStatus(v string) *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsertOne) UpdateStatus() *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *TemplateUpgradeCampaignUpsertOne) SetMessage(v string) *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsertOne) UpdateMessage() *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *TemplateUpgradeCampaignUpsertOne) ClearMessage() *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetResults sets the "results" field.
+func (u *TemplateUpgradeCampaignUpsertOne) SetResults(v []types.TemplateUpgradeCampaignResult) *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.SetResults(v)
+	})
+}
+
+// UpdateResults sets the "results" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsertOne) UpdateResults() *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.UpdateResults()
+	})
+}
+
+// ClearResults clears the value of the "results" field.
+func (u *TemplateUpgradeCampaignUpsertOne) ClearResults() *TemplateUpgradeCampaignUpsertOne {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.ClearResults()
+	})
+}
+
+// Exec executes the query.
+func (u *TemplateUpgradeCampaignUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for TemplateUpgradeCampaignCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TemplateUpgradeCampaignUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TemplateUpgradeCampaignUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: TemplateUpgradeCampaignUpsertOne.ID is not supported by MySQL driver. Use TemplateUpgradeCampaignUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TemplateUpgradeCampaignUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TemplateUpgradeCampaignCreateBulk is the builder for creating many TemplateUpgradeCampaign entities in bulk.
+type TemplateUpgradeCampaignCreateBulk struct {
+	config
+	err        error
+	builders   []*TemplateUpgradeCampaignCreate
+	conflict   []sql.ConflictOption
+	objects    []*TemplateUpgradeCampaign
+	fromUpsert bool
+}
+
+// Save creates the TemplateUpgradeCampaign entities in the database.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) Save(ctx context.Context) ([]*TemplateUpgradeCampaign, error) {
+	if tuccb.err != nil {
+		return nil, tuccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tuccb.builders))
+	nodes := make([]*TemplateUpgradeCampaign, len(tuccb.builders))
+	mutators := make([]Mutator, len(tuccb.builders))
+	for i := range tuccb.builders {
+		func(i int, root context.Context) {
+			builder := tuccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TemplateUpgradeCampaignMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tuccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tuccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tuccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tuccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) SaveX(ctx context.Context) []*TemplateUpgradeCampaign {
+	v, err := tuccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) Exec(ctx context.Context) error {
+	_, err := tuccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuccb *TemplateUpgradeCampaignCreateBulk) ExecX(ctx context.Context) {
+	if err := tuccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TemplateUpgradeCampaign.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TemplateUpgradeCampaignUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (tuccb *TemplateUpgradeCampaignCreateBulk) OnConflict(opts ...sql.ConflictOption) *TemplateUpgradeCampaignUpsertBulk {
+	tuccb.conflict = opts
+	return &TemplateUpgradeCampaignUpsertBulk{
+		create: tuccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TemplateUpgradeCampaign.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tuccb *TemplateUpgradeCampaignCreateBulk) OnConflictColumns(columns ...string) *TemplateUpgradeCampaignUpsertBulk {
+	tuccb.conflict = append(tuccb.conflict, sql.ConflictColumns(columns...))
+	return &TemplateUpgradeCampaignUpsertBulk{
+		create: tuccb,
+	}
+}
+
+// TemplateUpgradeCampaignUpsertBulk is the builder for "upsert"-ing
+// a bulk of TemplateUpgradeCampaign nodes.
+type TemplateUpgradeCampaignUpsertBulk struct {
+	create *TemplateUpgradeCampaignCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TemplateUpgradeCampaign.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(templateupgradecampaign.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TemplateUpgradeCampaignUpsertBulk) UpdateNewValues() *TemplateUpgradeCampaignUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldCreateTime)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldProjectID)
+			}
+			if _, exists := b.mutation.TemplateVersionID(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldTemplateVersionID)
+			}
+			if _, exists := b.mutation.TargetVersionID(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldTargetVersionID)
+			}
+			if _, exists := b.mutation.Preview(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldPreview)
+			}
+			if _, exists := b.mutation.BatchSize(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldBatchSize)
+			}
+			if _, exists := b.mutation.ChangeComment(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldChangeComment)
+			}
+			if _, exists := b.mutation.SubjectID(); exists {
+				s.SetIgnore(templateupgradecampaign.FieldSubjectID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TemplateUpgradeCampaign.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TemplateUpgradeCampaignUpsertBulk) Ignore() *TemplateUpgradeCampaignUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TemplateUpgradeCampaignUpsertBulk) DoNothing() *TemplateUpgradeCampaignUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TemplateUpgradeCampaignCreateBulk.OnConflict
+// documentation for more info.
+func (u *TemplateUpgradeCampaignUpsertBulk) Update(set func(*TemplateUpgradeCampaignUpsert)) *TemplateUpgradeCampaignUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TemplateUpgradeCampaignUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TemplateUpgradeCampaignUpsertBulk) SetUpdateTime(v time.Time) *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsertBulk) UpdateUpdateTime() *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TemplateUpgradeCampaignUpsertBulk) SetStatus(v string) *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsertBulk) UpdateStatus() *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *TemplateUpgradeCampaignUpsertBulk) SetMessage(v string) *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsertBulk) UpdateMessage() *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *TemplateUpgradeCampaignUpsertBulk) ClearMessage() *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// SetResults sets the "results" field.
+func (u *TemplateUpgradeCampaignUpsertBulk) SetResults(v []types.TemplateUpgradeCampaignResult) *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.SetResults(v)
+	})
+}
+
+// UpdateResults sets the "results" field to the value that was provided on create.
+func (u *TemplateUpgradeCampaignUpsertBulk) UpdateResults() *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.UpdateResults()
+	})
+}
+
+// ClearResults clears the value of the "results" field.
+func (u *TemplateUpgradeCampaignUpsertBulk) ClearResults() *TemplateUpgradeCampaignUpsertBulk {
+	return u.Update(func(s *TemplateUpgradeCampaignUpsert) {
+		s.ClearResults()
+	})
+}
+
+// Exec executes the query.
+func (u *TemplateUpgradeCampaignUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the TemplateUpgradeCampaignCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for TemplateUpgradeCampaignCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TemplateUpgradeCampaignUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+)
+
+// TemplateUpgradeCampaignDelete is the builder for deleting a TemplateUpgradeCampaign entity.
+type TemplateUpgradeCampaignDelete struct {
+	config
+	hooks    []Hook
+	mutation *TemplateUpgradeCampaignMutation
+}
+
+// Where appends a list predicates to the TemplateUpgradeCampaignDelete builder.
+func (tucd *TemplateUpgradeCampaignDelete) Where(ps ...predicate.TemplateUpgradeCampaign) *TemplateUpgradeCampaignDelete {
+	tucd.mutation.Where(ps...)
+	return tucd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tucd *TemplateUpgradeCampaignDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tucd.sqlExec, tucd.mutation, tucd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tucd *TemplateUpgradeCampaignDelete) ExecX(ctx context.Context) int {
+	n, err := tucd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tucd *TemplateUpgradeCampaignDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(templateupgradecampaign.Table, sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString))
+	_spec.Node.Schema = tucd.schemaConfig.TemplateUpgradeCampaign
+	ctx = internal.NewSchemaConfigContext(ctx, tucd.schemaConfig)
+	if ps := tucd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tucd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tucd.mutation.done = true
+	return affected, err
+}
+
+// TemplateUpgradeCampaignDeleteOne is the builder for deleting a single TemplateUpgradeCampaign entity.
+type TemplateUpgradeCampaignDeleteOne struct {
+	tucd *TemplateUpgradeCampaignDelete
+}
+
+// Where appends a list predicates to the TemplateUpgradeCampaignDelete builder.
+func (tucdo *TemplateUpgradeCampaignDeleteOne) Where(ps ...predicate.TemplateUpgradeCampaign) *TemplateUpgradeCampaignDeleteOne {
+	tucdo.tucd.mutation.Where(ps...)
+	return tucdo
+}
+
+// Exec executes the deletion query.
+func (tucdo *TemplateUpgradeCampaignDeleteOne) Exec(ctx context.Context) error {
+	n, err := tucdo.tucd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{templateupgradecampaign.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tucdo *TemplateUpgradeCampaignDeleteOne) ExecX(ctx context.Context) {
+	if err := tucdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,758 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// TemplateUpgradeCampaignQuery is the builder for querying TemplateUpgradeCampaign entities.
+type TemplateUpgradeCampaignQuery struct {
+	config
+	ctx                 *QueryContext
+	order               []templateupgradecampaign.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.TemplateUpgradeCampaign
+	withProject         *ProjectQuery
+	withTemplateVersion *TemplateVersionQuery
+	modifiers           []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TemplateUpgradeCampaignQuery builder.
+func (tucq *TemplateUpgradeCampaignQuery) Where(ps ...predicate.TemplateUpgradeCampaign) *TemplateUpgradeCampaignQuery {
+	tucq.predicates = append(tucq.predicates, ps...)
+	return tucq
+}
+
+// Limit the number of records to be returned by this query.
+func (tucq *TemplateUpgradeCampaignQuery) Limit(limit int) *TemplateUpgradeCampaignQuery {
+	tucq.ctx.Limit = &limit
+	return tucq
+}
+
+// Offset to start from.
+func (tucq *TemplateUpgradeCampaignQuery) Offset(offset int) *TemplateUpgradeCampaignQuery {
+	tucq.ctx.Offset = &offset
+	return tucq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tucq *TemplateUpgradeCampaignQuery) Unique(unique bool) *TemplateUpgradeCampaignQuery {
+	tucq.ctx.Unique = &unique
+	return tucq
+}
+
+// Order specifies how the records should be ordered.
+func (tucq *TemplateUpgradeCampaignQuery) Order(o ...templateupgradecampaign.OrderOption) *TemplateUpgradeCampaignQuery {
+	tucq.order = append(tucq.order, o...)
+	return tucq
+}
+
+// QueryProject chains the current query on the "project" edge.
+func (tucq *TemplateUpgradeCampaignQuery) QueryProject() *ProjectQuery {
+	query := (&ProjectClient{config: tucq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tucq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tucq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(templateupgradecampaign.Table, templateupgradecampaign.FieldID, selector),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, templateupgradecampaign.ProjectTable, templateupgradecampaign.ProjectColumn),
+		)
+		schemaConfig := tucq.schemaConfig
+		step.To.Schema = schemaConfig.Project
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		fromU = sqlgraph.SetNeighbors(tucq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTemplateVersion chains the current query on the "template_version" edge.
+func (tucq *TemplateUpgradeCampaignQuery) QueryTemplateVersion() *TemplateVersionQuery {
+	query := (&TemplateVersionClient{config: tucq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tucq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tucq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(templateupgradecampaign.Table, templateupgradecampaign.FieldID, selector),
+			sqlgraph.To(templateversion.Table, templateversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, templateupgradecampaign.TemplateVersionTable, templateupgradecampaign.TemplateVersionColumn),
+		)
+		schemaConfig := tucq.schemaConfig
+		step.To.Schema = schemaConfig.TemplateVersion
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		fromU = sqlgraph.SetNeighbors(tucq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TemplateUpgradeCampaign entity from the query.
+// Returns a *NotFoundError when no TemplateUpgradeCampaign was found.
+func (tucq *TemplateUpgradeCampaignQuery) First(ctx context.Context) (*TemplateUpgradeCampaign, error) {
+	nodes, err := tucq.Limit(1).All(setContextOp(ctx, tucq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{templateupgradecampaign.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tucq *TemplateUpgradeCampaignQuery) FirstX(ctx context.Context) *TemplateUpgradeCampaign {
+	node, err := tucq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TemplateUpgradeCampaign ID from the query.
+// Returns a *NotFoundError when no TemplateUpgradeCampaign ID was found.
+func (tucq *TemplateUpgradeCampaignQuery) FirstID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = tucq.Limit(1).IDs(setContextOp(ctx, tucq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{templateupgradecampaign.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tucq *TemplateUpgradeCampaignQuery) FirstIDX(ctx context.Context) object.ID {
+	id, err := tucq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TemplateUpgradeCampaign entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TemplateUpgradeCampaign entity is found.
+// Returns a *NotFoundError when no TemplateUpgradeCampaign entities are found.
+func (tucq *TemplateUpgradeCampaignQuery) Only(ctx context.Context) (*TemplateUpgradeCampaign, error) {
+	nodes, err := tucq.Limit(2).All(setContextOp(ctx, tucq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{templateupgradecampaign.Label}
+	default:
+		return nil, &NotSingularError{templateupgradecampaign.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tucq *TemplateUpgradeCampaignQuery) OnlyX(ctx context.Context) *TemplateUpgradeCampaign {
+	node, err := tucq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TemplateUpgradeCampaign ID in the query.
+// Returns a *NotSingularError when more than one TemplateUpgradeCampaign ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tucq *TemplateUpgradeCampaignQuery) OnlyID(ctx context.Context) (id object.ID, err error) {
+	var ids []object.ID
+	if ids, err = tucq.Limit(2).IDs(setContextOp(ctx, tucq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{templateupgradecampaign.Label}
+	default:
+		err = &NotSingularError{templateupgradecampaign.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tucq *TemplateUpgradeCampaignQuery) OnlyIDX(ctx context.Context) object.ID {
+	id, err := tucq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TemplateUpgradeCampaigns.
+func (tucq *TemplateUpgradeCampaignQuery) All(ctx context.Context) ([]*TemplateUpgradeCampaign, error) {
+	ctx = setContextOp(ctx, tucq.ctx, "All")
+	if err := tucq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TemplateUpgradeCampaign, *TemplateUpgradeCampaignQuery]()
+	return withInterceptors[[]*TemplateUpgradeCampaign](ctx, tucq, qr, tucq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tucq *TemplateUpgradeCampaignQuery) AllX(ctx context.Context) []*TemplateUpgradeCampaign {
+	nodes, err := tucq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TemplateUpgradeCampaign IDs.
+func (tucq *TemplateUpgradeCampaignQuery) IDs(ctx context.Context) (ids []object.ID, err error) {
+	if tucq.ctx.Unique == nil && tucq.path != nil {
+		tucq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tucq.ctx, "IDs")
+	if err = tucq.Select(templateupgradecampaign.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tucq *TemplateUpgradeCampaignQuery) IDsX(ctx context.Context) []object.ID {
+	ids, err := tucq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tucq *TemplateUpgradeCampaignQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tucq.ctx, "Count")
+	if err := tucq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tucq, querierCount[*TemplateUpgradeCampaignQuery](), tucq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tucq *TemplateUpgradeCampaignQuery) CountX(ctx context.Context) int {
+	count, err := tucq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tucq *TemplateUpgradeCampaignQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tucq.ctx, "Exist")
+	switch _, err := tucq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("model: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tucq *TemplateUpgradeCampaignQuery) ExistX(ctx context.Context) bool {
+	exist, err := tucq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TemplateUpgradeCampaignQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tucq *TemplateUpgradeCampaignQuery) Clone() *TemplateUpgradeCampaignQuery {
+	if tucq == nil {
+		return nil
+	}
+	return &TemplateUpgradeCampaignQuery{
+		config:              tucq.config,
+		ctx:                 tucq.ctx.Clone(),
+		order:               append([]templateupgradecampaign.OrderOption{}, tucq.order...),
+		inters:              append([]Interceptor{}, tucq.inters...),
+		predicates:          append([]predicate.TemplateUpgradeCampaign{}, tucq.predicates...),
+		withProject:         tucq.withProject.Clone(),
+		withTemplateVersion: tucq.withTemplateVersion.Clone(),
+		// clone intermediate query.
+		sql:  tucq.sql.Clone(),
+		path: tucq.path,
+	}
+}
+
+// WithProject tells the query-builder to eager-load the nodes that are connected to
+// the "project" edge. The optional arguments are used to configure the query builder of the edge.
+func (tucq *TemplateUpgradeCampaignQuery) WithProject(opts ...func(*ProjectQuery)) *TemplateUpgradeCampaignQuery {
+	query := (&ProjectClient{config: tucq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tucq.withProject = query
+	return tucq
+}
+
+// WithTemplateVersion tells the query-builder to eager-load the nodes that are connected to
+// the "template_version" edge. The optional arguments are used to configure the query builder of the edge.
+func (tucq *TemplateUpgradeCampaignQuery) WithTemplateVersion(opts ...func(*TemplateVersionQuery)) *TemplateUpgradeCampaignQuery {
+	query := (&TemplateVersionClient{config: tucq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tucq.withTemplateVersion = query
+	return tucq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TemplateUpgradeCampaign.Query().
+//		GroupBy(templateupgradecampaign.FieldCreateTime).
+//		Aggregate(model.Count()).
+//		Scan(ctx, &v)
+func (tucq *TemplateUpgradeCampaignQuery) GroupBy(field string, fields ...string) *TemplateUpgradeCampaignGroupBy {
+	tucq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TemplateUpgradeCampaignGroupBy{build: tucq}
+	grbuild.flds = &tucq.ctx.Fields
+	grbuild.label = templateupgradecampaign.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.TemplateUpgradeCampaign.Query().
+//		Select(templateupgradecampaign.FieldCreateTime).
+//		Scan(ctx, &v)
+func (tucq *TemplateUpgradeCampaignQuery) Select(fields ...string) *TemplateUpgradeCampaignSelect {
+	tucq.ctx.Fields = append(tucq.ctx.Fields, fields...)
+	sbuild := &TemplateUpgradeCampaignSelect{TemplateUpgradeCampaignQuery: tucq}
+	sbuild.label = templateupgradecampaign.Label
+	sbuild.flds, sbuild.scan = &tucq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TemplateUpgradeCampaignSelect configured with the given aggregations.
+func (tucq *TemplateUpgradeCampaignQuery) Aggregate(fns ...AggregateFunc) *TemplateUpgradeCampaignSelect {
+	return tucq.Select().Aggregate(fns...)
+}
+
+func (tucq *TemplateUpgradeCampaignQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tucq.inters {
+		if inter == nil {
+			return fmt.Errorf("model: uninitialized interceptor (forgotten import model/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tucq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tucq.ctx.Fields {
+		if !templateupgradecampaign.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+		}
+	}
+	if tucq.path != nil {
+		prev, err := tucq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tucq.sql = prev
+	}
+	return nil
+}
+
+func (tucq *TemplateUpgradeCampaignQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TemplateUpgradeCampaign, error) {
+	var (
+		nodes       = []*TemplateUpgradeCampaign{}
+		_spec       = tucq.querySpec()
+		loadedTypes = [2]bool{
+			tucq.withProject != nil,
+			tucq.withTemplateVersion != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TemplateUpgradeCampaign).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TemplateUpgradeCampaign{config: tucq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	_spec.Node.Schema = tucq.schemaConfig.TemplateUpgradeCampaign
+	ctx = internal.NewSchemaConfigContext(ctx, tucq.schemaConfig)
+	if len(tucq.modifiers) > 0 {
+		_spec.Modifiers = tucq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tucq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tucq.withProject; query != nil {
+		if err := tucq.loadProject(ctx, query, nodes, nil,
+			func(n *TemplateUpgradeCampaign, e *Project) { n.Edges.Project = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tucq.withTemplateVersion; query != nil {
+		if err := tucq.loadTemplateVersion(ctx, query, nodes, nil,
+			func(n *TemplateUpgradeCampaign, e *TemplateVersion) { n.Edges.TemplateVersion = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tucq *TemplateUpgradeCampaignQuery) loadProject(ctx context.Context, query *ProjectQuery, nodes []*TemplateUpgradeCampaign, init func(*TemplateUpgradeCampaign), assign func(*TemplateUpgradeCampaign, *Project)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*TemplateUpgradeCampaign)
+	for i := range nodes {
+		fk := nodes[i].ProjectID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(project.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "project_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tucq *TemplateUpgradeCampaignQuery) loadTemplateVersion(ctx context.Context, query *TemplateVersionQuery, nodes []*TemplateUpgradeCampaign, init func(*TemplateUpgradeCampaign), assign func(*TemplateUpgradeCampaign, *TemplateVersion)) error {
+	ids := make([]object.ID, 0, len(nodes))
+	nodeids := make(map[object.ID][]*TemplateUpgradeCampaign)
+	for i := range nodes {
+		fk := nodes[i].TemplateVersionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(templateversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "template_version_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tucq *TemplateUpgradeCampaignQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tucq.querySpec()
+	_spec.Node.Schema = tucq.schemaConfig.TemplateUpgradeCampaign
+	ctx = internal.NewSchemaConfigContext(ctx, tucq.schemaConfig)
+	if len(tucq.modifiers) > 0 {
+		_spec.Modifiers = tucq.modifiers
+	}
+	_spec.Node.Columns = tucq.ctx.Fields
+	if len(tucq.ctx.Fields) > 0 {
+		_spec.Unique = tucq.ctx.Unique != nil && *tucq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tucq.driver, _spec)
+}
+
+func (tucq *TemplateUpgradeCampaignQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(templateupgradecampaign.Table, templateupgradecampaign.Columns, sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString))
+	_spec.From = tucq.sql
+	if unique := tucq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tucq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tucq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, templateupgradecampaign.FieldID)
+		for i := range fields {
+			if fields[i] != templateupgradecampaign.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tucq.withProject != nil {
+			_spec.Node.AddColumnOnce(templateupgradecampaign.FieldProjectID)
+		}
+		if tucq.withTemplateVersion != nil {
+			_spec.Node.AddColumnOnce(templateupgradecampaign.FieldTemplateVersionID)
+		}
+	}
+	if ps := tucq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tucq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tucq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tucq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tucq *TemplateUpgradeCampaignQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tucq.driver.Dialect())
+	t1 := builder.Table(templateupgradecampaign.Table)
+	columns := tucq.ctx.Fields
+	if len(columns) == 0 {
+		columns = templateupgradecampaign.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tucq.sql != nil {
+		selector = tucq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tucq.ctx.Unique != nil && *tucq.ctx.Unique {
+		selector.Distinct()
+	}
+	t1.Schema(tucq.schemaConfig.TemplateUpgradeCampaign)
+	ctx = internal.NewSchemaConfigContext(ctx, tucq.schemaConfig)
+	selector.WithContext(ctx)
+	for _, m := range tucq.modifiers {
+		m(selector)
+	}
+	for _, p := range tucq.predicates {
+		p(selector)
+	}
+	for _, p := range tucq.order {
+		p(selector)
+	}
+	if offset := tucq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tucq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tucq *TemplateUpgradeCampaignQuery) ForUpdate(opts ...sql.LockOption) *TemplateUpgradeCampaignQuery {
+	if tucq.driver.Dialect() == dialect.Postgres {
+		tucq.Unique(false)
+	}
+	tucq.modifiers = append(tucq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tucq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tucq *TemplateUpgradeCampaignQuery) ForShare(opts ...sql.LockOption) *TemplateUpgradeCampaignQuery {
+	if tucq.driver.Dialect() == dialect.Postgres {
+		tucq.Unique(false)
+	}
+	tucq.modifiers = append(tucq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tucq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tucq *TemplateUpgradeCampaignQuery) Modify(modifiers ...func(s *sql.Selector)) *TemplateUpgradeCampaignSelect {
+	tucq.modifiers = append(tucq.modifiers, modifiers...)
+	return tucq.Select()
+}
+
+// WhereP appends storage-level predicates to the TemplateUpgradeCampaignQuery builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (tucq *TemplateUpgradeCampaignQuery) WhereP(ps ...func(*sql.Selector)) {
+	var wps = make([]predicate.TemplateUpgradeCampaign, 0, len(ps))
+	for i := 0; i < len(ps); i++ {
+		wps = append(wps, predicate.TemplateUpgradeCampaign(ps[i]))
+	}
+	tucq.predicates = append(tucq.predicates, wps...)
+}
+
+// TemplateUpgradeCampaignGroupBy is the group-by builder for TemplateUpgradeCampaign entities.
+type TemplateUpgradeCampaignGroupBy struct {
+	selector
+	build *TemplateUpgradeCampaignQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tucgb *TemplateUpgradeCampaignGroupBy) Aggregate(fns ...AggregateFunc) *TemplateUpgradeCampaignGroupBy {
+	tucgb.fns = append(tucgb.fns, fns...)
+	return tucgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tucgb *TemplateUpgradeCampaignGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tucgb.build.ctx, "GroupBy")
+	if err := tucgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TemplateUpgradeCampaignQuery, *TemplateUpgradeCampaignGroupBy](ctx, tucgb.build, tucgb, tucgb.build.inters, v)
+}
+
+func (tucgb *TemplateUpgradeCampaignGroupBy) sqlScan(ctx context.Context, root *TemplateUpgradeCampaignQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tucgb.fns))
+	for _, fn := range tucgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tucgb.flds)+len(tucgb.fns))
+		for _, f := range *tucgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tucgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tucgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TemplateUpgradeCampaignSelect is the builder for selecting fields of TemplateUpgradeCampaign entities.
+type TemplateUpgradeCampaignSelect struct {
+	*TemplateUpgradeCampaignQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tucs *TemplateUpgradeCampaignSelect) Aggregate(fns ...AggregateFunc) *TemplateUpgradeCampaignSelect {
+	tucs.fns = append(tucs.fns, fns...)
+	return tucs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tucs *TemplateUpgradeCampaignSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tucs.ctx, "Select")
+	if err := tucs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TemplateUpgradeCampaignQuery, *TemplateUpgradeCampaignSelect](ctx, tucs.TemplateUpgradeCampaignQuery, tucs, tucs.inters, v)
+}
+
+func (tucs *TemplateUpgradeCampaignSelect) sqlScan(ctx context.Context, root *TemplateUpgradeCampaignQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tucs.fns))
+	for _, fn := range tucs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tucs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tucs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tucs *TemplateUpgradeCampaignSelect) Modify(modifiers ...func(s *sql.Selector)) *TemplateUpgradeCampaignSelect {
+	tucs.modifiers = append(tucs.modifiers, modifiers...)
+	return tucs
+}
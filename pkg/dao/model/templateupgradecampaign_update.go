@@ -0,0 +1,636 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+// TemplateUpgradeCampaignUpdate is the builder for updating TemplateUpgradeCampaign entities.
+type TemplateUpgradeCampaignUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TemplateUpgradeCampaignMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *TemplateUpgradeCampaign
+}
+
+// Where appends a list predicates to the TemplateUpgradeCampaignUpdate builder.
+func (tucu *TemplateUpgradeCampaignUpdate) Where(ps ...predicate.TemplateUpgradeCampaign) *TemplateUpgradeCampaignUpdate {
+	tucu.mutation.Where(ps...)
+	return tucu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tucu *TemplateUpgradeCampaignUpdate) SetUpdateTime(t time.Time) *TemplateUpgradeCampaignUpdate {
+	tucu.mutation.SetUpdateTime(t)
+	return tucu
+}
+
+// SetStatus sets the "status" field.
+func (tucu *TemplateUpgradeCampaignUpdate) SetStatus(s string) *TemplateUpgradeCampaignUpdate {
+	tucu.mutation.SetStatus(s)
+	return tucu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tucu *TemplateUpgradeCampaignUpdate) SetNillableStatus(s *string) *TemplateUpgradeCampaignUpdate {
+	if s != nil {
+		tucu.SetStatus(*s)
+	}
+	return tucu
+}
+
+// SetMessage sets the "message" field.
+func (tucu *TemplateUpgradeCampaignUpdate) SetMessage(s string) *TemplateUpgradeCampaignUpdate {
+	tucu.mutation.SetMessage(s)
+	return tucu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (tucu *TemplateUpgradeCampaignUpdate) SetNillableMessage(s *string) *TemplateUpgradeCampaignUpdate {
+	if s != nil {
+		tucu.SetMessage(*s)
+	}
+	return tucu
+}
+
+// ClearMessage clears the value of the "message" field.
+func (tucu *TemplateUpgradeCampaignUpdate) ClearMessage() *TemplateUpgradeCampaignUpdate {
+	tucu.mutation.ClearMessage()
+	return tucu
+}
+
+// SetResults sets the "results" field.
+func (tucu *TemplateUpgradeCampaignUpdate) SetResults(tucr []types.TemplateUpgradeCampaignResult) *TemplateUpgradeCampaignUpdate {
+	tucu.mutation.SetResults(tucr)
+	return tucu
+}
+
+// AppendResults appends tucr to the "results" field.
+func (tucu *TemplateUpgradeCampaignUpdate) AppendResults(tucr []types.TemplateUpgradeCampaignResult) *TemplateUpgradeCampaignUpdate {
+	tucu.mutation.AppendResults(tucr)
+	return tucu
+}
+
+// ClearResults clears the value of the "results" field.
+func (tucu *TemplateUpgradeCampaignUpdate) ClearResults() *TemplateUpgradeCampaignUpdate {
+	tucu.mutation.ClearResults()
+	return tucu
+}
+
+// Mutation returns the TemplateUpgradeCampaignMutation object of the builder.
+func (tucu *TemplateUpgradeCampaignUpdate) Mutation() *TemplateUpgradeCampaignMutation {
+	return tucu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tucu *TemplateUpgradeCampaignUpdate) Save(ctx context.Context) (int, error) {
+	if err := tucu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, tucu.sqlSave, tucu.mutation, tucu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tucu *TemplateUpgradeCampaignUpdate) SaveX(ctx context.Context) int {
+	affected, err := tucu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tucu *TemplateUpgradeCampaignUpdate) Exec(ctx context.Context) error {
+	_, err := tucu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tucu *TemplateUpgradeCampaignUpdate) ExecX(ctx context.Context) {
+	if err := tucu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tucu *TemplateUpgradeCampaignUpdate) defaults() error {
+	if _, ok := tucu.mutation.UpdateTime(); !ok {
+		if templateupgradecampaign.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized templateupgradecampaign.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := templateupgradecampaign.UpdateDefaultUpdateTime()
+		tucu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tucu *TemplateUpgradeCampaignUpdate) check() error {
+	if _, ok := tucu.mutation.TemplateVersionID(); tucu.mutation.TemplateVersionCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "TemplateUpgradeCampaign.template_version"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (tucu *TemplateUpgradeCampaignUpdate) Set(obj *TemplateUpgradeCampaign) *TemplateUpgradeCampaignUpdate {
+	// Without Default.
+	tucu.SetStatus(obj.Status)
+	if obj.Message != "" {
+		tucu.SetMessage(obj.Message)
+	}
+	if !reflect.ValueOf(obj.Results).IsZero() {
+		tucu.SetResults(obj.Results)
+	}
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		tucu.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	tucu.object = obj
+
+	return tucu
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tucu *TemplateUpgradeCampaignUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TemplateUpgradeCampaignUpdate {
+	tucu.modifiers = append(tucu.modifiers, modifiers...)
+	return tucu
+}
+
+func (tucu *TemplateUpgradeCampaignUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tucu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templateupgradecampaign.Table, templateupgradecampaign.Columns, sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString))
+	if ps := tucu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tucu.mutation.UpdateTime(); ok {
+		_spec.SetField(templateupgradecampaign.FieldUpdateTime, field.TypeTime, value)
+	}
+	if tucu.mutation.ChangeCommentCleared() {
+		_spec.ClearField(templateupgradecampaign.FieldChangeComment, field.TypeString)
+	}
+	if tucu.mutation.SubjectIDCleared() {
+		_spec.ClearField(templateupgradecampaign.FieldSubjectID, field.TypeString)
+	}
+	if value, ok := tucu.mutation.Status(); ok {
+		_spec.SetField(templateupgradecampaign.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tucu.mutation.Message(); ok {
+		_spec.SetField(templateupgradecampaign.FieldMessage, field.TypeString, value)
+	}
+	if tucu.mutation.MessageCleared() {
+		_spec.ClearField(templateupgradecampaign.FieldMessage, field.TypeString)
+	}
+	if value, ok := tucu.mutation.Results(); ok {
+		_spec.SetField(templateupgradecampaign.FieldResults, field.TypeJSON, value)
+	}
+	if value, ok := tucu.mutation.AppendedResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, templateupgradecampaign.FieldResults, value)
+		})
+	}
+	if tucu.mutation.ResultsCleared() {
+		_spec.ClearField(templateupgradecampaign.FieldResults, field.TypeJSON)
+	}
+	_spec.Node.Schema = tucu.schemaConfig.TemplateUpgradeCampaign
+	ctx = internal.NewSchemaConfigContext(ctx, tucu.schemaConfig)
+	_spec.AddModifiers(tucu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tucu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templateupgradecampaign.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tucu.mutation.done = true
+	return n, nil
+}
+
+// TemplateUpgradeCampaignUpdateOne is the builder for updating a single TemplateUpgradeCampaign entity.
+type TemplateUpgradeCampaignUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TemplateUpgradeCampaignMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *TemplateUpgradeCampaign
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SetUpdateTime(t time.Time) *TemplateUpgradeCampaignUpdateOne {
+	tucuo.mutation.SetUpdateTime(t)
+	return tucuo
+}
+
+// SetStatus sets the "status" field.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SetStatus(s string) *TemplateUpgradeCampaignUpdateOne {
+	tucuo.mutation.SetStatus(s)
+	return tucuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SetNillableStatus(s *string) *TemplateUpgradeCampaignUpdateOne {
+	if s != nil {
+		tucuo.SetStatus(*s)
+	}
+	return tucuo
+}
+
+// SetMessage sets the "message" field.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SetMessage(s string) *TemplateUpgradeCampaignUpdateOne {
+	tucuo.mutation.SetMessage(s)
+	return tucuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SetNillableMessage(s *string) *TemplateUpgradeCampaignUpdateOne {
+	if s != nil {
+		tucuo.SetMessage(*s)
+	}
+	return tucuo
+}
+
+// ClearMessage clears the value of the "message" field.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) ClearMessage() *TemplateUpgradeCampaignUpdateOne {
+	tucuo.mutation.ClearMessage()
+	return tucuo
+}
+
+// SetResults sets the "results" field.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SetResults(tucr []types.TemplateUpgradeCampaignResult) *TemplateUpgradeCampaignUpdateOne {
+	tucuo.mutation.SetResults(tucr)
+	return tucuo
+}
+
+// AppendResults appends tucr to the "results" field.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) AppendResults(tucr []types.TemplateUpgradeCampaignResult) *TemplateUpgradeCampaignUpdateOne {
+	tucuo.mutation.AppendResults(tucr)
+	return tucuo
+}
+
+// ClearResults clears the value of the "results" field.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) ClearResults() *TemplateUpgradeCampaignUpdateOne {
+	tucuo.mutation.ClearResults()
+	return tucuo
+}
+
+// Mutation returns the TemplateUpgradeCampaignMutation object of the builder.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) Mutation() *TemplateUpgradeCampaignMutation {
+	return tucuo.mutation
+}
+
+// Where appends a list predicates to the TemplateUpgradeCampaignUpdate builder.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) Where(ps ...predicate.TemplateUpgradeCampaign) *TemplateUpgradeCampaignUpdateOne {
+	tucuo.mutation.Where(ps...)
+	return tucuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) Select(field string, fields ...string) *TemplateUpgradeCampaignUpdateOne {
+	tucuo.fields = append([]string{field}, fields...)
+	return tucuo
+}
+
+// Save executes the query and returns the updated TemplateUpgradeCampaign entity.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) Save(ctx context.Context) (*TemplateUpgradeCampaign, error) {
+	if err := tucuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, tucuo.sqlSave, tucuo.mutation, tucuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SaveX(ctx context.Context) *TemplateUpgradeCampaign {
+	node, err := tucuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) Exec(ctx context.Context) error {
+	_, err := tucuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) ExecX(ctx context.Context) {
+	if err := tucuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) defaults() error {
+	if _, ok := tucuo.mutation.UpdateTime(); !ok {
+		if templateupgradecampaign.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized templateupgradecampaign.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := templateupgradecampaign.UpdateDefaultUpdateTime()
+		tucuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) check() error {
+	if _, ok := tucuo.mutation.TemplateVersionID(); tucuo.mutation.TemplateVersionCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "TemplateUpgradeCampaign.template_version"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (tucuo *TemplateUpgradeCampaignUpdateOne) Set(obj *TemplateUpgradeCampaign) *TemplateUpgradeCampaignUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*TemplateUpgradeCampaignMutation)
+			db, err := mt.Client().TemplateUpgradeCampaign.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting TemplateUpgradeCampaign with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if db.Status != obj.Status {
+				tucuo.SetStatus(obj.Status)
+			}
+			if obj.Message != "" {
+				if db.Message != obj.Message {
+					tucuo.SetMessage(obj.Message)
+				}
+			}
+			if !reflect.ValueOf(obj.Results).IsZero() {
+				if !reflect.DeepEqual(db.Results, obj.Results) {
+					tucuo.SetResults(obj.Results)
+				}
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				tucuo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			tucuo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	tucuo.hooks = append(tucuo.hooks, h)
+
+	return tucuo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := tucuo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: tucuo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: tucuo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the TemplateUpgradeCampaign entity,
+// which is always good for cascading update operations.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *TemplateUpgradeCampaign) error) (*TemplateUpgradeCampaign, error) {
+	obj, err := tucuo.Save(ctx)
+	if err != nil &&
+		(tucuo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := tucuo.getClientSet()
+
+	if obj == nil {
+		obj = tucuo.object
+	} else if x := tucuo.object; x != nil {
+		if _, set := tucuo.mutation.Field(templateupgradecampaign.FieldStatus); set {
+			obj.Status = x.Status
+		}
+		if _, set := tucuo.mutation.Field(templateupgradecampaign.FieldMessage); set {
+			obj.Message = x.Message
+		}
+		if _, set := tucuo.mutation.Field(templateupgradecampaign.FieldResults); set {
+			obj.Results = x.Results
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *TemplateUpgradeCampaign) error) *TemplateUpgradeCampaign {
+	obj, err := tucuo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *TemplateUpgradeCampaign) error) error {
+	_, err := tucuo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *TemplateUpgradeCampaign) error) {
+	if err := tucuo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tucuo *TemplateUpgradeCampaignUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TemplateUpgradeCampaignUpdateOne {
+	tucuo.modifiers = append(tucuo.modifiers, modifiers...)
+	return tucuo
+}
+
+func (tucuo *TemplateUpgradeCampaignUpdateOne) sqlSave(ctx context.Context) (_node *TemplateUpgradeCampaign, err error) {
+	if err := tucuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(templateupgradecampaign.Table, templateupgradecampaign.Columns, sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString))
+	id, ok := tucuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "TemplateUpgradeCampaign.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tucuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, templateupgradecampaign.FieldID)
+		for _, f := range fields {
+			if !templateupgradecampaign.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != templateupgradecampaign.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tucuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tucuo.mutation.UpdateTime(); ok {
+		_spec.SetField(templateupgradecampaign.FieldUpdateTime, field.TypeTime, value)
+	}
+	if tucuo.mutation.ChangeCommentCleared() {
+		_spec.ClearField(templateupgradecampaign.FieldChangeComment, field.TypeString)
+	}
+	if tucuo.mutation.SubjectIDCleared() {
+		_spec.ClearField(templateupgradecampaign.FieldSubjectID, field.TypeString)
+	}
+	if value, ok := tucuo.mutation.Status(); ok {
+		_spec.SetField(templateupgradecampaign.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := tucuo.mutation.Message(); ok {
+		_spec.SetField(templateupgradecampaign.FieldMessage, field.TypeString, value)
+	}
+	if tucuo.mutation.MessageCleared() {
+		_spec.ClearField(templateupgradecampaign.FieldMessage, field.TypeString)
+	}
+	if value, ok := tucuo.mutation.Results(); ok {
+		_spec.SetField(templateupgradecampaign.FieldResults, field.TypeJSON, value)
+	}
+	if value, ok := tucuo.mutation.AppendedResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, templateupgradecampaign.FieldResults, value)
+		})
+	}
+	if tucuo.mutation.ResultsCleared() {
+		_spec.ClearField(templateupgradecampaign.FieldResults, field.TypeJSON)
+	}
+	_spec.Node.Schema = tucuo.schemaConfig.TemplateUpgradeCampaign
+	ctx = internal.NewSchemaConfigContext(ctx, tucuo.schemaConfig)
+	_spec.AddModifiers(tucuo.modifiers...)
+	_node = &TemplateUpgradeCampaign{config: tucuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tucuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{templateupgradecampaign.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tucuo.mutation.done = true
+	return _node, nil
+}
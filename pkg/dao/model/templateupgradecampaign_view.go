@@ -0,0 +1,1020 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// TemplateUpgradeCampaignCreateInput holds the creation input of the TemplateUpgradeCampaign entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type TemplateUpgradeCampaignCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create TemplateUpgradeCampaign entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// TemplateVersion indicates to create TemplateUpgradeCampaign entity MUST under the TemplateVersion route.
+	TemplateVersion *TemplateVersionQueryInput `path:",inline" query:"-" json:"-"`
+
+	// ID of the template version to upgrade to.
+	TargetVersionID object.ID `path:"-" query:"-" json:"targetVersionID"`
+	// Preview the upgrade without applying, the planned runs can be applied later.
+	Preview bool `path:"-" query:"-" json:"preview,omitempty"`
+	// Count of the resources to upgrade in each batch.
+	BatchSize int `path:"-" query:"-" json:"batchSize,omitempty"`
+	// Change comment of the upgrade runs.
+	ChangeComment string `path:"-" query:"-" json:"changeComment,omitempty"`
+}
+
+// Model returns the TemplateUpgradeCampaign entity for creating,
+// after validating.
+func (tucci *TemplateUpgradeCampaignCreateInput) Model() *TemplateUpgradeCampaign {
+	if tucci == nil {
+		return nil
+	}
+
+	_tuc := &TemplateUpgradeCampaign{
+		TargetVersionID: tucci.TargetVersionID,
+		Preview:         tucci.Preview,
+		BatchSize:       tucci.BatchSize,
+		ChangeComment:   tucci.ChangeComment,
+	}
+
+	if tucci.Project != nil {
+		_tuc.ProjectID = tucci.Project.ID
+	}
+	if tucci.TemplateVersion != nil {
+		_tuc.TemplateVersionID = tucci.TemplateVersion.ID
+	}
+
+	return _tuc
+}
+
+// Validate checks the TemplateUpgradeCampaignCreateInput entity.
+func (tucci *TemplateUpgradeCampaignCreateInput) Validate() error {
+	if tucci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return tucci.ValidateWith(tucci.inputConfig.Context, tucci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignCreateInput entity with the given context and client set.
+func (tucci *TemplateUpgradeCampaignCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if tucci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if tucci.Project != nil {
+		if err := tucci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				tucci.Project = nil
+			}
+		}
+	}
+	// Validate when creating under the TemplateVersion route.
+	if tucci.TemplateVersion != nil {
+		if err := tucci.TemplateVersion.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TemplateUpgradeCampaignCreateInputs holds the creation input item of the TemplateUpgradeCampaign entities.
+type TemplateUpgradeCampaignCreateInputsItem struct {
+	// ID of the template version to upgrade to.
+	TargetVersionID object.ID `path:"-" query:"-" json:"targetVersionID"`
+	// Preview the upgrade without applying, the planned runs can be applied later.
+	Preview bool `path:"-" query:"-" json:"preview,omitempty"`
+	// Count of the resources to upgrade in each batch.
+	BatchSize int `path:"-" query:"-" json:"batchSize,omitempty"`
+	// Change comment of the upgrade runs.
+	ChangeComment string `path:"-" query:"-" json:"changeComment,omitempty"`
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignCreateInputsItem entity with the given context and client set.
+func (tucci *TemplateUpgradeCampaignCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if tucci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// TemplateUpgradeCampaignCreateInputs holds the creation input of the TemplateUpgradeCampaign entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type TemplateUpgradeCampaignCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create TemplateUpgradeCampaign entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// TemplateVersion indicates to create TemplateUpgradeCampaign entity MUST under the TemplateVersion route.
+	TemplateVersion *TemplateVersionQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*TemplateUpgradeCampaignCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the TemplateUpgradeCampaign entities for creating,
+// after validating.
+func (tucci *TemplateUpgradeCampaignCreateInputs) Model() []*TemplateUpgradeCampaign {
+	if tucci == nil || len(tucci.Items) == 0 {
+		return nil
+	}
+
+	_tucs := make([]*TemplateUpgradeCampaign, len(tucci.Items))
+
+	for i := range tucci.Items {
+		_tuc := &TemplateUpgradeCampaign{
+			TargetVersionID: tucci.Items[i].TargetVersionID,
+			Preview:         tucci.Items[i].Preview,
+			BatchSize:       tucci.Items[i].BatchSize,
+			ChangeComment:   tucci.Items[i].ChangeComment,
+		}
+
+		if tucci.Project != nil {
+			_tuc.ProjectID = tucci.Project.ID
+		}
+		if tucci.TemplateVersion != nil {
+			_tuc.TemplateVersionID = tucci.TemplateVersion.ID
+		}
+
+		_tucs[i] = _tuc
+	}
+
+	return _tucs
+}
+
+// Validate checks the TemplateUpgradeCampaignCreateInputs entity .
+func (tucci *TemplateUpgradeCampaignCreateInputs) Validate() error {
+	if tucci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return tucci.ValidateWith(tucci.inputConfig.Context, tucci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignCreateInputs entity with the given context and client set.
+func (tucci *TemplateUpgradeCampaignCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if tucci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(tucci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if tucci.Project != nil {
+		if err := tucci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				tucci.Project = nil
+			}
+		}
+	}
+	// Validate when creating under the TemplateVersion route.
+	if tucci.TemplateVersion != nil {
+		if err := tucci.TemplateVersion.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				tucci.TemplateVersion = nil
+			}
+		}
+	}
+
+	for i := range tucci.Items {
+		if tucci.Items[i] == nil {
+			continue
+		}
+
+		if err := tucci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TemplateUpgradeCampaignDeleteInput holds the deletion input of the TemplateUpgradeCampaign entity,
+// please tags with `path:",inline"` if embedding.
+type TemplateUpgradeCampaignDeleteInput struct {
+	TemplateUpgradeCampaignQueryInput `path:",inline"`
+}
+
+// TemplateUpgradeCampaignDeleteInputs holds the deletion input item of the TemplateUpgradeCampaign entities.
+type TemplateUpgradeCampaignDeleteInputsItem struct {
+	// ID of the TemplateUpgradeCampaign entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// TemplateUpgradeCampaignDeleteInputs holds the deletion input of the TemplateUpgradeCampaign entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type TemplateUpgradeCampaignDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to delete TemplateUpgradeCampaign entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// TemplateVersion indicates to delete TemplateUpgradeCampaign entity MUST under the TemplateVersion route.
+	TemplateVersion *TemplateVersionQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*TemplateUpgradeCampaignDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the TemplateUpgradeCampaign entities for deleting,
+// after validating.
+func (tucdi *TemplateUpgradeCampaignDeleteInputs) Model() []*TemplateUpgradeCampaign {
+	if tucdi == nil || len(tucdi.Items) == 0 {
+		return nil
+	}
+
+	_tucs := make([]*TemplateUpgradeCampaign, len(tucdi.Items))
+	for i := range tucdi.Items {
+		_tucs[i] = &TemplateUpgradeCampaign{
+			ID: tucdi.Items[i].ID,
+		}
+	}
+	return _tucs
+}
+
+// IDs returns the ID list of the TemplateUpgradeCampaign entities for deleting,
+// after validating.
+func (tucdi *TemplateUpgradeCampaignDeleteInputs) IDs() []object.ID {
+	if tucdi == nil || len(tucdi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(tucdi.Items))
+	for i := range tucdi.Items {
+		ids[i] = tucdi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the TemplateUpgradeCampaignDeleteInputs entity.
+func (tucdi *TemplateUpgradeCampaignDeleteInputs) Validate() error {
+	if tucdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return tucdi.ValidateWith(tucdi.inputConfig.Context, tucdi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignDeleteInputs entity with the given context and client set.
+func (tucdi *TemplateUpgradeCampaignDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if tucdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(tucdi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.TemplateUpgradeCampaigns().Query()
+
+	// Validate when deleting under the Project route.
+	if tucdi.Project != nil {
+		if err := tucdi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				tucdi.Project = nil
+				q.Where(
+					templateupgradecampaign.ProjectIDIsNil())
+			}
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				templateupgradecampaign.ProjectID(tucdi.Project.ID))
+		}
+	} else {
+		q.Where(
+			templateupgradecampaign.ProjectIDIsNil())
+	}
+
+	// Validate when deleting under the TemplateVersion route.
+	if tucdi.TemplateVersion != nil {
+		if err := tucdi.TemplateVersion.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				templateupgradecampaign.TemplateVersionID(tucdi.TemplateVersion.ID))
+		}
+	}
+
+	ids := make([]object.ID, 0, len(tucdi.Items))
+
+	for i := range tucdi.Items {
+		if tucdi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if tucdi.Items[i].ID != "" {
+			ids = append(ids, tucdi.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(templateupgradecampaign.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	return nil
+}
+
+// TemplateUpgradeCampaignPatchInput holds the patch input of the TemplateUpgradeCampaign entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type TemplateUpgradeCampaignPatchInput struct {
+	TemplateUpgradeCampaignQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// ID of the template version to upgrade to.
+	TargetVersionID object.ID `path:"-" query:"-" json:"targetVersionID,omitempty"`
+	// Preview the upgrade without applying, the planned runs can be applied later.
+	Preview bool `path:"-" query:"-" json:"preview,omitempty"`
+	// Count of the resources to upgrade in each batch.
+	BatchSize int `path:"-" query:"-" json:"batchSize,omitempty"`
+	// Change comment of the upgrade runs.
+	ChangeComment string `path:"-" query:"-" json:"changeComment,omitempty"`
+	// ID of the subject who creates the campaign, the upgrade runs are acted as the subject.
+	SubjectID object.ID `path:"-" query:"-" json:"subjectID,omitempty"`
+	// Status of the campaign, pending, running, succeeded, failed or canceled.
+	Status string `path:"-" query:"-" json:"status,omitempty"`
+	// Message of the campaign status.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+	// Upgrade results of the affected resources.
+	Results []types.TemplateUpgradeCampaignResult `path:"-" query:"-" json:"results,omitempty"`
+
+	patchedEntity *TemplateUpgradeCampaign `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the TemplateUpgradeCampaign partition entity for patching.
+func (tucpi *TemplateUpgradeCampaignPatchInput) PatchModel() *TemplateUpgradeCampaign {
+	if tucpi == nil {
+		return nil
+	}
+
+	_tuc := &TemplateUpgradeCampaign{
+		CreateTime:      tucpi.CreateTime,
+		UpdateTime:      tucpi.UpdateTime,
+		TargetVersionID: tucpi.TargetVersionID,
+		Preview:         tucpi.Preview,
+		BatchSize:       tucpi.BatchSize,
+		ChangeComment:   tucpi.ChangeComment,
+		SubjectID:       tucpi.SubjectID,
+		Status:          tucpi.Status,
+		Message:         tucpi.Message,
+		Results:         tucpi.Results,
+	}
+
+	if tucpi.Project != nil {
+		_tuc.ProjectID = tucpi.Project.ID
+	}
+	if tucpi.TemplateVersion != nil {
+		_tuc.TemplateVersionID = tucpi.TemplateVersion.ID
+	}
+
+	return _tuc
+}
+
+// Model returns the TemplateUpgradeCampaign patched entity,
+// after validating.
+func (tucpi *TemplateUpgradeCampaignPatchInput) Model() *TemplateUpgradeCampaign {
+	if tucpi == nil {
+		return nil
+	}
+
+	return tucpi.patchedEntity
+}
+
+// Validate checks the TemplateUpgradeCampaignPatchInput entity.
+func (tucpi *TemplateUpgradeCampaignPatchInput) Validate() error {
+	if tucpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return tucpi.ValidateWith(tucpi.inputConfig.Context, tucpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignPatchInput entity with the given context and client set.
+func (tucpi *TemplateUpgradeCampaignPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := tucpi.TemplateUpgradeCampaignQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.TemplateUpgradeCampaigns().Query()
+
+	// Validate when querying under the Project route.
+	if tucpi.Project != nil {
+		if err := tucpi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				tucpi.Project = nil
+				q.Where(
+					templateupgradecampaign.ProjectIDIsNil())
+			}
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				templateupgradecampaign.ProjectID(tucpi.Project.ID))
+		}
+	} else {
+		q.Where(
+			templateupgradecampaign.ProjectIDIsNil())
+	}
+
+	// Validate when querying under the TemplateVersion route.
+	if tucpi.TemplateVersion != nil {
+		if err := tucpi.TemplateVersion.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				templateupgradecampaign.TemplateVersionID(tucpi.TemplateVersion.ID))
+		}
+	}
+
+	if tucpi.Refer != nil {
+		if tucpi.Refer.IsID() {
+			q.Where(
+				templateupgradecampaign.ID(tucpi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of templateupgradecampaign")
+		}
+	} else if tucpi.ID != "" {
+		q.Where(
+			templateupgradecampaign.ID(tucpi.ID))
+	} else {
+		return errors.New("invalid identify of templateupgradecampaign")
+	}
+
+	q.Select(
+		templateupgradecampaign.WithoutFields(
+			templateupgradecampaign.FieldCreateTime,
+			templateupgradecampaign.FieldUpdateTime,
+			templateupgradecampaign.FieldSubjectID,
+			templateupgradecampaign.FieldStatus,
+			templateupgradecampaign.FieldMessage,
+			templateupgradecampaign.FieldResults,
+		)...,
+	)
+
+	var e *TemplateUpgradeCampaign
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*TemplateUpgradeCampaign)
+		}
+	}
+
+	_pm := tucpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*TemplateUpgradeCampaign)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.TargetVersionID != _obj.TargetVersionID {
+		return errors.New("field targetVersionID is immutable")
+	}
+	if e.Preview != _obj.Preview {
+		return errors.New("field preview is immutable")
+	}
+	if e.BatchSize != _obj.BatchSize {
+		return errors.New("field batchSize is immutable")
+	}
+	if e.ChangeComment != _obj.ChangeComment {
+		return errors.New("field changeComment is immutable")
+	}
+	if e.SubjectID != _obj.SubjectID {
+		return errors.New("field subjectID is immutable")
+	}
+
+	tucpi.patchedEntity = _obj
+	return nil
+}
+
+// TemplateUpgradeCampaignQueryInput holds the query input of the TemplateUpgradeCampaign entity,
+// please tags with `path:",inline"` if embedding.
+type TemplateUpgradeCampaignQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query TemplateUpgradeCampaign entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"project,omitempty"`
+	// TemplateVersion indicates to query TemplateUpgradeCampaign entity MUST under the TemplateVersion route.
+	TemplateVersion *TemplateVersionQueryInput `path:",inline" query:"-" json:"templateVersion"`
+
+	// Refer holds the route path reference of the TemplateUpgradeCampaign entity.
+	Refer *object.Refer `path:"templateupgradecampaign,default=" query:"-" json:"-"`
+	// ID of the TemplateUpgradeCampaign entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// Model returns the TemplateUpgradeCampaign entity for querying,
+// after validating.
+func (tucqi *TemplateUpgradeCampaignQueryInput) Model() *TemplateUpgradeCampaign {
+	if tucqi == nil {
+		return nil
+	}
+
+	return &TemplateUpgradeCampaign{
+		ID: tucqi.ID,
+	}
+}
+
+// Validate checks the TemplateUpgradeCampaignQueryInput entity.
+func (tucqi *TemplateUpgradeCampaignQueryInput) Validate() error {
+	if tucqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return tucqi.ValidateWith(tucqi.inputConfig.Context, tucqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignQueryInput entity with the given context and client set.
+func (tucqi *TemplateUpgradeCampaignQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if tucqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if tucqi.Refer != nil && *tucqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", templateupgradecampaign.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.TemplateUpgradeCampaigns().Query()
+
+	// Validate when querying under the Project route.
+	if tucqi.Project != nil {
+		if err := tucqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				tucqi.Project = nil
+				q.Where(
+					templateupgradecampaign.ProjectIDIsNil())
+			}
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				templateupgradecampaign.ProjectID(tucqi.Project.ID))
+		}
+	} else {
+		q.Where(
+			templateupgradecampaign.ProjectIDIsNil())
+	}
+
+	// Validate when querying under the TemplateVersion route.
+	if tucqi.TemplateVersion != nil {
+		if err := tucqi.TemplateVersion.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				templateupgradecampaign.TemplateVersionID(tucqi.TemplateVersion.ID))
+		}
+	}
+
+	if tucqi.Refer != nil {
+		if tucqi.Refer.IsID() {
+			q.Where(
+				templateupgradecampaign.ID(tucqi.Refer.ID()))
+		} else {
+			return errors.New("invalid identify refer of templateupgradecampaign")
+		}
+	} else if tucqi.ID != "" {
+		q.Where(
+			templateupgradecampaign.ID(tucqi.ID))
+	} else {
+		return errors.New("invalid identify of templateupgradecampaign")
+	}
+
+	q.Select(
+		templateupgradecampaign.FieldID,
+	)
+
+	var e *TemplateUpgradeCampaign
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*TemplateUpgradeCampaign)
+		}
+	}
+
+	tucqi.ID = e.ID
+	return nil
+}
+
+// TemplateUpgradeCampaignQueryInputs holds the query input of the TemplateUpgradeCampaign entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type TemplateUpgradeCampaignQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query TemplateUpgradeCampaign entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// TemplateVersion indicates to query TemplateUpgradeCampaign entity MUST under the TemplateVersion route.
+	TemplateVersion *TemplateVersionQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Validate checks the TemplateUpgradeCampaignQueryInputs entity.
+func (tucqi *TemplateUpgradeCampaignQueryInputs) Validate() error {
+	if tucqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return tucqi.ValidateWith(tucqi.inputConfig.Context, tucqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignQueryInputs entity with the given context and client set.
+func (tucqi *TemplateUpgradeCampaignQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if tucqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when querying under the Project route.
+	if tucqi.Project != nil {
+		if err := tucqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				tucqi.Project = nil
+			}
+		}
+	}
+
+	// Validate when querying under the TemplateVersion route.
+	if tucqi.TemplateVersion != nil {
+		if err := tucqi.TemplateVersion.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TemplateUpgradeCampaignUpdateInput holds the modification input of the TemplateUpgradeCampaign entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type TemplateUpgradeCampaignUpdateInput struct {
+	TemplateUpgradeCampaignQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Model returns the TemplateUpgradeCampaign entity for modifying,
+// after validating.
+func (tucui *TemplateUpgradeCampaignUpdateInput) Model() *TemplateUpgradeCampaign {
+	if tucui == nil {
+		return nil
+	}
+
+	_tuc := &TemplateUpgradeCampaign{
+		ID: tucui.ID,
+	}
+
+	return _tuc
+}
+
+// Validate checks the TemplateUpgradeCampaignUpdateInput entity.
+func (tucui *TemplateUpgradeCampaignUpdateInput) Validate() error {
+	if tucui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return tucui.ValidateWith(tucui.inputConfig.Context, tucui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignUpdateInput entity with the given context and client set.
+func (tucui *TemplateUpgradeCampaignUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := tucui.TemplateUpgradeCampaignQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TemplateUpgradeCampaignUpdateInputs holds the modification input item of the TemplateUpgradeCampaign entities.
+type TemplateUpgradeCampaignUpdateInputsItem struct {
+	// ID of the TemplateUpgradeCampaign entity.
+	ID object.ID `path:"-" query:"-" json:"id"`
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignUpdateInputsItem entity with the given context and client set.
+func (tucui *TemplateUpgradeCampaignUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if tucui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// TemplateUpgradeCampaignUpdateInputs holds the modification input of the TemplateUpgradeCampaign entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type TemplateUpgradeCampaignUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to update TemplateUpgradeCampaign entity CAN under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+	// TemplateVersion indicates to update TemplateUpgradeCampaign entity MUST under the TemplateVersion route.
+	TemplateVersion *TemplateVersionQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*TemplateUpgradeCampaignUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the TemplateUpgradeCampaign entities for modifying,
+// after validating.
+func (tucui *TemplateUpgradeCampaignUpdateInputs) Model() []*TemplateUpgradeCampaign {
+	if tucui == nil || len(tucui.Items) == 0 {
+		return nil
+	}
+
+	_tucs := make([]*TemplateUpgradeCampaign, len(tucui.Items))
+
+	for i := range tucui.Items {
+		_tuc := &TemplateUpgradeCampaign{
+			ID: tucui.Items[i].ID,
+		}
+
+		_tucs[i] = _tuc
+	}
+
+	return _tucs
+}
+
+// IDs returns the ID list of the TemplateUpgradeCampaign entities for modifying,
+// after validating.
+func (tucui *TemplateUpgradeCampaignUpdateInputs) IDs() []object.ID {
+	if tucui == nil || len(tucui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(tucui.Items))
+	for i := range tucui.Items {
+		ids[i] = tucui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the TemplateUpgradeCampaignUpdateInputs entity.
+func (tucui *TemplateUpgradeCampaignUpdateInputs) Validate() error {
+	if tucui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return tucui.ValidateWith(tucui.inputConfig.Context, tucui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the TemplateUpgradeCampaignUpdateInputs entity with the given context and client set.
+func (tucui *TemplateUpgradeCampaignUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if tucui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(tucui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.TemplateUpgradeCampaigns().Query()
+
+	// Validate when updating under the Project route.
+	if tucui.Project != nil {
+		if err := tucui.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				tucui.Project = nil
+				q.Where(
+					templateupgradecampaign.ProjectIDIsNil())
+			}
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				templateupgradecampaign.ProjectID(tucui.Project.ID))
+		}
+	} else {
+		q.Where(
+			templateupgradecampaign.ProjectIDIsNil())
+	}
+
+	// Validate when updating under the TemplateVersion route.
+	if tucui.TemplateVersion != nil {
+		if err := tucui.TemplateVersion.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			q.Where(
+				templateupgradecampaign.TemplateVersionID(tucui.TemplateVersion.ID))
+		}
+	}
+
+	ids := make([]object.ID, 0, len(tucui.Items))
+
+	for i := range tucui.Items {
+		if tucui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if tucui.Items[i].ID != "" {
+			ids = append(ids, tucui.Items[i].ID)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	if len(ids) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	idsCnt, err := q.Where(templateupgradecampaign.IDIn(ids...)).
+		Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	if idsCnt != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range tucui.Items {
+		if err := tucui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TemplateUpgradeCampaignOutput holds the output of the TemplateUpgradeCampaign entity.
+type TemplateUpgradeCampaignOutput struct {
+	ID              object.ID                             `json:"id,omitempty"`
+	CreateTime      *time.Time                            `json:"createTime,omitempty"`
+	UpdateTime      *time.Time                            `json:"updateTime,omitempty"`
+	TargetVersionID object.ID                             `json:"targetVersionID,omitempty"`
+	Preview         bool                                  `json:"preview,omitempty"`
+	BatchSize       int                                   `json:"batchSize,omitempty"`
+	ChangeComment   string                                `json:"changeComment,omitempty"`
+	SubjectID       object.ID                             `json:"subjectID,omitempty"`
+	Status          string                                `json:"status,cli-table-column,omitempty"`
+	Message         string                                `json:"message,omitempty"`
+	Results         []types.TemplateUpgradeCampaignResult `json:"results,omitempty"`
+
+	Project         *ProjectOutput         `json:"project,omitempty"`
+	TemplateVersion *TemplateVersionOutput `json:"templateVersion,omitempty"`
+}
+
+// View returns the output of TemplateUpgradeCampaign entity.
+func (_tuc *TemplateUpgradeCampaign) View() *TemplateUpgradeCampaignOutput {
+	return ExposeTemplateUpgradeCampaign(_tuc)
+}
+
+// View returns the output of TemplateUpgradeCampaign entities.
+func (_tucs TemplateUpgradeCampaigns) View() []*TemplateUpgradeCampaignOutput {
+	return ExposeTemplateUpgradeCampaigns(_tucs)
+}
+
+// ExposeTemplateUpgradeCampaign converts the TemplateUpgradeCampaign to TemplateUpgradeCampaignOutput.
+func ExposeTemplateUpgradeCampaign(_tuc *TemplateUpgradeCampaign) *TemplateUpgradeCampaignOutput {
+	if _tuc == nil {
+		return nil
+	}
+
+	tuco := &TemplateUpgradeCampaignOutput{
+		ID:              _tuc.ID,
+		CreateTime:      _tuc.CreateTime,
+		UpdateTime:      _tuc.UpdateTime,
+		TargetVersionID: _tuc.TargetVersionID,
+		Preview:         _tuc.Preview,
+		BatchSize:       _tuc.BatchSize,
+		ChangeComment:   _tuc.ChangeComment,
+		SubjectID:       _tuc.SubjectID,
+		Status:          _tuc.Status,
+		Message:         _tuc.Message,
+		Results:         _tuc.Results,
+	}
+
+	if _tuc.Edges.Project != nil {
+		tuco.Project = ExposeProject(_tuc.Edges.Project)
+	} else if _tuc.ProjectID != "" {
+		tuco.Project = &ProjectOutput{
+			ID: _tuc.ProjectID,
+		}
+	}
+	if _tuc.Edges.TemplateVersion != nil {
+		tuco.TemplateVersion = ExposeTemplateVersion(_tuc.Edges.TemplateVersion)
+	} else if _tuc.TemplateVersionID != "" {
+		tuco.TemplateVersion = &TemplateVersionOutput{
+			ID: _tuc.TemplateVersionID,
+		}
+	}
+	return tuco
+}
+
+// ExposeTemplateUpgradeCampaigns converts the TemplateUpgradeCampaign slice to TemplateUpgradeCampaignOutput pointer slice.
+func ExposeTemplateUpgradeCampaigns(_tucs []*TemplateUpgradeCampaign) []*TemplateUpgradeCampaignOutput {
+	if len(_tucs) == 0 {
+		return nil
+	}
+
+	tucos := make([]*TemplateUpgradeCampaignOutput, len(_tucs))
+	for i := range _tucs {
+		tucos[i] = ExposeTemplateUpgradeCampaign(_tucs[i])
+	}
+	return tucos
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/utils/json"
 )
 
@@ -30,6 +31,8 @@ type TemplateVersion struct {
 	CreateTime *time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime *time.Time `json:"update_time,omitempty"`
+	// Status holds the value of the "status" field.
+	Status status.Status `json:"status,omitempty"`
 	// ID of the template.
 	TemplateID object.ID `json:"template_id,omitempty"`
 	// Name of the template.
@@ -48,6 +51,12 @@ type TemplateVersion struct {
 	SchemaDefaultValue []byte `json:"schema_default_value,omitempty"`
 	// ID of the project to belong, empty means for all projects.
 	ProjectID object.ID `json:"project_id,omitempty"`
+	// Lifecycle state of the template version, active, deprecated or blocked.
+	Lifecycle string `json:"lifecycle,omitempty"`
+	// Reason of the lifecycle state.
+	LifecycleReason string `json:"lifecycle_reason,omitempty"`
+	// Results of testing the template version with the fixtures.
+	TestResults []types.TemplateVersionTestResult `json:"test_results,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TemplateVersionQuery when eager-loading is set.
 	Edges        TemplateVersionEdges `json:"edges,omitempty"`
@@ -60,13 +69,15 @@ type TemplateVersionEdges struct {
 	Template *Template `json:"template,omitempty"`
 	// Resources that belong to the template version.
 	Resources []*Resource `json:"resources,omitempty"`
+	// Upgrade campaigns of the resources that use the template version.
+	UpgradeCampaigns []*TemplateUpgradeCampaign `json:"upgrade_campaigns,omitempty"`
 	// ResourceDefinitions holds the value of the resource_definitions edge.
 	ResourceDefinitions []*ResourceDefinitionMatchingRule `json:"resource_definitions,omitempty"`
 	// Project to which the template version belongs.
 	Project *Project `json:"project,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // TemplateOrErr returns the Template value or an error if the edge
@@ -91,10 +102,19 @@ func (e TemplateVersionEdges) ResourcesOrErr() ([]*Resource, error) {
 	return nil, &NotLoadedError{edge: "resources"}
 }
 
+// UpgradeCampaignsOrErr returns the UpgradeCampaigns value or an error if the edge
+// was not loaded in eager-loading.
+func (e TemplateVersionEdges) UpgradeCampaignsOrErr() ([]*TemplateUpgradeCampaign, error) {
+	if e.loadedTypes[2] {
+		return e.UpgradeCampaigns, nil
+	}
+	return nil, &NotLoadedError{edge: "upgrade_campaigns"}
+}
+
 // ResourceDefinitionsOrErr returns the ResourceDefinitions value or an error if the edge
 // was not loaded in eager-loading.
 func (e TemplateVersionEdges) ResourceDefinitionsOrErr() ([]*ResourceDefinitionMatchingRule, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[3] {
 		return e.ResourceDefinitions, nil
 	}
 	return nil, &NotLoadedError{edge: "resource_definitions"}
@@ -103,7 +123,7 @@ func (e TemplateVersionEdges) ResourceDefinitionsOrErr() ([]*ResourceDefinitionM
 // ProjectOrErr returns the Project value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e TemplateVersionEdges) ProjectOrErr() (*Project, error) {
-	if e.loadedTypes[3] {
+	if e.loadedTypes[4] {
 		if e.Project == nil {
 			// Edge was loaded but was not found.
 			return nil, &NotFoundError{label: project.Label}
@@ -118,11 +138,11 @@ func (*TemplateVersion) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case templateversion.FieldSchema, templateversion.FieldOriginalUISchema, templateversion.FieldUISchema, templateversion.FieldSchemaDefaultValue:
+		case templateversion.FieldStatus, templateversion.FieldSchema, templateversion.FieldOriginalUISchema, templateversion.FieldUISchema, templateversion.FieldSchemaDefaultValue, templateversion.FieldTestResults:
 			values[i] = new([]byte)
 		case templateversion.FieldID, templateversion.FieldTemplateID, templateversion.FieldProjectID:
 			values[i] = new(object.ID)
-		case templateversion.FieldName, templateversion.FieldVersion, templateversion.FieldSource:
+		case templateversion.FieldName, templateversion.FieldVersion, templateversion.FieldSource, templateversion.FieldLifecycle, templateversion.FieldLifecycleReason:
 			values[i] = new(sql.NullString)
 		case templateversion.FieldCreateTime, templateversion.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -161,6 +181,14 @@ func (tv *TemplateVersion) assignValues(columns []string, values []any) error {
 				tv.UpdateTime = new(time.Time)
 				*tv.UpdateTime = value.Time
 			}
+		case templateversion.FieldStatus:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tv.Status); err != nil {
+					return fmt.Errorf("unmarshal field status: %w", err)
+				}
+			}
 		case templateversion.FieldTemplateID:
 			if value, ok := values[i].(*object.ID); !ok {
 				return fmt.Errorf("unexpected type %T for field template_id", values[i])
@@ -221,6 +249,26 @@ func (tv *TemplateVersion) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				tv.ProjectID = *value
 			}
+		case templateversion.FieldLifecycle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lifecycle", values[i])
+			} else if value.Valid {
+				tv.Lifecycle = value.String
+			}
+		case templateversion.FieldLifecycleReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lifecycle_reason", values[i])
+			} else if value.Valid {
+				tv.LifecycleReason = value.String
+			}
+		case templateversion.FieldTestResults:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field test_results", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tv.TestResults); err != nil {
+					return fmt.Errorf("unmarshal field test_results: %w", err)
+				}
+			}
 		default:
 			tv.selectValues.Set(columns[i], values[i])
 		}
@@ -244,6 +292,11 @@ func (tv *TemplateVersion) QueryResources() *ResourceQuery {
 	return NewTemplateVersionClient(tv.config).QueryResources(tv)
 }
 
+// QueryUpgradeCampaigns queries the "upgrade_campaigns" edge of the TemplateVersion entity.
+func (tv *TemplateVersion) QueryUpgradeCampaigns() *TemplateUpgradeCampaignQuery {
+	return NewTemplateVersionClient(tv.config).QueryUpgradeCampaigns(tv)
+}
+
 // QueryResourceDefinitions queries the "resource_definitions" edge of the TemplateVersion entity.
 func (tv *TemplateVersion) QueryResourceDefinitions() *ResourceDefinitionMatchingRuleQuery {
 	return NewTemplateVersionClient(tv.config).QueryResourceDefinitions(tv)
@@ -287,6 +340,9 @@ func (tv *TemplateVersion) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", tv.Status))
+	builder.WriteString(", ")
 	builder.WriteString("template_id=")
 	builder.WriteString(fmt.Sprintf("%v", tv.TemplateID))
 	builder.WriteString(", ")
@@ -313,6 +369,15 @@ func (tv *TemplateVersion) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("project_id=")
 	builder.WriteString(fmt.Sprintf("%v", tv.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("lifecycle=")
+	builder.WriteString(tv.Lifecycle)
+	builder.WriteString(", ")
+	builder.WriteString("lifecycle_reason=")
+	builder.WriteString(tv.LifecycleReason)
+	builder.WriteString(", ")
+	builder.WriteString("test_results=")
+	builder.WriteString(fmt.Sprintf("%v", tv.TestResults))
 	builder.WriteByte(')')
 	return builder.String()
 }
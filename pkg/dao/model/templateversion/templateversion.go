@@ -25,6 +25,8 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// FieldTemplateID holds the string denoting the template_id field in the database.
 	FieldTemplateID = "template_id"
 	// FieldName holds the string denoting the name field in the database.
@@ -43,10 +45,18 @@ const (
 	FieldSchemaDefaultValue = "schema_default_value"
 	// FieldProjectID holds the string denoting the project_id field in the database.
 	FieldProjectID = "project_id"
+	// FieldLifecycle holds the string denoting the lifecycle field in the database.
+	FieldLifecycle = "lifecycle"
+	// FieldLifecycleReason holds the string denoting the lifecycle_reason field in the database.
+	FieldLifecycleReason = "lifecycle_reason"
+	// FieldTestResults holds the string denoting the test_results field in the database.
+	FieldTestResults = "test_results"
 	// EdgeTemplate holds the string denoting the template edge name in mutations.
 	EdgeTemplate = "template"
 	// EdgeResources holds the string denoting the resources edge name in mutations.
 	EdgeResources = "resources"
+	// EdgeUpgradeCampaigns holds the string denoting the upgrade_campaigns edge name in mutations.
+	EdgeUpgradeCampaigns = "upgrade_campaigns"
 	// EdgeResourceDefinitions holds the string denoting the resource_definitions edge name in mutations.
 	EdgeResourceDefinitions = "resource_definitions"
 	// EdgeProject holds the string denoting the project edge name in mutations.
@@ -67,6 +77,13 @@ const (
 	ResourcesInverseTable = "resources"
 	// ResourcesColumn is the table column denoting the resources relation/edge.
 	ResourcesColumn = "template_id"
+	// UpgradeCampaignsTable is the table that holds the upgrade_campaigns relation/edge.
+	UpgradeCampaignsTable = "template_upgrade_campaigns"
+	// UpgradeCampaignsInverseTable is the table name for the TemplateUpgradeCampaign entity.
+	// It exists in this package in order to avoid circular dependency with the "templateupgradecampaign" package.
+	UpgradeCampaignsInverseTable = "template_upgrade_campaigns"
+	// UpgradeCampaignsColumn is the table column denoting the upgrade_campaigns relation/edge.
+	UpgradeCampaignsColumn = "template_version_id"
 	// ResourceDefinitionsTable is the table that holds the resource_definitions relation/edge.
 	ResourceDefinitionsTable = "resource_definition_matching_rules"
 	// ResourceDefinitionsInverseTable is the table name for the ResourceDefinitionMatchingRule entity.
@@ -88,6 +105,7 @@ var Columns = []string{
 	FieldID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldStatus,
 	FieldTemplateID,
 	FieldName,
 	FieldVersion,
@@ -97,6 +115,9 @@ var Columns = []string{
 	FieldUISchema,
 	FieldSchemaDefaultValue,
 	FieldProjectID,
+	FieldLifecycle,
+	FieldLifecycleReason,
+	FieldTestResults,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -137,6 +158,8 @@ var (
 	DefaultOriginalUISchema types.UISchema
 	// DefaultUISchema holds the default value on creation for the "ui_schema" field.
 	DefaultUISchema types.UISchema
+	// DefaultLifecycle holds the default value on creation for the "lifecycle" field.
+	DefaultLifecycle string
 )
 
 // OrderOption defines the ordering options for the TemplateVersion queries.
@@ -182,6 +205,16 @@ func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
 }
 
+// ByLifecycle orders the results by the lifecycle field.
+func ByLifecycle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLifecycle, opts...).ToFunc()
+}
+
+// ByLifecycleReason orders the results by the lifecycle_reason field.
+func ByLifecycleReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLifecycleReason, opts...).ToFunc()
+}
+
 // ByTemplateField orders the results by template field.
 func ByTemplateField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -203,6 +236,20 @@ func ByResources(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByUpgradeCampaignsCount orders the results by upgrade_campaigns count.
+func ByUpgradeCampaignsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUpgradeCampaignsStep(), opts...)
+	}
+}
+
+// ByUpgradeCampaigns orders the results by upgrade_campaigns terms.
+func ByUpgradeCampaigns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUpgradeCampaignsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByResourceDefinitionsCount orders the results by resource_definitions count.
 func ByResourceDefinitionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -237,6 +284,13 @@ func newResourcesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ResourcesTable, ResourcesColumn),
 	)
 }
+func newUpgradeCampaignsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UpgradeCampaignsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UpgradeCampaignsTable, UpgradeCampaignsColumn),
+	)
+}
 func newResourceDefinitionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -101,6 +101,16 @@ func ProjectID(v object.ID) predicate.TemplateVersion {
 	return predicate.TemplateVersion(sql.FieldEQ(FieldProjectID, v))
 }
 
+// Lifecycle applies equality check predicate on the "lifecycle" field. It's identical to LifecycleEQ.
+func Lifecycle(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldEQ(FieldLifecycle, v))
+}
+
+// LifecycleReason applies equality check predicate on the "lifecycle_reason" field. It's identical to LifecycleReasonEQ.
+func LifecycleReason(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldEQ(FieldLifecycleReason, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.TemplateVersion {
 	return predicate.TemplateVersion(sql.FieldEQ(FieldCreateTime, v))
@@ -181,6 +191,16 @@ func UpdateTimeLTE(v time.Time) predicate.TemplateVersion {
 	return predicate.TemplateVersion(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldNotNull(FieldStatus))
+}
+
 // TemplateIDEQ applies the EQ predicate on the "template_id" field.
 func TemplateIDEQ(v object.ID) predicate.TemplateVersion {
 	return predicate.TemplateVersion(sql.FieldEQ(FieldTemplateID, v))
@@ -576,6 +596,166 @@ func ProjectIDContainsFold(v object.ID) predicate.TemplateVersion {
 	return predicate.TemplateVersion(sql.FieldContainsFold(FieldProjectID, vc))
 }
 
+// LifecycleEQ applies the EQ predicate on the "lifecycle" field.
+func LifecycleEQ(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldEQ(FieldLifecycle, v))
+}
+
+// LifecycleNEQ applies the NEQ predicate on the "lifecycle" field.
+func LifecycleNEQ(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldNEQ(FieldLifecycle, v))
+}
+
+// LifecycleIn applies the In predicate on the "lifecycle" field.
+func LifecycleIn(vs ...string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldIn(FieldLifecycle, vs...))
+}
+
+// LifecycleNotIn applies the NotIn predicate on the "lifecycle" field.
+func LifecycleNotIn(vs ...string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldNotIn(FieldLifecycle, vs...))
+}
+
+// LifecycleGT applies the GT predicate on the "lifecycle" field.
+func LifecycleGT(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldGT(FieldLifecycle, v))
+}
+
+// LifecycleGTE applies the GTE predicate on the "lifecycle" field.
+func LifecycleGTE(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldGTE(FieldLifecycle, v))
+}
+
+// LifecycleLT applies the LT predicate on the "lifecycle" field.
+func LifecycleLT(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldLT(FieldLifecycle, v))
+}
+
+// LifecycleLTE applies the LTE predicate on the "lifecycle" field.
+func LifecycleLTE(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldLTE(FieldLifecycle, v))
+}
+
+// LifecycleContains applies the Contains predicate on the "lifecycle" field.
+func LifecycleContains(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldContains(FieldLifecycle, v))
+}
+
+// LifecycleHasPrefix applies the HasPrefix predicate on the "lifecycle" field.
+func LifecycleHasPrefix(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldHasPrefix(FieldLifecycle, v))
+}
+
+// LifecycleHasSuffix applies the HasSuffix predicate on the "lifecycle" field.
+func LifecycleHasSuffix(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldHasSuffix(FieldLifecycle, v))
+}
+
+// LifecycleIsNil applies the IsNil predicate on the "lifecycle" field.
+func LifecycleIsNil() predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldIsNull(FieldLifecycle))
+}
+
+// LifecycleNotNil applies the NotNil predicate on the "lifecycle" field.
+func LifecycleNotNil() predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldNotNull(FieldLifecycle))
+}
+
+// LifecycleEqualFold applies the EqualFold predicate on the "lifecycle" field.
+func LifecycleEqualFold(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldEqualFold(FieldLifecycle, v))
+}
+
+// LifecycleContainsFold applies the ContainsFold predicate on the "lifecycle" field.
+func LifecycleContainsFold(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldContainsFold(FieldLifecycle, v))
+}
+
+// LifecycleReasonEQ applies the EQ predicate on the "lifecycle_reason" field.
+func LifecycleReasonEQ(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldEQ(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonNEQ applies the NEQ predicate on the "lifecycle_reason" field.
+func LifecycleReasonNEQ(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldNEQ(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonIn applies the In predicate on the "lifecycle_reason" field.
+func LifecycleReasonIn(vs ...string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldIn(FieldLifecycleReason, vs...))
+}
+
+// LifecycleReasonNotIn applies the NotIn predicate on the "lifecycle_reason" field.
+func LifecycleReasonNotIn(vs ...string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldNotIn(FieldLifecycleReason, vs...))
+}
+
+// LifecycleReasonGT applies the GT predicate on the "lifecycle_reason" field.
+func LifecycleReasonGT(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldGT(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonGTE applies the GTE predicate on the "lifecycle_reason" field.
+func LifecycleReasonGTE(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldGTE(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonLT applies the LT predicate on the "lifecycle_reason" field.
+func LifecycleReasonLT(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldLT(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonLTE applies the LTE predicate on the "lifecycle_reason" field.
+func LifecycleReasonLTE(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldLTE(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonContains applies the Contains predicate on the "lifecycle_reason" field.
+func LifecycleReasonContains(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldContains(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonHasPrefix applies the HasPrefix predicate on the "lifecycle_reason" field.
+func LifecycleReasonHasPrefix(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldHasPrefix(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonHasSuffix applies the HasSuffix predicate on the "lifecycle_reason" field.
+func LifecycleReasonHasSuffix(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldHasSuffix(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonIsNil applies the IsNil predicate on the "lifecycle_reason" field.
+func LifecycleReasonIsNil() predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldIsNull(FieldLifecycleReason))
+}
+
+// LifecycleReasonNotNil applies the NotNil predicate on the "lifecycle_reason" field.
+func LifecycleReasonNotNil() predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldNotNull(FieldLifecycleReason))
+}
+
+// LifecycleReasonEqualFold applies the EqualFold predicate on the "lifecycle_reason" field.
+func LifecycleReasonEqualFold(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldEqualFold(FieldLifecycleReason, v))
+}
+
+// LifecycleReasonContainsFold applies the ContainsFold predicate on the "lifecycle_reason" field.
+func LifecycleReasonContainsFold(v string) predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldContainsFold(FieldLifecycleReason, v))
+}
+
+// TestResultsIsNil applies the IsNil predicate on the "test_results" field.
+func TestResultsIsNil() predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldIsNull(FieldTestResults))
+}
+
+// TestResultsNotNil applies the NotNil predicate on the "test_results" field.
+func TestResultsNotNil() predicate.TemplateVersion {
+	return predicate.TemplateVersion(sql.FieldNotNull(FieldTestResults))
+}
+
 // HasTemplate applies the HasEdge predicate on the "template" edge.
 func HasTemplate() predicate.TemplateVersion {
 	return predicate.TemplateVersion(func(s *sql.Selector) {
@@ -634,6 +814,35 @@ func HasResourcesWith(preds ...predicate.Resource) predicate.TemplateVersion {
 	})
 }
 
+// HasUpgradeCampaigns applies the HasEdge predicate on the "upgrade_campaigns" edge.
+func HasUpgradeCampaigns() predicate.TemplateVersion {
+	return predicate.TemplateVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, UpgradeCampaignsTable, UpgradeCampaignsColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TemplateUpgradeCampaign
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUpgradeCampaignsWith applies the HasEdge predicate on the "upgrade_campaigns" edge with a given conditions (other predicates).
+func HasUpgradeCampaignsWith(preds ...predicate.TemplateUpgradeCampaign) predicate.TemplateVersion {
+	return predicate.TemplateVersion(func(s *sql.Selector) {
+		step := newUpgradeCampaignsStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.TemplateUpgradeCampaign
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasResourceDefinitions applies the HasEdge predicate on the "resource_definitions" edge.
 func HasResourceDefinitions() predicate.TemplateVersion {
 	return predicate.TemplateVersion(func(s *sql.Selector) {
@@ -21,9 +21,11 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinitionmatchingrule"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
 )
 
 // TemplateVersionCreate is the builder for creating a TemplateVersion entity.
@@ -64,6 +66,20 @@ func (tvc *TemplateVersionCreate) SetNillableUpdateTime(t *time.Time) *TemplateV
 	return tvc
 }
 
+// SetStatus sets the "status" field.
+func (tvc *TemplateVersionCreate) SetStatus(s status.Status) *TemplateVersionCreate {
+	tvc.mutation.SetStatus(s)
+	return tvc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableStatus(s *status.Status) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetStatus(*s)
+	}
+	return tvc
+}
+
 // SetTemplateID sets the "template_id" field.
 func (tvc *TemplateVersionCreate) SetTemplateID(o object.ID) *TemplateVersionCreate {
 	tvc.mutation.SetTemplateID(o)
@@ -150,6 +166,40 @@ func (tvc *TemplateVersionCreate) SetNillableProjectID(o *object.ID) *TemplateVe
 	return tvc
 }
 
+// SetLifecycle sets the "lifecycle" field.
+func (tvc *TemplateVersionCreate) SetLifecycle(s string) *TemplateVersionCreate {
+	tvc.mutation.SetLifecycle(s)
+	return tvc
+}
+
+// SetNillableLifecycle sets the "lifecycle" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableLifecycle(s *string) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetLifecycle(*s)
+	}
+	return tvc
+}
+
+// SetLifecycleReason sets the "lifecycle_reason" field.
+func (tvc *TemplateVersionCreate) SetLifecycleReason(s string) *TemplateVersionCreate {
+	tvc.mutation.SetLifecycleReason(s)
+	return tvc
+}
+
+// SetNillableLifecycleReason sets the "lifecycle_reason" field if the given value is not nil.
+func (tvc *TemplateVersionCreate) SetNillableLifecycleReason(s *string) *TemplateVersionCreate {
+	if s != nil {
+		tvc.SetLifecycleReason(*s)
+	}
+	return tvc
+}
+
+// SetTestResults sets the "test_results" field.
+func (tvc *TemplateVersionCreate) SetTestResults(tvtr []types.TemplateVersionTestResult) *TemplateVersionCreate {
+	tvc.mutation.SetTestResults(tvtr)
+	return tvc
+}
+
 // SetID sets the "id" field.
 func (tvc *TemplateVersionCreate) SetID(o object.ID) *TemplateVersionCreate {
 	tvc.mutation.SetID(o)
@@ -176,6 +226,21 @@ func (tvc *TemplateVersionCreate) AddResources(r ...*Resource) *TemplateVersionC
 	return tvc.AddResourceIDs(ids...)
 }
 
+// AddUpgradeCampaignIDs adds the "upgrade_campaigns" edge to the TemplateUpgradeCampaign entity by IDs.
+func (tvc *TemplateVersionCreate) AddUpgradeCampaignIDs(ids ...object.ID) *TemplateVersionCreate {
+	tvc.mutation.AddUpgradeCampaignIDs(ids...)
+	return tvc
+}
+
+// AddUpgradeCampaigns adds the "upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (tvc *TemplateVersionCreate) AddUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *TemplateVersionCreate {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tvc.AddUpgradeCampaignIDs(ids...)
+}
+
 // AddResourceDefinitionIDs adds the "resource_definitions" edge to the ResourceDefinitionMatchingRule entity by IDs.
 func (tvc *TemplateVersionCreate) AddResourceDefinitionIDs(ids ...object.ID) *TemplateVersionCreate {
 	tvc.mutation.AddResourceDefinitionIDs(ids...)
@@ -259,6 +324,10 @@ func (tvc *TemplateVersionCreate) defaults() error {
 		v := templateversion.DefaultUISchema
 		tvc.mutation.SetUISchema(v)
 	}
+	if _, ok := tvc.mutation.Lifecycle(); !ok {
+		v := templateversion.DefaultLifecycle
+		tvc.mutation.SetLifecycle(v)
+	}
 	return nil
 }
 
@@ -374,6 +443,10 @@ func (tvc *TemplateVersionCreate) createSpec() (*TemplateVersion, *sqlgraph.Crea
 		_spec.SetField(templateversion.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = &value
 	}
+	if value, ok := tvc.mutation.Status(); ok {
+		_spec.SetField(templateversion.FieldStatus, field.TypeJSON, value)
+		_node.Status = value
+	}
 	if value, ok := tvc.mutation.Name(); ok {
 		_spec.SetField(templateversion.FieldName, field.TypeString, value)
 		_node.Name = value
@@ -402,6 +475,18 @@ func (tvc *TemplateVersionCreate) createSpec() (*TemplateVersion, *sqlgraph.Crea
 		_spec.SetField(templateversion.FieldSchemaDefaultValue, field.TypeBytes, value)
 		_node.SchemaDefaultValue = value
 	}
+	if value, ok := tvc.mutation.Lifecycle(); ok {
+		_spec.SetField(templateversion.FieldLifecycle, field.TypeString, value)
+		_node.Lifecycle = value
+	}
+	if value, ok := tvc.mutation.LifecycleReason(); ok {
+		_spec.SetField(templateversion.FieldLifecycleReason, field.TypeString, value)
+		_node.LifecycleReason = value
+	}
+	if value, ok := tvc.mutation.TestResults(); ok {
+		_spec.SetField(templateversion.FieldTestResults, field.TypeJSON, value)
+		_node.TestResults = value
+	}
 	if nodes := tvc.mutation.TemplateIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -437,6 +522,23 @@ func (tvc *TemplateVersionCreate) createSpec() (*TemplateVersion, *sqlgraph.Crea
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tvc.mutation.UpgradeCampaignsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   templateversion.UpgradeCampaignsTable,
+			Columns: []string{templateversion.UpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvc.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tvc.mutation.ResourceDefinitionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -510,12 +612,24 @@ func (tvc *TemplateVersionCreate) Set(obj *TemplateVersion) *TemplateVersionCrea
 	if obj.UpdateTime != nil {
 		tvc.SetUpdateTime(*obj.UpdateTime)
 	}
+	if !reflect.ValueOf(obj.Status).IsZero() {
+		tvc.SetStatus(obj.Status)
+	}
 	if !reflect.ValueOf(obj.SchemaDefaultValue).IsZero() {
 		tvc.SetSchemaDefaultValue(obj.SchemaDefaultValue)
 	}
 	if obj.ProjectID != "" {
 		tvc.SetProjectID(obj.ProjectID)
 	}
+	if obj.Lifecycle != "" {
+		tvc.SetLifecycle(obj.Lifecycle)
+	}
+	if obj.LifecycleReason != "" {
+		tvc.SetLifecycleReason(obj.LifecycleReason)
+	}
+	if !reflect.ValueOf(obj.TestResults).IsZero() {
+		tvc.SetTestResults(obj.TestResults)
+	}
 
 	// Record the given object.
 	tvc.object = obj
@@ -568,6 +682,9 @@ func (tvc *TemplateVersionCreate) SaveE(ctx context.Context, cbs ...func(ctx con
 	}
 
 	if x := tvc.object; x != nil {
+		if _, set := tvc.mutation.Field(templateversion.FieldStatus); set {
+			obj.Status = x.Status
+		}
 		if _, set := tvc.mutation.Field(templateversion.FieldTemplateID); set {
 			obj.TemplateID = x.TemplateID
 		}
@@ -586,6 +703,12 @@ func (tvc *TemplateVersionCreate) SaveE(ctx context.Context, cbs ...func(ctx con
 		if _, set := tvc.mutation.Field(templateversion.FieldProjectID); set {
 			obj.ProjectID = x.ProjectID
 		}
+		if _, set := tvc.mutation.Field(templateversion.FieldLifecycleReason); set {
+			obj.LifecycleReason = x.LifecycleReason
+		}
+		if _, set := tvc.mutation.Field(templateversion.FieldTestResults); set {
+			obj.TestResults = x.TestResults
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -704,6 +827,9 @@ func (tvcb *TemplateVersionCreateBulk) SaveE(ctx context.Context, cbs ...func(ct
 
 	if x := tvcb.objects; x != nil {
 		for i := range x {
+			if _, set := tvcb.builders[i].mutation.Field(templateversion.FieldStatus); set {
+				objs[i].Status = x[i].Status
+			}
 			if _, set := tvcb.builders[i].mutation.Field(templateversion.FieldTemplateID); set {
 				objs[i].TemplateID = x[i].TemplateID
 			}
@@ -722,6 +848,12 @@ func (tvcb *TemplateVersionCreateBulk) SaveE(ctx context.Context, cbs ...func(ct
 			if _, set := tvcb.builders[i].mutation.Field(templateversion.FieldProjectID); set {
 				objs[i].ProjectID = x[i].ProjectID
 			}
+			if _, set := tvcb.builders[i].mutation.Field(templateversion.FieldLifecycleReason); set {
+				objs[i].LifecycleReason = x[i].LifecycleReason
+			}
+			if _, set := tvcb.builders[i].mutation.Field(templateversion.FieldTestResults); set {
+				objs[i].TestResults = x[i].TestResults
+			}
 			objs[i].Edges = x[i].Edges
 		}
 	}
@@ -860,6 +992,24 @@ func (u *TemplateVersionUpsert) UpdateUpdateTime() *TemplateVersionUpsert {
 	return u
 }
 
+// SetStatus sets the "status" field.
+func (u *TemplateVersionUpsert) SetStatus(v status.Status) *TemplateVersionUpsert {
+	u.Set(templateversion.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TemplateVersionUpsert) UpdateStatus() *TemplateVersionUpsert {
+	u.SetExcluded(templateversion.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TemplateVersionUpsert) ClearStatus() *TemplateVersionUpsert {
+	u.SetNull(templateversion.FieldStatus)
+	return u
+}
+
 // SetSchema sets the "schema" field.
 func (u *TemplateVersionUpsert) SetSchema(v types.TemplateVersionSchema) *TemplateVersionUpsert {
 	u.Set(templateversion.FieldSchema, v)
@@ -914,6 +1064,60 @@ func (u *TemplateVersionUpsert) ClearSchemaDefaultValue() *TemplateVersionUpsert
 	return u
 }
 
+// SetLifecycle sets the "lifecycle" field.
+func (u *TemplateVersionUpsert) SetLifecycle(v string) *TemplateVersionUpsert {
+	u.Set(templateversion.FieldLifecycle, v)
+	return u
+}
+
+// UpdateLifecycle sets the "lifecycle" field to the value that was provided on create.
+func (u *TemplateVersionUpsert) UpdateLifecycle() *TemplateVersionUpsert {
+	u.SetExcluded(templateversion.FieldLifecycle)
+	return u
+}
+
+// ClearLifecycle clears the value of the "lifecycle" field.
+func (u *TemplateVersionUpsert) ClearLifecycle() *TemplateVersionUpsert {
+	u.SetNull(templateversion.FieldLifecycle)
+	return u
+}
+
+// SetLifecycleReason sets the "lifecycle_reason" field.
+func (u *TemplateVersionUpsert) SetLifecycleReason(v string) *TemplateVersionUpsert {
+	u.Set(templateversion.FieldLifecycleReason, v)
+	return u
+}
+
+// UpdateLifecycleReason sets the "lifecycle_reason" field to the value that was provided on create.
+func (u *TemplateVersionUpsert) UpdateLifecycleReason() *TemplateVersionUpsert {
+	u.SetExcluded(templateversion.FieldLifecycleReason)
+	return u
+}
+
+// ClearLifecycleReason clears the value of the "lifecycle_reason" field.
+func (u *TemplateVersionUpsert) ClearLifecycleReason() *TemplateVersionUpsert {
+	u.SetNull(templateversion.FieldLifecycleReason)
+	return u
+}
+
+// SetTestResults sets the "test_results" field.
+func (u *TemplateVersionUpsert) SetTestResults(v []types.TemplateVersionTestResult) *TemplateVersionUpsert {
+	u.Set(templateversion.FieldTestResults, v)
+	return u
+}
+
+// UpdateTestResults sets the "test_results" field to the value that was provided on create.
+func (u *TemplateVersionUpsert) UpdateTestResults() *TemplateVersionUpsert {
+	u.SetExcluded(templateversion.FieldTestResults)
+	return u
+}
+
+// ClearTestResults clears the value of the "test_results" field.
+func (u *TemplateVersionUpsert) ClearTestResults() *TemplateVersionUpsert {
+	u.SetNull(templateversion.FieldTestResults)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -994,6 +1198,27 @@ func (u *TemplateVersionUpsertOne) UpdateUpdateTime() *TemplateVersionUpsertOne
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *TemplateVersionUpsertOne) SetStatus(v status.Status) *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TemplateVersionUpsertOne) UpdateStatus() *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TemplateVersionUpsertOne) ClearStatus() *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.ClearStatus()
+	})
+}
+
 // SetSchema sets the "schema" field.
 func (u *TemplateVersionUpsertOne) SetSchema(v types.TemplateVersionSchema) *TemplateVersionUpsertOne {
 	return u.Update(func(s *TemplateVersionUpsert) {
@@ -1057,6 +1282,69 @@ func (u *TemplateVersionUpsertOne) ClearSchemaDefaultValue() *TemplateVersionUps
 	})
 }
 
+// SetLifecycle sets the "lifecycle" field.
+func (u *TemplateVersionUpsertOne) SetLifecycle(v string) *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.SetLifecycle(v)
+	})
+}
+
+// UpdateLifecycle sets the "lifecycle" field to the value that was provided on create.
+func (u *TemplateVersionUpsertOne) UpdateLifecycle() *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.UpdateLifecycle()
+	})
+}
+
+// ClearLifecycle clears the value of the "lifecycle" field.
+func (u *TemplateVersionUpsertOne) ClearLifecycle() *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.ClearLifecycle()
+	})
+}
+
+// SetLifecycleReason sets the "lifecycle_reason" field.
+func (u *TemplateVersionUpsertOne) SetLifecycleReason(v string) *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.SetLifecycleReason(v)
+	})
+}
+
+// UpdateLifecycleReason sets the "lifecycle_reason" field to the value that was provided on create.
+func (u *TemplateVersionUpsertOne) UpdateLifecycleReason() *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.UpdateLifecycleReason()
+	})
+}
+
+// ClearLifecycleReason clears the value of the "lifecycle_reason" field.
+func (u *TemplateVersionUpsertOne) ClearLifecycleReason() *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.ClearLifecycleReason()
+	})
+}
+
+// SetTestResults sets the "test_results" field.
+func (u *TemplateVersionUpsertOne) SetTestResults(v []types.TemplateVersionTestResult) *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.SetTestResults(v)
+	})
+}
+
+// UpdateTestResults sets the "test_results" field to the value that was provided on create.
+func (u *TemplateVersionUpsertOne) UpdateTestResults() *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.UpdateTestResults()
+	})
+}
+
+// ClearTestResults clears the value of the "test_results" field.
+func (u *TemplateVersionUpsertOne) ClearTestResults() *TemplateVersionUpsertOne {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.ClearTestResults()
+	})
+}
+
 // Exec executes the query.
 func (u *TemplateVersionUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1306,6 +1594,27 @@ func (u *TemplateVersionUpsertBulk) UpdateUpdateTime() *TemplateVersionUpsertBul
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *TemplateVersionUpsertBulk) SetStatus(v status.Status) *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TemplateVersionUpsertBulk) UpdateStatus() *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TemplateVersionUpsertBulk) ClearStatus() *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.ClearStatus()
+	})
+}
+
 // SetSchema sets the "schema" field.
 func (u *TemplateVersionUpsertBulk) SetSchema(v types.TemplateVersionSchema) *TemplateVersionUpsertBulk {
 	return u.Update(func(s *TemplateVersionUpsert) {
@@ -1369,6 +1678,69 @@ func (u *TemplateVersionUpsertBulk) ClearSchemaDefaultValue() *TemplateVersionUp
 	})
 }
 
+// SetLifecycle sets the "lifecycle" field.
+func (u *TemplateVersionUpsertBulk) SetLifecycle(v string) *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.SetLifecycle(v)
+	})
+}
+
+// UpdateLifecycle sets the "lifecycle" field to the value that was provided on create.
+func (u *TemplateVersionUpsertBulk) UpdateLifecycle() *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.UpdateLifecycle()
+	})
+}
+
+// ClearLifecycle clears the value of the "lifecycle" field.
+func (u *TemplateVersionUpsertBulk) ClearLifecycle() *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.ClearLifecycle()
+	})
+}
+
+// SetLifecycleReason sets the "lifecycle_reason" field.
+func (u *TemplateVersionUpsertBulk) SetLifecycleReason(v string) *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.SetLifecycleReason(v)
+	})
+}
+
+// UpdateLifecycleReason sets the "lifecycle_reason" field to the value that was provided on create.
+func (u *TemplateVersionUpsertBulk) UpdateLifecycleReason() *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.UpdateLifecycleReason()
+	})
+}
+
+// ClearLifecycleReason clears the value of the "lifecycle_reason" field.
+func (u *TemplateVersionUpsertBulk) ClearLifecycleReason() *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.ClearLifecycleReason()
+	})
+}
+
+// SetTestResults sets the "test_results" field.
+func (u *TemplateVersionUpsertBulk) SetTestResults(v []types.TemplateVersionTestResult) *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.SetTestResults(v)
+	})
+}
+
+// UpdateTestResults sets the "test_results" field to the value that was provided on create.
+func (u *TemplateVersionUpsertBulk) UpdateTestResults() *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.UpdateTestResults()
+	})
+}
+
+// ClearTestResults clears the value of the "test_results" field.
+func (u *TemplateVersionUpsertBulk) ClearTestResults() *TemplateVersionUpsertBulk {
+	return u.Update(func(s *TemplateVersionUpsert) {
+		s.ClearTestResults()
+	})
+}
+
 // Exec executes the query.
 func (u *TemplateVersionUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -22,6 +22,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinitionmatchingrule"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
@@ -35,6 +36,7 @@ type TemplateVersionQuery struct {
 	predicates              []predicate.TemplateVersion
 	withTemplate            *TemplateQuery
 	withResources           *ResourceQuery
+	withUpgradeCampaigns    *TemplateUpgradeCampaignQuery
 	withResourceDefinitions *ResourceDefinitionMatchingRuleQuery
 	withProject             *ProjectQuery
 	modifiers               []func(*sql.Selector)
@@ -124,6 +126,31 @@ func (tvq *TemplateVersionQuery) QueryResources() *ResourceQuery {
 	return query
 }
 
+// QueryUpgradeCampaigns chains the current query on the "upgrade_campaigns" edge.
+func (tvq *TemplateVersionQuery) QueryUpgradeCampaigns() *TemplateUpgradeCampaignQuery {
+	query := (&TemplateUpgradeCampaignClient{config: tvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(templateversion.Table, templateversion.FieldID, selector),
+			sqlgraph.To(templateupgradecampaign.Table, templateupgradecampaign.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, templateversion.UpgradeCampaignsTable, templateversion.UpgradeCampaignsColumn),
+		)
+		schemaConfig := tvq.schemaConfig
+		step.To.Schema = schemaConfig.TemplateUpgradeCampaign
+		step.Edge.Schema = schemaConfig.TemplateUpgradeCampaign
+		fromU = sqlgraph.SetNeighbors(tvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryResourceDefinitions chains the current query on the "resource_definitions" edge.
 func (tvq *TemplateVersionQuery) QueryResourceDefinitions() *ResourceDefinitionMatchingRuleQuery {
 	query := (&ResourceDefinitionMatchingRuleClient{config: tvq.config}).Query()
@@ -368,6 +395,7 @@ func (tvq *TemplateVersionQuery) Clone() *TemplateVersionQuery {
 		predicates:              append([]predicate.TemplateVersion{}, tvq.predicates...),
 		withTemplate:            tvq.withTemplate.Clone(),
 		withResources:           tvq.withResources.Clone(),
+		withUpgradeCampaigns:    tvq.withUpgradeCampaigns.Clone(),
 		withResourceDefinitions: tvq.withResourceDefinitions.Clone(),
 		withProject:             tvq.withProject.Clone(),
 		// clone intermediate query.
@@ -398,6 +426,17 @@ func (tvq *TemplateVersionQuery) WithResources(opts ...func(*ResourceQuery)) *Te
 	return tvq
 }
 
+// WithUpgradeCampaigns tells the query-builder to eager-load the nodes that are connected to
+// the "upgrade_campaigns" edge. The optional arguments are used to configure the query builder of the edge.
+func (tvq *TemplateVersionQuery) WithUpgradeCampaigns(opts ...func(*TemplateUpgradeCampaignQuery)) *TemplateVersionQuery {
+	query := (&TemplateUpgradeCampaignClient{config: tvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tvq.withUpgradeCampaigns = query
+	return tvq
+}
+
 // WithResourceDefinitions tells the query-builder to eager-load the nodes that are connected to
 // the "resource_definitions" edge. The optional arguments are used to configure the query builder of the edge.
 func (tvq *TemplateVersionQuery) WithResourceDefinitions(opts ...func(*ResourceDefinitionMatchingRuleQuery)) *TemplateVersionQuery {
@@ -498,9 +537,10 @@ func (tvq *TemplateVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*TemplateVersion{}
 		_spec       = tvq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			tvq.withTemplate != nil,
 			tvq.withResources != nil,
+			tvq.withUpgradeCampaigns != nil,
 			tvq.withResourceDefinitions != nil,
 			tvq.withProject != nil,
 		}
@@ -541,6 +581,15 @@ func (tvq *TemplateVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := tvq.withUpgradeCampaigns; query != nil {
+		if err := tvq.loadUpgradeCampaigns(ctx, query, nodes,
+			func(n *TemplateVersion) { n.Edges.UpgradeCampaigns = []*TemplateUpgradeCampaign{} },
+			func(n *TemplateVersion, e *TemplateUpgradeCampaign) {
+				n.Edges.UpgradeCampaigns = append(n.Edges.UpgradeCampaigns, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := tvq.withResourceDefinitions; query != nil {
 		if err := tvq.loadResourceDefinitions(ctx, query, nodes,
 			func(n *TemplateVersion) { n.Edges.ResourceDefinitions = []*ResourceDefinitionMatchingRule{} },
@@ -621,6 +670,36 @@ func (tvq *TemplateVersionQuery) loadResources(ctx context.Context, query *Resou
 	}
 	return nil
 }
+func (tvq *TemplateVersionQuery) loadUpgradeCampaigns(ctx context.Context, query *TemplateUpgradeCampaignQuery, nodes []*TemplateVersion, init func(*TemplateVersion), assign func(*TemplateVersion, *TemplateUpgradeCampaign)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[object.ID]*TemplateVersion)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(templateupgradecampaign.FieldTemplateVersionID)
+	}
+	query.Where(predicate.TemplateUpgradeCampaign(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(templateversion.UpgradeCampaignsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TemplateVersionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "template_version_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (tvq *TemplateVersionQuery) loadResourceDefinitions(ctx context.Context, query *ResourceDefinitionMatchingRuleQuery, nodes []*TemplateVersion, init func(*TemplateVersion), assign func(*TemplateVersion, *ResourceDefinitionMatchingRule)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[object.ID]*TemplateVersion)
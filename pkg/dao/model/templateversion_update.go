@@ -17,15 +17,18 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 
 	"github.com/seal-io/walrus/pkg/dao/model/internal"
 	"github.com/seal-io/walrus/pkg/dao/model/predicate"
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcedefinitionmatchingrule"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
 )
 
 // TemplateVersionUpdate is the builder for updating TemplateVersion entities.
@@ -49,6 +52,26 @@ func (tvu *TemplateVersionUpdate) SetUpdateTime(t time.Time) *TemplateVersionUpd
 	return tvu
 }
 
+// SetStatus sets the "status" field.
+func (tvu *TemplateVersionUpdate) SetStatus(s status.Status) *TemplateVersionUpdate {
+	tvu.mutation.SetStatus(s)
+	return tvu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tvu *TemplateVersionUpdate) SetNillableStatus(s *status.Status) *TemplateVersionUpdate {
+	if s != nil {
+		tvu.SetStatus(*s)
+	}
+	return tvu
+}
+
+// ClearStatus clears the value of the "status" field.
+func (tvu *TemplateVersionUpdate) ClearStatus() *TemplateVersionUpdate {
+	tvu.mutation.ClearStatus()
+	return tvu
+}
+
 // SetSchema sets the "schema" field.
 func (tvu *TemplateVersionUpdate) SetSchema(tvs types.TemplateVersionSchema) *TemplateVersionUpdate {
 	tvu.mutation.SetSchema(tvs)
@@ -103,6 +126,64 @@ func (tvu *TemplateVersionUpdate) ClearSchemaDefaultValue() *TemplateVersionUpda
 	return tvu
 }
 
+// SetLifecycle sets the "lifecycle" field.
+func (tvu *TemplateVersionUpdate) SetLifecycle(s string) *TemplateVersionUpdate {
+	tvu.mutation.SetLifecycle(s)
+	return tvu
+}
+
+// SetNillableLifecycle sets the "lifecycle" field if the given value is not nil.
+func (tvu *TemplateVersionUpdate) SetNillableLifecycle(s *string) *TemplateVersionUpdate {
+	if s != nil {
+		tvu.SetLifecycle(*s)
+	}
+	return tvu
+}
+
+// ClearLifecycle clears the value of the "lifecycle" field.
+func (tvu *TemplateVersionUpdate) ClearLifecycle() *TemplateVersionUpdate {
+	tvu.mutation.ClearLifecycle()
+	return tvu
+}
+
+// SetLifecycleReason sets the "lifecycle_reason" field.
+func (tvu *TemplateVersionUpdate) SetLifecycleReason(s string) *TemplateVersionUpdate {
+	tvu.mutation.SetLifecycleReason(s)
+	return tvu
+}
+
+// SetNillableLifecycleReason sets the "lifecycle_reason" field if the given value is not nil.
+func (tvu *TemplateVersionUpdate) SetNillableLifecycleReason(s *string) *TemplateVersionUpdate {
+	if s != nil {
+		tvu.SetLifecycleReason(*s)
+	}
+	return tvu
+}
+
+// ClearLifecycleReason clears the value of the "lifecycle_reason" field.
+func (tvu *TemplateVersionUpdate) ClearLifecycleReason() *TemplateVersionUpdate {
+	tvu.mutation.ClearLifecycleReason()
+	return tvu
+}
+
+// SetTestResults sets the "test_results" field.
+func (tvu *TemplateVersionUpdate) SetTestResults(tvtr []types.TemplateVersionTestResult) *TemplateVersionUpdate {
+	tvu.mutation.SetTestResults(tvtr)
+	return tvu
+}
+
+// AppendTestResults appends tvtr to the "test_results" field.
+func (tvu *TemplateVersionUpdate) AppendTestResults(tvtr []types.TemplateVersionTestResult) *TemplateVersionUpdate {
+	tvu.mutation.AppendTestResults(tvtr)
+	return tvu
+}
+
+// ClearTestResults clears the value of the "test_results" field.
+func (tvu *TemplateVersionUpdate) ClearTestResults() *TemplateVersionUpdate {
+	tvu.mutation.ClearTestResults()
+	return tvu
+}
+
 // AddResourceIDs adds the "resources" edge to the Resource entity by IDs.
 func (tvu *TemplateVersionUpdate) AddResourceIDs(ids ...object.ID) *TemplateVersionUpdate {
 	tvu.mutation.AddResourceIDs(ids...)
@@ -118,6 +199,21 @@ func (tvu *TemplateVersionUpdate) AddResources(r ...*Resource) *TemplateVersionU
 	return tvu.AddResourceIDs(ids...)
 }
 
+// AddUpgradeCampaignIDs adds the "upgrade_campaigns" edge to the TemplateUpgradeCampaign entity by IDs.
+func (tvu *TemplateVersionUpdate) AddUpgradeCampaignIDs(ids ...object.ID) *TemplateVersionUpdate {
+	tvu.mutation.AddUpgradeCampaignIDs(ids...)
+	return tvu
+}
+
+// AddUpgradeCampaigns adds the "upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (tvu *TemplateVersionUpdate) AddUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *TemplateVersionUpdate {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tvu.AddUpgradeCampaignIDs(ids...)
+}
+
 // AddResourceDefinitionIDs adds the "resource_definitions" edge to the ResourceDefinitionMatchingRule entity by IDs.
 func (tvu *TemplateVersionUpdate) AddResourceDefinitionIDs(ids ...object.ID) *TemplateVersionUpdate {
 	tvu.mutation.AddResourceDefinitionIDs(ids...)
@@ -159,6 +255,27 @@ func (tvu *TemplateVersionUpdate) RemoveResources(r ...*Resource) *TemplateVersi
 	return tvu.RemoveResourceIDs(ids...)
 }
 
+// ClearUpgradeCampaigns clears all "upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (tvu *TemplateVersionUpdate) ClearUpgradeCampaigns() *TemplateVersionUpdate {
+	tvu.mutation.ClearUpgradeCampaigns()
+	return tvu
+}
+
+// RemoveUpgradeCampaignIDs removes the "upgrade_campaigns" edge to TemplateUpgradeCampaign entities by IDs.
+func (tvu *TemplateVersionUpdate) RemoveUpgradeCampaignIDs(ids ...object.ID) *TemplateVersionUpdate {
+	tvu.mutation.RemoveUpgradeCampaignIDs(ids...)
+	return tvu
+}
+
+// RemoveUpgradeCampaigns removes "upgrade_campaigns" edges to TemplateUpgradeCampaign entities.
+func (tvu *TemplateVersionUpdate) RemoveUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *TemplateVersionUpdate {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tvu.RemoveUpgradeCampaignIDs(ids...)
+}
+
 // ClearResourceDefinitions clears all "resource_definitions" edges to the ResourceDefinitionMatchingRule entity.
 func (tvu *TemplateVersionUpdate) ClearResourceDefinitions() *TemplateVersionUpdate {
 	tvu.mutation.ClearResourceDefinitions()
@@ -278,12 +395,24 @@ func (tvu *TemplateVersionUpdate) check() error {
 //	}
 func (tvu *TemplateVersionUpdate) Set(obj *TemplateVersion) *TemplateVersionUpdate {
 	// Without Default.
+	if !reflect.ValueOf(obj.Status).IsZero() {
+		tvu.SetStatus(obj.Status)
+	}
 	tvu.SetSchema(obj.Schema)
 	tvu.SetOriginalUISchema(obj.OriginalUISchema)
 	tvu.SetUISchema(obj.UISchema)
 	if !reflect.ValueOf(obj.SchemaDefaultValue).IsZero() {
 		tvu.SetSchemaDefaultValue(obj.SchemaDefaultValue)
 	}
+	if obj.Lifecycle != "" {
+		tvu.SetLifecycle(obj.Lifecycle)
+	}
+	if obj.LifecycleReason != "" {
+		tvu.SetLifecycleReason(obj.LifecycleReason)
+	}
+	if !reflect.ValueOf(obj.TestResults).IsZero() {
+		tvu.SetTestResults(obj.TestResults)
+	}
 
 	// With Default.
 	if obj.UpdateTime != nil {
@@ -317,6 +446,12 @@ func (tvu *TemplateVersionUpdate) sqlSave(ctx context.Context) (n int, err error
 	if value, ok := tvu.mutation.UpdateTime(); ok {
 		_spec.SetField(templateversion.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := tvu.mutation.Status(); ok {
+		_spec.SetField(templateversion.FieldStatus, field.TypeJSON, value)
+	}
+	if tvu.mutation.StatusCleared() {
+		_spec.ClearField(templateversion.FieldStatus, field.TypeJSON)
+	}
 	if value, ok := tvu.mutation.Schema(); ok {
 		_spec.SetField(templateversion.FieldSchema, field.TypeJSON, value)
 	}
@@ -332,6 +467,29 @@ func (tvu *TemplateVersionUpdate) sqlSave(ctx context.Context) (n int, err error
 	if tvu.mutation.SchemaDefaultValueCleared() {
 		_spec.ClearField(templateversion.FieldSchemaDefaultValue, field.TypeBytes)
 	}
+	if value, ok := tvu.mutation.Lifecycle(); ok {
+		_spec.SetField(templateversion.FieldLifecycle, field.TypeString, value)
+	}
+	if tvu.mutation.LifecycleCleared() {
+		_spec.ClearField(templateversion.FieldLifecycle, field.TypeString)
+	}
+	if value, ok := tvu.mutation.LifecycleReason(); ok {
+		_spec.SetField(templateversion.FieldLifecycleReason, field.TypeString, value)
+	}
+	if tvu.mutation.LifecycleReasonCleared() {
+		_spec.ClearField(templateversion.FieldLifecycleReason, field.TypeString)
+	}
+	if value, ok := tvu.mutation.TestResults(); ok {
+		_spec.SetField(templateversion.FieldTestResults, field.TypeJSON, value)
+	}
+	if value, ok := tvu.mutation.AppendedTestResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, templateversion.FieldTestResults, value)
+		})
+	}
+	if tvu.mutation.TestResultsCleared() {
+		_spec.ClearField(templateversion.FieldTestResults, field.TypeJSON)
+	}
 	if tvu.mutation.ResourcesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -380,6 +538,54 @@ func (tvu *TemplateVersionUpdate) sqlSave(ctx context.Context) (n int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tvu.mutation.UpgradeCampaignsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   templateversion.UpgradeCampaignsTable,
+			Columns: []string{templateversion.UpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvu.schemaConfig.TemplateUpgradeCampaign
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvu.mutation.RemovedUpgradeCampaignsIDs(); len(nodes) > 0 && !tvu.mutation.UpgradeCampaignsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   templateversion.UpgradeCampaignsTable,
+			Columns: []string{templateversion.UpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvu.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvu.mutation.UpgradeCampaignsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   templateversion.UpgradeCampaignsTable,
+			Columns: []string{templateversion.UpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvu.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tvu.mutation.ResourceDefinitionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -459,6 +665,26 @@ func (tvuo *TemplateVersionUpdateOne) SetUpdateTime(t time.Time) *TemplateVersio
 	return tvuo
 }
 
+// SetStatus sets the "status" field.
+func (tvuo *TemplateVersionUpdateOne) SetStatus(s status.Status) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetStatus(s)
+	return tvuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tvuo *TemplateVersionUpdateOne) SetNillableStatus(s *status.Status) *TemplateVersionUpdateOne {
+	if s != nil {
+		tvuo.SetStatus(*s)
+	}
+	return tvuo
+}
+
+// ClearStatus clears the value of the "status" field.
+func (tvuo *TemplateVersionUpdateOne) ClearStatus() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearStatus()
+	return tvuo
+}
+
 // SetSchema sets the "schema" field.
 func (tvuo *TemplateVersionUpdateOne) SetSchema(tvs types.TemplateVersionSchema) *TemplateVersionUpdateOne {
 	tvuo.mutation.SetSchema(tvs)
@@ -513,6 +739,64 @@ func (tvuo *TemplateVersionUpdateOne) ClearSchemaDefaultValue() *TemplateVersion
 	return tvuo
 }
 
+// SetLifecycle sets the "lifecycle" field.
+func (tvuo *TemplateVersionUpdateOne) SetLifecycle(s string) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetLifecycle(s)
+	return tvuo
+}
+
+// SetNillableLifecycle sets the "lifecycle" field if the given value is not nil.
+func (tvuo *TemplateVersionUpdateOne) SetNillableLifecycle(s *string) *TemplateVersionUpdateOne {
+	if s != nil {
+		tvuo.SetLifecycle(*s)
+	}
+	return tvuo
+}
+
+// ClearLifecycle clears the value of the "lifecycle" field.
+func (tvuo *TemplateVersionUpdateOne) ClearLifecycle() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearLifecycle()
+	return tvuo
+}
+
+// SetLifecycleReason sets the "lifecycle_reason" field.
+func (tvuo *TemplateVersionUpdateOne) SetLifecycleReason(s string) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetLifecycleReason(s)
+	return tvuo
+}
+
+// SetNillableLifecycleReason sets the "lifecycle_reason" field if the given value is not nil.
+func (tvuo *TemplateVersionUpdateOne) SetNillableLifecycleReason(s *string) *TemplateVersionUpdateOne {
+	if s != nil {
+		tvuo.SetLifecycleReason(*s)
+	}
+	return tvuo
+}
+
+// ClearLifecycleReason clears the value of the "lifecycle_reason" field.
+func (tvuo *TemplateVersionUpdateOne) ClearLifecycleReason() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearLifecycleReason()
+	return tvuo
+}
+
+// SetTestResults sets the "test_results" field.
+func (tvuo *TemplateVersionUpdateOne) SetTestResults(tvtr []types.TemplateVersionTestResult) *TemplateVersionUpdateOne {
+	tvuo.mutation.SetTestResults(tvtr)
+	return tvuo
+}
+
+// AppendTestResults appends tvtr to the "test_results" field.
+func (tvuo *TemplateVersionUpdateOne) AppendTestResults(tvtr []types.TemplateVersionTestResult) *TemplateVersionUpdateOne {
+	tvuo.mutation.AppendTestResults(tvtr)
+	return tvuo
+}
+
+// ClearTestResults clears the value of the "test_results" field.
+func (tvuo *TemplateVersionUpdateOne) ClearTestResults() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearTestResults()
+	return tvuo
+}
+
 // AddResourceIDs adds the "resources" edge to the Resource entity by IDs.
 func (tvuo *TemplateVersionUpdateOne) AddResourceIDs(ids ...object.ID) *TemplateVersionUpdateOne {
 	tvuo.mutation.AddResourceIDs(ids...)
@@ -528,6 +812,21 @@ func (tvuo *TemplateVersionUpdateOne) AddResources(r ...*Resource) *TemplateVers
 	return tvuo.AddResourceIDs(ids...)
 }
 
+// AddUpgradeCampaignIDs adds the "upgrade_campaigns" edge to the TemplateUpgradeCampaign entity by IDs.
+func (tvuo *TemplateVersionUpdateOne) AddUpgradeCampaignIDs(ids ...object.ID) *TemplateVersionUpdateOne {
+	tvuo.mutation.AddUpgradeCampaignIDs(ids...)
+	return tvuo
+}
+
+// AddUpgradeCampaigns adds the "upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (tvuo *TemplateVersionUpdateOne) AddUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *TemplateVersionUpdateOne {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tvuo.AddUpgradeCampaignIDs(ids...)
+}
+
 // AddResourceDefinitionIDs adds the "resource_definitions" edge to the ResourceDefinitionMatchingRule entity by IDs.
 func (tvuo *TemplateVersionUpdateOne) AddResourceDefinitionIDs(ids ...object.ID) *TemplateVersionUpdateOne {
 	tvuo.mutation.AddResourceDefinitionIDs(ids...)
@@ -569,6 +868,27 @@ func (tvuo *TemplateVersionUpdateOne) RemoveResources(r ...*Resource) *TemplateV
 	return tvuo.RemoveResourceIDs(ids...)
 }
 
+// ClearUpgradeCampaigns clears all "upgrade_campaigns" edges to the TemplateUpgradeCampaign entity.
+func (tvuo *TemplateVersionUpdateOne) ClearUpgradeCampaigns() *TemplateVersionUpdateOne {
+	tvuo.mutation.ClearUpgradeCampaigns()
+	return tvuo
+}
+
+// RemoveUpgradeCampaignIDs removes the "upgrade_campaigns" edge to TemplateUpgradeCampaign entities by IDs.
+func (tvuo *TemplateVersionUpdateOne) RemoveUpgradeCampaignIDs(ids ...object.ID) *TemplateVersionUpdateOne {
+	tvuo.mutation.RemoveUpgradeCampaignIDs(ids...)
+	return tvuo
+}
+
+// RemoveUpgradeCampaigns removes "upgrade_campaigns" edges to TemplateUpgradeCampaign entities.
+func (tvuo *TemplateVersionUpdateOne) RemoveUpgradeCampaigns(t ...*TemplateUpgradeCampaign) *TemplateVersionUpdateOne {
+	ids := make([]object.ID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tvuo.RemoveUpgradeCampaignIDs(ids...)
+}
+
 // ClearResourceDefinitions clears all "resource_definitions" edges to the ResourceDefinitionMatchingRule entity.
 func (tvuo *TemplateVersionUpdateOne) ClearResourceDefinitions() *TemplateVersionUpdateOne {
 	tvuo.mutation.ClearResourceDefinitions()
@@ -711,6 +1031,11 @@ func (tvuo *TemplateVersionUpdateOne) Set(obj *TemplateVersion) *TemplateVersion
 			}
 
 			// Without Default.
+			if !reflect.ValueOf(obj.Status).IsZero() {
+				if !db.Status.Equal(obj.Status) {
+					tvuo.SetStatus(obj.Status)
+				}
+			}
 			if !reflect.DeepEqual(db.Schema, obj.Schema) {
 				tvuo.SetSchema(obj.Schema)
 			}
@@ -725,6 +1050,21 @@ func (tvuo *TemplateVersionUpdateOne) Set(obj *TemplateVersion) *TemplateVersion
 					tvuo.SetSchemaDefaultValue(obj.SchemaDefaultValue)
 				}
 			}
+			if obj.Lifecycle != "" {
+				if db.Lifecycle != obj.Lifecycle {
+					tvuo.SetLifecycle(obj.Lifecycle)
+				}
+			}
+			if obj.LifecycleReason != "" {
+				if db.LifecycleReason != obj.LifecycleReason {
+					tvuo.SetLifecycleReason(obj.LifecycleReason)
+				}
+			}
+			if !reflect.ValueOf(obj.TestResults).IsZero() {
+				if !reflect.DeepEqual(db.TestResults, obj.TestResults) {
+					tvuo.SetTestResults(obj.TestResults)
+				}
+			}
 
 			// With Default.
 			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
@@ -775,6 +1115,9 @@ func (tvuo *TemplateVersionUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx
 	if obj == nil {
 		obj = tvuo.object
 	} else if x := tvuo.object; x != nil {
+		if _, set := tvuo.mutation.Field(templateversion.FieldStatus); set {
+			obj.Status = x.Status
+		}
 		if _, set := tvuo.mutation.Field(templateversion.FieldSchema); set {
 			obj.Schema = x.Schema
 		}
@@ -787,6 +1130,15 @@ func (tvuo *TemplateVersionUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx
 		if _, set := tvuo.mutation.Field(templateversion.FieldSchemaDefaultValue); set {
 			obj.SchemaDefaultValue = x.SchemaDefaultValue
 		}
+		if _, set := tvuo.mutation.Field(templateversion.FieldLifecycle); set {
+			obj.Lifecycle = x.Lifecycle
+		}
+		if _, set := tvuo.mutation.Field(templateversion.FieldLifecycleReason); set {
+			obj.LifecycleReason = x.LifecycleReason
+		}
+		if _, set := tvuo.mutation.Field(templateversion.FieldTestResults); set {
+			obj.TestResults = x.TestResults
+		}
 		obj.Edges = x.Edges
 	}
 
@@ -860,6 +1212,12 @@ func (tvuo *TemplateVersionUpdateOne) sqlSave(ctx context.Context) (_node *Templ
 	if value, ok := tvuo.mutation.UpdateTime(); ok {
 		_spec.SetField(templateversion.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := tvuo.mutation.Status(); ok {
+		_spec.SetField(templateversion.FieldStatus, field.TypeJSON, value)
+	}
+	if tvuo.mutation.StatusCleared() {
+		_spec.ClearField(templateversion.FieldStatus, field.TypeJSON)
+	}
 	if value, ok := tvuo.mutation.Schema(); ok {
 		_spec.SetField(templateversion.FieldSchema, field.TypeJSON, value)
 	}
@@ -875,6 +1233,29 @@ func (tvuo *TemplateVersionUpdateOne) sqlSave(ctx context.Context) (_node *Templ
 	if tvuo.mutation.SchemaDefaultValueCleared() {
 		_spec.ClearField(templateversion.FieldSchemaDefaultValue, field.TypeBytes)
 	}
+	if value, ok := tvuo.mutation.Lifecycle(); ok {
+		_spec.SetField(templateversion.FieldLifecycle, field.TypeString, value)
+	}
+	if tvuo.mutation.LifecycleCleared() {
+		_spec.ClearField(templateversion.FieldLifecycle, field.TypeString)
+	}
+	if value, ok := tvuo.mutation.LifecycleReason(); ok {
+		_spec.SetField(templateversion.FieldLifecycleReason, field.TypeString, value)
+	}
+	if tvuo.mutation.LifecycleReasonCleared() {
+		_spec.ClearField(templateversion.FieldLifecycleReason, field.TypeString)
+	}
+	if value, ok := tvuo.mutation.TestResults(); ok {
+		_spec.SetField(templateversion.FieldTestResults, field.TypeJSON, value)
+	}
+	if value, ok := tvuo.mutation.AppendedTestResults(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, templateversion.FieldTestResults, value)
+		})
+	}
+	if tvuo.mutation.TestResultsCleared() {
+		_spec.ClearField(templateversion.FieldTestResults, field.TypeJSON)
+	}
 	if tvuo.mutation.ResourcesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -923,6 +1304,54 @@ func (tvuo *TemplateVersionUpdateOne) sqlSave(ctx context.Context) (_node *Templ
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tvuo.mutation.UpgradeCampaignsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   templateversion.UpgradeCampaignsTable,
+			Columns: []string{templateversion.UpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvuo.schemaConfig.TemplateUpgradeCampaign
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvuo.mutation.RemovedUpgradeCampaignsIDs(); len(nodes) > 0 && !tvuo.mutation.UpgradeCampaignsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   templateversion.UpgradeCampaignsTable,
+			Columns: []string{templateversion.UpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvuo.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tvuo.mutation.UpgradeCampaignsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   templateversion.UpgradeCampaignsTable,
+			Columns: []string{templateversion.UpgradeCampaignsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(templateupgradecampaign.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = tvuo.schemaConfig.TemplateUpgradeCampaign
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tvuo.mutation.ResourceDefinitionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -17,6 +17,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/utils/json"
 )
 
@@ -382,6 +383,8 @@ type TemplateVersionPatchInput struct {
 	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// Status holds the value of the "status" field.
+	Status status.Status `path:"-" query:"-" json:"status,omitempty"`
 	// Name of the template.
 	Name string `path:"-" query:"-" json:"name,omitempty"`
 	// Version of the template.
@@ -396,6 +399,12 @@ type TemplateVersionPatchInput struct {
 	UISchema types.UISchema `path:"-" query:"-" json:"uiSchema,omitempty"`
 	// Default value generated from schema and ui schema
 	SchemaDefaultValue []byte `path:"-" query:"-" json:"schemaDefaultValue,omitempty"`
+	// Lifecycle state of the template version, active, deprecated or blocked.
+	Lifecycle string `path:"-" query:"-" json:"lifecycle,omitempty"`
+	// Reason of the lifecycle state.
+	LifecycleReason string `path:"-" query:"-" json:"lifecycleReason,omitempty"`
+	// Results of testing the template version with the fixtures.
+	TestResults []types.TemplateVersionTestResult `path:"-" query:"-" json:"testResults,omitempty"`
 
 	patchedEntity *TemplateVersion `path:"-" query:"-" json:"-"`
 }
@@ -409,6 +418,7 @@ func (tvpi *TemplateVersionPatchInput) PatchModel() *TemplateVersion {
 	_tv := &TemplateVersion{
 		CreateTime:         tvpi.CreateTime,
 		UpdateTime:         tvpi.UpdateTime,
+		Status:             tvpi.Status,
 		Name:               tvpi.Name,
 		Version:            tvpi.Version,
 		Source:             tvpi.Source,
@@ -416,6 +426,9 @@ func (tvpi *TemplateVersionPatchInput) PatchModel() *TemplateVersion {
 		OriginalUISchema:   tvpi.OriginalUISchema,
 		UISchema:           tvpi.UISchema,
 		SchemaDefaultValue: tvpi.SchemaDefaultValue,
+		Lifecycle:          tvpi.Lifecycle,
+		LifecycleReason:    tvpi.LifecycleReason,
+		TestResults:        tvpi.TestResults,
 	}
 
 	if tvpi.Project != nil {
@@ -502,8 +515,12 @@ func (tvpi *TemplateVersionPatchInput) ValidateWith(ctx context.Context, cs Clie
 		templateversion.WithoutFields(
 			templateversion.FieldCreateTime,
 			templateversion.FieldUpdateTime,
+			templateversion.FieldStatus,
 			templateversion.FieldOriginalUISchema,
 			templateversion.FieldSchemaDefaultValue,
+			templateversion.FieldLifecycle,
+			templateversion.FieldLifecycleReason,
+			templateversion.FieldTestResults,
 		)...,
 	)
 
@@ -967,14 +984,18 @@ func (tvui *TemplateVersionUpdateInputs) ValidateWith(ctx context.Context, cs Cl
 
 // TemplateVersionOutput holds the output of the TemplateVersion entity.
 type TemplateVersionOutput struct {
-	ID         object.ID                   `json:"id,omitempty"`
-	CreateTime *time.Time                  `json:"createTime,omitempty"`
-	UpdateTime *time.Time                  `json:"updateTime,omitempty"`
-	Name       string                      `json:"name,omitempty"`
-	Version    string                      `json:"version,cli-table-column,omitempty"`
-	Source     string                      `json:"source,omitempty"`
-	Schema     types.TemplateVersionSchema `json:"schema,omitempty"`
-	UISchema   types.UISchema              `json:"uiSchema,omitempty"`
+	ID              object.ID                         `json:"id,omitempty"`
+	CreateTime      *time.Time                        `json:"createTime,omitempty"`
+	UpdateTime      *time.Time                        `json:"updateTime,omitempty"`
+	Status          status.Status                     `json:"status,omitempty"`
+	Name            string                            `json:"name,omitempty"`
+	Version         string                            `json:"version,cli-table-column,omitempty"`
+	Source          string                            `json:"source,omitempty"`
+	Schema          types.TemplateVersionSchema       `json:"schema,omitempty"`
+	UISchema        types.UISchema                    `json:"uiSchema,omitempty"`
+	Lifecycle       string                            `json:"lifecycle,omitempty"`
+	LifecycleReason string                            `json:"lifecycleReason,omitempty"`
+	TestResults     []types.TemplateVersionTestResult `json:"testResults,omitempty"`
 
 	Template *TemplateOutput `json:"template,omitempty"`
 	Project  *ProjectOutput  `json:"project,omitempty"`
@@ -997,14 +1018,18 @@ func ExposeTemplateVersion(_tv *TemplateVersion) *TemplateVersionOutput {
 	}
 
 	tvo := &TemplateVersionOutput{
-		ID:         _tv.ID,
-		CreateTime: _tv.CreateTime,
-		UpdateTime: _tv.UpdateTime,
-		Name:       _tv.Name,
-		Version:    _tv.Version,
-		Source:     _tv.Source,
-		Schema:     _tv.Schema,
-		UISchema:   _tv.UISchema,
+		ID:              _tv.ID,
+		CreateTime:      _tv.CreateTime,
+		UpdateTime:      _tv.UpdateTime,
+		Status:          _tv.Status,
+		Name:            _tv.Name,
+		Version:         _tv.Version,
+		Source:          _tv.Source,
+		Schema:          _tv.Schema,
+		UISchema:        _tv.UISchema,
+		Lifecycle:       _tv.Lifecycle,
+		LifecycleReason: _tv.LifecycleReason,
+		TestResults:     _tv.TestResults,
 	}
 
 	if _tv.Edges.Template != nil {
@@ -25,7 +25,7 @@ type TokenCreateInput struct {
 	inputConfig `path:"-" query:"-" json:"-"`
 
 	// The value of token, store in string.
-	Value crypto.String `path:"-" query:"-" json:"value"`
+	Value crypto.String `path:"-" query:"-" json:"value" sensitive:"true"`
 	// The name of token.
 	Name string `path:"-" query:"-" json:"name"`
 	// The kind of token.
@@ -76,7 +76,7 @@ func (tci *TokenCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cac
 // TokenCreateInputs holds the creation input item of the Token entities.
 type TokenCreateInputsItem struct {
 	// The value of token, store in string.
-	Value crypto.String `path:"-" query:"-" json:"value"`
+	Value crypto.String `path:"-" query:"-" json:"value" sensitive:"true"`
 	// The name of token.
 	Name string `path:"-" query:"-" json:"name"`
 	// The kind of token.
@@ -316,7 +316,7 @@ type TokenPatchInput struct {
 	// The time of expiration, empty means forever.
 	Expiration *time.Time `path:"-" query:"-" json:"expiration,omitempty"`
 	// The value of token, store in string.
-	Value crypto.String `path:"-" query:"-" json:"value,omitempty"`
+	Value crypto.String `path:"-" query:"-" json:"value,omitempty" sensitive:"true"`
 	// AccessToken is the token used for authentication.
 	AccessToken string `path:"-" query:"-" json:"accessToken,omitempty"`
 
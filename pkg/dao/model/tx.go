@@ -17,12 +17,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Budget is the client for interacting with the Budget builders.
+	Budget *BudgetClient
+	// BudgetEvent is the client for interacting with the BudgetEvent builders.
+	BudgetEvent *BudgetEventClient
 	// Catalog is the client for interacting with the Catalog builders.
 	Catalog *CatalogClient
+	// ChargebackStatement is the client for interacting with the ChargebackStatement builders.
+	ChargebackStatement *ChargebackStatementClient
 	// Connector is the client for interacting with the Connector builders.
 	Connector *ConnectorClient
+	// CostAnomaly is the client for interacting with the CostAnomaly builders.
+	CostAnomaly *CostAnomalyClient
 	// CostReport is the client for interacting with the CostReport builders.
 	CostReport *CostReportClient
+	// DataEncryptionKey is the client for interacting with the DataEncryptionKey builders.
+	DataEncryptionKey *DataEncryptionKeyClient
 	// DistributeLock is the client for interacting with the DistributeLock builders.
 	DistributeLock *DistributeLockClient
 	// Environment is the client for interacting with the Environment builders.
@@ -47,24 +59,40 @@ type Tx struct {
 	ResourceRelationship *ResourceRelationshipClient
 	// ResourceRun is the client for interacting with the ResourceRun builders.
 	ResourceRun *ResourceRunClient
+	// ResourceRunApprovalRule is the client for interacting with the ResourceRunApprovalRule builders.
+	ResourceRunApprovalRule *ResourceRunApprovalRuleClient
+	// ResourceRunPolicy is the client for interacting with the ResourceRunPolicy builders.
+	ResourceRunPolicy *ResourceRunPolicyClient
 	// ResourceState is the client for interacting with the ResourceState builders.
 	ResourceState *ResourceStateClient
+	// ResourceStateLock is the client for interacting with the ResourceStateLock builders.
+	ResourceStateLock *ResourceStateLockClient
+	// ResourceStateVersion is the client for interacting with the ResourceStateVersion builders.
+	ResourceStateVersion *ResourceStateVersionClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// StorageObject is the client for interacting with the StorageObject builders.
+	StorageObject *StorageObjectClient
 	// Subject is the client for interacting with the Subject builders.
 	Subject *SubjectClient
 	// SubjectRoleRelationship is the client for interacting with the SubjectRoleRelationship builders.
 	SubjectRoleRelationship *SubjectRoleRelationshipClient
 	// Template is the client for interacting with the Template builders.
 	Template *TemplateClient
+	// TemplateUpgradeCampaign is the client for interacting with the TemplateUpgradeCampaign builders.
+	TemplateUpgradeCampaign *TemplateUpgradeCampaignClient
 	// TemplateVersion is the client for interacting with the TemplateVersion builders.
 	TemplateVersion *TemplateVersionClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// Variable is the client for interacting with the Variable builders.
 	Variable *VariableClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 	// Workflow is the client for interacting with the Workflow builders.
 	Workflow *WorkflowClient
 	// WorkflowExecution is the client for interacting with the WorkflowExecution builders.
@@ -208,9 +236,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Budget = NewBudgetClient(tx.config)
+	tx.BudgetEvent = NewBudgetEventClient(tx.config)
 	tx.Catalog = NewCatalogClient(tx.config)
+	tx.ChargebackStatement = NewChargebackStatementClient(tx.config)
 	tx.Connector = NewConnectorClient(tx.config)
+	tx.CostAnomaly = NewCostAnomalyClient(tx.config)
 	tx.CostReport = NewCostReportClient(tx.config)
+	tx.DataEncryptionKey = NewDataEncryptionKeyClient(tx.config)
 	tx.DistributeLock = NewDistributeLockClient(tx.config)
 	tx.Environment = NewEnvironmentClient(tx.config)
 	tx.EnvironmentConnectorRelationship = NewEnvironmentConnectorRelationshipClient(tx.config)
@@ -223,15 +257,23 @@ func (tx *Tx) init() {
 	tx.ResourceDefinitionMatchingRule = NewResourceDefinitionMatchingRuleClient(tx.config)
 	tx.ResourceRelationship = NewResourceRelationshipClient(tx.config)
 	tx.ResourceRun = NewResourceRunClient(tx.config)
+	tx.ResourceRunApprovalRule = NewResourceRunApprovalRuleClient(tx.config)
+	tx.ResourceRunPolicy = NewResourceRunPolicyClient(tx.config)
 	tx.ResourceState = NewResourceStateClient(tx.config)
+	tx.ResourceStateLock = NewResourceStateLockClient(tx.config)
+	tx.ResourceStateVersion = NewResourceStateVersionClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
+	tx.StorageObject = NewStorageObjectClient(tx.config)
 	tx.Subject = NewSubjectClient(tx.config)
 	tx.SubjectRoleRelationship = NewSubjectRoleRelationshipClient(tx.config)
 	tx.Template = NewTemplateClient(tx.config)
+	tx.TemplateUpgradeCampaign = NewTemplateUpgradeCampaignClient(tx.config)
 	tx.TemplateVersion = NewTemplateVersionClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
 	tx.Variable = NewVariableClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 	tx.Workflow = NewWorkflowClient(tx.config)
 	tx.WorkflowExecution = NewWorkflowExecutionClient(tx.config)
 	tx.WorkflowStage = NewWorkflowStageClient(tx.config)
@@ -247,7 +289,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Catalog.QueryXXX(), the query will be executed
+// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -301,21 +343,51 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 
 var _ dialect.Driver = (*txDriver)(nil)
 
+// AuditLogs implements the ClientSet.
+func (tx *Tx) AuditLogs() *AuditLogClient {
+	return tx.AuditLog
+}
+
+// Budgets implements the ClientSet.
+func (tx *Tx) Budgets() *BudgetClient {
+	return tx.Budget
+}
+
+// BudgetEvents implements the ClientSet.
+func (tx *Tx) BudgetEvents() *BudgetEventClient {
+	return tx.BudgetEvent
+}
+
 // Catalogs implements the ClientSet.
 func (tx *Tx) Catalogs() *CatalogClient {
 	return tx.Catalog
 }
 
+// ChargebackStatements implements the ClientSet.
+func (tx *Tx) ChargebackStatements() *ChargebackStatementClient {
+	return tx.ChargebackStatement
+}
+
 // Connectors implements the ClientSet.
 func (tx *Tx) Connectors() *ConnectorClient {
 	return tx.Connector
 }
 
+// CostAnomalies implements the ClientSet.
+func (tx *Tx) CostAnomalies() *CostAnomalyClient {
+	return tx.CostAnomaly
+}
+
 // CostReports implements the ClientSet.
 func (tx *Tx) CostReports() *CostReportClient {
 	return tx.CostReport
 }
 
+// DataEncryptionKeys implements the ClientSet.
+func (tx *Tx) DataEncryptionKeys() *DataEncryptionKeyClient {
+	return tx.DataEncryptionKey
+}
+
 // DistributeLocks implements the ClientSet.
 func (tx *Tx) DistributeLocks() *DistributeLockClient {
 	return tx.DistributeLock
@@ -376,11 +448,31 @@ func (tx *Tx) ResourceRuns() *ResourceRunClient {
 	return tx.ResourceRun
 }
 
+// ResourceRunApprovalRules implements the ClientSet.
+func (tx *Tx) ResourceRunApprovalRules() *ResourceRunApprovalRuleClient {
+	return tx.ResourceRunApprovalRule
+}
+
+// ResourceRunPolicies implements the ClientSet.
+func (tx *Tx) ResourceRunPolicies() *ResourceRunPolicyClient {
+	return tx.ResourceRunPolicy
+}
+
 // ResourceStates implements the ClientSet.
 func (tx *Tx) ResourceStates() *ResourceStateClient {
 	return tx.ResourceState
 }
 
+// ResourceStateLocks implements the ClientSet.
+func (tx *Tx) ResourceStateLocks() *ResourceStateLockClient {
+	return tx.ResourceStateLock
+}
+
+// ResourceStateVersions implements the ClientSet.
+func (tx *Tx) ResourceStateVersions() *ResourceStateVersionClient {
+	return tx.ResourceStateVersion
+}
+
 // Roles implements the ClientSet.
 func (tx *Tx) Roles() *RoleClient {
 	return tx.Role
@@ -391,6 +483,11 @@ func (tx *Tx) Settings() *SettingClient {
 	return tx.Setting
 }
 
+// StorageObjects implements the ClientSet.
+func (tx *Tx) StorageObjects() *StorageObjectClient {
+	return tx.StorageObject
+}
+
 // Subjects implements the ClientSet.
 func (tx *Tx) Subjects() *SubjectClient {
 	return tx.Subject
@@ -406,6 +503,11 @@ func (tx *Tx) Templates() *TemplateClient {
 	return tx.Template
 }
 
+// TemplateUpgradeCampaigns implements the ClientSet.
+func (tx *Tx) TemplateUpgradeCampaigns() *TemplateUpgradeCampaignClient {
+	return tx.TemplateUpgradeCampaign
+}
+
 // TemplateVersions implements the ClientSet.
 func (tx *Tx) TemplateVersions() *TemplateVersionClient {
 	return tx.TemplateVersion
@@ -421,6 +523,16 @@ func (tx *Tx) Variables() *VariableClient {
 	return tx.Variable
 }
 
+// Webhooks implements the ClientSet.
+func (tx *Tx) Webhooks() *WebhookClient {
+	return tx.Webhook
+}
+
+// WebhookDeliveries implements the ClientSet.
+func (tx *Tx) WebhookDeliveries() *WebhookDeliveryClient {
+	return tx.WebhookDelivery
+}
+
 // Workflows implements the ClientSet.
 func (tx *Tx) Workflows() *WorkflowClient {
 	return tx.Workflow
@@ -458,9 +570,15 @@ func (tx *Tx) Dialect() string {
 
 // Intercept adds the query interceptors to all the entity clients.
 func (tx *Tx) Intercept(interceptors ...Interceptor) {
+	tx.AuditLog.Intercept(interceptors...)
+	tx.Budget.Intercept(interceptors...)
+	tx.BudgetEvent.Intercept(interceptors...)
 	tx.Catalog.Intercept(interceptors...)
+	tx.ChargebackStatement.Intercept(interceptors...)
 	tx.Connector.Intercept(interceptors...)
+	tx.CostAnomaly.Intercept(interceptors...)
 	tx.CostReport.Intercept(interceptors...)
+	tx.DataEncryptionKey.Intercept(interceptors...)
 	tx.DistributeLock.Intercept(interceptors...)
 	tx.Environment.Intercept(interceptors...)
 	tx.EnvironmentConnectorRelationship.Intercept(interceptors...)
@@ -473,15 +591,23 @@ func (tx *Tx) Intercept(interceptors ...Interceptor) {
 	tx.ResourceDefinitionMatchingRule.Intercept(interceptors...)
 	tx.ResourceRelationship.Intercept(interceptors...)
 	tx.ResourceRun.Intercept(interceptors...)
+	tx.ResourceRunApprovalRule.Intercept(interceptors...)
+	tx.ResourceRunPolicy.Intercept(interceptors...)
 	tx.ResourceState.Intercept(interceptors...)
+	tx.ResourceStateLock.Intercept(interceptors...)
+	tx.ResourceStateVersion.Intercept(interceptors...)
 	tx.Role.Intercept(interceptors...)
 	tx.Setting.Intercept(interceptors...)
+	tx.StorageObject.Intercept(interceptors...)
 	tx.Subject.Intercept(interceptors...)
 	tx.SubjectRoleRelationship.Intercept(interceptors...)
 	tx.Template.Intercept(interceptors...)
+	tx.TemplateUpgradeCampaign.Intercept(interceptors...)
 	tx.TemplateVersion.Intercept(interceptors...)
 	tx.Token.Intercept(interceptors...)
 	tx.Variable.Intercept(interceptors...)
+	tx.Webhook.Intercept(interceptors...)
+	tx.WebhookDelivery.Intercept(interceptors...)
 	tx.Workflow.Intercept(interceptors...)
 	tx.WorkflowExecution.Intercept(interceptors...)
 	tx.WorkflowStage.Intercept(interceptors...)
@@ -516,9 +642,15 @@ func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any)
 
 // Use adds the mutation hooks to all the entity clients.
 func (tx *Tx) Use(hooks ...Hook) {
+	tx.AuditLog.Use(hooks...)
+	tx.Budget.Use(hooks...)
+	tx.BudgetEvent.Use(hooks...)
 	tx.Catalog.Use(hooks...)
+	tx.ChargebackStatement.Use(hooks...)
 	tx.Connector.Use(hooks...)
+	tx.CostAnomaly.Use(hooks...)
 	tx.CostReport.Use(hooks...)
+	tx.DataEncryptionKey.Use(hooks...)
 	tx.DistributeLock.Use(hooks...)
 	tx.Environment.Use(hooks...)
 	tx.EnvironmentConnectorRelationship.Use(hooks...)
@@ -531,15 +663,23 @@ func (tx *Tx) Use(hooks ...Hook) {
 	tx.ResourceDefinitionMatchingRule.Use(hooks...)
 	tx.ResourceRelationship.Use(hooks...)
 	tx.ResourceRun.Use(hooks...)
+	tx.ResourceRunApprovalRule.Use(hooks...)
+	tx.ResourceRunPolicy.Use(hooks...)
 	tx.ResourceState.Use(hooks...)
+	tx.ResourceStateLock.Use(hooks...)
+	tx.ResourceStateVersion.Use(hooks...)
 	tx.Role.Use(hooks...)
 	tx.Setting.Use(hooks...)
+	tx.StorageObject.Use(hooks...)
 	tx.Subject.Use(hooks...)
 	tx.SubjectRoleRelationship.Use(hooks...)
 	tx.Template.Use(hooks...)
+	tx.TemplateUpgradeCampaign.Use(hooks...)
 	tx.TemplateVersion.Use(hooks...)
 	tx.Token.Use(hooks...)
 	tx.Variable.Use(hooks...)
+	tx.Webhook.Use(hooks...)
+	tx.WebhookDelivery.Use(hooks...)
 	tx.Workflow.Use(hooks...)
 	tx.WorkflowExecution.Use(hooks...)
 	tx.WorkflowStage.Use(hooks...)
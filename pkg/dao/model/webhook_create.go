@@ -0,0 +1,1464 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/project"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// WebhookCreate is the builder for creating a Webhook entity.
+type WebhookCreate struct {
+	config
+	mutation   *WebhookMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *Webhook
+	fromUpsert bool
+}
+
+// SetName sets the "name" field.
+func (wc *WebhookCreate) SetName(s string) *WebhookCreate {
+	wc.mutation.SetName(s)
+	return wc
+}
+
+// SetDescription sets the "description" field.
+func (wc *WebhookCreate) SetDescription(s string) *WebhookCreate {
+	wc.mutation.SetDescription(s)
+	return wc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableDescription(s *string) *WebhookCreate {
+	if s != nil {
+		wc.SetDescription(*s)
+	}
+	return wc
+}
+
+// SetLabels sets the "labels" field.
+func (wc *WebhookCreate) SetLabels(m map[string]string) *WebhookCreate {
+	wc.mutation.SetLabels(m)
+	return wc
+}
+
+// SetCreateTime sets the "create_time" field.
+func (wc *WebhookCreate) SetCreateTime(t time.Time) *WebhookCreate {
+	wc.mutation.SetCreateTime(t)
+	return wc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableCreateTime(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetCreateTime(*t)
+	}
+	return wc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wc *WebhookCreate) SetUpdateTime(t time.Time) *WebhookCreate {
+	wc.mutation.SetUpdateTime(t)
+	return wc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableUpdateTime(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetUpdateTime(*t)
+	}
+	return wc
+}
+
+// SetProjectID sets the "project_id" field.
+func (wc *WebhookCreate) SetProjectID(o object.ID) *WebhookCreate {
+	wc.mutation.SetProjectID(o)
+	return wc
+}
+
+// SetURL sets the "url" field.
+func (wc *WebhookCreate) SetURL(s string) *WebhookCreate {
+	wc.mutation.SetURL(s)
+	return wc
+}
+
+// SetSecret sets the "secret" field.
+func (wc *WebhookCreate) SetSecret(c crypto.String) *WebhookCreate {
+	wc.mutation.SetSecret(c)
+	return wc
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableSecret(c *crypto.String) *WebhookCreate {
+	if c != nil {
+		wc.SetSecret(*c)
+	}
+	return wc
+}
+
+// SetTopics sets the "topics" field.
+func (wc *WebhookCreate) SetTopics(s []string) *WebhookCreate {
+	wc.mutation.SetTopics(s)
+	return wc
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wc *WebhookCreate) SetEventTypes(s []string) *WebhookCreate {
+	wc.mutation.SetEventTypes(s)
+	return wc
+}
+
+// SetDisabled sets the "disabled" field.
+func (wc *WebhookCreate) SetDisabled(b bool) *WebhookCreate {
+	wc.mutation.SetDisabled(b)
+	return wc
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableDisabled(b *bool) *WebhookCreate {
+	if b != nil {
+		wc.SetDisabled(*b)
+	}
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WebhookCreate) SetID(o object.ID) *WebhookCreate {
+	wc.mutation.SetID(o)
+	return wc
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (wc *WebhookCreate) SetProject(p *Project) *WebhookCreate {
+	return wc.SetProjectID(p.ID)
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wc *WebhookCreate) AddDeliveryIDs(ids ...object.ID) *WebhookCreate {
+	wc.mutation.AddDeliveryIDs(ids...)
+	return wc
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wc *WebhookCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wc *WebhookCreate) Mutation() *WebhookMutation {
+	return wc.mutation
+}
+
+// Save creates the Webhook in the database.
+func (wc *WebhookCreate) Save(ctx context.Context) (*Webhook, error) {
+	if err := wc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebhookCreate) SaveX(ctx context.Context) *Webhook {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WebhookCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WebhookCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WebhookCreate) defaults() error {
+	if _, ok := wc.mutation.Labels(); !ok {
+		v := webhook.DefaultLabels
+		wc.mutation.SetLabels(v)
+	}
+	if _, ok := wc.mutation.CreateTime(); !ok {
+		if webhook.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized webhook.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := webhook.DefaultCreateTime()
+		wc.mutation.SetCreateTime(v)
+	}
+	if _, ok := wc.mutation.UpdateTime(); !ok {
+		if webhook.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized webhook.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := webhook.DefaultUpdateTime()
+		wc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := wc.mutation.Disabled(); !ok {
+		v := webhook.DefaultDisabled
+		wc.mutation.SetDisabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WebhookCreate) check() error {
+	if _, ok := wc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`model: missing required field "Webhook.name"`)}
+	}
+	if v, ok := wc.mutation.Name(); ok {
+		if err := webhook.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`model: validator failed for field "Webhook.name": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "Webhook.create_time"`)}
+	}
+	if _, ok := wc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "Webhook.update_time"`)}
+	}
+	if _, ok := wc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`model: missing required field "Webhook.project_id"`)}
+	}
+	if v, ok := wc.mutation.ProjectID(); ok {
+		if err := webhook.ProjectIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "project_id", err: fmt.Errorf(`model: validator failed for field "Webhook.project_id": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`model: missing required field "Webhook.url"`)}
+	}
+	if v, ok := wc.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`model: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.Topics(); !ok {
+		return &ValidationError{Name: "topics", err: errors.New(`model: missing required field "Webhook.topics"`)}
+	}
+	if _, ok := wc.mutation.Disabled(); !ok {
+		return &ValidationError{Name: "disabled", err: errors.New(`model: missing required field "Webhook.disabled"`)}
+	}
+	if _, ok := wc.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project", err: errors.New(`model: missing required edge "Webhook.project"`)}
+	}
+	return nil
+}
+
+func (wc *WebhookCreate) sqlSave(ctx context.Context) (*Webhook, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Webhook{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(webhook.Table, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString))
+	)
+	_spec.Schema = wc.schemaConfig.Webhook
+	_spec.OnConflict = wc.conflict
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wc.mutation.Name(); ok {
+		_spec.SetField(webhook.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wc.mutation.Description(); ok {
+		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := wc.mutation.Labels(); ok {
+		_spec.SetField(webhook.FieldLabels, field.TypeJSON, value)
+		_node.Labels = value
+	}
+	if value, ok := wc.mutation.CreateTime(); ok {
+		_spec.SetField(webhook.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := wc.mutation.UpdateTime(); ok {
+		_spec.SetField(webhook.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := wc.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wc.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := wc.mutation.Topics(); ok {
+		_spec.SetField(webhook.FieldTopics, field.TypeJSON, value)
+		_node.Topics = value
+	}
+	if value, ok := wc.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := wc.mutation.Disabled(); ok {
+		_spec.SetField(webhook.FieldDisabled, field.TypeBool, value)
+		_node.Disabled = value
+	}
+	if nodes := wc.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.ProjectTable,
+			Columns: []string{webhook.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wc.schemaConfig.Webhook
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wc.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (wc *WebhookCreate) Set(obj *Webhook) *WebhookCreate {
+	// Required.
+	wc.SetName(obj.Name)
+	wc.SetProjectID(obj.ProjectID)
+	wc.SetURL(obj.URL)
+	wc.SetTopics(obj.Topics)
+	wc.SetDisabled(obj.Disabled)
+
+	// Optional.
+	if obj.Description != "" {
+		wc.SetDescription(obj.Description)
+	}
+	if !reflect.ValueOf(obj.Labels).IsZero() {
+		wc.SetLabels(obj.Labels)
+	}
+	if obj.CreateTime != nil {
+		wc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		wc.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.Secret != "" {
+		wc.SetSecret(obj.Secret)
+	}
+	if !reflect.ValueOf(obj.EventTypes).IsZero() {
+		wc.SetEventTypes(obj.EventTypes)
+	}
+
+	// Record the given object.
+	wc.object = obj
+
+	return wc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (wc *WebhookCreate) getClientSet() (mc ClientSet) {
+	if _, ok := wc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: wc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: wc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the Webhook entity,
+// which is always good for cascading create operations.
+func (wc *WebhookCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Webhook) error) (*Webhook, error) {
+	obj, err := wc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := wc.getClientSet()
+	if wc.fromUpsert {
+		q := mc.Webhooks().Query().
+			Where(
+				webhook.ProjectID(obj.ProjectID),
+				webhook.Name(obj.Name),
+			)
+		obj.ID, err = q.OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("model: failed to query id of Webhook entity: %w", err)
+		}
+	}
+
+	if x := wc.object; x != nil {
+		if _, set := wc.mutation.Field(webhook.FieldName); set {
+			obj.Name = x.Name
+		}
+		if _, set := wc.mutation.Field(webhook.FieldDescription); set {
+			obj.Description = x.Description
+		}
+		if _, set := wc.mutation.Field(webhook.FieldProjectID); set {
+			obj.ProjectID = x.ProjectID
+		}
+		if _, set := wc.mutation.Field(webhook.FieldURL); set {
+			obj.URL = x.URL
+		}
+		if _, set := wc.mutation.Field(webhook.FieldSecret); set {
+			obj.Secret = x.Secret
+		}
+		if _, set := wc.mutation.Field(webhook.FieldTopics); set {
+			obj.Topics = x.Topics
+		}
+		if _, set := wc.mutation.Field(webhook.FieldEventTypes); set {
+			obj.EventTypes = x.EventTypes
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (wc *WebhookCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Webhook) error) *Webhook {
+	obj, err := wc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (wc *WebhookCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Webhook) error) error {
+	_, err := wc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (wc *WebhookCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Webhook) error) {
+	if err := wc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the WebhookCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (wcb *WebhookCreateBulk) Set(objs ...*Webhook) *WebhookCreateBulk {
+	if len(objs) != 0 {
+		client := NewWebhookClient(wcb.config)
+
+		wcb.builders = make([]*WebhookCreate, len(objs))
+		for i := range objs {
+			wcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		wcb.objects = objs
+	}
+
+	return wcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (wcb *WebhookCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := wcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: wcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: wcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the Webhook entities,
+// which is always good for cascading create operations.
+func (wcb *WebhookCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Webhook) error) ([]*Webhook, error) {
+	objs, err := wcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := wcb.getClientSet()
+	if wcb.fromUpsert {
+		for i := range objs {
+			obj := objs[i]
+			q := mc.Webhooks().Query().
+				Where(
+					webhook.ProjectID(obj.ProjectID),
+					webhook.Name(obj.Name),
+				)
+			objs[i].ID, err = q.OnlyID(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("model: failed to query id of Webhook entity: %w", err)
+			}
+		}
+	}
+
+	if x := wcb.objects; x != nil {
+		for i := range x {
+			if _, set := wcb.builders[i].mutation.Field(webhook.FieldName); set {
+				objs[i].Name = x[i].Name
+			}
+			if _, set := wcb.builders[i].mutation.Field(webhook.FieldDescription); set {
+				objs[i].Description = x[i].Description
+			}
+			if _, set := wcb.builders[i].mutation.Field(webhook.FieldProjectID); set {
+				objs[i].ProjectID = x[i].ProjectID
+			}
+			if _, set := wcb.builders[i].mutation.Field(webhook.FieldURL); set {
+				objs[i].URL = x[i].URL
+			}
+			if _, set := wcb.builders[i].mutation.Field(webhook.FieldSecret); set {
+				objs[i].Secret = x[i].Secret
+			}
+			if _, set := wcb.builders[i].mutation.Field(webhook.FieldTopics); set {
+				objs[i].Topics = x[i].Topics
+			}
+			if _, set := wcb.builders[i].mutation.Field(webhook.FieldEventTypes); set {
+				objs[i].EventTypes = x[i].EventTypes
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Webhook) error) []*Webhook {
+	objs, err := wcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (wcb *WebhookCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Webhook) error) error {
+	_, err := wcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *Webhook) error) {
+	if err := wcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *WebhookUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Webhook) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for WebhookUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *WebhookUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Webhook) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *WebhookUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Webhook) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the WebhookUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for WebhookUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *WebhookUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Webhook) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Webhook.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (wc *WebhookCreate) OnConflict(opts ...sql.ConflictOption) *WebhookUpsertOne {
+	wc.conflict = opts
+	return &WebhookUpsertOne{
+		create: wc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wc *WebhookCreate) OnConflictColumns(columns ...string) *WebhookUpsertOne {
+	wc.conflict = append(wc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookUpsertOne{
+		create: wc,
+	}
+}
+
+type (
+	// WebhookUpsertOne is the builder for "upsert"-ing
+	//  one Webhook node.
+	WebhookUpsertOne struct {
+		create *WebhookCreate
+	}
+
+	// WebhookUpsert is the "OnConflict" setter.
+	WebhookUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDescription sets the "description" field.
+func (u *WebhookUpsert) SetDescription(v string) *WebhookUpsert {
+	u.Set(webhook.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateDescription() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WebhookUpsert) ClearDescription() *WebhookUpsert {
+	u.SetNull(webhook.FieldDescription)
+	return u
+}
+
+// SetLabels sets the "labels" field.
+func (u *WebhookUpsert) SetLabels(v map[string]string) *WebhookUpsert {
+	u.Set(webhook.FieldLabels, v)
+	return u
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateLabels() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldLabels)
+	return u
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *WebhookUpsert) ClearLabels() *WebhookUpsert {
+	u.SetNull(webhook.FieldLabels)
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookUpsert) SetUpdateTime(v time.Time) *WebhookUpsert {
+	u.Set(webhook.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateUpdateTime() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldUpdateTime)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookUpsert) SetURL(v string) *WebhookUpsert {
+	u.Set(webhook.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateURL() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldURL)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookUpsert) SetSecret(v crypto.String) *WebhookUpsert {
+	u.Set(webhook.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateSecret() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldSecret)
+	return u
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *WebhookUpsert) ClearSecret() *WebhookUpsert {
+	u.SetNull(webhook.FieldSecret)
+	return u
+}
+
+// SetTopics sets the "topics" field.
+func (u *WebhookUpsert) SetTopics(v []string) *WebhookUpsert {
+	u.Set(webhook.FieldTopics, v)
+	return u
+}
+
+// UpdateTopics sets the "topics" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateTopics() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldTopics)
+	return u
+}
+
+// SetEventTypes sets the "event_types" field.
+func (u *WebhookUpsert) SetEventTypes(v []string) *WebhookUpsert {
+	u.Set(webhook.FieldEventTypes, v)
+	return u
+}
+
+// UpdateEventTypes sets the "event_types" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateEventTypes() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldEventTypes)
+	return u
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (u *WebhookUpsert) ClearEventTypes() *WebhookUpsert {
+	u.SetNull(webhook.FieldEventTypes)
+	return u
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *WebhookUpsert) SetDisabled(v bool) *WebhookUpsert {
+	u.Set(webhook.FieldDisabled, v)
+	return u
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *WebhookUpsert) UpdateDisabled() *WebhookUpsert {
+	u.SetExcluded(webhook.FieldDisabled)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhook.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookUpsertOne) UpdateNewValues() *WebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhook.FieldID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(webhook.FieldName)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(webhook.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(webhook.FieldProjectID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookUpsertOne) Ignore() *WebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookUpsertOne) DoNothing() *WebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookCreate.OnConflict
+// documentation for more info.
+func (u *WebhookUpsertOne) Update(set func(*WebhookUpsert)) *WebhookUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *WebhookUpsertOne) SetDescription(v string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateDescription() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WebhookUpsertOne) ClearDescription() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *WebhookUpsertOne) SetLabels(v map[string]string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateLabels() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *WebhookUpsertOne) ClearLabels() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookUpsertOne) SetUpdateTime(v time.Time) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateUpdateTime() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookUpsertOne) SetURL(v string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateURL() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookUpsertOne) SetSecret(v crypto.String) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateSecret() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *WebhookUpsertOne) ClearSecret() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetTopics sets the "topics" field.
+func (u *WebhookUpsertOne) SetTopics(v []string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetTopics(v)
+	})
+}
+
+// UpdateTopics sets the "topics" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateTopics() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateTopics()
+	})
+}
+
+// SetEventTypes sets the "event_types" field.
+func (u *WebhookUpsertOne) SetEventTypes(v []string) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetEventTypes(v)
+	})
+}
+
+// UpdateEventTypes sets the "event_types" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateEventTypes() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateEventTypes()
+	})
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (u *WebhookUpsertOne) ClearEventTypes() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearEventTypes()
+	})
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *WebhookUpsertOne) SetDisabled(v bool) *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetDisabled(v)
+	})
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *WebhookUpsertOne) UpdateDisabled() *WebhookUpsertOne {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateDisabled()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for WebhookCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: WebhookUpsertOne.ID is not supported by MySQL driver. Use WebhookUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookCreateBulk is the builder for creating many Webhook entities in bulk.
+type WebhookCreateBulk struct {
+	config
+	err        error
+	builders   []*WebhookCreate
+	conflict   []sql.ConflictOption
+	objects    []*Webhook
+	fromUpsert bool
+}
+
+// Save creates the Webhook entities in the database.
+func (wcb *WebhookCreateBulk) Save(ctx context.Context) ([]*Webhook, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Webhook, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) SaveX(ctx context.Context) []*Webhook {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WebhookCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Webhook.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (wcb *WebhookCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookUpsertBulk {
+	wcb.conflict = opts
+	return &WebhookUpsertBulk{
+		create: wcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wcb *WebhookCreateBulk) OnConflictColumns(columns ...string) *WebhookUpsertBulk {
+	wcb.conflict = append(wcb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookUpsertBulk{
+		create: wcb,
+	}
+}
+
+// WebhookUpsertBulk is the builder for "upsert"-ing
+// a bulk of Webhook nodes.
+type WebhookUpsertBulk struct {
+	create *WebhookCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhook.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookUpsertBulk) UpdateNewValues() *WebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhook.FieldID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(webhook.FieldName)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(webhook.FieldCreateTime)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(webhook.FieldProjectID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Webhook.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookUpsertBulk) Ignore() *WebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookUpsertBulk) DoNothing() *WebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookUpsertBulk) Update(set func(*WebhookUpsert)) *WebhookUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *WebhookUpsertBulk) SetDescription(v string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateDescription() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *WebhookUpsertBulk) ClearDescription() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLabels sets the "labels" field.
+func (u *WebhookUpsertBulk) SetLabels(v map[string]string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetLabels(v)
+	})
+}
+
+// UpdateLabels sets the "labels" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateLabels() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateLabels()
+	})
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (u *WebhookUpsertBulk) ClearLabels() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearLabels()
+	})
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookUpsertBulk) SetUpdateTime(v time.Time) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateUpdateTime() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *WebhookUpsertBulk) SetURL(v string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateURL() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *WebhookUpsertBulk) SetSecret(v crypto.String) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateSecret() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (u *WebhookUpsertBulk) ClearSecret() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearSecret()
+	})
+}
+
+// SetTopics sets the "topics" field.
+func (u *WebhookUpsertBulk) SetTopics(v []string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetTopics(v)
+	})
+}
+
+// UpdateTopics sets the "topics" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateTopics() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateTopics()
+	})
+}
+
+// SetEventTypes sets the "event_types" field.
+func (u *WebhookUpsertBulk) SetEventTypes(v []string) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetEventTypes(v)
+	})
+}
+
+// UpdateEventTypes sets the "event_types" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateEventTypes() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateEventTypes()
+	})
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (u *WebhookUpsertBulk) ClearEventTypes() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.ClearEventTypes()
+	})
+}
+
+// SetDisabled sets the "disabled" field.
+func (u *WebhookUpsertBulk) SetDisabled(v bool) *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.SetDisabled(v)
+	})
+}
+
+// UpdateDisabled sets the "disabled" field to the value that was provided on create.
+func (u *WebhookUpsertBulk) UpdateDisabled() *WebhookUpsertBulk {
+	return u.Update(func(s *WebhookUpsert) {
+		s.UpdateDisabled()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the WebhookCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for WebhookCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
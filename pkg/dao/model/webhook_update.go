@@ -0,0 +1,1002 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *Webhook
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetDescription sets the "description" field.
+func (wu *WebhookUpdate) SetDescription(s string) *WebhookUpdate {
+	wu.mutation.SetDescription(s)
+	return wu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableDescription(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetDescription(*s)
+	}
+	return wu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (wu *WebhookUpdate) ClearDescription() *WebhookUpdate {
+	wu.mutation.ClearDescription()
+	return wu
+}
+
+// SetLabels sets the "labels" field.
+func (wu *WebhookUpdate) SetLabels(m map[string]string) *WebhookUpdate {
+	wu.mutation.SetLabels(m)
+	return wu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (wu *WebhookUpdate) ClearLabels() *WebhookUpdate {
+	wu.mutation.ClearLabels()
+	return wu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wu *WebhookUpdate) SetUpdateTime(t time.Time) *WebhookUpdate {
+	wu.mutation.SetUpdateTime(t)
+	return wu
+}
+
+// SetURL sets the "url" field.
+func (wu *WebhookUpdate) SetURL(s string) *WebhookUpdate {
+	wu.mutation.SetURL(s)
+	return wu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableURL(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetURL(*s)
+	}
+	return wu
+}
+
+// SetSecret sets the "secret" field.
+func (wu *WebhookUpdate) SetSecret(c crypto.String) *WebhookUpdate {
+	wu.mutation.SetSecret(c)
+	return wu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableSecret(c *crypto.String) *WebhookUpdate {
+	if c != nil {
+		wu.SetSecret(*c)
+	}
+	return wu
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (wu *WebhookUpdate) ClearSecret() *WebhookUpdate {
+	wu.mutation.ClearSecret()
+	return wu
+}
+
+// SetTopics sets the "topics" field.
+func (wu *WebhookUpdate) SetTopics(s []string) *WebhookUpdate {
+	wu.mutation.SetTopics(s)
+	return wu
+}
+
+// AppendTopics appends s to the "topics" field.
+func (wu *WebhookUpdate) AppendTopics(s []string) *WebhookUpdate {
+	wu.mutation.AppendTopics(s)
+	return wu
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wu *WebhookUpdate) SetEventTypes(s []string) *WebhookUpdate {
+	wu.mutation.SetEventTypes(s)
+	return wu
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wu *WebhookUpdate) AppendEventTypes(s []string) *WebhookUpdate {
+	wu.mutation.AppendEventTypes(s)
+	return wu
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (wu *WebhookUpdate) ClearEventTypes() *WebhookUpdate {
+	wu.mutation.ClearEventTypes()
+	return wu
+}
+
+// SetDisabled sets the "disabled" field.
+func (wu *WebhookUpdate) SetDisabled(b bool) *WebhookUpdate {
+	wu.mutation.SetDisabled(b)
+	return wu
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableDisabled(b *bool) *WebhookUpdate {
+	if b != nil {
+		wu.SetDisabled(*b)
+	}
+	return wu
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wu *WebhookUpdate) AddDeliveryIDs(ids ...object.ID) *WebhookUpdate {
+	wu.mutation.AddDeliveryIDs(ids...)
+	return wu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wu *WebhookUpdate) Mutation() *WebhookMutation {
+	return wu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) ClearDeliveries() *WebhookUpdate {
+	wu.mutation.ClearDeliveries()
+	return wu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wu *WebhookUpdate) RemoveDeliveryIDs(ids ...object.ID) *WebhookUpdate {
+	wu.mutation.RemoveDeliveryIDs(ids...)
+	return wu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wu *WebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	if err := wu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WebhookUpdate) defaults() error {
+	if _, ok := wu.mutation.UpdateTime(); !ok {
+		if webhook.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized webhook.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := webhook.UpdateDefaultUpdateTime()
+		wu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WebhookUpdate) check() error {
+	if v, ok := wu.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`model: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if _, ok := wu.mutation.ProjectID(); wu.mutation.ProjectCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "Webhook.project"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (wu *WebhookUpdate) Set(obj *Webhook) *WebhookUpdate {
+	// Without Default.
+	if obj.Description != "" {
+		wu.SetDescription(obj.Description)
+	}
+	if !reflect.ValueOf(obj.Labels).IsZero() {
+		wu.SetLabels(obj.Labels)
+	}
+	wu.SetURL(obj.URL)
+	if obj.Secret != "" {
+		wu.SetSecret(obj.Secret)
+	}
+	wu.SetTopics(obj.Topics)
+	if !reflect.ValueOf(obj.EventTypes).IsZero() {
+		wu.SetEventTypes(obj.EventTypes)
+	}
+	wu.SetDisabled(obj.Disabled)
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		wu.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	wu.object = obj
+
+	return wu
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wu *WebhookUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdate {
+	wu.modifiers = append(wu.modifiers, modifiers...)
+	return wu
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.Description(); ok {
+		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
+	}
+	if wu.mutation.DescriptionCleared() {
+		_spec.ClearField(webhook.FieldDescription, field.TypeString)
+	}
+	if value, ok := wu.mutation.Labels(); ok {
+		_spec.SetField(webhook.FieldLabels, field.TypeJSON, value)
+	}
+	if wu.mutation.LabelsCleared() {
+		_spec.ClearField(webhook.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := wu.mutation.UpdateTime(); ok {
+		_spec.SetField(webhook.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if wu.mutation.SecretCleared() {
+		_spec.ClearField(webhook.FieldSecret, field.TypeString)
+	}
+	if value, ok := wu.mutation.Topics(); ok {
+		_spec.SetField(webhook.FieldTopics, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedTopics(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldTopics, value)
+		})
+	}
+	if value, ok := wu.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEventTypes, value)
+		})
+	}
+	if wu.mutation.EventTypesCleared() {
+		_spec.ClearField(webhook.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := wu.mutation.Disabled(); ok {
+		_spec.SetField(webhook.FieldDisabled, field.TypeBool, value)
+	}
+	if wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wu.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wu.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wu.schemaConfig.Webhook
+	ctx = internal.NewSchemaConfigContext(ctx, wu.schemaConfig)
+	_spec.AddModifiers(wu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *Webhook
+}
+
+// SetDescription sets the "description" field.
+func (wuo *WebhookUpdateOne) SetDescription(s string) *WebhookUpdateOne {
+	wuo.mutation.SetDescription(s)
+	return wuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableDescription(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetDescription(*s)
+	}
+	return wuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (wuo *WebhookUpdateOne) ClearDescription() *WebhookUpdateOne {
+	wuo.mutation.ClearDescription()
+	return wuo
+}
+
+// SetLabels sets the "labels" field.
+func (wuo *WebhookUpdateOne) SetLabels(m map[string]string) *WebhookUpdateOne {
+	wuo.mutation.SetLabels(m)
+	return wuo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (wuo *WebhookUpdateOne) ClearLabels() *WebhookUpdateOne {
+	wuo.mutation.ClearLabels()
+	return wuo
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wuo *WebhookUpdateOne) SetUpdateTime(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetUpdateTime(t)
+	return wuo
+}
+
+// SetURL sets the "url" field.
+func (wuo *WebhookUpdateOne) SetURL(s string) *WebhookUpdateOne {
+	wuo.mutation.SetURL(s)
+	return wuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableURL(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetURL(*s)
+	}
+	return wuo
+}
+
+// SetSecret sets the "secret" field.
+func (wuo *WebhookUpdateOne) SetSecret(c crypto.String) *WebhookUpdateOne {
+	wuo.mutation.SetSecret(c)
+	return wuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableSecret(c *crypto.String) *WebhookUpdateOne {
+	if c != nil {
+		wuo.SetSecret(*c)
+	}
+	return wuo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (wuo *WebhookUpdateOne) ClearSecret() *WebhookUpdateOne {
+	wuo.mutation.ClearSecret()
+	return wuo
+}
+
+// SetTopics sets the "topics" field.
+func (wuo *WebhookUpdateOne) SetTopics(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetTopics(s)
+	return wuo
+}
+
+// AppendTopics appends s to the "topics" field.
+func (wuo *WebhookUpdateOne) AppendTopics(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendTopics(s)
+	return wuo
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wuo *WebhookUpdateOne) SetEventTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetEventTypes(s)
+	return wuo
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wuo *WebhookUpdateOne) AppendEventTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendEventTypes(s)
+	return wuo
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (wuo *WebhookUpdateOne) ClearEventTypes() *WebhookUpdateOne {
+	wuo.mutation.ClearEventTypes()
+	return wuo
+}
+
+// SetDisabled sets the "disabled" field.
+func (wuo *WebhookUpdateOne) SetDisabled(b bool) *WebhookUpdateOne {
+	wuo.mutation.SetDisabled(b)
+	return wuo
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableDisabled(b *bool) *WebhookUpdateOne {
+	if b != nil {
+		wuo.SetDisabled(*b)
+	}
+	return wuo
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wuo *WebhookUpdateOne) AddDeliveryIDs(ids ...object.ID) *WebhookUpdateOne {
+	wuo.mutation.AddDeliveryIDs(ids...)
+	return wuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wuo *WebhookUpdateOne) Mutation() *WebhookMutation {
+	return wuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) ClearDeliveries() *WebhookUpdateOne {
+	wuo.mutation.ClearDeliveries()
+	return wuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wuo *WebhookUpdateOne) RemoveDeliveryIDs(ids ...object.ID) *WebhookUpdateOne {
+	wuo.mutation.RemoveDeliveryIDs(ids...)
+	return wuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wuo *WebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]object.ID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wuo *WebhookUpdateOne) Where(ps ...predicate.Webhook) *WebhookUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WebhookUpdateOne) Select(field string, fields ...string) *WebhookUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Webhook entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	if err := wuo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WebhookUpdateOne) defaults() error {
+	if _, ok := wuo.mutation.UpdateTime(); !ok {
+		if webhook.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized webhook.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := webhook.UpdateDefaultUpdateTime()
+		wuo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WebhookUpdateOne) check() error {
+	if v, ok := wuo.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`model: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if _, ok := wuo.mutation.ProjectID(); wuo.mutation.ProjectCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "Webhook.project"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (wuo *WebhookUpdateOne) Set(obj *Webhook) *WebhookUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*WebhookMutation)
+			db, err := mt.Client().Webhook.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting Webhook with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if obj.Description != "" {
+				if db.Description != obj.Description {
+					wuo.SetDescription(obj.Description)
+				}
+			}
+			if !reflect.ValueOf(obj.Labels).IsZero() {
+				if !reflect.DeepEqual(db.Labels, obj.Labels) {
+					wuo.SetLabels(obj.Labels)
+				}
+			}
+			if db.URL != obj.URL {
+				wuo.SetURL(obj.URL)
+			}
+			if obj.Secret != "" {
+				if db.Secret != obj.Secret {
+					wuo.SetSecret(obj.Secret)
+				}
+			}
+			if !reflect.DeepEqual(db.Topics, obj.Topics) {
+				wuo.SetTopics(obj.Topics)
+			}
+			if !reflect.ValueOf(obj.EventTypes).IsZero() {
+				if !reflect.DeepEqual(db.EventTypes, obj.EventTypes) {
+					wuo.SetEventTypes(obj.EventTypes)
+				}
+			}
+			if db.Disabled != obj.Disabled {
+				wuo.SetDisabled(obj.Disabled)
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				wuo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			wuo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	wuo.hooks = append(wuo.hooks, h)
+
+	return wuo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (wuo *WebhookUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := wuo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: wuo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: wuo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the Webhook entity,
+// which is always good for cascading update operations.
+func (wuo *WebhookUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Webhook) error) (*Webhook, error) {
+	obj, err := wuo.Save(ctx)
+	if err != nil &&
+		(wuo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := wuo.getClientSet()
+
+	if obj == nil {
+		obj = wuo.object
+	} else if x := wuo.object; x != nil {
+		if _, set := wuo.mutation.Field(webhook.FieldDescription); set {
+			obj.Description = x.Description
+		}
+		if _, set := wuo.mutation.Field(webhook.FieldLabels); set {
+			obj.Labels = x.Labels
+		}
+		if _, set := wuo.mutation.Field(webhook.FieldURL); set {
+			obj.URL = x.URL
+		}
+		if _, set := wuo.mutation.Field(webhook.FieldSecret); set {
+			obj.Secret = x.Secret
+		}
+		if _, set := wuo.mutation.Field(webhook.FieldTopics); set {
+			obj.Topics = x.Topics
+		}
+		if _, set := wuo.mutation.Field(webhook.FieldEventTypes); set {
+			obj.EventTypes = x.EventTypes
+		}
+		if _, set := wuo.mutation.Field(webhook.FieldDisabled); set {
+			obj.Disabled = x.Disabled
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Webhook) error) *Webhook {
+	obj, err := wuo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (wuo *WebhookUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Webhook) error) error {
+	_, err := wuo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *Webhook) error) {
+	if err := wuo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wuo *WebhookUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdateOne {
+	wuo.modifiers = append(wuo.modifiers, modifiers...)
+	return wuo
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "Webhook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhook.FieldID)
+		for _, f := range fields {
+			if !webhook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != webhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.Description(); ok {
+		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
+	}
+	if wuo.mutation.DescriptionCleared() {
+		_spec.ClearField(webhook.FieldDescription, field.TypeString)
+	}
+	if value, ok := wuo.mutation.Labels(); ok {
+		_spec.SetField(webhook.FieldLabels, field.TypeJSON, value)
+	}
+	if wuo.mutation.LabelsCleared() {
+		_spec.ClearField(webhook.FieldLabels, field.TypeJSON)
+	}
+	if value, ok := wuo.mutation.UpdateTime(); ok {
+		_spec.SetField(webhook.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeString, value)
+	}
+	if wuo.mutation.SecretCleared() {
+		_spec.ClearField(webhook.FieldSecret, field.TypeString)
+	}
+	if value, ok := wuo.mutation.Topics(); ok {
+		_spec.SetField(webhook.FieldTopics, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedTopics(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldTopics, value)
+		})
+	}
+	if value, ok := wuo.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEventTypes, value)
+		})
+	}
+	if wuo.mutation.EventTypesCleared() {
+		_spec.ClearField(webhook.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := wuo.mutation.Disabled(); ok {
+		_spec.SetField(webhook.FieldDisabled, field.TypeBool, value)
+	}
+	if wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wuo.schemaConfig.WebhookDelivery
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wuo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wuo.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.Node.Schema = wuo.schemaConfig.Webhook
+	ctx = internal.NewSchemaConfigContext(ctx, wuo.schemaConfig)
+	_spec.AddModifiers(wuo.modifiers...)
+	_node = &Webhook{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1012 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// WebhookCreateInput holds the creation input of the Webhook entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create Webhook entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Topics of the model changes to subscribe, i.e. resources, resource_runs.
+	Topics []string `path:"-" query:"-" json:"topics"`
+	// URL to deliver the events.
+	URL string `path:"-" query:"-" json:"url,cli-table-column"`
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Secret to sign the payloads with HMAC-SHA256, keeps the previous one if not provided.
+	Secret crypto.String `path:"-" query:"-" json:"secret,omitempty" sensitive:"true"`
+	// Types of the events to subscribe, empty means all of create, update and delete.
+	EventTypes []string `path:"-" query:"-" json:"eventTypes,omitempty"`
+	// Indicate whether the webhook is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled,omitempty"`
+}
+
+// Model returns the Webhook entity for creating,
+// after validating.
+func (wci *WebhookCreateInput) Model() *Webhook {
+	if wci == nil {
+		return nil
+	}
+
+	_w := &Webhook{
+		Topics:      wci.Topics,
+		URL:         wci.URL,
+		Name:        wci.Name,
+		Description: wci.Description,
+		Labels:      wci.Labels,
+		Secret:      wci.Secret,
+		EventTypes:  wci.EventTypes,
+		Disabled:    wci.Disabled,
+	}
+
+	if wci.Project != nil {
+		_w.ProjectID = wci.Project.ID
+	}
+
+	return _w
+}
+
+// Validate checks the WebhookCreateInput entity.
+func (wci *WebhookCreateInput) Validate() error {
+	if wci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wci.ValidateWith(wci.inputConfig.Context, wci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookCreateInput entity with the given context and client set.
+func (wci *WebhookCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if wci.Project != nil {
+		if err := wci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WebhookCreateInputs holds the creation input item of the Webhook entities.
+type WebhookCreateInputsItem struct {
+	// Topics of the model changes to subscribe, i.e. resources, resource_runs.
+	Topics []string `path:"-" query:"-" json:"topics"`
+	// URL to deliver the events.
+	URL string `path:"-" query:"-" json:"url,cli-table-column"`
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// Secret to sign the payloads with HMAC-SHA256, keeps the previous one if not provided.
+	Secret crypto.String `path:"-" query:"-" json:"secret,omitempty" sensitive:"true"`
+	// Types of the events to subscribe, empty means all of create, update and delete.
+	EventTypes []string `path:"-" query:"-" json:"eventTypes,omitempty"`
+	// Indicate whether the webhook is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled,omitempty"`
+}
+
+// ValidateWith checks the WebhookCreateInputsItem entity with the given context and client set.
+func (wci *WebhookCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// WebhookCreateInputs holds the creation input of the Webhook entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to create Webhook entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*WebhookCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the Webhook entities for creating,
+// after validating.
+func (wci *WebhookCreateInputs) Model() []*Webhook {
+	if wci == nil || len(wci.Items) == 0 {
+		return nil
+	}
+
+	_ws := make([]*Webhook, len(wci.Items))
+
+	for i := range wci.Items {
+		_w := &Webhook{
+			Topics:      wci.Items[i].Topics,
+			URL:         wci.Items[i].URL,
+			Name:        wci.Items[i].Name,
+			Description: wci.Items[i].Description,
+			Labels:      wci.Items[i].Labels,
+			Secret:      wci.Items[i].Secret,
+			EventTypes:  wci.Items[i].EventTypes,
+			Disabled:    wci.Items[i].Disabled,
+		}
+
+		if wci.Project != nil {
+			_w.ProjectID = wci.Project.ID
+		}
+
+		_ws[i] = _w
+	}
+
+	return _ws
+}
+
+// Validate checks the WebhookCreateInputs entity .
+func (wci *WebhookCreateInputs) Validate() error {
+	if wci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wci.ValidateWith(wci.inputConfig.Context, wci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookCreateInputs entity with the given context and client set.
+func (wci *WebhookCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(wci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when creating under the Project route.
+	if wci.Project != nil {
+		if err := wci.Project.ValidateWith(ctx, cs, cache); err != nil {
+			if !IsBlankResourceReferError(err) {
+				return err
+			} else {
+				wci.Project = nil
+			}
+		}
+	}
+
+	for i := range wci.Items {
+		if wci.Items[i] == nil {
+			continue
+		}
+
+		if err := wci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WebhookDeleteInput holds the deletion input of the Webhook entity,
+// please tags with `path:",inline"` if embedding.
+type WebhookDeleteInput struct {
+	WebhookQueryInput `path:",inline"`
+}
+
+// WebhookDeleteInputs holds the deletion input item of the Webhook entities.
+type WebhookDeleteInputsItem struct {
+	// ID of the Webhook entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the Webhook entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+}
+
+// WebhookDeleteInputs holds the deletion input of the Webhook entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to delete Webhook entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*WebhookDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the Webhook entities for deleting,
+// after validating.
+func (wdi *WebhookDeleteInputs) Model() []*Webhook {
+	if wdi == nil || len(wdi.Items) == 0 {
+		return nil
+	}
+
+	_ws := make([]*Webhook, len(wdi.Items))
+	for i := range wdi.Items {
+		_ws[i] = &Webhook{
+			ID: wdi.Items[i].ID,
+		}
+	}
+	return _ws
+}
+
+// IDs returns the ID list of the Webhook entities for deleting,
+// after validating.
+func (wdi *WebhookDeleteInputs) IDs() []object.ID {
+	if wdi == nil || len(wdi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(wdi.Items))
+	for i := range wdi.Items {
+		ids[i] = wdi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the WebhookDeleteInputs entity.
+func (wdi *WebhookDeleteInputs) Validate() error {
+	if wdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wdi.ValidateWith(wdi.inputConfig.Context, wdi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeleteInputs entity with the given context and client set.
+func (wdi *WebhookDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wdi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(wdi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.Webhooks().Query()
+
+	// Validate when deleting under the Project route.
+	if wdi.Project != nil {
+		if err := wdi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				webhook.ProjectID(wdi.Project.ID))
+		}
+	}
+
+	ids := make([]object.ID, 0, len(wdi.Items))
+	ors := make([]predicate.Webhook, 0, len(wdi.Items))
+	indexers := make(map[any][]int)
+
+	for i := range wdi.Items {
+		if wdi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if wdi.Items[i].ID != "" {
+			ids = append(ids, wdi.Items[i].ID)
+			ors = append(ors, webhook.ID(wdi.Items[i].ID))
+			indexers[wdi.Items[i].ID] = append(indexers[wdi.Items[i].ID], i)
+		} else if wdi.Items[i].Name != "" {
+			ors = append(ors, webhook.And(
+				webhook.Name(wdi.Items[i].Name)))
+			indexerKey := fmt.Sprint("/", wdi.Items[i].Name)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := webhook.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = webhook.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			webhook.FieldID,
+			webhook.FieldName,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Name)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			wdi.Items[j].ID = es[i].ID
+			wdi.Items[j].Name = es[i].Name
+		}
+	}
+
+	return nil
+}
+
+// WebhookPatchInput holds the patch input of the Webhook entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookPatchInput struct {
+	WebhookQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Name holds the value of the "name" field.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// URL to deliver the events.
+	URL string `path:"-" query:"-" json:"url,omitempty"`
+	// Secret to sign the payloads with HMAC-SHA256, keeps the previous one if not provided.
+	Secret crypto.String `path:"-" query:"-" json:"secret,omitempty" sensitive:"true"`
+	// Topics of the model changes to subscribe, i.e. resources, resource_runs.
+	Topics []string `path:"-" query:"-" json:"topics,omitempty"`
+	// Types of the events to subscribe, empty means all of create, update and delete.
+	EventTypes []string `path:"-" query:"-" json:"eventTypes,omitempty"`
+	// Indicate whether the webhook is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled,omitempty"`
+
+	patchedEntity *Webhook `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the Webhook partition entity for patching.
+func (wpi *WebhookPatchInput) PatchModel() *Webhook {
+	if wpi == nil {
+		return nil
+	}
+
+	_w := &Webhook{
+		Name:        wpi.Name,
+		Description: wpi.Description,
+		Labels:      wpi.Labels,
+		CreateTime:  wpi.CreateTime,
+		UpdateTime:  wpi.UpdateTime,
+		URL:         wpi.URL,
+		Secret:      wpi.Secret,
+		Topics:      wpi.Topics,
+		EventTypes:  wpi.EventTypes,
+		Disabled:    wpi.Disabled,
+	}
+
+	if wpi.Project != nil {
+		_w.ProjectID = wpi.Project.ID
+	}
+
+	return _w
+}
+
+// Model returns the Webhook patched entity,
+// after validating.
+func (wpi *WebhookPatchInput) Model() *Webhook {
+	if wpi == nil {
+		return nil
+	}
+
+	return wpi.patchedEntity
+}
+
+// Validate checks the WebhookPatchInput entity.
+func (wpi *WebhookPatchInput) Validate() error {
+	if wpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wpi.ValidateWith(wpi.inputConfig.Context, wpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookPatchInput entity with the given context and client set.
+func (wpi *WebhookPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := wpi.WebhookQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.Webhooks().Query()
+
+	// Validate when querying under the Project route.
+	if wpi.Project != nil {
+		if err := wpi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				webhook.ProjectID(wpi.Project.ID))
+		}
+	}
+
+	if wpi.Refer != nil {
+		if wpi.Refer.IsID() {
+			q.Where(
+				webhook.ID(wpi.Refer.ID()))
+		} else if refers := wpi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				webhook.Name(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of webhook")
+		}
+	} else if wpi.ID != "" {
+		q.Where(
+			webhook.ID(wpi.ID))
+	} else if wpi.Name != "" {
+		q.Where(
+			webhook.Name(wpi.Name))
+	} else {
+		return errors.New("invalid identify of webhook")
+	}
+
+	q.Select(
+		webhook.WithoutFields(
+			webhook.FieldCreateTime,
+			webhook.FieldUpdateTime,
+		)...,
+	)
+
+	var e *Webhook
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*Webhook)
+		}
+	}
+
+	_pm := wpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*Webhook)
+
+	if e.Name != _obj.Name {
+		return errors.New("field name is immutable")
+	}
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+
+	wpi.patchedEntity = _obj
+	return nil
+}
+
+// WebhookQueryInput holds the query input of the Webhook entity,
+// please tags with `path:",inline"` if embedding.
+type WebhookQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query Webhook entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"project"`
+
+	// Refer holds the route path reference of the Webhook entity.
+	Refer *object.Refer `path:"webhook,default=" query:"-" json:"-"`
+	// ID of the Webhook entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the Webhook entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+}
+
+// Model returns the Webhook entity for querying,
+// after validating.
+func (wqi *WebhookQueryInput) Model() *Webhook {
+	if wqi == nil {
+		return nil
+	}
+
+	return &Webhook{
+		ID:   wqi.ID,
+		Name: wqi.Name,
+	}
+}
+
+// Validate checks the WebhookQueryInput entity.
+func (wqi *WebhookQueryInput) Validate() error {
+	if wqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wqi.ValidateWith(wqi.inputConfig.Context, wqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookQueryInput entity with the given context and client set.
+func (wqi *WebhookQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if wqi.Refer != nil && *wqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", webhook.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.Webhooks().Query()
+
+	// Validate when querying under the Project route.
+	if wqi.Project != nil {
+		if err := wqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				webhook.ProjectID(wqi.Project.ID))
+		}
+	}
+
+	if wqi.Refer != nil {
+		if wqi.Refer.IsID() {
+			q.Where(
+				webhook.ID(wqi.Refer.ID()))
+		} else if refers := wqi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				webhook.Name(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of webhook")
+		}
+	} else if wqi.ID != "" {
+		q.Where(
+			webhook.ID(wqi.ID))
+	} else if wqi.Name != "" {
+		q.Where(
+			webhook.Name(wqi.Name))
+	} else {
+		return errors.New("invalid identify of webhook")
+	}
+
+	q.Select(
+		webhook.FieldID,
+		webhook.FieldName,
+	)
+
+	var e *Webhook
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*Webhook)
+		}
+	}
+
+	wqi.ID = e.ID
+	wqi.Name = e.Name
+	return nil
+}
+
+// WebhookQueryInputs holds the query input of the Webhook entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type WebhookQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to query Webhook entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+}
+
+// Validate checks the WebhookQueryInputs entity.
+func (wqi *WebhookQueryInputs) Validate() error {
+	if wqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wqi.ValidateWith(wqi.inputConfig.Context, wqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookQueryInputs entity with the given context and client set.
+func (wqi *WebhookQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	// Validate when querying under the Project route.
+	if wqi.Project != nil {
+		if err := wqi.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WebhookUpdateInput holds the modification input of the Webhook entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookUpdateInput struct {
+	WebhookQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// URL to deliver the events.
+	URL string `path:"-" query:"-" json:"url,omitempty"`
+	// Secret to sign the payloads with HMAC-SHA256, keeps the previous one if not provided.
+	Secret crypto.String `path:"-" query:"-" json:"secret,omitempty" sensitive:"true"`
+	// Topics of the model changes to subscribe, i.e. resources, resource_runs.
+	Topics []string `path:"-" query:"-" json:"topics,omitempty"`
+	// Types of the events to subscribe, empty means all of create, update and delete.
+	EventTypes []string `path:"-" query:"-" json:"eventTypes,omitempty"`
+	// Indicate whether the webhook is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled,omitempty"`
+}
+
+// Model returns the Webhook entity for modifying,
+// after validating.
+func (wui *WebhookUpdateInput) Model() *Webhook {
+	if wui == nil {
+		return nil
+	}
+
+	_w := &Webhook{
+		ID:          wui.ID,
+		Name:        wui.Name,
+		Description: wui.Description,
+		Labels:      wui.Labels,
+		URL:         wui.URL,
+		Secret:      wui.Secret,
+		Topics:      wui.Topics,
+		EventTypes:  wui.EventTypes,
+		Disabled:    wui.Disabled,
+	}
+
+	return _w
+}
+
+// Validate checks the WebhookUpdateInput entity.
+func (wui *WebhookUpdateInput) Validate() error {
+	if wui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wui.ValidateWith(wui.inputConfig.Context, wui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookUpdateInput entity with the given context and client set.
+func (wui *WebhookUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := wui.WebhookQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WebhookUpdateInputs holds the modification input item of the Webhook entities.
+type WebhookUpdateInputsItem struct {
+	// ID of the Webhook entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// Name of the Webhook entity, a part of the unique index.
+	Name string `path:"-" query:"-" json:"name,omitempty"`
+
+	// Description holds the value of the "description" field.
+	Description string `path:"-" query:"-" json:"description,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels map[string]string `path:"-" query:"-" json:"labels,omitempty"`
+	// URL to deliver the events.
+	URL string `path:"-" query:"-" json:"url,cli-table-column"`
+	// Secret to sign the payloads with HMAC-SHA256, keeps the previous one if not provided.
+	Secret crypto.String `path:"-" query:"-" json:"secret,omitempty" sensitive:"true"`
+	// Topics of the model changes to subscribe, i.e. resources, resource_runs.
+	Topics []string `path:"-" query:"-" json:"topics"`
+	// Types of the events to subscribe, empty means all of create, update and delete.
+	EventTypes []string `path:"-" query:"-" json:"eventTypes,omitempty"`
+	// Indicate whether the webhook is disabled.
+	Disabled bool `path:"-" query:"-" json:"disabled"`
+}
+
+// ValidateWith checks the WebhookUpdateInputsItem entity with the given context and client set.
+func (wui *WebhookUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// WebhookUpdateInputs holds the modification input of the Webhook entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Project indicates to update Webhook entity MUST under the Project route.
+	Project *ProjectQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*WebhookUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the Webhook entities for modifying,
+// after validating.
+func (wui *WebhookUpdateInputs) Model() []*Webhook {
+	if wui == nil || len(wui.Items) == 0 {
+		return nil
+	}
+
+	_ws := make([]*Webhook, len(wui.Items))
+
+	for i := range wui.Items {
+		_w := &Webhook{
+			ID:          wui.Items[i].ID,
+			Name:        wui.Items[i].Name,
+			Description: wui.Items[i].Description,
+			Labels:      wui.Items[i].Labels,
+			URL:         wui.Items[i].URL,
+			Secret:      wui.Items[i].Secret,
+			Topics:      wui.Items[i].Topics,
+			EventTypes:  wui.Items[i].EventTypes,
+			Disabled:    wui.Items[i].Disabled,
+		}
+
+		_ws[i] = _w
+	}
+
+	return _ws
+}
+
+// IDs returns the ID list of the Webhook entities for modifying,
+// after validating.
+func (wui *WebhookUpdateInputs) IDs() []object.ID {
+	if wui == nil || len(wui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(wui.Items))
+	for i := range wui.Items {
+		ids[i] = wui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the WebhookUpdateInputs entity.
+func (wui *WebhookUpdateInputs) Validate() error {
+	if wui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wui.ValidateWith(wui.inputConfig.Context, wui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookUpdateInputs entity with the given context and client set.
+func (wui *WebhookUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(wui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.Webhooks().Query()
+
+	// Validate when updating under the Project route.
+	if wui.Project != nil {
+		if err := wui.Project.ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		} else {
+			ctx = valueContext(ctx, intercept.WithProjectInterceptor)
+			q.Where(
+				webhook.ProjectID(wui.Project.ID))
+		}
+	}
+
+	ids := make([]object.ID, 0, len(wui.Items))
+	ors := make([]predicate.Webhook, 0, len(wui.Items))
+	indexers := make(map[any][]int)
+
+	for i := range wui.Items {
+		if wui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if wui.Items[i].ID != "" {
+			ids = append(ids, wui.Items[i].ID)
+			ors = append(ors, webhook.ID(wui.Items[i].ID))
+			indexers[wui.Items[i].ID] = append(indexers[wui.Items[i].ID], i)
+		} else if wui.Items[i].Name != "" {
+			ors = append(ors, webhook.And(
+				webhook.Name(wui.Items[i].Name)))
+			indexerKey := fmt.Sprint("/", wui.Items[i].Name)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := webhook.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = webhook.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			webhook.FieldID,
+			webhook.FieldName,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].Name)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			wui.Items[j].ID = es[i].ID
+			wui.Items[j].Name = es[i].Name
+		}
+	}
+
+	for i := range wui.Items {
+		if err := wui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WebhookOutput holds the output of the Webhook entity.
+type WebhookOutput struct {
+	ID          object.ID         `json:"id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	CreateTime  *time.Time        `json:"createTime,omitempty"`
+	UpdateTime  *time.Time        `json:"updateTime,omitempty"`
+	URL         string            `json:"url,cli-table-column,omitempty"`
+	Topics      []string          `json:"topics,omitempty"`
+	EventTypes  []string          `json:"eventTypes,omitempty"`
+	Disabled    bool              `json:"disabled,omitempty"`
+
+	Project *ProjectOutput `json:"project,omitempty"`
+}
+
+// View returns the output of Webhook entity.
+func (_w *Webhook) View() *WebhookOutput {
+	return ExposeWebhook(_w)
+}
+
+// View returns the output of Webhook entities.
+func (_ws Webhooks) View() []*WebhookOutput {
+	return ExposeWebhooks(_ws)
+}
+
+// ExposeWebhook converts the Webhook to WebhookOutput.
+func ExposeWebhook(_w *Webhook) *WebhookOutput {
+	if _w == nil {
+		return nil
+	}
+
+	wo := &WebhookOutput{
+		ID:          _w.ID,
+		Name:        _w.Name,
+		Description: _w.Description,
+		Labels:      _w.Labels,
+		CreateTime:  _w.CreateTime,
+		UpdateTime:  _w.UpdateTime,
+		URL:         _w.URL,
+		Topics:      _w.Topics,
+		EventTypes:  _w.EventTypes,
+		Disabled:    _w.Disabled,
+	}
+
+	if _w.Edges.Project != nil {
+		wo.Project = ExposeProject(_w.Edges.Project)
+	} else if _w.ProjectID != "" {
+		wo.Project = &ProjectOutput{
+			ID: _w.ProjectID,
+		}
+	}
+	return wo
+}
+
+// ExposeWebhooks converts the Webhook slice to WebhookOutput pointer slice.
+func ExposeWebhooks(_ws []*Webhook) []*WebhookOutput {
+	if len(_ws) == 0 {
+		return nil
+	}
+
+	wos := make([]*WebhookOutput, len(_ws))
+	for i := range _ws {
+		wos[i] = ExposeWebhook(_ws[i])
+	}
+	return wos
+}
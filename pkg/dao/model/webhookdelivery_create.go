@@ -0,0 +1,1429 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation   *WebhookDeliveryMutation
+	hooks      []Hook
+	conflict   []sql.ConflictOption
+	object     *WebhookDelivery
+	fromUpsert bool
+}
+
+// SetCreateTime sets the "create_time" field.
+func (wdc *WebhookDeliveryCreate) SetCreateTime(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreateTime(t)
+	return wdc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreateTime(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreateTime(*t)
+	}
+	return wdc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wdc *WebhookDeliveryCreate) SetUpdateTime(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdateTime(t)
+	return wdc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdateTime(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetUpdateTime(*t)
+	}
+	return wdc
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdc *WebhookDeliveryCreate) SetWebhookID(o object.ID) *WebhookDeliveryCreate {
+	wdc.mutation.SetWebhookID(o)
+	return wdc
+}
+
+// SetEventID sets the "event_id" field.
+func (wdc *WebhookDeliveryCreate) SetEventID(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventID(s)
+	return wdc
+}
+
+// SetTopic sets the "topic" field.
+func (wdc *WebhookDeliveryCreate) SetTopic(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetTopic(s)
+	return wdc
+}
+
+// SetEventType sets the "event_type" field.
+func (wdc *WebhookDeliveryCreate) SetEventType(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventType(s)
+	return wdc
+}
+
+// SetPayload sets the "payload" field.
+func (wdc *WebhookDeliveryCreate) SetPayload(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetPayload(s)
+	return wdc
+}
+
+// SetStatus sets the "status" field.
+func (wdc *WebhookDeliveryCreate) SetStatus(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetStatus(s)
+	return wdc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableStatus(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetStatus(*s)
+	}
+	return wdc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdc *WebhookDeliveryCreate) SetAttempts(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempts(i)
+	return wdc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableAttempts(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetAttempts(*i)
+	}
+	return wdc
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdc *WebhookDeliveryCreate) SetResponseStatus(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseStatus(i)
+	return wdc
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseStatus(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetResponseStatus(*i)
+	}
+	return wdc
+}
+
+// SetResponseBody sets the "response_body" field.
+func (wdc *WebhookDeliveryCreate) SetResponseBody(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseBody(s)
+	return wdc
+}
+
+// SetNillableResponseBody sets the "response_body" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseBody(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetResponseBody(*s)
+	}
+	return wdc
+}
+
+// SetMessage sets the "message" field.
+func (wdc *WebhookDeliveryCreate) SetMessage(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetMessage(s)
+	return wdc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableMessage(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetMessage(*s)
+	}
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WebhookDeliveryCreate) SetID(o object.ID) *WebhookDeliveryCreate {
+	wdc.mutation.SetID(o)
+	return wdc
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdc *WebhookDeliveryCreate) SetWebhook(w *Webhook) *WebhookDeliveryCreate {
+	return wdc.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() error {
+	if _, ok := wdc.mutation.CreateTime(); !ok {
+		if webhookdelivery.DefaultCreateTime == nil {
+			return fmt.Errorf("model: uninitialized webhookdelivery.DefaultCreateTime (forgotten import model/runtime?)")
+		}
+		v := webhookdelivery.DefaultCreateTime()
+		wdc.mutation.SetCreateTime(v)
+	}
+	if _, ok := wdc.mutation.UpdateTime(); !ok {
+		if webhookdelivery.DefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized webhookdelivery.DefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := webhookdelivery.DefaultUpdateTime()
+		wdc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		v := webhookdelivery.DefaultStatus
+		wdc.mutation.SetStatus(v)
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		v := webhookdelivery.DefaultAttempts
+		wdc.mutation.SetAttempts(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`model: missing required field "WebhookDelivery.create_time"`)}
+	}
+	if _, ok := wdc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`model: missing required field "WebhookDelivery.update_time"`)}
+	}
+	if _, ok := wdc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook_id", err: errors.New(`model: missing required field "WebhookDelivery.webhook_id"`)}
+	}
+	if v, ok := wdc.mutation.WebhookID(); ok {
+		if err := webhookdelivery.WebhookIDValidator(string(v)); err != nil {
+			return &ValidationError{Name: "webhook_id", err: fmt.Errorf(`model: validator failed for field "WebhookDelivery.webhook_id": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`model: missing required field "WebhookDelivery.event_id"`)}
+	}
+	if v, ok := wdc.mutation.EventID(); ok {
+		if err := webhookdelivery.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`model: validator failed for field "WebhookDelivery.event_id": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.Topic(); !ok {
+		return &ValidationError{Name: "topic", err: errors.New(`model: missing required field "WebhookDelivery.topic"`)}
+	}
+	if v, ok := wdc.mutation.Topic(); ok {
+		if err := webhookdelivery.TopicValidator(v); err != nil {
+			return &ValidationError{Name: "topic", err: fmt.Errorf(`model: validator failed for field "WebhookDelivery.topic": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`model: missing required field "WebhookDelivery.event_type"`)}
+	}
+	if v, ok := wdc.mutation.EventType(); ok {
+		if err := webhookdelivery.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`model: validator failed for field "WebhookDelivery.event_type": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`model: missing required field "WebhookDelivery.payload"`)}
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`model: missing required field "WebhookDelivery.status"`)}
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`model: missing required field "WebhookDelivery.attempts"`)}
+	}
+	if _, ok := wdc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook", err: errors.New(`model: missing required edge "WebhookDelivery.webhook"`)}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*object.ID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	)
+	_spec.Schema = wdc.schemaConfig.WebhookDelivery
+	_spec.OnConflict = wdc.conflict
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wdc.mutation.CreateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = &value
+	}
+	if value, ok := wdc.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = &value
+	}
+	if value, ok := wdc.mutation.EventID(); ok {
+		_spec.SetField(webhookdelivery.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := wdc.mutation.Topic(); ok {
+		_spec.SetField(webhookdelivery.FieldTopic, field.TypeString, value)
+		_node.Topic = value
+	}
+	if value, ok := wdc.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := wdc.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := wdc.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := wdc.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := wdc.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+		_node.ResponseStatus = value
+	}
+	if value, ok := wdc.mutation.ResponseBody(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseBody, field.TypeString, value)
+		_node.ResponseBody = value
+	}
+	if value, ok := wdc.mutation.Message(); ok {
+		_spec.SetField(webhookdelivery.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if nodes := wdc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = wdc.schemaConfig.WebhookDelivery
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WebhookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (wdc *WebhookDeliveryCreate) Set(obj *WebhookDelivery) *WebhookDeliveryCreate {
+	// Required.
+	wdc.SetWebhookID(obj.WebhookID)
+	wdc.SetEventID(obj.EventID)
+	wdc.SetTopic(obj.Topic)
+	wdc.SetEventType(obj.EventType)
+	wdc.SetPayload(obj.Payload)
+	wdc.SetStatus(obj.Status)
+	wdc.SetAttempts(obj.Attempts)
+
+	// Optional.
+	if obj.CreateTime != nil {
+		wdc.SetCreateTime(*obj.CreateTime)
+	}
+	if obj.UpdateTime != nil {
+		wdc.SetUpdateTime(*obj.UpdateTime)
+	}
+	if obj.ResponseStatus != 0 {
+		wdc.SetResponseStatus(obj.ResponseStatus)
+	}
+	if obj.ResponseBody != "" {
+		wdc.SetResponseBody(obj.ResponseBody)
+	}
+	if obj.Message != "" {
+		wdc.SetMessage(obj.Message)
+	}
+
+	// Record the given object.
+	wdc.object = obj
+
+	return wdc
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (wdc *WebhookDeliveryCreate) getClientSet() (mc ClientSet) {
+	if _, ok := wdc.config.driver.(*txDriver); ok {
+		tx := &Tx{config: wdc.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: wdc.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the WebhookDelivery entity,
+// which is always good for cascading create operations.
+func (wdc *WebhookDeliveryCreate) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *WebhookDelivery) error) (*WebhookDelivery, error) {
+	obj, err := wdc.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := wdc.getClientSet()
+	if wdc.fromUpsert {
+		q := mc.WebhookDeliveries().Query().
+			Where(
+				webhookdelivery.WebhookID(obj.WebhookID),
+				webhookdelivery.EventID(obj.EventID),
+			)
+		obj.ID, err = q.OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("model: failed to query id of WebhookDelivery entity: %w", err)
+		}
+	}
+
+	if x := wdc.object; x != nil {
+		if _, set := wdc.mutation.Field(webhookdelivery.FieldWebhookID); set {
+			obj.WebhookID = x.WebhookID
+		}
+		if _, set := wdc.mutation.Field(webhookdelivery.FieldEventID); set {
+			obj.EventID = x.EventID
+		}
+		if _, set := wdc.mutation.Field(webhookdelivery.FieldTopic); set {
+			obj.Topic = x.Topic
+		}
+		if _, set := wdc.mutation.Field(webhookdelivery.FieldEventType); set {
+			obj.EventType = x.EventType
+		}
+		if _, set := wdc.mutation.Field(webhookdelivery.FieldPayload); set {
+			obj.Payload = x.Payload
+		}
+		if _, set := wdc.mutation.Field(webhookdelivery.FieldResponseStatus); set {
+			obj.ResponseStatus = x.ResponseStatus
+		}
+		if _, set := wdc.mutation.Field(webhookdelivery.FieldResponseBody); set {
+			obj.ResponseBody = x.ResponseBody
+		}
+		if _, set := wdc.mutation.Field(webhookdelivery.FieldMessage); set {
+			obj.Message = x.Message
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *WebhookDelivery) error) *WebhookDelivery {
+	obj, err := wdc.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (wdc *WebhookDeliveryCreate) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *WebhookDelivery) error) error {
+	_, err := wdc.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *WebhookDelivery) error) {
+	if err := wdc.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Set leverages the WebhookDeliveryCreate Set method,
+// it sets the value by judging the definition of each field within the entire item of the given list.
+//
+// For required fields, Set calls directly.
+//
+// For optional fields, Set calls if the value is not zero.
+//
+// For example:
+//
+//	## Required
+//
+//	db.SetX(obj.X)
+//
+//	## Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (wdcb *WebhookDeliveryCreateBulk) Set(objs ...*WebhookDelivery) *WebhookDeliveryCreateBulk {
+	if len(objs) != 0 {
+		client := NewWebhookDeliveryClient(wdcb.config)
+
+		wdcb.builders = make([]*WebhookDeliveryCreate, len(objs))
+		for i := range objs {
+			wdcb.builders[i] = client.Create().Set(objs[i])
+		}
+
+		// Record the given objects.
+		wdcb.objects = objs
+	}
+
+	return wdcb
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (wdcb *WebhookDeliveryCreateBulk) getClientSet() (mc ClientSet) {
+	if _, ok := wdcb.config.driver.(*txDriver); ok {
+		tx := &Tx{config: wdcb.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: wdcb.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after created the WebhookDelivery entities,
+// which is always good for cascading create operations.
+func (wdcb *WebhookDeliveryCreateBulk) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *WebhookDelivery) error) ([]*WebhookDelivery, error) {
+	objs, err := wdcb.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return objs, err
+	}
+
+	mc := wdcb.getClientSet()
+	if wdcb.fromUpsert {
+		for i := range objs {
+			obj := objs[i]
+			q := mc.WebhookDeliveries().Query().
+				Where(
+					webhookdelivery.WebhookID(obj.WebhookID),
+					webhookdelivery.EventID(obj.EventID),
+				)
+			objs[i].ID, err = q.OnlyID(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("model: failed to query id of WebhookDelivery entity: %w", err)
+			}
+		}
+	}
+
+	if x := wdcb.objects; x != nil {
+		for i := range x {
+			if _, set := wdcb.builders[i].mutation.Field(webhookdelivery.FieldWebhookID); set {
+				objs[i].WebhookID = x[i].WebhookID
+			}
+			if _, set := wdcb.builders[i].mutation.Field(webhookdelivery.FieldEventID); set {
+				objs[i].EventID = x[i].EventID
+			}
+			if _, set := wdcb.builders[i].mutation.Field(webhookdelivery.FieldTopic); set {
+				objs[i].Topic = x[i].Topic
+			}
+			if _, set := wdcb.builders[i].mutation.Field(webhookdelivery.FieldEventType); set {
+				objs[i].EventType = x[i].EventType
+			}
+			if _, set := wdcb.builders[i].mutation.Field(webhookdelivery.FieldPayload); set {
+				objs[i].Payload = x[i].Payload
+			}
+			if _, set := wdcb.builders[i].mutation.Field(webhookdelivery.FieldResponseStatus); set {
+				objs[i].ResponseStatus = x[i].ResponseStatus
+			}
+			if _, set := wdcb.builders[i].mutation.Field(webhookdelivery.FieldResponseBody); set {
+				objs[i].ResponseBody = x[i].ResponseBody
+			}
+			if _, set := wdcb.builders[i].mutation.Field(webhookdelivery.FieldMessage); set {
+				objs[i].Message = x[i].Message
+			}
+			objs[i].Edges = x[i].Edges
+		}
+	}
+
+	for i := range objs {
+		for j := range cbs {
+			if err = cbs[j](ctx, mc, objs[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return objs, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *WebhookDelivery) error) []*WebhookDelivery {
+	objs, err := wdcb.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return objs
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (wdcb *WebhookDeliveryCreateBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *WebhookDelivery) error) error {
+	_, err := wdcb.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, created *WebhookDelivery) error) {
+	if err := wdcb.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *WebhookDeliveryUpsertOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *WebhookDelivery) error) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for WebhookDeliveryUpsertOne.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *WebhookDelivery) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading create operations.
+func (u *WebhookDeliveryUpsertBulk) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *WebhookDelivery) error) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the WebhookDeliveryUpsertBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for WebhookDeliveryUpsertBulk.OnConflict")
+	}
+	u.create.fromUpsert = true
+	return u.create.ExecE(ctx, cbs...)
+}
+
+// ExecEX is like ExecE, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertBulk) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *WebhookDelivery) error) {
+	if err := u.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDelivery.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliveryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (wdc *WebhookDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *WebhookDeliveryUpsertOne {
+	wdc.conflict = opts
+	return &WebhookDeliveryUpsertOne{
+		create: wdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdc *WebhookDeliveryCreate) OnConflictColumns(columns ...string) *WebhookDeliveryUpsertOne {
+	wdc.conflict = append(wdc.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliveryUpsertOne{
+		create: wdc,
+	}
+}
+
+type (
+	// WebhookDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one WebhookDelivery node.
+	WebhookDeliveryUpsertOne struct {
+		create *WebhookDeliveryCreate
+	}
+
+	// WebhookDeliveryUpsert is the "OnConflict" setter.
+	WebhookDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookDeliveryUpsert) SetUpdateTime(v time.Time) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateUpdateTime() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldUpdateTime)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsert) SetStatus(v string) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateStatus() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsert) SetAttempts(v int) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateAttempts() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsert) AddAttempts(v int) *WebhookDeliveryUpsert {
+	u.Add(webhookdelivery.FieldAttempts, v)
+	return u
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliveryUpsert) SetResponseStatus(v int) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldResponseStatus, v)
+	return u
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateResponseStatus() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldResponseStatus)
+	return u
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliveryUpsert) AddResponseStatus(v int) *WebhookDeliveryUpsert {
+	u.Add(webhookdelivery.FieldResponseStatus, v)
+	return u
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *WebhookDeliveryUpsert) ClearResponseStatus() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldResponseStatus)
+	return u
+}
+
+// SetResponseBody sets the "response_body" field.
+func (u *WebhookDeliveryUpsert) SetResponseBody(v string) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldResponseBody, v)
+	return u
+}
+
+// UpdateResponseBody sets the "response_body" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateResponseBody() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldResponseBody)
+	return u
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (u *WebhookDeliveryUpsert) ClearResponseBody() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldResponseBody)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *WebhookDeliveryUpsert) SetMessage(v string) *WebhookDeliveryUpsert {
+	u.Set(webhookdelivery.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsert) UpdateMessage() *WebhookDeliveryUpsert {
+	u.SetExcluded(webhookdelivery.FieldMessage)
+	return u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *WebhookDeliveryUpsert) ClearMessage() *WebhookDeliveryUpsert {
+	u.SetNull(webhookdelivery.FieldMessage)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertOne) UpdateNewValues() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(webhookdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(webhookdelivery.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.WebhookID(); exists {
+			s.SetIgnore(webhookdelivery.FieldWebhookID)
+		}
+		if _, exists := u.create.mutation.EventID(); exists {
+			s.SetIgnore(webhookdelivery.FieldEventID)
+		}
+		if _, exists := u.create.mutation.Topic(); exists {
+			s.SetIgnore(webhookdelivery.FieldTopic)
+		}
+		if _, exists := u.create.mutation.EventType(); exists {
+			s.SetIgnore(webhookdelivery.FieldEventType)
+		}
+		if _, exists := u.create.mutation.Payload(); exists {
+			s.SetIgnore(webhookdelivery.FieldPayload)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WebhookDeliveryUpsertOne) Ignore() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliveryUpsertOne) DoNothing() *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *WebhookDeliveryUpsertOne) Update(set func(*WebhookDeliveryUpsert)) *WebhookDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookDeliveryUpsertOne) SetUpdateTime(v time.Time) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateUpdateTime() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsertOne) SetStatus(v string) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateStatus() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsertOne) SetAttempts(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsertOne) AddAttempts(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateAttempts() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliveryUpsertOne) SetResponseStatus(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetResponseStatus(v)
+	})
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliveryUpsertOne) AddResponseStatus(v int) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddResponseStatus(v)
+	})
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateResponseStatus() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateResponseStatus()
+	})
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *WebhookDeliveryUpsertOne) ClearResponseStatus() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearResponseStatus()
+	})
+}
+
+// SetResponseBody sets the "response_body" field.
+func (u *WebhookDeliveryUpsertOne) SetResponseBody(v string) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetResponseBody(v)
+	})
+}
+
+// UpdateResponseBody sets the "response_body" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateResponseBody() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateResponseBody()
+	})
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (u *WebhookDeliveryUpsertOne) ClearResponseBody() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearResponseBody()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *WebhookDeliveryUpsertOne) SetMessage(v string) *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertOne) UpdateMessage() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *WebhookDeliveryUpsertOne) ClearMessage() *WebhookDeliveryUpsertOne {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for WebhookDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WebhookDeliveryUpsertOne) ID(ctx context.Context) (id object.ID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("model: WebhookDeliveryUpsertOne.ID is not supported by MySQL driver. Use WebhookDeliveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertOne) IDX(ctx context.Context) object.ID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	err        error
+	builders   []*WebhookDeliveryCreate
+	conflict   []sql.ConflictOption
+	objects    []*WebhookDelivery
+	fromUpsert bool
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WebhookDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WebhookDeliveryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (wdcb *WebhookDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *WebhookDeliveryUpsertBulk {
+	wdcb.conflict = opts
+	return &WebhookDeliveryUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wdcb *WebhookDeliveryCreateBulk) OnConflictColumns(columns ...string) *WebhookDeliveryUpsertBulk {
+	wdcb.conflict = append(wdcb.conflict, sql.ConflictColumns(columns...))
+	return &WebhookDeliveryUpsertBulk{
+		create: wdcb,
+	}
+}
+
+// WebhookDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of WebhookDelivery nodes.
+type WebhookDeliveryUpsertBulk struct {
+	create *WebhookDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(webhookdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertBulk) UpdateNewValues() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(webhookdelivery.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(webhookdelivery.FieldCreateTime)
+			}
+			if _, exists := b.mutation.WebhookID(); exists {
+				s.SetIgnore(webhookdelivery.FieldWebhookID)
+			}
+			if _, exists := b.mutation.EventID(); exists {
+				s.SetIgnore(webhookdelivery.FieldEventID)
+			}
+			if _, exists := b.mutation.Topic(); exists {
+				s.SetIgnore(webhookdelivery.FieldTopic)
+			}
+			if _, exists := b.mutation.EventType(); exists {
+				s.SetIgnore(webhookdelivery.FieldEventType)
+			}
+			if _, exists := b.mutation.Payload(); exists {
+				s.SetIgnore(webhookdelivery.FieldPayload)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WebhookDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WebhookDeliveryUpsertBulk) Ignore() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WebhookDeliveryUpsertBulk) DoNothing() *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WebhookDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *WebhookDeliveryUpsertBulk) Update(set func(*WebhookDeliveryUpsert)) *WebhookDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WebhookDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *WebhookDeliveryUpsertBulk) SetUpdateTime(v time.Time) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateUpdateTime() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WebhookDeliveryUpsertBulk) SetStatus(v string) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateStatus() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *WebhookDeliveryUpsertBulk) SetAttempts(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *WebhookDeliveryUpsertBulk) AddAttempts(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateAttempts() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (u *WebhookDeliveryUpsertBulk) SetResponseStatus(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetResponseStatus(v)
+	})
+}
+
+// AddResponseStatus adds v to the "response_status" field.
+func (u *WebhookDeliveryUpsertBulk) AddResponseStatus(v int) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.AddResponseStatus(v)
+	})
+}
+
+// UpdateResponseStatus sets the "response_status" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateResponseStatus() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateResponseStatus()
+	})
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (u *WebhookDeliveryUpsertBulk) ClearResponseStatus() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearResponseStatus()
+	})
+}
+
+// SetResponseBody sets the "response_body" field.
+func (u *WebhookDeliveryUpsertBulk) SetResponseBody(v string) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetResponseBody(v)
+	})
+}
+
+// UpdateResponseBody sets the "response_body" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateResponseBody() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateResponseBody()
+	})
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (u *WebhookDeliveryUpsertBulk) ClearResponseBody() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearResponseBody()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *WebhookDeliveryUpsertBulk) SetMessage(v string) *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *WebhookDeliveryUpsertBulk) UpdateMessage() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// ClearMessage clears the value of the "message" field.
+func (u *WebhookDeliveryUpsertBulk) ClearMessage() *WebhookDeliveryUpsertBulk {
+	return u.Update(func(s *WebhookDeliveryUpsert) {
+		s.ClearMessage()
+	})
+}
+
+// Exec executes the query.
+func (u *WebhookDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("model: OnConflict was set for builder %d. Set it on the WebhookDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("model: missing options for WebhookDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WebhookDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,772 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/model/internal"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *WebhookDelivery
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdateTime(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdateTime(t)
+	return wdu
+}
+
+// SetStatus sets the "status" field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(s)
+	return wdu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetStatus(*s)
+	}
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) SetResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetResponseStatus()
+	wdu.mutation.SetResponseStatus(i)
+	return wdu
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetResponseStatus(*i)
+	}
+	return wdu
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) AddResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddResponseStatus(i)
+	return wdu
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (wdu *WebhookDeliveryUpdate) ClearResponseStatus() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearResponseStatus()
+	return wdu
+}
+
+// SetResponseBody sets the "response_body" field.
+func (wdu *WebhookDeliveryUpdate) SetResponseBody(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetResponseBody(s)
+	return wdu
+}
+
+// SetNillableResponseBody sets the "response_body" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseBody(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetResponseBody(*s)
+	}
+	return wdu
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (wdu *WebhookDeliveryUpdate) ClearResponseBody() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearResponseBody()
+	return wdu
+}
+
+// SetMessage sets the "message" field.
+func (wdu *WebhookDeliveryUpdate) SetMessage(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetMessage(s)
+	return wdu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableMessage(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetMessage(*s)
+	}
+	return wdu
+}
+
+// ClearMessage clears the value of the "message" field.
+func (wdu *WebhookDeliveryUpdate) ClearMessage() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearMessage()
+	return wdu
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	if err := wdu.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() error {
+	if _, ok := wdu.mutation.UpdateTime(); !ok {
+		if webhookdelivery.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized webhookdelivery.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := webhookdelivery.UpdateDefaultUpdateTime()
+		wdu.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if _, ok := wdu.mutation.WebhookID(); wdu.mutation.WebhookCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value is not zero.
+//
+// For no default but required fields, Set calls directly.
+//
+// For no default but optional fields, Set calls if the value is not zero,
+// or clears if the value is zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (wdu *WebhookDeliveryUpdate) Set(obj *WebhookDelivery) *WebhookDeliveryUpdate {
+	// Without Default.
+	wdu.SetStatus(obj.Status)
+	wdu.SetAttempts(obj.Attempts)
+	if obj.ResponseStatus != 0 {
+		wdu.SetResponseStatus(obj.ResponseStatus)
+	} else {
+		wdu.ClearResponseStatus()
+	}
+	if obj.ResponseBody != "" {
+		wdu.SetResponseBody(obj.ResponseBody)
+	} else {
+		wdu.ClearResponseBody()
+	}
+	if obj.Message != "" {
+		wdu.SetMessage(obj.Message)
+	} else {
+		wdu.ClearMessage()
+	}
+
+	// With Default.
+	if obj.UpdateTime != nil {
+		wdu.SetUpdateTime(*obj.UpdateTime)
+	}
+
+	// Record the given object.
+	wdu.object = obj
+
+	return wdu
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wdu *WebhookDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdate {
+	wdu.modifiers = append(wdu.modifiers, modifiers...)
+	return wdu
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if wdu.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := wdu.mutation.ResponseBody(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseBody, field.TypeString, value)
+	}
+	if wdu.mutation.ResponseBodyCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseBody, field.TypeString)
+	}
+	if value, ok := wdu.mutation.Message(); ok {
+		_spec.SetField(webhookdelivery.FieldMessage, field.TypeString, value)
+	}
+	if wdu.mutation.MessageCleared() {
+		_spec.ClearField(webhookdelivery.FieldMessage, field.TypeString)
+	}
+	_spec.Node.Schema = wdu.schemaConfig.WebhookDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, wdu.schemaConfig)
+	_spec.AddModifiers(wdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+	object    *WebhookDelivery
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdateTime(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdateTime(t)
+	return wduo
+}
+
+// SetStatus sets the "status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(s)
+	return wduo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetStatus(*s)
+	}
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetResponseStatus sets the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetResponseStatus()
+	wduo.mutation.SetResponseStatus(i)
+	return wduo
+}
+
+// SetNillableResponseStatus sets the "response_status" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetResponseStatus(*i)
+	}
+	return wduo
+}
+
+// AddResponseStatus adds i to the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) AddResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddResponseStatus(i)
+	return wduo
+}
+
+// ClearResponseStatus clears the value of the "response_status" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearResponseStatus() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearResponseStatus()
+	return wduo
+}
+
+// SetResponseBody sets the "response_body" field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseBody(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetResponseBody(s)
+	return wduo
+}
+
+// SetNillableResponseBody sets the "response_body" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseBody(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetResponseBody(*s)
+	}
+	return wduo
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearResponseBody() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearResponseBody()
+	return wduo
+}
+
+// SetMessage sets the "message" field.
+func (wduo *WebhookDeliveryUpdateOne) SetMessage(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetMessage(s)
+	return wduo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableMessage(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetMessage(*s)
+	}
+	return wduo
+}
+
+// ClearMessage clears the value of the "message" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearMessage() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearMessage()
+	return wduo
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wduo.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() error {
+	if _, ok := wduo.mutation.UpdateTime(); !ok {
+		if webhookdelivery.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("model: uninitialized webhookdelivery.UpdateDefaultUpdateTime (forgotten import model/runtime?)")
+		}
+		v := webhookdelivery.UpdateDefaultUpdateTime()
+		wduo.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if _, ok := wduo.mutation.WebhookID(); wduo.mutation.WebhookCleared() && !ok {
+		return errors.New(`model: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Set is different from other Set* methods,
+// it sets the value by judging the definition of each field within the entire object.
+//
+// For default fields, Set calls if the value changes from the original.
+//
+// For no default but required fields, Set calls if the value changes from the original.
+//
+// For no default but optional fields, Set calls if the value changes from the original,
+// or clears if changes to zero.
+//
+// For example:
+//
+//	## Without Default
+//
+//	### Required
+//
+//	db.SetX(obj.X)
+//
+//	### Optional or Default
+//
+//	if _is_zero_value_(obj.X) {
+//	   if _is_not_equal_(db.X, obj.X) {
+//	      db.SetX(obj.X)
+//	   }
+//	} else {
+//	   db.ClearX()
+//	}
+//
+//	## With Default
+//
+//	if _is_zero_value_(obj.X) && _is_not_equal_(db.X, obj.X) {
+//	   db.SetX(obj.X)
+//	}
+func (wduo *WebhookDeliveryUpdateOne) Set(obj *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	h := func(n ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mt := m.(*WebhookDeliveryMutation)
+			db, err := mt.Client().WebhookDelivery.Get(ctx, *mt.id)
+			if err != nil {
+				return nil, fmt.Errorf("failed getting WebhookDelivery with id: %v", *mt.id)
+			}
+
+			// Without Default.
+			if db.Status != obj.Status {
+				wduo.SetStatus(obj.Status)
+			}
+			if db.Attempts != obj.Attempts {
+				wduo.SetAttempts(obj.Attempts)
+			}
+			if obj.ResponseStatus != 0 {
+				if db.ResponseStatus != obj.ResponseStatus {
+					wduo.SetResponseStatus(obj.ResponseStatus)
+				}
+			} else {
+				wduo.ClearResponseStatus()
+			}
+			if obj.ResponseBody != "" {
+				if db.ResponseBody != obj.ResponseBody {
+					wduo.SetResponseBody(obj.ResponseBody)
+				}
+			} else {
+				wduo.ClearResponseBody()
+			}
+			if obj.Message != "" {
+				if db.Message != obj.Message {
+					wduo.SetMessage(obj.Message)
+				}
+			} else {
+				wduo.ClearMessage()
+			}
+
+			// With Default.
+			if (obj.UpdateTime != nil) && (!reflect.DeepEqual(db.UpdateTime, obj.UpdateTime)) {
+				wduo.SetUpdateTime(*obj.UpdateTime)
+			}
+
+			// Record the given object.
+			wduo.object = obj
+
+			return n.Mutate(ctx, m)
+		})
+	}
+
+	wduo.hooks = append(wduo.hooks, h)
+
+	return wduo
+}
+
+// getClientSet returns the ClientSet for the given builder.
+func (wduo *WebhookDeliveryUpdateOne) getClientSet() (mc ClientSet) {
+	if _, ok := wduo.config.driver.(*txDriver); ok {
+		tx := &Tx{config: wduo.config}
+		tx.init()
+		mc = tx
+	} else {
+		cli := &Client{config: wduo.config}
+		cli.init()
+		mc = cli
+	}
+	return mc
+}
+
+// SaveE calls the given function after updated the WebhookDelivery entity,
+// which is always good for cascading update operations.
+func (wduo *WebhookDeliveryUpdateOne) SaveE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *WebhookDelivery) error) (*WebhookDelivery, error) {
+	obj, err := wduo.Save(ctx)
+	if err != nil &&
+		(wduo.object == nil || !errors.Is(err, stdsql.ErrNoRows)) {
+		return nil, err
+	}
+
+	if len(cbs) == 0 {
+		return obj, err
+	}
+
+	mc := wduo.getClientSet()
+
+	if obj == nil {
+		obj = wduo.object
+	} else if x := wduo.object; x != nil {
+		if _, set := wduo.mutation.Field(webhookdelivery.FieldStatus); set {
+			obj.Status = x.Status
+		}
+		if _, set := wduo.mutation.Field(webhookdelivery.FieldAttempts); set {
+			obj.Attempts = x.Attempts
+		}
+		if _, set := wduo.mutation.Field(webhookdelivery.FieldResponseStatus); set {
+			obj.ResponseStatus = x.ResponseStatus
+		}
+		if _, set := wduo.mutation.Field(webhookdelivery.FieldResponseBody); set {
+			obj.ResponseBody = x.ResponseBody
+		}
+		if _, set := wduo.mutation.Field(webhookdelivery.FieldMessage); set {
+			obj.Message = x.Message
+		}
+		obj.Edges = x.Edges
+	}
+
+	for i := range cbs {
+		if err = cbs[i](ctx, mc, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	return obj, nil
+}
+
+// SaveEX is like SaveE, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *WebhookDelivery) error) *WebhookDelivery {
+	obj, err := wduo.SaveE(ctx, cbs...)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// ExecE calls the given function after executed the query,
+// which is always good for cascading update operations.
+func (wduo *WebhookDeliveryUpdateOne) ExecE(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *WebhookDelivery) error) error {
+	_, err := wduo.SaveE(ctx, cbs...)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecEX(ctx context.Context, cbs ...func(ctx context.Context, mc ClientSet, updated *WebhookDelivery) error) {
+	if err := wduo.ExecE(ctx, cbs...); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wduo *WebhookDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdateOne {
+	wduo.modifiers = append(wduo.modifiers, modifiers...)
+	return wduo
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeString))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`model: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("model: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.SetField(webhookdelivery.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.ResponseStatus(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedResponseStatus(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatus, field.TypeInt, value)
+	}
+	if wduo.mutation.ResponseStatusCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatus, field.TypeInt)
+	}
+	if value, ok := wduo.mutation.ResponseBody(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseBody, field.TypeString, value)
+	}
+	if wduo.mutation.ResponseBodyCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseBody, field.TypeString)
+	}
+	if value, ok := wduo.mutation.Message(); ok {
+		_spec.SetField(webhookdelivery.FieldMessage, field.TypeString, value)
+	}
+	if wduo.mutation.MessageCleared() {
+		_spec.ClearField(webhookdelivery.FieldMessage, field.TypeString)
+	}
+	_spec.Node.Schema = wduo.schemaConfig.WebhookDelivery
+	ctx = internal.NewSchemaConfigContext(ctx, wduo.schemaConfig)
+	_spec.AddModifiers(wduo.modifiers...)
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
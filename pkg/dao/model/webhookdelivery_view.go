@@ -0,0 +1,921 @@
+// SPDX-FileCopyrightText: 2024 Seal, Inc
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by "walrus". DO NOT EDIT.
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/webhookdelivery"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// WebhookDeliveryCreateInput holds the creation input of the WebhookDelivery entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookDeliveryCreateInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Payload of the event to deliver.
+	Payload string `path:"-" query:"-" json:"payload"`
+	// Type of the event.
+	EventType string `path:"-" query:"-" json:"eventType"`
+	// Topic of the event.
+	Topic string `path:"-" query:"-" json:"topic"`
+	// ID of the event to deliver.
+	EventID string `path:"-" query:"-" json:"eventID"`
+	// Status of the delivery, pending, succeeded or failed.
+	Status string `path:"-" query:"-" json:"status,omitempty"`
+	// Count of the delivering attempts.
+	Attempts int `path:"-" query:"-" json:"attempts,omitempty"`
+	// Status code of the last response.
+	ResponseStatus int `path:"-" query:"-" json:"responseStatus,omitempty"`
+	// Body of the last response, truncated if too large.
+	ResponseBody string `path:"-" query:"-" json:"responseBody,omitempty"`
+	// Error message of the last attempt.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+}
+
+// Model returns the WebhookDelivery entity for creating,
+// after validating.
+func (wdci *WebhookDeliveryCreateInput) Model() *WebhookDelivery {
+	if wdci == nil {
+		return nil
+	}
+
+	_wd := &WebhookDelivery{
+		Payload:        wdci.Payload,
+		EventType:      wdci.EventType,
+		Topic:          wdci.Topic,
+		EventID:        wdci.EventID,
+		Status:         wdci.Status,
+		Attempts:       wdci.Attempts,
+		ResponseStatus: wdci.ResponseStatus,
+		ResponseBody:   wdci.ResponseBody,
+		Message:        wdci.Message,
+	}
+
+	return _wd
+}
+
+// Validate checks the WebhookDeliveryCreateInput entity.
+func (wdci *WebhookDeliveryCreateInput) Validate() error {
+	if wdci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wdci.ValidateWith(wdci.inputConfig.Context, wdci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeliveryCreateInput entity with the given context and client set.
+func (wdci *WebhookDeliveryCreateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wdci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// WebhookDeliveryCreateInputs holds the creation input item of the WebhookDelivery entities.
+type WebhookDeliveryCreateInputsItem struct {
+	// Payload of the event to deliver.
+	Payload string `path:"-" query:"-" json:"payload"`
+	// Type of the event.
+	EventType string `path:"-" query:"-" json:"eventType"`
+	// Topic of the event.
+	Topic string `path:"-" query:"-" json:"topic"`
+	// ID of the event to deliver.
+	EventID string `path:"-" query:"-" json:"eventID"`
+	// Status of the delivery, pending, succeeded or failed.
+	Status string `path:"-" query:"-" json:"status,omitempty"`
+	// Count of the delivering attempts.
+	Attempts int `path:"-" query:"-" json:"attempts,omitempty"`
+	// Status code of the last response.
+	ResponseStatus int `path:"-" query:"-" json:"responseStatus,omitempty"`
+	// Body of the last response, truncated if too large.
+	ResponseBody string `path:"-" query:"-" json:"responseBody,omitempty"`
+	// Error message of the last attempt.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+}
+
+// ValidateWith checks the WebhookDeliveryCreateInputsItem entity with the given context and client set.
+func (wdci *WebhookDeliveryCreateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wdci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// WebhookDeliveryCreateInputs holds the creation input of the WebhookDelivery entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookDeliveryCreateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*WebhookDeliveryCreateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the WebhookDelivery entities for creating,
+// after validating.
+func (wdci *WebhookDeliveryCreateInputs) Model() []*WebhookDelivery {
+	if wdci == nil || len(wdci.Items) == 0 {
+		return nil
+	}
+
+	_wds := make([]*WebhookDelivery, len(wdci.Items))
+
+	for i := range wdci.Items {
+		_wd := &WebhookDelivery{
+			Payload:        wdci.Items[i].Payload,
+			EventType:      wdci.Items[i].EventType,
+			Topic:          wdci.Items[i].Topic,
+			EventID:        wdci.Items[i].EventID,
+			Status:         wdci.Items[i].Status,
+			Attempts:       wdci.Items[i].Attempts,
+			ResponseStatus: wdci.Items[i].ResponseStatus,
+			ResponseBody:   wdci.Items[i].ResponseBody,
+			Message:        wdci.Items[i].Message,
+		}
+
+		_wds[i] = _wd
+	}
+
+	return _wds
+}
+
+// Validate checks the WebhookDeliveryCreateInputs entity .
+func (wdci *WebhookDeliveryCreateInputs) Validate() error {
+	if wdci == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wdci.ValidateWith(wdci.inputConfig.Context, wdci.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeliveryCreateInputs entity with the given context and client set.
+func (wdci *WebhookDeliveryCreateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wdci == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(wdci.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	for i := range wdci.Items {
+		if wdci.Items[i] == nil {
+			continue
+		}
+
+		if err := wdci.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WebhookDeliveryDeleteInput holds the deletion input of the WebhookDelivery entity,
+// please tags with `path:",inline"` if embedding.
+type WebhookDeliveryDeleteInput struct {
+	WebhookDeliveryQueryInput `path:",inline"`
+}
+
+// WebhookDeliveryDeleteInputs holds the deletion input item of the WebhookDelivery entities.
+type WebhookDeliveryDeleteInputsItem struct {
+	// ID of the WebhookDelivery entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// EventID of the WebhookDelivery entity, a part of the unique index.
+	EventID string `path:"-" query:"-" json:"eventID,omitempty"`
+}
+
+// WebhookDeliveryDeleteInputs holds the deletion input of the WebhookDelivery entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookDeliveryDeleteInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*WebhookDeliveryDeleteInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the WebhookDelivery entities for deleting,
+// after validating.
+func (wddi *WebhookDeliveryDeleteInputs) Model() []*WebhookDelivery {
+	if wddi == nil || len(wddi.Items) == 0 {
+		return nil
+	}
+
+	_wds := make([]*WebhookDelivery, len(wddi.Items))
+	for i := range wddi.Items {
+		_wds[i] = &WebhookDelivery{
+			ID: wddi.Items[i].ID,
+		}
+	}
+	return _wds
+}
+
+// IDs returns the ID list of the WebhookDelivery entities for deleting,
+// after validating.
+func (wddi *WebhookDeliveryDeleteInputs) IDs() []object.ID {
+	if wddi == nil || len(wddi.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(wddi.Items))
+	for i := range wddi.Items {
+		ids[i] = wddi.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the WebhookDeliveryDeleteInputs entity.
+func (wddi *WebhookDeliveryDeleteInputs) Validate() error {
+	if wddi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wddi.ValidateWith(wddi.inputConfig.Context, wddi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeliveryDeleteInputs entity with the given context and client set.
+func (wddi *WebhookDeliveryDeleteInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wddi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(wddi.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.WebhookDeliveries().Query()
+
+	ids := make([]object.ID, 0, len(wddi.Items))
+	ors := make([]predicate.WebhookDelivery, 0, len(wddi.Items))
+	indexers := make(map[any][]int)
+
+	for i := range wddi.Items {
+		if wddi.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if wddi.Items[i].ID != "" {
+			ids = append(ids, wddi.Items[i].ID)
+			ors = append(ors, webhookdelivery.ID(wddi.Items[i].ID))
+			indexers[wddi.Items[i].ID] = append(indexers[wddi.Items[i].ID], i)
+		} else if wddi.Items[i].EventID != "" {
+			ors = append(ors, webhookdelivery.And(
+				webhookdelivery.EventID(wddi.Items[i].EventID)))
+			indexerKey := fmt.Sprint("/", wddi.Items[i].EventID)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := webhookdelivery.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = webhookdelivery.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			webhookdelivery.FieldID,
+			webhookdelivery.FieldEventID,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].EventID)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			wddi.Items[j].ID = es[i].ID
+			wddi.Items[j].EventID = es[i].EventID
+		}
+	}
+
+	return nil
+}
+
+// WebhookDeliveryPatchInput holds the patch input of the WebhookDelivery entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookDeliveryPatchInput struct {
+	WebhookDeliveryQueryInput `path:",inline" query:"-" json:"-"`
+
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime *time.Time `path:"-" query:"-" json:"createTime,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime *time.Time `path:"-" query:"-" json:"updateTime,omitempty"`
+	// ID of the event to deliver.
+	EventID string `path:"-" query:"-" json:"eventID,omitempty"`
+	// Topic of the event.
+	Topic string `path:"-" query:"-" json:"topic,omitempty"`
+	// Type of the event.
+	EventType string `path:"-" query:"-" json:"eventType,omitempty"`
+	// Payload of the event to deliver.
+	Payload string `path:"-" query:"-" json:"payload,omitempty"`
+	// Status of the delivery, pending, succeeded or failed.
+	Status string `path:"-" query:"-" json:"status,omitempty"`
+	// Count of the delivering attempts.
+	Attempts int `path:"-" query:"-" json:"attempts,omitempty"`
+	// Status code of the last response.
+	ResponseStatus int `path:"-" query:"-" json:"responseStatus,omitempty"`
+	// Body of the last response, truncated if too large.
+	ResponseBody string `path:"-" query:"-" json:"responseBody,omitempty"`
+	// Error message of the last attempt.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+
+	patchedEntity *WebhookDelivery `path:"-" query:"-" json:"-"`
+}
+
+// PatchModel returns the WebhookDelivery partition entity for patching.
+func (wdpi *WebhookDeliveryPatchInput) PatchModel() *WebhookDelivery {
+	if wdpi == nil {
+		return nil
+	}
+
+	_wd := &WebhookDelivery{
+		CreateTime:     wdpi.CreateTime,
+		UpdateTime:     wdpi.UpdateTime,
+		EventID:        wdpi.EventID,
+		Topic:          wdpi.Topic,
+		EventType:      wdpi.EventType,
+		Payload:        wdpi.Payload,
+		Status:         wdpi.Status,
+		Attempts:       wdpi.Attempts,
+		ResponseStatus: wdpi.ResponseStatus,
+		ResponseBody:   wdpi.ResponseBody,
+		Message:        wdpi.Message,
+	}
+
+	return _wd
+}
+
+// Model returns the WebhookDelivery patched entity,
+// after validating.
+func (wdpi *WebhookDeliveryPatchInput) Model() *WebhookDelivery {
+	if wdpi == nil {
+		return nil
+	}
+
+	return wdpi.patchedEntity
+}
+
+// Validate checks the WebhookDeliveryPatchInput entity.
+func (wdpi *WebhookDeliveryPatchInput) Validate() error {
+	if wdpi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wdpi.ValidateWith(wdpi.inputConfig.Context, wdpi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeliveryPatchInput entity with the given context and client set.
+func (wdpi *WebhookDeliveryPatchInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := wdpi.WebhookDeliveryQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	q := cs.WebhookDeliveries().Query()
+
+	if wdpi.Refer != nil {
+		if wdpi.Refer.IsID() {
+			q.Where(
+				webhookdelivery.ID(wdpi.Refer.ID()))
+		} else if refers := wdpi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				webhookdelivery.EventID(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of webhookdelivery")
+		}
+	} else if wdpi.ID != "" {
+		q.Where(
+			webhookdelivery.ID(wdpi.ID))
+	} else if wdpi.EventID != "" {
+		q.Where(
+			webhookdelivery.EventID(wdpi.EventID))
+	} else {
+		return errors.New("invalid identify of webhookdelivery")
+	}
+
+	q.Select(
+		webhookdelivery.WithoutFields(
+			webhookdelivery.FieldCreateTime,
+			webhookdelivery.FieldUpdateTime,
+		)...,
+	)
+
+	var e *WebhookDelivery
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*WebhookDelivery)
+		}
+	}
+
+	_pm := wdpi.PatchModel()
+
+	_po, err := json.PatchObject(*e, *_pm)
+	if err != nil {
+		return err
+	}
+
+	_obj := _po.(*WebhookDelivery)
+
+	if !reflect.DeepEqual(e.CreateTime, _obj.CreateTime) {
+		return errors.New("field createTime is immutable")
+	}
+	if e.EventID != _obj.EventID {
+		return errors.New("field eventID is immutable")
+	}
+	if e.Topic != _obj.Topic {
+		return errors.New("field topic is immutable")
+	}
+	if e.EventType != _obj.EventType {
+		return errors.New("field eventType is immutable")
+	}
+	if e.Payload != _obj.Payload {
+		return errors.New("field payload is immutable")
+	}
+
+	wdpi.patchedEntity = _obj
+	return nil
+}
+
+// WebhookDeliveryQueryInput holds the query input of the WebhookDelivery entity,
+// please tags with `path:",inline"` if embedding.
+type WebhookDeliveryQueryInput struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Refer holds the route path reference of the WebhookDelivery entity.
+	Refer *object.Refer `path:"webhookdelivery,default=" query:"-" json:"-"`
+	// ID of the WebhookDelivery entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// EventID of the WebhookDelivery entity, a part of the unique index.
+	EventID string `path:"-" query:"-" json:"eventID,omitempty"`
+}
+
+// Model returns the WebhookDelivery entity for querying,
+// after validating.
+func (wdqi *WebhookDeliveryQueryInput) Model() *WebhookDelivery {
+	if wdqi == nil {
+		return nil
+	}
+
+	return &WebhookDelivery{
+		ID:      wdqi.ID,
+		EventID: wdqi.EventID,
+	}
+}
+
+// Validate checks the WebhookDeliveryQueryInput entity.
+func (wdqi *WebhookDeliveryQueryInput) Validate() error {
+	if wdqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wdqi.ValidateWith(wdqi.inputConfig.Context, wdqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeliveryQueryInput entity with the given context and client set.
+func (wdqi *WebhookDeliveryQueryInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wdqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if wdqi.Refer != nil && *wdqi.Refer == "" {
+		return fmt.Errorf("model: %s : %w", webhookdelivery.Label, ErrBlankResourceRefer)
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.WebhookDeliveries().Query()
+
+	if wdqi.Refer != nil {
+		if wdqi.Refer.IsID() {
+			q.Where(
+				webhookdelivery.ID(wdqi.Refer.ID()))
+		} else if refers := wdqi.Refer.Split(1); len(refers) == 1 {
+			q.Where(
+				webhookdelivery.EventID(refers[0].String()))
+		} else {
+			return errors.New("invalid identify refer of webhookdelivery")
+		}
+	} else if wdqi.ID != "" {
+		q.Where(
+			webhookdelivery.ID(wdqi.ID))
+	} else if wdqi.EventID != "" {
+		q.Where(
+			webhookdelivery.EventID(wdqi.EventID))
+	} else {
+		return errors.New("invalid identify of webhookdelivery")
+	}
+
+	q.Select(
+		webhookdelivery.FieldID,
+		webhookdelivery.FieldEventID,
+	)
+
+	var e *WebhookDelivery
+	{
+		// Get cache from previous validation.
+		queryStmt, queryArgs := q.sqlQuery(setContextOp(ctx, q.ctx, "cache")).Query()
+		ck := fmt.Sprintf("stmt=%v, args=%v", queryStmt, queryArgs)
+		if cv, existed := cache[ck]; !existed {
+			var err error
+			e, err = q.Only(ctx)
+			if err != nil {
+				return err
+			}
+
+			// Set cache for other validation.
+			cache[ck] = e
+		} else {
+			e = cv.(*WebhookDelivery)
+		}
+	}
+
+	wdqi.ID = e.ID
+	wdqi.EventID = e.EventID
+	return nil
+}
+
+// WebhookDeliveryQueryInputs holds the query input of the WebhookDelivery entities,
+// please tags with `path:",inline" query:",inline"` if embedding.
+type WebhookDeliveryQueryInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+}
+
+// Validate checks the WebhookDeliveryQueryInputs entity.
+func (wdqi *WebhookDeliveryQueryInputs) Validate() error {
+	if wdqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wdqi.ValidateWith(wdqi.inputConfig.Context, wdqi.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeliveryQueryInputs entity with the given context and client set.
+func (wdqi *WebhookDeliveryQueryInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wdqi == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// WebhookDeliveryUpdateInput holds the modification input of the WebhookDelivery entity,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookDeliveryUpdateInput struct {
+	WebhookDeliveryQueryInput `path:",inline" query:"-" json:"-"`
+
+	// Status of the delivery, pending, succeeded or failed.
+	Status string `path:"-" query:"-" json:"status,omitempty"`
+	// Count of the delivering attempts.
+	Attempts int `path:"-" query:"-" json:"attempts,omitempty"`
+	// Status code of the last response.
+	ResponseStatus int `path:"-" query:"-" json:"responseStatus,omitempty"`
+	// Body of the last response, truncated if too large.
+	ResponseBody string `path:"-" query:"-" json:"responseBody,omitempty"`
+	// Error message of the last attempt.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+}
+
+// Model returns the WebhookDelivery entity for modifying,
+// after validating.
+func (wdui *WebhookDeliveryUpdateInput) Model() *WebhookDelivery {
+	if wdui == nil {
+		return nil
+	}
+
+	_wd := &WebhookDelivery{
+		ID:             wdui.ID,
+		EventID:        wdui.EventID,
+		Status:         wdui.Status,
+		Attempts:       wdui.Attempts,
+		ResponseStatus: wdui.ResponseStatus,
+		ResponseBody:   wdui.ResponseBody,
+		Message:        wdui.Message,
+	}
+
+	return _wd
+}
+
+// Validate checks the WebhookDeliveryUpdateInput entity.
+func (wdui *WebhookDeliveryUpdateInput) Validate() error {
+	if wdui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wdui.ValidateWith(wdui.inputConfig.Context, wdui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeliveryUpdateInput entity with the given context and client set.
+func (wdui *WebhookDeliveryUpdateInput) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	if err := wdui.WebhookDeliveryQueryInput.ValidateWith(ctx, cs, cache); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WebhookDeliveryUpdateInputs holds the modification input item of the WebhookDelivery entities.
+type WebhookDeliveryUpdateInputsItem struct {
+	// ID of the WebhookDelivery entity, tries to retrieve the entity with the following unique index parts if no ID provided.
+	ID object.ID `path:"-" query:"-" json:"id,omitempty"`
+	// EventID of the WebhookDelivery entity, a part of the unique index.
+	EventID string `path:"-" query:"-" json:"eventID,omitempty"`
+
+	// Status of the delivery, pending, succeeded or failed.
+	Status string `path:"-" query:"-" json:"status"`
+	// Count of the delivering attempts.
+	Attempts int `path:"-" query:"-" json:"attempts"`
+	// Status code of the last response.
+	ResponseStatus int `path:"-" query:"-" json:"responseStatus,omitempty"`
+	// Body of the last response, truncated if too large.
+	ResponseBody string `path:"-" query:"-" json:"responseBody,omitempty"`
+	// Error message of the last attempt.
+	Message string `path:"-" query:"-" json:"message,omitempty"`
+}
+
+// ValidateWith checks the WebhookDeliveryUpdateInputsItem entity with the given context and client set.
+func (wdui *WebhookDeliveryUpdateInputsItem) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wdui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	return nil
+}
+
+// WebhookDeliveryUpdateInputs holds the modification input of the WebhookDelivery entities,
+// please tags with `path:",inline" json:",inline"` if embedding.
+type WebhookDeliveryUpdateInputs struct {
+	inputConfig `path:"-" query:"-" json:"-"`
+
+	// Items holds the entities to create, which MUST not be empty.
+	Items []*WebhookDeliveryUpdateInputsItem `path:"-" query:"-" json:"items"`
+}
+
+// Model returns the WebhookDelivery entities for modifying,
+// after validating.
+func (wdui *WebhookDeliveryUpdateInputs) Model() []*WebhookDelivery {
+	if wdui == nil || len(wdui.Items) == 0 {
+		return nil
+	}
+
+	_wds := make([]*WebhookDelivery, len(wdui.Items))
+
+	for i := range wdui.Items {
+		_wd := &WebhookDelivery{
+			ID:             wdui.Items[i].ID,
+			EventID:        wdui.Items[i].EventID,
+			Status:         wdui.Items[i].Status,
+			Attempts:       wdui.Items[i].Attempts,
+			ResponseStatus: wdui.Items[i].ResponseStatus,
+			ResponseBody:   wdui.Items[i].ResponseBody,
+			Message:        wdui.Items[i].Message,
+		}
+
+		_wds[i] = _wd
+	}
+
+	return _wds
+}
+
+// IDs returns the ID list of the WebhookDelivery entities for modifying,
+// after validating.
+func (wdui *WebhookDeliveryUpdateInputs) IDs() []object.ID {
+	if wdui == nil || len(wdui.Items) == 0 {
+		return nil
+	}
+
+	ids := make([]object.ID, len(wdui.Items))
+	for i := range wdui.Items {
+		ids[i] = wdui.Items[i].ID
+	}
+	return ids
+}
+
+// Validate checks the WebhookDeliveryUpdateInputs entity.
+func (wdui *WebhookDeliveryUpdateInputs) Validate() error {
+	if wdui == nil {
+		return errors.New("nil receiver")
+	}
+
+	return wdui.ValidateWith(wdui.inputConfig.Context, wdui.inputConfig.Client, nil)
+}
+
+// ValidateWith checks the WebhookDeliveryUpdateInputs entity with the given context and client set.
+func (wdui *WebhookDeliveryUpdateInputs) ValidateWith(ctx context.Context, cs ClientSet, cache map[string]any) error {
+	if wdui == nil {
+		return errors.New("nil receiver")
+	}
+
+	if len(wdui.Items) == 0 {
+		return errors.New("empty items")
+	}
+
+	if cache == nil {
+		cache = map[string]any{}
+	}
+
+	q := cs.WebhookDeliveries().Query()
+
+	ids := make([]object.ID, 0, len(wdui.Items))
+	ors := make([]predicate.WebhookDelivery, 0, len(wdui.Items))
+	indexers := make(map[any][]int)
+
+	for i := range wdui.Items {
+		if wdui.Items[i] == nil {
+			return errors.New("nil item")
+		}
+
+		if wdui.Items[i].ID != "" {
+			ids = append(ids, wdui.Items[i].ID)
+			ors = append(ors, webhookdelivery.ID(wdui.Items[i].ID))
+			indexers[wdui.Items[i].ID] = append(indexers[wdui.Items[i].ID], i)
+		} else if wdui.Items[i].EventID != "" {
+			ors = append(ors, webhookdelivery.And(
+				webhookdelivery.EventID(wdui.Items[i].EventID)))
+			indexerKey := fmt.Sprint("/", wdui.Items[i].EventID)
+			indexers[indexerKey] = append(indexers[indexerKey], i)
+		} else {
+			return errors.New("found item hasn't identify")
+		}
+	}
+
+	p := webhookdelivery.IDIn(ids...)
+	if len(ids) != cap(ids) {
+		p = webhookdelivery.Or(ors...)
+	}
+
+	es, err := q.
+		Where(p).
+		Select(
+			webhookdelivery.FieldID,
+			webhookdelivery.FieldEventID,
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(es) != cap(ids) {
+		return errors.New("found unrecognized item")
+	}
+
+	for i := range es {
+		indexer := indexers[es[i].ID]
+		if indexer == nil {
+			indexerKey := fmt.Sprint("/", es[i].EventID)
+			indexer = indexers[indexerKey]
+		}
+		for _, j := range indexer {
+			wdui.Items[j].ID = es[i].ID
+			wdui.Items[j].EventID = es[i].EventID
+		}
+	}
+
+	for i := range wdui.Items {
+		if err := wdui.Items[i].ValidateWith(ctx, cs, cache); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WebhookDeliveryOutput holds the output of the WebhookDelivery entity.
+type WebhookDeliveryOutput struct {
+	ID             object.ID  `json:"id,omitempty"`
+	CreateTime     *time.Time `json:"createTime,omitempty"`
+	UpdateTime     *time.Time `json:"updateTime,omitempty"`
+	EventID        string     `json:"eventID,omitempty"`
+	Topic          string     `json:"topic,omitempty"`
+	EventType      string     `json:"eventType,omitempty"`
+	Payload        string     `json:"payload,omitempty"`
+	Status         string     `json:"status,omitempty"`
+	Attempts       int        `json:"attempts,omitempty"`
+	ResponseStatus int        `json:"responseStatus,omitempty"`
+	ResponseBody   string     `json:"responseBody,omitempty"`
+	Message        string     `json:"message,omitempty"`
+
+	Webhook *WebhookOutput `json:"webhook,omitempty"`
+}
+
+// View returns the output of WebhookDelivery entity.
+func (_wd *WebhookDelivery) View() *WebhookDeliveryOutput {
+	return ExposeWebhookDelivery(_wd)
+}
+
+// View returns the output of WebhookDelivery entities.
+func (_wds WebhookDeliveries) View() []*WebhookDeliveryOutput {
+	return ExposeWebhookDeliveries(_wds)
+}
+
+// ExposeWebhookDelivery converts the WebhookDelivery to WebhookDeliveryOutput.
+func ExposeWebhookDelivery(_wd *WebhookDelivery) *WebhookDeliveryOutput {
+	if _wd == nil {
+		return nil
+	}
+
+	wdo := &WebhookDeliveryOutput{
+		ID:             _wd.ID,
+		CreateTime:     _wd.CreateTime,
+		UpdateTime:     _wd.UpdateTime,
+		EventID:        _wd.EventID,
+		Topic:          _wd.Topic,
+		EventType:      _wd.EventType,
+		Payload:        _wd.Payload,
+		Status:         _wd.Status,
+		Attempts:       _wd.Attempts,
+		ResponseStatus: _wd.ResponseStatus,
+		ResponseBody:   _wd.ResponseBody,
+		Message:        _wd.Message,
+	}
+
+	if _wd.Edges.Webhook != nil {
+		wdo.Webhook = ExposeWebhook(_wd.Edges.Webhook)
+	} else if _wd.WebhookID != "" {
+		wdo.Webhook = &WebhookOutput{
+			ID: _wd.WebhookID,
+		}
+	}
+	return wdo
+}
+
+// ExposeWebhookDeliveries converts the WebhookDelivery slice to WebhookDeliveryOutput pointer slice.
+func ExposeWebhookDeliveries(_wds []*WebhookDelivery) []*WebhookDeliveryOutput {
+	if len(_wds) == 0 {
+		return nil
+	}
+
+	wdos := make([]*WebhookDeliveryOutput, len(_wds))
+	for i := range _wds {
+		wdos[i] = ExposeWebhookDelivery(_wds[i])
+	}
+	return wdos
+}
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// AuditLog holds the schema definition for the record of the mutating API calls.
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time().WithoutUpdateTime(),
+	}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject_id"),
+		index.Fields("project_id", "environment_id"),
+		index.Fields("resource_kind", "resource_id"),
+	}
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("subject_id").
+			Comment("ID of the subject who performs the call, empty means anonymous.").
+			Optional().
+			Immutable(),
+		field.String("subject_name").
+			Comment("Name of the subject who performs the call.").
+			Optional().
+			Immutable(),
+		field.String("action").
+			Comment("Action of the call, i.e. create, update, delete or the name of the custom route.").
+			NotEmpty().
+			Immutable(),
+		field.String("method").
+			Comment("HTTP method of the call.").
+			NotEmpty().
+			Immutable(),
+		field.String("path").
+			Comment("Path of the call.").
+			NotEmpty().
+			Immutable(),
+		field.String("resource_kind").
+			Comment("Kind of the resource to operate.").
+			Optional().
+			Immutable(),
+		field.String("resource_id").
+			Comment("ID or name of the resource to operate, empty means operating a collection.").
+			Optional().
+			Immutable(),
+		object.IDField("project_id").
+			Comment("ID of the project to which the resource belongs.").
+			Optional().
+			Immutable(),
+		object.IDField("environment_id").
+			Comment("ID of the environment to which the resource belongs.").
+			Optional().
+			Immutable(),
+		field.Text("request").
+			Comment("Summary of the request, sensitive fields are redacted.").
+			Optional().
+			Immutable(),
+		field.Int("status").
+			Comment("HTTP status code of the response.").
+			Immutable(),
+		field.String("result").
+			Comment("Result of the call, succeeded or failed.").
+			NotEmpty().
+			Immutable(),
+		field.Text("message").
+			Comment("Message of the result, i.e. the error message if failed.").
+			Optional().
+			Immutable(),
+	}
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entx.SkipClearingOptionalField(),
+	}
+}
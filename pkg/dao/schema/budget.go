@@ -0,0 +1,116 @@
+package schema
+
+import (
+	"errors"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// Budget holds the schema definition for the cost budget of a project or an environment.
+type Budget struct {
+	ent.Schema
+}
+
+func (Budget) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Metadata().WithoutAnnotations(),
+	}
+}
+
+func (Budget) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "name").
+			Unique(),
+	}
+}
+
+func (Budget) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("project_id").
+			Comment("ID of the project to belong.").
+			NotEmpty().
+			Immutable(),
+		object.IDField("environment_id").
+			Comment("ID of the environment to watch, empty means the whole project.").
+			Optional().
+			Immutable(),
+		field.JSON("filters", types.CostFilters{}).
+			Comment("Filters to narrow down the watched cost, within the project or environment.").
+			Optional(),
+		field.Float("amount").
+			Comment("Amount of the budget within a period.").
+			Positive().
+			StructTag(`json:"amount,omitempty,cli-table-column"`),
+		field.String("period").
+			Comment("Period of the budget, monthly or custom.").
+			Default(types.BudgetPeriodMonthly).
+			Validate(func(s string) error {
+				if !types.IsBudgetPeriod(s) {
+					return errors.New("invalid period: unknown")
+				}
+
+				return nil
+			}).
+			StructTag(`json:"period,omitempty,cli-table-column"`),
+		field.Time("start_time").
+			Comment("Start time of the custom period.").
+			Optional().
+			Nillable(),
+		field.Time("end_time").
+			Comment("End time of the custom period.").
+			Optional().
+			Nillable(),
+		field.JSON("thresholds", types.BudgetThresholds{}).
+			Comment("Thresholds to alert, in percentage of the amount.").
+			Default(types.DefaultBudgetThresholds()),
+		field.JSON("spend", &types.BudgetSpend{}).
+			Comment("Spend of the budget at the last evaluation.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+	}
+}
+
+func (Budget) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Project 1-* Budgets.
+		edge.From("project", Project.Type).
+			Ref("budgets").
+			Field("project_id").
+			Comment("Project to which the budget belongs.").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(
+				entx.ValidateContext(intercept.WithProjectInterceptor)),
+		// Environment 1-* Budgets.
+		edge.From("environment", Environment.Type).
+			Ref("budgets").
+			Field("environment_id").
+			Comment("Environment which the budget watches.").
+			Unique().
+			Immutable(),
+		// Budget 1-* BudgetEvents.
+		edge.To("events", BudgetEvent.Type).
+			Comment("Events of the budget.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+	}
+}
+
+func (Budget) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.ByProject("project_id"),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// BudgetEvent holds the schema definition for the threshold breach of a budget.
+type BudgetEvent struct {
+	ent.Schema
+}
+
+func (BudgetEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time(),
+	}
+}
+
+func (BudgetEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Alert each threshold once per period.
+		index.Fields("budget_id", "period_start", "threshold_type", "threshold_percent").
+			Unique(),
+	}
+}
+
+func (BudgetEvent) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("project_id").
+			Comment("ID of the project to belong.").
+			NotEmpty().
+			Immutable(),
+		object.IDField("environment_id").
+			Comment("ID of the environment which the budget watches.").
+			Optional().
+			Immutable(),
+		object.IDField("budget_id").
+			Comment("ID of the budget to belong.").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			Comment("Name of the budget.").
+			NotEmpty().
+			Immutable(),
+		field.Time("period_start").
+			Comment("Start time of the period which the event occurs in.").
+			Immutable(),
+		field.Time("period_end").
+			Comment("End time of the period which the event occurs in.").
+			Immutable(),
+		field.String("threshold_type").
+			Comment("Type of the breached threshold, actual or forecast.").
+			NotEmpty().
+			Immutable(),
+		field.Float("threshold_percent").
+			Comment("Percentage of the breached threshold.").
+			Immutable(),
+		field.Float("amount").
+			Comment("Amount of the budget.").
+			Immutable(),
+		field.Float("cost").
+			Comment("Actual or forecast cost breaching the threshold.").
+			Immutable(),
+		field.String("message").
+			Comment("Message of the event.").
+			Optional().
+			Immutable(),
+	}
+}
+
+func (BudgetEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Budget 1-* BudgetEvents.
+		edge.From("budget", Budget.Type).
+			Ref("events").
+			Field("budget_id").
+			Comment("Budget to which the event belongs.").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(
+				entx.SkipInput()),
+	}
+}
+
+func (BudgetEvent) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.ByProject("project_id"),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
 	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
 	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
 
@@ -61,6 +62,23 @@ func (Catalog) Fields() []ent.Field {
 		field.String("filter_pattern").
 			Comment("Catalog regexp pattern to filter the repositories by names.").
 			Optional(),
+		field.Strings("repositories").
+			Comment("URLs of the repositories to sync besides the source, only for the generic Git catalog.").
+			Optional(),
+		field.Strings("directories").
+			Comment("Subdirectories of the source to sync as templates, " +
+				"or to discover the templates from in the monorepo mode, only for the generic Git catalog.").
+			Optional(),
+		field.Bool("monorepo").
+			Comment("Discover the templates from the directories of the source, " +
+				"which contain the Terraform files or the schema.yaml, only for the generic Git catalog.").
+			Optional(),
+		crypto.MapField[string, string]("credential").
+			Comment("Credential to access the repositories, keeps the previous one if not provided.").
+			Optional().
+			Sensitive().
+			Annotations(
+				entx.SkipClearingOptionalField()),
 	}
 }
 
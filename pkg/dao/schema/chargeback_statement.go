@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+// ChargebackStatement holds the schema definition for the immutable statement
+// of the costs per project and environment within a period.
+type ChargebackStatement struct {
+	ent.Schema
+}
+
+func (ChargebackStatement) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time().WithoutUpdateTime(),
+	}
+}
+
+func (ChargebackStatement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("start_time", "end_time"),
+	}
+}
+
+func (ChargebackStatement) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("Name of the statement, generated from the period.").
+			Immutable().
+			Annotations(
+				entx.SkipInput()).
+			StructTag(`json:"name,omitempty,cli-table-column"`),
+		field.Time("start_time").
+			Comment("Start time of the period.").
+			Immutable().
+			StructTag(`json:"startTime,omitempty,cli-table-column"`),
+		field.Time("end_time").
+			Comment("End time of the period.").
+			Immutable().
+			StructTag(`json:"endTime,omitempty,cli-table-column"`),
+		field.JSON("filters", types.CostFilters{}).
+			Comment("Filters to select the costs of the statement, empty means all costs.").
+			Optional().
+			Immutable(),
+		field.JSON("shared_options", &types.SharedCostOptions{}).
+			Comment("Options to distribute the shared, idle and management costs.").
+			Optional().
+			Immutable(),
+		field.Float("total_cost").
+			Comment("Total cost of the statement.").
+			Default(0).
+			Immutable().
+			Annotations(
+				entx.SkipInput()).
+			StructTag(`json:"totalCost,omitempty,cli-table-column"`),
+		field.JSON("items", types.ChargebackStatementItems{}).
+			Comment("Costs per project and environment.").
+			Optional().
+			Immutable().
+			Annotations(
+				entx.SkipInput()),
+	}
+}
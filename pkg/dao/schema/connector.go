@@ -87,6 +87,11 @@ func (Connector) Fields() []ent.Field {
 			Optional().
 			Annotations(
 				entx.SkipClearingOptionalField()),
+		field.JSON("fin_ops_billing_source", &types.FinOpsBillingSource{}).
+			Comment("Billing export source to import the cost of the cloud provider connector.").
+			Optional().
+			Annotations(
+				entx.SkipClearingOptionalField()),
 	}
 }
 
@@ -119,6 +124,11 @@ func (Connector) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 				entx.SkipIO()),
+		edge.To("cost_anomalies", CostAnomaly.Type).
+			Comment("CostAnomalies that detected from the namespaces of the connection.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
 	}
 }
 
@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// CostAnomaly holds the schema definition for the day
+// where the cost of a project or namespace deviates significantly from its baseline.
+type CostAnomaly struct {
+	ent.Schema
+}
+
+func (CostAnomaly) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time(),
+	}
+}
+
+func (CostAnomaly) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("fingerprint", "day").
+			Unique(),
+	}
+}
+
+func (CostAnomaly) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("scope").
+			Comment("Scope of the anomaly, project or namespace.").
+			NotEmpty().
+			Immutable().
+			StructTag(`json:"scope,omitempty,cli-table-column"`),
+		field.String("name").
+			Comment("Name of the project or namespace.").
+			NotEmpty().
+			Immutable().
+			StructTag(`json:"name,omitempty,cli-table-column"`),
+		object.IDField("connector_id").
+			Comment("ID of the connector to which the namespace belongs.").
+			Optional().
+			Immutable(),
+		field.String("fingerprint").
+			Comment("String generated from the scope, connector and name, used to identify the anomaly of a day.").
+			Immutable().
+			Annotations(
+				entx.SkipIO()),
+		field.Time("day").
+			Comment("Start time of the anomalous day.").
+			Immutable().
+			StructTag(`json:"day,omitempty,cli-table-column"`),
+		field.String("type").
+			Comment("Type of the anomaly, spike or drop.").
+			StructTag(`json:"type,omitempty,cli-table-column"`),
+		field.Float("cost").
+			Comment("Cost of the day."),
+		field.Float("baseline").
+			Comment("Average daily cost of the baseline days."),
+		field.Float("deviation_percent").
+			Comment("Deviation of the cost from the baseline in percentage."),
+		field.Float("score").
+			Comment("Deviation of the cost from the baseline in standard deviations."),
+	}
+}
+
+func (CostAnomaly) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Connector 1-* CostAnomalies.
+		edge.From("connector", Connector.Type).
+			Ref("cost_anomalies").
+			Field("connector_id").
+			Comment("Connector to which the namespace belongs.").
+			Unique().
+			Immutable().
+			Annotations(
+				entx.SkipIO()),
+	}
+}
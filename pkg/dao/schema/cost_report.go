@@ -44,7 +44,7 @@ func (CostReport) Fields() []ent.Field {
 			NotEmpty().
 			Immutable(),
 		field.String("name").
-			Comment("Resource name for current cost, could be __unmounted__.").
+			Comment("Resource name for current cost, could be __unmounted__ or the cloud resource ID.").
 			Immutable(),
 		field.String("fingerprint").
 			Comment("String generated from resource properties, used to identify this cost.").
@@ -195,7 +195,8 @@ func (CostReport) Hooks() []ent.Hook {
 			}
 
 			var err error
-			if types.IsIdleOrManagementCost(name) {
+			// The cloud resource cost only has the name to identify.
+			if types.IsIdleOrManagementCost(name) || nd == "" && ns == "" && pd == "" && cd == "" {
 				err = m.SetField("fingerprint", strs.Join("/", cn, name))
 			} else {
 				err = m.SetField("fingerprint", strs.Join("/", cn, nd, ns, pd, cd))
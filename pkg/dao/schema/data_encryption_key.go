@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+)
+
+// DataEncryptionKey holds the schema definition for the data encryption key(DEK),
+// which is wrapped by the key encryption key(KEK) before storing.
+type DataEncryptionKey struct {
+	ent.Schema
+}
+
+func (DataEncryptionKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time(),
+	}
+}
+
+func (DataEncryptionKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provider").
+			Comment("Provider of the key encryption key which wraps the key, i.e. local, file.").
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entx.SkipInput()),
+		field.Bytes("wrapped_key").
+			Comment("Wrapped key.").
+			NotEmpty().
+			Immutable().
+			Sensitive().
+			Annotations(
+				entx.SkipIO()),
+		field.Bool("active").
+			Comment("Indicate whether the key is used to encrypt the new data.").
+			Default(false).
+			Annotations(
+				entx.SkipInput()),
+		field.String("rotation_status").
+			Comment("Status of re-encrypting the existing data with the key, i.e. running, succeeded, failed.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+		field.Int("rotation_total").
+			Comment("Total count of the data to re-encrypt.").
+			Default(0).
+			Annotations(
+				entx.SkipInput()),
+		field.Int("rotation_processed").
+			Comment("Processed count of the data to re-encrypt.").
+			Default(0).
+			Annotations(
+				entx.SkipInput()),
+		field.Text("rotation_message").
+			Comment("Message of re-encrypting, i.e. the error message if failed.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+	}
+}
+
+func (DataEncryptionKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entx.SkipClearingOptionalField(),
+	}
+}
@@ -74,12 +74,36 @@ func (Environment) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.NoAction),
 				entx.SkipIO()),
+		// Environment 1-* ResourceStateVersions.
+		edge.To("resource_state_versions", ResourceStateVersion.Type).
+			Comment("ResourceStateVersions that belong to the environment.").
+			Annotations(
+				entsql.OnDelete(entsql.NoAction),
+				entx.SkipIO()),
 		// Environment 1-* ResourceComponents.
 		edge.To("resource_components", ResourceComponent.Type).
 			Comment("ResourceComponents that belong to the environment.").
 			Annotations(
 				entsql.OnDelete(entsql.NoAction),
 				entx.SkipIO()),
+		// Environment 1-* ResourceRunApprovalRules.
+		edge.To("resource_run_approval_rules", ResourceRunApprovalRule.Type).
+			Comment("ResourceRunApprovalRules that apply to the environment.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+		// Environment 1-* ResourceRunPolicies.
+		edge.To("resource_run_policies", ResourceRunPolicy.Type).
+			Comment("ResourceRunPolicies that attach to the environment.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+		// Environment 1-* Budgets.
+		edge.To("budgets", Budget.Type).
+			Comment("Budgets that watch the environment.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
 		// Environment 1-* Variables.
 		edge.To("variables", Variable.Type).
 			Comment("Variables that belong to the environment.").
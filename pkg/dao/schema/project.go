@@ -67,6 +67,42 @@ func (Project) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.NoAction),
 				entx.SkipIO()),
+		// Project 1-* ResourceStateVersions.
+		edge.To("resource_state_versions", ResourceStateVersion.Type).
+			Comment("ResourceStateVersions that belong to the project.").
+			Annotations(
+				entsql.OnDelete(entsql.NoAction),
+				entx.SkipIO()),
+		// Project 1-* ResourceRunApprovalRules.
+		edge.To("resource_run_approval_rules", ResourceRunApprovalRule.Type).
+			Comment("ResourceRunApprovalRules that belong to the project.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+		// Project 1-* ResourceRunPolicies.
+		edge.To("resource_run_policies", ResourceRunPolicy.Type).
+			Comment("ResourceRunPolicies that belong to the project.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+		// Project 1-* TemplateUpgradeCampaigns.
+		edge.To("template_upgrade_campaigns", TemplateUpgradeCampaign.Type).
+			Comment("TemplateUpgradeCampaigns that belong to the project.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+		// Project 1-* Webhooks.
+		edge.To("webhooks", Webhook.Type).
+			Comment("Webhooks that belong to the project.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+		// Project 1-* Budgets.
+		edge.To("budgets", Budget.Type).
+			Comment("Budgets that belong to the project.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
 		// Project 1-* Variables.
 		edge.To("variables", Variable.Type).
 			Comment("Variables that belong to the project.").
@@ -85,6 +85,11 @@ func (Resource) Fields() []ent.Field {
 			Comment("Endpoints of the resource.").
 			Optional().
 			StructTag(`json:"endpoints,omitempty,cli-table-column"`),
+		field.JSON("drift", &types.ResourceDrift{}).
+			Comment("Drift detection result of the resource.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
 	}
 }
 
@@ -166,6 +171,19 @@ func (Resource) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Cascade),
 				entx.SkipIO()),
+		// Resource 1-* ResourceStateVersions.
+		edge.To("state_versions", ResourceStateVersion.Type).
+			Comment("State versions of the resource.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+		// Resource 1-1 ResourceStateLock.
+		edge.To("state_lock", ResourceStateLock.Type).
+			Comment("Lock of the resource state.").
+			Unique().
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 
@@ -111,6 +112,21 @@ func (ResourceRun) Fields() []ent.Field {
 		field.JSON("component_change_summary", types.ResourceComponentChangeSummary{}).
 			Comment("Change summary of the resource.").
 			Optional(),
+		field.JSON("cost_estimate", &types.ResourceRunCostEstimate{}).
+			Comment("Estimated monthly cost of the planned changes of the resource.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+		field.JSON("policy_results", types.ResourceRunPolicyResults{}).
+			Comment("Evaluation results of the policies against the plan of the run.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+		field.JSON("approval", &types.ResourceRunApproval{}).
+			Comment("Approval requirements and records of the run.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
 	}
 }
 
@@ -142,6 +158,13 @@ func (ResourceRun) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Immutable(),
+		// ResourceRun 1-* ResourceStateVersions.
+		edge.To("state_versions", ResourceStateVersion.Type).
+			Comment("State versions written by the run.").
+			Annotations(
+				// Keep the state versions after the run is deleted.
+				entsql.OnDelete(entsql.SetNull),
+				entx.SkipIO()),
 	}
 }
 
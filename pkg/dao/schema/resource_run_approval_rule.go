@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+type ResourceRunApprovalRule struct {
+	ent.Schema
+}
+
+func (ResourceRunApprovalRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Metadata().WithoutAnnotations(),
+	}
+}
+
+func (ResourceRunApprovalRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "environment_id", "name").
+			Unique().
+			Annotations(
+				entsql.IndexWhere("project_id IS NOT NULL AND environment_id IS NOT NULL")),
+		index.Fields("project_id", "name").
+			Unique().
+			Annotations(
+				entsql.IndexWhere("project_id IS NOT NULL AND environment_id IS NULL")),
+		index.Fields("name").
+			Unique().
+			Annotations(
+				entsql.IndexWhere("project_id IS NULL AND environment_id IS NULL")),
+	}
+}
+
+func (ResourceRunApprovalRule) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("project_id").
+			Comment("ID of the project to belong, empty means for all projects.").
+			Immutable().
+			Optional(),
+		object.IDField("environment_id").
+			Comment("ID of the environment to which the rule applies, empty means for all environments.").
+			Immutable().
+			Optional(),
+		field.String("environment_type").
+			Comment("Type of the environments to which the rule applies, empty means for all types.").
+			Optional(),
+		field.Strings("run_types").
+			Comment("Types of the runs which require approvals."),
+		field.Int("approvals").
+			Comment("Number of the approvals required.").
+			Positive().
+			Default(1).
+			StructTag(`json:"approvals,omitempty,cli-table-column"`),
+		field.Strings("roles").
+			Comment("Roles which the approvers must hold one of, empty means any subject.").
+			Optional(),
+	}
+}
+
+func (ResourceRunApprovalRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Project 1-* ResourceRunApprovalRules.
+		edge.From("project", Project.Type).
+			Ref("resource_run_approval_rules").
+			Field("project_id").
+			Comment("Project to which the rule belongs.").
+			Unique().
+			Immutable().
+			Annotations(
+				entx.ValidateContext(intercept.WithProjectInterceptor)),
+		// Environment 1-* ResourceRunApprovalRules.
+		edge.From("environment", Environment.Type).
+			Ref("resource_run_approval_rules").
+			Field("environment_id").
+			Comment("Environment to which the rule applies.").
+			Unique().
+			Immutable(),
+	}
+}
+
+func (ResourceRunApprovalRule) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.ByProjectOptional("project_id"),
+	}
+}
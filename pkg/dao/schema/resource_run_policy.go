@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"errors"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+type ResourceRunPolicy struct {
+	ent.Schema
+}
+
+func (ResourceRunPolicy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Metadata().WithoutAnnotations(),
+	}
+}
+
+func (ResourceRunPolicy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "environment_id", "name").
+			Unique().
+			Annotations(
+				entsql.IndexWhere("environment_id IS NOT NULL")),
+		index.Fields("project_id", "name").
+			Unique().
+			Annotations(
+				entsql.IndexWhere("environment_id IS NULL")),
+	}
+}
+
+func (ResourceRunPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("project_id").
+			Comment("ID of the project to belong.").
+			NotEmpty().
+			Immutable(),
+		object.IDField("environment_id").
+			Comment("ID of the environment to which the policy attaches, empty means for the whole project.").
+			Optional().
+			Immutable(),
+		field.String("enforcement").
+			Comment("Enforcement of the policy, deny blocks the run from applying, warn annotates the run only.").
+			Default(types.PolicyEnforcementDeny).
+			Validate(func(s string) error {
+				if !types.IsPolicyEnforcement(s) {
+					return errors.New("invalid enforcement: unknown")
+				}
+
+				return nil
+			}).
+			StructTag(`json:"enforcement,omitempty,cli-table-column"`),
+		field.Text("expression").
+			Comment("Expression of the policy, evaluates the plan of the run, " +
+				"returns true or a non-empty list of violation messages if violated.").
+			NotEmpty(),
+		field.String("message").
+			Comment("Message to show if the policy is violated.").
+			Optional(),
+		field.Bool("disabled").
+			Comment("Indicate whether the policy is disabled.").
+			Default(false),
+	}
+}
+
+func (ResourceRunPolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Project 1-* ResourceRunPolicies.
+		edge.From("project", Project.Type).
+			Ref("resource_run_policies").
+			Field("project_id").
+			Comment("Project to which the policy belongs.").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(
+				entx.ValidateContext(intercept.WithProjectInterceptor)),
+		// Environment 1-* ResourceRunPolicies.
+		edge.From("environment", Environment.Type).
+			Ref("resource_run_policies").
+			Field("environment_id").
+			Comment("Environment to which the policy attaches.").
+			Unique().
+			Immutable(),
+	}
+}
+
+func (ResourceRunPolicy) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.ByProject("project_id"),
+	}
+}
@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+type ResourceStateLock struct {
+	ent.Schema
+}
+
+func (ResourceStateLock) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time().WithoutUpdateTime(),
+	}
+}
+
+func (ResourceStateLock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id").
+			Unique(),
+	}
+}
+
+func (ResourceStateLock) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("resource_id").
+			Comment("ID of the resource to which the locked state belongs.").
+			NotEmpty().
+			Immutable(),
+		object.IDField("run_id").
+			Comment("ID of the run which holds the lock, empty if the lock is not requested by the run's deployment.").
+			Optional().
+			Immutable(),
+		field.String("lock_id").
+			Comment("ID of the lock generated by the client.").
+			NotEmpty().
+			Immutable(),
+		field.String("holder").
+			Comment("Holder of the lock.").
+			Immutable(),
+		field.JSON("info", types.ResourceStateLockInfo{}).
+			Comment("Original lock information submitted by the client.").
+			Immutable(),
+	}
+}
+
+func (ResourceStateLock) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Resource 1-1 ResourceStateLock.
+		edge.From("resource", Resource.Type).
+			Ref("state_lock").
+			Field("resource_id").
+			Comment("Resource to which the locked state belongs.").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(
+				entx.SkipIO()),
+	}
+}
@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+type ResourceStateVersion struct {
+	ent.Schema
+}
+
+func (ResourceStateVersion) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time().WithoutUpdateTime(),
+	}
+}
+
+func (ResourceStateVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("resource_id", "serial"),
+	}
+}
+
+func (ResourceStateVersion) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("project_id").
+			Comment("ID of the project to belong.").
+			NotEmpty().
+			Immutable(),
+		object.IDField("environment_id").
+			Comment("ID of the environment to which the resource deploys.").
+			NotEmpty().
+			Immutable(),
+		object.IDField("resource_id").
+			Comment("ID of the resource to which the state version belongs.").
+			NotEmpty().
+			Immutable(),
+		object.IDField("run_id").
+			Comment("ID of the run which writes the state version.").
+			Optional().
+			Immutable(),
+		field.Int64("serial").
+			Comment("Serial of the state, increased by the client on every change.").
+			Immutable(),
+		field.String("lineage").
+			Comment("Lineage of the state, generated by the client when the state is initialized.").
+			Immutable(),
+		field.Text("data").
+			Comment("State data of the version.").
+			Immutable().
+			Annotations(
+				entx.SkipIO()),
+	}
+}
+
+func (ResourceStateVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Project 1-* ResourceStateVersions.
+		edge.From("project", Project.Type).
+			Ref("resource_state_versions").
+			Field("project_id").
+			Comment("Project to which the state version belongs.").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(
+				entx.ValidateContext(intercept.WithProjectInterceptor)),
+		// Environment 1-* ResourceStateVersions.
+		edge.From("environment", Environment.Type).
+			Ref("resource_state_versions").
+			Field("environment_id").
+			Comment("Environment to which the state version belongs.").
+			Unique().
+			Required().
+			Immutable(),
+		// Resource 1-* ResourceStateVersions.
+		edge.From("resource", Resource.Type).
+			Ref("state_versions").
+			Field("resource_id").
+			Comment("Resource to which the state version belongs.").
+			Unique().
+			Required().
+			Immutable(),
+		// ResourceRun 1-* ResourceStateVersions.
+		edge.From("run", ResourceRun.Type).
+			Ref("state_versions").
+			Field("run_id").
+			Comment("Run which writes the state version.").
+			Unique().
+			Immutable().
+			Annotations(
+				entx.SkipInput()),
+	}
+}
+
+func (ResourceStateVersion) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.ByProject("project_id"),
+	}
+}
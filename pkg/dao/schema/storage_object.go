@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+)
+
+// StorageObject holds the schema definition for the object stored by the database storage driver,
+// which keeps the artifacts of the resource runs without an external object storage.
+type StorageObject struct {
+	ent.Schema
+}
+
+func (StorageObject) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time(),
+	}
+}
+
+func (StorageObject) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key").
+			Unique(),
+	}
+}
+
+func (StorageObject) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			Comment("Key of the object, i.e. walrus/project/xxx/environment/xxx/resource/xxx.zip.").
+			NotEmpty().
+			Immutable().
+			Annotations(
+				entx.SkipInput()),
+		field.Bytes("data").
+			Comment("Data of the object.").
+			Sensitive().
+			Annotations(
+				entx.SkipIO()),
+		field.Int64("size").
+			Comment("Size of the data in bytes.").
+			Default(0).
+			Annotations(
+				entx.SkipInput()),
+		field.String("checksum").
+			Comment("Checksum of the data, i.e. sha256:xxx.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+	}
+}
+
+func (StorageObject) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entx.SkipClearingOptionalField(),
+	}
+}
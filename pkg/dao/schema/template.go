@@ -10,6 +10,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/entx"
 	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
 	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
 
@@ -58,6 +59,12 @@ func (Template) Fields() []ent.Field {
 			Comment("ID of the project to belong, empty means for all projects.").
 			Immutable().
 			Optional(),
+		crypto.MapField[string, string]("credential").
+			Comment("Credential to access the private registry of the source, keeps the previous one if not provided.").
+			Optional().
+			Sensitive().
+			Annotations(
+				entx.SkipClearingOptionalField()),
 	}
 }
 
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// TemplateUpgradeCampaign holds the schema definition for the campaign,
+// which upgrades the resources using a template version to another version in batches.
+type TemplateUpgradeCampaign struct {
+	ent.Schema
+}
+
+func (TemplateUpgradeCampaign) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time(),
+	}
+}
+
+func (TemplateUpgradeCampaign) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("project_id").
+			Comment("ID of the project to belong, empty means upgrading the resources of all projects.").
+			Immutable().
+			Optional(),
+		object.IDField("template_version_id").
+			Comment("ID of the template version to upgrade from.").
+			NotEmpty().
+			Immutable(),
+		object.IDField("target_version_id").
+			Comment("ID of the template version to upgrade to.").
+			NotEmpty().
+			Immutable(),
+		field.Bool("preview").
+			Comment("Preview the upgrade without applying, the planned runs can be applied later.").
+			Default(false).
+			Immutable(),
+		field.Int("batch_size").
+			Comment("Count of the resources to upgrade in each batch.").
+			Positive().
+			Default(10).
+			Immutable(),
+		field.String("change_comment").
+			Comment("Change comment of the upgrade runs.").
+			Optional().
+			Immutable(),
+		object.IDField("subject_id").
+			Comment("ID of the subject who creates the campaign, the upgrade runs are acted as the subject.").
+			Optional().
+			Immutable().
+			Annotations(
+				entx.SkipInput()),
+		field.String("status").
+			Comment("Status of the campaign, pending, running, succeeded, failed or canceled.").
+			Default(types.TemplateUpgradeCampaignStatusPending).
+			StructTag(`json:"status,omitempty,cli-table-column"`).
+			Annotations(
+				entx.SkipInput()),
+		field.String("message").
+			Comment("Message of the campaign status.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+		field.JSON("results", []types.TemplateUpgradeCampaignResult{}).
+			Comment("Upgrade results of the affected resources.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+	}
+}
+
+func (TemplateUpgradeCampaign) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Project 1-* TemplateUpgradeCampaigns.
+		edge.From("project", Project.Type).
+			Ref("template_upgrade_campaigns").
+			Field("project_id").
+			Comment("Project to which the campaign belongs.").
+			Unique().
+			Immutable().
+			Annotations(
+				entx.ValidateContext(intercept.WithProjectInterceptor)),
+		// TemplateVersion 1-* TemplateUpgradeCampaigns.
+		edge.From("template_version", TemplateVersion.Type).
+			Ref("upgrade_campaigns").
+			Field("template_version_id").
+			Comment("Template version to upgrade from.").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+func (TemplateUpgradeCampaign) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.ByProjectOptional("project_id"),
+	}
+}
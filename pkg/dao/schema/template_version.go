@@ -22,6 +22,7 @@ func (TemplateVersion) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.ID(),
 		mixin.Time(),
+		mixin.Status(),
 	}
 }
 
@@ -84,6 +85,22 @@ func (TemplateVersion) Fields() []ent.Field {
 			Comment("ID of the project to belong, empty means for all projects.").
 			Immutable().
 			Optional(),
+		field.String("lifecycle").
+			Comment("Lifecycle state of the template version, active, deprecated or blocked.").
+			Optional().
+			Default(types.TemplateVersionLifecycleActive).
+			Annotations(
+				entx.SkipInput()),
+		field.String("lifecycle_reason").
+			Comment("Reason of the lifecycle state.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
+		field.JSON("test_results", []types.TemplateVersionTestResult{}).
+			Comment("Results of testing the template version with the fixtures.").
+			Optional().
+			Annotations(
+				entx.SkipInput()),
 	}
 }
 
@@ -105,6 +122,12 @@ func (TemplateVersion) Edges() []ent.Edge {
 			Annotations(
 				entsql.OnDelete(entsql.Restrict),
 				entx.SkipIO()),
+		// TemplateVersion 1-* TemplateUpgradeCampaigns.
+		edge.To("upgrade_campaigns", TemplateUpgradeCampaign.Type).
+			Comment("Upgrade campaigns of the resources that use the template version.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
 		// TemplateVersion *-* ResourceDefinitions.
 		edge.From("resource_definitions", ResourceDefinition.Type).
 			Ref("matching_rules").
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/intercept"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Metadata().WithoutAnnotations(),
+	}
+}
+
+func (Webhook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("project_id", "name").
+			Unique(),
+	}
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("project_id").
+			Comment("ID of the project to belong.").
+			NotEmpty().
+			Immutable(),
+		field.String("url").
+			Comment("URL to deliver the events.").
+			NotEmpty().
+			StructTag(`json:"url,omitempty,cli-table-column"`),
+		crypto.StringField("secret").
+			Comment("Secret to sign the payloads with HMAC-SHA256, keeps the previous one if not provided.").
+			Optional().
+			Sensitive(),
+		field.Strings("topics").
+			Comment("Topics of the model changes to subscribe, i.e. resources, resource_runs."),
+		field.Strings("event_types").
+			Comment("Types of the events to subscribe, empty means all of create, update and delete.").
+			Optional(),
+		field.Bool("disabled").
+			Comment("Indicate whether the webhook is disabled.").
+			Default(false),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Project 1-* Webhooks.
+		edge.From("project", Project.Type).
+			Ref("webhooks").
+			Field("project_id").
+			Comment("Project to which the webhook belongs.").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(
+				entx.ValidateContext(intercept.WithProjectInterceptor)),
+		// Webhook 1-* WebhookDeliveries.
+		edge.To("deliveries", WebhookDelivery.Type).
+			Comment("Deliveries of the webhook.").
+			Annotations(
+				entsql.OnDelete(entsql.Cascade),
+				entx.SkipIO()),
+	}
+}
+
+func (Webhook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entx.SkipClearingOptionalField(),
+	}
+}
+
+func (Webhook) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.ByProject("project_id"),
+	}
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/seal-io/walrus/pkg/dao/entx"
+	"github.com/seal-io/walrus/pkg/dao/schema/mixin"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// WebhookDelivery holds the schema definition for the delivery log of a webhook.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.ID(),
+		mixin.Time(),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// Deduplicate the same event received by multiple replicas.
+		index.Fields("webhook_id", "event_id").
+			Unique(),
+	}
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		object.IDField("webhook_id").
+			Comment("ID of the webhook to belong.").
+			NotEmpty().
+			Immutable(),
+		field.String("event_id").
+			Comment("ID of the event to deliver.").
+			NotEmpty().
+			Immutable(),
+		field.String("topic").
+			Comment("Topic of the event.").
+			NotEmpty().
+			Immutable(),
+		field.String("event_type").
+			Comment("Type of the event.").
+			NotEmpty().
+			Immutable(),
+		field.Text("payload").
+			Comment("Payload of the event to deliver.").
+			Immutable(),
+		field.String("status").
+			Comment("Status of the delivery, pending, succeeded or failed.").
+			Default(types.WebhookDeliveryStatusPending),
+		field.Int("attempts").
+			Comment("Count of the delivering attempts.").
+			Default(0),
+		field.Int("response_status").
+			Comment("Status code of the last response.").
+			Optional(),
+		field.Text("response_body").
+			Comment("Body of the last response, truncated if too large.").
+			Optional(),
+		field.Text("message").
+			Comment("Error message of the last attempt.").
+			Optional(),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Webhook 1-* WebhookDeliveries.
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Field("webhook_id").
+			Comment("Webhook to which the delivery belongs.").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(
+				entx.SkipInput()),
+	}
+}
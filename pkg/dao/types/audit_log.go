@@ -0,0 +1,8 @@
+package types
+
+const (
+	// AuditLogResultSucceeded indicates the audited call is succeeded.
+	AuditLogResultSucceeded = "succeeded"
+	// AuditLogResultFailed indicates the audited call is failed.
+	AuditLogResultFailed = "failed"
+)
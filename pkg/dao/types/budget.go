@@ -0,0 +1,83 @@
+package types
+
+import (
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// BudgetPeriodMonthly indicates the budget resets at the beginning of every calendar month.
+	BudgetPeriodMonthly = "monthly"
+	// BudgetPeriodCustom indicates the budget covers the specified time range.
+	BudgetPeriodCustom = "custom"
+)
+
+var budgetPeriods = []string{
+	BudgetPeriodMonthly,
+	BudgetPeriodCustom,
+}
+
+func BudgetPeriods() []string {
+	return slices.Clone(budgetPeriods)
+}
+
+func IsBudgetPeriod(s string) bool {
+	return slices.Contains(budgetPeriods, s)
+}
+
+const (
+	// BudgetThresholdTypeActual indicates the threshold compares with the actual cost of the period.
+	BudgetThresholdTypeActual = "actual"
+	// BudgetThresholdTypeForecast indicates the threshold compares with the forecast cost of the period.
+	BudgetThresholdTypeForecast = "forecast"
+)
+
+var budgetThresholdTypes = []string{
+	BudgetThresholdTypeActual,
+	BudgetThresholdTypeForecast,
+}
+
+func BudgetThresholdTypes() []string {
+	return slices.Clone(budgetThresholdTypes)
+}
+
+func IsBudgetThresholdType(s string) bool {
+	return slices.Contains(budgetThresholdTypes, s)
+}
+
+// BudgetThreshold is the percentage of the budget amount to alert.
+type BudgetThreshold struct {
+	// Type is the type of the cost to compare, actual or forecast.
+	Type string `json:"type"`
+	// Percent is the percentage of the budget amount, i.e. 80 means 80%.
+	Percent float64 `json:"percent"`
+}
+
+// BudgetThresholds holds the thresholds of a budget.
+type BudgetThresholds []BudgetThreshold
+
+// DefaultBudgetThresholds returns the thresholds alerting at 50%, 80% and 100% of the actual cost,
+// and 100% of the forecast cost.
+func DefaultBudgetThresholds() BudgetThresholds {
+	return BudgetThresholds{
+		{Type: BudgetThresholdTypeActual, Percent: 50},
+		{Type: BudgetThresholdTypeActual, Percent: 80},
+		{Type: BudgetThresholdTypeActual, Percent: 100},
+		{Type: BudgetThresholdTypeForecast, Percent: 100},
+	}
+}
+
+// BudgetSpend is the spend of a budget at the last evaluation.
+type BudgetSpend struct {
+	// PeriodStart is the start time of the evaluated period.
+	PeriodStart time.Time `json:"periodStart"`
+	// PeriodEnd is the end time of the evaluated period.
+	PeriodEnd time.Time `json:"periodEnd"`
+	// ActualCost is the cost spent within the period.
+	ActualCost float64 `json:"actualCost"`
+	// ForecastCost is the projected cost at the end of the period.
+	ForecastCost float64 `json:"forecastCost"`
+	// EvaluateTime is the time of the evaluation.
+	EvaluateTime time.Time `json:"evaluateTime"`
+}
@@ -9,4 +9,19 @@ const (
 
 	// AnnotationSubjectID specify the subject ID of the system resource.
 	AnnotationSubjectID = "walrus.seal.io/subject-id"
+
+	// AnnotationDeployerType specify the deployer type of the runs,
+	// works on environment annotations and template labels, the environment one takes precedence.
+	AnnotationDeployerType = "walrus.seal.io/deployer-type"
+	// AnnotationDeployerImage specify the image of the deployer job,
+	// works on environment annotations and template labels, the environment one takes precedence.
+	AnnotationDeployerImage = "walrus.seal.io/deployer-image"
+	// AnnotationDeployerBinary specify the binary executed by the deployer job,
+	// works on environment annotations and template labels, the environment one takes precedence.
+	AnnotationDeployerBinary = "walrus.seal.io/deployer-binary"
+
+	// AnnotationRunConcurrency specify the maximum number of the resource runs executing at the same time,
+	// works on environment and connector annotations, non-positive value means unlimited,
+	// overrides the ResourceRunEnvironmentConcurrency or ResourceRunConnectorConcurrency setting.
+	AnnotationRunConcurrency = "walrus.seal.io/run-concurrency"
 )
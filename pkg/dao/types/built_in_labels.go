@@ -31,4 +31,8 @@ const (
 	LabelWalrusResourceRunID = "walrus.seal.io/resource-run-id"
 	// LabelWalrusResourceRunTaskType is the label for resource run task type of the run job.
 	LabelWalrusResourceRunTaskType = "walrus.seal.io/resource-run-task-type"
+	// LabelWalrusResourceRunDriftDetection indicates the resource run is created by drift detection.
+	LabelWalrusResourceRunDriftDetection = "walrus.seal.io/drift-detection"
+	// LabelWalrusTemplateUpgradeCampaign indicates the resource run is created by the template upgrade campaign.
+	LabelWalrusTemplateUpgradeCampaign = "walrus.seal.io/template-upgrade-campaign"
 )
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// ChargebackFormatCSV indicates the statement is exported as CSV.
+	ChargebackFormatCSV = "csv"
+	// ChargebackFormatJSON indicates the statement is exported as JSON.
+	ChargebackFormatJSON = "json"
+	// ChargebackFormatFOCUS indicates the statement is exported as CSV
+	// in the columns of the FinOps Open Cost and Usage Specification.
+	ChargebackFormatFOCUS = "focus"
+)
+
+var chargebackFormats = []string{
+	ChargebackFormatCSV,
+	ChargebackFormatJSON,
+	ChargebackFormatFOCUS,
+}
+
+func ChargebackFormats() []string {
+	return slices.Clone(chargebackFormats)
+}
+
+func IsChargebackFormat(s string) bool {
+	return slices.Contains(chargebackFormats, s)
+}
+
+// ChargebackStatementItem is the cost of a project environment within the period of a statement.
+type ChargebackStatementItem struct {
+	// Project is the name of the project, UnallocatedItemName means the cost is not allocated to any project.
+	Project string `json:"project"`
+	// Environment is the name of the environment, blank means the cost is not allocated to any environment.
+	Environment string `json:"environment,omitempty"`
+	// TotalCost is the total cost including the shared cost.
+	TotalCost float64 `json:"totalCost"`
+	// SharedCost is the cost distributed from the shared, idle and management costs.
+	SharedCost       float64 `json:"sharedCost"`
+	CPUCost          float64 `json:"cpuCost"`
+	GPUCost          float64 `json:"gpuCost"`
+	RAMCost          float64 `json:"ramCost"`
+	PVCost           float64 `json:"pvCost"`
+	LoadBalancerCost float64 `json:"loadBalancerCost"`
+}
+
+// ChargebackStatementItems holds the items of a statement.
+type ChargebackStatementItems []ChargebackStatementItem
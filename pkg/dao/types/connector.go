@@ -59,3 +59,36 @@ func DefaultFinOpsCustomPricing() *FinOpsCustomPricing {
 		Storage: "0.0399999996",
 	}
 }
+
+const (
+	FinOpsBillingFormatAWSCUR      = "aws-cur"
+	FinOpsBillingFormatAzureCSV    = "azure-csv"
+	FinOpsBillingFormatGCPBigQuery = "gcp-bigquery"
+	FinOpsBillingFormatAlibabaCSV  = "alibaba-csv"
+)
+
+// FinOpsBillingFormats returns the supported formats of the billing export.
+func FinOpsBillingFormats() []string {
+	return []string{
+		FinOpsBillingFormatAWSCUR,
+		FinOpsBillingFormatAzureCSV,
+		FinOpsBillingFormatGCPBigQuery,
+		FinOpsBillingFormatAlibabaCSV,
+	}
+}
+
+// FinOpsBillingSource used to import the cost of cloud provider connector from the billing export.
+type FinOpsBillingSource struct {
+	// Format describes the format of the billing export,
+	// select from aws-cur, azure-csv, gcp-bigquery and alibaba-csv.
+	Format string `json:"format"`
+	// URL describes where to read the billing export files,
+	// in form of file:///path/to/dir or s3://endpoint/bucket[/prefix][?region=...&sslmode=disable],
+	// the s3 credential is read from the billing_access_key and billing_secret_key of the connector config data,
+	// or the access_key and secret_key if not specified.
+	URL string `json:"url"`
+}
+
+func (s *FinOpsBillingSource) IsZero() bool {
+	return s == nil || s.Format == "" && s.URL == ""
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// CostAnomalyScopeProject indicates the anomaly is detected from the daily cost of a project.
+	CostAnomalyScopeProject = "project"
+	// CostAnomalyScopeNamespace indicates the anomaly is detected from the daily cost of a namespace.
+	CostAnomalyScopeNamespace = "namespace"
+)
+
+const (
+	// CostAnomalyTypeSpike indicates the cost is significantly higher than the baseline.
+	CostAnomalyTypeSpike = "spike"
+	// CostAnomalyTypeDrop indicates the cost is significantly lower than the baseline.
+	CostAnomalyTypeDrop = "drop"
+)
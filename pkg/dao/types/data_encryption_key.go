@@ -0,0 +1,10 @@
+package types
+
+const (
+	// DataEncryptionKeyRotationStatusRunning indicates the existing data is re-encrypting with the key.
+	DataEncryptionKeyRotationStatusRunning = "running"
+	// DataEncryptionKeyRotationStatusSucceeded indicates all existing data is re-encrypted with the key.
+	DataEncryptionKeyRotationStatusSucceeded = "succeeded"
+	// DataEncryptionKeyRotationStatusFailed indicates the re-encrypting is interrupted by error.
+	DataEncryptionKeyRotationStatusFailed = "failed"
+)
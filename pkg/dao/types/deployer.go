@@ -1,3 +1,18 @@
 package types
 
-const DeployerTypeTF string = "Terraform"
+const (
+	DeployerTypeTF       string = "Terraform"
+	DeployerTypeOpenTofu string = "OpenTofu"
+	DeployerTypePulumi   string = "Pulumi"
+)
+
+// IsHCLDeployerType returns true if the given deployer type deploys HCL modules,
+// whose states and plans are compatible with the Terraform ones.
+func IsHCLDeployerType(deployerType string) bool {
+	switch deployerType {
+	case DeployerTypeTF, DeployerTypeOpenTofu:
+		return true
+	}
+
+	return false
+}
@@ -4,4 +4,18 @@ const (
 	GitDriverGithub = "Github"
 	GitDriverGitlab = "Gitlab"
 	GitDriverGitee  = "Gitee"
+	// GitDriverGit is the generic Git driver,
+	// which accesses any Git server without the vendor API.
+	GitDriverGit = "Git"
+)
+
+// Keys of the catalog credential.
+const (
+	// CatalogCredentialUsername is the username for HTTPS authentication,
+	// defaults to "oauth2" if the token is provided.
+	CatalogCredentialUsername = "username"
+	// CatalogCredentialToken is the token or password for HTTPS authentication.
+	CatalogCredentialToken = "token"
+	// CatalogCredentialSSHKey is the PEM encoded private key for SSH authentication.
+	CatalogCredentialSSHKey = "sshKey"
 )
@@ -2,6 +2,9 @@ package types
 
 import (
 	"sort"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/types/object"
 )
 
 const (
@@ -53,3 +56,29 @@ func (in ResourceEndpoints) Sort() ResourceEndpoints {
 	sort.Sort(in)
 	return in
 }
+
+const (
+	// ResourceDriftStatusInSync indicates the resource matches its deployed configuration.
+	ResourceDriftStatusInSync = "InSync"
+	// ResourceDriftStatusDrifted indicates the resource has been changed outside of Walrus.
+	ResourceDriftStatusDrifted = "Drifted"
+)
+
+// ResourceDrift holds the latest drift detection result of resource.
+type ResourceDrift struct {
+	// Status indicates the drift status of the resource.
+	Status string `json:"status,cli-table-column"`
+	// Summary indicates the change summary to reconcile the drift.
+	Summary ResourceComponentChangeSummary `json:"summary"`
+	// Changes indicates the addresses of the drifted components.
+	Changes []string `json:"changes,omitempty"`
+	// RunID indicates the ID of the run which detects the drift.
+	RunID object.ID `json:"runID,omitempty"`
+	// Time indicates the time of the detection.
+	Time time.Time `json:"time"`
+}
+
+// IsDrifted returns true if the resource has drifted.
+func (in *ResourceDrift) IsDrifted() bool {
+	return in != nil && in.Status == ResourceDriftStatusDrifted
+}
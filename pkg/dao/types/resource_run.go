@@ -35,6 +35,7 @@ const (
 	RunTypeStart    RunType = "start"
 	RunTypeStop     RunType = "stop"
 	RunTypeRollback RunType = "rollback"
+	RunTypeRestore  RunType = "restore"
 )
 
 type RunType string
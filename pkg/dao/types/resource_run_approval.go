@@ -0,0 +1,95 @@
+package types
+
+import (
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// ResourceRunApproval holds the approval requirements and the approval records of a run.
+type ResourceRunApproval struct {
+	// Requirements holds the requirements of the approval rules matched the run,
+	// the run is approved if all requirements are satisfied.
+	Requirements []ResourceRunApprovalRequirement `json:"requirements"`
+	// Records holds the records of the approvers.
+	Records []ResourceRunApprovalRecord `json:"records,omitempty"`
+}
+
+// ResourceRunApprovalRequirement is the snapshot of an approval rule matched the run.
+type ResourceRunApprovalRequirement struct {
+	// RuleID is the ID of the approval rule.
+	RuleID string `json:"ruleID"`
+	// RuleName is the name of the approval rule.
+	RuleName string `json:"ruleName"`
+	// Approvals is the number of the approvals required.
+	Approvals int `json:"approvals"`
+	// Roles is the roles which the approvers must hold one of,
+	// empty means any subject.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// ResourceRunApprovalRecord is the record of an approver.
+type ResourceRunApprovalRecord struct {
+	// SubjectID is the ID of the approver.
+	SubjectID string `json:"subjectID"`
+	// SubjectName is the name of the approver.
+	SubjectName string `json:"subjectName"`
+	// Roles is the roles held by the approver when approving.
+	Roles []string `json:"roles,omitempty"`
+	// Approved indicates the approver approves or rejects the run.
+	Approved bool `json:"approved"`
+	// Comment is the comment of the approver.
+	Comment string `json:"comment,omitempty"`
+	// Time is the time of approving.
+	Time time.Time `json:"time"`
+}
+
+// IsQualified returns true if the given roles qualify to approve the requirement.
+func (in ResourceRunApprovalRequirement) IsQualified(roles []string) bool {
+	if len(in.Roles) == 0 {
+		return true
+	}
+
+	for i := range roles {
+		if slices.Contains(in.Roles, roles[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Count returns the number of the approvals satisfying the given requirement.
+func (in *ResourceRunApproval) Count(req ResourceRunApprovalRequirement) int {
+	var c int
+
+	for i := range in.Records {
+		if in.Records[i].Approved && req.IsQualified(in.Records[i].Roles) {
+			c++
+		}
+	}
+
+	return c
+}
+
+// IsSatisfied returns true if all requirements are satisfied.
+func (in *ResourceRunApproval) IsSatisfied() bool {
+	for i := range in.Requirements {
+		if in.Count(in.Requirements[i]) < in.Requirements[i].Approvals {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasRecord returns true if the given subject has recorded.
+func (in *ResourceRunApproval) HasRecord(subjectID string) bool {
+	for i := range in.Records {
+		if in.Records[i].SubjectID == subjectID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ResourceRunCostEstimate is the estimated monthly cost of the planned resource components.
+type ResourceRunCostEstimate struct {
+	// Currency is the currency of the costs, i.e. USD.
+	Currency string `json:"currency"`
+	// PriorMonthlyCost is the monthly cost of the priced components before applying.
+	PriorMonthlyCost float64 `json:"priorMonthlyCost"`
+	// MonthlyCost is the monthly cost of the priced components after applying.
+	MonthlyCost float64 `json:"monthlyCost"`
+	// MonthlyDelta is the difference between MonthlyCost and PriorMonthlyCost.
+	MonthlyDelta float64 `json:"monthlyDelta"`
+	// Components holds the estimates of the changed components.
+	Components []ResourceRunComponentCostEstimate `json:"components,omitempty"`
+}
+
+// ResourceRunComponentCostEstimate is the estimated monthly cost of a planned resource component.
+type ResourceRunComponentCostEstimate struct {
+	// Address is the address of the component in the plan.
+	Address string `json:"address"`
+	// Type is the type of the component, i.e. aws_instance.
+	Type string `json:"type"`
+	// ChangeType is the type of the change, create, update or delete.
+	ChangeType string `json:"changeType"`
+	// PriorMonthlyCost is the monthly cost before applying.
+	PriorMonthlyCost float64 `json:"priorMonthlyCost"`
+	// MonthlyCost is the monthly cost after applying.
+	MonthlyCost float64 `json:"monthlyCost"`
+	// Unpriced indicates the component cannot be priced from the pricing catalog,
+	// the unpriced components are not counted in the total.
+	Unpriced bool `json:"unpriced,omitempty"`
+	// Message is the reason of the unpriced component.
+	Message string `json:"message,omitempty"`
+}
+
+// DeltaString returns the monthly delta in form of "+$240/month".
+func (in *ResourceRunCostEstimate) DeltaString() string {
+	if in == nil {
+		return ""
+	}
+
+	sign := "+"
+	if in.MonthlyDelta < 0 {
+		sign = "-"
+	}
+
+	amount := fmt.Sprintf("%.2f", math.Abs(in.MonthlyDelta))
+	amount = strings.TrimSuffix(amount, ".00")
+
+	if amount == "0" {
+		sign = ""
+	}
+
+	symbol := "$"
+	if in.Currency != "" && in.Currency != "USD" {
+		symbol = in.Currency + " "
+	}
+
+	return sign + symbol + amount + "/month"
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceRunCostEstimate_DeltaString(t *testing.T) {
+	testCases := []struct {
+		given    *ResourceRunCostEstimate
+		expected string
+	}{
+		{
+			given:    &ResourceRunCostEstimate{Currency: "USD", MonthlyDelta: 240},
+			expected: "+$240/month",
+		},
+		{
+			given:    &ResourceRunCostEstimate{Currency: "USD", MonthlyDelta: -12.5},
+			expected: "-$12.50/month",
+		},
+		{
+			given:    &ResourceRunCostEstimate{Currency: "USD", MonthlyDelta: 0.001},
+			expected: "$0/month",
+		},
+		{
+			given:    nil,
+			expected: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, tc.given.DeltaString())
+	}
+}
@@ -0,0 +1,69 @@
+package types
+
+import "golang.org/x/exp/slices"
+
+const (
+	// PolicyEnforcementDeny blocks the run from applying if the policy is violated.
+	PolicyEnforcementDeny = "deny"
+	// PolicyEnforcementWarn annotates the run if the policy is violated.
+	PolicyEnforcementWarn = "warn"
+)
+
+var policyEnforcements = []string{
+	PolicyEnforcementDeny,
+	PolicyEnforcementWarn,
+}
+
+func PolicyEnforcements() []string {
+	return slices.Clone(policyEnforcements)
+}
+
+func IsPolicyEnforcement(s string) bool {
+	return slices.Contains(policyEnforcements, s)
+}
+
+// ResourceRunPolicyResult is the evaluation result of a policy against the plan of a run.
+type ResourceRunPolicyResult struct {
+	// PolicyID is the ID of the evaluated policy.
+	PolicyID string `json:"policyID"`
+	// PolicyName is the name of the evaluated policy.
+	PolicyName string `json:"policyName"`
+	// Enforcement is the enforcement of the evaluated policy.
+	Enforcement string `json:"enforcement"`
+	// Violated indicates the plan violates the policy.
+	Violated bool `json:"violated"`
+	// Message is the message of the policy if violated,
+	// or the error message if the policy failed to evaluate.
+	Message string `json:"message,omitempty"`
+	// Details holds the violation details returned by the policy.
+	Details []string `json:"details,omitempty"`
+}
+
+// ResourceRunPolicyResults is the list of ResourceRunPolicyResult.
+type ResourceRunPolicyResults []ResourceRunPolicyResult
+
+// Denied returns the violated results which enforcement is deny.
+func (in ResourceRunPolicyResults) Denied() ResourceRunPolicyResults {
+	var out ResourceRunPolicyResults
+
+	for i := range in {
+		if in[i].Violated && in[i].Enforcement == PolicyEnforcementDeny {
+			out = append(out, in[i])
+		}
+	}
+
+	return out
+}
+
+// Warned returns the violated results which enforcement is warn.
+func (in ResourceRunPolicyResults) Warned() ResourceRunPolicyResults {
+	var out ResourceRunPolicyResults
+
+	for i := range in {
+		if in[i].Violated && in[i].Enforcement == PolicyEnforcementWarn {
+			out = append(out, in[i])
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"time"
+)
+
+// ResourceStateLockInfo holds the lock information exchanged with the Terraform HTTP backend,
+// it keeps the same structure as the Terraform's statemgr.LockInfo.
+type ResourceStateLockInfo struct {
+	// ID indicates the unique ID of the lock, generated by the client.
+	ID string `json:"ID"`
+	// Operation indicates the operation which requests the lock, e.g. OperationTypeApply.
+	Operation string `json:"Operation"`
+	// Info indicates the extra information to store with the lock.
+	Info string `json:"Info"`
+	// Who indicates the user who requests the lock.
+	Who string `json:"Who"`
+	// Version indicates the version of the client.
+	Version string `json:"Version"`
+	// Created indicates the time when the lock was requested.
+	Created time.Time `json:"Created"`
+	// Path indicates the path of the state.
+	Path string `json:"Path"`
+}
@@ -1,29 +1,38 @@
 package status
 
 const (
+	ResourceRunStatusQueued   ConditionType = "Queued"
 	ResourceRunStatusPending  ConditionType = "Pending"
 	ResourceRunStatusPlanned  ConditionType = "Planned"
+	ResourceRunStatusApproved ConditionType = "Approved"
 	ResourceRunStatusApplied  ConditionType = "Applied"
 	ResourceRunStatusCanceled ConditionType = "Canceled"
 
-	ResourceRunSummaryStatusPlanning string = "Planning"
-	ResourceRunSummaryStatusPlanned  string = "Planned"
-	ResourceRunSummaryStatusPending  string = "Pending"
-	ResourceRunSummaryStatusCanceled string = "Canceled"
-	ResourceRunSummaryStatusRunning  string = "Running"
-	ResourceRunSummaryStatusFailed   string = "Failed"
-	ResourceRunSummaryStatusSucceed  string = "Succeeded"
+	ResourceRunSummaryStatusQueued          string = "Queued"
+	ResourceRunSummaryStatusPlanning        string = "Planning"
+	ResourceRunSummaryStatusPlanned         string = "Planned"
+	ResourceRunSummaryStatusPendingApproval string = "PendingApproval"
+	ResourceRunSummaryStatusRejected        string = "Rejected"
+	ResourceRunSummaryStatusPending         string = "Pending"
+	ResourceRunSummaryStatusCanceled        string = "Canceled"
+	ResourceRunSummaryStatusRunning         string = "Running"
+	ResourceRunSummaryStatusFailed          string = "Failed"
+	ResourceRunSummaryStatusSucceed         string = "Succeeded"
 )
 
 // resourceRunStatusPaths makes the following decision.
 //
 //	|  Condition Type  |     Condition Status    | Human Readable Status | Human Sensible Status |
 //	| ---------------- | ----------------------- | --------------------- | --------------------- |
+//	| Queued           | Unknown                 | Queued                | Transitioning         |
 //	| Pending          | Unknown                 | Pending               | Transitioning         |
 //	| Pending          | False                   | Failed                | Error                 |
 //	| Plan             | Unknown                 | Planning              | Transitioning         |
 //	| Plan             | False                   | Failed                | Error                 |
 //	| Plan             | True                    | Planned               |                       |
+//	| Approved         | Unknown                 | PendingApproval       | Transitioning         |
+//	| Approved         | False                   | Rejected              | Error                 |
+//	| Approved         | True                    | Planned               |                       |
 //	| Applied          | Unknown                 | Running               | Transitioning         |
 //	| Applied          | False                   | Failed                | Error                 |
 //	| Applied          | True                    | Succeeded             |                       |
@@ -31,12 +40,24 @@ const (
 var resourceRunStatusPaths = NewWalker(
 	[][]ConditionType{
 		{
+			ResourceRunStatusQueued,
 			ResourceRunStatusPending,
 			ResourceRunStatusPlanned,
+			ResourceRunStatusApproved,
 			ResourceRunStatusApplied,
 			ResourceRunStatusCanceled,
 		},
 	},
+	func(d Decision[ConditionType]) {
+		d.Make(ResourceRunStatusQueued,
+			func(st ConditionStatus, reason string) *Summary {
+				// The queued condition only exists while waiting in the queue.
+				return &Summary{
+					SummaryStatus: "Queued",
+					Transitioning: st == ConditionStatusUnknown,
+				}
+			})
+	},
 	func(d Decision[ConditionType]) {
 		d.Make(ResourceRunStatusPlanned,
 			func(st ConditionStatus, reason string) *Summary {
@@ -59,6 +80,28 @@ var resourceRunStatusPaths = NewWalker(
 				}
 			})
 	},
+	func(d Decision[ConditionType]) {
+		d.Make(ResourceRunStatusApproved,
+			func(st ConditionStatus, reason string) *Summary {
+				switch st {
+				case ConditionStatusUnknown:
+					return &Summary{
+						SummaryStatus: "PendingApproval",
+						Transitioning: true,
+					}
+				case ConditionStatusFalse:
+					return &Summary{
+						SummaryStatus: "Rejected",
+						Error:         true,
+					}
+				}
+				// The approved run waits for applying as same as the planned run.
+				return &Summary{
+					SummaryStatus: "Planned",
+					Warning:       true,
+				}
+			})
+	},
 	func(d Decision[ConditionType]) {
 		d.Make(ResourceRunStatusCanceled,
 			func(st ConditionStatus, reason string) *Summary {
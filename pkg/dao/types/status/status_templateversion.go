@@ -0,0 +1,26 @@
+package status
+
+const (
+	TemplateVersionStatusTested ConditionType = "Tested"
+
+	TemplateVersionSummaryStatusTestFailed string = "TestFailed"
+)
+
+// templateVersionStatusPaths makes the following decision.
+//
+//	|  Condition Type  |     Condition Status    | Human Readable Status | Human Sensible Status |
+//	| ---------------- | ----------------------- | --------------------- | --------------------- |
+//	| Tested           | Unknown                 | Testing               | Transitioning         |
+//	| Tested           | False                   | TestFailed            | Error                 |
+//	| Tested           | True                    | Tested                |                       |
+var templateVersionStatusPaths = NewWalker(
+	[][]ConditionType{
+		{
+			TemplateVersionStatusTested,
+		},
+	},
+)
+
+func WalkTemplateVersion(st *Status) *Summary {
+	return templateVersionStatusPaths.Walk(st)
+}
@@ -239,4 +239,5 @@ var replacements = map[string]struct {
 	"Canceled":    {"Canceling", "CancelFailed", "Canceled"},
 	"Planned":     {"Planning", "Failed", "Planned"},
 	"Applied":     {"Running", "Failed", "Succeeded"},
+	"Tested":      {"Testing", "TestFailed", "Tested"},
 }
@@ -0,0 +1,100 @@
+package types
+
+import "github.com/seal-io/walrus/pkg/dao/types/object"
+
+// Keys of the template credential.
+const (
+	// TemplateCredentialUsername is the username for the basic authentication of the OCI registry.
+	TemplateCredentialUsername = "username"
+	// TemplateCredentialToken is the password of the OCI registry or the API token of the module registry.
+	TemplateCredentialToken = "token"
+)
+
+// Lifecycle states of the template version.
+const (
+	// TemplateVersionLifecycleActive indicates the template version is available.
+	TemplateVersionLifecycleActive = "active"
+	// TemplateVersionLifecycleDeprecated indicates the template version is still available,
+	// but the resources are expected to upgrade to a newer version.
+	TemplateVersionLifecycleDeprecated = "deprecated"
+	// TemplateVersionLifecycleBlocked indicates the template version cannot be used to create resources.
+	TemplateVersionLifecycleBlocked = "blocked"
+)
+
+// TemplateVersionLifecycles returns all lifecycle states of the template version.
+func TemplateVersionLifecycles() []string {
+	return []string{
+		TemplateVersionLifecycleActive,
+		TemplateVersionLifecycleDeprecated,
+		TemplateVersionLifecycleBlocked,
+	}
+}
+
+// Status of the template upgrade campaign.
+const (
+	// TemplateUpgradeCampaignStatusPending indicates the campaign is waiting for processing.
+	TemplateUpgradeCampaignStatusPending = "pending"
+	// TemplateUpgradeCampaignStatusRunning indicates the campaign is upgrading the resources batch by batch.
+	TemplateUpgradeCampaignStatusRunning = "running"
+	// TemplateUpgradeCampaignStatusSucceeded indicates all resources are upgraded successfully.
+	TemplateUpgradeCampaignStatusSucceeded = "succeeded"
+	// TemplateUpgradeCampaignStatusFailed indicates some resources are failed to upgrade.
+	TemplateUpgradeCampaignStatusFailed = "failed"
+	// TemplateUpgradeCampaignStatusCanceled indicates the campaign is canceled before finishing.
+	TemplateUpgradeCampaignStatusCanceled = "canceled"
+)
+
+// TemplateUpgradeCampaignResult records the upgrade of a resource in the campaign.
+type TemplateUpgradeCampaignResult struct {
+	// ResourceID is the ID of the resource to upgrade.
+	ResourceID object.ID `json:"resourceID"`
+	// ResourceName is the name of the resource to upgrade.
+	ResourceName string `json:"resourceName"`
+	// ProjectID is the ID of the project which the resource belongs to.
+	ProjectID object.ID `json:"projectID"`
+	// EnvironmentID is the ID of the environment which the resource belongs to.
+	EnvironmentID object.ID `json:"environmentID"`
+	// Batch is the 1-based index of the batch which the resource is upgraded in.
+	Batch int `json:"batch"`
+	// RunID is the ID of the upgrade run, empty if the run is not created.
+	RunID object.ID `json:"runID,omitempty"`
+	// Status is the summary status of the upgrade run.
+	Status string `json:"status,omitempty"`
+	// Done indicates the upgrade run is finished.
+	Done bool `json:"done,omitempty"`
+	// Failed indicates the upgrade is failed.
+	Failed bool `json:"failed,omitempty"`
+	// Message is the error message of the upgrade.
+	Message string `json:"message,omitempty"`
+}
+
+// Modes of testing the template versions.
+const (
+	// TemplateTestModeDisabled indicates the template versions are not tested.
+	TemplateTestModeDisabled = "disabled"
+	// TemplateTestModeValidate indicates the fixtures are validated against the schema,
+	// and the template is validated by `terraform validate`.
+	TemplateTestModeValidate = "validate"
+	// TemplateTestModePlan indicates the fixtures are validated against the schema,
+	// and the template is planned with the fixtures by `terraform plan`.
+	TemplateTestModePlan = "plan"
+)
+
+// TemplateTestModes returns all modes of testing the template versions.
+func TemplateTestModes() []string {
+	return []string{
+		TemplateTestModeDisabled,
+		TemplateTestModeValidate,
+		TemplateTestModePlan,
+	}
+}
+
+// TemplateVersionTestResult holds the result of testing the template version with one fixture.
+type TemplateVersionTestResult struct {
+	// Name is the name of the fixture.
+	Name string `json:"name"`
+	// Passed indicates whether the test passed.
+	Passed bool `json:"passed"`
+	// Message is the reason of the failure or the skipped checks.
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,10 @@
+package types
+
+const (
+	// WebhookDeliveryStatusPending indicates the delivery is waiting for delivering or retrying.
+	WebhookDeliveryStatusPending = "pending"
+	// WebhookDeliveryStatusSucceeded indicates the delivery is acknowledged by the receiver.
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	// WebhookDeliveryStatusFailed indicates the delivery is failed after all attempts.
+	WebhookDeliveryStatusFailed = "failed"
+)
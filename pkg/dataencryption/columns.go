@@ -0,0 +1,31 @@
+package dataencryption
+
+import (
+	"github.com/seal-io/walrus/pkg/dao/model/catalog"
+	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/setting"
+	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/model/token"
+	"github.com/seal-io/walrus/pkg/dao/model/variable"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+)
+
+// column describes a table column storing the data encrypted by the crypto fields.
+type column struct {
+	Table  string
+	Column string
+}
+
+// columns holds all columns of the crypto fields,
+// which must be updated once introducing a new crypto field.
+var columns = []column{
+	{Table: catalog.Table, Column: catalog.FieldCredential},
+	{Table: connector.Table, Column: connector.FieldConfigData},
+	{Table: resourcerun.Table, Column: resourcerun.FieldVariables},
+	{Table: setting.Table, Column: setting.FieldValue},
+	{Table: template.Table, Column: template.FieldCredential},
+	{Table: token.Table, Column: token.FieldValue},
+	{Table: variable.Table, Column: variable.FieldValue},
+	{Table: webhook.Table, Column: webhook.FieldSecret},
+}
@@ -0,0 +1,84 @@
+package dataencryption
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/seal-io/walrus/utils/cryptox"
+)
+
+// KEKProviderLocal is the provider name of the local key encryption key(KEK),
+// which is the data encryption key configured at launching.
+const KEKProviderLocal = "local"
+
+// KEKProvider returns the key encryption key(KEK) with the given address,
+// the KEK must be an Encryptor which wraps and unwraps the data encryption keys.
+type KEKProvider func(ctx context.Context, address string) (cryptox.Encryptor, error)
+
+var (
+	kekProvidersMu sync.RWMutex
+	kekProviders   = map[string]KEKProvider{
+		"file": fileKEK,
+	}
+)
+
+// RegisterKEKProvider registers the KEKProvider with the given name,
+// which allows wrapping the data encryption keys by an external key management service.
+func RegisterKEKProvider(name string, provider KEKProvider) {
+	kekProvidersMu.Lock()
+	defer kekProvidersMu.Unlock()
+
+	kekProviders[name] = provider
+}
+
+// GetKEK returns the key encryption key(KEK) with the given provider and address.
+func GetKEK(ctx context.Context, provider, address string) (cryptox.Encryptor, error) {
+	kekProvidersMu.RLock()
+	p, ok := kekProviders[provider]
+	kekProvidersMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown key encryption key provider: %s", provider)
+	}
+
+	return p(ctx, address)
+}
+
+// ParseKEK parses the given string in format of "<provider>:<address>".
+func ParseKEK(s string) (provider, address string, err error) {
+	provider, address, ok := strings.Cut(s, ":")
+	if !ok || provider == "" || address == "" {
+		return "", "", errors.New("illegal format")
+	}
+
+	if provider == KEKProviderLocal {
+		return "", "", fmt.Errorf("reserved provider: %s", provider)
+	}
+
+	return provider, address, nil
+}
+
+// fileKEK reads the AES-GCM key(in-hex string) from the given file path,
+// the file is usually mounted from an external secret manager.
+func fileKEK(_ context.Context, path string) (cryptox.Encryptor, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading key encryption key file: %w", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(bs)))
+	if err != nil {
+		return nil, errors.New("invalid key encryption key: must in hex string")
+	}
+
+	if ks := len(key); ks != 16 && ks != 32 {
+		return nil, errors.New("invalid key encryption key: must in 16 bytes or 32 bytes")
+	}
+
+	return cryptox.AesGcm(key)
+}
@@ -0,0 +1,416 @@
+package dataencryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/cryptox"
+	"github.com/seal-io/walrus/utils/gopool"
+	"github.com/seal-io/walrus/utils/log"
+	"github.com/seal-io/walrus/utils/vars"
+)
+
+var (
+	// KeyringConfig holds the config of the data encryption keyring,
+	// which is also configured as the crypto.EncryptorConfig.
+	KeyringConfig = vars.NewSetOnce(cryptox.NewKeyring(cryptox.Null()))
+
+	// ManagerConfig holds the config of the data encryption key Manager.
+	ManagerConfig = vars.SetOnce[*Manager]{}
+)
+
+const (
+	// keySize is the size of the data encryption key, which is for AES-256-GCM.
+	keySize = 32
+	// reencryptBatchSize is the size of the rows to re-encrypt in a batch.
+	reencryptBatchSize = 100
+)
+
+// Manager manages the data encryption keys(DEK),
+// which are wrapped by the key encryption key(KEK) and stored in the database.
+type Manager struct {
+	logger      log.Logger
+	modelClient model.ClientSet
+	driver      dialect.Driver
+	keyring     *cryptox.Keyring
+	provider    string
+	kek         cryptox.Encryptor
+}
+
+// NewManager returns a Manager with the given key encryption key(KEK),
+// rotation is not allowed if the KEK is nil.
+func NewManager(
+	mc model.ClientSet,
+	drv dialect.Driver,
+	kr *cryptox.Keyring,
+	provider string,
+	kek cryptox.Encryptor,
+) *Manager {
+	m := &Manager{
+		logger:      log.WithName("data-encryption"),
+		modelClient: mc,
+		driver:      drv,
+		keyring:     kr,
+		provider:    provider,
+		kek:         kek,
+	}
+
+	// Resolve the key rotated by other replicas.
+	kr.Resolve(func(id string) (cryptox.Encryptor, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		key, err := m.modelClient.DataEncryptionKeys().Get(ctx, object.ID(id))
+		if err != nil {
+			return nil, err
+		}
+
+		return m.unwrap(key)
+	})
+
+	return m
+}
+
+// Load loads all data encryption keys into the keyring,
+// and activates the active one.
+func (m *Manager) Load(ctx context.Context) error {
+	keys, err := m.modelClient.DataEncryptionKeys().Query().
+		Order(model.Asc(dataencryptionkey.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing data encryption keys: %w", err)
+	}
+
+	var active string
+
+	for i := range keys {
+		enc, err := m.unwrap(keys[i])
+		if err != nil {
+			return fmt.Errorf("error unwrapping data encryption key %s: %w", keys[i].ID, err)
+		}
+
+		m.keyring.Set(keys[i].ID.String(), enc)
+
+		if keys[i].Active {
+			active = keys[i].ID.String()
+		}
+	}
+
+	return m.keyring.Activate(active)
+}
+
+// Watch reloads the keys with the given interval until the given context is done,
+// so that the key rotated by other replicas is used to encrypt the new data.
+func (m *Manager) Watch(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		if err := m.Load(ctx); err != nil {
+			m.logger.Errorf("error reloading data encryption keys: %v", err)
+		}
+	}
+}
+
+// Rotate generates a new data encryption key to encrypt the new data,
+// and re-encrypts the existing data with the new key in background.
+func (m *Manager) Rotate(ctx context.Context) (*model.DataEncryptionKey, error) {
+	if m.kek == nil {
+		return nil, errors.New("data encryption is disabled")
+	}
+
+	if err := m.checkRunning(ctx); err != nil {
+		return nil, err
+	}
+
+	dek := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, dek); err != nil {
+		return nil, fmt.Errorf("error generating data encryption key: %w", err)
+	}
+
+	enc, err := cryptox.AesGcm(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := m.kek.Encrypt(dek, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error wrapping data encryption key: %w", err)
+	}
+
+	var key *model.DataEncryptionKey
+
+	err = m.modelClient.WithTx(ctx, func(tx *model.Tx) error {
+		err := tx.DataEncryptionKeys().Update().
+			Where(dataencryptionkey.Active(true)).
+			SetActive(false).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		key, err = tx.DataEncryptionKeys().Create().
+			SetProvider(m.provider).
+			SetWrappedKey(wrapped).
+			SetActive(true).
+			SetRotationStatus(types.DataEncryptionKeyRotationStatusRunning).
+			Save(ctx)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.keyring.Set(key.ID.String(), enc)
+
+	if err = m.keyring.Activate(key.ID.String()); err != nil {
+		return nil, err
+	}
+
+	m.start(key)
+
+	return key, nil
+}
+
+// Reencrypt re-encrypts the existing data with the given active key in background,
+// which is used to resume the rotation interrupted by error or restarting.
+func (m *Manager) Reencrypt(ctx context.Context, key *model.DataEncryptionKey) (*model.DataEncryptionKey, error) {
+	if !key.Active {
+		return nil, errors.New("cannot re-encrypt with inactive key")
+	}
+
+	key, err := m.modelClient.DataEncryptionKeys().UpdateOne(key).
+		SetRotationStatus(types.DataEncryptionKeyRotationStatusRunning).
+		SetRotationTotal(0).
+		SetRotationProcessed(0).
+		SetRotationMessage("").
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m.start(key)
+
+	return key, nil
+}
+
+func (m *Manager) checkRunning(ctx context.Context) error {
+	running, err := m.modelClient.DataEncryptionKeys().Query().
+		Where(dataencryptionkey.RotationStatus(types.DataEncryptionKeyRotationStatusRunning)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+
+	if running {
+		return errors.New("another rotation is running")
+	}
+
+	return nil
+}
+
+func (m *Manager) unwrap(key *model.DataEncryptionKey) (cryptox.Encryptor, error) {
+	if m.kek == nil {
+		return nil, errors.New("data encryption is disabled")
+	}
+
+	if key.Provider != m.provider {
+		return nil, fmt.Errorf("wrapped by %s key encryption key, but configured %s",
+			key.Provider, m.provider)
+	}
+
+	dek, err := m.kek.Decrypt(key.WrappedKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("inconsistent key encryption key: %w", err)
+	}
+
+	return cryptox.AesGcm(dek)
+}
+
+// start re-encrypts the existing data with the given key in background,
+// and records the progress.
+func (m *Manager) start(key *model.DataEncryptionKey) {
+	gopool.Go(func() {
+		// Continue even if the requester has gone.
+		ctx := context.Background()
+
+		err := m.reencrypt(ctx, key)
+
+		upd := m.modelClient.DataEncryptionKeys().UpdateOneID(key.ID).
+			SetRotationStatus(types.DataEncryptionKeyRotationStatusSucceeded)
+		if err != nil {
+			m.logger.Errorf("error re-encrypting data with key %s: %v", key.ID, err)
+
+			upd.SetRotationStatus(types.DataEncryptionKeyRotationStatusFailed).
+				SetRotationMessage(err.Error())
+		}
+
+		if err = upd.Exec(ctx); err != nil {
+			m.logger.Errorf("error recording rotation of key %s: %v", key.ID, err)
+		}
+	})
+}
+
+func (m *Manager) reencrypt(ctx context.Context, key *model.DataEncryptionKey) error {
+	total := 0
+
+	for i := range columns {
+		n, err := m.count(ctx, columns[i])
+		if err != nil {
+			return err
+		}
+
+		total += n
+	}
+
+	err := m.modelClient.DataEncryptionKeys().UpdateOneID(key.ID).
+		SetRotationTotal(total).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	processed := 0
+
+	for i := range columns {
+		err = m.reencryptColumn(ctx, columns[i], key.ID.String(), func(n int) error {
+			processed += n
+
+			return m.modelClient.DataEncryptionKeys().UpdateOneID(key.ID).
+				SetRotationProcessed(processed).
+				Exec(ctx)
+		})
+		if err != nil {
+			return fmt.Errorf("error re-encrypting %s.%s: %w", columns[i].Table, columns[i].Column, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) count(ctx context.Context, c column) (int, error) {
+	query, args := sql.Dialect(m.driver.Dialect()).
+		Select(sql.Count("*")).
+		From(sql.Table(c.Table)).
+		Where(sql.NotNull(c.Column)).
+		Query()
+
+	rows := &sql.Rows{}
+	if err := m.driver.Query(ctx, query, args, rows); err != nil {
+		return 0, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return sql.ScanInt(rows)
+}
+
+// reencryptColumn re-encrypts the given column in batches,
+// skips the values have been encrypted by the given key,
+// and calls the given progress function after each batch.
+func (m *Manager) reencryptColumn(ctx context.Context, c column, keyID string, progress func(int) error) error {
+	type entry struct {
+		ID    int64
+		Value []byte
+	}
+
+	var after int64
+
+	for {
+		query, args := sql.Dialect(m.driver.Dialect()).
+			Select("id", c.Column).
+			From(sql.Table(c.Table)).
+			Where(sql.And(
+				sql.GT("id", after),
+				sql.NotNull(c.Column))).
+			OrderBy("id").
+			Limit(reencryptBatchSize).
+			Query()
+
+		rows := &sql.Rows{}
+		if err := m.driver.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+
+		var entries []entry
+
+		for rows.Next() {
+			var e entry
+			if err := rows.Scan(&e.ID, &e.Value); err != nil {
+				_ = rows.Close()
+				return err
+			}
+
+			entries = append(entries, e)
+		}
+
+		if err := rows.Close(); err != nil {
+			return err
+		}
+
+		for _, e := range entries {
+			if cryptox.KeyringKeyID(e.Value) == keyID {
+				continue
+			}
+
+			p, err := m.keyring.Decrypt(e.Value, nil)
+			if err != nil {
+				return fmt.Errorf("error decrypting row %d: %w", e.ID, err)
+			}
+
+			v, err := m.keyring.Encrypt(p, nil)
+			if err != nil {
+				return fmt.Errorf("error encrypting row %d: %w", e.ID, err)
+			}
+
+			if bytes.Equal(v, e.Value) {
+				continue
+			}
+
+			// Skip if the row has been modified concurrently,
+			// which is re-encrypted by the writer.
+			query, args := sql.Dialect(m.driver.Dialect()).
+				Update(c.Table).
+				Set(c.Column, v).
+				Where(sql.And(
+					sql.EQ("id", e.ID),
+					sql.EQ(c.Column, e.Value))).
+				Query()
+
+			var res stdsql.Result
+			if err = m.driver.Exec(ctx, query, args, &res); err != nil {
+				return fmt.Errorf("error updating row %d: %w", e.ID, err)
+			}
+		}
+
+		if err := progress(len(entries)); err != nil {
+			return err
+		}
+
+		if len(entries) < reencryptBatchSize {
+			return nil
+		}
+
+		after = entries[len(entries)-1].ID
+	}
+}
@@ -0,0 +1,120 @@
+package dataencryption
+
+import (
+	"context"
+	stdsql "database/sql"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/dataencryptionkey"
+	"github.com/seal-io/walrus/pkg/dao/model/migrate"
+	"github.com/seal-io/walrus/pkg/dao/model/setting"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/utils/cryptox"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+func TestColumns(t *testing.T) {
+	expected := make(map[column]struct{}, len(columns))
+	for i := range columns {
+		expected[columns[i]] = struct{}{}
+	}
+
+	for _, tb := range migrate.Tables {
+		for _, c := range tb.Columns {
+			if c.SchemaType[dialect.Postgres] != "bytea" {
+				continue
+			}
+
+			_, ok := expected[column{Table: tb.Name, Column: c.Name}]
+			assert.True(t, ok, "crypto column %s.%s is not re-encrypted", tb.Name, c.Name)
+		}
+	}
+}
+
+func TestManager_Rotate(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	db, err := stdsql.Open("sqlite3", "file:dataencryption?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+
+	defer func() { _ = db.Close() }()
+
+	drv := sql.OpenDB(dialect.SQLite, db)
+	client := model.NewClient(model.Driver(drv))
+	require.NoError(t, client.Schema.Create(ctx))
+
+	legacy, err := cryptox.AesGcm([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+
+	kr := cryptox.NewKeyring(legacy)
+	crypto.EncryptorConfig.Set(kr)
+
+	// Encrypted with the legacy key.
+	err = client.Settings().Create().
+		SetName("test").
+		SetValue("test").
+		Exec(ctx)
+	require.NoError(t, err)
+
+	mgr := NewManager(client, drv, kr, KEKProviderLocal, legacy)
+	require.NoError(t, mgr.Load(ctx))
+
+	key, err := mgr.Rotate(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, kr.Primary(), key.ID.String())
+
+	require.Eventually(t, func() bool {
+		key, err = client.DataEncryptionKeys().Get(ctx, key.ID)
+		return err == nil && key.RotationStatus != types.DataEncryptionKeyRotationStatusRunning
+	}, 10*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, types.DataEncryptionKeyRotationStatusSucceeded, key.RotationStatus)
+	assert.Equal(t, key.RotationTotal, key.RotationProcessed)
+
+	// Re-encrypted with the new key.
+	var raw []byte
+	require.NoError(t, db.QueryRowContext(ctx,
+		"SELECT value FROM settings WHERE name = 'test'").Scan(&raw))
+	assert.Equal(t, key.ID.String(), cryptox.KeyringKeyID(raw))
+
+	s, err := client.Settings().Query().
+		Where(setting.Name("test")).
+		Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "test", string(s.Value))
+
+	// Reload after restarting.
+	other := cryptox.NewKeyring(legacy)
+	require.NoError(t, NewManager(client, drv, other, KEKProviderLocal, legacy).Load(ctx))
+	assert.Equal(t, key.ID.String(), other.Primary())
+
+	p, err := other.Decrypt(raw, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "test", string(p))
+
+	// Refuse unwrapping with another key encryption key.
+	wrong, err := cryptox.AesGcm([]byte("fedcba9876543210"))
+	require.NoError(t, err)
+	assert.Error(t, NewManager(client, drv, cryptox.NewKeyring(legacy), KEKProviderLocal, wrong).Load(ctx))
+
+	cnt, err := client.DataEncryptionKeys().Query().
+		Where(dataencryptionkey.Active(true)).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, cnt)
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model/setting"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/database"
+	"github.com/seal-io/walrus/pkg/webhooks"
+	"github.com/seal-io/walrus/utils/hash"
 	"github.com/seal-io/walrus/utils/json"
 	"github.com/seal-io/walrus/utils/log"
 	"github.com/seal-io/walrus/utils/strs"
@@ -138,4 +140,20 @@ func (h handler) Handle(ctx context.Context, _, payload string) {
 
 	// Otherwise, write event to the corresponding topic.
 	h.buffer.Write(ctx, topic.Topic(le.TableName), event)
+
+	// And dispatch to the webhooks,
+	// the payload hash identifies the same change received by multiple replicas.
+	wev := webhooks.Event{
+		ID:        hash.SumSHA224(strs.ToBytes(&payload)),
+		Topic:     le.TableName,
+		Type:      event.Type.String(),
+		Timestamp: le.Timestamp,
+		Data:      make([]webhooks.EventData, len(data)),
+	}
+
+	for i := range data {
+		wev.Data[i] = webhooks.EventData(data[i])
+	}
+
+	webhooks.Dispatch(ctx, h.modelClient, wev)
 }
@@ -32,12 +32,13 @@ var (
 	WorkflowExecution = topic.Topic(migrate.WorkflowExecutionsTable.Name)
 	// ResourceDefinition is the topic for model.ResourceDefinition.
 	ResourceDefinition = topic.Topic(migrate.ResourceDefinitionsTable.Name)
+	// BudgetEvent is the topic for model.BudgetEvent.
+	BudgetEvent = topic.Topic(migrate.BudgetEventsTable.Name)
 )
 
-// tableNameSet holds the set for interested table names,
+// topicNameSet holds the set for the table names allowed subscribing from topic,
 // which should correspond to the topics above.
-var tableNameSet = sets.NewString(
-	// Allow subscribing from topic.
+var topicNameSet = sets.NewString(
 	string(Catalog),
 	string(Connector),
 	string(Resource),
@@ -47,9 +48,19 @@ var tableNameSet = sets.NewString(
 	string(Workflow),
 	string(WorkflowExecution),
 	string(ResourceDefinition),
+	string(BudgetEvent),
+)
+
+// tableNameSet holds the set for interested table names.
+var tableNameSet = topicNameSet.Union(sets.NewString(
 	// Disallow subscribing from topic.
 	migrate.SettingsTable.Name,
-)
+))
+
+// Topics returns the name list of the topics allowed subscribing.
+func Topics() []string {
+	return topicNameSet.List()
+}
 
 // EventType indicates the type of model change event.
 type EventType uint8
@@ -62,6 +73,15 @@ const (
 	_EventTypeLength
 )
 
+// EventTypes returns the name list of the known event types.
+func EventTypes() []string {
+	return []string{
+		EventTypeCreate.String(),
+		EventTypeUpdate.String(),
+		EventTypeDelete.String(),
+	}
+}
+
 func (t EventType) String() string {
 	switch t {
 	case EventTypeCreate:
@@ -3,6 +3,7 @@ package datalisten
 import (
 	"context"
 
+	"github.com/seal-io/walrus/pkg/cache"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/database"
 	"github.com/seal-io/walrus/pkg/datalisten/modelchange"
@@ -11,6 +12,7 @@ import (
 type StartOptions struct {
 	ModelClient       model.ClientSet
 	DataSourceAddress string
+	CacheDriver       cache.Driver
 }
 
 // Start leverages the database listener to establish the data changes.
@@ -25,6 +27,12 @@ func Start(ctx context.Context, opts StartOptions) error {
 	hs := []database.ListenHandler{
 		modelchange.Handle(ctx, opts.ModelClient),
 	}
+
+	if opts.CacheDriver != nil {
+		if h := cache.InvalidationHandler(opts.CacheDriver); h != nil {
+			hs = append(hs, h)
+		}
+	}
 	for i := range hs {
 		if err = l.Register(hs[i]); err != nil {
 			return err
@@ -0,0 +1,124 @@
+package pulumi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/deployer/terraform"
+	runconfig "github.com/seal-io/walrus/pkg/resourceruns/config"
+	"github.com/seal-io/walrus/pkg/servervars"
+)
+
+const (
+	// _stateAPI the API path to get and update the states of the run,
+	// the Pulumi stack is imported from and exported to it.
+	_stateAPI = "/v1/projects/%s/environments/%s/resources/%s/runs/%s/terraform-states"
+
+	// _stateFileName the file to store the exported stack state.
+	_stateFileName = "state.json"
+	// _planFileName the file to store the plan of the resource run.
+	_planFileName = "plan.out"
+	// _jsonPlanFileName the json file to show the plan of the resource run.
+	_jsonPlanFileName = "plan.json"
+)
+
+// getCommand returns the shell command of the Pulumi job.
+func getCommand(opts terraform.JobCreateOptions) string {
+	run, binary := opts.ResourceRun, opts.Binary
+	if binary == "" {
+		binary = "pulumi"
+	}
+
+	cmds := getPrepareCommands(run, binary, opts)
+
+	destroy := run.Type == types.RunTypeDelete.String() || run.Type == types.RunTypeStop.String()
+
+	switch opts.Type {
+	case types.RunTaskTypePlan:
+		if destroy {
+			cmds = append(cmds, fmt.Sprintf("%s destroy --preview-only --json > %s", binary, _jsonPlanFileName))
+		} else {
+			cmds = append(cmds, fmt.Sprintf("%s preview --json > %s", binary, _jsonPlanFileName))
+		}
+
+		// Pulumi applies without the plan file,
+		// keeps the json plan as the plan file to satisfy the plan API.
+		cmds = append(cmds, fmt.Sprintf("cp %s %s", _jsonPlanFileName, _planFileName))
+
+		return strings.Join(cmds, " && ") + terraform.SetPlanFile(run, opts)
+	case types.RunTaskTypeApply:
+		cmds = append(cmds, fmt.Sprintf("%s up --yes --skip-preview", binary))
+	case types.RunTaskTypeDestroy:
+		cmds = append(cmds, fmt.Sprintf("%s destroy --yes --skip-preview", binary))
+	}
+
+	cmds = append(cmds,
+		fmt.Sprintf("%s stack export --file %s", binary, _stateFileName),
+		setState(run, opts))
+
+	return strings.Join(cmds, " && ")
+}
+
+// getPrepareCommands returns the commands to fetch the program,
+// place the project files and import the stack state.
+func getPrepareCommands(run *model.ResourceRun, binary string, opts terraform.JobCreateOptions) []string {
+	secretPath := opts.SecretMountPath
+
+	return []string{
+		// Use the local backend inside the workspace and the resource scoped passphrase,
+		// the state is persisted by walrus after each run.
+		fmt.Sprintf("export PULUMI_BACKEND_URL=file://$(pwd) PULUMI_CONFIG_PASSPHRASE=%s PULUMI_SKIP_UPDATE_CHECK=true",
+			run.ResourceID),
+		fmt.Sprintf("KUBECONFIG=$(ls %s/config-* 2>/dev/null | head -n 1) && export KUBECONFIG", secretPath),
+		fmt.Sprintf(". %s/%s", secretPath, runconfig.PulumiFileProgramEnv),
+		fmt.Sprintf(`git clone --depth 1 ${PROGRAM_REF:+--branch "$PROGRAM_REF"} "$PROGRAM_REPO" %s`,
+			runconfig.PulumiProgramDir),
+		fmt.Sprintf("cp %[1]s/%[2]s %[2]s && cp %[1]s/%[3]s %[3]s",
+			secretPath, runconfig.PulumiFileProject, runconfig.PulumiFileStack),
+		fmt.Sprintf("%s stack select --create %s", binary, runconfig.PulumiStack),
+		getState(run, opts),
+		fmt.Sprintf("if grep -q deployment %[2]s; then %[1]s stack import --file %[2]s; fi",
+			binary, _stateFileName),
+	}
+}
+
+// getState returns the command to get the state of the resource.
+func getState(run *model.ResourceRun, opts terraform.JobCreateOptions) string {
+	cmd := fmt.Sprintf(
+		"curl -sS --fail-with-body -X GET -H \"Authorization: Bearer $ACCESS_TOKEN\" %s -o %s",
+		getStateAPI(run, opts),
+		_stateFileName,
+	)
+
+	if !servervars.TlsCertified.Get() {
+		cmd += " -k"
+	}
+
+	return cmd
+}
+
+// setState returns the command to set the state of the resource.
+func setState(run *model.ResourceRun, opts terraform.JobCreateOptions) string {
+	cmd := fmt.Sprintf(
+		"curl -sS --fail-with-body -X POST -H \"Content-Type: application/json\""+
+			" -H \"Authorization: Bearer $ACCESS_TOKEN\" %s --data-binary @%s",
+		getStateAPI(run, opts),
+		_stateFileName,
+	)
+
+	if !servervars.TlsCertified.Get() {
+		cmd += " -k"
+	}
+
+	return cmd
+}
+
+func getStateAPI(run *model.ResourceRun, opts terraform.JobCreateOptions) string {
+	return opts.ServerURL + fmt.Sprintf(_stateAPI,
+		run.ProjectID,
+		run.EnvironmentID,
+		run.ResourceID,
+		run.ID)
+}
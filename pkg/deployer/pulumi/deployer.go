@@ -0,0 +1,22 @@
+package pulumi
+
+import (
+	"context"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/deployer/terraform"
+	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
+	"github.com/seal-io/walrus/pkg/settings"
+)
+
+// NewDeployer returns the deployer driving the Pulumi YAML program,
+// which shares the Kubernetes Job lifecycle with the terraform deployer,
+// but constructs the Pulumi project files and commands instead.
+func NewDeployer(ctx context.Context, opts deptypes.CreateOptions) (deptypes.Deployer, error) {
+	return terraform.NewJobDeployer(ctx, opts, terraform.JobOptions{
+		Type:    types.DeployerTypePulumi,
+		Binary:  "pulumi",
+		Image:   settings.PulumiDeployerImage,
+		Command: getCommand,
+	})
+}
@@ -0,0 +1,113 @@
+package pulumi
+
+import (
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// preview is the output of `pulumi preview --json`.
+type preview struct {
+	Steps []previewStep `json:"steps"`
+}
+
+type previewStep struct {
+	Op       string        `json:"op"`
+	URN      string        `json:"urn"`
+	Provider string        `json:"provider"`
+	OldState *previewState `json:"oldState"`
+	NewState *previewState `json:"newState"`
+}
+
+type previewState struct {
+	Type    string         `json:"type"`
+	Inputs  map[string]any `json:"inputs"`
+	Outputs map[string]any `json:"outputs"`
+}
+
+// ParsePreview parses the given Pulumi preview json into the plan,
+// the steps of the Pulumi internal resources are ignored.
+func ParsePreview(data []byte) (*types.Plan, error) {
+	var p preview
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+
+	plan := &types.Plan{}
+
+	for _, s := range p.Steps {
+		actions := getActions(s.Op)
+		if actions == nil {
+			continue
+		}
+
+		typ, name := parseURN(s.URN)
+		if typ == "" || strings.HasPrefix(typ, "pulumi:") {
+			continue
+		}
+
+		change := &tfjson.Change{
+			Actions: actions,
+		}
+
+		if s.OldState != nil {
+			change.Before = s.OldState.Outputs
+		}
+
+		if s.NewState != nil {
+			change.After = s.NewState.Inputs
+		}
+
+		plan.ResourceComponentChanges = append(plan.ResourceComponentChanges, &types.ResourceComponentChange{
+			ResourceChange: &tfjson.ResourceChange{
+				Address:      typ + "." + name,
+				Mode:         tfjson.ManagedResourceMode,
+				Type:         typ,
+				Name:         name,
+				ProviderName: s.Provider,
+			},
+			Change: (&types.Change{Change: change}).Process(),
+		})
+	}
+
+	return plan, nil
+}
+
+// getActions converts the Pulumi step operation to the terraform actions,
+// returns nil if the operation is not a resource change.
+func getActions(op string) tfjson.Actions {
+	switch op {
+	case "same":
+		return tfjson.Actions{tfjson.ActionNoop}
+	case "create":
+		return tfjson.Actions{tfjson.ActionCreate}
+	case "update":
+		return tfjson.Actions{tfjson.ActionUpdate}
+	case "delete":
+		return tfjson.Actions{tfjson.ActionDelete}
+	case "replace":
+		return tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}
+	case "read":
+		return tfjson.Actions{tfjson.ActionRead}
+	}
+
+	// The create-replacement, delete-replaced and others are the steps of the above operations.
+	return nil
+}
+
+// parseURN parses the type and name from the given Pulumi URN,
+// e.g. urn:pulumi:stack::project::kubernetes:apps/v1:Deployment::nginx.
+func parseURN(urn string) (typ, name string) {
+	parts := strings.Split(urn, "::")
+	if len(parts) < 4 {
+		return "", ""
+	}
+
+	// The type may be nested with the parent types by `$`.
+	ts := strings.Split(parts[2], "$")
+
+	return ts[len(ts)-1], parts[len(parts)-1]
+}
@@ -0,0 +1,53 @@
+package pulumi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+func TestParsePreview(t *testing.T) {
+	data := []byte(`{
+  "steps": [
+    {
+      "op": "create",
+      "urn": "urn:pulumi:walrus::walrus::pulumi:pulumi:Stack::walrus-walrus"
+    },
+    {
+      "op": "create",
+      "urn": "urn:pulumi:walrus::walrus::kubernetes:apps/v1:Deployment::nginx",
+      "newState": {"type": "kubernetes:apps/v1:Deployment", "inputs": {"replicas": 1}}
+    },
+    {
+      "op": "replace",
+      "urn": "urn:pulumi:walrus::walrus::kubernetes:core/v1:Service::nginx",
+      "oldState": {"type": "kubernetes:core/v1:Service", "outputs": {"type": "ClusterIP"}},
+      "newState": {"type": "kubernetes:core/v1:Service", "inputs": {"type": "NodePort"}}
+    },
+    {
+      "op": "create-replacement",
+      "urn": "urn:pulumi:walrus::walrus::kubernetes:core/v1:Service::nginx"
+    },
+    {
+      "op": "delete",
+      "urn": "urn:pulumi:walrus::walrus::kubernetes:core/v1:ConfigMap::nginx",
+      "oldState": {"type": "kubernetes:core/v1:ConfigMap", "outputs": {}}
+    }
+  ],
+  "changeSummary": {"create": 1, "replace": 1, "delete": 1}
+}`)
+
+	plan, err := ParsePreview(data)
+	require.NoError(t, err)
+	require.Len(t, plan.ResourceComponentChanges, 3)
+
+	assert.Equal(t, "kubernetes:apps/v1:Deployment.nginx", plan.ResourceComponentChanges[0].Address)
+	assert.Equal(t, types.ResourceComponentChangeTypeCreate, plan.ResourceComponentChanges[0].Change.Type)
+	assert.Equal(t, types.ResourceComponentChangeTypeUpdate, plan.ResourceComponentChanges[1].Change.Type)
+	assert.Equal(t, types.ResourceComponentChangeTypeDelete, plan.ResourceComponentChanges[2].Change.Type)
+	assert.Equal(t, types.ResourceComponentChangeSummary{Created: 1, Updated: 1, Deleted: 1},
+		plan.GetResourceChangeSummary())
+}
@@ -3,24 +3,62 @@ package deployer
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/deployer/pulumi"
 	"github.com/seal-io/walrus/pkg/deployer/terraform"
 	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
 )
 
-var dpCreators map[deptypes.Type]deptypes.Creator
+var (
+	dpCreatorsMu sync.RWMutex
+	dpCreators   map[deptypes.Type]deptypes.Creator
+)
 
 func init() {
 	// Register deployer creators as below.
 	dpCreators = map[deptypes.Type]deptypes.Creator{
-		types.DeployerTypeTF: terraform.NewDeployer,
+		types.DeployerTypeTF:       terraform.NewDeployer,
+		types.DeployerTypeOpenTofu: terraform.NewOpenTofuDeployer,
+		types.DeployerTypePulumi:   pulumi.NewDeployer,
 	}
 }
 
+// Register registers the deployer creator of the given type,
+// the registered creator replaces the previous one.
+func Register(typ deptypes.Type, creator deptypes.Creator) {
+	dpCreatorsMu.Lock()
+	defer dpCreatorsMu.Unlock()
+
+	dpCreators[typ] = creator
+}
+
 // Get returns types.Deployer with the given types.CreateOptions.
+//
+// The returned deployer works as the given type,
+// but delegates the run to the deployer of the run's deployer type,
+// which is chosen by the environment or template when creating the run.
 func Get(ctx context.Context, opts deptypes.CreateOptions) (deptypes.Deployer, error) {
+	dp, err := create(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &delegator{
+		opts: opts,
+		dps: map[deptypes.Type]deptypes.Deployer{
+			opts.Type: dp,
+		},
+	}, nil
+}
+
+func create(ctx context.Context, opts deptypes.CreateOptions) (deptypes.Deployer, error) {
+	dpCreatorsMu.RLock()
 	f, exist := dpCreators[opts.Type]
+	dpCreatorsMu.RUnlock()
+
 	if !exist {
 		return nil, fmt.Errorf("unknown deployer: %s", opts.Type)
 	}
@@ -32,3 +70,84 @@ func Get(ctx context.Context, opts deptypes.CreateOptions) (deptypes.Deployer, e
 
 	return dp, nil
 }
+
+// delegator delegates the run to the deployer of the run's deployer type.
+type delegator struct {
+	opts deptypes.CreateOptions
+
+	mu  sync.Mutex
+	dps map[deptypes.Type]deptypes.Deployer
+}
+
+func (d *delegator) Type() deptypes.Type {
+	return d.opts.Type
+}
+
+func (d *delegator) Apply(
+	ctx context.Context,
+	mc model.ClientSet,
+	run *model.ResourceRun,
+	opts deptypes.ApplyOptions,
+) error {
+	dp, err := d.get(ctx, run)
+	if err != nil {
+		return err
+	}
+
+	return dp.Apply(ctx, mc, run, opts)
+}
+
+func (d *delegator) Destroy(
+	ctx context.Context,
+	mc model.ClientSet,
+	run *model.ResourceRun,
+	opts deptypes.DestroyOptions,
+) error {
+	dp, err := d.get(ctx, run)
+	if err != nil {
+		return err
+	}
+
+	return dp.Destroy(ctx, mc, run, opts)
+}
+
+func (d *delegator) Plan(
+	ctx context.Context,
+	mc model.ClientSet,
+	run *model.ResourceRun,
+	opts deptypes.PlanOptions,
+) error {
+	dp, err := d.get(ctx, run)
+	if err != nil {
+		return err
+	}
+
+	return dp.Plan(ctx, mc, run, opts)
+}
+
+// get returns the deployer of the given run's deployer type.
+func (d *delegator) get(ctx context.Context, run *model.ResourceRun) (deptypes.Deployer, error) {
+	typ := run.DeployerType
+	if typ == "" {
+		typ = d.opts.Type
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if dp, ok := d.dps[typ]; ok {
+		return dp, nil
+	}
+
+	opts := d.opts
+	opts.Type = typ
+
+	dp, err := create(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	d.dps[typ] = dp
+
+	return dp, nil
+}
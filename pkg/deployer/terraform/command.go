@@ -14,14 +14,15 @@ const (
 	// _jsonPlanFileName the json file to show the plan of the resource run.
 	_jsonPlanFileName = "plan.json"
 
-	// _planCommands the commands to get the changes of the resource run.
-	_planCommands = "terraform init -no-color && terraform plan %s -no-color -out=plan.out %s" +
-		" && terraform show -json plan.out > " + _jsonPlanFileName
+	// _planCommands the commands to get the changes of the resource run,
+	// the first argument is the binary, e.g. terraform or tofu.
+	_planCommands = "%[1]s init -no-color && %[1]s plan %[2]s -no-color -out=plan.out %[3]s" +
+		" && %[1]s show -json plan.out > " + _jsonPlanFileName
 	// _applyCommands the commands to apply deployment of the resource run.
-	_applyCommands = "terraform init -no-color && terraform apply %s -no-color"
+	_applyCommands = "%[1]s init -no-color && %[1]s apply %[2]s -no-color"
 	// _destroyCommands the commands to destroy deployment of the resource run.
 	// As destroy planned in plan file, use apply command to execution the plan.
-	_destroyCommands = "terraform init -no-color && terraform apply %s -no-color"
+	_destroyCommands = "%[1]s init -no-color && %[1]s apply %[2]s -no-color"
 
 	// _planAPI.
 	_planAPI = "/v1/projects/%s/environments/%s/resources/%s/runs/%s/plan"
@@ -30,26 +31,37 @@ const (
 func getPlanCommands(run *model.ResourceRun, opts JobCreateOptions) string {
 	var (
 		destroy string
-		varfile = fmt.Sprintf(" -var-file=%s/terraform.tfvars", _secretMountPath)
+		varfile = fmt.Sprintf(" -var-file=%s/terraform.tfvars", getSecretMountPath(opts))
 	)
 
 	if run.Type == types.RunTypeDelete.String() || run.Type == types.RunTypeStop.String() {
 		destroy = "-destroy"
 	}
 
-	return fmt.Sprintf(_planCommands, destroy, varfile) + setPlanFile(run, opts)
+	return fmt.Sprintf(_planCommands, getBinary(opts), destroy, varfile) + SetPlanFile(run, opts)
 }
 
 func getApplyCommands(run *model.ResourceRun, opts JobCreateOptions) string {
-	return fmt.Sprintf("%s && %s", getPlanFile(run, opts), fmt.Sprintf(_applyCommands, _planFileName))
+	return fmt.Sprintf("%s && %s",
+		GetPlanFile(run, opts), fmt.Sprintf(_applyCommands, getBinary(opts), _planFileName))
 }
 
 func getDestroyCommands(run *model.ResourceRun, opts JobCreateOptions) string {
-	return fmt.Sprintf("%s && %s", getPlanFile(run, opts), fmt.Sprintf(_destroyCommands, _planFileName))
+	return fmt.Sprintf("%s && %s",
+		GetPlanFile(run, opts), fmt.Sprintf(_destroyCommands, getBinary(opts), _planFileName))
 }
 
-// getPlanFile returns the command to get the plan file.
-func getPlanFile(run *model.ResourceRun, opts JobCreateOptions) string {
+// getBinary returns the binary of the job, defaults to terraform.
+func getBinary(opts JobCreateOptions) string {
+	if opts.Binary == "" {
+		return "terraform"
+	}
+
+	return opts.Binary
+}
+
+// GetPlanFile returns the command to get the plan file.
+func GetPlanFile(run *model.ResourceRun, opts JobCreateOptions) string {
 	getPlanAPI := fmt.Sprintf("%s%s", opts.ServerURL,
 		fmt.Sprintf(_planAPI, run.ProjectID, run.EnvironmentID, run.ResourceID, run.ID))
 
@@ -66,8 +78,8 @@ func getPlanFile(run *model.ResourceRun, opts JobCreateOptions) string {
 	return getPlan
 }
 
-// setPlanFile returns the command to set the plan file.
-func setPlanFile(run *model.ResourceRun, opts JobCreateOptions) string {
+// SetPlanFile returns the command to set the plan file.
+func SetPlanFile(run *model.ResourceRun, opts JobCreateOptions) string {
 	setPlanAPI := fmt.Sprintf("%s%s", opts.ServerURL,
 		fmt.Sprintf(_planAPI,
 			run.ProjectID,
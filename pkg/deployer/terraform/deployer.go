@@ -2,18 +2,25 @@ package terraform
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"path"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/template"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
 	runconfig "github.com/seal-io/walrus/pkg/resourceruns/config"
 	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
 	"github.com/seal-io/walrus/pkg/settings"
+	"github.com/seal-io/walrus/pkg/templates"
+	"github.com/seal-io/walrus/pkg/templates/registry"
+	"github.com/seal-io/walrus/utils/json"
 	"github.com/seal-io/walrus/utils/log"
 )
 
@@ -22,23 +29,67 @@ type Deployer struct {
 	logger          log.Logger
 	clientSet       *kubernetes.Clientset
 	runConfigurator runconfig.Configurator
+	jobOptions      JobOptions
 }
 
-func NewDeployer(_ context.Context, opts deptypes.CreateOptions) (deptypes.Deployer, error) {
+// JobOptions customizes the job driven by the Deployer,
+// which allows the other tools to reuse the Deployer.
+type JobOptions struct {
+	// Type is the deployer type.
+	Type deptypes.Type
+	// Binary is the default binary executed by the job.
+	Binary string
+	// Image is the setting of the default job image.
+	Image settings.Value
+	// Command returns the shell command of the job,
+	// defaults to the terraform commands.
+	Command func(JobCreateOptions) string
+}
+
+func NewDeployer(ctx context.Context, opts deptypes.CreateOptions) (deptypes.Deployer, error) {
+	return NewJobDeployer(ctx, opts, JobOptions{
+		Type:   types.DeployerTypeTF,
+		Binary: "terraform",
+		Image:  settings.DeployerImage,
+	})
+}
+
+// NewOpenTofuDeployer returns the deployer driving the OpenTofu,
+// which shares the HCL configs with the terraform deployer.
+func NewOpenTofuDeployer(ctx context.Context, opts deptypes.CreateOptions) (deptypes.Deployer, error) {
+	return NewJobDeployer(ctx, opts, JobOptions{
+		Type:   types.DeployerTypeOpenTofu,
+		Binary: "tofu",
+		Image:  settings.OpenTofuDeployerImage,
+	})
+}
+
+// NewJobDeployer returns the deployer driving the Kubernetes Job with the given JobOptions.
+func NewJobDeployer(
+	_ context.Context,
+	opts deptypes.CreateOptions,
+	jobOpts JobOptions,
+) (deptypes.Deployer, error) {
 	clientSet, err := kubernetes.NewForConfig(opts.KubeConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kubernetes client set: %w", err)
 	}
 
+	rc := runconfig.NewConfigurator(jobOpts.Type)
+	if rc == nil {
+		return nil, fmt.Errorf("unknown configurator: %s", jobOpts.Type)
+	}
+
 	return &Deployer{
 		clientSet:       clientSet,
-		logger:          log.WithName("deployer").WithName("tf"),
-		runConfigurator: runconfig.NewConfigurator(types.DeployerTypeTF),
+		logger:          log.WithName("deployer").WithName(strings.ToLower(jobOpts.Type)),
+		runConfigurator: rc,
+		jobOptions:      jobOpts,
 	}, nil
 }
 
 func (d Deployer) Type() deptypes.Type {
-	return types.DeployerTypeTF
+	return d.jobOptions.Type
 }
 
 // Apply creates a new resource run by the given resource,
@@ -64,7 +115,7 @@ func (d Deployer) Apply(
 		return
 	}
 
-	err = d.createK8sJob(ctx, mc, createK8sJobOptions{
+	err = d.createJob(ctx, mc, createJobOptions{
 		Type:        types.RunTaskTypeApply,
 		ResourceRun: run,
 	})
@@ -93,7 +144,7 @@ func (d Deployer) Plan(
 		return
 	}
 
-	err = d.createK8sJob(ctx, mc, createK8sJobOptions{
+	err = d.createJob(ctx, mc, createJobOptions{
 		Type:        types.RunTaskTypePlan,
 		ResourceRun: run,
 	})
@@ -124,7 +175,7 @@ func (d Deployer) Destroy(
 		return
 	}
 
-	err = d.createK8sJob(ctx, mc, createK8sJobOptions{
+	err = d.createJob(ctx, mc, createJobOptions{
 		Type:        types.RunTaskTypeDestroy,
 		ResourceRun: run,
 	})
@@ -148,32 +199,61 @@ func (d Deployer) errorHandle(mc model.ClientSet, run *model.ResourceRun, err er
 	}
 }
 
-type createK8sJobOptions struct {
+type createJobOptions struct {
 	// Type indicates the type of the job.
 	Type types.RunJobType
 	// ResourceRun indicates the resource run to create the deployment job.
 	ResourceRun *model.ResourceRun
 }
 
-// createK8sJob creates a k8s job to deploy, destroy or rollback the resource.
-func (d Deployer) createK8sJob(ctx context.Context, mc model.ClientSet, opts createK8sJobOptions) error {
+// createJob creates a job to deploy, destroy or rollback the resource.
+func (d Deployer) createJob(ctx context.Context, mc model.ClientSet, opts createJobOptions) error {
+	executor := GetJobExecutor(d.clientSet)
+	secretMountPath := executor.SecretMountPath(opts.ResourceRun)
+
 	// Prepare tfConfig for deployment.
-	secretOpts, err := runconfig.GetConfigOptions(ctx, mc, opts.ResourceRun, _secretMountPath)
+	secretOpts, err := runconfig.GetConfigOptions(ctx, mc, opts.ResourceRun, secretMountPath)
 	if err != nil {
 		return err
 	}
 
-	if err = d.createK8sSecrets(ctx, mc, secretOpts); err != nil {
+	files, err := d.loadConfigFiles(ctx, mc, secretOpts)
+	if err != nil {
 		return err
 	}
 
-	jobImage, err := settings.DeployerImage.Value(ctx, mc)
+	spec, err := runconfig.GetDeployerSpec(ctx, mc, opts.ResourceRun.EnvironmentID, opts.ResourceRun.TemplateID)
 	if err != nil {
 		return err
 	}
 
+	jobImage := spec.Image
+	if jobImage == "" {
+		jobImage, err = d.jobOptions.Image.Value(ctx, mc)
+		if err != nil {
+			return err
+		}
+	}
+
+	jobBinary := spec.Binary
+	if jobBinary == "" {
+		jobBinary = d.jobOptions.Binary
+	}
+
 	jobEnv := d.getEnv(ctx, mc, opts)
 
+	// Mount the credentials to access the private template repositories or registries.
+	credFiles, credEnv, err := getTemplateCredentials(ctx, mc, opts.ResourceRun, secretMountPath)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range credFiles {
+		files[k] = v
+	}
+
+	jobEnv = append(jobEnv, credEnv...)
+
 	localEnvironmentMode, err := settings.LocalEnvironmentMode.Value(ctx, mc)
 	if err != nil {
 		return err
@@ -181,19 +261,25 @@ func (d Deployer) createK8sJob(ctx context.Context, mc model.ClientSet, opts cre
 
 	// Create a deployment job.
 	jobOpts := JobCreateOptions{
-		Type:        opts.Type,
-		Image:       jobImage,
-		Env:         jobEnv,
-		DockerMode:  localEnvironmentMode == "docker",
-		ResourceRun: opts.ResourceRun,
-		ServerURL:   secretOpts.SeverULR,
-		Token:       secretOpts.Token,
+		Type:            opts.Type,
+		Image:           jobImage,
+		Binary:          jobBinary,
+		Env:             jobEnv,
+		DockerMode:      localEnvironmentMode == "docker",
+		ResourceRun:     opts.ResourceRun,
+		ServerURL:       secretOpts.SeverULR,
+		Token:           secretOpts.Token,
+		SecretMountPath: secretMountPath,
+	}
+
+	if d.jobOptions.Command != nil {
+		jobOpts.Command = d.jobOptions.Command(jobOpts)
 	}
 
-	return CreateJob(ctx, d.clientSet, jobOpts)
+	return executor.Execute(ctx, jobOpts, files)
 }
 
-func (d Deployer) getEnv(ctx context.Context, mc model.ClientSet, opts createK8sJobOptions) (env []corev1.EnvVar) {
+func (d Deployer) getEnv(ctx context.Context, mc model.ClientSet, opts createJobOptions) (env []corev1.EnvVar) {
 	env = append(env, corev1.EnvVar{
 		Name: "ACCESS_TOKEN",
 		ValueFrom: &corev1.EnvVarSource{
@@ -256,16 +342,18 @@ func (d Deployer) getEnv(ctx context.Context, mc model.ClientSet, opts createK8s
 	return env
 }
 
-// createK8sSecrets creates the k8s secrets for deployment.
-func (d Deployer) createK8sSecrets(ctx context.Context, mc model.ClientSet, opts *runconfig.Options) error {
+// loadConfigFiles loads the config files for deployment.
+func (d Deployer) loadConfigFiles(
+	ctx context.Context,
+	mc model.ClientSet,
+	opts *runconfig.Options,
+) (map[string][]byte, error) {
 	secretData := make(map[string][]byte)
-	// SecretName terraform tfConfig name.
-	secretName := _jobSecretPrefix + string(opts.ResourceRun.ID)
 
 	// Prepare terraform config files bytes for deployment.
 	inputConfigs, err := d.runConfigurator.LoadAll(ctx, mc, opts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for k, v := range inputConfigs {
@@ -275,7 +363,7 @@ func (d Deployer) createK8sSecrets(ctx context.Context, mc model.ClientSet, opts
 	// Mount the provider configs(e.g. kubeconfig) to secret.
 	providerConfigs, err := d.runConfigurator.LoadProviders(opts.Connectors)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for k, v := range providerConfigs {
@@ -285,10 +373,148 @@ func (d Deployer) createK8sSecrets(ctx context.Context, mc model.ClientSet, opts
 	// Mount deploy access token to secret.
 	secretData[_accessTokenkey] = []byte(opts.Token)
 
-	// Create deployment secret.
-	if err = CreateSecret(ctx, d.clientSet, secretName, secretData); err != nil {
-		return err
+	return secretData, nil
+}
+
+const (
+	// _gitConfigKey is the key of the Git configuration in the job secret.
+	_gitConfigKey = "gitconfig"
+	// _gitSSHKeyKey is the key of the Git SSH private key in the job secret.
+	_gitSSHKeyKey = "git-ssh-key"
+	// _registryTokenKey is the key of the module registry token in the job secret.
+	_registryTokenKey = "registry-token"
+	// _ociAuthKey is the key of the Docker style credential configuration in the job secret,
+	// which is named as config.json to be discovered under the DOCKER_CONFIG directory.
+	_ociAuthKey = "config.json"
+)
+
+// getTemplateCredentials returns the secret files and the environment variables of the job,
+// which allow the job to fetch the template from the private repositories or registries.
+func getTemplateCredentials(
+	ctx context.Context,
+	mc model.ClientSet,
+	run *model.ResourceRun,
+	secretMountPath string,
+) (map[string][]byte, []corev1.EnvVar, error) {
+	t, err := mc.Templates().Query().
+		Where(template.ID(run.TemplateID)).
+		Select(
+			template.FieldID,
+			template.FieldSource,
+			template.FieldCredential,
+			template.FieldCatalogID).
+		WithCatalog().
+		Only(ctx)
+	if err != nil {
+		if model.IsNotFound(err) {
+			return nil, nil, nil
+		}
+
+		return nil, nil, fmt.Errorf("error getting template: %w", err)
+	}
+
+	if src, err := registry.ParseSource(t.Source); err == nil {
+		return getRegistryCredentials(run, src, templates.GetTemplateRegistryAuth(t), secretMountPath)
+	}
+
+	return getGitCredentials(t.Edges.Catalog, secretMountPath)
+}
+
+// getGitCredentials returns the secret files and the environment variables of the job,
+// which allow the job to clone the template repositories with the credentials of the catalog.
+func getGitCredentials(c *model.Catalog, secretMountPath string) (map[string][]byte, []corev1.EnvVar, error) {
+	auth := templates.GetCatalogGitAuth(c)
+	if auth.IsEmpty() {
+		return nil, nil, nil
+	}
+
+	sshKeyPath := path.Join(secretMountPath, _gitSSHKeyKey)
+	files := map[string][]byte{
+		_gitConfigKey: []byte(auth.GitConfig(append([]string{c.Source}, c.Repositories...), sshKeyPath)),
+	}
+
+	if auth.SSHKey != "" {
+		files[_gitSSHKeyKey] = []byte(auth.SSHKey)
+	}
+
+	env := []corev1.EnvVar{
+		{
+			Name:  "GIT_CONFIG_GLOBAL",
+			Value: path.Join(secretMountPath, _gitConfigKey),
+		},
+	}
+
+	return files, env, nil
+}
+
+// getRegistryCredentials returns the secret files and the environment variables of the job,
+// which allow the job to fetch the template from the private registry,
+// the module registry token is passed by the TF_TOKEN_<host> variable,
+// and the OCI registry credential is passed by the Docker style configuration.
+func getRegistryCredentials(
+	run *model.ResourceRun,
+	src *registry.Source,
+	auth *registry.Auth,
+	secretMountPath string,
+) (map[string][]byte, []corev1.EnvVar, error) {
+	if auth.IsEmpty() {
+		return nil, nil, nil
 	}
 
-	return nil
+	if src.Type == registry.SourceTypeModule {
+		files := map[string][]byte{
+			_registryTokenKey: []byte(auth.Token),
+		}
+
+		env := []corev1.EnvVar{
+			{
+				Name: "TF_TOKEN_" + registryTokenHost(src.Host),
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: _jobSecretPrefix + string(run.ID),
+						},
+						Key: _registryTokenKey,
+					},
+				},
+			},
+		}
+
+		return files, env, nil
+	}
+
+	cfg, err := json.Marshal(map[string]any{
+		"auths": map[string]any{
+			src.Host: map[string]string{
+				"auth": base64.StdEncoding.EncodeToString([]byte(auth.Username + ":" + auth.Token)),
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := map[string][]byte{
+		_ociAuthKey: cfg,
+	}
+
+	env := []corev1.EnvVar{
+		{
+			Name:  "DOCKER_CONFIG",
+			Value: secretMountPath,
+		},
+		{
+			Name:  "REGISTRY_AUTH_FILE",
+			Value: path.Join(secretMountPath, _ociAuthKey),
+		},
+	}
+
+	return files, env, nil
+}
+
+// registryTokenHost returns the host in the form of the TF_TOKEN_<host> variable name,
+// which replaces the periods with underscores and the hyphens with double underscores.
+func registryTokenHost(host string) string {
+	host = strings.ReplaceAll(host, "-", "__")
+	return strings.ReplaceAll(host, ".", "_")
 }
@@ -0,0 +1,98 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/pointer"
+)
+
+// JobExecutor executes the deployment jobs of the resource runs.
+type JobExecutor interface {
+	// SecretMountPath returns the path to place the config files of the given run,
+	// the path is referred by the config files, so it must be determined before loading configs.
+	SecretMountPath(*model.ResourceRun) string
+
+	// Execute starts the job with the given options and config files,
+	// the job result is synced to the run once the job exits.
+	Execute(context.Context, JobCreateOptions, map[string][]byte) error
+
+	// StreamLogs streams the logs of the job.
+	StreamLogs(context.Context, StreamJobLogsOptions) error
+
+	// Cancel cancels the running jobs of the given run.
+	Cancel(context.Context, *model.ResourceRun) error
+}
+
+// errJobNotFound indicates the job is not found in the executor.
+var errJobNotFound = errors.New("job not found")
+
+// GetJobExecutor returns the JobExecutor to run the jobs,
+// returns the local executor if configured, otherwise, the Kubernetes executor.
+func GetJobExecutor(clientSet *kubernetes.Clientset) JobExecutor {
+	if le := localExecutor.Load(); le != nil {
+		return le
+	}
+
+	return KubernetesExecutor{clientSet: clientSet}
+}
+
+// StreamJobLogs streams the logs of a job,
+// the job is looked up from the local executor first if configured.
+func StreamJobLogs(ctx context.Context, opts StreamJobLogsOptions) error {
+	if le := localExecutor.Load(); le != nil {
+		err := le.StreamLogs(ctx, opts)
+		if !errors.Is(err, errJobNotFound) {
+			return err
+		}
+	}
+
+	return streamK8sJobLogs(ctx, opts)
+}
+
+// KubernetesExecutor runs the jobs as Kubernetes Jobs,
+// the job result is synced by the job reconciler.
+type KubernetesExecutor struct {
+	clientSet *kubernetes.Clientset
+}
+
+func (KubernetesExecutor) SecretMountPath(*model.ResourceRun) string {
+	return _secretMountPath
+}
+
+func (e KubernetesExecutor) Execute(ctx context.Context, opts JobCreateOptions, files map[string][]byte) error {
+	secretName := _jobSecretPrefix + string(opts.ResourceRun.ID)
+
+	// Create deployment secret.
+	if err := CreateSecret(ctx, e.clientSet, secretName, files); err != nil {
+		return err
+	}
+
+	return CreateJob(ctx, e.clientSet, opts)
+}
+
+func (KubernetesExecutor) StreamLogs(ctx context.Context, opts StreamJobLogsOptions) error {
+	return streamK8sJobLogs(ctx, opts)
+}
+
+func (e KubernetesExecutor) Cancel(ctx context.Context, run *model.ResourceRun) error {
+	for _, jt := range []types.RunJobType{types.RunTaskTypePlan, types.RunTaskTypeApply, types.RunTaskTypeDestroy} {
+		name := getK8sJobName(_jobNameFormat, jt.String(), run.ID.String())
+
+		err := e.clientSet.BatchV1().Jobs(types.WalrusSystemNamespace).
+			Delete(ctx, name, metav1.DeleteOptions{
+				PropagationPolicy: pointer.Ref(metav1.DeletePropagationBackground),
+			})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,350 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/resourceruns/job/result"
+	"github.com/seal-io/walrus/pkg/resourcestate"
+	"github.com/seal-io/walrus/pkg/storage"
+	"github.com/seal-io/walrus/utils/gopool"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+const (
+	// _localSecretsDir the directory to place the config files of the run in the local sandbox.
+	_localSecretsDir = "secrets"
+	// _localWorkspaceDir the working directory of the job in the local sandbox.
+	_localWorkspaceDir = "workspace"
+	// _localLogFile the file to record the logs of the job in the local sandbox.
+	_localLogFile = "job.log"
+)
+
+// localExecutor holds the configured LocalExecutor.
+var localExecutor atomic.Pointer[LocalExecutor]
+
+// LocalExecutorOptions holds the options for creating LocalExecutor.
+type LocalExecutorOptions struct {
+	ModelClient    model.ClientSet
+	StorageManager *storage.Manager
+	// Workdir is the directory to place the sandboxes of the jobs.
+	Workdir string
+	// Workers is the maximum number of the jobs running at the same time.
+	Workers int
+}
+
+// SetupLocalExecutor configures the LocalExecutor to run the jobs as local processes,
+// the running jobs are canceled once the given context is done.
+func SetupLocalExecutor(ctx context.Context, opts LocalExecutorOptions) error {
+	if opts.ModelClient == nil {
+		return errors.New("invalid local executor options: nil model client")
+	}
+
+	if opts.Workers <= 0 {
+		return errors.New("invalid local executor options: non-positive workers")
+	}
+
+	workdir, err := filepath.Abs(opts.Workdir)
+	if err != nil {
+		return fmt.Errorf("invalid local executor options: %w", err)
+	}
+
+	if err = os.MkdirAll(workdir, 0o700); err != nil {
+		return fmt.Errorf("error creating local executor workdir: %w", err)
+	}
+
+	localExecutor.Store(&LocalExecutor{
+		ctx:     ctx,
+		logger:  log.WithName("deployer").WithName("local"),
+		mc:      opts.ModelClient,
+		sm:      opts.StorageManager,
+		workdir: workdir,
+		workers: make(chan struct{}, opts.Workers),
+		jobs:    map[string]*localJob{},
+	})
+
+	return nil
+}
+
+// LocalExecutor runs the jobs as local processes inside the sandbox directories,
+// the jobs are limited by a bounded worker pool,
+// and the job result is synced in the same way as the job reconciler.
+type LocalExecutor struct {
+	ctx     context.Context
+	logger  log.Logger
+	mc      model.ClientSet
+	sm      *storage.Manager
+	workdir string
+	workers chan struct{}
+
+	mu   sync.Mutex
+	jobs map[string]*localJob
+}
+
+type localJob struct {
+	runID    object.ID
+	taskType string
+	dir      string
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+func (e *LocalExecutor) SecretMountPath(run *model.ResourceRun) string {
+	return filepath.Join(e.workdir, run.ID.String(), _localSecretsDir)
+}
+
+func (e *LocalExecutor) Execute(_ context.Context, opts JobCreateOptions, files map[string][]byte) error {
+	name := getK8sJobName(_jobNameFormat, opts.Type.String(), opts.ResourceRun.ID.String())
+
+	e.mu.Lock()
+	if _, exist := e.jobs[name]; exist {
+		e.mu.Unlock()
+		e.logger.Warnf("local job %s already exists", name)
+
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(e.ctx)
+	job := &localJob{
+		runID:    opts.ResourceRun.ID,
+		taskType: opts.Type.String(),
+		dir:      filepath.Join(e.workdir, opts.ResourceRun.ID.String(), opts.Type.String()),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	e.jobs[name] = job
+	e.mu.Unlock()
+
+	err := e.prepare(job, opts, files)
+	if err != nil {
+		e.finish(name, job)
+		return err
+	}
+
+	gopool.Go(func() {
+		var (
+			succeeded bool
+			record    string
+		)
+
+		// Wait for an idle worker.
+		select {
+		case <-ctx.Done():
+			record = "canceled before running"
+		case e.workers <- struct{}{}:
+			succeeded, record = e.run(ctx, job, opts)
+			<-e.workers
+		}
+
+		// Clean the sandbox before syncing,
+		// as the syncing may trigger the next job of the run.
+		e.finish(name, job)
+		e.sync(job, succeeded, record)
+	})
+
+	e.logger.Debugf("local job %s created", name)
+
+	return nil
+}
+
+// prepare prepares the sandbox of the given job.
+func (e *LocalExecutor) prepare(job *localJob, opts JobCreateOptions, files map[string][]byte) error {
+	// Recreate the sandbox to clean the leftovers of the previous job.
+	if err := os.RemoveAll(job.dir); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Join(job.dir, _localWorkspaceDir), 0o700); err != nil {
+		return err
+	}
+
+	secretDir := opts.SecretMountPath
+	if secretDir == "" {
+		secretDir = e.SecretMountPath(opts.ResourceRun)
+	}
+
+	if err := os.MkdirAll(secretDir, 0o700); err != nil {
+		return err
+	}
+
+	for n, bs := range files {
+		if err := os.WriteFile(filepath.Join(secretDir, filepath.Base(n)), bs, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// run runs the job process, returns whether the job succeeded and the logs of the job.
+func (e *LocalExecutor) run(ctx context.Context, job *localJob, opts JobCreateOptions) (bool, string) {
+	logPath := filepath.Join(job.dir, _localLogFile)
+
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return false, fmt.Sprintf("error creating log file: %v", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "/bin/sh", "-c", getCommand(opts))
+	cmd.Dir = filepath.Join(job.dir, _localWorkspaceDir)
+	cmd.Env = e.getEnv(job, opts)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	// Wait a while for the process to exit after killing.
+	cmd.WaitDelay = 10 * time.Second
+
+	err = cmd.Run()
+	if err != nil {
+		_, _ = fmt.Fprintf(logFile, "\n%v\n", err)
+	}
+
+	_ = logFile.Close()
+
+	record, rerr := os.ReadFile(logPath)
+	if rerr != nil {
+		return false, rerr.Error()
+	}
+
+	return err == nil, string(record)
+}
+
+// sync syncs the result of the given job to the run,
+// and releases the state locks left by the job.
+func (e *LocalExecutor) sync(job *localJob, succeeded bool, record string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := result.Sync(ctx, e.mc, e.sm, result.Result{
+		RunID:     job.runID,
+		TaskType:  job.taskType,
+		Succeeded: succeeded,
+		Record:    record,
+	})
+	if err != nil && !model.IsNotFound(err) {
+		e.logger.Errorf("error syncing the result of run %s: %v", job.runID, err)
+	}
+
+	err = resourcestate.ReleaseRunLocks(ctx, e.mc, job.runID)
+	if err != nil {
+		e.logger.Errorf("error releasing the state locks of run %s: %v", job.runID, err)
+	}
+}
+
+// finish cleans the sandbox of the given job,
+// the streaming logs are still readable as the log file is opened before removing.
+func (e *LocalExecutor) finish(name string, job *localJob) {
+	job.cancel()
+	close(job.done)
+
+	e.mu.Lock()
+	delete(e.jobs, name)
+	e.mu.Unlock()
+
+	// The config files contain the credentials, remove them along with the sandbox.
+	if err := os.RemoveAll(filepath.Dir(job.dir)); err != nil {
+		e.logger.Warnf("error removing the sandbox of local job %s: %v", name, err)
+	}
+}
+
+// getEnv returns the environment variables of the job process,
+// the process does not inherit the environment of the server except PATH.
+func (e *LocalExecutor) getEnv(job *localJob, opts JobCreateOptions) []string {
+	env := []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + job.dir,
+		"TF_IN_AUTOMATION=true",
+	}
+
+	for _, ev := range opts.Env {
+		switch {
+		case ev.Value != "":
+			env = append(env, ev.Name+"="+ev.Value)
+		case ev.ValueFrom != nil && ev.ValueFrom.SecretKeyRef != nil &&
+			ev.ValueFrom.SecretKeyRef.Key == _accessTokenkey:
+			env = append(env, ev.Name+"="+opts.Token)
+		}
+	}
+
+	return env
+}
+
+func (e *LocalExecutor) StreamLogs(ctx context.Context, opts StreamJobLogsOptions) error {
+	name := getK8sJobName(_jobNameFormat, opts.JobType, opts.RunID.String())
+
+	e.mu.Lock()
+	job, exist := e.jobs[name]
+	e.mu.Unlock()
+
+	if !exist {
+		return errJobNotFound
+	}
+
+	var (
+		logPath = filepath.Join(job.dir, _localLogFile)
+		f       *os.File
+		err     error
+	)
+
+	// Wait for the job to start.
+	for f == nil {
+		f, err = os.Open(logPath)
+		if err == nil {
+			break
+		}
+
+		if !os.IsNotExist(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-job.done:
+			return nil
+		case <-time.After(time.Second):
+		}
+	}
+
+	defer f.Close()
+
+	// Follow the logs until the job exits.
+	for {
+		if _, err = io.Copy(opts.Out, f); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-job.done:
+			_, err = io.Copy(opts.Out, f)
+			return err
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+func (e *LocalExecutor) Cancel(_ context.Context, run *model.ResourceRun) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, jt := range []types.RunJobType{types.RunTaskTypePlan, types.RunTaskTypeApply, types.RunTaskTypeDestroy} {
+		name := getK8sJobName(_jobNameFormat, jt.String(), run.ID.String())
+		if job, exist := e.jobs[name]; exist {
+			job.cancel()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+func TestLocalExecutorPrepare(t *testing.T) {
+	e := &LocalExecutor{
+		workdir: t.TempDir(),
+	}
+
+	run := &model.ResourceRun{ID: "1"}
+	job := &localJob{
+		runID:    run.ID,
+		taskType: types.RunTaskTypePlan.String(),
+		dir:      filepath.Join(e.workdir, run.ID.String(), types.RunTaskTypePlan.String()),
+	}
+
+	// Leftovers of the previous job should be removed.
+	require.NoError(t, os.MkdirAll(job.dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(job.dir, _localLogFile), []byte("stale"), 0o600))
+
+	opts := JobCreateOptions{
+		Type:        types.RunTaskTypePlan,
+		ResourceRun: run,
+	}
+	files := map[string][]byte{
+		"main.tf":             []byte(`resource "null_resource" "test" {}`),
+		"../terraform.tfvars": []byte(`foo = "bar"`),
+	}
+
+	require.NoError(t, e.prepare(job, opts, files))
+
+	assert.NoFileExists(t, filepath.Join(job.dir, _localLogFile))
+	assert.DirExists(t, filepath.Join(job.dir, _localWorkspaceDir))
+
+	secretDir := e.SecretMountPath(run)
+	assert.Equal(t, filepath.Join(e.workdir, "1", _localSecretsDir), secretDir)
+
+	for n, bs := range files {
+		// The file names must not escape the secret directory.
+		actual, err := os.ReadFile(filepath.Join(secretDir, filepath.Base(n)))
+		require.NoError(t, err)
+		assert.Equal(t, bs, actual)
+	}
+}
+
+func TestLocalExecutorGetEnv(t *testing.T) {
+	t.Setenv("PATH", "/usr/bin")
+	t.Setenv("WALRUS_SERVER_SECRET", "secret")
+
+	e := &LocalExecutor{}
+	job := &localJob{dir: "/tmp/job"}
+	opts := JobCreateOptions{
+		Token: "token",
+		Env: []corev1.EnvVar{
+			{
+				Name:  "TF_LOG",
+				Value: "DEBUG",
+			},
+			{
+				Name: "ACCESS_TOKEN",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						Key: _accessTokenkey,
+					},
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		"PATH=/usr/bin",
+		"HOME=/tmp/job",
+		"TF_IN_AUTOMATION=true",
+		"TF_LOG=DEBUG",
+		"ACCESS_TOKEN=token",
+	}
+
+	assert.Equal(t, expected, e.getEnv(job, opts))
+}
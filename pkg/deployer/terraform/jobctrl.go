@@ -31,9 +31,17 @@ type JobCreateOptions struct {
 	Env        []corev1.EnvVar
 	DockerMode bool
 
+	// Binary is the binary executed by the job, e.g. terraform, tofu.
+	Binary string
+	// Command is the shell command of the job,
+	// defaults to the terraform commands of the job type.
+	Command string
+
 	ResourceRun *model.ResourceRun
 	Token       string
 	ServerURL   string
+	// SecretMountPath is the path to place the config files of the job.
+	SecretMountPath string
 }
 
 type StreamJobLogsOptions struct {
@@ -137,21 +145,7 @@ func CreateSecret(ctx context.Context, clientSet *kubernetes.Clientset, name str
 
 // getPodTemplate returns a pod template for deployment.
 func getPodTemplate(configName string, opts JobCreateOptions) corev1.PodTemplateSpec {
-	var (
-		command       = []string{"/bin/sh", "-c"}
-		deployCommand = fmt.Sprintf("cp %s/main.tf main.tf && ", _secretMountPath)
-	)
-
-	switch opts.Type {
-	case types.RunTaskTypePlan:
-		deployCommand += getPlanCommands(opts.ResourceRun, opts)
-	case types.RunTaskTypeApply:
-		deployCommand += getApplyCommands(opts.ResourceRun, opts)
-	case types.RunTaskTypeDestroy:
-		deployCommand += getDestroyCommands(opts.ResourceRun, opts)
-	}
-
-	command = append(command, deployCommand)
+	command := []string{"/bin/sh", "-c", getCommand(opts)}
 
 	volumeMounts := []corev1.VolumeMount{
 		{
@@ -221,13 +215,42 @@ func getPodTemplate(configName string, opts JobCreateOptions) corev1.PodTemplate
 	}
 }
 
+// getCommand returns the shell command of the job.
+func getCommand(opts JobCreateOptions) string {
+	if opts.Command != "" {
+		return opts.Command
+	}
+
+	deployCommand := fmt.Sprintf("cp %s/main.tf main.tf && ", getSecretMountPath(opts))
+
+	switch opts.Type {
+	case types.RunTaskTypePlan:
+		deployCommand += getPlanCommands(opts.ResourceRun, opts)
+	case types.RunTaskTypeApply:
+		deployCommand += getApplyCommands(opts.ResourceRun, opts)
+	case types.RunTaskTypeDestroy:
+		deployCommand += getDestroyCommands(opts.ResourceRun, opts)
+	}
+
+	return deployCommand
+}
+
+// getSecretMountPath returns the path of the config files, defaults to the Kubernetes secret mount path.
+func getSecretMountPath(opts JobCreateOptions) string {
+	if opts.SecretMountPath == "" {
+		return _secretMountPath
+	}
+
+	return opts.SecretMountPath
+}
+
 // getK8sJobName returns the kubernetes job name for the given resource run id.
 func getK8sJobName(format, jobType, resourceRunID string) string {
 	return fmt.Sprintf(format, jobType, resourceRunID)
 }
 
-// StreamJobLogs streams the logs of a job.
-func StreamJobLogs(ctx context.Context, opts StreamJobLogsOptions) error {
+// streamK8sJobLogs streams the logs of a Kubernetes job.
+func streamK8sJobLogs(ctx context.Context, opts StreamJobLogsOptions) error {
 	var (
 		jobName       = getK8sJobName(_jobNameFormat, opts.JobType, opts.RunID.String())
 		labelSelector = "job-name=" + jobName
@@ -0,0 +1,167 @@
+package kms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	stdpath "path"
+	"path/filepath"
+
+	humanize "github.com/dustin/go-humanize"
+
+	"github.com/seal-io/walrus/utils/hash"
+)
+
+type FileOptions struct {
+	// Root indicates the root directory to place the data.
+	Root string
+	// RaiseNotFound indicates the function to raise not found error,
+	// uses default function if not set.
+	RaiseNotFound func(key string) error
+}
+
+// NewFile returns a FileDriver which stores the data as files under the root directory,
+// it is mainly used for testing or mounting the secrets from the local filesystem.
+func NewFile(opts FileOptions) (*FileDriver, error) {
+	if opts.Root == "" {
+		return nil, errors.New("root is required")
+	}
+
+	raiseNotFound := func(key string) error {
+		return fmt.Errorf("not found key %s", key)
+	}
+	if opts.RaiseNotFound != nil {
+		raiseNotFound = opts.RaiseNotFound
+	}
+
+	root, err := filepath.Abs(opts.Root)
+	if err != nil {
+		return nil, fmt.Errorf("error getting absolute path of root: %w", err)
+	}
+
+	return &FileDriver{
+		root:          root,
+		raiseNotFound: raiseNotFound,
+	}, nil
+}
+
+type FileDriver struct {
+	root          string
+	raiseNotFound func(key string) error
+}
+
+// path returns the filesystem path of the given key,
+// the normalized key is always under the root directory.
+func (d FileDriver) path(key string) string {
+	return filepath.Join(d.root, filepath.FromSlash(normalize(key)))
+}
+
+func (d FileDriver) Get(_ context.Context, key string) ([]byte, error) {
+	if key == "" {
+		return nil, d.raiseNotFound(key)
+	}
+
+	bs, err := os.ReadFile(d.path(key))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, d.raiseNotFound(normalize(key))
+		}
+
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+
+	return bs, nil
+}
+
+func (d FileDriver) Put(_ context.Context, key string, value []byte) error {
+	if key == "" || len(value) == 0 {
+		return nil
+	}
+
+	p := d.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return fmt.Errorf("error creating directory: %w", err)
+	}
+
+	if err := os.WriteFile(p, value, 0o600); err != nil {
+		return fmt.Errorf("error writing file: %w", err)
+	}
+
+	return nil
+}
+
+func (d FileDriver) Delete(_ context.Context, key string) error {
+	if key == "" {
+		return nil
+	}
+
+	err := os.Remove(d.path(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting file: %w", err)
+	}
+
+	return nil
+}
+
+func (d FileDriver) List(_ context.Context, path string) ([]KeyValue, error) {
+	path = normalize(path)
+
+	var kvs []KeyValue
+
+	err := filepath.WalkDir(d.path(path), func(p string, e fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if e.IsDir() {
+			return nil
+		}
+
+		bs, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		rp, err := filepath.Rel(d.root, p)
+		if err != nil {
+			return err
+		}
+
+		key := stdpath.Join("/", filepath.ToSlash(rp))
+
+		kvs = append(kvs, KeyValue{
+			Path:      stdpath.Dir(key),
+			Key:       stdpath.Base(key),
+			ValueHash: "sha224:" + hash.SumSHA224(bs),
+			ValueSize: humanize.Bytes(uint64(len(bs))),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing files: %w", err)
+	}
+
+	return kvs, nil
+}
+
+// IsConnected checks whether the root directory is accessible.
+func (d FileDriver) IsConnected(_ context.Context) error {
+	fi, err := os.Stat(d.root)
+	if err != nil {
+		return err
+	}
+
+	if !fi.IsDir() {
+		return fmt.Errorf("%s is not a directory", d.root)
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	stdpath "path"
+	"strings"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+
+	"github.com/seal-io/walrus/utils/hash"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+type VaultOptions struct {
+	// Address indicates the address of the Vault server, e.g. https://vault.example.com:8200.
+	Address string
+	// Token indicates the token to access the Vault server.
+	Token string
+	// Namespace indicates the Vault Enterprise namespace, optional.
+	Namespace string
+	// Mount indicates the mount path of the KV secrets engine(version 2),
+	// uses "secret" if not set.
+	Mount string
+	// InsecureSkipVerify indicates skipping the server certificate verification.
+	InsecureSkipVerify bool
+	// RaiseNotFound indicates the function to raise not found error,
+	// uses default function if not set.
+	RaiseNotFound func(key string) error
+}
+
+// NewVault returns a VaultDriver which accesses the secrets
+// stored in the HashiCorp Vault KV secrets engine(version 2).
+func NewVault(opts VaultOptions) (*VaultDriver, error) {
+	if opts.Address == "" {
+		return nil, errors.New("address is required")
+	}
+
+	if opts.Token == "" {
+		return nil, errors.New("token is required")
+	}
+
+	if opts.Mount == "" {
+		opts.Mount = "secret"
+	}
+
+	raiseNotFound := func(key string) error {
+		return fmt.Errorf("not found key %s", key)
+	}
+	if opts.RaiseNotFound != nil {
+		raiseNotFound = opts.RaiseNotFound
+	}
+
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.InsecureSkipVerify {
+		tr.TLSClientConfig = &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: true, // nolint:gosec
+		}
+	}
+
+	return &VaultDriver{
+		cli: &http.Client{
+			Transport: tr,
+			Timeout:   15 * time.Second,
+		},
+		address:       strings.TrimSuffix(opts.Address, "/"),
+		token:         opts.Token,
+		namespace:     opts.Namespace,
+		mount:         strings.Trim(opts.Mount, "/"),
+		raiseNotFound: raiseNotFound,
+	}, nil
+}
+
+type VaultDriver struct {
+	cli           *http.Client
+	address       string
+	token         string
+	namespace     string
+	mount         string
+	raiseNotFound func(key string) error
+}
+
+// Get returns the data of the secret in JSON object, e.g. {"username":"admin","password":"..."}.
+func (d VaultDriver) Get(ctx context.Context, key string) ([]byte, error) {
+	if key == "" {
+		return nil, d.raiseNotFound(key)
+	}
+
+	key = normalize(key)
+
+	var body struct {
+		Data struct {
+			Data json.RawMessage `json:"data"`
+		} `json:"data"`
+	}
+
+	found, err := d.do(ctx, http.MethodGet, d.mount+"/data"+key, nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	// The latest version has been deleted or destroyed.
+	if !found || len(body.Data.Data) == 0 || string(body.Data.Data) == "null" {
+		return nil, d.raiseNotFound(key)
+	}
+
+	return body.Data.Data, nil
+}
+
+// Put stores the given value as a new version of the secret,
+// the value is stored as the data of the secret if it is a JSON object,
+// otherwise, it is stored under the "value" field.
+func (d VaultDriver) Put(ctx context.Context, key string, value []byte) error {
+	if key == "" || len(value) == 0 {
+		return nil
+	}
+
+	key = normalize(key)
+
+	data := json.RawMessage(value)
+
+	var m map[string]any
+	if json.Unmarshal(value, &m) != nil {
+		bs, err := json.Marshal(map[string]string{"value": string(value)})
+		if err != nil {
+			return err
+		}
+
+		data = bs
+	}
+
+	_, err := d.do(ctx, http.MethodPost, d.mount+"/data"+key, map[string]any{"data": data}, nil)
+
+	return err
+}
+
+// Delete removes all versions and the metadata of the secret.
+func (d VaultDriver) Delete(ctx context.Context, key string) error {
+	if key == "" {
+		return nil
+	}
+
+	key = normalize(key)
+
+	_, err := d.do(ctx, http.MethodDelete, d.mount+"/metadata"+key, nil, nil)
+
+	return err
+}
+
+func (d VaultDriver) List(ctx context.Context, path string) ([]KeyValue, error) {
+	path = normalize(path)
+
+	var body struct {
+		Data struct {
+			Keys []string `json:"keys"`
+		} `json:"data"`
+	}
+
+	found, err := d.do(ctx, "LIST", d.mount+"/metadata"+path, nil, &body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return []KeyValue{}, nil
+	}
+
+	kvs := make([]KeyValue, 0, len(body.Data.Keys))
+
+	for _, k := range body.Data.Keys {
+		// Skip the sub-directories.
+		if strings.HasSuffix(k, "/") {
+			continue
+		}
+
+		v, err := d.Get(ctx, stdpath.Join(path, k))
+		if err != nil {
+			return nil, err
+		}
+
+		kvs = append(kvs, KeyValue{
+			Path:      path,
+			Key:       k,
+			ValueHash: "sha224:" + hash.SumSHA224(v),
+			ValueSize: humanize.Bytes(uint64(len(v))),
+		})
+	}
+
+	return kvs, nil
+}
+
+// IsConnected checks whether the Vault server is initialized and unsealed.
+func (d VaultDriver) IsConnected(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.address+"/v1/sys/health", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.cli.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusTooManyRequests, 473:
+		// Active, standby or performance standby.
+		return nil
+	case http.StatusNotImplemented:
+		return errors.New("vault is not initialized")
+	case http.StatusServiceUnavailable:
+		return errors.New("vault is sealed")
+	default:
+		return fmt.Errorf("unexpected vault health status %d", resp.StatusCode)
+	}
+}
+
+// do requests the given path of the Vault API,
+// returns false if the path is not found.
+func (d VaultDriver) do(ctx context.Context, method, path string, in, out any) (bool, error) {
+	var rb io.Reader
+
+	if in != nil {
+		bs, err := json.Marshal(in)
+		if err != nil {
+			return false, err
+		}
+
+		rb = bytes.NewReader(bs)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, d.address+"/v1/"+path, rb)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("X-Vault-Token", d.token)
+	req.Header.Set("X-Vault-Request", "true")
+
+	if d.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", d.namespace)
+	}
+
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := d.cli.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("error requesting vault: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return false, nil
+	case resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices:
+		var eb struct {
+			Errors []string `json:"errors"`
+		}
+
+		_ = json.NewDecoder(resp.Body).Decode(&eb)
+
+		return false, fmt.Errorf("unexpected vault response status %d: %s",
+			resp.StatusCode, strings.Join(eb.Errors, "; "))
+	}
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return true, nil
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, fmt.Errorf("error decoding vault response: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,189 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/seal-io/walrus/utils/json"
+	"github.com/seal-io/walrus/utils/vars"
+)
+
+// SecretStoresConfig holds the drivers of the external secret stores,
+// which are keyed by the scheme of the secret reference, e.g. vault.
+var SecretStoresConfig = vars.NewSetOnce(map[string]Driver{})
+
+// NewSecretStore returns the scheme and the Driver of the external secret store with the given address:
+//   - vault://[token@]host[:port][/mount][?namespace=ns&sslmode=disable&insecure=true],
+//     uses the VAULT_TOKEN environment variable if the token is not provided.
+//   - file:///path/to/dir.
+func NewSecretStore(address string) (string, Driver, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	switch u.Scheme {
+	case "vault":
+		q := u.Query()
+
+		scheme := "https"
+		if q.Get("sslmode") == "disable" {
+			scheme = "http"
+		}
+
+		token := u.User.Username()
+		if token == "" {
+			token = os.Getenv("VAULT_TOKEN")
+		}
+
+		insecure, _ := strconv.ParseBool(q.Get("insecure"))
+
+		drv, err := NewVault(VaultOptions{
+			Address:            scheme + "://" + u.Host,
+			Token:              token,
+			Namespace:          q.Get("namespace"),
+			Mount:              u.Path,
+			InsecureSkipVerify: insecure,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+
+		return u.Scheme, drv, nil
+	case "file":
+		drv, err := NewFile(FileOptions{
+			Root: u.Host + u.Path,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+
+		return u.Scheme, drv, nil
+	}
+
+	return "", nil, fmt.Errorf("unsupported secret store %q", u.Scheme)
+}
+
+// SecretRef represents a reference to the secret stored in the external secret store,
+// in form of <scheme>://<path>[#<key>], e.g. vault://db/prod#password.
+type SecretRef struct {
+	// Scheme is the scheme of the configured secret store.
+	Scheme string
+	// Path is the path of the secret in the secret store.
+	Path string
+	// Key is the field of the secret in JSON object, optional.
+	Key string
+}
+
+func (r SecretRef) String() string {
+	s := r.Scheme + "://" + strings.TrimPrefix(r.Path, "/")
+	if r.Key != "" {
+		s += "#" + r.Key
+	}
+
+	return s
+}
+
+var secretRefRegex = regexp.MustCompile(`^([a-z][a-z0-9+.-]*)://([^#\s]+)(?:#([^#\s]+))?$`)
+
+// ParseSecretRef parses the given string as a SecretRef,
+// returns false if the string is not a reference to any configured secret store.
+func ParseSecretRef(s string) (SecretRef, bool) {
+	ms := secretRefRegex.FindStringSubmatch(s)
+	if ms == nil {
+		return SecretRef{}, false
+	}
+
+	if _, ok := SecretStoresConfig.Get()[ms[1]]; !ok {
+		return SecretRef{}, false
+	}
+
+	return SecretRef{
+		Scheme: ms[1],
+		Path:   normalize(ms[2]),
+		Key:    ms[3],
+	}, true
+}
+
+// IsSecretRef returns true if the given string references to any configured secret store.
+func IsSecretRef(s string) bool {
+	_, ok := ParseSecretRef(s)
+	return ok
+}
+
+// ResolveSecretRef returns the secret value referenced by the given SecretRef,
+// picks the field of the secret if the SecretRef specifies a key.
+func ResolveSecretRef(ctx context.Context, ref SecretRef) (string, error) {
+	drv, ok := SecretStoresConfig.Get()[ref.Scheme]
+	if !ok {
+		return "", fmt.Errorf("secret store %q is not configured", ref.Scheme)
+	}
+
+	bs, err := drv.Get(ctx, ref.Path)
+	if err != nil {
+		return "", fmt.Errorf("error getting secret %s: %w", ref, err)
+	}
+
+	if ref.Key == "" {
+		return string(bs), nil
+	}
+
+	var m map[string]any
+	if err = json.Unmarshal(bs, &m); err != nil {
+		return "", fmt.Errorf("secret %s is not a JSON object: %w", ref, err)
+	}
+
+	v, ok := m[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("not found key %q in secret %s", ref.Key, ref)
+	}
+
+	switch vt := v.(type) {
+	case string:
+		return vt, nil
+	default:
+		return string(json.ShouldMarshal(vt)), nil
+	}
+}
+
+// Resolve returns the secret value if the given string references to any configured secret store,
+// otherwise, returns the given string.
+func Resolve(ctx context.Context, s string) (string, error) {
+	ref, ok := ParseSecretRef(s)
+	if !ok {
+		return s, nil
+	}
+
+	return ResolveSecretRef(ctx, ref)
+}
+
+// SecretStoreSchemes returns the sorted schemes of the configured secret stores.
+func SecretStoreSchemes() []string {
+	ss := make([]string, 0, len(SecretStoresConfig.Get()))
+	for s := range SecretStoresConfig.Get() {
+		ss = append(ss, s)
+	}
+
+	sort.Strings(ss)
+
+	return ss
+}
+
+// IsConnected checks whether the given Driver is connected,
+// returns nil if the Driver does not support checking.
+func IsConnected(ctx context.Context, drv Driver) error {
+	c, ok := drv.(interface {
+		IsConnected(ctx context.Context) error
+	})
+	if !ok {
+		return nil
+	}
+
+	return c.IsConnected(ctx)
+}
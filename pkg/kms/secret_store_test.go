@@ -0,0 +1,188 @@
+package kms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// newFakeVault returns a fake Vault server which serves the KV secrets engine(version 2) mounted at "secret".
+func newFakeVault(t *testing.T, token string) *httptest.Server {
+	var (
+		m       sync.Mutex
+		secrets = map[string]json.RawMessage{}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/sys/health" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+
+			return
+		}
+
+		m.Lock()
+		defer m.Unlock()
+
+		switch p := r.URL.Path; {
+		case strings.HasPrefix(p, "/v1/secret/data/"):
+			k := strings.TrimPrefix(p, "/v1/secret/data")
+
+			switch r.Method {
+			case http.MethodGet:
+				v, ok := secrets[k]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				_, _ = w.Write(json.ShouldMarshal(map[string]any{"data": map[string]any{"data": v}}))
+			case http.MethodPost:
+				var body struct {
+					Data json.RawMessage `json:"data"`
+				}
+
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				secrets[k] = body.Data
+
+				_, _ = w.Write([]byte(`{"data":{"version":1}}`))
+			}
+		case strings.HasPrefix(p, "/v1/secret/metadata/"):
+			k := strings.TrimPrefix(p, "/v1/secret/metadata")
+
+			switch r.Method {
+			case http.MethodDelete:
+				delete(secrets, k)
+				w.WriteHeader(http.StatusNoContent)
+			case "LIST":
+				var keys []string
+
+				for sk := range secrets {
+					if strings.HasPrefix(sk, k+"/") {
+						keys = append(keys, strings.TrimPrefix(sk, k+"/"))
+					}
+				}
+
+				if len(keys) == 0 {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				_, _ = w.Write(json.ShouldMarshal(map[string]any{"data": map[string]any{"keys": keys}}))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestVaultDriver(t *testing.T) {
+	ctx := context.Background()
+	srv := newFakeVault(t, "root")
+
+	drv, err := NewVault(VaultOptions{Address: srv.URL, Token: "root"})
+	require.NoError(t, err)
+
+	assert.NoError(t, drv.IsConnected(ctx))
+
+	_, err = drv.Get(ctx, "db/prod")
+	assert.Error(t, err, "not found")
+
+	require.NoError(t, drv.Put(ctx, "db/prod", []byte(`{"password":"p@ss"}`)))
+	require.NoError(t, drv.Put(ctx, "/db/plain", []byte(`plain`)))
+
+	v, err := drv.Get(ctx, "/db/prod")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"password":"p@ss"}`, string(v))
+
+	v, err = drv.Get(ctx, "db/plain")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value":"plain"}`, string(v))
+
+	kvs, err := drv.List(ctx, "db")
+	require.NoError(t, err)
+	assert.Len(t, kvs, 2)
+
+	require.NoError(t, drv.Delete(ctx, "db/prod"))
+
+	_, err = drv.Get(ctx, "db/prod")
+	assert.Error(t, err, "deleted")
+
+	// Wrong token.
+	drv, err = NewVault(VaultOptions{Address: srv.URL, Token: "wrong"})
+	require.NoError(t, err)
+
+	_, err = drv.Get(ctx, "db/plain")
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+	srv := newFakeVault(t, "root")
+
+	t.Setenv("VAULT_TOKEN", "root")
+
+	s, vd, err := NewSecretStore("vault://" + strings.TrimPrefix(srv.URL, "http://") + "?sslmode=disable")
+	require.NoError(t, err)
+	assert.Equal(t, "vault", s)
+
+	s, fd, err := NewSecretStore("file://" + t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, "file", s)
+
+	_, _, err = NewSecretStore("unknown://x")
+	assert.Error(t, err)
+
+	SecretStoresConfig.Set(map[string]Driver{"vault": vd, "file": fd})
+
+	require.NoError(t, vd.Put(ctx, "db/prod", []byte(`{"password":"p@ss","port":5432}`)))
+	require.NoError(t, fd.Put(ctx, "token", []byte(`t0ken`)))
+
+	testCases := []struct {
+		given    string
+		expected string
+		isErr    bool
+	}{
+		{given: "plain", expected: "plain"},
+		{given: "https://example.com#x", expected: "https://example.com#x"},
+		{given: "vault://db/prod#password", expected: "p@ss"},
+		{given: "vault://db/prod#port", expected: "5432"},
+		{given: "vault://db/prod", expected: `{"password":"p@ss","port":5432}`},
+		{given: "vault://db/prod#user", isErr: true},
+		{given: "vault://db/dev#password", isErr: true},
+		{given: "file://token", expected: "t0ken"},
+		{given: "file://token#key", isErr: true},
+	}
+
+	for _, tc := range testCases {
+		actual, err := Resolve(ctx, tc.given)
+		if tc.isErr {
+			assert.Error(t, err, tc.given)
+			continue
+		}
+
+		if assert.NoError(t, err, tc.given) {
+			assert.Equal(t, tc.expected, actual, tc.given)
+		}
+	}
+
+	assert.Equal(t, []string{"file", "vault"}, SecretStoreSchemes())
+	assert.NoError(t, IsConnected(ctx, vd))
+	assert.NoError(t, IsConnected(ctx, fd))
+}
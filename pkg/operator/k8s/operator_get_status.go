@@ -22,7 +22,7 @@ func (op Operator) GetStatus(ctx context.Context, res *model.ResourceComponent)
 		return kubestatus.StatusError(""), nil
 	}
 
-	if res.DeployerType != types.DeployerTypeTF {
+	if !types.IsHCLDeployerType(res.DeployerType) {
 		op.Logger.Warn("error resource stating: unknown deployer type: " + res.DeployerType)
 		return kubestatus.StatusError("unknown deployer type"), nil
 	}
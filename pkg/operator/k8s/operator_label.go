@@ -20,7 +20,7 @@ func (op Operator) Label(ctx context.Context, res *model.ResourceComponent, labe
 		return nil
 	}
 
-	if res.DeployerType != types.DeployerTypeTF {
+	if !types.IsHCLDeployerType(res.DeployerType) {
 		op.Logger.Warn("error resource label: unknown deployer type: " + res.DeployerType)
 		return nil
 	}
@@ -41,7 +41,7 @@ func parseResources(
 	res *model.ResourceComponent,
 	enforcer intercept.Enforcer,
 ) ([]resource, error) {
-	if res.DeployerType != types.DeployerTypeTF {
+	if !types.IsHCLDeployerType(res.DeployerType) {
 		return nil, resourceParsingError("unknown deployer type: " + res.DeployerType)
 	}
 
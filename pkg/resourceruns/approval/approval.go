@@ -0,0 +1,184 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunapprovalrule"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	"github.com/seal-io/walrus/utils/errorx"
+)
+
+// Check checks whether the given planned run is approved to apply,
+// returns true if the run is approved or does not require approvals.
+//
+// If the run requires approvals, the run is marked as pending approval,
+// and waits for the approvers to approve or reject.
+func Check(ctx context.Context, mc model.ClientSet, run *model.ResourceRun) (bool, error) {
+	switch {
+	case status.ResourceRunStatusApproved.IsTrue(run):
+		return true, nil
+	case status.ResourceRunStatusApproved.Exist(run):
+		// Pending approval or rejected.
+		return false, nil
+	}
+
+	reqs, err := getRequirements(ctx, mc, run)
+	if err != nil {
+		return false, err
+	}
+
+	if len(reqs) == 0 {
+		return true, nil
+	}
+
+	run.Approval = &types.ResourceRunApproval{
+		Requirements: reqs,
+	}
+	status.ResourceRunStatusApproved.Unknown(run, getPendingMessage(run.Approval))
+
+	return false, update(ctx, mc, run)
+}
+
+// Record records the approval of an approver to the given pending approval run,
+// the run is approved once all requirements are satisfied, or rejected by any qualified approver.
+func Record(ctx context.Context, mc model.ClientSet, run *model.ResourceRun, record types.ResourceRunApprovalRecord) error {
+	if !runstatus.IsStatusPendingApproval(run) || run.Approval == nil {
+		return errorx.Errorf("run is not pending approval: %s", run.Status.SummaryStatus)
+	}
+
+	if run.Approval.HasRecord(record.SubjectID) {
+		return errorx.Errorf("%s has already approved or rejected the run", record.SubjectName)
+	}
+
+	// The approver must be qualified for one of the unsatisfied requirements.
+	qualified := slices.ContainsFunc(run.Approval.Requirements, func(req types.ResourceRunApprovalRequirement) bool {
+		return run.Approval.Count(req) < req.Approvals && req.IsQualified(record.Roles)
+	})
+	if !qualified {
+		return errorx.Errorf("%s is not qualified to approve the run", record.SubjectName)
+	}
+
+	run.Approval.Records = append(run.Approval.Records, record)
+
+	switch {
+	case !record.Approved:
+		msg := "rejected by " + record.SubjectName
+		if record.Comment != "" {
+			msg += ": " + record.Comment
+		}
+
+		status.ResourceRunStatusApproved.False(run, msg)
+	case run.Approval.IsSatisfied():
+		status.ResourceRunStatusApproved.True(run, getApprovedMessage(run.Approval))
+	default:
+		status.ResourceRunStatusApproved.Unknown(run, getPendingMessage(run.Approval))
+	}
+
+	return update(ctx, mc, run)
+}
+
+// update persists the approval and the status of the given run.
+func update(ctx context.Context, mc model.ClientSet, run *model.ResourceRun) error {
+	run.Status.SetSummary(status.WalkResourceRun(&run.Status))
+
+	return mc.WithTx(ctx, func(tx *model.Tx) error {
+		err := tx.ResourceRuns().UpdateOne(run).
+			SetApproval(run.Approval).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = runstatus.UpdateStatus(ctx, tx, run)
+
+		return err
+	})
+}
+
+// getRequirements returns the requirements of the approval rules matched the given run.
+func getRequirements(
+	ctx context.Context,
+	mc model.ClientSet,
+	run *model.ResourceRun,
+) ([]types.ResourceRunApprovalRequirement, error) {
+	env, err := mc.Environments().Query().
+		Select(
+			environment.FieldID,
+			environment.FieldType).
+		Where(environment.ID(run.EnvironmentID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := mc.ResourceRunApprovalRules().Query().
+		Where(
+			resourcerunapprovalrule.Or(
+				resourcerunapprovalrule.ProjectIDIsNil(),
+				resourcerunapprovalrule.ProjectID(run.ProjectID)),
+			resourcerunapprovalrule.Or(
+				resourcerunapprovalrule.EnvironmentIDIsNil(),
+				resourcerunapprovalrule.EnvironmentID(run.EnvironmentID)),
+			resourcerunapprovalrule.Or(
+				resourcerunapprovalrule.EnvironmentTypeIsNil(),
+				resourcerunapprovalrule.EnvironmentTypeEQ(""),
+				resourcerunapprovalrule.EnvironmentTypeEQ(env.Type))).
+		Order(model.Asc(resourcerunapprovalrule.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reqs []types.ResourceRunApprovalRequirement
+
+	for _, r := range rules {
+		if !slices.Contains(r.RunTypes, run.Type) {
+			continue
+		}
+
+		reqs = append(reqs, types.ResourceRunApprovalRequirement{
+			RuleID:    r.ID.String(),
+			RuleName:  r.Name,
+			Approvals: r.Approvals,
+			Roles:     r.Roles,
+		})
+	}
+
+	return reqs, nil
+}
+
+func getPendingMessage(a *types.ResourceRunApproval) string {
+	var sb strings.Builder
+
+	sb.WriteString("Waiting for approvals of ")
+
+	for i, req := range a.Requirements {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString(fmt.Sprintf("%s (%d/%d)", req.RuleName, a.Count(req), req.Approvals))
+	}
+
+	return sb.String()
+}
+
+func getApprovedMessage(a *types.ResourceRunApproval) string {
+	names := make([]string, 0, len(a.Records))
+
+	for i := range a.Records {
+		if a.Records[i].Approved {
+			names = append(names, a.Records[i].SubjectName)
+		}
+	}
+
+	return "Approved by " + strings.Join(names, ", ")
+}
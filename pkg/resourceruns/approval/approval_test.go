@@ -0,0 +1,163 @@
+package approval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+const (
+	testDriverName     = "sqlite3"
+	testDataSourceName = "file:resourcerunapproval?mode=memory&cache=shared&_fk=1"
+)
+
+func TestApproval(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, testDriverName, testDataSourceName)
+	defer client.Close()
+
+	proj, err := client.Projects().Create().
+		SetName("approval").
+		Save(ctx)
+	require.NoError(t, err)
+
+	prod, err := client.Environments().Create().
+		SetProjectID(proj.ID).
+		SetName("prod").
+		SetType(types.EnvironmentProduction).
+		Save(ctx)
+	require.NoError(t, err)
+
+	dev, err := client.Environments().Create().
+		SetProjectID(proj.ID).
+		SetName("dev").
+		SetType(types.EnvironmentDevelopment).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Global rule for the production environments.
+	_, err = client.ResourceRunApprovalRules().Create().
+		SetName("production").
+		SetEnvironmentType(types.EnvironmentProduction).
+		SetRunTypes([]string{types.RunTypeUpdate.String(), types.RunTypeDelete.String()}).
+		SetApprovals(2).
+		SetRoles([]string{types.ProjectRoleOwner, types.ProjectRoleMember}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	newRun := func(env *model.Environment, runType types.RunType) *model.ResourceRun {
+		res, err := client.Resources().Create().
+			SetProjectID(proj.ID).
+			SetEnvironmentID(env.ID).
+			SetName(env.Name + "-" + runType.String()).
+			Save(ctx)
+		require.NoError(t, err)
+
+		entity := &model.ResourceRun{
+			ProjectID:       proj.ID,
+			EnvironmentID:   env.ID,
+			ResourceID:      res.ID,
+			TemplateID:      "1",
+			TemplateName:    "test",
+			TemplateVersion: "v0.0.1",
+			InputConfigs:    map[string]types.ResourceRunConfigData{},
+			CreatedBy:       "admin",
+			Type:            runType.String(),
+		}
+		status.ResourceRunStatusPending.True(entity, "")
+		status.ResourceRunStatusPlanned.True(entity, "")
+		entity.Status.SetSummary(status.WalkResourceRun(&entity.Status))
+
+		run, err := client.ResourceRuns().Create().
+			Set(entity).
+			Save(ctx)
+		require.NoError(t, err)
+
+		return run
+	}
+
+	newRecord := func(name string, approved bool, roles ...string) types.ResourceRunApprovalRecord {
+		return types.ResourceRunApprovalRecord{
+			SubjectID:   name,
+			SubjectName: name,
+			Roles:       roles,
+			Approved:    approved,
+			Time:        time.Now(),
+		}
+	}
+
+	// The run out of the rules does not require approvals.
+	approved, err := Check(ctx, client, newRun(dev, types.RunTypeUpdate))
+	require.NoError(t, err)
+	assert.True(t, approved)
+
+	approved, err = Check(ctx, client, newRun(prod, types.RunTypeCreate))
+	require.NoError(t, err)
+	assert.True(t, approved)
+
+	// The run matched the rules waits for approvals.
+	run := newRun(prod, types.RunTypeUpdate)
+
+	approved, err = Check(ctx, client, run)
+	require.NoError(t, err)
+	assert.False(t, approved)
+	assert.True(t, runstatus.IsStatusPendingApproval(run))
+	assert.Equal(t, status.ResourceRunSummaryStatusPendingApproval, run.Status.SummaryStatus)
+
+	err = Record(ctx, client, run, newRecord("viewer", true, types.ProjectRoleViewer))
+	assert.Error(t, err, "viewer is not qualified")
+
+	err = Record(ctx, client, run, newRecord("owner", true, types.ProjectRoleOwner))
+	require.NoError(t, err)
+	assert.True(t, runstatus.IsStatusPendingApproval(run))
+
+	err = Record(ctx, client, run, newRecord("owner", true, types.ProjectRoleOwner))
+	assert.Error(t, err, "owner has already approved")
+
+	err = Record(ctx, client, run, newRecord("member", true, types.ProjectRoleMember))
+	require.NoError(t, err)
+	assert.True(t, status.ResourceRunStatusApproved.IsTrue(run))
+	assert.True(t, runstatus.IsStatusPlanned(run))
+	assert.Equal(t, status.ResourceRunSummaryStatusPlanned, run.Status.SummaryStatus)
+
+	approved, err = Check(ctx, client, run)
+	require.NoError(t, err)
+	assert.True(t, approved)
+
+	stored, err := client.ResourceRuns().Get(ctx, run.ID)
+	require.NoError(t, err)
+
+	if assert.NotNil(t, stored.Approval) {
+		assert.Len(t, stored.Approval.Records, 2)
+	}
+
+	// The run is rejected by any qualified approver.
+	run = newRun(prod, types.RunTypeDelete)
+
+	_, err = Check(ctx, client, run)
+	require.NoError(t, err)
+
+	err = Record(ctx, client, run, newRecord("member", false, types.ProjectRoleMember))
+	require.NoError(t, err)
+	assert.True(t, runstatus.IsStatusFailed(run))
+	assert.False(t, runstatus.IsStatusPlanned(run))
+	assert.Equal(t, status.ResourceRunSummaryStatusRejected, run.Status.SummaryStatus)
+}
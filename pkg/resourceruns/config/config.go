@@ -57,8 +57,10 @@ type Options struct {
 // NewConfigurator creates a new configurator with the deployer type.
 func NewConfigurator(deployerType string) Configurator {
 	switch deployerType {
-	case types.DeployerTypeTF:
+	case types.DeployerTypeTF, types.DeployerTypeOpenTofu:
 		return NewTerraformConfigurator()
+	case types.DeployerTypePulumi:
+		return NewPulumiConfigurator()
 	default:
 		return nil
 	}
@@ -86,6 +88,11 @@ func GetConfigOptions(
 		return nil, err
 	}
 
+	connectors, err = resolveConnectors(ctx, connectors)
+	if err != nil {
+		return nil, err
+	}
+
 	proj, err := mc.Projects().Get(ctx, run.ProjectID)
 	if err != nil {
 		return nil, err
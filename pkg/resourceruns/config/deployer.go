@@ -0,0 +1,77 @@
+package config
+
+import (
+	"context"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/template"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// DeployerSpec holds the deployer choices of the run,
+// empty fields mean using the defaults of the deployer.
+type DeployerSpec struct {
+	// Type is the deployer type.
+	Type string
+	// Image is the image of the deployer job.
+	Image string
+	// Binary is the binary executed by the deployer job.
+	Binary string
+}
+
+// GetDeployerSpec returns the DeployerSpec of the given environment and template,
+// which are specified by the environment annotations or template labels,
+// the environment ones take precedence over the template ones.
+func GetDeployerSpec(ctx context.Context, mc model.ClientSet, envID, templateID object.ID) (DeployerSpec, error) {
+	var annos []map[string]string
+
+	if envID != "" {
+		env, err := mc.Environments().Query().
+			Where(environment.ID(envID)).
+			Select(environment.FieldAnnotations).
+			Only(ctx)
+		if err != nil && !model.IsNotFound(err) {
+			return DeployerSpec{}, err
+		}
+
+		if env != nil {
+			annos = append(annos, env.Annotations)
+		}
+	}
+
+	if templateID != "" {
+		tmpl, err := mc.Templates().Query().
+			Where(template.ID(templateID)).
+			Select(template.FieldLabels).
+			Only(ctx)
+		if err != nil && !model.IsNotFound(err) {
+			return DeployerSpec{}, err
+		}
+
+		if tmpl != nil {
+			annos = append(annos, tmpl.Labels)
+		}
+	}
+
+	return mergeDeployerSpec(annos...), nil
+}
+
+// mergeDeployerSpec picks the first non-blank deployer annotations from the given annotations.
+func mergeDeployerSpec(annos ...map[string]string) (spec DeployerSpec) {
+	pick := func(dst *string, key string) {
+		for i := range annos {
+			if v := annos[i][key]; v != "" {
+				*dst = v
+				return
+			}
+		}
+	}
+
+	pick(&spec.Type, types.AnnotationDeployerType)
+	pick(&spec.Image, types.AnnotationDeployerImage)
+	pick(&spec.Binary, types.AnnotationDeployerBinary)
+
+	return
+}
@@ -0,0 +1,301 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	runbus "github.com/seal-io/walrus/pkg/bus/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	opk8s "github.com/seal-io/walrus/pkg/operator/k8s"
+	"github.com/seal-io/walrus/pkg/terraform/util"
+	"github.com/seal-io/walrus/utils/json"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+const (
+	// PulumiProject is the project name of the generated Pulumi project,
+	// the configs of the stack are namespaced with it.
+	PulumiProject = "walrus"
+	// PulumiStack is the stack name of the generated Pulumi project.
+	PulumiStack = "walrus"
+	// PulumiProgramDir is the directory to place the Pulumi YAML program of the template.
+	PulumiProgramDir = "program"
+
+	// PulumiFileProject is the file name of the Pulumi project.
+	PulumiFileProject = "Pulumi.yaml"
+	// PulumiFileStack is the file name of the Pulumi stack settings.
+	PulumiFileStack = "Pulumi." + PulumiStack + ".yaml"
+	// PulumiFileProgramEnv is the file name of the shell environment to fetch the program.
+	PulumiFileProgramEnv = "program.env"
+)
+
+// _pulumiReferenceReg matches the variable and resource output references replaced by ParseModuleAttributes.
+var _pulumiReferenceReg = regexp.MustCompile(`\$\{var\.((?:` + _variablePrefix + `|` + _resourcePrefix + `)[^}]+)}`)
+
+// PulumiConfigurator constructs the Pulumi YAML project files for the run.
+//
+// The template of the run is treated as a Pulumi YAML program,
+// which is fetched into PulumiProgramDir by the deployer job,
+// the generated project points to the program and feeds the attributes as stack configs.
+type PulumiConfigurator struct {
+	logger log.Logger
+}
+
+func NewPulumiConfigurator() Configurator {
+	return &PulumiConfigurator{
+		logger: log.WithName("resource-run").WithName("pulumi"),
+	}
+}
+
+func (c *PulumiConfigurator) LoadMain(
+	ctx context.Context,
+	mc model.ClientSet,
+	opts *Options,
+) (types.ResourceRunConfigData, error) {
+	inputConfigs, err := c.LoadAll(ctx, mc, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return inputConfigs[PulumiFileProject], nil
+}
+
+func (c *PulumiConfigurator) LoadAll(
+	ctx context.Context,
+	mc model.ClientSet,
+	opts *Options,
+) (map[string]types.ResourceRunConfigData, error) {
+	tv, err := mc.TemplateVersions().Query().
+		Select(
+			templateversion.FieldID,
+			templateversion.FieldSource,
+			templateversion.FieldSchema).
+		Where(
+			templateversion.TemplateID(opts.ResourceRun.TemplateID),
+			templateversion.Version(opts.ResourceRun.TemplateVersion)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleConfig, err := getModuleConfig(opts.ResourceRun, tv, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, variables, dependencyOutputs, err := ParseModuleAttributes(
+		ctx,
+		mc,
+		moduleConfig.Attributes,
+		false,
+		RunOpts{
+			ResourceRun:   opts.ResourceRun,
+			ResourceName:  opts.Context.Resource.Name,
+			ProjectID:     opts.Context.Project.ID,
+			EnvironmentID: opts.Context.Environment.ID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the secret references of the variables for deploying,
+	// the saved resource run keeps the references.
+	resolvedVariables, resolved, err := resolveVariables(ctx, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pulumi has no variables like terraform,
+	// so inline the variables and resource outputs into the attributes.
+	outputRefs := make(map[string]any, len(dependencyOutputs))
+
+	for n, v := range dependencyOutputs {
+		var val any
+		if err = json.Unmarshal(v.Value, &val); err != nil {
+			return nil, fmt.Errorf("error decoding resource output %s: %w", n, err)
+		}
+
+		outputRefs[_resourcePrefix+n] = val
+	}
+
+	marshalStack := func(variables model.Variables) ([]byte, error) {
+		refs := make(map[string]any, len(variables)+len(outputRefs))
+
+		for _, v := range variables {
+			refs[_variablePrefix+v.Name] = string(v.Value)
+		}
+
+		for k, v := range outputRefs {
+			refs[k] = v
+		}
+
+		stackConfig := make(map[string]any, len(attrs))
+		for k, v := range attrs {
+			stackConfig[PulumiProject+":"+k] = inlinePulumiReferences(v, refs)
+		}
+
+		return json.Marshal(map[string]any{
+			"config": stackConfig,
+		})
+	}
+
+	repo, ref, subPath := ParsePulumiProgramSource(tv.Source)
+
+	project := map[string]any{
+		"name":    PulumiProject,
+		"runtime": "yaml",
+		"main":    path.Join(PulumiProgramDir, subPath) + "/",
+	}
+
+	inputConfigs := make(map[string]types.ResourceRunConfigData, 3)
+
+	// YAML is a superset of JSON, so it's fine to write the Pulumi files in JSON.
+	if inputConfigs[PulumiFileProject], err = json.Marshal(project); err != nil {
+		return nil, err
+	}
+
+	if inputConfigs[PulumiFileStack], err = marshalStack(variables); err != nil {
+		return nil, err
+	}
+
+	inputConfigs[PulumiFileProgramEnv] = []byte(fmt.Sprintf("PROGRAM_REPO=%s\nPROGRAM_REF=%s\n",
+		shellQuote(repo), shellQuote(ref)))
+
+	// Save input plan to resource run.
+	opts.ResourceRun.InputConfigs = inputConfigs
+
+	if len(opts.ResourceRun.Variables) == 0 {
+		variableMap := make(crypto.Map[string, string], len(variables))
+		for _, s := range variables {
+			variableMap[s.Name] = string(s.Value)
+		}
+		opts.ResourceRun.Variables = variableMap
+	}
+
+	run, err := mc.ResourceRuns().UpdateOne(opts.ResourceRun).
+		Set(opts.ResourceRun).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = runbus.Notify(ctx, mc, run); err != nil {
+		return nil, err
+	}
+
+	if !resolved {
+		return inputConfigs, nil
+	}
+
+	resolvedConfigs := make(map[string]types.ResourceRunConfigData, len(inputConfigs))
+	for k, v := range inputConfigs {
+		resolvedConfigs[k] = v
+	}
+
+	if resolvedConfigs[PulumiFileStack], err = marshalStack(resolvedVariables); err != nil {
+		return nil, err
+	}
+
+	return resolvedConfigs, nil
+}
+
+func (c *PulumiConfigurator) LoadProviders(
+	connectors model.Connectors,
+) (map[string]types.ResourceRunConfigData, error) {
+	providerConfigs := make(map[string]types.ResourceRunConfigData, len(connectors))
+
+	for _, c := range connectors {
+		if c.Type != types.ConnectorTypeKubernetes {
+			continue
+		}
+
+		_, s, err := opk8s.LoadApiConfig(*c)
+		if err != nil {
+			return nil, err
+		}
+
+		// The deployer job points KUBECONFIG to the first kubeconfig file.
+		providerConfigs[util.GetK8sSecretName(c.ID.String())] = []byte(s)
+	}
+
+	return providerConfigs, nil
+}
+
+// inlinePulumiReferences replaces the references inside the given value with the given reference values,
+// a string that is exactly one reference is replaced with the typed reference value.
+func inlinePulumiReferences(v any, refs map[string]any) any {
+	switch vt := v.(type) {
+	case string:
+		if m := _pulumiReferenceReg.FindStringSubmatch(vt); m != nil && m[0] == vt {
+			if rv, ok := refs[m[1]]; ok {
+				return rv
+			}
+
+			return vt
+		}
+
+		return _pulumiReferenceReg.ReplaceAllStringFunc(vt, func(s string) string {
+			m := _pulumiReferenceReg.FindStringSubmatch(s)
+
+			rv, ok := refs[m[1]]
+			if !ok {
+				return s
+			}
+
+			if rs, ok := rv.(string); ok {
+				return rs
+			}
+
+			bs, err := json.Marshal(rv)
+			if err != nil {
+				return s
+			}
+
+			return string(bs)
+		})
+	case map[string]any:
+		for k := range vt {
+			vt[k] = inlinePulumiReferences(vt[k], refs)
+		}
+	case []any:
+		for i := range vt {
+			vt[i] = inlinePulumiReferences(vt[i], refs)
+		}
+	}
+
+	return v
+}
+
+// ParsePulumiProgramSource parses the given template source,
+// e.g. git::https://github.com/foo/bar.git//sub?ref=v1.0.0,
+// into the repository, reference and sub path.
+func ParsePulumiProgramSource(source string) (repo, ref, subPath string) {
+	repo = strings.TrimPrefix(source, "git::")
+
+	if i := strings.Index(repo, "?ref="); i >= 0 {
+		repo, ref = repo[:i], repo[i+len("?ref="):]
+	}
+
+	start := 0
+	if i := strings.Index(repo, "://"); i >= 0 {
+		start = i + len("://")
+	}
+
+	if i := strings.Index(repo[start:], "//"); i >= 0 {
+		repo, subPath = repo[:start+i], repo[start+i+len("//"):]
+	}
+
+	return repo, ref, subPath
+}
+
+// shellQuote quotes the given string for POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
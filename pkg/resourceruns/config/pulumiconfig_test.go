@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePulumiProgramSource(t *testing.T) {
+	testCases := []struct {
+		name            string
+		source          string
+		expectedRepo    string
+		expectedRef     string
+		expectedSubPath string
+	}{
+		{
+			name:         "plain repository",
+			source:       "https://github.com/foo/bar",
+			expectedRepo: "https://github.com/foo/bar",
+		},
+		{
+			name:         "git repository with reference",
+			source:       "git::https://github.com/foo/bar.git?ref=v1.0.0",
+			expectedRepo: "https://github.com/foo/bar.git",
+			expectedRef:  "v1.0.0",
+		},
+		{
+			name:            "git repository with sub path and reference",
+			source:          "git::https://github.com/foo/bar.git//modules/baz?ref=main",
+			expectedRepo:    "https://github.com/foo/bar.git",
+			expectedRef:     "main",
+			expectedSubPath: "modules/baz",
+		},
+	}
+
+	for _, tc := range testCases {
+		repo, ref, subPath := ParsePulumiProgramSource(tc.source)
+		assert.Equal(t, tc.expectedRepo, repo, fmt.Sprintf("unexpected repo in test case: %s", tc.name))
+		assert.Equal(t, tc.expectedRef, ref, fmt.Sprintf("unexpected ref in test case: %s", tc.name))
+		assert.Equal(t, tc.expectedSubPath, subPath, fmt.Sprintf("unexpected sub path in test case: %s", tc.name))
+	}
+}
+
+func TestInlinePulumiReferences(t *testing.T) {
+	refs := map[string]any{
+		_variablePrefix + "image":   "nginx",
+		_resourcePrefix + "db_port": float64(3306),
+	}
+
+	testCases := []struct {
+		name     string
+		input    any
+		expected any
+	}{
+		{
+			name:     "exact variable reference",
+			input:    "${var." + _variablePrefix + "image}",
+			expected: "nginx",
+		},
+		{
+			name:     "exact resource output reference keeps the type",
+			input:    "${var." + _resourcePrefix + "db_port}",
+			expected: float64(3306),
+		},
+		{
+			name:     "embedded references",
+			input:    "${var." + _variablePrefix + "image}:${var." + _resourcePrefix + "db_port}",
+			expected: "nginx:3306",
+		},
+		{
+			name: "nested references",
+			input: map[string]any{
+				"ports": []any{"${var." + _resourcePrefix + "db_port}"},
+			},
+			expected: map[string]any{
+				"ports": []any{float64(3306)},
+			},
+		},
+		{
+			name:     "unknown reference",
+			input:    "${var." + _variablePrefix + "unknown}",
+			expected: "${var." + _variablePrefix + "unknown}",
+		},
+	}
+
+	for _, tc := range testCases {
+		actual := inlinePulumiReferences(tc.input, refs)
+		assert.Equal(t, tc.expected, actual, fmt.Sprintf("unexpected result in test case: %s", tc.name))
+	}
+}
+
+func TestMergeDeployerSpec(t *testing.T) {
+	env := map[string]string{
+		"walrus.seal.io/deployer-type": "OpenTofu",
+	}
+	tmpl := map[string]string{
+		"walrus.seal.io/deployer-type":  "Terraform",
+		"walrus.seal.io/deployer-image": "foo/tofu:v1",
+	}
+
+	actual := mergeDeployerSpec(env, tmpl)
+	assert.Equal(t, DeployerSpec{Type: "OpenTofu", Image: "foo/tofu:v1"}, actual)
+	assert.Equal(t, DeployerSpec{}, mergeDeployerSpec(nil, nil))
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/pkg/dao/types/property"
+	"github.com/seal-io/walrus/pkg/kms"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// resolveVariables returns a copy of the given variables with the secret references resolved,
+// and returns false if no variable references to the external secret store.
+//
+// The variables inherited from the cloned run lose the sensitive flag,
+// so the references are resolved regardless of the flag,
+// the variable API refuses to reference secret by a non-sensitive variable.
+func resolveVariables(ctx context.Context, variables model.Variables) (model.Variables, bool, error) {
+	var (
+		resolved = make(model.Variables, len(variables))
+		changed  bool
+	)
+
+	for i := range variables {
+		resolved[i] = variables[i]
+
+		ref, ok := kms.ParseSecretRef(string(variables[i].Value))
+		if !ok {
+			continue
+		}
+
+		v, err := kms.ResolveSecretRef(ctx, ref)
+		if err != nil {
+			return nil, false, fmt.Errorf("error resolving variable %s: %w", variables[i].Name, err)
+		}
+
+		vc := *variables[i]
+		vc.Value = crypto.String(v)
+		resolved[i] = &vc
+		changed = true
+	}
+
+	return resolved, changed, nil
+}
+
+// resolveConnectors returns a copy of the given connectors
+// with the secret references of the configuration data resolved.
+func resolveConnectors(ctx context.Context, connectors model.Connectors) (model.Connectors, error) {
+	resolved := make(model.Connectors, len(connectors))
+
+	for i := range connectors {
+		resolved[i] = connectors[i]
+
+		var cd crypto.Properties
+
+		for k, p := range connectors[i].ConfigData {
+			s, ok, _ := property.GetString(p.Value)
+			if !ok {
+				continue
+			}
+
+			ref, ok := kms.ParseSecretRef(s)
+			if !ok {
+				continue
+			}
+
+			v, err := kms.ResolveSecretRef(ctx, ref)
+			if err != nil {
+				return nil, fmt.Errorf("error resolving connector %s config %s: %w",
+					connectors[i].Name, k, err)
+			}
+
+			if cd == nil {
+				cd = make(crypto.Properties, len(connectors[i].ConfigData))
+				for k, p := range connectors[i].ConfigData {
+					cd[k] = p
+				}
+			}
+
+			cd[k] = crypto.Property{
+				Value:   json.ShouldMarshal(v),
+				Visible: p.Visible,
+			}
+		}
+
+		if cd != nil {
+			cc := *connectors[i]
+			cc.ConfigData = cd
+			resolved[i] = &cc
+		}
+	}
+
+	return resolved, nil
+}
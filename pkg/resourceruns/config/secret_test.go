@@ -0,0 +1,64 @@
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types/crypto"
+	"github.com/seal-io/walrus/pkg/kms"
+)
+
+func TestResolveSecretRefs(t *testing.T) {
+	ctx := context.Background()
+
+	drv, err := kms.NewFile(kms.FileOptions{Root: t.TempDir()})
+	require.NoError(t, err)
+	require.NoError(t, drv.Put(ctx, "db", []byte(`{"password":"p@ss"}`)))
+	require.NoError(t, drv.Put(ctx, "ak", []byte(`secret-key`)))
+
+	kms.SecretStoresConfig.Set(map[string]kms.Driver{"file": drv})
+
+	// Variables.
+	variables := model.Variables{
+		{Name: "plain", Value: crypto.String("plain")},
+		{Name: "password", Value: crypto.String("file://db#password"), Sensitive: true},
+	}
+
+	resolved, changed, err := resolveVariables(ctx, variables)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, crypto.String("plain"), resolved[0].Value)
+	assert.Equal(t, crypto.String("p@ss"), resolved[1].Value)
+	assert.True(t, resolved[1].Sensitive)
+	assert.Equal(t, crypto.String("file://db#password"), variables[1].Value, "keep reference")
+
+	_, changed, err = resolveVariables(ctx, variables[:1])
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, _, err = resolveVariables(ctx, model.Variables{
+		{Name: "missing", Value: crypto.String("file://missing")},
+	})
+	assert.Error(t, err)
+
+	// Connectors.
+	connectors := model.Connectors{
+		{
+			Name: "alibaba",
+			ConfigData: crypto.Properties{
+				"access_key": crypto.Property{Value: []byte(`"id"`), Visible: true},
+				"secret_key": crypto.Property{Value: []byte(`"file://ak"`)},
+			},
+		},
+	}
+
+	resolvedConnectors, err := resolveConnectors(ctx, connectors)
+	require.NoError(t, err)
+	assert.Equal(t, `"id"`, string(resolvedConnectors[0].ConfigData["access_key"].Value))
+	assert.Equal(t, `"secret-key"`, string(resolvedConnectors[0].ConfigData["secret_key"].Value))
+	assert.Equal(t, `"file://ak"`, string(connectors[0].ConfigData["secret_key"].Value), "keep reference")
+}
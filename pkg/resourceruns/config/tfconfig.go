@@ -16,6 +16,7 @@ import (
 	opk8s "github.com/seal-io/walrus/pkg/operator/k8s"
 	"github.com/seal-io/walrus/pkg/servervars"
 	"github.com/seal-io/walrus/pkg/templates/openapi"
+	"github.com/seal-io/walrus/pkg/templates/registry"
 	"github.com/seal-io/walrus/pkg/templates/translator"
 	"github.com/seal-io/walrus/pkg/terraform/config"
 	"github.com/seal-io/walrus/pkg/terraform/parser"
@@ -97,6 +98,13 @@ func (c *TerraformConfigurator) LoadAll(
 
 	moduleConfig.Attributes = attrs
 
+	// Resolve the secret references of the variables for deploying,
+	// the saved resource run keeps the references.
+	resolvedVariables, resolved, err := resolveVariables(ctx, variables)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update output sensitive with variables.
 	wrapVariables, err := updateOutputWithVariables(variables, moduleConfig)
 	if err != nil {
@@ -121,6 +129,8 @@ func (c *TerraformConfigurator) LoadAll(
 			TerraformOptions: &config.TerraformOptions{
 				Token:                opts.Token,
 				Address:              address,
+				LockAddress:          address + "/lock",
+				UnlockAddress:        address + "/unlock",
 				SkipTLSVerify:        !servervars.TlsCertified.Get(),
 				ProviderRequirements: requiredProviders,
 			},
@@ -175,7 +185,22 @@ func (c *TerraformConfigurator) LoadAll(
 		return nil, err
 	}
 
-	return inputConfigs, nil
+	if !resolved {
+		return inputConfigs, nil
+	}
+
+	resolvedConfigs := make(map[string]types.ResourceRunConfigData, len(inputConfigs))
+	for k, v := range inputConfigs {
+		resolvedConfigs[k] = v
+	}
+
+	resolvedConfigs[config.FileVars], err = config.CreateConfigToBytes(
+		getVarConfigOptions(resolvedVariables, dependencyOutputs))
+	if err != nil {
+		return nil, err
+	}
+
+	return resolvedConfigs, nil
 }
 
 // getModuleConfig returns module configs and required connectors to
@@ -234,6 +259,11 @@ func getModuleConfig(
 		Source: template.Source,
 	}
 
+	// The module registry source requires the version argument.
+	if registry.IsModuleSource(template.Source) {
+		mc.Version = template.Version
+	}
+
 	if template.Schema.IsEmpty() {
 		return mc, nil
 	}
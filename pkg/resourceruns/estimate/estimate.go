@@ -0,0 +1,238 @@
+package estimate
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/seal-io/walrus/pkg/costs/pricing"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// hoursPerMonth is the hours of a month used to convert the hourly price to the monthly cost.
+const hoursPerMonth = 730
+
+// Estimate estimates the monthly cost of the given run by its JSON plan,
+// the cloud resources are priced from the given pricing catalog,
+// and the Kubernetes workloads are priced by the custom pricing of the Kubernetes connector of the run environment.
+func Estimate(
+	ctx context.Context,
+	mc model.ClientSet,
+	catalog *pricing.Catalog,
+	run *model.ResourceRun,
+	jsonPlan []byte,
+) (*types.ResourceRunCostEstimate, error) {
+	var plan tfjson.Plan
+	if err := json.Unmarshal(jsonPlan, &plan); err != nil {
+		return nil, fmt.Errorf("error decoding plan: %w", err)
+	}
+
+	p := &pricer{
+		catalog: catalog,
+		regions: getProviderRegions(&plan),
+		kubernetesPricing: func() (*types.FinOpsCustomPricing, error) {
+			return getKubernetesPricing(ctx, mc, run)
+		},
+	}
+
+	return p.estimate(&plan), nil
+}
+
+// getKubernetesPricing returns the custom pricing of the Kubernetes connector of the run environment.
+func getKubernetesPricing(
+	ctx context.Context,
+	mc model.ClientSet,
+	run *model.ResourceRun,
+) (*types.FinOpsCustomPricing, error) {
+	conn, err := mc.Connectors().Query().
+		Where(
+			connector.Category(types.ConnectorCategoryKubernetes),
+			connector.HasEnvironmentsWith(
+				environmentconnectorrelationship.EnvironmentID(run.EnvironmentID))).
+		Select(
+			connector.FieldID,
+			connector.FieldFinOpsCustomPricing).
+		First(ctx)
+	if err != nil && !model.IsNotFound(err) {
+		return nil, err
+	}
+
+	if conn == nil || conn.FinOpsCustomPricing.IsZero() {
+		return types.DefaultFinOpsCustomPricing(), nil
+	}
+
+	return conn.FinOpsCustomPricing, nil
+}
+
+// pricer prices the resources of a plan.
+type pricer struct {
+	catalog *pricing.Catalog
+	// regions holds the region configured by the providers, indexed by the vendor.
+	regions map[string]string
+	// kubernetesPricing returns the custom pricing of the Kubernetes connector.
+	kubernetesPricing func() (*types.FinOpsCustomPricing, error)
+
+	kubernetesPricingCache *types.FinOpsCustomPricing
+}
+
+func (p *pricer) estimate(plan *tfjson.Plan) *types.ResourceRunCostEstimate {
+	e := &types.ResourceRunCostEstimate{
+		Currency: "USD",
+	}
+
+	for _, rc := range plan.ResourceChanges {
+		if rc == nil || rc.Change == nil || rc.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+
+		rp, ok := resourcePricers[rc.Type]
+		if !ok {
+			// Free or not supported.
+			continue
+		}
+
+		var changeType string
+
+		switch {
+		case rc.Change.Actions.Create():
+			changeType = types.ResourceComponentChangeTypeCreate
+		case rc.Change.Actions.Update(), rc.Change.Actions.Replace():
+			changeType = types.ResourceComponentChangeTypeUpdate
+		case rc.Change.Actions.Delete():
+			changeType = types.ResourceComponentChangeTypeDelete
+		default:
+			changeType = types.ResourceComponentChangeTypeNoChange
+		}
+
+		ce := types.ResourceRunComponentCostEstimate{
+			Address:    rc.Address,
+			Type:       rc.Type,
+			ChangeType: changeType,
+		}
+
+		var err error
+
+		if before, ok := rc.Change.Before.(map[string]any); ok {
+			ce.PriorMonthlyCost, err = p.price(rp, before)
+		}
+
+		if after, ok := rc.Change.After.(map[string]any); ok && err == nil {
+			ce.MonthlyCost, err = p.price(rp, after)
+		}
+
+		if err != nil {
+			ce.Unpriced = true
+			ce.Message = err.Error()
+			ce.PriorMonthlyCost, ce.MonthlyCost = 0, 0
+		}
+
+		e.PriorMonthlyCost += ce.PriorMonthlyCost
+		e.MonthlyCost += ce.MonthlyCost
+
+		if changeType != types.ResourceComponentChangeTypeNoChange {
+			e.Components = append(e.Components, ce)
+		}
+	}
+
+	e.MonthlyDelta = e.MonthlyCost - e.PriorMonthlyCost
+
+	return e
+}
+
+func (p *pricer) price(rp resourcePricer, values map[string]any) (float64, error) {
+	region := rp.region(values)
+	if region == "" {
+		region = p.regions[rp.vendor]
+	}
+
+	if region == "" {
+		region = defaultRegions[rp.vendor]
+	}
+
+	return rp.price(p, region, values)
+}
+
+// lookup returns the cost of the given quantity of the product matched the given filter.
+func (p *pricer) lookup(f pricing.Filter, quantity float64) (float64, error) {
+	pr, ok := p.catalog.Lookup(f)
+	if !ok {
+		attrs := make([]string, 0, len(f.Attributes))
+		for k, v := range f.Attributes {
+			attrs = append(attrs, k+"="+v)
+		}
+
+		sort.Strings(attrs)
+
+		return 0, fmt.Errorf("no price of %s %s in %s", f.Service, strings.Join(attrs, ","), f.Region)
+	}
+
+	return pr.USD * quantity, nil
+}
+
+func (p *pricer) getKubernetesPricing() (*types.FinOpsCustomPricing, error) {
+	if p.kubernetesPricingCache != nil {
+		return p.kubernetesPricingCache, nil
+	}
+
+	cp, err := p.kubernetesPricing()
+	if err != nil {
+		return nil, fmt.Errorf("error getting kubernetes pricing: %w", err)
+	}
+
+	p.kubernetesPricingCache = cp
+
+	return cp, nil
+}
+
+// getProviderRegions returns the region configured by the providers of the given plan.
+func getProviderRegions(plan *tfjson.Plan) map[string]string {
+	r := map[string]string{}
+
+	if plan.Config == nil {
+		return r
+	}
+
+	for _, pc := range plan.Config.ProviderConfigs {
+		if pc == nil || pc.Alias != "" {
+			continue
+		}
+
+		vendor, ok := providerVendors[path.Base(pc.Name)]
+		if !ok {
+			continue
+		}
+
+		expr, ok := pc.Expressions["region"]
+		if !ok || expr == nil || expr.ExpressionData == nil {
+			continue
+		}
+
+		if s, ok := expr.ConstantValue.(string); ok && s != "" {
+			r[vendor] = s
+			continue
+		}
+
+		// Resolve the variable reference, i.e. var.region.
+		for _, ref := range expr.References {
+			n, ok := strings.CutPrefix(ref, "var.")
+			if !ok || plan.Variables[n] == nil {
+				continue
+			}
+
+			if s, ok := plan.Variables[n].Value.(string); ok && s != "" {
+				r[vendor] = s
+				break
+			}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,62 @@
+package estimate
+
+import (
+	"os"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/costs/pricing"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+func TestEstimate(t *testing.T) {
+	bs, err := os.ReadFile("testdata/plan.json")
+	require.NoError(t, err)
+
+	var plan tfjson.Plan
+	require.NoError(t, json.Unmarshal(bs, &plan))
+
+	p := &pricer{
+		catalog: pricing.Builtin(),
+		regions: getProviderRegions(&plan),
+		kubernetesPricing: func() (*types.FinOpsCustomPricing, error) {
+			return &types.FinOpsCustomPricing{
+				CPU:     "20",
+				RAM:     "3",
+				Storage: "0.1",
+			}, nil
+		},
+	}
+	assert.Equal(t, map[string]string{"aws": "us-west-2"}, p.regions)
+
+	e := p.estimate(&plan)
+	require.Len(t, e.Components, 4, "free and unchanged components are not listed")
+
+	// Region of the provider.
+	web := e.Components[0]
+	assert.Equal(t, types.ResourceComponentChangeTypeUpdate, web.ChangeType)
+	assert.InDelta(t, 0.0104*730+8*0.08, web.PriorMonthlyCost, 1e-9)
+	assert.InDelta(t, 0.096*730+8*0.08, web.MonthlyCost, 1e-9)
+
+	// Region of the availability zone.
+	data := e.Components[1]
+	assert.InDelta(t, 0, data.PriorMonthlyCost, 1e-9)
+	assert.InDelta(t, 100*0.08, data.MonthlyCost, 1e-9)
+
+	legacy := e.Components[2]
+	assert.True(t, legacy.Unpriced)
+	assert.Contains(t, legacy.Message, "instanceType=x1.unknown")
+
+	// 2 replicas of 0.75 cores and 1.5 GiB.
+	app := e.Components[3]
+	assert.InDelta(t, 2*(0.75*20+1.5*3), app.MonthlyCost, 1e-9)
+
+	pvc := 10 * 0.1
+	assert.InDelta(t, web.PriorMonthlyCost+pvc, e.PriorMonthlyCost, 1e-9)
+	assert.InDelta(t, web.MonthlyCost+data.MonthlyCost+app.MonthlyCost+pvc, e.MonthlyCost, 1e-9)
+	assert.InDelta(t, e.MonthlyCost-e.PriorMonthlyCost, e.MonthlyDelta, 1e-9)
+}
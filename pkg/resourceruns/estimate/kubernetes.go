@@ -0,0 +1,200 @@
+package estimate
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+const gib = 1 << 30
+
+var (
+	kubernetesWorkloadPricer = resourcePricer{
+		vendor: vendorKubernetes,
+		region: kubernetesRegion,
+		price:  priceKubernetesWorkload,
+	}
+	kubernetesVolumeClaimPricer = resourcePricer{
+		vendor: vendorKubernetes,
+		region: kubernetesRegion,
+		price:  priceKubernetesVolumeClaim,
+	}
+)
+
+func kubernetesRegion(map[string]any) string {
+	// The Kubernetes resources are priced by the connector.
+	return ""
+}
+
+// priceKubernetesWorkload prices the requested CPU and memory of the containers,
+// and the requested storage of the volume claim templates, multiplied by the replicas.
+func priceKubernetesWorkload(p *pricer, _ string, values map[string]any) (float64, error) {
+	cp, err := p.getKubernetesPricing()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		cpu, memory, storage float64
+		replicas             = 1.0
+	)
+
+	for _, spec := range getBlocks(values, "spec") {
+		if r, ok := getNumber(spec, "replicas"); ok {
+			replicas = r
+		}
+
+		// Pod.
+		c, m, err := getContainersRequests(spec)
+		if err != nil {
+			return 0, err
+		}
+
+		cpu += c
+		memory += m
+
+		// Deployment and StatefulSet.
+		for _, tmpl := range getBlocks(spec, "template") {
+			for _, ps := range getBlocks(tmpl, "spec") {
+				c, m, err = getContainersRequests(ps)
+				if err != nil {
+					return 0, err
+				}
+
+				cpu += c
+				memory += m
+			}
+		}
+
+		// StatefulSet.
+		for _, vct := range getBlocks(spec, "volume_claim_template") {
+			for _, vs := range getBlocks(vct, "spec") {
+				s, err := getStorageRequest(vs)
+				if err != nil {
+					return 0, err
+				}
+
+				storage += s
+			}
+		}
+	}
+
+	return kubernetesCost(cp, replicas*cpu, replicas*memory, replicas*storage)
+}
+
+// priceKubernetesVolumeClaim prices the requested storage of the persistent volume claim.
+func priceKubernetesVolumeClaim(p *pricer, _ string, values map[string]any) (float64, error) {
+	cp, err := p.getKubernetesPricing()
+	if err != nil {
+		return 0, err
+	}
+
+	var storage float64
+
+	for _, spec := range getBlocks(values, "spec") {
+		s, err := getStorageRequest(spec)
+		if err != nil {
+			return 0, err
+		}
+
+		storage += s
+	}
+
+	return kubernetesCost(cp, 0, 0, storage)
+}
+
+// getContainersRequests returns the requested CPU cores and memory GiB of the containers of the given pod spec,
+// the limits are used if the requests are not specified.
+func getContainersRequests(podSpec map[string]any) (cpu, memory float64, err error) {
+	for _, c := range getBlocks(podSpec, "container") {
+		for _, res := range getBlocks(c, "resources") {
+			requests, _ := res["requests"].(map[string]any)
+			limits, _ := res["limits"].(map[string]any)
+
+			q, err := getQuantity(requests, limits, "cpu")
+			if err != nil {
+				return 0, 0, err
+			}
+
+			cpu += q
+
+			q, err = getQuantity(requests, limits, "memory")
+			if err != nil {
+				return 0, 0, err
+			}
+
+			memory += q / gib
+		}
+	}
+
+	return cpu, memory, nil
+}
+
+// getStorageRequest returns the requested storage GiB of the given volume claim spec.
+func getStorageRequest(claimSpec map[string]any) (float64, error) {
+	var storage float64
+
+	for _, res := range getBlocks(claimSpec, "resources") {
+		requests, _ := res["requests"].(map[string]any)
+		limits, _ := res["limits"].(map[string]any)
+
+		q, err := getQuantity(requests, limits, "storage")
+		if err != nil {
+			return 0, err
+		}
+
+		storage += q / gib
+	}
+
+	return storage, nil
+}
+
+func getQuantity(requests, limits map[string]any, name string) (float64, error) {
+	s := getString(requests, name)
+	if s == "" {
+		s = getString(limits, name)
+	}
+
+	if s == "" {
+		return 0, nil
+	}
+
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s quantity %q: %w", name, s, err)
+	}
+
+	return q.AsApproximateFloat64(), nil
+}
+
+// kubernetesCost returns the monthly cost of the given CPU cores, memory GiB and storage GiB
+// by the given custom pricing.
+func kubernetesCost(cp *types.FinOpsCustomPricing, cpu, memory, storage float64) (float64, error) {
+	var cost float64
+
+	for _, i := range []struct {
+		name     string
+		price    string
+		quantity float64
+	}{
+		{name: "cpu", price: cp.CPU, quantity: cpu},
+		{name: "ram", price: cp.RAM, quantity: memory},
+		{name: "storage", price: cp.Storage, quantity: storage},
+	} {
+		if i.quantity == 0 {
+			continue
+		}
+
+		price, err := strconv.ParseFloat(i.price, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s price %q: %w", i.name, i.price, err)
+		}
+
+		cost += price * i.quantity
+	}
+
+	return cost, nil
+}
@@ -0,0 +1,353 @@
+package estimate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/seal-io/walrus/pkg/costs/pricing"
+)
+
+const (
+	vendorAWS        = "aws"
+	vendorGoogle     = "gcp"
+	vendorAzure      = "azure"
+	vendorKubernetes = "kubernetes"
+)
+
+// providerVendors maps the provider name to the vendor name of the pricing catalog.
+var providerVendors = map[string]string{
+	"aws":        vendorAWS,
+	"google":     vendorGoogle,
+	"azurerm":    vendorAzure,
+	"kubernetes": vendorKubernetes,
+}
+
+// defaultRegions is the region of the vendor if not configured.
+var defaultRegions = map[string]string{
+	vendorAWS:    "us-east-1",
+	vendorGoogle: "us-central1",
+	vendorAzure:  "eastus",
+}
+
+// resourcePricer prices a type of resource.
+type resourcePricer struct {
+	vendor string
+	// region returns the region specified by the resource values,
+	// returns blank to use the provider region.
+	region func(values map[string]any) string
+	// price returns the monthly cost of the resource values.
+	price func(p *pricer, region string, values map[string]any) (float64, error)
+}
+
+// resourcePricers holds the supported resource pricers indexed by the resource type.
+var resourcePricers = map[string]resourcePricer{
+	"aws_instance": {
+		vendor: vendorAWS,
+		region: awsRegion,
+		price:  priceAWSInstance,
+	},
+	"aws_ebs_volume": {
+		vendor: vendorAWS,
+		region: awsRegion,
+		price:  priceAWSEBSVolume,
+	},
+	"aws_db_instance": {
+		vendor: vendorAWS,
+		region: awsRegion,
+		price:  priceAWSDBInstance,
+	},
+	"google_compute_instance": {
+		vendor: vendorGoogle,
+		region: googleRegion,
+		price:  priceGoogleComputeInstance,
+	},
+	"google_compute_disk": {
+		vendor: vendorGoogle,
+		region: googleRegion,
+		price:  priceGoogleComputeDisk,
+	},
+	"azurerm_linux_virtual_machine": {
+		vendor: vendorAzure,
+		region: azureRegion,
+		price:  priceAzureLinuxVirtualMachine,
+	},
+	"kubernetes_deployment":                 kubernetesWorkloadPricer,
+	"kubernetes_deployment_v1":              kubernetesWorkloadPricer,
+	"kubernetes_stateful_set":               kubernetesWorkloadPricer,
+	"kubernetes_stateful_set_v1":            kubernetesWorkloadPricer,
+	"kubernetes_pod":                        kubernetesWorkloadPricer,
+	"kubernetes_pod_v1":                     kubernetesWorkloadPricer,
+	"kubernetes_persistent_volume_claim":    kubernetesVolumeClaimPricer,
+	"kubernetes_persistent_volume_claim_v1": kubernetesVolumeClaimPricer,
+}
+
+func awsRegion(values map[string]any) string {
+	// Trim the zone letter, i.e. us-east-1a.
+	az := getString(values, "availability_zone")
+	if len(az) > 1 && az[len(az)-1] >= 'a' && az[len(az)-1] <= 'z' {
+		return az[:len(az)-1]
+	}
+
+	return ""
+}
+
+func priceAWSInstance(p *pricer, region string, values map[string]any) (float64, error) {
+	tenancy := "Shared"
+	if getString(values, "tenancy") == "dedicated" {
+		tenancy = "Dedicated"
+	}
+
+	cost, err := p.lookup(pricing.Filter{
+		VendorName:    vendorAWS,
+		Region:        region,
+		Service:       "AmazonEC2",
+		ProductFamily: "Compute Instance",
+		Attributes: map[string]string{
+			"instanceType":    getString(values, "instance_type"),
+			"operatingSystem": "Linux",
+			"tenancy":         tenancy,
+			"capacitystatus":  "Used",
+			"preInstalledSw":  "NA",
+		},
+		PurchaseOption: "on_demand",
+	}, hoursPerMonth)
+	if err != nil {
+		return 0, err
+	}
+
+	bds := append(getBlocks(values, "root_block_device"), getBlocks(values, "ebs_block_device")...)
+	for _, bd := range bds {
+		size, ok := getNumber(bd, "volume_size")
+		if !ok {
+			// Default size of the root volume.
+			size = 8
+		}
+
+		c, err := priceAWSVolume(p, region, getString(bd, "volume_type"), size)
+		if err != nil {
+			return 0, err
+		}
+
+		cost += c
+	}
+
+	return cost, nil
+}
+
+func priceAWSEBSVolume(p *pricer, region string, values map[string]any) (float64, error) {
+	size, ok := getNumber(values, "size")
+	if !ok {
+		return 0, errors.New("unknown volume size")
+	}
+
+	return priceAWSVolume(p, region, getString(values, "type"), size)
+}
+
+func priceAWSVolume(p *pricer, region, typ string, size float64) (float64, error) {
+	if typ == "" {
+		typ = "gp2"
+	}
+
+	return p.lookup(pricing.Filter{
+		VendorName:    vendorAWS,
+		Region:        region,
+		Service:       "AmazonEC2",
+		ProductFamily: "Storage",
+		Attributes: map[string]string{
+			"volumeApiName": typ,
+		},
+		PurchaseOption: "on_demand",
+	}, size)
+}
+
+// awsDatabaseEngines maps the engine of the aws_db_instance to the database engine of the pricing catalog.
+var awsDatabaseEngines = map[string]string{
+	"mysql":    "MySQL",
+	"postgres": "PostgreSQL",
+	"mariadb":  "MariaDB",
+}
+
+func priceAWSDBInstance(p *pricer, region string, values map[string]any) (float64, error) {
+	engine, ok := awsDatabaseEngines[getString(values, "engine")]
+	if !ok {
+		return 0, fmt.Errorf("unsupported engine %q", getString(values, "engine"))
+	}
+
+	deployment := "Single-AZ"
+	if b, _ := values["multi_az"].(bool); b {
+		deployment = "Multi-AZ"
+	}
+
+	cost, err := p.lookup(pricing.Filter{
+		VendorName:    vendorAWS,
+		Region:        region,
+		Service:       "AmazonRDS",
+		ProductFamily: "Database Instance",
+		Attributes: map[string]string{
+			"instanceType":     getString(values, "instance_class"),
+			"databaseEngine":   engine,
+			"deploymentOption": deployment,
+		},
+		PurchaseOption: "on_demand",
+	}, hoursPerMonth)
+	if err != nil {
+		return 0, err
+	}
+
+	size, ok := getNumber(values, "allocated_storage")
+	if !ok || size == 0 {
+		return cost, nil
+	}
+
+	switch getString(values, "storage_type") {
+	case "", "gp2", "gp3":
+	default:
+		return 0, fmt.Errorf("unsupported storage type %q", getString(values, "storage_type"))
+	}
+
+	c, err := p.lookup(pricing.Filter{
+		VendorName:    vendorAWS,
+		Region:        region,
+		Service:       "AmazonRDS",
+		ProductFamily: "Database Storage",
+		Attributes: map[string]string{
+			"volumeType":       "General Purpose",
+			"deploymentOption": deployment,
+		},
+		PurchaseOption: "on_demand",
+	}, size)
+	if err != nil {
+		return 0, err
+	}
+
+	return cost + c, nil
+}
+
+func googleRegion(values map[string]any) string {
+	// Trim the zone suffix, i.e. us-central1-a.
+	zone := getString(values, "zone")
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+
+	return ""
+}
+
+func priceGoogleComputeInstance(p *pricer, region string, values map[string]any) (float64, error) {
+	cost, err := p.lookup(pricing.Filter{
+		VendorName:    vendorGoogle,
+		Region:        region,
+		Service:       "Compute Engine",
+		ProductFamily: "Compute Instance",
+		Attributes: map[string]string{
+			"machineType": getString(values, "machine_type"),
+		},
+		PurchaseOption: "on_demand",
+	}, hoursPerMonth)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, bd := range getBlocks(values, "boot_disk") {
+		for _, ip := range getBlocks(bd, "initialize_params") {
+			size, ok := getNumber(ip, "size")
+			if !ok {
+				// Default size of the boot disk.
+				size = 10
+			}
+
+			c, err := priceGoogleDisk(p, region, getString(ip, "type"), size)
+			if err != nil {
+				return 0, err
+			}
+
+			cost += c
+		}
+	}
+
+	return cost, nil
+}
+
+func priceGoogleComputeDisk(p *pricer, region string, values map[string]any) (float64, error) {
+	size, ok := getNumber(values, "size")
+	if !ok {
+		return 0, errors.New("unknown disk size")
+	}
+
+	return priceGoogleDisk(p, region, getString(values, "type"), size)
+}
+
+func priceGoogleDisk(p *pricer, region, typ string, size float64) (float64, error) {
+	if typ == "" {
+		typ = "pd-standard"
+	}
+
+	return p.lookup(pricing.Filter{
+		VendorName:    vendorGoogle,
+		Region:        region,
+		Service:       "Compute Engine",
+		ProductFamily: "Storage",
+		Attributes: map[string]string{
+			"diskType": typ,
+		},
+		PurchaseOption: "on_demand",
+	}, size)
+}
+
+func azureRegion(values map[string]any) string {
+	// Normalize the display name, i.e. East US.
+	return strings.ToLower(strings.ReplaceAll(getString(values, "location"), " ", ""))
+}
+
+func priceAzureLinuxVirtualMachine(p *pricer, region string, values map[string]any) (float64, error) {
+	return p.lookup(pricing.Filter{
+		VendorName:    vendorAzure,
+		Region:        region,
+		Service:       "Virtual Machines",
+		ProductFamily: "Compute",
+		Attributes: map[string]string{
+			"armSkuName": getString(values, "size"),
+			"os":         "Linux",
+		},
+		PurchaseOption: "Consumption",
+	}, hoursPerMonth)
+}
+
+// getString returns the string value of the given key.
+func getString(values map[string]any, key string) string {
+	s, _ := values[key].(string)
+	return s
+}
+
+// getNumber returns the number value of the given key,
+// the number in string is parsed, i.e. the replicas of the Kubernetes deployment.
+func getNumber(values map[string]any, key string) (float64, bool) {
+	switch v := values[key].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// getBlocks returns the nested blocks of the given key.
+func getBlocks(values map[string]any, key string) []map[string]any {
+	l, _ := values[key].([]any)
+
+	r := make([]map[string]any, 0, len(l))
+
+	for i := range l {
+		if m, ok := l[i].(map[string]any); ok {
+			r = append(r, m)
+		}
+	}
+
+	return r
+}
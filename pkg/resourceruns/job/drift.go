@@ -0,0 +1,88 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	"github.com/seal-io/walrus/pkg/settings"
+)
+
+// IsDriftDetectionRun returns true if the run is created by drift detection.
+func IsDriftDetectionRun(run *model.ResourceRun) bool {
+	return run != nil && run.Labels[types.LabelWalrusResourceRunDriftDetection] == "true"
+}
+
+// syncDrift records the drift detection result of the planned run to the resource,
+// cancels the run if no drift is detected,
+// or applies the run if the drift reconciliation is enabled.
+func (s syncer) syncDrift(
+	ctx context.Context,
+	mc model.ClientSet,
+	dp deptypes.Deployer,
+	entity *model.Resource,
+	run *model.ResourceRun,
+) error {
+	drift := getDrift(run)
+
+	s.logger.Debugf("resource %s drift status %q", entity.Name, drift.Status)
+
+	err := mc.Resources().UpdateOne(entity).
+		SetDrift(drift).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !drift.IsDrifted() {
+		// Nothing to apply.
+		status.ResourceRunStatusCanceled.True(run, "no drift detected")
+		run.Status.SetSummary(status.WalkResourceRun(&run.Status))
+
+		_, err = runstatus.UpdateStatus(ctx, mc, run)
+
+		return err
+	}
+
+	if !settings.EnableResourceDriftReconcile.ShouldValueBool(ctx, mc) {
+		// Wait to be applied manually.
+		return nil
+	}
+
+	s.logger.Infof("reconcile drift of resource %s with run %s", entity.Name, run.ID)
+
+	return PerformRunJob(ctx, mc, dp, run)
+}
+
+// getDrift returns the drift detection result from the planned run.
+func getDrift(run *model.ResourceRun) *types.ResourceDrift {
+	drift := &types.ResourceDrift{
+		Status:  types.ResourceDriftStatusInSync,
+		Summary: run.ComponentChangeSummary,
+		RunID:   run.ID,
+		Time:    time.Now(),
+	}
+
+	for _, c := range run.ComponentChanges {
+		if c == nil || c.ResourceChange == nil || c.Change == nil {
+			continue
+		}
+
+		switch c.Change.Type {
+		case types.ResourceComponentChangeTypeCreate,
+			types.ResourceComponentChangeTypeUpdate,
+			types.ResourceComponentChangeTypeDelete:
+			drift.Changes = append(drift.Changes, c.Address)
+		}
+	}
+
+	if len(drift.Changes) != 0 {
+		drift.Status = types.ResourceDriftStatusDrifted
+	}
+
+	return drift
+}
@@ -0,0 +1,77 @@
+package job
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+func TestGetDrift(t *testing.T) {
+	change := func(addr, typ string) *types.ResourceComponentChange {
+		return &types.ResourceComponentChange{
+			ResourceChange: &tfjson.ResourceChange{Address: addr},
+			Change:         &types.Change{Type: typ},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		given           *model.ResourceRun
+		expectedStatus  string
+		expectedChanges []string
+	}{
+		{
+			name: "no changes",
+			given: &model.ResourceRun{
+				ID: "1",
+			},
+			expectedStatus: types.ResourceDriftStatusInSync,
+		},
+		{
+			name: "no-change only",
+			given: &model.ResourceRun{
+				ID: "1",
+				ComponentChanges: []*types.ResourceComponentChange{
+					change("kubernetes_deployment.app", types.ResourceComponentChangeTypeNoChange),
+				},
+			},
+			expectedStatus: types.ResourceDriftStatusInSync,
+		},
+		{
+			name: "drifted",
+			given: &model.ResourceRun{
+				ID: "1",
+				ComponentChanges: []*types.ResourceComponentChange{
+					change("kubernetes_deployment.app", types.ResourceComponentChangeTypeUpdate),
+					change("kubernetes_service.app", types.ResourceComponentChangeTypeNoChange),
+					change("kubernetes_config_map.app", types.ResourceComponentChangeTypeCreate),
+					nil,
+				},
+				ComponentChangeSummary: types.ResourceComponentChangeSummary{
+					Created: 1,
+					Updated: 1,
+				},
+			},
+			expectedStatus: types.ResourceDriftStatusDrifted,
+			expectedChanges: []string{
+				"kubernetes_deployment.app",
+				"kubernetes_config_map.app",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := getDrift(tc.given)
+			assert.Equal(t, tc.expectedStatus, actual.Status)
+			assert.Equal(t, tc.expectedChanges, actual.Changes)
+			assert.Equal(t, tc.given.ComponentChangeSummary, actual.Summary)
+			assert.Equal(t, tc.given.ID, actual.RunID)
+			assert.Equal(t, tc.expectedStatus == types.ResourceDriftStatusDrifted, actual.IsDrifted())
+		})
+	}
+}
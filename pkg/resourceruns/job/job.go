@@ -8,6 +8,9 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
+	"github.com/seal-io/walrus/pkg/resourceruns/approval"
+	"github.com/seal-io/walrus/pkg/resourceruns/policy"
+	runqueue "github.com/seal-io/walrus/pkg/resourceruns/queue"
 	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
 	resstatus "github.com/seal-io/walrus/pkg/resources/status"
 	"github.com/seal-io/walrus/utils/log"
@@ -39,6 +42,39 @@ func PerformRunJob(ctx context.Context, mc model.ClientSet, dp deptypes.Deployer
 		return err
 	}
 
+	// The run violating the deny policies is blocked from applying.
+	if runJobType != types.RunTaskTypePlan {
+		if denied := run.PolicyResults.Denied(); len(denied) != 0 {
+			status.ResourceRunStatusApplied.False(run, policy.DeniedMessage(denied))
+			run.Status.SetSummary(status.WalkResourceRun(&run.Status))
+
+			_, err = runstatus.UpdateStatus(ctx, mc, run)
+
+			return err
+		}
+
+		// The run requiring approvals waits for the approvers.
+		approved, err := approval.Check(ctx, mc, run)
+		if err != nil || !approved {
+			return err
+		}
+	}
+
+	// The not preview run applies right after planned with the admitted slot,
+	// other jobs wait for the concurrency limits of the environment and connectors.
+	if runJobType == types.RunTaskTypePlan || run.Preview {
+		admitted, err := runqueue.Admit(ctx, mc, run)
+		if err != nil {
+			return err
+		}
+
+		// If the run is queued, it will not be performed.
+		// Scheduler will admit and perform the run job later.
+		if !admitted {
+			return nil
+		}
+	}
+
 	defer func() {
 		_, rerr := runstatus.UpdateStatusWithErr(ctx, mc, run, err)
 		if rerr != nil {
@@ -2,7 +2,6 @@ package job
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -15,14 +14,14 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	runbus "github.com/seal-io/walrus/pkg/bus/resourcerun"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/object"
-	"github.com/seal-io/walrus/pkg/dao/types/status"
+	"github.com/seal-io/walrus/pkg/resourceruns/job/result"
+	"github.com/seal-io/walrus/pkg/resourceruns/logs"
 	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	"github.com/seal-io/walrus/pkg/resourcestate"
 	"github.com/seal-io/walrus/pkg/storage"
-	"github.com/seal-io/walrus/utils/gopool"
 )
 
 type Reconciler struct {
@@ -72,10 +71,20 @@ func (r Reconciler) syncRunStatus(ctx context.Context, job *batchv1.Job) (err er
 		return nil
 	}
 
+	// Release the state locks left by the exited job,
+	// no matter whether the run status is synced or not.
+	if job.DeletionTimestamp != nil || job.Status.Succeeded > 0 || job.Status.Failed > 0 {
+		defer r.releaseStateLocks(object.ID(runID))
+	}
+
 	if job.DeletionTimestamp != nil {
 		return nil
 	}
 
+	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+		return nil
+	}
+
 	run, err := r.ModelClient.ResourceRuns().Get(ctx, object.ID(runID))
 	if err != nil {
 		return err
@@ -86,145 +95,61 @@ func (r Reconciler) syncRunStatus(ctx context.Context, job *batchv1.Job) (err er
 		return nil
 	}
 
-	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
-		return nil
-	}
-
 	// Get job pods logs.
-	record, err := r.getJobPodsLogs(ctx, job.Name)
+	lines, err := r.getJobPodsLogs(ctx, job.Name)
 	if err != nil {
 		r.Logger.Error(err, "failed to get job pod logs", "resource-run", runID)
-		record = err.Error()
-	}
-
-	update := r.ModelClient.ResourceRuns().UpdateOne(run)
-
-	if job.Status.Succeeded > 0 {
-		r.Logger.Info("succeed", "resource-run", runID)
-		setStatusTrue(run, taskType, "")
-	}
-
-	if job.Status.Failed > 0 {
-		r.Logger.Info("failed", "resource-run", runID)
-		setStatusFalse(run, taskType, "please check the logs")
-		// Clear component changes and summary when run failed.
-		update.ClearComponentChanges().
-			ClearComponentChangeSummary()
-	}
-
-	// Report to Resource run.
-	if runstatus.IsStatusPlanCondition(run) {
-		run.PlanRecord = record
-	} else {
-		run.Record = record
-	}
-
-	run.Status.SetSummary(status.WalkResourceRun(&run.Status))
-	run.Duration = int(time.Since(*run.CreateTime).Seconds())
-
-	run, err = update.
-		SetStatus(run.Status).
-		SetPlanRecord(run.PlanRecord).
-		SetRecord(run.Record).
-		SetDuration(run.Duration).
-		Save(ctx)
-	if err != nil {
-		return err
+		lines = logs.FromText(err.Error(), time.Now())
 	}
 
-	// Clean plan files.
-	r.cleanPlanFiles(run)
-
-	return runbus.Notify(ctx, r.ModelClient, run)
+	return result.Sync(ctx, r.ModelClient, r.StorageManager, result.Result{
+		RunID:     object.ID(runID),
+		TaskType:  taskType,
+		Succeeded: job.Status.Succeeded > 0,
+		Record:    logs.Text(lines),
+		Lines:     lines,
+	})
 }
 
-// getJobPodsLogs returns the logs of all pods of a job.
-func (r Reconciler) getJobPodsLogs(ctx context.Context, jobName string) (string, error) {
+// getJobPodsLogs returns the timestamped logs of all pods of a job.
+func (r Reconciler) getJobPodsLogs(ctx context.Context, jobName string) ([]logs.Line, error) {
 	clientSet, err := kubernetes.NewForConfig(r.Kubeconfig)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	ls := "job-name=" + jobName
 
 	pods, err := clientSet.CoreV1().Pods(types.WalrusSystemNamespace).
 		List(ctx, metav1.ListOptions{LabelSelector: ls})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	var logs string
+	var lines []logs.Line
 
 	for _, pod := range pods.Items {
 		var podLogs []byte
 
 		podLogs, err = clientSet.CoreV1().Pods(types.WalrusSystemNamespace).
-			GetLogs(pod.Name, &corev1.PodLogOptions{}).
+			GetLogs(pod.Name, &corev1.PodLogOptions{Timestamps: true}).
 			DoRaw(ctx)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-		logs += string(podLogs)
-	}
 
-	return logs, nil
-}
-
-func (r Reconciler) cleanPlanFiles(run *model.ResourceRun) {
-	// When run status is planned, skip it.
-	if runstatus.IsStatusPlanned(run) {
-		return
+		lines = append(lines, logs.ParseTimestamped(string(podLogs), time.Now())...)
 	}
 
-	gopool.Go(func() {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-		defer cancel()
-
-		err := r.StorageManager.DeleteRunPlan(ctx, run)
-		if err != nil {
-			r.Logger.Error(err, "failed to delete run plan", "run", run.ID)
-		}
-	})
+	return lines, nil
 }
 
-// SetStatusFalse sets the status of the resource run to false with task type.
-func setStatusFalse(run *model.ResourceRun, taskType, errMsg string) {
-	if status.ResourceRunStatusPending.IsUnknown(run) {
-		errMsg = fmt.Sprintf("pending failed: %s", errMsg)
-		status.ResourceRunStatusPending.False(run, errMsg)
-	}
+// releaseStateLocks releases the state locks held by the given run.
+func (r Reconciler) releaseStateLocks(runID object.ID) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
 
-	var ct status.ConditionType
-	switch taskType {
-	case types.RunTaskTypePlan.String():
-		ct = status.ResourceRunStatusPlanned
-		errMsg = fmt.Sprintf("plan failed: %s", errMsg)
-	case types.RunTaskTypeApply.String(), types.RunTaskTypeDestroy.String():
-		ct = status.ResourceRunStatusApplied
-		errMsg = fmt.Sprintf("apply failed: %s", errMsg)
-	}
-
-	if ct.IsUnknown(run) {
-		ct.False(run, errMsg)
-	}
-
-	run.Status.SetSummary(status.WalkResourceRun(&run.Status))
-}
-
-// setStatusTrue sets the status of the resource run to true with task type.
-func setStatusTrue(run *model.ResourceRun, taskType, msg string) {
-	var ct status.ConditionType
-
-	switch taskType {
-	case types.RunTaskTypePlan.String():
-		ct = status.ResourceRunStatusPlanned
-		msg = ""
-	case types.RunTaskTypeApply.String(), types.RunTaskTypeDestroy.String():
-		ct = status.ResourceRunStatusApplied
-	}
-
-	if ct.IsUnknown(run) {
-		ct.True(run, msg)
+	err := resourcestate.ReleaseRunLocks(ctx, r.ModelClient, runID)
+	if err != nil {
+		r.Logger.Error(err, "failed to release state locks", "resource-run", runID)
 	}
-
-	run.Status.SetSummary(status.WalkResourceRun(&run.Status))
 }
@@ -0,0 +1,180 @@
+package result
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	runbus "github.com/seal-io/walrus/pkg/bus/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	"github.com/seal-io/walrus/pkg/resourceruns/logs"
+	runqueue "github.com/seal-io/walrus/pkg/resourceruns/queue"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	"github.com/seal-io/walrus/pkg/storage"
+	"github.com/seal-io/walrus/utils/gopool"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+// Result holds the result of an exited deployment job.
+type Result struct {
+	// RunID is the ID of the resource run that the job belongs to.
+	RunID object.ID
+	// TaskType is the task type of the job, plan, apply or destroy.
+	TaskType string
+	// Succeeded indicates whether the job exited successfully.
+	Succeeded bool
+	// Record is the logs of the job.
+	Record string
+	// Lines is the timestamped logs of the job,
+	// it is split from the Record with the exited time if not provided.
+	Lines []logs.Line
+}
+
+// Sync syncs the given job result to the resource run,
+// it is shared by all job executors to report the job result in the same way.
+func Sync(ctx context.Context, mc model.ClientSet, sm *storage.Manager, r Result) error {
+	logger := log.WithName("resource-run").WithName("result")
+
+	// Release the slot of the run queue held by the exited job.
+	runqueue.Release(r.RunID)
+
+	run, err := mc.ResourceRuns().Get(ctx, r.RunID)
+	if err != nil {
+		return err
+	}
+
+	// If the run status is not running, then skip it.
+	if !runstatus.IsStatusRunning(run) {
+		return nil
+	}
+
+	update := mc.ResourceRuns().UpdateOne(run)
+
+	if r.Succeeded {
+		logger.Debugf("resource run %s %s succeeded", r.RunID, r.TaskType)
+		setStatusTrue(run, r.TaskType, "")
+	} else {
+		logger.Debugf("resource run %s %s failed", r.RunID, r.TaskType)
+		setStatusFalse(run, r.TaskType, "please check the logs")
+		// Clear component changes, summary and cost estimate when run failed.
+		update.ClearComponentChanges().
+			ClearComponentChangeSummary().
+			ClearCostEstimate()
+	}
+
+	// Report to Resource run.
+	if runstatus.IsStatusPlanCondition(run) {
+		run.PlanRecord = r.Record
+	} else {
+		run.Record = r.Record
+	}
+
+	run.Status.SetSummary(status.WalkResourceRun(&run.Status))
+	run.Duration = int(time.Since(*run.CreateTime).Seconds())
+
+	run, err = update.
+		SetStatus(run.Status).
+		SetPlanRecord(run.PlanRecord).
+		SetRecord(run.Record).
+		SetDuration(run.Duration).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Store logs.
+	storeLogs(sm, run, r)
+
+	// Clean plan files.
+	cleanPlanFiles(sm, run)
+
+	return runbus.Notify(ctx, mc, run)
+}
+
+// storeLogs stores the logs of the job in chunks,
+// the record of the run is kept for the clients which read the logs from the run directly.
+func storeLogs(sm *storage.Manager, run *model.ResourceRun, r Result) {
+	if sm == nil || (r.Record == "" && len(r.Lines) == 0) {
+		return
+	}
+
+	lines := r.Lines
+	if len(lines) == 0 {
+		lines = logs.FromText(r.Record, time.Now())
+	}
+
+	gopool.Go(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		err := logs.Store(ctx, sm, run, r.TaskType, lines)
+		if err != nil {
+			log.WithName("resource-run").WithName("result").
+				Errorf("failed to store run logs %s: %v", run.ID, err)
+		}
+	})
+}
+
+func cleanPlanFiles(sm *storage.Manager, run *model.ResourceRun) {
+	// When run status is planned, skip it.
+	if sm == nil || runstatus.IsStatusPlanned(run) {
+		return
+	}
+
+	gopool.Go(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		err := sm.DeleteRunPlan(ctx, run)
+		if err != nil {
+			log.WithName("resource-run").WithName("result").
+				Errorf("failed to delete run plan %s: %v", run.ID, err)
+		}
+	})
+}
+
+// setStatusFalse sets the status of the resource run to false with task type.
+func setStatusFalse(run *model.ResourceRun, taskType, errMsg string) {
+	if status.ResourceRunStatusPending.IsUnknown(run) {
+		errMsg = fmt.Sprintf("pending failed: %s", errMsg)
+		status.ResourceRunStatusPending.False(run, errMsg)
+	}
+
+	var ct status.ConditionType
+	switch taskType {
+	case types.RunTaskTypePlan.String():
+		ct = status.ResourceRunStatusPlanned
+		errMsg = fmt.Sprintf("plan failed: %s", errMsg)
+	case types.RunTaskTypeApply.String(), types.RunTaskTypeDestroy.String():
+		ct = status.ResourceRunStatusApplied
+		errMsg = fmt.Sprintf("apply failed: %s", errMsg)
+	}
+
+	if ct.IsUnknown(run) {
+		ct.False(run, errMsg)
+	}
+
+	run.Status.SetSummary(status.WalkResourceRun(&run.Status))
+}
+
+// setStatusTrue sets the status of the resource run to true with task type.
+func setStatusTrue(run *model.ResourceRun, taskType, msg string) {
+	var ct status.ConditionType
+
+	switch taskType {
+	case types.RunTaskTypePlan.String():
+		ct = status.ResourceRunStatusPlanned
+		msg = ""
+	case types.RunTaskTypeApply.String(), types.RunTaskTypeDestroy.String():
+		ct = status.ResourceRunStatusApplied
+	}
+
+	if ct.IsUnknown(run) {
+		ct.True(run, msg)
+	}
+
+	run.Status.SetSummary(status.WalkResourceRun(&run.Status))
+}
@@ -3,6 +3,7 @@ package job
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/client-go/rest"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/pkg/deployer"
 	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
+	"github.com/seal-io/walrus/pkg/resourceruns/approval"
 	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
 	"github.com/seal-io/walrus/utils/log"
 	"github.com/seal-io/walrus/utils/strs"
@@ -55,6 +57,10 @@ func (s syncer) Do(ctx context.Context, bm runbus.BusMessage) (err error) {
 
 	switch {
 	case runstatus.IsStatusPlanned(run):
+		if IsDriftDetectionRun(run) {
+			return s.syncDrift(ctx, mc, dp, entity, run)
+		}
+
 		if !run.Preview {
 			err = PerformRunJob(ctx, mc, dp, run)
 			if err != nil {
@@ -64,6 +70,13 @@ func (s syncer) Do(ctx context.Context, bm runbus.BusMessage) (err error) {
 			return nil
 		}
 
+		// Mark the preview run as pending approval if required,
+		// so that the approvers can approve before applying.
+		_, err = approval.Check(ctx, mc, run)
+		if err != nil {
+			return err
+		}
+
 		s.logger.Debugf("resource %s %q run %s %q, wait to be applied", entity.Name, run.Type, run.ID, run.Status.SummaryStatus)
 
 		return nil
@@ -100,6 +113,15 @@ func (s syncer) Do(ctx context.Context, bm runbus.BusMessage) (err error) {
 			// Deployed.
 			status.ResourceStatusDeployed.True(entity, "")
 			status.ResourceStatusReady.Unknown(entity, "")
+
+			// The resource is back in sync after deployed.
+			if entity.Drift.IsDrifted() {
+				entity.Drift = &types.ResourceDrift{
+					Status: types.ResourceDriftStatusInSync,
+					RunID:  run.ID,
+					Time:   time.Now(),
+				}
+			}
 		}
 	case runstatus.IsStatusFailed(run):
 		// If job fail, and preview is true, then we should not update the resource status.
@@ -111,7 +133,8 @@ func (s syncer) Do(ctx context.Context, bm runbus.BusMessage) (err error) {
 		s.logger.Debugf("resource %s %q run %s status %q", entity.Name, run.Type, run.ID, run.Status.SummaryStatus)
 
 		switch run.Type {
-		case types.RunTypeCreate.String(), types.RunTypeUpdate.String(), types.RunTypeRollback.String(), types.RunTypeStart.String():
+		case types.RunTypeCreate.String(), types.RunTypeUpdate.String(), types.RunTypeRollback.String(),
+			types.RunTypeStart.String(), types.RunTypeRestore.String():
 			status.ResourceStatusDeployed.False(entity, "")
 		case types.RunTypeDelete.String():
 			status.ResourceStatusDeleted.False(entity, "")
@@ -132,7 +155,11 @@ func (s syncer) Do(ctx context.Context, bm runbus.BusMessage) (err error) {
 
 	s.logger.Debugf("set resource %s status to %q", entity.Name, entity.Status.SummaryStatus)
 
-	return mc.Resources().UpdateOne(entity).
-		SetStatus(entity.Status).
-		Exec(ctx)
+	update := mc.Resources().UpdateOne(entity).
+		SetStatus(entity.Status)
+	if entity.Drift != nil {
+		update.SetDrift(entity.Drift)
+	}
+
+	return update.Exec(ctx)
 }
@@ -0,0 +1,209 @@
+package logs
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/storage"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+const (
+	// maxChunkLines is the maximum number of lines of a chunk.
+	maxChunkLines = 1000
+	// maxChunkSize is the maximum size in bytes of a chunk.
+	maxChunkSize = 64 * 1024
+)
+
+// Line is a timestamped line of the logs.
+type Line struct {
+	// Number is the 1-based line number in the whole logs,
+	// it is not stored but filled at reading.
+	Number int `json:"number,omitempty"`
+	// Time is the time when the line is produced.
+	Time time.Time `json:"time"`
+	// Text is the content of the line without the line break.
+	Text string `json:"text"`
+}
+
+// size returns the size in bytes of the line including the line break.
+func (l Line) size() int64 {
+	return int64(len(l.Text)) + 1
+}
+
+// Chunk describes a segment of the logs.
+type Chunk struct {
+	// Seq is the sequence of the chunk, starts from 0.
+	Seq int `json:"seq"`
+	// Offset is the byte offset of the chunk in the whole logs.
+	Offset int64 `json:"offset"`
+	// Size is the size in bytes of the chunk.
+	Size int64 `json:"size"`
+	// Line is the 1-based line number of the first line of the chunk.
+	Line int `json:"line"`
+	// Lines is the count of the lines of the chunk.
+	Lines int `json:"lines"`
+	// Start is the time of the first line of the chunk.
+	Start time.Time `json:"start"`
+	// End is the time of the last line of the chunk.
+	End time.Time `json:"end"`
+}
+
+// Index describes the chunks of the logs,
+// it is stored as the log artifact and the chunks are stored as the parts of the artifact.
+type Index struct {
+	// Size is the total size in bytes of the logs.
+	Size int64 `json:"size"`
+	// Lines is the total count of the lines of the logs.
+	Lines int `json:"lines"`
+	// Chunks holds the chunks in sequence.
+	Chunks []Chunk `json:"chunks"`
+}
+
+// ParseTimestamped parses the logs which each line is prefixed with a RFC3339 timestamp,
+// like the output of the Kubernetes pod logs with timestamps,
+// the lines without valid timestamp inherit the time of the previous line.
+func ParseTimestamped(s string, defaultTime time.Time) []Line {
+	var (
+		lines []Line
+		last  = defaultTime
+	)
+
+	scanLines(s, func(text string) {
+		if ts, rest, ok := strings.Cut(text, " "); ok {
+			if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+				last = t
+				text = rest
+			}
+		}
+
+		lines = append(lines, Line{Time: last, Text: text})
+	})
+
+	return lines
+}
+
+// FromText splits the plain logs into lines with the given time.
+func FromText(s string, t time.Time) []Line {
+	var lines []Line
+
+	scanLines(s, func(text string) {
+		lines = append(lines, Line{Time: t, Text: text})
+	})
+
+	return lines
+}
+
+// Text joins the given lines into plain logs.
+func Text(lines []Line) string {
+	var sb strings.Builder
+
+	for i := range lines {
+		sb.WriteString(lines[i].Text)
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+func scanLines(s string, fn func(string)) {
+	sc := bufio.NewScanner(strings.NewReader(s))
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for sc.Scan() {
+		fn(strings.TrimSuffix(sc.Text(), "\r"))
+	}
+}
+
+// ArtifactKind returns the artifact kind to store the logs of the given job type.
+func ArtifactKind(jobType string) storage.ArtifactKind {
+	if jobType == types.RunTaskTypePlan.String() {
+		return storage.ArtifactPlanLog
+	}
+
+	return storage.ArtifactLog
+}
+
+// Store splits the given lines into chunks and stores them with the index,
+// the index is stored at last so that the readers never see a partial logs.
+func Store(ctx context.Context, sm *storage.Manager, run *model.ResourceRun, jobType string, lines []Line) error {
+	if sm == nil {
+		return errors.New("storage manager is not configured")
+	}
+
+	var (
+		kind  = ArtifactKind(jobType)
+		index Index
+		chunk []Line
+	)
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+
+		c := Chunk{
+			Seq:    len(index.Chunks),
+			Offset: index.Size,
+			Line:   index.Lines + 1,
+			Lines:  len(chunk),
+			Start:  chunk[0].Time,
+			End:    chunk[len(chunk)-1].Time,
+		}
+
+		for i := range chunk {
+			c.Size += chunk[i].size()
+		}
+
+		data, err := json.Marshal(chunk)
+		if err != nil {
+			return err
+		}
+
+		if err = sm.SetRunArtifactPart(ctx, run, kind, c.Seq, data); err != nil {
+			return fmt.Errorf("error storing log chunk %d: %w", c.Seq, err)
+		}
+
+		index.Chunks = append(index.Chunks, c)
+		index.Size += c.Size
+		index.Lines += c.Lines
+		chunk = chunk[:0]
+
+		return nil
+	}
+
+	var size int64
+
+	for i := range lines {
+		l := lines[i]
+		l.Number = 0
+
+		if len(chunk) >= maxChunkLines || (len(chunk) > 0 && size+l.size() > maxChunkSize) {
+			if err := flush(); err != nil {
+				return err
+			}
+
+			size = 0
+		}
+
+		chunk = append(chunk, l)
+		size += l.size()
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+
+	return sm.SetRunArtifact(ctx, run, kind, data)
+}
@@ -0,0 +1,126 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/storage"
+)
+
+func TestParseTimestamped(t *testing.T) {
+	def := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	lines := ParseTimestamped(
+		"2023-10-01T08:00:00.123456789Z Initializing...\n"+
+			"continued\r\n"+
+			"2023-10-01T08:00:01Z Done\n",
+		def)
+
+	require.Len(t, lines, 3)
+	assert.Equal(t, "Initializing...", lines[0].Text)
+	assert.Equal(t, time.Date(2023, 10, 1, 8, 0, 0, 123456789, time.UTC), lines[0].Time)
+	assert.Equal(t, "continued", lines[1].Text)
+	assert.Equal(t, lines[0].Time, lines[1].Time)
+	assert.Equal(t, "Done", lines[2].Text)
+
+	lines = ParseTimestamped("plain", def)
+	require.Len(t, lines, 1)
+	assert.Equal(t, def, lines[0].Time)
+}
+
+func TestStoreAndRead(t *testing.T) {
+	ctx := context.Background()
+
+	drv, err := storage.NewFilesystemDriver(t.TempDir())
+	require.NoError(t, err)
+
+	sm := storage.NewManager(drv)
+
+	run := &model.ResourceRun{
+		ID:            "1",
+		ProjectID:     "2",
+		EnvironmentID: "3",
+	}
+
+	var (
+		start = time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC)
+		lines = make([]Line, 2500)
+		text  strings.Builder
+	)
+
+	for i := range lines {
+		lines[i] = Line{
+			Time: start.Add(time.Duration(i) * time.Second),
+			Text: fmt.Sprintf("line %d", i+1),
+		}
+		text.WriteString(lines[i].Text + "\n")
+	}
+
+	apply := types.RunTaskTypeApply.String()
+
+	require.NoError(t, Store(ctx, sm, run, apply, lines))
+
+	r, err := Open(ctx, sm, run, apply)
+	require.NoError(t, err)
+
+	idx := r.Index()
+	assert.Equal(t, 2500, idx.Lines)
+	assert.Equal(t, int64(text.Len()), idx.Size)
+	assert.Len(t, idx.Chunks, 3)
+
+	// Lines across chunks.
+	got, err := r.ReadLines(ctx, 999, 3)
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.Equal(t, 999, got[0].Number)
+	assert.Equal(t, "line 1001", got[2].Text)
+
+	got, err = r.Tail(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "line 2500", got[1].Text)
+
+	// Bytes across chunks.
+	off := strings.Index(text.String(), "line 1000\n")
+	b, err := r.ReadBytes(ctx, int64(off), 20)
+	require.NoError(t, err)
+	assert.Equal(t, text.String()[off:off+20], string(b))
+
+	// Search.
+	got, err = r.Search(ctx, SearchOptions{Query: "LINE 25", Since: start.Add(2000 * time.Second)})
+	require.NoError(t, err)
+	// Only "line 2500" matches in the time range.
+	require.Len(t, got, 1)
+	assert.Equal(t, 2500, got[0].Number)
+
+	// Download.
+	var buf bytes.Buffer
+	require.NoError(t, r.Copy(ctx, &buf, false))
+	assert.Equal(t, text.String(), buf.String())
+
+	// Fall back to the record of the run.
+	run.PlanRecord = "planning\nplanned\n"
+
+	r, err = Open(ctx, sm, run, types.RunTaskTypePlan.String())
+	require.NoError(t, err)
+
+	got, err = r.ReadLines(ctx, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "planned", got[0].Text)
+
+	// Clean up the chunks.
+	require.NoError(t, sm.DeleteRunArtifacts(ctx, run))
+
+	_, err = sm.GetRunArtifactPart(ctx, run, storage.ArtifactLog, 0)
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+}
@@ -0,0 +1,303 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/storage"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// Reader reads the stored logs of a job of the resource run.
+type Reader struct {
+	sm    *storage.Manager
+	run   *model.ResourceRun
+	kind  storage.ArtifactKind
+	index Index
+
+	// inline holds the lines of the logs which are not stored in chunks,
+	// like the logs stored by the previous version or recorded in the run only.
+	inline []Line
+}
+
+// Open opens the stored logs of the given job type of the run,
+// it falls back to the record of the run if the logs are not stored in chunks.
+func Open(ctx context.Context, sm *storage.Manager, run *model.ResourceRun, jobType string) (*Reader, error) {
+	r := &Reader{
+		sm:   sm,
+		run:  run,
+		kind: ArtifactKind(jobType),
+	}
+
+	t := runTime(run)
+
+	if sm != nil {
+		data, err := sm.GetRunArtifact(ctx, run, r.kind)
+
+		switch {
+		case err == nil:
+			if bytes.HasPrefix(data, []byte("{")) && json.Unmarshal(data, &r.index) == nil {
+				return r, nil
+			}
+
+			// Stored in plain text.
+			r.setInline(FromText(string(data), t))
+
+			return r, nil
+		case !errors.Is(err, storage.ErrNotFound):
+			return nil, err
+		}
+	}
+
+	record := run.Record
+	if jobType == types.RunTaskTypePlan.String() {
+		record = run.PlanRecord
+	}
+
+	r.setInline(FromText(record, t))
+
+	return r, nil
+}
+
+// runTime returns the time of the lines which are not timestamped.
+func runTime(run *model.ResourceRun) time.Time {
+	if run.CreateTime == nil {
+		return time.Time{}
+	}
+
+	return run.CreateTime.Add(time.Duration(run.Duration) * time.Second)
+}
+
+func (r *Reader) setInline(lines []Line) {
+	r.inline = lines
+	r.index = Index{}
+
+	if len(lines) == 0 {
+		return
+	}
+
+	c := Chunk{
+		Line:  1,
+		Lines: len(lines),
+		Start: lines[0].Time,
+		End:   lines[len(lines)-1].Time,
+	}
+
+	for i := range lines {
+		r.inline[i].Number = i + 1
+		c.Size += lines[i].size()
+	}
+
+	r.index = Index{
+		Size:   c.Size,
+		Lines:  c.Lines,
+		Chunks: []Chunk{c},
+	}
+}
+
+// Index returns the index of the logs.
+func (r *Reader) Index() Index {
+	return r.index
+}
+
+// chunk returns the lines of the given chunk with line numbers.
+func (r *Reader) chunk(ctx context.Context, c Chunk) ([]Line, error) {
+	if r.inline != nil {
+		return r.inline, nil
+	}
+
+	data, err := r.sm.GetRunArtifactPart(ctx, r.run, r.kind, c.Seq)
+	if err != nil {
+		return nil, fmt.Errorf("error getting log chunk %d: %w", c.Seq, err)
+	}
+
+	var lines []Line
+	if err = json.Unmarshal(data, &lines); err != nil {
+		return nil, fmt.Errorf("error decoding log chunk %d: %w", c.Seq, err)
+	}
+
+	for i := range lines {
+		lines[i].Number = c.Line + i
+	}
+
+	return lines, nil
+}
+
+// ReadLines returns at most count lines starting from the given 1-based line number.
+func (r *Reader) ReadLines(ctx context.Context, from, count int) ([]Line, error) {
+	if from < 1 {
+		from = 1
+	}
+
+	if count <= 0 || from > r.index.Lines {
+		return nil, nil
+	}
+
+	to := from + count // Exclusive.
+
+	// Find the first chunk contains the line.
+	i := sort.Search(len(r.index.Chunks), func(i int) bool {
+		c := r.index.Chunks[i]
+		return c.Line+c.Lines > from
+	})
+
+	var lines []Line
+
+	for ; i < len(r.index.Chunks) && r.index.Chunks[i].Line < to; i++ {
+		c := r.index.Chunks[i]
+
+		cls, err := r.chunk(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		for j := range cls {
+			if n := cls[j].Number; n >= from && n < to {
+				lines = append(lines, cls[j])
+			}
+		}
+	}
+
+	return lines, nil
+}
+
+// Tail returns the last count lines.
+func (r *Reader) Tail(ctx context.Context, count int) ([]Line, error) {
+	return r.ReadLines(ctx, r.index.Lines-count+1, count)
+}
+
+// ReadBytes returns at most length bytes of the plain logs starting from the given byte offset.
+func (r *Reader) ReadBytes(ctx context.Context, offset, length int64) ([]byte, error) {
+	if offset < 0 {
+		offset = 0
+	}
+
+	if length <= 0 || offset >= r.index.Size {
+		return nil, nil
+	}
+
+	end := offset + length // Exclusive.
+	if end > r.index.Size {
+		end = r.index.Size
+	}
+
+	// Find the first chunk contains the offset.
+	i := sort.Search(len(r.index.Chunks), func(i int) bool {
+		c := r.index.Chunks[i]
+		return c.Offset+c.Size > offset
+	})
+
+	buf := make([]byte, 0, end-offset)
+
+	for ; i < len(r.index.Chunks) && r.index.Chunks[i].Offset < end; i++ {
+		c := r.index.Chunks[i]
+
+		cls, err := r.chunk(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		data := []byte(Text(cls))
+
+		s, e := offset-c.Offset, end-c.Offset
+		if s < 0 {
+			s = 0
+		}
+
+		if e > int64(len(data)) {
+			e = int64(len(data))
+		}
+
+		buf = append(buf, data[s:e]...)
+	}
+
+	return buf, nil
+}
+
+// SearchOptions holds the options of searching the logs.
+type SearchOptions struct {
+	// Query is the text to search, it is matched case-insensitively.
+	Query string
+	// Since filters the lines produced at or after the given time.
+	Since time.Time
+	// Until filters the lines produced at or before the given time.
+	Until time.Time
+	// Limit is the maximum count of the matched lines, no limit if not positive.
+	Limit int
+}
+
+// Search returns the lines contain the query,
+// the chunks out of the time range are skipped without loading.
+func (r *Reader) Search(ctx context.Context, opts SearchOptions) ([]Line, error) {
+	query := strings.ToLower(opts.Query)
+
+	inRange := func(t time.Time) bool {
+		return (opts.Since.IsZero() || !t.Before(opts.Since)) &&
+			(opts.Until.IsZero() || !t.After(opts.Until))
+	}
+
+	var matches []Line
+
+	for _, c := range r.index.Chunks {
+		if !opts.Since.IsZero() && c.End.Before(opts.Since) ||
+			!opts.Until.IsZero() && c.Start.After(opts.Until) {
+			continue
+		}
+
+		cls, err := r.chunk(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range cls {
+			if !inRange(cls[i].Time) || !strings.Contains(strings.ToLower(cls[i].Text), query) {
+				continue
+			}
+
+			matches = append(matches, cls[i])
+
+			if opts.Limit > 0 && len(matches) >= opts.Limit {
+				return matches, nil
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// Copy writes the whole plain logs into the given writer,
+// each line is prefixed with its RFC3339 timestamp if timestamps is true.
+func (r *Reader) Copy(ctx context.Context, w io.Writer, timestamps bool) error {
+	for _, c := range r.index.Chunks {
+		cls, err := r.chunk(ctx, c)
+		if err != nil {
+			return err
+		}
+
+		var sb strings.Builder
+
+		for i := range cls {
+			if timestamps {
+				sb.WriteString(cls[i].Time.Format(time.RFC3339Nano))
+				sb.WriteByte(' ')
+			}
+
+			sb.WriteString(cls[i].Text)
+			sb.WriteByte('\n')
+		}
+
+		if _, err = io.WriteString(w, sb.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
 	"github.com/seal-io/walrus/pkg/resourceruns/annotations"
+	runconfig "github.com/seal-io/walrus/pkg/resourceruns/config"
 	runjob "github.com/seal-io/walrus/pkg/resourceruns/job"
 	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
 	"github.com/seal-io/walrus/pkg/storage"
@@ -40,7 +41,8 @@ type CreateOptions struct {
 	// ResourceID is the ID of the resource.
 	ResourceID object.ID
 
-	// DeployerType is the type of the deployer that run uses.
+	// DeployerType is the type of the deployer that run uses,
+	// it can be overridden by the deployer annotations of the environment or template.
 	// +required: true
 	DeployerType string
 
@@ -61,9 +63,7 @@ type CreateOptions struct {
 func Create(ctx context.Context, mc model.ClientSet, opts CreateOptions) (*model.ResourceRun, error) {
 	// Validate if there is a running run.
 	prevEntity, err := mc.ResourceRuns().Query().
-		Where(resourcerun.And(
-			resourcerun.ResourceID(opts.ResourceID),
-			resourcerun.DeployerType(opts.DeployerType))).
+		Where(resourcerun.ResourceID(opts.ResourceID)).
 		Order(model.Desc(resourcerun.FieldCreateTime)).
 		First(ctx)
 	if err != nil && !model.IsNotFound(err) {
@@ -142,6 +142,17 @@ func Create(ctx context.Context, mc model.ClientSet, opts CreateOptions) (*model
 		return nil, errors.New("missing template or resource definition")
 	}
 
+	// Choose the deployer by the environment or template.
+	spec, err := runconfig.GetDeployerSpec(ctx, mc, res.EnvironmentID, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	deployerType := opts.DeployerType
+	if spec.Type != "" {
+		deployerType = spec.Type
+	}
+
 	s, err := session.GetSubject(ctx)
 	if err != nil {
 		return nil, err
@@ -163,7 +174,7 @@ func Create(ctx context.Context, mc model.ClientSet, opts CreateOptions) (*model
 		TemplateVersion:    templateVersion,
 		Attributes:         attributes,
 		ComputedAttributes: computedAttributes,
-		DeployerType:       opts.DeployerType,
+		DeployerType:       deployerType,
 		CreatedBy:          userSubject.Name,
 		ChangeComment:      opts.ChangeComment,
 		Type:               opts.Type.String(),
@@ -176,12 +187,13 @@ func Create(ctx context.Context, mc model.ClientSet, opts CreateOptions) (*model
 
 	output := res.Edges.State.Data
 
-	if prevEntity != nil && output != "" {
+	if prevEntity != nil && output != "" && types.IsHCLDeployerType(deployerType) {
 		switch {
 		case opts.Type == types.RunTypeCreate ||
 			opts.Type == types.RunTypeUpdate ||
 			opts.Type == types.RunTypeStart ||
-			opts.Type == types.RunTypeRollback:
+			opts.Type == types.RunTypeRollback ||
+			opts.Type == types.RunTypeRestore:
 			// Get required providers from the previous output after first deployment.
 			requiredProviders, err := getRequiredProviders(ctx, mc, opts.ResourceID, output)
 			if err != nil {
@@ -301,6 +313,36 @@ func Apply(ctx context.Context, mc model.ClientSet, dp deptypes.Deployer, run *m
 	return runjob.PerformRunJob(ctx, mc, dp, run)
 }
 
+// CleanRunArtifacts removes all artifacts of the given runs in background,
+// i.e. plans, logs and state snapshots.
+func CleanRunArtifacts(ctx context.Context, mc model.ClientSet, sm *storage.Manager, ids ...object.ID) error {
+	logger := log.WithName("resource-run")
+
+	if sm == nil {
+		return nil
+	}
+
+	runs, err := mc.ResourceRuns().Query().
+		Where(resourcerun.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	gopool.Go(func() {
+		subCtx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+		defer cancel()
+
+		for i := range runs {
+			if rerr := sm.DeleteRunArtifacts(subCtx, runs[i]); rerr != nil {
+				logger.Error(rerr, "failed to delete run artifacts", "run", runs[i].ID)
+			}
+		}
+	})
+
+	return nil
+}
+
 func CleanPlanFiles(ctx context.Context, mc model.ClientSet, sm *storage.Manager, ids ...object.ID) error {
 	logger := log.WithName("resource-run")
 
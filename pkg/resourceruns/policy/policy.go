@@ -0,0 +1,216 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/antonmedv/expr"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerunpolicy"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// Input is the input of the policy expression,
+// the fields are exposed as the variables of the expression.
+type Input struct {
+	// Plan is the JSON plan of the run.
+	Plan map[string]any `expr:"plan"`
+	// Changes is the changes of the resource components.
+	Changes []any `expr:"changes"`
+	// Summary is the change summary of the resource components.
+	Summary map[string]any `expr:"summary"`
+	// Run is the attributes of the run.
+	Run map[string]any `expr:"run"`
+	// Environment is the attributes of the environment.
+	Environment map[string]any `expr:"environment"`
+}
+
+// Validate validates the given policy expression.
+func Validate(expression string) error {
+	_, err := expr.Compile(expression, expr.Env(Input{}))
+	return err
+}
+
+// Evaluate evaluates the enabled policies attached to the project and the environment of the given run,
+// against the given JSON plan and the component changes of the run.
+func Evaluate(
+	ctx context.Context,
+	mc model.ClientSet,
+	run *model.ResourceRun,
+	jsonPlan []byte,
+) (types.ResourceRunPolicyResults, error) {
+	policies, err := mc.ResourceRunPolicies().Query().
+		Where(
+			resourcerunpolicy.ProjectID(run.ProjectID),
+			resourcerunpolicy.Or(
+				resourcerunpolicy.EnvironmentIDIsNil(),
+				resourcerunpolicy.EnvironmentID(run.EnvironmentID)),
+			resourcerunpolicy.Disabled(false)).
+		Order(model.Asc(resourcerunpolicy.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	env, err := mc.Environments().Query().
+		Select(
+			environment.FieldID,
+			environment.FieldName,
+			environment.FieldType,
+			environment.FieldLabels).
+		Where(environment.ID(run.EnvironmentID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in, err := getInput(run, env, jsonPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(types.ResourceRunPolicyResults, len(policies))
+	for i := range policies {
+		results[i] = evaluate(policies[i], in)
+	}
+
+	return results, nil
+}
+
+// DeniedMessage returns the message of the given denied results.
+func DeniedMessage(results types.ResourceRunPolicyResults) string {
+	msgs := make([]string, len(results))
+	for i := range results {
+		msgs[i] = fmt.Sprintf("%s: %s", results[i].PolicyName, results[i].Message)
+	}
+
+	return "blocked by policies: " + strings.Join(msgs, "; ")
+}
+
+func getInput(run *model.ResourceRun, env *model.Environment, jsonPlan []byte) (Input, error) {
+	in := Input{
+		Plan:    map[string]any{},
+		Changes: []any{},
+		Summary: map[string]any{
+			"created": run.ComponentChangeSummary.Created,
+			"updated": run.ComponentChangeSummary.Updated,
+			"deleted": run.ComponentChangeSummary.Deleted,
+		},
+		Run: map[string]any{
+			"id":              run.ID.String(),
+			"type":            run.Type,
+			"preview":         run.Preview,
+			"templateName":    run.TemplateName,
+			"templateVersion": run.TemplateVersion,
+			"deployerType":    run.DeployerType,
+			"createdBy":       run.CreatedBy,
+			"labels":          run.Labels,
+		},
+		Environment: map[string]any{
+			"id":     env.ID.String(),
+			"name":   env.Name,
+			"type":   env.Type,
+			"labels": env.Labels,
+		},
+	}
+
+	if len(jsonPlan) != 0 {
+		if err := json.Unmarshal(jsonPlan, &in.Plan); err != nil {
+			return Input{}, fmt.Errorf("error decoding plan: %w", err)
+		}
+	}
+
+	// Convert the changes into generic values,
+	// so that the expression accesses the changes in the same way as the JSON plan.
+	if len(run.ComponentChanges) != 0 {
+		bs, err := json.Marshal(run.ComponentChanges)
+		if err != nil {
+			return Input{}, err
+		}
+
+		if err = json.Unmarshal(bs, &in.Changes); err != nil {
+			return Input{}, err
+		}
+	}
+
+	return in, nil
+}
+
+// evaluate evaluates the given policy with the input,
+// the policy failing to evaluate is treated as violated.
+func evaluate(p *model.ResourceRunPolicy, in Input) types.ResourceRunPolicyResult {
+	r := types.ResourceRunPolicyResult{
+		PolicyID:    p.ID.String(),
+		PolicyName:  p.Name,
+		Enforcement: p.Enforcement,
+	}
+
+	violated, details, err := execute(p.Expression, in)
+	if err != nil {
+		r.Violated = true
+		r.Message = fmt.Sprintf("error evaluating policy: %v", err)
+
+		return r
+	}
+
+	if !violated {
+		return r
+	}
+
+	r.Violated = true
+	r.Message = p.Message
+	r.Details = details
+
+	if r.Message == "" {
+		r.Message = "policy is violated"
+	}
+
+	return r
+}
+
+// execute runs the given expression with the input,
+// the expression returns true or a non-empty string or list if violated,
+// the returned string or list items are treated as the violation details.
+func execute(expression string, in Input) (bool, []string, error) {
+	program, err := expr.Compile(expression, expr.Env(Input{}))
+	if err != nil {
+		return false, nil, err
+	}
+
+	out, err := expr.Run(program, in)
+	if err != nil {
+		return false, nil, err
+	}
+
+	switch v := out.(type) {
+	case nil:
+		return false, nil, nil
+	case bool:
+		return v, nil, nil
+	case string:
+		if v == "" {
+			return false, nil, nil
+		}
+
+		return true, []string{v}, nil
+	case []string:
+		return len(v) != 0, v, nil
+	case []any:
+		details := make([]string, len(v))
+		for i := range v {
+			details[i] = fmt.Sprint(v[i])
+		}
+
+		return len(v) != 0, details, nil
+	}
+
+	return false, nil, fmt.Errorf("unexpected result type %T", out)
+}
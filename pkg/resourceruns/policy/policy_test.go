@@ -0,0 +1,123 @@
+package policy
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+func TestEvaluate(t *testing.T) {
+	run := &model.ResourceRun{
+		ID:   "1",
+		Type: types.RunTypeUpdate.String(),
+		ComponentChanges: []*types.ResourceComponentChange{
+			{
+				ResourceChange: &tfjson.ResourceChange{
+					Address: "aws_instance.test",
+					Type:    "aws_instance",
+					Name:    "test",
+				},
+				Change: &types.Change{
+					Change: &tfjson.Change{
+						Actions: tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate},
+					},
+					Type: types.ResourceComponentChangeTypeUpdate,
+				},
+			},
+		},
+		ComponentChangeSummary: types.ResourceComponentChangeSummary{
+			Updated: 1,
+		},
+	}
+	env := &model.Environment{
+		ID:   "2",
+		Name: "prod",
+		Type: types.EnvironmentProduction,
+	}
+	jsonPlan := []byte(`{"format_version":"1.2","variables":{"instance_type":{"value":"m5.large"}}}`)
+
+	in, err := getInput(run, env, jsonPlan)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		given    model.ResourceRunPolicy
+		expected types.ResourceRunPolicyResult
+	}{
+		{
+			name: "passed",
+			given: model.ResourceRunPolicy{
+				Name:        "no-deletion",
+				Enforcement: types.PolicyEnforcementDeny,
+				Expression:  `summary.deleted > 0`,
+			},
+			expected: types.ResourceRunPolicyResult{
+				PolicyName:  "no-deletion",
+				Enforcement: types.PolicyEnforcementDeny,
+			},
+		},
+		{
+			name: "violated by boolean",
+			given: model.ResourceRunPolicy{
+				Name:        "no-large-instance",
+				Enforcement: types.PolicyEnforcementWarn,
+				Expression:  `environment.type == "production" && plan.variables.instance_type.value endsWith "large"`,
+			},
+			expected: types.ResourceRunPolicyResult{
+				PolicyName:  "no-large-instance",
+				Enforcement: types.PolicyEnforcementWarn,
+				Violated:    true,
+				Message:     "policy is violated",
+			},
+		},
+		{
+			name: "violated by list",
+			given: model.ResourceRunPolicy{
+				Name:        "no-replacement",
+				Enforcement: types.PolicyEnforcementDeny,
+				Expression: `map(filter(changes, "delete" in .change.actions && "create" in .change.actions),` +
+					` .address + " is replaced")`,
+				Message: "replacement is not allowed",
+			},
+			expected: types.ResourceRunPolicyResult{
+				PolicyName:  "no-replacement",
+				Enforcement: types.PolicyEnforcementDeny,
+				Violated:    true,
+				Message:     "replacement is not allowed",
+				Details:     []string{"aws_instance.test is replaced"},
+			},
+		},
+		{
+			name: "failed to evaluate",
+			given: model.ResourceRunPolicy{
+				Name:        "invalid",
+				Enforcement: types.PolicyEnforcementDeny,
+				Expression:  `summary.updated`,
+			},
+			expected: types.ResourceRunPolicyResult{
+				PolicyName:  "invalid",
+				Enforcement: types.PolicyEnforcementDeny,
+				Violated:    true,
+				Message:     "error evaluating policy: unexpected result type int",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := evaluate(&tc.given, in)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(`len(changes) > 10`))
+	assert.Error(t, Validate(`unknown > 10`))
+	assert.Error(t, Validate(`changes >`))
+}
@@ -0,0 +1,344 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"golang.org/x/exp/slices"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/connector"
+	"github.com/seal-io/walrus/pkg/dao/model/environment"
+	"github.com/seal-io/walrus/pkg/dao/model/environmentconnectorrelationship"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	"github.com/seal-io/walrus/pkg/settings"
+)
+
+const (
+	ScopeEnvironment = "environment"
+	ScopeConnector   = "connector"
+)
+
+// _reservationTTL is the maximum duration to keep the slot of an admitted run,
+// the run should have started its job within the duration.
+const _reservationTTL = time.Minute
+
+var (
+	// mu serializes the admission of the runs.
+	mu sync.Mutex
+	// reservations holds the slots of the admitted runs which job is not observed yet,
+	// it prevents the concurrent admissions from exceeding the limits.
+	reservations = map[object.ID]time.Time{}
+)
+
+// Position describes the position of a queued run.
+type Position struct {
+	// Position is the 1-based position of the run in the blocking scope.
+	Position int `json:"position"`
+	// Scope is the kind of the blocking scope, either environment or connector.
+	Scope string `json:"scope"`
+	// ScopeID is the ID of the blocking environment or connector.
+	ScopeID object.ID `json:"scopeID"`
+	// ScopeName is the name of the blocking environment or connector.
+	ScopeName string `json:"scopeName"`
+	// Limit is the concurrency limit of the blocking scope.
+	Limit int `json:"limit"`
+	// Running is the number of the executing runs in the blocking scope.
+	Running int `json:"running"`
+}
+
+// Message returns the human-readable message of the position.
+func (p Position) Message() string {
+	return fmt.Sprintf("Waiting in the run queue at position %d, "+
+		"%d of %d runs are executing in %s %s",
+		p.Position, p.Running, p.Limit, p.Scope, p.ScopeName)
+}
+
+// Admit checks whether the given run can execute its job under the concurrency limits
+// of its environment and the connectors of its environment.
+//
+// If admitted, the run holds a slot until its job is observed executing,
+// otherwise, the run is marked as queued with its position,
+// and waits for the next admission.
+func Admit(ctx context.Context, mc model.ClientSet, run *model.ResourceRun) (bool, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	pos, err := evaluate(ctx, mc, run, true)
+	if err != nil {
+		return false, err
+	}
+
+	if pos == nil {
+		reservations[run.ID] = time.Now().Add(_reservationTTL)
+
+		// The run status is persisted along with the following job status changes.
+		status.ResourceRunStatusQueued.Remove(run)
+		run.Status.SetSummary(status.WalkResourceRun(&run.Status))
+
+		return true, nil
+	}
+
+	msg := pos.Message()
+	if runstatus.IsStatusQueued(run) && status.ResourceRunStatusQueued.GetMessage(run) == msg {
+		return false, nil
+	}
+
+	status.ResourceRunStatusQueued.Unknown(run, msg)
+
+	_, err = runstatus.UpdateStatus(ctx, mc, run)
+
+	return false, err
+}
+
+// Release releases the slot held by the given run.
+func Release(runID object.ID) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	delete(reservations, runID)
+}
+
+// GetPosition returns the position of the given run in the queue,
+// returns nil if the run is not queued or can be admitted.
+func GetPosition(ctx context.Context, mc model.ClientSet, run *model.ResourceRun) (*Position, error) {
+	if !runstatus.IsStatusQueued(run) {
+		return nil, nil
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	return evaluate(ctx, mc, run, false)
+}
+
+// scope is a set of environments sharing a concurrency limit.
+type scope struct {
+	kind           string
+	id             object.ID
+	name           string
+	limit          int
+	environmentIDs []object.ID
+}
+
+// evaluate returns the position of the given run in the first blocking scope,
+// returns nil if no scope blocks the run.
+func evaluate(ctx context.Context, mc model.ClientSet, run *model.ResourceRun, gc bool) (*Position, error) {
+	scopes, err := getScopes(ctx, mc, run.EnvironmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(scopes) == 0 {
+		return nil, nil
+	}
+
+	envIDs := make([]object.ID, 0, len(scopes))
+	for i := range scopes {
+		envIDs = append(envIDs, scopes[i].environmentIDs...)
+	}
+
+	runs, err := mc.ResourceRuns().Query().
+		Select(
+			resourcerun.FieldID,
+			resourcerun.FieldCreateTime,
+			resourcerun.FieldEnvironmentID,
+			resourcerun.FieldPreview,
+			resourcerun.FieldStatus).
+		Where(
+			resourcerun.EnvironmentIDIn(envIDs...),
+			resourcerun.IDNEQ(run.ID),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueIn(
+					resourcerun.FieldStatus,
+					[]any{
+						status.ResourceRunSummaryStatusQueued,
+						status.ResourceRunSummaryStatusPending,
+						status.ResourceRunSummaryStatusPlanning,
+						status.ResourceRunSummaryStatusPlanned,
+						status.ResourceRunSummaryStatusRunning,
+					},
+					sqljson.Path("summaryStatus"),
+				))
+			}).
+		Order(model.Asc(resourcerun.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if gc {
+		gcReservations(runs)
+	}
+
+	for _, s := range scopes {
+		var running, ahead int
+
+		for _, r := range runs {
+			if !slices.Contains(s.environmentIDs, r.EnvironmentID) {
+				continue
+			}
+
+			switch {
+			case isExecuting(r):
+				running++
+			case runstatus.IsStatusQueued(r) && isAhead(r, run):
+				ahead++
+			}
+		}
+
+		if running+ahead < s.limit {
+			continue
+		}
+
+		return &Position{
+			Position:  ahead + 1,
+			Scope:     s.kind,
+			ScopeID:   s.id,
+			ScopeName: s.name,
+			Limit:     s.limit,
+			Running:   running,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// getScopes returns the limited scopes of the given environment.
+func getScopes(ctx context.Context, mc model.ClientSet, environmentID object.ID) ([]scope, error) {
+	env, err := mc.Environments().Query().
+		Select(
+			environment.FieldID,
+			environment.FieldName,
+			environment.FieldAnnotations).
+		Where(environment.ID(environmentID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var scopes []scope
+
+	envLimit := getLimit(env.Annotations,
+		settings.ResourceRunEnvironmentConcurrency.ShouldValueInt64(ctx, mc))
+	if envLimit > 0 {
+		scopes = append(scopes, scope{
+			kind:           ScopeEnvironment,
+			id:             env.ID,
+			name:           env.Name,
+			limit:          envLimit,
+			environmentIDs: []object.ID{env.ID},
+		})
+	}
+
+	conns, err := mc.Connectors().Query().
+		Select(
+			connector.FieldID,
+			connector.FieldName,
+			connector.FieldAnnotations).
+		Where(connector.HasEnvironmentsWith(environmentconnectorrelationship.EnvironmentID(environmentID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	connDefLimit := settings.ResourceRunConnectorConcurrency.ShouldValueInt64(ctx, mc)
+
+	for _, c := range conns {
+		limit := getLimit(c.Annotations, connDefLimit)
+		if limit <= 0 {
+			continue
+		}
+
+		envIDs, err := mc.EnvironmentConnectorRelationships().Query().
+			Where(environmentconnectorrelationship.ConnectorID(c.ID)).
+			Select(environmentconnectorrelationship.FieldEnvironmentID).
+			Strings(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]object.ID, len(envIDs))
+		for i := range envIDs {
+			ids[i] = object.ID(envIDs[i])
+		}
+
+		scopes = append(scopes, scope{
+			kind:           ScopeConnector,
+			id:             c.ID,
+			name:           c.Name,
+			limit:          limit,
+			environmentIDs: ids,
+		})
+	}
+
+	return scopes, nil
+}
+
+// getLimit returns the concurrency limit from the given annotations,
+// returns the given default limit if not specified.
+func getLimit(annotations map[string]string, def int64) int {
+	if v, ok := annotations[types.AnnotationRunConcurrency]; ok {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+
+	return int(def)
+}
+
+// isExecuting returns true if the given run is executing its job,
+// or is going to execute its job without admission.
+func isExecuting(run *model.ResourceRun) bool {
+	if _, ok := reservations[run.ID]; ok {
+		return true
+	}
+
+	return status.ResourceRunStatusPlanned.IsUnknown(run) ||
+		status.ResourceRunStatusApplied.IsUnknown(run) ||
+		// The not preview run is applied after planned without admission.
+		(runstatus.IsStatusPlanned(run) && !run.Preview &&
+			!runstatus.IsStatusQueued(run) && !runstatus.IsStatusPendingApproval(run))
+}
+
+// isAhead returns true if the queued run is ahead of the given run.
+func isAhead(queued, run *model.ResourceRun) bool {
+	if queued.CreateTime == nil || run.CreateTime == nil {
+		return true
+	}
+
+	return queued.CreateTime.Before(*run.CreateTime)
+}
+
+// gcReservations drops the expired reservations and the reservations of the runs
+// which job is observed executing or which is not running any more.
+func gcReservations(runs model.ResourceRuns) {
+	now := time.Now()
+
+	observed := make(map[object.ID]*model.ResourceRun, len(runs))
+	for i := range runs {
+		observed[runs[i].ID] = runs[i]
+	}
+
+	for id, expiry := range reservations {
+		r, ok := observed[id]
+
+		switch {
+		case now.After(expiry):
+		case ok && (status.ResourceRunStatusPlanned.IsUnknown(r) || status.ResourceRunStatusApplied.IsUnknown(r)):
+		default:
+			continue
+		}
+
+		delete(reservations, id)
+	}
+}
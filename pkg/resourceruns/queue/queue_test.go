@@ -0,0 +1,167 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+const (
+	testDriverName     = "sqlite3"
+	testDataSourceName = "file:resourcerunqueue?mode=memory&cache=shared&_fk=1"
+)
+
+func testRun(
+	ctx context.Context,
+	t *testing.T,
+	mc model.ClientSet,
+	res *model.Resource,
+	mark func(*model.ResourceRun),
+) *model.ResourceRun {
+	t.Helper()
+
+	entity := &model.ResourceRun{
+		ProjectID:       res.ProjectID,
+		EnvironmentID:   res.EnvironmentID,
+		ResourceID:      res.ID,
+		TemplateID:      "1",
+		TemplateName:    "test",
+		TemplateVersion: "v0.0.1",
+		InputConfigs:    map[string]types.ResourceRunConfigData{},
+		CreatedBy:       "admin",
+		Type:            types.RunTypeCreate.String(),
+	}
+
+	status.ResourceRunStatusPending.Unknown(entity, "")
+	mark(entity)
+	entity.Status.SetSummary(status.WalkResourceRun(&entity.Status))
+
+	run, err := mc.ResourceRuns().Create().
+		Set(entity).
+		Save(ctx)
+	require.NoError(t, err, "error creating run")
+
+	// Keep the creation order.
+	time.Sleep(10 * time.Millisecond)
+
+	return run
+}
+
+func TestAdmit(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, testDriverName, testDataSourceName)
+	defer client.Close()
+
+	proj, err := client.Projects().Create().
+		SetName("queue").
+		Save(ctx)
+	require.NoError(t, err)
+
+	env, err := client.Environments().Create().
+		SetProjectID(proj.ID).
+		SetName("queue").
+		SetType(types.EnvironmentDevelopment).
+		SetAnnotations(map[string]string{
+			types.AnnotationRunConcurrency: "1",
+		}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	resources := make([]*model.Resource, 3)
+	for i, n := range []string{"a", "b", "c"} {
+		resources[i], err = client.Resources().Create().
+			SetProjectID(proj.ID).
+			SetEnvironmentID(env.ID).
+			SetName(n).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	// The run of resource a is executing.
+	planning := testRun(ctx, t, client, resources[0], func(r *model.ResourceRun) {
+		status.ResourceRunStatusPending.True(r, "")
+		status.ResourceRunStatusPlanned.Unknown(r, "")
+	})
+
+	pending := testRun(ctx, t, client, resources[1], func(*model.ResourceRun) {})
+	later := testRun(ctx, t, client, resources[2], func(*model.ResourceRun) {})
+
+	// Both pending runs are queued by the limit.
+	admitted, err := Admit(ctx, client, pending)
+	require.NoError(t, err)
+	assert.False(t, admitted)
+	assert.True(t, runstatus.IsStatusQueued(pending))
+	assert.Equal(t, status.ResourceRunSummaryStatusQueued, pending.Status.SummaryStatus)
+
+	admitted, err = Admit(ctx, client, later)
+	require.NoError(t, err)
+	assert.False(t, admitted)
+
+	pos, err := GetPosition(ctx, client, later)
+	require.NoError(t, err)
+
+	if assert.NotNil(t, pos) {
+		assert.Equal(t, 2, pos.Position)
+		assert.Equal(t, ScopeEnvironment, pos.Scope)
+		assert.Equal(t, 1, pos.Limit)
+		assert.Equal(t, 1, pos.Running)
+	}
+
+	// Finish the executing run.
+	status.ResourceRunStatusPlanned.False(planning, "")
+	_, err = runstatus.UpdateStatus(ctx, client, planning)
+	require.NoError(t, err)
+
+	// The later run still waits for the earlier one.
+	admitted, err = Admit(ctx, client, later)
+	require.NoError(t, err)
+	assert.False(t, admitted)
+
+	admitted, err = Admit(ctx, client, pending)
+	require.NoError(t, err)
+	assert.True(t, admitted)
+	assert.False(t, runstatus.IsStatusQueued(pending))
+	assert.Equal(t, status.ResourceRunSummaryStatusPending, pending.Status.SummaryStatus)
+
+	_, err = runstatus.UpdateStatus(ctx, client, pending)
+	require.NoError(t, err)
+
+	// The admitted run holds the slot until released.
+	admitted, err = Admit(ctx, client, later)
+	require.NoError(t, err)
+	assert.False(t, admitted)
+
+	pos, err = GetPosition(ctx, client, later)
+	require.NoError(t, err)
+
+	if assert.NotNil(t, pos) {
+		assert.Equal(t, 1, pos.Position)
+	}
+
+	Release(pending.ID)
+	status.ResourceRunStatusPending.False(pending, "")
+	_, err = runstatus.UpdateStatus(ctx, client, pending)
+	require.NoError(t, err)
+
+	admitted, err = Admit(ctx, client, later)
+	require.NoError(t, err)
+	assert.True(t, admitted)
+}
@@ -9,10 +9,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	runbus "github.com/seal-io/walrus/pkg/bus/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/resource"
 	"github.com/seal-io/walrus/pkg/dao/model/resourcerelationship"
 	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	resstatus "github.com/seal-io/walrus/pkg/resources/status"
 	"github.com/seal-io/walrus/utils/log"
@@ -26,11 +28,22 @@ const (
 
 // IsStatusRunning checks if the resource run is in the running status.
 func IsStatusRunning(run *model.ResourceRun) bool {
-	return status.ResourceRunStatusPending.IsUnknown(run) ||
+	return status.ResourceRunStatusQueued.IsUnknown(run) ||
+		status.ResourceRunStatusPending.IsUnknown(run) ||
 		status.ResourceRunStatusPlanned.IsUnknown(run) ||
 		status.ResourceRunStatusApplied.IsUnknown(run)
 }
 
+// IsStatusQueued checks if the resource run is waiting in the run queue.
+func IsStatusQueued(run *model.ResourceRun) bool {
+	return status.ResourceRunStatusQueued.IsUnknown(run)
+}
+
+// IsStatusPendingApproval checks if the resource run is waiting for approvals.
+func IsStatusPendingApproval(run *model.ResourceRun) bool {
+	return status.ResourceRunStatusApproved.IsUnknown(run)
+}
+
 func IsStatusPending(run *model.ResourceRun) bool {
 	return status.ResourceRunStatusPending.IsUnknown(run)
 }
@@ -43,11 +56,13 @@ func IsStatusApplying(run *model.ResourceRun) bool {
 func IsStatusFailed(run *model.ResourceRun) bool {
 	return status.ResourceRunStatusPending.IsFalse(run) ||
 		status.ResourceRunStatusPlanned.IsFalse(run) ||
+		status.ResourceRunStatusApproved.IsFalse(run) ||
 		status.ResourceRunStatusApplied.IsFalse(run)
 }
 
 func IsStatusPlanned(run *model.ResourceRun) bool {
 	return status.ResourceRunStatusPlanned.IsTrue(run) &&
+		!status.ResourceRunStatusApproved.IsFalse(run) &&
 		!status.ResourceRunStatusApplied.Exist(run)
 }
 
@@ -73,7 +88,14 @@ func IsPreviewPlanFailed(run *model.ResourceRun) bool {
 func SetStatusFalse(run *model.ResourceRun, errMsg string) {
 	logger := log.WithName("resource-run").WithName("status")
 
+	// Drop out of the run queue.
+	queued := IsStatusQueued(run)
+	status.ResourceRunStatusQueued.Remove(run)
+
 	switch {
+	case queued && IsStatusPlanned(run):
+		errMsg = fmt.Sprintf("apply failed: %s", errMsg)
+		status.ResourceRunStatusApplied.False(run, errMsg)
 	case status.ResourceRunStatusPlanned.IsUnknown(run):
 		errMsg = fmt.Sprintf("plan failed: %s", errMsg)
 		status.ResourceRunStatusPlanned.False(run, errMsg)
@@ -167,9 +189,21 @@ func CheckDependencyStatus(
 		return true, nil
 	}
 
+	running, err := getRunningResourceIDs(ctx, mc, dependencies)
+	if err != nil {
+		return false, err
+	}
+
 	notReadyDependencies := make([]*model.Resource, 0, len(dependencies))
 
 	for _, d := range dependencies {
+		// Wait for the dependency which is running,
+		// even if it has an error status left by the previous run.
+		if running.Has(d.ID) {
+			notReadyDependencies = append(notReadyDependencies, d)
+			continue
+		}
+
 		if resstatus.IsStatusError(d) {
 			msg := fmt.Sprintf("failed as the dependency resource %s has an error status", d.Name)
 			SetStatusFalse(run, msg)
@@ -242,9 +276,14 @@ func CheckDependantStatus(
 		return true, nil
 	}
 
+	running, err := getRunningResourceIDs(ctx, mc, dependants)
+	if err != nil {
+		return false, err
+	}
+
 	dependantsNames := sets.NewString()
 	for _, d := range dependants {
-		if resstatus.IsStatusError(d) {
+		if !running.Has(d.ID) && resstatus.IsStatusError(d) {
 			msg := fmt.Sprintf("failed as the dependant resource %s has an error status", d.Name)
 			SetStatusFalse(run, msg)
 
@@ -278,6 +317,29 @@ func CheckDependantStatus(
 	return false, nil
 }
 
+// getRunningResourceIDs returns the IDs of the given resources which latest run is running.
+func getRunningResourceIDs(ctx context.Context, mc model.ClientSet, entities []*model.Resource) (sets.Set[object.ID], error) {
+	ids := make([]object.ID, len(entities))
+	for i := range entities {
+		ids[i] = entities[i].ID
+	}
+
+	runs, err := dao.GetResourcesLatestRuns(ctx, mc, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	running := sets.New[object.ID]()
+
+	for i := range runs {
+		if IsStatusRunning(runs[i]) {
+			running.Insert(runs[i].ResourceID)
+		}
+	}
+
+	return running, nil
+}
+
 // CheckStatus checks the status of the resource run dependant or dependency with the given resource run.
 func CheckStatus(ctx context.Context, mc model.ClientSet, run *model.ResourceRun) (bool, error) {
 	switch types.RunType(run.Type) {
@@ -0,0 +1,160 @@
+package resourcestate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestatelock"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// LockedError indicates the state has been locked by another holder.
+type LockedError struct {
+	// Lock is the lock record held currently.
+	Lock *model.ResourceStateLock
+}
+
+func (e LockedError) Error() string {
+	return fmt.Sprintf("state has been locked by %q with lock %q", e.Lock.Holder, e.Lock.LockID)
+}
+
+// LockOptions holds the options of locking the state.
+type LockOptions struct {
+	// ResourceID is the ID of the resource to which the state belongs.
+	ResourceID object.ID
+	// RunID is the ID of the run which requests the lock,
+	// leave it blank if the lock is not requested by the run's deployment.
+	RunID object.ID
+	// Holder is the name of the subject who requests the lock.
+	Holder string
+	// Info is the lock information submitted by the client.
+	Info types.ResourceStateLockInfo
+}
+
+// _lockRetries is the maximum attempts to acquire the lock,
+// when the held lock is released during locking.
+const _lockRetries = 3
+
+// Lock acquires the state lock of the resource,
+// returns LockedError if the lock has been held by others.
+//
+// Locking again with the same lock ID is idempotent.
+func Lock(ctx context.Context, mc model.ClientSet, opts LockOptions) (*model.ResourceStateLock, error) {
+	if opts.Info.ID == "" {
+		return nil, errors.New("invalid lock: blank ID")
+	}
+
+	// Record the real holder into the lock information,
+	// so that clients can show who is holding the lock.
+	if opts.Holder != "" {
+		opts.Info.Who = opts.Holder
+	}
+
+	for i := 0; i < _lockRetries; i++ {
+		creates := mc.ResourceStateLocks().Create().
+			SetResourceID(opts.ResourceID).
+			SetLockID(opts.Info.ID).
+			SetHolder(opts.Holder).
+			SetInfo(opts.Info)
+		if opts.RunID != "" {
+			creates.SetRunID(opts.RunID)
+		}
+
+		entity, err := creates.Save(ctx)
+		if err == nil {
+			return entity, nil
+		}
+
+		if !model.IsConstraintError(err) {
+			return nil, err
+		}
+
+		// Already locked.
+		entity, err = GetLock(ctx, mc, opts.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if entity == nil {
+			// The previous lock has been released during creating, try again.
+			continue
+		}
+
+		if entity.LockID != opts.Info.ID {
+			return nil, LockedError{Lock: entity}
+		}
+
+		return entity, nil
+	}
+
+	return nil, fmt.Errorf("failed to lock the state of resource %q: too many attempts", opts.ResourceID)
+}
+
+// GetLock returns the state lock of the resource, returns nil if not locked.
+func GetLock(ctx context.Context, mc model.ClientSet, resourceID object.ID) (*model.ResourceStateLock, error) {
+	entity, err := mc.ResourceStateLocks().Query().
+		Where(resourcestatelock.ResourceID(resourceID)).
+		Only(ctx)
+	if err != nil {
+		if model.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// CheckLock returns LockedError if the state of the resource is locked by a different lock ID.
+func CheckLock(ctx context.Context, mc model.ClientSet, resourceID object.ID, lockID string) error {
+	entity, err := GetLock(ctx, mc, resourceID)
+	if err != nil {
+		return err
+	}
+
+	if entity != nil && entity.LockID != lockID {
+		return LockedError{Lock: entity}
+	}
+
+	return nil
+}
+
+// Unlock releases the state lock of the resource with the given lock ID,
+// returns LockedError if the lock is held by a different lock ID.
+//
+// Unlocking a non-locked state is a no-op.
+func Unlock(ctx context.Context, mc model.ClientSet, resourceID object.ID, lockID string) error {
+	n, err := mc.ResourceStateLocks().Delete().
+		Where(
+			resourcestatelock.ResourceID(resourceID),
+			resourcestatelock.LockID(lockID)).
+		Exec(ctx)
+	if err != nil || n != 0 {
+		return err
+	}
+
+	return CheckLock(ctx, mc, resourceID, lockID)
+}
+
+// ForceUnlock releases the state lock of the resource regardless of the holder.
+func ForceUnlock(ctx context.Context, mc model.ClientSet, resourceID object.ID) error {
+	_, err := mc.ResourceStateLocks().Delete().
+		Where(resourcestatelock.ResourceID(resourceID)).
+		Exec(ctx)
+
+	return err
+}
+
+// ReleaseRunLocks releases all state locks held by the given run,
+// it is used to clean up the locks left by an exited run.
+func ReleaseRunLocks(ctx context.Context, mc model.ClientSet, runID object.ID) error {
+	_, err := mc.ResourceStateLocks().Delete().
+		Where(resourcestatelock.RunID(runID)).
+		Exec(ctx)
+
+	return err
+}
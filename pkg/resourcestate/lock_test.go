@@ -0,0 +1,156 @@
+package resourcestate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+const (
+	testDriverName     = "sqlite3"
+	testDataSourceName = "file:resourcestate?mode=memory&cache=shared&_fk=1"
+)
+
+func testResource(ctx context.Context, t *testing.T, mc model.ClientSet, name string) *model.Resource {
+	t.Helper()
+
+	proj, err := mc.Projects().Create().
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("error creating project: %v", err)
+	}
+
+	env, err := mc.Environments().Create().
+		SetProjectID(proj.ID).
+		SetName(name).
+		SetType(types.EnvironmentDevelopment).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("error creating environment: %v", err)
+	}
+
+	res, err := mc.Resources().Create().
+		SetProjectID(proj.ID).
+		SetEnvironmentID(env.ID).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("error creating resource: %v", err)
+	}
+
+	return res
+}
+
+func TestLock(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, testDriverName, testDataSourceName)
+	defer client.Close()
+
+	var (
+		resourceID = testResource(ctx, t, client, "lock").ID
+		runID      = object.ID("1")
+	)
+
+	// Lock.
+	lock, err := Lock(ctx, client, LockOptions{
+		ResourceID: resourceID,
+		RunID:      runID,
+		Holder:     "admin",
+		Info: types.ResourceStateLockInfo{
+			ID:  "lock-a",
+			Who: "root@localhost",
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "lock-a", lock.LockID)
+		assert.Equal(t, runID, lock.RunID)
+		assert.Equal(t, "admin", lock.Info.Who, "lock info should record the real holder")
+	}
+
+	// Lock again with the same ID.
+	_, err = Lock(ctx, client, LockOptions{
+		ResourceID: resourceID,
+		Holder:     "admin",
+		Info:       types.ResourceStateLockInfo{ID: "lock-a"},
+	})
+	assert.NoError(t, err, "locking with the same ID should be idempotent")
+
+	// Lock with a different ID.
+	_, err = Lock(ctx, client, LockOptions{
+		ResourceID: resourceID,
+		Holder:     "operator",
+		Info:       types.ResourceStateLockInfo{ID: "lock-b"},
+	})
+
+	var le LockedError
+	if assert.True(t, errors.As(err, &le), "locking with a different ID should conflict") {
+		assert.Equal(t, "lock-a", le.Lock.LockID)
+		assert.Equal(t, "admin", le.Lock.Holder)
+	}
+
+	// Check lock.
+	assert.NoError(t, CheckLock(ctx, client, resourceID, "lock-a"))
+	assert.True(t, errors.As(CheckLock(ctx, client, resourceID, ""), &le))
+	assert.NoError(t, CheckLock(ctx, client, testResource(ctx, t, client, "non-lock").ID, ""),
+		"checking a non-locked state should pass")
+
+	// Unlock with a different ID.
+	err = Unlock(ctx, client, resourceID, "lock-b")
+	assert.True(t, errors.As(err, &le), "unlocking with a different ID should conflict")
+
+	// Unlock.
+	assert.NoError(t, Unlock(ctx, client, resourceID, "lock-a"))
+	assert.NoError(t, Unlock(ctx, client, resourceID, "lock-a"),
+		"unlocking a non-locked state should be no-op")
+
+	lock, err = GetLock(ctx, client, resourceID)
+	if assert.NoError(t, err) {
+		assert.Nil(t, lock)
+	}
+
+	// Force unlock.
+	_, err = Lock(ctx, client, LockOptions{
+		ResourceID: resourceID,
+		Holder:     "operator",
+		Info:       types.ResourceStateLockInfo{ID: "lock-b"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, ForceUnlock(ctx, client, resourceID))
+
+	lock, err = GetLock(ctx, client, resourceID)
+	if assert.NoError(t, err) {
+		assert.Nil(t, lock)
+	}
+
+	// Release run locks,
+	// which must not release the lock requested outside of the run.
+	_, err = Lock(ctx, client, LockOptions{
+		ResourceID: resourceID,
+		Holder:     "operator",
+		Info:       types.ResourceStateLockInfo{ID: "lock-c"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, ReleaseRunLocks(ctx, client, runID))
+
+	lock, err = GetLock(ctx, client, resourceID)
+	if assert.NoError(t, err) && assert.NotNil(t, lock) {
+		assert.Equal(t, "lock-c", lock.LockID)
+	}
+}
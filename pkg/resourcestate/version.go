@@ -0,0 +1,266 @@
+package resourcestate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcestateversion"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/json"
+	"github.com/seal-io/walrus/utils/strs"
+)
+
+// VersionConflictError indicates the state to write conflicts with the latest version.
+type VersionConflictError struct {
+	// Latest is the latest version of the state.
+	Latest *model.ResourceStateVersion
+	// Serial is the serial of the state to write.
+	Serial int64
+	// Lineage is the lineage of the state to write.
+	Lineage string
+}
+
+func (e VersionConflictError) Error() string {
+	switch {
+	case e.Lineage != e.Latest.Lineage:
+		return fmt.Sprintf("state lineage %q mismatches the latest lineage %q", e.Lineage, e.Latest.Lineage)
+	case e.Serial < e.Latest.Serial:
+		return fmt.Sprintf("state serial %d is older than the latest serial %d", e.Serial, e.Latest.Serial)
+	default:
+		return fmt.Sprintf("state serial %d has been written with different data", e.Serial)
+	}
+}
+
+// stateMeta holds the metadata of the Terraform state.
+type stateMeta struct {
+	Serial  int64  `json:"serial"`
+	Lineage string `json:"lineage"`
+}
+
+func parseStateMeta(data string) (stateMeta, error) {
+	var m stateMeta
+
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		return m, fmt.Errorf("invalid state: %w", err)
+	}
+
+	if m.Lineage == "" {
+		return m, errors.New("invalid state: blank lineage")
+	}
+
+	return m, nil
+}
+
+// GetLatestVersion returns the latest state version of the resource, returns nil if not found.
+func GetLatestVersion(
+	ctx context.Context,
+	mc model.ClientSet,
+	resourceID object.ID,
+) (*model.ResourceStateVersion, error) {
+	entity, err := mc.ResourceStateVersions().Query().
+		Where(resourcestateversion.ResourceID(resourceID)).
+		Order(
+			model.Desc(resourcestateversion.FieldSerial),
+			model.Desc(resourcestateversion.FieldCreateTime)).
+		First(ctx)
+	if err != nil {
+		if model.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return entity, nil
+}
+
+// CreateVersion records the given state data as a new version of the run's resource,
+// returns VersionConflictError if the state goes backwards or forks from the latest version.
+//
+// Writing the same data of the latest version is a no-op.
+func CreateVersion(
+	ctx context.Context,
+	mc model.ClientSet,
+	run *model.ResourceRun,
+	data string,
+) (*model.ResourceStateVersion, error) {
+	m, err := parseStateMeta(data)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := GetLatestVersion(ctx, mc, run.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil {
+		switch {
+		case m.Lineage != latest.Lineage, m.Serial < latest.Serial:
+			return nil, VersionConflictError{Latest: latest, Serial: m.Serial, Lineage: m.Lineage}
+		case m.Serial == latest.Serial:
+			if latest.Data == data {
+				return latest, nil
+			}
+
+			return nil, VersionConflictError{Latest: latest, Serial: m.Serial, Lineage: m.Lineage}
+		}
+	}
+
+	return mc.ResourceStateVersions().Create().
+		SetProjectID(run.ProjectID).
+		SetEnvironmentID(run.EnvironmentID).
+		SetResourceID(run.ResourceID).
+		SetRunID(run.ID).
+		SetSerial(m.Serial).
+		SetLineage(m.Lineage).
+		SetData(data).
+		Save(ctx)
+}
+
+// RewriteVersion returns the given state data with the given serial and lineage,
+// it is used to restore a historical state on top of the latest version.
+func RewriteVersion(data string, serial int64, lineage string) (string, error) {
+	if _, err := parseStateMeta(data); err != nil {
+		return "", err
+	}
+
+	bs, err := json.Set([]byte(data), "serial", []byte(strconv.FormatInt(serial, 10)))
+	if err != nil {
+		return "", err
+	}
+
+	bs, err = json.Set(bs, "lineage", json.ShouldMarshal(lineage))
+	if err != nil {
+		return "", err
+	}
+
+	return string(bs), nil
+}
+
+// VersionDiff holds the differences between two state versions,
+// the items are the addresses of the resource instances.
+type VersionDiff struct {
+	// Added holds the instances only exist in the new version.
+	Added []string `json:"added"`
+	// Removed holds the instances only exist in the old version.
+	Removed []string `json:"removed"`
+	// Changed holds the instances whose attributes have been changed.
+	Changed []string `json:"changed"`
+}
+
+type (
+	diffState struct {
+		Resources []diffResource `json:"resources"`
+	}
+
+	diffResource struct {
+		Module    string         `json:"module"`
+		Mode      string         `json:"mode"`
+		Type      string         `json:"type"`
+		Name      string         `json:"name"`
+		Instances []diffInstance `json:"instances"`
+	}
+
+	diffInstance struct {
+		IndexKey   json.RawMessage `json:"index_key"`
+		Attributes json.RawMessage `json:"attributes"`
+	}
+)
+
+// indexInstances indexes the attributes of the state instances by their addresses.
+func indexInstances(data string) (map[string]json.RawMessage, error) {
+	var s diffState
+
+	if data != "" {
+		if err := json.Unmarshal([]byte(data), &s); err != nil {
+			return nil, fmt.Errorf("invalid state: %w", err)
+		}
+	}
+
+	r := make(map[string]json.RawMessage)
+
+	for _, rs := range s.Resources {
+		addr := strs.Join(".", rs.Type, rs.Name)
+		if rs.Mode == "data" {
+			addr = strs.Join(".", "data", addr)
+		}
+
+		if rs.Module != "" {
+			addr = strs.Join(".", rs.Module, addr)
+		}
+
+		for _, is := range rs.Instances {
+			k := addr
+			if len(is.IndexKey) != 0 {
+				k += "[" + string(is.IndexKey) + "]"
+			}
+
+			r[k] = is.Attributes
+		}
+	}
+
+	return r, nil
+}
+
+// Diff returns the differences from the old state data to the new state data.
+func Diff(oldData, newData string) (*VersionDiff, error) {
+	oldIdx, err := indexInstances(oldData)
+	if err != nil {
+		return nil, err
+	}
+
+	newIdx, err := indexInstances(newData)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &VersionDiff{
+		Added:   []string{},
+		Removed: []string{},
+		Changed: []string{},
+	}
+
+	for k, nv := range newIdx {
+		ov, ok := oldIdx[k]
+		if !ok {
+			d.Added = append(d.Added, k)
+			continue
+		}
+
+		if !jsonEqual(ov, nv) {
+			d.Changed = append(d.Changed, k)
+		}
+	}
+
+	for k := range oldIdx {
+		if _, ok := newIdx[k]; !ok {
+			d.Removed = append(d.Removed, k)
+		}
+	}
+
+	sort.Strings(d.Added)
+	sort.Strings(d.Removed)
+	sort.Strings(d.Changed)
+
+	return d, nil
+}
+
+// jsonEqual compares two JSON documents regardless of their formatting.
+func jsonEqual(a, b json.RawMessage) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+
+	var av, bv any
+	if json.Unmarshal(a, &av) != nil || json.Unmarshal(b, &bv) != nil {
+		return false
+	}
+
+	return bytes.Equal(json.ShouldMarshal(av), json.ShouldMarshal(bv))
+}
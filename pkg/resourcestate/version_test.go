@@ -0,0 +1,194 @@
+package resourcestate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+)
+
+func testRun(ctx context.Context, t *testing.T, mc model.ClientSet, res *model.Resource) *model.ResourceRun {
+	t.Helper()
+
+	run, err := mc.ResourceRuns().Create().
+		SetProjectID(res.ProjectID).
+		SetEnvironmentID(res.EnvironmentID).
+		SetResourceID(res.ID).
+		SetTemplateID("1").
+		SetTemplateName("test").
+		SetTemplateVersion("v0.0.1").
+		SetInputConfigs(map[string]types.ResourceRunConfigData{}).
+		SetCreatedBy("admin").
+		SetType(types.RunTypeCreate.String()).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("error creating run: %v", err)
+	}
+
+	return run
+}
+
+func TestCreateVersion(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, testDriverName, testDataSourceName)
+	defer client.Close()
+
+	run := testRun(ctx, t, client, testResource(ctx, t, client, "version"))
+
+	// First version.
+	v1, err := CreateVersion(ctx, client, run, `{"serial":1,"lineage":"a"}`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(1), v1.Serial)
+		assert.Equal(t, "a", v1.Lineage)
+		assert.Equal(t, run.ID, v1.RunID)
+	}
+
+	// Same serial with the same data.
+	v, err := CreateVersion(ctx, client, run, `{"serial":1,"lineage":"a"}`)
+	if assert.NoError(t, err, "writing the same data should be no-op") {
+		assert.Equal(t, v1.ID, v.ID)
+	}
+
+	// Newer serial.
+	v2, err := CreateVersion(ctx, client, run, `{"serial":2,"lineage":"a"}`)
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, v1.ID, v2.ID)
+	}
+
+	latest, err := GetLatestVersion(ctx, client, run.ResourceID)
+	if assert.NoError(t, err) && assert.NotNil(t, latest) {
+		assert.Equal(t, v2.ID, latest.ID)
+	}
+
+	// Conflicts.
+	var ve VersionConflictError
+
+	_, err = CreateVersion(ctx, client, run, `{"serial":1,"lineage":"a"}`)
+	assert.True(t, errors.As(err, &ve), "writing an older serial should conflict")
+
+	_, err = CreateVersion(ctx, client, run, `{"serial":2,"lineage":"a","outputs":{}}`)
+	assert.True(t, errors.As(err, &ve), "writing the same serial with different data should conflict")
+
+	_, err = CreateVersion(ctx, client, run, `{"serial":3,"lineage":"b"}`)
+	assert.True(t, errors.As(err, &ve), "writing a different lineage should conflict")
+
+	// Invalid.
+	_, err = CreateVersion(ctx, client, run, `{"serial":3}`)
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &ve))
+}
+
+func TestRewriteVersion(t *testing.T) {
+	actual, err := RewriteVersion(`{"version":4,"serial":1,"lineage":"a","outputs":{}}`, 5, "b")
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"version":4,"serial":5,"lineage":"b","outputs":{}}`, actual)
+	}
+
+	_, err = RewriteVersion(`{"serial":1}`, 5, "b")
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	const (
+		oldData = `{
+  "serial": 1,
+  "lineage": "a",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "kubernetes_deployment",
+      "name": "app",
+      "instances": [{"attributes": {"replicas": 1}}]
+    },
+    {
+      "module": "module.db",
+      "mode": "managed",
+      "type": "random_password",
+      "name": "pwd",
+      "instances": [{"index_key": 0, "attributes": {"length": 8}}]
+    },
+    {
+      "mode": "data",
+      "type": "kubernetes_namespace",
+      "name": "ns",
+      "instances": [{"attributes": {"name": "default"}}]
+    }
+  ]
+}`
+		newData = `{
+  "serial": 2,
+  "lineage": "a",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "kubernetes_deployment",
+      "name": "app",
+      "instances": [{"attributes": {"replicas": 2}}]
+    },
+    {
+      "mode": "data",
+      "type": "kubernetes_namespace",
+      "name": "ns",
+      "instances": [{"attributes": {"name":"default"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "kubernetes_service",
+      "name": "app",
+      "instances": [{"index_key": "http", "attributes": {}}]
+    }
+  ]
+}`
+	)
+
+	testCases := []struct {
+		name     string
+		old, new string
+		expected *VersionDiff
+	}{
+		{
+			name: "from empty",
+			old:  "",
+			new:  oldData,
+			expected: &VersionDiff{
+				Added: []string{
+					"data.kubernetes_namespace.ns",
+					"kubernetes_deployment.app",
+					"module.db.random_password.pwd[0]",
+				},
+				Removed: []string{},
+				Changed: []string{},
+			},
+		},
+		{
+			name: "changes",
+			old:  oldData,
+			new:  newData,
+			expected: &VersionDiff{
+				Added:   []string{`kubernetes_service.app["http"]`},
+				Removed: []string{"module.db.random_password.pwd[0]"},
+				Changed: []string{"kubernetes_deployment.app"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Diff(tc.old, tc.new)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package auditlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/auditlog"
+	"github.com/seal-io/walrus/pkg/settings"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+type CleanTask struct {
+	logger      log.Logger
+	modelClient model.ClientSet
+}
+
+func NewCleanTask(logger log.Logger, mc model.ClientSet) (in *CleanTask, err error) {
+	in = &CleanTask{
+		logger:      logger,
+		modelClient: mc,
+	}
+
+	return
+}
+
+func (in *CleanTask) Process(ctx context.Context, args ...any) error {
+	days, err := settings.AuditLogRetentionDays.ValueInt64(ctx, in.modelClient)
+	if err != nil {
+		return fmt.Errorf("error getting audit log retention days: %w", err)
+	}
+
+	// Retain forever.
+	if days <= 0 {
+		return nil
+	}
+
+	before := time.Now().AddDate(0, 0, -int(days))
+
+	cnt, err := in.modelClient.AuditLogs().Delete().
+		Where(auditlog.CreateTimeLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error cleaning audit logs: %w", err)
+	}
+
+	if cnt != 0 {
+		in.logger.Debugf("cleaned %d audit logs created before %s", cnt, before.Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package budget
+
+import (
+	"context"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/costs/evaluator"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+type EvaluateTask struct {
+	logger      log.Logger
+	modelClient model.ClientSet
+}
+
+func NewEvaluateTask(logger log.Logger, mc model.ClientSet) (in *EvaluateTask, err error) {
+	in = &EvaluateTask{
+		logger:      logger,
+		modelClient: mc,
+	}
+
+	return
+}
+
+func (in *EvaluateTask) Process(ctx context.Context, args ...any) error {
+	return evaluator.New(in.modelClient, in.logger).
+		EvaluateAll(ctx, time.Now())
+}
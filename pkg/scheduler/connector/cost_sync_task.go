@@ -29,7 +29,9 @@ func NewCollectTask(logger log.Logger, mc model.ClientSet) (in *CollectTask, err
 func (in *CollectTask) Process(ctx context.Context, args ...any) error {
 	cs, err := in.modelClient.Connectors().Query().
 		Where(
-			connector.TypeEQ(types.ConnectorTypeKubernetes),
+			connector.CategoryIn(
+				types.ConnectorCategoryKubernetes,
+				types.ConnectorCategoryCloudProvider),
 			connector.EnableFinOps(true)).
 		All(ctx)
 	if err != nil {
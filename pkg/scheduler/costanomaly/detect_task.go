@@ -0,0 +1,29 @@
+package costanomaly
+
+import (
+	"context"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/costs/detector"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+type DetectTask struct {
+	logger      log.Logger
+	modelClient model.ClientSet
+}
+
+func NewDetectTask(logger log.Logger, mc model.ClientSet) (in *DetectTask, err error) {
+	in = &DetectTask{
+		logger:      logger,
+		modelClient: mc,
+	}
+
+	return
+}
+
+func (in *DetectTask) Process(ctx context.Context, args ...any) error {
+	return detector.New(in.modelClient, in.logger).
+		Detect(ctx, time.Now())
+}
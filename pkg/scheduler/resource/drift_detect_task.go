@@ -0,0 +1,163 @@
+package resource
+
+import (
+	"context"
+
+	"go.uber.org/multierr"
+	"k8s.io/client-go/rest"
+
+	"github.com/seal-io/walrus/pkg/auths/session"
+	"github.com/seal-io/walrus/pkg/dao"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/model/subject"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/pkg/deployer"
+	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
+	pkgrun "github.com/seal-io/walrus/pkg/resourceruns"
+	runjob "github.com/seal-io/walrus/pkg/resourceruns/job"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	pkgresource "github.com/seal-io/walrus/pkg/resources"
+	resstatus "github.com/seal-io/walrus/pkg/resources/status"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+// DriftDetectTask plans the deployed resources without applying,
+// the drift is recorded to the resource when the plan is finished.
+type DriftDetectTask struct {
+	logger      log.Logger
+	modelClient model.ClientSet
+	deployer    deptypes.Deployer
+}
+
+func NewDriftDetectTask(
+	logger log.Logger,
+	mc model.ClientSet,
+	kc *rest.Config,
+) (in *DriftDetectTask, err error) {
+	// Create deployer.
+	opts := deptypes.CreateOptions{
+		Type:       types.DeployerTypeTF,
+		KubeConfig: kc,
+	}
+
+	dp, err := deployer.Get(context.Background(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	in = &DriftDetectTask{
+		logger:      logger,
+		modelClient: mc,
+		deployer:    dp,
+	}
+
+	return
+}
+
+func (in *DriftDetectTask) Process(ctx context.Context, args ...any) error {
+	entities, err := in.modelClient.Resources().Query().
+		Select(
+			resource.FieldID,
+			resource.FieldName,
+			resource.FieldStatus,
+			resource.FieldAnnotations).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]object.ID, 0, len(entities))
+	index := make(map[object.ID]*model.Resource, len(entities))
+
+	for i := range entities {
+		// Only detect the resources deployed successfully.
+		if !resstatus.IsStatusReady(entities[i]) {
+			continue
+		}
+
+		ids = append(ids, entities[i].ID)
+		index[entities[i].ID] = entities[i]
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	latestRuns, err := dao.GetResourcesLatestRuns(ctx, in.modelClient, ids...)
+	if err != nil {
+		return err
+	}
+
+	// Merge the errors to return them all at once,
+	// instead of returning the first error.
+	var berr error
+
+	for _, run := range latestRuns {
+		// Skip the resources which are running or waiting to be applied.
+		if runstatus.IsStatusRunning(run) || runstatus.IsStatusPlanned(run) {
+			continue
+		}
+
+		berr = multierr.Append(berr, in.detect(ctx, index[run.ResourceID]))
+
+		// Give up the loop if the context is canceled.
+		if multierr.AppendInto(&berr, ctx.Err()) {
+			break
+		}
+	}
+
+	return berr
+}
+
+// detect creates a plan-only run to detect the drift of the given resource,
+// the run is acted as the subject who manages the resource.
+func (in *DriftDetectTask) detect(ctx context.Context, entity *model.Resource) error {
+	if entity == nil {
+		return nil
+	}
+
+	subjectID, err := pkgresource.GetSubjectID(entity)
+	if err != nil {
+		return err
+	}
+
+	if subjectID == "" {
+		in.logger.Debugf("skip detecting drift of resource %s: unknown subject", entity.Name)
+		return nil
+	}
+
+	sj, err := in.modelClient.Subjects().Query().
+		Where(subject.ID(subjectID)).
+		Select(
+			subject.FieldID,
+			subject.FieldName).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx = session.WithSubject(ctx, session.Subject{
+		ID:   sj.ID,
+		Name: sj.Name,
+	})
+
+	run, err := pkgrun.Create(ctx, in.modelClient, pkgrun.CreateOptions{
+		ResourceID:    entity.ID,
+		DeployerType:  in.deployer.Type(),
+		Type:          types.RunTypeUpdate,
+		ChangeComment: "Drift detection",
+		Preview:       true,
+		Labels: map[string]string{
+			types.LabelWalrusResourceRunDriftDetection: "true",
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	in.logger.Debugf("detecting drift of resource %s with run %s", entity.Name, run.ID)
+
+	return runjob.PerformRunJob(ctx, in.modelClient, in.deployer, run)
+}
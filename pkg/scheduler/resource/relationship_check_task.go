@@ -209,6 +209,18 @@ func (in *RelationshipCheckTask) checkDependencies(ctx context.Context, res *mod
 		return false, err
 	}
 
+	dependencyRuns, err := dao.GetResourcesLatestRuns(ctx, in.modelClient, resourceIDs...)
+	if err != nil {
+		return false, err
+	}
+
+	for _, run := range dependencyRuns {
+		// Wait for the running dependency instead of failing.
+		if runstatus.IsStatusRunning(run) {
+			return false, nil
+		}
+	}
+
 	for _, depRes := range dependencyResources {
 		if resstatus.IsStatusReady(depRes) {
 			continue
@@ -0,0 +1,61 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/settings"
+	"github.com/seal-io/walrus/pkg/storage"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+type RunArtifactCleanTask struct {
+	logger         log.Logger
+	modelClient    model.ClientSet
+	storageManager *storage.Manager
+}
+
+func NewRunArtifactCleanTask(
+	logger log.Logger,
+	mc model.ClientSet,
+	sm *storage.Manager,
+) (in *RunArtifactCleanTask, err error) {
+	in = &RunArtifactCleanTask{
+		logger:         logger,
+		modelClient:    mc,
+		storageManager: sm,
+	}
+
+	return
+}
+
+func (in *RunArtifactCleanTask) Process(ctx context.Context, args ...any) error {
+	if in.storageManager == nil {
+		return nil
+	}
+
+	days, err := settings.ResourceRunArtifactRetentionDays.ValueInt64(ctx, in.modelClient)
+	if err != nil {
+		return fmt.Errorf("error getting resource run artifact retention days: %w", err)
+	}
+
+	// Retain forever.
+	if days <= 0 {
+		return nil
+	}
+
+	before := time.Now().AddDate(0, 0, -int(days))
+
+	cnt, err := in.storageManager.Prune(ctx, before)
+	if err != nil {
+		return fmt.Errorf("error cleaning resource run artifacts: %w", err)
+	}
+
+	if cnt != 0 {
+		in.logger.Debugf("cleaned %d resource run artifacts modified before %s", cnt, before.Format(time.RFC3339))
+	}
+
+	return nil
+}
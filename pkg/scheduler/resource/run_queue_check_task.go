@@ -0,0 +1,89 @@
+package resource
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"go.uber.org/multierr"
+	"k8s.io/client-go/rest"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	"github.com/seal-io/walrus/pkg/deployer"
+	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
+	runjob "github.com/seal-io/walrus/pkg/resourceruns/job"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+// RunQueueCheckTask admits the queued resource runs in order of creation,
+// and performs the admitted runs.
+type RunQueueCheckTask struct {
+	logger      log.Logger
+	modelClient model.ClientSet
+	deployer    deptypes.Deployer
+}
+
+func NewRunQueueCheckTask(
+	logger log.Logger,
+	mc model.ClientSet,
+	kc *rest.Config,
+) (in *RunQueueCheckTask, err error) {
+	// Create deployer.
+	opts := deptypes.CreateOptions{
+		Type:       types.DeployerTypeTF,
+		KubeConfig: kc,
+	}
+
+	dp, err := deployer.Get(context.Background(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	in = &RunQueueCheckTask{
+		logger:      logger,
+		modelClient: mc,
+		deployer:    dp,
+	}
+
+	return
+}
+
+func (in *RunQueueCheckTask) Process(ctx context.Context, args ...any) error {
+	runs, err := in.modelClient.ResourceRuns().Query().
+		Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(
+				resourcerun.FieldStatus,
+				status.ResourceRunSummaryStatusQueued,
+				sqljson.Path("summaryStatus"),
+			))
+		}).
+		Order(model.Asc(resourcerun.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Merge the errors to return them all at once,
+	// instead of returning the first error.
+	var berr error
+
+	for _, run := range runs {
+		// The run is admitted and performed if the limits allow,
+		// otherwise, its position is refreshed.
+		err = runjob.PerformRunJob(ctx, in.modelClient, in.deployer, run)
+		if err != nil {
+			in.logger.Errorf("error performing queued run %s: %v", run.ID, err)
+			berr = multierr.Append(berr, err)
+		}
+
+		// Give up the loop if the context is canceled.
+		if multierr.AppendInto(&berr, ctx.Err()) {
+			break
+		}
+	}
+
+	return berr
+}
@@ -0,0 +1,87 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/multierr"
+	"k8s.io/client-go/rest"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/deployer"
+	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
+	"github.com/seal-io/walrus/pkg/storage"
+	"github.com/seal-io/walrus/pkg/templates/campaign"
+	"github.com/seal-io/walrus/utils/log"
+)
+
+// UpgradeCampaignProcessTask advances the unfinished template upgrade campaigns batch by batch.
+type UpgradeCampaignProcessTask struct {
+	logger         log.Logger
+	modelClient    model.ClientSet
+	deployer       deptypes.Deployer
+	storageManager *storage.Manager
+}
+
+func NewUpgradeCampaignProcessTask(
+	logger log.Logger,
+	mc model.ClientSet,
+	kc *rest.Config,
+	sm *storage.Manager,
+) (in *UpgradeCampaignProcessTask, err error) {
+	// Create deployer.
+	opts := deptypes.CreateOptions{
+		Type:       types.DeployerTypeTF,
+		KubeConfig: kc,
+	}
+
+	dp, err := deployer.Get(context.Background(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	in = &UpgradeCampaignProcessTask{
+		logger:         logger,
+		modelClient:    mc,
+		deployer:       dp,
+		storageManager: sm,
+	}
+
+	return
+}
+
+func (in *UpgradeCampaignProcessTask) Process(ctx context.Context, args ...any) error {
+	entities, err := in.modelClient.TemplateUpgradeCampaigns().Query().
+		Where(templateupgradecampaign.StatusIn(
+			types.TemplateUpgradeCampaignStatusPending,
+			types.TemplateUpgradeCampaignStatusRunning)).
+		Order(model.Asc(templateupgradecampaign.FieldCreateTime)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Merge the errors to return them all at once,
+	// instead of returning the first error.
+	var berr error
+
+	for i := range entities {
+		err = campaign.Process(ctx, in.modelClient, entities[i], campaign.Options{
+			Deployer:       in.deployer,
+			StorageManager: in.storageManager,
+		})
+		if err != nil {
+			berr = multierr.Append(berr,
+				fmt.Errorf("error processing template upgrade campaign %s: %w", entities[i].ID, err))
+		}
+
+		// Give up the loop if the context is canceled.
+		if multierr.AppendInto(&berr, ctx.Err()) {
+			break
+		}
+	}
+
+	return berr
+}
@@ -33,8 +33,10 @@ func (r *Server) init(ctx context.Context, opts initOptions) error {
 	// Initialize data for system.
 	inits := []initiation{
 		r.applyModelSchemas,
+		r.setupDataEncryption,
 		r.setupSettings,
 		r.initConfigs,
+		r.setupSecretStores,
 		r.registerMetricCollectors,
 		r.registerHealthCheckers,
 		r.startBackgroundJobs,
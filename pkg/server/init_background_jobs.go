@@ -4,11 +4,15 @@ import (
 	"context"
 
 	"github.com/seal-io/walrus/pkg/cron"
+	auditlogskd "github.com/seal-io/walrus/pkg/scheduler/auditlog"
+	budgetskd "github.com/seal-io/walrus/pkg/scheduler/budget"
 	catalogskd "github.com/seal-io/walrus/pkg/scheduler/catalog"
 	connskd "github.com/seal-io/walrus/pkg/scheduler/connector"
+	costanomalyskd "github.com/seal-io/walrus/pkg/scheduler/costanomaly"
 	svcskd "github.com/seal-io/walrus/pkg/scheduler/resource"
 	svcresskd "github.com/seal-io/walrus/pkg/scheduler/resourcecomponent"
 	telemetryskd "github.com/seal-io/walrus/pkg/scheduler/telemetry"
+	templateskd "github.com/seal-io/walrus/pkg/scheduler/template"
 	tokenskd "github.com/seal-io/walrus/pkg/scheduler/token"
 	"github.com/seal-io/walrus/pkg/settings"
 	"github.com/seal-io/walrus/utils/log"
@@ -18,14 +22,21 @@ import (
 // StartBackgroundJobs requires the global settings to be initialized.
 func (r *Server) startBackgroundJobs(ctx context.Context, opts initOptions) error {
 	bjs := []jobCreatorBuilder{
+		buildAuditLogCleanJobCreator,
 		buildCatalogTemplateSyncJobCreator,
 		buildConnectorCostCollectJobCreator,
+		buildCostBudgetEvaluateJobCreator,
+		buildCostAnomalyDetectJobCreator,
 		buildConnectorStatusSyncJobCreator,
 		buildResourceComponentsDiscoverJobCreator,
 		buildResourceLabelApplyJobCreator,
 		buildResourceStatusSyncJobCreator,
+		buildResourceDriftDetectJobCreator,
 		buildResourceRelationshipCheckJobCreator,
+		buildResourceRunQueueCheckJobCreator,
+		buildResourceRunArtifactCleanJobCreator,
 		buildTelemetryPeriodicReportJobCreator,
+		buildTemplateUpgradeCampaignProcessJobCreator,
 		buildTokenDeploymentExpireCleanJobCreator,
 	}
 
@@ -43,6 +54,20 @@ func (r *Server) startBackgroundJobs(ctx context.Context, opts initOptions) erro
 // must return the expression setting and the job creator.
 type jobCreatorBuilder func(initOptions) (exprSetting settings.Value, jobCreator cron.JobCreator)
 
+func buildAuditLogCleanJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
+	es = settings.AuditLogCleanCronExpr
+	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
+		task, err := auditlogskd.NewCleanTask(logger, opts.ModelClient)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return cron.AwaitedExpr(expr), task, nil
+	}
+
+	return
+}
+
 func buildCatalogTemplateSyncJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
 	es = settings.CatalogTemplateSyncCronExpr
 	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
@@ -71,6 +96,34 @@ func buildConnectorCostCollectJobCreator(opts initOptions) (es settings.Value, j
 	return
 }
 
+func buildCostBudgetEvaluateJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
+	es = settings.CostBudgetEvaluateCronExpr
+	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
+		task, err := budgetskd.NewEvaluateTask(logger, opts.ModelClient)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return cron.AwaitedExpr(expr), task, nil
+	}
+
+	return
+}
+
+func buildCostAnomalyDetectJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
+	es = settings.CostAnomalyDetectCronExpr
+	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
+		task, err := costanomalyskd.NewDetectTask(logger, opts.ModelClient)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return cron.AwaitedExpr(expr), task, nil
+	}
+
+	return
+}
+
 func buildConnectorStatusSyncJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
 	es = settings.ConnectorStatusSyncCronExpr
 	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
@@ -127,6 +180,21 @@ func buildResourceStatusSyncJobCreator(opts initOptions) (es settings.Value, jc
 	return
 }
 
+func buildResourceDriftDetectJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
+	es = settings.ResourceDriftDetectCronExpr
+	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
+		task, err := svcskd.NewDriftDetectTask(logger,
+			opts.ModelClient, opts.K8sConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return cron.AwaitedExpr(expr), task, nil
+	}
+
+	return
+}
+
 func buildResourceRelationshipCheckJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
 	es = settings.ResourceRelationshipCheckCronExpr
 	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
@@ -142,6 +210,35 @@ func buildResourceRelationshipCheckJobCreator(opts initOptions) (es settings.Val
 	return
 }
 
+func buildResourceRunQueueCheckJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
+	es = settings.ResourceRunQueueCheckCronExpr
+	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
+		task, err := svcskd.NewRunQueueCheckTask(logger,
+			opts.ModelClient, opts.K8sConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return cron.ImmediateExpr(expr), task, nil
+	}
+
+	return
+}
+
+func buildResourceRunArtifactCleanJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
+	es = settings.ResourceRunArtifactCleanCronExpr
+	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
+		task, err := svcskd.NewRunArtifactCleanTask(logger, opts.ModelClient, opts.StorageManager)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return cron.AwaitedExpr(expr), task, nil
+	}
+
+	return
+}
+
 func buildTelemetryPeriodicReportJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
 	es = settings.TelemetryPeriodicReportCronExpr
 	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
@@ -156,6 +253,21 @@ func buildTelemetryPeriodicReportJobCreator(opts initOptions) (es settings.Value
 	return
 }
 
+func buildTemplateUpgradeCampaignProcessJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
+	es = settings.TemplateUpgradeCampaignProcessCronExpr
+	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
+		task, err := templateskd.NewUpgradeCampaignProcessTask(logger,
+			opts.ModelClient, opts.K8sConfig, opts.StorageManager)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return cron.AwaitedExpr(expr), task, nil
+	}
+
+	return
+}
+
 func buildTokenDeploymentExpireCleanJobCreator(opts initOptions) (es settings.Value, jc cron.JobCreator) {
 	es = settings.TokenDeploymentExpiredCleanCronExpr
 	jc = func(logger log.Logger, expr string) (cron.Expr, cron.Task, error) {
@@ -14,6 +14,7 @@ import (
 	"github.com/seal-io/walrus/pkg/auths"
 	"github.com/seal-io/walrus/pkg/cache"
 	"github.com/seal-io/walrus/pkg/caches"
+	"github.com/seal-io/walrus/pkg/costs/pricing"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/settings"
@@ -23,7 +24,7 @@ import (
 )
 
 // initConfigs configures the system singleton instances at initialization phase,
-// like caches, encryption, auths, etc.
+// like caches, encryption, auths, pricing, etc.
 // initConfigs requires the global settings to be initialized.
 func (r *Server) initConfigs(ctx context.Context, opts initOptions) (err error) {
 	err = configureCaches(ctx, opts.CacheDriver)
@@ -41,6 +42,11 @@ func (r *Server) initConfigs(ctx context.Context, opts initOptions) (err error)
 		return
 	}
 
+	err = configureCostPricing(r.CostPricingCatalog)
+	if err != nil {
+		return
+	}
+
 	return
 }
 
@@ -82,6 +88,10 @@ func configureCaches(ctx context.Context, remoteDrv cache.Driver) error {
 		}
 
 		switch dialect {
+		case cache.DialectMemory:
+			// The in-process cache is invalidated across the replicas,
+			// replace the memory cache to avoid serving the stale entries.
+			layers = []cacheutil.Cache{cli.(cacheutil.Cache)}
 		case cache.DialectRedis, cache.DialectRedisCluster:
 			remoteCfg := cacheutil.RemoteRedisConfig{
 				Namespace:   "walrus",
@@ -172,3 +182,20 @@ func configureAuths(ctx context.Context, mc model.ClientSet, maxIdle time.Durati
 
 	return nil
 }
+
+// configureCostPricing configures the pricing catalog.
+func configureCostPricing(path string) error {
+	if path == "" {
+		pricing.CatalogConfig.Set(pricing.Builtin())
+		return nil
+	}
+
+	c, err := pricing.LoadFile(path)
+	if err != nil {
+		return fmt.Errorf("error loading pricing catalog: %w", err)
+	}
+
+	pricing.CatalogConfig.Set(c)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/seal-io/walrus/pkg/dataencryption"
+	"github.com/seal-io/walrus/utils/cryptox"
+	"github.com/seal-io/walrus/utils/gopool"
+)
+
+// setupDataEncryption loads the rotated data encryption keys,
+// it must be called before accessing any crypto field.
+func (r *Server) setupDataEncryption(ctx context.Context, opts initOptions) error {
+	var (
+		provider string
+		kek      cryptox.Encryptor
+		err      error
+	)
+
+	switch {
+	case r.DataSourceDataEncryptKEK != "":
+		// Wrap with the external key encryption key.
+		var address string
+
+		provider, address, err = dataencryption.ParseKEK(r.DataSourceDataEncryptKEK)
+		if err != nil {
+			return err
+		}
+
+		kek, err = dataencryption.GetKEK(ctx, provider, address)
+		if err != nil {
+			return fmt.Errorf("error gaining key encryption key: %w", err)
+		}
+	case r.DataSourceDataEncryptKey != nil:
+		// Wrap with the configured data encryption key.
+		provider = dataencryption.KEKProviderLocal
+
+		kek, err = cryptox.AesGcm(r.DataSourceDataEncryptKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	mgr := dataencryption.NewManager(
+		opts.ModelClient,
+		entsql.OpenDB(opts.ModelClient.Dialect(), opts.DatabaseDriver),
+		dataencryption.KeyringConfig.Get(),
+		provider,
+		kek)
+
+	if err = mgr.Load(ctx); err != nil {
+		return err
+	}
+
+	dataencryption.ManagerConfig.Set(mgr)
+
+	gopool.Go(func() {
+		mgr.Watch(ctx, time.Minute)
+	})
+
+	return nil
+}
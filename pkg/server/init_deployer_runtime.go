@@ -17,6 +17,7 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/deployer/installer/hermitcrab"
+	"github.com/seal-io/walrus/pkg/deployer/terraform"
 	"github.com/seal-io/walrus/utils/gopool"
 	"github.com/seal-io/walrus/utils/log"
 	"github.com/seal-io/walrus/utils/pointer"
@@ -44,6 +45,18 @@ func (r *Server) setupDeployerRuntime(ctx context.Context, opts initOptions) err
 
 	applyDeployerNetworkMirror(opts.ModelClient, opts.K8sConfig)
 
+	if r.DeployerExecutor == "local" {
+		err = terraform.SetupLocalExecutor(ctx, terraform.LocalExecutorOptions{
+			ModelClient:    opts.ModelClient,
+			StorageManager: opts.StorageManager,
+			Workdir:        r.DeployerLocalWorkdir,
+			Workers:        r.DeployerLocalWorkers,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to setup local executor: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/seal-io/walrus/pkg/database"
 	"github.com/seal-io/walrus/pkg/health"
 	"github.com/seal-io/walrus/pkg/k8s"
+	"github.com/seal-io/walrus/pkg/kms"
 	"github.com/seal-io/walrus/utils/gopool"
 )
 
@@ -30,10 +31,19 @@ func (r *Server) registerHealthCheckers(ctx context.Context, opts initOptions) e
 		health.CheckerFunc("gopool", getGoPoolHealthChecker()),
 	}
 
+	if opts.StorageManager != nil {
+		cs = append(cs, health.CheckerFunc("storage", opts.StorageManager.IsConnected))
+	}
+
 	if opts.CacheDriver != nil {
 		cs = append(cs, health.CheckerFunc("cache", getCacheHealthChecker(opts.CacheDriver)))
 	}
 
+	for _, s := range kms.SecretStoreSchemes() {
+		cs = append(cs, health.CheckerFunc("secretstore-"+s,
+			getSecretStoreHealthChecker(kms.SecretStoresConfig.Get()[s])))
+	}
+
 	if r.EnableAuthn {
 		cs = append(cs, health.CheckerFunc("casdoor", casdoor.IsConnected))
 	}
@@ -69,6 +79,12 @@ func getCacheHealthChecker(cacheDriver cache.Driver) health.Check {
 	}
 }
 
+func getSecretStoreHealthChecker(drv kms.Driver) health.Check {
+	return func(ctx context.Context) error {
+		return kms.IsConnected(ctx, drv)
+	}
+}
+
 func getGoPoolHealthChecker() health.Check {
 	return func(_ context.Context) error {
 		return gopool.IsHealthy()
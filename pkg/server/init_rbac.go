@@ -75,6 +75,7 @@ func createRoles(ctx context.Context, mc model.ClientSet) error {
 						"templates",
 						"templateVersions",
 						"resourceDefinitions",
+						"resourceRunApprovalRules",
 						"variables"),
 				},
 				{
@@ -129,6 +130,7 @@ func createRoles(ctx context.Context, mc model.ClientSet) error {
 						"templateVersions",
 						"templateCompletions",
 						"resourceDefinitions",
+						"resourceRunApprovalRules",
 						"variables"),
 				},
 			},
@@ -166,6 +168,7 @@ func createRoles(ctx context.Context, mc model.ClientSet) error {
 				{
 					Actions: types.RolePolicyFields(http.MethodGet),
 					Resources: types.RolePolicyFields(
+						"budgets",
 						"catalogs",
 						"connectors",
 						"environments",
@@ -174,9 +177,14 @@ func createRoles(ctx context.Context, mc model.ClientSet) error {
 						"resources",
 						"resourceComponents",
 						"resourceRuns",
+						"resourceRunApprovalRules",
+						"resourceRunPolicies",
+						"resourceStateVersions",
 						"templates",
+						"templateUpgradeCampaigns",
 						"templateVersions",
 						"variables",
+						"webhooks",
 						"workflows",
 						"workflowExecutions",
 						"workflowStageExecutions",
@@ -196,12 +204,16 @@ func createRoles(ctx context.Context, mc model.ClientSet) error {
 				{
 					Actions: types.RolePolicyFields(http.MethodGet),
 					Resources: types.RolePolicyFields(
+						"budgets",
 						"catalogs",
 						"connectors",
 						"projects",
 						"projectSubjects",
+						"resourceRunApprovalRules",
+						"resourceRunPolicies",
 						"templates",
-						"templateVersions"),
+						"templateVersions",
+						"webhooks"),
 				},
 				{
 					Actions: types.RolePolicyFields("*"),
@@ -210,6 +222,8 @@ func createRoles(ctx context.Context, mc model.ClientSet) error {
 						"resources",
 						"resourceComponents",
 						"resourceRuns",
+						"resourceStateVersions",
+						"templateUpgradeCampaigns",
 						"variables",
 						"workflows",
 						"workflowExecutions",
@@ -242,6 +256,7 @@ func createRoles(ctx context.Context, mc model.ClientSet) error {
 				{
 					Actions: types.RolePolicyFields("*"),
 					Resources: types.RolePolicyFields(
+						"budgets",
 						"catalogs",
 						"connectors",
 						"environments",
@@ -250,9 +265,14 @@ func createRoles(ctx context.Context, mc model.ClientSet) error {
 						"resources",
 						"resourceComponents",
 						"resourceRuns",
+						"resourceRunApprovalRules",
+						"resourceRunPolicies",
+						"resourceStateVersions",
 						"templates",
+						"templateUpgradeCampaigns",
 						"templateVersions",
 						"variables",
+						"webhooks",
 						"workflows",
 						"workflowExecutions",
 						"workflowStageExecutions",
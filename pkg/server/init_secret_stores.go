@@ -0,0 +1,27 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/seal-io/walrus/pkg/kms"
+)
+
+// setupSecretStores configures the external secret stores,
+// which are used to resolve the secret references at running.
+func (r *Server) setupSecretStores(ctx context.Context, opts initOptions) error {
+	stores := make(map[string]kms.Driver, len(r.SecretStores))
+
+	for i := range r.SecretStores {
+		s, drv, err := kms.NewSecretStore(r.SecretStores[i])
+		if err != nil {
+			return fmt.Errorf("error creating secret store: %w", err)
+		}
+
+		stores[s] = drv
+	}
+
+	kms.SecretStoresConfig.Set(stores)
+
+	return nil
+}
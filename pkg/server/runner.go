@@ -9,6 +9,8 @@ import (
 	"fmt"
 	stdlog "log"
 	"net"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -30,12 +32,15 @@ import (
 	"github.com/seal-io/walrus/pkg/apis"
 	"github.com/seal-io/walrus/pkg/cache"
 	"github.com/seal-io/walrus/pkg/casdoor"
+	"github.com/seal-io/walrus/pkg/consts"
 	"github.com/seal-io/walrus/pkg/cron"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/pkg/database"
+	"github.com/seal-io/walrus/pkg/dataencryption"
 	"github.com/seal-io/walrus/pkg/datalisten"
 	"github.com/seal-io/walrus/pkg/k8s"
+	"github.com/seal-io/walrus/pkg/kms"
 	"github.com/seal-io/walrus/pkg/servervars"
 	"github.com/seal-io/walrus/pkg/storage"
 	"github.com/seal-io/walrus/utils/clis"
@@ -81,6 +86,7 @@ type Server struct {
 	DataSourceConnMaxLife    time.Duration
 	DataSourceDataEncryptAlg string
 	DataSourceDataEncryptKey []byte
+	DataSourceDataEncryptKEK string
 
 	CacheSourceAddress     string
 	CacheSourceConnMaxOpen int
@@ -89,10 +95,20 @@ type Server struct {
 
 	S3SourceAddress string
 
+	StorageDriver        string
+	StorageFilesystemDir string
+
+	SecretStores []string
+
 	EnableAuthn            bool
 	AuthnSessionMaxIdle    time.Duration
 	CasdoorServer          string
 	BuiltinCatalogProvider string
+	CostPricingCatalog     string
+
+	DeployerExecutor     string
+	DeployerLocalWorkdir string
+	DeployerLocalWorkers int
 }
 
 func New() *Server {
@@ -117,6 +133,11 @@ func New() *Server {
 		AuthnSessionMaxIdle:    30 * time.Minute,
 		GopoolWorkerFactor:     100,
 		BuiltinCatalogProvider: "github",
+		StorageDriver:          storage.DriverS3,
+		StorageFilesystemDir:   filepath.Join(consts.DataDir, "artifacts"),
+		DeployerExecutor:       "kubernetes",
+		DeployerLocalWorkdir:   filepath.Join(os.TempDir(), "walrus-deployer"),
+		DeployerLocalWorkers:   4,
 	}
 }
 
@@ -387,12 +408,27 @@ func (r *Server) Flags(cmd *cli.Command) {
 				return nil
 			},
 		},
+		&cli.StringFlag{
+			Name: "data-source-data-encryption-kek",
+			Usage: "The provider and address of the key encryption key(KEK) for wrapping the rotated data encryption keys, " +
+				"enables the envelope encryption, e.g. file:/etc/walrus/kek, " +
+				"the file holds an AES-GCM key(in-hex string) in 16 bytes or 32 bytes.",
+			Action: func(c *cli.Context, s string) error {
+				if _, _, err := dataencryption.ParseKEK(s); err != nil {
+					return fmt.Errorf("invalid --data-source-data-encryption-kek: %w", err)
+				}
+				r.DataSourceDataEncryptKEK = s
+				return nil
+			},
+		},
 		&cli.StringFlag{
 			Name: "cache-source-address",
 			Usage: "The addresses for connecting cache source, e.g. " +
 				"Redis(redis://[username[:password]@]host[:port]/dbname[?param1=value1&...&paramN=valueN]), " +
 				"Redis Cluster(rediss://[username[:password]@]host[:port]?addr=host2[:port2]&addr=host3" +
-				"[:port3][&param1=value1&...&paramN=valueN]).",
+				"[:port3][&param1=value1&...&paramN=valueN]), " +
+				"In-process LRU(memory://[?max_entries=10000&entry_max_age=5m]), " +
+				"which invalidates the entries across the replicas by the database notifications.",
 			Destination: &r.CacheSourceAddress,
 			Value:       r.CacheSourceAddress,
 		},
@@ -403,6 +439,54 @@ func (r *Server) Flags(cmd *cli.Command) {
 			Destination: &r.S3SourceAddress,
 			Value:       r.S3SourceAddress,
 		},
+		&cli.StringFlag{
+			Name: "storage-driver",
+			Usage: "Specify the driver to store the artifacts of the resource runs, i.e. plans, logs and state snapshots, " +
+				"select from 's3', 'filesystem' or 'database', " +
+				"the 's3' driver connects the service specified by --s3-source-address or runs an embedded one, " +
+				"the 'filesystem' driver stores under the directory specified by --storage-filesystem-dir.",
+			Destination: &r.StorageDriver,
+			Value:       r.StorageDriver,
+			Action: func(c *cli.Context, s string) error {
+				ss := []string{storage.DriverS3, storage.DriverFilesystem, storage.DriverDatabase}
+				if !slices.Contains(ss, s) {
+					return fmt.Errorf(`--storage-driver: select from %v`, ss)
+				}
+				return nil
+			},
+		},
+		&cli.StringFlag{
+			Name:        "storage-filesystem-dir",
+			Usage:       "The directory to store the artifacts of the resource runs by the 'filesystem' storage driver.",
+			Destination: &r.StorageFilesystemDir,
+			Value:       r.StorageFilesystemDir,
+		},
+		&cli.StringSliceFlag{
+			Name: "secret-stores",
+			Usage: "The addresses of the external secret stores, " +
+				"which can be referenced by the sensitive variables and the connector configurations " +
+				"in form of <scheme>://<path>[#<key>], e.g. vault://db/prod#password, " +
+				"select from HashiCorp Vault KV version 2" +
+				"(vault://[token@]host[:port][/mount][?namespace=ns&sslmode=disable&insecure=true], " +
+				"uses VAULT_TOKEN environment variable if the token is not provided), " +
+				"Local Filesystem(file:///path/to/dir).",
+			Action: func(c *cli.Context, v []string) error {
+				ss := make(map[string]struct{}, len(v))
+				for i := range v {
+					s, _, err := kms.NewSecretStore(v[i])
+					if err != nil {
+						return fmt.Errorf("invalid --secret-stores: %w", err)
+					}
+					if _, ok := ss[s]; ok {
+						return fmt.Errorf("invalid --secret-stores: duplicated %q", s)
+					}
+					ss[s] = struct{}{}
+				}
+				r.SecretStores = v
+				return nil
+			},
+			Value: cli.NewStringSlice(r.SecretStores...),
+		},
 		&cli.IntFlag{
 			Name:        "cache-source-conn-max-open",
 			Usage:       "The maximum opening connections for connecting cache source.",
@@ -446,6 +530,14 @@ func (r *Server) Flags(cmd *cli.Command) {
 			Destination: &r.CasdoorServer,
 			Value:       r.CasdoorServer,
 		},
+		&cli.StringFlag{
+			Name: "cost-pricing-catalog",
+			Usage: "The path of the pricing catalog file for estimating the cost of the resource run plans, " +
+				"which is in the format of the Infracost price data dump and can be gzipped, " +
+				"the builtin catalog is used if not specified.",
+			Destination: &r.CostPricingCatalog,
+			Value:       r.CostPricingCatalog,
+		},
 		&cli.IntFlag{
 			Name: "gopool-worker-factor",
 			Usage: "The gopool worker factor determines the number of tasks of the goroutine worker pool," +
@@ -459,6 +551,38 @@ func (r *Server) Flags(cmd *cli.Command) {
 			Destination: &r.GopoolWorkerFactor,
 			Value:       r.GopoolWorkerFactor,
 		},
+		&cli.StringFlag{
+			Name: "deployer-executor",
+			Usage: "Specify the executor to run the deployment jobs, select from 'kubernetes' or 'local', " +
+				"the 'local' executor runs the jobs as local processes without Kubernetes.",
+			Destination: &r.DeployerExecutor,
+			Value:       r.DeployerExecutor,
+			Action: func(c *cli.Context, s string) error {
+				ss := []string{"kubernetes", "local"}
+				if !slices.Contains(ss, s) {
+					return fmt.Errorf(`--deployer-executor: select from %v`, ss)
+				}
+				return nil
+			},
+		},
+		&cli.StringFlag{
+			Name:        "deployer-local-workdir",
+			Usage:       "The directory to place the sandboxes of the deployment jobs run by the local executor.",
+			Destination: &r.DeployerLocalWorkdir,
+			Value:       r.DeployerLocalWorkdir,
+		},
+		&cli.IntFlag{
+			Name:  "deployer-local-workers",
+			Usage: "The maximum number of the deployment jobs run by the local executor at the same time.",
+			Action: func(c *cli.Context, i int) error {
+				if i <= 0 {
+					return errors.New("too small --deployer-local-workers: must be greater than 0")
+				}
+				return nil
+			},
+			Destination: &r.DeployerLocalWorkers,
+			Value:       r.DeployerLocalWorkers,
+		},
 	}
 	for i := range flags {
 		cmd.Flags = append(cmd.Flags, flags[i])
@@ -588,6 +712,9 @@ func (r *Server) Run(c context.Context) error {
 
 		r.setCacheDriver(cacheDrv)
 
+		// Broadcast the invalidation of the in-process cache if needed.
+		cache.BroadcastInvalidation(cacheDrv, databaseDrv)
+
 		// Wait cache to be ready.
 		if err = cache.Wait(ctx, cacheDrv); err != nil {
 			return fmt.Errorf("error waiting cache ready: %w", err)
@@ -596,7 +723,9 @@ func (r *Server) Run(c context.Context) error {
 
 	var storageConf *storage.Config
 
-	if r.S3SourceAddress == "" {
+	switch {
+	case r.StorageDriver != storage.DriverS3:
+	case r.S3SourceAddress == "":
 		var e storage.Embedded
 
 		g.Go(func() error {
@@ -620,19 +749,13 @@ func (r *Server) Run(c context.Context) error {
 		if err = storage.Wait(ctx, storageConf.Endpoint); err != nil {
 			return fmt.Errorf("error waiting s3 ready: %w", err)
 		}
-	} else {
+	default:
 		storageConf, err = storage.NewConfig(r.S3SourceAddress)
 		if err != nil {
 			return fmt.Errorf("error loading s3 config: %w", err)
 		}
 	}
 
-	// Load object manager.
-	storageMgr, err := storage.NewManager(storageConf)
-	if err != nil {
-		return fmt.Errorf("error loading object manager: %w", err)
-	}
-
 	// Enable authentication if needed.
 	if r.EnableAuthn {
 		if r.CasdoorServer == "" {
@@ -665,6 +788,24 @@ func (r *Server) Run(c context.Context) error {
 	log.Info("initializing")
 	modelClient := getModelClient(databaseDrvDialect, databaseDrv)
 
+	// Load object manager.
+	var storageDrv storage.Driver
+
+	switch r.StorageDriver {
+	case storage.DriverFilesystem:
+		storageDrv, err = storage.NewFilesystemDriver(r.StorageFilesystemDir)
+	case storage.DriverDatabase:
+		storageDrv = storage.NewDatabaseDriver(modelClient)
+	default:
+		storageDrv, err = storage.NewS3Driver(storageConf)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error loading object manager: %w", err)
+	}
+
+	storageMgr := storage.NewManager(storageDrv)
+
 	initOpts := initOptions{
 		K8sConfig:              k8sCfg,
 		K8sCtrlMgrIsReady:      &atomic.Bool{},
@@ -684,6 +825,7 @@ func (r *Server) Run(c context.Context) error {
 	startDataListenOpts := datalisten.StartOptions{
 		ModelClient:       modelClient,
 		DataSourceAddress: r.DataSourceAddress,
+		CacheDriver:       cacheDrv,
 	}
 
 	g.Go(func() error {
@@ -780,9 +922,13 @@ func (r *Server) configure(_ context.Context) error {
 			return fmt.Errorf("error gaining data encryptor: %w", err)
 		}
 
-		crypto.EncryptorConfig.Set(enc)
+		dataencryption.KeyringConfig.Set(cryptox.NewKeyring(enc))
 	}
 
+	// Encrypt with the rotated data encryption keys if any,
+	// otherwise, encrypt with the configured one.
+	crypto.EncryptorConfig.Set(dataencryption.KeyringConfig.Get())
+
 	// Configure IPv4 addresses and subnet CIDR.
 	{
 		var (
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	imgdistref "github.com/distribution/distribution/reference"
@@ -173,6 +174,51 @@ func cronAtLeast(d time.Duration) modifyValidator {
 	}
 }
 
+// oneOf implements the modifyValidator stereotype,
+// which means the value can be modified if it's one of the given values.
+// This modifier allows blank new value,
+// if not allowed, combine with notBlank.
+func oneOf(values ...string) modifyValidator {
+	return func(ctx context.Context, name, oldVal, newVal string) (bool, error) {
+		// Allow blank,
+		// combine with notBlank if disallowed.
+		if newVal == "" {
+			return true, nil
+		}
+
+		for i := range values {
+			if newVal == values[i] {
+				return true, nil
+			}
+		}
+
+		return false, fmt.Errorf("invalid value %q, must be one of %v", newVal, values)
+	}
+}
+
+// nonNegativeInteger implements the modifyValidator stereotype,
+// which means the value can be modified if it's a non-negative integer.
+// This modifier allows blank new value,
+// if not allowed, combine with notBlank.
+func nonNegativeInteger(ctx context.Context, name, oldVal, newVal string) (bool, error) {
+	// Allow blank,
+	// combine with notBlank if disallowed.
+	if newVal == "" {
+		return true, nil
+	}
+
+	i, err := strconv.ParseInt(newVal, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid integer %q", newVal)
+	}
+
+	if i < 0 {
+		return false, fmt.Errorf("negative integer %q", newVal)
+	}
+
+	return true, nil
+}
+
 // containerImageReference implements the modifyValidator stereotype,
 // which means the value can be modified if it's container image reference.
 // This modifier allows blank new value,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/seal-io/walrus/pkg/casdoor"
 	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/crypto"
 	"github.com/seal-io/walrus/utils/pointer"
 	"github.com/seal-io/walrus/utils/strs"
@@ -51,6 +52,20 @@ var (
 		initializeFromEnv("sealio/terraform-deployer:v1.5.7-seal.1"),
 		modifyWith(notBlank, containerImageReference),
 	)
+	// OpenTofuDeployerImage indicates the image used by OpenTofu deployer.
+	OpenTofuDeployerImage = newValue(
+		"OpenTofuDeployerImage",
+		editable,
+		initializeFromEnv("ghcr.io/opentofu/opentofu:1.6.2"),
+		modifyWith(notBlank, containerImageReference),
+	)
+	// PulumiDeployerImage indicates the image used by Pulumi deployer.
+	PulumiDeployerImage = newValue(
+		"PulumiDeployerImage",
+		editable,
+		initializeFromEnv("pulumi/pulumi-base:3.108.1"),
+		modifyWith(notBlank, containerImageReference),
+	)
 	// DeployerNetworkMirrorUrl indicates the URL to configure the network mirror for deployer.
 	DeployerNetworkMirrorUrl = newValue(
 		"DeployerNetworkMirrorUrl",
@@ -139,6 +154,39 @@ var (
 		editable,
 		initializeFromEnv("true"),
 		modifyWith(notBlank))
+	// EnableResourceDriftReconcile keeps the user config for applying the drift detection plan automatically or not.
+	EnableResourceDriftReconcile = newValue(
+		"EnableResourceDriftReconcile",
+		editable,
+		initializeFromEnv("false"),
+		modifyWith(notBlank))
+	// ResourceRunEnvironmentConcurrency keeps the maximum number of the resource runs
+	// executing at the same time in one environment, zero means unlimited.
+	ResourceRunEnvironmentConcurrency = newValue(
+		"ResourceRunEnvironmentConcurrency",
+		editable,
+		initializeFromEnv("0"),
+		modifyWith(notBlank, nonNegativeInteger))
+	// ResourceRunConnectorConcurrency keeps the maximum number of the resource runs
+	// executing at the same time with one connector, zero means unlimited.
+	ResourceRunConnectorConcurrency = newValue(
+		"ResourceRunConnectorConcurrency",
+		editable,
+		initializeFromEnv("0"),
+		modifyWith(notBlank, nonNegativeInteger))
+	// AuditLogRetentionDays keeps the days to retain the audit logs, zero means retaining forever.
+	AuditLogRetentionDays = newValue(
+		"AuditLogRetentionDays",
+		editable,
+		initializeFromEnv("90"),
+		modifyWith(notBlank, nonNegativeInteger))
+	// ResourceRunArtifactRetentionDays keeps the days to retain the artifacts of the resource runs,
+	// i.e. plans, logs and state snapshots, zero means retaining forever.
+	ResourceRunArtifactRetentionDays = newValue(
+		"ResourceRunArtifactRetentionDays",
+		editable,
+		initializeFromEnv("90"),
+		modifyWith(notBlank, nonNegativeInteger))
 	// ImageRegistry config the image registry for seal tools, like finOps tools.
 	ImageRegistry = newValue(
 		"ImageRegistry",
@@ -162,6 +210,19 @@ var (
 		private,
 		initializeFromEnv("kubernetes"),
 		modifyWith(never))
+	// TemplateTestMode indicates how to test the template versions with the fixtures during synchronization,
+	// "disabled" skips the testing, "validate" runs `terraform validate`, and "plan" runs `terraform plan` in addition.
+	TemplateTestMode = newValue(
+		"TemplateTestMode",
+		editable,
+		initializeFromEnv(types.TemplateTestModeDisabled),
+		modifyWith(notBlank, oneOf(types.TemplateTestModes()...)))
+	// HideTestFailedTemplateVersions indicates whether to hide the template versions failed in testing from selection.
+	HideTestFailedTemplateVersions = newValue(
+		"HideTestFailedTemplateVersions",
+		editable,
+		initializeFromEnv("false"),
+		modifyWith(notBlank))
 	// WalrusFileHubURL keeps the address for Walrus file hub.
 	WalrusFileHubURL = newValue(
 		"WalrusFileHubURL",
@@ -182,6 +243,24 @@ var (
 		initializeFromEnv("0 0 * ? * *"),
 		modifyWith(notBlank, cronExpression),
 	)
+	// CostBudgetEvaluateCronExpr indicates the cron expression of evaluate cost budgets,
+	// default cron expression means evaluating at the 30th minute per hour,
+	// which is after the collection of cost data.
+	CostBudgetEvaluateCronExpr = newValue(
+		"CostBudgetEvaluateCronExpr",
+		editable,
+		initializeFromEnv("0 30 * ? * *"),
+		modifyWith(notBlank, cronExpression),
+	)
+	// CostAnomalyDetectCronExpr indicates the cron expression of detect cost anomalies,
+	// default cron expression means detecting at the 40th minute per hour,
+	// which is after the collection of cost data.
+	CostAnomalyDetectCronExpr = newValue(
+		"CostAnomalyDetectCronExpr",
+		editable,
+		initializeFromEnv("0 40 * ? * *"),
+		modifyWith(notBlank, cronExpression),
+	)
 	// ConnectorStatusSyncCronExpr indicates the cron expression of sync connector status,
 	// default cron expression means executing check every 5 minutes.
 	ConnectorStatusSyncCronExpr = newValue(
@@ -198,6 +277,14 @@ var (
 		initializeFromEnv("0 */1 * ? * *"),
 		modifyWith(notBlank, cronExpression),
 	)
+	// ResourceDriftDetectCronExpr indicates the cron expression of detect resource drift,
+	// default cron expression means detecting every 1 hour, and new cron must be at least 10 minutes.
+	ResourceDriftDetectCronExpr = newValue(
+		"ResourceDriftDetectCronExpr",
+		editable,
+		initializeFromEnv("0 0 * ? * *"),
+		modifyWith(notBlank, cronExpression, cronAtLeast(10*time.Minute)),
+	)
 	// ResourceComponentLabelApplyCronExpr indicates the cron expression of set labels to resource component,
 	// default cron expression means setting every 2 minutes.
 	ResourceComponentLabelApplyCronExpr = newValue(
@@ -222,6 +309,14 @@ var (
 		initializeFrom("0 */30 * ? * *"),
 		modifyWith(notBlank, cronExpression),
 	)
+	// ResourceRunQueueCheckCronExpr indicates the cron expression of dequeue the queued resource runs,
+	// default cron expression means checking every 10 seconds.
+	ResourceRunQueueCheckCronExpr = newValue(
+		"ResourceRunQueueCheckCronExpr",
+		editable,
+		initializeFromEnv("*/10 * * ? * *"),
+		modifyWith(notBlank, cronExpression),
+	)
 	// ResourceRelationshipCheckCronExpr indicates the cron expression of deploy scheduled resource,
 	// default cron expression means deploying every 30 seconds.
 	ResourceRelationshipCheckCronExpr = newValue(
@@ -238,6 +333,30 @@ var (
 		initializeFrom("0 0 2 * * *"),
 		modifyWith(notBlank, cronExpression),
 	)
+	// AuditLogCleanCronExpr indicates the cron expression of clean the audit logs out of retention,
+	// default cron expression means cleaning up at 3 o'clock every day.
+	AuditLogCleanCronExpr = newValue(
+		"AuditLogCleanCronExpr",
+		editable,
+		initializeFromEnv("0 0 3 * * *"),
+		modifyWith(notBlank, cronExpression),
+	)
+	// ResourceRunArtifactCleanCronExpr indicates the cron expression of clean the resource run artifacts out of retention,
+	// default cron expression means cleaning up at 4 o'clock every day.
+	ResourceRunArtifactCleanCronExpr = newValue(
+		"ResourceRunArtifactCleanCronExpr",
+		editable,
+		initializeFromEnv("0 0 4 * * *"),
+		modifyWith(notBlank, cronExpression),
+	)
+	// TemplateUpgradeCampaignProcessCronExpr indicates the cron expression of process the template upgrade campaigns,
+	// default cron expression means processing every 30 seconds.
+	TemplateUpgradeCampaignProcessCronExpr = newValue(
+		"TemplateUpgradeCampaignProcessCronExpr",
+		editable,
+		initializeFromEnv("*/30 * * ? * *"),
+		modifyWith(notBlank, cronExpression),
+	)
 	// CatalogTemplateSyncCronExpr indicates the cron expression of catalog template synchronization event,
 	// default cron expression means sync at 1 o'clock evey day, and new cron must be at least 30 minutes.
 	CatalogTemplateSyncCronExpr = newValue(
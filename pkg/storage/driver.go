@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned when the object is not found.
+var ErrNotFound = errors.New("object not found")
+
+// ErrChecksumMismatch is returned when the checksum of the retrieved object is inconsistent.
+var ErrChecksumMismatch = errors.New("object checksum mismatch")
+
+const (
+	// DriverS3 stores the objects in the S3 compatible service.
+	DriverS3 = "s3"
+	// DriverFilesystem stores the objects in the local filesystem.
+	DriverFilesystem = "filesystem"
+	// DriverDatabase stores the objects in the database.
+	DriverDatabase = "database"
+)
+
+type (
+	// Object holds the metadata of the stored object.
+	Object struct {
+		// Key is the key of the object.
+		Key string
+		// Size is the size of the object in bytes.
+		Size int64
+		// Checksum is the checksum of the object, empty if not recorded.
+		Checksum string
+		// ModifiedAt is the last modified time of the object.
+		ModifiedAt time.Time
+	}
+
+	// Driver defines the operations of the object storage backend.
+	Driver interface {
+		// Name returns the name of the Driver.
+		Name() string
+
+		// Put stores the data under the given key along with the checksum,
+		// overwrites the existing object.
+		Put(ctx context.Context, key string, data []byte, checksum string) error
+
+		// Get retrieves the data and the checksum of the given key,
+		// returns ErrNotFound if no such key.
+		Get(ctx context.Context, key string) (data []byte, checksum string, err error)
+
+		// Delete removes the object of the given key,
+		// returns nil if no such key.
+		Delete(ctx context.Context, key string) error
+
+		// List lists the objects with the given key prefix.
+		List(ctx context.Context, prefix string) ([]Object, error)
+
+		// IsConnected checks whether the backend is available.
+		IsConnected(ctx context.Context) error
+	}
+)
+
+// Checksum returns the checksum of the given data.
+func Checksum(data []byte) string {
+	s := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(s[:])
+}
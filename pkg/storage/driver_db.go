@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/storageobject"
+)
+
+// NewDatabaseDriver returns a Driver which stores the objects in the database,
+// it is suitable for the small installation without external object storage.
+func NewDatabaseDriver(mc model.ClientSet) Driver {
+	return databaseDriver{modelClient: mc}
+}
+
+type databaseDriver struct {
+	modelClient model.ClientSet
+}
+
+func (databaseDriver) Name() string {
+	return DriverDatabase
+}
+
+func (d databaseDriver) Put(ctx context.Context, key string, data []byte, checksum string) error {
+	return d.modelClient.StorageObjects().Create().
+		SetKey(key).
+		SetData(data).
+		SetSize(int64(len(data))).
+		SetChecksum(checksum).
+		OnConflictColumns(storageobject.FieldKey).
+		UpdateNewValues().
+		Exec(ctx)
+}
+
+func (d databaseDriver) Get(ctx context.Context, key string) ([]byte, string, error) {
+	obj, err := d.modelClient.StorageObjects().Query().
+		Where(storageobject.Key(key)).
+		Select(
+			storageobject.FieldData,
+			storageobject.FieldChecksum).
+		Only(ctx)
+	if err != nil {
+		if model.IsNotFound(err) {
+			return nil, "", ErrNotFound
+		}
+
+		return nil, "", err
+	}
+
+	return obj.Data, obj.Checksum, nil
+}
+
+func (d databaseDriver) Delete(ctx context.Context, key string) error {
+	_, err := d.modelClient.StorageObjects().Delete().
+		Where(storageobject.Key(key)).
+		Exec(ctx)
+
+	return err
+}
+
+func (d databaseDriver) List(ctx context.Context, prefix string) ([]Object, error) {
+	entities, err := d.modelClient.StorageObjects().Query().
+		Where(storageobject.KeyHasPrefix(prefix)).
+		Select(
+			storageobject.FieldKey,
+			storageobject.FieldSize,
+			storageobject.FieldChecksum,
+			storageobject.FieldUpdateTime).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make([]Object, 0, len(entities))
+
+	for i := range entities {
+		objs = append(objs, Object{
+			Key:        entities[i].Key,
+			Size:       entities[i].Size,
+			Checksum:   entities[i].Checksum,
+			ModifiedAt: *entities[i].UpdateTime,
+		})
+	}
+
+	return objs, nil
+}
+
+func (d databaseDriver) IsConnected(ctx context.Context) error {
+	_, err := d.modelClient.StorageObjects().Query().
+		Limit(1).
+		Exist(ctx)
+
+	return err
+}
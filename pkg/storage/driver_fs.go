@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// checksumSuffix is the suffix of the file which records the checksum of the object.
+const checksumSuffix = ".checksum"
+
+// NewFilesystemDriver returns a Driver which stores the objects as files under the given directory.
+func NewFilesystemDriver(dir string) (Driver, error) {
+	if dir == "" {
+		return nil, errors.New("directory is required")
+	}
+
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating directory: %w", err)
+	}
+
+	return filesystemDriver{dir: dir}, nil
+}
+
+type filesystemDriver struct {
+	dir string
+}
+
+func (filesystemDriver) Name() string {
+	return DriverFilesystem
+}
+
+// path returns the file path of the given key, which is always under the directory.
+func (d filesystemDriver) path(key string) string {
+	return filepath.Join(d.dir, filepath.FromSlash(filepath.Clean("/"+key)))
+}
+
+func (d filesystemDriver) Put(_ context.Context, key string, data []byte, checksum string) error {
+	p := d.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return fmt.Errorf("error creating directory: %w", err)
+	}
+
+	// Write to a temporary file and rename it,
+	// so that the readers never see a partial object.
+	if err := writeFileAtomic(p, data); err != nil {
+		return err
+	}
+
+	return writeFileAtomic(p+checksumSuffix, []byte(checksum))
+}
+
+func (d filesystemDriver) Get(_ context.Context, key string) ([]byte, string, error) {
+	p := d.path(key)
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, "", ErrNotFound
+		}
+
+		return nil, "", err
+	}
+
+	checksum, err := os.ReadFile(p + checksumSuffix)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, "", err
+	}
+
+	return data, string(checksum), nil
+}
+
+func (d filesystemDriver) Delete(_ context.Context, key string) error {
+	p := d.path(key)
+
+	for _, f := range []string{p, p + checksumSuffix} {
+		if err := os.Remove(f); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d filesystemDriver) List(_ context.Context, prefix string) ([]Object, error) {
+	var objs []Object
+
+	err := filepath.WalkDir(d.dir, func(p string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if e.IsDir() || strings.HasSuffix(p, checksumSuffix) || strings.HasPrefix(e.Name(), ".tmp-") {
+			return nil
+		}
+
+		rp, err := filepath.Rel(d.dir, p)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rp)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		fi, err := e.Info()
+		if err != nil {
+			return err
+		}
+
+		checksum, _ := os.ReadFile(p + checksumSuffix)
+
+		objs = append(objs, Object{
+			Key:        key,
+			Size:       fi.Size(),
+			Checksum:   string(checksum),
+			ModifiedAt: fi.ModTime(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objs, nil
+}
+
+func (d filesystemDriver) IsConnected(_ context.Context) error {
+	f, err := os.CreateTemp(d.dir, ".tmp-")
+	if err != nil {
+		return fmt.Errorf("directory %s is not writable: %w", d.dir, err)
+	}
+
+	_ = f.Close()
+
+	return os.Remove(f.Name())
+}
+
+func writeFileAtomic(p string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(p), ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	if _, err = f.Write(data); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), p)
+}
@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	minio "github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// s3ChecksumMetadata is the user metadata key to record the checksum of the object.
+const s3ChecksumMetadata = "Walrus-Checksum"
+
+// NewS3Driver returns a Driver which stores the objects in the S3 compatible service.
+func NewS3Driver(conf *Config) (Driver, error) {
+	minioClient, err := minio.New(conf.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(conf.AccessKeyID, conf.SecretAccessKey, ""),
+		Secure: conf.Secure,
+		Region: conf.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Driver{
+		config:      conf,
+		minioClient: minioClient,
+	}, nil
+}
+
+type s3Driver struct {
+	config      *Config
+	minioClient *minio.Client
+}
+
+func (*s3Driver) Name() string {
+	return DriverS3
+}
+
+func (d *s3Driver) Put(ctx context.Context, key string, data []byte, checksum string) error {
+	if err := d.CheckValidBucketName(ctx, d.config.Bucket); err != nil {
+		return err
+	}
+
+	_, err := d.minioClient.PutObject(ctx, d.config.Bucket, key, bytes.NewReader(data), int64(len(data)),
+		minio.PutObjectOptions{
+			UserMetadata: map[string]string{
+				s3ChecksumMetadata: checksum,
+			},
+		})
+
+	return err
+}
+
+func (d *s3Driver) Get(ctx context.Context, key string) ([]byte, string, error) {
+	object, err := d.minioClient.GetObject(ctx, d.config.Bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, "", d.wrapError(err)
+	}
+	defer object.Close()
+
+	buf := new(bytes.Buffer)
+
+	if _, err = buf.ReadFrom(object); err != nil {
+		return nil, "", d.wrapError(err)
+	}
+
+	stat, err := object.Stat()
+	if err != nil {
+		return nil, "", d.wrapError(err)
+	}
+
+	var checksum string
+
+	for k, v := range stat.UserMetadata {
+		if strings.EqualFold(k, s3ChecksumMetadata) {
+			checksum = v
+			break
+		}
+	}
+
+	return buf.Bytes(), checksum, nil
+}
+
+func (d *s3Driver) Delete(ctx context.Context, key string) error {
+	err := d.minioClient.RemoveObject(ctx, d.config.Bucket, key, minio.RemoveObjectOptions{})
+	if err != nil && minio.ToErrorResponse(err).Code != s3.ErrCodeNoSuchKey {
+		return err
+	}
+
+	return nil
+}
+
+func (d *s3Driver) List(ctx context.Context, prefix string) ([]Object, error) {
+	var objs []Object
+
+	for o := range d.minioClient.ListObjects(ctx, d.config.Bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		if o.Err != nil {
+			if minio.ToErrorResponse(o.Err).Code == s3.ErrCodeNoSuchBucket {
+				return objs, nil
+			}
+
+			return nil, o.Err
+		}
+
+		objs = append(objs, Object{
+			Key:        o.Key,
+			Size:       o.Size,
+			ModifiedAt: o.LastModified,
+		})
+	}
+
+	return objs, nil
+}
+
+func (d *s3Driver) IsConnected(ctx context.Context) error {
+	_, err := d.minioClient.BucketExists(ctx, d.config.Bucket)
+	return err
+}
+
+func (d *s3Driver) CheckValidBucketName(ctx context.Context, bucketName string) error {
+	found, err := d.minioClient.BucketExists(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return nil
+	}
+
+	err = d.minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{
+		Region: d.config.Region,
+	})
+	if err != nil {
+		switch minio.ToErrorResponse(err).Code {
+		case s3.ErrCodeBucketAlreadyOwnedByYou,
+			s3.ErrCodeBucketAlreadyExists:
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (d *s3Driver) wrapError(err error) error {
+	if minio.ToErrorResponse(err).Code == s3.ErrCodeNoSuchKey {
+		return ErrNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+)
+
+// ArtifactKind is the kind of the artifact produced by the resource run.
+type ArtifactKind string
+
+const (
+	// ArtifactPlan is the binary plan file for applying.
+	ArtifactPlan ArtifactKind = "plan"
+	// ArtifactJSONPlan is the plan in JSON format.
+	ArtifactJSONPlan ArtifactKind = "jsonplan"
+	// ArtifactPlanLog is the logs of the plan task.
+	ArtifactPlanLog ArtifactKind = "planlog"
+	// ArtifactLog is the logs of the apply or destroy task.
+	ArtifactLog ArtifactKind = "log"
+	// ArtifactState is the state snapshot after applying.
+	ArtifactState ArtifactKind = "state"
+)
+
+// ArtifactKinds returns all kinds of the artifact.
+func ArtifactKinds() []ArtifactKind {
+	return []ArtifactKind{
+		ArtifactPlan,
+		ArtifactJSONPlan,
+		ArtifactPlanLog,
+		ArtifactLog,
+		ArtifactState,
+	}
+}
+
+// artifactKeyPrefix is the key prefix of all artifacts.
+const artifactKeyPrefix = "walrus/"
+
+type Manager struct {
+	driver Driver
+}
+
+// NewManager creates a new storage manager with the given Driver.
+func NewManager(drv Driver) *Manager {
+	return &Manager{
+		driver: drv,
+	}
+}
+
+// Driver returns the name of the storage driver.
+func (m *Manager) Driver() string {
+	return m.driver.Name()
+}
+
+// IsConnected checks whether the storage backend is available.
+func (m *Manager) IsConnected(ctx context.Context) error {
+	return m.driver.IsConnected(ctx)
+}
+
+// SetRunArtifact stores the given kind artifact of the run along with its checksum.
+func (m *Manager) SetRunArtifact(ctx context.Context, run *model.ResourceRun, kind ArtifactKind, data []byte) error {
+	return m.driver.Put(ctx, GetArtifactKey(run, kind), data, Checksum(data))
+}
+
+// GetRunArtifact retrieves the given kind artifact of the run,
+// returns ErrNotFound if not found and ErrChecksumMismatch if the artifact is corrupted.
+func (m *Manager) GetRunArtifact(ctx context.Context, run *model.ResourceRun, kind ArtifactKind) ([]byte, error) {
+	key := GetArtifactKey(run, kind)
+
+	data, checksum, err := m.driver.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// The artifact stored by the previous version has no checksum.
+	if checksum != "" && checksum != Checksum(data) {
+		return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, key)
+	}
+
+	return data, nil
+}
+
+// DeleteRunArtifact removes the given kind artifact of the run.
+func (m *Manager) DeleteRunArtifact(ctx context.Context, run *model.ResourceRun, kind ArtifactKind) error {
+	return m.driver.Delete(ctx, GetArtifactKey(run, kind))
+}
+
+// SetRunArtifactPart stores the given part of the given kind artifact of the run,
+// it is used to store the large artifact in multiple objects.
+func (m *Manager) SetRunArtifactPart(
+	ctx context.Context,
+	run *model.ResourceRun,
+	kind ArtifactKind,
+	part int,
+	data []byte,
+) error {
+	return m.driver.Put(ctx, GetArtifactPartKey(run, kind, part), data, Checksum(data))
+}
+
+// GetRunArtifactPart retrieves the given part of the given kind artifact of the run,
+// returns ErrNotFound if not found and ErrChecksumMismatch if the part is corrupted.
+func (m *Manager) GetRunArtifactPart(
+	ctx context.Context,
+	run *model.ResourceRun,
+	kind ArtifactKind,
+	part int,
+) ([]byte, error) {
+	key := GetArtifactPartKey(run, kind, part)
+
+	data, checksum, err := m.driver.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if checksum != "" && checksum != Checksum(data) {
+		return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, key)
+	}
+
+	return data, nil
+}
+
+// DeleteRunArtifacts removes all artifacts of the run, including the parts.
+func (m *Manager) DeleteRunArtifacts(ctx context.Context, run *model.ResourceRun) error {
+	objs, err := m.driver.List(ctx, getArtifactKeyPrefix(run))
+	if err != nil {
+		return fmt.Errorf("error listing artifacts: %w", err)
+	}
+
+	var errs []error
+
+	for i := range objs {
+		if err = m.driver.Delete(ctx, objs[i].Key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SetRunPlan sets the run plan files to storage.
+func (m *Manager) SetRunPlan(ctx context.Context, run *model.ResourceRun, plan []byte) error {
+	return m.SetRunArtifact(ctx, run, ArtifactPlan, plan)
+}
+
+func (m *Manager) GetRunPlan(ctx context.Context, run *model.ResourceRun) ([]byte, error) {
+	return m.GetRunArtifact(ctx, run, ArtifactPlan)
+}
+
+func (m *Manager) DeleteRunPlan(ctx context.Context, run *model.ResourceRun) error {
+	return m.DeleteRunArtifact(ctx, run, ArtifactPlan)
+}
+
+// Prune removes the artifacts modified before the given time,
+// and returns the count of the removed artifacts.
+func (m *Manager) Prune(ctx context.Context, before time.Time) (int, error) {
+	objs, err := m.driver.List(ctx, artifactKeyPrefix)
+	if err != nil {
+		return 0, fmt.Errorf("error listing artifacts: %w", err)
+	}
+
+	cnt := 0
+
+	for i := range objs {
+		if !objs[i].ModifiedAt.Before(before) {
+			continue
+		}
+
+		if err = m.driver.Delete(ctx, objs[i].Key); err != nil {
+			return cnt, fmt.Errorf("error deleting artifact %s: %w", objs[i].Key, err)
+		}
+
+		cnt++
+	}
+
+	return cnt, nil
+}
+
+func GetPlanFileName(run *model.ResourceRun) string {
+	return fmt.Sprintf("walrus/project/%s/environment/%s/resource/%s.zip", run.ProjectID, run.EnvironmentID, run.ID)
+}
+
+// GetArtifactKey returns the key of the given kind artifact of the run.
+func GetArtifactKey(run *model.ResourceRun, kind ArtifactKind) string {
+	if kind == ArtifactPlan {
+		return GetPlanFileName(run)
+	}
+
+	return getArtifactKeyPrefix(run) + string(kind)
+}
+
+// GetArtifactPartKey returns the key of the given part of the given kind artifact of the run.
+func GetArtifactPartKey(run *model.ResourceRun, kind ArtifactKind, part int) string {
+	return fmt.Sprintf("%s.%06d", GetArtifactKey(run, kind), part)
+}
+
+// getArtifactKeyPrefix returns the key prefix of all artifacts of the run.
+func getArtifactKeyPrefix(run *model.ResourceRun) string {
+	return strings.TrimSuffix(GetPlanFileName(run), "zip")
+}
@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+func TestManager(t *testing.T) {
+	ctx := context.Background()
+
+	dir := t.TempDir()
+
+	fsDrv, err := NewFilesystemDriver(dir)
+	require.NoError(t, err)
+
+	drivers := []Driver{fsDrv}
+
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) != nil {
+		client := enttest.Open(t, "sqlite3", "file:storage?mode=memory&cache=shared&_fk=1")
+		defer client.Close()
+
+		drivers = append(drivers, NewDatabaseDriver(client))
+	}
+
+	run := &model.ResourceRun{
+		ID:            "1",
+		ProjectID:     "2",
+		EnvironmentID: "3",
+	}
+
+	for _, drv := range drivers {
+		t.Run(drv.Name(), func(t *testing.T) {
+			m := NewManager(drv)
+
+			require.NoError(t, m.IsConnected(ctx))
+
+			_, err := m.GetRunPlan(ctx, run)
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			require.NoError(t, m.SetRunPlan(ctx, run, []byte("plan")))
+			require.NoError(t, m.SetRunArtifact(ctx, run, ArtifactLog, []byte("log")))
+			// Overwrite.
+			require.NoError(t, m.SetRunArtifact(ctx, run, ArtifactLog, []byte("new log")))
+
+			plan, err := m.GetRunPlan(ctx, run)
+			require.NoError(t, err)
+			assert.Equal(t, "plan", string(plan))
+
+			logs, err := m.GetRunArtifact(ctx, run, ArtifactLog)
+			require.NoError(t, err)
+			assert.Equal(t, "new log", string(logs))
+
+			// Retain the fresh artifacts.
+			cnt, err := m.Prune(ctx, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, 0, cnt)
+
+			require.NoError(t, m.DeleteRunPlan(ctx, run))
+
+			_, err = m.GetRunPlan(ctx, run)
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			cnt, err = m.Prune(ctx, time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, 1, cnt)
+
+			_, err = m.GetRunArtifact(ctx, run, ArtifactLog)
+			assert.ErrorIs(t, err, ErrNotFound)
+
+			assert.NoError(t, m.DeleteRunArtifacts(ctx, run))
+		})
+	}
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		m := NewManager(fsDrv)
+
+		require.NoError(t, m.SetRunArtifact(ctx, run, ArtifactState, []byte(`{"version":4}`)))
+
+		p := filepath.Join(dir, filepath.FromSlash(GetArtifactKey(run, ArtifactState)))
+		require.NoError(t, os.WriteFile(p, []byte(`{"version":5}`), 0o600))
+
+		_, err := m.GetRunArtifact(ctx, run, ArtifactState)
+		assert.ErrorIs(t, err, ErrChecksumMismatch)
+
+		// The artifact stored without checksum is not verified.
+		require.NoError(t, os.Remove(p+checksumSuffix))
+
+		state, err := m.GetRunArtifact(ctx, run, ArtifactState)
+		require.NoError(t, err)
+		assert.Equal(t, `{"version":5}`, string(state))
+	})
+}
+
+func TestGetArtifactKey(t *testing.T) {
+	run := &model.ResourceRun{
+		ID:            "1",
+		ProjectID:     "2",
+		EnvironmentID: "3",
+	}
+
+	assert.Equal(t, "walrus/project/2/environment/3/resource/1.zip", GetArtifactKey(run, ArtifactPlan))
+	assert.Equal(t, "walrus/project/2/environment/3/resource/1.jsonplan", GetArtifactKey(run, ArtifactJSONPlan))
+	assert.Equal(t, "walrus/project/2/environment/3/resource/1.state", GetArtifactKey(run, ArtifactState))
+}
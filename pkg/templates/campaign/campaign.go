@@ -0,0 +1,373 @@
+package campaign
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/seal-io/walrus/pkg/auths/session"
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/resource"
+	"github.com/seal-io/walrus/pkg/dao/model/resourcerun"
+	"github.com/seal-io/walrus/pkg/dao/model/subject"
+	"github.com/seal-io/walrus/pkg/dao/model/templateupgradecampaign"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	deptypes "github.com/seal-io/walrus/pkg/deployer/types"
+	runstatus "github.com/seal-io/walrus/pkg/resourceruns/status"
+	pkgresource "github.com/seal-io/walrus/pkg/resources"
+	"github.com/seal-io/walrus/pkg/storage"
+)
+
+// Options holds the options to process the campaign.
+type Options struct {
+	// Deployer is the deployer to perform the upgrade runs.
+	Deployer deptypes.Deployer
+	// StorageManager is the storage manager of the run artifacts.
+	StorageManager *storage.Manager
+}
+
+// IsFinished returns true if the campaign is not going to upgrade any resource.
+func IsFinished(entity *model.TemplateUpgradeCampaign) bool {
+	switch entity.Status {
+	case types.TemplateUpgradeCampaignStatusSucceeded,
+		types.TemplateUpgradeCampaignStatusFailed,
+		types.TemplateUpgradeCampaignStatusCanceled:
+		return true
+	}
+
+	return false
+}
+
+// GetAffectedResources returns the resources using the given template version,
+// the resources are limited to the given project if the project ID is not empty.
+func GetAffectedResources(
+	ctx context.Context,
+	mc model.ClientSet,
+	templateVersionID, projectID object.ID,
+) (model.Resources, error) {
+	query := mc.Resources().Query().
+		Where(resource.TemplateID(templateVersionID))
+
+	if projectID != "" {
+		query.Where(resource.ProjectID(projectID))
+	}
+
+	return query.
+		Order(model.Asc(resource.FieldCreateTime)).
+		All(ctx)
+}
+
+// Process advances the given campaign,
+// the first processing plans the batches of the affected resources,
+// the following processing starts the next batch once all runs of the previous batch are finished.
+func Process(ctx context.Context, mc model.ClientSet, entity *model.TemplateUpgradeCampaign, opts Options) error {
+	if IsFinished(entity) {
+		return nil
+	}
+
+	var err error
+
+	switch entity.Status {
+	case types.TemplateUpgradeCampaignStatusPending:
+		entity.Results, err = planBatches(ctx, mc, entity)
+		if err != nil {
+			return err
+		}
+
+		entity.Status = types.TemplateUpgradeCampaignStatusRunning
+	default:
+		err = refreshResults(ctx, mc, entity)
+		if err != nil {
+			return err
+		}
+	}
+
+	if batch := nextBatch(entity.Results); batch != 0 {
+		// Act as the subject who creates the campaign.
+		ctx, err = withSubject(ctx, mc, entity.SubjectID)
+		if err != nil {
+			return err
+		}
+
+		upgradeBatch(ctx, mc, entity, batch, opts)
+	}
+
+	if pending, failed := countResults(entity.Results); pending == 0 {
+		entity.Status = types.TemplateUpgradeCampaignStatusSucceeded
+		entity.Message = ""
+
+		if failed != 0 {
+			entity.Status = types.TemplateUpgradeCampaignStatusFailed
+			entity.Message = fmt.Sprintf("%d of %d resources failed to upgrade", failed, len(entity.Results))
+		}
+	}
+
+	err = mc.TemplateUpgradeCampaigns().UpdateOne(entity).
+		// Avoid overriding the campaign canceled during the processing.
+		Where(templateupgradecampaign.StatusIn(
+			types.TemplateUpgradeCampaignStatusPending,
+			types.TemplateUpgradeCampaignStatusRunning)).
+		SetStatus(entity.Status).
+		SetMessage(entity.Message).
+		SetResults(entity.Results).
+		Exec(ctx)
+	if model.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// planBatches assigns the affected resources into batches,
+// the resources of the same environment are sorted by the dependencies.
+func planBatches(
+	ctx context.Context,
+	mc model.ClientSet,
+	entity *model.TemplateUpgradeCampaign,
+) ([]types.TemplateUpgradeCampaignResult, error) {
+	entities, err := GetAffectedResources(ctx, mc, entity.TemplateVersionID, entity.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped, err := pkgresource.GroupByEnvironment(ctx, mc, entities)
+	if err != nil {
+		return nil, err
+	}
+
+	envIDs := make([]object.ID, 0, len(grouped))
+	for id := range grouped {
+		envIDs = append(envIDs, id)
+	}
+
+	sort.Slice(envIDs, func(i, j int) bool {
+		return envIDs[i] < envIDs[j]
+	})
+
+	batchSize := entity.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	results := make([]types.TemplateUpgradeCampaignResult, 0, len(entities))
+
+	for _, envID := range envIDs {
+		sorted, err := pkgresource.TopologicalSortResources(grouped[envID])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range sorted {
+			results = append(results, types.TemplateUpgradeCampaignResult{
+				ResourceID:    res.ID,
+				ResourceName:  res.Name,
+				ProjectID:     res.ProjectID,
+				EnvironmentID: res.EnvironmentID,
+				Batch:         len(results)/batchSize + 1,
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// refreshResults refreshes the results with the status of the upgrade runs.
+func refreshResults(ctx context.Context, mc model.ClientSet, entity *model.TemplateUpgradeCampaign) error {
+	runIDs := make([]object.ID, 0)
+
+	for i := range entity.Results {
+		if entity.Results[i].RunID != "" && !entity.Results[i].Done {
+			runIDs = append(runIDs, entity.Results[i].RunID)
+		}
+	}
+
+	if len(runIDs) == 0 {
+		return nil
+	}
+
+	runs, err := mc.ResourceRuns().Query().
+		Where(resourcerun.IDIn(runIDs...)).
+		Select(
+			resourcerun.FieldID,
+			resourcerun.FieldStatus).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	runsIndex := make(map[object.ID]*model.ResourceRun, len(runs))
+	for i := range runs {
+		runsIndex[runs[i].ID] = runs[i]
+	}
+
+	for i := range entity.Results {
+		r := &entity.Results[i]
+		if r.RunID == "" || r.Done {
+			continue
+		}
+
+		run, ok := runsIndex[r.RunID]
+		if !ok {
+			r.Done, r.Failed, r.Message = true, true, "upgrade run is deleted"
+			continue
+		}
+
+		r.Status = run.Status.SummaryStatus
+
+		if runstatus.IsStatusRunning(run) {
+			continue
+		}
+
+		r.Done = true
+
+		if runstatus.IsStatusFailed(run) || runstatus.IsStatusCanceled(run) {
+			r.Failed = true
+			r.Message = run.Status.SummaryStatusMessage
+		}
+	}
+
+	return nil
+}
+
+// nextBatch returns the batch to start,
+// returns zero if the previous batch is not finished or all batches are started.
+func nextBatch(results []types.TemplateUpgradeCampaignResult) int {
+	var next int
+
+	for i := range results {
+		r := results[i]
+
+		switch {
+		case r.RunID != "" && !r.Done:
+			// The started batch is not finished.
+			return 0
+		case r.RunID == "" && !r.Done:
+			if next == 0 || r.Batch < next {
+				next = r.Batch
+			}
+		}
+	}
+
+	return next
+}
+
+// upgradeBatch creates the upgrade runs for the resources of the given batch,
+// the failures are recorded in the results instead of interrupting the campaign.
+func upgradeBatch(
+	ctx context.Context,
+	mc model.ClientSet,
+	entity *model.TemplateUpgradeCampaign,
+	batch int,
+	opts Options,
+) {
+	for i := range entity.Results {
+		r := &entity.Results[i]
+		if r.Batch != batch || r.Done || r.RunID != "" {
+			continue
+		}
+
+		run, err := upgrade(ctx, mc, entity, r.ResourceID, opts)
+
+		switch {
+		case err != nil:
+			r.Done, r.Failed, r.Message = true, true, err.Error()
+		case run == nil:
+			r.Done, r.Message = true, "resource does not use the template version anymore"
+		default:
+			r.RunID = run.ID
+			r.Status = run.Status.SummaryStatus
+		}
+	}
+}
+
+// upgrade upgrades the given resource to the target template version with the original attributes,
+// returns nil run if the resource does not use the template version anymore.
+func upgrade(
+	ctx context.Context,
+	mc model.ClientSet,
+	entity *model.TemplateUpgradeCampaign,
+	resourceID object.ID,
+	opts Options,
+) (*model.ResourceRun, error) {
+	res, err := mc.Resources().Query().
+		Where(resource.ID(resourceID)).
+		Only(ctx)
+	if err != nil {
+		if model.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if res.TemplateID == nil || *res.TemplateID != entity.TemplateVersionID {
+		return nil, nil
+	}
+
+	res.TemplateID = &entity.TargetVersionID
+
+	res.ComputedAttributes, err = pkgresource.GenComputedAttributes(ctx, mc, res)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := pkgresource.Upgrade(ctx, mc, res, pkgresource.Options{
+		Deployer:       opts.Deployer,
+		StorageManager: opts.StorageManager,
+		Preview:        entity.Preview,
+		ChangeComment:  entity.ChangeComment,
+		RunLabels: map[string]string{
+			types.LabelWalrusTemplateUpgradeCampaign: entity.ID.String(),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if run == nil {
+		return nil, errors.New("no upgrade run is created")
+	}
+
+	return run, nil
+}
+
+// countResults returns the count of the resources waiting for upgrading and the failed ones.
+func countResults(results []types.TemplateUpgradeCampaignResult) (pending, failed int) {
+	for i := range results {
+		switch {
+		case !results[i].Done:
+			pending++
+		case results[i].Failed:
+			failed++
+		}
+	}
+
+	return
+}
+
+func withSubject(ctx context.Context, mc model.ClientSet, subjectID object.ID) (context.Context, error) {
+	if _, err := session.GetSubject(ctx); err == nil {
+		return ctx, nil
+	}
+
+	if subjectID == "" {
+		return nil, errors.New("unknown subject of the campaign")
+	}
+
+	sj, err := mc.Subjects().Query().
+		Where(subject.ID(subjectID)).
+		Select(
+			subject.FieldID,
+			subject.FieldName).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subject of the campaign: %w", err)
+	}
+
+	return session.WithSubject(ctx, session.Subject{
+		ID:   sj.ID,
+		Name: sj.Name,
+	}), nil
+}
@@ -0,0 +1,227 @@
+package campaign
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+const (
+	testDriverName     = "sqlite3"
+	testDataSourceName = "file:templatecampaign?mode=memory&cache=shared&_fk=1"
+)
+
+func TestNextBatch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		given    []types.TemplateUpgradeCampaignResult
+		expected int
+	}{
+		{
+			name: "not started",
+			given: []types.TemplateUpgradeCampaignResult{
+				{Batch: 1},
+				{Batch: 2},
+			},
+			expected: 1,
+		},
+		{
+			name: "previous batch running",
+			given: []types.TemplateUpgradeCampaignResult{
+				{Batch: 1, RunID: "1"},
+				{Batch: 1, RunID: "2", Done: true},
+				{Batch: 2},
+			},
+			expected: 0,
+		},
+		{
+			name: "previous batch finished",
+			given: []types.TemplateUpgradeCampaignResult{
+				{Batch: 1, RunID: "1", Done: true},
+				{Batch: 1, Done: true, Failed: true},
+				{Batch: 2},
+				{Batch: 3},
+			},
+			expected: 2,
+		},
+		{
+			name: "all finished",
+			given: []types.TemplateUpgradeCampaignResult{
+				{Batch: 1, RunID: "1", Done: true},
+			},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, nextBatch(tc.given))
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, testDriverName, testDataSourceName)
+	defer client.Close()
+
+	sj, err := client.Subjects().Create().
+		SetKind(types.SubjectKindUser).
+		SetDomain(types.SubjectDomainBuiltin).
+		SetName("campaign").
+		Save(ctx)
+	require.NoError(t, err)
+
+	proj, err := client.Projects().Create().
+		SetName("campaign").
+		Save(ctx)
+	require.NoError(t, err)
+
+	env, err := client.Environments().Create().
+		SetProjectID(proj.ID).
+		SetName("campaign").
+		SetType(types.EnvironmentDevelopment).
+		Save(ctx)
+	require.NoError(t, err)
+
+	tmpl, err := client.Templates().Create().
+		SetName("mysql").
+		SetSource("https://example.com/mysql").
+		Save(ctx)
+	require.NoError(t, err)
+
+	tvs := make([]*model.TemplateVersion, 2)
+	for i, v := range []string{"1.0.0", "2.0.0"} {
+		tvs[i], err = client.TemplateVersions().Create().
+			SetTemplateID(tmpl.ID).
+			SetName(tmpl.Name).
+			SetVersion(v).
+			SetSource(tmpl.Source + "?ref=" + v).
+			Save(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, types.TemplateVersionLifecycleActive, tvs[i].Lifecycle)
+	}
+
+	resources := make([]*model.Resource, 3)
+	for i, n := range []string{"a", "b", "c"} {
+		resources[i], err = client.Resources().Create().
+			SetProjectID(proj.ID).
+			SetEnvironmentID(env.ID).
+			SetTemplateID(tvs[0].ID).
+			SetName(n).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	entity, err := client.TemplateUpgradeCampaigns().Create().
+		SetTemplateVersionID(tvs[0].ID).
+		SetTargetVersionID(tvs[1].ID).
+		SetBatchSize(2).
+		SetSubjectID(sj.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Plan batches.
+	results, err := planBatches(ctx, client, entity)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	batches := make([]int, len(results))
+	for i := range results {
+		batches[i] = results[i].Batch
+	}
+
+	assert.Equal(t, []int{1, 1, 2}, batches)
+
+	// Simulate the first batch is started,
+	// one run is applied and the other one is failed.
+	runs := make([]*model.ResourceRun, 2)
+	for i, mark := range []func(*model.ResourceRun){
+		func(r *model.ResourceRun) { status.ResourceRunStatusApplied.True(r, "") },
+		func(r *model.ResourceRun) { status.ResourceRunStatusApplied.False(r, "apply failed") },
+	} {
+		res := resources[i]
+
+		run := &model.ResourceRun{
+			ProjectID:       res.ProjectID,
+			EnvironmentID:   res.EnvironmentID,
+			ResourceID:      res.ID,
+			TemplateID:      tvs[1].ID,
+			TemplateName:    tvs[1].Name,
+			TemplateVersion: tvs[1].Version,
+			InputConfigs:    map[string]types.ResourceRunConfigData{},
+			CreatedBy:       sj.Name,
+			Type:            types.RunTypeUpdate.String(),
+		}
+		mark(run)
+		run.Status.SetSummary(status.WalkResourceRun(&run.Status))
+
+		runs[i], err = client.ResourceRuns().Create().
+			Set(run).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	for i := range results {
+		if results[i].ResourceID == resources[0].ID {
+			results[i].RunID = runs[0].ID
+		}
+
+		if results[i].ResourceID == resources[1].ID {
+			results[i].RunID = runs[1].ID
+		}
+	}
+
+	// The last resource is upgraded manually before the second batch.
+	_, err = client.Resources().UpdateOne(resources[2]).
+		SetTemplateID(tvs[1].ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	entity, err = client.TemplateUpgradeCampaigns().UpdateOne(entity).
+		SetStatus(types.TemplateUpgradeCampaignStatusRunning).
+		SetResults(results).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, Process(ctx, client, entity, Options{}))
+
+	entity, err = client.TemplateUpgradeCampaigns().Get(ctx, entity.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.TemplateUpgradeCampaignStatusFailed, entity.Status)
+	assert.Equal(t, "1 of 3 resources failed to upgrade", entity.Message)
+
+	for _, r := range entity.Results {
+		assert.True(t, r.Done, r.ResourceName)
+
+		switch r.ResourceID {
+		case resources[0].ID:
+			assert.False(t, r.Failed)
+		case resources[1].ID:
+			assert.True(t, r.Failed)
+			assert.Equal(t, "apply failed", r.Message)
+		case resources[2].ID:
+			assert.False(t, r.Failed)
+			assert.Empty(t, r.RunID)
+		}
+	}
+
+	// The finished campaign is not processed anymore.
+	require.NoError(t, Process(ctx, client, entity, Options{}))
+}
@@ -1,6 +1,7 @@
 package templates
 
 import (
+	"context"
 	"path/filepath"
 	"strings"
 
@@ -12,31 +13,41 @@ import (
 	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/templates/loader"
 	"github.com/seal-io/walrus/pkg/templates/openapi"
+	"github.com/seal-io/walrus/pkg/templates/tester"
 	"github.com/seal-io/walrus/pkg/vcs"
 	"github.com/seal-io/walrus/utils/log"
 	utilversion "github.com/seal-io/walrus/utils/version"
 )
 
-// getValidVersions get valid terraform module versions.
+// getValidVersions get valid terraform module versions,
+// and tests the valid versions with the given options while the worktree is checked out.
 func getValidVersions(
+	ctx context.Context,
 	entity *model.Template,
 	r *git.Repository,
 	versions []*version.Version,
-	subPath string,
-) ([]*version.Version, map[*version.Version]*loader.SchemaGroup, error) {
+	subPath, tagPrefix string,
+	testOpts tester.Options,
+) (
+	[]*version.Version,
+	map[*version.Version]*loader.SchemaGroup,
+	map[*version.Version][]types.TemplateVersionTestResult,
+	error,
+) {
 	logger := log.WithName("template")
 
 	w, err := r.Worktree()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	validVersions := make([]*version.Version, 0, len(versions))
 	versionSchema := make(map[*version.Version]*loader.SchemaGroup)
+	versionTests := make(map[*version.Version][]types.TemplateVersionTestResult)
 
 	for i := range versions {
 		v := versions[i]
-		tag := v.Original()
+		tag := tagPrefix + v.Original()
 
 		resetRef, err := vcs.GetRepoRef(r, tag)
 		if err != nil {
@@ -77,9 +88,10 @@ func getValidVersions(
 
 		validVersions = append(validVersions, v)
 		versionSchema[v] = sg
+		versionTests[v] = tester.Test(ctx, dir, sg.Schema, testOpts)
 	}
 
-	return validVersions, versionSchema, nil
+	return validVersions, versionSchema, versionTests, nil
 }
 
 func isConstraintSatisfied(schema *types.UISchema) (bool, error) {
@@ -6,20 +6,36 @@ import (
 	"path/filepath"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/hashicorp/go-version"
 
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/model/template"
 	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
 	"github.com/seal-io/walrus/pkg/settings"
 	"github.com/seal-io/walrus/pkg/templates/loader"
+	"github.com/seal-io/walrus/pkg/templates/tester"
 	"github.com/seal-io/walrus/pkg/vcs"
 	"github.com/seal-io/walrus/utils/log"
 	"github.com/seal-io/walrus/utils/strs"
 )
 
+// GetCatalogGitAuth returns the credentials to access the repositories of the given catalog.
+func GetCatalogGitAuth(c *model.Catalog) *vcs.GitAuth {
+	if c == nil || len(c.Credential) == 0 {
+		return nil
+	}
+
+	return &vcs.GitAuth{
+		Username: c.Credential[types.CatalogCredentialUsername],
+		Token:    c.Credential[types.CatalogCredentialToken],
+		SSHKey:   c.Credential[types.CatalogCredentialSSHKey],
+	}
+}
+
 // SyncTemplateFromGitRepo clones a git repository, retrieves all tags, creates a template and template versions.
 // If the template already exists, it will update the template and template versions.
 // Only semver tags will be used to create template versions.
@@ -28,9 +44,7 @@ func SyncTemplateFromGitRepo(
 	mc model.ClientSet,
 	entity *model.Template,
 	repo *vcs.Repository,
-) (err error) {
-	logger := log.WithName("template")
-
+) error {
 	tempDir := filepath.Join(os.TempDir(), "seal-template-"+strs.String(10))
 	defer os.RemoveAll(tempDir)
 
@@ -40,17 +54,40 @@ func SyncTemplateFromGitRepo(
 	}
 
 	// Clone git repository.
-	r, err := vcs.CloneGitRepo(ctx, u.String(), tempDir, settings.SkipRemoteTLSVerify.ShouldValueBool(ctx, mc))
+	r, err := vcs.CloneGitRepoWithAuth(ctx, u.String(), tempDir,
+		settings.SkipRemoteTLSVerify.ShouldValueBool(ctx, mc), repo.Auth)
 	if err != nil {
 		return err
 	}
 
-	versions, err := vcs.GetGitRepoVersions(r)
+	return SyncTemplateFromClonedGitRepo(ctx, mc, entity, repo, r)
+}
+
+// SyncTemplateFromClonedGitRepo creates or updates the template and template versions from the cloned git repository,
+// which allows syncing multiple templates of a monorepo with one clone.
+// The subdirectory of a monorepo is versioned by the tags with its path or name as the prefix if any,
+// e.g. "modules/mysql/v1.2.0" or "mysql/v1.2.0", otherwise by the semver tags of the repository.
+func SyncTemplateFromClonedGitRepo(
+	ctx context.Context,
+	mc model.ClientSet,
+	entity *model.Template,
+	repo *vcs.Repository,
+	r *git.Repository,
+) (err error) {
+	logger := log.WithName("template")
+
+	tagPrefix, err := vcs.GetGitRepoTagPrefix(r, repo.SubPath)
 	if err != nil {
 		return err
 	}
 
-	versions, versionSchema, err := getValidVersions(entity, r, versions, repo.SubPath)
+	versions, err := vcs.GetGitRepoPrefixedVersions(r, tagPrefix)
+	if err != nil {
+		return err
+	}
+
+	versions, versionSchema, versionTests, err := getValidVersions(
+		ctx, entity, r, versions, repo.SubPath, tagPrefix, getTestOptions(ctx, mc))
 	if err != nil {
 		return err
 	}
@@ -58,32 +95,7 @@ func SyncTemplateFromGitRepo(
 	if len(versions) == 0 {
 		logger.Warnf("no versions found for %s", repo.Name)
 
-		// If template exists, update template status.
-		query := mc.Templates().Query().
-			Where(template.Name(entity.Name))
-
-		if entity.ProjectID.Valid() {
-			query.Where(template.ProjectID(entity.ProjectID))
-		} else {
-			query.Where(template.ProjectIDIsNil())
-		}
-
-		t, err := query.Only(ctx)
-		if err != nil {
-			if !model.IsNotFound(err) {
-				return err
-			}
-
-			return nil
-		}
-
-		// When template source contains no valid versions, set template status to initialized.
-		status.TemplateStatusInitialized.True(t, "")
-		t.Status.SetSummary(status.WalkTemplate(&t.Status))
-
-		return mc.Templates().UpdateOne(t).
-			SetStatus(t.Status).
-			Exec(ctx)
+		return updateTemplateWithoutVersions(ctx, mc, entity)
 	}
 
 	// Create template.
@@ -106,10 +118,12 @@ func SyncTemplateFromGitRepo(
 	}()
 
 	// Create template versions.
-	templateVersions, err := genTemplateVersionsFromGitRepo(ctx, entity, versions, versionSchema, repo, mc)
+	templateVersions, err := genTemplateVersionsFromGitRepo(
+		ctx, entity, versions, versionSchema, versionTests, repo, tagPrefix, mc)
 	if err != nil {
 		return err
 	}
+
 	return createTemplateVersions(ctx, mc, entity, templateVersions)
 }
 
@@ -127,7 +141,8 @@ func SyncTemplateFromGitRef(
 	defer os.RemoveAll(tempDir)
 
 	// Clone git repository.
-	r, err := vcs.CloneGitRepo(ctx, repo.Link, tempDir, settings.SkipRemoteTLSVerify.ShouldValueBool(ctx, mc))
+	r, err := vcs.CloneGitRepoWithAuth(ctx, repo.Link, tempDir,
+		settings.SkipRemoteTLSVerify.ShouldValueBool(ctx, mc), repo.Auth)
 	if err != nil {
 		return err
 	}
@@ -192,6 +207,8 @@ func SyncTemplateFromGitRef(
 		return err
 	}
 
+	applyTestResults(tv, tester.Test(ctx, rootDir, schema.Schema, getTestOptions(ctx, mc)))
+
 	return createTemplateVersions(ctx, mc, entity, []*model.TemplateVersion{tv})
 }
 
@@ -218,6 +235,11 @@ func createTemplateVersions(
 	return mc.TemplateVersions().CreateBulk(templateVersionCreates...).
 		OnConflict(conflictOptions...).
 		UpdateNewValues().
+		Update(func(up *model.TemplateVersionUpsert) {
+			// Keep the lifecycle managed by the users.
+			up.SetIgnore(templateversion.FieldLifecycle)
+			up.SetIgnore(templateversion.FieldLifecycleReason)
+		}).
 		Exec(ctx)
 }
 
@@ -245,6 +267,37 @@ func getTemplateVersionUpsertConflictOptions(entity *model.Template) []sql.Confl
 	}
 }
 
+// updateTemplateWithoutVersions updates the status of the existing template,
+// whose source contains no valid versions.
+func updateTemplateWithoutVersions(ctx context.Context, mc model.ClientSet, entity *model.Template) error {
+	// If template exists, update template status.
+	query := mc.Templates().Query().
+		Where(template.Name(entity.Name))
+
+	if entity.ProjectID.Valid() {
+		query.Where(template.ProjectID(entity.ProjectID))
+	} else {
+		query.Where(template.ProjectIDIsNil())
+	}
+
+	t, err := query.Only(ctx)
+	if err != nil {
+		if !model.IsNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	// When template source contains no valid versions, set template status to initialized.
+	status.TemplateStatusInitialized.True(t, "")
+	t.Status.SetSummary(status.WalkTemplate(&t.Status))
+
+	return mc.Templates().UpdateOne(t).
+		SetStatus(t.Status).
+		Exec(ctx)
+}
+
 // updateTemplateStatus updates template status.
 func updateTemplateStatus(ctx context.Context, mc model.ClientSet, entity *model.Template, err error) error {
 	if err != nil {
@@ -268,7 +321,9 @@ func genTemplateVersionsFromGitRepo(
 	entity *model.Template,
 	newVersions []*version.Version,
 	versionSchema map[*version.Version]*loader.SchemaGroup,
+	versionTests map[*version.Version][]types.TemplateVersionTestResult,
 	repo *vcs.Repository,
+	tagPrefix string,
 	mc model.ClientSet,
 ) (model.TemplateVersions, error) {
 	var (
@@ -282,7 +337,7 @@ func genTemplateVersionsFromGitRepo(
 			tag = v.Original()
 		)
 
-		s, err := generateSource(repo, tag)
+		s, err := generateSource(repo, tagPrefix+tag)
 		if err != nil {
 			logger.Warnf("failed to generate source for %s:%s: %v", entity.Name, tag, err)
 			continue
@@ -300,6 +355,8 @@ func genTemplateVersionsFromGitRepo(
 			return nil, err
 		}
 
+		applyTestResults(tv, versionTests[v])
+
 		tvs = append(tvs, tv)
 	}
 
@@ -0,0 +1,99 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/predicate"
+	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+	"github.com/seal-io/walrus/pkg/settings"
+	"github.com/seal-io/walrus/pkg/templates/tester"
+)
+
+// getTestOptions returns the options to test the template versions with the settings.
+func getTestOptions(ctx context.Context, mc model.ClientSet) tester.Options {
+	opts := tester.Options{
+		Mode: settings.TemplateTestMode.ShouldValue(ctx, mc),
+	}
+
+	if opts.Mode == types.TemplateTestModeDisabled {
+		return opts
+	}
+
+	// Download the providers and modules with the proxies of deployer.
+	proxies := []struct {
+		name    string
+		setting settings.Value
+	}{
+		{"ALL_PROXY", settings.DeployerAllProxy},
+		{"HTTP_PROXY", settings.DeployerHttpProxy},
+		{"HTTPS_PROXY", settings.DeployerHttpsProxy},
+		{"NO_PROXY", settings.DeployerNoProxy},
+	}
+
+	for _, p := range proxies {
+		if v := p.setting.ShouldValue(ctx, mc); v != "" {
+			opts.Env = append(opts.Env, p.name+"="+v)
+		}
+	}
+
+	return opts
+}
+
+// applyTestResults records the test results into the template version and its status,
+// the template version is not changed if there is no result.
+func applyTestResults(tv *model.TemplateVersion, results []types.TemplateVersionTestResult) {
+	if len(results) == 0 {
+		return
+	}
+
+	tv.TestResults = results
+
+	var failed int
+
+	for i := range results {
+		if !results[i].Passed {
+			failed++
+		}
+	}
+
+	if failed == 0 {
+		status.TemplateVersionStatusTested.True(tv, "")
+	} else {
+		status.TemplateVersionStatusTested.False(tv,
+			fmt.Sprintf("%d of %d test cases failed", failed, len(results)))
+	}
+
+	tv.Status.SetSummary(status.WalkTemplateVersion(&tv.Status))
+}
+
+// WithoutTestFailedVersions returns a predicate to exclude the template versions failed in testing
+// if the HideTestFailedTemplateVersions setting is enabled, otherwise returns nil.
+func WithoutTestFailedVersions(ctx context.Context, mc model.ClientSet) predicate.TemplateVersion {
+	if !settings.HideTestFailedTemplateVersions.ShouldValueBool(ctx, mc) {
+		return nil
+	}
+
+	return testNotFailed()
+}
+
+// testNotFailed returns a predicate to select the template versions not failed in testing.
+func testNotFailed() predicate.TemplateVersion {
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.IsNull(s.C(templateversion.FieldStatus)),
+			sql.Not(sqljson.HasKey(s.C(templateversion.FieldStatus), sqljson.Path("summaryStatus"))),
+			sqljson.ValueNEQ(
+				s.C(templateversion.FieldStatus),
+				status.TemplateVersionSummaryStatusTestFailed,
+				sqljson.Path("summaryStatus"),
+			),
+		))
+	}
+}
@@ -0,0 +1,88 @@
+package templates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/status"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+func TestApplyTestResults(t *testing.T) {
+	tv := &model.TemplateVersion{}
+
+	applyTestResults(tv, nil)
+	assert.Empty(t, tv.Status.SummaryStatus)
+	assert.Nil(t, tv.TestResults)
+
+	applyTestResults(tv, []types.TemplateVersionTestResult{
+		{Name: "standalone", Passed: true},
+		{Name: "replication", Message: "terraform validate failed"},
+	})
+	assert.Equal(t, status.TemplateVersionSummaryStatusTestFailed, tv.Status.SummaryStatus)
+	assert.Equal(t, "1 of 2 test cases failed", tv.Status.SummaryStatusMessage)
+	assert.True(t, tv.Status.Error)
+	assert.Len(t, tv.TestResults, 2)
+
+	applyTestResults(tv, []types.TemplateVersionTestResult{
+		{Name: "standalone", Passed: true},
+	})
+	assert.Equal(t, "Tested", tv.Status.SummaryStatus)
+	assert.False(t, tv.Status.Error)
+}
+
+func TestTestNotFailed(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, "sqlite3", "file:templateharness?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	tmpl, err := client.Templates().Create().
+		SetName("mysql").
+		SetSource("https://example.com/mysql").
+		Save(ctx)
+	require.NoError(t, err)
+
+	results := map[string][]types.TemplateVersionTestResult{
+		"1.0.0": nil,
+		"2.0.0": {{Name: "standalone", Passed: true}},
+		"3.0.0": {{Name: "standalone"}},
+	}
+
+	for v, r := range results {
+		tv := &model.TemplateVersion{
+			TemplateID: tmpl.ID,
+			Name:       tmpl.Name,
+			Version:    v,
+			Source:     tmpl.Source + "?ref=" + v,
+		}
+		applyTestResults(tv, r)
+
+		_, err = client.TemplateVersions().Create().
+			Set(tv).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	versions, err := client.TemplateVersions().Query().
+		Where(testNotFailed()).
+		Order(model.Asc(templateversion.FieldVersion)).
+		Select(templateversion.FieldVersion).
+		Strings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0", "2.0.0"}, versions)
+}
@@ -0,0 +1,97 @@
+package templates
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// DiscoverTemplateDirs walks the given directories under the root of a monorepo,
+// and returns the slash-separated relative paths of the directories
+// containing the Terraform files or the schema.yaml, walks the whole root if no directories are given.
+// The subdirectories of a discovered directory are taken as the nested modules or examples and skipped,
+// so are the hidden directories.
+func DiscoverTemplateDirs(root string, dirs []string) ([]string, error) {
+	if len(dirs) == 0 {
+		dirs = []string{""}
+	}
+
+	var found []string
+
+	for _, d := range dirs {
+		d = strings.Trim(filepath.ToSlash(filepath.Clean("/"+d)), "/")
+		start := filepath.Join(root, filepath.FromSlash(d))
+
+		if _, err := os.Stat(start); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		err := filepath.WalkDir(start, func(p string, e fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !e.IsDir() {
+				return nil
+			}
+
+			if p != start && strings.HasPrefix(e.Name(), ".") {
+				return filepath.SkipDir
+			}
+
+			rel, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+
+			// The root itself is not a template of the monorepo.
+			if rel == "." {
+				return nil
+			}
+
+			ok, err := isTemplateDir(p)
+			if err != nil || !ok {
+				return err
+			}
+
+			found = append(found, filepath.ToSlash(rel))
+
+			return filepath.SkipDir
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Deduplicate the overlapped directories.
+	return sets.List(sets.New(found...)), nil
+}
+
+// isTemplateDir returns true if the given directory contains the Terraform files or the schema.yaml.
+func isTemplateDir(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false, err
+	}
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		switch n := e.Name(); {
+		case strings.HasSuffix(n, ".tf"), strings.HasSuffix(n, ".tf.json"),
+			n == "schema.yaml", n == "schema.yml":
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,40 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverTemplateDirs(t *testing.T) {
+	root := t.TempDir()
+
+	files := []string{
+		"main.tf",
+		"modules/mysql/main.tf",
+		"modules/mysql/modules/replica/main.tf",
+		"modules/mysql/examples/basic/main.tf",
+		"modules/redis/schema.yaml",
+		"modules/redis/README.md",
+		"modules/docs/README.md",
+		"modules/.hidden/main.tf",
+		"stacks/web/main.tf.json",
+	}
+
+	for _, f := range files {
+		p := filepath.Join(root, filepath.FromSlash(f))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, nil, 0o600))
+	}
+
+	actual, err := DiscoverTemplateDirs(root, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"modules/mysql", "modules/redis", "stacks/web"}, actual)
+
+	actual, err = DiscoverTemplateDirs(root, []string{"/modules/", "modules/mysql", "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"modules/mysql", "modules/redis"}, actual)
+}
@@ -0,0 +1,146 @@
+package templates
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-version"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/templateversion"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/settings"
+	"github.com/seal-io/walrus/pkg/templates/registry"
+	"github.com/seal-io/walrus/pkg/templates/tester"
+	"github.com/seal-io/walrus/utils/log"
+	"github.com/seal-io/walrus/utils/strs"
+)
+
+// GetTemplateRegistryAuth returns the credentials to access the private registry of the given template.
+func GetTemplateRegistryAuth(t *model.Template) *registry.Auth {
+	if t == nil || len(t.Credential) == 0 {
+		return nil
+	}
+
+	return &registry.Auth{
+		Username: t.Credential[types.TemplateCredentialUsername],
+		Token:    t.Credential[types.TemplateCredentialToken],
+	}
+}
+
+// SyncTemplateFromRegistry lists the versions of the template source stored in the OCI registry
+// or the Terraform module registry, downloads the module packages of the new versions,
+// and creates the template and template versions.
+// The existing versions are not downloaded again, as the published versions are immutable.
+func SyncTemplateFromRegistry(
+	ctx context.Context,
+	mc model.ClientSet,
+	entity *model.Template,
+	src *registry.Source,
+	auth *registry.Auth,
+) (err error) {
+	logger := log.WithName("template")
+
+	client := registry.NewClient(src, auth, settings.SkipRemoteTLSVerify.ShouldValueBool(ctx, mc))
+
+	versions, err := client.Versions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		logger.Warnf("no versions found for %s", entity.Name)
+
+		return updateTemplateWithoutVersions(ctx, mc, entity)
+	}
+
+	entity, err = CreateTemplate(ctx, mc, entity)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		rerr := updateTemplateStatus(ctx, mc, entity, err)
+		if rerr != nil {
+			logger.Errorf("failed to update template %s status: %v", entity.Name, rerr)
+		}
+	}()
+
+	existed, err := mc.TemplateVersions().Query().
+		Where(templateversion.TemplateID(entity.ID)).
+		Select(templateversion.FieldVersion).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+
+	tvs := make(model.TemplateVersions, 0, len(versions))
+
+	for _, v := range versions {
+		if containsVersion(existed, v) {
+			continue
+		}
+
+		tv, err := genTemplateVersionFromRegistry(ctx, mc, entity, client, src, v)
+		if err != nil {
+			logger.Warnf("error get \"%s:%s\"'s schema from registry: %v", entity.Name, v.Original(), err)
+			continue
+		}
+
+		tvs = append(tvs, tv)
+	}
+
+	if len(tvs) == 0 {
+		return nil
+	}
+
+	return createTemplateVersions(ctx, mc, entity, tvs)
+}
+
+// genTemplateVersionFromRegistry downloads the module package of the given version,
+// and generates the template version with the loaded schema.
+func genTemplateVersionFromRegistry(
+	ctx context.Context,
+	mc model.ClientSet,
+	entity *model.Template,
+	client registry.Client,
+	src *registry.Source,
+	v *version.Version,
+) (*model.TemplateVersion, error) {
+	tempDir := filepath.Join(os.TempDir(), "seal-template-"+strs.String(10))
+	defer os.RemoveAll(tempDir)
+
+	if err := client.Download(ctx, v, tempDir); err != nil {
+		return nil, err
+	}
+
+	rootDir := tempDir
+	if src.SubPath != "" {
+		rootDir = filepath.Join(rootDir, src.SubPath)
+	}
+
+	schema, err := getSchemas(rootDir, entity.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tv, err := genTemplateVersion(ctx, src.VersionSource(v.Original()), v.Original(), entity, schema, mc)
+	if err != nil {
+		return nil, err
+	}
+
+	applyTestResults(tv, tester.Test(ctx, rootDir, schema.Schema, getTestOptions(ctx, mc)))
+
+	return tv, nil
+}
+
+func containsVersion(existed []string, v *version.Version) bool {
+	for _, e := range existed {
+		if e == v.Original() {
+			return true
+		}
+	}
+
+	return false
+}
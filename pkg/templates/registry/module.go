@@ -0,0 +1,191 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	getter "github.com/hashicorp/go-getter"
+	"github.com/hashicorp/go-version"
+
+	"github.com/seal-io/walrus/utils/json"
+)
+
+// moduleClient accesses the module registry protocol of the registry,
+// see https://developer.hashicorp.com/terraform/internals/module-registry-protocol.
+type moduleClient struct {
+	source        *Source
+	auth          *Auth
+	httpClient    *http.Client
+	skipTLSVerify bool
+
+	// baseURL caches the discovered modules API of the registry.
+	baseURL *url.URL
+}
+
+func (c *moduleClient) Versions(ctx context.Context) ([]*version.Version, error) {
+	u, err := c.url(ctx, c.source.Repository+"/versions")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Modules []struct {
+			Versions []struct {
+				Version string `json:"version"`
+			} `json:"versions"`
+		} `json:"modules"`
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("error decoding versions: %w", err)
+	}
+
+	var tags []string
+
+	for _, m := range body.Modules {
+		for _, v := range m.Versions {
+			tags = append(tags, v.Version)
+		}
+	}
+
+	return parseVersions(tags), nil
+}
+
+func (c *moduleClient) Download(ctx context.Context, v *version.Version, dir string) error {
+	u, err := c.url(ctx, c.source.Repository+"/"+v.Original()+"/download")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(ctx, u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	location := resp.Header.Get("X-Terraform-Get")
+	if location == "" {
+		// Some registries respond the location in body.
+		var body struct {
+			Location string `json:"location"`
+		}
+
+		if b, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024)); len(b) != 0 {
+			_ = json.Unmarshal(b, &body)
+		}
+
+		location = body.Location
+	}
+
+	if location == "" {
+		return fmt.Errorf("no download location of %s:%s", c.source.Repository, v.Original())
+	}
+
+	// Resolve the relative location.
+	if strings.HasPrefix(location, "/") || strings.HasPrefix(location, "./") || strings.HasPrefix(location, "../") {
+		l, err := resp.Request.URL.Parse(location)
+		if err != nil {
+			return err
+		}
+
+		location = l.String()
+	}
+
+	hg := &getter.HttpGetter{Netrc: true, Client: c.httpClient}
+
+	// Only pass the token to the registry itself.
+	if l, err := url.Parse(location); err == nil && l.Host == c.source.Host && !c.auth.IsEmpty() {
+		hg.Header = http.Header{"Authorization": []string{"Bearer " + c.auth.Token}}
+	}
+
+	getters := make(map[string]getter.Getter, len(getter.Getters))
+	for k, g := range getter.Getters {
+		getters[k] = g
+	}
+
+	getters["http"] = hg
+	getters["https"] = hg
+
+	opts := []getter.ClientOption{
+		getter.WithContext(ctx),
+		getter.WithGetters(getters),
+	}
+	if c.skipTLSVerify {
+		opts = append(opts, getter.WithInsecure())
+	}
+
+	return getter.Get(dir, location, opts...)
+}
+
+// url returns the URL of the given path under the modules API.
+func (c *moduleClient) url(ctx context.Context, p string) (string, error) {
+	if c.baseURL == nil {
+		resp, err := c.do(ctx, "https://"+c.source.Host+"/.well-known/terraform.json")
+		if err != nil {
+			return "", fmt.Errorf("error discovering module registry: %w", err)
+		}
+		defer resp.Body.Close()
+
+		var services map[string]any
+		if err = json.NewDecoder(resp.Body).Decode(&services); err != nil {
+			return "", fmt.Errorf("error decoding services: %w", err)
+		}
+
+		s, ok := services["modules.v1"].(string)
+		if !ok || s == "" {
+			return "", errors.New("registry does not support the modules.v1 service")
+		}
+
+		base, err := resp.Request.URL.Parse(s)
+		if err != nil {
+			return "", err
+		}
+
+		if !strings.HasSuffix(base.Path, "/") {
+			base.Path += "/"
+		}
+
+		c.baseURL = base
+	}
+
+	u, err := c.baseURL.Parse(p)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+func (c *moduleClient) do(ctx context.Context, u string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.auth.IsEmpty() {
+		req.Header.Set("Authorization", "Bearer "+c.auth.Token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = checkResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
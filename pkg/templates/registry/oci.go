@@ -0,0 +1,358 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	getter "github.com/hashicorp/go-getter"
+	"github.com/hashicorp/go-version"
+
+	"github.com/seal-io/walrus/utils/json"
+)
+
+const (
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+// ociClient accesses the OCI distribution API of the registry.
+type ociClient struct {
+	source     *Source
+	auth       *Auth
+	httpClient *http.Client
+
+	// token caches the bearer token issued by the authorization service of the registry.
+	token string
+	// basic indicates the registry accepts the basic authentication.
+	basic bool
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+func (c *ociClient) url(p string) string {
+	return "https://" + c.source.Host + "/v2/" + c.source.Repository + p
+}
+
+func (c *ociClient) Versions(ctx context.Context) ([]*version.Version, error) {
+	if c.source.Tag != "" {
+		v, err := version.NewVersion(c.source.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag %q: %w", c.source.Tag, err)
+		}
+
+		return []*version.Version{v}, nil
+	}
+
+	var tags []string
+
+	for next := c.url("/tags/list"); next != ""; {
+		resp, err := c.do(ctx, next, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var body struct {
+			Tags []string `json:"tags"`
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("error decoding tags: %w", err)
+		}
+
+		tags = append(tags, body.Tags...)
+		next = nextLink(resp)
+	}
+
+	return parseVersions(tags), nil
+}
+
+func (c *ociClient) Download(ctx context.Context, v *version.Version, dir string) error {
+	resp, err := c.do(ctx, c.url("/manifests/"+v.Original()), http.Header{
+		"Accept": []string{mediaTypeOCIManifest + ", " + mediaTypeDockerManifest},
+	})
+	if err != nil {
+		return err
+	}
+
+	var manifest struct {
+		Layers []ociDescriptor `json:"layers"`
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(&manifest)
+	resp.Body.Close()
+
+	if err != nil {
+		return fmt.Errorf("error decoding manifest: %w", err)
+	}
+
+	var (
+		layer ociDescriptor
+		dec   getter.Decompressor
+	)
+
+	for _, l := range manifest.Layers {
+		if dec = layerDecompressor(l.MediaType); dec != nil {
+			layer = l
+			break
+		}
+	}
+
+	if dec == nil {
+		return fmt.Errorf("no module package layer found in %s:%s", c.source.Repository, v.Original())
+	}
+
+	f, err := os.CreateTemp("", "walrus-oci-*")
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	if err = c.fetchBlob(ctx, layer, f); err != nil {
+		return err
+	}
+
+	return dec.Decompress(dir, f.Name(), true, 0)
+}
+
+// fetchBlob writes the given blob into the writer and verifies its digest.
+func (c *ociClient) fetchBlob(ctx context.Context, desc ociDescriptor, w io.Writer) error {
+	alg, want, ok := strings.Cut(desc.Digest, ":")
+	if !ok || alg != "sha256" {
+		return fmt.Errorf("unsupported digest %q", desc.Digest)
+	}
+
+	resp, err := c.do(ctx, c.url("/blobs/"+desc.Digest), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	h := sha256.New()
+
+	if _, err = io.Copy(io.MultiWriter(w, h), resp.Body); err != nil {
+		return fmt.Errorf("error fetching blob %s: %w", desc.Digest, err)
+	}
+
+	if got := hex.EncodeToString(h.Sum(nil)); got != want {
+		return fmt.Errorf("digest mismatched of blob %s: got sha256:%s", desc.Digest, got)
+	}
+
+	return nil
+}
+
+// do sends the GET request to the registry,
+// and retries once with the authorization requested by the challenge of the registry.
+func (c *ociClient) do(ctx context.Context, u string, header http.Header) (*http.Response, error) {
+	resp, err := c.send(ctx, u, header)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.token == "" && !c.basic {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+
+		if err = c.authorize(ctx, challenge); err != nil {
+			return nil, err
+		}
+
+		resp, err = c.send(ctx, u, header)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err = checkResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *ociClient) send(ctx context.Context, u string, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range header {
+		req.Header[k] = header[k]
+	}
+
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.basic:
+		req.SetBasicAuth(c.auth.Username, c.auth.Token)
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// authorize prepares the authorization requested by the given challenge,
+// the bearer token is requested from the authorization service with the credentials if provided.
+func (c *ociClient) authorize(ctx context.Context, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.auth.IsEmpty() {
+			return errors.New("registry requires credential")
+		}
+
+		c.basic = true
+
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return fmt.Errorf("invalid authentication realm %q", params["realm"])
+	}
+
+	q := realm.Query()
+	if s := params["service"]; s != "" {
+		q.Set("service", s)
+	}
+
+	scope := params["scope"]
+	if scope == "" {
+		scope = "repository:" + c.source.Repository + ":pull"
+	}
+
+	q.Set("scope", scope)
+	realm.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if !c.auth.IsEmpty() {
+		req.SetBasicAuth(c.auth.Username, c.auth.Token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err = checkResponse(resp); err != nil {
+		return err
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("error decoding token: %w", err)
+	}
+
+	c.token = body.Token
+	if c.token == "" {
+		c.token = body.AccessToken
+	}
+
+	if c.token == "" {
+		return errors.New("no token issued by the registry")
+	}
+
+	return nil
+}
+
+// parseChallenge parses the WWW-Authenticate header,
+// e.g. Bearer realm="https://auth.example.com/token",service="registry.example.com".
+func parseChallenge(s string) (scheme string, params map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(s), " ")
+	params = make(map[string]string)
+
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		k, v, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+
+		k = strings.ToLower(strings.TrimSpace(k))
+		v = strings.TrimSpace(v)
+
+		if strings.HasPrefix(v, `"`) {
+			// Quoted value may contain commas.
+			end := strings.Index(v[1:], `"`)
+			if end < 0 {
+				params[k] = v[1:]
+				break
+			}
+
+			params[k] = v[1 : end+1]
+			rest = strings.TrimPrefix(strings.TrimSpace(v[end+2:]), ",")
+		} else {
+			v, rest, _ = strings.Cut(v, ",")
+			params[k] = strings.TrimSpace(v)
+		}
+
+		rest = strings.TrimSpace(rest)
+	}
+
+	return scheme, params
+}
+
+// nextLink returns the URL of the next page indicated by the Link header.
+func nextLink(resp *http.Response) string {
+	link := resp.Header.Get("Link")
+	if link == "" || !strings.Contains(link, `rel="next"`) {
+		return ""
+	}
+
+	s, e := strings.Index(link, "<"), strings.Index(link, ">")
+	if s < 0 || e < s {
+		return ""
+	}
+
+	u, err := resp.Request.URL.Parse(link[s+1 : e])
+	if err != nil {
+		return ""
+	}
+
+	return u.String()
+}
+
+// layerDecompressor returns the decompressor of the layer in the given media type,
+// returns nil if the layer is not an archive.
+func layerDecompressor(mediaType string) getter.Decompressor {
+	switch {
+	case strings.HasSuffix(mediaType, "zip") && !strings.HasSuffix(mediaType, "gzip"):
+		return new(getter.ZipDecompressor)
+	case strings.HasSuffix(mediaType, "gzip"):
+		return new(getter.TarGzipDecompressor)
+	case strings.HasSuffix(mediaType, "tar"):
+		return new(getter.TarDecompressor)
+	}
+
+	return nil
+}
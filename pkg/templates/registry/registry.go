@@ -0,0 +1,239 @@
+package registry
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	tfaddr "github.com/hashicorp/terraform-registry-address"
+)
+
+const (
+	// SourceTypeOCI is the type of the template source stored as an OCI artifact,
+	// e.g. oci://registry.example.com/modules/mysql.
+	SourceTypeOCI = "OCI"
+	// SourceTypeModule is the type of the template source published to a Terraform module registry,
+	// e.g. registry.example.com/infra/mysql/aws.
+	SourceTypeModule = "Module"
+)
+
+const schemeOCI = "oci://"
+
+// vcsHosts holds the hosts which are taken as the version control systems,
+// the four-part sources of them are not the module registry addresses.
+var vcsHosts = []string{
+	"github.com",
+	"gitlab.com",
+	"gitee.com",
+	"bitbucket.org",
+}
+
+// Source describes a template source stored in a registry.
+type Source struct {
+	// Type is the type of the source, either SourceTypeOCI or SourceTypeModule.
+	Type string
+	// Host is the host of the registry, may include the port.
+	Host string
+	// Repository is the repository of the OCI artifact,
+	// or the namespace/name/provider of the registry module.
+	Repository string
+	// Tag is the specified tag of the OCI artifact, empty means all tags.
+	Tag string
+	// SubPath is the subdirectory of the module package.
+	SubPath string
+}
+
+// IsSource returns true if the given template source is stored in a registry.
+func IsSource(src string) bool {
+	_, err := ParseSource(src)
+	return err == nil
+}
+
+// IsModuleSource returns true if the given template source is a module registry address,
+// which requires the version argument to be set in the module block.
+func IsModuleSource(src string) bool {
+	s, err := ParseSource(src)
+	return err == nil && s.Type == SourceTypeModule
+}
+
+// ParseSource parses the given template source,
+// returns error if the source is neither an OCI reference nor a module registry address.
+func ParseSource(src string) (*Source, error) {
+	if strings.HasPrefix(src, schemeOCI) {
+		return parseOCISource(strings.TrimPrefix(src, schemeOCI))
+	}
+
+	if strings.Contains(src, "::") || strings.Contains(src, "://") {
+		return nil, errors.New("not a registry source")
+	}
+
+	// Only accept the addresses with explicit host,
+	// the three-part addresses are ambiguous with the shorthand of the version control systems.
+	if strings.Count(strings.SplitN(src, "//", 2)[0], "/") != 3 {
+		return nil, errors.New("module registry address must be in form of host/namespace/name/provider")
+	}
+
+	m, err := tfaddr.ParseModuleSource(src)
+	if err != nil {
+		return nil, err
+	}
+
+	host := m.Package.Host.ForDisplay()
+	for _, h := range vcsHosts {
+		if host == h {
+			return nil, fmt.Errorf("%s is not a module registry", host)
+		}
+	}
+
+	return &Source{
+		Type:       SourceTypeModule,
+		Host:       host,
+		Repository: m.Package.ForRegistryProtocol(),
+		SubPath:    m.Subdir,
+	}, nil
+}
+
+func parseOCISource(ref string) (*Source, error) {
+	s := &Source{Type: SourceTypeOCI}
+
+	if r, query, ok := strings.Cut(ref, "?"); ok {
+		ref = r
+
+		for _, kv := range strings.Split(query, "&") {
+			k, v, _ := strings.Cut(kv, "=")
+			if k != "tag" {
+				return nil, fmt.Errorf("unsupported OCI source argument %q", k)
+			}
+
+			s.Tag = v
+		}
+	}
+
+	ref, s.SubPath, _ = strings.Cut(ref, "//")
+
+	host, repo, ok := strings.Cut(ref, "/")
+	if !ok || host == "" || repo == "" {
+		return nil, errors.New("OCI source must be in form of oci://host/repository")
+	}
+
+	s.Host = host
+	s.Repository = strings.TrimSuffix(repo, "/")
+
+	return s, nil
+}
+
+// String returns the source without the version.
+func (s *Source) String() string {
+	var src string
+
+	switch s.Type {
+	case SourceTypeOCI:
+		src = schemeOCI + s.Host + "/" + s.Repository
+	default:
+		src = s.Host + "/" + s.Repository
+	}
+
+	if s.SubPath != "" {
+		src += "//" + s.SubPath
+	}
+
+	return src
+}
+
+// VersionSource returns the module source of the given version,
+// the version of the module registry address is not included,
+// which must be specified by the version argument of the module block.
+func (s *Source) VersionSource(v string) string {
+	if s.Type == SourceTypeOCI {
+		return s.String() + "?tag=" + v
+	}
+
+	return s.String()
+}
+
+// Auth holds the credentials to access the private registry.
+type Auth struct {
+	// Username is the username for the basic authentication of the OCI registry.
+	Username string
+	// Token is the password of the OCI registry or the API token of the module registry.
+	Token string
+}
+
+// IsEmpty returns true if no credential is provided.
+func (a *Auth) IsEmpty() bool {
+	return a == nil || a.Token == ""
+}
+
+// Client lists the versions of the template source and downloads the module packages.
+type Client interface {
+	// Versions returns the semver versions of the source in ascending order.
+	Versions(ctx context.Context) ([]*version.Version, error)
+	// Download downloads and extracts the module package of the given version into the given directory.
+	Download(ctx context.Context, v *version.Version, dir string) error
+}
+
+// NewClient returns the client of the given source.
+func NewClient(src *Source, auth *Auth, skipTLSVerify bool) Client {
+	hc := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: skipTLSVerify, // nolint:gosec
+			},
+		},
+	}
+
+	if src.Type == SourceTypeOCI {
+		return &ociClient{
+			source:     src,
+			auth:       auth,
+			httpClient: hc,
+		}
+	}
+
+	return &moduleClient{
+		source:        src,
+		auth:          auth,
+		httpClient:    hc,
+		skipTLSVerify: skipTLSVerify,
+	}
+}
+
+// parseVersions parses the given tags into the semver versions in ascending order,
+// the tags which are not semver are ignored.
+func parseVersions(tags []string) []*version.Version {
+	vs := make([]*version.Version, 0, len(tags))
+
+	for _, t := range tags {
+		v, err := version.NewVersion(t)
+		if err != nil {
+			continue
+		}
+
+		vs = append(vs, v)
+	}
+
+	sort.Slice(vs, func(i, j int) bool {
+		return vs[i].LessThan(vs[j])
+	})
+
+	return vs
+}
+
+// checkResponse returns error if the response is not successful.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	return fmt.Errorf("unexpected response of %s: %s: %s",
+		resp.Request.URL.Redacted(), resp.Status, strings.TrimSpace(string(b)))
+}
@@ -0,0 +1,250 @@
+package registry
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/utils/json"
+)
+
+func TestParseSource(t *testing.T) {
+	testCases := []struct {
+		given    string
+		expected *Source
+	}{
+		{
+			given: "oci://registry.example.com/modules/mysql",
+			expected: &Source{
+				Type:       SourceTypeOCI,
+				Host:       "registry.example.com",
+				Repository: "modules/mysql",
+			},
+		},
+		{
+			given: "oci://localhost:5000/modules/mysql//sub?tag=v1.0.0",
+			expected: &Source{
+				Type:       SourceTypeOCI,
+				Host:       "localhost:5000",
+				Repository: "modules/mysql",
+				Tag:        "v1.0.0",
+				SubPath:    "sub",
+			},
+		},
+		{
+			given: "registry.example.com/infra/mysql/aws",
+			expected: &Source{
+				Type:       SourceTypeModule,
+				Host:       "registry.example.com",
+				Repository: "infra/mysql/aws",
+			},
+		},
+		{
+			given: "registry.example.com/infra/mysql/aws//modules/replica",
+			expected: &Source{
+				Type:       SourceTypeModule,
+				Host:       "registry.example.com",
+				Repository: "infra/mysql/aws",
+				SubPath:    "modules/replica",
+			},
+		},
+		{
+			given: "hashicorp/consul/aws",
+		},
+		{
+			given: "gitlab.com/group/sub/repo",
+		},
+		{
+			given: "https://github.com/seal-io/terraform-mysql",
+		},
+		{
+			given: "git::https://registry.example.com/infra/mysql/aws",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.given, func(t *testing.T) {
+			actual, err := ParseSource(tc.given)
+			if tc.expected == nil {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+			assert.Equal(t, strings.Split(tc.given, "?")[0], actual.String())
+		})
+	}
+}
+
+func TestOCIClient(t *testing.T) {
+	pkg := tgz(t, map[string]string{"main.tf": `variable "name" {}`})
+	digest := sha256.Sum256(pkg)
+	layer := "sha256:" + hex.EncodeToString(digest[:])
+
+	mux := http.NewServeMux()
+	srv := httptest.NewTLSServer(mux)
+	defer srv.Close()
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		u, p, ok := r.BasicAuth()
+		if !ok || u != "robot" || p != "secret" ||
+			r.URL.Query().Get("scope") != "repository:modules/mysql:pull" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"token":"issued"}`))
+	})
+
+	mux.HandleFunc("/v2/modules/mysql/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer issued" {
+			w.Header().Set("WWW-Authenticate",
+				`Bearer realm="`+srv.URL+`/token",service="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch p := strings.TrimPrefix(r.URL.Path, "/v2/modules/mysql"); {
+		case p == "/tags/list" && r.URL.Query().Get("last") == "":
+			w.Header().Set("Link", `</v2/modules/mysql/tags/list?n=2&last=latest>; rel="next"`)
+			_, _ = w.Write([]byte(`{"tags":["v1.1.0","latest"]}`))
+		case p == "/tags/list":
+			_, _ = w.Write([]byte(`{"tags":["v1.0.0"]}`))
+		case p == "/manifests/v1.1.0":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"layers": []map[string]any{
+					{"mediaType": "application/vnd.oci.image.config.v1+json", "digest": "sha256:00"},
+					{"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip", "digest": layer},
+				},
+			})
+		case p == "/blobs/"+layer:
+			_, _ = w.Write(pkg)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	src := &Source{
+		Type:       SourceTypeOCI,
+		Host:       strings.TrimPrefix(srv.URL, "https://"),
+		Repository: "modules/mysql",
+	}
+	c := NewClient(src, &Auth{Username: "robot", Token: "secret"}, true)
+
+	vs, err := c.Versions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, vs, 2)
+	assert.Equal(t, "v1.0.0", vs[0].Original())
+	assert.Equal(t, "v1.1.0", vs[1].Original())
+
+	dir := t.TempDir()
+	require.NoError(t, c.Download(context.Background(), vs[1], dir))
+
+	b, err := os.ReadFile(filepath.Join(dir, "main.tf"))
+	require.NoError(t, err)
+	assert.Equal(t, `variable "name" {}`, string(b))
+
+	// Without credential.
+	c = NewClient(src, nil, true)
+	_, err = c.Versions(context.Background())
+	assert.Error(t, err)
+}
+
+func TestModuleClient(t *testing.T) {
+	pkg := tgz(t, map[string]string{"main.tf": `variable "name" {}`})
+
+	mux := http.NewServeMux()
+	srv := httptest.NewTLSServer(mux)
+	defer srv.Close()
+
+	auth := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			next(w, r)
+		}
+	}
+
+	mux.HandleFunc("/.well-known/terraform.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"modules.v1":"/api/registry/v1/modules"}`))
+	})
+
+	mux.HandleFunc("/api/registry/v1/modules/infra/mysql/aws/versions", auth(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"modules":[{"versions":[{"version":"1.1.0"},{"version":"1.0.0"},{"version":"x"}]}]}`))
+	}))
+
+	mux.HandleFunc("/api/registry/v1/modules/infra/mysql/aws/1.1.0/download", auth(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Terraform-Get", "/archives/mysql-1.1.0.tar.gz")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	mux.HandleFunc("/archives/mysql-1.1.0.tar.gz", auth(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(pkg)
+	}))
+
+	src := &Source{
+		Type:       SourceTypeModule,
+		Host:       strings.TrimPrefix(srv.URL, "https://"),
+		Repository: "infra/mysql/aws",
+	}
+	c := NewClient(src, &Auth{Token: "secret"}, true)
+
+	vs, err := c.Versions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, vs, 2)
+	assert.Equal(t, "1.1.0", vs[1].Original())
+
+	dir := filepath.Join(t.TempDir(), "module")
+	require.NoError(t, c.Download(context.Background(), vs[1], dir))
+
+	b, err := os.ReadFile(filepath.Join(dir, "main.tf"))
+	require.NoError(t, err)
+	assert.Equal(t, `variable "name" {}`, string(b))
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(
+		`Bearer realm="https://auth.example.com/token",service="registry",scope="repository:a:pull,push"`)
+
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry",
+		"scope":   "repository:a:pull,push",
+	}, params)
+}
+
+func tgz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	for n, c := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: n, Mode: 0o644, Size: int64(len(c))}))
+		_, err := tw.Write([]byte(c))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return buf.Bytes()
+}
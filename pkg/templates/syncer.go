@@ -7,6 +7,7 @@ import (
 	"github.com/seal-io/walrus/pkg/bus/template"
 	"github.com/seal-io/walrus/pkg/dao/model"
 	"github.com/seal-io/walrus/pkg/dao/types/status"
+	"github.com/seal-io/walrus/pkg/templates/registry"
 	"github.com/seal-io/walrus/pkg/vcs"
 	"github.com/seal-io/walrus/utils/gopool"
 	"github.com/seal-io/walrus/utils/log"
@@ -55,6 +56,15 @@ func (s schemaSyncer) Do(_ context.Context, message template.BusMessage) error {
 }
 
 func syncSchema(ctx context.Context, mc model.ClientSet, t *model.Template) error {
+	if src, err := registry.ParseSource(t.Source); err == nil {
+		auth, err := getTemplateRegistryAuth(ctx, mc, t)
+		if err != nil {
+			return err
+		}
+
+		return SyncTemplateFromRegistry(ctx, mc, t, src, auth)
+	}
+
 	repo, err := vcs.ParseURLToRepo(t.Source)
 	if err != nil {
 		return err
@@ -68,6 +78,7 @@ func syncSchema(ctx context.Context, mc model.ClientSet, t *model.Template) erro
 
 		// Use the catalog type as the vcs repository type.
 		repo.Driver = c.Type
+		repo.Auth = GetCatalogGitAuth(c)
 	}
 
 	if repo.Reference != "" {
@@ -76,3 +87,18 @@ func syncSchema(ctx context.Context, mc model.ClientSet, t *model.Template) erro
 
 	return SyncTemplateFromGitRepo(ctx, mc, t, repo)
 }
+
+// getTemplateRegistryAuth returns the credentials of the given template,
+// falls back to the stored one if the given template carries no credential.
+func getTemplateRegistryAuth(ctx context.Context, mc model.ClientSet, t *model.Template) (*registry.Auth, error) {
+	if len(t.Credential) != 0 || !t.ID.Valid() {
+		return GetTemplateRegistryAuth(t), nil
+	}
+
+	stored, err := mc.Templates().Get(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetTemplateRegistryAuth(stored), nil
+}
@@ -0,0 +1,295 @@
+package tester
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/dao/types/property"
+	"github.com/seal-io/walrus/utils/json"
+	"github.com/seal-io/walrus/utils/strs"
+)
+
+const (
+	// FixturesDir is the directory of the template to place the example attribute sets,
+	// each JSON or YAML file under the directory is an attribute set of the template variables.
+	FixturesDir = "fixtures"
+
+	// _defaultTimeout is the timeout of running terraform for one fixture.
+	_defaultTimeout = 5 * time.Minute
+	// _maxMessageLength is the maximum length of the recorded failure message.
+	_maxMessageLength = 4096
+)
+
+// Fixture holds an example attribute set of the template.
+type Fixture struct {
+	// Name is the file name of the fixture without extension.
+	Name string
+	// Attributes is the attribute set of the template variables.
+	Attributes property.Values
+}
+
+// Options holds the options to test the template.
+type Options struct {
+	// Mode is one of the types.TemplateTestModes.
+	Mode string
+	// Env is the extra environment variables of the terraform process, e.g. proxies.
+	Env []string
+	// Timeout is the timeout of running terraform for one fixture.
+	Timeout time.Duration
+}
+
+// LoadFixtures loads the fixtures from the FixturesDir of the given template directory,
+// returns nothing if the directory does not exist.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	entries, err := os.ReadDir(filepath.Join(dir, FixturesDir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	fixtures := make([]Fixture, 0, len(entries))
+
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+
+		ext := filepath.Ext(e.Name())
+		switch ext {
+		case ".json", ".yaml", ".yml":
+		default:
+			continue
+		}
+
+		bs, err := os.ReadFile(filepath.Join(dir, FixturesDir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		if ext != ".json" {
+			bs, err = yaml.YAMLToJSON(bs)
+			if err != nil {
+				return nil, fmt.Errorf("invalid fixture %s: %w", e.Name(), err)
+			}
+		}
+
+		var attrs property.Values
+		if err = json.Unmarshal(bs, &attrs); err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", e.Name(), err)
+		}
+
+		fixtures = append(fixtures, Fixture{
+			Name:       strings.TrimSuffix(e.Name(), ext),
+			Attributes: attrs,
+		})
+	}
+
+	sort.Slice(fixtures, func(i, j int) bool {
+		return fixtures[i].Name < fixtures[j].Name
+	})
+
+	return fixtures, nil
+}
+
+// Test tests the template located in the given directory,
+// validates each fixture against the variable schema,
+// and validates or plans the template with the fixture in a sandbox.
+// If there is no fixture, the template itself is validated in the sandbox.
+// The sandbox checks are skipped if the terraform binary is not found,
+// returns nothing if the testing is disabled or there is nothing to test.
+func Test(
+	ctx context.Context,
+	dir string,
+	schema *types.TemplateVersionSchema,
+	opts Options,
+) []types.TemplateVersionTestResult {
+	if opts.Mode == "" || opts.Mode == types.TemplateTestModeDisabled {
+		return nil
+	}
+
+	fixtures, err := LoadFixtures(dir)
+	if err != nil {
+		return []types.TemplateVersionTestResult{
+			{
+				Name:    FixturesDir,
+				Message: err.Error(),
+			},
+		}
+	}
+
+	bin, _ := exec.LookPath("terraform")
+
+	if len(fixtures) == 0 {
+		if bin == "" {
+			return nil
+		}
+
+		r := types.TemplateVersionTestResult{Name: "default", Passed: true}
+
+		// Plan requires the values of the variables, validate the template only.
+		if err = run(ctx, bin, dir, nil, types.TemplateTestModeValidate, opts); err != nil {
+			r.Passed, r.Message = false, err.Error()
+		}
+
+		return []types.TemplateVersionTestResult{r}
+	}
+
+	results := make([]types.TemplateVersionTestResult, 0, len(fixtures))
+
+	for _, f := range fixtures {
+		results = append(results, testFixture(ctx, bin, dir, schema, f, opts))
+	}
+
+	return results
+}
+
+// testFixture validates the fixture against the variable schema,
+// and checks the template with the fixture in a sandbox if the terraform binary is given.
+func testFixture(
+	ctx context.Context,
+	bin, dir string,
+	schema *types.TemplateVersionSchema,
+	f Fixture,
+	opts Options,
+) types.TemplateVersionTestResult {
+	r := types.TemplateVersionTestResult{Name: f.Name, Passed: true}
+
+	if schema != nil {
+		if err := f.Attributes.ValidateWith(schema.VariableSchema()); err != nil {
+			r.Passed, r.Message = false, fmt.Sprintf("violate schema: %v", err)
+			return r
+		}
+	}
+
+	if bin == "" {
+		r.Message = "terraform is not found, skip checking in sandbox"
+		return r
+	}
+
+	if err := run(ctx, bin, dir, f.Attributes, opts.Mode, opts); err != nil {
+		r.Passed, r.Message = false, err.Error()
+	}
+
+	return r
+}
+
+// run runs terraform in a sandbox with the module located in the given directory,
+// the module is called by a root module with the given attributes if not nil.
+func run(ctx context.Context, bin, dir string, attrs property.Values, mode string, opts Options) error {
+	sandbox := filepath.Join(os.TempDir(), "seal-template-test-"+strs.String(10))
+	defer os.RemoveAll(sandbox)
+
+	if err := os.MkdirAll(sandbox, 0o700); err != nil {
+		return err
+	}
+
+	workdir := dir
+
+	if attrs != nil {
+		workdir = filepath.Join(sandbox, "root")
+		if err := writeRootModule(workdir, dir, attrs); err != nil {
+			return err
+		}
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = _defaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Keep the initialization and plugins out of the template directory.
+	env := append([]string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + sandbox,
+		"TF_IN_AUTOMATION=true",
+		"TF_INPUT=false",
+		"TF_DATA_DIR=" + filepath.Join(sandbox, ".terraform"),
+	}, opts.Env...)
+
+	commands := [][]string{
+		{"init", "-backend=false", "-input=false", "-no-color"},
+		{"validate", "-no-color"},
+	}
+	if mode == types.TemplateTestModePlan {
+		commands = append(commands, []string{"plan", "-input=false", "-refresh=false", "-lock=false", "-no-color"})
+	}
+
+	for _, args := range commands {
+		cmd := exec.CommandContext(ctx, bin, args...)
+		cmd.Dir = workdir
+		cmd.Env = env
+		cmd.WaitDelay = 10 * time.Second
+
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("terraform %s timeout after %v", args[0], timeout)
+			}
+
+			return fmt.Errorf("terraform %s failed: %s", args[0], truncate(string(out)))
+		}
+	}
+
+	return nil
+}
+
+// writeRootModule writes the root module calling the module located in the given directory with the attributes.
+func writeRootModule(workdir, dir string, attrs property.Values) error {
+	if err := os.MkdirAll(workdir, 0o700); err != nil {
+		return err
+	}
+
+	source, err := filepath.Rel(workdir, dir)
+	if err != nil {
+		return err
+	}
+
+	source = filepath.ToSlash(source)
+	if !strings.HasPrefix(source, "../") {
+		source = "./" + source
+	}
+
+	module := map[string]any{
+		"source": source,
+	}
+	for k, v := range attrs {
+		module[k] = v
+	}
+
+	bs, err := json.Marshal(map[string]any{
+		"module": map[string]any{
+			"fixture": module,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(workdir, "main.tf.json"), bs, 0o600)
+}
+
+// truncate keeps the tail of the given message, which usually contains the errors.
+func truncate(msg string) string {
+	msg = strings.TrimSpace(msg)
+	if len(msg) <= _maxMessageLength {
+		return msg
+	}
+
+	return "..." + msg[len(msg)-_maxMessageLength:]
+}
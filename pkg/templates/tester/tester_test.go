@@ -0,0 +1,82 @@
+package tester
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/pkg/templates/loader"
+	"github.com/seal-io/walrus/utils/json"
+)
+
+func TestLoadFixtures(t *testing.T) {
+	fixtures, err := LoadFixtures("testdata/mysql")
+	require.NoError(t, err)
+
+	names := make([]string, len(fixtures))
+	for i := range fixtures {
+		names[i] = fixtures[i].Name
+	}
+
+	assert.Equal(t, []string{"replication", "standalone", "unnamed"}, names)
+	assert.JSONEq(t, `"replication"`, string(fixtures[0].Attributes["name"]))
+	assert.JSONEq(t, `3`, string(fixtures[0].Attributes["replicas"]))
+	assert.JSONEq(t, `"standalone"`, string(fixtures[1].Attributes["name"]))
+
+	fixtures, err = LoadFixtures("testdata")
+	require.NoError(t, err)
+	assert.Empty(t, fixtures)
+}
+
+func TestTest(t *testing.T) {
+	// Skip the sandbox checks.
+	t.Setenv("PATH", t.TempDir())
+
+	sg, err := loader.LoadSchema("testdata/mysql", "mysql")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	results := Test(ctx, "testdata/mysql", sg.Schema, Options{Mode: types.TemplateTestModeDisabled})
+	assert.Nil(t, results)
+
+	results = Test(ctx, "testdata/mysql", sg.Schema, Options{Mode: types.TemplateTestModeValidate})
+	require.Len(t, results, 3)
+
+	for _, r := range results {
+		switch r.Name {
+		case "unnamed":
+			assert.False(t, r.Passed)
+			assert.Contains(t, r.Message, "violate schema")
+		default:
+			assert.True(t, r.Passed, r.Name)
+			assert.Contains(t, r.Message, "skip checking in sandbox")
+		}
+	}
+
+	// Nothing to test without fixtures and terraform.
+	results = Test(ctx, "testdata", nil, Options{Mode: types.TemplateTestModePlan})
+	assert.Nil(t, results)
+}
+
+func TestWriteRootModule(t *testing.T) {
+	dir := t.TempDir()
+	workdir := filepath.Join(dir, "sandbox", "root")
+	moduleDir := filepath.Join(dir, "mysql")
+
+	err := writeRootModule(workdir, moduleDir, map[string]json.RawMessage{
+		"name": json.RawMessage(`"standalone"`),
+	})
+	require.NoError(t, err)
+
+	bs, err := os.ReadFile(filepath.Join(workdir, "main.tf.json"))
+	require.NoError(t, err)
+
+	expected := `{"module":{"fixture":{"source":"../../mysql","name":"standalone"}}}`
+	assert.JSONEq(t, expected, string(bs))
+}
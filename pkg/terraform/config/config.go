@@ -55,6 +55,9 @@ const (
 
 	// _updateMethod is the method to update state in the backend.
 	_updateMethod = "PUT"
+
+	// _lockMethod is the method to lock/unlock state in the backend.
+	_lockMethod = "POST"
 )
 
 // NewConfig returns a new Config.
@@ -280,6 +283,13 @@ func loadTerraformBlock(opts *TerraformOptions) *block.Block {
 		},
 	}
 
+	if opts.LockAddress != "" {
+		backendBlock.Attributes["lock_address"] = opts.LockAddress
+		backendBlock.Attributes["lock_method"] = _lockMethod
+		backendBlock.Attributes["unlock_address"] = opts.UnlockAddress
+		backendBlock.Attributes["unlock_method"] = _lockMethod
+	}
+
 	terraformBlock.AppendBlock(backendBlock)
 
 	return terraformBlock
@@ -419,6 +429,10 @@ func ToModuleBlock(mc *ModuleConfig) (*block.Block, error) {
 	}
 
 	mc.Attributes["source"] = mc.Source
+	if mc.Version != "" {
+		mc.Attributes["version"] = mc.Version
+	}
+
 	b = block.Block{
 		Type:       block.TypeModule,
 		Labels:     []string{mc.Name},
@@ -58,6 +58,58 @@ output "test-resource_test-output-sensitive" {
   value     = module.test-resource.test-output-sensitive
 }
 
+`),
+		},
+		{
+			name: "test create config to bytes with backend",
+			option: CreateOptions{
+				TerraformOptions: &TerraformOptions{
+					Token:         "token",
+					Address:       "http://localhost/terraform-states",
+					SkipTLSVerify: true,
+				},
+			},
+			expected: []byte(`terraform {
+  backend "http" {
+    address                = "http://localhost/terraform-states"
+    password               = "token"
+    retry_max              = 10
+    retry_wait_max         = 5
+    skip_cert_verification = true
+    update_method          = "PUT"
+    username               = "seal"
+  }
+}
+
+`),
+		},
+		{
+			name: "test create config to bytes with locking backend",
+			option: CreateOptions{
+				TerraformOptions: &TerraformOptions{
+					Token:         "token",
+					Address:       "http://localhost/terraform-states",
+					LockAddress:   "http://localhost/terraform-states/lock",
+					UnlockAddress: "http://localhost/terraform-states/unlock",
+					SkipTLSVerify: true,
+				},
+			},
+			expected: []byte(`terraform {
+  backend "http" {
+    address                = "http://localhost/terraform-states"
+    lock_address           = "http://localhost/terraform-states/lock"
+    lock_method            = "POST"
+    password               = "token"
+    retry_max              = 10
+    retry_wait_max         = 5
+    skip_cert_verification = true
+    unlock_address         = "http://localhost/terraform-states/unlock"
+    unlock_method          = "POST"
+    update_method          = "PUT"
+    username               = "seal"
+  }
+}
+
 `),
 		},
 	}
@@ -18,6 +18,9 @@ type ModuleConfig struct {
 	Name string
 	// Source is the module source.
 	Source string
+	// Version is the version constraint of the module,
+	// only for the module registry source.
+	Version string
 	// SchemaData is the data module schema.
 	SchemaData types.TemplateVersionSchemaData
 	// Attributes is the attributes of the module.
@@ -43,6 +46,10 @@ type (
 		Token string
 		// Address is the backend address of the terraform config.
 		Address string
+		// LockAddress is the backend address to lock the state, locking is disabled if blank.
+		LockAddress string
+		// UnlockAddress is the backend address to unlock the state.
+		UnlockAddress string
 		// SkipTLSVerify is the backend cert verification of the terraform config.
 		SkipTLSVerify bool
 
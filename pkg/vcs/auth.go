@@ -0,0 +1,148 @@
+package vcs
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// GitAuth holds the credentials to access the Git repositories.
+type GitAuth struct {
+	// Username is the username for HTTPS authentication, defaults to "oauth2".
+	Username string
+	// Token is the token or password for HTTPS authentication.
+	Token string
+	// SSHKey is the PEM encoded private key for SSH authentication.
+	SSHKey string
+}
+
+// IsEmpty returns true if no credential is provided.
+func (a *GitAuth) IsEmpty() bool {
+	return a == nil || (a.Token == "" && a.SSHKey == "")
+}
+
+func (a *GitAuth) userInfo() *url.Userinfo {
+	username := a.Username
+	if username == "" {
+		username = "oauth2"
+	}
+
+	return url.UserPassword(username, a.Token)
+}
+
+// Source returns the getter source of the given link with the credentials,
+// the token is put in the user info of the HTTPS URL,
+// and the SSH key is put in the sshkey query parameter of the SSH URL.
+func (a *GitAuth) Source(link string) (string, error) {
+	if a.IsEmpty() {
+		return GetGitSource(link)
+	}
+
+	endpoint, err := transport.NewEndpoint(link)
+	if err != nil {
+		return "", err
+	}
+
+	switch endpoint.Protocol {
+	case "http", "https":
+		if a.Token == "" {
+			break
+		}
+
+		u := endpointURL(endpoint)
+		u.User = a.userInfo()
+
+		return "git::" + u.String(), nil
+	case "ssh":
+		if a.SSHKey == "" {
+			break
+		}
+
+		key := base64.StdEncoding.EncodeToString([]byte(a.SSHKey))
+
+		return "git::" + endpointURL(endpoint).String() + "?sshkey=" + url.QueryEscape(key), nil
+	}
+
+	return GetGitSource(link)
+}
+
+// GitConfig returns the Git configuration to access the repositories of the given links,
+// which is used by the processes cloning the repositories without the credentials in the links,
+// i.e. terraform init, the SSH key is expected to be stored at the given path.
+func (a *GitAuth) GitConfig(links []string, sshKeyPath string) string {
+	if a.IsEmpty() {
+		return ""
+	}
+
+	var (
+		sb      strings.Builder
+		baseURL = sets.New[string]()
+	)
+
+	for _, link := range links {
+		endpoint, err := transport.NewEndpoint(link)
+		if err != nil {
+			continue
+		}
+
+		if endpoint.Protocol == "http" || endpoint.Protocol == "https" {
+			u := endpointURL(endpoint)
+			u.Path = "/"
+			baseURL.Insert(u.String())
+		}
+	}
+
+	if a.Token != "" {
+		for _, b := range sets.List(baseURL) {
+			u, _ := url.Parse(b)
+			u.User = a.userInfo()
+
+			fmt.Fprintf(&sb, "[url %q]\n\tinsteadOf = %s\n", u.String(), b)
+		}
+	}
+
+	if a.SSHKey != "" && sshKeyPath != "" {
+		// SSH refuses the private key readable by others,
+		// so copy it to the home directory with the restricted permission.
+		key := "$HOME/.walrus_git_ssh_key"
+		cmd := fmt.Sprintf(
+			`sh -c 'install -m 600 %s "%s" && exec ssh -i "%s" -o StrictHostKeyChecking=accept-new "$@"' ssh`,
+			sshKeyPath, key, key)
+
+		fmt.Fprintf(&sb, "[core]\n\tsshCommand = %q\n", cmd)
+	}
+
+	return sb.String()
+}
+
+// defaultPorts holds the default ports of the protocols,
+// which are omitted from the URL.
+var defaultPorts = map[string]int{
+	"ssh":   22,
+	"http":  80,
+	"https": 443,
+}
+
+// endpointURL returns the URL of the given endpoint without the credentials.
+func endpointURL(endpoint *transport.Endpoint) *url.URL {
+	host := endpoint.Host
+	if endpoint.Port != 0 && endpoint.Port != defaultPorts[endpoint.Protocol] {
+		host = fmt.Sprintf("%s:%d", host, endpoint.Port)
+	}
+
+	u := &url.URL{
+		Scheme: endpoint.Protocol,
+		Host:   host,
+		Path:   "/" + strings.TrimPrefix(endpoint.Path, "/"),
+	}
+
+	if endpoint.Protocol == "ssh" && endpoint.User != "" {
+		u.User = url.User(endpoint.User)
+	}
+
+	return u
+}
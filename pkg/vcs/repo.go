@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path"
 	"sort"
 	"strings"
 	"time"
@@ -35,6 +36,8 @@ type Repository struct {
 	Driver string `json:"driver"`
 	// SubPath is the sub path of the repository.
 	SubPath string `json:"subPath"`
+	// Auth is the credentials to access the repository.
+	Auth *GitAuth `json:"-"`
 }
 
 // FileRawURL returns raw URL of a file in a git repository.
@@ -185,6 +188,12 @@ func HardResetGitRepo(r *git.Repository, ref string) error {
 
 // GetGitRepoVersions returns all versions of a git repository in descending order.
 func GetGitRepoVersions(r *git.Repository) ([]*version.Version, error) {
+	return GetGitRepoPrefixedVersions(r, "")
+}
+
+// GetGitRepoPrefixedVersions returns the versions of the tags with the given prefix in ascending order,
+// the prefix is trimmed from the versions, e.g. "mysql/v1.2.0" is taken as "v1.2.0" with prefix "mysql/".
+func GetGitRepoPrefixedVersions(r *git.Repository, prefix string) ([]*version.Version, error) {
 	logger := log.WithName("vcs")
 
 	tagRefs, err := r.Tags()
@@ -195,9 +204,14 @@ func GetGitRepoVersions(r *git.Repository) ([]*version.Version, error) {
 	var versions []*version.Version
 
 	err = tagRefs.ForEach(func(ref *plumbing.Reference) error {
-		v, verr := version.NewVersion(ref.Name().Short())
+		name := ref.Name().Short()
+		if !strings.HasPrefix(name, prefix) {
+			return nil
+		}
+
+		v, verr := version.NewVersion(strings.TrimPrefix(name, prefix))
 		if verr != nil {
-			logger.Warnf("failed to parse tag %s: %v", ref.Name().Short(), verr)
+			logger.Warnf("failed to parse tag %s: %v", name, verr)
 		}
 
 		if v != nil {
@@ -214,11 +228,62 @@ func GetGitRepoVersions(r *git.Repository) ([]*version.Version, error) {
 	return versions, err
 }
 
+// GetGitRepoTagPrefix returns the prefix of the tags versioning the given subdirectory of a monorepo,
+// which is either "<subPath>/" or "<base name of the subPath>/",
+// returns empty if the subdirectory is not versioned by the prefixed tags.
+func GetGitRepoTagPrefix(r *git.Repository, subPath string) (string, error) {
+	subPath = strings.Trim(subPath, "/")
+	if subPath == "" {
+		return "", nil
+	}
+
+	tagRefs, err := r.Tags()
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		prefixes = []string{subPath + "/", path.Base(subPath) + "/"}
+		found    = make([]bool, len(prefixes))
+	)
+
+	err = tagRefs.ForEach(func(ref *plumbing.Reference) error {
+		for i := range prefixes {
+			if strings.HasPrefix(ref.Name().Short(), prefixes[i]) {
+				found[i] = true
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for i := range prefixes {
+		if found[i] {
+			return prefixes[i], nil
+		}
+	}
+
+	return "", nil
+}
+
 // CloneGitRepo clones a git repository to a specific directory.
 func CloneGitRepo(ctx context.Context, link, dir string, skipTLSVerify bool) (*git.Repository, error) {
+	return CloneGitRepoWithAuth(ctx, link, dir, skipTLSVerify, nil)
+}
+
+// CloneGitRepoWithAuth clones a git repository with the given credentials.
+func CloneGitRepoWithAuth(
+	ctx context.Context,
+	link, dir string,
+	skipTLSVerify bool,
+	auth *GitAuth,
+) (*git.Repository, error) {
 	logger := log.WithName("template")
 
-	src, err := GetGitSource(link)
+	src, err := auth.Source(link)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,59 @@
+package vcs
+
+import (
+	"testing"
+	"time"
+
+	git "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGitRepoPrefixedVersions(t *testing.T) {
+	r, err := git.PlainInit(t.TempDir(), false)
+	require.NoError(t, err)
+
+	w, err := r.Worktree()
+	require.NoError(t, err)
+
+	h, err := w.Commit("init", &git.CommitOptions{
+		AllowEmptyCommits: true,
+		Author:            &object.Signature{Name: "walrus", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	for _, tag := range []string{"v0.1.0", "mysql/v1.1.0", "mysql/v1.0.0", "modules/redis/v2.0.0", "redis/v1.0.0"} {
+		_, err = r.CreateTag(tag, h, nil)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		subPath  string
+		prefix   string
+		versions []string
+	}{
+		{subPath: "", prefix: "", versions: []string{"v0.1.0"}},
+		{subPath: "modules/mysql", prefix: "mysql/", versions: []string{"v1.0.0", "v1.1.0"}},
+		{subPath: "modules/redis", prefix: "modules/redis/", versions: []string{"v2.0.0"}},
+		{subPath: "modules/kafka", prefix: "", versions: []string{"v0.1.0"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.subPath, func(t *testing.T) {
+			prefix, err := GetGitRepoTagPrefix(r, tc.subPath)
+			require.NoError(t, err)
+			assert.Equal(t, tc.prefix, prefix)
+
+			vs, err := GetGitRepoPrefixedVersions(r, prefix)
+			require.NoError(t, err)
+
+			actual := make([]string, 0, len(vs))
+			for _, v := range vs {
+				actual = append(actual, v.Original())
+			}
+
+			assert.Equal(t, tc.versions, actual)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types"
+	"github.com/seal-io/walrus/utils/log"
+	"github.com/seal-io/walrus/utils/strs"
+)
+
+const (
+	// deliverAttemptMax is the maximum attempts of a delivery.
+	deliverAttemptMax = 5
+	// deliverBackoffMin is the first interval between the attempts,
+	// which is doubled after each failed attempt.
+	deliverBackoffMin = 2 * time.Second
+	// deliverBackoffMax is the maximum interval between the attempts.
+	deliverBackoffMax = time.Minute
+	// deliverTimeout is the timeout of each attempt.
+	deliverTimeout = 10 * time.Second
+	// responseBodyMaxSize is the maximum size of the recorded response body.
+	responseBodyMaxSize = 1024
+)
+
+var httpClient = &http.Client{
+	Timeout: deliverTimeout,
+}
+
+// Deliver delivers the given delivery to the given webhook,
+// retries with exponential backoff until succeeded or reaching the given maximum attempts,
+// and records the result of each attempt.
+func Deliver(
+	ctx context.Context,
+	mc model.ClientSet,
+	wh *model.Webhook,
+	d *model.WebhookDelivery,
+	attemptMax int,
+) error {
+	logger := log.WithName("webhook")
+	backoff := deliverBackoffMin
+
+	for {
+		err := attempt(ctx, wh, d)
+
+		switch {
+		case err == nil:
+			d.Status = types.WebhookDeliveryStatusSucceeded
+			d.Message = ""
+		case d.Attempts >= attemptMax:
+			d.Status = types.WebhookDeliveryStatusFailed
+			d.Message = err.Error()
+		default:
+			d.Message = err.Error()
+		}
+
+		uerr := mc.WebhookDeliveries().UpdateOne(d).
+			SetStatus(d.Status).
+			SetAttempts(d.Attempts).
+			SetResponseStatus(d.ResponseStatus).
+			SetResponseBody(d.ResponseBody).
+			SetMessage(d.Message).
+			Exec(ctx)
+		if uerr != nil {
+			logger.Errorf("error recording delivery %s: %v", d.ID, uerr)
+		}
+
+		if d.Status != types.WebhookDeliveryStatusPending {
+			if err != nil {
+				logger.Warnf("failed to deliver %s to webhook %s after %d attempts: %v",
+					d.ID, wh.Name, d.Attempts, err)
+			}
+
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > deliverBackoffMax {
+			backoff = deliverBackoffMax
+		}
+	}
+}
+
+// attempt sends the payload of the given delivery to the given webhook once,
+// returns error if the receiver doesn't respond with 2xx.
+func attempt(ctx context.Context, wh *model.Webhook, d *model.WebhookDelivery) error {
+	d.Attempts++
+	d.ResponseStatus = 0
+	d.ResponseBody = ""
+
+	payload := strs.ToBytes(&d.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "walrus-webhook")
+	req.Header.Set(HeaderEvent, d.Topic+"."+d.EventType)
+	req.Header.Set(HeaderDelivery, d.ID.String())
+
+	if wh.Secret != "" {
+		req.Header.Set(HeaderSignature, Sign(string(wh.Secret), payload))
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, responseBodyMaxSize))
+	d.ResponseStatus = resp.StatusCode
+	d.ResponseBody = string(body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+
+	return nil
+}
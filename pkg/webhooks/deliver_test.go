@@ -0,0 +1,125 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sony/sonyflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/enttest"
+	"github.com/seal-io/walrus/pkg/dao/types"
+
+	_ "github.com/mattn/go-sqlite3"
+	_ "github.com/seal-io/walrus/pkg/dao/model/runtime"
+)
+
+const (
+	testDriverName     = "sqlite3"
+	testDataSourceName = "file:webhooks?mode=memory&cache=shared&_fk=1"
+)
+
+func TestDeliver(t *testing.T) {
+	if sonyflake.NewSonyflake(sonyflake.Settings{}) == nil {
+		t.Skip("skip as no private IP address to generate object ID")
+	}
+
+	ctx := context.Background()
+
+	client := enttest.Open(t, testDriverName, testDataSourceName)
+	defer client.Close()
+
+	var (
+		status    = http.StatusOK
+		signature string
+		received  []byte
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(HeaderSignature)
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	proj, err := client.Projects().Create().
+		SetName("webhooks").
+		Save(ctx)
+	require.NoError(t, err)
+
+	wh, err := client.Webhooks().Create().
+		SetProjectID(proj.ID).
+		SetName("test").
+		SetURL(srv.URL).
+		SetSecret("secret").
+		SetTopics([]string{"resources"}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	event := Event{
+		ID:        "1",
+		Topic:     "resources",
+		Type:      "create",
+		Timestamp: time.Now(),
+		ProjectID: proj.ID,
+		Data:      []EventData{{ID: "1", Name: "a", ProjectID: proj.ID}},
+	}
+
+	// Deliver with signature.
+	d, err := createDelivery(ctx, client, wh, event)
+	require.NoError(t, err)
+	require.NotNil(t, d)
+
+	require.NoError(t, Deliver(ctx, client, wh, d, 1))
+	assert.Equal(t, string(received), d.Payload)
+	assert.Equal(t, Sign("secret", received), signature)
+
+	d, err = client.WebhookDeliveries().Get(ctx, d.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.WebhookDeliveryStatusSucceeded, d.Status)
+	assert.Equal(t, 1, d.Attempts)
+
+	// Ignore the recorded event.
+	dup, err := createDelivery(ctx, client, wh, event)
+	require.NoError(t, err)
+	assert.Nil(t, dup)
+
+	// Fail after reaching the maximum attempts.
+	status = http.StatusInternalServerError
+	event.ID = "2"
+
+	d, err = createDelivery(ctx, client, wh, event)
+	require.NoError(t, err)
+	require.NotNil(t, d)
+
+	assert.Error(t, Deliver(ctx, client, wh, d, 1))
+
+	d, err = client.WebhookDeliveries().Get(ctx, d.ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.WebhookDeliveryStatusFailed, d.Status)
+	assert.Equal(t, http.StatusInternalServerError, d.ResponseStatus)
+	assert.NotEmpty(t, d.Message)
+}
+
+func TestIsSubscribed(t *testing.T) {
+	wh := &model.Webhook{
+		Topics:     []string{"resources"},
+		EventTypes: []string{"delete"},
+	}
+
+	assert.True(t, IsSubscribed(wh, "resources", "delete"))
+	assert.False(t, IsSubscribed(wh, "resources", "create"))
+	assert.False(t, IsSubscribed(wh, "environments", "delete"))
+
+	wh.EventTypes = nil
+	assert.True(t, IsSubscribed(wh, "resources", "create"))
+
+	wh.Disabled = true
+	assert.False(t, IsSubscribed(wh, "resources", "create"))
+}
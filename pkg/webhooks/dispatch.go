@@ -0,0 +1,134 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/model/webhook"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+	"github.com/seal-io/walrus/utils/gopool"
+	"github.com/seal-io/walrus/utils/json"
+	"github.com/seal-io/walrus/utils/log"
+	"github.com/seal-io/walrus/utils/strs"
+)
+
+// Dispatch dispatches the given event to the subscribed webhooks in background.
+func Dispatch(ctx context.Context, mc model.ClientSet, event Event) {
+	gopool.Go(func() {
+		if err := dispatch(ctx, mc, event); err != nil {
+			log.WithName("webhook").
+				Errorf("error dispatching event %s: %v", event.Name(), err)
+		}
+	})
+}
+
+func dispatch(ctx context.Context, mc model.ClientSet, event Event) error {
+	// Group data by project.
+	pds := make(map[object.ID][]EventData)
+
+	for i := range event.Data {
+		if event.Data[i].ProjectID == "" {
+			continue
+		}
+
+		pds[event.Data[i].ProjectID] = append(pds[event.Data[i].ProjectID], event.Data[i])
+	}
+
+	if len(pds) == 0 {
+		return nil
+	}
+
+	whs, err := mc.Webhooks().Query().
+		Where(
+			webhook.ProjectIDIn(maps.Keys(pds)...),
+			webhook.Disabled(false)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range whs {
+		if !IsSubscribed(whs[i], event.Topic, event.Type) {
+			continue
+		}
+
+		ev := event
+		ev.ProjectID = whs[i].ProjectID
+		ev.Data = pds[whs[i].ProjectID]
+
+		d, err := createDelivery(ctx, mc, whs[i], ev)
+		if err != nil {
+			return err
+		}
+
+		// Delivered by another replica.
+		if d == nil {
+			continue
+		}
+
+		wh := whs[i]
+
+		gopool.Go(func() {
+			_ = Deliver(ctx, mc, wh, d, deliverAttemptMax)
+		})
+	}
+
+	return nil
+}
+
+// createDelivery creates the delivery of the given event for the given webhook,
+// returns nil if the event has been recorded.
+func createDelivery(
+	ctx context.Context,
+	mc model.ClientSet,
+	wh *model.Webhook,
+	event Event,
+) (*model.WebhookDelivery, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := mc.WebhookDeliveries().Create().
+		SetWebhookID(wh.ID).
+		SetEventID(event.ID).
+		SetTopic(event.Topic).
+		SetEventType(event.Type).
+		SetPayload(string(payload)).
+		Save(ctx)
+	if err != nil {
+		if model.IsConstraintError(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Test delivers a test event to the given webhook once,
+// and returns the recorded delivery.
+func Test(ctx context.Context, mc model.ClientSet, wh *model.Webhook) (*model.WebhookDelivery, error) {
+	event := Event{
+		ID:        strs.Hex(16),
+		Topic:     TopicTest,
+		Type:      EventTypeTest,
+		Timestamp: time.Now(),
+		ProjectID: wh.ProjectID,
+		Data:      []EventData{},
+	}
+
+	d, err := createDelivery(ctx, mc, wh, event)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ignore the delivering error, which is recorded in the delivery.
+	_ = Deliver(ctx, mc, wh, d, 1)
+
+	return d, nil
+}
@@ -0,0 +1,82 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/seal-io/walrus/pkg/dao/model"
+	"github.com/seal-io/walrus/pkg/dao/types/object"
+)
+
+// Collection of the headers attached to the delivering request.
+const (
+	// HeaderEvent is the header of the event name, in form of `<topic>.<type>`.
+	HeaderEvent = "X-Walrus-Event"
+	// HeaderDelivery is the header of the delivery ID.
+	HeaderDelivery = "X-Walrus-Delivery"
+	// HeaderSignature is the header of the payload signature,
+	// in form of `sha256=<hex encoded HMAC-SHA256 of the payload>`.
+	HeaderSignature = "X-Walrus-Signature-256"
+)
+
+const (
+	// TopicTest is the topic of the test event.
+	TopicTest = "test"
+	// EventTypeTest is the type of the test event.
+	EventTypeTest = "test"
+)
+
+// Event is the payload delivered to the webhooks.
+type Event struct {
+	// ID is the ID of the event,
+	// the same change observed by multiple replicas shares the same ID.
+	ID string `json:"id"`
+	// Topic is the topic of the event, i.e. resources, resource_runs.
+	Topic string `json:"topic"`
+	// Type is the type of the event, i.e. create, update, delete.
+	Type string `json:"type"`
+	// Timestamp is the time of the change.
+	Timestamp time.Time `json:"timestamp"`
+	// ProjectID is the ID of the project to which the webhook belongs.
+	ProjectID object.ID `json:"projectID,omitempty"`
+	// Data holds the changed items.
+	Data []EventData `json:"data"`
+}
+
+// EventData holds the brief of a changed item.
+type EventData struct {
+	ID            object.ID `json:"id"`
+	Name          string    `json:"name,omitempty"`
+	ProjectID     object.ID `json:"projectID,omitempty"`
+	EnvironmentID object.ID `json:"environmentID,omitempty"`
+}
+
+// Name returns the name of the event.
+func (e Event) Name() string {
+	return e.Topic + "." + e.Type
+}
+
+// Sign returns the signature of the given payload with the given secret.
+func Sign(secret string, payload []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	_, _ = h.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(h.Sum(nil))
+}
+
+// IsSubscribed returns true if the given webhook subscribes the given topic and event type.
+func IsSubscribed(wh *model.Webhook, topic, eventType string) bool {
+	if wh == nil || wh.Disabled {
+		return false
+	}
+
+	if !slices.Contains(wh.Topics, topic) {
+		return false
+	}
+
+	return len(wh.EventTypes) == 0 || slices.Contains(wh.EventTypes, eventType)
+}
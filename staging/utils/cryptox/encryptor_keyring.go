@@ -0,0 +1,179 @@
+package cryptox
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
+
+// keyringMagic is the prefix of the ciphertext encrypted by Keyring,
+// which is followed by the length of the key ID, the key ID and the ciphertext.
+var keyringMagic = []byte{0x00, 'w', 'k'}
+
+// NewKeyring returns a Keyring with the given fallback Encryptor,
+// the fallback Encryptor is used to encrypt data if no primary key is activated,
+// and to decrypt the data which is not tagged with any key ID.
+func NewKeyring(fallback Encryptor) *Keyring {
+	return &Keyring{
+		fallback: fallback,
+		keys:     map[string]Encryptor{},
+	}
+}
+
+// Keyring implements Encryptor with multiple versioned keys,
+// the ciphertext is tagged with the ID of the key encrypting it,
+// so that the data encrypted by the previous keys can still be decrypted after rotation.
+type Keyring struct {
+	fallback Encryptor
+
+	m       sync.RWMutex
+	primary string
+	keys    map[string]Encryptor
+	resolve func(id string) (Encryptor, error)
+}
+
+// Set puts the Encryptor of the given key ID into the keyring.
+func (k *Keyring) Set(id string, enc Encryptor) {
+	k.m.Lock()
+	defer k.m.Unlock()
+
+	k.keys[id] = enc
+}
+
+// Activate sets the given key ID as the primary key for encrypting,
+// empty ID means encrypting with the fallback Encryptor.
+func (k *Keyring) Activate(id string) error {
+	if len(id) > 255 {
+		return fmt.Errorf("key ID %q is too long", id)
+	}
+
+	k.m.Lock()
+	defer k.m.Unlock()
+
+	if _, ok := k.keys[id]; id != "" && !ok {
+		return fmt.Errorf("key %q not found", id)
+	}
+
+	k.primary = id
+
+	return nil
+}
+
+// Primary returns the ID of the primary key,
+// empty means encrypting with the fallback Encryptor.
+func (k *Keyring) Primary() string {
+	k.m.RLock()
+	defer k.m.RUnlock()
+
+	return k.primary
+}
+
+// Resolve configures the function to retrieve the Encryptor of the unknown key ID,
+// the resolved Encryptor is cached in the keyring.
+func (k *Keyring) Resolve(fn func(id string) (Encryptor, error)) {
+	k.m.Lock()
+	defer k.m.Unlock()
+
+	k.resolve = fn
+}
+
+func (k *Keyring) get(id string) (Encryptor, error) {
+	k.m.RLock()
+	enc, ok := k.keys[id]
+	resolve := k.resolve
+	k.m.RUnlock()
+
+	if ok {
+		return enc, nil
+	}
+
+	if resolve == nil {
+		return nil, fmt.Errorf("key %q not found", id)
+	}
+
+	enc, err := resolve(id)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving key %q: %w", id, err)
+	}
+
+	k.Set(id, enc)
+
+	return enc, nil
+}
+
+func (k *Keyring) Encrypt(p, a []byte) ([]byte, error) {
+	k.m.RLock()
+	id := k.primary
+	enc := k.keys[id]
+	k.m.RUnlock()
+
+	if id == "" {
+		return k.fallback.Encrypt(p, a)
+	}
+
+	if len(p) == 0 {
+		return []byte{}, nil
+	}
+
+	c, err := enc.Encrypt(p, a)
+	if err != nil {
+		return nil, err
+	}
+
+	t := make([]byte, 0, len(keyringMagic)+1+len(id)+len(c))
+	t = append(t, keyringMagic...)
+	t = append(t, byte(len(id)))
+	t = append(t, id...)
+	t = append(t, c...)
+
+	return t, nil
+}
+
+func (k *Keyring) Decrypt(c, a []byte) ([]byte, error) {
+	id, tc, ok := parseKeyringCiphertext(c)
+	if !ok {
+		return k.fallback.Decrypt(c, a)
+	}
+
+	enc, err := k.get(id)
+	if err == nil {
+		var p []byte
+
+		p, err = enc.Decrypt(tc, a)
+		if err == nil {
+			return p, nil
+		}
+	}
+
+	// The untagged ciphertext may start with the magic by chance,
+	// try to decrypt it with the fallback Encryptor if it is a real one.
+	if _, isNull := k.fallback.(nullEncryptor); !isNull {
+		if p, ferr := k.fallback.Decrypt(c, a); ferr == nil {
+			return p, nil
+		}
+	}
+
+	return nil, err
+}
+
+// KeyringKeyID returns the key ID tagged in the given ciphertext,
+// returns empty if the ciphertext is not encrypted by the Keyring with a primary key.
+func KeyringKeyID(c []byte) string {
+	id, _, _ := parseKeyringCiphertext(c)
+	return id
+}
+
+func parseKeyringCiphertext(c []byte) (id string, tc []byte, ok bool) {
+	if !bytes.HasPrefix(c, keyringMagic) || len(c) < len(keyringMagic)+1 {
+		return "", nil, false
+	}
+
+	c = c[len(keyringMagic):]
+
+	n := int(c[0])
+	if n == 0 || len(c) < 1+n {
+		return "", nil, false
+	}
+
+	return string(c[1 : 1+n]), c[1+n:], true
+}
@@ -0,0 +1,73 @@
+package cryptox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring(t *testing.T) {
+	legacy, err := AesGcm([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+
+	k1, err := AesGcm([]byte("fedcba9876543210"))
+	require.NoError(t, err)
+
+	k2, err := AesGcm([]byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	kr := NewKeyring(legacy)
+
+	// Encrypt with the fallback before activating.
+	c0, err := kr.Encrypt([]byte("v0"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", KeyringKeyID(c0))
+
+	// Encrypt with the primary key.
+	kr.Set("1", k1)
+	require.NoError(t, kr.Activate("1"))
+
+	c1, err := kr.Encrypt([]byte("v1"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "1", KeyringKeyID(c1))
+
+	// Rotate.
+	kr.Set("2", k2)
+	require.NoError(t, kr.Activate("2"))
+
+	c2, err := kr.Encrypt([]byte("v2"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "2", KeyringKeyID(c2))
+
+	for i, c := range [][]byte{c0, c1, c2} {
+		p, err := kr.Decrypt(c, nil)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("v%d", i), string(p))
+	}
+
+	// Empty.
+	c, err := kr.Encrypt(nil, nil)
+	require.NoError(t, err)
+
+	p, err := kr.Decrypt(c, nil)
+	require.NoError(t, err)
+	assert.Empty(t, p)
+
+	// Unknown key.
+	assert.Error(t, kr.Activate("3"))
+
+	other := NewKeyring(legacy)
+	_, err = other.Decrypt(c2, nil)
+	assert.Error(t, err)
+
+	// Resolve unknown key.
+	other.Resolve(func(id string) (Encryptor, error) {
+		return k2, nil
+	})
+
+	p, err = other.Decrypt(c2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(p))
+}